@@ -30,37 +30,41 @@ const (
 
 // 后台操作日志
 type AdminOperationLog struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                // 后台操作日志ID
-	CostTime       *durationpb.Duration   `protobuf:"bytes,2,opt,name=cost_time,json=costTime,proto3,oneof" json:"cost_time,omitempty"`                     // 操作耗时
-	Success        *bool                  `protobuf:"varint,3,opt,name=success,proto3,oneof" json:"success,omitempty"`                                      // 操作是否成功
-	RequestId      *string                `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                  // 请求ID
-	StatusCode     *int32                 `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`              // 状态码
-	Reason         *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                         // 操作失败原因
-	Location       *string                `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`                                     // 操作地理位置
-	Operation      *string                `protobuf:"bytes,8,opt,name=operation,proto3,oneof" json:"operation,omitempty"`                                   // 操作方法
-	Method         *string                `protobuf:"bytes,9,opt,name=method,proto3,oneof" json:"method,omitempty"`                                         // 请求方法
-	Path           *string                `protobuf:"bytes,10,opt,name=path,proto3,oneof" json:"path,omitempty"`                                            // 请求路径
-	ApiModule      *string                `protobuf:"bytes,11,opt,name=api_module,json=apiModule,proto3,oneof" json:"api_module,omitempty"`                 // API所属模块
-	ApiDescription *string                `protobuf:"bytes,12,opt,name=api_description,json=apiDescription,proto3,oneof" json:"api_description,omitempty"`  // API操作描述
-	Referer        *string                `protobuf:"bytes,20,opt,name=referer,proto3,oneof" json:"referer,omitempty"`                                      // 请求源
-	RequestUri     *string                `protobuf:"bytes,21,opt,name=request_uri,json=requestUri,proto3,oneof" json:"request_uri,omitempty"`              // 请求URI
-	RequestHeader  *string                `protobuf:"bytes,50,opt,name=request_header,json=requestHeader,proto3,oneof" json:"request_header,omitempty"`     // 请求头
-	RequestBody    *string                `protobuf:"bytes,51,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`           // 请求体
-	Response       *string                `protobuf:"bytes,52,opt,name=response,proto3,oneof" json:"response,omitempty"`                                    // 响应信息
-	UserId         *uint32                `protobuf:"varint,100,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                        // 操作者用户ID
-	Username       *string                `protobuf:"bytes,101,opt,name=username,proto3,oneof" json:"username,omitempty"`                                   // 操作者账号名
-	ClientIp       *string                `protobuf:"bytes,102,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`                   // 操作者IP
-	UserAgent      *string                `protobuf:"bytes,200,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`                // 浏览器的用户代理信息
-	BrowserName    *string                `protobuf:"bytes,201,opt,name=browser_name,json=browserName,proto3,oneof" json:"browser_name,omitempty"`          // 浏览器名称
-	BrowserVersion *string                `protobuf:"bytes,202,opt,name=browser_version,json=browserVersion,proto3,oneof" json:"browser_version,omitempty"` // 浏览器版本
-	ClientId       *string                `protobuf:"bytes,300,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`                   // 客户端ID
-	ClientName     *string                `protobuf:"bytes,301,opt,name=client_name,json=clientName,proto3,oneof" json:"client_name,omitempty"`             // 客户端名称
-	OsName         *string                `protobuf:"bytes,302,opt,name=os_name,json=osName,proto3,oneof" json:"os_name,omitempty"`                         // 操作系统名称
-	OsVersion      *string                `protobuf:"bytes,303,opt,name=os_version,json=osVersion,proto3,oneof" json:"os_version,omitempty"`                // 操作系统版本
-	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,500,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                // 创建时间
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                     // 后台操作日志ID
+	CostTime             *durationpb.Duration   `protobuf:"bytes,2,opt,name=cost_time,json=costTime,proto3,oneof" json:"cost_time,omitempty"`                                          // 操作耗时
+	Success              *bool                  `protobuf:"varint,3,opt,name=success,proto3,oneof" json:"success,omitempty"`                                                           // 操作是否成功
+	RequestId            *string                `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3,oneof" json:"request_id,omitempty"`                                       // 请求ID
+	StatusCode           *int32                 `protobuf:"varint,5,opt,name=status_code,json=statusCode,proto3,oneof" json:"status_code,omitempty"`                                   // 状态码
+	Reason               *string                `protobuf:"bytes,6,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                                              // 操作失败原因
+	Location             *string                `protobuf:"bytes,7,opt,name=location,proto3,oneof" json:"location,omitempty"`                                                          // 操作地理位置
+	Operation            *string                `protobuf:"bytes,8,opt,name=operation,proto3,oneof" json:"operation,omitempty"`                                                        // 操作方法
+	Method               *string                `protobuf:"bytes,9,opt,name=method,proto3,oneof" json:"method,omitempty"`                                                              // 请求方法
+	Path                 *string                `protobuf:"bytes,10,opt,name=path,proto3,oneof" json:"path,omitempty"`                                                                 // 请求路径
+	ApiModule            *string                `protobuf:"bytes,11,opt,name=api_module,json=apiModule,proto3,oneof" json:"api_module,omitempty"`                                      // API所属模块
+	ApiDescription       *string                `protobuf:"bytes,12,opt,name=api_description,json=apiDescription,proto3,oneof" json:"api_description,omitempty"`                       // API操作描述
+	Referer              *string                `protobuf:"bytes,20,opt,name=referer,proto3,oneof" json:"referer,omitempty"`                                                           // 请求源
+	RequestUri           *string                `protobuf:"bytes,21,opt,name=request_uri,json=requestUri,proto3,oneof" json:"request_uri,omitempty"`                                   // 请求URI
+	RequestHeader        *string                `protobuf:"bytes,50,opt,name=request_header,json=requestHeader,proto3,oneof" json:"request_header,omitempty"`                          // 请求头
+	RequestBody          *string                `protobuf:"bytes,51,opt,name=request_body,json=requestBody,proto3,oneof" json:"request_body,omitempty"`                                // 请求体
+	Response             *string                `protobuf:"bytes,52,opt,name=response,proto3,oneof" json:"response,omitempty"`                                                         // 响应信息
+	UserId               *uint32                `protobuf:"varint,100,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`                                             // 操作者用户ID
+	Username             *string                `protobuf:"bytes,101,opt,name=username,proto3,oneof" json:"username,omitempty"`                                                        // 操作者账号名
+	ClientIp             *string                `protobuf:"bytes,102,opt,name=client_ip,json=clientIp,proto3,oneof" json:"client_ip,omitempty"`                                        // 操作者IP
+	ImpersonatorId       *uint32                `protobuf:"varint,110,opt,name=impersonator_id,json=impersonatorId,proto3,oneof" json:"impersonator_id,omitempty"`                     // 代管操作人的用户ID
+	ImpersonatorName     *string                `protobuf:"bytes,111,opt,name=impersonator_name,json=impersonatorName,proto3,oneof" json:"impersonator_name,omitempty"`                // 代管操作人的用户名
+	ImpersonationReason  *string                `protobuf:"bytes,112,opt,name=impersonation_reason,json=impersonationReason,proto3,oneof" json:"impersonation_reason,omitempty"`       // 代管原因
+	ImpersonationAuditId *uint32                `protobuf:"varint,113,opt,name=impersonation_audit_id,json=impersonationAuditId,proto3,oneof" json:"impersonation_audit_id,omitempty"` // 代管审计日志ID
+	UserAgent            *string                `protobuf:"bytes,200,opt,name=user_agent,json=userAgent,proto3,oneof" json:"user_agent,omitempty"`                                     // 浏览器的用户代理信息
+	BrowserName          *string                `protobuf:"bytes,201,opt,name=browser_name,json=browserName,proto3,oneof" json:"browser_name,omitempty"`                               // 浏览器名称
+	BrowserVersion       *string                `protobuf:"bytes,202,opt,name=browser_version,json=browserVersion,proto3,oneof" json:"browser_version,omitempty"`                      // 浏览器版本
+	ClientId             *string                `protobuf:"bytes,300,opt,name=client_id,json=clientId,proto3,oneof" json:"client_id,omitempty"`                                        // 客户端ID
+	ClientName           *string                `protobuf:"bytes,301,opt,name=client_name,json=clientName,proto3,oneof" json:"client_name,omitempty"`                                  // 客户端名称
+	OsName               *string                `protobuf:"bytes,302,opt,name=os_name,json=osName,proto3,oneof" json:"os_name,omitempty"`                                              // 操作系统名称
+	OsVersion            *string                `protobuf:"bytes,303,opt,name=os_version,json=osVersion,proto3,oneof" json:"os_version,omitempty"`                                     // 操作系统版本
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,500,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                     // 创建时间
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *AdminOperationLog) Reset() {
@@ -233,6 +237,34 @@ func (x *AdminOperationLog) GetClientIp() string {
 	return ""
 }
 
+func (x *AdminOperationLog) GetImpersonatorId() uint32 {
+	if x != nil && x.ImpersonatorId != nil {
+		return *x.ImpersonatorId
+	}
+	return 0
+}
+
+func (x *AdminOperationLog) GetImpersonatorName() string {
+	if x != nil && x.ImpersonatorName != nil {
+		return *x.ImpersonatorName
+	}
+	return ""
+}
+
+func (x *AdminOperationLog) GetImpersonationReason() string {
+	if x != nil && x.ImpersonationReason != nil {
+		return *x.ImpersonationReason
+	}
+	return ""
+}
+
+func (x *AdminOperationLog) GetImpersonationAuditId() uint32 {
+	if x != nil && x.ImpersonationAuditId != nil {
+		return *x.ImpersonationAuditId
+	}
+	return 0
+}
+
 func (x *AdminOperationLog) GetUserAgent() string {
 	if x != nil && x.UserAgent != nil {
 		return *x.UserAgent
@@ -580,7 +612,7 @@ var File_admin_service_v1_i_admin_operation_log_proto protoreflect.FileDescripto
 
 const file_admin_service_v1_i_admin_operation_log_proto_rawDesc = "" +
 	"\n" +
-	",admin/service/v1/i_admin_operation_log.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1epagination/v1/pagination.proto\"\x9b\x10\n" +
+	",admin/service/v1/i_admin_operation_log.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1epagination/v1/pagination.proto\"\xee\x14\n" +
 	"\x11AdminOperationLog\x122\n" +
 	"\x02id\x18\x01 \x01(\rB\x1d\xe0A\x01\xbaG\x17\x92\x02\x14后台操作日志IDH\x00R\x02id\x88\x01\x01\x12O\n" +
 	"\tcost_time\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x12\xbaG\x0f\x92\x02\f操作耗时H\x01R\bcostTime\x88\x01\x01\x127\n" +
@@ -607,19 +639,23 @@ const file_admin_service_v1_i_admin_operation_log_proto_rawDesc = "" +
 	"\bresponse\x184 \x01(\tB\x12\xbaG\x0f\x92\x02\f响应信息H\x10R\bresponse\x88\x01\x01\x125\n" +
 	"\auser_id\x18d \x01(\rB\x17\xbaG\x14\x92\x02\x11操作者用户IDH\x11R\x06userId\x88\x01\x01\x129\n" +
 	"\busername\x18e \x01(\tB\x18\xbaG\x15\x92\x02\x12操作者账号名H\x12R\busername\x88\x01\x01\x123\n" +
-	"\tclient_ip\x18f \x01(\tB\x11\xbaG\x0e\x92\x02\v操作者IPH\x13R\bclientIp\x88\x01\x01\x12I\n" +
+	"\tclient_ip\x18f \x01(\tB\x11\xbaG\x0e\x92\x02\v操作者IPH\x13R\bclientIp\x88\x01\x01\x12\x87\x01\n" +
+	"\x0fimpersonator_id\x18n \x01(\rBY\xbaGV\x92\x02S代管操作人的用户ID，不为空表示平台管理员代管租户时的操作H\x14R\x0eimpersonatorId\x88\x01\x01\x12S\n" +
+	"\x11impersonator_name\x18o \x01(\tB!\xbaG\x1e\x92\x02\x1b代管操作人的用户名H\x15R\x10impersonatorName\x88\x01\x01\x12w\n" +
+	"\x14impersonation_reason\x18p \x01(\tB?\xbaG<\x92\x029代管原因，只记录在开始代管的审计日志中H\x16R\x13impersonationReason\x88\x01\x01\x12\x9a\x01\n" +
+	"\x16impersonation_audit_id\x18q \x01(\rB_\xbaG\\\x92\x02Y代管审计日志的ID，代管期间的操作据此关联到开始代管的审计日志H\x17R\x14impersonationAuditId\x88\x01\x01\x12I\n" +
 	"\n" +
-	"user_agent\x18\xc8\x01 \x01(\tB$\xbaG!\x92\x02\x1e浏览器的用户代理信息H\x14R\tuserAgent\x88\x01\x01\x12>\n" +
-	"\fbrowser_name\x18\xc9\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f浏览器名称H\x15R\vbrowserName\x88\x01\x01\x12D\n" +
-	"\x0fbrowser_version\x18\xca\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f浏览器版本H\x16R\x0ebrowserVersion\x88\x01\x01\x124\n" +
-	"\tclient_id\x18\xac\x02 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x17R\bclientId\x88\x01\x01\x12<\n" +
-	"\vclient_name\x18\xad\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f客户端名称H\x18R\n" +
+	"user_agent\x18\xc8\x01 \x01(\tB$\xbaG!\x92\x02\x1e浏览器的用户代理信息H\x18R\tuserAgent\x88\x01\x01\x12>\n" +
+	"\fbrowser_name\x18\xc9\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f浏览器名称H\x19R\vbrowserName\x88\x01\x01\x12D\n" +
+	"\x0fbrowser_version\x18\xca\x01 \x01(\tB\x15\xbaG\x12\x92\x02\x0f浏览器版本H\x1aR\x0ebrowserVersion\x88\x01\x01\x124\n" +
+	"\tclient_id\x18\xac\x02 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x1bR\bclientId\x88\x01\x01\x12<\n" +
+	"\vclient_name\x18\xad\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f客户端名称H\x1cR\n" +
 	"clientName\x88\x01\x01\x127\n" +
-	"\aos_name\x18\xae\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12操作系统名称H\x19R\x06osName\x88\x01\x01\x12=\n" +
+	"\aos_name\x18\xae\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12操作系统名称H\x1dR\x06osName\x88\x01\x01\x12=\n" +
 	"\n" +
-	"os_version\x18\xaf\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12操作系统版本H\x1aR\tosVersion\x88\x01\x01\x12S\n" +
+	"os_version\x18\xaf\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12操作系统版本H\x1eR\tosVersion\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xf4\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1bR\tcreatedAt\x88\x01\x01B\x05\n" +
+	"created_at\x18\xf4\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1fR\tcreatedAt\x88\x01\x01B\x05\n" +
 	"\x03_idB\f\n" +
 	"\n" +
 	"_cost_timeB\n" +
@@ -645,7 +681,11 @@ const file_admin_service_v1_i_admin_operation_log_proto_rawDesc = "" +
 	"\b_user_idB\v\n" +
 	"\t_usernameB\f\n" +
 	"\n" +
-	"_client_ipB\r\n" +
+	"_client_ipB\x12\n" +
+	"\x10_impersonator_idB\x14\n" +
+	"\x12_impersonator_nameB\x17\n" +
+	"\x15_impersonation_reasonB\x19\n" +
+	"\x17_impersonation_audit_idB\r\n" +
 	"\v_user_agentB\x0f\n" +
 	"\r_browser_nameB\x12\n" +
 	"\x10_browser_versionB\f\n" +
@@ -118,6 +118,14 @@ func (x *AdminOperationLog) Redact() string {
 
 	// Safe field: ClientIp
 
+	// Safe field: ImpersonatorId
+
+	// Safe field: ImpersonatorName
+
+	// Safe field: ImpersonationReason
+
+	// Safe field: ImpersonationAuditId
+
 	// Safe field: UserAgent
 
 	// Safe field: BrowserName
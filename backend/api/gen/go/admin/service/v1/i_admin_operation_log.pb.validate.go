@@ -166,6 +166,22 @@ func (m *AdminOperationLog) validate(all bool) error {
 		// no validation rules for ClientIp
 	}
 
+	if m.ImpersonatorId != nil {
+		// no validation rules for ImpersonatorId
+	}
+
+	if m.ImpersonatorName != nil {
+		// no validation rules for ImpersonatorName
+	}
+
+	if m.ImpersonationReason != nil {
+		// no validation rules for ImpersonationReason
+	}
+
+	if m.ImpersonationAuditId != nil {
+		// no validation rules for ImpersonationAuditId
+	}
+
 	if m.UserAgent != nil {
 		// no validation rules for UserAgent
 	}
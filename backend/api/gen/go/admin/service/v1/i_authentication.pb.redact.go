@@ -76,3 +76,36 @@ func (s *redactedAuthenticationServiceServer) RefreshToken(ctx context.Context,
 	}
 	return res, err
 }
+
+// WhoAmI is the redacted wrapper for the actual AuthenticationServiceServer.WhoAmI method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) WhoAmI(ctx context.Context, in *emptypb.Empty) (*servicev11.WhoAmIResponse, error) {
+	res, err := s.srv.WhoAmI(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// Impersonate is the redacted wrapper for the actual AuthenticationServiceServer.Impersonate method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) Impersonate(ctx context.Context, in *servicev11.ImpersonateRequest) (*servicev11.LoginResponse, error) {
+	res, err := s.srv.Impersonate(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CreateSseTicket is the redacted wrapper for the actual AuthenticationServiceServer.CreateSseTicket method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) CreateSseTicket(ctx context.Context, in *emptypb.Empty) (*servicev11.CreateSseTicketResponse, error) {
+	res, err := s.srv.CreateSseTicket(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
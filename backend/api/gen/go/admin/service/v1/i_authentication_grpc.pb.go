@@ -21,9 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AuthenticationService_Login_FullMethodName        = "/admin.service.v1.AuthenticationService/Login"
-	AuthenticationService_Logout_FullMethodName       = "/admin.service.v1.AuthenticationService/Logout"
-	AuthenticationService_RefreshToken_FullMethodName = "/admin.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_Login_FullMethodName           = "/admin.service.v1.AuthenticationService/Login"
+	AuthenticationService_Logout_FullMethodName          = "/admin.service.v1.AuthenticationService/Logout"
+	AuthenticationService_RefreshToken_FullMethodName    = "/admin.service.v1.AuthenticationService/RefreshToken"
+	AuthenticationService_WhoAmI_FullMethodName          = "/admin.service.v1.AuthenticationService/WhoAmI"
+	AuthenticationService_Impersonate_FullMethodName     = "/admin.service.v1.AuthenticationService/Impersonate"
+	AuthenticationService_CreateSseTicket_FullMethodName = "/admin.service.v1.AuthenticationService/CreateSseTicket"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -38,6 +41,12 @@ type AuthenticationServiceClient interface {
 	Logout(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(ctx context.Context, in *v1.LoginRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 获取当前用户身份信息，代管时同时返回操作人的身份
+	WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WhoAmIResponse, error)
+	// 平台管理员代管租户，获取限时的代管访问令牌
+	Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error)
+	// 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+	CreateSseTicket(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.CreateSseTicketResponse, error)
 }
 
 type authenticationServiceClient struct {
@@ -78,6 +87,36 @@ func (c *authenticationServiceClient) RefreshToken(ctx context.Context, in *v1.L
 	return out, nil
 }
 
+func (c *authenticationServiceClient) WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.WhoAmIResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.WhoAmIResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_WhoAmI_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...grpc.CallOption) (*v1.LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationServiceClient) CreateSseTicket(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.CreateSseTicketResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.CreateSseTicketResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_CreateSseTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility.
@@ -90,6 +129,12 @@ type AuthenticationServiceServer interface {
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 刷新认证令牌
 	RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// 获取当前用户身份信息，代管时同时返回操作人的身份
+	WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error)
+	// 平台管理员代管租户，获取限时的代管访问令牌
+	Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error)
+	// 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+	CreateSseTicket(context.Context, *emptypb.Empty) (*v1.CreateSseTicketResponse, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -109,6 +154,15 @@ func (UnimplementedAuthenticationServiceServer) Logout(context.Context, *emptypb
 func (UnimplementedAuthenticationServiceServer) RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
+func (UnimplementedAuthenticationServiceServer) CreateSseTicket(context.Context, *emptypb.Empty) (*v1.CreateSseTicketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSseTicket not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 func (UnimplementedAuthenticationServiceServer) testEmbeddedByValue()                               {}
 
@@ -184,6 +238,60 @@ func _AuthenticationService_RefreshToken_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_WhoAmI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).WhoAmI(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, req.(*v1.ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuthenticationService_CreateSseTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).CreateSseTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_CreateSseTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).CreateSseTicket(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -203,6 +311,18 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _AuthenticationService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _AuthenticationService_WhoAmI_Handler,
+		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AuthenticationService_Impersonate_Handler,
+		},
+		{
+			MethodName: "CreateSseTicket",
+			Handler:    _AuthenticationService_CreateSseTicket_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_authentication.proto",
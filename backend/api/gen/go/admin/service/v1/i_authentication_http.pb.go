@@ -21,17 +21,26 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthenticationServiceCreateSseTicket = "/admin.service.v1.AuthenticationService/CreateSseTicket"
+const OperationAuthenticationServiceImpersonate = "/admin.service.v1.AuthenticationService/Impersonate"
 const OperationAuthenticationServiceLogin = "/admin.service.v1.AuthenticationService/Login"
 const OperationAuthenticationServiceLogout = "/admin.service.v1.AuthenticationService/Logout"
 const OperationAuthenticationServiceRefreshToken = "/admin.service.v1.AuthenticationService/RefreshToken"
+const OperationAuthenticationServiceWhoAmI = "/admin.service.v1.AuthenticationService/WhoAmI"
 
 type AuthenticationServiceHTTPServer interface {
+	// CreateSseTicket 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+	CreateSseTicket(context.Context, *emptypb.Empty) (*v1.CreateSseTicketResponse, error)
+	// Impersonate 平台管理员代管租户，获取限时的代管访问令牌
+	Impersonate(context.Context, *v1.ImpersonateRequest) (*v1.LoginResponse, error)
 	// Login 登录
 	Login(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
 	// Logout 登出
 	Logout(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(context.Context, *v1.LoginRequest) (*v1.LoginResponse, error)
+	// WhoAmI 获取当前用户身份信息，代管时同时返回操作人的身份
+	WhoAmI(context.Context, *emptypb.Empty) (*v1.WhoAmIResponse, error)
 }
 
 func RegisterAuthenticationServiceHTTPServer(s *http.Server, srv AuthenticationServiceHTTPServer) {
@@ -39,6 +48,9 @@ func RegisterAuthenticationServiceHTTPServer(s *http.Server, srv AuthenticationS
 	r.POST("/admin/v1/login", _AuthenticationService_Login0_HTTP_Handler(srv))
 	r.POST("/admin/v1/logout", _AuthenticationService_Logout0_HTTP_Handler(srv))
 	r.POST("/admin/v1/refresh_token", _AuthenticationService_RefreshToken0_HTTP_Handler(srv))
+	r.GET("/admin/v1/whoami", _AuthenticationService_WhoAmI0_HTTP_Handler(srv))
+	r.POST("/admin/v1/impersonate", _AuthenticationService_Impersonate0_HTTP_Handler(srv))
+	r.POST("/admin/v1/sse/ticket", _AuthenticationService_CreateSseTicket0_HTTP_Handler(srv))
 }
 
 func _AuthenticationService_Login0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
@@ -107,13 +119,82 @@ func _AuthenticationService_RefreshToken0_HTTP_Handler(srv AuthenticationService
 	}
 }
 
+func _AuthenticationService_WhoAmI0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceWhoAmI)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.WhoAmI(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.WhoAmIResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_Impersonate0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.ImpersonateRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceImpersonate)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Impersonate(ctx, req.(*v1.ImpersonateRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.LoginResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthenticationService_CreateSseTicket0_HTTP_Handler(srv AuthenticationServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthenticationServiceCreateSseTicket)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSseTicket(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.CreateSseTicketResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AuthenticationServiceHTTPClient interface {
+	// CreateSseTicket 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+	CreateSseTicket(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.CreateSseTicketResponse, err error)
+	// Impersonate 平台管理员代管租户，获取限时的代管访问令牌
+	Impersonate(ctx context.Context, req *v1.ImpersonateRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Login 登录
 	Login(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
 	// Logout 登出
 	Logout(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// RefreshToken 刷新认证令牌
 	RefreshToken(ctx context.Context, req *v1.LoginRequest, opts ...http.CallOption) (rsp *v1.LoginResponse, err error)
+	// WhoAmI 获取当前用户身份信息，代管时同时返回操作人的身份
+	WhoAmI(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.WhoAmIResponse, err error)
 }
 
 type AuthenticationServiceHTTPClientImpl struct {
@@ -124,6 +205,34 @@ func NewAuthenticationServiceHTTPClient(client *http.Client) AuthenticationServi
 	return &AuthenticationServiceHTTPClientImpl{client}
 }
 
+// CreateSseTicket 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+func (c *AuthenticationServiceHTTPClientImpl) CreateSseTicket(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.CreateSseTicketResponse, error) {
+	var out v1.CreateSseTicketResponse
+	pattern := "/admin/v1/sse/ticket"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceCreateSseTicket))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Impersonate 平台管理员代管租户，获取限时的代管访问令牌
+func (c *AuthenticationServiceHTTPClientImpl) Impersonate(ctx context.Context, in *v1.ImpersonateRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
+	var out v1.LoginResponse
+	pattern := "/admin/v1/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceImpersonate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Login 登录
 func (c *AuthenticationServiceHTTPClientImpl) Login(ctx context.Context, in *v1.LoginRequest, opts ...http.CallOption) (*v1.LoginResponse, error) {
 	var out v1.LoginResponse
@@ -165,3 +274,17 @@ func (c *AuthenticationServiceHTTPClientImpl) RefreshToken(ctx context.Context,
 	}
 	return &out, nil
 }
+
+// WhoAmI 获取当前用户身份信息，代管时同时返回操作人的身份
+func (c *AuthenticationServiceHTTPClientImpl) WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.WhoAmIResponse, error) {
+	var out v1.WhoAmIResponse
+	pattern := "/admin/v1/whoami"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthenticationServiceWhoAmI))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
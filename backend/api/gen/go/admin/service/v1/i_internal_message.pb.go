@@ -29,7 +29,7 @@ var File_admin_service_v1_i_internal_message_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_internal_message_proto_rawDesc = "" +
 	"\n" +
-	")admin/service/v1/i_internal_message.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a2internal_message/service/v1/internal_message.proto2\xac\a\n" +
+	")admin/service/v1/i_internal_message.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a2internal_message/service/v1/internal_message.proto\x1a;internal_message/service/v1/internal_message_delivery.proto\x1a<internal_message/service/v1/internal_message_recipient.proto2\xd4\x14\n" +
 	"\x16InternalMessageService\x12\x8f\x01\n" +
 	"\vListMessage\x12\x19.pagination.PagingRequest\x1a8.internal_message.service.v1.ListInternalMessageResponse\"+\x82\xd3\xe4\x93\x02%\x12#/admin/v1/internal-message/messages\x12\xa4\x01\n" +
 	"\n" +
@@ -37,39 +37,80 @@ const file_admin_service_v1_i_internal_message_proto_rawDesc = "" +
 	"\rUpdateMessage\x129.internal_message.service.v1.UpdateInternalMessageRequest\x1a\x16.google.protobuf.Empty\"3\x82\xd3\xe4\x93\x02-:\x01*\x1a(/admin/v1/internal-message/messages/{id}\x12\x94\x01\n" +
 	"\rDeleteMessage\x129.internal_message.service.v1.DeleteInternalMessageRequest\x1a\x16.google.protobuf.Empty\"0\x82\xd3\xe4\x93\x02**(/admin/v1/internal-message/messages/{id}\x12\x9c\x01\n" +
 	"\vSendMessage\x12/.internal_message.service.v1.SendMessageRequest\x1a0.internal_message.service.v1.SendMessageResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/admin/v1/internal-message/send\x12\x88\x01\n" +
-	"\rRevokeMessage\x121.internal_message.service.v1.RevokeMessageRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/v1/internal-message/revokeB\xc4\x01\n" +
+	"\rRevokeMessage\x121.internal_message.service.v1.RevokeMessageRequest\x1a\x16.google.protobuf.Empty\",\x82\xd3\xe4\x93\x02&:\x01*\"!/admin/v1/internal-message/revoke\x12\xaa\x01\n" +
+	"\x16UpdateScheduledMessage\x12:.internal_message.service.v1.UpdateScheduledMessageRequest\x1a\x16.google.protobuf.Empty\"<\x82\xd3\xe4\x93\x026:\x01*\x1a1/admin/v1/internal-message/scheduled/{message_id}\x12\xb1\x01\n" +
+	"\x16CancelScheduledMessage\x12:.internal_message.service.v1.CancelScheduledMessageRequest\x1a\x16.google.protobuf.Empty\"C\x82\xd3\xe4\x93\x02=:\x01*\"8/admin/v1/internal-message/scheduled/{message_id}:cancel\x12\xad\x01\n" +
+	"\x0fPreviewAudience\x12,.internal_message.service.v1.MessageAudience\x1a4.internal_message.service.v1.PreviewAudienceResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/admin/v1/internal-message/audience:preview\x12\x9a\x01\n" +
+	"\fListDelivery\x12\x19.pagination.PagingRequest\x1a@.internal_message.service.v1.ListInternalMessageDeliveryResponse\"-\x82\xd3\xe4\x93\x02'\x12%/admin/v1/internal-message/deliveries\x12\x90\x01\n" +
+	"\vListChannel\x12\x16.google.protobuf.Empty\x1a<.internal_message.service.v1.ListNotificationChannelResponse\"+\x82\xd3\xe4\x93\x02%\x12#/admin/v1/internal-message/channels\x12\xc2\x01\n" +
+	"\x13GetMessageReadStats\x127.internal_message.service.v1.GetMessageReadStatsRequest\x1a-.internal_message.service.v1.MessageReadStats\"C\x82\xd3\xe4\x93\x02=\x12;/admin/v1/internal-message/messages/{message_id}/read-stats\x12\xd4\x01\n" +
+	"\x15ListMessageNonReaders\x129.internal_message.service.v1.ListMessageNonReadersRequest\x1a:.internal_message.service.v1.ListMessageNonReadersResponse\"D\x82\xd3\xe4\x93\x02>\x12</admin/v1/internal-message/messages/{message_id}/non-readers\x12\xe1\x01\n" +
+	"\x17ExportMessageNonReaders\x12;.internal_message.service.v1.ExportMessageNonReadersRequest\x1a<.internal_message.service.v1.ExportMessageNonReadersResponse\"K\x82\xd3\xe4\x93\x02E\x12C/admin/v1/internal-message/messages/{message_id}/non-readers:export\x12\xe4\x01\n" +
+	"\x17RemindMessageNonReaders\x12;.internal_message.service.v1.RemindMessageNonReadersRequest\x1a<.internal_message.service.v1.RemindMessageNonReadersResponse\"N\x82\xd3\xe4\x93\x02H:\x01*\"C/admin/v1/internal-message/messages/{message_id}/non-readers:remindB\xc4\x01\n" +
 	"\x14com.admin.service.v1B\x15IInternalMessageProtoP\x01Z3go-wind-admin/api/gen/go/admin/service/v1;servicev1\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var file_admin_service_v1_i_internal_message_proto_goTypes = []any{
-	(*v1.PagingRequest)(nil),                 // 0: pagination.PagingRequest
-	(*v11.GetInternalMessageRequest)(nil),    // 1: internal_message.service.v1.GetInternalMessageRequest
-	(*v11.UpdateInternalMessageRequest)(nil), // 2: internal_message.service.v1.UpdateInternalMessageRequest
-	(*v11.DeleteInternalMessageRequest)(nil), // 3: internal_message.service.v1.DeleteInternalMessageRequest
-	(*v11.SendMessageRequest)(nil),           // 4: internal_message.service.v1.SendMessageRequest
-	(*v11.RevokeMessageRequest)(nil),         // 5: internal_message.service.v1.RevokeMessageRequest
-	(*v11.ListInternalMessageResponse)(nil),  // 6: internal_message.service.v1.ListInternalMessageResponse
-	(*v11.InternalMessage)(nil),              // 7: internal_message.service.v1.InternalMessage
-	(*emptypb.Empty)(nil),                    // 8: google.protobuf.Empty
-	(*v11.SendMessageResponse)(nil),          // 9: internal_message.service.v1.SendMessageResponse
+	(*v1.PagingRequest)(nil),                        // 0: pagination.PagingRequest
+	(*v11.GetInternalMessageRequest)(nil),           // 1: internal_message.service.v1.GetInternalMessageRequest
+	(*v11.UpdateInternalMessageRequest)(nil),        // 2: internal_message.service.v1.UpdateInternalMessageRequest
+	(*v11.DeleteInternalMessageRequest)(nil),        // 3: internal_message.service.v1.DeleteInternalMessageRequest
+	(*v11.SendMessageRequest)(nil),                  // 4: internal_message.service.v1.SendMessageRequest
+	(*v11.RevokeMessageRequest)(nil),                // 5: internal_message.service.v1.RevokeMessageRequest
+	(*v11.UpdateScheduledMessageRequest)(nil),       // 6: internal_message.service.v1.UpdateScheduledMessageRequest
+	(*v11.CancelScheduledMessageRequest)(nil),       // 7: internal_message.service.v1.CancelScheduledMessageRequest
+	(*v11.MessageAudience)(nil),                     // 8: internal_message.service.v1.MessageAudience
+	(*emptypb.Empty)(nil),                           // 9: google.protobuf.Empty
+	(*v11.GetMessageReadStatsRequest)(nil),          // 10: internal_message.service.v1.GetMessageReadStatsRequest
+	(*v11.ListMessageNonReadersRequest)(nil),        // 11: internal_message.service.v1.ListMessageNonReadersRequest
+	(*v11.ExportMessageNonReadersRequest)(nil),      // 12: internal_message.service.v1.ExportMessageNonReadersRequest
+	(*v11.RemindMessageNonReadersRequest)(nil),      // 13: internal_message.service.v1.RemindMessageNonReadersRequest
+	(*v11.ListInternalMessageResponse)(nil),         // 14: internal_message.service.v1.ListInternalMessageResponse
+	(*v11.InternalMessage)(nil),                     // 15: internal_message.service.v1.InternalMessage
+	(*v11.SendMessageResponse)(nil),                 // 16: internal_message.service.v1.SendMessageResponse
+	(*v11.PreviewAudienceResponse)(nil),             // 17: internal_message.service.v1.PreviewAudienceResponse
+	(*v11.ListInternalMessageDeliveryResponse)(nil), // 18: internal_message.service.v1.ListInternalMessageDeliveryResponse
+	(*v11.ListNotificationChannelResponse)(nil),     // 19: internal_message.service.v1.ListNotificationChannelResponse
+	(*v11.MessageReadStats)(nil),                    // 20: internal_message.service.v1.MessageReadStats
+	(*v11.ListMessageNonReadersResponse)(nil),       // 21: internal_message.service.v1.ListMessageNonReadersResponse
+	(*v11.ExportMessageNonReadersResponse)(nil),     // 22: internal_message.service.v1.ExportMessageNonReadersResponse
+	(*v11.RemindMessageNonReadersResponse)(nil),     // 23: internal_message.service.v1.RemindMessageNonReadersResponse
 }
 var file_admin_service_v1_i_internal_message_proto_depIdxs = []int32{
-	0, // 0: admin.service.v1.InternalMessageService.ListMessage:input_type -> pagination.PagingRequest
-	1, // 1: admin.service.v1.InternalMessageService.GetMessage:input_type -> internal_message.service.v1.GetInternalMessageRequest
-	2, // 2: admin.service.v1.InternalMessageService.UpdateMessage:input_type -> internal_message.service.v1.UpdateInternalMessageRequest
-	3, // 3: admin.service.v1.InternalMessageService.DeleteMessage:input_type -> internal_message.service.v1.DeleteInternalMessageRequest
-	4, // 4: admin.service.v1.InternalMessageService.SendMessage:input_type -> internal_message.service.v1.SendMessageRequest
-	5, // 5: admin.service.v1.InternalMessageService.RevokeMessage:input_type -> internal_message.service.v1.RevokeMessageRequest
-	6, // 6: admin.service.v1.InternalMessageService.ListMessage:output_type -> internal_message.service.v1.ListInternalMessageResponse
-	7, // 7: admin.service.v1.InternalMessageService.GetMessage:output_type -> internal_message.service.v1.InternalMessage
-	8, // 8: admin.service.v1.InternalMessageService.UpdateMessage:output_type -> google.protobuf.Empty
-	8, // 9: admin.service.v1.InternalMessageService.DeleteMessage:output_type -> google.protobuf.Empty
-	9, // 10: admin.service.v1.InternalMessageService.SendMessage:output_type -> internal_message.service.v1.SendMessageResponse
-	8, // 11: admin.service.v1.InternalMessageService.RevokeMessage:output_type -> google.protobuf.Empty
-	6, // [6:12] is the sub-list for method output_type
-	0, // [0:6] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: admin.service.v1.InternalMessageService.ListMessage:input_type -> pagination.PagingRequest
+	1,  // 1: admin.service.v1.InternalMessageService.GetMessage:input_type -> internal_message.service.v1.GetInternalMessageRequest
+	2,  // 2: admin.service.v1.InternalMessageService.UpdateMessage:input_type -> internal_message.service.v1.UpdateInternalMessageRequest
+	3,  // 3: admin.service.v1.InternalMessageService.DeleteMessage:input_type -> internal_message.service.v1.DeleteInternalMessageRequest
+	4,  // 4: admin.service.v1.InternalMessageService.SendMessage:input_type -> internal_message.service.v1.SendMessageRequest
+	5,  // 5: admin.service.v1.InternalMessageService.RevokeMessage:input_type -> internal_message.service.v1.RevokeMessageRequest
+	6,  // 6: admin.service.v1.InternalMessageService.UpdateScheduledMessage:input_type -> internal_message.service.v1.UpdateScheduledMessageRequest
+	7,  // 7: admin.service.v1.InternalMessageService.CancelScheduledMessage:input_type -> internal_message.service.v1.CancelScheduledMessageRequest
+	8,  // 8: admin.service.v1.InternalMessageService.PreviewAudience:input_type -> internal_message.service.v1.MessageAudience
+	0,  // 9: admin.service.v1.InternalMessageService.ListDelivery:input_type -> pagination.PagingRequest
+	9,  // 10: admin.service.v1.InternalMessageService.ListChannel:input_type -> google.protobuf.Empty
+	10, // 11: admin.service.v1.InternalMessageService.GetMessageReadStats:input_type -> internal_message.service.v1.GetMessageReadStatsRequest
+	11, // 12: admin.service.v1.InternalMessageService.ListMessageNonReaders:input_type -> internal_message.service.v1.ListMessageNonReadersRequest
+	12, // 13: admin.service.v1.InternalMessageService.ExportMessageNonReaders:input_type -> internal_message.service.v1.ExportMessageNonReadersRequest
+	13, // 14: admin.service.v1.InternalMessageService.RemindMessageNonReaders:input_type -> internal_message.service.v1.RemindMessageNonReadersRequest
+	14, // 15: admin.service.v1.InternalMessageService.ListMessage:output_type -> internal_message.service.v1.ListInternalMessageResponse
+	15, // 16: admin.service.v1.InternalMessageService.GetMessage:output_type -> internal_message.service.v1.InternalMessage
+	9,  // 17: admin.service.v1.InternalMessageService.UpdateMessage:output_type -> google.protobuf.Empty
+	9,  // 18: admin.service.v1.InternalMessageService.DeleteMessage:output_type -> google.protobuf.Empty
+	16, // 19: admin.service.v1.InternalMessageService.SendMessage:output_type -> internal_message.service.v1.SendMessageResponse
+	9,  // 20: admin.service.v1.InternalMessageService.RevokeMessage:output_type -> google.protobuf.Empty
+	9,  // 21: admin.service.v1.InternalMessageService.UpdateScheduledMessage:output_type -> google.protobuf.Empty
+	9,  // 22: admin.service.v1.InternalMessageService.CancelScheduledMessage:output_type -> google.protobuf.Empty
+	17, // 23: admin.service.v1.InternalMessageService.PreviewAudience:output_type -> internal_message.service.v1.PreviewAudienceResponse
+	18, // 24: admin.service.v1.InternalMessageService.ListDelivery:output_type -> internal_message.service.v1.ListInternalMessageDeliveryResponse
+	19, // 25: admin.service.v1.InternalMessageService.ListChannel:output_type -> internal_message.service.v1.ListNotificationChannelResponse
+	20, // 26: admin.service.v1.InternalMessageService.GetMessageReadStats:output_type -> internal_message.service.v1.MessageReadStats
+	21, // 27: admin.service.v1.InternalMessageService.ListMessageNonReaders:output_type -> internal_message.service.v1.ListMessageNonReadersResponse
+	22, // 28: admin.service.v1.InternalMessageService.ExportMessageNonReaders:output_type -> internal_message.service.v1.ExportMessageNonReadersResponse
+	23, // 29: admin.service.v1.InternalMessageService.RemindMessageNonReaders:output_type -> internal_message.service.v1.RemindMessageNonReadersResponse
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_internal_message_proto_init() }
@@ -24,6 +24,8 @@ var (
 	_ emptypb.Empty
 	_ pagination.Sorting
 	_ servicev1.InternalMessage
+	_ servicev1.InternalMessageDelivery
+	_ servicev1.InternalMessageRecipient
 )
 
 // RegisterRedactedInternalMessageServiceServer wraps the InternalMessageServiceServer with the redacted server and registers the service in GRPC
@@ -109,3 +111,102 @@ func (s *redactedInternalMessageServiceServer) RevokeMessage(ctx context.Context
 	}
 	return res, err
 }
+
+// UpdateScheduledMessage is the redacted wrapper for the actual InternalMessageServiceServer.UpdateScheduledMessage method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) UpdateScheduledMessage(ctx context.Context, in *servicev1.UpdateScheduledMessageRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.UpdateScheduledMessage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CancelScheduledMessage is the redacted wrapper for the actual InternalMessageServiceServer.CancelScheduledMessage method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) CancelScheduledMessage(ctx context.Context, in *servicev1.CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.CancelScheduledMessage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PreviewAudience is the redacted wrapper for the actual InternalMessageServiceServer.PreviewAudience method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) PreviewAudience(ctx context.Context, in *servicev1.MessageAudience) (*servicev1.PreviewAudienceResponse, error) {
+	res, err := s.srv.PreviewAudience(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListDelivery is the redacted wrapper for the actual InternalMessageServiceServer.ListDelivery method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) ListDelivery(ctx context.Context, in *pagination.PagingRequest) (*servicev1.ListInternalMessageDeliveryResponse, error) {
+	res, err := s.srv.ListDelivery(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListChannel is the redacted wrapper for the actual InternalMessageServiceServer.ListChannel method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) ListChannel(ctx context.Context, in *emptypb.Empty) (*servicev1.ListNotificationChannelResponse, error) {
+	res, err := s.srv.ListChannel(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetMessageReadStats is the redacted wrapper for the actual InternalMessageServiceServer.GetMessageReadStats method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) GetMessageReadStats(ctx context.Context, in *servicev1.GetMessageReadStatsRequest) (*servicev1.MessageReadStats, error) {
+	res, err := s.srv.GetMessageReadStats(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListMessageNonReaders is the redacted wrapper for the actual InternalMessageServiceServer.ListMessageNonReaders method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) ListMessageNonReaders(ctx context.Context, in *servicev1.ListMessageNonReadersRequest) (*servicev1.ListMessageNonReadersResponse, error) {
+	res, err := s.srv.ListMessageNonReaders(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ExportMessageNonReaders is the redacted wrapper for the actual InternalMessageServiceServer.ExportMessageNonReaders method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) ExportMessageNonReaders(ctx context.Context, in *servicev1.ExportMessageNonReadersRequest) (*servicev1.ExportMessageNonReadersResponse, error) {
+	res, err := s.srv.ExportMessageNonReaders(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RemindMessageNonReaders is the redacted wrapper for the actual InternalMessageServiceServer.RemindMessageNonReaders method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) RemindMessageNonReaders(ctx context.Context, in *servicev1.RemindMessageNonReadersRequest) (*servicev1.RemindMessageNonReadersResponse, error) {
+	res, err := s.srv.RemindMessageNonReaders(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -22,12 +22,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InternalMessageService_ListMessage_FullMethodName   = "/admin.service.v1.InternalMessageService/ListMessage"
-	InternalMessageService_GetMessage_FullMethodName    = "/admin.service.v1.InternalMessageService/GetMessage"
-	InternalMessageService_UpdateMessage_FullMethodName = "/admin.service.v1.InternalMessageService/UpdateMessage"
-	InternalMessageService_DeleteMessage_FullMethodName = "/admin.service.v1.InternalMessageService/DeleteMessage"
-	InternalMessageService_SendMessage_FullMethodName   = "/admin.service.v1.InternalMessageService/SendMessage"
-	InternalMessageService_RevokeMessage_FullMethodName = "/admin.service.v1.InternalMessageService/RevokeMessage"
+	InternalMessageService_ListMessage_FullMethodName             = "/admin.service.v1.InternalMessageService/ListMessage"
+	InternalMessageService_GetMessage_FullMethodName              = "/admin.service.v1.InternalMessageService/GetMessage"
+	InternalMessageService_UpdateMessage_FullMethodName           = "/admin.service.v1.InternalMessageService/UpdateMessage"
+	InternalMessageService_DeleteMessage_FullMethodName           = "/admin.service.v1.InternalMessageService/DeleteMessage"
+	InternalMessageService_SendMessage_FullMethodName             = "/admin.service.v1.InternalMessageService/SendMessage"
+	InternalMessageService_RevokeMessage_FullMethodName           = "/admin.service.v1.InternalMessageService/RevokeMessage"
+	InternalMessageService_UpdateScheduledMessage_FullMethodName  = "/admin.service.v1.InternalMessageService/UpdateScheduledMessage"
+	InternalMessageService_CancelScheduledMessage_FullMethodName  = "/admin.service.v1.InternalMessageService/CancelScheduledMessage"
+	InternalMessageService_PreviewAudience_FullMethodName         = "/admin.service.v1.InternalMessageService/PreviewAudience"
+	InternalMessageService_ListDelivery_FullMethodName            = "/admin.service.v1.InternalMessageService/ListDelivery"
+	InternalMessageService_ListChannel_FullMethodName             = "/admin.service.v1.InternalMessageService/ListChannel"
+	InternalMessageService_GetMessageReadStats_FullMethodName     = "/admin.service.v1.InternalMessageService/GetMessageReadStats"
+	InternalMessageService_ListMessageNonReaders_FullMethodName   = "/admin.service.v1.InternalMessageService/ListMessageNonReaders"
+	InternalMessageService_ExportMessageNonReaders_FullMethodName = "/admin.service.v1.InternalMessageService/ExportMessageNonReaders"
+	InternalMessageService_RemindMessageNonReaders_FullMethodName = "/admin.service.v1.InternalMessageService/RemindMessageNonReaders"
 )
 
 // InternalMessageServiceClient is the client API for InternalMessageService service.
@@ -48,6 +57,24 @@ type InternalMessageServiceClient interface {
 	SendMessage(ctx context.Context, in *v11.SendMessageRequest, opts ...grpc.CallOption) (*v11.SendMessageResponse, error)
 	// 撤销某条消息
 	RevokeMessage(ctx context.Context, in *v11.RevokeMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 修改定时消息
+	UpdateScheduledMessage(ctx context.Context, in *v11.UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 取消定时消息
+	CancelScheduledMessage(ctx context.Context, in *v11.CancelScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 预览受众
+	PreviewAudience(ctx context.Context, in *v11.MessageAudience, opts ...grpc.CallOption) (*v11.PreviewAudienceResponse, error)
+	// 查询外部渠道投递记录
+	ListDelivery(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListInternalMessageDeliveryResponse, error)
+	// 查询已启用的通知渠道
+	ListChannel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListNotificationChannelResponse, error)
+	// 查询消息的阅读统计
+	GetMessageReadStats(ctx context.Context, in *v11.GetMessageReadStatsRequest, opts ...grpc.CallOption) (*v11.MessageReadStats, error)
+	// 查询消息的未读者
+	ListMessageNonReaders(ctx context.Context, in *v11.ListMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.ListMessageNonReadersResponse, error)
+	// 导出消息的未读者
+	ExportMessageNonReaders(ctx context.Context, in *v11.ExportMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.ExportMessageNonReadersResponse, error)
+	// 再次通知消息的未读者
+	RemindMessageNonReaders(ctx context.Context, in *v11.RemindMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.RemindMessageNonReadersResponse, error)
 }
 
 type internalMessageServiceClient struct {
@@ -118,6 +145,96 @@ func (c *internalMessageServiceClient) RevokeMessage(ctx context.Context, in *v1
 	return out, nil
 }
 
+func (c *internalMessageServiceClient) UpdateScheduledMessage(ctx context.Context, in *v11.UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InternalMessageService_UpdateScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) CancelScheduledMessage(ctx context.Context, in *v11.CancelScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InternalMessageService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) PreviewAudience(ctx context.Context, in *v11.MessageAudience, opts ...grpc.CallOption) (*v11.PreviewAudienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.PreviewAudienceResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_PreviewAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) ListDelivery(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*v11.ListInternalMessageDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListInternalMessageDeliveryResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_ListDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) ListChannel(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.ListNotificationChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListNotificationChannelResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_ListChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) GetMessageReadStats(ctx context.Context, in *v11.GetMessageReadStatsRequest, opts ...grpc.CallOption) (*v11.MessageReadStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.MessageReadStats)
+	err := c.cc.Invoke(ctx, InternalMessageService_GetMessageReadStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) ListMessageNonReaders(ctx context.Context, in *v11.ListMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.ListMessageNonReadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ListMessageNonReadersResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_ListMessageNonReaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) ExportMessageNonReaders(ctx context.Context, in *v11.ExportMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.ExportMessageNonReadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.ExportMessageNonReadersResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_ExportMessageNonReaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) RemindMessageNonReaders(ctx context.Context, in *v11.RemindMessageNonReadersRequest, opts ...grpc.CallOption) (*v11.RemindMessageNonReadersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.RemindMessageNonReadersResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_RemindMessageNonReaders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalMessageServiceServer is the server API for InternalMessageService service.
 // All implementations must embed UnimplementedInternalMessageServiceServer
 // for forward compatibility.
@@ -136,6 +253,24 @@ type InternalMessageServiceServer interface {
 	SendMessage(context.Context, *v11.SendMessageRequest) (*v11.SendMessageResponse, error)
 	// 撤销某条消息
 	RevokeMessage(context.Context, *v11.RevokeMessageRequest) (*emptypb.Empty, error)
+	// 修改定时消息
+	UpdateScheduledMessage(context.Context, *v11.UpdateScheduledMessageRequest) (*emptypb.Empty, error)
+	// 取消定时消息
+	CancelScheduledMessage(context.Context, *v11.CancelScheduledMessageRequest) (*emptypb.Empty, error)
+	// 预览受众
+	PreviewAudience(context.Context, *v11.MessageAudience) (*v11.PreviewAudienceResponse, error)
+	// 查询外部渠道投递记录
+	ListDelivery(context.Context, *v1.PagingRequest) (*v11.ListInternalMessageDeliveryResponse, error)
+	// 查询已启用的通知渠道
+	ListChannel(context.Context, *emptypb.Empty) (*v11.ListNotificationChannelResponse, error)
+	// 查询消息的阅读统计
+	GetMessageReadStats(context.Context, *v11.GetMessageReadStatsRequest) (*v11.MessageReadStats, error)
+	// 查询消息的未读者
+	ListMessageNonReaders(context.Context, *v11.ListMessageNonReadersRequest) (*v11.ListMessageNonReadersResponse, error)
+	// 导出消息的未读者
+	ExportMessageNonReaders(context.Context, *v11.ExportMessageNonReadersRequest) (*v11.ExportMessageNonReadersResponse, error)
+	// 再次通知消息的未读者
+	RemindMessageNonReaders(context.Context, *v11.RemindMessageNonReadersRequest) (*v11.RemindMessageNonReadersResponse, error)
 	mustEmbedUnimplementedInternalMessageServiceServer()
 }
 
@@ -164,6 +299,33 @@ func (UnimplementedInternalMessageServiceServer) SendMessage(context.Context, *v
 func (UnimplementedInternalMessageServiceServer) RevokeMessage(context.Context, *v11.RevokeMessageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeMessage not implemented")
 }
+func (UnimplementedInternalMessageServiceServer) UpdateScheduledMessage(context.Context, *v11.UpdateScheduledMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledMessage not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) CancelScheduledMessage(context.Context, *v11.CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) PreviewAudience(context.Context, *v11.MessageAudience) (*v11.PreviewAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAudience not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) ListDelivery(context.Context, *v1.PagingRequest) (*v11.ListInternalMessageDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelivery not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) ListChannel(context.Context, *emptypb.Empty) (*v11.ListNotificationChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannel not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) GetMessageReadStats(context.Context, *v11.GetMessageReadStatsRequest) (*v11.MessageReadStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMessageReadStats not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) ListMessageNonReaders(context.Context, *v11.ListMessageNonReadersRequest) (*v11.ListMessageNonReadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageNonReaders not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) ExportMessageNonReaders(context.Context, *v11.ExportMessageNonReadersRequest) (*v11.ExportMessageNonReadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMessageNonReaders not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) RemindMessageNonReaders(context.Context, *v11.RemindMessageNonReadersRequest) (*v11.RemindMessageNonReadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemindMessageNonReaders not implemented")
+}
 func (UnimplementedInternalMessageServiceServer) mustEmbedUnimplementedInternalMessageServiceServer() {
 }
 func (UnimplementedInternalMessageServiceServer) testEmbeddedByValue() {}
@@ -294,6 +456,168 @@ func _InternalMessageService_RevokeMessage_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalMessageService_UpdateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.UpdateScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).UpdateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_UpdateScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).UpdateScheduledMessage(ctx, req.(*v11.UpdateScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).CancelScheduledMessage(ctx, req.(*v11.CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_PreviewAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.MessageAudience)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).PreviewAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_PreviewAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).PreviewAudience(ctx, req.(*v11.MessageAudience))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_ListDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).ListDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_ListDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).ListDelivery(ctx, req.(*v1.PagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_ListChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).ListChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_ListChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).ListChannel(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_GetMessageReadStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.GetMessageReadStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).GetMessageReadStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_GetMessageReadStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).GetMessageReadStats(ctx, req.(*v11.GetMessageReadStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_ListMessageNonReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ListMessageNonReadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).ListMessageNonReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_ListMessageNonReaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).ListMessageNonReaders(ctx, req.(*v11.ListMessageNonReadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_ExportMessageNonReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ExportMessageNonReadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).ExportMessageNonReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_ExportMessageNonReaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).ExportMessageNonReaders(ctx, req.(*v11.ExportMessageNonReadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_RemindMessageNonReaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RemindMessageNonReadersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).RemindMessageNonReaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_RemindMessageNonReaders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).RemindMessageNonReaders(ctx, req.(*v11.RemindMessageNonReadersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InternalMessageService_ServiceDesc is the grpc.ServiceDesc for InternalMessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -325,6 +649,42 @@ var InternalMessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeMessage",
 			Handler:    _InternalMessageService_RevokeMessage_Handler,
 		},
+		{
+			MethodName: "UpdateScheduledMessage",
+			Handler:    _InternalMessageService_UpdateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _InternalMessageService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "PreviewAudience",
+			Handler:    _InternalMessageService_PreviewAudience_Handler,
+		},
+		{
+			MethodName: "ListDelivery",
+			Handler:    _InternalMessageService_ListDelivery_Handler,
+		},
+		{
+			MethodName: "ListChannel",
+			Handler:    _InternalMessageService_ListChannel_Handler,
+		},
+		{
+			MethodName: "GetMessageReadStats",
+			Handler:    _InternalMessageService_GetMessageReadStats_Handler,
+		},
+		{
+			MethodName: "ListMessageNonReaders",
+			Handler:    _InternalMessageService_ListMessageNonReaders_Handler,
+		},
+		{
+			MethodName: "ExportMessageNonReaders",
+			Handler:    _InternalMessageService_ExportMessageNonReaders_Handler,
+		},
+		{
+			MethodName: "RemindMessageNonReaders",
+			Handler:    _InternalMessageService_RemindMessageNonReaders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_internal_message.proto",
@@ -22,39 +22,75 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationInternalMessageServiceCancelScheduledMessage = "/admin.service.v1.InternalMessageService/CancelScheduledMessage"
 const OperationInternalMessageServiceDeleteMessage = "/admin.service.v1.InternalMessageService/DeleteMessage"
+const OperationInternalMessageServiceExportMessageNonReaders = "/admin.service.v1.InternalMessageService/ExportMessageNonReaders"
 const OperationInternalMessageServiceGetMessage = "/admin.service.v1.InternalMessageService/GetMessage"
+const OperationInternalMessageServiceGetMessageReadStats = "/admin.service.v1.InternalMessageService/GetMessageReadStats"
+const OperationInternalMessageServiceListChannel = "/admin.service.v1.InternalMessageService/ListChannel"
+const OperationInternalMessageServiceListDelivery = "/admin.service.v1.InternalMessageService/ListDelivery"
 const OperationInternalMessageServiceListMessage = "/admin.service.v1.InternalMessageService/ListMessage"
+const OperationInternalMessageServiceListMessageNonReaders = "/admin.service.v1.InternalMessageService/ListMessageNonReaders"
+const OperationInternalMessageServicePreviewAudience = "/admin.service.v1.InternalMessageService/PreviewAudience"
+const OperationInternalMessageServiceRemindMessageNonReaders = "/admin.service.v1.InternalMessageService/RemindMessageNonReaders"
 const OperationInternalMessageServiceRevokeMessage = "/admin.service.v1.InternalMessageService/RevokeMessage"
 const OperationInternalMessageServiceSendMessage = "/admin.service.v1.InternalMessageService/SendMessage"
 const OperationInternalMessageServiceUpdateMessage = "/admin.service.v1.InternalMessageService/UpdateMessage"
+const OperationInternalMessageServiceUpdateScheduledMessage = "/admin.service.v1.InternalMessageService/UpdateScheduledMessage"
 
 type InternalMessageServiceHTTPServer interface {
+	// CancelScheduledMessage 取消定时消息
+	CancelScheduledMessage(context.Context, *v11.CancelScheduledMessageRequest) (*emptypb.Empty, error)
 	// DeleteMessage 删除站内信消息
 	DeleteMessage(context.Context, *v11.DeleteInternalMessageRequest) (*emptypb.Empty, error)
+	// ExportMessageNonReaders 导出消息的未读者
+	ExportMessageNonReaders(context.Context, *v11.ExportMessageNonReadersRequest) (*v11.ExportMessageNonReadersResponse, error)
 	// GetMessage 查询站内信消息详情
 	GetMessage(context.Context, *v11.GetInternalMessageRequest) (*v11.InternalMessage, error)
+	// GetMessageReadStats 查询消息的阅读统计
+	GetMessageReadStats(context.Context, *v11.GetMessageReadStatsRequest) (*v11.MessageReadStats, error)
+	// ListChannel 查询已启用的通知渠道
+	ListChannel(context.Context, *emptypb.Empty) (*v11.ListNotificationChannelResponse, error)
+	// ListDelivery 查询外部渠道投递记录
+	ListDelivery(context.Context, *v1.PagingRequest) (*v11.ListInternalMessageDeliveryResponse, error)
 	// ListMessage 查询站内信消息列表
 	ListMessage(context.Context, *v1.PagingRequest) (*v11.ListInternalMessageResponse, error)
+	// ListMessageNonReaders 查询消息的未读者
+	ListMessageNonReaders(context.Context, *v11.ListMessageNonReadersRequest) (*v11.ListMessageNonReadersResponse, error)
+	// PreviewAudience 预览受众
+	PreviewAudience(context.Context, *v11.MessageAudience) (*v11.PreviewAudienceResponse, error)
+	// RemindMessageNonReaders 再次通知消息的未读者
+	RemindMessageNonReaders(context.Context, *v11.RemindMessageNonReadersRequest) (*v11.RemindMessageNonReadersResponse, error)
 	// RevokeMessage 撤销某条消息
 	RevokeMessage(context.Context, *v11.RevokeMessageRequest) (*emptypb.Empty, error)
 	// SendMessage 发送消息
 	SendMessage(context.Context, *v11.SendMessageRequest) (*v11.SendMessageResponse, error)
 	// UpdateMessage 更新站内信消息
 	UpdateMessage(context.Context, *v11.UpdateInternalMessageRequest) (*emptypb.Empty, error)
+	// UpdateScheduledMessage 修改定时消息
+	UpdateScheduledMessage(context.Context, *v11.UpdateScheduledMessageRequest) (*emptypb.Empty, error)
 }
 
 func RegisterInternalMessageServiceHTTPServer(s *http.Server, srv InternalMessageServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/internal-message/messages", _InternalMessageService_ListMessage0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/messages", _InternalMessageService_ListMessage1_HTTP_Handler(srv))
 	r.GET("/admin/v1/internal-message/messages/{id}", _InternalMessageService_GetMessage0_HTTP_Handler(srv))
 	r.PUT("/admin/v1/internal-message/messages/{id}", _InternalMessageService_UpdateMessage0_HTTP_Handler(srv))
 	r.DELETE("/admin/v1/internal-message/messages/{id}", _InternalMessageService_DeleteMessage0_HTTP_Handler(srv))
-	r.POST("/admin/v1/internal-message/send", _InternalMessageService_SendMessage0_HTTP_Handler(srv))
+	r.POST("/admin/v1/internal-message/send", _InternalMessageService_SendMessage1_HTTP_Handler(srv))
 	r.POST("/admin/v1/internal-message/revoke", _InternalMessageService_RevokeMessage0_HTTP_Handler(srv))
+	r.PUT("/admin/v1/internal-message/scheduled/{message_id}", _InternalMessageService_UpdateScheduledMessage0_HTTP_Handler(srv))
+	r.POST("/admin/v1/internal-message/scheduled/{message_id}:cancel", _InternalMessageService_CancelScheduledMessage0_HTTP_Handler(srv))
+	r.POST("/admin/v1/internal-message/audience:preview", _InternalMessageService_PreviewAudience0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/deliveries", _InternalMessageService_ListDelivery0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/channels", _InternalMessageService_ListChannel0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/messages/{message_id}/read-stats", _InternalMessageService_GetMessageReadStats0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/messages/{message_id}/non-readers", _InternalMessageService_ListMessageNonReaders0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/messages/{message_id}/non-readers:export", _InternalMessageService_ExportMessageNonReaders0_HTTP_Handler(srv))
+	r.POST("/admin/v1/internal-message/messages/{message_id}/non-readers:remind", _InternalMessageService_RemindMessageNonReaders0_HTTP_Handler(srv))
 }
 
-func _InternalMessageService_ListMessage0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+func _InternalMessageService_ListMessage1_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -142,7 +178,7 @@ func _InternalMessageService_DeleteMessage0_HTTP_Handler(srv InternalMessageServ
 	}
 }
 
-func _InternalMessageService_SendMessage0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+func _InternalMessageService_SendMessage1_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.SendMessageRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -186,19 +222,238 @@ func _InternalMessageService_RevokeMessage0_HTTP_Handler(srv InternalMessageServ
 	}
 }
 
+func _InternalMessageService_UpdateScheduledMessage0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.UpdateScheduledMessageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceUpdateScheduledMessage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateScheduledMessage(ctx, req.(*v11.UpdateScheduledMessageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_CancelScheduledMessage0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.CancelScheduledMessageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceCancelScheduledMessage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CancelScheduledMessage(ctx, req.(*v11.CancelScheduledMessageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_PreviewAudience0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.MessageAudience
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServicePreviewAudience)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PreviewAudience(ctx, req.(*v11.MessageAudience))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.PreviewAudienceResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_ListDelivery0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PagingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceListDelivery)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDelivery(ctx, req.(*v1.PagingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListInternalMessageDeliveryResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_ListChannel0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceListChannel)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListChannel(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListNotificationChannelResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_GetMessageReadStats0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.GetMessageReadStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceGetMessageReadStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMessageReadStats(ctx, req.(*v11.GetMessageReadStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.MessageReadStats)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_ListMessageNonReaders0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ListMessageNonReadersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceListMessageNonReaders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMessageNonReaders(ctx, req.(*v11.ListMessageNonReadersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ListMessageNonReadersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_ExportMessageNonReaders0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.ExportMessageNonReadersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceExportMessageNonReaders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportMessageNonReaders(ctx, req.(*v11.ExportMessageNonReadersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.ExportMessageNonReadersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageService_RemindMessageNonReaders0_HTTP_Handler(srv InternalMessageServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.RemindMessageNonReadersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageServiceRemindMessageNonReaders)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemindMessageNonReaders(ctx, req.(*v11.RemindMessageNonReadersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.RemindMessageNonReadersResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type InternalMessageServiceHTTPClient interface {
+	// CancelScheduledMessage 取消定时消息
+	CancelScheduledMessage(ctx context.Context, req *v11.CancelScheduledMessageRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// DeleteMessage 删除站内信消息
 	DeleteMessage(ctx context.Context, req *v11.DeleteInternalMessageRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ExportMessageNonReaders 导出消息的未读者
+	ExportMessageNonReaders(ctx context.Context, req *v11.ExportMessageNonReadersRequest, opts ...http.CallOption) (rsp *v11.ExportMessageNonReadersResponse, err error)
 	// GetMessage 查询站内信消息详情
 	GetMessage(ctx context.Context, req *v11.GetInternalMessageRequest, opts ...http.CallOption) (rsp *v11.InternalMessage, err error)
+	// GetMessageReadStats 查询消息的阅读统计
+	GetMessageReadStats(ctx context.Context, req *v11.GetMessageReadStatsRequest, opts ...http.CallOption) (rsp *v11.MessageReadStats, err error)
+	// ListChannel 查询已启用的通知渠道
+	ListChannel(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.ListNotificationChannelResponse, err error)
+	// ListDelivery 查询外部渠道投递记录
+	ListDelivery(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListInternalMessageDeliveryResponse, err error)
 	// ListMessage 查询站内信消息列表
 	ListMessage(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListInternalMessageResponse, err error)
+	// ListMessageNonReaders 查询消息的未读者
+	ListMessageNonReaders(ctx context.Context, req *v11.ListMessageNonReadersRequest, opts ...http.CallOption) (rsp *v11.ListMessageNonReadersResponse, err error)
+	// PreviewAudience 预览受众
+	PreviewAudience(ctx context.Context, req *v11.MessageAudience, opts ...http.CallOption) (rsp *v11.PreviewAudienceResponse, err error)
+	// RemindMessageNonReaders 再次通知消息的未读者
+	RemindMessageNonReaders(ctx context.Context, req *v11.RemindMessageNonReadersRequest, opts ...http.CallOption) (rsp *v11.RemindMessageNonReadersResponse, err error)
 	// RevokeMessage 撤销某条消息
 	RevokeMessage(ctx context.Context, req *v11.RevokeMessageRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// SendMessage 发送消息
 	SendMessage(ctx context.Context, req *v11.SendMessageRequest, opts ...http.CallOption) (rsp *v11.SendMessageResponse, err error)
 	// UpdateMessage 更新站内信消息
 	UpdateMessage(ctx context.Context, req *v11.UpdateInternalMessageRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// UpdateScheduledMessage 修改定时消息
+	UpdateScheduledMessage(ctx context.Context, req *v11.UpdateScheduledMessageRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
 
 type InternalMessageServiceHTTPClientImpl struct {
@@ -209,6 +464,20 @@ func NewInternalMessageServiceHTTPClient(client *http.Client) InternalMessageSer
 	return &InternalMessageServiceHTTPClientImpl{client}
 }
 
+// CancelScheduledMessage 取消定时消息
+func (c *InternalMessageServiceHTTPClientImpl) CancelScheduledMessage(ctx context.Context, in *v11.CancelScheduledMessageRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/internal-message/scheduled/{message_id}:cancel"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceCancelScheduledMessage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DeleteMessage 删除站内信消息
 func (c *InternalMessageServiceHTTPClientImpl) DeleteMessage(ctx context.Context, in *v11.DeleteInternalMessageRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -223,6 +492,20 @@ func (c *InternalMessageServiceHTTPClientImpl) DeleteMessage(ctx context.Context
 	return &out, nil
 }
 
+// ExportMessageNonReaders 导出消息的未读者
+func (c *InternalMessageServiceHTTPClientImpl) ExportMessageNonReaders(ctx context.Context, in *v11.ExportMessageNonReadersRequest, opts ...http.CallOption) (*v11.ExportMessageNonReadersResponse, error) {
+	var out v11.ExportMessageNonReadersResponse
+	pattern := "/admin/v1/internal-message/messages/{message_id}/non-readers:export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceExportMessageNonReaders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetMessage 查询站内信消息详情
 func (c *InternalMessageServiceHTTPClientImpl) GetMessage(ctx context.Context, in *v11.GetInternalMessageRequest, opts ...http.CallOption) (*v11.InternalMessage, error) {
 	var out v11.InternalMessage
@@ -237,6 +520,48 @@ func (c *InternalMessageServiceHTTPClientImpl) GetMessage(ctx context.Context, i
 	return &out, nil
 }
 
+// GetMessageReadStats 查询消息的阅读统计
+func (c *InternalMessageServiceHTTPClientImpl) GetMessageReadStats(ctx context.Context, in *v11.GetMessageReadStatsRequest, opts ...http.CallOption) (*v11.MessageReadStats, error) {
+	var out v11.MessageReadStats
+	pattern := "/admin/v1/internal-message/messages/{message_id}/read-stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceGetMessageReadStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListChannel 查询已启用的通知渠道
+func (c *InternalMessageServiceHTTPClientImpl) ListChannel(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.ListNotificationChannelResponse, error) {
+	var out v11.ListNotificationChannelResponse
+	pattern := "/admin/v1/internal-message/channels"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceListChannel))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListDelivery 查询外部渠道投递记录
+func (c *InternalMessageServiceHTTPClientImpl) ListDelivery(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListInternalMessageDeliveryResponse, error) {
+	var out v11.ListInternalMessageDeliveryResponse
+	pattern := "/admin/v1/internal-message/deliveries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceListDelivery))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListMessage 查询站内信消息列表
 func (c *InternalMessageServiceHTTPClientImpl) ListMessage(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListInternalMessageResponse, error) {
 	var out v11.ListInternalMessageResponse
@@ -251,6 +576,48 @@ func (c *InternalMessageServiceHTTPClientImpl) ListMessage(ctx context.Context,
 	return &out, nil
 }
 
+// ListMessageNonReaders 查询消息的未读者
+func (c *InternalMessageServiceHTTPClientImpl) ListMessageNonReaders(ctx context.Context, in *v11.ListMessageNonReadersRequest, opts ...http.CallOption) (*v11.ListMessageNonReadersResponse, error) {
+	var out v11.ListMessageNonReadersResponse
+	pattern := "/admin/v1/internal-message/messages/{message_id}/non-readers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceListMessageNonReaders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PreviewAudience 预览受众
+func (c *InternalMessageServiceHTTPClientImpl) PreviewAudience(ctx context.Context, in *v11.MessageAudience, opts ...http.CallOption) (*v11.PreviewAudienceResponse, error) {
+	var out v11.PreviewAudienceResponse
+	pattern := "/admin/v1/internal-message/audience:preview"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInternalMessageServicePreviewAudience))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemindMessageNonReaders 再次通知消息的未读者
+func (c *InternalMessageServiceHTTPClientImpl) RemindMessageNonReaders(ctx context.Context, in *v11.RemindMessageNonReadersRequest, opts ...http.CallOption) (*v11.RemindMessageNonReadersResponse, error) {
+	var out v11.RemindMessageNonReadersResponse
+	pattern := "/admin/v1/internal-message/messages/{message_id}/non-readers:remind"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceRemindMessageNonReaders))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // RevokeMessage 撤销某条消息
 func (c *InternalMessageServiceHTTPClientImpl) RevokeMessage(ctx context.Context, in *v11.RevokeMessageRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -292,3 +659,17 @@ func (c *InternalMessageServiceHTTPClientImpl) UpdateMessage(ctx context.Context
 	}
 	return &out, nil
 }
+
+// UpdateScheduledMessage 修改定时消息
+func (c *InternalMessageServiceHTTPClientImpl) UpdateScheduledMessage(ctx context.Context, in *v11.UpdateScheduledMessageRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/internal-message/scheduled/{message_id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInternalMessageServiceUpdateScheduledMessage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
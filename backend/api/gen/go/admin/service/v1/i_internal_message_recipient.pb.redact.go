@@ -76,3 +76,25 @@ func (s *redactedInternalMessageRecipientServiceServer) MarkNotificationAsRead(c
 	}
 	return res, err
 }
+
+// GetInboxSummary is the redacted wrapper for the actual InternalMessageRecipientServiceServer.GetInboxSummary method
+// Unary RPC
+func (s *redactedInternalMessageRecipientServiceServer) GetInboxSummary(ctx context.Context, in *servicev1.GetInboxSummaryRequest) (*servicev1.InboxSummary, error) {
+	res, err := s.srv.GetInboxSummary(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// MarkAllNotificationsAsRead is the redacted wrapper for the actual InternalMessageRecipientServiceServer.MarkAllNotificationsAsRead method
+// Unary RPC
+func (s *redactedInternalMessageRecipientServiceServer) MarkAllNotificationsAsRead(ctx context.Context, in *servicev1.MarkAllNotificationsAsReadRequest) (*servicev1.InboxSummary, error) {
+	res, err := s.srv.MarkAllNotificationsAsRead(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -25,6 +25,8 @@ const (
 	InternalMessageRecipientService_ListUserInbox_FullMethodName               = "/admin.service.v1.InternalMessageRecipientService/ListUserInbox"
 	InternalMessageRecipientService_DeleteNotificationFromInbox_FullMethodName = "/admin.service.v1.InternalMessageRecipientService/DeleteNotificationFromInbox"
 	InternalMessageRecipientService_MarkNotificationAsRead_FullMethodName      = "/admin.service.v1.InternalMessageRecipientService/MarkNotificationAsRead"
+	InternalMessageRecipientService_GetInboxSummary_FullMethodName             = "/admin.service.v1.InternalMessageRecipientService/GetInboxSummary"
+	InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName  = "/admin.service.v1.InternalMessageRecipientService/MarkAllNotificationsAsRead"
 )
 
 // InternalMessageRecipientServiceClient is the client API for InternalMessageRecipientService service.
@@ -39,6 +41,10 @@ type InternalMessageRecipientServiceClient interface {
 	DeleteNotificationFromInbox(ctx context.Context, in *v11.DeleteNotificationFromInboxRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 将通知标记为已读
 	MarkNotificationAsRead(ctx context.Context, in *v11.MarkNotificationAsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取用户收件箱的未读数量汇总
+	GetInboxSummary(ctx context.Context, in *v11.GetInboxSummaryRequest, opts ...grpc.CallOption) (*v11.InboxSummary, error)
+	// 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(ctx context.Context, in *v11.MarkAllNotificationsAsReadRequest, opts ...grpc.CallOption) (*v11.InboxSummary, error)
 }
 
 type internalMessageRecipientServiceClient struct {
@@ -79,6 +85,26 @@ func (c *internalMessageRecipientServiceClient) MarkNotificationAsRead(ctx conte
 	return out, nil
 }
 
+func (c *internalMessageRecipientServiceClient) GetInboxSummary(ctx context.Context, in *v11.GetInboxSummaryRequest, opts ...grpc.CallOption) (*v11.InboxSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.InboxSummary)
+	err := c.cc.Invoke(ctx, InternalMessageRecipientService_GetInboxSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageRecipientServiceClient) MarkAllNotificationsAsRead(ctx context.Context, in *v11.MarkAllNotificationsAsReadRequest, opts ...grpc.CallOption) (*v11.InboxSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.InboxSummary)
+	err := c.cc.Invoke(ctx, InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalMessageRecipientServiceServer is the server API for InternalMessageRecipientService service.
 // All implementations must embed UnimplementedInternalMessageRecipientServiceServer
 // for forward compatibility.
@@ -91,6 +117,10 @@ type InternalMessageRecipientServiceServer interface {
 	DeleteNotificationFromInbox(context.Context, *v11.DeleteNotificationFromInboxRequest) (*emptypb.Empty, error)
 	// 将通知标记为已读
 	MarkNotificationAsRead(context.Context, *v11.MarkNotificationAsReadRequest) (*emptypb.Empty, error)
+	// 获取用户收件箱的未读数量汇总
+	GetInboxSummary(context.Context, *v11.GetInboxSummaryRequest) (*v11.InboxSummary, error)
+	// 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(context.Context, *v11.MarkAllNotificationsAsReadRequest) (*v11.InboxSummary, error)
 	mustEmbedUnimplementedInternalMessageRecipientServiceServer()
 }
 
@@ -110,6 +140,12 @@ func (UnimplementedInternalMessageRecipientServiceServer) DeleteNotificationFrom
 func (UnimplementedInternalMessageRecipientServiceServer) MarkNotificationAsRead(context.Context, *v11.MarkNotificationAsReadRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationAsRead not implemented")
 }
+func (UnimplementedInternalMessageRecipientServiceServer) GetInboxSummary(context.Context, *v11.GetInboxSummaryRequest) (*v11.InboxSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInboxSummary not implemented")
+}
+func (UnimplementedInternalMessageRecipientServiceServer) MarkAllNotificationsAsRead(context.Context, *v11.MarkAllNotificationsAsReadRequest) (*v11.InboxSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsAsRead not implemented")
+}
 func (UnimplementedInternalMessageRecipientServiceServer) mustEmbedUnimplementedInternalMessageRecipientServiceServer() {
 }
 func (UnimplementedInternalMessageRecipientServiceServer) testEmbeddedByValue() {}
@@ -186,6 +222,42 @@ func _InternalMessageRecipientService_MarkNotificationAsRead_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalMessageRecipientService_GetInboxSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.GetInboxSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageRecipientServiceServer).GetInboxSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageRecipientService_GetInboxSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageRecipientServiceServer).GetInboxSummary(ctx, req.(*v11.GetInboxSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageRecipientService_MarkAllNotificationsAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.MarkAllNotificationsAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageRecipientServiceServer).MarkAllNotificationsAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageRecipientServiceServer).MarkAllNotificationsAsRead(ctx, req.(*v11.MarkAllNotificationsAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InternalMessageRecipientService_ServiceDesc is the grpc.ServiceDesc for InternalMessageRecipientService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -205,6 +277,14 @@ var InternalMessageRecipientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkNotificationAsRead",
 			Handler:    _InternalMessageRecipientService_MarkNotificationAsRead_Handler,
 		},
+		{
+			MethodName: "GetInboxSummary",
+			Handler:    _InternalMessageRecipientService_GetInboxSummary_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsAsRead",
+			Handler:    _InternalMessageRecipientService_MarkAllNotificationsAsRead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_internal_message_recipient.proto",
@@ -23,14 +23,20 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationInternalMessageRecipientServiceDeleteNotificationFromInbox = "/admin.service.v1.InternalMessageRecipientService/DeleteNotificationFromInbox"
+const OperationInternalMessageRecipientServiceGetInboxSummary = "/admin.service.v1.InternalMessageRecipientService/GetInboxSummary"
 const OperationInternalMessageRecipientServiceListUserInbox = "/admin.service.v1.InternalMessageRecipientService/ListUserInbox"
+const OperationInternalMessageRecipientServiceMarkAllNotificationsAsRead = "/admin.service.v1.InternalMessageRecipientService/MarkAllNotificationsAsRead"
 const OperationInternalMessageRecipientServiceMarkNotificationAsRead = "/admin.service.v1.InternalMessageRecipientService/MarkNotificationAsRead"
 
 type InternalMessageRecipientServiceHTTPServer interface {
 	// DeleteNotificationFromInbox 删除用户收件箱中的通知记录
 	DeleteNotificationFromInbox(context.Context, *v11.DeleteNotificationFromInboxRequest) (*emptypb.Empty, error)
+	// GetInboxSummary 获取用户收件箱的未读数量汇总
+	GetInboxSummary(context.Context, *v11.GetInboxSummaryRequest) (*v11.InboxSummary, error)
 	// ListUserInbox 获取用户的收件箱列表 (通知类)
 	ListUserInbox(context.Context, *v1.PagingRequest) (*v11.ListUserInboxResponse, error)
+	// MarkAllNotificationsAsRead 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(context.Context, *v11.MarkAllNotificationsAsReadRequest) (*v11.InboxSummary, error)
 	// MarkNotificationAsRead 将通知标记为已读
 	MarkNotificationAsRead(context.Context, *v11.MarkNotificationAsReadRequest) (*emptypb.Empty, error)
 }
@@ -40,6 +46,8 @@ func RegisterInternalMessageRecipientServiceHTTPServer(s *http.Server, srv Inter
 	r.GET("/admin/v1/internal-message/inbox", _InternalMessageRecipientService_ListUserInbox0_HTTP_Handler(srv))
 	r.POST("/admin/v1/internal-message/inbox/delete", _InternalMessageRecipientService_DeleteNotificationFromInbox0_HTTP_Handler(srv))
 	r.POST("/admin/v1/internal-message/read", _InternalMessageRecipientService_MarkNotificationAsRead0_HTTP_Handler(srv))
+	r.GET("/admin/v1/internal-message/inbox/summary", _InternalMessageRecipientService_GetInboxSummary0_HTTP_Handler(srv))
+	r.POST("/admin/v1/internal-message/read-all", _InternalMessageRecipientService_MarkAllNotificationsAsRead0_HTTP_Handler(srv))
 }
 
 func _InternalMessageRecipientService_ListUserInbox0_HTTP_Handler(srv InternalMessageRecipientServiceHTTPServer) func(ctx http.Context) error {
@@ -105,11 +113,56 @@ func _InternalMessageRecipientService_MarkNotificationAsRead0_HTTP_Handler(srv I
 	}
 }
 
+func _InternalMessageRecipientService_GetInboxSummary0_HTTP_Handler(srv InternalMessageRecipientServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.GetInboxSummaryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageRecipientServiceGetInboxSummary)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetInboxSummary(ctx, req.(*v11.GetInboxSummaryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.InboxSummary)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _InternalMessageRecipientService_MarkAllNotificationsAsRead0_HTTP_Handler(srv InternalMessageRecipientServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.MarkAllNotificationsAsReadRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationInternalMessageRecipientServiceMarkAllNotificationsAsRead)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MarkAllNotificationsAsRead(ctx, req.(*v11.MarkAllNotificationsAsReadRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.InboxSummary)
+		return ctx.Result(200, reply)
+	}
+}
+
 type InternalMessageRecipientServiceHTTPClient interface {
 	// DeleteNotificationFromInbox 删除用户收件箱中的通知记录
 	DeleteNotificationFromInbox(ctx context.Context, req *v11.DeleteNotificationFromInboxRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetInboxSummary 获取用户收件箱的未读数量汇总
+	GetInboxSummary(ctx context.Context, req *v11.GetInboxSummaryRequest, opts ...http.CallOption) (rsp *v11.InboxSummary, err error)
 	// ListUserInbox 获取用户的收件箱列表 (通知类)
 	ListUserInbox(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListUserInboxResponse, err error)
+	// MarkAllNotificationsAsRead 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(ctx context.Context, req *v11.MarkAllNotificationsAsReadRequest, opts ...http.CallOption) (rsp *v11.InboxSummary, err error)
 	// MarkNotificationAsRead 将通知标记为已读
 	MarkNotificationAsRead(ctx context.Context, req *v11.MarkNotificationAsReadRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 }
@@ -136,6 +189,20 @@ func (c *InternalMessageRecipientServiceHTTPClientImpl) DeleteNotificationFromIn
 	return &out, nil
 }
 
+// GetInboxSummary 获取用户收件箱的未读数量汇总
+func (c *InternalMessageRecipientServiceHTTPClientImpl) GetInboxSummary(ctx context.Context, in *v11.GetInboxSummaryRequest, opts ...http.CallOption) (*v11.InboxSummary, error) {
+	var out v11.InboxSummary
+	pattern := "/admin/v1/internal-message/inbox/summary"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationInternalMessageRecipientServiceGetInboxSummary))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListUserInbox 获取用户的收件箱列表 (通知类)
 func (c *InternalMessageRecipientServiceHTTPClientImpl) ListUserInbox(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListUserInboxResponse, error) {
 	var out v11.ListUserInboxResponse
@@ -150,6 +217,20 @@ func (c *InternalMessageRecipientServiceHTTPClientImpl) ListUserInbox(ctx contex
 	return &out, nil
 }
 
+// MarkAllNotificationsAsRead 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+func (c *InternalMessageRecipientServiceHTTPClientImpl) MarkAllNotificationsAsRead(ctx context.Context, in *v11.MarkAllNotificationsAsReadRequest, opts ...http.CallOption) (*v11.InboxSummary, error) {
+	var out v11.InboxSummary
+	pattern := "/admin/v1/internal-message/read-all"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationInternalMessageRecipientServiceMarkAllNotificationsAsRead))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // MarkNotificationAsRead 将通知标记为已读
 func (c *InternalMessageRecipientServiceHTTPClientImpl) MarkNotificationAsRead(ctx context.Context, in *v11.MarkNotificationAsReadRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
@@ -78,6 +78,106 @@ func (Task_Type) EnumDescriptor() ([]byte, []int) {
 	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{1, 0}
 }
 
+// 执行状态
+type TaskRun_Status int32
+
+const (
+	TaskRun_PENDING   TaskRun_Status = 0 // 已入队，等待执行
+	TaskRun_RUNNING   TaskRun_Status = 1 // 执行中
+	TaskRun_SUCCEEDED TaskRun_Status = 2 // 执行成功
+	TaskRun_FAILED    TaskRun_Status = 3 // 执行失败
+)
+
+// Enum value maps for TaskRun_Status.
+var (
+	TaskRun_Status_name = map[int32]string{
+		0: "PENDING",
+		1: "RUNNING",
+		2: "SUCCEEDED",
+		3: "FAILED",
+	}
+	TaskRun_Status_value = map[string]int32{
+		"PENDING":   0,
+		"RUNNING":   1,
+		"SUCCEEDED": 2,
+		"FAILED":    3,
+	}
+)
+
+func (x TaskRun_Status) Enum() *TaskRun_Status {
+	p := new(TaskRun_Status)
+	*p = x
+	return p
+}
+
+func (x TaskRun_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRun_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_service_v1_i_task_proto_enumTypes[1].Descriptor()
+}
+
+func (TaskRun_Status) Type() protoreflect.EnumType {
+	return &file_admin_service_v1_i_task_proto_enumTypes[1]
+}
+
+func (x TaskRun_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRun_Status.Descriptor instead.
+func (TaskRun_Status) EnumDescriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{2, 0}
+}
+
+// 触发方式
+type TaskRun_Trigger int32
+
+const (
+	TaskRun_SCHEDULE TaskRun_Trigger = 0 // 调度触发
+	TaskRun_MANUAL   TaskRun_Trigger = 1 // 手动触发
+)
+
+// Enum value maps for TaskRun_Trigger.
+var (
+	TaskRun_Trigger_name = map[int32]string{
+		0: "SCHEDULE",
+		1: "MANUAL",
+	}
+	TaskRun_Trigger_value = map[string]int32{
+		"SCHEDULE": 0,
+		"MANUAL":   1,
+	}
+)
+
+func (x TaskRun_Trigger) Enum() *TaskRun_Trigger {
+	p := new(TaskRun_Trigger)
+	*p = x
+	return p
+}
+
+func (x TaskRun_Trigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskRun_Trigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_service_v1_i_task_proto_enumTypes[2].Descriptor()
+}
+
+func (TaskRun_Trigger) Type() protoreflect.EnumType {
+	return &file_admin_service_v1_i_task_proto_enumTypes[2]
+}
+
+func (x TaskRun_Trigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskRun_Trigger.Descriptor instead.
+func (TaskRun_Trigger) EnumDescriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{2, 1}
+}
+
 // 调度任务控制类型
 type ControlTaskRequest_ControlType int32
 
@@ -112,11 +212,11 @@ func (x ControlTaskRequest_ControlType) String() string {
 }
 
 func (ControlTaskRequest_ControlType) Descriptor() protoreflect.EnumDescriptor {
-	return file_admin_service_v1_i_task_proto_enumTypes[1].Descriptor()
+	return file_admin_service_v1_i_task_proto_enumTypes[3].Descriptor()
 }
 
 func (ControlTaskRequest_ControlType) Type() protoreflect.EnumType {
-	return &file_admin_service_v1_i_task_proto_enumTypes[1]
+	return &file_admin_service_v1_i_task_proto_enumTypes[3]
 }
 
 func (x ControlTaskRequest_ControlType) Number() protoreflect.EnumNumber {
@@ -125,7 +225,7 @@ func (x ControlTaskRequest_ControlType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ControlTaskRequest_ControlType.Descriptor instead.
 func (ControlTaskRequest_ControlType) EnumDescriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{8, 0}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{9, 0}
 }
 
 // 任务选项
@@ -248,6 +348,8 @@ type Task struct {
 	TaskOptions   *TaskOption            `protobuf:"bytes,6,opt,name=task_options,json=taskOptions,proto3,oneof" json:"task_options,omitempty"` // 任务选项
 	Enable        *bool                  `protobuf:"varint,10,opt,name=enable,proto3,oneof" json:"enable,omitempty"`                            // 启用/禁用任务
 	Remark        *string                `protobuf:"bytes,11,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                             // 备注
+	LastRun       *TaskRun               `protobuf:"bytes,12,opt,name=last_run,json=lastRun,proto3,oneof" json:"last_run,omitempty"`            // 最近一次执行记录
+	TenantId      *uint32                `protobuf:"varint,13,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`        // 租户ID
 	CreatedBy     *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`    // 创建者ID
 	UpdatedBy     *uint32                `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`    // 更新者ID
 	DeletedBy     *uint32                `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`    // 删除者用户ID
@@ -344,6 +446,20 @@ func (x *Task) GetRemark() string {
 	return ""
 }
 
+func (x *Task) GetLastRun() *TaskRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *Task) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 func (x *Task) GetCreatedBy() uint32 {
 	if x != nil && x.CreatedBy != nil {
 		return *x.CreatedBy
@@ -386,6 +502,203 @@ func (x *Task) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 任务执行记录
+type TaskRun struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                  // 执行记录ID
+	TaskId          *uint32                `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3,oneof" json:"task_id,omitempty"`                            // 调度任务ID
+	TypeName        *string                `protobuf:"bytes,3,opt,name=type_name,json=typeName,proto3,oneof" json:"type_name,omitempty"`                       // 任务执行类型名
+	RunId           *string                `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3,oneof" json:"run_id,omitempty"`                                // 队列中的任务ID
+	Queue           *string                `protobuf:"bytes,5,opt,name=queue,proto3,oneof" json:"queue,omitempty"`                                             // 队列名称
+	Status          *TaskRun_Status        `protobuf:"varint,6,opt,name=status,proto3,enum=admin.service.v1.TaskRun_Status,oneof" json:"status,omitempty"`     // 执行状态
+	Trigger         *TaskRun_Trigger       `protobuf:"varint,7,opt,name=trigger,proto3,enum=admin.service.v1.TaskRun_Trigger,oneof" json:"trigger,omitempty"`  // 触发方式
+	StartedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=started_at,json=startedAt,proto3,oneof" json:"started_at,omitempty"`                    // 开始时间
+	FinishedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=finished_at,json=finishedAt,proto3,oneof" json:"finished_at,omitempty"`                 // 结束时间
+	DurationMs      *int64                 `protobuf:"varint,10,opt,name=duration_ms,json=durationMs,proto3,oneof" json:"duration_ms,omitempty"`               // 执行耗时（毫秒）
+	RetryCount      *int32                 `protobuf:"varint,11,opt,name=retry_count,json=retryCount,proto3,oneof" json:"retry_count,omitempty"`               // 已重试次数
+	MaxRetry        *int32                 `protobuf:"varint,12,opt,name=max_retry,json=maxRetry,proto3,oneof" json:"max_retry,omitempty"`                     // 最大重试次数
+	Progress        *int32                 `protobuf:"varint,13,opt,name=progress,proto3,oneof" json:"progress,omitempty"`                                     // 执行进度（0-100）
+	ProgressMessage *string                `protobuf:"bytes,14,opt,name=progress_message,json=progressMessage,proto3,oneof" json:"progress_message,omitempty"` // 进度说明
+	ErrorMessage    *string                `protobuf:"bytes,15,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`          // 错误信息
+	Result          *string                `protobuf:"bytes,16,opt,name=result,proto3,oneof" json:"result,omitempty"`                                          // 执行结果，以 JSON 格式存储
+	TenantId        *uint32                `protobuf:"varint,17,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                     // 租户ID
+	CreatedBy       *uint32                `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                 // 触发者ID
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                  // 创建时间
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                  // 更新时间
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TaskRun) Reset() {
+	*x = TaskRun{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun) ProtoMessage() {}
+
+func (x *TaskRun) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TaskRun) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+func (x *TaskRun) GetTaskId() uint32 {
+	if x != nil && x.TaskId != nil {
+		return *x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskRun) GetTypeName() string {
+	if x != nil && x.TypeName != nil {
+		return *x.TypeName
+	}
+	return ""
+}
+
+func (x *TaskRun) GetRunId() string {
+	if x != nil && x.RunId != nil {
+		return *x.RunId
+	}
+	return ""
+}
+
+func (x *TaskRun) GetQueue() string {
+	if x != nil && x.Queue != nil {
+		return *x.Queue
+	}
+	return ""
+}
+
+func (x *TaskRun) GetStatus() TaskRun_Status {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskRun_PENDING
+}
+
+func (x *TaskRun) GetTrigger() TaskRun_Trigger {
+	if x != nil && x.Trigger != nil {
+		return *x.Trigger
+	}
+	return TaskRun_SCHEDULE
+}
+
+func (x *TaskRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *TaskRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *TaskRun) GetDurationMs() int64 {
+	if x != nil && x.DurationMs != nil {
+		return *x.DurationMs
+	}
+	return 0
+}
+
+func (x *TaskRun) GetRetryCount() int32 {
+	if x != nil && x.RetryCount != nil {
+		return *x.RetryCount
+	}
+	return 0
+}
+
+func (x *TaskRun) GetMaxRetry() int32 {
+	if x != nil && x.MaxRetry != nil {
+		return *x.MaxRetry
+	}
+	return 0
+}
+
+func (x *TaskRun) GetProgress() int32 {
+	if x != nil && x.Progress != nil {
+		return *x.Progress
+	}
+	return 0
+}
+
+func (x *TaskRun) GetProgressMessage() string {
+	if x != nil && x.ProgressMessage != nil {
+		return *x.ProgressMessage
+	}
+	return ""
+}
+
+func (x *TaskRun) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *TaskRun) GetResult() string {
+	if x != nil && x.Result != nil {
+		return *x.Result
+	}
+	return ""
+}
+
+func (x *TaskRun) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *TaskRun) GetCreatedBy() uint32 {
+	if x != nil && x.CreatedBy != nil {
+		return *x.CreatedBy
+	}
+	return 0
+}
+
+func (x *TaskRun) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskRun) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 // 查询调度任务列表 - 回应
 type ListTaskResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -397,7 +710,7 @@ type ListTaskResponse struct {
 
 func (x *ListTaskResponse) Reset() {
 	*x = ListTaskResponse{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[2]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -409,7 +722,7 @@ func (x *ListTaskResponse) String() string {
 func (*ListTaskResponse) ProtoMessage() {}
 
 func (x *ListTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[2]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,7 +735,7 @@ func (x *ListTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskResponse.ProtoReflect.Descriptor instead.
 func (*ListTaskResponse) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{2}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ListTaskResponse) GetItems() []*Task {
@@ -454,7 +767,7 @@ type GetTaskRequest struct {
 
 func (x *GetTaskRequest) Reset() {
 	*x = GetTaskRequest{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[3]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +779,7 @@ func (x *GetTaskRequest) String() string {
 func (*GetTaskRequest) ProtoMessage() {}
 
 func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[3]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +792,7 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{3}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTaskRequest) GetQueryBy() isGetTaskRequest_QueryBy {
@@ -540,7 +853,7 @@ type CreateTaskRequest struct {
 
 func (x *CreateTaskRequest) Reset() {
 	*x = CreateTaskRequest{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[4]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -552,7 +865,7 @@ func (x *CreateTaskRequest) String() string {
 func (*CreateTaskRequest) ProtoMessage() {}
 
 func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[4]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -565,7 +878,7 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
 func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{4}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateTaskRequest) GetData() *Task {
@@ -588,7 +901,7 @@ type UpdateTaskRequest struct {
 
 func (x *UpdateTaskRequest) Reset() {
 	*x = UpdateTaskRequest{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[5]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -600,7 +913,7 @@ func (x *UpdateTaskRequest) String() string {
 func (*UpdateTaskRequest) ProtoMessage() {}
 
 func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[5]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -613,7 +926,7 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{5}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateTaskRequest) GetId() uint32 {
@@ -654,7 +967,7 @@ type DeleteTaskRequest struct {
 
 func (x *DeleteTaskRequest) Reset() {
 	*x = DeleteTaskRequest{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[6]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -666,7 +979,7 @@ func (x *DeleteTaskRequest) String() string {
 func (*DeleteTaskRequest) ProtoMessage() {}
 
 func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[6]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +992,7 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{6}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteTaskRequest) GetId() uint32 {
@@ -699,7 +1012,7 @@ type RestartAllTaskResponse struct {
 
 func (x *RestartAllTaskResponse) Reset() {
 	*x = RestartAllTaskResponse{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[7]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -711,7 +1024,7 @@ func (x *RestartAllTaskResponse) String() string {
 func (*RestartAllTaskResponse) ProtoMessage() {}
 
 func (x *RestartAllTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[7]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,7 +1037,7 @@ func (x *RestartAllTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartAllTaskResponse.ProtoReflect.Descriptor instead.
 func (*RestartAllTaskResponse) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{7}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RestartAllTaskResponse) GetCount() int32 {
@@ -739,13 +1052,14 @@ type ControlTaskRequest struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	ControlType   ControlTaskRequest_ControlType `protobuf:"varint,1,opt,name=control_type,json=controlType,proto3,enum=admin.service.v1.ControlTaskRequest_ControlType" json:"control_type,omitempty"` // 控制类型
 	TypeName      string                         `protobuf:"bytes,2,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`                                                                // 任务执行类型名
+	Id            *uint32                        `protobuf:"varint,3,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                     // 调度任务ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ControlTaskRequest) Reset() {
 	*x = ControlTaskRequest{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[8]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -757,7 +1071,7 @@ func (x *ControlTaskRequest) String() string {
 func (*ControlTaskRequest) ProtoMessage() {}
 
 func (x *ControlTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[8]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -770,7 +1084,7 @@ func (x *ControlTaskRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ControlTaskRequest.ProtoReflect.Descriptor instead.
 func (*ControlTaskRequest) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{8}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ControlTaskRequest) GetControlType() ControlTaskRequest_ControlType {
@@ -787,17 +1101,25 @@ func (x *ControlTaskRequest) GetTypeName() string {
 	return ""
 }
 
+func (x *ControlTaskRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
 // 任务类型名称列表 - 回应
 type ListTaskTypeNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TypeNames     []string               `protobuf:"bytes,1,rep,name=type_names,json=typeNames,proto3" json:"type_names,omitempty"` // 类型名称列表
+	Types         []*TaskTypeInfo        `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`                          // 任务类型列表
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListTaskTypeNameResponse) Reset() {
 	*x = ListTaskTypeNameResponse{}
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[9]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -809,7 +1131,7 @@ func (x *ListTaskTypeNameResponse) String() string {
 func (*ListTaskTypeNameResponse) ProtoMessage() {}
 
 func (x *ListTaskTypeNameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_service_v1_i_task_proto_msgTypes[9]
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +1144,7 @@ func (x *ListTaskTypeNameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTaskTypeNameResponse.ProtoReflect.Descriptor instead.
 func (*ListTaskTypeNameResponse) Descriptor() ([]byte, []int) {
-	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{9}
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListTaskTypeNameResponse) GetTypeNames() []string {
@@ -832,28 +1154,560 @@ func (x *ListTaskTypeNameResponse) GetTypeNames() []string {
 	return nil
 }
 
-var File_admin_service_v1_i_task_proto protoreflect.FileDescriptor
+func (x *ListTaskTypeNameResponse) GetTypes() []*TaskTypeInfo {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
 
-const file_admin_service_v1_i_task_proto_rawDesc = "" +
-	"\n" +
-	"\x1dadmin/service/v1/i_task.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xf7\x06\n" +
-	"\n" +
-	"TaskOption\x12L\n" +
-	"\tmax_retry\x18\x01 \x01(\rB*\xe0A\x01\xbaG$\x92\x02!任务最多可以重试的次数H\x00R\bmaxRetry\x88\x01\x01\x12U\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x1b\xe0A\x01\xbaG\x15\x92\x02\x12任务超时时间H\x01R\atimeout\x88\x01\x01\x12X\n" +
-	"\bdeadline\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1b\xe0A\x01\xbaG\x15\x92\x02\x12任务截止时间H\x02R\bdeadline\x88\x01\x01\x12`\n" +
-	"\n" +
-	"process_in\x18\x04 \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务延迟处理时间H\x03R\tprocessIn\x88\x01\x01\x12^\n" +
-	"\n" +
-	"process_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xe0A\x01\xbaG\x18\x92\x02\x15任务执行时间点H\x04R\tprocessAt\x88\x01\x01\x12`\n" +
-	"\n" +
-	"unique_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务唯一锁定时间H\x05R\tuniqueTTL\x88\x01\x01\x12_\n" +
-	"\tretention\x18\a \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务结果保留时间H\x06R\tretention\x88\x01\x01\x120\n" +
-	"\x05group\x18\b \x01(\tB\x15\xe0A\x01\xbaG\x0f\x92\x02\f任务分组H\aR\x05group\x88\x01\x01\x12;\n" +
-	"\atask_id\x18\t \x01(\tB\x1d\xe0A\x01\xbaG\x17\x92\x02\x14任务唯一标识IDH\bR\x06taskID\x88\x01\x01B\f\n" +
-	"\n" +
-	"_max_retryB\n" +
-	"\n" +
+// 任务类型
+type TaskTypeInfo struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	TypeName      string                       `protobuf:"bytes,1,opt,name=type_name,json=typeName,proto3" json:"type_name,omitempty"`                // 任务执行类型名
+	Description   string                       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                          // 任务类型说明
+	PayloadFields []*TaskTypeInfo_PayloadField `protobuf:"bytes,3,rep,name=payload_fields,json=payloadFields,proto3" json:"payload_fields,omitempty"` // 任务数据字段
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTypeInfo) Reset() {
+	*x = TaskTypeInfo{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTypeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTypeInfo) ProtoMessage() {}
+
+func (x *TaskTypeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTypeInfo.ProtoReflect.Descriptor instead.
+func (*TaskTypeInfo) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TaskTypeInfo) GetTypeName() string {
+	if x != nil {
+		return x.TypeName
+	}
+	return ""
+}
+
+func (x *TaskTypeInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TaskTypeInfo) GetPayloadFields() []*TaskTypeInfo_PayloadField {
+	if x != nil {
+		return x.PayloadFields
+	}
+	return nil
+}
+
+// 立即执行调度任务 - 请求
+type RunTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                           // 调度任务ID
+	TaskPayload   *string                `protobuf:"bytes,2,opt,name=task_payload,json=taskPayload,proto3,oneof" json:"task_payload,omitempty"` // 本次执行使用的任务数据
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunTaskRequest) Reset() {
+	*x = RunTaskRequest{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunTaskRequest) ProtoMessage() {}
+
+func (x *RunTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunTaskRequest.ProtoReflect.Descriptor instead.
+func (*RunTaskRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RunTaskRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RunTaskRequest) GetTaskPayload() string {
+	if x != nil && x.TaskPayload != nil {
+		return *x.TaskPayload
+	}
+	return ""
+}
+
+// 立即执行调度任务 - 回应
+type RunTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         uint32                 `protobuf:"varint,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"` // 执行记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunTaskResponse) Reset() {
+	*x = RunTaskResponse{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunTaskResponse) ProtoMessage() {}
+
+func (x *RunTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunTaskResponse.ProtoReflect.Descriptor instead.
+func (*RunTaskResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RunTaskResponse) GetRunId() uint32 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+// 查询任务执行记录列表 - 回应
+type ListTaskRunResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TaskRun             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRunResponse) Reset() {
+	*x = ListTaskRunResponse{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRunResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunResponse) ProtoMessage() {}
+
+func (x *ListTaskRunResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskRunResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListTaskRunResponse) GetItems() []*TaskRun {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListTaskRunResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 查询某个调度任务的执行记录列表 - 请求
+type ListTaskRunByTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        uint32                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`             // 调度任务ID
+	Page          *uint32                `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`                         // 当前页码
+	PageSize      *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // 每页的行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskRunByTaskRequest) Reset() {
+	*x = ListTaskRunByTaskRequest{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskRunByTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskRunByTaskRequest) ProtoMessage() {}
+
+func (x *ListTaskRunByTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskRunByTaskRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskRunByTaskRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListTaskRunByTaskRequest) GetTaskId() uint32 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ListTaskRunByTaskRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListTaskRunByTaskRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// 查询任务执行记录详情 - 请求
+type GetTaskRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 执行记录ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRunRequest) Reset() {
+	*x = GetTaskRunRequest{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRunRequest) ProtoMessage() {}
+
+func (x *GetTaskRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRunRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRunRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTaskRunRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 从备份文件恢复数据库 - 请求
+type RestoreBackupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileId        uint32                 `protobuf:"varint,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`       // 备份文件ID
+	DryRun        *bool                  `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3,oneof" json:"dry_run,omitempty"` // 只校验备份文件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBackupRequest) Reset() {
+	*x = RestoreBackupRequest{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBackupRequest) ProtoMessage() {}
+
+func (x *RestoreBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RestoreBackupRequest) GetFileId() uint32 {
+	if x != nil {
+		return x.FileId
+	}
+	return 0
+}
+
+func (x *RestoreBackupRequest) GetDryRun() bool {
+	if x != nil && x.DryRun != nil {
+		return *x.DryRun
+	}
+	return false
+}
+
+// 从备份文件恢复数据库 - 回应
+type RestoreBackupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int32                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`                         // 备份格式版本
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`                            // 数据库驱动
+	BackupAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=backup_at,json=backupAt,proto3" json:"backup_at,omitempty"`        // 备份时间
+	TableCount    uint32                 `protobuf:"varint,4,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"` // 表数量
+	RowCount      int64                  `protobuf:"varint,5,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`       // 数据行数
+	Restored      bool                   `protobuf:"varint,6,opt,name=restored,proto3" json:"restored,omitempty"`                       // 是否已写入数据库
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBackupResponse) Reset() {
+	*x = RestoreBackupResponse{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBackupResponse) ProtoMessage() {}
+
+func (x *RestoreBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBackupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RestoreBackupResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RestoreBackupResponse) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *RestoreBackupResponse) GetBackupAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BackupAt
+	}
+	return nil
+}
+
+func (x *RestoreBackupResponse) GetTableCount() uint32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+func (x *RestoreBackupResponse) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *RestoreBackupResponse) GetRestored() bool {
+	if x != nil {
+		return x.Restored
+	}
+	return false
+}
+
+// 任务数据字段
+type TaskTypeInfo_PayloadField struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 字段名
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`               // 字段类型
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`      // 是否必填
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"` // 字段说明
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskTypeInfo_PayloadField) Reset() {
+	*x = TaskTypeInfo_PayloadField{}
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskTypeInfo_PayloadField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTypeInfo_PayloadField) ProtoMessage() {}
+
+func (x *TaskTypeInfo_PayloadField) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_task_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTypeInfo_PayloadField.ProtoReflect.Descriptor instead.
+func (*TaskTypeInfo_PayloadField) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_task_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *TaskTypeInfo_PayloadField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaskTypeInfo_PayloadField) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TaskTypeInfo_PayloadField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TaskTypeInfo_PayloadField) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+var File_admin_service_v1_i_task_proto protoreflect.FileDescriptor
+
+const file_admin_service_v1_i_task_proto_rawDesc = "" +
+	"\n" +
+	"\x1dadmin/service/v1/i_task.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xf7\x06\n" +
+	"\n" +
+	"TaskOption\x12L\n" +
+	"\tmax_retry\x18\x01 \x01(\rB*\xe0A\x01\xbaG$\x92\x02!任务最多可以重试的次数H\x00R\bmaxRetry\x88\x01\x01\x12U\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationB\x1b\xe0A\x01\xbaG\x15\x92\x02\x12任务超时时间H\x01R\atimeout\x88\x01\x01\x12X\n" +
+	"\bdeadline\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x1b\xe0A\x01\xbaG\x15\x92\x02\x12任务截止时间H\x02R\bdeadline\x88\x01\x01\x12`\n" +
+	"\n" +
+	"process_in\x18\x04 \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务延迟处理时间H\x03R\tprocessIn\x88\x01\x01\x12^\n" +
+	"\n" +
+	"process_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xe0A\x01\xbaG\x18\x92\x02\x15任务执行时间点H\x04R\tprocessAt\x88\x01\x01\x12`\n" +
+	"\n" +
+	"unique_ttl\x18\x06 \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务唯一锁定时间H\x05R\tuniqueTTL\x88\x01\x01\x12_\n" +
+	"\tretention\x18\a \x01(\v2\x19.google.protobuf.DurationB!\xe0A\x01\xbaG\x1b\x92\x02\x18任务结果保留时间H\x06R\tretention\x88\x01\x01\x120\n" +
+	"\x05group\x18\b \x01(\tB\x15\xe0A\x01\xbaG\x0f\x92\x02\f任务分组H\aR\x05group\x88\x01\x01\x12;\n" +
+	"\atask_id\x18\t \x01(\tB\x1d\xe0A\x01\xbaG\x17\x92\x02\x14任务唯一标识IDH\bR\x06taskID\x88\x01\x01B\f\n" +
+	"\n" +
+	"_max_retryB\n" +
+	"\n" +
 	"\b_timeoutB\v\n" +
 	"\t_deadlineB\r\n" +
 	"\v_process_inB\r\n" +
@@ -863,7 +1717,7 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"_retentionB\b\n" +
 	"\x06_groupB\n" +
 	"\n" +
-	"\b_task_id\"\x93\v\n" +
+	"\b_task_id\"\xbe\f\n" +
 	"\x04Task\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b任务IDH\x00R\x02id\x88\x01\x01\x12K\n" +
 	"\x04type\x18\x02 \x01(\x0e2\x1b.admin.service.v1.Task.TypeB\x15\xe0A\x01\xbaG\x0f\x92\x02\f任务类型H\x01R\x04type\x88\x01\x01\x12\x92\x01\n" +
@@ -873,20 +1727,22 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"\ftask_options\x18\x06 \x01(\v2\x1c.admin.service.v1.TaskOptionBZ\xe0A\x01\xbaGT\x92\x02Q任务选项，以 JSON 格式存储，方便存储不同类型和数量的选项H\x05R\vtaskOptions\x88\x01\x01\x126\n" +
 	"\x06enable\x18\n" +
 	" \x01(\bB\x19\xbaG\x16\x92\x02\x13启用/禁用任务H\x06R\x06enable\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x125\n" +
+	"\x06remark\x18\v \x01(\tB\f\xbaG\t\x92\x02\x06备注H\aR\x06remark\x88\x01\x01\x12\\\n" +
+	"\blast_run\x18\f \x01(\v2\x19.admin.service.v1.TaskRunB!\xe0A\x03\xbaG\x1b\x92\x02\x18最近一次执行记录H\bR\alastRun\x88\x01\x01\x120\n" +
+	"\ttenant_id\x18\r \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\tR\btenantId\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\bR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\n" +
+	"R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\tR\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\vR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\n" +
-	"R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\fR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\vR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\rR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\fR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x0eR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\rR\tdeletedAt\x88\x01\x01\"0\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x0fR\tdeletedAt\x88\x01\x01\"0\n" +
 	"\x04Type\x12\f\n" +
 	"\bPERIODIC\x10\x00\x12\t\n" +
 	"\x05DELAY\x10\x01\x12\x0f\n" +
@@ -900,13 +1756,81 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"_cron_specB\x0f\n" +
 	"\r_task_optionsB\t\n" +
 	"\a_enableB\t\n" +
-	"\a_remarkB\r\n" +
+	"\a_remarkB\v\n" +
+	"\t_last_runB\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\r\n" +
 	"\v_deleted_byB\r\n" +
 	"\v_created_atB\r\n" +
 	"\v_updated_atB\r\n" +
-	"\v_deleted_at\"V\n" +
+	"\v_deleted_at\"\xb9\r\n" +
+	"\aTaskRun\x12,\n" +
+	"\x02id\x18\x01 \x01(\rB\x17\xe0A\x01\xbaG\x11\x92\x02\x0e执行记录IDH\x00R\x02id\x88\x01\x01\x122\n" +
+	"\atask_id\x18\x02 \x01(\rB\x14\xbaG\x11\x92\x02\x0e调度任务IDH\x01R\x06taskId\x88\x01\x01\x12=\n" +
+	"\ttype_name\x18\x03 \x01(\tB\x1b\xbaG\x18\x92\x02\x15任务执行类型名H\x02R\btypeName\x88\x01\x01\x126\n" +
+	"\x06run_id\x18\x04 \x01(\tB\x1a\xbaG\x17\x92\x02\x14队列中的任务IDH\x03R\x05runId\x88\x01\x01\x12-\n" +
+	"\x05queue\x18\x05 \x01(\tB\x12\xbaG\x0f\x92\x02\f队列名称H\x04R\x05queue\x88\x01\x01\x12Q\n" +
+	"\x06status\x18\x06 \x01(\x0e2 .admin.service.v1.TaskRun.StatusB\x12\xbaG\x0f\x92\x02\f执行状态H\x05R\x06status\x88\x01\x01\x12T\n" +
+	"\atrigger\x18\a \x01(\x0e2!.admin.service.v1.TaskRun.TriggerB\x12\xbaG\x0f\x92\x02\f触发方式H\x06R\atrigger\x88\x01\x01\x12R\n" +
+	"\n" +
+	"started_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f开始时间H\aR\tstartedAt\x88\x01\x01\x12T\n" +
+	"\vfinished_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f结束时间H\bR\n" +
+	"finishedAt\x88\x01\x01\x12D\n" +
+	"\vduration_ms\x18\n" +
+	" \x01(\x03B\x1e\xbaG\x1b\x92\x02\x18执行耗时（毫秒）H\tR\n" +
+	"durationMs\x88\x01\x01\x12;\n" +
+	"\vretry_count\x18\v \x01(\x05B\x15\xbaG\x12\x92\x02\x0f已重试次数H\n" +
+	"R\n" +
+	"retryCount\x88\x01\x01\x12:\n" +
+	"\tmax_retry\x18\f \x01(\x05B\x18\xbaG\x15\x92\x02\x12最大重试次数H\vR\bmaxRetry\x88\x01\x01\x12>\n" +
+	"\bprogress\x18\r \x01(\x05B\x1d\xbaG\x1a\x92\x02\x17执行进度（0-100）H\fR\bprogress\x88\x01\x01\x12B\n" +
+	"\x10progress_message\x18\x0e \x01(\tB\x12\xbaG\x0f\x92\x02\f进度说明H\rR\x0fprogressMessage\x88\x01\x01\x12<\n" +
+	"\rerror_message\x18\x0f \x01(\tB\x12\xbaG\x0f\x92\x02\f错误信息H\x0eR\ferrorMessage\x88\x01\x01\x12G\n" +
+	"\x06result\x18\x10 \x01(\tB*\xbaG'\x92\x02$执行结果，以 JSON 格式存储H\x0fR\x06result\x88\x01\x01\x120\n" +
+	"\ttenant_id\x18\x11 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x10R\btenantId\x88\x01\x01\x125\n" +
+	"\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v触发者IDH\x11R\tcreatedBy\x88\x01\x01\x12S\n" +
+	"\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x12R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x13R\tupdatedAt\x88\x01\x01\"=\n" +
+	"\x06Status\x12\v\n" +
+	"\aPENDING\x10\x00\x12\v\n" +
+	"\aRUNNING\x10\x01\x12\r\n" +
+	"\tSUCCEEDED\x10\x02\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x03\"#\n" +
+	"\aTrigger\x12\f\n" +
+	"\bSCHEDULE\x10\x00\x12\n" +
+	"\n" +
+	"\x06MANUAL\x10\x01B\x05\n" +
+	"\x03_idB\n" +
+	"\n" +
+	"\b_task_idB\f\n" +
+	"\n" +
+	"_type_nameB\t\n" +
+	"\a_run_idB\b\n" +
+	"\x06_queueB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_triggerB\r\n" +
+	"\v_started_atB\x0e\n" +
+	"\f_finished_atB\x0e\n" +
+	"\f_duration_msB\x0e\n" +
+	"\f_retry_countB\f\n" +
+	"\n" +
+	"_max_retryB\v\n" +
+	"\t_progressB\x13\n" +
+	"\x11_progress_messageB\x10\n" +
+	"\x0e_error_messageB\t\n" +
+	"\a_resultB\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
+	"\v_created_byB\r\n" +
+	"\v_created_atB\r\n" +
+	"\v_updated_at\"V\n" +
 	"\x10ListTaskResponse\x12,\n" +
 	"\x05items\x18\x01 \x03(\v2\x16.admin.service.v1.TaskR\x05items\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x04R\x05total\"\xd3\x02\n" +
@@ -931,17 +1855,60 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\".\n" +
 	"\x16RestartAllTaskResponse\x12\x14\n" +
-	"\x05count\x18\x01 \x01(\x05R\x05count\"\xbe\x02\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\"\xb8\x03\n" +
 	"\x12ControlTaskRequest\x12g\n" +
 	"\fcontrol_type\x18\x01 \x01(\x0e20.admin.service.v1.ControlTaskRequest.ControlTypeB\x12\xbaG\x0f\x92\x02\f控制类型R\vcontrolType\x12\x8d\x01\n" +
-	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\"/\n" +
+	"\ttype_name\x18\x02 \x01(\tBp\xe0A\x01\xbaGj\x92\x02g任务执行类型名，例如 \"send_email\"、\"generate_report\" 等，用于区分不同类型的任务R\btypeName\x12q\n" +
+	"\x02id\x18\x03 \x01(\rB\\\xe0A\x01\xbaGV\x92\x02S调度任务ID，设置后只控制该任务，否则按任务执行类型名查找H\x00R\x02id\x88\x01\x01\"/\n" +
 	"\vControlType\x12\t\n" +
 	"\x05Start\x10\x00\x12\b\n" +
 	"\x04Stop\x10\x01\x12\v\n" +
-	"\aRestart\x10\x02\"S\n" +
+	"\aRestart\x10\x02B\x05\n" +
+	"\x03_id\"\xbe\x01\n" +
 	"\x18ListTaskTypeNameResponse\x127\n" +
 	"\n" +
-	"type_names\x18\x01 \x03(\tB\x18\xbaG\x15\x92\x02\x12类型名称列表R\ttypeNames2\xcd\b\n" +
+	"type_names\x18\x01 \x03(\tB\x18\xbaG\x15\x92\x02\x12类型名称列表R\ttypeNames\x12i\n" +
+	"\x05types\x18\x02 \x03(\v2\x1e.admin.service.v1.TaskTypeInfoB3\xbaG0\x92\x02-任务类型列表，包含任务数据结构R\x05types\"\xed\x03\n" +
+	"\fTaskTypeInfo\x128\n" +
+	"\ttype_name\x18\x01 \x01(\tB\x1b\xbaG\x18\x92\x02\x15任务执行类型名R\btypeName\x12:\n" +
+	"\vdescription\x18\x02 \x01(\tB\x18\xbaG\x15\x92\x02\x12任务类型说明R\vdescription\x12l\n" +
+	"\x0epayload_fields\x18\x03 \x03(\v2+.admin.service.v1.TaskTypeInfo.PayloadFieldB\x18\xbaG\x15\x92\x02\x12任务数据字段R\rpayloadFields\x1a\xf8\x01\n" +
+	"\fPayloadField\x12#\n" +
+	"\x04name\x18\x01 \x01(\tB\x0f\xbaG\f\x92\x02\t字段名R\x04name\x12]\n" +
+	"\x04type\x18\x02 \x01(\tBI\xbaGF\x92\x02C字段类型：string、integer、number、boolean、array、objectR\x04type\x12.\n" +
+	"\brequired\x18\x03 \x01(\bB\x12\xbaG\x0f\x92\x02\f是否必填R\brequired\x124\n" +
+	"\vdescription\x18\x04 \x01(\tB\x12\xbaG\x0f\x92\x02\f字段说明R\vdescription\"\xc5\x01\n" +
+	"\x0eRunTaskRequest\x12$\n" +
+	"\x02id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e调度任务IDR\x02id\x12|\n" +
+	"\ftask_payload\x18\x02 \x01(\tBT\xe0A\x01\xbaGN\x92\x02K本次执行使用的任务数据，为空时使用任务定义中的数据H\x00R\vtaskPayload\x88\x01\x01B\x0f\n" +
+	"\r_task_payload\">\n" +
+	"\x0fRunTaskResponse\x12+\n" +
+	"\x06run_id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e执行记录IDR\x05runId\"\\\n" +
+	"\x13ListTaskRunResponse\x12/\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.admin.service.v1.TaskRunR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\xde\x01\n" +
+	"\x18ListTaskRunByTaskRequest\x12-\n" +
+	"\atask_id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e调度任务IDR\x06taskId\x127\n" +
+	"\x04page\x18\x02 \x01(\rB\x1e\xbaG\x1b\x8a\x02\t\t\x00\x00\x00\x00\x00\x00\xf0?\x92\x02\f当前页码H\x00R\x04page\x88\x01\x01\x12C\n" +
+	"\tpage_size\x18\x03 \x01(\rB!\xbaG\x1e\x8a\x02\t\t\x00\x00\x00\x00\x00\x00$@\x92\x02\x0f每页的行数H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"9\n" +
+	"\x11GetTaskRunRequest\x12$\n" +
+	"\x02id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e执行记录IDR\x02id\"\xa9\x01\n" +
+	"\x14RestoreBackupRequest\x12-\n" +
+	"\afile_id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e备份文件IDR\x06fileId\x12V\n" +
+	"\adry_run\x18\x02 \x01(\bB8\xe0A\x01\xbaG2\x8a\x02\x02\x10\x00\x92\x02*只校验备份文件，不写入数据库H\x00R\x06dryRun\x88\x01\x01B\n" +
+	"\n" +
+	"\b_dry_run\"\xfd\x02\n" +
+	"\x15RestoreBackupResponse\x122\n" +
+	"\aversion\x18\x01 \x01(\x05B\x18\xbaG\x15\x92\x02\x12备份格式版本R\aversion\x12-\n" +
+	"\x06driver\x18\x02 \x01(\tB\x15\xbaG\x12\x92\x02\x0f数据库驱动R\x06driver\x12K\n" +
+	"\tbackup_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f备份时间R\bbackupAt\x120\n" +
+	"\vtable_count\x18\x04 \x01(\rB\x0f\xbaG\f\x92\x02\t表数量R\n" +
+	"tableCount\x12/\n" +
+	"\trow_count\x18\x05 \x01(\x03B\x12\xbaG\x0f\x92\x02\f数据行数R\browCount\x12Q\n" +
+	"\brestored\x18\x06 \x01(\bB5\xbaG2\x92\x02/是否已写入数据库，dry_run 时为 falseR\brestored2\xba\r\n" +
 	"\vTaskService\x12^\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a\".admin.service.v1.ListTaskResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/admin/v1/tasks\x12\x86\x01\n" +
 	"\x03Get\x12 .admin.service.v1.GetTaskRequest\x1a\x16.admin.service.v1.Task\"E\x82\xd3\xe4\x93\x02?Z'\x12%/admin/v1/tasks/type-name/{type_name}\x12\x14/admin/v1/tasks/{id}\x12a\n" +
@@ -952,7 +1919,13 @@ const file_admin_service_v1_i_task_proto_rawDesc = "" +
 	"\x0eRestartAllTask\x12\x16.google.protobuf.Empty\x1a(.admin.service.v1.RestartAllTaskResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:restart\x12`\n" +
 	"\fStartAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/admin/v1/tasks:start\x12^\n" +
 	"\vStopAllTask\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/admin/v1/tasks:stop\x12o\n" +
-	"\vControlTask\x12$.admin.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:controlB\xb9\x01\n" +
+	"\vControlTask\x12$.admin.service.v1.ControlTaskRequest\x1a\x16.google.protobuf.Empty\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/admin/v1/tasks:control\x12s\n" +
+	"\aRunTask\x12 .admin.service.v1.RunTaskRequest\x1a!.admin.service.v1.RunTaskResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/admin/v1/tasks/{id}:run\x12l\n" +
+	"\vListTaskRun\x12\x19.pagination.PagingRequest\x1a%.admin.service.v1.ListTaskRunResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/admin/v1/task-runs\x12\x8e\x01\n" +
+	"\x11ListTaskRunByTask\x12*.admin.service.v1.ListTaskRunByTaskRequest\x1a%.admin.service.v1.ListTaskRunResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/admin/v1/tasks/{task_id}/runs\x12n\n" +
+	"\n" +
+	"GetTaskRun\x12#.admin.service.v1.GetTaskRunRequest\x1a\x19.admin.service.v1.TaskRun\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/admin/v1/task-runs/{id}\x12\x86\x01\n" +
+	"\rRestoreBackup\x12&.admin.service.v1.RestoreBackupRequest\x1a'.admin.service.v1.RestoreBackupResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/admin/v1/backups:restoreB\xb9\x01\n" +
 	"\x14com.admin.service.v1B\n" +
 	"ITaskProtoP\x01Z3go-wind-admin/api/gen/go/admin/service/v1;servicev1\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
@@ -968,70 +1941,103 @@ func file_admin_service_v1_i_task_proto_rawDescGZIP() []byte {
 	return file_admin_service_v1_i_task_proto_rawDescData
 }
 
-var file_admin_service_v1_i_task_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_admin_service_v1_i_task_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_admin_service_v1_i_task_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_admin_service_v1_i_task_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_admin_service_v1_i_task_proto_goTypes = []any{
 	(Task_Type)(0),                      // 0: admin.service.v1.Task.Type
-	(ControlTaskRequest_ControlType)(0), // 1: admin.service.v1.ControlTaskRequest.ControlType
-	(*TaskOption)(nil),                  // 2: admin.service.v1.TaskOption
-	(*Task)(nil),                        // 3: admin.service.v1.Task
-	(*ListTaskResponse)(nil),            // 4: admin.service.v1.ListTaskResponse
-	(*GetTaskRequest)(nil),              // 5: admin.service.v1.GetTaskRequest
-	(*CreateTaskRequest)(nil),           // 6: admin.service.v1.CreateTaskRequest
-	(*UpdateTaskRequest)(nil),           // 7: admin.service.v1.UpdateTaskRequest
-	(*DeleteTaskRequest)(nil),           // 8: admin.service.v1.DeleteTaskRequest
-	(*RestartAllTaskResponse)(nil),      // 9: admin.service.v1.RestartAllTaskResponse
-	(*ControlTaskRequest)(nil),          // 10: admin.service.v1.ControlTaskRequest
-	(*ListTaskTypeNameResponse)(nil),    // 11: admin.service.v1.ListTaskTypeNameResponse
-	(*durationpb.Duration)(nil),         // 12: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),       // 13: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),       // 14: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),            // 15: pagination.PagingRequest
-	(*emptypb.Empty)(nil),               // 16: google.protobuf.Empty
+	(TaskRun_Status)(0),                 // 1: admin.service.v1.TaskRun.Status
+	(TaskRun_Trigger)(0),                // 2: admin.service.v1.TaskRun.Trigger
+	(ControlTaskRequest_ControlType)(0), // 3: admin.service.v1.ControlTaskRequest.ControlType
+	(*TaskOption)(nil),                  // 4: admin.service.v1.TaskOption
+	(*Task)(nil),                        // 5: admin.service.v1.Task
+	(*TaskRun)(nil),                     // 6: admin.service.v1.TaskRun
+	(*ListTaskResponse)(nil),            // 7: admin.service.v1.ListTaskResponse
+	(*GetTaskRequest)(nil),              // 8: admin.service.v1.GetTaskRequest
+	(*CreateTaskRequest)(nil),           // 9: admin.service.v1.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),           // 10: admin.service.v1.UpdateTaskRequest
+	(*DeleteTaskRequest)(nil),           // 11: admin.service.v1.DeleteTaskRequest
+	(*RestartAllTaskResponse)(nil),      // 12: admin.service.v1.RestartAllTaskResponse
+	(*ControlTaskRequest)(nil),          // 13: admin.service.v1.ControlTaskRequest
+	(*ListTaskTypeNameResponse)(nil),    // 14: admin.service.v1.ListTaskTypeNameResponse
+	(*TaskTypeInfo)(nil),                // 15: admin.service.v1.TaskTypeInfo
+	(*RunTaskRequest)(nil),              // 16: admin.service.v1.RunTaskRequest
+	(*RunTaskResponse)(nil),             // 17: admin.service.v1.RunTaskResponse
+	(*ListTaskRunResponse)(nil),         // 18: admin.service.v1.ListTaskRunResponse
+	(*ListTaskRunByTaskRequest)(nil),    // 19: admin.service.v1.ListTaskRunByTaskRequest
+	(*GetTaskRunRequest)(nil),           // 20: admin.service.v1.GetTaskRunRequest
+	(*RestoreBackupRequest)(nil),        // 21: admin.service.v1.RestoreBackupRequest
+	(*RestoreBackupResponse)(nil),       // 22: admin.service.v1.RestoreBackupResponse
+	(*TaskTypeInfo_PayloadField)(nil),   // 23: admin.service.v1.TaskTypeInfo.PayloadField
+	(*durationpb.Duration)(nil),         // 24: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),       // 25: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 26: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),            // 27: pagination.PagingRequest
+	(*emptypb.Empty)(nil),               // 28: google.protobuf.Empty
 }
 var file_admin_service_v1_i_task_proto_depIdxs = []int32{
-	12, // 0: admin.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
-	13, // 1: admin.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
-	12, // 2: admin.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
-	13, // 3: admin.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
-	12, // 4: admin.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
-	12, // 5: admin.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
+	24, // 0: admin.service.v1.TaskOption.timeout:type_name -> google.protobuf.Duration
+	25, // 1: admin.service.v1.TaskOption.deadline:type_name -> google.protobuf.Timestamp
+	24, // 2: admin.service.v1.TaskOption.process_in:type_name -> google.protobuf.Duration
+	25, // 3: admin.service.v1.TaskOption.process_at:type_name -> google.protobuf.Timestamp
+	24, // 4: admin.service.v1.TaskOption.unique_ttl:type_name -> google.protobuf.Duration
+	24, // 5: admin.service.v1.TaskOption.retention:type_name -> google.protobuf.Duration
 	0,  // 6: admin.service.v1.Task.type:type_name -> admin.service.v1.Task.Type
-	2,  // 7: admin.service.v1.Task.task_options:type_name -> admin.service.v1.TaskOption
-	13, // 8: admin.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	13, // 9: admin.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 10: admin.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
-	3,  // 11: admin.service.v1.ListTaskResponse.items:type_name -> admin.service.v1.Task
-	14, // 12: admin.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
-	3,  // 13: admin.service.v1.CreateTaskRequest.data:type_name -> admin.service.v1.Task
-	3,  // 14: admin.service.v1.UpdateTaskRequest.data:type_name -> admin.service.v1.Task
-	14, // 15: admin.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 16: admin.service.v1.ControlTaskRequest.control_type:type_name -> admin.service.v1.ControlTaskRequest.ControlType
-	15, // 17: admin.service.v1.TaskService.List:input_type -> pagination.PagingRequest
-	5,  // 18: admin.service.v1.TaskService.Get:input_type -> admin.service.v1.GetTaskRequest
-	6,  // 19: admin.service.v1.TaskService.Create:input_type -> admin.service.v1.CreateTaskRequest
-	7,  // 20: admin.service.v1.TaskService.Update:input_type -> admin.service.v1.UpdateTaskRequest
-	8,  // 21: admin.service.v1.TaskService.Delete:input_type -> admin.service.v1.DeleteTaskRequest
-	16, // 22: admin.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
-	16, // 23: admin.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
-	16, // 24: admin.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
-	16, // 25: admin.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
-	10, // 26: admin.service.v1.TaskService.ControlTask:input_type -> admin.service.v1.ControlTaskRequest
-	4,  // 27: admin.service.v1.TaskService.List:output_type -> admin.service.v1.ListTaskResponse
-	3,  // 28: admin.service.v1.TaskService.Get:output_type -> admin.service.v1.Task
-	16, // 29: admin.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
-	16, // 30: admin.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
-	16, // 31: admin.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
-	11, // 32: admin.service.v1.TaskService.ListTaskTypeName:output_type -> admin.service.v1.ListTaskTypeNameResponse
-	9,  // 33: admin.service.v1.TaskService.RestartAllTask:output_type -> admin.service.v1.RestartAllTaskResponse
-	16, // 34: admin.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
-	16, // 35: admin.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
-	16, // 36: admin.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
-	27, // [27:37] is the sub-list for method output_type
-	17, // [17:27] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	4,  // 7: admin.service.v1.Task.task_options:type_name -> admin.service.v1.TaskOption
+	6,  // 8: admin.service.v1.Task.last_run:type_name -> admin.service.v1.TaskRun
+	25, // 9: admin.service.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	25, // 10: admin.service.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	25, // 11: admin.service.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	1,  // 12: admin.service.v1.TaskRun.status:type_name -> admin.service.v1.TaskRun.Status
+	2,  // 13: admin.service.v1.TaskRun.trigger:type_name -> admin.service.v1.TaskRun.Trigger
+	25, // 14: admin.service.v1.TaskRun.started_at:type_name -> google.protobuf.Timestamp
+	25, // 15: admin.service.v1.TaskRun.finished_at:type_name -> google.protobuf.Timestamp
+	25, // 16: admin.service.v1.TaskRun.created_at:type_name -> google.protobuf.Timestamp
+	25, // 17: admin.service.v1.TaskRun.updated_at:type_name -> google.protobuf.Timestamp
+	5,  // 18: admin.service.v1.ListTaskResponse.items:type_name -> admin.service.v1.Task
+	26, // 19: admin.service.v1.GetTaskRequest.view_mask:type_name -> google.protobuf.FieldMask
+	5,  // 20: admin.service.v1.CreateTaskRequest.data:type_name -> admin.service.v1.Task
+	5,  // 21: admin.service.v1.UpdateTaskRequest.data:type_name -> admin.service.v1.Task
+	26, // 22: admin.service.v1.UpdateTaskRequest.update_mask:type_name -> google.protobuf.FieldMask
+	3,  // 23: admin.service.v1.ControlTaskRequest.control_type:type_name -> admin.service.v1.ControlTaskRequest.ControlType
+	15, // 24: admin.service.v1.ListTaskTypeNameResponse.types:type_name -> admin.service.v1.TaskTypeInfo
+	23, // 25: admin.service.v1.TaskTypeInfo.payload_fields:type_name -> admin.service.v1.TaskTypeInfo.PayloadField
+	6,  // 26: admin.service.v1.ListTaskRunResponse.items:type_name -> admin.service.v1.TaskRun
+	25, // 27: admin.service.v1.RestoreBackupResponse.backup_at:type_name -> google.protobuf.Timestamp
+	27, // 28: admin.service.v1.TaskService.List:input_type -> pagination.PagingRequest
+	8,  // 29: admin.service.v1.TaskService.Get:input_type -> admin.service.v1.GetTaskRequest
+	9,  // 30: admin.service.v1.TaskService.Create:input_type -> admin.service.v1.CreateTaskRequest
+	10, // 31: admin.service.v1.TaskService.Update:input_type -> admin.service.v1.UpdateTaskRequest
+	11, // 32: admin.service.v1.TaskService.Delete:input_type -> admin.service.v1.DeleteTaskRequest
+	28, // 33: admin.service.v1.TaskService.ListTaskTypeName:input_type -> google.protobuf.Empty
+	28, // 34: admin.service.v1.TaskService.RestartAllTask:input_type -> google.protobuf.Empty
+	28, // 35: admin.service.v1.TaskService.StartAllTask:input_type -> google.protobuf.Empty
+	28, // 36: admin.service.v1.TaskService.StopAllTask:input_type -> google.protobuf.Empty
+	13, // 37: admin.service.v1.TaskService.ControlTask:input_type -> admin.service.v1.ControlTaskRequest
+	16, // 38: admin.service.v1.TaskService.RunTask:input_type -> admin.service.v1.RunTaskRequest
+	27, // 39: admin.service.v1.TaskService.ListTaskRun:input_type -> pagination.PagingRequest
+	19, // 40: admin.service.v1.TaskService.ListTaskRunByTask:input_type -> admin.service.v1.ListTaskRunByTaskRequest
+	20, // 41: admin.service.v1.TaskService.GetTaskRun:input_type -> admin.service.v1.GetTaskRunRequest
+	21, // 42: admin.service.v1.TaskService.RestoreBackup:input_type -> admin.service.v1.RestoreBackupRequest
+	7,  // 43: admin.service.v1.TaskService.List:output_type -> admin.service.v1.ListTaskResponse
+	5,  // 44: admin.service.v1.TaskService.Get:output_type -> admin.service.v1.Task
+	28, // 45: admin.service.v1.TaskService.Create:output_type -> google.protobuf.Empty
+	28, // 46: admin.service.v1.TaskService.Update:output_type -> google.protobuf.Empty
+	28, // 47: admin.service.v1.TaskService.Delete:output_type -> google.protobuf.Empty
+	14, // 48: admin.service.v1.TaskService.ListTaskTypeName:output_type -> admin.service.v1.ListTaskTypeNameResponse
+	12, // 49: admin.service.v1.TaskService.RestartAllTask:output_type -> admin.service.v1.RestartAllTaskResponse
+	28, // 50: admin.service.v1.TaskService.StartAllTask:output_type -> google.protobuf.Empty
+	28, // 51: admin.service.v1.TaskService.StopAllTask:output_type -> google.protobuf.Empty
+	28, // 52: admin.service.v1.TaskService.ControlTask:output_type -> google.protobuf.Empty
+	17, // 53: admin.service.v1.TaskService.RunTask:output_type -> admin.service.v1.RunTaskResponse
+	18, // 54: admin.service.v1.TaskService.ListTaskRun:output_type -> admin.service.v1.ListTaskRunResponse
+	18, // 55: admin.service.v1.TaskService.ListTaskRunByTask:output_type -> admin.service.v1.ListTaskRunResponse
+	6,  // 56: admin.service.v1.TaskService.GetTaskRun:output_type -> admin.service.v1.TaskRun
+	22, // 57: admin.service.v1.TaskService.RestoreBackup:output_type -> admin.service.v1.RestoreBackupResponse
+	43, // [43:58] is the sub-list for method output_type
+	28, // [28:43] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_task_proto_init() }
@@ -1041,18 +2047,23 @@ func file_admin_service_v1_i_task_proto_init() {
 	}
 	file_admin_service_v1_i_task_proto_msgTypes[0].OneofWrappers = []any{}
 	file_admin_service_v1_i_task_proto_msgTypes[1].OneofWrappers = []any{}
-	file_admin_service_v1_i_task_proto_msgTypes[3].OneofWrappers = []any{
+	file_admin_service_v1_i_task_proto_msgTypes[2].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[4].OneofWrappers = []any{
 		(*GetTaskRequest_Id)(nil),
 		(*GetTaskRequest_TypeName)(nil),
 	}
-	file_admin_service_v1_i_task_proto_msgTypes[5].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[6].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[9].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[12].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[15].OneofWrappers = []any{}
+	file_admin_service_v1_i_task_proto_msgTypes[17].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_service_v1_i_task_proto_rawDesc), len(file_admin_service_v1_i_task_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   10,
+			NumEnums:      4,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
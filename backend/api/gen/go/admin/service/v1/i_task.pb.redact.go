@@ -160,6 +160,61 @@ func (s *redactedTaskServiceServer) ControlTask(ctx context.Context, in *Control
 	return res, err
 }
 
+// RunTask is the redacted wrapper for the actual TaskServiceServer.RunTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) RunTask(ctx context.Context, in *RunTaskRequest) (*RunTaskResponse, error) {
+	res, err := s.srv.RunTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListTaskRun is the redacted wrapper for the actual TaskServiceServer.ListTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskRun(ctx context.Context, in *pagination.PagingRequest) (*ListTaskRunResponse, error) {
+	res, err := s.srv.ListTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// ListTaskRunByTask is the redacted wrapper for the actual TaskServiceServer.ListTaskRunByTask method
+// Unary RPC
+func (s *redactedTaskServiceServer) ListTaskRunByTask(ctx context.Context, in *ListTaskRunByTaskRequest) (*ListTaskRunResponse, error) {
+	res, err := s.srv.ListTaskRunByTask(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTaskRun is the redacted wrapper for the actual TaskServiceServer.GetTaskRun method
+// Unary RPC
+func (s *redactedTaskServiceServer) GetTaskRun(ctx context.Context, in *GetTaskRunRequest) (*TaskRun, error) {
+	res, err := s.srv.GetTaskRun(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// RestoreBackup is the redacted wrapper for the actual TaskServiceServer.RestoreBackup method
+// Unary RPC
+func (s *redactedTaskServiceServer) RestoreBackup(ctx context.Context, in *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	res, err := s.srv.RestoreBackup(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for TaskOption
 func (x *TaskOption) Redact() string {
 	if x == nil {
@@ -208,6 +263,10 @@ func (x *Task) Redact() string {
 
 	// Safe field: Remark
 
+	// Safe field: LastRun
+
+	// Safe field: TenantId
+
 	// Safe field: CreatedBy
 
 	// Safe field: UpdatedBy
@@ -222,6 +281,54 @@ func (x *Task) Redact() string {
 	return x.String()
 }
 
+// Redact method implementation for TaskRun
+func (x *TaskRun) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TaskId
+
+	// Safe field: TypeName
+
+	// Safe field: RunId
+
+	// Safe field: Queue
+
+	// Safe field: Status
+
+	// Safe field: Trigger
+
+	// Safe field: StartedAt
+
+	// Safe field: FinishedAt
+
+	// Safe field: DurationMs
+
+	// Safe field: RetryCount
+
+	// Safe field: MaxRetry
+
+	// Safe field: Progress
+
+	// Safe field: ProgressMessage
+
+	// Safe field: ErrorMessage
+
+	// Safe field: Result
+
+	// Safe field: TenantId
+
+	// Safe field: CreatedBy
+
+	// Safe field: CreatedAt
+
+	// Safe field: UpdatedAt
+	return x.String()
+}
+
 // Redact method implementation for ListTaskResponse
 func (x *ListTaskResponse) Redact() string {
 	if x == nil {
@@ -303,6 +410,8 @@ func (x *ControlTaskRequest) Redact() string {
 	// Safe field: ControlType
 
 	// Safe field: TypeName
+
+	// Safe field: Id
 	return x.String()
 }
 
@@ -313,5 +422,127 @@ func (x *ListTaskTypeNameResponse) Redact() string {
 	}
 
 	// Safe field: TypeNames
+
+	// Safe field: Types
+	return x.String()
+}
+
+// Redact method implementation for TaskTypeInfo
+func (x *TaskTypeInfo) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TypeName
+
+	// Safe field: Description
+
+	// Safe field: PayloadFields
+	return x.String()
+}
+
+// Redact method implementation for RunTaskRequest
+func (x *RunTaskRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: TaskPayload
+	return x.String()
+}
+
+// Redact method implementation for RunTaskResponse
+func (x *RunTaskResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RunId
+	return x.String()
+}
+
+// Redact method implementation for ListTaskRunResponse
+func (x *ListTaskRunResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for ListTaskRunByTaskRequest
+func (x *ListTaskRunByTaskRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TaskId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for GetTaskRunRequest
+func (x *GetTaskRunRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for RestoreBackupRequest
+func (x *RestoreBackupRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FileId
+
+	// Safe field: DryRun
+	return x.String()
+}
+
+// Redact method implementation for RestoreBackupResponse
+func (x *RestoreBackupResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Version
+
+	// Safe field: Driver
+
+	// Safe field: BackupAt
+
+	// Safe field: TableCount
+
+	// Safe field: RowCount
+
+	// Safe field: Restored
+	return x.String()
+}
+
+// Redact method implementation for TaskTypeInfo_PayloadField
+func (x *TaskTypeInfo_PayloadField) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Type
+
+	// Safe field: Required
+
+	// Safe field: Description
 	return x.String()
 }
@@ -426,6 +426,43 @@ func (m *Task) validate(all bool) error {
 		// no validation rules for Remark
 	}
 
+	if m.LastRun != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastRun()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  "LastRun",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  "LastRun",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -614,6 +651,300 @@ var _ interface {
 	ErrorName() string
 } = TaskValidationError{}
 
+// Validate checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskRun) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskRun with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskRunMultiError, or nil if none found.
+func (m *TaskRun) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskRun) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if m.TaskId != nil {
+		// no validation rules for TaskId
+	}
+
+	if m.TypeName != nil {
+		// no validation rules for TypeName
+	}
+
+	if m.RunId != nil {
+		// no validation rules for RunId
+	}
+
+	if m.Queue != nil {
+		// no validation rules for Queue
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.Trigger != nil {
+		// no validation rules for Trigger
+	}
+
+	if m.StartedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "StartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.FinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "FinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "FinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DurationMs != nil {
+		// no validation rules for DurationMs
+	}
+
+	if m.RetryCount != nil {
+		// no validation rules for RetryCount
+	}
+
+	if m.MaxRetry != nil {
+		// no validation rules for MaxRetry
+	}
+
+	if m.Progress != nil {
+		// no validation rules for Progress
+	}
+
+	if m.ProgressMessage != nil {
+		// no validation rules for ProgressMessage
+	}
+
+	if m.ErrorMessage != nil {
+		// no validation rules for ErrorMessage
+	}
+
+	if m.Result != nil {
+		// no validation rules for Result
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.CreatedBy != nil {
+		// no validation rules for CreatedBy
+	}
+
+	if m.CreatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCreatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "CreatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskRunValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskRunValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TaskRunMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskRunMultiError is an error wrapping multiple validation errors returned
+// by TaskRun.ValidateAll() if the designated constraints aren't met.
+type TaskRunMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskRunMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskRunMultiError) AllErrors() []error { return m }
+
+// TaskRunValidationError is the validation error returned by TaskRun.Validate
+// if the designated constraints aren't met.
+type TaskRunValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskRunValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskRunValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskRunValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskRunValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskRunValidationError) ErrorName() string { return "TaskRunValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskRunValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskRun.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskRunValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskRunValidationError{}
+
 // Validate checks the field values on ListTaskResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1443,9 +1774,13 @@ func (m *ControlTaskRequest) validate(all bool) error {
 
 	// no validation rules for TypeName
 
-	if len(errors) > 0 {
-		return ControlTaskRequestMultiError(errors)
-	}
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if len(errors) > 0 {
+		return ControlTaskRequestMultiError(errors)
+	}
 
 	return nil
 }
@@ -1545,6 +1880,40 @@ func (m *ListTaskTypeNameResponse) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskTypeNameResponseValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskTypeNameResponseValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskTypeNameResponseValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListTaskTypeNameResponseMultiError(errors)
 	}
@@ -1624,3 +1993,1061 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListTaskTypeNameResponseValidationError{}
+
+// Validate checks the field values on TaskTypeInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TaskTypeInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskTypeInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TaskTypeInfoMultiError, or
+// nil if none found.
+func (m *TaskTypeInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskTypeInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TypeName
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetPayloadFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskTypeInfoValidationError{
+						field:  fmt.Sprintf("PayloadFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskTypeInfoValidationError{
+						field:  fmt.Sprintf("PayloadFields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskTypeInfoValidationError{
+					field:  fmt.Sprintf("PayloadFields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TaskTypeInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskTypeInfoMultiError is an error wrapping multiple validation errors
+// returned by TaskTypeInfo.ValidateAll() if the designated constraints aren't met.
+type TaskTypeInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskTypeInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskTypeInfoMultiError) AllErrors() []error { return m }
+
+// TaskTypeInfoValidationError is the validation error returned by
+// TaskTypeInfo.Validate if the designated constraints aren't met.
+type TaskTypeInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskTypeInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskTypeInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskTypeInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskTypeInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskTypeInfoValidationError) ErrorName() string { return "TaskTypeInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskTypeInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskTypeInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskTypeInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskTypeInfoValidationError{}
+
+// Validate checks the field values on RunTaskRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RunTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunTaskRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RunTaskRequestMultiError,
+// or nil if none found.
+func (m *RunTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.TaskPayload != nil {
+		// no validation rules for TaskPayload
+	}
+
+	if len(errors) > 0 {
+		return RunTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunTaskRequestMultiError is an error wrapping multiple validation errors
+// returned by RunTaskRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RunTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunTaskRequestMultiError) AllErrors() []error { return m }
+
+// RunTaskRequestValidationError is the validation error returned by
+// RunTaskRequest.Validate if the designated constraints aren't met.
+type RunTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunTaskRequestValidationError) ErrorName() string { return "RunTaskRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunTaskRequestValidationError{}
+
+// Validate checks the field values on RunTaskResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RunTaskResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RunTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RunTaskResponseMultiError, or nil if none found.
+func (m *RunTaskResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RunTaskResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RunId
+
+	if len(errors) > 0 {
+		return RunTaskResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RunTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by RunTaskResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RunTaskResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RunTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RunTaskResponseMultiError) AllErrors() []error { return m }
+
+// RunTaskResponseValidationError is the validation error returned by
+// RunTaskResponse.Validate if the designated constraints aren't met.
+type RunTaskResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunTaskResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunTaskResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunTaskResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunTaskResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunTaskResponseValidationError) ErrorName() string { return "RunTaskResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RunTaskResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunTaskResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunTaskResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunTaskResponseValidationError{}
+
+// Validate checks the field values on ListTaskRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskRunResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskRunResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskRunResponseMultiError, or nil if none found.
+func (m *ListTaskRunResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskRunResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTaskRunResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTaskRunResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTaskRunResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListTaskRunResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskRunResponseMultiError is an error wrapping multiple validation
+// errors returned by ListTaskRunResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskRunResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskRunResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskRunResponseMultiError) AllErrors() []error { return m }
+
+// ListTaskRunResponseValidationError is the validation error returned by
+// ListTaskRunResponse.Validate if the designated constraints aren't met.
+type ListTaskRunResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskRunResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskRunResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskRunResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskRunResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskRunResponseValidationError) ErrorName() string {
+	return "ListTaskRunResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskRunResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskRunResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskRunResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskRunResponseValidationError{}
+
+// Validate checks the field values on ListTaskRunByTaskRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTaskRunByTaskRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTaskRunByTaskRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTaskRunByTaskRequestMultiError, or nil if none found.
+func (m *ListTaskRunByTaskRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTaskRunByTaskRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TaskId
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListTaskRunByTaskRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTaskRunByTaskRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTaskRunByTaskRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTaskRunByTaskRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTaskRunByTaskRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTaskRunByTaskRequestMultiError) AllErrors() []error { return m }
+
+// ListTaskRunByTaskRequestValidationError is the validation error returned by
+// ListTaskRunByTaskRequest.Validate if the designated constraints aren't met.
+type ListTaskRunByTaskRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTaskRunByTaskRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTaskRunByTaskRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTaskRunByTaskRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTaskRunByTaskRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTaskRunByTaskRequestValidationError) ErrorName() string {
+	return "ListTaskRunByTaskRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTaskRunByTaskRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTaskRunByTaskRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTaskRunByTaskRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTaskRunByTaskRequestValidationError{}
+
+// Validate checks the field values on GetTaskRunRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetTaskRunRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTaskRunRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTaskRunRequestMultiError, or nil if none found.
+func (m *GetTaskRunRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTaskRunRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return GetTaskRunRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTaskRunRequestMultiError is an error wrapping multiple validation errors
+// returned by GetTaskRunRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetTaskRunRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTaskRunRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTaskRunRequestMultiError) AllErrors() []error { return m }
+
+// GetTaskRunRequestValidationError is the validation error returned by
+// GetTaskRunRequest.Validate if the designated constraints aren't met.
+type GetTaskRunRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTaskRunRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTaskRunRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTaskRunRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTaskRunRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTaskRunRequestValidationError) ErrorName() string {
+	return "GetTaskRunRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTaskRunRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTaskRunRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTaskRunRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTaskRunRequestValidationError{}
+
+// Validate checks the field values on RestoreBackupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreBackupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreBackupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreBackupRequestMultiError, or nil if none found.
+func (m *RestoreBackupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreBackupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	if m.DryRun != nil {
+		// no validation rules for DryRun
+	}
+
+	if len(errors) > 0 {
+		return RestoreBackupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreBackupRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreBackupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreBackupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreBackupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreBackupRequestMultiError) AllErrors() []error { return m }
+
+// RestoreBackupRequestValidationError is the validation error returned by
+// RestoreBackupRequest.Validate if the designated constraints aren't met.
+type RestoreBackupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreBackupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreBackupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreBackupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreBackupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreBackupRequestValidationError) ErrorName() string {
+	return "RestoreBackupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreBackupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreBackupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreBackupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreBackupRequestValidationError{}
+
+// Validate checks the field values on RestoreBackupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreBackupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreBackupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreBackupResponseMultiError, or nil if none found.
+func (m *RestoreBackupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreBackupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Driver
+
+	if all {
+		switch v := interface{}(m.GetBackupAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RestoreBackupResponseValidationError{
+					field:  "BackupAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RestoreBackupResponseValidationError{
+					field:  "BackupAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBackupAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RestoreBackupResponseValidationError{
+				field:  "BackupAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TableCount
+
+	// no validation rules for RowCount
+
+	// no validation rules for Restored
+
+	if len(errors) > 0 {
+		return RestoreBackupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreBackupResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreBackupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreBackupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreBackupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreBackupResponseMultiError) AllErrors() []error { return m }
+
+// RestoreBackupResponseValidationError is the validation error returned by
+// RestoreBackupResponse.Validate if the designated constraints aren't met.
+type RestoreBackupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreBackupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreBackupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreBackupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreBackupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreBackupResponseValidationError) ErrorName() string {
+	return "RestoreBackupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreBackupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreBackupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreBackupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreBackupResponseValidationError{}
+
+// Validate checks the field values on TaskTypeInfo_PayloadField with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TaskTypeInfo_PayloadField) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TaskTypeInfo_PayloadField with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TaskTypeInfo_PayloadFieldMultiError, or nil if none found.
+func (m *TaskTypeInfo_PayloadField) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TaskTypeInfo_PayloadField) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Required
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return TaskTypeInfo_PayloadFieldMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskTypeInfo_PayloadFieldMultiError is an error wrapping multiple validation
+// errors returned by TaskTypeInfo_PayloadField.ValidateAll() if the
+// designated constraints aren't met.
+type TaskTypeInfo_PayloadFieldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskTypeInfo_PayloadFieldMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskTypeInfo_PayloadFieldMultiError) AllErrors() []error { return m }
+
+// TaskTypeInfo_PayloadFieldValidationError is the validation error returned by
+// TaskTypeInfo_PayloadField.Validate if the designated constraints aren't met.
+type TaskTypeInfo_PayloadFieldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskTypeInfo_PayloadFieldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskTypeInfo_PayloadFieldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskTypeInfo_PayloadFieldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskTypeInfo_PayloadFieldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskTypeInfo_PayloadFieldValidationError) ErrorName() string {
+	return "TaskTypeInfo_PayloadFieldValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TaskTypeInfo_PayloadFieldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTaskTypeInfo_PayloadField.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskTypeInfo_PayloadFieldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskTypeInfo_PayloadFieldValidationError{}
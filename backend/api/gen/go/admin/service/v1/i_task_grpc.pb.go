@@ -21,16 +21,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_List_FullMethodName             = "/admin.service.v1.TaskService/List"
-	TaskService_Get_FullMethodName              = "/admin.service.v1.TaskService/Get"
-	TaskService_Create_FullMethodName           = "/admin.service.v1.TaskService/Create"
-	TaskService_Update_FullMethodName           = "/admin.service.v1.TaskService/Update"
-	TaskService_Delete_FullMethodName           = "/admin.service.v1.TaskService/Delete"
-	TaskService_ListTaskTypeName_FullMethodName = "/admin.service.v1.TaskService/ListTaskTypeName"
-	TaskService_RestartAllTask_FullMethodName   = "/admin.service.v1.TaskService/RestartAllTask"
-	TaskService_StartAllTask_FullMethodName     = "/admin.service.v1.TaskService/StartAllTask"
-	TaskService_StopAllTask_FullMethodName      = "/admin.service.v1.TaskService/StopAllTask"
-	TaskService_ControlTask_FullMethodName      = "/admin.service.v1.TaskService/ControlTask"
+	TaskService_List_FullMethodName              = "/admin.service.v1.TaskService/List"
+	TaskService_Get_FullMethodName               = "/admin.service.v1.TaskService/Get"
+	TaskService_Create_FullMethodName            = "/admin.service.v1.TaskService/Create"
+	TaskService_Update_FullMethodName            = "/admin.service.v1.TaskService/Update"
+	TaskService_Delete_FullMethodName            = "/admin.service.v1.TaskService/Delete"
+	TaskService_ListTaskTypeName_FullMethodName  = "/admin.service.v1.TaskService/ListTaskTypeName"
+	TaskService_RestartAllTask_FullMethodName    = "/admin.service.v1.TaskService/RestartAllTask"
+	TaskService_StartAllTask_FullMethodName      = "/admin.service.v1.TaskService/StartAllTask"
+	TaskService_StopAllTask_FullMethodName       = "/admin.service.v1.TaskService/StopAllTask"
+	TaskService_ControlTask_FullMethodName       = "/admin.service.v1.TaskService/ControlTask"
+	TaskService_RunTask_FullMethodName           = "/admin.service.v1.TaskService/RunTask"
+	TaskService_ListTaskRun_FullMethodName       = "/admin.service.v1.TaskService/ListTaskRun"
+	TaskService_ListTaskRunByTask_FullMethodName = "/admin.service.v1.TaskService/ListTaskRunByTask"
+	TaskService_GetTaskRun_FullMethodName        = "/admin.service.v1.TaskService/GetTaskRun"
+	TaskService_RestoreBackup_FullMethodName     = "/admin.service.v1.TaskService/RestoreBackup"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -59,6 +64,16 @@ type TaskServiceClient interface {
 	StopAllTask(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(ctx context.Context, in *ControlTaskRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 立即执行调度任务
+	RunTask(ctx context.Context, in *RunTaskRequest, opts ...grpc.CallOption) (*RunTaskResponse, error)
+	// 查询任务执行记录列表
+	ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error)
+	// 查询某个调度任务的执行记录列表
+	ListTaskRunByTask(ctx context.Context, in *ListTaskRunByTaskRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, in *GetTaskRunRequest, opts ...grpc.CallOption) (*TaskRun, error)
+	// 从备份文件恢复数据库
+	RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error)
 }
 
 type taskServiceClient struct {
@@ -169,6 +184,56 @@ func (c *taskServiceClient) ControlTask(ctx context.Context, in *ControlTaskRequ
 	return out, nil
 }
 
+func (c *taskServiceClient) RunTask(ctx context.Context, in *RunTaskRequest, opts ...grpc.CallOption) (*RunTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_RunTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRunResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskRunByTask(ctx context.Context, in *ListTaskRunByTaskRequest, opts ...grpc.CallOption) (*ListTaskRunResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTaskRunResponse)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskRunByTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskRun(ctx context.Context, in *GetTaskRunRequest, opts ...grpc.CallOption) (*TaskRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskRun)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...grpc.CallOption) (*RestoreBackupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBackupResponse)
+	err := c.cc.Invoke(ctx, TaskService_RestoreBackup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
@@ -195,6 +260,16 @@ type TaskServiceServer interface {
 	StopAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// 控制调度任务
 	ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error)
+	// 立即执行调度任务
+	RunTask(context.Context, *RunTaskRequest) (*RunTaskResponse, error)
+	// 查询任务执行记录列表
+	ListTaskRun(context.Context, *v1.PagingRequest) (*ListTaskRunResponse, error)
+	// 查询某个调度任务的执行记录列表
+	ListTaskRunByTask(context.Context, *ListTaskRunByTaskRequest) (*ListTaskRunResponse, error)
+	// 查询任务执行记录详情
+	GetTaskRun(context.Context, *GetTaskRunRequest) (*TaskRun, error)
+	// 从备份文件恢复数据库
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -235,6 +310,21 @@ func (UnimplementedTaskServiceServer) StopAllTask(context.Context, *emptypb.Empt
 func (UnimplementedTaskServiceServer) ControlTask(context.Context, *ControlTaskRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControlTask not implemented")
 }
+func (UnimplementedTaskServiceServer) RunTask(context.Context, *RunTaskRequest) (*RunTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskRun(context.Context, *v1.PagingRequest) (*ListTaskRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRun not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskRunByTask(context.Context, *ListTaskRunByTaskRequest) (*ListTaskRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskRunByTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskRun(context.Context, *GetTaskRunRequest) (*TaskRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskRun not implemented")
+}
+func (UnimplementedTaskServiceServer) RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBackup not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -436,6 +526,96 @@ func _TaskService_ControlTask_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_RunTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RunTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RunTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RunTask(ctx, req.(*RunTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.PagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskRun(ctx, req.(*v1.PagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskRunByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaskRunByTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskRunByTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskRunByTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskRunByTask(ctx, req.(*ListTaskRunByTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskRun(ctx, req.(*GetTaskRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RestoreBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RestoreBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreBackup(ctx, req.(*RestoreBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -483,6 +663,26 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ControlTask",
 			Handler:    _TaskService_ControlTask_Handler,
 		},
+		{
+			MethodName: "RunTask",
+			Handler:    _TaskService_RunTask_Handler,
+		},
+		{
+			MethodName: "ListTaskRun",
+			Handler:    _TaskService_ListTaskRun_Handler,
+		},
+		{
+			MethodName: "ListTaskRunByTask",
+			Handler:    _TaskService_ListTaskRunByTask_Handler,
+		},
+		{
+			MethodName: "GetTaskRun",
+			Handler:    _TaskService_GetTaskRun_Handler,
+		},
+		{
+			MethodName: "RestoreBackup",
+			Handler:    _TaskService_RestoreBackup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_task.proto",
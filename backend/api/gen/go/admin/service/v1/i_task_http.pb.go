@@ -25,9 +25,14 @@ const OperationTaskServiceControlTask = "/admin.service.v1.TaskService/ControlTa
 const OperationTaskServiceCreate = "/admin.service.v1.TaskService/Create"
 const OperationTaskServiceDelete = "/admin.service.v1.TaskService/Delete"
 const OperationTaskServiceGet = "/admin.service.v1.TaskService/Get"
+const OperationTaskServiceGetTaskRun = "/admin.service.v1.TaskService/GetTaskRun"
 const OperationTaskServiceList = "/admin.service.v1.TaskService/List"
+const OperationTaskServiceListTaskRun = "/admin.service.v1.TaskService/ListTaskRun"
+const OperationTaskServiceListTaskRunByTask = "/admin.service.v1.TaskService/ListTaskRunByTask"
 const OperationTaskServiceListTaskTypeName = "/admin.service.v1.TaskService/ListTaskTypeName"
 const OperationTaskServiceRestartAllTask = "/admin.service.v1.TaskService/RestartAllTask"
+const OperationTaskServiceRestoreBackup = "/admin.service.v1.TaskService/RestoreBackup"
+const OperationTaskServiceRunTask = "/admin.service.v1.TaskService/RunTask"
 const OperationTaskServiceStartAllTask = "/admin.service.v1.TaskService/StartAllTask"
 const OperationTaskServiceStopAllTask = "/admin.service.v1.TaskService/StopAllTask"
 const OperationTaskServiceUpdate = "/admin.service.v1.TaskService/Update"
@@ -41,12 +46,22 @@ type TaskServiceHTTPServer interface {
 	Delete(context.Context, *DeleteTaskRequest) (*emptypb.Empty, error)
 	// Get 查询调度任务详情
 	Get(context.Context, *GetTaskRequest) (*Task, error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(context.Context, *GetTaskRunRequest) (*TaskRun, error)
 	// List 查询调度任务列表
 	List(context.Context, *v1.PagingRequest) (*ListTaskResponse, error)
+	// ListTaskRun 查询任务执行记录列表
+	ListTaskRun(context.Context, *v1.PagingRequest) (*ListTaskRunResponse, error)
+	// ListTaskRunByTask 查询某个调度任务的执行记录列表
+	ListTaskRunByTask(context.Context, *ListTaskRunByTaskRequest) (*ListTaskRunResponse, error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(context.Context, *emptypb.Empty) (*ListTaskTypeNameResponse, error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(context.Context, *emptypb.Empty) (*RestartAllTaskResponse, error)
+	// RestoreBackup 从备份文件恢复数据库
+	RestoreBackup(context.Context, *RestoreBackupRequest) (*RestoreBackupResponse, error)
+	// RunTask 立即执行调度任务
+	RunTask(context.Context, *RunTaskRequest) (*RunTaskResponse, error)
 	// StartAllTask 启动所有的调度任务
 	StartAllTask(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	// StopAllTask 停止所有的调度任务
@@ -57,20 +72,25 @@ type TaskServiceHTTPServer interface {
 
 func RegisterTaskServiceHTTPServer(s *http.Server, srv TaskServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/tasks", _TaskService_List11_HTTP_Handler(srv))
-	r.GET("/admin/v1/tasks/type-name/{type_name}", _TaskService_Get11_HTTP_Handler(srv))
-	r.GET("/admin/v1/tasks/{id}", _TaskService_Get12_HTTP_Handler(srv))
-	r.POST("/admin/v1/tasks", _TaskService_Create9_HTTP_Handler(srv))
-	r.PUT("/admin/v1/tasks/{id}", _TaskService_Update9_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/tasks/{id}", _TaskService_Delete9_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks", _TaskService_List13_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks/type-name/{type_name}", _TaskService_Get13_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks/{id}", _TaskService_Get14_HTTP_Handler(srv))
+	r.POST("/admin/v1/tasks", _TaskService_Create11_HTTP_Handler(srv))
+	r.PUT("/admin/v1/tasks/{id}", _TaskService_Update11_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/tasks/{id}", _TaskService_Delete10_HTTP_Handler(srv))
 	r.GET("/admin/v1/tasks:type-names", _TaskService_ListTaskTypeName0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:restart", _TaskService_RestartAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:start", _TaskService_StartAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:stop", _TaskService_StopAllTask0_HTTP_Handler(srv))
 	r.POST("/admin/v1/tasks:control", _TaskService_ControlTask0_HTTP_Handler(srv))
+	r.POST("/admin/v1/tasks/{id}:run", _TaskService_RunTask0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs", _TaskService_ListTaskRun0_HTTP_Handler(srv))
+	r.GET("/admin/v1/tasks/{task_id}/runs", _TaskService_ListTaskRunByTask0_HTTP_Handler(srv))
+	r.GET("/admin/v1/task-runs/{id}", _TaskService_GetTaskRun0_HTTP_Handler(srv))
+	r.POST("/admin/v1/backups:restore", _TaskService_RestoreBackup0_HTTP_Handler(srv))
 }
 
-func _TaskService_List11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_List13_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -89,7 +109,7 @@ func _TaskService_List11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.C
 	}
 }
 
-func _TaskService_Get11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Get13_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -111,7 +131,7 @@ func _TaskService_Get11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _TaskService_Get12_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Get14_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -133,7 +153,7 @@ func _TaskService_Get12_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _TaskService_Create9_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Create11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in CreateTaskRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -155,7 +175,7 @@ func _TaskService_Create9_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.
 	}
 }
 
-func _TaskService_Update9_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Update11_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in UpdateTaskRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -180,7 +200,7 @@ func _TaskService_Update9_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.
 	}
 }
 
-func _TaskService_Delete9_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+func _TaskService_Delete10_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteTaskRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -309,6 +329,116 @@ func _TaskService_ControlTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx
 	}
 }
 
+func _TaskService_RunTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RunTaskRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceRunTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RunTask(ctx, req.(*RunTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RunTaskResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_ListTaskRun0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.PagingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListTaskRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskRun(ctx, req.(*v1.PagingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTaskRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_ListTaskRunByTask0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTaskRunByTaskRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceListTaskRunByTask)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTaskRunByTask(ctx, req.(*ListTaskRunByTaskRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTaskRunResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_GetTaskRun0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTaskRunRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceGetTaskRun)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTaskRun(ctx, req.(*GetTaskRunRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TaskRun)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TaskService_RestoreBackup0_HTTP_Handler(srv TaskServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreBackupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTaskServiceRestoreBackup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreBackup(ctx, req.(*RestoreBackupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RestoreBackupResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TaskServiceHTTPClient interface {
 	// ControlTask 控制调度任务
 	ControlTask(ctx context.Context, req *ControlTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -318,12 +448,22 @@ type TaskServiceHTTPClient interface {
 	Delete(ctx context.Context, req *DeleteTaskRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Get 查询调度任务详情
 	Get(ctx context.Context, req *GetTaskRequest, opts ...http.CallOption) (rsp *Task, err error)
+	// GetTaskRun 查询任务执行记录详情
+	GetTaskRun(ctx context.Context, req *GetTaskRunRequest, opts ...http.CallOption) (rsp *TaskRun, err error)
 	// List 查询调度任务列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *ListTaskResponse, err error)
+	// ListTaskRun 查询任务执行记录列表
+	ListTaskRun(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *ListTaskRunResponse, err error)
+	// ListTaskRunByTask 查询某个调度任务的执行记录列表
+	ListTaskRunByTask(ctx context.Context, req *ListTaskRunByTaskRequest, opts ...http.CallOption) (rsp *ListTaskRunResponse, err error)
 	// ListTaskTypeName 任务类型名称列表
 	ListTaskTypeName(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListTaskTypeNameResponse, err error)
 	// RestartAllTask 重启所有的调度任务
 	RestartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *RestartAllTaskResponse, err error)
+	// RestoreBackup 从备份文件恢复数据库
+	RestoreBackup(ctx context.Context, req *RestoreBackupRequest, opts ...http.CallOption) (rsp *RestoreBackupResponse, err error)
+	// RunTask 立即执行调度任务
+	RunTask(ctx context.Context, req *RunTaskRequest, opts ...http.CallOption) (rsp *RunTaskResponse, err error)
 	// StartAllTask 启动所有的调度任务
 	StartAllTask(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// StopAllTask 停止所有的调度任务
@@ -396,6 +536,20 @@ func (c *TaskServiceHTTPClientImpl) Get(ctx context.Context, in *GetTaskRequest,
 	return &out, nil
 }
 
+// GetTaskRun 查询任务执行记录详情
+func (c *TaskServiceHTTPClientImpl) GetTaskRun(ctx context.Context, in *GetTaskRunRequest, opts ...http.CallOption) (*TaskRun, error) {
+	var out TaskRun
+	pattern := "/admin/v1/task-runs/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceGetTaskRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // List 查询调度任务列表
 func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*ListTaskResponse, error) {
 	var out ListTaskResponse
@@ -410,6 +564,34 @@ func (c *TaskServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReque
 	return &out, nil
 }
 
+// ListTaskRun 查询任务执行记录列表
+func (c *TaskServiceHTTPClientImpl) ListTaskRun(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*ListTaskRunResponse, error) {
+	var out ListTaskRunResponse
+	pattern := "/admin/v1/task-runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListTaskRun))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaskRunByTask 查询某个调度任务的执行记录列表
+func (c *TaskServiceHTTPClientImpl) ListTaskRunByTask(ctx context.Context, in *ListTaskRunByTaskRequest, opts ...http.CallOption) (*ListTaskRunResponse, error) {
+	var out ListTaskRunResponse
+	pattern := "/admin/v1/tasks/{task_id}/runs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTaskServiceListTaskRunByTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListTaskTypeName 任务类型名称列表
 func (c *TaskServiceHTTPClientImpl) ListTaskTypeName(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListTaskTypeNameResponse, error) {
 	var out ListTaskTypeNameResponse
@@ -438,6 +620,34 @@ func (c *TaskServiceHTTPClientImpl) RestartAllTask(ctx context.Context, in *empt
 	return &out, nil
 }
 
+// RestoreBackup 从备份文件恢复数据库
+func (c *TaskServiceHTTPClientImpl) RestoreBackup(ctx context.Context, in *RestoreBackupRequest, opts ...http.CallOption) (*RestoreBackupResponse, error) {
+	var out RestoreBackupResponse
+	pattern := "/admin/v1/backups:restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTaskServiceRestoreBackup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunTask 立即执行调度任务
+func (c *TaskServiceHTTPClientImpl) RunTask(ctx context.Context, in *RunTaskRequest, opts ...http.CallOption) (*RunTaskResponse, error) {
+	var out RunTaskResponse
+	pattern := "/admin/v1/tasks/{id}:run"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTaskServiceRunTask))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // StartAllTask 启动所有的调度任务
 func (c *TaskServiceHTTPClientImpl) StartAllTask(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
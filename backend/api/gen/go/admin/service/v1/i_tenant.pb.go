@@ -26,12 +26,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 下线方式
+type OffboardTenantRequest_Mode int32
+
+const (
+	OffboardTenantRequest_ARCHIVE OffboardTenantRequest_Mode = 0 // 归档：停用租户并使租户用户下线，保留全部数据，可重新启用
+	OffboardTenantRequest_PURGE   OffboardTenantRequest_Mode = 1 // 清除：删除租户及其全部数据，不可恢复
+)
+
+// Enum value maps for OffboardTenantRequest_Mode.
+var (
+	OffboardTenantRequest_Mode_name = map[int32]string{
+		0: "ARCHIVE",
+		1: "PURGE",
+	}
+	OffboardTenantRequest_Mode_value = map[string]int32{
+		"ARCHIVE": 0,
+		"PURGE":   1,
+	}
+)
+
+func (x OffboardTenantRequest_Mode) Enum() *OffboardTenantRequest_Mode {
+	p := new(OffboardTenantRequest_Mode)
+	*p = x
+	return p
+}
+
+func (x OffboardTenantRequest_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OffboardTenantRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_admin_service_v1_i_tenant_proto_enumTypes[0].Descriptor()
+}
+
+func (OffboardTenantRequest_Mode) Type() protoreflect.EnumType {
+	return &file_admin_service_v1_i_tenant_proto_enumTypes[0]
+}
+
+func (x OffboardTenantRequest_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OffboardTenantRequest_Mode.Descriptor instead.
+func (OffboardTenantRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{3, 0}
+}
+
 // 创建租户及管理员用户 - 请求
 type CreateTenantWithAdminUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tenant        *v1.Tenant             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	User          *v1.User               `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Template      *string                `protobuf:"bytes,4,opt,name=template,proto3,oneof" json:"template,omitempty"` // 租户模板名称
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -87,15 +135,502 @@ func (x *CreateTenantWithAdminUserRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateTenantWithAdminUserRequest) GetTemplate() string {
+	if x != nil && x.Template != nil {
+		return *x.Template
+	}
+	return ""
+}
+
+// 租户模板
+type TenantTemplate struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                    // 模板名称
+	Description       *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`                                // 模板描述
+	IsDefault         bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`                        // 是否为默认模板
+	Roles             []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`                                                  // 复制的平台角色编码
+	AdminRoles        []string               `protobuf:"bytes,5,rep,name=admin_roles,json=adminRoles,proto3" json:"admin_roles,omitempty"`                      // 分配给租户管理员的角色编码
+	DictTypes         []string               `protobuf:"bytes,6,rep,name=dict_types,json=dictTypes,proto3" json:"dict_types,omitempty"`                         // 复制的平台字典类型编码
+	MessageCategories []string               `protobuf:"bytes,7,rep,name=message_categories,json=messageCategories,proto3" json:"message_categories,omitempty"` // 复制的平台站内信分类编码
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TenantTemplate) Reset() {
+	*x = TenantTemplate{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantTemplate) ProtoMessage() {}
+
+func (x *TenantTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantTemplate.ProtoReflect.Descriptor instead.
+func (*TenantTemplate) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TenantTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TenantTemplate) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TenantTemplate) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *TenantTemplate) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *TenantTemplate) GetAdminRoles() []string {
+	if x != nil {
+		return x.AdminRoles
+	}
+	return nil
+}
+
+func (x *TenantTemplate) GetDictTypes() []string {
+	if x != nil {
+		return x.DictTypes
+	}
+	return nil
+}
+
+func (x *TenantTemplate) GetMessageCategories() []string {
+	if x != nil {
+		return x.MessageCategories
+	}
+	return nil
+}
+
+// 获取租户模板列表 - 回应
+type ListTenantTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*TenantTemplate      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantTemplatesResponse) Reset() {
+	*x = ListTenantTemplatesResponse{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantTemplatesResponse) ProtoMessage() {}
+
+func (x *ListTenantTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListTenantTemplatesResponse) GetItems() []*TenantTemplate {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// 租户下线 - 请求
+type OffboardTenantRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Id            uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                      // 租户ID
+	Mode          OffboardTenantRequest_Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=admin.service.v1.OffboardTenantRequest_Mode" json:"mode,omitempty"` // 下线方式
+	ConfirmCode   string                     `protobuf:"bytes,3,opt,name=confirm_code,json=confirmCode,proto3" json:"confirm_code,omitempty"`                  // 确认的租户编码
+	Reason        *string                    `protobuf:"bytes,4,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                         // 下线原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardTenantRequest) Reset() {
+	*x = OffboardTenantRequest{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardTenantRequest) ProtoMessage() {}
+
+func (x *OffboardTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardTenantRequest.ProtoReflect.Descriptor instead.
+func (*OffboardTenantRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OffboardTenantRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OffboardTenantRequest) GetMode() OffboardTenantRequest_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return OffboardTenantRequest_ARCHIVE
+}
+
+func (x *OffboardTenantRequest) GetConfirmCode() string {
+	if x != nil {
+		return x.ConfirmCode
+	}
+	return ""
+}
+
+func (x *OffboardTenantRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// 租户下线 - 回应
+type OffboardTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Affected      map[string]uint32      `protobuf:"bytes,1,rep,name=affected,proto3" json:"affected,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // 各数据表受影响的行数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardTenantResponse) Reset() {
+	*x = OffboardTenantResponse{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardTenantResponse) ProtoMessage() {}
+
+func (x *OffboardTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardTenantResponse.ProtoReflect.Descriptor instead.
+func (*OffboardTenantResponse) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OffboardTenantResponse) GetAffected() map[string]uint32 {
+	if x != nil {
+		return x.Affected
+	}
+	return nil
+}
+
+// 获取租户用量 - 请求
+type GetTenantUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *uint32                `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"` // 租户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTenantUsageRequest) Reset() {
+	*x = GetTenantUsageRequest{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTenantUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTenantUsageRequest) ProtoMessage() {}
+
+func (x *GetTenantUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTenantUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetTenantUsageRequest) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTenantUsageRequest) GetId() uint32 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return 0
+}
+
+// 配额用量
+type QuotaUsage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Used          uint64                 `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`         // 已用量
+	Limit         uint64                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`       // 配额，0表示不限制
+	Exceeded      bool                   `protobuf:"varint,3,opt,name=exceeded,proto3" json:"exceeded,omitempty"` // 是否已用尽
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuotaUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetExceeded() bool {
+	if x != nil {
+		return x.Exceeded
+	}
+	return false
+}
+
+// 租户用量
+type TenantUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TenantId         uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`                          // 租户ID
+	Plan             *v1.TenantPlan         `protobuf:"bytes,2,opt,name=plan,proto3,oneof" json:"plan,omitempty"`                                             // 订阅套餐
+	Users            *QuotaUsage            `protobuf:"bytes,3,opt,name=users,proto3" json:"users,omitempty"`                                                 // 用户数
+	StorageBytes     *QuotaUsage            `protobuf:"bytes,4,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`               // 存储空间（字节）
+	ApiRequestsToday *QuotaUsage            `protobuf:"bytes,5,opt,name=api_requests_today,json=apiRequestsToday,proto3" json:"api_requests_today,omitempty"` // 今日API请求数
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TenantUsage) Reset() {
+	*x = TenantUsage{}
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TenantUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantUsage) ProtoMessage() {}
+
+func (x *TenantUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_service_v1_i_tenant_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantUsage.ProtoReflect.Descriptor instead.
+func (*TenantUsage) Descriptor() ([]byte, []int) {
+	return file_admin_service_v1_i_tenant_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TenantUsage) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *TenantUsage) GetPlan() *v1.TenantPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+func (x *TenantUsage) GetUsers() *QuotaUsage {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *TenantUsage) GetStorageBytes() *QuotaUsage {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return nil
+}
+
+func (x *TenantUsage) GetApiRequestsToday() *QuotaUsage {
+	if x != nil {
+		return x.ApiRequestsToday
+	}
+	return nil
+}
+
 var File_admin_service_v1_i_tenant_proto protoreflect.FileDescriptor
 
 const file_admin_service_v1_i_tenant_proto_rawDesc = "" +
 	"\n" +
-	"\x1fadmin/service/v1/i_tenant.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1cuser/service/v1/tenant.proto\x1a\x1auser/service/v1/user.proto\"\x9a\x01\n" +
+	"\x1fadmin/service/v1/i_tenant.proto\x12\x10admin.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1epagination/v1/pagination.proto\x1a\x1cuser/service/v1/tenant.proto\x1a!user/service/v1/tenant_plan.proto\x1a\x1auser/service/v1/user.proto\"\x80\x02\n" +
 	" CreateTenantWithAdminUserRequest\x12/\n" +
 	"\x06tenant\x18\x01 \x01(\v2\x17.user.service.v1.TenantR\x06tenant\x12)\n" +
 	"\x04user\x18\x02 \x01(\v2\x15.user.service.v1.UserR\x04user\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword2\xa0\x06\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12W\n" +
+	"\btemplate\x18\x04 \x01(\tB6\xbaG3\x92\x020租户模板名称，为空时使用默认模板H\x00R\btemplate\x88\x01\x01B\v\n" +
+	"\t_template\"\xeb\x03\n" +
+	"\x0eTenantTemplate\x12&\n" +
+	"\x04name\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f模板名称R\x04name\x129\n" +
+	"\vdescription\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f模板描述H\x00R\vdescription\x88\x01\x01\x12:\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bB\x1b\xbaG\x18\x92\x02\x15是否为默认模板R\tisDefault\x127\n" +
+	"\x05roles\x18\x04 \x03(\tB!\xbaG\x1e\x92\x02\x1b复制的平台角色编码R\x05roles\x12N\n" +
+	"\vadmin_roles\x18\x05 \x03(\tB-\xbaG*\x92\x02'分配给租户管理员的角色编码R\n" +
+	"adminRoles\x12F\n" +
+	"\n" +
+	"dict_types\x18\x06 \x03(\tB'\xbaG$\x92\x02!复制的平台字典类型编码R\tdictTypes\x12Y\n" +
+	"\x12message_categories\x18\a \x03(\tB*\xbaG'\x92\x02$复制的平台站内信分类编码R\x11messageCategoriesB\x0e\n" +
+	"\f_description\"U\n" +
+	"\x1bListTenantTemplatesResponse\x126\n" +
+	"\x05items\x18\x01 \x03(\v2 .admin.service.v1.TenantTemplateR\x05items\"\xc7\x02\n" +
+	"\x15OffboardTenantRequest\x12\x1e\n" +
+	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDR\x02id\x12T\n" +
+	"\x04mode\x18\x02 \x01(\x0e2,.admin.service.v1.OffboardTenantRequest.ModeB\x12\xbaG\x0f\x92\x02\f下线方式R\x04mode\x12\\\n" +
+	"\fconfirm_code\x18\x03 \x01(\tB9\xbaG6\x92\x023确认的租户编码，必须与租户编码一致R\vconfirmCode\x12/\n" +
+	"\x06reason\x18\x04 \x01(\tB\x12\xbaG\x0f\x92\x02\f下线原因H\x00R\x06reason\x88\x01\x01\"\x1e\n" +
+	"\x04Mode\x12\v\n" +
+	"\aARCHIVE\x10\x00\x12\t\n" +
+	"\x05PURGE\x10\x01B\t\n" +
+	"\a_reason\"\xcf\x01\n" +
+	"\x16OffboardTenantResponse\x12x\n" +
+	"\baffected\x18\x01 \x03(\v26.admin.service.v1.OffboardTenantResponse.AffectedEntryB$\xbaG!\x92\x02\x1e各数据表受影响的行数R\baffected\x1a;\n" +
+	"\rAffectedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\rR\x05value:\x028\x01\"a\n" +
+	"\x15GetTenantUsageRequest\x12A\n" +
+	"\x02id\x18\x01 \x01(\rB,\xbaG)\x92\x02&租户ID，租户用户查询时忽略H\x00R\x02id\x88\x01\x01B\x05\n" +
+	"\x03_id\"\x9b\x01\n" +
+	"\n" +
+	"QuotaUsage\x12#\n" +
+	"\x04used\x18\x01 \x01(\x04B\x0f\xbaG\f\x92\x02\t已用量R\x04used\x125\n" +
+	"\x05limit\x18\x02 \x01(\x04B\x1f\xbaG\x1c\x92\x02\x19配额，0表示不限制R\x05limit\x121\n" +
+	"\bexceeded\x18\x03 \x01(\bB\x15\xbaG\x12\x92\x02\x0f是否已用尽R\bexceeded\"\xce\x03\n" +
+	"\vTenantUsage\x12+\n" +
+	"\ttenant_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDR\btenantId\x12{\n" +
+	"\x04plan\x18\x02 \x01(\v2\x1b.user.service.v1.TenantPlanBE\xbaGB\x92\x02?订阅套餐，为空表示没有订阅套餐，不限制配额H\x00R\x04plan\x88\x01\x01\x12C\n" +
+	"\x05users\x18\x03 \x01(\v2\x1c.admin.service.v1.QuotaUsageB\x0f\xbaG\f\x92\x02\t用户数R\x05users\x12a\n" +
+	"\rstorage_bytes\x18\x04 \x01(\v2\x1c.admin.service.v1.QuotaUsageB\x1e\xbaG\x1b\x92\x02\x18存储空间（字节）R\fstorageBytes\x12d\n" +
+	"\x12api_requests_today\x18\x05 \x01(\v2\x1c.admin.service.v1.QuotaUsageB\x18\xbaG\x15\x92\x02\x12今日API请求数R\x10apiRequestsTodayB\a\n" +
+	"\x05_plan2\xaf\t\n" +
 	"\rTenantService\x12a\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a#.user.service.v1.ListTenantResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/admin/v1/tenants\x12a\n" +
 	"\x03Get\x12!.user.service.v1.GetTenantRequest\x1a\x17.user.service.v1.Tenant\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/admin/v1/tenants/{id}\x12d\n" +
@@ -103,7 +638,10 @@ const file_admin_service_v1_i_tenant_proto_rawDesc = "" +
 	"\x06Update\x12$.user.service.v1.UpdateTenantRequest\x1a\x16.google.protobuf.Empty\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/admin/v1/tenants/{id}\x12f\n" +
 	"\x06Delete\x12$.user.service.v1.DeleteTenantRequest\x1a\x16.google.protobuf.Empty\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/admin/v1/tenants/{id}\x12\x90\x01\n" +
 	"\x19CreateTenantWithAdminUser\x122.admin.service.v1.CreateTenantWithAdminUserRequest\x1a\x16.google.protobuf.Empty\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/admin/v1/tenants_with_admin\x12}\n" +
-	"\fTenantExists\x12$.user.service.v1.TenantExistsRequest\x1a%.user.service.v1.TenantExistsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/admin/v1/tenants_existsB\xbb\x01\n" +
+	"\fTenantExists\x12$.user.service.v1.TenantExistsRequest\x1a%.user.service.v1.TenantExistsResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/admin/v1/tenants_exists\x12\x80\x01\n" +
+	"\x13ListTenantTemplates\x12\x16.google.protobuf.Empty\x1a-.admin.service.v1.ListTenantTemplatesResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/admin/v1/tenant_templates\x12\x8f\x01\n" +
+	"\x0eOffboardTenant\x12'.admin.service.v1.OffboardTenantRequest\x1a(.admin.service.v1.OffboardTenantResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/admin/v1/tenants/{id}/offboard\x12x\n" +
+	"\x0eGetTenantUsage\x12'.admin.service.v1.GetTenantUsageRequest\x1a\x1d.admin.service.v1.TenantUsage\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/admin/v1/tenant_usageB\xbb\x01\n" +
 	"\x14com.admin.service.v1B\fITenantProtoP\x01Z3go-wind-admin/api/gen/go/admin/service/v1;servicev1\xa2\x02\x03ASX\xaa\x02\x10Admin.Service.V1\xca\x02\x10Admin\\Service\\V1\xe2\x02\x1cAdmin\\Service\\V1\\GPBMetadata\xea\x02\x12Admin::Service::V1b\x06proto3"
 
 var (
@@ -118,43 +656,67 @@ func file_admin_service_v1_i_tenant_proto_rawDescGZIP() []byte {
 	return file_admin_service_v1_i_tenant_proto_rawDescData
 }
 
-var file_admin_service_v1_i_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_admin_service_v1_i_tenant_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_admin_service_v1_i_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_admin_service_v1_i_tenant_proto_goTypes = []any{
-	(*CreateTenantWithAdminUserRequest)(nil), // 0: admin.service.v1.CreateTenantWithAdminUserRequest
-	(*v1.Tenant)(nil),                        // 1: user.service.v1.Tenant
-	(*v1.User)(nil),                          // 2: user.service.v1.User
-	(*v11.PagingRequest)(nil),                // 3: pagination.PagingRequest
-	(*v1.GetTenantRequest)(nil),              // 4: user.service.v1.GetTenantRequest
-	(*v1.CreateTenantRequest)(nil),           // 5: user.service.v1.CreateTenantRequest
-	(*v1.UpdateTenantRequest)(nil),           // 6: user.service.v1.UpdateTenantRequest
-	(*v1.DeleteTenantRequest)(nil),           // 7: user.service.v1.DeleteTenantRequest
-	(*v1.TenantExistsRequest)(nil),           // 8: user.service.v1.TenantExistsRequest
-	(*v1.ListTenantResponse)(nil),            // 9: user.service.v1.ListTenantResponse
-	(*emptypb.Empty)(nil),                    // 10: google.protobuf.Empty
-	(*v1.TenantExistsResponse)(nil),          // 11: user.service.v1.TenantExistsResponse
+	(OffboardTenantRequest_Mode)(0),          // 0: admin.service.v1.OffboardTenantRequest.Mode
+	(*CreateTenantWithAdminUserRequest)(nil), // 1: admin.service.v1.CreateTenantWithAdminUserRequest
+	(*TenantTemplate)(nil),                   // 2: admin.service.v1.TenantTemplate
+	(*ListTenantTemplatesResponse)(nil),      // 3: admin.service.v1.ListTenantTemplatesResponse
+	(*OffboardTenantRequest)(nil),            // 4: admin.service.v1.OffboardTenantRequest
+	(*OffboardTenantResponse)(nil),           // 5: admin.service.v1.OffboardTenantResponse
+	(*GetTenantUsageRequest)(nil),            // 6: admin.service.v1.GetTenantUsageRequest
+	(*QuotaUsage)(nil),                       // 7: admin.service.v1.QuotaUsage
+	(*TenantUsage)(nil),                      // 8: admin.service.v1.TenantUsage
+	nil,                                      // 9: admin.service.v1.OffboardTenantResponse.AffectedEntry
+	(*v1.Tenant)(nil),                        // 10: user.service.v1.Tenant
+	(*v1.User)(nil),                          // 11: user.service.v1.User
+	(*v1.TenantPlan)(nil),                    // 12: user.service.v1.TenantPlan
+	(*v11.PagingRequest)(nil),                // 13: pagination.PagingRequest
+	(*v1.GetTenantRequest)(nil),              // 14: user.service.v1.GetTenantRequest
+	(*v1.CreateTenantRequest)(nil),           // 15: user.service.v1.CreateTenantRequest
+	(*v1.UpdateTenantRequest)(nil),           // 16: user.service.v1.UpdateTenantRequest
+	(*v1.DeleteTenantRequest)(nil),           // 17: user.service.v1.DeleteTenantRequest
+	(*v1.TenantExistsRequest)(nil),           // 18: user.service.v1.TenantExistsRequest
+	(*emptypb.Empty)(nil),                    // 19: google.protobuf.Empty
+	(*v1.ListTenantResponse)(nil),            // 20: user.service.v1.ListTenantResponse
+	(*v1.TenantExistsResponse)(nil),          // 21: user.service.v1.TenantExistsResponse
 }
 var file_admin_service_v1_i_tenant_proto_depIdxs = []int32{
-	1,  // 0: admin.service.v1.CreateTenantWithAdminUserRequest.tenant:type_name -> user.service.v1.Tenant
-	2,  // 1: admin.service.v1.CreateTenantWithAdminUserRequest.user:type_name -> user.service.v1.User
-	3,  // 2: admin.service.v1.TenantService.List:input_type -> pagination.PagingRequest
-	4,  // 3: admin.service.v1.TenantService.Get:input_type -> user.service.v1.GetTenantRequest
-	5,  // 4: admin.service.v1.TenantService.Create:input_type -> user.service.v1.CreateTenantRequest
-	6,  // 5: admin.service.v1.TenantService.Update:input_type -> user.service.v1.UpdateTenantRequest
-	7,  // 6: admin.service.v1.TenantService.Delete:input_type -> user.service.v1.DeleteTenantRequest
-	0,  // 7: admin.service.v1.TenantService.CreateTenantWithAdminUser:input_type -> admin.service.v1.CreateTenantWithAdminUserRequest
-	8,  // 8: admin.service.v1.TenantService.TenantExists:input_type -> user.service.v1.TenantExistsRequest
-	9,  // 9: admin.service.v1.TenantService.List:output_type -> user.service.v1.ListTenantResponse
-	1,  // 10: admin.service.v1.TenantService.Get:output_type -> user.service.v1.Tenant
-	10, // 11: admin.service.v1.TenantService.Create:output_type -> google.protobuf.Empty
-	10, // 12: admin.service.v1.TenantService.Update:output_type -> google.protobuf.Empty
-	10, // 13: admin.service.v1.TenantService.Delete:output_type -> google.protobuf.Empty
-	10, // 14: admin.service.v1.TenantService.CreateTenantWithAdminUser:output_type -> google.protobuf.Empty
-	11, // 15: admin.service.v1.TenantService.TenantExists:output_type -> user.service.v1.TenantExistsResponse
-	9,  // [9:16] is the sub-list for method output_type
-	2,  // [2:9] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	10, // 0: admin.service.v1.CreateTenantWithAdminUserRequest.tenant:type_name -> user.service.v1.Tenant
+	11, // 1: admin.service.v1.CreateTenantWithAdminUserRequest.user:type_name -> user.service.v1.User
+	2,  // 2: admin.service.v1.ListTenantTemplatesResponse.items:type_name -> admin.service.v1.TenantTemplate
+	0,  // 3: admin.service.v1.OffboardTenantRequest.mode:type_name -> admin.service.v1.OffboardTenantRequest.Mode
+	9,  // 4: admin.service.v1.OffboardTenantResponse.affected:type_name -> admin.service.v1.OffboardTenantResponse.AffectedEntry
+	12, // 5: admin.service.v1.TenantUsage.plan:type_name -> user.service.v1.TenantPlan
+	7,  // 6: admin.service.v1.TenantUsage.users:type_name -> admin.service.v1.QuotaUsage
+	7,  // 7: admin.service.v1.TenantUsage.storage_bytes:type_name -> admin.service.v1.QuotaUsage
+	7,  // 8: admin.service.v1.TenantUsage.api_requests_today:type_name -> admin.service.v1.QuotaUsage
+	13, // 9: admin.service.v1.TenantService.List:input_type -> pagination.PagingRequest
+	14, // 10: admin.service.v1.TenantService.Get:input_type -> user.service.v1.GetTenantRequest
+	15, // 11: admin.service.v1.TenantService.Create:input_type -> user.service.v1.CreateTenantRequest
+	16, // 12: admin.service.v1.TenantService.Update:input_type -> user.service.v1.UpdateTenantRequest
+	17, // 13: admin.service.v1.TenantService.Delete:input_type -> user.service.v1.DeleteTenantRequest
+	1,  // 14: admin.service.v1.TenantService.CreateTenantWithAdminUser:input_type -> admin.service.v1.CreateTenantWithAdminUserRequest
+	18, // 15: admin.service.v1.TenantService.TenantExists:input_type -> user.service.v1.TenantExistsRequest
+	19, // 16: admin.service.v1.TenantService.ListTenantTemplates:input_type -> google.protobuf.Empty
+	4,  // 17: admin.service.v1.TenantService.OffboardTenant:input_type -> admin.service.v1.OffboardTenantRequest
+	6,  // 18: admin.service.v1.TenantService.GetTenantUsage:input_type -> admin.service.v1.GetTenantUsageRequest
+	20, // 19: admin.service.v1.TenantService.List:output_type -> user.service.v1.ListTenantResponse
+	10, // 20: admin.service.v1.TenantService.Get:output_type -> user.service.v1.Tenant
+	19, // 21: admin.service.v1.TenantService.Create:output_type -> google.protobuf.Empty
+	19, // 22: admin.service.v1.TenantService.Update:output_type -> google.protobuf.Empty
+	19, // 23: admin.service.v1.TenantService.Delete:output_type -> google.protobuf.Empty
+	19, // 24: admin.service.v1.TenantService.CreateTenantWithAdminUser:output_type -> google.protobuf.Empty
+	21, // 25: admin.service.v1.TenantService.TenantExists:output_type -> user.service.v1.TenantExistsResponse
+	3,  // 26: admin.service.v1.TenantService.ListTenantTemplates:output_type -> admin.service.v1.ListTenantTemplatesResponse
+	5,  // 27: admin.service.v1.TenantService.OffboardTenant:output_type -> admin.service.v1.OffboardTenantResponse
+	8,  // 28: admin.service.v1.TenantService.GetTenantUsage:output_type -> admin.service.v1.TenantUsage
+	19, // [19:29] is the sub-list for method output_type
+	9,  // [9:19] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_admin_service_v1_i_tenant_proto_init() }
@@ -162,18 +724,24 @@ func file_admin_service_v1_i_tenant_proto_init() {
 	if File_admin_service_v1_i_tenant_proto != nil {
 		return
 	}
+	file_admin_service_v1_i_tenant_proto_msgTypes[0].OneofWrappers = []any{}
+	file_admin_service_v1_i_tenant_proto_msgTypes[1].OneofWrappers = []any{}
+	file_admin_service_v1_i_tenant_proto_msgTypes[3].OneofWrappers = []any{}
+	file_admin_service_v1_i_tenant_proto_msgTypes[5].OneofWrappers = []any{}
+	file_admin_service_v1_i_tenant_proto_msgTypes[7].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_service_v1_i_tenant_proto_rawDesc), len(file_admin_service_v1_i_tenant_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_admin_service_v1_i_tenant_proto_goTypes,
 		DependencyIndexes: file_admin_service_v1_i_tenant_proto_depIdxs,
+		EnumInfos:         file_admin_service_v1_i_tenant_proto_enumTypes,
 		MessageInfos:      file_admin_service_v1_i_tenant_proto_msgTypes,
 	}.Build()
 	File_admin_service_v1_i_tenant_proto = out.File
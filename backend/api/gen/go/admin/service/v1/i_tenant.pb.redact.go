@@ -24,6 +24,7 @@ var (
 	_ emptypb.Empty
 	_ pagination.Sorting
 	_ servicev1.Tenant
+	_ servicev1.TenantPlan
 	_ servicev1.User
 )
 
@@ -122,6 +123,39 @@ func (s *redactedTenantServiceServer) TenantExists(ctx context.Context, in *serv
 	return res, err
 }
 
+// ListTenantTemplates is the redacted wrapper for the actual TenantServiceServer.ListTenantTemplates method
+// Unary RPC
+func (s *redactedTenantServiceServer) ListTenantTemplates(ctx context.Context, in *emptypb.Empty) (*ListTenantTemplatesResponse, error) {
+	res, err := s.srv.ListTenantTemplates(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// OffboardTenant is the redacted wrapper for the actual TenantServiceServer.OffboardTenant method
+// Unary RPC
+func (s *redactedTenantServiceServer) OffboardTenant(ctx context.Context, in *OffboardTenantRequest) (*OffboardTenantResponse, error) {
+	res, err := s.srv.OffboardTenant(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetTenantUsage is the redacted wrapper for the actual TenantServiceServer.GetTenantUsage method
+// Unary RPC
+func (s *redactedTenantServiceServer) GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest) (*TenantUsage, error) {
+	res, err := s.srv.GetTenantUsage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for CreateTenantWithAdminUserRequest
 func (x *CreateTenantWithAdminUserRequest) Redact() string {
 	if x == nil {
@@ -133,5 +167,107 @@ func (x *CreateTenantWithAdminUserRequest) Redact() string {
 	// Safe field: User
 
 	// Safe field: Password
+
+	// Safe field: Template
+	return x.String()
+}
+
+// Redact method implementation for TenantTemplate
+func (x *TenantTemplate) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Name
+
+	// Safe field: Description
+
+	// Safe field: IsDefault
+
+	// Safe field: Roles
+
+	// Safe field: AdminRoles
+
+	// Safe field: DictTypes
+
+	// Safe field: MessageCategories
+	return x.String()
+}
+
+// Redact method implementation for ListTenantTemplatesResponse
+func (x *ListTenantTemplatesResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+	return x.String()
+}
+
+// Redact method implementation for OffboardTenantRequest
+func (x *OffboardTenantRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+
+	// Safe field: Mode
+
+	// Safe field: ConfirmCode
+
+	// Safe field: Reason
+	return x.String()
+}
+
+// Redact method implementation for OffboardTenantResponse
+func (x *OffboardTenantResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Affected
+	return x.String()
+}
+
+// Redact method implementation for GetTenantUsageRequest
+func (x *GetTenantUsageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Id
+	return x.String()
+}
+
+// Redact method implementation for QuotaUsage
+func (x *QuotaUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Used
+
+	// Safe field: Limit
+
+	// Safe field: Exceeded
+	return x.String()
+}
+
+// Redact method implementation for TenantUsage
+func (x *TenantUsage) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: Plan
+
+	// Safe field: Users
+
+	// Safe field: StorageBytes
+
+	// Safe field: ApiRequestsToday
 	return x.String()
 }
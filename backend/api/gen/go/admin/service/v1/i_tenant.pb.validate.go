@@ -118,6 +118,10 @@ func (m *CreateTenantWithAdminUserRequest) validate(all bool) error {
 
 	// no validation rules for Password
 
+	if m.Template != nil {
+		// no validation rules for Template
+	}
+
 	if len(errors) > 0 {
 		return CreateTenantWithAdminUserRequestMultiError(errors)
 	}
@@ -199,3 +203,896 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CreateTenantWithAdminUserRequestValidationError{}
+
+// Validate checks the field values on TenantTemplate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantTemplate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantTemplateMultiError,
+// or nil if none found.
+func (m *TenantTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for IsDefault
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return TenantTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantTemplateMultiError is an error wrapping multiple validation errors
+// returned by TenantTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type TenantTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantTemplateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantTemplateMultiError) AllErrors() []error { return m }
+
+// TenantTemplateValidationError is the validation error returned by
+// TenantTemplate.Validate if the designated constraints aren't met.
+type TenantTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantTemplateValidationError) ErrorName() string { return "TenantTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantTemplateValidationError{}
+
+// Validate checks the field values on ListTenantTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTenantTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTenantTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTenantTemplatesResponseMultiError, or nil if none found.
+func (m *ListTenantTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTenantTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTenantTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTenantTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTenantTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTenantTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTenantTemplatesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListTenantTemplatesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListTenantTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTenantTemplatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTenantTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// ListTenantTemplatesResponseValidationError is the validation error returned
+// by ListTenantTemplatesResponse.Validate if the designated constraints
+// aren't met.
+type ListTenantTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTenantTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTenantTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTenantTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTenantTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTenantTemplatesResponseValidationError) ErrorName() string {
+	return "ListTenantTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTenantTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTenantTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTenantTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTenantTemplatesResponseValidationError{}
+
+// Validate checks the field values on OffboardTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OffboardTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OffboardTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OffboardTenantRequestMultiError, or nil if none found.
+func (m *OffboardTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OffboardTenantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Mode
+
+	// no validation rules for ConfirmCode
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return OffboardTenantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OffboardTenantRequestMultiError is an error wrapping multiple validation
+// errors returned by OffboardTenantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type OffboardTenantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OffboardTenantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OffboardTenantRequestMultiError) AllErrors() []error { return m }
+
+// OffboardTenantRequestValidationError is the validation error returned by
+// OffboardTenantRequest.Validate if the designated constraints aren't met.
+type OffboardTenantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OffboardTenantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OffboardTenantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OffboardTenantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OffboardTenantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OffboardTenantRequestValidationError) ErrorName() string {
+	return "OffboardTenantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OffboardTenantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOffboardTenantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OffboardTenantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OffboardTenantRequestValidationError{}
+
+// Validate checks the field values on OffboardTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OffboardTenantResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OffboardTenantResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OffboardTenantResponseMultiError, or nil if none found.
+func (m *OffboardTenantResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OffboardTenantResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Affected
+
+	if len(errors) > 0 {
+		return OffboardTenantResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OffboardTenantResponseMultiError is an error wrapping multiple validation
+// errors returned by OffboardTenantResponse.ValidateAll() if the designated
+// constraints aren't met.
+type OffboardTenantResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OffboardTenantResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OffboardTenantResponseMultiError) AllErrors() []error { return m }
+
+// OffboardTenantResponseValidationError is the validation error returned by
+// OffboardTenantResponse.Validate if the designated constraints aren't met.
+type OffboardTenantResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OffboardTenantResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OffboardTenantResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OffboardTenantResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OffboardTenantResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OffboardTenantResponseValidationError) ErrorName() string {
+	return "OffboardTenantResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OffboardTenantResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOffboardTenantResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OffboardTenantResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OffboardTenantResponseValidationError{}
+
+// Validate checks the field values on GetTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetTenantUsageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetTenantUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetTenantUsageRequestMultiError, or nil if none found.
+func (m *GetTenantUsageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetTenantUsageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Id != nil {
+		// no validation rules for Id
+	}
+
+	if len(errors) > 0 {
+		return GetTenantUsageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetTenantUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetTenantUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetTenantUsageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetTenantUsageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetTenantUsageRequestMultiError) AllErrors() []error { return m }
+
+// GetTenantUsageRequestValidationError is the validation error returned by
+// GetTenantUsageRequest.Validate if the designated constraints aren't met.
+type GetTenantUsageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetTenantUsageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetTenantUsageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetTenantUsageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetTenantUsageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetTenantUsageRequestValidationError) ErrorName() string {
+	return "GetTenantUsageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetTenantUsageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetTenantUsageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetTenantUsageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetTenantUsageRequestValidationError{}
+
+// Validate checks the field values on QuotaUsage with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuotaUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotaUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuotaUsageMultiError, or
+// nil if none found.
+func (m *QuotaUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotaUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Used
+
+	// no validation rules for Limit
+
+	// no validation rules for Exceeded
+
+	if len(errors) > 0 {
+		return QuotaUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotaUsageMultiError is an error wrapping multiple validation errors
+// returned by QuotaUsage.ValidateAll() if the designated constraints aren't met.
+type QuotaUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotaUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotaUsageMultiError) AllErrors() []error { return m }
+
+// QuotaUsageValidationError is the validation error returned by
+// QuotaUsage.Validate if the designated constraints aren't met.
+type QuotaUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotaUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotaUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotaUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotaUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotaUsageValidationError) ErrorName() string { return "QuotaUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuotaUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotaUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotaUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotaUsageValidationError{}
+
+// Validate checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TenantUsage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TenantUsage with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TenantUsageMultiError, or
+// nil if none found.
+func (m *TenantUsage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TenantUsage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	if all {
+		switch v := interface{}(m.GetUsers()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "Users",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "Users",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUsers()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUsageValidationError{
+				field:  "Users",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageBytes()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "StorageBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "StorageBytes",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageBytes()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUsageValidationError{
+				field:  "StorageBytes",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetApiRequestsToday()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "ApiRequestsToday",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TenantUsageValidationError{
+					field:  "ApiRequestsToday",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApiRequestsToday()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TenantUsageValidationError{
+				field:  "ApiRequestsToday",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Plan != nil {
+
+		if all {
+			switch v := interface{}(m.GetPlan()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TenantUsageValidationError{
+						field:  "Plan",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TenantUsageValidationError{
+						field:  "Plan",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPlan()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TenantUsageValidationError{
+					field:  "Plan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return TenantUsageMultiError(errors)
+	}
+
+	return nil
+}
+
+// TenantUsageMultiError is an error wrapping multiple validation errors
+// returned by TenantUsage.ValidateAll() if the designated constraints aren't met.
+type TenantUsageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TenantUsageMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TenantUsageMultiError) AllErrors() []error { return m }
+
+// TenantUsageValidationError is the validation error returned by
+// TenantUsage.Validate if the designated constraints aren't met.
+type TenantUsageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TenantUsageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TenantUsageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TenantUsageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TenantUsageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TenantUsageValidationError) ErrorName() string { return "TenantUsageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TenantUsageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTenantUsage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TenantUsageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TenantUsageValidationError{}
@@ -29,6 +29,9 @@ const (
 	TenantService_Delete_FullMethodName                    = "/admin.service.v1.TenantService/Delete"
 	TenantService_CreateTenantWithAdminUser_FullMethodName = "/admin.service.v1.TenantService/CreateTenantWithAdminUser"
 	TenantService_TenantExists_FullMethodName              = "/admin.service.v1.TenantService/TenantExists"
+	TenantService_ListTenantTemplates_FullMethodName       = "/admin.service.v1.TenantService/ListTenantTemplates"
+	TenantService_OffboardTenant_FullMethodName            = "/admin.service.v1.TenantService/OffboardTenant"
+	TenantService_GetTenantUsage_FullMethodName            = "/admin.service.v1.TenantService/GetTenantUsage"
 )
 
 // TenantServiceClient is the client API for TenantService service.
@@ -51,6 +54,12 @@ type TenantServiceClient interface {
 	CreateTenantWithAdminUser(ctx context.Context, in *CreateTenantWithAdminUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 租户是否存在
 	TenantExists(ctx context.Context, in *v11.TenantExistsRequest, opts ...grpc.CallOption) (*v11.TenantExistsResponse, error)
+	// 获取租户模板列表
+	ListTenantTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTenantTemplatesResponse, error)
+	// 租户下线：归档或清除租户的全部数据
+	OffboardTenant(ctx context.Context, in *OffboardTenantRequest, opts ...grpc.CallOption) (*OffboardTenantResponse, error)
+	// 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+	GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...grpc.CallOption) (*TenantUsage, error)
 }
 
 type tenantServiceClient struct {
@@ -131,6 +140,36 @@ func (c *tenantServiceClient) TenantExists(ctx context.Context, in *v11.TenantEx
 	return out, nil
 }
 
+func (c *tenantServiceClient) ListTenantTemplates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListTenantTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTenantTemplatesResponse)
+	err := c.cc.Invoke(ctx, TenantService_ListTenantTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) OffboardTenant(ctx context.Context, in *OffboardTenantRequest, opts ...grpc.CallOption) (*OffboardTenantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OffboardTenantResponse)
+	err := c.cc.Invoke(ctx, TenantService_OffboardTenant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tenantServiceClient) GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...grpc.CallOption) (*TenantUsage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TenantUsage)
+	err := c.cc.Invoke(ctx, TenantService_GetTenantUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TenantServiceServer is the server API for TenantService service.
 // All implementations must embed UnimplementedTenantServiceServer
 // for forward compatibility.
@@ -151,6 +190,12 @@ type TenantServiceServer interface {
 	CreateTenantWithAdminUser(context.Context, *CreateTenantWithAdminUserRequest) (*emptypb.Empty, error)
 	// 租户是否存在
 	TenantExists(context.Context, *v11.TenantExistsRequest) (*v11.TenantExistsResponse, error)
+	// 获取租户模板列表
+	ListTenantTemplates(context.Context, *emptypb.Empty) (*ListTenantTemplatesResponse, error)
+	// 租户下线：归档或清除租户的全部数据
+	OffboardTenant(context.Context, *OffboardTenantRequest) (*OffboardTenantResponse, error)
+	// 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+	GetTenantUsage(context.Context, *GetTenantUsageRequest) (*TenantUsage, error)
 	mustEmbedUnimplementedTenantServiceServer()
 }
 
@@ -182,6 +227,15 @@ func (UnimplementedTenantServiceServer) CreateTenantWithAdminUser(context.Contex
 func (UnimplementedTenantServiceServer) TenantExists(context.Context, *v11.TenantExistsRequest) (*v11.TenantExistsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantExists not implemented")
 }
+func (UnimplementedTenantServiceServer) ListTenantTemplates(context.Context, *emptypb.Empty) (*ListTenantTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTenantTemplates not implemented")
+}
+func (UnimplementedTenantServiceServer) OffboardTenant(context.Context, *OffboardTenantRequest) (*OffboardTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffboardTenant not implemented")
+}
+func (UnimplementedTenantServiceServer) GetTenantUsage(context.Context, *GetTenantUsageRequest) (*TenantUsage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTenantUsage not implemented")
+}
 func (UnimplementedTenantServiceServer) mustEmbedUnimplementedTenantServiceServer() {}
 func (UnimplementedTenantServiceServer) testEmbeddedByValue()                       {}
 
@@ -329,6 +383,60 @@ func _TenantService_TenantExists_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TenantService_ListTenantTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).ListTenantTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_ListTenantTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).ListTenantTemplates(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_OffboardTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OffboardTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).OffboardTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_OffboardTenant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).OffboardTenant(ctx, req.(*OffboardTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TenantService_GetTenantUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTenantUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TenantServiceServer).GetTenantUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TenantService_GetTenantUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TenantServiceServer).GetTenantUsage(ctx, req.(*GetTenantUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TenantService_ServiceDesc is the grpc.ServiceDesc for TenantService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -364,6 +472,18 @@ var TenantService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TenantExists",
 			Handler:    _TenantService_TenantExists_Handler,
 		},
+		{
+			MethodName: "ListTenantTemplates",
+			Handler:    _TenantService_ListTenantTemplates_Handler,
+		},
+		{
+			MethodName: "OffboardTenant",
+			Handler:    _TenantService_OffboardTenant_Handler,
+		},
+		{
+			MethodName: "GetTenantUsage",
+			Handler:    _TenantService_GetTenantUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_tenant.proto",
@@ -26,7 +26,10 @@ const OperationTenantServiceCreate = "/admin.service.v1.TenantService/Create"
 const OperationTenantServiceCreateTenantWithAdminUser = "/admin.service.v1.TenantService/CreateTenantWithAdminUser"
 const OperationTenantServiceDelete = "/admin.service.v1.TenantService/Delete"
 const OperationTenantServiceGet = "/admin.service.v1.TenantService/Get"
+const OperationTenantServiceGetTenantUsage = "/admin.service.v1.TenantService/GetTenantUsage"
 const OperationTenantServiceList = "/admin.service.v1.TenantService/List"
+const OperationTenantServiceListTenantTemplates = "/admin.service.v1.TenantService/ListTenantTemplates"
+const OperationTenantServiceOffboardTenant = "/admin.service.v1.TenantService/OffboardTenant"
 const OperationTenantServiceTenantExists = "/admin.service.v1.TenantService/TenantExists"
 const OperationTenantServiceUpdate = "/admin.service.v1.TenantService/Update"
 
@@ -39,8 +42,14 @@ type TenantServiceHTTPServer interface {
 	Delete(context.Context, *v11.DeleteTenantRequest) (*emptypb.Empty, error)
 	// Get 获取租户数据
 	Get(context.Context, *v11.GetTenantRequest) (*v11.Tenant, error)
+	// GetTenantUsage 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+	GetTenantUsage(context.Context, *GetTenantUsageRequest) (*TenantUsage, error)
 	// List 获取租户列表
 	List(context.Context, *v1.PagingRequest) (*v11.ListTenantResponse, error)
+	// ListTenantTemplates 获取租户模板列表
+	ListTenantTemplates(context.Context, *emptypb.Empty) (*ListTenantTemplatesResponse, error)
+	// OffboardTenant 租户下线：归档或清除租户的全部数据
+	OffboardTenant(context.Context, *OffboardTenantRequest) (*OffboardTenantResponse, error)
 	// TenantExists 租户是否存在
 	TenantExists(context.Context, *v11.TenantExistsRequest) (*v11.TenantExistsResponse, error)
 	// Update 更新租户
@@ -49,16 +58,19 @@ type TenantServiceHTTPServer interface {
 
 func RegisterTenantServiceHTTPServer(s *http.Server, srv TenantServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/tenants", _TenantService_List12_HTTP_Handler(srv))
-	r.GET("/admin/v1/tenants/{id}", _TenantService_Get13_HTTP_Handler(srv))
-	r.POST("/admin/v1/tenants", _TenantService_Create10_HTTP_Handler(srv))
-	r.PUT("/admin/v1/tenants/{id}", _TenantService_Update10_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/tenants/{id}", _TenantService_Delete10_HTTP_Handler(srv))
+	r.GET("/admin/v1/tenants", _TenantService_List14_HTTP_Handler(srv))
+	r.GET("/admin/v1/tenants/{id}", _TenantService_Get15_HTTP_Handler(srv))
+	r.POST("/admin/v1/tenants", _TenantService_Create12_HTTP_Handler(srv))
+	r.PUT("/admin/v1/tenants/{id}", _TenantService_Update12_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/tenants/{id}", _TenantService_Delete11_HTTP_Handler(srv))
 	r.POST("/admin/v1/tenants_with_admin", _TenantService_CreateTenantWithAdminUser0_HTTP_Handler(srv))
 	r.GET("/admin/v1/tenants_exists", _TenantService_TenantExists0_HTTP_Handler(srv))
+	r.GET("/admin/v1/tenant_templates", _TenantService_ListTenantTemplates0_HTTP_Handler(srv))
+	r.POST("/admin/v1/tenants/{id}/offboard", _TenantService_OffboardTenant0_HTTP_Handler(srv))
+	r.GET("/admin/v1/tenant_usage", _TenantService_GetTenantUsage0_HTTP_Handler(srv))
 }
 
-func _TenantService_List12_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_List14_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -77,7 +89,7 @@ func _TenantService_List12_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx ht
 	}
 }
 
-func _TenantService_Get13_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_Get15_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetTenantRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -99,7 +111,7 @@ func _TenantService_Get13_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx htt
 	}
 }
 
-func _TenantService_Create10_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_Create12_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateTenantRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -121,7 +133,7 @@ func _TenantService_Create10_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx
 	}
 }
 
-func _TenantService_Update10_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_Update12_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateTenantRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -146,7 +158,7 @@ func _TenantService_Update10_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx
 	}
 }
 
-func _TenantService_Delete10_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+func _TenantService_Delete11_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteTenantRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -209,6 +221,69 @@ func _TenantService_TenantExists0_HTTP_Handler(srv TenantServiceHTTPServer) func
 	}
 }
 
+func _TenantService_ListTenantTemplates0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceListTenantTemplates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTenantTemplates(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTenantTemplatesResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_OffboardTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OffboardTenantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceOffboardTenant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.OffboardTenant(ctx, req.(*OffboardTenantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OffboardTenantResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _TenantService_GetTenantUsage0_HTTP_Handler(srv TenantServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetTenantUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationTenantServiceGetTenantUsage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetTenantUsage(ctx, req.(*GetTenantUsageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TenantUsage)
+		return ctx.Result(200, reply)
+	}
+}
+
 type TenantServiceHTTPClient interface {
 	// Create 创建租户
 	Create(ctx context.Context, req *v11.CreateTenantRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -218,8 +293,14 @@ type TenantServiceHTTPClient interface {
 	Delete(ctx context.Context, req *v11.DeleteTenantRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// Get 获取租户数据
 	Get(ctx context.Context, req *v11.GetTenantRequest, opts ...http.CallOption) (rsp *v11.Tenant, err error)
+	// GetTenantUsage 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+	GetTenantUsage(ctx context.Context, req *GetTenantUsageRequest, opts ...http.CallOption) (rsp *TenantUsage, err error)
 	// List 获取租户列表
 	List(ctx context.Context, req *v1.PagingRequest, opts ...http.CallOption) (rsp *v11.ListTenantResponse, err error)
+	// ListTenantTemplates 获取租户模板列表
+	ListTenantTemplates(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *ListTenantTemplatesResponse, err error)
+	// OffboardTenant 租户下线：归档或清除租户的全部数据
+	OffboardTenant(ctx context.Context, req *OffboardTenantRequest, opts ...http.CallOption) (rsp *OffboardTenantResponse, err error)
 	// TenantExists 租户是否存在
 	TenantExists(ctx context.Context, req *v11.TenantExistsRequest, opts ...http.CallOption) (rsp *v11.TenantExistsResponse, err error)
 	// Update 更新租户
@@ -290,6 +371,20 @@ func (c *TenantServiceHTTPClientImpl) Get(ctx context.Context, in *v11.GetTenant
 	return &out, nil
 }
 
+// GetTenantUsage 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+func (c *TenantServiceHTTPClientImpl) GetTenantUsage(ctx context.Context, in *GetTenantUsageRequest, opts ...http.CallOption) (*TenantUsage, error) {
+	var out TenantUsage
+	pattern := "/admin/v1/tenant_usage"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantServiceGetTenantUsage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // List 获取租户列表
 func (c *TenantServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingRequest, opts ...http.CallOption) (*v11.ListTenantResponse, error) {
 	var out v11.ListTenantResponse
@@ -304,6 +399,34 @@ func (c *TenantServiceHTTPClientImpl) List(ctx context.Context, in *v1.PagingReq
 	return &out, nil
 }
 
+// ListTenantTemplates 获取租户模板列表
+func (c *TenantServiceHTTPClientImpl) ListTenantTemplates(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*ListTenantTemplatesResponse, error) {
+	var out ListTenantTemplatesResponse
+	pattern := "/admin/v1/tenant_templates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationTenantServiceListTenantTemplates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// OffboardTenant 租户下线：归档或清除租户的全部数据
+func (c *TenantServiceHTTPClientImpl) OffboardTenant(ctx context.Context, in *OffboardTenantRequest, opts ...http.CallOption) (*OffboardTenantResponse, error) {
+	var out OffboardTenantResponse
+	pattern := "/admin/v1/tenants/{id}/offboard"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationTenantServiceOffboardTenant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // TenantExists 租户是否存在
 func (c *TenantServiceHTTPClientImpl) TenantExists(ctx context.Context, in *v11.TenantExistsRequest, opts ...http.CallOption) (*v11.TenantExistsResponse, error) {
 	var out v11.TenantExistsResponse
@@ -49,17 +49,17 @@ type UserServiceHTTPServer interface {
 
 func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r := s.Route("/")
-	r.GET("/admin/v1/users", _UserService_List13_HTTP_Handler(srv))
-	r.GET("/admin/v1/users/username/{user_name}", _UserService_Get14_HTTP_Handler(srv))
-	r.GET("/admin/v1/users/{id}", _UserService_Get15_HTTP_Handler(srv))
-	r.POST("/admin/v1/users", _UserService_Create11_HTTP_Handler(srv))
-	r.PUT("/admin/v1/users/{id}", _UserService_Update11_HTTP_Handler(srv))
-	r.DELETE("/admin/v1/users/{id}", _UserService_Delete11_HTTP_Handler(srv))
+	r.GET("/admin/v1/users", _UserService_List16_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/username/{user_name}", _UserService_Get17_HTTP_Handler(srv))
+	r.GET("/admin/v1/users/{id}", _UserService_Get18_HTTP_Handler(srv))
+	r.POST("/admin/v1/users", _UserService_Create14_HTTP_Handler(srv))
+	r.PUT("/admin/v1/users/{id}", _UserService_Update14_HTTP_Handler(srv))
+	r.DELETE("/admin/v1/users/{id}", _UserService_Delete13_HTTP_Handler(srv))
 	r.GET("/admin/v1/users:exists", _UserService_UserExists0_HTTP_Handler(srv))
 	r.POST("/admin/v1/users/{user_id}/password", _UserService_EditUserPassword0_HTTP_Handler(srv))
 }
 
-func _UserService_List13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_List16_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v1.PagingRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -78,7 +78,7 @@ func _UserService_List13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.C
 	}
 }
 
-func _UserService_Get14_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Get17_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -100,7 +100,7 @@ func _UserService_Get14_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _UserService_Get15_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Get18_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.GetUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -122,7 +122,7 @@ func _UserService_Get15_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Co
 	}
 }
 
-func _UserService_Create11_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Create14_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.CreateUserRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -144,7 +144,7 @@ func _UserService_Create11_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
-func _UserService_Update11_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Update14_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.UpdateUserRequest
 		if err := ctx.Bind(&in); err != nil {
@@ -169,7 +169,7 @@ func _UserService_Update11_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http
 	}
 }
 
-func _UserService_Delete11_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+func _UserService_Delete13_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in v11.DeleteUserRequest
 		if err := ctx.BindQuery(&in); err != nil {
@@ -6,6 +6,7 @@ package servicev1
 import (
 	context "context"
 	redact "github.com/menta2k/protoc-gen-redact/v3/redact/v3"
+	servicev11 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	servicev1 "go-wind-admin/api/gen/go/user/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -22,6 +23,8 @@ var (
 	_ status.Status
 	_ emptypb.Empty
 	_ servicev1.User
+	_ servicev11.CategoryChannels
+	_ servicev1.SettingDefinition
 )
 
 // RegisterRedactedUserProfileServiceServer wraps the UserProfileServiceServer with the redacted server and registers the service in GRPC
@@ -118,3 +121,47 @@ func (s *redactedUserProfileServiceServer) VerifyContact(ctx context.Context, in
 	}
 	return res, err
 }
+
+// GetNotificationPreference is the redacted wrapper for the actual UserProfileServiceServer.GetNotificationPreference method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) GetNotificationPreference(ctx context.Context, in *emptypb.Empty) (*servicev11.UserNotificationPreference, error) {
+	res, err := s.srv.GetNotificationPreference(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateNotificationPreference is the redacted wrapper for the actual UserProfileServiceServer.UpdateNotificationPreference method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) UpdateNotificationPreference(ctx context.Context, in *servicev11.UpdateUserNotificationPreferenceRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.UpdateNotificationPreference(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// GetSettings is the redacted wrapper for the actual UserProfileServiceServer.GetSettings method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) GetSettings(ctx context.Context, in *emptypb.Empty) (*servicev1.ListSettingResponse, error) {
+	res, err := s.srv.GetSettings(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// UpdateSettings is the redacted wrapper for the actual UserProfileServiceServer.UpdateSettings method
+// Unary RPC
+func (s *redactedUserProfileServiceServer) UpdateSettings(ctx context.Context, in *servicev1.UpdateSettingsRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.UpdateSettings(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
@@ -8,6 +8,7 @@ package servicev1
 
 import (
 	context "context"
+	v11 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	v1 "go-wind-admin/api/gen/go/user/service/v1"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -21,13 +22,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserProfileService_GetUser_FullMethodName        = "/admin.service.v1.UserProfileService/GetUser"
-	UserProfileService_UpdateUser_FullMethodName     = "/admin.service.v1.UserProfileService/UpdateUser"
-	UserProfileService_ChangePassword_FullMethodName = "/admin.service.v1.UserProfileService/ChangePassword"
-	UserProfileService_UploadAvatar_FullMethodName   = "/admin.service.v1.UserProfileService/UploadAvatar"
-	UserProfileService_DeleteAvatar_FullMethodName   = "/admin.service.v1.UserProfileService/DeleteAvatar"
-	UserProfileService_BindContact_FullMethodName    = "/admin.service.v1.UserProfileService/BindContact"
-	UserProfileService_VerifyContact_FullMethodName  = "/admin.service.v1.UserProfileService/VerifyContact"
+	UserProfileService_GetUser_FullMethodName                      = "/admin.service.v1.UserProfileService/GetUser"
+	UserProfileService_UpdateUser_FullMethodName                   = "/admin.service.v1.UserProfileService/UpdateUser"
+	UserProfileService_ChangePassword_FullMethodName               = "/admin.service.v1.UserProfileService/ChangePassword"
+	UserProfileService_UploadAvatar_FullMethodName                 = "/admin.service.v1.UserProfileService/UploadAvatar"
+	UserProfileService_DeleteAvatar_FullMethodName                 = "/admin.service.v1.UserProfileService/DeleteAvatar"
+	UserProfileService_BindContact_FullMethodName                  = "/admin.service.v1.UserProfileService/BindContact"
+	UserProfileService_VerifyContact_FullMethodName                = "/admin.service.v1.UserProfileService/VerifyContact"
+	UserProfileService_GetNotificationPreference_FullMethodName    = "/admin.service.v1.UserProfileService/GetNotificationPreference"
+	UserProfileService_UpdateNotificationPreference_FullMethodName = "/admin.service.v1.UserProfileService/UpdateNotificationPreference"
+	UserProfileService_GetSettings_FullMethodName                  = "/admin.service.v1.UserProfileService/GetSettings"
+	UserProfileService_UpdateSettings_FullMethodName               = "/admin.service.v1.UserProfileService/UpdateSettings"
 )
 
 // UserProfileServiceClient is the client API for UserProfileService service.
@@ -50,6 +55,14 @@ type UserProfileServiceClient interface {
 	BindContact(ctx context.Context, in *v1.BindContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(ctx context.Context, in *v1.VerifyContactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取通知偏好
+	GetNotificationPreference(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.UserNotificationPreference, error)
+	// 更新通知偏好
+	UpdateNotificationPreference(ctx context.Context, in *v11.UpdateUserNotificationPreferenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取个人配置，返回对当前用户生效的配置
+	GetSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.ListSettingResponse, error)
+	// 更新个人配置，只能覆盖允许用户修改的配置项
+	UpdateSettings(ctx context.Context, in *v1.UpdateSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type userProfileServiceClient struct {
@@ -130,6 +143,46 @@ func (c *userProfileServiceClient) VerifyContact(ctx context.Context, in *v1.Ver
 	return out, nil
 }
 
+func (c *userProfileServiceClient) GetNotificationPreference(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v11.UserNotificationPreference, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v11.UserNotificationPreference)
+	err := c.cc.Invoke(ctx, UserProfileService_GetNotificationPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) UpdateNotificationPreference(ctx context.Context, in *v11.UpdateUserNotificationPreferenceRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateNotificationPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) GetSettings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*v1.ListSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(v1.ListSettingResponse)
+	err := c.cc.Invoke(ctx, UserProfileService_GetSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userProfileServiceClient) UpdateSettings(ctx context.Context, in *v1.UpdateSettingsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserProfileService_UpdateSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserProfileServiceServer is the server API for UserProfileService service.
 // All implementations must embed UnimplementedUserProfileServiceServer
 // for forward compatibility.
@@ -150,6 +203,14 @@ type UserProfileServiceServer interface {
 	BindContact(context.Context, *v1.BindContactRequest) (*emptypb.Empty, error)
 	// 验证手机号码/邮箱
 	VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error)
+	// 获取通知偏好
+	GetNotificationPreference(context.Context, *emptypb.Empty) (*v11.UserNotificationPreference, error)
+	// 更新通知偏好
+	UpdateNotificationPreference(context.Context, *v11.UpdateUserNotificationPreferenceRequest) (*emptypb.Empty, error)
+	// 获取个人配置，返回对当前用户生效的配置
+	GetSettings(context.Context, *emptypb.Empty) (*v1.ListSettingResponse, error)
+	// 更新个人配置，只能覆盖允许用户修改的配置项
+	UpdateSettings(context.Context, *v1.UpdateSettingsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedUserProfileServiceServer()
 }
 
@@ -181,6 +242,18 @@ func (UnimplementedUserProfileServiceServer) BindContact(context.Context, *v1.Bi
 func (UnimplementedUserProfileServiceServer) VerifyContact(context.Context, *v1.VerifyContactRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VerifyContact not implemented")
 }
+func (UnimplementedUserProfileServiceServer) GetNotificationPreference(context.Context, *emptypb.Empty) (*v11.UserNotificationPreference, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreference not implemented")
+}
+func (UnimplementedUserProfileServiceServer) UpdateNotificationPreference(context.Context, *v11.UpdateUserNotificationPreferenceRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreference not implemented")
+}
+func (UnimplementedUserProfileServiceServer) GetSettings(context.Context, *emptypb.Empty) (*v1.ListSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettings not implemented")
+}
+func (UnimplementedUserProfileServiceServer) UpdateSettings(context.Context, *v1.UpdateSettingsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSettings not implemented")
+}
 func (UnimplementedUserProfileServiceServer) mustEmbedUnimplementedUserProfileServiceServer() {}
 func (UnimplementedUserProfileServiceServer) testEmbeddedByValue()                            {}
 
@@ -328,6 +401,78 @@ func _UserProfileService_VerifyContact_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserProfileService_GetNotificationPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetNotificationPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetNotificationPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetNotificationPreference(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_UpdateNotificationPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.UpdateUserNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateNotificationPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateNotificationPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateNotificationPreference(ctx, req.(*v11.UpdateUserNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_GetSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).GetSettings(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserProfileService_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserProfileServiceServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserProfileService_UpdateSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserProfileServiceServer).UpdateSettings(ctx, req.(*v1.UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserProfileService_ServiceDesc is the grpc.ServiceDesc for UserProfileService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,6 +508,22 @@ var UserProfileService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyContact",
 			Handler:    _UserProfileService_VerifyContact_Handler,
 		},
+		{
+			MethodName: "GetNotificationPreference",
+			Handler:    _UserProfileService_GetNotificationPreference_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreference",
+			Handler:    _UserProfileService_UpdateNotificationPreference_Handler,
+		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _UserProfileService_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _UserProfileService_UpdateSettings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/service/v1/i_user_profile.proto",
@@ -10,6 +10,7 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	v11 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	v1 "go-wind-admin/api/gen/go/user/service/v1"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
@@ -24,7 +25,11 @@ const _ = http.SupportPackageIsVersion1
 const OperationUserProfileServiceBindContact = "/admin.service.v1.UserProfileService/BindContact"
 const OperationUserProfileServiceChangePassword = "/admin.service.v1.UserProfileService/ChangePassword"
 const OperationUserProfileServiceDeleteAvatar = "/admin.service.v1.UserProfileService/DeleteAvatar"
+const OperationUserProfileServiceGetNotificationPreference = "/admin.service.v1.UserProfileService/GetNotificationPreference"
+const OperationUserProfileServiceGetSettings = "/admin.service.v1.UserProfileService/GetSettings"
 const OperationUserProfileServiceGetUser = "/admin.service.v1.UserProfileService/GetUser"
+const OperationUserProfileServiceUpdateNotificationPreference = "/admin.service.v1.UserProfileService/UpdateNotificationPreference"
+const OperationUserProfileServiceUpdateSettings = "/admin.service.v1.UserProfileService/UpdateSettings"
 const OperationUserProfileServiceUpdateUser = "/admin.service.v1.UserProfileService/UpdateUser"
 const OperationUserProfileServiceUploadAvatar = "/admin.service.v1.UserProfileService/UploadAvatar"
 const OperationUserProfileServiceVerifyContact = "/admin.service.v1.UserProfileService/VerifyContact"
@@ -36,8 +41,16 @@ type UserProfileServiceHTTPServer interface {
 	ChangePassword(context.Context, *v1.ChangePasswordRequest) (*emptypb.Empty, error)
 	// DeleteAvatar 删除头像
 	DeleteAvatar(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// GetNotificationPreference 获取通知偏好
+	GetNotificationPreference(context.Context, *emptypb.Empty) (*v11.UserNotificationPreference, error)
+	// GetSettings 获取个人配置，返回对当前用户生效的配置
+	GetSettings(context.Context, *emptypb.Empty) (*v1.ListSettingResponse, error)
 	// GetUser 获取用户资料
 	GetUser(context.Context, *emptypb.Empty) (*v1.User, error)
+	// UpdateNotificationPreference 更新通知偏好
+	UpdateNotificationPreference(context.Context, *v11.UpdateUserNotificationPreferenceRequest) (*emptypb.Empty, error)
+	// UpdateSettings 更新个人配置，只能覆盖允许用户修改的配置项
+	UpdateSettings(context.Context, *v1.UpdateSettingsRequest) (*emptypb.Empty, error)
 	// UpdateUser 更新用户资料
 	UpdateUser(context.Context, *v1.UpdateUserRequest) (*emptypb.Empty, error)
 	// UploadAvatar 上传头像
@@ -55,6 +68,10 @@ func RegisterUserProfileServiceHTTPServer(s *http.Server, srv UserProfileService
 	r.DELETE("/admin/v1/me/avatar", _UserProfileService_DeleteAvatar0_HTTP_Handler(srv))
 	r.POST("/admin/v1/me/contact", _UserProfileService_BindContact0_HTTP_Handler(srv))
 	r.POST("/admin/v1/me/contact/verify", _UserProfileService_VerifyContact0_HTTP_Handler(srv))
+	r.GET("/admin/v1/me/notification-preference", _UserProfileService_GetNotificationPreference0_HTTP_Handler(srv))
+	r.PUT("/admin/v1/me/notification-preference", _UserProfileService_UpdateNotificationPreference0_HTTP_Handler(srv))
+	r.GET("/admin/v1/me/settings", _UserProfileService_GetSettings1_HTTP_Handler(srv))
+	r.PUT("/admin/v1/me/settings", _UserProfileService_UpdateSettings1_HTTP_Handler(srv))
 }
 
 func _UserProfileService_GetUser0_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
@@ -205,6 +222,88 @@ func _UserProfileService_VerifyContact0_HTTP_Handler(srv UserProfileServiceHTTPS
 	}
 }
 
+func _UserProfileService_GetNotificationPreference0_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceGetNotificationPreference)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNotificationPreference(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v11.UserNotificationPreference)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserProfileService_UpdateNotificationPreference0_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v11.UpdateUserNotificationPreferenceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceUpdateNotificationPreference)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateNotificationPreference(ctx, req.(*v11.UpdateUserNotificationPreferenceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserProfileService_GetSettings1_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in emptypb.Empty
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceGetSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSettings(ctx, req.(*emptypb.Empty))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*v1.ListSettingResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserProfileService_UpdateSettings1_HTTP_Handler(srv UserProfileServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in v1.UpdateSettingsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserProfileServiceUpdateSettings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSettings(ctx, req.(*v1.UpdateSettingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 type UserProfileServiceHTTPClient interface {
 	// BindContact 绑定手机号码/邮箱
 	BindContact(ctx context.Context, req *v1.BindContactRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
@@ -212,8 +311,16 @@ type UserProfileServiceHTTPClient interface {
 	ChangePassword(ctx context.Context, req *v1.ChangePasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// DeleteAvatar 删除头像
 	DeleteAvatar(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// GetNotificationPreference 获取通知偏好
+	GetNotificationPreference(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v11.UserNotificationPreference, err error)
+	// GetSettings 获取个人配置，返回对当前用户生效的配置
+	GetSettings(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.ListSettingResponse, err error)
 	// GetUser 获取用户资料
 	GetUser(ctx context.Context, req *emptypb.Empty, opts ...http.CallOption) (rsp *v1.User, err error)
+	// UpdateNotificationPreference 更新通知偏好
+	UpdateNotificationPreference(ctx context.Context, req *v11.UpdateUserNotificationPreferenceRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// UpdateSettings 更新个人配置，只能覆盖允许用户修改的配置项
+	UpdateSettings(ctx context.Context, req *v1.UpdateSettingsRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UpdateUser 更新用户资料
 	UpdateUser(ctx context.Context, req *v1.UpdateUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UploadAvatar 上传头像
@@ -272,6 +379,34 @@ func (c *UserProfileServiceHTTPClientImpl) DeleteAvatar(ctx context.Context, in
 	return &out, nil
 }
 
+// GetNotificationPreference 获取通知偏好
+func (c *UserProfileServiceHTTPClientImpl) GetNotificationPreference(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v11.UserNotificationPreference, error) {
+	var out v11.UserNotificationPreference
+	pattern := "/admin/v1/me/notification-preference"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserProfileServiceGetNotificationPreference))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetSettings 获取个人配置，返回对当前用户生效的配置
+func (c *UserProfileServiceHTTPClientImpl) GetSettings(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.ListSettingResponse, error) {
+	var out v1.ListSettingResponse
+	pattern := "/admin/v1/me/settings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserProfileServiceGetSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetUser 获取用户资料
 func (c *UserProfileServiceHTTPClientImpl) GetUser(ctx context.Context, in *emptypb.Empty, opts ...http.CallOption) (*v1.User, error) {
 	var out v1.User
@@ -286,6 +421,34 @@ func (c *UserProfileServiceHTTPClientImpl) GetUser(ctx context.Context, in *empt
 	return &out, nil
 }
 
+// UpdateNotificationPreference 更新通知偏好
+func (c *UserProfileServiceHTTPClientImpl) UpdateNotificationPreference(ctx context.Context, in *v11.UpdateUserNotificationPreferenceRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/me/notification-preference"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserProfileServiceUpdateNotificationPreference))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSettings 更新个人配置，只能覆盖允许用户修改的配置项
+func (c *UserProfileServiceHTTPClientImpl) UpdateSettings(ctx context.Context, in *v1.UpdateSettingsRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/admin/v1/me/settings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserProfileServiceUpdateSettings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateUser 更新用户资料
 func (c *UserProfileServiceHTTPClientImpl) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
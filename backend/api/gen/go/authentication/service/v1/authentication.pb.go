@@ -187,6 +187,7 @@ type LoginRequest struct {
 	Username      *string                `protobuf:"bytes,10,opt,name=username,proto3,oneof" json:"username,omitempty"`                                                  // 用户名，必选项。
 	Password      *string                `protobuf:"bytes,11,opt,name=password,proto3,oneof" json:"password,omitempty"`                                                  // 用户的密码，必选项。
 	UserId        *uint32                `protobuf:"varint,12,opt,name=user_id,proto3,oneof" json:"user_id,omitempty"`                                                   // 用户ID
+	TenantCode    *string                `protobuf:"bytes,13,opt,name=tenant_code,proto3,oneof" json:"tenant_code,omitempty"`                                            // 租户编码
 	RefreshToken  *string                `protobuf:"bytes,20,opt,name=refresh_token,proto3,oneof" json:"refresh_token,omitempty"`                                        // 更新令牌，用来获取下一次的访问令牌，必选项。
 	Code          *string                `protobuf:"bytes,30,opt,name=code,proto3,oneof" json:"code,omitempty"`                                                          // 授权请求中收到的一次性验证/认证码。(当使用授权码模式时)
 	ClientType    *ClientType            `protobuf:"varint,40,opt,name=client_type,proto3,enum=authentication.service.v1.ClientType,oneof" json:"client_type,omitempty"` // 客户端类型
@@ -280,6 +281,13 @@ func (x *LoginRequest) GetUserId() uint32 {
 	return 0
 }
 
+func (x *LoginRequest) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
 func (x *LoginRequest) GetRefreshToken() string {
 	if x != nil && x.RefreshToken != nil {
 		return *x.RefreshToken
@@ -659,6 +667,9 @@ type UserTokenPayload struct {
 	Authority     v1.User_Authority      `protobuf:"varint,5,opt,name=authority,json=aut,proto3,enum=user.service.v1.User_Authority" json:"authority,omitempty"` // 用户权限
 	Roles         []string               `protobuf:"bytes,6,rep,name=roles,json=roc,proto3" json:"roles,omitempty"`                                              // 用户角色码列表
 	DeviceId      *string                `protobuf:"bytes,8,opt,name=device_id,json=did,proto3,oneof" json:"device_id,omitempty"`                                // 设备ID
+	ActUserId     *uint32                `protobuf:"varint,9,opt,name=act_user_id,json=act,proto3,oneof" json:"act_user_id,omitempty"`                           // 代管操作人的用户ID
+	ActUsername   *string                `protobuf:"bytes,10,opt,name=act_username,json=acn,proto3,oneof" json:"act_username,omitempty"`                         // 代管操作人的用户名
+	ActAuditId    *uint32                `protobuf:"varint,12,opt,name=act_audit_id,json=aai,proto3,oneof" json:"act_audit_id,omitempty"`                        // 代管审计日志ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -742,12 +753,39 @@ func (x *UserTokenPayload) GetDeviceId() string {
 	return ""
 }
 
+func (x *UserTokenPayload) GetActUserId() uint32 {
+	if x != nil && x.ActUserId != nil {
+		return *x.ActUserId
+	}
+	return 0
+}
+
+func (x *UserTokenPayload) GetActUsername() string {
+	if x != nil && x.ActUsername != nil {
+		return *x.ActUsername
+	}
+	return ""
+}
+
+func (x *UserTokenPayload) GetActAuditId() uint32 {
+	if x != nil && x.ActAuditId != nil {
+		return *x.ActAuditId
+	}
+	return 0
+}
+
 // 获取当前用户身份信息 - 响应
 type WhoAmIResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=uid,proto3" json:"user_id,omitempty"`                                // 用户ID
 	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`                                        // 当前用户的用户名
 	Authority     v1.User_Authority      `protobuf:"varint,3,opt,name=authority,proto3,enum=user.service.v1.User_Authority" json:"authority,omitempty"` // 用户权限
+	TenantId      *uint32                `protobuf:"varint,4,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                 // 租户ID
+	TenantName    *string                `protobuf:"bytes,5,opt,name=tenant_name,json=tenantName,proto3,oneof" json:"tenant_name,omitempty"`            // 租户名称
+	Impersonated  bool                   `protobuf:"varint,6,opt,name=impersonated,proto3" json:"impersonated,omitempty"`                               // 是否代管
+	ActUserId     *uint32                `protobuf:"varint,7,opt,name=act_user_id,json=actUserId,proto3,oneof" json:"act_user_id,omitempty"`            // 代管操作人的用户ID
+	ActUsername   *string                `protobuf:"bytes,8,opt,name=act_username,json=actUsername,proto3,oneof" json:"act_username,omitempty"`         // 代管操作人的用户名
+	ActReason     *string                `protobuf:"bytes,9,opt,name=act_reason,json=actReason,proto3,oneof" json:"act_reason,omitempty"`               // 代管原因
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -803,12 +841,175 @@ func (x *WhoAmIResponse) GetAuthority() v1.User_Authority {
 	return v1.User_Authority(0)
 }
 
+func (x *WhoAmIResponse) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
+func (x *WhoAmIResponse) GetTenantName() string {
+	if x != nil && x.TenantName != nil {
+		return *x.TenantName
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetImpersonated() bool {
+	if x != nil {
+		return x.Impersonated
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetActUserId() uint32 {
+	if x != nil && x.ActUserId != nil {
+		return *x.ActUserId
+	}
+	return 0
+}
+
+func (x *WhoAmIResponse) GetActUsername() string {
+	if x != nil && x.ActUsername != nil {
+		return *x.ActUsername
+	}
+	return ""
+}
+
+func (x *WhoAmIResponse) GetActReason() string {
+	if x != nil && x.ActReason != nil {
+		return *x.ActReason
+	}
+	return ""
+}
+
+// 代管租户 - 请求
+type ImpersonateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      uint32                 `protobuf:"varint,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"` // 代管的租户ID
+	UserId        *uint32                `protobuf:"varint,2,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"` // 代管的用户ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                      // 代管原因
+	ClientId      *string                `protobuf:"bytes,4,opt,name=client_id,proto3,oneof" json:"client_id,omitempty"`          // 客户端ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateRequest) Reset() {
+	*x = ImpersonateRequest{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateRequest) ProtoMessage() {}
+
+func (x *ImpersonateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateRequest) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ImpersonateRequest) GetTenantId() uint32 {
+	if x != nil {
+		return x.TenantId
+	}
+	return 0
+}
+
+func (x *ImpersonateRequest) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *ImpersonateRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ImpersonateRequest) GetClientId() string {
+	if x != nil && x.ClientId != nil {
+		return *x.ClientId
+	}
+	return ""
+}
+
+// 创建SSE连接票据 - 回应
+type CreateSseTicketResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`                         // 连接票据
+	ExpiresIn     uint32                 `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // 有效期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSseTicketResponse) Reset() {
+	*x = CreateSseTicketResponse{}
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSseTicketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSseTicketResponse) ProtoMessage() {}
+
+func (x *CreateSseTicketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_service_v1_authentication_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSseTicketResponse.ProtoReflect.Descriptor instead.
+func (*CreateSseTicketResponse) Descriptor() ([]byte, []int) {
+	return file_authentication_service_v1_authentication_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CreateSseTicketResponse) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *CreateSseTicketResponse) GetExpiresIn() uint32 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
 var File_authentication_service_v1_authentication_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\n" +
-	".authentication/service/v1/authentication.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1auser/service/v1/user.proto\"\xbb\n" +
-	"\n" +
+	".authentication/service/v1/authentication.proto\x12\x19authentication.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1auser/service/v1/user.proto\"\x8f\f\n" +
 	"\fLoginRequest\x12\x99\x01\n" +
 	"\n" +
 	"grant_type\x18\x01 \x01(\x0e2$.authentication.service.v1.GrantTypeBS\xe0A\x02\xbaGM\x8a\x02\n" +
@@ -821,10 +1022,12 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\busername\x18\n" +
 	" \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x04R\busername\x88\x01\x01\x12<\n" +
 	"\bpassword\x18\v \x01(\tB\x1b\xbaG\x12\x92\x02\x0f用户的密码ڶ\x1a\x02z\x00H\x05R\bpassword\x88\x01\x01\x12-\n" +
-	"\auser_id\x18\f \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x06R\auser_id\x88\x01\x01\x12\xc2\x02\n" +
-	"\rrefresh_token\x18\x14 \x01(\tB\x96\x02\xbaG\x92\x02\x92\x02\x8e\x02更新令牌，用来获取下一次的访问令牌，可选项。如果访问令牌将过期，则返回刷新令牌很有用，应用程序可以使用该刷新令牌来获取另一个访问令牌。但是，通过隐式授予颁发的令牌不能颁发刷新令牌。H\aR\rrefresh_token\x88\x01\x01\x12p\n" +
-	"\x04code\x18\x1e \x01(\tBW\xbaGT\x92\x02Q授权请求中收到的一次性验证/认证码。(当使用授权码模式时)H\bR\x04code\x88\x01\x01\x12c\n" +
-	"\vclient_type\x18( \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型H\tR\vclient_type\x88\x01\x01B\f\n" +
+	"\auser_id\x18\f \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x06R\auser_id\x88\x01\x01\x12\xc1\x01\n" +
+	"\vtenant_code\x18\r \x01(\tB\x99\x01\xbaG\x95\x01\x92\x02\x91\x01租户编码，用户名在租户内唯一，为空时依次从请求头X-Tenant-Code与子域名识别租户，都没有则为平台用户登录H\aR\vtenant_code\x88\x01\x01\x12\xc2\x02\n" +
+	"\rrefresh_token\x18\x14 \x01(\tB\x96\x02\xbaG\x92\x02\x92\x02\x8e\x02更新令牌，用来获取下一次的访问令牌，可选项。如果访问令牌将过期，则返回刷新令牌很有用，应用程序可以使用该刷新令牌来获取另一个访问令牌。但是，通过隐式授予颁发的令牌不能颁发刷新令牌。H\bR\rrefresh_token\x88\x01\x01\x12p\n" +
+	"\x04code\x18\x1e \x01(\tBW\xbaGT\x92\x02Q授权请求中收到的一次性验证/认证码。(当使用授权码模式时)H\tR\x04code\x88\x01\x01\x12c\n" +
+	"\vclient_type\x18( \x01(\x0e2%.authentication.service.v1.ClientTypeB\x15\xbaG\x12\x92\x02\x0f客户端类型H\n" +
+	"R\vclient_type\x88\x01\x01B\f\n" +
 	"\n" +
 	"_client_idB\x10\n" +
 	"\x0e_client_secretB\b\n" +
@@ -833,7 +1036,8 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\t_usernameB\v\n" +
 	"\t_passwordB\n" +
 	"\n" +
-	"\b_user_idB\x10\n" +
+	"\b_user_idB\x0e\n" +
+	"\f_tenant_codeB\x10\n" +
 	"\x0e_refresh_tokenB\a\n" +
 	"\x05_codeB\x0e\n" +
 	"\f_client_type\"\xb7\b\n" +
@@ -869,7 +1073,7 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\x05email\x18\x04 \x01(\tB\x18\xbaG\x15\x92\x02\x12电子邮件地址H\x00R\x05email\x88\x01\x01B\b\n" +
 	"\x06_email\"/\n" +
 	"\x14RegisterUserResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\rR\x06userId\"\xa4\x03\n" +
+	"\auser_id\x18\x01 \x01(\rR\x06userId\"\x97\x06\n" +
 	"\x10UserTokenPayload\x12$\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x03uid\x12+\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x03tid\x88\x01\x01\x12+\n" +
@@ -877,18 +1081,52 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\tclient_id\x18\x04 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x02R\x03cid\x88\x01\x01\x12K\n" +
 	"\tauthority\x18\x05 \x01(\x0e2\x1f.user.service.v1.User.AuthorityB\x12\xbaG\x0f\x92\x02\f用户权限R\x03aut\x12/\n" +
 	"\x05roles\x18\x06 \x03(\tB\x1b\xbaG\x18\x92\x02\x15用户角色码列表R\x03roc\x12+\n" +
-	"\tdevice_id\x18\b \x01(\tB\x0e\xbaG\v\x92\x02\b设备IDH\x03R\x03did\x88\x01\x01B\f\n" +
+	"\tdevice_id\x18\b \x01(\tB\x0e\xbaG\v\x92\x02\b设备IDH\x03R\x03did\x88\x01\x01\x12{\n" +
+	"\vact_user_id\x18\t \x01(\rB\\\xbaGY\x92\x02V代管操作人的用户ID，不为空表示这是平台管理员代管租户的令牌H\x04R\x03act\x88\x01\x01\x12A\n" +
+	"\fact_username\x18\n" +
+	" \x01(\tB!\xbaG\x1e\x92\x02\x1b代管操作人的用户名H\x05R\x03acn\x88\x01\x01\x12y\n" +
+	"\fact_audit_id\x18\f \x01(\rBY\xbaGV\x92\x02S代管审计日志的ID，代管原因只记录在审计日志中，不写入令牌H\x06R\x03aai\x88\x01\x01B\f\n" +
 	"\n" +
 	"_tenant_idB\v\n" +
 	"\t_usernameB\f\n" +
 	"\n" +
 	"_client_idB\f\n" +
 	"\n" +
-	"_device_id\"\xc5\x01\n" +
+	"_device_idB\x0e\n" +
+	"\f_act_user_idB\x0f\n" +
+	"\r_act_usernameB\x0f\n" +
+	"\r_act_audit_idJ\x04\b\v\x10\f\"\xdb\x05\n" +
 	"\x0eWhoAmIResponse\x12$\n" +
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x03uid\x12:\n" +
 	"\busername\x18\x02 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18当前用户的用户名R\busername\x12Q\n" +
-	"\tauthority\x18\x03 \x01(\x0e2\x1f.user.service.v1.User.AuthorityB\x12\xbaG\x0f\x92\x02\f用户权限R\tauthority*j\n" +
+	"\tauthority\x18\x03 \x01(\x0e2\x1f.user.service.v1.User.AuthorityB\x12\xbaG\x0f\x92\x02\f用户权限R\tauthority\x12Z\n" +
+	"\ttenant_id\x18\x04 \x01(\rB8\xbaG5\x92\x022当前用户所属的租户ID，平台用户为空H\x00R\btenantId\x88\x01\x01\x12M\n" +
+	"\vtenant_name\x18\x05 \x01(\tB'\xbaG$\x92\x02!当前用户所属的租户名称H\x01R\n" +
+	"tenantName\x88\x01\x01\x12Q\n" +
+	"\fimpersonated\x18\x06 \x01(\bB-\xbaG*\x92\x02'是否为平台管理员代管的身份R\fimpersonated\x12E\n" +
+	"\vact_user_id\x18\a \x01(\rB \xbaG\x1d\x92\x02\x1a代管操作人的用户IDH\x02R\tactUserId\x88\x01\x01\x12I\n" +
+	"\fact_username\x18\b \x01(\tB!\xbaG\x1e\x92\x02\x1b代管操作人的用户名H\x03R\vactUsername\x88\x01\x01\x126\n" +
+	"\n" +
+	"act_reason\x18\t \x01(\tB\x12\xbaG\x0f\x92\x02\f代管原因H\x04R\tactReason\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_idB\x0e\n" +
+	"\f_tenant_nameB\x0e\n" +
+	"\f_act_user_idB\x0f\n" +
+	"\r_act_usernameB\r\n" +
+	"\v_act_reason\"\xe9\x02\n" +
+	"\x12ImpersonateRequest\x127\n" +
+	"\ttenant_id\x18\x01 \x01(\rB\x1a\xe0A\x02\xbaG\x14\x92\x02\x11代管的租户IDR\btenantId\x12Y\n" +
+	"\auser_id\x18\x02 \x01(\rB;\xbaG8\x92\x025代管的用户ID，为空时代管租户的管理员H\x00R\x06userId\x88\x01\x01\x12o\n" +
+	"\x06reason\x18\x03 \x01(\tBW\xe0A\x02\xbaGQ\x92\x02N代管原因，必填，最多200个字符，记录在代管的审计日志中R\x06reason\x124\n" +
+	"\tclient_id\x18\x04 \x01(\tB\x11\xbaG\x0e\x92\x02\v客户端IDH\x01R\tclient_id\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\f\n" +
+	"\n" +
+	"_client_id\"\xbc\x01\n" +
+	"\x17CreateSseTicketResponse\x12_\n" +
+	"\x06ticket\x18\x01 \x01(\tBG\xbaGD\x92\x02A一次性连接票据，通过查询参数 ticket 建立SSE连接R\x06ticket\x12@\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\rB!\xbaG\x1e\x92\x02\x1b票据有效期，单位秒R\texpiresIn*j\n" +
 	"\tGrantType\x12\f\n" +
 	"\bpassword\x10\x00\x12\x16\n" +
 	"\x12client_credentials\x10\x01\x12\x16\n" +
@@ -902,14 +1140,15 @@ const file_authentication_service_v1_authentication_proto_rawDesc = "" +
 	"\n" +
 	"ClientType\x12\t\n" +
 	"\x05admin\x10\x00\x12\a\n" +
-	"\x03app\x10\x012\xe0\x04\n" +
+	"\x03app\x10\x012\xca\x05\n" +
 	"\x15AuthenticationService\x12\\\n" +
 	"\x05Login\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12L\n" +
 	"\x06Logout\x12(.authentication.service.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"\x00\x12q\n" +
 	"\fRegisterUser\x12..authentication.service.v1.RegisterUserRequest\x1a/.authentication.service.v1.RegisterUserResponse\"\x00\x12c\n" +
 	"\fRefreshToken\x12'.authentication.service.v1.LoginRequest\x1a(.authentication.service.v1.LoginResponse\"\x00\x12t\n" +
 	"\rValidateToken\x12/.authentication.service.v1.ValidateTokenRequest\x1a0.authentication.service.v1.ValidateTokenResponse\"\x00\x12M\n" +
-	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x00B\xf8\x01\n" +
+	"\x06WhoAmI\x12\x16.google.protobuf.Empty\x1a).authentication.service.v1.WhoAmIResponse\"\x00\x12h\n" +
+	"\vImpersonate\x12-.authentication.service.v1.ImpersonateRequest\x1a(.authentication.service.v1.LoginResponse\"\x00B\xf8\x01\n" +
 	"\x1dcom.authentication.service.v1B\x13AuthenticationProtoP\x01Z<go-wind-admin/api/gen/go/authentication/service/v1;servicev1\xa2\x02\x03ASX\xaa\x02\x19Authentication.Service.V1\xca\x02\x19Authentication\\Service\\V1\xe2\x02%Authentication\\Service\\V1\\GPBMetadata\xea\x02\x1bAuthentication::Service::V1b\x06proto3"
 
 var (
@@ -925,22 +1164,24 @@ func file_authentication_service_v1_authentication_proto_rawDescGZIP() []byte {
 }
 
 var file_authentication_service_v1_authentication_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_authentication_service_v1_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_authentication_service_v1_authentication_proto_goTypes = []any{
-	(GrantType)(0),                // 0: authentication.service.v1.GrantType
-	(TokenType)(0),                // 1: authentication.service.v1.TokenType
-	(ClientType)(0),               // 2: authentication.service.v1.ClientType
-	(*LoginRequest)(nil),          // 3: authentication.service.v1.LoginRequest
-	(*LoginResponse)(nil),         // 4: authentication.service.v1.LoginResponse
-	(*LogoutRequest)(nil),         // 5: authentication.service.v1.LogoutRequest
-	(*ValidateTokenRequest)(nil),  // 6: authentication.service.v1.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil), // 7: authentication.service.v1.ValidateTokenResponse
-	(*RegisterUserRequest)(nil),   // 8: authentication.service.v1.RegisterUserRequest
-	(*RegisterUserResponse)(nil),  // 9: authentication.service.v1.RegisterUserResponse
-	(*UserTokenPayload)(nil),      // 10: authentication.service.v1.UserTokenPayload
-	(*WhoAmIResponse)(nil),        // 11: authentication.service.v1.WhoAmIResponse
-	(v1.User_Authority)(0),        // 12: user.service.v1.User.Authority
-	(*emptypb.Empty)(nil),         // 13: google.protobuf.Empty
+	(GrantType)(0),                  // 0: authentication.service.v1.GrantType
+	(TokenType)(0),                  // 1: authentication.service.v1.TokenType
+	(ClientType)(0),                 // 2: authentication.service.v1.ClientType
+	(*LoginRequest)(nil),            // 3: authentication.service.v1.LoginRequest
+	(*LoginResponse)(nil),           // 4: authentication.service.v1.LoginResponse
+	(*LogoutRequest)(nil),           // 5: authentication.service.v1.LogoutRequest
+	(*ValidateTokenRequest)(nil),    // 6: authentication.service.v1.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),   // 7: authentication.service.v1.ValidateTokenResponse
+	(*RegisterUserRequest)(nil),     // 8: authentication.service.v1.RegisterUserRequest
+	(*RegisterUserResponse)(nil),    // 9: authentication.service.v1.RegisterUserResponse
+	(*UserTokenPayload)(nil),        // 10: authentication.service.v1.UserTokenPayload
+	(*WhoAmIResponse)(nil),          // 11: authentication.service.v1.WhoAmIResponse
+	(*ImpersonateRequest)(nil),      // 12: authentication.service.v1.ImpersonateRequest
+	(*CreateSseTicketResponse)(nil), // 13: authentication.service.v1.CreateSseTicketResponse
+	(v1.User_Authority)(0),          // 14: user.service.v1.User.Authority
+	(*emptypb.Empty)(nil),           // 15: google.protobuf.Empty
 }
 var file_authentication_service_v1_authentication_proto_depIdxs = []int32{
 	0,  // 0: authentication.service.v1.LoginRequest.grant_type:type_name -> authentication.service.v1.GrantType
@@ -949,22 +1190,24 @@ var file_authentication_service_v1_authentication_proto_depIdxs = []int32{
 	2,  // 3: authentication.service.v1.LogoutRequest.client_type:type_name -> authentication.service.v1.ClientType
 	2,  // 4: authentication.service.v1.ValidateTokenRequest.client_type:type_name -> authentication.service.v1.ClientType
 	10, // 5: authentication.service.v1.ValidateTokenResponse.claim:type_name -> authentication.service.v1.UserTokenPayload
-	12, // 6: authentication.service.v1.UserTokenPayload.authority:type_name -> user.service.v1.User.Authority
-	12, // 7: authentication.service.v1.WhoAmIResponse.authority:type_name -> user.service.v1.User.Authority
+	14, // 6: authentication.service.v1.UserTokenPayload.authority:type_name -> user.service.v1.User.Authority
+	14, // 7: authentication.service.v1.WhoAmIResponse.authority:type_name -> user.service.v1.User.Authority
 	3,  // 8: authentication.service.v1.AuthenticationService.Login:input_type -> authentication.service.v1.LoginRequest
 	5,  // 9: authentication.service.v1.AuthenticationService.Logout:input_type -> authentication.service.v1.LogoutRequest
 	8,  // 10: authentication.service.v1.AuthenticationService.RegisterUser:input_type -> authentication.service.v1.RegisterUserRequest
 	3,  // 11: authentication.service.v1.AuthenticationService.RefreshToken:input_type -> authentication.service.v1.LoginRequest
 	6,  // 12: authentication.service.v1.AuthenticationService.ValidateToken:input_type -> authentication.service.v1.ValidateTokenRequest
-	13, // 13: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
-	4,  // 14: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
-	13, // 15: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
-	9,  // 16: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
-	4,  // 17: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
-	7,  // 18: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
-	11, // 19: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
+	15, // 13: authentication.service.v1.AuthenticationService.WhoAmI:input_type -> google.protobuf.Empty
+	12, // 14: authentication.service.v1.AuthenticationService.Impersonate:input_type -> authentication.service.v1.ImpersonateRequest
+	4,  // 15: authentication.service.v1.AuthenticationService.Login:output_type -> authentication.service.v1.LoginResponse
+	15, // 16: authentication.service.v1.AuthenticationService.Logout:output_type -> google.protobuf.Empty
+	9,  // 17: authentication.service.v1.AuthenticationService.RegisterUser:output_type -> authentication.service.v1.RegisterUserResponse
+	4,  // 18: authentication.service.v1.AuthenticationService.RefreshToken:output_type -> authentication.service.v1.LoginResponse
+	7,  // 19: authentication.service.v1.AuthenticationService.ValidateToken:output_type -> authentication.service.v1.ValidateTokenResponse
+	11, // 20: authentication.service.v1.AuthenticationService.WhoAmI:output_type -> authentication.service.v1.WhoAmIResponse
+	4,  // 21: authentication.service.v1.AuthenticationService.Impersonate:output_type -> authentication.service.v1.LoginResponse
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
 	8,  // [8:8] is the sub-list for extension extendee
 	0,  // [0:8] is the sub-list for field type_name
@@ -980,13 +1223,15 @@ func file_authentication_service_v1_authentication_proto_init() {
 	file_authentication_service_v1_authentication_proto_msgTypes[4].OneofWrappers = []any{}
 	file_authentication_service_v1_authentication_proto_msgTypes[5].OneofWrappers = []any{}
 	file_authentication_service_v1_authentication_proto_msgTypes[7].OneofWrappers = []any{}
+	file_authentication_service_v1_authentication_proto_msgTypes[8].OneofWrappers = []any{}
+	file_authentication_service_v1_authentication_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_authentication_service_v1_authentication_proto_rawDesc), len(file_authentication_service_v1_authentication_proto_rawDesc)),
 			NumEnums:      3,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
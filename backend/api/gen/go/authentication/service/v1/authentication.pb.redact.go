@@ -111,6 +111,17 @@ func (s *redactedAuthenticationServiceServer) WhoAmI(ctx context.Context, in *em
 	return res, err
 }
 
+// Impersonate is the redacted wrapper for the actual AuthenticationServiceServer.Impersonate method
+// Unary RPC
+func (s *redactedAuthenticationServiceServer) Impersonate(ctx context.Context, in *ImpersonateRequest) (*LoginResponse, error) {
+	res, err := s.srv.Impersonate(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for LoginRequest
 func (x *LoginRequest) Redact() string {
 	if x == nil {
@@ -135,6 +146,8 @@ func (x *LoginRequest) Redact() string {
 
 	// Safe field: UserId
 
+	// Safe field: TenantCode
+
 	// Safe field: RefreshToken
 
 	// Safe field: Code
@@ -242,6 +255,12 @@ func (x *UserTokenPayload) Redact() string {
 	// Safe field: Roles
 
 	// Safe field: DeviceId
+
+	// Safe field: ActUserId
+
+	// Safe field: ActUsername
+
+	// Safe field: ActAuditId
 	return x.String()
 }
 
@@ -256,5 +275,45 @@ func (x *WhoAmIResponse) Redact() string {
 	// Safe field: Username
 
 	// Safe field: Authority
+
+	// Safe field: TenantId
+
+	// Safe field: TenantName
+
+	// Safe field: Impersonated
+
+	// Safe field: ActUserId
+
+	// Safe field: ActUsername
+
+	// Safe field: ActReason
+	return x.String()
+}
+
+// Redact method implementation for ImpersonateRequest
+func (x *ImpersonateRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: TenantId
+
+	// Safe field: UserId
+
+	// Safe field: Reason
+
+	// Safe field: ClientId
+	return x.String()
+}
+
+// Redact method implementation for CreateSseTicketResponse
+func (x *CreateSseTicketResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Ticket
+
+	// Safe field: ExpiresIn
 	return x.String()
 }
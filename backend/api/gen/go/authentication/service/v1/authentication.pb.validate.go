@@ -91,6 +91,10 @@ func (m *LoginRequest) validate(all bool) error {
 		// no validation rules for UserId
 	}
 
+	if m.TenantCode != nil {
+		// no validation rules for TenantCode
+	}
+
 	if m.RefreshToken != nil {
 		// no validation rules for RefreshToken
 	}
@@ -899,6 +903,18 @@ func (m *UserTokenPayload) validate(all bool) error {
 		// no validation rules for DeviceId
 	}
 
+	if m.ActUserId != nil {
+		// no validation rules for ActUserId
+	}
+
+	if m.ActUsername != nil {
+		// no validation rules for ActUsername
+	}
+
+	if m.ActAuditId != nil {
+		// no validation rules for ActAuditId
+	}
+
 	if len(errors) > 0 {
 		return UserTokenPayloadMultiError(errors)
 	}
@@ -1005,6 +1021,28 @@ func (m *WhoAmIResponse) validate(all bool) error {
 
 	// no validation rules for Authority
 
+	// no validation rules for Impersonated
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
+	if m.TenantName != nil {
+		// no validation rules for TenantName
+	}
+
+	if m.ActUserId != nil {
+		// no validation rules for ActUserId
+	}
+
+	if m.ActUsername != nil {
+		// no validation rules for ActUsername
+	}
+
+	if m.ActReason != nil {
+		// no validation rules for ActReason
+	}
+
 	if len(errors) > 0 {
 		return WhoAmIResponseMultiError(errors)
 	}
@@ -1082,3 +1120,223 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = WhoAmIResponseValidationError{}
+
+// Validate checks the field values on ImpersonateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImpersonateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImpersonateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImpersonateRequestMultiError, or nil if none found.
+func (m *ImpersonateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImpersonateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantId
+
+	// no validation rules for Reason
+
+	if m.UserId != nil {
+		// no validation rules for UserId
+	}
+
+	if m.ClientId != nil {
+		// no validation rules for ClientId
+	}
+
+	if len(errors) > 0 {
+		return ImpersonateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImpersonateRequestMultiError is an error wrapping multiple validation errors
+// returned by ImpersonateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImpersonateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonateRequestMultiError) AllErrors() []error { return m }
+
+// ImpersonateRequestValidationError is the validation error returned by
+// ImpersonateRequest.Validate if the designated constraints aren't met.
+type ImpersonateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImpersonateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImpersonateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImpersonateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImpersonateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImpersonateRequestValidationError) ErrorName() string {
+	return "ImpersonateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImpersonateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImpersonateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImpersonateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonateRequestValidationError{}
+
+// Validate checks the field values on CreateSseTicketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateSseTicketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateSseTicketResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateSseTicketResponseMultiError, or nil if none found.
+func (m *CreateSseTicketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateSseTicketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ticket
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return CreateSseTicketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateSseTicketResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateSseTicketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateSseTicketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateSseTicketResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateSseTicketResponseMultiError) AllErrors() []error { return m }
+
+// CreateSseTicketResponseValidationError is the validation error returned by
+// CreateSseTicketResponse.Validate if the designated constraints aren't met.
+type CreateSseTicketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateSseTicketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateSseTicketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateSseTicketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateSseTicketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateSseTicketResponseValidationError) ErrorName() string {
+	return "CreateSseTicketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateSseTicketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateSseTicketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateSseTicketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateSseTicketResponseValidationError{}
@@ -41,6 +41,8 @@ const (
 	AuthenticationErrorReason_INCORRECT_REFRESH_TOKEN AuthenticationErrorReason = 105 // 刷新令牌错误
 	AuthenticationErrorReason_TOKEN_EXPIRED           AuthenticationErrorReason = 106 // token过期
 	AuthenticationErrorReason_TOKEN_NOT_EXIST         AuthenticationErrorReason = 107 // token不存在
+	AuthenticationErrorReason_TENANT_DISABLED         AuthenticationErrorReason = 108 // 租户已停用、冻结或未通过审核
+	AuthenticationErrorReason_TENANT_EXPIRED          AuthenticationErrorReason = 109 // 租户已过期
 	// 402
 	AuthenticationErrorReason_PAYMENT_REQUIRED AuthenticationErrorReason = 200 // 需要支付
 	// 403
@@ -140,6 +142,8 @@ var (
 		105:  "INCORRECT_REFRESH_TOKEN",
 		106:  "TOKEN_EXPIRED",
 		107:  "TOKEN_NOT_EXIST",
+		108:  "TENANT_DISABLED",
+		109:  "TENANT_EXPIRED",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
 		400:  "NOT_FOUND",
@@ -196,6 +200,8 @@ var (
 		"INCORRECT_REFRESH_TOKEN":         105,
 		"TOKEN_EXPIRED":                   106,
 		"TOKEN_NOT_EXIST":                 107,
+		"TENANT_DISABLED":                 108,
+		"TENANT_EXPIRED":                  109,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
 		"NOT_FOUND":                       400,
@@ -271,7 +277,7 @@ var File_authentication_service_v1_authentication_error_proto protoreflect.FileD
 
 const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\n" +
-	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xed\f\n" +
+	"4authentication/service/v1/authentication_error.proto\x12\x19authentication.service.v1\x1a\x13errors/errors.proto*\xa2\r\n" +
 	"\x19AuthenticationErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x1c\n" +
 	"\x12INVALID_GRANT_TYPE\x10\x01\x1a\x04\xa8E\x90\x03\x12\x18\n" +
@@ -285,7 +291,9 @@ const file_authentication_service_v1_authentication_error_proto_rawDesc = "" +
 	"\x16INCORRECT_ACCESS_TOKEN\x10h\x1a\x04\xa8E\x91\x03\x12!\n" +
 	"\x17INCORRECT_REFRESH_TOKEN\x10i\x1a\x04\xa8E\x91\x03\x12\x17\n" +
 	"\rTOKEN_EXPIRED\x10j\x1a\x04\xa8E\x91\x03\x12\x19\n" +
-	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
+	"\x0fTOKEN_NOT_EXIST\x10k\x1a\x04\xa8E\x91\x03\x12\x19\n" +
+	"\x0fTENANT_DISABLED\x10l\x1a\x04\xa8E\x91\x03\x12\x18\n" +
+	"\x0eTENANT_EXPIRED\x10m\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
 	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
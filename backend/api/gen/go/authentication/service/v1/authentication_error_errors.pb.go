@@ -193,6 +193,34 @@ func ErrorTokenNotExist(format string, args ...interface{}) *errors.Error {
 	return errors.New(401, AuthenticationErrorReason_TOKEN_NOT_EXIST.String(), fmt.Sprintf(format, args...))
 }
 
+// 租户已停用、冻结或未通过审核
+func IsTenantDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_TENANT_DISABLED.String() && e.Code == 401
+}
+
+// 租户已停用、冻结或未通过审核
+func ErrorTenantDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AuthenticationErrorReason_TENANT_DISABLED.String(), fmt.Sprintf(format, args...))
+}
+
+// 租户已过期
+func IsTenantExpired(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == AuthenticationErrorReason_TENANT_EXPIRED.String() && e.Code == 401
+}
+
+// 租户已过期
+func ErrorTenantExpired(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, AuthenticationErrorReason_TENANT_EXPIRED.String(), fmt.Sprintf(format, args...))
+}
+
 // 402
 func IsPaymentRequired(err error) bool {
 	if err == nil {
@@ -26,6 +26,7 @@ const (
 	AuthenticationService_RefreshToken_FullMethodName  = "/authentication.service.v1.AuthenticationService/RefreshToken"
 	AuthenticationService_ValidateToken_FullMethodName = "/authentication.service.v1.AuthenticationService/ValidateToken"
 	AuthenticationService_WhoAmI_FullMethodName        = "/authentication.service.v1.AuthenticationService/WhoAmI"
+	AuthenticationService_Impersonate_FullMethodName   = "/authentication.service.v1.AuthenticationService/Impersonate"
 )
 
 // AuthenticationServiceClient is the client API for AuthenticationService service.
@@ -46,6 +47,8 @@ type AuthenticationServiceClient interface {
 	ValidateToken(ctx context.Context, in *ValidateTokenRequest, opts ...grpc.CallOption) (*ValidateTokenResponse, error)
 	// 获取当前用户身份信息
 	WhoAmI(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	// 平台管理员代管租户，以租户用户的身份获取限时的访问令牌
+	Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type authenticationServiceClient struct {
@@ -116,6 +119,16 @@ func (c *authenticationServiceClient) WhoAmI(ctx context.Context, in *emptypb.Em
 	return out, nil
 }
 
+func (c *authenticationServiceClient) Impersonate(ctx context.Context, in *ImpersonateRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, AuthenticationService_Impersonate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServiceServer is the server API for AuthenticationService service.
 // All implementations must embed UnimplementedAuthenticationServiceServer
 // for forward compatibility.
@@ -134,6 +147,8 @@ type AuthenticationServiceServer interface {
 	ValidateToken(context.Context, *ValidateTokenRequest) (*ValidateTokenResponse, error)
 	// 获取当前用户身份信息
 	WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResponse, error)
+	// 平台管理员代管租户，以租户用户的身份获取限时的访问令牌
+	Impersonate(context.Context, *ImpersonateRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedAuthenticationServiceServer()
 }
 
@@ -162,6 +177,9 @@ func (UnimplementedAuthenticationServiceServer) ValidateToken(context.Context, *
 func (UnimplementedAuthenticationServiceServer) WhoAmI(context.Context, *emptypb.Empty) (*WhoAmIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
 }
+func (UnimplementedAuthenticationServiceServer) Impersonate(context.Context, *ImpersonateRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Impersonate not implemented")
+}
 func (UnimplementedAuthenticationServiceServer) mustEmbedUnimplementedAuthenticationServiceServer() {}
 func (UnimplementedAuthenticationServiceServer) testEmbeddedByValue()                               {}
 
@@ -291,6 +309,24 @@ func _AuthenticationService_WhoAmI_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthenticationService_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthenticationService_Impersonate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServiceServer).Impersonate(ctx, req.(*ImpersonateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthenticationService_ServiceDesc is the grpc.ServiceDesc for AuthenticationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -322,6 +358,10 @@ var AuthenticationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WhoAmI",
 			Handler:    _AuthenticationService_WhoAmI_Handler,
 		},
+		{
+			MethodName: "Impersonate",
+			Handler:    _AuthenticationService_Impersonate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "authentication/service/v1/authentication.proto",
@@ -755,6 +755,7 @@ type GetUserCredentialByIdentifierRequest struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	IdentityType  UserCredential_IdentityType `protobuf:"varint,1,opt,name=identity_type,json=identityType,proto3,enum=authentication.service.v1.UserCredential_IdentityType" json:"identity_type,omitempty"` // 身份类型
 	Identifier    string                      `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`                                                                                     // 身份唯一标识符
+	TenantId      *uint32                     `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                                  // 租户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -803,6 +804,13 @@ func (x *GetUserCredentialByIdentifierRequest) GetIdentifier() string {
 	return ""
 }
 
+func (x *GetUserCredentialByIdentifierRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 // 验证凭证 - 请求
 type VerifyCredentialRequest struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
@@ -810,6 +818,7 @@ type VerifyCredentialRequest struct {
 	Identifier    string                      `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`                                                                                     // 身份唯一标识符
 	Credential    string                      `protobuf:"bytes,3,opt,name=credential,proto3" json:"credential,omitempty"`                                                                                     // 凭证
 	NeedDecrypt   bool                        `protobuf:"varint,4,opt,name=need_decrypt,json=needDecrypt,proto3" json:"need_decrypt,omitempty"`                                                               // 是否需要解码
+	TenantId      *uint32                     `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                                  // 租户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -872,6 +881,13 @@ func (x *VerifyCredentialRequest) GetNeedDecrypt() bool {
 	return false
 }
 
+func (x *VerifyCredentialRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 // 验证凭证 - 答复
 type VerifyCredentialResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -925,6 +941,7 @@ type ChangeCredentialRequest struct {
 	OldCredential string                      `protobuf:"bytes,3,opt,name=old_credential,json=oldCredential,proto3" json:"old_credential,omitempty"`                                                          // 旧凭证
 	NewCredential string                      `protobuf:"bytes,4,opt,name=new_credential,json=newCredential,proto3" json:"new_credential,omitempty"`                                                          // 新凭证
 	NeedDecrypt   bool                        `protobuf:"varint,5,opt,name=need_decrypt,json=needDecrypt,proto3" json:"need_decrypt,omitempty"`                                                               // 是否需要解码
+	TenantId      *uint32                     `protobuf:"varint,6,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                                  // 租户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -994,6 +1011,13 @@ func (x *ChangeCredentialRequest) GetNeedDecrypt() bool {
 	return false
 }
 
+func (x *ChangeCredentialRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 // 重设凭证 - 请求
 type ResetCredentialRequest struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
@@ -1001,6 +1025,7 @@ type ResetCredentialRequest struct {
 	Identifier    string                      `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`                                                                                     // 身份唯一标识符
 	NewCredential string                      `protobuf:"bytes,3,opt,name=new_credential,json=newCredential,proto3" json:"new_credential,omitempty"`                                                          // 新凭证
 	NeedDecrypt   bool                        `protobuf:"varint,4,opt,name=need_decrypt,json=needDecrypt,proto3" json:"need_decrypt,omitempty"`                                                               // 是否需要解码
+	TenantId      *uint32                     `protobuf:"varint,5,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                                  // 租户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1063,6 +1088,13 @@ func (x *ResetCredentialRequest) GetNeedDecrypt() bool {
 	return false
 }
 
+func (x *ResetCredentialRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 var File_authentication_service_v1_user_credential_proto protoreflect.FileDescriptor
 
 const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
@@ -1188,12 +1220,15 @@ const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\n" +
 	"\bquery_byB\f\n" +
 	"\n" +
-	"_view_mask\"\xd4\x01\n" +
+	"_view_mask\"\xd4\x02\n" +
 	"$GetUserCredentialByIdentifierRequest\x12o\n" +
 	"\ridentity_type\x18\x01 \x01(\x0e26.authentication.service.v1.UserCredential.IdentityTypeB\x12\xbaG\x0f\x92\x02\f身份类型R\fidentityType\x12;\n" +
 	"\n" +
 	"identifier\x18\x02 \x01(\tB\x1b\xbaG\x18\x92\x02\x15身份唯一标识符R\n" +
-	"identifier\"\xb2\x02\n" +
+	"identifier\x12p\n" +
+	"\ttenant_id\x18\x03 \x01(\rBN\xbaGK\x92\x02H租户ID，身份标识符在租户内唯一，为0时表示平台用户H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xb2\x03\n" +
 	"\x17VerifyCredentialRequest\x12o\n" +
 	"\ridentity_type\x18\x01 \x01(\x0e26.authentication.service.v1.UserCredential.IdentityTypeB\x12\xbaG\x0f\x92\x02\f身份类型R\fidentityType\x12;\n" +
 	"\n" +
@@ -1202,9 +1237,12 @@ const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"\n" +
 	"credential\x18\x03 \x01(\tB\f\xbaG\t\x92\x02\x06凭证R\n" +
 	"credential\x12;\n" +
-	"\fneed_decrypt\x18\x04 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt\"4\n" +
+	"\fneed_decrypt\x18\x04 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt\x12p\n" +
+	"\ttenant_id\x18\x05 \x01(\rBN\xbaGK\x92\x02H租户ID，身份标识符在租户内唯一，为0时表示平台用户H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"4\n" +
 	"\x18VerifyCredentialResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf4\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf4\x03\n" +
 	"\x17ChangeCredentialRequest\x12o\n" +
 	"\ridentity_type\x18\x01 \x01(\x0e26.authentication.service.v1.UserCredential.IdentityTypeB\x12\xbaG\x0f\x92\x02\f身份类型R\fidentityType\x12;\n" +
 	"\n" +
@@ -1212,14 +1250,20 @@ const file_authentication_service_v1_user_credential_proto_rawDesc = "" +
 	"identifier\x126\n" +
 	"\x0eold_credential\x18\x03 \x01(\tB\x0f\xbaG\f\x92\x02\t旧凭证R\roldCredential\x126\n" +
 	"\x0enew_credential\x18\x04 \x01(\tB\x0f\xbaG\f\x92\x02\t新凭证R\rnewCredential\x12;\n" +
-	"\fneed_decrypt\x18\x05 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt\"\xbb\x02\n" +
+	"\fneed_decrypt\x18\x05 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt\x12p\n" +
+	"\ttenant_id\x18\x06 \x01(\rBN\xbaGK\x92\x02H租户ID，身份标识符在租户内唯一，为0时表示平台用户H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"\xbb\x03\n" +
 	"\x16ResetCredentialRequest\x12o\n" +
 	"\ridentity_type\x18\x01 \x01(\x0e26.authentication.service.v1.UserCredential.IdentityTypeB\x12\xbaG\x0f\x92\x02\f身份类型R\fidentityType\x12;\n" +
 	"\n" +
 	"identifier\x18\x02 \x01(\tB\x1b\xbaG\x18\x92\x02\x15身份唯一标识符R\n" +
 	"identifier\x126\n" +
 	"\x0enew_credential\x18\x03 \x01(\tB\x0f\xbaG\f\x92\x02\t新凭证R\rnewCredential\x12;\n" +
-	"\fneed_decrypt\x18\x04 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt2\xb2\a\n" +
+	"\fneed_decrypt\x18\x04 \x01(\bB\x18\xbaG\x15\x92\x02\x12是否需要解码R\vneedDecrypt\x12p\n" +
+	"\ttenant_id\x18\x05 \x01(\rBN\xbaGK\x92\x02H租户ID，身份标识符在租户内唯一，为0时表示平台用户H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id2\xb2\a\n" +
 	"\x15UserCredentialService\x12Z\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1a5.authentication.service.v1.ListUserCredentialResponse\"\x00\x12g\n" +
 	"\x03Get\x123.authentication.service.v1.GetUserCredentialRequest\x1a).authentication.service.v1.UserCredential\"\x00\x12\x7f\n" +
@@ -1317,6 +1361,10 @@ func file_authentication_service_v1_user_credential_proto_init() {
 	file_authentication_service_v1_user_credential_proto_msgTypes[5].OneofWrappers = []any{
 		(*GetUserCredentialRequest_Id)(nil),
 	}
+	file_authentication_service_v1_user_credential_proto_msgTypes[6].OneofWrappers = []any{}
+	file_authentication_service_v1_user_credential_proto_msgTypes[7].OneofWrappers = []any{}
+	file_authentication_service_v1_user_credential_proto_msgTypes[9].OneofWrappers = []any{}
+	file_authentication_service_v1_user_credential_proto_msgTypes[10].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
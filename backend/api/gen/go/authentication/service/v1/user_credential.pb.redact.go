@@ -256,6 +256,8 @@ func (x *GetUserCredentialByIdentifierRequest) Redact() string {
 	// Safe field: IdentityType
 
 	// Safe field: Identifier
+
+	// Safe field: TenantId
 	return x.String()
 }
 
@@ -272,6 +274,8 @@ func (x *VerifyCredentialRequest) Redact() string {
 	// Safe field: Credential
 
 	// Safe field: NeedDecrypt
+
+	// Safe field: TenantId
 	return x.String()
 }
 
@@ -300,6 +304,8 @@ func (x *ChangeCredentialRequest) Redact() string {
 	// Safe field: NewCredential
 
 	// Safe field: NeedDecrypt
+
+	// Safe field: TenantId
 	return x.String()
 }
 
@@ -316,5 +322,7 @@ func (x *ResetCredentialRequest) Redact() string {
 	// Safe field: NewCredential
 
 	// Safe field: NeedDecrypt
+
+	// Safe field: TenantId
 	return x.String()
 }
@@ -1009,6 +1009,10 @@ func (m *GetUserCredentialByIdentifierRequest) validate(all bool) error {
 
 	// no validation rules for Identifier
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if len(errors) > 0 {
 		return GetUserCredentialByIdentifierRequestMultiError(errors)
 	}
@@ -1121,6 +1125,10 @@ func (m *VerifyCredentialRequest) validate(all bool) error {
 
 	// no validation rules for NeedDecrypt
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if len(errors) > 0 {
 		return VerifyCredentialRequestMultiError(errors)
 	}
@@ -1337,6 +1345,10 @@ func (m *ChangeCredentialRequest) validate(all bool) error {
 
 	// no validation rules for NeedDecrypt
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if len(errors) > 0 {
 		return ChangeCredentialRequestMultiError(errors)
 	}
@@ -1447,6 +1459,10 @@ func (m *ResetCredentialRequest) validate(all bool) error {
 
 	// no validation rules for NeedDecrypt
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if len(errors) > 0 {
 		return ResetCredentialRequestMultiError(errors)
 	}
@@ -136,26 +136,156 @@ func (InternalMessage_Type) EnumDescriptor() ([]byte, []int) {
 	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{0, 1}
 }
 
+// 投递状态
+type InternalMessage_DeliveryStatus int32
+
+const (
+	InternalMessage_PENDING          InternalMessage_DeliveryStatus = 0 // 等待投递
+	InternalMessage_DELIVERING       InternalMessage_DeliveryStatus = 1 // 投递中
+	InternalMessage_DELIVERED        InternalMessage_DeliveryStatus = 2 // 全部投递成功
+	InternalMessage_PARTIALLY_FAILED InternalMessage_DeliveryStatus = 3 // 部分投递失败
+	InternalMessage_FAILED           InternalMessage_DeliveryStatus = 4 // 全部投递失败
+)
+
+// Enum value maps for InternalMessage_DeliveryStatus.
+var (
+	InternalMessage_DeliveryStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "DELIVERING",
+		2: "DELIVERED",
+		3: "PARTIALLY_FAILED",
+		4: "FAILED",
+	}
+	InternalMessage_DeliveryStatus_value = map[string]int32{
+		"PENDING":          0,
+		"DELIVERING":       1,
+		"DELIVERED":        2,
+		"PARTIALLY_FAILED": 3,
+		"FAILED":           4,
+	}
+)
+
+func (x InternalMessage_DeliveryStatus) Enum() *InternalMessage_DeliveryStatus {
+	p := new(InternalMessage_DeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x InternalMessage_DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalMessage_DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_message_service_v1_internal_message_proto_enumTypes[2].Descriptor()
+}
+
+func (InternalMessage_DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_internal_message_service_v1_internal_message_proto_enumTypes[2]
+}
+
+func (x InternalMessage_DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalMessage_DeliveryStatus.Descriptor instead.
+func (InternalMessage_DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{0, 2}
+}
+
+// 消息优先级
+type InternalMessage_Priority int32
+
+const (
+	InternalMessage_NORMAL InternalMessage_Priority = 0 // 普通
+	InternalMessage_LOW    InternalMessage_Priority = 1 // 低，开启摘要模式的用户汇总到定期的摘要消息中
+	InternalMessage_HIGH   InternalMessage_Priority = 2 // 高
+	InternalMessage_URGENT InternalMessage_Priority = 3 // 紧急，免打扰时段也立即推送
+)
+
+// Enum value maps for InternalMessage_Priority.
+var (
+	InternalMessage_Priority_name = map[int32]string{
+		0: "NORMAL",
+		1: "LOW",
+		2: "HIGH",
+		3: "URGENT",
+	}
+	InternalMessage_Priority_value = map[string]int32{
+		"NORMAL": 0,
+		"LOW":    1,
+		"HIGH":   2,
+		"URGENT": 3,
+	}
+)
+
+func (x InternalMessage_Priority) Enum() *InternalMessage_Priority {
+	p := new(InternalMessage_Priority)
+	*p = x
+	return p
+}
+
+func (x InternalMessage_Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalMessage_Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_message_service_v1_internal_message_proto_enumTypes[3].Descriptor()
+}
+
+func (InternalMessage_Priority) Type() protoreflect.EnumType {
+	return &file_internal_message_service_v1_internal_message_proto_enumTypes[3]
+}
+
+func (x InternalMessage_Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalMessage_Priority.Descriptor instead.
+func (InternalMessage_Priority) EnumDescriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{0, 3}
+}
+
 // 站内信消息
 type InternalMessage struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            *uint32                 `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                 // 消息ID
-	Title         *string                 `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`                                                            // 消息标题
-	Content       *string                 `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`                                                        // 消息内容
-	Status        *InternalMessage_Status `protobuf:"varint,4,opt,name=status,proto3,enum=internal_message.service.v1.InternalMessage_Status,oneof" json:"status,omitempty"` // 消息状态
-	Type          *InternalMessage_Type   `protobuf:"varint,5,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type,oneof" json:"type,omitempty"`       // 消息类型
-	SenderId      *uint32                 `protobuf:"varint,6,opt,name=sender_id,json=senderId,proto3,oneof" json:"sender_id,omitempty"`                                     // 发送者 ID（用户 ID，系统消息为0）
-	SenderName    *string                 `protobuf:"bytes,7,opt,name=sender_name,json=senderName,proto3,oneof" json:"sender_name,omitempty"`                                // 发送者名称
-	CategoryId    *uint32                 `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                               // 分类ID
-	CategoryName  *string                 `protobuf:"bytes,9,opt,name=category_name,json=categoryName,proto3,oneof" json:"category_name,omitempty"`                          // 分类名称
-	CreatedBy     *uint32                 `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                // 创建者ID
-	UpdatedBy     *uint32                 `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                // 更新者ID
-	DeletedBy     *uint32                 `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                // 删除者用户ID
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                 // 创建时间
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                 // 更新时间
-	DeletedAt     *timestamppb.Timestamp  `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                 // 删除时间
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	Id                 *uint32                         `protobuf:"varint,1,opt,name=id,proto3,oneof" json:"id,omitempty"`                                                                                                                            // 消息ID
+	Title              *string                         `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`                                                                                                                       // 消息标题
+	Content            *string                         `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`                                                                                                                   // 消息内容
+	Status             *InternalMessage_Status         `protobuf:"varint,4,opt,name=status,proto3,enum=internal_message.service.v1.InternalMessage_Status,oneof" json:"status,omitempty"`                                                            // 消息状态
+	Type               *InternalMessage_Type           `protobuf:"varint,5,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type,oneof" json:"type,omitempty"`                                                                  // 消息类型
+	SenderId           *uint32                         `protobuf:"varint,6,opt,name=sender_id,json=senderId,proto3,oneof" json:"sender_id,omitempty"`                                                                                                // 发送者 ID（用户 ID，系统消息为0）
+	SenderName         *string                         `protobuf:"bytes,7,opt,name=sender_name,json=senderName,proto3,oneof" json:"sender_name,omitempty"`                                                                                           // 发送者名称
+	CategoryId         *uint32                         `protobuf:"varint,8,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                                                                                          // 分类ID
+	CategoryName       *string                         `protobuf:"bytes,9,opt,name=category_name,json=categoryName,proto3,oneof" json:"category_name,omitempty"`                                                                                     // 分类名称
+	SendAt             *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`                                                                                                      // 定时发送时间
+	CronSpec           *string                         `protobuf:"bytes,11,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"`                                                                                                // 周期发送的cron表达式
+	LastSentAt         *timestamppb.Timestamp          `protobuf:"bytes,12,opt,name=last_sent_at,json=lastSentAt,proto3,oneof" json:"last_sent_at,omitempty"`                                                                                        // 最近一次发送时间
+	TargetAll          *bool                           `protobuf:"varint,13,opt,name=target_all,json=targetAll,proto3,oneof" json:"target_all,omitempty"`                                                                                            // 全员发送标志
+	TargetUserIds      []uint32                        `protobuf:"varint,14,rep,packed,name=target_user_ids,json=targetUserIds,proto3" json:"target_user_ids,omitempty"`                                                                             // 接收者用户ID列表
+	Audience           *MessageAudience                `protobuf:"bytes,15,opt,name=audience,proto3,oneof" json:"audience,omitempty"`                                                                                                                // 受众表达式
+	DeliveryStatus     *InternalMessage_DeliveryStatus `protobuf:"varint,16,opt,name=delivery_status,json=deliveryStatus,proto3,enum=internal_message.service.v1.InternalMessage_DeliveryStatus,oneof" json:"delivery_status,omitempty"`             // 投递状态
+	RecipientTotal     *uint32                         `protobuf:"varint,17,opt,name=recipient_total,json=recipientTotal,proto3,oneof" json:"recipient_total,omitempty"`                                                                             // 接收者总数
+	DeliveredCount     *uint32                         `protobuf:"varint,18,opt,name=delivered_count,json=deliveredCount,proto3,oneof" json:"delivered_count,omitempty"`                                                                             // 已投递数量
+	FailedCount        *uint32                         `protobuf:"varint,19,opt,name=failed_count,json=failedCount,proto3,oneof" json:"failed_count,omitempty"`                                                                                      // 投递失败数量
+	DeliveryStartedAt  *timestamppb.Timestamp          `protobuf:"bytes,20,opt,name=delivery_started_at,json=deliveryStartedAt,proto3,oneof" json:"delivery_started_at,omitempty"`                                                                   // 开始投递时间
+	DeliveryFinishedAt *timestamppb.Timestamp          `protobuf:"bytes,21,opt,name=delivery_finished_at,json=deliveryFinishedAt,proto3,oneof" json:"delivery_finished_at,omitempty"`                                                                // 完成投递时间
+	TemplateCode       *string                         `protobuf:"bytes,22,opt,name=template_code,json=templateCode,proto3,oneof" json:"template_code,omitempty"`                                                                                    // 消息模板编码
+	TemplateVariables  map[string]string               `protobuf:"bytes,23,rep,name=template_variables,json=templateVariables,proto3" json:"template_variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 消息模板变量
+	ConversationId     *uint32                         `protobuf:"varint,24,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`                                                                             // 会话ID
+	ThreadId           *uint32                         `protobuf:"varint,25,opt,name=thread_id,json=threadId,proto3,oneof" json:"thread_id,omitempty"`                                                                                               // 话题ID
+	ReplyCount         *uint32                         `protobuf:"varint,26,opt,name=reply_count,json=replyCount,proto3,oneof" json:"reply_count,omitempty"`                                                                                         // 回复数量
+	RemindCount        *uint32                         `protobuf:"varint,27,opt,name=remind_count,json=remindCount,proto3,oneof" json:"remind_count,omitempty"`                                                                                      // 提醒未读者的次数
+	LastRemindedAt     *timestamppb.Timestamp          `protobuf:"bytes,28,opt,name=last_reminded_at,json=lastRemindedAt,proto3,oneof" json:"last_reminded_at,omitempty"`                                                                            // 最近一次提醒未读者的时间
+	Priority           *InternalMessage_Priority       `protobuf:"varint,29,opt,name=priority,proto3,enum=internal_message.service.v1.InternalMessage_Priority,oneof" json:"priority,omitempty"`                                                     // 消息优先级
+	TenantId           *uint32                         `protobuf:"varint,30,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`                                                                                               // 租户ID
+	CreatedBy          *uint32                         `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                                                                                           // 创建者ID
+	UpdatedBy          *uint32                         `protobuf:"varint,101,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                                                                                           // 更新者ID
+	DeletedBy          *uint32                         `protobuf:"varint,102,opt,name=deleted_by,json=deletedBy,proto3,oneof" json:"deleted_by,omitempty"`                                                                                           // 删除者用户ID
+	CreatedAt          *timestamppb.Timestamp          `protobuf:"bytes,200,opt,name=created_at,json=createdAt,proto3,oneof" json:"created_at,omitempty"`                                                                                            // 创建时间
+	UpdatedAt          *timestamppb.Timestamp          `protobuf:"bytes,201,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`                                                                                            // 更新时间
+	DeletedAt          *timestamppb.Timestamp          `protobuf:"bytes,202,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`                                                                                            // 删除时间
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *InternalMessage) Reset() {
@@ -251,6 +381,153 @@ func (x *InternalMessage) GetCategoryName() string {
 	return ""
 }
 
+func (x *InternalMessage) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetCronSpec() string {
+	if x != nil && x.CronSpec != nil {
+		return *x.CronSpec
+	}
+	return ""
+}
+
+func (x *InternalMessage) GetLastSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSentAt
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetTargetAll() bool {
+	if x != nil && x.TargetAll != nil {
+		return *x.TargetAll
+	}
+	return false
+}
+
+func (x *InternalMessage) GetTargetUserIds() []uint32 {
+	if x != nil {
+		return x.TargetUserIds
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetAudience() *MessageAudience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetDeliveryStatus() InternalMessage_DeliveryStatus {
+	if x != nil && x.DeliveryStatus != nil {
+		return *x.DeliveryStatus
+	}
+	return InternalMessage_PENDING
+}
+
+func (x *InternalMessage) GetRecipientTotal() uint32 {
+	if x != nil && x.RecipientTotal != nil {
+		return *x.RecipientTotal
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetDeliveredCount() uint32 {
+	if x != nil && x.DeliveredCount != nil {
+		return *x.DeliveredCount
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetFailedCount() uint32 {
+	if x != nil && x.FailedCount != nil {
+		return *x.FailedCount
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetDeliveryStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryStartedAt
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetDeliveryFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveryFinishedAt
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetTemplateCode() string {
+	if x != nil && x.TemplateCode != nil {
+		return *x.TemplateCode
+	}
+	return ""
+}
+
+func (x *InternalMessage) GetTemplateVariables() map[string]string {
+	if x != nil {
+		return x.TemplateVariables
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetConversationId() uint32 {
+	if x != nil && x.ConversationId != nil {
+		return *x.ConversationId
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetThreadId() uint32 {
+	if x != nil && x.ThreadId != nil {
+		return *x.ThreadId
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetReplyCount() uint32 {
+	if x != nil && x.ReplyCount != nil {
+		return *x.ReplyCount
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetRemindCount() uint32 {
+	if x != nil && x.RemindCount != nil {
+		return *x.RemindCount
+	}
+	return 0
+}
+
+func (x *InternalMessage) GetLastRemindedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRemindedAt
+	}
+	return nil
+}
+
+func (x *InternalMessage) GetPriority() InternalMessage_Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return InternalMessage_NORMAL
+}
+
+func (x *InternalMessage) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 func (x *InternalMessage) GetCreatedBy() uint32 {
 	if x != nil && x.CreatedBy != nil {
 		return *x.CreatedBy
@@ -581,15 +858,22 @@ func (x *DeleteInternalMessageRequest) GetId() uint32 {
 }
 
 type SendMessageRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Type            InternalMessage_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type" json:"type,omitempty"` // 消息类型
-	RecipientUserId *uint32                `protobuf:"varint,2,opt,name=recipient_user_id,json=recipientUserId,proto3,oneof" json:"recipient_user_id,omitempty"`  // 接收者用户ID
-	ConversationId  *uint32                `protobuf:"varint,3,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`       // 会话ID
-	CategoryId      *uint32                `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                   // 分类ID
-	TargetUserIds   []uint32               `protobuf:"varint,6,rep,packed,name=target_user_ids,json=targetUserIds,proto3" json:"target_user_ids,omitempty"`       // 定向发送用户ID列表
-	TargetAll       *bool                  `protobuf:"varint,7,opt,name=target_all,json=targetAll,proto3,oneof" json:"target_all,omitempty"`                      // 全员发送标志
-	Title           *string                `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`                                               // 消息标题
-	Content         string                 `protobuf:"bytes,11,opt,name=content,proto3" json:"content,omitempty"`                                                 // 消息内容
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	Type            InternalMessage_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type" json:"type,omitempty"`                               // 消息类型
+	RecipientUserId *uint32                   `protobuf:"varint,2,opt,name=recipient_user_id,json=recipientUserId,proto3,oneof" json:"recipient_user_id,omitempty"`                                // 接收者用户ID
+	ConversationId  *uint32                   `protobuf:"varint,3,opt,name=conversation_id,json=conversationId,proto3,oneof" json:"conversation_id,omitempty"`                                     // 会话ID
+	CategoryId      *uint32                   `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                                                 // 分类ID
+	TargetUserIds   []uint32                  `protobuf:"varint,6,rep,packed,name=target_user_ids,json=targetUserIds,proto3" json:"target_user_ids,omitempty"`                                     // 定向发送用户ID列表
+	TargetAll       *bool                     `protobuf:"varint,7,opt,name=target_all,json=targetAll,proto3,oneof" json:"target_all,omitempty"`                                                    // 全员发送标志
+	Title           *string                   `protobuf:"bytes,10,opt,name=title,proto3,oneof" json:"title,omitempty"`                                                                             // 消息标题
+	Content         string                    `protobuf:"bytes,11,opt,name=content,proto3" json:"content,omitempty"`                                                                               // 消息内容
+	SendAt          *timestamppb.Timestamp    `protobuf:"bytes,12,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`                                                             // 定时发送时间
+	CronSpec        *string                   `protobuf:"bytes,13,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"`                                                       // 周期发送的cron表达式
+	Audience        *MessageAudience          `protobuf:"bytes,14,opt,name=audience,proto3,oneof" json:"audience,omitempty"`                                                                       // 受众表达式
+	TemplateCode    *string                   `protobuf:"bytes,15,opt,name=template_code,json=templateCode,proto3,oneof" json:"template_code,omitempty"`                                           // 消息模板编码
+	Variables       map[string]string         `protobuf:"bytes,16,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 消息模板变量
+	ThreadId        *uint32                   `protobuf:"varint,17,opt,name=thread_id,json=threadId,proto3,oneof" json:"thread_id,omitempty"`                                                      // 话题ID
+	Priority        *InternalMessage_Priority `protobuf:"varint,18,opt,name=priority,proto3,enum=internal_message.service.v1.InternalMessage_Priority,oneof" json:"priority,omitempty"`            // 消息优先级
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -680,9 +964,59 @@ func (x *SendMessageRequest) GetContent() string {
 	return ""
 }
 
+func (x *SendMessageRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetCronSpec() string {
+	if x != nil && x.CronSpec != nil {
+		return *x.CronSpec
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetAudience() *MessageAudience {
+	if x != nil {
+		return x.Audience
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetTemplateCode() string {
+	if x != nil && x.TemplateCode != nil {
+		return *x.TemplateCode
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *SendMessageRequest) GetThreadId() uint32 {
+	if x != nil && x.ThreadId != nil {
+		return *x.ThreadId
+	}
+	return 0
+}
+
+func (x *SendMessageRequest) GetPriority() InternalMessage_Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return InternalMessage_NORMAL
+}
+
 type SendMessageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`     // 定时消息的发送时间
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -724,10 +1058,17 @@ func (x *SendMessageResponse) GetMessageId() uint32 {
 	return 0
 }
 
+func (x *SendMessageResponse) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
 type RevokeMessageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
-	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // 用户ID
+	UserId        uint32                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`          // 用户ID，为空时撤销所有接收者的消息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -776,12 +1117,348 @@ func (x *RevokeMessageRequest) GetUserId() uint32 {
 	return 0
 }
 
+// 修改定时消息 - 请求
+type UpdateScheduledMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`   // 消息ID
+	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`                       // 消息标题
+	Content       *string                `protobuf:"bytes,3,opt,name=content,proto3,oneof" json:"content,omitempty"`                   // 消息内容
+	SendAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=send_at,json=sendAt,proto3,oneof" json:"send_at,omitempty"`       // 定时发送时间
+	CronSpec      *string                `protobuf:"bytes,5,opt,name=cron_spec,json=cronSpec,proto3,oneof" json:"cron_spec,omitempty"` // 周期发送的cron表达式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateScheduledMessageRequest) Reset() {
+	*x = UpdateScheduledMessageRequest{}
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateScheduledMessageRequest) ProtoMessage() {}
+
+func (x *UpdateScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateScheduledMessageRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *UpdateScheduledMessageRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateScheduledMessageRequest) GetContent() string {
+	if x != nil && x.Content != nil {
+		return *x.Content
+	}
+	return ""
+}
+
+func (x *UpdateScheduledMessageRequest) GetSendAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *UpdateScheduledMessageRequest) GetCronSpec() string {
+	if x != nil && x.CronSpec != nil {
+		return *x.CronSpec
+	}
+	return ""
+}
+
+// 取消定时消息 - 请求
+type CancelScheduledMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMessageRequest) Reset() {
+	*x = CancelScheduledMessageRequest{}
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMessageRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMessageRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMessageRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelScheduledMessageRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// 受众筛选条件，各条件之间为并集
+type AudienceFilter struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	UserIds                 []uint32               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`                                                  // 用户ID列表
+	RoleIds                 []uint32               `protobuf:"varint,2,rep,packed,name=role_ids,json=roleIds,proto3" json:"role_ids,omitempty"`                                                  // 角色ID列表
+	DepartmentIds           []uint32               `protobuf:"varint,3,rep,packed,name=department_ids,json=departmentIds,proto3" json:"department_ids,omitempty"`                                // 部门ID列表
+	OrganizationIds         []uint32               `protobuf:"varint,4,rep,packed,name=organization_ids,json=organizationIds,proto3" json:"organization_ids,omitempty"`                          // 组织ID列表
+	PositionIds             []uint32               `protobuf:"varint,5,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty"`                                      // 岗位ID列表
+	TenantIds               []uint32               `protobuf:"varint,6,rep,packed,name=tenant_ids,json=tenantIds,proto3" json:"tenant_ids,omitempty"`                                            // 租户ID列表
+	IncludeSubDepartments   *bool                  `protobuf:"varint,7,opt,name=include_sub_departments,json=includeSubDepartments,proto3,oneof" json:"include_sub_departments,omitempty"`       // 部门条件是否包含下级部门
+	IncludeSubOrganizations *bool                  `protobuf:"varint,8,opt,name=include_sub_organizations,json=includeSubOrganizations,proto3,oneof" json:"include_sub_organizations,omitempty"` // 组织条件是否包含下级组织
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *AudienceFilter) Reset() {
+	*x = AudienceFilter{}
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudienceFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceFilter) ProtoMessage() {}
+
+func (x *AudienceFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceFilter.ProtoReflect.Descriptor instead.
+func (*AudienceFilter) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AudienceFilter) GetUserIds() []uint32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetRoleIds() []uint32 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetDepartmentIds() []uint32 {
+	if x != nil {
+		return x.DepartmentIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetOrganizationIds() []uint32 {
+	if x != nil {
+		return x.OrganizationIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetPositionIds() []uint32 {
+	if x != nil {
+		return x.PositionIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetTenantIds() []uint32 {
+	if x != nil {
+		return x.TenantIds
+	}
+	return nil
+}
+
+func (x *AudienceFilter) GetIncludeSubDepartments() bool {
+	if x != nil && x.IncludeSubDepartments != nil {
+		return *x.IncludeSubDepartments
+	}
+	return false
+}
+
+func (x *AudienceFilter) GetIncludeSubOrganizations() bool {
+	if x != nil && x.IncludeSubOrganizations != nil {
+		return *x.IncludeSubOrganizations
+	}
+	return false
+}
+
+// 受众表达式：全员或 include 选出的用户，去掉 exclude 选出的用户
+type MessageAudience struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	All           *bool                  `protobuf:"varint,1,opt,name=all,proto3,oneof" json:"all,omitempty"`        // 全员
+	Include       *AudienceFilter        `protobuf:"bytes,2,opt,name=include,proto3,oneof" json:"include,omitempty"` // 包含的用户
+	Exclude       *AudienceFilter        `protobuf:"bytes,3,opt,name=exclude,proto3,oneof" json:"exclude,omitempty"` // 排除的用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageAudience) Reset() {
+	*x = MessageAudience{}
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageAudience) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageAudience) ProtoMessage() {}
+
+func (x *MessageAudience) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageAudience.ProtoReflect.Descriptor instead.
+func (*MessageAudience) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MessageAudience) GetAll() bool {
+	if x != nil && x.All != nil {
+		return *x.All
+	}
+	return false
+}
+
+func (x *MessageAudience) GetInclude() *AudienceFilter {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+func (x *MessageAudience) GetExclude() *AudienceFilter {
+	if x != nil {
+		return x.Exclude
+	}
+	return nil
+}
+
+// 预览受众 - 回应
+type PreviewAudienceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint32               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"` // 接收者用户ID列表
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                           // 接收者数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewAudienceResponse) Reset() {
+	*x = PreviewAudienceResponse{}
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewAudienceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewAudienceResponse) ProtoMessage() {}
+
+func (x *PreviewAudienceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewAudienceResponse.ProtoReflect.Descriptor instead.
+func (*PreviewAudienceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PreviewAudienceResponse) GetUserIds() []uint32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *PreviewAudienceResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 var File_internal_message_service_v1_internal_message_proto protoreflect.FileDescriptor
 
 const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"\n" +
-	"2internal_message/service/v1/internal_message.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xec\n" +
-	"\n" +
+	"2internal_message/service/v1/internal_message.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xe5\x1e\n" +
 	"\x0fInternalMessage\x12&\n" +
 	"\x02id\x18\x01 \x01(\rB\x11\xe0A\x01\xbaG\v\x92\x02\b消息IDH\x00R\x02id\x88\x01\x01\x12-\n" +
 	"\x05title\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f消息标题H\x01R\x05title\x88\x01\x01\x121\n" +
@@ -793,20 +1470,48 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"senderName\x88\x01\x01\x124\n" +
 	"\vcategory_id\x18\b \x01(\rB\x0e\xbaG\v\x92\x02\b分类IDH\aR\n" +
 	"categoryId\x88\x01\x01\x12<\n" +
-	"\rcategory_name\x18\t \x01(\tB\x12\xbaG\x0f\x92\x02\f分类名称H\bR\fcategoryName\x88\x01\x01\x125\n" +
+	"\rcategory_name\x18\t \x01(\tB\x12\xbaG\x0f\x92\x02\f分类名称H\bR\fcategoryName\x88\x01\x01\x12y\n" +
+	"\asend_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB?\xbaG<\x92\x029定时发送时间，周期消息为下一次发送时间H\tR\x06sendAt\x88\x01\x01\x12D\n" +
+	"\tcron_spec\x18\v \x01(\tB\"\xbaG\x1f\x92\x02\x1c周期发送的cron表达式H\n" +
+	"R\bcronSpec\x88\x01\x01\x12a\n" +
+	"\flast_sent_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xbaG\x1b\x92\x02\x18最近一次发送时间H\vR\n" +
+	"lastSentAt\x88\x01\x01\x12<\n" +
+	"\n" +
+	"target_all\x18\r \x01(\bB\x18\xbaG\x15\x92\x02\x12全员发送标志H\fR\ttargetAll\x88\x01\x01\x12~\n" +
+	"\x0ftarget_user_ids\x18\x0e \x03(\rBV\xbaGS\x92\x02P接收者用户ID列表，按受众发送时为发送时解析出的受众快照R\rtargetUserIds\x12d\n" +
+	"\baudience\x18\x0f \x01(\v2,.internal_message.service.v1.MessageAudienceB\x15\xbaG\x12\x92\x02\x0f受众表达式H\rR\baudience\x88\x01\x01\x12}\n" +
+	"\x0fdelivery_status\x18\x10 \x01(\x0e2;.internal_message.service.v1.InternalMessage.DeliveryStatusB\x12\xbaG\x0f\x92\x02\f投递状态H\x0eR\x0edeliveryStatus\x88\x01\x01\x12C\n" +
+	"\x0frecipient_total\x18\x11 \x01(\rB\x15\xbaG\x12\x92\x02\x0f接收者总数H\x0fR\x0erecipientTotal\x88\x01\x01\x12C\n" +
+	"\x0fdelivered_count\x18\x12 \x01(\rB\x15\xbaG\x12\x92\x02\x0f已投递数量H\x10R\x0edeliveredCount\x88\x01\x01\x12@\n" +
+	"\ffailed_count\x18\x13 \x01(\rB\x18\xbaG\x15\x92\x02\x12投递失败数量H\x11R\vfailedCount\x88\x01\x01\x12i\n" +
+	"\x13delivery_started_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12开始投递时间H\x12R\x11deliveryStartedAt\x88\x01\x01\x12k\n" +
+	"\x14delivery_finished_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12完成投递时间H\x13R\x12deliveryFinishedAt\x88\x01\x01\x12\x81\x01\n" +
+	"\rtemplate_code\x18\x16 \x01(\tBW\xbaGT\x92\x02Q消息模板编码，设置后按接收者的语言与资料渲染标题和内容H\x14R\ftemplateCode\x88\x01\x01\x12\x8c\x01\n" +
+	"\x12template_variables\x18\x17 \x03(\v2C.internal_message.service.v1.InternalMessage.TemplateVariablesEntryB\x18\xbaG\x15\x92\x02\x12消息模板变量R\x11templateVariables\x12c\n" +
+	"\x0fconversation_id\x18\x18 \x01(\rB5\xbaG2\x92\x02/会话ID，私信与群聊消息所属的会话H\x15R\x0econversationId\x88\x01\x01\x12S\n" +
+	"\tthread_id\x18\x19 \x01(\rB1\xbaG.\x92\x02+话题ID，回复消息所属的根消息IDH\x16R\bthreadId\x88\x01\x01\x128\n" +
+	"\vreply_count\x18\x1a \x01(\rB\x12\xbaG\x0f\x92\x02\f回复数量H\x17R\n" +
+	"replyCount\x88\x01\x01\x12F\n" +
+	"\fremind_count\x18\x1b \x01(\rB\x1e\xbaG\x1b\x92\x02\x18提醒未读者的次数H\x18R\vremindCount\x88\x01\x01\x12u\n" +
+	"\x10last_reminded_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampB*\xbaG'\x92\x02$最近一次提醒未读者的时间H\x19R\x0elastRemindedAt\x88\x01\x01\x12m\n" +
+	"\bpriority\x18\x1d \x01(\x0e25.internal_message.service.v1.InternalMessage.PriorityB\x15\xbaG\x12\x92\x02\x0f消息优先级H\x1aR\bpriority\x88\x01\x01\x120\n" +
+	"\ttenant_id\x18\x1e \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x1bR\btenantId\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\tR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x1cR\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\n" +
-	"R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x1dR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\vR\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x1eR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\fR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1fR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\rR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H R\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x0eR\tdeletedAt\x88\x01\x01\"Y\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H!R\tdeletedAt\x88\x01\x01\x1aD\n" +
+	"\x16TemplateVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
 	"\x06Status\x12\t\n" +
 	"\x05DRAFT\x10\x00\x12\r\n" +
 	"\tPUBLISHED\x10\x01\x12\r\n" +
@@ -817,7 +1522,22 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"\x04Type\x12\x10\n" +
 	"\fNOTIFICATION\x10\x00\x12\v\n" +
 	"\aPRIVATE\x10\x01\x12\t\n" +
-	"\x05GROUP\x10\x02B\x05\n" +
+	"\x05GROUP\x10\x02\"^\n" +
+	"\x0eDeliveryStatus\x12\v\n" +
+	"\aPENDING\x10\x00\x12\x0e\n" +
+	"\n" +
+	"DELIVERING\x10\x01\x12\r\n" +
+	"\tDELIVERED\x10\x02\x12\x14\n" +
+	"\x10PARTIALLY_FAILED\x10\x03\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x04\"5\n" +
+	"\bPriority\x12\n" +
+	"\n" +
+	"\x06NORMAL\x10\x00\x12\a\n" +
+	"\x03LOW\x10\x01\x12\b\n" +
+	"\x04HIGH\x10\x02\x12\n" +
+	"\n" +
+	"\x06URGENT\x10\x03B\x05\n" +
 	"\x03_idB\b\n" +
 	"\x06_titleB\n" +
 	"\n" +
@@ -828,7 +1548,30 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"_sender_idB\x0e\n" +
 	"\f_sender_nameB\x0e\n" +
 	"\f_category_idB\x10\n" +
-	"\x0e_category_nameB\r\n" +
+	"\x0e_category_nameB\n" +
+	"\n" +
+	"\b_send_atB\f\n" +
+	"\n" +
+	"_cron_specB\x0f\n" +
+	"\r_last_sent_atB\r\n" +
+	"\v_target_allB\v\n" +
+	"\t_audienceB\x12\n" +
+	"\x10_delivery_statusB\x12\n" +
+	"\x10_recipient_totalB\x12\n" +
+	"\x10_delivered_countB\x0f\n" +
+	"\r_failed_countB\x16\n" +
+	"\x14_delivery_started_atB\x17\n" +
+	"\x15_delivery_finished_atB\x10\n" +
+	"\x0e_template_codeB\x12\n" +
+	"\x10_conversation_idB\f\n" +
+	"\n" +
+	"_thread_idB\x0e\n" +
+	"\f_reply_countB\x0f\n" +
+	"\r_remind_countB\x13\n" +
+	"\x11_last_reminded_atB\v\n" +
+	"\t_priorityB\f\n" +
+	"\n" +
+	"_tenant_idB\r\n" +
 	"\v_created_byB\r\n" +
 	"\v_updated_byB\r\n" +
 	"\v_deleted_byB\r\n" +
@@ -856,7 +1599,7 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"\rallow_missing\x18\x04 \x01(\bB\x89\x01\xbaG\x85\x01\x92\x02\x81\x01如果设置为true的时候，资源不存在则会新增(插入)，并且在这种情况下`updateMask`字段将会被忽略。H\x00R\fallowMissing\x88\x01\x01B\x10\n" +
 	"\x0e_allow_missing\".\n" +
 	"\x1cDeleteInternalMessageRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"\xe5\x04\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xe9\f\n" +
 	"\x12SendMessageRequest\x12Y\n" +
 	"\x04type\x18\x01 \x01(\x0e21.internal_message.service.v1.InternalMessage.TypeB\x12\xbaG\x0f\x92\x02\f消息类型R\x04type\x12H\n" +
 	"\x11recipient_user_id\x18\x02 \x01(\rB\x17\xbaG\x14\x92\x02\x11接收者用户IDH\x00R\x0frecipientUserId\x88\x01\x01\x12<\n" +
@@ -868,19 +1611,83 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"target_all\x18\a \x01(\bB\x18\xbaG\x15\x92\x02\x12全员发送标志H\x03R\ttargetAll\x88\x01\x01\x12-\n" +
 	"\x05title\x18\n" +
 	" \x01(\tB\x12\xbaG\x0f\x92\x02\f消息标题H\x04R\x05title\x88\x01\x01\x12,\n" +
-	"\acontent\x18\v \x01(\tB\x12\xbaG\x0f\x92\x02\f消息内容R\acontentB\x14\n" +
+	"\acontent\x18\v \x01(\tB\x12\xbaG\x0f\x92\x02\f消息内容R\acontent\x12j\n" +
+	"\asend_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB0\xbaG-\x92\x02*定时发送时间，为空时立即发送H\x05R\x06sendAt\x88\x01\x01\x12h\n" +
+	"\tcron_spec\x18\r \x01(\tBF\xbaGC\x92\x02@周期发送的cron表达式，设置后按表达式重复发送H\x06R\bcronSpec\x88\x01\x01\x12\xb1\x01\n" +
+	"\baudience\x18\x0e \x01(\v2,.internal_message.service.v1.MessageAudienceBb\xbaG_\x92\x02\\受众表达式，与 target_all、recipient_user_id、target_user_ids 合并计算接收者H\aR\baudience\x88\x01\x01\x12l\n" +
+	"\rtemplate_code\x18\x0f \x01(\tBB\xbaG?\x92\x02<消息模板编码，设置后标题与内容由模板渲染H\bR\ftemplateCode\x88\x01\x01\x12\xa3\x01\n" +
+	"\tvariables\x18\x10 \x03(\v2>.internal_message.service.v1.SendMessageRequest.VariablesEntryBE\xbaGB\x92\x02?消息模板变量，模板中通过 {{.Vars.变量名}} 使用R\tvariables\x12Q\n" +
+	"\tthread_id\x18\x11 \x01(\rB/\xbaG,\x92\x02)回复的话题ID，只用于会话消息H\tR\bthreadId\x88\x01\x01\x12\x7f\n" +
+	"\bpriority\x18\x12 \x01(\x0e25.internal_message.service.v1.InternalMessage.PriorityB'\xbaG$\x92\x02!消息优先级，默认为普通H\n" +
+	"R\bpriority\x88\x01\x01\x1a<\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x14\n" +
 	"\x12_recipient_user_idB\x12\n" +
 	"\x10_conversation_idB\x0e\n" +
 	"\f_category_idB\r\n" +
 	"\v_target_allB\b\n" +
-	"\x06_title\"D\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_send_atB\f\n" +
+	"\n" +
+	"_cron_specB\v\n" +
+	"\t_audienceB\x10\n" +
+	"\x0e_template_codeB\f\n" +
+	"\n" +
+	"_thread_idB\v\n" +
+	"\t_priority\"\xc5\x01\n" +
 	"\x13SendMessageResponse\x12-\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\"n\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x12s\n" +
+	"\asend_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB9\xbaG6\x92\x023定时消息的发送时间，立即发送时为空H\x00R\x06sendAt\x88\x01\x01B\n" +
+	"\n" +
+	"\b_send_at\"\x98\x01\n" +
 	"\x14RevokeMessageRequest\x12-\n" +
 	"\n" +
-	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x12'\n" +
-	"\auser_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId2\x8a\x06\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x12Q\n" +
+	"\auser_id\x18\x02 \x01(\rB8\xbaG5\x92\x022用户ID，为空时撤销所有接收者的消息R\x06userId\"\xaa\x03\n" +
+	"\x1dUpdateScheduledMessageRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x12-\n" +
+	"\x05title\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f消息标题H\x00R\x05title\x88\x01\x01\x121\n" +
+	"\acontent\x18\x03 \x01(\tB\x12\xbaG\x0f\x92\x02\f消息内容H\x01R\acontent\x88\x01\x01\x12R\n" +
+	"\asend_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12定时发送时间H\x02R\x06sendAt\x88\x01\x01\x12t\n" +
+	"\tcron_spec\x18\x05 \x01(\tBR\xbaGO\x92\x02L周期发送的cron表达式，设置为空字符串时改为只发送一次H\x03R\bcronSpec\x88\x01\x01B\b\n" +
+	"\x06_titleB\n" +
+	"\n" +
+	"\b_contentB\n" +
+	"\n" +
+	"\b_send_atB\f\n" +
+	"\n" +
+	"_cron_spec\"N\n" +
+	"\x1dCancelScheduledMessageRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\"\xee\x04\n" +
+	"\x0eAudienceFilter\x12/\n" +
+	"\buser_ids\x18\x01 \x03(\rB\x14\xbaG\x11\x92\x02\x0e用户ID列表R\auserIds\x12/\n" +
+	"\brole_ids\x18\x02 \x03(\rB\x14\xbaG\x11\x92\x02\x0e角色ID列表R\aroleIds\x12;\n" +
+	"\x0edepartment_ids\x18\x03 \x03(\rB\x14\xbaG\x11\x92\x02\x0e部门ID列表R\rdepartmentIds\x12?\n" +
+	"\x10organization_ids\x18\x04 \x03(\rB\x14\xbaG\x11\x92\x02\x0e组织ID列表R\x0forganizationIds\x127\n" +
+	"\fposition_ids\x18\x05 \x03(\rB\x14\xbaG\x11\x92\x02\x0e岗位ID列表R\vpositionIds\x123\n" +
+	"\n" +
+	"tenant_ids\x18\x06 \x03(\rB\x14\xbaG\x11\x92\x02\x0e租户ID列表R\ttenantIds\x12g\n" +
+	"\x17include_sub_departments\x18\a \x01(\bB*\xbaG'\x92\x02$部门条件是否包含下级部门H\x00R\x15includeSubDepartments\x88\x01\x01\x12k\n" +
+	"\x19include_sub_organizations\x18\b \x01(\bB*\xbaG'\x92\x02$组织条件是否包含下级组织H\x01R\x17includeSubOrganizations\x88\x01\x01B\x1a\n" +
+	"\x18_include_sub_departmentsB\x1c\n" +
+	"\x1a_include_sub_organizations\"\x9c\x02\n" +
+	"\x0fMessageAudience\x12#\n" +
+	"\x03all\x18\x01 \x01(\bB\f\xbaG\t\x92\x02\x06全员H\x00R\x03all\x88\x01\x01\x12a\n" +
+	"\ainclude\x18\x02 \x01(\v2+.internal_message.service.v1.AudienceFilterB\x15\xbaG\x12\x92\x02\x0f包含的用户H\x01R\ainclude\x88\x01\x01\x12a\n" +
+	"\aexclude\x18\x03 \x01(\v2+.internal_message.service.v1.AudienceFilterB\x15\xbaG\x12\x92\x02\x0f排除的用户H\x02R\aexclude\x88\x01\x01B\x06\n" +
+	"\x04_allB\n" +
+	"\n" +
+	"\b_includeB\n" +
+	"\n" +
+	"\b_exclude\"\x80\x01\n" +
+	"\x17PreviewAudienceResponse\x128\n" +
+	"\buser_ids\x18\x01 \x03(\rB\x1d\xbaG\x1a\x92\x02\x17接收者用户ID列表R\auserIds\x12+\n" +
+	"\x05total\x18\x02 \x01(\rB\x15\xbaG\x12\x92\x02\x0f接收者数量R\x05total2\xdd\b\n" +
 	"\x16InternalMessageService\x12d\n" +
 	"\vListMessage\x12\x19.pagination.PagingRequest\x1a8.internal_message.service.v1.ListInternalMessageResponse\"\x00\x12t\n" +
 	"\n" +
@@ -889,7 +1696,10 @@ const file_internal_message_service_v1_internal_message_proto_rawDesc = "" +
 	"\rUpdateMessage\x129.internal_message.service.v1.UpdateInternalMessageRequest\x1a\x16.google.protobuf.Empty\"\x00\x12d\n" +
 	"\rDeleteMessage\x129.internal_message.service.v1.DeleteInternalMessageRequest\x1a\x16.google.protobuf.Empty\"\x00\x12p\n" +
 	"\vSendMessage\x12/.internal_message.service.v1.SendMessageRequest\x1a0.internal_message.service.v1.SendMessageResponse\x12Z\n" +
-	"\rRevokeMessage\x121.internal_message.service.v1.RevokeMessageRequest\x1a\x16.google.protobuf.EmptyB\x81\x02\n" +
+	"\rRevokeMessage\x121.internal_message.service.v1.RevokeMessageRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
+	"\x16UpdateScheduledMessage\x12:.internal_message.service.v1.UpdateScheduledMessageRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
+	"\x16CancelScheduledMessage\x12:.internal_message.service.v1.CancelScheduledMessageRequest\x1a\x16.google.protobuf.Empty\x12u\n" +
+	"\x0fPreviewAudience\x12,.internal_message.service.v1.MessageAudience\x1a4.internal_message.service.v1.PreviewAudienceResponseB\x81\x02\n" +
 	"\x1fcom.internal_message.service.v1B\x14InternalMessageProtoP\x01Z>go-wind-admin/api/gen/go/internal_message/service/v1;servicev1\xa2\x02\x03ISX\xaa\x02\x1aInternalMessage.Service.V1\xca\x02\x1aInternalMessage\\Service\\V1\xe2\x02&InternalMessage\\Service\\V1\\GPBMetadata\xea\x02\x1cInternalMessage::Service::V1b\x06proto3"
 
 var (
@@ -904,56 +1714,88 @@ func file_internal_message_service_v1_internal_message_proto_rawDescGZIP() []byt
 	return file_internal_message_service_v1_internal_message_proto_rawDescData
 }
 
-var file_internal_message_service_v1_internal_message_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_internal_message_service_v1_internal_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_internal_message_service_v1_internal_message_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_internal_message_service_v1_internal_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_internal_message_service_v1_internal_message_proto_goTypes = []any{
-	(InternalMessage_Status)(0),          // 0: internal_message.service.v1.InternalMessage.Status
-	(InternalMessage_Type)(0),            // 1: internal_message.service.v1.InternalMessage.Type
-	(*InternalMessage)(nil),              // 2: internal_message.service.v1.InternalMessage
-	(*ListInternalMessageResponse)(nil),  // 3: internal_message.service.v1.ListInternalMessageResponse
-	(*GetInternalMessageRequest)(nil),    // 4: internal_message.service.v1.GetInternalMessageRequest
-	(*CreateInternalMessageRequest)(nil), // 5: internal_message.service.v1.CreateInternalMessageRequest
-	(*UpdateInternalMessageRequest)(nil), // 6: internal_message.service.v1.UpdateInternalMessageRequest
-	(*DeleteInternalMessageRequest)(nil), // 7: internal_message.service.v1.DeleteInternalMessageRequest
-	(*SendMessageRequest)(nil),           // 8: internal_message.service.v1.SendMessageRequest
-	(*SendMessageResponse)(nil),          // 9: internal_message.service.v1.SendMessageResponse
-	(*RevokeMessageRequest)(nil),         // 10: internal_message.service.v1.RevokeMessageRequest
-	(*timestamppb.Timestamp)(nil),        // 11: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),        // 12: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),             // 13: pagination.PagingRequest
-	(*emptypb.Empty)(nil),                // 14: google.protobuf.Empty
+	(InternalMessage_Status)(0),           // 0: internal_message.service.v1.InternalMessage.Status
+	(InternalMessage_Type)(0),             // 1: internal_message.service.v1.InternalMessage.Type
+	(InternalMessage_DeliveryStatus)(0),   // 2: internal_message.service.v1.InternalMessage.DeliveryStatus
+	(InternalMessage_Priority)(0),         // 3: internal_message.service.v1.InternalMessage.Priority
+	(*InternalMessage)(nil),               // 4: internal_message.service.v1.InternalMessage
+	(*ListInternalMessageResponse)(nil),   // 5: internal_message.service.v1.ListInternalMessageResponse
+	(*GetInternalMessageRequest)(nil),     // 6: internal_message.service.v1.GetInternalMessageRequest
+	(*CreateInternalMessageRequest)(nil),  // 7: internal_message.service.v1.CreateInternalMessageRequest
+	(*UpdateInternalMessageRequest)(nil),  // 8: internal_message.service.v1.UpdateInternalMessageRequest
+	(*DeleteInternalMessageRequest)(nil),  // 9: internal_message.service.v1.DeleteInternalMessageRequest
+	(*SendMessageRequest)(nil),            // 10: internal_message.service.v1.SendMessageRequest
+	(*SendMessageResponse)(nil),           // 11: internal_message.service.v1.SendMessageResponse
+	(*RevokeMessageRequest)(nil),          // 12: internal_message.service.v1.RevokeMessageRequest
+	(*UpdateScheduledMessageRequest)(nil), // 13: internal_message.service.v1.UpdateScheduledMessageRequest
+	(*CancelScheduledMessageRequest)(nil), // 14: internal_message.service.v1.CancelScheduledMessageRequest
+	(*AudienceFilter)(nil),                // 15: internal_message.service.v1.AudienceFilter
+	(*MessageAudience)(nil),               // 16: internal_message.service.v1.MessageAudience
+	(*PreviewAudienceResponse)(nil),       // 17: internal_message.service.v1.PreviewAudienceResponse
+	nil,                                   // 18: internal_message.service.v1.InternalMessage.TemplateVariablesEntry
+	nil,                                   // 19: internal_message.service.v1.SendMessageRequest.VariablesEntry
+	(*timestamppb.Timestamp)(nil),         // 20: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),         // 21: google.protobuf.FieldMask
+	(*v1.PagingRequest)(nil),              // 22: pagination.PagingRequest
+	(*emptypb.Empty)(nil),                 // 23: google.protobuf.Empty
 }
 var file_internal_message_service_v1_internal_message_proto_depIdxs = []int32{
 	0,  // 0: internal_message.service.v1.InternalMessage.status:type_name -> internal_message.service.v1.InternalMessage.Status
 	1,  // 1: internal_message.service.v1.InternalMessage.type:type_name -> internal_message.service.v1.InternalMessage.Type
-	11, // 2: internal_message.service.v1.InternalMessage.created_at:type_name -> google.protobuf.Timestamp
-	11, // 3: internal_message.service.v1.InternalMessage.updated_at:type_name -> google.protobuf.Timestamp
-	11, // 4: internal_message.service.v1.InternalMessage.deleted_at:type_name -> google.protobuf.Timestamp
-	2,  // 5: internal_message.service.v1.ListInternalMessageResponse.items:type_name -> internal_message.service.v1.InternalMessage
-	12, // 6: internal_message.service.v1.GetInternalMessageRequest.view_mask:type_name -> google.protobuf.FieldMask
-	2,  // 7: internal_message.service.v1.CreateInternalMessageRequest.data:type_name -> internal_message.service.v1.InternalMessage
-	2,  // 8: internal_message.service.v1.UpdateInternalMessageRequest.data:type_name -> internal_message.service.v1.InternalMessage
-	12, // 9: internal_message.service.v1.UpdateInternalMessageRequest.update_mask:type_name -> google.protobuf.FieldMask
-	1,  // 10: internal_message.service.v1.SendMessageRequest.type:type_name -> internal_message.service.v1.InternalMessage.Type
-	13, // 11: internal_message.service.v1.InternalMessageService.ListMessage:input_type -> pagination.PagingRequest
-	4,  // 12: internal_message.service.v1.InternalMessageService.GetMessage:input_type -> internal_message.service.v1.GetInternalMessageRequest
-	5,  // 13: internal_message.service.v1.InternalMessageService.CreateMessage:input_type -> internal_message.service.v1.CreateInternalMessageRequest
-	6,  // 14: internal_message.service.v1.InternalMessageService.UpdateMessage:input_type -> internal_message.service.v1.UpdateInternalMessageRequest
-	7,  // 15: internal_message.service.v1.InternalMessageService.DeleteMessage:input_type -> internal_message.service.v1.DeleteInternalMessageRequest
-	8,  // 16: internal_message.service.v1.InternalMessageService.SendMessage:input_type -> internal_message.service.v1.SendMessageRequest
-	10, // 17: internal_message.service.v1.InternalMessageService.RevokeMessage:input_type -> internal_message.service.v1.RevokeMessageRequest
-	3,  // 18: internal_message.service.v1.InternalMessageService.ListMessage:output_type -> internal_message.service.v1.ListInternalMessageResponse
-	2,  // 19: internal_message.service.v1.InternalMessageService.GetMessage:output_type -> internal_message.service.v1.InternalMessage
-	2,  // 20: internal_message.service.v1.InternalMessageService.CreateMessage:output_type -> internal_message.service.v1.InternalMessage
-	14, // 21: internal_message.service.v1.InternalMessageService.UpdateMessage:output_type -> google.protobuf.Empty
-	14, // 22: internal_message.service.v1.InternalMessageService.DeleteMessage:output_type -> google.protobuf.Empty
-	9,  // 23: internal_message.service.v1.InternalMessageService.SendMessage:output_type -> internal_message.service.v1.SendMessageResponse
-	14, // 24: internal_message.service.v1.InternalMessageService.RevokeMessage:output_type -> google.protobuf.Empty
-	18, // [18:25] is the sub-list for method output_type
-	11, // [11:18] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	20, // 2: internal_message.service.v1.InternalMessage.send_at:type_name -> google.protobuf.Timestamp
+	20, // 3: internal_message.service.v1.InternalMessage.last_sent_at:type_name -> google.protobuf.Timestamp
+	16, // 4: internal_message.service.v1.InternalMessage.audience:type_name -> internal_message.service.v1.MessageAudience
+	2,  // 5: internal_message.service.v1.InternalMessage.delivery_status:type_name -> internal_message.service.v1.InternalMessage.DeliveryStatus
+	20, // 6: internal_message.service.v1.InternalMessage.delivery_started_at:type_name -> google.protobuf.Timestamp
+	20, // 7: internal_message.service.v1.InternalMessage.delivery_finished_at:type_name -> google.protobuf.Timestamp
+	18, // 8: internal_message.service.v1.InternalMessage.template_variables:type_name -> internal_message.service.v1.InternalMessage.TemplateVariablesEntry
+	20, // 9: internal_message.service.v1.InternalMessage.last_reminded_at:type_name -> google.protobuf.Timestamp
+	3,  // 10: internal_message.service.v1.InternalMessage.priority:type_name -> internal_message.service.v1.InternalMessage.Priority
+	20, // 11: internal_message.service.v1.InternalMessage.created_at:type_name -> google.protobuf.Timestamp
+	20, // 12: internal_message.service.v1.InternalMessage.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 13: internal_message.service.v1.InternalMessage.deleted_at:type_name -> google.protobuf.Timestamp
+	4,  // 14: internal_message.service.v1.ListInternalMessageResponse.items:type_name -> internal_message.service.v1.InternalMessage
+	21, // 15: internal_message.service.v1.GetInternalMessageRequest.view_mask:type_name -> google.protobuf.FieldMask
+	4,  // 16: internal_message.service.v1.CreateInternalMessageRequest.data:type_name -> internal_message.service.v1.InternalMessage
+	4,  // 17: internal_message.service.v1.UpdateInternalMessageRequest.data:type_name -> internal_message.service.v1.InternalMessage
+	21, // 18: internal_message.service.v1.UpdateInternalMessageRequest.update_mask:type_name -> google.protobuf.FieldMask
+	1,  // 19: internal_message.service.v1.SendMessageRequest.type:type_name -> internal_message.service.v1.InternalMessage.Type
+	20, // 20: internal_message.service.v1.SendMessageRequest.send_at:type_name -> google.protobuf.Timestamp
+	16, // 21: internal_message.service.v1.SendMessageRequest.audience:type_name -> internal_message.service.v1.MessageAudience
+	19, // 22: internal_message.service.v1.SendMessageRequest.variables:type_name -> internal_message.service.v1.SendMessageRequest.VariablesEntry
+	3,  // 23: internal_message.service.v1.SendMessageRequest.priority:type_name -> internal_message.service.v1.InternalMessage.Priority
+	20, // 24: internal_message.service.v1.SendMessageResponse.send_at:type_name -> google.protobuf.Timestamp
+	20, // 25: internal_message.service.v1.UpdateScheduledMessageRequest.send_at:type_name -> google.protobuf.Timestamp
+	15, // 26: internal_message.service.v1.MessageAudience.include:type_name -> internal_message.service.v1.AudienceFilter
+	15, // 27: internal_message.service.v1.MessageAudience.exclude:type_name -> internal_message.service.v1.AudienceFilter
+	22, // 28: internal_message.service.v1.InternalMessageService.ListMessage:input_type -> pagination.PagingRequest
+	6,  // 29: internal_message.service.v1.InternalMessageService.GetMessage:input_type -> internal_message.service.v1.GetInternalMessageRequest
+	7,  // 30: internal_message.service.v1.InternalMessageService.CreateMessage:input_type -> internal_message.service.v1.CreateInternalMessageRequest
+	8,  // 31: internal_message.service.v1.InternalMessageService.UpdateMessage:input_type -> internal_message.service.v1.UpdateInternalMessageRequest
+	9,  // 32: internal_message.service.v1.InternalMessageService.DeleteMessage:input_type -> internal_message.service.v1.DeleteInternalMessageRequest
+	10, // 33: internal_message.service.v1.InternalMessageService.SendMessage:input_type -> internal_message.service.v1.SendMessageRequest
+	12, // 34: internal_message.service.v1.InternalMessageService.RevokeMessage:input_type -> internal_message.service.v1.RevokeMessageRequest
+	13, // 35: internal_message.service.v1.InternalMessageService.UpdateScheduledMessage:input_type -> internal_message.service.v1.UpdateScheduledMessageRequest
+	14, // 36: internal_message.service.v1.InternalMessageService.CancelScheduledMessage:input_type -> internal_message.service.v1.CancelScheduledMessageRequest
+	16, // 37: internal_message.service.v1.InternalMessageService.PreviewAudience:input_type -> internal_message.service.v1.MessageAudience
+	5,  // 38: internal_message.service.v1.InternalMessageService.ListMessage:output_type -> internal_message.service.v1.ListInternalMessageResponse
+	4,  // 39: internal_message.service.v1.InternalMessageService.GetMessage:output_type -> internal_message.service.v1.InternalMessage
+	4,  // 40: internal_message.service.v1.InternalMessageService.CreateMessage:output_type -> internal_message.service.v1.InternalMessage
+	23, // 41: internal_message.service.v1.InternalMessageService.UpdateMessage:output_type -> google.protobuf.Empty
+	23, // 42: internal_message.service.v1.InternalMessageService.DeleteMessage:output_type -> google.protobuf.Empty
+	11, // 43: internal_message.service.v1.InternalMessageService.SendMessage:output_type -> internal_message.service.v1.SendMessageResponse
+	23, // 44: internal_message.service.v1.InternalMessageService.RevokeMessage:output_type -> google.protobuf.Empty
+	23, // 45: internal_message.service.v1.InternalMessageService.UpdateScheduledMessage:output_type -> google.protobuf.Empty
+	23, // 46: internal_message.service.v1.InternalMessageService.CancelScheduledMessage:output_type -> google.protobuf.Empty
+	17, // 47: internal_message.service.v1.InternalMessageService.PreviewAudience:output_type -> internal_message.service.v1.PreviewAudienceResponse
+	38, // [38:48] is the sub-list for method output_type
+	28, // [28:38] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_internal_message_service_v1_internal_message_proto_init() }
@@ -967,13 +1809,17 @@ func file_internal_message_service_v1_internal_message_proto_init() {
 	}
 	file_internal_message_service_v1_internal_message_proto_msgTypes[4].OneofWrappers = []any{}
 	file_internal_message_service_v1_internal_message_proto_msgTypes[6].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_proto_msgTypes[7].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_proto_msgTypes[9].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_proto_msgTypes[11].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_proto_msgTypes[12].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_message_service_v1_internal_message_proto_rawDesc), len(file_internal_message_service_v1_internal_message_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      4,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
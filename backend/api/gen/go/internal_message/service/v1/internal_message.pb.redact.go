@@ -125,6 +125,39 @@ func (s *redactedInternalMessageServiceServer) RevokeMessage(ctx context.Context
 	return res, err
 }
 
+// UpdateScheduledMessage is the redacted wrapper for the actual InternalMessageServiceServer.UpdateScheduledMessage method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.UpdateScheduledMessage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// CancelScheduledMessage is the redacted wrapper for the actual InternalMessageServiceServer.CancelScheduledMessage method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	res, err := s.srv.CancelScheduledMessage(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// PreviewAudience is the redacted wrapper for the actual InternalMessageServiceServer.PreviewAudience method
+// Unary RPC
+func (s *redactedInternalMessageServiceServer) PreviewAudience(ctx context.Context, in *MessageAudience) (*PreviewAudienceResponse, error) {
+	res, err := s.srv.PreviewAudience(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for InternalMessage
 func (x *InternalMessage) Redact() string {
 	if x == nil {
@@ -149,6 +182,48 @@ func (x *InternalMessage) Redact() string {
 
 	// Safe field: CategoryName
 
+	// Safe field: SendAt
+
+	// Safe field: CronSpec
+
+	// Safe field: LastSentAt
+
+	// Safe field: TargetAll
+
+	// Safe field: TargetUserIds
+
+	// Safe field: Audience
+
+	// Safe field: DeliveryStatus
+
+	// Safe field: RecipientTotal
+
+	// Safe field: DeliveredCount
+
+	// Safe field: FailedCount
+
+	// Safe field: DeliveryStartedAt
+
+	// Safe field: DeliveryFinishedAt
+
+	// Safe field: TemplateCode
+
+	// Safe field: TemplateVariables
+
+	// Safe field: ConversationId
+
+	// Safe field: ThreadId
+
+	// Safe field: ReplyCount
+
+	// Safe field: RemindCount
+
+	// Safe field: LastRemindedAt
+
+	// Safe field: Priority
+
+	// Safe field: TenantId
+
 	// Safe field: CreatedBy
 
 	// Safe field: UpdatedBy
@@ -244,6 +319,20 @@ func (x *SendMessageRequest) Redact() string {
 	// Safe field: Title
 
 	// Safe field: Content
+
+	// Safe field: SendAt
+
+	// Safe field: CronSpec
+
+	// Safe field: Audience
+
+	// Safe field: TemplateCode
+
+	// Safe field: Variables
+
+	// Safe field: ThreadId
+
+	// Safe field: Priority
 	return x.String()
 }
 
@@ -254,6 +343,8 @@ func (x *SendMessageResponse) Redact() string {
 	}
 
 	// Safe field: MessageId
+
+	// Safe field: SendAt
 	return x.String()
 }
 
@@ -268,3 +359,81 @@ func (x *RevokeMessageRequest) Redact() string {
 	// Safe field: UserId
 	return x.String()
 }
+
+// Redact method implementation for UpdateScheduledMessageRequest
+func (x *UpdateScheduledMessageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+
+	// Safe field: Title
+
+	// Safe field: Content
+
+	// Safe field: SendAt
+
+	// Safe field: CronSpec
+	return x.String()
+}
+
+// Redact method implementation for CancelScheduledMessageRequest
+func (x *CancelScheduledMessageRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+	return x.String()
+}
+
+// Redact method implementation for AudienceFilter
+func (x *AudienceFilter) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserIds
+
+	// Safe field: RoleIds
+
+	// Safe field: DepartmentIds
+
+	// Safe field: OrganizationIds
+
+	// Safe field: PositionIds
+
+	// Safe field: TenantIds
+
+	// Safe field: IncludeSubDepartments
+
+	// Safe field: IncludeSubOrganizations
+	return x.String()
+}
+
+// Redact method implementation for MessageAudience
+func (x *MessageAudience) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: All
+
+	// Safe field: Include
+
+	// Safe field: Exclude
+	return x.String()
+}
+
+// Redact method implementation for PreviewAudienceResponse
+func (x *PreviewAudienceResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserIds
+
+	// Safe field: Total
+	return x.String()
+}
@@ -57,6 +57,8 @@ func (m *InternalMessage) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for TemplateVariables
+
 	if m.Id != nil {
 		// no validation rules for Id
 	}
@@ -93,6 +95,256 @@ func (m *InternalMessage) validate(all bool) error {
 		// no validation rules for CategoryName
 	}
 
+	if m.SendAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSendAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSpec != nil {
+		// no validation rules for CronSpec
+	}
+
+	if m.LastSentAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastSentAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "LastSentAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "LastSentAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastSentAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "LastSentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TargetAll != nil {
+		// no validation rules for TargetAll
+	}
+
+	if m.Audience != nil {
+
+		if all {
+			switch v := interface{}(m.GetAudience()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "Audience",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "Audience",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAudience()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DeliveryStatus != nil {
+		// no validation rules for DeliveryStatus
+	}
+
+	if m.RecipientTotal != nil {
+		// no validation rules for RecipientTotal
+	}
+
+	if m.DeliveredCount != nil {
+		// no validation rules for DeliveredCount
+	}
+
+	if m.FailedCount != nil {
+		// no validation rules for FailedCount
+	}
+
+	if m.DeliveryStartedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeliveryStartedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "DeliveryStartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "DeliveryStartedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeliveryStartedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "DeliveryStartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DeliveryFinishedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeliveryFinishedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "DeliveryFinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "DeliveryFinishedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeliveryFinishedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "DeliveryFinishedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TemplateCode != nil {
+		// no validation rules for TemplateCode
+	}
+
+	if m.ConversationId != nil {
+		// no validation rules for ConversationId
+	}
+
+	if m.ThreadId != nil {
+		// no validation rules for ThreadId
+	}
+
+	if m.ReplyCount != nil {
+		// no validation rules for ReplyCount
+	}
+
+	if m.RemindCount != nil {
+		// no validation rules for RemindCount
+	}
+
+	if m.LastRemindedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastRemindedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "LastRemindedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMessageValidationError{
+						field:  "LastRemindedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastRemindedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMessageValidationError{
+					field:  "LastRemindedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Priority != nil {
+		// no validation rules for Priority
+	}
+
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -1003,6 +1255,8 @@ func (m *SendMessageRequest) validate(all bool) error {
 
 	// no validation rules for Content
 
+	// no validation rules for Variables
+
 	if m.RecipientUserId != nil {
 		// no validation rules for RecipientUserId
 	}
@@ -1023,6 +1277,88 @@ func (m *SendMessageRequest) validate(all bool) error {
 		// no validation rules for Title
 	}
 
+	if m.SendAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSendAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSpec != nil {
+		// no validation rules for CronSpec
+	}
+
+	if m.Audience != nil {
+
+		if all {
+			switch v := interface{}(m.GetAudience()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Audience",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageRequestValidationError{
+						field:  "Audience",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAudience()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageRequestValidationError{
+					field:  "Audience",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.TemplateCode != nil {
+		// no validation rules for TemplateCode
+	}
+
+	if m.ThreadId != nil {
+		// no validation rules for ThreadId
+	}
+
+	if m.Priority != nil {
+		// no validation rules for Priority
+	}
+
 	if len(errors) > 0 {
 		return SendMessageRequestMultiError(errors)
 	}
@@ -1127,12 +1463,45 @@ func (m *SendMessageResponse) validate(all bool) error {
 
 	// no validation rules for MessageId
 
-	if len(errors) > 0 {
-		return SendMessageResponseMultiError(errors)
-	}
-
-	return nil
-}
+	if m.SendAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSendAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SendMessageResponseValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SendMessageResponseValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendMessageResponseValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SendMessageResponseMultiError(errors)
+	}
+
+	return nil
+}
 
 // SendMessageResponseMultiError is an error wrapping multiple validation
 // errors returned by SendMessageResponse.ValidateAll() if the designated
@@ -1312,3 +1681,640 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = RevokeMessageRequestValidationError{}
+
+// Validate checks the field values on UpdateScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateScheduledMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateScheduledMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateScheduledMessageRequestMultiError, or nil if none found.
+func (m *UpdateScheduledMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateScheduledMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if m.Title != nil {
+		// no validation rules for Title
+	}
+
+	if m.Content != nil {
+		// no validation rules for Content
+	}
+
+	if m.SendAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSendAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateScheduledMessageRequestValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateScheduledMessageRequestValidationError{
+						field:  "SendAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateScheduledMessageRequestValidationError{
+					field:  "SendAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CronSpec != nil {
+		// no validation rules for CronSpec
+	}
+
+	if len(errors) > 0 {
+		return UpdateScheduledMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateScheduledMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateScheduledMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateScheduledMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateScheduledMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateScheduledMessageRequestMultiError) AllErrors() []error { return m }
+
+// UpdateScheduledMessageRequestValidationError is the validation error
+// returned by UpdateScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type UpdateScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateScheduledMessageRequestValidationError) ErrorName() string {
+	return "UpdateScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on CancelScheduledMessageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelScheduledMessageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelScheduledMessageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CancelScheduledMessageRequestMultiError, or nil if none found.
+func (m *CancelScheduledMessageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelScheduledMessageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return CancelScheduledMessageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelScheduledMessageRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelScheduledMessageRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CancelScheduledMessageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelScheduledMessageRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelScheduledMessageRequestMultiError) AllErrors() []error { return m }
+
+// CancelScheduledMessageRequestValidationError is the validation error
+// returned by CancelScheduledMessageRequest.Validate if the designated
+// constraints aren't met.
+type CancelScheduledMessageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledMessageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledMessageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledMessageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledMessageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledMessageRequestValidationError) ErrorName() string {
+	return "CancelScheduledMessageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledMessageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledMessageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledMessageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledMessageRequestValidationError{}
+
+// Validate checks the field values on AudienceFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AudienceFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AudienceFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AudienceFilterMultiError,
+// or nil if none found.
+func (m *AudienceFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AudienceFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.IncludeSubDepartments != nil {
+		// no validation rules for IncludeSubDepartments
+	}
+
+	if m.IncludeSubOrganizations != nil {
+		// no validation rules for IncludeSubOrganizations
+	}
+
+	if len(errors) > 0 {
+		return AudienceFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// AudienceFilterMultiError is an error wrapping multiple validation errors
+// returned by AudienceFilter.ValidateAll() if the designated constraints
+// aren't met.
+type AudienceFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AudienceFilterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AudienceFilterMultiError) AllErrors() []error { return m }
+
+// AudienceFilterValidationError is the validation error returned by
+// AudienceFilter.Validate if the designated constraints aren't met.
+type AudienceFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceFilterValidationError) ErrorName() string { return "AudienceFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudienceFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudienceFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceFilterValidationError{}
+
+// Validate checks the field values on MessageAudience with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageAudience) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageAudience with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageAudienceMultiError, or nil if none found.
+func (m *MessageAudience) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageAudience) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.All != nil {
+		// no validation rules for All
+	}
+
+	if m.Include != nil {
+
+		if all {
+			switch v := interface{}(m.GetInclude()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageAudienceValidationError{
+						field:  "Include",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageAudienceValidationError{
+						field:  "Include",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInclude()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageAudienceValidationError{
+					field:  "Include",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Exclude != nil {
+
+		if all {
+			switch v := interface{}(m.GetExclude()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageAudienceValidationError{
+						field:  "Exclude",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageAudienceValidationError{
+						field:  "Exclude",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExclude()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageAudienceValidationError{
+					field:  "Exclude",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MessageAudienceMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageAudienceMultiError is an error wrapping multiple validation errors
+// returned by MessageAudience.ValidateAll() if the designated constraints
+// aren't met.
+type MessageAudienceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageAudienceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageAudienceMultiError) AllErrors() []error { return m }
+
+// MessageAudienceValidationError is the validation error returned by
+// MessageAudience.Validate if the designated constraints aren't met.
+type MessageAudienceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageAudienceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageAudienceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageAudienceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageAudienceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageAudienceValidationError) ErrorName() string { return "MessageAudienceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageAudienceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageAudience.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageAudienceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageAudienceValidationError{}
+
+// Validate checks the field values on PreviewAudienceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewAudienceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewAudienceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewAudienceResponseMultiError, or nil if none found.
+func (m *PreviewAudienceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewAudienceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return PreviewAudienceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewAudienceResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewAudienceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewAudienceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewAudienceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewAudienceResponseMultiError) AllErrors() []error { return m }
+
+// PreviewAudienceResponseValidationError is the validation error returned by
+// PreviewAudienceResponse.Validate if the designated constraints aren't met.
+type PreviewAudienceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewAudienceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewAudienceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewAudienceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewAudienceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewAudienceResponseValidationError) ErrorName() string {
+	return "PreviewAudienceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewAudienceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewAudienceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewAudienceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewAudienceResponseValidationError{}
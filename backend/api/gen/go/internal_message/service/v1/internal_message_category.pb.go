@@ -36,6 +36,7 @@ type InternalMessageCategory struct {
 	IconUrl       *string                    `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3,oneof" json:"icon_url,omitempty"`          // 图标URL
 	SortOrder     *int32                     `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`   // 排序顺序，值越小越靠前
 	IsEnabled     *bool                      `protobuf:"varint,6,opt,name=is_enabled,json=isEnabled,proto3,oneof" json:"is_enabled,omitempty"`   // 是否启用
+	Channels      []string                   `protobuf:"bytes,7,rep,name=channels,proto3" json:"channels,omitempty"`                             // 默认通知渠道
 	ParentId      *uint32                    `protobuf:"varint,50,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`     // 父节点ID
 	Children      []*InternalMessageCategory `protobuf:"bytes,51,rep,name=children,proto3" json:"children,omitempty"`                            // 子节点树
 	CreatedBy     *uint32                    `protobuf:"varint,100,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"` // 创建者ID
@@ -120,6 +121,13 @@ func (x *InternalMessageCategory) GetIsEnabled() bool {
 	return false
 }
 
+func (x *InternalMessageCategory) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
 func (x *InternalMessageCategory) GetParentId() uint32 {
 	if x != nil && x.ParentId != nil {
 		return *x.ParentId
@@ -467,7 +475,7 @@ var File_internal_message_service_v1_internal_message_category_proto protoreflec
 
 const file_internal_message_service_v1_internal_message_category_proto_rawDesc = "" +
 	"\n" +
-	";internal_message/service/v1/internal_message_category.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xb3\b\n" +
+	";internal_message/service/v1/internal_message_category.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\xb3\t\n" +
 	"\x17InternalMessageCategory\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b分类IDH\x00R\x02id\x88\x01\x01\x12%\n" +
 	"\x04name\x18\x02 \x01(\tB\f\xbaG\t\x92\x02\x06名称H\x01R\x04name\x88\x01\x01\x12%\n" +
@@ -476,7 +484,8 @@ const file_internal_message_service_v1_internal_message_category_proto_rawDesc =
 	"\n" +
 	"sort_order\x18\x05 \x01(\x05B'\xbaG$\x92\x02!排序顺序，值越小越靠前H\x04R\tsortOrder\x88\x01\x01\x126\n" +
 	"\n" +
-	"is_enabled\x18\x06 \x01(\bB\x12\xbaG\x0f\x92\x02\f是否启用H\x05R\tisEnabled\x88\x01\x01\x123\n" +
+	"is_enabled\x18\x06 \x01(\bB\x12\xbaG\x0f\x92\x02\f是否启用H\x05R\tisEnabled\x88\x01\x01\x12~\n" +
+	"\bchannels\x18\a \x03(\tBb\xbaG_\x92\x02\\默认通知渠道，站内信之外同时通过这些渠道发送，可选值：email、smsR\bchannels\x123\n" +
 	"\tparent_id\x182 \x01(\rB\x11\xbaG\x0e\x92\x02\v父节点IDH\x06R\bparentId\x88\x01\x01\x12d\n" +
 	"\bchildren\x183 \x03(\v24.internal_message.service.v1.InternalMessageCategoryB\x12\xbaG\x0f\x92\x02\f子节点树R\bchildren\x125\n" +
 	"\n" +
@@ -121,6 +121,8 @@ func (x *InternalMessageCategory) Redact() string {
 
 	// Safe field: IsEnabled
 
+	// Safe field: Channels
+
 	// Safe field: ParentId
 
 	// Safe field: Children
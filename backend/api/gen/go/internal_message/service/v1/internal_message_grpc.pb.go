@@ -21,13 +21,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InternalMessageService_ListMessage_FullMethodName   = "/internal_message.service.v1.InternalMessageService/ListMessage"
-	InternalMessageService_GetMessage_FullMethodName    = "/internal_message.service.v1.InternalMessageService/GetMessage"
-	InternalMessageService_CreateMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/CreateMessage"
-	InternalMessageService_UpdateMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/UpdateMessage"
-	InternalMessageService_DeleteMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/DeleteMessage"
-	InternalMessageService_SendMessage_FullMethodName   = "/internal_message.service.v1.InternalMessageService/SendMessage"
-	InternalMessageService_RevokeMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/RevokeMessage"
+	InternalMessageService_ListMessage_FullMethodName            = "/internal_message.service.v1.InternalMessageService/ListMessage"
+	InternalMessageService_GetMessage_FullMethodName             = "/internal_message.service.v1.InternalMessageService/GetMessage"
+	InternalMessageService_CreateMessage_FullMethodName          = "/internal_message.service.v1.InternalMessageService/CreateMessage"
+	InternalMessageService_UpdateMessage_FullMethodName          = "/internal_message.service.v1.InternalMessageService/UpdateMessage"
+	InternalMessageService_DeleteMessage_FullMethodName          = "/internal_message.service.v1.InternalMessageService/DeleteMessage"
+	InternalMessageService_SendMessage_FullMethodName            = "/internal_message.service.v1.InternalMessageService/SendMessage"
+	InternalMessageService_RevokeMessage_FullMethodName          = "/internal_message.service.v1.InternalMessageService/RevokeMessage"
+	InternalMessageService_UpdateScheduledMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/UpdateScheduledMessage"
+	InternalMessageService_CancelScheduledMessage_FullMethodName = "/internal_message.service.v1.InternalMessageService/CancelScheduledMessage"
+	InternalMessageService_PreviewAudience_FullMethodName        = "/internal_message.service.v1.InternalMessageService/PreviewAudience"
 )
 
 // InternalMessageServiceClient is the client API for InternalMessageService service.
@@ -50,6 +53,12 @@ type InternalMessageServiceClient interface {
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	// 撤销消息
 	RevokeMessage(ctx context.Context, in *RevokeMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 修改定时消息
+	UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 取消定时消息
+	CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 预览受众
+	PreviewAudience(ctx context.Context, in *MessageAudience, opts ...grpc.CallOption) (*PreviewAudienceResponse, error)
 }
 
 type internalMessageServiceClient struct {
@@ -130,6 +139,36 @@ func (c *internalMessageServiceClient) RevokeMessage(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *internalMessageServiceClient) UpdateScheduledMessage(ctx context.Context, in *UpdateScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InternalMessageService_UpdateScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) CancelScheduledMessage(ctx context.Context, in *CancelScheduledMessageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, InternalMessageService_CancelScheduledMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageServiceClient) PreviewAudience(ctx context.Context, in *MessageAudience, opts ...grpc.CallOption) (*PreviewAudienceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewAudienceResponse)
+	err := c.cc.Invoke(ctx, InternalMessageService_PreviewAudience_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalMessageServiceServer is the server API for InternalMessageService service.
 // All implementations must embed UnimplementedInternalMessageServiceServer
 // for forward compatibility.
@@ -150,6 +189,12 @@ type InternalMessageServiceServer interface {
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
 	// 撤销消息
 	RevokeMessage(context.Context, *RevokeMessageRequest) (*emptypb.Empty, error)
+	// 修改定时消息
+	UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*emptypb.Empty, error)
+	// 取消定时消息
+	CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*emptypb.Empty, error)
+	// 预览受众
+	PreviewAudience(context.Context, *MessageAudience) (*PreviewAudienceResponse, error)
 	mustEmbedUnimplementedInternalMessageServiceServer()
 }
 
@@ -181,6 +226,15 @@ func (UnimplementedInternalMessageServiceServer) SendMessage(context.Context, *S
 func (UnimplementedInternalMessageServiceServer) RevokeMessage(context.Context, *RevokeMessageRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeMessage not implemented")
 }
+func (UnimplementedInternalMessageServiceServer) UpdateScheduledMessage(context.Context, *UpdateScheduledMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledMessage not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) CancelScheduledMessage(context.Context, *CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMessage not implemented")
+}
+func (UnimplementedInternalMessageServiceServer) PreviewAudience(context.Context, *MessageAudience) (*PreviewAudienceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewAudience not implemented")
+}
 func (UnimplementedInternalMessageServiceServer) mustEmbedUnimplementedInternalMessageServiceServer() {
 }
 func (UnimplementedInternalMessageServiceServer) testEmbeddedByValue() {}
@@ -329,6 +383,60 @@ func _InternalMessageService_RevokeMessage_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalMessageService_UpdateScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).UpdateScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_UpdateScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).UpdateScheduledMessage(ctx, req.(*UpdateScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_CancelScheduledMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).CancelScheduledMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_CancelScheduledMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).CancelScheduledMessage(ctx, req.(*CancelScheduledMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageService_PreviewAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MessageAudience)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageServiceServer).PreviewAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageService_PreviewAudience_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageServiceServer).PreviewAudience(ctx, req.(*MessageAudience))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InternalMessageService_ServiceDesc is the grpc.ServiceDesc for InternalMessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -364,6 +472,18 @@ var InternalMessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeMessage",
 			Handler:    _InternalMessageService_RevokeMessage_Handler,
 		},
+		{
+			MethodName: "UpdateScheduledMessage",
+			Handler:    _InternalMessageService_UpdateScheduledMessage_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMessage",
+			Handler:    _InternalMessageService_CancelScheduledMessage_Handler,
+		},
+		{
+			MethodName: "PreviewAudience",
+			Handler:    _InternalMessageService_PreviewAudience_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal_message/service/v1/internal_message.proto",
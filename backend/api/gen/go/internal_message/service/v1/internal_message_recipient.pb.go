@@ -780,11 +780,966 @@ func (x *MarkNotificationsStatusRequest) GetNewStatus() InternalMessageRecipient
 	return InternalMessageRecipient_SENT
 }
 
+// 查询收件箱未读数量汇总 - 请求
+type GetInboxSummaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID，为空时为当前用户
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInboxSummaryRequest) Reset() {
+	*x = GetInboxSummaryRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInboxSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInboxSummaryRequest) ProtoMessage() {}
+
+func (x *GetInboxSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInboxSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetInboxSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInboxSummaryRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// 收件箱未读数量汇总
+type InboxSummary struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	UserId        uint32                         `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID
+	TotalUnread   uint32                         `protobuf:"varint,2,opt,name=total_unread,json=totalUnread,proto3" json:"total_unread,omitempty"` // 未读总数
+	Categories    []*InboxSummary_CategoryUnread `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`                       // 各分类的未读数量，不包含未读数量为0的分类
+	Types         []*InboxSummary_TypeUnread     `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty"`                                 // 各消息类型的未读数量，不包含未读数量为0的类型
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxSummary) Reset() {
+	*x = InboxSummary{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxSummary) ProtoMessage() {}
+
+func (x *InboxSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxSummary.ProtoReflect.Descriptor instead.
+func (*InboxSummary) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InboxSummary) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *InboxSummary) GetTotalUnread() uint32 {
+	if x != nil {
+		return x.TotalUnread
+	}
+	return 0
+}
+
+func (x *InboxSummary) GetCategories() []*InboxSummary_CategoryUnread {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *InboxSummary) GetTypes() []*InboxSummary_TypeUnread {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// 全部标记为已读 - 请求
+type MarkAllNotificationsAsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                           // 用户ID，为空时为当前用户
+	CategoryId    *uint32                `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                         // 只标记该分类的通知，0为未分类
+	Type          *InternalMessage_Type  `protobuf:"varint,3,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type,oneof" json:"type,omitempty"` // 只标记该类型的通知
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllNotificationsAsReadRequest) Reset() {
+	*x = MarkAllNotificationsAsReadRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllNotificationsAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllNotificationsAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MarkAllNotificationsAsReadRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MarkAllNotificationsAsReadRequest) GetCategoryId() uint32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *MarkAllNotificationsAsReadRequest) GetType() InternalMessage_Type {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return InternalMessage_NOTIFICATION
+}
+
+// 查询消息阅读统计 - 请求
+type GetMessageReadStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
+	Interval      *string                `protobuf:"bytes,2,opt,name=interval,proto3,oneof" json:"interval,omitempty"`               // 阅读趋势的统计粒度：hour、day，默认为hour
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMessageReadStatsRequest) Reset() {
+	*x = GetMessageReadStatsRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMessageReadStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMessageReadStatsRequest) ProtoMessage() {}
+
+func (x *GetMessageReadStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMessageReadStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetMessageReadStatsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetMessageReadStatsRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *GetMessageReadStatsRequest) GetInterval() string {
+	if x != nil && x.Interval != nil {
+		return *x.Interval
+	}
+	return ""
+}
+
+// 消息阅读统计
+type MessageReadStats struct {
+	state          protoimpl.MessageState             `protogen:"open.v1"`
+	MessageId      uint32                             `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`                        // 消息ID
+	Targeted       uint32                             `protobuf:"varint,2,opt,name=targeted,proto3" json:"targeted,omitempty"`                                           // 目标接收者数量
+	Delivered      uint32                             `protobuf:"varint,3,opt,name=delivered,proto3" json:"delivered,omitempty"`                                         // 已投递到收件箱的数量
+	Failed         uint32                             `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`                                               // 投递失败的数量
+	Received       uint32                             `protobuf:"varint,5,opt,name=received,proto3" json:"received,omitempty"`                                           // 客户端已接收的数量，包含已读
+	Read           uint32                             `protobuf:"varint,6,opt,name=read,proto3" json:"read,omitempty"`                                                   // 已读数量，包含阅读后删除的
+	Unread         uint32                             `protobuf:"varint,7,opt,name=unread,proto3" json:"unread,omitempty"`                                               // 未读数量
+	Revoked        uint32                             `protobuf:"varint,8,opt,name=revoked,proto3" json:"revoked,omitempty"`                                             // 已撤销数量
+	Deleted        uint32                             `protobuf:"varint,9,opt,name=deleted,proto3" json:"deleted,omitempty"`                                             // 接收者已删除的数量
+	ReadRate       float64                            `protobuf:"fixed64,10,opt,name=read_rate,json=readRate,proto3" json:"read_rate,omitempty"`                         // 阅读率，已读数量与已投递数量之比
+	ReadTrend      []*MessageReadStats_ReadTrendPoint `protobuf:"bytes,11,rep,name=read_trend,json=readTrend,proto3" json:"read_trend,omitempty"`                        // 阅读趋势，从投递开始按统计粒度划分
+	RemindCount    *uint32                            `protobuf:"varint,12,opt,name=remind_count,json=remindCount,proto3,oneof" json:"remind_count,omitempty"`           // 提醒未读者的次数
+	LastRemindedAt *timestamppb.Timestamp             `protobuf:"bytes,13,opt,name=last_reminded_at,json=lastRemindedAt,proto3,oneof" json:"last_reminded_at,omitempty"` // 最近一次提醒未读者的时间
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessageReadStats) Reset() {
+	*x = MessageReadStats{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReadStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReadStats) ProtoMessage() {}
+
+func (x *MessageReadStats) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReadStats.ProtoReflect.Descriptor instead.
+func (*MessageReadStats) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MessageReadStats) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetTargeted() uint32 {
+	if x != nil {
+		return x.Targeted
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetDelivered() uint32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetFailed() uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetRead() uint32 {
+	if x != nil {
+		return x.Read
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetUnread() uint32 {
+	if x != nil {
+		return x.Unread
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetRevoked() uint32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetDeleted() uint32 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetReadRate() float64 {
+	if x != nil {
+		return x.ReadRate
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetReadTrend() []*MessageReadStats_ReadTrendPoint {
+	if x != nil {
+		return x.ReadTrend
+	}
+	return nil
+}
+
+func (x *MessageReadStats) GetRemindCount() uint32 {
+	if x != nil && x.RemindCount != nil {
+		return *x.RemindCount
+	}
+	return 0
+}
+
+func (x *MessageReadStats) GetLastRemindedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRemindedAt
+	}
+	return nil
+}
+
+// 查询消息的未读者 - 请求
+type ListMessageNonReadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`    // 消息ID
+	Page          *uint32                `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`                         // 页码，从1开始
+	PageSize      *uint32                `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageNonReadersRequest) Reset() {
+	*x = ListMessageNonReadersRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageNonReadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageNonReadersRequest) ProtoMessage() {}
+
+func (x *ListMessageNonReadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageNonReadersRequest.ProtoReflect.Descriptor instead.
+func (*ListMessageNonReadersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListMessageNonReadersRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+func (x *ListMessageNonReadersRequest) GetPage() uint32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListMessageNonReadersRequest) GetPageSize() uint32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// 消息的未读者
+type MessageNonReader struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	RecipientId   uint32                          `protobuf:"varint,1,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`                                     // 收件记录ID
+	UserId        uint32                          `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                    // 用户ID
+	Username      *string                         `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`                                                         // 用户名
+	Realname      *string                         `protobuf:"bytes,4,opt,name=realname,proto3,oneof" json:"realname,omitempty"`                                                         // 真实姓名
+	Status        InternalMessageRecipient_Status `protobuf:"varint,5,opt,name=status,proto3,enum=internal_message.service.v1.InternalMessageRecipient_Status" json:"status,omitempty"` // 收件状态
+	DeliveredAt   *timestamppb.Timestamp          `protobuf:"bytes,6,opt,name=delivered_at,json=deliveredAt,proto3,oneof" json:"delivered_at,omitempty"`                                // 投递到收件箱的时间
+	ReceivedAt    *timestamppb.Timestamp          `protobuf:"bytes,7,opt,name=received_at,json=receivedAt,proto3,oneof" json:"received_at,omitempty"`                                   // 客户端接收的时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageNonReader) Reset() {
+	*x = MessageNonReader{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageNonReader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageNonReader) ProtoMessage() {}
+
+func (x *MessageNonReader) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageNonReader.ProtoReflect.Descriptor instead.
+func (*MessageNonReader) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MessageNonReader) GetRecipientId() uint32 {
+	if x != nil {
+		return x.RecipientId
+	}
+	return 0
+}
+
+func (x *MessageNonReader) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MessageNonReader) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *MessageNonReader) GetRealname() string {
+	if x != nil && x.Realname != nil {
+		return *x.Realname
+	}
+	return ""
+}
+
+func (x *MessageNonReader) GetStatus() InternalMessageRecipient_Status {
+	if x != nil {
+		return x.Status
+	}
+	return InternalMessageRecipient_SENT
+}
+
+func (x *MessageNonReader) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *MessageNonReader) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+// 查询消息的未读者 - 回应
+type ListMessageNonReadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*MessageNonReader    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         uint64                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageNonReadersResponse) Reset() {
+	*x = ListMessageNonReadersResponse{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageNonReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageNonReadersResponse) ProtoMessage() {}
+
+func (x *ListMessageNonReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageNonReadersResponse.ProtoReflect.Descriptor instead.
+func (*ListMessageNonReadersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListMessageNonReadersResponse) GetItems() []*MessageNonReader {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListMessageNonReadersResponse) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 导出消息的未读者 - 请求
+type ExportMessageNonReadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMessageNonReadersRequest) Reset() {
+	*x = ExportMessageNonReadersRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMessageNonReadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMessageNonReadersRequest) ProtoMessage() {}
+
+func (x *ExportMessageNonReadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMessageNonReadersRequest.ProtoReflect.Descriptor instead.
+func (*ExportMessageNonReadersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportMessageNonReadersRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// 导出消息的未读者 - 回应
+type ExportMessageNonReadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // 文件名
+	MimeType      string                 `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // 文件类型
+	Content       []byte                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`                   // 文件内容，CSV格式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMessageNonReadersResponse) Reset() {
+	*x = ExportMessageNonReadersResponse{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMessageNonReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMessageNonReadersResponse) ProtoMessage() {}
+
+func (x *ExportMessageNonReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMessageNonReadersResponse.ProtoReflect.Descriptor instead.
+func (*ExportMessageNonReadersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportMessageNonReadersResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportMessageNonReadersResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ExportMessageNonReadersResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// 提醒消息的未读者 - 请求
+type RemindMessageNonReadersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MessageId     uint32                 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"` // 消息ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindMessageNonReadersRequest) Reset() {
+	*x = RemindMessageNonReadersRequest{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindMessageNonReadersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindMessageNonReadersRequest) ProtoMessage() {}
+
+func (x *RemindMessageNonReadersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindMessageNonReadersRequest.ProtoReflect.Descriptor instead.
+func (*RemindMessageNonReadersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemindMessageNonReadersRequest) GetMessageId() uint32 {
+	if x != nil {
+		return x.MessageId
+	}
+	return 0
+}
+
+// 提醒消息的未读者 - 回应
+type RemindMessageNonReadersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminded      uint32                 `protobuf:"varint,1,opt,name=reminded,proto3" json:"reminded,omitempty"` // 提醒的未读者数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindMessageNonReadersResponse) Reset() {
+	*x = RemindMessageNonReadersResponse{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindMessageNonReadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindMessageNonReadersResponse) ProtoMessage() {}
+
+func (x *RemindMessageNonReadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindMessageNonReadersResponse.ProtoReflect.Descriptor instead.
+func (*RemindMessageNonReadersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemindMessageNonReadersResponse) GetReminded() uint32 {
+	if x != nil {
+		return x.Reminded
+	}
+	return 0
+}
+
+// 分类的未读数量
+type InboxSummary_CategoryUnread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    uint32                 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`            // 分类ID，0为未分类
+	CategoryName  *string                `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3,oneof" json:"category_name,omitempty"` // 分类名称
+	Unread        uint32                 `protobuf:"varint,3,opt,name=unread,proto3" json:"unread,omitempty"`                                      // 未读数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxSummary_CategoryUnread) Reset() {
+	*x = InboxSummary_CategoryUnread{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxSummary_CategoryUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxSummary_CategoryUnread) ProtoMessage() {}
+
+func (x *InboxSummary_CategoryUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxSummary_CategoryUnread.ProtoReflect.Descriptor instead.
+func (*InboxSummary_CategoryUnread) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *InboxSummary_CategoryUnread) GetCategoryId() uint32 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *InboxSummary_CategoryUnread) GetCategoryName() string {
+	if x != nil && x.CategoryName != nil {
+		return *x.CategoryName
+	}
+	return ""
+}
+
+func (x *InboxSummary_CategoryUnread) GetUnread() uint32 {
+	if x != nil {
+		return x.Unread
+	}
+	return 0
+}
+
+// 消息类型的未读数量
+type InboxSummary_TypeUnread struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          InternalMessage_Type   `protobuf:"varint,1,opt,name=type,proto3,enum=internal_message.service.v1.InternalMessage_Type" json:"type,omitempty"` // 消息类型
+	Unread        uint32                 `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`                                                   // 未读数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InboxSummary_TypeUnread) Reset() {
+	*x = InboxSummary_TypeUnread{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InboxSummary_TypeUnread) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InboxSummary_TypeUnread) ProtoMessage() {}
+
+func (x *InboxSummary_TypeUnread) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InboxSummary_TypeUnread.ProtoReflect.Descriptor instead.
+func (*InboxSummary_TypeUnread) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *InboxSummary_TypeUnread) GetType() InternalMessage_Type {
+	if x != nil {
+		return x.Type
+	}
+	return InternalMessage_NOTIFICATION
+}
+
+func (x *InboxSummary_TypeUnread) GetUnread() uint32 {
+	if x != nil {
+		return x.Unread
+	}
+	return 0
+}
+
+// 阅读趋势中的一个时间段
+type MessageReadStats_ReadTrendPoint struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Time           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`                                            // 时间段的开始时间
+	Read           uint32                 `protobuf:"varint,2,opt,name=read,proto3" json:"read,omitempty"`                                           // 时间段内的阅读人数
+	CumulativeRead uint32                 `protobuf:"varint,3,opt,name=cumulative_read,json=cumulativeRead,proto3" json:"cumulative_read,omitempty"` // 截至时间段结束的累计阅读人数
+	ReadRate       float64                `protobuf:"fixed64,4,opt,name=read_rate,json=readRate,proto3" json:"read_rate,omitempty"`                  // 截至时间段结束的累计阅读率
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *MessageReadStats_ReadTrendPoint) Reset() {
+	*x = MessageReadStats_ReadTrendPoint{}
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReadStats_ReadTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReadStats_ReadTrendPoint) ProtoMessage() {}
+
+func (x *MessageReadStats_ReadTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReadStats_ReadTrendPoint.ProtoReflect.Descriptor instead.
+func (*MessageReadStats_ReadTrendPoint) Descriptor() ([]byte, []int) {
+	return file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *MessageReadStats_ReadTrendPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MessageReadStats_ReadTrendPoint) GetRead() uint32 {
+	if x != nil {
+		return x.Read
+	}
+	return 0
+}
+
+func (x *MessageReadStats_ReadTrendPoint) GetCumulativeRead() uint32 {
+	if x != nil {
+		return x.CumulativeRead
+	}
+	return 0
+}
+
+func (x *MessageReadStats_ReadTrendPoint) GetReadRate() float64 {
+	if x != nil {
+		return x.ReadRate
+	}
+	return 0
+}
+
 var File_internal_message_service_v1_internal_message_recipient_proto protoreflect.FileDescriptor
 
 const file_internal_message_service_v1_internal_message_recipient_proto_rawDesc = "" +
 	"\n" +
-	"<internal_message/service/v1/internal_message_recipient.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\"\x95\n" +
+	"<internal_message/service/v1/internal_message_recipient.proto\x12\x1binternal_message.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a google/protobuf/field_mask.proto\x1a\x1epagination/v1/pagination.proto\x1a2internal_message/service/v1/internal_message.proto\"\x95\n" +
 	"\n" +
 	"\x18InternalMessageRecipient\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b记录IDH\x00R\x02id\x88\x01\x01\x12;\n" +
@@ -870,7 +1825,99 @@ const file_internal_message_service_v1_internal_message_recipient_proto_rawDesc
 	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x12B\n" +
 	"\rrecipient_ids\x18\x02 \x03(\rB\x1d\xbaG\x1a\x92\x02\x17收件箱记录ID列表R\frecipientIds\x12u\n" +
 	"\n" +
-	"new_status\x18\x03 \x01(\x0e2<.internal_message.service.v1.InternalMessageRecipient.StatusB\x18\xbaG\x15\x92\x02\x12新的消息状态R\tnewStatus2\xca\t\n" +
+	"new_status\x18\x03 \x01(\x0e2<.internal_message.service.v1.InternalMessageRecipient.StatusB\x18\xbaG\x15\x92\x02\x12新的消息状态R\tnewStatus\"\\\n" +
+	"\x16GetInboxSummaryRequest\x12B\n" +
+	"\auser_id\x18\x01 \x01(\rB)\xbaG&\x92\x02#用户ID，为空时为当前用户R\x06userId\"\x8c\x06\n" +
+	"\fInboxSummary\x12'\n" +
+	"\auser_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x125\n" +
+	"\ftotal_unread\x18\x02 \x01(\rB\x12\xbaG\x0f\x92\x02\f未读总数R\vtotalUnread\x12\x9d\x01\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v28.internal_message.service.v1.InboxSummary.CategoryUnreadBC\xbaG@\x92\x02=各分类的未读数量，不包含未读数量为0的分类R\n" +
+	"categories\x12\x95\x01\n" +
+	"\x05types\x18\x04 \x03(\v24.internal_message.service.v1.InboxSummary.TypeUnreadBI\xbaGF\x92\x02C各消息类型的未读数量，不包含未读数量为0的类型R\x05types\x1a\xcd\x01\n" +
+	"\x0eCategoryUnread\x12?\n" +
+	"\vcategory_id\x18\x01 \x01(\rB\x1e\xbaG\x1b\x92\x02\x18分类ID，0为未分类R\n" +
+	"categoryId\x12<\n" +
+	"\rcategory_name\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f分类名称H\x00R\fcategoryName\x88\x01\x01\x12*\n" +
+	"\x06unread\x18\x03 \x01(\rB\x12\xbaG\x0f\x92\x02\f未读数量R\x06unreadB\x10\n" +
+	"\x0e_category_name\x1a\x93\x01\n" +
+	"\n" +
+	"TypeUnread\x12Y\n" +
+	"\x04type\x18\x01 \x01(\x0e21.internal_message.service.v1.InternalMessage.TypeB\x12\xbaG\x0f\x92\x02\f消息类型R\x04type\x12*\n" +
+	"\x06unread\x18\x02 \x01(\rB\x12\xbaG\x0f\x92\x02\f未读数量R\x06unread\"\xc8\x02\n" +
+	"!MarkAllNotificationsAsReadRequest\x12B\n" +
+	"\auser_id\x18\x01 \x01(\rB)\xbaG&\x92\x02#用户ID，为空时为当前用户R\x06userId\x12W\n" +
+	"\vcategory_id\x18\x02 \x01(\rB1\xbaG.\x92\x02+只标记该分类的通知，0为未分类H\x00R\n" +
+	"categoryId\x88\x01\x01\x12m\n" +
+	"\x04type\x18\x03 \x01(\x0e21.internal_message.service.v1.InternalMessage.TypeB!\xbaG\x1e\x92\x02\x1b只标记该类型的通知H\x01R\x04type\x88\x01\x01B\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_type\"\xb9\x01\n" +
+	"\x1aGetMessageReadStatsRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x12_\n" +
+	"\binterval\x18\x02 \x01(\tB>\xbaG;\x92\x028阅读趋势的统计粒度：hour、day，默认为hourH\x00R\binterval\x88\x01\x01B\v\n" +
+	"\t_interval\"\xb2\n" +
+	"\n" +
+	"\x10MessageReadStats\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x127\n" +
+	"\btargeted\x18\x02 \x01(\rB\x1b\xbaG\x18\x92\x02\x15目标接收者数量R\btargeted\x12B\n" +
+	"\tdelivered\x18\x03 \x01(\rB$\xbaG!\x92\x02\x1e已投递到收件箱的数量R\tdelivered\x123\n" +
+	"\x06failed\x18\x04 \x01(\rB\x1b\xbaG\x18\x92\x02\x15投递失败的数量R\x06failed\x12L\n" +
+	"\breceived\x18\x05 \x01(\rB0\xbaG-\x92\x02*客户端已接收的数量，包含已读R\breceived\x12A\n" +
+	"\x04read\x18\x06 \x01(\rB-\xbaG*\x92\x02'已读数量，包含阅读后删除的R\x04read\x12*\n" +
+	"\x06unread\x18\a \x01(\rB\x12\xbaG\x0f\x92\x02\f未读数量R\x06unread\x12/\n" +
+	"\arevoked\x18\b \x01(\rB\x15\xbaG\x12\x92\x02\x0f已撤销数量R\arevoked\x12;\n" +
+	"\adeleted\x18\t \x01(\rB!\xbaG\x1e\x92\x02\x1b接收者已删除的数量R\adeleted\x12S\n" +
+	"\tread_rate\x18\n" +
+	" \x01(\x01B6\xbaG3\x92\x020阅读率，已读数量与已投递数量之比R\breadRate\x12\x96\x01\n" +
+	"\n" +
+	"read_trend\x18\v \x03(\v2<.internal_message.service.v1.MessageReadStats.ReadTrendPointB9\xbaG6\x92\x023阅读趋势，从投递开始按统计粒度划分R\treadTrend\x12F\n" +
+	"\fremind_count\x18\f \x01(\rB\x1e\xbaG\x1b\x92\x02\x18提醒未读者的次数H\x00R\vremindCount\x88\x01\x01\x12u\n" +
+	"\x10last_reminded_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampB*\xbaG'\x92\x02$最近一次提醒未读者的时间H\x01R\x0elastRemindedAt\x88\x01\x01\x1a\xbe\x02\n" +
+	"\x0eReadTrendPoint\x12N\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xbaG\x1b\x92\x02\x18时间段的开始时间R\x04time\x125\n" +
+	"\x04read\x18\x02 \x01(\rB!\xbaG\x1e\x92\x02\x1b时间段内的阅读人数R\x04read\x12Y\n" +
+	"\x0fcumulative_read\x18\x03 \x01(\rB0\xbaG-\x92\x02*截至时间段结束的累计阅读人数R\x0ecumulativeRead\x12J\n" +
+	"\tread_rate\x18\x04 \x01(\x01B-\xbaG*\x92\x02'截至时间段结束的累计阅读率R\breadRateB\x0f\n" +
+	"\r_remind_countB\x13\n" +
+	"\x11_last_reminded_at\"\xce\x01\n" +
+	"\x1cListMessageNonReadersRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\x122\n" +
+	"\x04page\x18\x02 \x01(\rB\x19\xbaG\x16\x92\x02\x13页码，从1开始H\x00R\x04page\x88\x01\x01\x124\n" +
+	"\tpage_size\x18\x03 \x01(\rB\x12\xbaG\x0f\x92\x02\f每页数量H\x01R\bpageSize\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xc9\x04\n" +
+	"\x10MessageNonReader\x127\n" +
+	"\frecipient_id\x18\x01 \x01(\rB\x14\xbaG\x11\x92\x02\x0e收件记录IDR\vrecipientId\x12'\n" +
+	"\auser_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDR\x06userId\x120\n" +
+	"\busername\x18\x03 \x01(\tB\x0f\xbaG\f\x92\x02\t用户名H\x00R\busername\x88\x01\x01\x123\n" +
+	"\brealname\x18\x04 \x01(\tB\x12\xbaG\x0f\x92\x02\f真实姓名H\x01R\brealname\x88\x01\x01\x12h\n" +
+	"\x06status\x18\x05 \x01(\x0e2<.internal_message.service.v1.InternalMessageRecipient.StatusB\x12\xbaG\x0f\x92\x02\f收件状态R\x06status\x12e\n" +
+	"\fdelivered_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB!\xbaG\x1e\x92\x02\x1b投递到收件箱的时间H\x02R\vdeliveredAt\x88\x01\x01\x12`\n" +
+	"\vreceived_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x1e\xbaG\x1b\x92\x02\x18客户端接收的时间H\x03R\n" +
+	"receivedAt\x88\x01\x01B\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_realnameB\x0f\n" +
+	"\r_delivered_atB\x0e\n" +
+	"\f_received_at\"z\n" +
+	"\x1dListMessageNonReadersResponse\x12C\n" +
+	"\x05items\x18\x01 \x03(\v2-.internal_message.service.v1.MessageNonReaderR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"O\n" +
+	"\x1eExportMessageNonReadersRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\"\xba\x01\n" +
+	"\x1fExportMessageNonReadersResponse\x12,\n" +
+	"\tfile_name\x18\x01 \x01(\tB\x0f\xbaG\f\x92\x02\t文件名R\bfileName\x12/\n" +
+	"\tmime_type\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f文件类型R\bmimeType\x128\n" +
+	"\acontent\x18\x03 \x01(\fB\x1e\xbaG\x1b\x92\x02\x18文件内容，CSV格式R\acontent\"O\n" +
+	"\x1eRemindMessageNonReadersRequest\x12-\n" +
+	"\n" +
+	"message_id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b消息IDR\tmessageId\"]\n" +
+	"\x1fRemindMessageNonReadersResponse\x12:\n" +
+	"\breminded\x18\x01 \x01(\rB\x1e\xbaG\x1b\x92\x02\x18提醒的未读者数量R\breminded2\xc7\v\n" +
 	"\x1fInternalMessageRecipientService\x12f\n" +
 	"\x04List\x12\x19.pagination.PagingRequest\x1aA.internal_message.service.v1.ListInternalMessageRecipientResponse\"\x00\x12\x7f\n" +
 	"\x03Get\x12?.internal_message.service.v1.GetInternalMessageRecipientRequest\x1a5.internal_message.service.v1.InternalMessageRecipient\"\x00\x12\x85\x01\n" +
@@ -881,7 +1928,9 @@ const file_internal_message_service_v1_internal_message_recipient_proto_rawDesc
 	"\rListUserInbox\x12\x19.pagination.PagingRequest\x1a2.internal_message.service.v1.ListUserInboxResponse\x12v\n" +
 	"\x1bDeleteNotificationFromInbox\x12?.internal_message.service.v1.DeleteNotificationFromInboxRequest\x1a\x16.google.protobuf.Empty\x12l\n" +
 	"\x16MarkNotificationAsRead\x12:.internal_message.service.v1.MarkNotificationAsReadRequest\x1a\x16.google.protobuf.Empty\x12n\n" +
-	"\x17MarkNotificationsStatus\x12;.internal_message.service.v1.MarkNotificationsStatusRequest\x1a\x16.google.protobuf.EmptyB\x8a\x02\n" +
+	"\x17MarkNotificationsStatus\x12;.internal_message.service.v1.MarkNotificationsStatusRequest\x1a\x16.google.protobuf.Empty\x12q\n" +
+	"\x0fGetInboxSummary\x123.internal_message.service.v1.GetInboxSummaryRequest\x1a).internal_message.service.v1.InboxSummary\x12\x87\x01\n" +
+	"\x1aMarkAllNotificationsAsRead\x12>.internal_message.service.v1.MarkAllNotificationsAsReadRequest\x1a).internal_message.service.v1.InboxSummaryB\x8a\x02\n" +
 	"\x1fcom.internal_message.service.v1B\x1dInternalMessageRecipientProtoP\x01Z>go-wind-admin/api/gen/go/internal_message/service/v1;servicev1\xa2\x02\x03ISX\xaa\x02\x1aInternalMessage.Service.V1\xca\x02\x1aInternalMessage\\Service\\V1\xe2\x02&InternalMessage\\Service\\V1\\GPBMetadata\xea\x02\x1cInternalMessage::Service::V1b\x06proto3"
 
 var (
@@ -897,7 +1946,7 @@ func file_internal_message_service_v1_internal_message_recipient_proto_rawDescGZ
 }
 
 var file_internal_message_service_v1_internal_message_recipient_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_message_service_v1_internal_message_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internal_message_service_v1_internal_message_recipient_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_internal_message_service_v1_internal_message_recipient_proto_goTypes = []any{
 	(InternalMessageRecipient_Status)(0),             // 0: internal_message.service.v1.InternalMessageRecipient.Status
 	(*InternalMessageRecipient)(nil),                 // 1: internal_message.service.v1.InternalMessageRecipient
@@ -911,50 +1960,81 @@ var file_internal_message_service_v1_internal_message_recipient_proto_goTypes =
 	(*DeleteNotificationFromInboxRequest)(nil),       // 9: internal_message.service.v1.DeleteNotificationFromInboxRequest
 	(*MarkNotificationAsReadRequest)(nil),            // 10: internal_message.service.v1.MarkNotificationAsReadRequest
 	(*MarkNotificationsStatusRequest)(nil),           // 11: internal_message.service.v1.MarkNotificationsStatusRequest
-	(*timestamppb.Timestamp)(nil),                    // 12: google.protobuf.Timestamp
-	(*fieldmaskpb.FieldMask)(nil),                    // 13: google.protobuf.FieldMask
-	(*v1.PagingRequest)(nil),                         // 14: pagination.PagingRequest
-	(*emptypb.Empty)(nil),                            // 15: google.protobuf.Empty
+	(*GetInboxSummaryRequest)(nil),                   // 12: internal_message.service.v1.GetInboxSummaryRequest
+	(*InboxSummary)(nil),                             // 13: internal_message.service.v1.InboxSummary
+	(*MarkAllNotificationsAsReadRequest)(nil),        // 14: internal_message.service.v1.MarkAllNotificationsAsReadRequest
+	(*GetMessageReadStatsRequest)(nil),               // 15: internal_message.service.v1.GetMessageReadStatsRequest
+	(*MessageReadStats)(nil),                         // 16: internal_message.service.v1.MessageReadStats
+	(*ListMessageNonReadersRequest)(nil),             // 17: internal_message.service.v1.ListMessageNonReadersRequest
+	(*MessageNonReader)(nil),                         // 18: internal_message.service.v1.MessageNonReader
+	(*ListMessageNonReadersResponse)(nil),            // 19: internal_message.service.v1.ListMessageNonReadersResponse
+	(*ExportMessageNonReadersRequest)(nil),           // 20: internal_message.service.v1.ExportMessageNonReadersRequest
+	(*ExportMessageNonReadersResponse)(nil),          // 21: internal_message.service.v1.ExportMessageNonReadersResponse
+	(*RemindMessageNonReadersRequest)(nil),           // 22: internal_message.service.v1.RemindMessageNonReadersRequest
+	(*RemindMessageNonReadersResponse)(nil),          // 23: internal_message.service.v1.RemindMessageNonReadersResponse
+	(*InboxSummary_CategoryUnread)(nil),              // 24: internal_message.service.v1.InboxSummary.CategoryUnread
+	(*InboxSummary_TypeUnread)(nil),                  // 25: internal_message.service.v1.InboxSummary.TypeUnread
+	(*MessageReadStats_ReadTrendPoint)(nil),          // 26: internal_message.service.v1.MessageReadStats.ReadTrendPoint
+	(*timestamppb.Timestamp)(nil),                    // 27: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                    // 28: google.protobuf.FieldMask
+	(InternalMessage_Type)(0),                        // 29: internal_message.service.v1.InternalMessage.Type
+	(*v1.PagingRequest)(nil),                         // 30: pagination.PagingRequest
+	(*emptypb.Empty)(nil),                            // 31: google.protobuf.Empty
 }
 var file_internal_message_service_v1_internal_message_recipient_proto_depIdxs = []int32{
 	0,  // 0: internal_message.service.v1.InternalMessageRecipient.status:type_name -> internal_message.service.v1.InternalMessageRecipient.Status
-	12, // 1: internal_message.service.v1.InternalMessageRecipient.received_at:type_name -> google.protobuf.Timestamp
-	12, // 2: internal_message.service.v1.InternalMessageRecipient.read_at:type_name -> google.protobuf.Timestamp
-	12, // 3: internal_message.service.v1.InternalMessageRecipient.created_at:type_name -> google.protobuf.Timestamp
-	12, // 4: internal_message.service.v1.InternalMessageRecipient.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 5: internal_message.service.v1.InternalMessageRecipient.deleted_at:type_name -> google.protobuf.Timestamp
+	27, // 1: internal_message.service.v1.InternalMessageRecipient.received_at:type_name -> google.protobuf.Timestamp
+	27, // 2: internal_message.service.v1.InternalMessageRecipient.read_at:type_name -> google.protobuf.Timestamp
+	27, // 3: internal_message.service.v1.InternalMessageRecipient.created_at:type_name -> google.protobuf.Timestamp
+	27, // 4: internal_message.service.v1.InternalMessageRecipient.updated_at:type_name -> google.protobuf.Timestamp
+	27, // 5: internal_message.service.v1.InternalMessageRecipient.deleted_at:type_name -> google.protobuf.Timestamp
 	1,  // 6: internal_message.service.v1.ListInternalMessageRecipientResponse.items:type_name -> internal_message.service.v1.InternalMessageRecipient
 	1,  // 7: internal_message.service.v1.ListUserInboxResponse.items:type_name -> internal_message.service.v1.InternalMessageRecipient
-	13, // 8: internal_message.service.v1.GetInternalMessageRecipientRequest.view_mask:type_name -> google.protobuf.FieldMask
+	28, // 8: internal_message.service.v1.GetInternalMessageRecipientRequest.view_mask:type_name -> google.protobuf.FieldMask
 	1,  // 9: internal_message.service.v1.CreateInternalMessageRecipientRequest.data:type_name -> internal_message.service.v1.InternalMessageRecipient
 	1,  // 10: internal_message.service.v1.UpdateInternalMessageRecipientRequest.data:type_name -> internal_message.service.v1.InternalMessageRecipient
-	13, // 11: internal_message.service.v1.UpdateInternalMessageRecipientRequest.update_mask:type_name -> google.protobuf.FieldMask
+	28, // 11: internal_message.service.v1.UpdateInternalMessageRecipientRequest.update_mask:type_name -> google.protobuf.FieldMask
 	0,  // 12: internal_message.service.v1.MarkNotificationsStatusRequest.new_status:type_name -> internal_message.service.v1.InternalMessageRecipient.Status
-	14, // 13: internal_message.service.v1.InternalMessageRecipientService.List:input_type -> pagination.PagingRequest
-	4,  // 14: internal_message.service.v1.InternalMessageRecipientService.Get:input_type -> internal_message.service.v1.GetInternalMessageRecipientRequest
-	5,  // 15: internal_message.service.v1.InternalMessageRecipientService.Create:input_type -> internal_message.service.v1.CreateInternalMessageRecipientRequest
-	6,  // 16: internal_message.service.v1.InternalMessageRecipientService.Update:input_type -> internal_message.service.v1.UpdateInternalMessageRecipientRequest
-	7,  // 17: internal_message.service.v1.InternalMessageRecipientService.Delete:input_type -> internal_message.service.v1.DeleteInternalMessageRecipientRequest
-	8,  // 18: internal_message.service.v1.InternalMessageRecipientService.GetInternalMessageRecipientsByIds:input_type -> internal_message.service.v1.GetInternalMessageRecipientsByIdsRequest
-	14, // 19: internal_message.service.v1.InternalMessageRecipientService.ListUserInbox:input_type -> pagination.PagingRequest
-	9,  // 20: internal_message.service.v1.InternalMessageRecipientService.DeleteNotificationFromInbox:input_type -> internal_message.service.v1.DeleteNotificationFromInboxRequest
-	10, // 21: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationAsRead:input_type -> internal_message.service.v1.MarkNotificationAsReadRequest
-	11, // 22: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationsStatus:input_type -> internal_message.service.v1.MarkNotificationsStatusRequest
-	2,  // 23: internal_message.service.v1.InternalMessageRecipientService.List:output_type -> internal_message.service.v1.ListInternalMessageRecipientResponse
-	1,  // 24: internal_message.service.v1.InternalMessageRecipientService.Get:output_type -> internal_message.service.v1.InternalMessageRecipient
-	1,  // 25: internal_message.service.v1.InternalMessageRecipientService.Create:output_type -> internal_message.service.v1.InternalMessageRecipient
-	15, // 26: internal_message.service.v1.InternalMessageRecipientService.Update:output_type -> google.protobuf.Empty
-	15, // 27: internal_message.service.v1.InternalMessageRecipientService.Delete:output_type -> google.protobuf.Empty
-	2,  // 28: internal_message.service.v1.InternalMessageRecipientService.GetInternalMessageRecipientsByIds:output_type -> internal_message.service.v1.ListInternalMessageRecipientResponse
-	3,  // 29: internal_message.service.v1.InternalMessageRecipientService.ListUserInbox:output_type -> internal_message.service.v1.ListUserInboxResponse
-	15, // 30: internal_message.service.v1.InternalMessageRecipientService.DeleteNotificationFromInbox:output_type -> google.protobuf.Empty
-	15, // 31: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationAsRead:output_type -> google.protobuf.Empty
-	15, // 32: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationsStatus:output_type -> google.protobuf.Empty
-	23, // [23:33] is the sub-list for method output_type
-	13, // [13:23] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	24, // 13: internal_message.service.v1.InboxSummary.categories:type_name -> internal_message.service.v1.InboxSummary.CategoryUnread
+	25, // 14: internal_message.service.v1.InboxSummary.types:type_name -> internal_message.service.v1.InboxSummary.TypeUnread
+	29, // 15: internal_message.service.v1.MarkAllNotificationsAsReadRequest.type:type_name -> internal_message.service.v1.InternalMessage.Type
+	26, // 16: internal_message.service.v1.MessageReadStats.read_trend:type_name -> internal_message.service.v1.MessageReadStats.ReadTrendPoint
+	27, // 17: internal_message.service.v1.MessageReadStats.last_reminded_at:type_name -> google.protobuf.Timestamp
+	0,  // 18: internal_message.service.v1.MessageNonReader.status:type_name -> internal_message.service.v1.InternalMessageRecipient.Status
+	27, // 19: internal_message.service.v1.MessageNonReader.delivered_at:type_name -> google.protobuf.Timestamp
+	27, // 20: internal_message.service.v1.MessageNonReader.received_at:type_name -> google.protobuf.Timestamp
+	18, // 21: internal_message.service.v1.ListMessageNonReadersResponse.items:type_name -> internal_message.service.v1.MessageNonReader
+	29, // 22: internal_message.service.v1.InboxSummary.TypeUnread.type:type_name -> internal_message.service.v1.InternalMessage.Type
+	27, // 23: internal_message.service.v1.MessageReadStats.ReadTrendPoint.time:type_name -> google.protobuf.Timestamp
+	30, // 24: internal_message.service.v1.InternalMessageRecipientService.List:input_type -> pagination.PagingRequest
+	4,  // 25: internal_message.service.v1.InternalMessageRecipientService.Get:input_type -> internal_message.service.v1.GetInternalMessageRecipientRequest
+	5,  // 26: internal_message.service.v1.InternalMessageRecipientService.Create:input_type -> internal_message.service.v1.CreateInternalMessageRecipientRequest
+	6,  // 27: internal_message.service.v1.InternalMessageRecipientService.Update:input_type -> internal_message.service.v1.UpdateInternalMessageRecipientRequest
+	7,  // 28: internal_message.service.v1.InternalMessageRecipientService.Delete:input_type -> internal_message.service.v1.DeleteInternalMessageRecipientRequest
+	8,  // 29: internal_message.service.v1.InternalMessageRecipientService.GetInternalMessageRecipientsByIds:input_type -> internal_message.service.v1.GetInternalMessageRecipientsByIdsRequest
+	30, // 30: internal_message.service.v1.InternalMessageRecipientService.ListUserInbox:input_type -> pagination.PagingRequest
+	9,  // 31: internal_message.service.v1.InternalMessageRecipientService.DeleteNotificationFromInbox:input_type -> internal_message.service.v1.DeleteNotificationFromInboxRequest
+	10, // 32: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationAsRead:input_type -> internal_message.service.v1.MarkNotificationAsReadRequest
+	11, // 33: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationsStatus:input_type -> internal_message.service.v1.MarkNotificationsStatusRequest
+	12, // 34: internal_message.service.v1.InternalMessageRecipientService.GetInboxSummary:input_type -> internal_message.service.v1.GetInboxSummaryRequest
+	14, // 35: internal_message.service.v1.InternalMessageRecipientService.MarkAllNotificationsAsRead:input_type -> internal_message.service.v1.MarkAllNotificationsAsReadRequest
+	2,  // 36: internal_message.service.v1.InternalMessageRecipientService.List:output_type -> internal_message.service.v1.ListInternalMessageRecipientResponse
+	1,  // 37: internal_message.service.v1.InternalMessageRecipientService.Get:output_type -> internal_message.service.v1.InternalMessageRecipient
+	1,  // 38: internal_message.service.v1.InternalMessageRecipientService.Create:output_type -> internal_message.service.v1.InternalMessageRecipient
+	31, // 39: internal_message.service.v1.InternalMessageRecipientService.Update:output_type -> google.protobuf.Empty
+	31, // 40: internal_message.service.v1.InternalMessageRecipientService.Delete:output_type -> google.protobuf.Empty
+	2,  // 41: internal_message.service.v1.InternalMessageRecipientService.GetInternalMessageRecipientsByIds:output_type -> internal_message.service.v1.ListInternalMessageRecipientResponse
+	3,  // 42: internal_message.service.v1.InternalMessageRecipientService.ListUserInbox:output_type -> internal_message.service.v1.ListUserInboxResponse
+	31, // 43: internal_message.service.v1.InternalMessageRecipientService.DeleteNotificationFromInbox:output_type -> google.protobuf.Empty
+	31, // 44: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationAsRead:output_type -> google.protobuf.Empty
+	31, // 45: internal_message.service.v1.InternalMessageRecipientService.MarkNotificationsStatus:output_type -> google.protobuf.Empty
+	13, // 46: internal_message.service.v1.InternalMessageRecipientService.GetInboxSummary:output_type -> internal_message.service.v1.InboxSummary
+	13, // 47: internal_message.service.v1.InternalMessageRecipientService.MarkAllNotificationsAsRead:output_type -> internal_message.service.v1.InboxSummary
+	36, // [36:48] is the sub-list for method output_type
+	24, // [24:36] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_internal_message_service_v1_internal_message_recipient_proto_init() }
@@ -962,18 +2042,25 @@ func file_internal_message_service_v1_internal_message_recipient_proto_init() {
 	if File_internal_message_service_v1_internal_message_recipient_proto != nil {
 		return
 	}
+	file_internal_message_service_v1_internal_message_proto_init()
 	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[0].OneofWrappers = []any{}
 	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[3].OneofWrappers = []any{
 		(*GetInternalMessageRecipientRequest_Id)(nil),
 	}
 	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[5].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[13].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[14].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[15].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[16].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[17].OneofWrappers = []any{}
+	file_internal_message_service_v1_internal_message_recipient_proto_msgTypes[23].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_message_service_v1_internal_message_recipient_proto_rawDesc), len(file_internal_message_service_v1_internal_message_recipient_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -158,6 +158,28 @@ func (s *redactedInternalMessageRecipientServiceServer) MarkNotificationsStatus(
 	return res, err
 }
 
+// GetInboxSummary is the redacted wrapper for the actual InternalMessageRecipientServiceServer.GetInboxSummary method
+// Unary RPC
+func (s *redactedInternalMessageRecipientServiceServer) GetInboxSummary(ctx context.Context, in *GetInboxSummaryRequest) (*InboxSummary, error) {
+	res, err := s.srv.GetInboxSummary(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
+// MarkAllNotificationsAsRead is the redacted wrapper for the actual InternalMessageRecipientServiceServer.MarkAllNotificationsAsRead method
+// Unary RPC
+func (s *redactedInternalMessageRecipientServiceServer) MarkAllNotificationsAsRead(ctx context.Context, in *MarkAllNotificationsAsReadRequest) (*InboxSummary, error) {
+	res, err := s.srv.MarkAllNotificationsAsRead(ctx, in)
+	if !s.bypass.CheckInternal(ctx) {
+		// Apply redaction to the response
+		redact.Apply(res)
+	}
+	return res, err
+}
+
 // Redact method implementation for InternalMessageRecipient
 func (x *InternalMessageRecipient) Redact() string {
 	if x == nil {
@@ -313,3 +335,223 @@ func (x *MarkNotificationsStatusRequest) Redact() string {
 	// Safe field: NewStatus
 	return x.String()
 }
+
+// Redact method implementation for GetInboxSummaryRequest
+func (x *GetInboxSummaryRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+	return x.String()
+}
+
+// Redact method implementation for InboxSummary
+func (x *InboxSummary) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: TotalUnread
+
+	// Safe field: Categories
+
+	// Safe field: Types
+	return x.String()
+}
+
+// Redact method implementation for MarkAllNotificationsAsReadRequest
+func (x *MarkAllNotificationsAsReadRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: UserId
+
+	// Safe field: CategoryId
+
+	// Safe field: Type
+	return x.String()
+}
+
+// Redact method implementation for GetMessageReadStatsRequest
+func (x *GetMessageReadStatsRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+
+	// Safe field: Interval
+	return x.String()
+}
+
+// Redact method implementation for MessageReadStats
+func (x *MessageReadStats) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+
+	// Safe field: Targeted
+
+	// Safe field: Delivered
+
+	// Safe field: Failed
+
+	// Safe field: Received
+
+	// Safe field: Read
+
+	// Safe field: Unread
+
+	// Safe field: Revoked
+
+	// Safe field: Deleted
+
+	// Safe field: ReadRate
+
+	// Safe field: ReadTrend
+
+	// Safe field: RemindCount
+
+	// Safe field: LastRemindedAt
+	return x.String()
+}
+
+// Redact method implementation for ListMessageNonReadersRequest
+func (x *ListMessageNonReadersRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+
+	// Safe field: Page
+
+	// Safe field: PageSize
+	return x.String()
+}
+
+// Redact method implementation for MessageNonReader
+func (x *MessageNonReader) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: RecipientId
+
+	// Safe field: UserId
+
+	// Safe field: Username
+
+	// Safe field: Realname
+
+	// Safe field: Status
+
+	// Safe field: DeliveredAt
+
+	// Safe field: ReceivedAt
+	return x.String()
+}
+
+// Redact method implementation for ListMessageNonReadersResponse
+func (x *ListMessageNonReadersResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Items
+
+	// Safe field: Total
+	return x.String()
+}
+
+// Redact method implementation for ExportMessageNonReadersRequest
+func (x *ExportMessageNonReadersRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+	return x.String()
+}
+
+// Redact method implementation for ExportMessageNonReadersResponse
+func (x *ExportMessageNonReadersResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: FileName
+
+	// Safe field: MimeType
+
+	// Safe field: Content
+	return x.String()
+}
+
+// Redact method implementation for RemindMessageNonReadersRequest
+func (x *RemindMessageNonReadersRequest) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: MessageId
+	return x.String()
+}
+
+// Redact method implementation for RemindMessageNonReadersResponse
+func (x *RemindMessageNonReadersResponse) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Reminded
+	return x.String()
+}
+
+// Redact method implementation for InboxSummary_CategoryUnread
+func (x *InboxSummary_CategoryUnread) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: CategoryId
+
+	// Safe field: CategoryName
+
+	// Safe field: Unread
+	return x.String()
+}
+
+// Redact method implementation for InboxSummary_TypeUnread
+func (x *InboxSummary_TypeUnread) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Type
+
+	// Safe field: Unread
+	return x.String()
+}
+
+// Redact method implementation for MessageReadStats_ReadTrendPoint
+func (x *MessageReadStats_ReadTrendPoint) Redact() string {
+	if x == nil {
+		return ""
+	}
+
+	// Safe field: Time
+
+	// Safe field: Read
+
+	// Safe field: CumulativeRead
+
+	// Safe field: ReadRate
+	return x.String()
+}
@@ -1606,3 +1606,1903 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MarkNotificationsStatusRequestValidationError{}
+
+// Validate checks the field values on GetInboxSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInboxSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInboxSummaryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInboxSummaryRequestMultiError, or nil if none found.
+func (m *GetInboxSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInboxSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if len(errors) > 0 {
+		return GetInboxSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInboxSummaryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInboxSummaryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInboxSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInboxSummaryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInboxSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetInboxSummaryRequestValidationError is the validation error returned by
+// GetInboxSummaryRequest.Validate if the designated constraints aren't met.
+type GetInboxSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInboxSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInboxSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInboxSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInboxSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInboxSummaryRequestValidationError) ErrorName() string {
+	return "GetInboxSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInboxSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInboxSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInboxSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInboxSummaryRequestValidationError{}
+
+// Validate checks the field values on InboxSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InboxSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboxSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InboxSummaryMultiError, or
+// nil if none found.
+func (m *InboxSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboxSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for TotalUnread
+
+	for idx, item := range m.GetCategories() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InboxSummaryValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InboxSummaryValidationError{
+						field:  fmt.Sprintf("Categories[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InboxSummaryValidationError{
+					field:  fmt.Sprintf("Categories[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InboxSummaryValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InboxSummaryValidationError{
+						field:  fmt.Sprintf("Types[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InboxSummaryValidationError{
+					field:  fmt.Sprintf("Types[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InboxSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboxSummaryMultiError is an error wrapping multiple validation errors
+// returned by InboxSummary.ValidateAll() if the designated constraints aren't met.
+type InboxSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboxSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboxSummaryMultiError) AllErrors() []error { return m }
+
+// InboxSummaryValidationError is the validation error returned by
+// InboxSummary.Validate if the designated constraints aren't met.
+type InboxSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboxSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboxSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboxSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboxSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboxSummaryValidationError) ErrorName() string { return "InboxSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InboxSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboxSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboxSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboxSummaryValidationError{}
+
+// Validate checks the field values on MarkAllNotificationsAsReadRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MarkAllNotificationsAsReadRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MarkAllNotificationsAsReadRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MarkAllNotificationsAsReadRequestMultiError, or nil if none found.
+func (m *MarkAllNotificationsAsReadRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MarkAllNotificationsAsReadRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Type != nil {
+		// no validation rules for Type
+	}
+
+	if len(errors) > 0 {
+		return MarkAllNotificationsAsReadRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MarkAllNotificationsAsReadRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// MarkAllNotificationsAsReadRequest.ValidateAll() if the designated
+// constraints aren't met.
+type MarkAllNotificationsAsReadRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MarkAllNotificationsAsReadRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MarkAllNotificationsAsReadRequestMultiError) AllErrors() []error { return m }
+
+// MarkAllNotificationsAsReadRequestValidationError is the validation error
+// returned by MarkAllNotificationsAsReadRequest.Validate if the designated
+// constraints aren't met.
+type MarkAllNotificationsAsReadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MarkAllNotificationsAsReadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MarkAllNotificationsAsReadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MarkAllNotificationsAsReadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MarkAllNotificationsAsReadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MarkAllNotificationsAsReadRequestValidationError) ErrorName() string {
+	return "MarkAllNotificationsAsReadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MarkAllNotificationsAsReadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMarkAllNotificationsAsReadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MarkAllNotificationsAsReadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MarkAllNotificationsAsReadRequestValidationError{}
+
+// Validate checks the field values on GetMessageReadStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetMessageReadStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetMessageReadStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetMessageReadStatsRequestMultiError, or nil if none found.
+func (m *GetMessageReadStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetMessageReadStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if m.Interval != nil {
+		// no validation rules for Interval
+	}
+
+	if len(errors) > 0 {
+		return GetMessageReadStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetMessageReadStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetMessageReadStatsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetMessageReadStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetMessageReadStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetMessageReadStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetMessageReadStatsRequestValidationError is the validation error returned
+// by GetMessageReadStatsRequest.Validate if the designated constraints aren't met.
+type GetMessageReadStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetMessageReadStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetMessageReadStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetMessageReadStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetMessageReadStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetMessageReadStatsRequestValidationError) ErrorName() string {
+	return "GetMessageReadStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetMessageReadStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetMessageReadStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetMessageReadStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetMessageReadStatsRequestValidationError{}
+
+// Validate checks the field values on MessageReadStats with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageReadStats) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageReadStats with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageReadStatsMultiError, or nil if none found.
+func (m *MessageReadStats) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageReadStats) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	// no validation rules for Targeted
+
+	// no validation rules for Delivered
+
+	// no validation rules for Failed
+
+	// no validation rules for Received
+
+	// no validation rules for Read
+
+	// no validation rules for Unread
+
+	// no validation rules for Revoked
+
+	// no validation rules for Deleted
+
+	// no validation rules for ReadRate
+
+	for idx, item := range m.GetReadTrend() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageReadStatsValidationError{
+						field:  fmt.Sprintf("ReadTrend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageReadStatsValidationError{
+						field:  fmt.Sprintf("ReadTrend[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageReadStatsValidationError{
+					field:  fmt.Sprintf("ReadTrend[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RemindCount != nil {
+		// no validation rules for RemindCount
+	}
+
+	if m.LastRemindedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetLastRemindedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageReadStatsValidationError{
+						field:  "LastRemindedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageReadStatsValidationError{
+						field:  "LastRemindedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLastRemindedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageReadStatsValidationError{
+					field:  "LastRemindedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MessageReadStatsMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageReadStatsMultiError is an error wrapping multiple validation errors
+// returned by MessageReadStats.ValidateAll() if the designated constraints
+// aren't met.
+type MessageReadStatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageReadStatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageReadStatsMultiError) AllErrors() []error { return m }
+
+// MessageReadStatsValidationError is the validation error returned by
+// MessageReadStats.Validate if the designated constraints aren't met.
+type MessageReadStatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageReadStatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageReadStatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageReadStatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageReadStatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageReadStatsValidationError) ErrorName() string { return "MessageReadStatsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageReadStatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageReadStats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageReadStatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageReadStatsValidationError{}
+
+// Validate checks the field values on ListMessageNonReadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageNonReadersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageNonReadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListMessageNonReadersRequestMultiError, or nil if none found.
+func (m *ListMessageNonReadersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageNonReadersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return ListMessageNonReadersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageNonReadersRequestMultiError is an error wrapping multiple
+// validation errors returned by ListMessageNonReadersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListMessageNonReadersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageNonReadersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageNonReadersRequestMultiError) AllErrors() []error { return m }
+
+// ListMessageNonReadersRequestValidationError is the validation error returned
+// by ListMessageNonReadersRequest.Validate if the designated constraints
+// aren't met.
+type ListMessageNonReadersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageNonReadersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageNonReadersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageNonReadersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageNonReadersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageNonReadersRequestValidationError) ErrorName() string {
+	return "ListMessageNonReadersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageNonReadersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageNonReadersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageNonReadersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageNonReadersRequestValidationError{}
+
+// Validate checks the field values on MessageNonReader with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MessageNonReader) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageNonReader with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageNonReaderMultiError, or nil if none found.
+func (m *MessageNonReader) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageNonReader) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecipientId
+
+	// no validation rules for UserId
+
+	// no validation rules for Status
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Realname != nil {
+		// no validation rules for Realname
+	}
+
+	if m.DeliveredAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDeliveredAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageNonReaderValidationError{
+						field:  "DeliveredAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageNonReaderValidationError{
+						field:  "DeliveredAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageNonReaderValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReceivedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetReceivedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageNonReaderValidationError{
+						field:  "ReceivedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageNonReaderValidationError{
+						field:  "ReceivedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReceivedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageNonReaderValidationError{
+					field:  "ReceivedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MessageNonReaderMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageNonReaderMultiError is an error wrapping multiple validation errors
+// returned by MessageNonReader.ValidateAll() if the designated constraints
+// aren't met.
+type MessageNonReaderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageNonReaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageNonReaderMultiError) AllErrors() []error { return m }
+
+// MessageNonReaderValidationError is the validation error returned by
+// MessageNonReader.Validate if the designated constraints aren't met.
+type MessageNonReaderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageNonReaderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageNonReaderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageNonReaderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageNonReaderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageNonReaderValidationError) ErrorName() string { return "MessageNonReaderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageNonReaderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageNonReader.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageNonReaderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageNonReaderValidationError{}
+
+// Validate checks the field values on ListMessageNonReadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListMessageNonReadersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListMessageNonReadersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListMessageNonReadersResponseMultiError, or nil if none found.
+func (m *ListMessageNonReadersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListMessageNonReadersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListMessageNonReadersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListMessageNonReadersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListMessageNonReadersResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return ListMessageNonReadersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListMessageNonReadersResponseMultiError is an error wrapping multiple
+// validation errors returned by ListMessageNonReadersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListMessageNonReadersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListMessageNonReadersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListMessageNonReadersResponseMultiError) AllErrors() []error { return m }
+
+// ListMessageNonReadersResponseValidationError is the validation error
+// returned by ListMessageNonReadersResponse.Validate if the designated
+// constraints aren't met.
+type ListMessageNonReadersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListMessageNonReadersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListMessageNonReadersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListMessageNonReadersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListMessageNonReadersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListMessageNonReadersResponseValidationError) ErrorName() string {
+	return "ListMessageNonReadersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListMessageNonReadersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListMessageNonReadersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListMessageNonReadersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListMessageNonReadersResponseValidationError{}
+
+// Validate checks the field values on ExportMessageNonReadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMessageNonReadersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMessageNonReadersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportMessageNonReadersRequestMultiError, or nil if none found.
+func (m *ExportMessageNonReadersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMessageNonReadersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return ExportMessageNonReadersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMessageNonReadersRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportMessageNonReadersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ExportMessageNonReadersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMessageNonReadersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMessageNonReadersRequestMultiError) AllErrors() []error { return m }
+
+// ExportMessageNonReadersRequestValidationError is the validation error
+// returned by ExportMessageNonReadersRequest.Validate if the designated
+// constraints aren't met.
+type ExportMessageNonReadersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMessageNonReadersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMessageNonReadersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMessageNonReadersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMessageNonReadersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMessageNonReadersRequestValidationError) ErrorName() string {
+	return "ExportMessageNonReadersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMessageNonReadersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMessageNonReadersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMessageNonReadersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMessageNonReadersRequestValidationError{}
+
+// Validate checks the field values on ExportMessageNonReadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportMessageNonReadersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportMessageNonReadersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ExportMessageNonReadersResponseMultiError, or nil if none found.
+func (m *ExportMessageNonReadersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportMessageNonReadersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileName
+
+	// no validation rules for MimeType
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ExportMessageNonReadersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportMessageNonReadersResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportMessageNonReadersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ExportMessageNonReadersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportMessageNonReadersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportMessageNonReadersResponseMultiError) AllErrors() []error { return m }
+
+// ExportMessageNonReadersResponseValidationError is the validation error
+// returned by ExportMessageNonReadersResponse.Validate if the designated
+// constraints aren't met.
+type ExportMessageNonReadersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportMessageNonReadersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportMessageNonReadersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportMessageNonReadersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportMessageNonReadersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportMessageNonReadersResponseValidationError) ErrorName() string {
+	return "ExportMessageNonReadersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportMessageNonReadersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportMessageNonReadersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportMessageNonReadersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportMessageNonReadersResponseValidationError{}
+
+// Validate checks the field values on RemindMessageNonReadersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemindMessageNonReadersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemindMessageNonReadersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemindMessageNonReadersRequestMultiError, or nil if none found.
+func (m *RemindMessageNonReadersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemindMessageNonReadersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if len(errors) > 0 {
+		return RemindMessageNonReadersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemindMessageNonReadersRequestMultiError is an error wrapping multiple
+// validation errors returned by RemindMessageNonReadersRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RemindMessageNonReadersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemindMessageNonReadersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemindMessageNonReadersRequestMultiError) AllErrors() []error { return m }
+
+// RemindMessageNonReadersRequestValidationError is the validation error
+// returned by RemindMessageNonReadersRequest.Validate if the designated
+// constraints aren't met.
+type RemindMessageNonReadersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemindMessageNonReadersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemindMessageNonReadersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemindMessageNonReadersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemindMessageNonReadersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemindMessageNonReadersRequestValidationError) ErrorName() string {
+	return "RemindMessageNonReadersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemindMessageNonReadersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemindMessageNonReadersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemindMessageNonReadersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemindMessageNonReadersRequestValidationError{}
+
+// Validate checks the field values on RemindMessageNonReadersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemindMessageNonReadersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemindMessageNonReadersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemindMessageNonReadersResponseMultiError, or nil if none found.
+func (m *RemindMessageNonReadersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemindMessageNonReadersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reminded
+
+	if len(errors) > 0 {
+		return RemindMessageNonReadersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemindMessageNonReadersResponseMultiError is an error wrapping multiple
+// validation errors returned by RemindMessageNonReadersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemindMessageNonReadersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemindMessageNonReadersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemindMessageNonReadersResponseMultiError) AllErrors() []error { return m }
+
+// RemindMessageNonReadersResponseValidationError is the validation error
+// returned by RemindMessageNonReadersResponse.Validate if the designated
+// constraints aren't met.
+type RemindMessageNonReadersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemindMessageNonReadersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemindMessageNonReadersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemindMessageNonReadersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemindMessageNonReadersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemindMessageNonReadersResponseValidationError) ErrorName() string {
+	return "RemindMessageNonReadersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemindMessageNonReadersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemindMessageNonReadersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemindMessageNonReadersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemindMessageNonReadersResponseValidationError{}
+
+// Validate checks the field values on InboxSummary_CategoryUnread with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InboxSummary_CategoryUnread) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboxSummary_CategoryUnread with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InboxSummary_CategoryUnreadMultiError, or nil if none found.
+func (m *InboxSummary_CategoryUnread) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboxSummary_CategoryUnread) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CategoryId
+
+	// no validation rules for Unread
+
+	if m.CategoryName != nil {
+		// no validation rules for CategoryName
+	}
+
+	if len(errors) > 0 {
+		return InboxSummary_CategoryUnreadMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboxSummary_CategoryUnreadMultiError is an error wrapping multiple
+// validation errors returned by InboxSummary_CategoryUnread.ValidateAll() if
+// the designated constraints aren't met.
+type InboxSummary_CategoryUnreadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboxSummary_CategoryUnreadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboxSummary_CategoryUnreadMultiError) AllErrors() []error { return m }
+
+// InboxSummary_CategoryUnreadValidationError is the validation error returned
+// by InboxSummary_CategoryUnread.Validate if the designated constraints
+// aren't met.
+type InboxSummary_CategoryUnreadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboxSummary_CategoryUnreadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboxSummary_CategoryUnreadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboxSummary_CategoryUnreadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboxSummary_CategoryUnreadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboxSummary_CategoryUnreadValidationError) ErrorName() string {
+	return "InboxSummary_CategoryUnreadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InboxSummary_CategoryUnreadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboxSummary_CategoryUnread.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboxSummary_CategoryUnreadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboxSummary_CategoryUnreadValidationError{}
+
+// Validate checks the field values on InboxSummary_TypeUnread with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InboxSummary_TypeUnread) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InboxSummary_TypeUnread with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InboxSummary_TypeUnreadMultiError, or nil if none found.
+func (m *InboxSummary_TypeUnread) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InboxSummary_TypeUnread) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Unread
+
+	if len(errors) > 0 {
+		return InboxSummary_TypeUnreadMultiError(errors)
+	}
+
+	return nil
+}
+
+// InboxSummary_TypeUnreadMultiError is an error wrapping multiple validation
+// errors returned by InboxSummary_TypeUnread.ValidateAll() if the designated
+// constraints aren't met.
+type InboxSummary_TypeUnreadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InboxSummary_TypeUnreadMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InboxSummary_TypeUnreadMultiError) AllErrors() []error { return m }
+
+// InboxSummary_TypeUnreadValidationError is the validation error returned by
+// InboxSummary_TypeUnread.Validate if the designated constraints aren't met.
+type InboxSummary_TypeUnreadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InboxSummary_TypeUnreadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InboxSummary_TypeUnreadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InboxSummary_TypeUnreadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InboxSummary_TypeUnreadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InboxSummary_TypeUnreadValidationError) ErrorName() string {
+	return "InboxSummary_TypeUnreadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InboxSummary_TypeUnreadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInboxSummary_TypeUnread.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InboxSummary_TypeUnreadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InboxSummary_TypeUnreadValidationError{}
+
+// Validate checks the field values on MessageReadStats_ReadTrendPoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MessageReadStats_ReadTrendPoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MessageReadStats_ReadTrendPoint with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// MessageReadStats_ReadTrendPointMultiError, or nil if none found.
+func (m *MessageReadStats_ReadTrendPoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MessageReadStats_ReadTrendPoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageReadStats_ReadTrendPointValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageReadStats_ReadTrendPointValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageReadStats_ReadTrendPointValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Read
+
+	// no validation rules for CumulativeRead
+
+	// no validation rules for ReadRate
+
+	if len(errors) > 0 {
+		return MessageReadStats_ReadTrendPointMultiError(errors)
+	}
+
+	return nil
+}
+
+// MessageReadStats_ReadTrendPointMultiError is an error wrapping multiple
+// validation errors returned by MessageReadStats_ReadTrendPoint.ValidateAll()
+// if the designated constraints aren't met.
+type MessageReadStats_ReadTrendPointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MessageReadStats_ReadTrendPointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MessageReadStats_ReadTrendPointMultiError) AllErrors() []error { return m }
+
+// MessageReadStats_ReadTrendPointValidationError is the validation error
+// returned by MessageReadStats_ReadTrendPoint.Validate if the designated
+// constraints aren't met.
+type MessageReadStats_ReadTrendPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageReadStats_ReadTrendPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageReadStats_ReadTrendPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageReadStats_ReadTrendPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageReadStats_ReadTrendPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageReadStats_ReadTrendPointValidationError) ErrorName() string {
+	return "MessageReadStats_ReadTrendPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MessageReadStats_ReadTrendPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageReadStats_ReadTrendPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageReadStats_ReadTrendPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageReadStats_ReadTrendPointValidationError{}
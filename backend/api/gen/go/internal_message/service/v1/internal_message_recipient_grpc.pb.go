@@ -31,6 +31,8 @@ const (
 	InternalMessageRecipientService_DeleteNotificationFromInbox_FullMethodName       = "/internal_message.service.v1.InternalMessageRecipientService/DeleteNotificationFromInbox"
 	InternalMessageRecipientService_MarkNotificationAsRead_FullMethodName            = "/internal_message.service.v1.InternalMessageRecipientService/MarkNotificationAsRead"
 	InternalMessageRecipientService_MarkNotificationsStatus_FullMethodName           = "/internal_message.service.v1.InternalMessageRecipientService/MarkNotificationsStatus"
+	InternalMessageRecipientService_GetInboxSummary_FullMethodName                   = "/internal_message.service.v1.InternalMessageRecipientService/GetInboxSummary"
+	InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName        = "/internal_message.service.v1.InternalMessageRecipientService/MarkAllNotificationsAsRead"
 )
 
 // InternalMessageRecipientServiceClient is the client API for InternalMessageRecipientService service.
@@ -59,6 +61,10 @@ type InternalMessageRecipientServiceClient interface {
 	MarkNotificationAsRead(ctx context.Context, in *MarkNotificationAsReadRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 标记特定用户的某些或所有通知的状态
 	MarkNotificationsStatus(ctx context.Context, in *MarkNotificationsStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 获取用户收件箱的未读数量汇总
+	GetInboxSummary(ctx context.Context, in *GetInboxSummaryRequest, opts ...grpc.CallOption) (*InboxSummary, error)
+	// 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(ctx context.Context, in *MarkAllNotificationsAsReadRequest, opts ...grpc.CallOption) (*InboxSummary, error)
 }
 
 type internalMessageRecipientServiceClient struct {
@@ -169,6 +175,26 @@ func (c *internalMessageRecipientServiceClient) MarkNotificationsStatus(ctx cont
 	return out, nil
 }
 
+func (c *internalMessageRecipientServiceClient) GetInboxSummary(ctx context.Context, in *GetInboxSummaryRequest, opts ...grpc.CallOption) (*InboxSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxSummary)
+	err := c.cc.Invoke(ctx, InternalMessageRecipientService_GetInboxSummary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *internalMessageRecipientServiceClient) MarkAllNotificationsAsRead(ctx context.Context, in *MarkAllNotificationsAsReadRequest, opts ...grpc.CallOption) (*InboxSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InboxSummary)
+	err := c.cc.Invoke(ctx, InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InternalMessageRecipientServiceServer is the server API for InternalMessageRecipientService service.
 // All implementations must embed UnimplementedInternalMessageRecipientServiceServer
 // for forward compatibility.
@@ -195,6 +221,10 @@ type InternalMessageRecipientServiceServer interface {
 	MarkNotificationAsRead(context.Context, *MarkNotificationAsReadRequest) (*emptypb.Empty, error)
 	// 标记特定用户的某些或所有通知的状态
 	MarkNotificationsStatus(context.Context, *MarkNotificationsStatusRequest) (*emptypb.Empty, error)
+	// 获取用户收件箱的未读数量汇总
+	GetInboxSummary(context.Context, *GetInboxSummaryRequest) (*InboxSummary, error)
+	// 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+	MarkAllNotificationsAsRead(context.Context, *MarkAllNotificationsAsReadRequest) (*InboxSummary, error)
 	mustEmbedUnimplementedInternalMessageRecipientServiceServer()
 }
 
@@ -235,6 +265,12 @@ func (UnimplementedInternalMessageRecipientServiceServer) MarkNotificationAsRead
 func (UnimplementedInternalMessageRecipientServiceServer) MarkNotificationsStatus(context.Context, *MarkNotificationsStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsStatus not implemented")
 }
+func (UnimplementedInternalMessageRecipientServiceServer) GetInboxSummary(context.Context, *GetInboxSummaryRequest) (*InboxSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInboxSummary not implemented")
+}
+func (UnimplementedInternalMessageRecipientServiceServer) MarkAllNotificationsAsRead(context.Context, *MarkAllNotificationsAsReadRequest) (*InboxSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsAsRead not implemented")
+}
 func (UnimplementedInternalMessageRecipientServiceServer) mustEmbedUnimplementedInternalMessageRecipientServiceServer() {
 }
 func (UnimplementedInternalMessageRecipientServiceServer) testEmbeddedByValue() {}
@@ -437,6 +473,42 @@ func _InternalMessageRecipientService_MarkNotificationsStatus_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _InternalMessageRecipientService_GetInboxSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInboxSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageRecipientServiceServer).GetInboxSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageRecipientService_GetInboxSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageRecipientServiceServer).GetInboxSummary(ctx, req.(*GetInboxSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InternalMessageRecipientService_MarkAllNotificationsAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllNotificationsAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InternalMessageRecipientServiceServer).MarkAllNotificationsAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InternalMessageRecipientService_MarkAllNotificationsAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InternalMessageRecipientServiceServer).MarkAllNotificationsAsRead(ctx, req.(*MarkAllNotificationsAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // InternalMessageRecipientService_ServiceDesc is the grpc.ServiceDesc for InternalMessageRecipientService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -484,6 +556,14 @@ var InternalMessageRecipientService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkNotificationsStatus",
 			Handler:    _InternalMessageRecipientService_MarkNotificationsStatus_Handler,
 		},
+		{
+			MethodName: "GetInboxSummary",
+			Handler:    _InternalMessageRecipientService_GetInboxSummary_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsAsRead",
+			Handler:    _InternalMessageRecipientService_MarkAllNotificationsAsRead_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal_message/service/v1/internal_message_recipient.proto",
@@ -209,6 +209,8 @@ type Tenant struct {
 	UnsubscribeAt    *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=unsubscribe_at,json=unsubscribeAt,proto3,oneof" json:"unsubscribe_at,omitempty"`                                   // 取消订阅时间（NULL表示未取消）
 	ExpiredAt        *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=expired_at,json=expiredAt,proto3,oneof" json:"expired_at,omitempty"`                                               // 租户有效期（NULL表示永久，过期后状态自动改为“过期”）
 	SubscriptionPlan *string                `protobuf:"bytes,23,opt,name=subscription_plan,json=subscriptionPlan,proto3,oneof" json:"subscription_plan,omitempty"`                          // 订阅套餐（如“企业版1年”“基础版3个月”）
+	PlanId           *uint32                `protobuf:"varint,24,opt,name=plan_id,json=planId,proto3,oneof" json:"plan_id,omitempty"`                                                       // 订阅套餐ID，为空表示不限制配额
+	PlanName         *string                `protobuf:"bytes,25,opt,name=plan_name,json=planName,proto3,oneof" json:"plan_name,omitempty"`                                                  // 订阅套餐名称
 	MemberCount      *int32                 `protobuf:"varint,30,opt,name=member_count,json=memberCount,proto3,oneof" json:"member_count,omitempty"`                                        // 成员数量
 	LastLoginTime    *timestamppb.Timestamp `protobuf:"bytes,31,opt,name=last_login_time,json=lastLoginTime,proto3,oneof" json:"last_login_time,omitempty"`                                 // 最后登录时间
 	LastLoginIp      *string                `protobuf:"bytes,32,opt,name=last_login_ip,json=lastLoginIp,proto3,oneof" json:"last_login_ip,omitempty"`                                       // 最后登录IP
@@ -359,6 +361,20 @@ func (x *Tenant) GetSubscriptionPlan() string {
 	return ""
 }
 
+func (x *Tenant) GetPlanId() uint32 {
+	if x != nil && x.PlanId != nil {
+		return *x.PlanId
+	}
+	return 0
+}
+
+func (x *Tenant) GetPlanName() string {
+	if x != nil && x.PlanName != nil {
+		return *x.PlanName
+	}
+	return ""
+}
+
 func (x *Tenant) GetMemberCount() int32 {
 	if x != nil && x.MemberCount != nil {
 		return *x.MemberCount
@@ -937,7 +953,7 @@ var File_user_service_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_user_service_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	"\x1cuser/service/v1/tenant.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xc7\x14\n" +
+	"\x1cuser/service/v1/tenant.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1epagination/v1/pagination.proto\"\xef\x15\n" +
 	"\x06Tenant\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x00R\x02id\x88\x01\x01\x12+\n" +
 	"\x04name\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f租户名称H\x01R\x04name\x88\x01\x01\x12+\n" +
@@ -956,24 +972,26 @@ const file_user_service_v1_tenant_proto_rawDesc = "" +
 	"\x0eunsubscribe_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12取消订阅时间H\fR\runsubscribeAt\x88\x01\x01\x12\x95\x01\n" +
 	"\n" +
 	"expired_at\x18\x16 \x01(\v2\x1a.google.protobuf.TimestampBU\xbaGR\x92\x02O租户有效期（NULL表示永久，过期后状态自动改为“过期”）H\rR\texpiredAt\x88\x01\x01\x12v\n" +
-	"\x11subscription_plan\x18\x17 \x01(\tBD\xbaGA\x92\x02>订阅套餐（如“企业版1年”“基础版3个月”）H\x0eR\x10subscriptionPlan\x88\x01\x01\x12:\n" +
-	"\fmember_count\x18\x1e \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员数量H\x0fR\vmemberCount\x88\x01\x01\x12a\n" +
-	"\x0flast_login_time\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x10R\rlastLoginTime\x88\x01\x01\x12=\n" +
-	"\rlast_login_ip\x18  \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x11R\vlastLoginIp\x88\x01\x01\x123\n" +
-	"\tparent_id\x182 \x01(\rB\x11\xbaG\x0e\x92\x02\v父节点IDH\x12R\bparentId\x88\x01\x01\x12G\n" +
+	"\x11subscription_plan\x18\x17 \x01(\tBD\xbaGA\x92\x02>订阅套餐（如“企业版1年”“基础版3个月”）H\x0eR\x10subscriptionPlan\x88\x01\x01\x12P\n" +
+	"\aplan_id\x18\x18 \x01(\rB2\xbaG/\x92\x02,订阅套餐ID，为空表示不限制配额H\x0fR\x06planId\x88\x01\x01\x12:\n" +
+	"\tplan_name\x18\x19 \x01(\tB\x18\xbaG\x15\x92\x02\x12订阅套餐名称H\x10R\bplanName\x88\x01\x01\x12:\n" +
+	"\fmember_count\x18\x1e \x01(\x05B\x12\xbaG\x0f\x92\x02\f成员数量H\x11R\vmemberCount\x88\x01\x01\x12a\n" +
+	"\x0flast_login_time\x18\x1f \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x12R\rlastLoginTime\x88\x01\x01\x12=\n" +
+	"\rlast_login_ip\x18  \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x13R\vlastLoginIp\x88\x01\x01\x123\n" +
+	"\tparent_id\x182 \x01(\rB\x11\xbaG\x0e\x92\x02\v父节点IDH\x14R\bparentId\x88\x01\x01\x12G\n" +
 	"\bchildren\x183 \x03(\v2\x17.user.service.v1.TenantB\x12\xbaG\x0f\x92\x02\f子节点树R\bchildren\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x13R\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x15R\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x14R\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x16R\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x15R\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x17R\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x16R\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x18R\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x17R\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x19R\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x18R\tdeletedAt\x88\x01\x01\"2\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1aR\tdeletedAt\x88\x01\x01\"2\n" +
 	"\x06Status\x12\a\n" +
 	"\x03OFF\x10\x00\x12\x06\n" +
 	"\x02ON\x10\x01\x12\v\n" +
@@ -1007,7 +1025,11 @@ const file_user_service_v1_tenant_proto_rawDesc = "" +
 	"\x10_subscription_atB\x11\n" +
 	"\x0f_unsubscribe_atB\r\n" +
 	"\v_expired_atB\x14\n" +
-	"\x12_subscription_planB\x0f\n" +
+	"\x12_subscription_planB\n" +
+	"\n" +
+	"\b_plan_idB\f\n" +
+	"\n" +
+	"_plan_nameB\x0f\n" +
 	"\r_member_countB\x12\n" +
 	"\x10_last_login_timeB\x10\n" +
 	"\x0e_last_login_ipB\f\n" +
@@ -159,6 +159,10 @@ func (x *Tenant) Redact() string {
 
 	// Safe field: SubscriptionPlan
 
+	// Safe field: PlanId
+
+	// Safe field: PlanName
+
 	// Safe field: MemberCount
 
 	// Safe field: LastLoginTime
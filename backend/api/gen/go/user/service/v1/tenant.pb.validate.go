@@ -237,6 +237,14 @@ func (m *Tenant) validate(all bool) error {
 		// no validation rules for SubscriptionPlan
 	}
 
+	if m.PlanId != nil {
+		// no validation rules for PlanId
+	}
+
+	if m.PlanName != nil {
+		// no validation rules for PlanName
+	}
+
 	if m.MemberCount != nil {
 		// no validation rules for MemberCount
 	}
@@ -205,6 +205,7 @@ type User struct {
 	Gender        *User_Gender           `protobuf:"varint,27,opt,name=gender,proto3,enum=user.service.v1.User_Gender,oneof" json:"gender,omitempty"`          // 性别
 	Address       *string                `protobuf:"bytes,28,opt,name=address,proto3,oneof" json:"address,omitempty"`                                          // 住址
 	Region        *string                `protobuf:"bytes,29,opt,name=region,proto3,oneof" json:"region,omitempty"`                                            // 国家地区
+	Language      *string                `protobuf:"bytes,36,opt,name=language,proto3,oneof" json:"language,omitempty"`                                        // 首选语言代码
 	Description   *string                `protobuf:"bytes,30,opt,name=description,proto3,oneof" json:"description,omitempty"`                                  // 个人描述
 	Remark        *string                `protobuf:"bytes,31,opt,name=remark,proto3,oneof" json:"remark,omitempty"`                                            // 备注
 	LastLoginTime *timestamppb.Timestamp `protobuf:"bytes,32,opt,name=last_login_time,json=lastLoginTime,proto3,oneof" json:"last_login_time,omitempty"`       // 最后登录时间
@@ -412,6 +413,13 @@ func (x *User) GetRegion() string {
 	return ""
 }
 
+func (x *User) GetLanguage() string {
+	if x != nil && x.Language != nil {
+		return *x.Language
+	}
+	return ""
+}
+
 func (x *User) GetDescription() string {
 	if x != nil && x.Description != nil {
 		return *x.Description
@@ -557,6 +565,7 @@ type GetUserRequest struct {
 	//	*GetUserRequest_Id
 	//	*GetUserRequest_UserName
 	QueryBy       isGetUserRequest_QueryBy `protobuf_oneof:"query_by"`
+	TenantId      *uint32                  `protobuf:"varint,3,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"`  // 租户ID
 	ViewMask      *fieldmaskpb.FieldMask   `protobuf:"bytes,100,opt,name=view_mask,json=viewMask,proto3,oneof" json:"view_mask,omitempty"` // 视图字段过滤器，用于控制返回的字段
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -617,6 +626,13 @@ func (x *GetUserRequest) GetUserName() string {
 	return ""
 }
 
+func (x *GetUserRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 func (x *GetUserRequest) GetViewMask() *fieldmaskpb.FieldMask {
 	if x != nil {
 		return x.ViewMask
@@ -818,7 +834,8 @@ func (x *DeleteUserRequest) GetId() uint32 {
 // 用户是否存在 - 请求
 type UserExistsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"` // 用户登录名
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`                        // 用户登录名
+	TenantId      *uint32                `protobuf:"varint,2,opt,name=tenant_id,json=tenantId,proto3,oneof" json:"tenant_id,omitempty"` // 租户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -860,6 +877,13 @@ func (x *UserExistsRequest) GetUsername() string {
 	return ""
 }
 
+func (x *UserExistsRequest) GetTenantId() uint32 {
+	if x != nil && x.TenantId != nil {
+		return *x.TenantId
+	}
+	return 0
+}
+
 // 用户是否存在 - 答复
 type UserExistsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1656,7 +1680,7 @@ var File_user_service_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_service_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x1auser/service/v1/user.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\"\xdf\x14\n" +
+	"\x1auser/service/v1/user.proto\x12\x0fuser.service.v1\x1a$gnostic/openapi/v3/annotations.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1fgoogle/api/field_behavior.proto\x1a\x16redact/v3/redact.proto\x1a\x1epagination/v1/pagination.proto\"\xd1\x15\n" +
 	"\x04User\x12#\n" +
 	"\x02id\x18\x01 \x01(\rB\x0e\xbaG\v\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01\x120\n" +
 	"\ttenant_id\x18\x02 \x01(\rB\x0e\xbaG\v\x92\x02\b租户IDH\x01R\btenantId\x88\x01\x01\x128\n" +
@@ -1686,25 +1710,26 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\ttelephone\x18\x1a \x01(\tB\x0f\xbaG\f\x92\x02\t座机号H\x10R\ttelephone\x88\x01\x01\x12G\n" +
 	"\x06gender\x18\x1b \x01(\x0e2\x1c.user.service.v1.User.GenderB\f\xbaG\t\x92\x02\x06性别H\x11R\x06gender\x88\x01\x01\x12+\n" +
 	"\aaddress\x18\x1c \x01(\tB\f\xbaG\t\x92\x02\x06住址H\x12R\aaddress\x88\x01\x01\x12/\n" +
-	"\x06region\x18\x1d \x01(\tB\x12\xbaG\x0f\x92\x02\f国家地区H\x13R\x06region\x88\x01\x01\x129\n" +
-	"\vdescription\x18\x1e \x01(\tB\x12\xbaG\x0f\x92\x02\f个人描述H\x14R\vdescription\x88\x01\x01\x12)\n" +
-	"\x06remark\x18\x1f \x01(\tB\f\xbaG\t\x92\x02\x06备注H\x15R\x06remark\x88\x01\x01\x12a\n" +
-	"\x0flast_login_time\x18  \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x16R\rlastLoginTime\x88\x01\x01\x12=\n" +
-	"\rlast_login_ip\x18! \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x17R\vlastLoginIp\x88\x01\x01\x12M\n" +
-	"\x06status\x18\" \x01(\x0e2\x1c.user.service.v1.User.StatusB\x12\xbaG\x0f\x92\x02\f用户状态H\x18R\x06status\x88\x01\x01\x12b\n" +
-	"\tauthority\x18# \x01(\x0e2\x1f.user.service.v1.User.AuthorityB\x1e\xbaG\x1b\x8a\x02\x0f\x1a\rCUSTOMER_USER\x92\x02\x06权限H\x19R\tauthority\x88\x01\x01\x125\n" +
+	"\x06region\x18\x1d \x01(\tB\x12\xbaG\x0f\x92\x02\f国家地区H\x13R\x06region\x88\x01\x01\x12c\n" +
+	"\blanguage\x18$ \x01(\tBB\xbaG?\x92\x02<首选语言代码，用于选择消息模板的语言版本H\x14R\blanguage\x88\x01\x01\x129\n" +
+	"\vdescription\x18\x1e \x01(\tB\x12\xbaG\x0f\x92\x02\f个人描述H\x15R\vdescription\x88\x01\x01\x12)\n" +
+	"\x06remark\x18\x1f \x01(\tB\f\xbaG\t\x92\x02\x06备注H\x16R\x06remark\x88\x01\x01\x12a\n" +
+	"\x0flast_login_time\x18  \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间H\x17R\rlastLoginTime\x88\x01\x01\x12=\n" +
+	"\rlast_login_ip\x18! \x01(\tB\x14\xbaG\x11\x92\x02\x0e最后登录IPH\x18R\vlastLoginIp\x88\x01\x01\x12M\n" +
+	"\x06status\x18\" \x01(\x0e2\x1c.user.service.v1.User.StatusB\x12\xbaG\x0f\x92\x02\f用户状态H\x19R\x06status\x88\x01\x01\x12b\n" +
+	"\tauthority\x18# \x01(\x0e2\x1f.user.service.v1.User.AuthorityB\x1e\xbaG\x1b\x8a\x02\x0f\x1a\rCUSTOMER_USER\x92\x02\x06权限H\x1aR\tauthority\x88\x01\x01\x125\n" +
 	"\n" +
-	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x1aR\tcreatedBy\x88\x01\x01\x125\n" +
+	"created_by\x18d \x01(\rB\x11\xbaG\x0e\x92\x02\v创建者IDH\x1bR\tcreatedBy\x88\x01\x01\x125\n" +
 	"\n" +
-	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x1bR\tupdatedBy\x88\x01\x01\x12;\n" +
+	"updated_by\x18e \x01(\rB\x11\xbaG\x0e\x92\x02\v更新者IDH\x1cR\tupdatedBy\x88\x01\x01\x12;\n" +
 	"\n" +
-	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x1cR\tdeletedBy\x88\x01\x01\x12S\n" +
+	"deleted_by\x18f \x01(\rB\x17\xbaG\x14\x92\x02\x11删除者用户IDH\x1dR\tdeletedBy\x88\x01\x01\x12S\n" +
 	"\n" +
-	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1dR\tcreatedAt\x88\x01\x01\x12S\n" +
+	"created_at\x18\xc8\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间H\x1eR\tcreatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x1eR\tupdatedAt\x88\x01\x01\x12S\n" +
+	"updated_at\x18\xc9\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间H\x1fR\tupdatedAt\x88\x01\x01\x12S\n" +
 	"\n" +
-	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H\x1fR\tdeletedAt\x88\x01\x01\"\x19\n" +
+	"deleted_at\x18\xca\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f删除时间H R\tdeletedAt\x88\x01\x01\"\x19\n" +
 	"\x06Status\x12\a\n" +
 	"\x03OFF\x10\x00\x12\x06\n" +
 	"\x02ON\x10\x01\"J\n" +
@@ -1742,7 +1767,8 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\a_genderB\n" +
 	"\n" +
 	"\b_addressB\t\n" +
-	"\a_regionB\x0e\n" +
+	"\a_regionB\v\n" +
+	"\t_languageB\x0e\n" +
 	"\f_descriptionB\t\n" +
 	"\a_remarkB\x12\n" +
 	"\x10_last_login_timeB\x10\n" +
@@ -1758,14 +1784,17 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\v_deleted_at\"U\n" +
 	"\x10ListUserResponse\x12+\n" +
 	"\x05items\x18\x01 \x03(\v2\x15.user.service.v1.UserR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x04R\x05total\"\xff\x01\n" +
+	"\x05total\x18\x02 \x01(\x04R\x05total\"\x8b\x03\n" +
 	"\x0eGetUserRequest\x12\"\n" +
 	"\x02id\x18\x01 \x01(\rB\x10\xbaG\r\x18\x01\x92\x02\b用户IDH\x00R\x02id\x126\n" +
-	"\tuser_name\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\buserName\x12w\n" +
-	"\tview_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskB9\xbaG6\x92\x023视图字段过滤器，用于控制返回的字段H\x01R\bviewMask\x88\x01\x01B\n" +
+	"\tuser_name\x18\x02 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名H\x00R\buserName\x12|\n" +
+	"\ttenant_id\x18\x03 \x01(\rBZ\xbaGW\x92\x02T租户ID，按用户登录名查询时限定所属租户，为0时表示平台用户H\x01R\btenantId\x88\x01\x01\x12w\n" +
+	"\tview_mask\x18d \x01(\v2\x1a.google.protobuf.FieldMaskB9\xbaG6\x92\x023视图字段过滤器，用于控制返回的字段H\x02R\bviewMask\x88\x01\x01B\n" +
 	"\n" +
 	"\bquery_byB\f\n" +
 	"\n" +
+	"_tenant_idB\f\n" +
+	"\n" +
 	"_view_mask\"\x88\x01\n" +
 	"\x11CreateUserRequest\x12)\n" +
 	"\x04data\x18\x01 \x01(\v2\x15.user.service.v1.UserR\x04data\x12;\n" +
@@ -1781,9 +1810,12 @@ const file_user_service_v1_user_proto_rawDesc = "" +
 	"\t_passwordB\x10\n" +
 	"\x0e_allow_missing\"#\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\"H\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"\xda\x01\n" +
 	"\x11UserExistsRequest\x123\n" +
-	"\busername\x18\x01 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名R\busername\"*\n" +
+	"\busername\x18\x01 \x01(\tB\x17\xbaG\x14\x18\x01\x92\x02\x0f用户登录名R\busername\x12\x81\x01\n" +
+	"\ttenant_id\x18\x02 \x01(\rB_\xbaG\\\x92\x02Y租户ID，用户登录名在租户内唯一，为空时使用当前用户所在的租户H\x00R\btenantId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tenant_id\"*\n" +
 	"\x12UserExistsResponse\x12\x14\n" +
 	"\x05exist\x18\x01 \x01(\bR\x05exist\"D\n" +
 	"\x17BatchCreateUsersRequest\x12)\n" +
@@ -1934,6 +1966,7 @@ func file_user_service_v1_user_proto_init() {
 	}
 	file_user_service_v1_user_proto_msgTypes[3].OneofWrappers = []any{}
 	file_user_service_v1_user_proto_msgTypes[4].OneofWrappers = []any{}
+	file_user_service_v1_user_proto_msgTypes[6].OneofWrappers = []any{}
 	file_user_service_v1_user_proto_msgTypes[13].OneofWrappers = []any{
 		(*UploadAvatarRequest_ImageBase64)(nil),
 		(*UploadAvatarRequest_ImageUrl)(nil),
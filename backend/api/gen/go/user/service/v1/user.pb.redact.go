@@ -180,6 +180,8 @@ func (x *User) Redact() string {
 
 	// Safe field: Region
 
+	// Safe field: Language
+
 	// Safe field: Description
 
 	// Safe field: Remark
@@ -228,6 +230,8 @@ func (x *GetUserRequest) Redact() string {
 
 	// Safe field: UserName
 
+	// Safe field: TenantId
+
 	// Safe field: ViewMask
 	return x.String()
 }
@@ -279,6 +283,8 @@ func (x *UserExistsRequest) Redact() string {
 	}
 
 	// Safe field: Username
+
+	// Safe field: TenantId
 	return x.String()
 }
 
@@ -136,6 +136,10 @@ func (m *User) validate(all bool) error {
 		// no validation rules for Region
 	}
 
+	if m.Language != nil {
+		// no validation rules for Language
+	}
+
 	if m.Description != nil {
 		// no validation rules for Description
 	}
@@ -564,6 +568,10 @@ func (m *GetUserRequest) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if m.ViewMask != nil {
 
 		if all {
@@ -1108,6 +1116,10 @@ func (m *UserExistsRequest) validate(all bool) error {
 
 	// no validation rules for Username
 
+	if m.TenantId != nil {
+		// no validation rules for TenantId
+	}
+
 	if len(errors) > 0 {
 		return UserExistsRequestMultiError(errors)
 	}
@@ -36,7 +36,9 @@ const (
 	// 402
 	UserErrorReason_PAYMENT_REQUIRED UserErrorReason = 200 // 需要支付
 	// 403
-	UserErrorReason_FORBIDDEN UserErrorReason = 300 // 禁止访问
+	UserErrorReason_FORBIDDEN               UserErrorReason = 300 // 禁止访问
+	UserErrorReason_TENANT_QUOTA_EXCEEDED   UserErrorReason = 301 // 租户配额已用尽
+	UserErrorReason_TENANT_FEATURE_DISABLED UserErrorReason = 302 // 租户订阅套餐未开通该功能
 	// 404
 	UserErrorReason_NOT_FOUND              UserErrorReason = 400 // 找不到资源
 	UserErrorReason_USER_NOT_FOUND         UserErrorReason = 401 // 用户不存在
@@ -132,6 +134,8 @@ var (
 		102:  "INCORRECT_PASSWORD",
 		200:  "PAYMENT_REQUIRED",
 		300:  "FORBIDDEN",
+		301:  "TENANT_QUOTA_EXCEEDED",
+		302:  "TENANT_FEATURE_DISABLED",
 		400:  "NOT_FOUND",
 		401:  "USER_NOT_FOUND",
 		402:  "ROLE_NOT_FOUND",
@@ -186,6 +190,8 @@ var (
 		"INCORRECT_PASSWORD":              102,
 		"PAYMENT_REQUIRED":                200,
 		"FORBIDDEN":                       300,
+		"TENANT_QUOTA_EXCEEDED":           301,
+		"TENANT_FEATURE_DISABLED":         302,
 		"NOT_FOUND":                       400,
 		"USER_NOT_FOUND":                  401,
 		"ROLE_NOT_FOUND":                  402,
@@ -264,7 +270,7 @@ var File_user_service_v1_user_error_proto protoreflect.FileDescriptor
 
 const file_user_service_v1_user_error_proto_rawDesc = "" +
 	"\n" +
-	" user/service/v1/user_error.proto\x12\x0fuser.service.v1\x1a\x13errors/errors.proto*\xae\f\n" +
+	" user/service/v1/user_error.proto\x12\x0fuser.service.v1\x1a\x13errors/errors.proto*\xf4\f\n" +
 	"\x0fUserErrorReason\x12\x15\n" +
 	"\vBAD_REQUEST\x10\x00\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eINVALID_USERID\x10\x01\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
@@ -273,7 +279,9 @@ const file_user_service_v1_user_error_proto_rawDesc = "" +
 	"\vUSER_FREEZE\x10e\x1a\x04\xa8E\x91\x03\x12\x1c\n" +
 	"\x12INCORRECT_PASSWORD\x10f\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x10PAYMENT_REQUIRED\x10\xc8\x01\x1a\x04\xa8E\x92\x03\x12\x14\n" +
-	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
+	"\tFORBIDDEN\x10\xac\x02\x1a\x04\xa8E\x93\x03\x12 \n" +
+	"\x15TENANT_QUOTA_EXCEEDED\x10\xad\x02\x1a\x04\xa8E\x93\x03\x12\"\n" +
+	"\x17TENANT_FEATURE_DISABLED\x10\xae\x02\x1a\x04\xa8E\x93\x03\x12\x14\n" +
 	"\tNOT_FOUND\x10\x90\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eUSER_NOT_FOUND\x10\x91\x03\x1a\x04\xa8E\x94\x03\x12\x19\n" +
 	"\x0eROLE_NOT_FOUND\x10\x92\x03\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
@@ -123,6 +123,34 @@ func ErrorForbidden(format string, args ...interface{}) *errors.Error {
 	return errors.New(403, UserErrorReason_FORBIDDEN.String(), fmt.Sprintf(format, args...))
 }
 
+// 租户配额已用尽
+func IsTenantQuotaExceeded(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TENANT_QUOTA_EXCEEDED.String() && e.Code == 403
+}
+
+// 租户配额已用尽
+func ErrorTenantQuotaExceeded(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, UserErrorReason_TENANT_QUOTA_EXCEEDED.String(), fmt.Sprintf(format, args...))
+}
+
+// 租户订阅套餐未开通该功能
+func IsTenantFeatureDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == UserErrorReason_TENANT_FEATURE_DISABLED.String() && e.Code == 403
+}
+
+// 租户订阅套餐未开通该功能
+func ErrorTenantFeatureDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, UserErrorReason_TENANT_FEATURE_DISABLED.String(), fmt.Sprintf(format, args...))
+}
+
 // 404
 func IsNotFound(err error) bool {
 	if err == nil {
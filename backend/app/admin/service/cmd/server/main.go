@@ -8,11 +8,13 @@ import (
 
 	"github.com/tx7do/kratos-bootstrap/bootstrap"
 	"github.com/tx7do/kratos-transport/transport/asynq"
-	"github.com/tx7do/kratos-transport/transport/sse"
 
 	"github.com/tx7do/go-utils/trans"
 
+	"go-wind-admin/pkg/cluster"
 	"go-wind-admin/pkg/service"
+	"go-wind-admin/pkg/sse"
+	"go-wind-admin/pkg/task"
 )
 
 var version string
@@ -25,6 +27,8 @@ func newApp(
 	hs *http.Server,
 	as *asynq.Server,
 	ss *sse.Server,
+	ts *task.Scheduler,
+	es *cluster.Elector,
 ) *kratos.App {
 	return bootstrap.NewApp(
 		lg,
@@ -32,6 +36,8 @@ func newApp(
 		hs,
 		as,
 		ss,
+		ts,
+		es,
 	)
 }
 
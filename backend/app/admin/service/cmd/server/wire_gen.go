@@ -29,7 +29,9 @@ func initApp(logger log.Logger, registrar registry.Registrar, bootstrap *v1.Boot
 	}
 	roleRepo := data.NewRoleRepo(dataData, logger)
 	apiResourceRepo := data.NewApiResourceRepo(dataData, logger)
-	authorizer := data.NewAuthorizer(logger, bootstrap, roleRepo, apiResourceRepo)
+	elector := data.NewLeaderElector(logger, client)
+	broadcaster := data.NewClusterBroadcaster(logger, client, elector)
+	authorizer := data.NewAuthorizer(logger, bootstrap, roleRepo, apiResourceRepo, broadcaster)
 	adminOperationLogRepo := data.NewAdminOperationLogRepo(dataData, logger)
 	adminLoginLogRepo := data.NewAdminLoginLogRepo(dataData, logger)
 	userRepo := data.NewUserRepo(logger, dataData)
@@ -37,16 +39,15 @@ func initApp(logger log.Logger, registrar registry.Registrar, bootstrap *v1.Boot
 	userCredentialRepo := data.NewUserCredentialRepo(logger, dataData, crypto)
 	tenantRepo := data.NewTenantRepo(dataData, logger)
 	userTokenCacheRepo := data.NewUserTokenRepo(logger, client, authenticator, bootstrap)
-	authenticationService := service.NewAuthenticationService(logger, userRepo, userCredentialRepo, tenantRepo, roleRepo, userTokenCacheRepo, authenticator)
+	sseConfig := data.NewSseConfig(logger)
+	sseServer := server.NewSseServer(bootstrap, sseConfig, logger, client, authenticator, userTokenCacheRepo)
 	positionRepo := data.NewPositionRepo(dataData, logger)
 	departmentRepo := data.NewDepartmentRepo(dataData, logger)
 	organizationRepo := data.NewOrganizationRepo(dataData, logger)
 	userRoleRepo := data.NewUserRoleRepo(dataData, logger)
 	userPositionRepo := data.NewUserPositionRepo(dataData, logger)
-	userService := service.NewUserService(logger, userRepo, roleRepo, userCredentialRepo, positionRepo, departmentRepo, organizationRepo, tenantRepo, userRoleRepo, userPositionRepo)
 	menuRepo := data.NewMenuRepo(dataData, logger)
 	menuService := service.NewMenuService(logger, menuRepo)
-	routerService := service.NewRouterService(logger, menuRepo, roleRepo, userRepo)
 	organizationService := service.NewOrganizationService(logger, organizationRepo, userRepo)
 	roleApiRepo := data.NewRoleApiRepo(dataData, logger)
 	roleMenuRepo := data.NewRoleMenuRepo(dataData, logger)
@@ -62,28 +63,63 @@ func initApp(logger log.Logger, registrar registry.Registrar, bootstrap *v1.Boot
 	adminLoginLogService := service.NewAdminLoginLogService(logger, adminLoginLogRepo)
 	adminOperationLogService := service.NewAdminOperationLogService(logger, adminOperationLogRepo, apiResourceRepo)
 	minIOClient := data.NewMinIoClient(bootstrap, logger)
-	ossService := service.NewOssService(logger, minIOClient)
-	uEditorService := service.NewUEditorService(logger, minIOClient)
-	fileRepo := data.NewFileRepo(dataData, logger)
-	fileService := service.NewFileService(logger, fileRepo)
-	tenantService := service.NewTenantService(logger, tenantRepo, userRepo, userCredentialRepo)
 	taskRepo := data.NewTaskRepo(dataData, logger)
-	taskService := service.NewTaskService(logger, taskRepo, userRepo)
+	taskRunRepo := data.NewTaskRunRepo(dataData, logger)
+	databaseBackupRepo := data.NewDatabaseBackupRepo(bootstrap, logger)
 	internalMessageRepo := data.NewInternalMessageRepo(dataData, logger)
 	internalMessageCategoryRepo := data.NewInternalMessageCategoryRepo(dataData, logger)
 	internalMessageRecipientRepo := data.NewInternalMessageRecipientRepo(dataData, logger)
-	sseServer := server.NewSseServer(bootstrap, logger)
-	internalMessageService := service.NewInternalMessageService(logger, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, sseServer, userTokenCacheRepo)
+	audienceRepo := data.NewAudienceRepo(logger, userRepo, userRoleRepo, userPositionRepo, departmentRepo, organizationRepo)
+	internalMessageDeliveryRepo := data.NewInternalMessageDeliveryRepo(dataData, logger)
+	userNotificationPreferenceRepo := data.NewUserNotificationPreferenceRepo(dataData, logger)
+	messageTemplateRepo := data.NewMessageTemplateRepo(dataData, logger)
+	conversationRepo := data.NewConversationRepo(dataData, logger)
+	conversationService := service.NewConversationService(logger, conversationRepo, internalMessageRepo, userRepo, sseServer)
+	registry2 := data.NewNotifyRegistry(logger)
+	inboxCounterRepo := data.NewInboxCounterRepo(logger, client)
+	internalMessageRecipientService := service.NewInternalMessageRecipientService(logger, internalMessageRepo, internalMessageRecipientRepo, internalMessageCategoryRepo, inboxCounterRepo, sseServer)
+	notificationDigestRepo := data.NewNotificationDigestRepo(logger, client)
+	internalMessageService := service.NewInternalMessageService(logger, internalMessageRepo, internalMessageCategoryRepo, internalMessageRecipientRepo, userRepo, audienceRepo, internalMessageDeliveryRepo, userNotificationPreferenceRepo, notificationDigestRepo, messageTemplateRepo, conversationService, internalMessageRecipientService, registry2, sseServer)
+	eventBus, cleanup2 := data.NewEventBus(logger)
+	tenantCacheRepo := data.NewTenantCacheRepo(logger, client)
+	tenantProvisionRepo := data.NewTenantProvisionRepo(dataData, logger, tenantRepo, userRepo, userCredentialRepo)
+	tenantTemplates := data.NewTenantTemplates(logger)
+	tenantPlanRepo := data.NewTenantPlanRepo(dataData, logger)
+	fileRepo := data.NewFileRepo(dataData, logger)
+	tenantService := service.NewTenantService(logger, tenantRepo, tenantCacheRepo, tenantProvisionRepo, tenantTemplates, tenantPlanRepo, userRepo, fileRepo, userCredentialRepo, userTokenCacheRepo, authorizer, internalMessageService, eventBus, sseServer)
+	userService := service.NewUserService(logger, userRepo, roleRepo, userCredentialRepo, positionRepo, departmentRepo, organizationRepo, tenantRepo, tenantService, userRoleRepo, userPositionRepo)
+	routerService := service.NewRouterService(logger, menuRepo, roleRepo, userRepo, tenantService)
+	ossService := service.NewOssService(logger, minIOClient, fileRepo, tenantService)
+	uEditorService := service.NewUEditorService(logger, minIOClient, ossService)
+	fileService := service.NewFileService(logger, fileRepo, tenantService)
+	tenantPlanService := service.NewTenantPlanService(logger, tenantPlanRepo, tenantRepo, tenantService)
+	registry3 := data.NewSettingRegistry()
+	settingRepo := data.NewSettingRepo(dataData, logger)
+	settingCacheRepo := data.NewSettingCacheRepo(logger, client)
+	settingService := service.NewSettingService(logger, registry3, settingRepo, settingCacheRepo, tenantRepo)
+	authenticationService := service.NewAuthenticationService(logger, userRepo, userCredentialRepo, tenantRepo, roleRepo, adminOperationLogRepo, tenantService, settingService, userTokenCacheRepo, authenticator, sseServer)
+	tenantTransferRepo := data.NewTenantTransferRepo(dataData, logger)
+	config := data.NewTenantUsageConfig(logger)
+	tenantUsageRepo := data.NewTenantUsageRepo(dataData, logger)
+	tenantUsageCacheRepo := data.NewTenantUsageCacheRepo(logger, client)
+	tenantUsageService := service.NewTenantUsageService(logger, config, tenantUsageRepo, tenantUsageCacheRepo, tenantRepo, fileRepo, apiResourceRepo, internalMessageRecipientRepo, tenantService, eventBus)
+	taskService := service.NewTaskService(logger, taskRepo, taskRunRepo, userRepo, fileRepo, databaseBackupRepo, minIOClient, internalMessageRepo, tenantService, tenantTransferRepo, tenantUsageService, authorizer, sseServer, elector, broadcaster)
 	internalMessageCategoryService := service.NewInternalMessageCategoryService(logger, internalMessageCategoryRepo)
-	internalMessageRecipientService := service.NewInternalMessageRecipientService(logger, internalMessageRepo, internalMessageRecipientRepo)
+	messageTemplateService := service.NewMessageTemplateService(logger, messageTemplateRepo, userRepo)
 	adminLoginRestrictionRepo := data.NewAdminLoginRestrictionRepo(dataData, logger)
 	adminLoginRestrictionService := service.NewAdminLoginRestrictionService(logger, adminLoginRestrictionRepo)
-	userProfileService := service.NewUserProfileService(logger, userRepo, userTokenCacheRepo, roleRepo, userCredentialRepo)
+	userProfileService := service.NewUserProfileService(logger, userRepo, userTokenCacheRepo, roleRepo, userCredentialRepo, userNotificationPreferenceRepo, internalMessageCategoryRepo, registry2, settingService)
 	apiResourceService := service.NewApiResourceService(logger, apiResourceRepo, authorizer)
-	httpServer := server.NewRESTServer(bootstrap, logger, authenticator, authorizer, adminOperationLogRepo, adminLoginLogRepo, authenticationService, userService, menuService, routerService, organizationService, roleService, positionService, dictService, departmentService, adminLoginLogService, adminOperationLogService, ossService, uEditorService, fileService, tenantService, taskService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService, adminLoginRestrictionService, userProfileService, apiResourceService)
-	asynqServer := server.NewAsynqServer(bootstrap, logger, taskService)
-	app := newApp(logger, registrar, httpServer, asynqServer, sseServer)
+	clusterService := service.NewClusterService(logger, elector)
+	policy := data.NewRateLimitPolicy(logger)
+	limiter := data.NewRateLimiter(client, policy)
+	rateLimitService := service.NewRateLimitService(logger, limiter)
+	httpServer := server.NewRESTServer(bootstrap, logger, authenticator, authorizer, adminOperationLogRepo, adminLoginLogRepo, authenticationService, userService, menuService, routerService, organizationService, roleService, positionService, dictService, departmentService, adminLoginLogService, adminOperationLogService, ossService, uEditorService, fileService, tenantService, tenantPlanService, settingService, taskService, internalMessageService, internalMessageCategoryService, internalMessageRecipientService, messageTemplateService, conversationService, adminLoginRestrictionService, userProfileService, apiResourceService, clusterService, rateLimitService, tenantUsageService, limiter, elector)
+	scheduler := server.NewTaskScheduler(bootstrap, logger, taskService)
+	asynqServer := server.NewAsynqServer(bootstrap, logger, taskService, scheduler, internalMessageService)
+	app := newApp(logger, registrar, httpServer, asynqServer, sseServer, scheduler, elector)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
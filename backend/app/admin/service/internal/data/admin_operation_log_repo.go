@@ -152,6 +152,31 @@ func (r *AdminOperationLogRepo) Create(ctx context.Context, req *adminV1.CreateA
 		return adminV1.ErrorBadRequest("invalid parameter")
 	}
 
+	err := r.newCreateBuilder(req).Exec(ctx)
+	if err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return err
+}
+
+// CreateAudit 写入审计日志并返回日志ID，如代管令牌以该ID关联代管原因
+func (r *AdminOperationLogRepo) CreateAudit(ctx context.Context, req *adminV1.CreateAdminOperationLogRequest) (uint32, error) {
+	if req == nil || req.Data == nil {
+		return 0, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	entity, err := r.newCreateBuilder(req).Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return 0, adminV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return entity.ID, nil
+}
+
+func (r *AdminOperationLogRepo) newCreateBuilder(req *adminV1.CreateAdminOperationLogRequest) *ent.AdminOperationLogCreate {
 	builder := r.data.db.Client().AdminOperationLog.
 		Create().
 		SetNillableRequestID(req.Data.RequestId).
@@ -167,6 +192,10 @@ func (r *AdminOperationLogRepo) Create(ctx context.Context, req *adminV1.CreateA
 		SetNillableUserID(req.Data.UserId).
 		SetNillableUsername(req.Data.Username).
 		SetNillableClientIP(req.Data.ClientIp).
+		SetNillableImpersonatorID(req.Data.ImpersonatorId).
+		SetNillableImpersonatorName(req.Data.ImpersonatorName).
+		SetNillableImpersonationReason(req.Data.ImpersonationReason).
+		SetNillableImpersonationAuditID(req.Data.ImpersonationAuditId).
 		SetNillableUserAgent(req.Data.UserAgent).
 		SetNillableBrowserName(req.Data.BrowserName).
 		SetNillableBrowserVersion(req.Data.BrowserVersion).
@@ -184,11 +213,5 @@ func (r *AdminOperationLogRepo) Create(ctx context.Context, req *adminV1.CreateA
 		builder.SetCreatedAt(time.Now())
 	}
 
-	err := builder.Exec(ctx)
-	if err != nil {
-		r.log.Errorf("insert one data failed: %s", err.Error())
-		return adminV1.ErrorInternalServerError("insert data failed")
-	}
-
-	return err
+	return builder
 }
@@ -256,6 +256,28 @@ func (r *ApiResourceRepo) Delete(ctx context.Context, req *adminV1.DeleteApiReso
 	return nil
 }
 
+// ListOperationModules 查询所有接口所属的业务模块，键为接口的 operationId
+func (r *ApiResourceRepo) ListOperationModules(ctx context.Context) (map[string]string, error) {
+	entities, err := r.data.db.Client().ApiResource.Query().
+		Where(
+			apiresource.OperationNotNil(),
+			apiresource.ModuleNotNil(),
+		).
+		Select(apiresource.FieldOperation, apiresource.FieldModule).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query api resource modules failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query api resource modules failed")
+	}
+
+	modules := make(map[string]string, len(entities))
+	for _, entity := range entities {
+		modules[*entity.Operation] = *entity.Module
+	}
+
+	return modules, nil
+}
+
 // Truncate 清空表数据
 func (r *ApiResourceRepo) Truncate(ctx context.Context) error {
 	if _, err := r.data.db.Client().ApiResource.Delete().Exec(ctx); err != nil {
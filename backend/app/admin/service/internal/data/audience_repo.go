@@ -0,0 +1,90 @@
+package data
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	"go-wind-admin/pkg/audience"
+)
+
+// AudienceRepo 受众解析的数据来源，由用户、角色、部门、组织、岗位等数据组合而成
+type AudienceRepo struct {
+	log *log.Helper
+
+	userRepo         *UserRepo
+	userRoleRepo     *UserRoleRepo
+	userPositionRepo *UserPositionRepo
+	departmentRepo   *DepartmentRepo
+	organizationRepo *OrganizationRepo
+}
+
+var _ audience.Source = (*AudienceRepo)(nil)
+
+func NewAudienceRepo(
+	logger log.Logger,
+	userRepo *UserRepo,
+	userRoleRepo *UserRoleRepo,
+	userPositionRepo *UserPositionRepo,
+	departmentRepo *DepartmentRepo,
+	organizationRepo *OrganizationRepo,
+) *AudienceRepo {
+	return &AudienceRepo{
+		log:              log.NewHelper(log.With(logger, "module", "audience/repo/admin-service")),
+		userRepo:         userRepo,
+		userRoleRepo:     userRoleRepo,
+		userPositionRepo: userPositionRepo,
+		departmentRepo:   departmentRepo,
+		organizationRepo: organizationRepo,
+	}
+}
+
+// Resolve 解析受众表达式，返回用户ID列表
+func (r *AudienceRepo) Resolve(ctx context.Context, spec *audience.Spec) ([]uint32, error) {
+	return audience.Resolve(ctx, r, spec)
+}
+
+func (r *AudienceRepo) AllUserIds(ctx context.Context) ([]uint32, error) {
+	return r.userRepo.ListIdsWhere(ctx)
+}
+
+func (r *AudienceRepo) UserIdsByRoles(ctx context.Context, roleIds []uint32) ([]uint32, error) {
+	return r.userRoleRepo.ListUserIdsByRoleIds(ctx, roleIds)
+}
+
+func (r *AudienceRepo) UserIdsByDepartments(ctx context.Context, departmentIds []uint32) ([]uint32, error) {
+	return r.userRepo.ListIdsWhere(ctx, user.DepartmentIDIn(departmentIds...))
+}
+
+func (r *AudienceRepo) UserIdsByOrganizations(ctx context.Context, organizationIds []uint32) ([]uint32, error) {
+	return r.userRepo.ListIdsWhere(ctx, user.OrgIDIn(organizationIds...))
+}
+
+// UserIdsByPositions 包括主岗位与兼任岗位
+func (r *AudienceRepo) UserIdsByPositions(ctx context.Context, positionIds []uint32) ([]uint32, error) {
+	ids, err := r.userRepo.ListIdsWhere(ctx, user.PositionIDIn(positionIds...))
+	if err != nil {
+		return nil, err
+	}
+
+	var extra []uint32
+	if extra, err = r.userPositionRepo.ListUserIdsByPositionIds(ctx, positionIds); err != nil {
+		return nil, err
+	}
+
+	return append(ids, extra...), nil
+}
+
+func (r *AudienceRepo) UserIdsByTenants(ctx context.Context, tenantIds []uint32) ([]uint32, error) {
+	return r.userRepo.ListIdsWhere(ctx, user.TenantIDIn(tenantIds...))
+}
+
+func (r *AudienceRepo) DepartmentParents(ctx context.Context) (map[uint32]uint32, error) {
+	return r.departmentRepo.ListParentIds(ctx)
+}
+
+func (r *AudienceRepo) OrganizationParents(ctx context.Context) (map[uint32]uint32, error) {
+	return r.organizationRepo.ListParentIds(ctx)
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/go-kratos/kratos/v2/log"
 
@@ -17,17 +18,30 @@ import (
 
 	"go-wind-admin/app/admin/service/cmd/server/assets"
 
+	"go-wind-admin/pkg/cluster"
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 )
 
+// policyReloadChannel 策略变更通知频道
+const policyReloadChannel = "authz:reload"
+
 type Authorizer struct {
 	log *log.Helper
 
 	roleRepo        *RoleRepo
 	apiResourceRepo *ApiResourceRepo
 
+	broadcaster *cluster.Broadcaster
+
 	engine authzEngine.Engine
+
+	// platformRoles 平台（租户ID为0）的角色编码，随策略一起重新加载
+	platformRoles map[string]struct{}
+	mu            sync.RWMutex
 }
 
 func NewAuthorizer(
@@ -35,11 +49,13 @@ func NewAuthorizer(
 	cfg *conf.Bootstrap,
 	roleRepo *RoleRepo,
 	apiResourceRepo *ApiResourceRepo,
+	broadcaster *cluster.Broadcaster,
 ) *Authorizer {
 	a := &Authorizer{
 		log:             log.NewHelper(log.With(logger, "module", "authorizer/repo/admin-service")),
 		roleRepo:        roleRepo,
 		apiResourceRepo: apiResourceRepo,
+		broadcaster:     broadcaster,
 	}
 
 	a.init(cfg)
@@ -53,6 +69,15 @@ func (a *Authorizer) init(cfg *conf.Bootstrap) {
 	if err := a.ResetPolicies(context.Background()); err != nil {
 		a.log.Errorf("reset policies error: %v", err)
 	}
+
+	// 策略保存在进程内，其他副本修改了角色或接口后需要通知本副本重新加载
+	if a.broadcaster != nil {
+		a.broadcaster.Subscribe(policyReloadChannel, func(ctx context.Context, _ []byte) {
+			if err := a.ResetPolicies(ctx); err != nil {
+				a.log.Errorf("reload policies on broadcast error: %v", err)
+			}
+		})
+	}
 }
 
 func (a *Authorizer) newEngine(cfg *conf.Bootstrap) authzEngine.Engine {
@@ -113,16 +138,35 @@ func (a *Authorizer) Engine() authzEngine.Engine {
 	return a.engine
 }
 
-// ResetPolicies 重置策略
+// ReloadPolicies 重新加载本副本的策略，并通知其他副本重新加载
+func (a *Authorizer) ReloadPolicies(ctx context.Context) error {
+	if err := a.ResetPolicies(ctx); err != nil {
+		return err
+	}
+
+	if a.broadcaster != nil {
+		if err := a.broadcaster.Publish(ctx, policyReloadChannel, struct{}{}); err != nil {
+			a.log.Errorf("broadcast policy reload error: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ResetPolicies 重置本副本的策略，策略包含所有租户的角色，总是在系统上下文中查询
 func (a *Authorizer) ResetPolicies(ctx context.Context) error {
 	//a.log.Info("*******************reset policies")
 
+	ctx = viewer.NewSystemViewerContext(ctx)
+
 	roles, err := a.roleRepo.List(ctx, &pagination.PagingRequest{NoPaging: trans.Ptr(true)})
 	if err != nil {
 		a.log.Errorf("failed to list roles: %v", err)
 		return err
 	}
 
+	a.setPlatformRoles(roles)
+
 	if roles == nil || len(roles.Items) < 1 {
 		a.log.Warnf("no roles found to set policies")
 		return nil // No roles to set policies
@@ -175,6 +219,28 @@ func (a *Authorizer) ResetPolicies(ctx context.Context) error {
 	return nil
 }
 
+// IsPlatformRole 角色编码是否属于平台的角色
+func (a *Authorizer) IsPlatformRole(code string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	_, ok := a.platformRoles[code]
+	return ok
+}
+
+func (a *Authorizer) setPlatformRoles(roles *userV1.ListRoleResponse) {
+	codes := make(map[string]struct{})
+	for _, role := range roles.GetItems() {
+		if role.GetTenantId() == 0 && role.GetCode() != "" {
+			codes[role.GetCode()] = struct{}{}
+		}
+	}
+
+	a.mu.Lock()
+	a.platformRoles = codes
+	a.mu.Unlock()
+}
+
 // generateCasbinPolicies 生成 Casbin 策略
 func (a *Authorizer) generateCasbinPolicies(roles *userV1.ListRoleResponse, apis *adminV1.ListApiResourceResponse) (authzEngine.PolicyMap, error) {
 	var rules []casbin.PolicyRule
@@ -199,7 +265,7 @@ func (a *Authorizer) generateCasbinPolicies(roles *userV1.ListRoleResponse, apis
 			if _, exists := apiSet[api.GetId()]; exists {
 				rules = append(rules, casbin.PolicyRule{
 					PType: "p",
-					V0:    role.GetCode(),
+					V0:    auth.RoleSubject(role.GetTenantId(), role.GetCode()),
 					V1:    api.GetPath(),
 					V2:    api.GetMethod(),
 					V3:    domain,
@@ -254,7 +320,7 @@ func (a *Authorizer) generateOpaPolicies(roles *userV1.ListRoleResponse, apis *a
 			}
 		}
 
-		policies[role.GetCode()] = paths
+		policies[auth.RoleSubject(role.GetTenantId(), role.GetCode())] = paths
 	}
 
 	return policies, nil
@@ -0,0 +1,29 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	"go-wind-admin/pkg/cluster"
+	"go-wind-admin/pkg/service"
+)
+
+const (
+	// leaderElectionKey 领导者选举使用的键，同一个服务的所有副本竞争同一个键
+	leaderElectionKey = "cluster:leader:" + service.AdminService
+
+	// broadcastChannelPrefix 实例间通知的频道前缀
+	broadcastChannelPrefix = "cluster:broadcast:" + service.AdminService + ":"
+)
+
+// NewLeaderElector 创建领导者选举器，只有领导者执行周期任务调度与默认数据初始化等单例工作
+func NewLeaderElector(logger log.Logger, rdb *redis.Client) *cluster.Elector {
+	return cluster.NewElector(rdb, leaderElectionKey,
+		cluster.WithLogger(logger),
+	)
+}
+
+// NewClusterBroadcaster 创建实例间通知，用于刷新其他副本进程内的状态
+func NewClusterBroadcaster(logger log.Logger, rdb *redis.Client, elector *cluster.Elector) *cluster.Broadcaster {
+	return cluster.NewBroadcaster(rdb, broadcastChannelPrefix, elector.InstanceID(), logger)
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"flag"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// defaultConfigPath 与启动参数 -conf 的默认值一致
+const defaultConfigPath = "../../configs"
+
+// scanConfig 从配置目录读取 key 对应的配置，配置不存在时保持 v 不变
+func scanConfig(key string, v any) error {
+	path := defaultConfigPath
+	if f := flag.Lookup("conf"); f != nil && f.Value.String() != "" {
+		path = f.Value.String()
+	}
+
+	c := config.New(config.WithSource(file.NewSource(path)))
+	defer func() { _ = c.Close() }()
+
+	if err := c.Load(); err != nil {
+		return err
+	}
+
+	if err := c.Value(key).Scan(v); err != nil && err != config.ErrNotFound {
+		return err
+	}
+
+	return nil
+}
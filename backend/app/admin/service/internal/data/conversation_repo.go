@@ -0,0 +1,666 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+type ConversationRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper        *mapper.CopierMapper[internalMessageV1.Conversation, ent.Conversation]
+	typeConverter *mapper.EnumTypeConverter[internalMessageV1.Conversation_Type, conversation.Type]
+
+	memberMapper  *mapper.CopierMapper[internalMessageV1.ConversationMember, ent.ConversationMember]
+	roleConverter *mapper.EnumTypeConverter[internalMessageV1.ConversationMember_Role, conversationmember.Role]
+}
+
+func NewConversationRepo(data *Data, logger log.Logger) *ConversationRepo {
+	repo := &ConversationRepo{
+		log:           log.NewHelper(log.With(logger, "module", "conversation/repo/admin-service")),
+		data:          data,
+		mapper:        mapper.NewCopierMapper[internalMessageV1.Conversation, ent.Conversation](),
+		typeConverter: mapper.NewEnumTypeConverter[internalMessageV1.Conversation_Type, conversation.Type](internalMessageV1.Conversation_Type_name, internalMessageV1.Conversation_Type_value),
+		memberMapper:  mapper.NewCopierMapper[internalMessageV1.ConversationMember, ent.ConversationMember](),
+		roleConverter: mapper.NewEnumTypeConverter[internalMessageV1.ConversationMember_Role, conversationmember.Role](internalMessageV1.ConversationMember_Role_name, internalMessageV1.ConversationMember_Role_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *ConversationRepo) init() {
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
+
+	r.memberMapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.memberMapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+	r.memberMapper.AppendConverters(r.roleConverter.NewConverterPair())
+}
+
+// DirectKey 返回两个用户之间私聊会话的成员键
+func DirectKey(userA, userB uint32) string {
+	if userA > userB {
+		userA, userB = userB, userA
+	}
+	return fmt.Sprintf("%d:%d", userA, userB)
+}
+
+// directPeer 从私聊会话的成员键中解析对方的用户ID
+func directPeer(directKey string, userId uint32) uint32 {
+	a, b, ok := strings.Cut(directKey, ":")
+	if !ok {
+		return 0
+	}
+
+	for _, part := range []string{a, b} {
+		id, err := strconv.ParseUint(part, 10, 32)
+		if err == nil && uint32(id) != userId {
+			return uint32(id)
+		}
+	}
+
+	return 0
+}
+
+func (r *ConversationRepo) Get(ctx context.Context, id uint32) (*internalMessageV1.Conversation, error) {
+	entity, err := r.data.db.Client().Conversation.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, internalMessageV1.ErrorNotFound("conversation not found")
+		}
+
+		r.log.Errorf("query conversation failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// GetDirect 查询两个用户之间的私聊会话，不存在时返回 nil
+func (r *ConversationRepo) GetDirect(ctx context.Context, userA, userB uint32) (*internalMessageV1.Conversation, error) {
+	entity, err := r.data.db.Client().Conversation.Query().
+		Where(conversation.DirectKeyEQ(DirectKey(userA, userB))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		r.log.Errorf("query direct conversation failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query direct conversation failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Create 创建会话并加入成员。群聊的创建者为群主；私聊已存在时返回已有的会话
+func (r *ConversationRepo) Create(ctx context.Context, data *internalMessageV1.Conversation, ownerId uint32, memberUserIds []uint32) (*internalMessageV1.Conversation, error) {
+	if data == nil {
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	now := time.Now()
+
+	userIds := []uint32{ownerId}
+	for _, uid := range memberUserIds {
+		if uid != ownerId && !slices.Contains(userIds, uid) {
+			userIds = append(userIds, uid)
+		}
+	}
+
+	isDirect := data.GetType() == internalMessageV1.Conversation_DIRECT
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	builder := tx.Conversation.Create().
+		SetNillableType(r.typeConverter.ToEntity(data.Type)).
+		SetNillableName(data.Name).
+		SetNillableAvatarURL(data.AvatarUrl).
+		SetMemberCount(uint32(len(userIds))).
+		SetCreatedBy(ownerId).
+		SetCreatedAt(now)
+	if isDirect {
+		if len(userIds) != 2 {
+			_ = tx.Rollback()
+			return nil, internalMessageV1.ErrorBadRequest("direct conversation requires exactly one other member")
+		}
+		builder.SetDirectKey(DirectKey(userIds[0], userIds[1]))
+	}
+
+	var entity *ent.Conversation
+	if entity, err = builder.Save(ctx); err != nil {
+		_ = tx.Rollback()
+
+		// 并发创建同一个私聊会话时返回先创建的会话
+		if isDirect && ent.IsConstraintError(err) {
+			var existing *internalMessageV1.Conversation
+			if existing, err = r.GetDirect(ctx, userIds[0], userIds[1]); err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return existing, nil
+			}
+		}
+
+		r.log.Errorf("insert conversation failed: %v", err)
+		return nil, internalMessageV1.ErrorInternalServerError("insert conversation failed")
+	}
+
+	builders := make([]*ent.ConversationMemberCreate, 0, len(userIds))
+	for _, uid := range userIds {
+		role := conversationmember.RoleMember
+		if !isDirect && uid == ownerId {
+			role = conversationmember.RoleOwner
+		}
+
+		builders = append(builders, tx.ConversationMember.Create().
+			SetConversationID(entity.ID).
+			SetUserID(uid).
+			SetRole(role).
+			SetUnreadCount(0).
+			SetJoinedAt(now).
+			SetCreatedAt(now),
+		)
+	}
+
+	if _, err = tx.ConversationMember.CreateBulk(builders...).Save(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("insert conversation members failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("insert conversation members failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Update 更新会话名称与头像
+func (r *ConversationRepo) Update(ctx context.Context, id uint32, name, avatarUrl *string, operatorId uint32) error {
+	if err := r.data.db.Client().Conversation.UpdateOneID(id).
+		SetNillableName(name).
+		SetNillableAvatarURL(avatarUrl).
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return internalMessageV1.ErrorNotFound("conversation not found")
+		}
+
+		r.log.Errorf("update conversation failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("update conversation failed")
+	}
+
+	return nil
+}
+
+// ListByMember 分页查询用户加入的会话，按最后一条消息的时间倒序，并填充用户在会话中的未读数量、角色、禁言时间与私聊对方
+func (r *ConversationRepo) ListByMember(ctx context.Context, userId uint32, page, pageSize int) ([]*internalMessageV1.Conversation, uint64, error) {
+	members, err := r.data.db.Client().ConversationMember.Query().
+		Where(conversationmember.UserIDEQ(userId)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query conversation members failed: %s", err.Error())
+		return nil, 0, internalMessageV1.ErrorInternalServerError("query conversation members failed")
+	}
+	if len(members) == 0 {
+		return []*internalMessageV1.Conversation{}, 0, nil
+	}
+
+	memberMap := make(map[uint32]*ent.ConversationMember, len(members))
+	ids := make([]uint32, 0, len(members))
+	for _, m := range members {
+		memberMap[*m.ConversationID] = m
+		ids = append(ids, *m.ConversationID)
+	}
+
+	builder := r.data.db.Client().Conversation.Query().
+		Where(conversation.IDIn(ids...)).
+		Order(ent.Desc(conversation.FieldLastMessageAt), ent.Desc(conversation.FieldID))
+	if pageSize > 0 {
+		if page < 1 {
+			page = 1
+		}
+		builder.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	entities, err := builder.All(ctx)
+	if err != nil {
+		r.log.Errorf("query conversations failed: %s", err.Error())
+		return nil, 0, internalMessageV1.ErrorInternalServerError("query conversations failed")
+	}
+
+	dtos := make([]*internalMessageV1.Conversation, 0, len(entities))
+	for _, entity := range entities {
+		dto := r.mapper.ToDTO(entity)
+
+		member := r.memberMapper.ToDTO(memberMap[entity.ID])
+		dto.UnreadCount = trans.Ptr(member.GetUnreadCount())
+		dto.Role = member.Role
+		dto.MutedUntil = member.MutedUntil
+		if entity.DirectKey != nil {
+			dto.PeerUserId = trans.Ptr(directPeer(*entity.DirectKey, userId))
+		}
+
+		dtos = append(dtos, dto)
+	}
+
+	return dtos, uint64(len(ids)), nil
+}
+
+// GetMember 查询会话成员，用户不是会话成员时返回 NotFound
+func (r *ConversationRepo) GetMember(ctx context.Context, conversationId, userId uint32) (*internalMessageV1.ConversationMember, error) {
+	entity, err := r.data.db.Client().ConversationMember.Query().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDEQ(userId),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, internalMessageV1.ErrorNotFound("conversation member not found")
+		}
+
+		r.log.Errorf("query conversation member failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation member failed")
+	}
+
+	return r.memberMapper.ToDTO(entity), nil
+}
+
+// ListMembers 查询会话的所有成员，按加入顺序排列
+func (r *ConversationRepo) ListMembers(ctx context.Context, conversationId uint32) ([]*internalMessageV1.ConversationMember, error) {
+	entities, err := r.data.db.Client().ConversationMember.Query().
+		Where(conversationmember.ConversationIDEQ(conversationId)).
+		Order(ent.Asc(conversationmember.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query conversation members failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation members failed")
+	}
+
+	dtos := make([]*internalMessageV1.ConversationMember, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.memberMapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// ListMemberUserIds 查询会话所有成员的用户ID
+func (r *ConversationRepo) ListMemberUserIds(ctx context.Context, conversationId uint32) ([]uint32, error) {
+	userIds, err := r.data.db.Client().ConversationMember.Query().
+		Where(conversationmember.ConversationIDEQ(conversationId)).
+		Select(conversationmember.FieldUserID).
+		Uint32s(ctx)
+	if err != nil {
+		r.log.Errorf("query conversation member ids failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation member ids failed")
+	}
+
+	return userIds, nil
+}
+
+// AddMembers 添加会话成员，已经是成员的用户会被跳过，返回新加入的用户ID
+func (r *ConversationRepo) AddMembers(ctx context.Context, conversationId uint32, userIds []uint32) ([]uint32, error) {
+	if len(userIds) == 0 {
+		return []uint32{}, nil
+	}
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	var existing []uint32
+	if existing, err = tx.ConversationMember.Query().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDIn(userIds...),
+		).
+		Select(conversationmember.FieldUserID).
+		Uint32s(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("query conversation members failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation members failed")
+	}
+
+	now := time.Now()
+
+	added := make([]uint32, 0, len(userIds))
+	builders := make([]*ent.ConversationMemberCreate, 0, len(userIds))
+	for _, uid := range userIds {
+		if slices.Contains(existing, uid) || slices.Contains(added, uid) {
+			continue
+		}
+		added = append(added, uid)
+
+		builders = append(builders, tx.ConversationMember.Create().
+			SetConversationID(conversationId).
+			SetUserID(uid).
+			SetRole(conversationmember.RoleMember).
+			SetUnreadCount(0).
+			SetJoinedAt(now).
+			SetCreatedAt(now),
+		)
+	}
+
+	if len(builders) > 0 {
+		if _, err = tx.ConversationMember.CreateBulk(builders...).Save(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("insert conversation members failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("insert conversation members failed")
+		}
+
+		if err = tx.Conversation.UpdateOneID(conversationId).
+			AddMemberCount(int32(len(builders))).
+			SetUpdatedAt(now).
+			Exec(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("update conversation member count failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("update conversation member count failed")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return added, nil
+}
+
+// RemoveMember 移除会话成员
+func (r *ConversationRepo) RemoveMember(ctx context.Context, conversationId, userId uint32) error {
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	var affected int
+	if affected, err = tx.ConversationMember.Delete().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDEQ(userId),
+		).
+		Exec(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("delete conversation member failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("delete conversation member failed")
+	}
+
+	if affected > 0 {
+		if err = tx.Conversation.UpdateOneID(conversationId).
+			AddMemberCount(int32(-affected)).
+			SetUpdatedAt(time.Now()).
+			Exec(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("update conversation member count failed: %s", err.Error())
+			return internalMessageV1.ErrorInternalServerError("update conversation member count failed")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return nil
+}
+
+// UpdateMemberRole 修改成员角色，转让群主时原群主降为管理员
+func (r *ConversationRepo) UpdateMemberRole(ctx context.Context, conversationId, userId uint32, role internalMessageV1.ConversationMember_Role) error {
+	now := time.Now()
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	if role == internalMessageV1.ConversationMember_OWNER {
+		if _, err = tx.ConversationMember.Update().
+			Where(
+				conversationmember.ConversationIDEQ(conversationId),
+				conversationmember.RoleEQ(conversationmember.RoleOwner),
+			).
+			SetRole(conversationmember.RoleAdmin).
+			SetUpdatedAt(now).
+			Save(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("demote conversation owner failed: %s", err.Error())
+			return internalMessageV1.ErrorInternalServerError("update conversation member role failed")
+		}
+	}
+
+	var affected int
+	if affected, err = tx.ConversationMember.Update().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDEQ(userId),
+		).
+		SetNillableRole(r.roleConverter.ToEntity(&role)).
+		SetUpdatedAt(now).
+		Save(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("update conversation member role failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("update conversation member role failed")
+	}
+	if affected == 0 {
+		_ = tx.Rollback()
+		return internalMessageV1.ErrorNotFound("conversation member not found")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return nil
+}
+
+// MuteMember 禁言成员到 until，until 为空时解除禁言
+func (r *ConversationRepo) MuteMember(ctx context.Context, conversationId, userId uint32, until *time.Time) error {
+	builder := r.data.db.Client().ConversationMember.Update().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDEQ(userId),
+		).
+		SetUpdatedAt(time.Now())
+	if until != nil {
+		builder.SetMutedUntil(*until)
+	} else {
+		builder.ClearMutedUntil()
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("mute conversation member failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("mute conversation member failed")
+	}
+	if affected == 0 {
+		return internalMessageV1.ErrorNotFound("conversation member not found")
+	}
+
+	return nil
+}
+
+// SendMessage 在会话中保存一条消息：更新会话的最后一条消息与话题的回复数量，
+// 其他成员的未读数量加一，发送者已读到该消息。返回消息ID
+func (r *ConversationRepo) SendMessage(ctx context.Context, msg *internalMessageV1.InternalMessage) (uint32, error) {
+	if msg == nil || msg.ConversationId == nil || msg.SenderId == nil {
+		return 0, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	now := time.Now()
+	conversationId := msg.GetConversationId()
+	senderId := msg.GetSenderId()
+
+	msgType := internalmessage.TypePrivate
+	if msg.GetType() == internalMessageV1.InternalMessage_GROUP {
+		msgType = internalmessage.TypeGroup
+	}
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	var entity *ent.InternalMessage
+	if entity, err = tx.InternalMessage.Create().
+		SetNillableTitle(msg.Title).
+		SetNillableContent(msg.Content).
+		SetSenderID(senderId).
+		SetConversationID(conversationId).
+		SetNillableThreadID(msg.ThreadId).
+		SetReplyCount(0).
+		SetStatus(internalmessage.StatusPublished).
+		SetType(msgType).
+		SetLastSentAt(now).
+		SetCreatedBy(senderId).
+		SetCreatedAt(now).
+		Save(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("insert conversation message failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("insert conversation message failed")
+	}
+
+	if msg.ThreadId != nil {
+		if err = tx.InternalMessage.UpdateOneID(msg.GetThreadId()).
+			AddReplyCount(1).
+			Exec(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("update thread reply count failed: %s", err.Error())
+			return 0, internalMessageV1.ErrorInternalServerError("update thread reply count failed")
+		}
+	}
+
+	if err = tx.Conversation.UpdateOneID(conversationId).
+		SetLastMessageID(entity.ID).
+		SetLastMessageAt(now).
+		Exec(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("update conversation last message failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("update conversation last message failed")
+	}
+
+	if _, err = tx.ConversationMember.Update().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDNEQ(senderId),
+		).
+		AddUnreadCount(1).
+		Save(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("update conversation unread count failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("update conversation unread count failed")
+	}
+
+	if _, err = tx.ConversationMember.Update().
+		Where(
+			conversationmember.ConversationIDEQ(conversationId),
+			conversationmember.UserIDEQ(senderId),
+		).
+		SetLastReadMessageID(entity.ID).
+		SetUnreadCount(0).
+		Save(ctx); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("update sender read position failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("update sender read position failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return entity.ID, nil
+}
+
+// MarkRead 把成员的已读位置推进到 messageId，并按已读位置之后其他成员发送的消息重新计算未读数量。
+// 已读位置只会前进，返回实际的已读位置与未读数量
+func (r *ConversationRepo) MarkRead(ctx context.Context, conversationId, userId, messageId uint32) (uint32, uint32, error) {
+	member, err := r.GetMember(ctx, conversationId, userId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lastRead := member.GetLastReadMessageId()
+	if messageId > lastRead {
+		lastRead = messageId
+	}
+
+	unread, err := r.data.db.Client().InternalMessage.Query().
+		Where(
+			internalmessage.ConversationIDEQ(conversationId),
+			internalmessage.IDGT(lastRead),
+			internalmessage.SenderIDNEQ(userId),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count unread conversation messages failed: %s", err.Error())
+		return 0, 0, internalMessageV1.ErrorInternalServerError("count unread conversation messages failed")
+	}
+
+	if err = r.data.db.Client().ConversationMember.UpdateOneID(member.GetId()).
+		SetLastReadMessageID(lastRead).
+		SetUnreadCount(uint32(unread)).
+		SetUpdatedAt(time.Now()).
+		Exec(ctx); err != nil {
+		r.log.Errorf("update conversation read position failed: %s", err.Error())
+		return 0, 0, internalMessageV1.ErrorInternalServerError("update conversation read position failed")
+	}
+
+	return lastRead, uint32(unread), nil
+}
+
+// GetUnreadCounts 查询会话成员的未读数量
+func (r *ConversationRepo) GetUnreadCounts(ctx context.Context, conversationId uint32) (map[uint32]uint32, error) {
+	entities, err := r.data.db.Client().ConversationMember.Query().
+		Where(conversationmember.ConversationIDEQ(conversationId)).
+		Select(conversationmember.FieldUserID, conversationmember.FieldUnreadCount).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query conversation unread counts failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query conversation unread counts failed")
+	}
+
+	counts := make(map[uint32]uint32, len(entities))
+	for _, entity := range entities {
+		counts[trans.Uint32Value(entity.UserID)] = trans.Uint32Value(entity.UnreadCount)
+	}
+
+	return counts, nil
+}
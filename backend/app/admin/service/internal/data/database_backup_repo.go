@@ -0,0 +1,134 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"io"
+
+	entSchema "entgo.io/ent/dialect/sql/schema"
+	"github.com/go-kratos/kratos/v2/log"
+
+	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/backup"
+)
+
+// DatabaseBackupRepo 数据库备份与恢复。
+// ent 客户端不暴露底层连接，所以备份和恢复时使用配置单独打开连接。
+type DatabaseBackupRepo struct {
+	log *log.Helper
+
+	driver string
+	source string
+
+	tables []string
+}
+
+func NewDatabaseBackupRepo(cfg *conf.Bootstrap, logger log.Logger) *DatabaseBackupRepo {
+	repo := &DatabaseBackupRepo{
+		log:    log.NewHelper(log.With(logger, "module", "database-backup/repo/admin-service")),
+		tables: sortTablesByDependency(migrate.Tables),
+	}
+
+	if cfg.GetData().GetDatabase() != nil {
+		repo.driver = cfg.GetData().GetDatabase().GetDriver()
+		repo.source = cfg.GetData().GetDatabase().GetSource()
+	}
+
+	return repo
+}
+
+// Driver 返回数据库驱动名称
+func (r *DatabaseBackupRepo) Driver() string {
+	return r.driver
+}
+
+func (r *DatabaseBackupRepo) open() (*sql.DB, error) {
+	if _, err := backup.NewDialect(r.driver); err != nil {
+		return nil, adminV1.ErrorBadRequest("database driver [%s] does not support backup", r.driver)
+	}
+
+	db, err := sql.Open(r.driver, r.source)
+	if err != nil {
+		r.log.Errorf("open database failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("open database failed")
+	}
+
+	return db, nil
+}
+
+// Export 导出全部业务表到备份归档
+func (r *DatabaseBackupRepo) Export(ctx context.Context, w io.Writer, progress backup.ProgressFunc) (*backup.Manifest, error) {
+	db, err := r.open()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	manifest, err := backup.Dump(ctx, db, r.driver, r.tables, w, progress)
+	if err != nil {
+		r.log.Errorf("dump database failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("dump database failed")
+	}
+
+	return manifest, nil
+}
+
+// Validate 读取并校验备份归档，校验失败时不会对数据库做任何修改
+func (r *DatabaseBackupRepo) Validate(reader io.Reader) (*backup.Archive, error) {
+	archive, err := backup.ReadArchive(reader, r.driver, r.tables)
+	if err != nil {
+		r.log.Warnf("invalid backup archive: %s", err.Error())
+		return nil, adminV1.ErrorBadRequest("invalid backup archive: %s", err.Error())
+	}
+
+	return archive, nil
+}
+
+// Restore 用备份归档替换数据库中的数据
+func (r *DatabaseBackupRepo) Restore(ctx context.Context, archive *backup.Archive, progress backup.ProgressFunc) error {
+	db, err := r.open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if err = backup.Restore(ctx, db, archive, progress); err != nil {
+		r.log.Errorf("restore database failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("restore database failed")
+	}
+
+	return nil
+}
+
+// sortTablesByDependency 按外键依赖排序，被引用的表排在前面
+func sortTablesByDependency(tables []*entSchema.Table) []string {
+	visited := make(map[string]bool, len(tables))
+	result := make([]string, 0, len(tables))
+
+	var visit func(t *entSchema.Table)
+	visit = func(t *entSchema.Table) {
+		if visited[t.Name] {
+			return
+		}
+		visited[t.Name] = true
+
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable != t {
+				visit(fk.RefTable)
+			}
+		}
+
+		result = append(result, t.Name)
+	}
+
+	for _, t := range tables {
+		visit(t)
+	}
+
+	return result
+}
@@ -220,7 +220,7 @@ func (r *DepartmentRepo) Create(ctx context.Context, req *userV1.CreateDepartmen
 		SetNillableCreatedBy(req.Data.CreatedBy).
 		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
 
-	if req.Data.TenantId == nil {
+	if req.Data.TenantId != nil {
 		builder.SetTenantID(req.Data.GetTenantId())
 	}
 	if req.Data.CreatedAt == nil {
@@ -313,3 +313,27 @@ func (r *DepartmentRepo) Delete(ctx context.Context, req *userV1.DeleteDepartmen
 
 	return nil
 }
+
+// ListParentIds 获取所有部门的上级关系：部门ID -> 上级部门ID，顶级部门的上级为0
+func (r *DepartmentRepo) ListParentIds(ctx context.Context) (map[uint32]uint32, error) {
+	var rows []struct {
+		ID       uint32  `json:"id"`
+		ParentID *uint32 `json:"parent_id"`
+	}
+	if err := r.data.db.Client().Department.Query().
+		Select(department.FieldID, department.FieldParentID).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query department parent ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query department parent ids failed")
+	}
+
+	parents := make(map[uint32]uint32, len(rows))
+	for _, row := range rows {
+		if row.ParentID != nil {
+			parents[row.ID] = *row.ParentID
+		} else {
+			parents[row.ID] = 0
+		}
+	}
+	return parents, nil
+}
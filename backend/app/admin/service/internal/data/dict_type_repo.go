@@ -19,6 +19,8 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 
 	dictV1 "go-wind-admin/api/gen/go/dict/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
 )
 
 type DictTypeRepo struct {
@@ -123,7 +125,11 @@ func (r *DictTypeRepo) Get(ctx context.Context, req *dictV1.GetDictTypeRequest)
 	case *dictV1.GetDictTypeRequest_Id:
 		whereCond = append(whereCond, dicttype.IDEQ(req.GetId()))
 	case *dictV1.GetDictTypeRequest_Code:
-		builder.Where(dicttype.TypeCodeEQ(req.GetCode()))
+		id, err := r.getIdByCode(ctx, req.GetCode())
+		if err != nil {
+			return nil, err
+		}
+		whereCond = append(whereCond, dicttype.IDEQ(id))
 	}
 
 	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
@@ -134,6 +140,36 @@ func (r *DictTypeRepo) Get(ctx context.Context, req *dictV1.GetDictTypeRequest)
 	return dto, err
 }
 
+// getIdByCode 通过编码查询字典类型ID。字典类型编码在租户内唯一，
+// 租户与平台存在相同编码时，优先使用查看者所在租户的字典类型
+func (r *DictTypeRepo) getIdByCode(ctx context.Context, code string) (uint32, error) {
+	var tenantIds []uint32
+	if view := viewer.FromContext(ctx); view != nil {
+		if tid, ok := view.Tenant(); ok && tid != 0 {
+			tenantIds = append(tenantIds, tid)
+		}
+	}
+	tenantIds = append(tenantIds, 0)
+
+	for _, tid := range tenantIds {
+		id, err := r.data.db.Client().DictType.Query().
+			Where(
+				dicttype.TypeCodeEQ(code),
+				tenantScope(tid),
+			).
+			FirstID(ctx)
+		if err == nil {
+			return id, nil
+		}
+		if !ent.IsNotFound(err) {
+			r.log.Errorf("query dict type by code failed: %s", err.Error())
+			return 0, dictV1.ErrorInternalServerError("query dict type failed")
+		}
+	}
+
+	return 0, dictV1.ErrorNotFound("dict type not found")
+}
+
 func (r *DictTypeRepo) Create(ctx context.Context, req *dictV1.CreateDictTypeRequest) error {
 	if req == nil || req.Data == nil {
 		return dictV1.ErrorBadRequest("invalid parameter")
@@ -46,6 +46,14 @@ type AdminOperationLog struct {
 	Username *string `json:"username,omitempty"`
 	// 操作者IP
 	ClientIP *string `json:"client_ip,omitempty"`
+	// 代管操作人的用户ID
+	ImpersonatorID *uint32 `json:"impersonator_id,omitempty"`
+	// 代管操作人的用户名
+	ImpersonatorName *string `json:"impersonator_name,omitempty"`
+	// 代管原因
+	ImpersonationReason *string `json:"impersonation_reason,omitempty"`
+	// 代管审计日志ID
+	ImpersonationAuditID *uint32 `json:"impersonation_audit_id,omitempty"`
 	// 状态码
 	StatusCode *int32 `json:"status_code,omitempty"`
 	// 操作失败原因
@@ -80,9 +88,9 @@ func (*AdminOperationLog) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case adminoperationlog.FieldCostTime:
 			values[i] = new(sql.NullFloat64)
-		case adminoperationlog.FieldID, adminoperationlog.FieldUserID, adminoperationlog.FieldStatusCode:
+		case adminoperationlog.FieldID, adminoperationlog.FieldUserID, adminoperationlog.FieldImpersonatorID, adminoperationlog.FieldImpersonationAuditID, adminoperationlog.FieldStatusCode:
 			values[i] = new(sql.NullInt64)
-		case adminoperationlog.FieldRequestID, adminoperationlog.FieldMethod, adminoperationlog.FieldOperation, adminoperationlog.FieldPath, adminoperationlog.FieldReferer, adminoperationlog.FieldRequestURI, adminoperationlog.FieldRequestBody, adminoperationlog.FieldRequestHeader, adminoperationlog.FieldResponse, adminoperationlog.FieldUsername, adminoperationlog.FieldClientIP, adminoperationlog.FieldReason, adminoperationlog.FieldLocation, adminoperationlog.FieldUserAgent, adminoperationlog.FieldBrowserName, adminoperationlog.FieldBrowserVersion, adminoperationlog.FieldClientID, adminoperationlog.FieldClientName, adminoperationlog.FieldOsName, adminoperationlog.FieldOsVersion:
+		case adminoperationlog.FieldRequestID, adminoperationlog.FieldMethod, adminoperationlog.FieldOperation, adminoperationlog.FieldPath, adminoperationlog.FieldReferer, adminoperationlog.FieldRequestURI, adminoperationlog.FieldRequestBody, adminoperationlog.FieldRequestHeader, adminoperationlog.FieldResponse, adminoperationlog.FieldUsername, adminoperationlog.FieldClientIP, adminoperationlog.FieldImpersonatorName, adminoperationlog.FieldImpersonationReason, adminoperationlog.FieldReason, adminoperationlog.FieldLocation, adminoperationlog.FieldUserAgent, adminoperationlog.FieldBrowserName, adminoperationlog.FieldBrowserVersion, adminoperationlog.FieldClientID, adminoperationlog.FieldClientName, adminoperationlog.FieldOsName, adminoperationlog.FieldOsVersion:
 			values[i] = new(sql.NullString)
 		case adminoperationlog.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -205,6 +213,34 @@ func (_m *AdminOperationLog) assignValues(columns []string, values []any) error
 				_m.ClientIP = new(string)
 				*_m.ClientIP = value.String
 			}
+		case adminoperationlog.FieldImpersonatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_id", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorID = new(uint32)
+				*_m.ImpersonatorID = uint32(value.Int64)
+			}
+		case adminoperationlog.FieldImpersonatorName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonator_name", values[i])
+			} else if value.Valid {
+				_m.ImpersonatorName = new(string)
+				*_m.ImpersonatorName = value.String
+			}
+		case adminoperationlog.FieldImpersonationReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonation_reason", values[i])
+			} else if value.Valid {
+				_m.ImpersonationReason = new(string)
+				*_m.ImpersonationReason = value.String
+			}
+		case adminoperationlog.FieldImpersonationAuditID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field impersonation_audit_id", values[i])
+			} else if value.Valid {
+				_m.ImpersonationAuditID = new(uint32)
+				*_m.ImpersonationAuditID = uint32(value.Int64)
+			}
 		case adminoperationlog.FieldStatusCode:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status_code", values[i])
@@ -388,6 +424,26 @@ func (_m *AdminOperationLog) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.ImpersonatorID; v != nil {
+		builder.WriteString("impersonator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ImpersonatorName; v != nil {
+		builder.WriteString("impersonator_name=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ImpersonationReason; v != nil {
+		builder.WriteString("impersonation_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.ImpersonationAuditID; v != nil {
+		builder.WriteString("impersonation_audit_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.StatusCode; v != nil {
 		builder.WriteString("status_code=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
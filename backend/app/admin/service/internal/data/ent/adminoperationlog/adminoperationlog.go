@@ -39,6 +39,14 @@ const (
 	FieldUsername = "username"
 	// FieldClientIP holds the string denoting the client_ip field in the database.
 	FieldClientIP = "client_ip"
+	// FieldImpersonatorID holds the string denoting the impersonator_id field in the database.
+	FieldImpersonatorID = "impersonator_id"
+	// FieldImpersonatorName holds the string denoting the impersonator_name field in the database.
+	FieldImpersonatorName = "impersonator_name"
+	// FieldImpersonationReason holds the string denoting the impersonation_reason field in the database.
+	FieldImpersonationReason = "impersonation_reason"
+	// FieldImpersonationAuditID holds the string denoting the impersonation_audit_id field in the database.
+	FieldImpersonationAuditID = "impersonation_audit_id"
 	// FieldStatusCode holds the string denoting the status_code field in the database.
 	FieldStatusCode = "status_code"
 	// FieldReason holds the string denoting the reason field in the database.
@@ -82,6 +90,10 @@ var Columns = []string{
 	FieldUserID,
 	FieldUsername,
 	FieldClientIP,
+	FieldImpersonatorID,
+	FieldImpersonatorName,
+	FieldImpersonationReason,
+	FieldImpersonationAuditID,
 	FieldStatusCode,
 	FieldReason,
 	FieldSuccess,
@@ -188,6 +200,26 @@ func ByClientIP(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldClientIP, opts...).ToFunc()
 }
 
+// ByImpersonatorID orders the results by the impersonator_id field.
+func ByImpersonatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorID, opts...).ToFunc()
+}
+
+// ByImpersonatorName orders the results by the impersonator_name field.
+func ByImpersonatorName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonatorName, opts...).ToFunc()
+}
+
+// ByImpersonationReason orders the results by the impersonation_reason field.
+func ByImpersonationReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonationReason, opts...).ToFunc()
+}
+
+// ByImpersonationAuditID orders the results by the impersonation_audit_id field.
+func ByImpersonationAuditID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImpersonationAuditID, opts...).ToFunc()
+}
+
 // ByStatusCode orders the results by the status_code field.
 func ByStatusCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatusCode, opts...).ToFunc()
@@ -124,6 +124,26 @@ func ClientIP(v string) predicate.AdminOperationLog {
 	return predicate.AdminOperationLog(sql.FieldEQ(FieldClientIP, v))
 }
 
+// ImpersonatorID applies equality check predicate on the "impersonator_id" field. It's identical to ImpersonatorIDEQ.
+func ImpersonatorID(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorName applies equality check predicate on the "impersonator_name" field. It's identical to ImpersonatorNameEQ.
+func ImpersonatorName(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonationReason applies equality check predicate on the "impersonation_reason" field. It's identical to ImpersonationReasonEQ.
+func ImpersonationReason(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonationReason, v))
+}
+
+// ImpersonationAuditID applies equality check predicate on the "impersonation_audit_id" field. It's identical to ImpersonationAuditIDEQ.
+func ImpersonationAuditID(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonationAuditID, v))
+}
+
 // StatusCode applies equality check predicate on the "status_code" field. It's identical to StatusCodeEQ.
 func StatusCode(v int32) predicate.AdminOperationLog {
 	return predicate.AdminOperationLog(sql.FieldEQ(FieldStatusCode, v))
@@ -1154,6 +1174,256 @@ func ClientIPContainsFold(v string) predicate.AdminOperationLog {
 	return predicate.AdminOperationLog(sql.FieldContainsFold(FieldClientIP, v))
 }
 
+// ImpersonatorIDEQ applies the EQ predicate on the "impersonator_id" field.
+func ImpersonatorIDEQ(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDNEQ applies the NEQ predicate on the "impersonator_id" field.
+func ImpersonatorIDNEQ(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNEQ(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIn applies the In predicate on the "impersonator_id" field.
+func ImpersonatorIDIn(vs ...uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDNotIn applies the NotIn predicate on the "impersonator_id" field.
+func ImpersonatorIDNotIn(vs ...uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotIn(FieldImpersonatorID, vs...))
+}
+
+// ImpersonatorIDGT applies the GT predicate on the "impersonator_id" field.
+func ImpersonatorIDGT(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDGTE applies the GTE predicate on the "impersonator_id" field.
+func ImpersonatorIDGTE(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLT applies the LT predicate on the "impersonator_id" field.
+func ImpersonatorIDLT(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLT(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDLTE applies the LTE predicate on the "impersonator_id" field.
+func ImpersonatorIDLTE(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLTE(FieldImpersonatorID, v))
+}
+
+// ImpersonatorIDIsNil applies the IsNil predicate on the "impersonator_id" field.
+func ImpersonatorIDIsNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIsNull(FieldImpersonatorID))
+}
+
+// ImpersonatorIDNotNil applies the NotNil predicate on the "impersonator_id" field.
+func ImpersonatorIDNotNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotNull(FieldImpersonatorID))
+}
+
+// ImpersonatorNameEQ applies the EQ predicate on the "impersonator_name" field.
+func ImpersonatorNameEQ(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameNEQ applies the NEQ predicate on the "impersonator_name" field.
+func ImpersonatorNameNEQ(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNEQ(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIn applies the In predicate on the "impersonator_name" field.
+func ImpersonatorNameIn(vs ...string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameNotIn applies the NotIn predicate on the "impersonator_name" field.
+func ImpersonatorNameNotIn(vs ...string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotIn(FieldImpersonatorName, vs...))
+}
+
+// ImpersonatorNameGT applies the GT predicate on the "impersonator_name" field.
+func ImpersonatorNameGT(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameGTE applies the GTE predicate on the "impersonator_name" field.
+func ImpersonatorNameGTE(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLT applies the LT predicate on the "impersonator_name" field.
+func ImpersonatorNameLT(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLT(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameLTE applies the LTE predicate on the "impersonator_name" field.
+func ImpersonatorNameLTE(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLTE(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContains applies the Contains predicate on the "impersonator_name" field.
+func ImpersonatorNameContains(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldContains(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasPrefix applies the HasPrefix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasPrefix(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldHasPrefix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameHasSuffix applies the HasSuffix predicate on the "impersonator_name" field.
+func ImpersonatorNameHasSuffix(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldHasSuffix(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameIsNil applies the IsNil predicate on the "impersonator_name" field.
+func ImpersonatorNameIsNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIsNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameNotNil applies the NotNil predicate on the "impersonator_name" field.
+func ImpersonatorNameNotNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotNull(FieldImpersonatorName))
+}
+
+// ImpersonatorNameEqualFold applies the EqualFold predicate on the "impersonator_name" field.
+func ImpersonatorNameEqualFold(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEqualFold(FieldImpersonatorName, v))
+}
+
+// ImpersonatorNameContainsFold applies the ContainsFold predicate on the "impersonator_name" field.
+func ImpersonatorNameContainsFold(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldContainsFold(FieldImpersonatorName, v))
+}
+
+// ImpersonationReasonEQ applies the EQ predicate on the "impersonation_reason" field.
+func ImpersonationReasonEQ(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonNEQ applies the NEQ predicate on the "impersonation_reason" field.
+func ImpersonationReasonNEQ(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNEQ(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonIn applies the In predicate on the "impersonation_reason" field.
+func ImpersonationReasonIn(vs ...string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIn(FieldImpersonationReason, vs...))
+}
+
+// ImpersonationReasonNotIn applies the NotIn predicate on the "impersonation_reason" field.
+func ImpersonationReasonNotIn(vs ...string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotIn(FieldImpersonationReason, vs...))
+}
+
+// ImpersonationReasonGT applies the GT predicate on the "impersonation_reason" field.
+func ImpersonationReasonGT(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGT(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonGTE applies the GTE predicate on the "impersonation_reason" field.
+func ImpersonationReasonGTE(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGTE(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonLT applies the LT predicate on the "impersonation_reason" field.
+func ImpersonationReasonLT(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLT(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonLTE applies the LTE predicate on the "impersonation_reason" field.
+func ImpersonationReasonLTE(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLTE(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonContains applies the Contains predicate on the "impersonation_reason" field.
+func ImpersonationReasonContains(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldContains(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonHasPrefix applies the HasPrefix predicate on the "impersonation_reason" field.
+func ImpersonationReasonHasPrefix(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldHasPrefix(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonHasSuffix applies the HasSuffix predicate on the "impersonation_reason" field.
+func ImpersonationReasonHasSuffix(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldHasSuffix(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonIsNil applies the IsNil predicate on the "impersonation_reason" field.
+func ImpersonationReasonIsNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIsNull(FieldImpersonationReason))
+}
+
+// ImpersonationReasonNotNil applies the NotNil predicate on the "impersonation_reason" field.
+func ImpersonationReasonNotNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotNull(FieldImpersonationReason))
+}
+
+// ImpersonationReasonEqualFold applies the EqualFold predicate on the "impersonation_reason" field.
+func ImpersonationReasonEqualFold(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEqualFold(FieldImpersonationReason, v))
+}
+
+// ImpersonationReasonContainsFold applies the ContainsFold predicate on the "impersonation_reason" field.
+func ImpersonationReasonContainsFold(v string) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldContainsFold(FieldImpersonationReason, v))
+}
+
+// ImpersonationAuditIDEQ applies the EQ predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDEQ(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldEQ(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDNEQ applies the NEQ predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDNEQ(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNEQ(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDIn applies the In predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDIn(vs ...uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIn(FieldImpersonationAuditID, vs...))
+}
+
+// ImpersonationAuditIDNotIn applies the NotIn predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDNotIn(vs ...uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotIn(FieldImpersonationAuditID, vs...))
+}
+
+// ImpersonationAuditIDGT applies the GT predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDGT(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGT(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDGTE applies the GTE predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDGTE(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldGTE(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDLT applies the LT predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDLT(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLT(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDLTE applies the LTE predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDLTE(v uint32) predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldLTE(FieldImpersonationAuditID, v))
+}
+
+// ImpersonationAuditIDIsNil applies the IsNil predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDIsNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldIsNull(FieldImpersonationAuditID))
+}
+
+// ImpersonationAuditIDNotNil applies the NotNil predicate on the "impersonation_audit_id" field.
+func ImpersonationAuditIDNotNil() predicate.AdminOperationLog {
+	return predicate.AdminOperationLog(sql.FieldNotNull(FieldImpersonationAuditID))
+}
+
 // StatusCodeEQ applies the EQ predicate on the "status_code" field.
 func StatusCodeEQ(v int32) predicate.AdminOperationLog {
 	return predicate.AdminOperationLog(sql.FieldEQ(FieldStatusCode, v))
@@ -218,6 +218,62 @@ func (_c *AdminOperationLogCreate) SetNillableClientIP(v *string) *AdminOperatio
 	return _c
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_c *AdminOperationLogCreate) SetImpersonatorID(v uint32) *AdminOperationLogCreate {
+	_c.mutation.SetImpersonatorID(v)
+	return _c
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_c *AdminOperationLogCreate) SetNillableImpersonatorID(v *uint32) *AdminOperationLogCreate {
+	if v != nil {
+		_c.SetImpersonatorID(*v)
+	}
+	return _c
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_c *AdminOperationLogCreate) SetImpersonatorName(v string) *AdminOperationLogCreate {
+	_c.mutation.SetImpersonatorName(v)
+	return _c
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_c *AdminOperationLogCreate) SetNillableImpersonatorName(v *string) *AdminOperationLogCreate {
+	if v != nil {
+		_c.SetImpersonatorName(*v)
+	}
+	return _c
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (_c *AdminOperationLogCreate) SetImpersonationReason(v string) *AdminOperationLogCreate {
+	_c.mutation.SetImpersonationReason(v)
+	return _c
+}
+
+// SetNillableImpersonationReason sets the "impersonation_reason" field if the given value is not nil.
+func (_c *AdminOperationLogCreate) SetNillableImpersonationReason(v *string) *AdminOperationLogCreate {
+	if v != nil {
+		_c.SetImpersonationReason(*v)
+	}
+	return _c
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (_c *AdminOperationLogCreate) SetImpersonationAuditID(v uint32) *AdminOperationLogCreate {
+	_c.mutation.SetImpersonationAuditID(v)
+	return _c
+}
+
+// SetNillableImpersonationAuditID sets the "impersonation_audit_id" field if the given value is not nil.
+func (_c *AdminOperationLogCreate) SetNillableImpersonationAuditID(v *uint32) *AdminOperationLogCreate {
+	if v != nil {
+		_c.SetImpersonationAuditID(*v)
+	}
+	return _c
+}
+
 // SetStatusCode sets the "status_code" field.
 func (_c *AdminOperationLogCreate) SetStatusCode(v int32) *AdminOperationLogCreate {
 	_c.mutation.SetStatusCode(v)
@@ -506,6 +562,22 @@ func (_c *AdminOperationLogCreate) createSpec() (*AdminOperationLog, *sqlgraph.C
 		_spec.SetField(adminoperationlog.FieldClientIP, field.TypeString, value)
 		_node.ClientIP = &value
 	}
+	if value, ok := _c.mutation.ImpersonatorID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorID, field.TypeUint32, value)
+		_node.ImpersonatorID = &value
+	}
+	if value, ok := _c.mutation.ImpersonatorName(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorName, field.TypeString, value)
+		_node.ImpersonatorName = &value
+	}
+	if value, ok := _c.mutation.ImpersonationReason(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationReason, field.TypeString, value)
+		_node.ImpersonationReason = &value
+	}
+	if value, ok := _c.mutation.ImpersonationAuditID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32, value)
+		_node.ImpersonationAuditID = &value
+	}
 	if value, ok := _c.mutation.StatusCode(); ok {
 		_spec.SetField(adminoperationlog.FieldStatusCode, field.TypeInt32, value)
 		_node.StatusCode = &value
@@ -848,6 +920,90 @@ func (u *AdminOperationLogUpsert) ClearClientIP() *AdminOperationLogUpsert {
 	return u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *AdminOperationLogUpsert) SetImpersonatorID(v uint32) *AdminOperationLogUpsert {
+	u.Set(adminoperationlog.FieldImpersonatorID, v)
+	return u
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsert) UpdateImpersonatorID() *AdminOperationLogUpsert {
+	u.SetExcluded(adminoperationlog.FieldImpersonatorID)
+	return u
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *AdminOperationLogUpsert) AddImpersonatorID(v uint32) *AdminOperationLogUpsert {
+	u.Add(adminoperationlog.FieldImpersonatorID, v)
+	return u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *AdminOperationLogUpsert) ClearImpersonatorID() *AdminOperationLogUpsert {
+	u.SetNull(adminoperationlog.FieldImpersonatorID)
+	return u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *AdminOperationLogUpsert) SetImpersonatorName(v string) *AdminOperationLogUpsert {
+	u.Set(adminoperationlog.FieldImpersonatorName, v)
+	return u
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *AdminOperationLogUpsert) UpdateImpersonatorName() *AdminOperationLogUpsert {
+	u.SetExcluded(adminoperationlog.FieldImpersonatorName)
+	return u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *AdminOperationLogUpsert) ClearImpersonatorName() *AdminOperationLogUpsert {
+	u.SetNull(adminoperationlog.FieldImpersonatorName)
+	return u
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (u *AdminOperationLogUpsert) SetImpersonationReason(v string) *AdminOperationLogUpsert {
+	u.Set(adminoperationlog.FieldImpersonationReason, v)
+	return u
+}
+
+// UpdateImpersonationReason sets the "impersonation_reason" field to the value that was provided on create.
+func (u *AdminOperationLogUpsert) UpdateImpersonationReason() *AdminOperationLogUpsert {
+	u.SetExcluded(adminoperationlog.FieldImpersonationReason)
+	return u
+}
+
+// ClearImpersonationReason clears the value of the "impersonation_reason" field.
+func (u *AdminOperationLogUpsert) ClearImpersonationReason() *AdminOperationLogUpsert {
+	u.SetNull(adminoperationlog.FieldImpersonationReason)
+	return u
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsert) SetImpersonationAuditID(v uint32) *AdminOperationLogUpsert {
+	u.Set(adminoperationlog.FieldImpersonationAuditID, v)
+	return u
+}
+
+// UpdateImpersonationAuditID sets the "impersonation_audit_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsert) UpdateImpersonationAuditID() *AdminOperationLogUpsert {
+	u.SetExcluded(adminoperationlog.FieldImpersonationAuditID)
+	return u
+}
+
+// AddImpersonationAuditID adds v to the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsert) AddImpersonationAuditID(v uint32) *AdminOperationLogUpsert {
+	u.Add(adminoperationlog.FieldImpersonationAuditID, v)
+	return u
+}
+
+// ClearImpersonationAuditID clears the value of the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsert) ClearImpersonationAuditID() *AdminOperationLogUpsert {
+	u.SetNull(adminoperationlog.FieldImpersonationAuditID)
+	return u
+}
+
 // SetStatusCode sets the "status_code" field.
 func (u *AdminOperationLogUpsert) SetStatusCode(v int32) *AdminOperationLogUpsert {
 	u.Set(adminoperationlog.FieldStatusCode, v)
@@ -1390,6 +1546,104 @@ func (u *AdminOperationLogUpsertOne) ClearClientIP() *AdminOperationLogUpsertOne
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *AdminOperationLogUpsertOne) SetImpersonatorID(v uint32) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *AdminOperationLogUpsertOne) AddImpersonatorID(v uint32) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertOne) UpdateImpersonatorID() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *AdminOperationLogUpsertOne) ClearImpersonatorID() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *AdminOperationLogUpsertOne) SetImpersonatorName(v string) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertOne) UpdateImpersonatorName() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *AdminOperationLogUpsertOne) ClearImpersonatorName() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (u *AdminOperationLogUpsertOne) SetImpersonationReason(v string) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonationReason(v)
+	})
+}
+
+// UpdateImpersonationReason sets the "impersonation_reason" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertOne) UpdateImpersonationReason() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonationReason()
+	})
+}
+
+// ClearImpersonationReason clears the value of the "impersonation_reason" field.
+func (u *AdminOperationLogUpsertOne) ClearImpersonationReason() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonationReason()
+	})
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertOne) SetImpersonationAuditID(v uint32) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonationAuditID(v)
+	})
+}
+
+// AddImpersonationAuditID adds v to the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertOne) AddImpersonationAuditID(v uint32) *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.AddImpersonationAuditID(v)
+	})
+}
+
+// UpdateImpersonationAuditID sets the "impersonation_audit_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertOne) UpdateImpersonationAuditID() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonationAuditID()
+	})
+}
+
+// ClearImpersonationAuditID clears the value of the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertOne) ClearImpersonationAuditID() *AdminOperationLogUpsertOne {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonationAuditID()
+	})
+}
+
 // SetStatusCode sets the "status_code" field.
 func (u *AdminOperationLogUpsertOne) SetStatusCode(v int32) *AdminOperationLogUpsertOne {
 	return u.Update(func(s *AdminOperationLogUpsert) {
@@ -2131,6 +2385,104 @@ func (u *AdminOperationLogUpsertBulk) ClearClientIP() *AdminOperationLogUpsertBu
 	})
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (u *AdminOperationLogUpsertBulk) SetImpersonatorID(v uint32) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonatorID(v)
+	})
+}
+
+// AddImpersonatorID adds v to the "impersonator_id" field.
+func (u *AdminOperationLogUpsertBulk) AddImpersonatorID(v uint32) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.AddImpersonatorID(v)
+	})
+}
+
+// UpdateImpersonatorID sets the "impersonator_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertBulk) UpdateImpersonatorID() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonatorID()
+	})
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (u *AdminOperationLogUpsertBulk) ClearImpersonatorID() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonatorID()
+	})
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (u *AdminOperationLogUpsertBulk) SetImpersonatorName(v string) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonatorName(v)
+	})
+}
+
+// UpdateImpersonatorName sets the "impersonator_name" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertBulk) UpdateImpersonatorName() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonatorName()
+	})
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (u *AdminOperationLogUpsertBulk) ClearImpersonatorName() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonatorName()
+	})
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (u *AdminOperationLogUpsertBulk) SetImpersonationReason(v string) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonationReason(v)
+	})
+}
+
+// UpdateImpersonationReason sets the "impersonation_reason" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertBulk) UpdateImpersonationReason() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonationReason()
+	})
+}
+
+// ClearImpersonationReason clears the value of the "impersonation_reason" field.
+func (u *AdminOperationLogUpsertBulk) ClearImpersonationReason() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonationReason()
+	})
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertBulk) SetImpersonationAuditID(v uint32) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.SetImpersonationAuditID(v)
+	})
+}
+
+// AddImpersonationAuditID adds v to the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertBulk) AddImpersonationAuditID(v uint32) *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.AddImpersonationAuditID(v)
+	})
+}
+
+// UpdateImpersonationAuditID sets the "impersonation_audit_id" field to the value that was provided on create.
+func (u *AdminOperationLogUpsertBulk) UpdateImpersonationAuditID() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.UpdateImpersonationAuditID()
+	})
+}
+
+// ClearImpersonationAuditID clears the value of the "impersonation_audit_id" field.
+func (u *AdminOperationLogUpsertBulk) ClearImpersonationAuditID() *AdminOperationLogUpsertBulk {
+	return u.Update(func(s *AdminOperationLogUpsert) {
+		s.ClearImpersonationAuditID()
+	})
+}
+
 // SetStatusCode sets the "status_code" field.
 func (u *AdminOperationLogUpsertBulk) SetStatusCode(v int32) *AdminOperationLogUpsertBulk {
 	return u.Update(func(s *AdminOperationLogUpsert) {
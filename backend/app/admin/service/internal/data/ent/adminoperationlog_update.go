@@ -302,6 +302,100 @@ func (_u *AdminOperationLogUpdate) ClearClientIP() *AdminOperationLogUpdate {
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *AdminOperationLogUpdate) SetImpersonatorID(v uint32) *AdminOperationLogUpdate {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *AdminOperationLogUpdate) SetNillableImpersonatorID(v *uint32) *AdminOperationLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *AdminOperationLogUpdate) AddImpersonatorID(v int32) *AdminOperationLogUpdate {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *AdminOperationLogUpdate) ClearImpersonatorID() *AdminOperationLogUpdate {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *AdminOperationLogUpdate) SetImpersonatorName(v string) *AdminOperationLogUpdate {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *AdminOperationLogUpdate) SetNillableImpersonatorName(v *string) *AdminOperationLogUpdate {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *AdminOperationLogUpdate) ClearImpersonatorName() *AdminOperationLogUpdate {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (_u *AdminOperationLogUpdate) SetImpersonationReason(v string) *AdminOperationLogUpdate {
+	_u.mutation.SetImpersonationReason(v)
+	return _u
+}
+
+// SetNillableImpersonationReason sets the "impersonation_reason" field if the given value is not nil.
+func (_u *AdminOperationLogUpdate) SetNillableImpersonationReason(v *string) *AdminOperationLogUpdate {
+	if v != nil {
+		_u.SetImpersonationReason(*v)
+	}
+	return _u
+}
+
+// ClearImpersonationReason clears the value of the "impersonation_reason" field.
+func (_u *AdminOperationLogUpdate) ClearImpersonationReason() *AdminOperationLogUpdate {
+	_u.mutation.ClearImpersonationReason()
+	return _u
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdate) SetImpersonationAuditID(v uint32) *AdminOperationLogUpdate {
+	_u.mutation.ResetImpersonationAuditID()
+	_u.mutation.SetImpersonationAuditID(v)
+	return _u
+}
+
+// SetNillableImpersonationAuditID sets the "impersonation_audit_id" field if the given value is not nil.
+func (_u *AdminOperationLogUpdate) SetNillableImpersonationAuditID(v *uint32) *AdminOperationLogUpdate {
+	if v != nil {
+		_u.SetImpersonationAuditID(*v)
+	}
+	return _u
+}
+
+// AddImpersonationAuditID adds value to the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdate) AddImpersonationAuditID(v int32) *AdminOperationLogUpdate {
+	_u.mutation.AddImpersonationAuditID(v)
+	return _u
+}
+
+// ClearImpersonationAuditID clears the value of the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdate) ClearImpersonationAuditID() *AdminOperationLogUpdate {
+	_u.mutation.ClearImpersonationAuditID()
+	return _u
+}
+
 // SetStatusCode sets the "status_code" field.
 func (_u *AdminOperationLogUpdate) SetStatusCode(v int32) *AdminOperationLogUpdate {
 	_u.mutation.ResetStatusCode()
@@ -663,6 +757,36 @@ func (_u *AdminOperationLogUpdate) sqlSave(ctx context.Context) (_node int, err
 	if _u.mutation.ClientIPCleared() {
 		_spec.ClearField(adminoperationlog.FieldClientIP, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(adminoperationlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonatorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImpersonationReason(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationReason, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonationReasonCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImpersonationAuditID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonationAuditID(); ok {
+		_spec.AddField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonationAuditIDCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.StatusCode(); ok {
 		_spec.SetField(adminoperationlog.FieldStatusCode, field.TypeInt32, value)
 	}
@@ -1028,6 +1152,100 @@ func (_u *AdminOperationLogUpdateOne) ClearClientIP() *AdminOperationLogUpdateOn
 	return _u
 }
 
+// SetImpersonatorID sets the "impersonator_id" field.
+func (_u *AdminOperationLogUpdateOne) SetImpersonatorID(v uint32) *AdminOperationLogUpdateOne {
+	_u.mutation.ResetImpersonatorID()
+	_u.mutation.SetImpersonatorID(v)
+	return _u
+}
+
+// SetNillableImpersonatorID sets the "impersonator_id" field if the given value is not nil.
+func (_u *AdminOperationLogUpdateOne) SetNillableImpersonatorID(v *uint32) *AdminOperationLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorID(*v)
+	}
+	return _u
+}
+
+// AddImpersonatorID adds value to the "impersonator_id" field.
+func (_u *AdminOperationLogUpdateOne) AddImpersonatorID(v int32) *AdminOperationLogUpdateOne {
+	_u.mutation.AddImpersonatorID(v)
+	return _u
+}
+
+// ClearImpersonatorID clears the value of the "impersonator_id" field.
+func (_u *AdminOperationLogUpdateOne) ClearImpersonatorID() *AdminOperationLogUpdateOne {
+	_u.mutation.ClearImpersonatorID()
+	return _u
+}
+
+// SetImpersonatorName sets the "impersonator_name" field.
+func (_u *AdminOperationLogUpdateOne) SetImpersonatorName(v string) *AdminOperationLogUpdateOne {
+	_u.mutation.SetImpersonatorName(v)
+	return _u
+}
+
+// SetNillableImpersonatorName sets the "impersonator_name" field if the given value is not nil.
+func (_u *AdminOperationLogUpdateOne) SetNillableImpersonatorName(v *string) *AdminOperationLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonatorName(*v)
+	}
+	return _u
+}
+
+// ClearImpersonatorName clears the value of the "impersonator_name" field.
+func (_u *AdminOperationLogUpdateOne) ClearImpersonatorName() *AdminOperationLogUpdateOne {
+	_u.mutation.ClearImpersonatorName()
+	return _u
+}
+
+// SetImpersonationReason sets the "impersonation_reason" field.
+func (_u *AdminOperationLogUpdateOne) SetImpersonationReason(v string) *AdminOperationLogUpdateOne {
+	_u.mutation.SetImpersonationReason(v)
+	return _u
+}
+
+// SetNillableImpersonationReason sets the "impersonation_reason" field if the given value is not nil.
+func (_u *AdminOperationLogUpdateOne) SetNillableImpersonationReason(v *string) *AdminOperationLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonationReason(*v)
+	}
+	return _u
+}
+
+// ClearImpersonationReason clears the value of the "impersonation_reason" field.
+func (_u *AdminOperationLogUpdateOne) ClearImpersonationReason() *AdminOperationLogUpdateOne {
+	_u.mutation.ClearImpersonationReason()
+	return _u
+}
+
+// SetImpersonationAuditID sets the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdateOne) SetImpersonationAuditID(v uint32) *AdminOperationLogUpdateOne {
+	_u.mutation.ResetImpersonationAuditID()
+	_u.mutation.SetImpersonationAuditID(v)
+	return _u
+}
+
+// SetNillableImpersonationAuditID sets the "impersonation_audit_id" field if the given value is not nil.
+func (_u *AdminOperationLogUpdateOne) SetNillableImpersonationAuditID(v *uint32) *AdminOperationLogUpdateOne {
+	if v != nil {
+		_u.SetImpersonationAuditID(*v)
+	}
+	return _u
+}
+
+// AddImpersonationAuditID adds value to the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdateOne) AddImpersonationAuditID(v int32) *AdminOperationLogUpdateOne {
+	_u.mutation.AddImpersonationAuditID(v)
+	return _u
+}
+
+// ClearImpersonationAuditID clears the value of the "impersonation_audit_id" field.
+func (_u *AdminOperationLogUpdateOne) ClearImpersonationAuditID() *AdminOperationLogUpdateOne {
+	_u.mutation.ClearImpersonationAuditID()
+	return _u
+}
+
 // SetStatusCode sets the "status_code" field.
 func (_u *AdminOperationLogUpdateOne) SetStatusCode(v int32) *AdminOperationLogUpdateOne {
 	_u.mutation.ResetStatusCode()
@@ -1419,6 +1637,36 @@ func (_u *AdminOperationLogUpdateOne) sqlSave(ctx context.Context) (_node *Admin
 	if _u.mutation.ClientIPCleared() {
 		_spec.ClearField(adminoperationlog.FieldClientIP, field.TypeString)
 	}
+	if value, ok := _u.mutation.ImpersonatorID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonatorID(); ok {
+		_spec.AddField(adminoperationlog.FieldImpersonatorID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonatorIDCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonatorID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ImpersonatorName(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonatorName, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonatorNameCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonatorName, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImpersonationReason(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationReason, field.TypeString, value)
+	}
+	if _u.mutation.ImpersonationReasonCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonationReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.ImpersonationAuditID(); ok {
+		_spec.SetField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedImpersonationAuditID(); ok {
+		_spec.AddField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32, value)
+	}
+	if _u.mutation.ImpersonationAuditIDCleared() {
+		_spec.ClearField(adminoperationlog.FieldImpersonationAuditID, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.StatusCode(); ok {
 		_spec.SetField(adminoperationlog.FieldStatusCode, field.TypeInt32, value)
 	}
@@ -15,15 +15,19 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/adminloginrestriction"
 	"go-wind-admin/app/admin/service/internal/data/ent/adminoperationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiresource"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
 	"go-wind-admin/app/admin/service/internal/data/ent/department"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
 	"go-wind-admin/app/admin/service/internal/data/ent/organization"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
@@ -32,10 +36,15 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemenu"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleorg"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 
@@ -58,6 +67,10 @@ type Client struct {
 	AdminOperationLog *AdminOperationLogClient
 	// ApiResource is the client for interacting with the ApiResource builders.
 	ApiResource *ApiResourceClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// ConversationMember is the client for interacting with the ConversationMember builders.
+	ConversationMember *ConversationMemberClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// DictEntry is the client for interacting with the DictEntry builders.
@@ -70,12 +83,16 @@ type Client struct {
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
 	InternalMessageCategory *InternalMessageCategoryClient
+	// InternalMessageDelivery is the client for interacting with the InternalMessageDelivery builders.
+	InternalMessageDelivery *InternalMessageDeliveryClient
 	// InternalMessageRecipient is the client for interacting with the InternalMessageRecipient builders.
 	InternalMessageRecipient *InternalMessageRecipientClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// MessageTemplate is the client for interacting with the MessageTemplate builders.
+	MessageTemplate *MessageTemplateClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// Position is the client for interacting with the Position builders.
@@ -92,14 +109,24 @@ type Client struct {
 	RoleOrg *RoleOrgClient
 	// RolePosition is the client for interacting with the RolePosition builders.
 	RolePosition *RolePositionClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantPlan is the client for interacting with the TenantPlan builders.
+	TenantPlan *TenantPlanClient
+	// TenantUsage is the client for interacting with the TenantUsage builders.
+	TenantUsage *TenantUsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserCredential is the client for interacting with the UserCredential builders.
 	UserCredential *UserCredentialClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// UserPosition is the client for interacting with the UserPosition builders.
 	UserPosition *UserPositionClient
 	// UserRole is the client for interacting with the UserRole builders.
@@ -119,15 +146,19 @@ func (c *Client) init() {
 	c.AdminLoginRestriction = NewAdminLoginRestrictionClient(c.config)
 	c.AdminOperationLog = NewAdminOperationLogClient(c.config)
 	c.ApiResource = NewApiResourceClient(c.config)
+	c.Conversation = NewConversationClient(c.config)
+	c.ConversationMember = NewConversationMemberClient(c.config)
 	c.Department = NewDepartmentClient(c.config)
 	c.DictEntry = NewDictEntryClient(c.config)
 	c.DictType = NewDictTypeClient(c.config)
 	c.File = NewFileClient(c.config)
 	c.InternalMessage = NewInternalMessageClient(c.config)
 	c.InternalMessageCategory = NewInternalMessageCategoryClient(c.config)
+	c.InternalMessageDelivery = NewInternalMessageDeliveryClient(c.config)
 	c.InternalMessageRecipient = NewInternalMessageRecipientClient(c.config)
 	c.Language = NewLanguageClient(c.config)
 	c.Menu = NewMenuClient(c.config)
+	c.MessageTemplate = NewMessageTemplateClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
 	c.Position = NewPositionClient(c.config)
 	c.Role = NewRoleClient(c.config)
@@ -136,10 +167,15 @@ func (c *Client) init() {
 	c.RoleMenu = NewRoleMenuClient(c.config)
 	c.RoleOrg = NewRoleOrgClient(c.config)
 	c.RolePosition = NewRolePositionClient(c.config)
+	c.Setting = NewSettingClient(c.config)
 	c.Task = NewTaskClient(c.config)
+	c.TaskRun = NewTaskRunClient(c.config)
 	c.Tenant = NewTenantClient(c.config)
+	c.TenantPlan = NewTenantPlanClient(c.config)
+	c.TenantUsage = NewTenantUsageClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserCredential = NewUserCredentialClient(c.config)
+	c.UserNotificationPreference = NewUserNotificationPreferenceClient(c.config)
 	c.UserPosition = NewUserPositionClient(c.config)
 	c.UserRole = NewUserRoleClient(c.config)
 }
@@ -232,35 +268,44 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:                      ctx,
-		config:                   cfg,
-		AdminLoginLog:            NewAdminLoginLogClient(cfg),
-		AdminLoginRestriction:    NewAdminLoginRestrictionClient(cfg),
-		AdminOperationLog:        NewAdminOperationLogClient(cfg),
-		ApiResource:              NewApiResourceClient(cfg),
-		Department:               NewDepartmentClient(cfg),
-		DictEntry:                NewDictEntryClient(cfg),
-		DictType:                 NewDictTypeClient(cfg),
-		File:                     NewFileClient(cfg),
-		InternalMessage:          NewInternalMessageClient(cfg),
-		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
-		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
-		Language:                 NewLanguageClient(cfg),
-		Menu:                     NewMenuClient(cfg),
-		Organization:             NewOrganizationClient(cfg),
-		Position:                 NewPositionClient(cfg),
-		Role:                     NewRoleClient(cfg),
-		RoleApi:                  NewRoleApiClient(cfg),
-		RoleDept:                 NewRoleDeptClient(cfg),
-		RoleMenu:                 NewRoleMenuClient(cfg),
-		RoleOrg:                  NewRoleOrgClient(cfg),
-		RolePosition:             NewRolePositionClient(cfg),
-		Task:                     NewTaskClient(cfg),
-		Tenant:                   NewTenantClient(cfg),
-		User:                     NewUserClient(cfg),
-		UserCredential:           NewUserCredentialClient(cfg),
-		UserPosition:             NewUserPositionClient(cfg),
-		UserRole:                 NewUserRoleClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		AdminLoginLog:              NewAdminLoginLogClient(cfg),
+		AdminLoginRestriction:      NewAdminLoginRestrictionClient(cfg),
+		AdminOperationLog:          NewAdminOperationLogClient(cfg),
+		ApiResource:                NewApiResourceClient(cfg),
+		Conversation:               NewConversationClient(cfg),
+		ConversationMember:         NewConversationMemberClient(cfg),
+		Department:                 NewDepartmentClient(cfg),
+		DictEntry:                  NewDictEntryClient(cfg),
+		DictType:                   NewDictTypeClient(cfg),
+		File:                       NewFileClient(cfg),
+		InternalMessage:            NewInternalMessageClient(cfg),
+		InternalMessageCategory:    NewInternalMessageCategoryClient(cfg),
+		InternalMessageDelivery:    NewInternalMessageDeliveryClient(cfg),
+		InternalMessageRecipient:   NewInternalMessageRecipientClient(cfg),
+		Language:                   NewLanguageClient(cfg),
+		Menu:                       NewMenuClient(cfg),
+		MessageTemplate:            NewMessageTemplateClient(cfg),
+		Organization:               NewOrganizationClient(cfg),
+		Position:                   NewPositionClient(cfg),
+		Role:                       NewRoleClient(cfg),
+		RoleApi:                    NewRoleApiClient(cfg),
+		RoleDept:                   NewRoleDeptClient(cfg),
+		RoleMenu:                   NewRoleMenuClient(cfg),
+		RoleOrg:                    NewRoleOrgClient(cfg),
+		RolePosition:               NewRolePositionClient(cfg),
+		Setting:                    NewSettingClient(cfg),
+		Task:                       NewTaskClient(cfg),
+		TaskRun:                    NewTaskRunClient(cfg),
+		Tenant:                     NewTenantClient(cfg),
+		TenantPlan:                 NewTenantPlanClient(cfg),
+		TenantUsage:                NewTenantUsageClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserCredential:             NewUserCredentialClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		UserPosition:               NewUserPositionClient(cfg),
+		UserRole:                   NewUserRoleClient(cfg),
 	}, nil
 }
 
@@ -278,35 +323,44 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:                      ctx,
-		config:                   cfg,
-		AdminLoginLog:            NewAdminLoginLogClient(cfg),
-		AdminLoginRestriction:    NewAdminLoginRestrictionClient(cfg),
-		AdminOperationLog:        NewAdminOperationLogClient(cfg),
-		ApiResource:              NewApiResourceClient(cfg),
-		Department:               NewDepartmentClient(cfg),
-		DictEntry:                NewDictEntryClient(cfg),
-		DictType:                 NewDictTypeClient(cfg),
-		File:                     NewFileClient(cfg),
-		InternalMessage:          NewInternalMessageClient(cfg),
-		InternalMessageCategory:  NewInternalMessageCategoryClient(cfg),
-		InternalMessageRecipient: NewInternalMessageRecipientClient(cfg),
-		Language:                 NewLanguageClient(cfg),
-		Menu:                     NewMenuClient(cfg),
-		Organization:             NewOrganizationClient(cfg),
-		Position:                 NewPositionClient(cfg),
-		Role:                     NewRoleClient(cfg),
-		RoleApi:                  NewRoleApiClient(cfg),
-		RoleDept:                 NewRoleDeptClient(cfg),
-		RoleMenu:                 NewRoleMenuClient(cfg),
-		RoleOrg:                  NewRoleOrgClient(cfg),
-		RolePosition:             NewRolePositionClient(cfg),
-		Task:                     NewTaskClient(cfg),
-		Tenant:                   NewTenantClient(cfg),
-		User:                     NewUserClient(cfg),
-		UserCredential:           NewUserCredentialClient(cfg),
-		UserPosition:             NewUserPositionClient(cfg),
-		UserRole:                 NewUserRoleClient(cfg),
+		ctx:                        ctx,
+		config:                     cfg,
+		AdminLoginLog:              NewAdminLoginLogClient(cfg),
+		AdminLoginRestriction:      NewAdminLoginRestrictionClient(cfg),
+		AdminOperationLog:          NewAdminOperationLogClient(cfg),
+		ApiResource:                NewApiResourceClient(cfg),
+		Conversation:               NewConversationClient(cfg),
+		ConversationMember:         NewConversationMemberClient(cfg),
+		Department:                 NewDepartmentClient(cfg),
+		DictEntry:                  NewDictEntryClient(cfg),
+		DictType:                   NewDictTypeClient(cfg),
+		File:                       NewFileClient(cfg),
+		InternalMessage:            NewInternalMessageClient(cfg),
+		InternalMessageCategory:    NewInternalMessageCategoryClient(cfg),
+		InternalMessageDelivery:    NewInternalMessageDeliveryClient(cfg),
+		InternalMessageRecipient:   NewInternalMessageRecipientClient(cfg),
+		Language:                   NewLanguageClient(cfg),
+		Menu:                       NewMenuClient(cfg),
+		MessageTemplate:            NewMessageTemplateClient(cfg),
+		Organization:               NewOrganizationClient(cfg),
+		Position:                   NewPositionClient(cfg),
+		Role:                       NewRoleClient(cfg),
+		RoleApi:                    NewRoleApiClient(cfg),
+		RoleDept:                   NewRoleDeptClient(cfg),
+		RoleMenu:                   NewRoleMenuClient(cfg),
+		RoleOrg:                    NewRoleOrgClient(cfg),
+		RolePosition:               NewRolePositionClient(cfg),
+		Setting:                    NewSettingClient(cfg),
+		Task:                       NewTaskClient(cfg),
+		TaskRun:                    NewTaskRunClient(cfg),
+		Tenant:                     NewTenantClient(cfg),
+		TenantPlan:                 NewTenantPlanClient(cfg),
+		TenantUsage:                NewTenantUsageClient(cfg),
+		User:                       NewUserClient(cfg),
+		UserCredential:             NewUserCredentialClient(cfg),
+		UserNotificationPreference: NewUserNotificationPreferenceClient(cfg),
+		UserPosition:               NewUserPositionClient(cfg),
+		UserRole:                   NewUserRoleClient(cfg),
 	}, nil
 }
 
@@ -337,11 +391,13 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.AdminLoginLog, c.AdminLoginRestriction, c.AdminOperationLog, c.ApiResource,
-		c.Department, c.DictEntry, c.DictType, c.File, c.InternalMessage,
-		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language, c.Menu,
-		c.Organization, c.Position, c.Role, c.RoleApi, c.RoleDept, c.RoleMenu,
-		c.RoleOrg, c.RolePosition, c.Task, c.Tenant, c.User, c.UserCredential,
-		c.UserPosition, c.UserRole,
+		c.Conversation, c.ConversationMember, c.Department, c.DictEntry, c.DictType,
+		c.File, c.InternalMessage, c.InternalMessageCategory,
+		c.InternalMessageDelivery, c.InternalMessageRecipient, c.Language, c.Menu,
+		c.MessageTemplate, c.Organization, c.Position, c.Role, c.RoleApi, c.RoleDept,
+		c.RoleMenu, c.RoleOrg, c.RolePosition, c.Setting, c.Task, c.TaskRun, c.Tenant,
+		c.TenantPlan, c.TenantUsage, c.User, c.UserCredential,
+		c.UserNotificationPreference, c.UserPosition, c.UserRole,
 	} {
 		n.Use(hooks...)
 	}
@@ -352,11 +408,13 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.AdminLoginLog, c.AdminLoginRestriction, c.AdminOperationLog, c.ApiResource,
-		c.Department, c.DictEntry, c.DictType, c.File, c.InternalMessage,
-		c.InternalMessageCategory, c.InternalMessageRecipient, c.Language, c.Menu,
-		c.Organization, c.Position, c.Role, c.RoleApi, c.RoleDept, c.RoleMenu,
-		c.RoleOrg, c.RolePosition, c.Task, c.Tenant, c.User, c.UserCredential,
-		c.UserPosition, c.UserRole,
+		c.Conversation, c.ConversationMember, c.Department, c.DictEntry, c.DictType,
+		c.File, c.InternalMessage, c.InternalMessageCategory,
+		c.InternalMessageDelivery, c.InternalMessageRecipient, c.Language, c.Menu,
+		c.MessageTemplate, c.Organization, c.Position, c.Role, c.RoleApi, c.RoleDept,
+		c.RoleMenu, c.RoleOrg, c.RolePosition, c.Setting, c.Task, c.TaskRun, c.Tenant,
+		c.TenantPlan, c.TenantUsage, c.User, c.UserCredential,
+		c.UserNotificationPreference, c.UserPosition, c.UserRole,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -373,6 +431,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AdminOperationLog.mutate(ctx, m)
 	case *ApiResourceMutation:
 		return c.ApiResource.mutate(ctx, m)
+	case *ConversationMutation:
+		return c.Conversation.mutate(ctx, m)
+	case *ConversationMemberMutation:
+		return c.ConversationMember.mutate(ctx, m)
 	case *DepartmentMutation:
 		return c.Department.mutate(ctx, m)
 	case *DictEntryMutation:
@@ -385,12 +447,16 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.InternalMessage.mutate(ctx, m)
 	case *InternalMessageCategoryMutation:
 		return c.InternalMessageCategory.mutate(ctx, m)
+	case *InternalMessageDeliveryMutation:
+		return c.InternalMessageDelivery.mutate(ctx, m)
 	case *InternalMessageRecipientMutation:
 		return c.InternalMessageRecipient.mutate(ctx, m)
 	case *LanguageMutation:
 		return c.Language.mutate(ctx, m)
 	case *MenuMutation:
 		return c.Menu.mutate(ctx, m)
+	case *MessageTemplateMutation:
+		return c.MessageTemplate.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
 	case *PositionMutation:
@@ -407,14 +473,24 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.RoleOrg.mutate(ctx, m)
 	case *RolePositionMutation:
 		return c.RolePosition.mutate(ctx, m)
+	case *SettingMutation:
+		return c.Setting.mutate(ctx, m)
 	case *TaskMutation:
 		return c.Task.mutate(ctx, m)
+	case *TaskRunMutation:
+		return c.TaskRun.mutate(ctx, m)
 	case *TenantMutation:
 		return c.Tenant.mutate(ctx, m)
+	case *TenantPlanMutation:
+		return c.TenantPlan.mutate(ctx, m)
+	case *TenantUsageMutation:
+		return c.TenantUsage.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserCredentialMutation:
 		return c.UserCredential.mutate(ctx, m)
+	case *UserNotificationPreferenceMutation:
+		return c.UserNotificationPreference.mutate(ctx, m)
 	case *UserPositionMutation:
 		return c.UserPosition.mutate(ctx, m)
 	case *UserRoleMutation:
@@ -956,6 +1032,274 @@ func (c *ApiResourceClient) mutate(ctx context.Context, m *ApiResourceMutation)
 	}
 }
 
+// ConversationClient is a client for the Conversation schema.
+type ConversationClient struct {
+	config
+}
+
+// NewConversationClient returns a client for the Conversation from the given config.
+func NewConversationClient(c config) *ConversationClient {
+	return &ConversationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversation.Hooks(f(g(h())))`.
+func (c *ConversationClient) Use(hooks ...Hook) {
+	c.hooks.Conversation = append(c.hooks.Conversation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversation.Intercept(f(g(h())))`.
+func (c *ConversationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Conversation = append(c.inters.Conversation, interceptors...)
+}
+
+// Create returns a builder for creating a Conversation entity.
+func (c *ConversationClient) Create() *ConversationCreate {
+	mutation := newConversationMutation(c.config, OpCreate)
+	return &ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Conversation entities.
+func (c *ConversationClient) CreateBulk(builders ...*ConversationCreate) *ConversationCreateBulk {
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationClient) MapCreateBulk(slice any, setFunc func(*ConversationCreate, int)) *ConversationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationCreateBulk{err: fmt.Errorf("calling to ConversationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Conversation.
+func (c *ConversationClient) Update() *ConversationUpdate {
+	mutation := newConversationMutation(c.config, OpUpdate)
+	return &ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationClient) UpdateOne(_m *Conversation) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversation(_m))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationClient) UpdateOneID(id uint32) *ConversationUpdateOne {
+	mutation := newConversationMutation(c.config, OpUpdateOne, withConversationID(id))
+	return &ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Conversation.
+func (c *ConversationClient) Delete() *ConversationDelete {
+	mutation := newConversationMutation(c.config, OpDelete)
+	return &ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationClient) DeleteOne(_m *Conversation) *ConversationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationClient) DeleteOneID(id uint32) *ConversationDeleteOne {
+	builder := c.Delete().Where(conversation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationDeleteOne{builder}
+}
+
+// Query returns a query builder for Conversation.
+func (c *ConversationClient) Query() *ConversationQuery {
+	return &ConversationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Conversation entity by its id.
+func (c *ConversationClient) Get(ctx context.Context, id uint32) (*Conversation, error) {
+	return c.Query().Where(conversation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationClient) GetX(ctx context.Context, id uint32) *Conversation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationClient) Hooks() []Hook {
+	hooks := c.hooks.Conversation
+	return append(hooks[:len(hooks):len(hooks)], conversation.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationClient) Interceptors() []Interceptor {
+	return c.inters.Conversation
+}
+
+func (c *ConversationClient) mutate(ctx context.Context, m *ConversationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Conversation mutation op: %q", m.Op())
+	}
+}
+
+// ConversationMemberClient is a client for the ConversationMember schema.
+type ConversationMemberClient struct {
+	config
+}
+
+// NewConversationMemberClient returns a client for the ConversationMember from the given config.
+func NewConversationMemberClient(c config) *ConversationMemberClient {
+	return &ConversationMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversationmember.Hooks(f(g(h())))`.
+func (c *ConversationMemberClient) Use(hooks ...Hook) {
+	c.hooks.ConversationMember = append(c.hooks.ConversationMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversationmember.Intercept(f(g(h())))`.
+func (c *ConversationMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationMember = append(c.inters.ConversationMember, interceptors...)
+}
+
+// Create returns a builder for creating a ConversationMember entity.
+func (c *ConversationMemberClient) Create() *ConversationMemberCreate {
+	mutation := newConversationMemberMutation(c.config, OpCreate)
+	return &ConversationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConversationMember entities.
+func (c *ConversationMemberClient) CreateBulk(builders ...*ConversationMemberCreate) *ConversationMemberCreateBulk {
+	return &ConversationMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationMemberClient) MapCreateBulk(slice any, setFunc func(*ConversationMemberCreate, int)) *ConversationMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationMemberCreateBulk{err: fmt.Errorf("calling to ConversationMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConversationMember.
+func (c *ConversationMemberClient) Update() *ConversationMemberUpdate {
+	mutation := newConversationMemberMutation(c.config, OpUpdate)
+	return &ConversationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationMemberClient) UpdateOne(_m *ConversationMember) *ConversationMemberUpdateOne {
+	mutation := newConversationMemberMutation(c.config, OpUpdateOne, withConversationMember(_m))
+	return &ConversationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationMemberClient) UpdateOneID(id uint32) *ConversationMemberUpdateOne {
+	mutation := newConversationMemberMutation(c.config, OpUpdateOne, withConversationMemberID(id))
+	return &ConversationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConversationMember.
+func (c *ConversationMemberClient) Delete() *ConversationMemberDelete {
+	mutation := newConversationMemberMutation(c.config, OpDelete)
+	return &ConversationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationMemberClient) DeleteOne(_m *ConversationMember) *ConversationMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationMemberClient) DeleteOneID(id uint32) *ConversationMemberDeleteOne {
+	builder := c.Delete().Where(conversationmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for ConversationMember.
+func (c *ConversationMemberClient) Query() *ConversationMemberQuery {
+	return &ConversationMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversationMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConversationMember entity by its id.
+func (c *ConversationMemberClient) Get(ctx context.Context, id uint32) (*ConversationMember, error) {
+	return c.Query().Where(conversationmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationMemberClient) GetX(ctx context.Context, id uint32) *ConversationMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationMemberClient) Hooks() []Hook {
+	hooks := c.hooks.ConversationMember
+	return append(hooks[:len(hooks):len(hooks)], conversationmember.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationMemberClient) Interceptors() []Interceptor {
+	return c.inters.ConversationMember
+}
+
+func (c *ConversationMemberClient) mutate(ctx context.Context, m *ConversationMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConversationMember mutation op: %q", m.Op())
+	}
+}
+
 // DepartmentClient is a client for the Department schema.
 type DepartmentClient struct {
 	config
@@ -1098,7 +1442,8 @@ func (c *DepartmentClient) QueryChildren(_m *Department) *DepartmentQuery {
 
 // Hooks returns the client hooks.
 func (c *DepartmentClient) Hooks() []Hook {
-	return c.hooks.Department
+	hooks := c.hooks.Department
+	return append(hooks[:len(hooks):len(hooks)], department.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1247,7 +1592,8 @@ func (c *DictEntryClient) QuerySysDictTypes(_m *DictEntry) *DictTypeQuery {
 
 // Hooks returns the client hooks.
 func (c *DictEntryClient) Hooks() []Hook {
-	return c.hooks.DictEntry
+	hooks := c.hooks.DictEntry
+	return append(hooks[:len(hooks):len(hooks)], dictentry.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1396,7 +1742,8 @@ func (c *DictTypeClient) QueryEntries(_m *DictType) *DictEntryQuery {
 
 // Hooks returns the client hooks.
 func (c *DictTypeClient) Hooks() []Hook {
-	return c.hooks.DictType
+	hooks := c.hooks.DictType
+	return append(hooks[:len(hooks):len(hooks)], dicttype.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1529,7 +1876,8 @@ func (c *FileClient) GetX(ctx context.Context, id uint32) *File {
 
 // Hooks returns the client hooks.
 func (c *FileClient) Hooks() []Hook {
-	return c.hooks.File
+	hooks := c.hooks.File
+	return append(hooks[:len(hooks):len(hooks)], file.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1662,7 +2010,8 @@ func (c *InternalMessageClient) GetX(ctx context.Context, id uint32) *InternalMe
 
 // Hooks returns the client hooks.
 func (c *InternalMessageClient) Hooks() []Hook {
-	return c.hooks.InternalMessage
+	hooks := c.hooks.InternalMessage
+	return append(hooks[:len(hooks):len(hooks)], internalmessage.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1827,7 +2176,8 @@ func (c *InternalMessageCategoryClient) QueryChildren(_m *InternalMessageCategor
 
 // Hooks returns the client hooks.
 func (c *InternalMessageCategoryClient) Hooks() []Hook {
-	return c.hooks.InternalMessageCategory
+	hooks := c.hooks.InternalMessageCategory
+	return append(hooks[:len(hooks):len(hooks)], internalmessagecategory.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -1850,6 +2200,140 @@ func (c *InternalMessageCategoryClient) mutate(ctx context.Context, m *InternalM
 	}
 }
 
+// InternalMessageDeliveryClient is a client for the InternalMessageDelivery schema.
+type InternalMessageDeliveryClient struct {
+	config
+}
+
+// NewInternalMessageDeliveryClient returns a client for the InternalMessageDelivery from the given config.
+func NewInternalMessageDeliveryClient(c config) *InternalMessageDeliveryClient {
+	return &InternalMessageDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `internalmessagedelivery.Hooks(f(g(h())))`.
+func (c *InternalMessageDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.InternalMessageDelivery = append(c.hooks.InternalMessageDelivery, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `internalmessagedelivery.Intercept(f(g(h())))`.
+func (c *InternalMessageDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.InternalMessageDelivery = append(c.inters.InternalMessageDelivery, interceptors...)
+}
+
+// Create returns a builder for creating a InternalMessageDelivery entity.
+func (c *InternalMessageDeliveryClient) Create() *InternalMessageDeliveryCreate {
+	mutation := newInternalMessageDeliveryMutation(c.config, OpCreate)
+	return &InternalMessageDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of InternalMessageDelivery entities.
+func (c *InternalMessageDeliveryClient) CreateBulk(builders ...*InternalMessageDeliveryCreate) *InternalMessageDeliveryCreateBulk {
+	return &InternalMessageDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *InternalMessageDeliveryClient) MapCreateBulk(slice any, setFunc func(*InternalMessageDeliveryCreate, int)) *InternalMessageDeliveryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &InternalMessageDeliveryCreateBulk{err: fmt.Errorf("calling to InternalMessageDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*InternalMessageDeliveryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &InternalMessageDeliveryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for InternalMessageDelivery.
+func (c *InternalMessageDeliveryClient) Update() *InternalMessageDeliveryUpdate {
+	mutation := newInternalMessageDeliveryMutation(c.config, OpUpdate)
+	return &InternalMessageDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *InternalMessageDeliveryClient) UpdateOne(_m *InternalMessageDelivery) *InternalMessageDeliveryUpdateOne {
+	mutation := newInternalMessageDeliveryMutation(c.config, OpUpdateOne, withInternalMessageDelivery(_m))
+	return &InternalMessageDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *InternalMessageDeliveryClient) UpdateOneID(id uint32) *InternalMessageDeliveryUpdateOne {
+	mutation := newInternalMessageDeliveryMutation(c.config, OpUpdateOne, withInternalMessageDeliveryID(id))
+	return &InternalMessageDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for InternalMessageDelivery.
+func (c *InternalMessageDeliveryClient) Delete() *InternalMessageDeliveryDelete {
+	mutation := newInternalMessageDeliveryMutation(c.config, OpDelete)
+	return &InternalMessageDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *InternalMessageDeliveryClient) DeleteOne(_m *InternalMessageDelivery) *InternalMessageDeliveryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *InternalMessageDeliveryClient) DeleteOneID(id uint32) *InternalMessageDeliveryDeleteOne {
+	builder := c.Delete().Where(internalmessagedelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &InternalMessageDeliveryDeleteOne{builder}
+}
+
+// Query returns a query builder for InternalMessageDelivery.
+func (c *InternalMessageDeliveryClient) Query() *InternalMessageDeliveryQuery {
+	return &InternalMessageDeliveryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeInternalMessageDelivery},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a InternalMessageDelivery entity by its id.
+func (c *InternalMessageDeliveryClient) Get(ctx context.Context, id uint32) (*InternalMessageDelivery, error) {
+	return c.Query().Where(internalmessagedelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *InternalMessageDeliveryClient) GetX(ctx context.Context, id uint32) *InternalMessageDelivery {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *InternalMessageDeliveryClient) Hooks() []Hook {
+	hooks := c.hooks.InternalMessageDelivery
+	return append(hooks[:len(hooks):len(hooks)], internalmessagedelivery.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *InternalMessageDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.InternalMessageDelivery
+}
+
+func (c *InternalMessageDeliveryClient) mutate(ctx context.Context, m *InternalMessageDeliveryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&InternalMessageDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&InternalMessageDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&InternalMessageDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&InternalMessageDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown InternalMessageDelivery mutation op: %q", m.Op())
+	}
+}
+
 // InternalMessageRecipientClient is a client for the InternalMessageRecipient schema.
 type InternalMessageRecipientClient struct {
 	config
@@ -1960,7 +2444,8 @@ func (c *InternalMessageRecipientClient) GetX(ctx context.Context, id uint32) *I
 
 // Hooks returns the client hooks.
 func (c *InternalMessageRecipientClient) Hooks() []Hook {
-	return c.hooks.InternalMessageRecipient
+	hooks := c.hooks.InternalMessageRecipient
+	return append(hooks[:len(hooks):len(hooks)], internalmessagerecipient.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2281,69 +2766,203 @@ func (c *MenuClient) mutate(ctx context.Context, m *MenuMutation) (Value, error)
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// MessageTemplateClient is a client for the MessageTemplate schema.
+type MessageTemplateClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewMessageTemplateClient returns a client for the MessageTemplate from the given config.
+func NewMessageTemplateClient(c config) *MessageTemplateClient {
+	return &MessageTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `messagetemplate.Hooks(f(g(h())))`.
+func (c *MessageTemplateClient) Use(hooks ...Hook) {
+	c.hooks.MessageTemplate = append(c.hooks.MessageTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `messagetemplate.Intercept(f(g(h())))`.
+func (c *MessageTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MessageTemplate = append(c.inters.MessageTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a MessageTemplate entity.
+func (c *MessageTemplateClient) Create() *MessageTemplateCreate {
+	mutation := newMessageTemplateMutation(c.config, OpCreate)
+	return &MessageTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of MessageTemplate entities.
+func (c *MessageTemplateClient) CreateBulk(builders ...*MessageTemplateCreate) *MessageTemplateCreateBulk {
+	return &MessageTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *MessageTemplateClient) MapCreateBulk(slice any, setFunc func(*MessageTemplateCreate, int)) *MessageTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &MessageTemplateCreateBulk{err: fmt.Errorf("calling to MessageTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*MessageTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &MessageTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for MessageTemplate.
+func (c *MessageTemplateClient) Update() *MessageTemplateUpdate {
+	mutation := newMessageTemplateMutation(c.config, OpUpdate)
+	return &MessageTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *MessageTemplateClient) UpdateOne(_m *MessageTemplate) *MessageTemplateUpdateOne {
+	mutation := newMessageTemplateMutation(c.config, OpUpdateOne, withMessageTemplate(_m))
+	return &MessageTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id uint32) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+func (c *MessageTemplateClient) UpdateOneID(id uint32) *MessageTemplateUpdateOne {
+	mutation := newMessageTemplateMutation(c.config, OpUpdateOne, withMessageTemplateID(id))
+	return &MessageTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MessageTemplate.
+func (c *MessageTemplateClient) Delete() *MessageTemplateDelete {
+	mutation := newMessageTemplateMutation(c.config, OpDelete)
+	return &MessageTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MessageTemplateClient) DeleteOne(_m *MessageTemplate) *MessageTemplateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MessageTemplateClient) DeleteOneID(id uint32) *MessageTemplateDeleteOne {
+	builder := c.Delete().Where(messagetemplate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MessageTemplateDeleteOne{builder}
+}
+
+// Query returns a query builder for MessageTemplate.
+func (c *MessageTemplateClient) Query() *MessageTemplateQuery {
+	return &MessageTemplateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMessageTemplate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MessageTemplate entity by its id.
+func (c *MessageTemplateClient) Get(ctx context.Context, id uint32) (*MessageTemplate, error) {
+	return c.Query().Where(messagetemplate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MessageTemplateClient) GetX(ctx context.Context, id uint32) *MessageTemplate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *MessageTemplateClient) Hooks() []Hook {
+	hooks := c.hooks.MessageTemplate
+	return append(hooks[:len(hooks):len(hooks)], messagetemplate.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *MessageTemplateClient) Interceptors() []Interceptor {
+	return c.inters.MessageTemplate
+}
+
+func (c *MessageTemplateClient) mutate(ctx context.Context, m *MessageTemplateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MessageTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MessageTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MessageTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MessageTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MessageTemplate mutation op: %q", m.Op())
+	}
+}
+
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
+	config
+}
+
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
+}
+
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrganizationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrganizationClient) UpdateOneID(id uint32) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
 	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
@@ -2423,7 +3042,8 @@ func (c *OrganizationClient) QueryChildren(_m *Organization) *OrganizationQuery
 
 // Hooks returns the client hooks.
 func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+	hooks := c.hooks.Organization
+	return append(hooks[:len(hooks):len(hooks)], organization.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2588,7 +3208,8 @@ func (c *PositionClient) QueryChildren(_m *Position) *PositionQuery {
 
 // Hooks returns the client hooks.
 func (c *PositionClient) Hooks() []Hook {
-	return c.hooks.Position
+	hooks := c.hooks.Position
+	return append(hooks[:len(hooks):len(hooks)], position.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -2753,7 +3374,8 @@ func (c *RoleClient) QueryChildren(_m *Role) *RoleQuery {
 
 // Hooks returns the client hooks.
 func (c *RoleClient) Hooks() []Hook {
-	return c.hooks.Role
+	hooks := c.hooks.Role
+	return append(hooks[:len(hooks):len(hooks)], role.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -3441,6 +4063,140 @@ func (c *RolePositionClient) mutate(ctx context.Context, m *RolePositionMutation
 	}
 }
 
+// SettingClient is a client for the Setting schema.
+type SettingClient struct {
+	config
+}
+
+// NewSettingClient returns a client for the Setting from the given config.
+func NewSettingClient(c config) *SettingClient {
+	return &SettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `setting.Hooks(f(g(h())))`.
+func (c *SettingClient) Use(hooks ...Hook) {
+	c.hooks.Setting = append(c.hooks.Setting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `setting.Intercept(f(g(h())))`.
+func (c *SettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Setting = append(c.inters.Setting, interceptors...)
+}
+
+// Create returns a builder for creating a Setting entity.
+func (c *SettingClient) Create() *SettingCreate {
+	mutation := newSettingMutation(c.config, OpCreate)
+	return &SettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Setting entities.
+func (c *SettingClient) CreateBulk(builders ...*SettingCreate) *SettingCreateBulk {
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SettingClient) MapCreateBulk(slice any, setFunc func(*SettingCreate, int)) *SettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SettingCreateBulk{err: fmt.Errorf("calling to SettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Setting.
+func (c *SettingClient) Update() *SettingUpdate {
+	mutation := newSettingMutation(c.config, OpUpdate)
+	return &SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SettingClient) UpdateOne(_m *Setting) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSetting(_m))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SettingClient) UpdateOneID(id uint32) *SettingUpdateOne {
+	mutation := newSettingMutation(c.config, OpUpdateOne, withSettingID(id))
+	return &SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Setting.
+func (c *SettingClient) Delete() *SettingDelete {
+	mutation := newSettingMutation(c.config, OpDelete)
+	return &SettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SettingClient) DeleteOne(_m *Setting) *SettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SettingClient) DeleteOneID(id uint32) *SettingDeleteOne {
+	builder := c.Delete().Where(setting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SettingDeleteOne{builder}
+}
+
+// Query returns a query builder for Setting.
+func (c *SettingClient) Query() *SettingQuery {
+	return &SettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Setting entity by its id.
+func (c *SettingClient) Get(ctx context.Context, id uint32) (*Setting, error) {
+	return c.Query().Where(setting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SettingClient) GetX(ctx context.Context, id uint32) *Setting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SettingClient) Hooks() []Hook {
+	hooks := c.hooks.Setting
+	return append(hooks[:len(hooks):len(hooks)], setting.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *SettingClient) Interceptors() []Interceptor {
+	return c.inters.Setting
+}
+
+func (c *SettingClient) mutate(ctx context.Context, m *SettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Setting mutation op: %q", m.Op())
+	}
+}
+
 // TaskClient is a client for the Task schema.
 type TaskClient struct {
 	config
@@ -3551,7 +4307,8 @@ func (c *TaskClient) GetX(ctx context.Context, id uint32) *Task {
 
 // Hooks returns the client hooks.
 func (c *TaskClient) Hooks() []Hook {
-	return c.hooks.Task
+	hooks := c.hooks.Task
+	return append(hooks[:len(hooks):len(hooks)], task.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -3574,6 +4331,140 @@ func (c *TaskClient) mutate(ctx context.Context, m *TaskMutation) (Value, error)
 	}
 }
 
+// TaskRunClient is a client for the TaskRun schema.
+type TaskRunClient struct {
+	config
+}
+
+// NewTaskRunClient returns a client for the TaskRun from the given config.
+func NewTaskRunClient(c config) *TaskRunClient {
+	return &TaskRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taskrun.Hooks(f(g(h())))`.
+func (c *TaskRunClient) Use(hooks ...Hook) {
+	c.hooks.TaskRun = append(c.hooks.TaskRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taskrun.Intercept(f(g(h())))`.
+func (c *TaskRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaskRun = append(c.inters.TaskRun, interceptors...)
+}
+
+// Create returns a builder for creating a TaskRun entity.
+func (c *TaskRunClient) Create() *TaskRunCreate {
+	mutation := newTaskRunMutation(c.config, OpCreate)
+	return &TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaskRun entities.
+func (c *TaskRunClient) CreateBulk(builders ...*TaskRunCreate) *TaskRunCreateBulk {
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaskRunClient) MapCreateBulk(slice any, setFunc func(*TaskRunCreate, int)) *TaskRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaskRunCreateBulk{err: fmt.Errorf("calling to TaskRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaskRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaskRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaskRun.
+func (c *TaskRunClient) Update() *TaskRunUpdate {
+	mutation := newTaskRunMutation(c.config, OpUpdate)
+	return &TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaskRunClient) UpdateOne(_m *TaskRun) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRun(_m))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaskRunClient) UpdateOneID(id uint32) *TaskRunUpdateOne {
+	mutation := newTaskRunMutation(c.config, OpUpdateOne, withTaskRunID(id))
+	return &TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaskRun.
+func (c *TaskRunClient) Delete() *TaskRunDelete {
+	mutation := newTaskRunMutation(c.config, OpDelete)
+	return &TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaskRunClient) DeleteOne(_m *TaskRun) *TaskRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaskRunClient) DeleteOneID(id uint32) *TaskRunDeleteOne {
+	builder := c.Delete().Where(taskrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaskRunDeleteOne{builder}
+}
+
+// Query returns a query builder for TaskRun.
+func (c *TaskRunClient) Query() *TaskRunQuery {
+	return &TaskRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaskRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaskRun entity by its id.
+func (c *TaskRunClient) Get(ctx context.Context, id uint32) (*TaskRun, error) {
+	return c.Query().Where(taskrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaskRunClient) GetX(ctx context.Context, id uint32) *TaskRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaskRunClient) Hooks() []Hook {
+	hooks := c.hooks.TaskRun
+	return append(hooks[:len(hooks):len(hooks)], taskrun.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaskRunClient) Interceptors() []Interceptor {
+	return c.inters.TaskRun
+}
+
+func (c *TaskRunClient) mutate(ctx context.Context, m *TaskRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaskRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaskRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaskRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaskRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaskRun mutation op: %q", m.Op())
+	}
+}
+
 // TenantClient is a client for the Tenant schema.
 type TenantClient struct {
 	config
@@ -3707,6 +4598,273 @@ func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, er
 	}
 }
 
+// TenantPlanClient is a client for the TenantPlan schema.
+type TenantPlanClient struct {
+	config
+}
+
+// NewTenantPlanClient returns a client for the TenantPlan from the given config.
+func NewTenantPlanClient(c config) *TenantPlanClient {
+	return &TenantPlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantplan.Hooks(f(g(h())))`.
+func (c *TenantPlanClient) Use(hooks ...Hook) {
+	c.hooks.TenantPlan = append(c.hooks.TenantPlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantplan.Intercept(f(g(h())))`.
+func (c *TenantPlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantPlan = append(c.inters.TenantPlan, interceptors...)
+}
+
+// Create returns a builder for creating a TenantPlan entity.
+func (c *TenantPlanClient) Create() *TenantPlanCreate {
+	mutation := newTenantPlanMutation(c.config, OpCreate)
+	return &TenantPlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantPlan entities.
+func (c *TenantPlanClient) CreateBulk(builders ...*TenantPlanCreate) *TenantPlanCreateBulk {
+	return &TenantPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantPlanClient) MapCreateBulk(slice any, setFunc func(*TenantPlanCreate, int)) *TenantPlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantPlanCreateBulk{err: fmt.Errorf("calling to TenantPlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantPlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantPlan.
+func (c *TenantPlanClient) Update() *TenantPlanUpdate {
+	mutation := newTenantPlanMutation(c.config, OpUpdate)
+	return &TenantPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantPlanClient) UpdateOne(_m *TenantPlan) *TenantPlanUpdateOne {
+	mutation := newTenantPlanMutation(c.config, OpUpdateOne, withTenantPlan(_m))
+	return &TenantPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantPlanClient) UpdateOneID(id uint32) *TenantPlanUpdateOne {
+	mutation := newTenantPlanMutation(c.config, OpUpdateOne, withTenantPlanID(id))
+	return &TenantPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantPlan.
+func (c *TenantPlanClient) Delete() *TenantPlanDelete {
+	mutation := newTenantPlanMutation(c.config, OpDelete)
+	return &TenantPlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantPlanClient) DeleteOne(_m *TenantPlan) *TenantPlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantPlanClient) DeleteOneID(id uint32) *TenantPlanDeleteOne {
+	builder := c.Delete().Where(tenantplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantPlanDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantPlan.
+func (c *TenantPlanClient) Query() *TenantPlanQuery {
+	return &TenantPlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantPlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantPlan entity by its id.
+func (c *TenantPlanClient) Get(ctx context.Context, id uint32) (*TenantPlan, error) {
+	return c.Query().Where(tenantplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantPlanClient) GetX(ctx context.Context, id uint32) *TenantPlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantPlanClient) Hooks() []Hook {
+	return c.hooks.TenantPlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantPlanClient) Interceptors() []Interceptor {
+	return c.inters.TenantPlan
+}
+
+func (c *TenantPlanClient) mutate(ctx context.Context, m *TenantPlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantPlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantPlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantPlan mutation op: %q", m.Op())
+	}
+}
+
+// TenantUsageClient is a client for the TenantUsage schema.
+type TenantUsageClient struct {
+	config
+}
+
+// NewTenantUsageClient returns a client for the TenantUsage from the given config.
+func NewTenantUsageClient(c config) *TenantUsageClient {
+	return &TenantUsageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenantusage.Hooks(f(g(h())))`.
+func (c *TenantUsageClient) Use(hooks ...Hook) {
+	c.hooks.TenantUsage = append(c.hooks.TenantUsage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenantusage.Intercept(f(g(h())))`.
+func (c *TenantUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TenantUsage = append(c.inters.TenantUsage, interceptors...)
+}
+
+// Create returns a builder for creating a TenantUsage entity.
+func (c *TenantUsageClient) Create() *TenantUsageCreate {
+	mutation := newTenantUsageMutation(c.config, OpCreate)
+	return &TenantUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TenantUsage entities.
+func (c *TenantUsageClient) CreateBulk(builders ...*TenantUsageCreate) *TenantUsageCreateBulk {
+	return &TenantUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantUsageClient) MapCreateBulk(slice any, setFunc func(*TenantUsageCreate, int)) *TenantUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantUsageCreateBulk{err: fmt.Errorf("calling to TenantUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TenantUsage.
+func (c *TenantUsageClient) Update() *TenantUsageUpdate {
+	mutation := newTenantUsageMutation(c.config, OpUpdate)
+	return &TenantUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantUsageClient) UpdateOne(_m *TenantUsage) *TenantUsageUpdateOne {
+	mutation := newTenantUsageMutation(c.config, OpUpdateOne, withTenantUsage(_m))
+	return &TenantUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantUsageClient) UpdateOneID(id uint32) *TenantUsageUpdateOne {
+	mutation := newTenantUsageMutation(c.config, OpUpdateOne, withTenantUsageID(id))
+	return &TenantUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TenantUsage.
+func (c *TenantUsageClient) Delete() *TenantUsageDelete {
+	mutation := newTenantUsageMutation(c.config, OpDelete)
+	return &TenantUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantUsageClient) DeleteOne(_m *TenantUsage) *TenantUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantUsageClient) DeleteOneID(id uint32) *TenantUsageDeleteOne {
+	builder := c.Delete().Where(tenantusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for TenantUsage.
+func (c *TenantUsageClient) Query() *TenantUsageQuery {
+	return &TenantUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenantUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TenantUsage entity by its id.
+func (c *TenantUsageClient) Get(ctx context.Context, id uint32) (*TenantUsage, error) {
+	return c.Query().Where(tenantusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantUsageClient) GetX(ctx context.Context, id uint32) *TenantUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TenantUsageClient) Hooks() []Hook {
+	hooks := c.hooks.TenantUsage
+	return append(hooks[:len(hooks):len(hooks)], tenantusage.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantUsageClient) Interceptors() []Interceptor {
+	return c.inters.TenantUsage
+}
+
+func (c *TenantUsageClient) mutate(ctx context.Context, m *TenantUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TenantUsage mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -3817,7 +4975,8 @@ func (c *UserClient) GetX(ctx context.Context, id uint32) *User {
 
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+	hooks := c.hooks.User
+	return append(hooks[:len(hooks):len(hooks)], user.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -3950,7 +5109,8 @@ func (c *UserCredentialClient) GetX(ctx context.Context, id uint32) *UserCredent
 
 // Hooks returns the client hooks.
 func (c *UserCredentialClient) Hooks() []Hook {
-	return c.hooks.UserCredential
+	hooks := c.hooks.UserCredential
+	return append(hooks[:len(hooks):len(hooks)], usercredential.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -3973,6 +5133,140 @@ func (c *UserCredentialClient) mutate(ctx context.Context, m *UserCredentialMuta
 	}
 }
 
+// UserNotificationPreferenceClient is a client for the UserNotificationPreference schema.
+type UserNotificationPreferenceClient struct {
+	config
+}
+
+// NewUserNotificationPreferenceClient returns a client for the UserNotificationPreference from the given config.
+func NewUserNotificationPreferenceClient(c config) *UserNotificationPreferenceClient {
+	return &UserNotificationPreferenceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usernotificationpreference.Hooks(f(g(h())))`.
+func (c *UserNotificationPreferenceClient) Use(hooks ...Hook) {
+	c.hooks.UserNotificationPreference = append(c.hooks.UserNotificationPreference, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usernotificationpreference.Intercept(f(g(h())))`.
+func (c *UserNotificationPreferenceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserNotificationPreference = append(c.inters.UserNotificationPreference, interceptors...)
+}
+
+// Create returns a builder for creating a UserNotificationPreference entity.
+func (c *UserNotificationPreferenceClient) Create() *UserNotificationPreferenceCreate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpCreate)
+	return &UserNotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserNotificationPreference entities.
+func (c *UserNotificationPreferenceClient) CreateBulk(builders ...*UserNotificationPreferenceCreate) *UserNotificationPreferenceCreateBulk {
+	return &UserNotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserNotificationPreferenceClient) MapCreateBulk(slice any, setFunc func(*UserNotificationPreferenceCreate, int)) *UserNotificationPreferenceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserNotificationPreferenceCreateBulk{err: fmt.Errorf("calling to UserNotificationPreferenceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserNotificationPreferenceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserNotificationPreferenceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Update() *UserNotificationPreferenceUpdate {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdate)
+	return &UserNotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserNotificationPreferenceClient) UpdateOne(_m *UserNotificationPreference) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreference(_m))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserNotificationPreferenceClient) UpdateOneID(id uint32) *UserNotificationPreferenceUpdateOne {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpUpdateOne, withUserNotificationPreferenceID(id))
+	return &UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Delete() *UserNotificationPreferenceDelete {
+	mutation := newUserNotificationPreferenceMutation(c.config, OpDelete)
+	return &UserNotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserNotificationPreferenceClient) DeleteOne(_m *UserNotificationPreference) *UserNotificationPreferenceDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserNotificationPreferenceClient) DeleteOneID(id uint32) *UserNotificationPreferenceDeleteOne {
+	builder := c.Delete().Where(usernotificationpreference.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserNotificationPreferenceDeleteOne{builder}
+}
+
+// Query returns a query builder for UserNotificationPreference.
+func (c *UserNotificationPreferenceClient) Query() *UserNotificationPreferenceQuery {
+	return &UserNotificationPreferenceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserNotificationPreference},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserNotificationPreference entity by its id.
+func (c *UserNotificationPreferenceClient) Get(ctx context.Context, id uint32) (*UserNotificationPreference, error) {
+	return c.Query().Where(usernotificationpreference.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserNotificationPreferenceClient) GetX(ctx context.Context, id uint32) *UserNotificationPreference {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserNotificationPreferenceClient) Hooks() []Hook {
+	hooks := c.hooks.UserNotificationPreference
+	return append(hooks[:len(hooks):len(hooks)], usernotificationpreference.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserNotificationPreferenceClient) Interceptors() []Interceptor {
+	return c.inters.UserNotificationPreference
+}
+
+func (c *UserNotificationPreferenceClient) mutate(ctx context.Context, m *UserNotificationPreferenceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserNotificationPreferenceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserNotificationPreferenceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserNotificationPreferenceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserNotificationPreferenceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserNotificationPreference mutation op: %q", m.Op())
+	}
+}
+
 // UserPositionClient is a client for the UserPosition schema.
 type UserPositionClient struct {
 	config
@@ -4243,18 +5537,20 @@ func (c *UserRoleClient) mutate(ctx context.Context, m *UserRoleMutation) (Value
 type (
 	hooks struct {
 		AdminLoginLog, AdminLoginRestriction, AdminOperationLog, ApiResource,
-		Department, DictEntry, DictType, File, InternalMessage,
-		InternalMessageCategory, InternalMessageRecipient, Language, Menu,
-		Organization, Position, Role, RoleApi, RoleDept, RoleMenu, RoleOrg,
-		RolePosition, Task, Tenant, User, UserCredential, UserPosition,
-		UserRole []ent.Hook
+		Conversation, ConversationMember, Department, DictEntry, DictType, File,
+		InternalMessage, InternalMessageCategory, InternalMessageDelivery,
+		InternalMessageRecipient, Language, Menu, MessageTemplate, Organization,
+		Position, Role, RoleApi, RoleDept, RoleMenu, RoleOrg, RolePosition, Setting,
+		Task, TaskRun, Tenant, TenantPlan, TenantUsage, User, UserCredential,
+		UserNotificationPreference, UserPosition, UserRole []ent.Hook
 	}
 	inters struct {
 		AdminLoginLog, AdminLoginRestriction, AdminOperationLog, ApiResource,
-		Department, DictEntry, DictType, File, InternalMessage,
-		InternalMessageCategory, InternalMessageRecipient, Language, Menu,
-		Organization, Position, Role, RoleApi, RoleDept, RoleMenu, RoleOrg,
-		RolePosition, Task, Tenant, User, UserCredential, UserPosition,
-		UserRole []ent.Interceptor
+		Conversation, ConversationMember, Department, DictEntry, DictType, File,
+		InternalMessage, InternalMessageCategory, InternalMessageDelivery,
+		InternalMessageRecipient, Language, Menu, MessageTemplate, Organization,
+		Position, Role, RoleApi, RoleDept, RoleMenu, RoleOrg, RolePosition, Setting,
+		Task, TaskRun, Tenant, TenantPlan, TenantUsage, User, UserCredential,
+		UserNotificationPreference, UserPosition, UserRole []ent.Interceptor
 	}
 )
@@ -0,0 +1,1543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationCreate is the builder for creating a Conversation entity.
+type ConversationCreate struct {
+	config
+	mutation *ConversationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationCreate) SetCreatedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableCreatedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConversationCreate) SetUpdatedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableUpdatedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ConversationCreate) SetDeletedAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableDeletedAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *ConversationCreate) SetCreatedBy(v uint32) *ConversationCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableCreatedBy(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *ConversationCreate) SetUpdatedBy(v uint32) *ConversationCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableUpdatedBy(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *ConversationCreate) SetDeletedBy(v uint32) *ConversationCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableDeletedBy(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ConversationCreate) SetTenantID(v uint32) *ConversationCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableTenantID(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *ConversationCreate) SetType(v conversation.Type) *ConversationCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableType(v *conversation.Type) *ConversationCreate {
+	if v != nil {
+		_c.SetType(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *ConversationCreate) SetName(v string) *ConversationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableName(v *string) *ConversationCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_c *ConversationCreate) SetAvatarURL(v string) *ConversationCreate {
+	_c.mutation.SetAvatarURL(v)
+	return _c
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableAvatarURL(v *string) *ConversationCreate {
+	if v != nil {
+		_c.SetAvatarURL(*v)
+	}
+	return _c
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (_c *ConversationCreate) SetDirectKey(v string) *ConversationCreate {
+	_c.mutation.SetDirectKey(v)
+	return _c
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableDirectKey(v *string) *ConversationCreate {
+	if v != nil {
+		_c.SetDirectKey(*v)
+	}
+	return _c
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_c *ConversationCreate) SetMemberCount(v uint32) *ConversationCreate {
+	_c.mutation.SetMemberCount(v)
+	return _c
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableMemberCount(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetMemberCount(*v)
+	}
+	return _c
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_c *ConversationCreate) SetLastMessageID(v uint32) *ConversationCreate {
+	_c.mutation.SetLastMessageID(v)
+	return _c
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableLastMessageID(v *uint32) *ConversationCreate {
+	if v != nil {
+		_c.SetLastMessageID(*v)
+	}
+	return _c
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_c *ConversationCreate) SetLastMessageAt(v time.Time) *ConversationCreate {
+	_c.mutation.SetLastMessageAt(v)
+	return _c
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_c *ConversationCreate) SetNillableLastMessageAt(v *time.Time) *ConversationCreate {
+	if v != nil {
+		_c.SetLastMessageAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationCreate) SetID(v uint32) *ConversationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_c *ConversationCreate) Mutation() *ConversationMutation {
+	return _c.mutation
+}
+
+// Save creates the Conversation in the database.
+func (_c *ConversationCreate) Save(ctx context.Context) (*Conversation, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationCreate) SaveX(ctx context.Context) *Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationCreate) defaults() error {
+	if _, ok := _c.mutation.GetType(); !ok {
+		v := conversation.DefaultType
+		_c.mutation.SetType(v)
+	}
+	if _, ok := _c.mutation.MemberCount(); !ok {
+		v := conversation.DefaultMemberCount
+		_c.mutation.SetMemberCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationCreate) check() error {
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := conversation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Conversation.type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversation.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Conversation.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ConversationCreate) sqlSave(ctx context.Context) (*Conversation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationCreate) createSpec() (*Conversation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Conversation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversation.Table, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(conversation.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(conversation.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(conversation.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(conversation.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(conversation.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(conversation.FieldType, field.TypeEnum, value)
+		_node.Type = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(conversation.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.AvatarURL(); ok {
+		_spec.SetField(conversation.FieldAvatarURL, field.TypeString, value)
+		_node.AvatarURL = &value
+	}
+	if value, ok := _c.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+		_node.DirectKey = &value
+	}
+	if value, ok := _c.mutation.MemberCount(); ok {
+		_spec.SetField(conversation.FieldMemberCount, field.TypeUint32, value)
+		_node.MemberCount = &value
+	}
+	if value, ok := _c.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeUint32, value)
+		_node.LastMessageID = &value
+	}
+	if value, ok := _c.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+		_node.LastMessageAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationCreate) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertOne {
+	_c.conflict = opts
+	return &ConversationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationCreate) OnConflictColumns(columns ...string) *ConversationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ConversationUpsertOne is the builder for "upsert"-ing
+	//  one Conversation node.
+	ConversationUpsertOne struct {
+		create *ConversationCreate
+	}
+
+	// ConversationUpsert is the "OnConflict" setter.
+	ConversationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsert) SetUpdatedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateUpdatedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationUpsert) ClearUpdatedAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsert) SetDeletedAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateDeletedAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsert) ClearDeletedAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ConversationUpsert) SetCreatedBy(v uint32) *ConversationUpsert {
+	u.Set(conversation.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateCreatedBy() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ConversationUpsert) AddCreatedBy(v uint32) *ConversationUpsert {
+	u.Add(conversation.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ConversationUpsert) ClearCreatedBy() *ConversationUpsert {
+	u.SetNull(conversation.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ConversationUpsert) SetUpdatedBy(v uint32) *ConversationUpsert {
+	u.Set(conversation.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateUpdatedBy() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ConversationUpsert) AddUpdatedBy(v uint32) *ConversationUpsert {
+	u.Add(conversation.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ConversationUpsert) ClearUpdatedBy() *ConversationUpsert {
+	u.SetNull(conversation.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsert) SetDeletedBy(v uint32) *ConversationUpsert {
+	u.Set(conversation.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateDeletedBy() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ConversationUpsert) AddDeletedBy(v uint32) *ConversationUpsert {
+	u.Add(conversation.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsert) ClearDeletedBy() *ConversationUpsert {
+	u.SetNull(conversation.FieldDeletedBy)
+	return u
+}
+
+// SetType sets the "type" field.
+func (u *ConversationUpsert) SetType(v conversation.Type) *ConversationUpsert {
+	u.Set(conversation.FieldType, v)
+	return u
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateType() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldType)
+	return u
+}
+
+// ClearType clears the value of the "type" field.
+func (u *ConversationUpsert) ClearType() *ConversationUpsert {
+	u.SetNull(conversation.FieldType)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *ConversationUpsert) SetName(v string) *ConversationUpsert {
+	u.Set(conversation.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateName() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ConversationUpsert) ClearName() *ConversationUpsert {
+	u.SetNull(conversation.FieldName)
+	return u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *ConversationUpsert) SetAvatarURL(v string) *ConversationUpsert {
+	u.Set(conversation.FieldAvatarURL, v)
+	return u
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateAvatarURL() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldAvatarURL)
+	return u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *ConversationUpsert) ClearAvatarURL() *ConversationUpsert {
+	u.SetNull(conversation.FieldAvatarURL)
+	return u
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (u *ConversationUpsert) SetDirectKey(v string) *ConversationUpsert {
+	u.Set(conversation.FieldDirectKey, v)
+	return u
+}
+
+// UpdateDirectKey sets the "direct_key" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateDirectKey() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldDirectKey)
+	return u
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (u *ConversationUpsert) ClearDirectKey() *ConversationUpsert {
+	u.SetNull(conversation.FieldDirectKey)
+	return u
+}
+
+// SetMemberCount sets the "member_count" field.
+func (u *ConversationUpsert) SetMemberCount(v uint32) *ConversationUpsert {
+	u.Set(conversation.FieldMemberCount, v)
+	return u
+}
+
+// UpdateMemberCount sets the "member_count" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateMemberCount() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldMemberCount)
+	return u
+}
+
+// AddMemberCount adds v to the "member_count" field.
+func (u *ConversationUpsert) AddMemberCount(v uint32) *ConversationUpsert {
+	u.Add(conversation.FieldMemberCount, v)
+	return u
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (u *ConversationUpsert) ClearMemberCount() *ConversationUpsert {
+	u.SetNull(conversation.FieldMemberCount)
+	return u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (u *ConversationUpsert) SetLastMessageID(v uint32) *ConversationUpsert {
+	u.Set(conversation.FieldLastMessageID, v)
+	return u
+}
+
+// UpdateLastMessageID sets the "last_message_id" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateLastMessageID() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldLastMessageID)
+	return u
+}
+
+// AddLastMessageID adds v to the "last_message_id" field.
+func (u *ConversationUpsert) AddLastMessageID(v uint32) *ConversationUpsert {
+	u.Add(conversation.FieldLastMessageID, v)
+	return u
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (u *ConversationUpsert) ClearLastMessageID() *ConversationUpsert {
+	u.SetNull(conversation.FieldLastMessageID)
+	return u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsert) SetLastMessageAt(v time.Time) *ConversationUpsert {
+	u.Set(conversation.FieldLastMessageAt, v)
+	return u
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsert) UpdateLastMessageAt() *ConversationUpsert {
+	u.SetExcluded(conversation.FieldLastMessageAt)
+	return u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsert) ClearLastMessageAt() *ConversationUpsert {
+	u.SetNull(conversation.FieldLastMessageAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertOne) UpdateNewValues() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversation.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(conversation.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationUpsertOne) Ignore() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertOne) DoNothing() *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreate.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertOne) Update(set func(*ConversationUpsert)) *ConversationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsertOne) SetUpdatedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateUpdatedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationUpsertOne) ClearUpdatedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsertOne) SetDeletedAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateDeletedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsertOne) ClearDeletedAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ConversationUpsertOne) SetCreatedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ConversationUpsertOne) AddCreatedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateCreatedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ConversationUpsertOne) ClearCreatedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ConversationUpsertOne) SetUpdatedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ConversationUpsertOne) AddUpdatedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateUpdatedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ConversationUpsertOne) ClearUpdatedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsertOne) SetDeletedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ConversationUpsertOne) AddDeletedBy(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateDeletedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsertOne) ClearDeletedBy() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ConversationUpsertOne) SetType(v conversation.Type) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateType() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// ClearType clears the value of the "type" field.
+func (u *ConversationUpsertOne) ClearType() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ConversationUpsertOne) SetName(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateName() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ConversationUpsertOne) ClearName() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *ConversationUpsertOne) SetAvatarURL(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateAvatarURL() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *ConversationUpsertOne) ClearAvatarURL() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (u *ConversationUpsertOne) SetDirectKey(v string) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDirectKey(v)
+	})
+}
+
+// UpdateDirectKey sets the "direct_key" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateDirectKey() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDirectKey()
+	})
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (u *ConversationUpsertOne) ClearDirectKey() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDirectKey()
+	})
+}
+
+// SetMemberCount sets the "member_count" field.
+func (u *ConversationUpsertOne) SetMemberCount(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetMemberCount(v)
+	})
+}
+
+// AddMemberCount adds v to the "member_count" field.
+func (u *ConversationUpsertOne) AddMemberCount(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddMemberCount(v)
+	})
+}
+
+// UpdateMemberCount sets the "member_count" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateMemberCount() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateMemberCount()
+	})
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (u *ConversationUpsertOne) ClearMemberCount() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearMemberCount()
+	})
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (u *ConversationUpsertOne) SetLastMessageID(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageID(v)
+	})
+}
+
+// AddLastMessageID adds v to the "last_message_id" field.
+func (u *ConversationUpsertOne) AddLastMessageID(v uint32) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddLastMessageID(v)
+	})
+}
+
+// UpdateLastMessageID sets the "last_message_id" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateLastMessageID() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageID()
+	})
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (u *ConversationUpsertOne) ClearLastMessageID() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageID()
+	})
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsertOne) SetLastMessageAt(v time.Time) *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageAt(v)
+	})
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsertOne) UpdateLastMessageAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageAt()
+	})
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsertOne) ClearLastMessageAt() *ConversationUpsertOne {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationCreateBulk is the builder for creating many Conversation entities in bulk.
+type ConversationCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Conversation entities in the database.
+func (_c *ConversationCreateBulk) Save(ctx context.Context) ([]*Conversation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Conversation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationCreateBulk) SaveX(ctx context.Context) []*Conversation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Conversation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationUpsertBulk {
+	_c.conflict = opts
+	return &ConversationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationCreateBulk) OnConflictColumns(columns ...string) *ConversationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationUpsertBulk{
+		create: _c,
+	}
+}
+
+// ConversationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Conversation nodes.
+type ConversationUpsertBulk struct {
+	create *ConversationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) UpdateNewValues() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversation.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(conversation.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Conversation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationUpsertBulk) Ignore() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationUpsertBulk) DoNothing() *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationUpsertBulk) Update(set func(*ConversationUpsert)) *ConversationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationUpsertBulk) SetUpdatedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateUpdatedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationUpsertBulk) ClearUpdatedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationUpsertBulk) SetDeletedAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateDeletedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationUpsertBulk) ClearDeletedAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *ConversationUpsertBulk) SetCreatedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *ConversationUpsertBulk) AddCreatedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateCreatedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *ConversationUpsertBulk) ClearCreatedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *ConversationUpsertBulk) SetUpdatedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *ConversationUpsertBulk) AddUpdatedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateUpdatedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *ConversationUpsertBulk) ClearUpdatedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *ConversationUpsertBulk) SetDeletedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *ConversationUpsertBulk) AddDeletedBy(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateDeletedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *ConversationUpsertBulk) ClearDeletedBy() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *ConversationUpsertBulk) SetType(v conversation.Type) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateType() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateType()
+	})
+}
+
+// ClearType clears the value of the "type" field.
+func (u *ConversationUpsertBulk) ClearType() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearType()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *ConversationUpsertBulk) SetName(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateName() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *ConversationUpsertBulk) ClearName() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (u *ConversationUpsertBulk) SetAvatarURL(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetAvatarURL(v)
+	})
+}
+
+// UpdateAvatarURL sets the "avatar_url" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateAvatarURL() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateAvatarURL()
+	})
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (u *ConversationUpsertBulk) ClearAvatarURL() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearAvatarURL()
+	})
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (u *ConversationUpsertBulk) SetDirectKey(v string) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetDirectKey(v)
+	})
+}
+
+// UpdateDirectKey sets the "direct_key" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateDirectKey() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateDirectKey()
+	})
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (u *ConversationUpsertBulk) ClearDirectKey() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearDirectKey()
+	})
+}
+
+// SetMemberCount sets the "member_count" field.
+func (u *ConversationUpsertBulk) SetMemberCount(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetMemberCount(v)
+	})
+}
+
+// AddMemberCount adds v to the "member_count" field.
+func (u *ConversationUpsertBulk) AddMemberCount(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddMemberCount(v)
+	})
+}
+
+// UpdateMemberCount sets the "member_count" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateMemberCount() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateMemberCount()
+	})
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (u *ConversationUpsertBulk) ClearMemberCount() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearMemberCount()
+	})
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (u *ConversationUpsertBulk) SetLastMessageID(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageID(v)
+	})
+}
+
+// AddLastMessageID adds v to the "last_message_id" field.
+func (u *ConversationUpsertBulk) AddLastMessageID(v uint32) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.AddLastMessageID(v)
+	})
+}
+
+// UpdateLastMessageID sets the "last_message_id" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateLastMessageID() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageID()
+	})
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (u *ConversationUpsertBulk) ClearLastMessageID() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageID()
+	})
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (u *ConversationUpsertBulk) SetLastMessageAt(v time.Time) *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.SetLastMessageAt(v)
+	})
+}
+
+// UpdateLastMessageAt sets the "last_message_at" field to the value that was provided on create.
+func (u *ConversationUpsertBulk) UpdateLastMessageAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.UpdateLastMessageAt()
+	})
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (u *ConversationUpsertBulk) ClearLastMessageAt() *ConversationUpsertBulk {
+	return u.Update(func(s *ConversationUpsert) {
+		s.ClearLastMessageAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConversationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,954 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationUpdate is the builder for updating Conversation entities.
+type ConversationUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ConversationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdate) Where(ps ...predicate.Conversation) *ConversationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationUpdate) SetUpdatedAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUpdatedAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ConversationUpdate) ClearUpdatedAt() *ConversationUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConversationUpdate) SetDeletedAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableDeletedAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConversationUpdate) ClearDeletedAt() *ConversationUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ConversationUpdate) SetCreatedBy(v uint32) *ConversationUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableCreatedBy(v *uint32) *ConversationUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *ConversationUpdate) AddCreatedBy(v int32) *ConversationUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ConversationUpdate) ClearCreatedBy() *ConversationUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ConversationUpdate) SetUpdatedBy(v uint32) *ConversationUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableUpdatedBy(v *uint32) *ConversationUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *ConversationUpdate) AddUpdatedBy(v int32) *ConversationUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ConversationUpdate) ClearUpdatedBy() *ConversationUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ConversationUpdate) SetDeletedBy(v uint32) *ConversationUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableDeletedBy(v *uint32) *ConversationUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *ConversationUpdate) AddDeletedBy(v int32) *ConversationUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ConversationUpdate) ClearDeletedBy() *ConversationUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ConversationUpdate) SetType(v conversation.Type) *ConversationUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableType(v *conversation.Type) *ConversationUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// ClearType clears the value of the "type" field.
+func (_u *ConversationUpdate) ClearType() *ConversationUpdate {
+	_u.mutation.ClearType()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ConversationUpdate) SetName(v string) *ConversationUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableName(v *string) *ConversationUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ConversationUpdate) ClearName() *ConversationUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ConversationUpdate) SetAvatarURL(v string) *ConversationUpdate {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableAvatarURL(v *string) *ConversationUpdate {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ConversationUpdate) ClearAvatarURL() *ConversationUpdate {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (_u *ConversationUpdate) SetDirectKey(v string) *ConversationUpdate {
+	_u.mutation.SetDirectKey(v)
+	return _u
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableDirectKey(v *string) *ConversationUpdate {
+	if v != nil {
+		_u.SetDirectKey(*v)
+	}
+	return _u
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (_u *ConversationUpdate) ClearDirectKey() *ConversationUpdate {
+	_u.mutation.ClearDirectKey()
+	return _u
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_u *ConversationUpdate) SetMemberCount(v uint32) *ConversationUpdate {
+	_u.mutation.ResetMemberCount()
+	_u.mutation.SetMemberCount(v)
+	return _u
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableMemberCount(v *uint32) *ConversationUpdate {
+	if v != nil {
+		_u.SetMemberCount(*v)
+	}
+	return _u
+}
+
+// AddMemberCount adds value to the "member_count" field.
+func (_u *ConversationUpdate) AddMemberCount(v int32) *ConversationUpdate {
+	_u.mutation.AddMemberCount(v)
+	return _u
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (_u *ConversationUpdate) ClearMemberCount() *ConversationUpdate {
+	_u.mutation.ClearMemberCount()
+	return _u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_u *ConversationUpdate) SetLastMessageID(v uint32) *ConversationUpdate {
+	_u.mutation.ResetLastMessageID()
+	_u.mutation.SetLastMessageID(v)
+	return _u
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableLastMessageID(v *uint32) *ConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastMessageID adds value to the "last_message_id" field.
+func (_u *ConversationUpdate) AddLastMessageID(v int32) *ConversationUpdate {
+	_u.mutation.AddLastMessageID(v)
+	return _u
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (_u *ConversationUpdate) ClearLastMessageID() *ConversationUpdate {
+	_u.mutation.ClearLastMessageID()
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdate) SetLastMessageAt(v time.Time) *ConversationUpdate {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdate) SetNillableLastMessageAt(v *time.Time) *ConversationUpdate {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdate) ClearLastMessageAt() *ConversationUpdate {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdate) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := conversation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Conversation.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(conversation.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(conversation.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(conversation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(conversation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(conversation.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(conversation.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(conversation.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(conversation.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(conversation.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(conversation.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(conversation.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(conversation.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(conversation.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(conversation.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(conversation.FieldType, field.TypeEnum, value)
+	}
+	if _u.mutation.TypeCleared() {
+		_spec.ClearField(conversation.FieldType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(conversation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(conversation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(conversation.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(conversation.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+	}
+	if _u.mutation.DirectKeyCleared() {
+		_spec.ClearField(conversation.FieldDirectKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.MemberCount(); ok {
+		_spec.SetField(conversation.FieldMemberCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMemberCount(); ok {
+		_spec.AddField(conversation.FieldMemberCount, field.TypeUint32, value)
+	}
+	if _u.mutation.MemberCountCleared() {
+		_spec.ClearField(conversation.FieldMemberCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLastMessageID(); ok {
+		_spec.AddField(conversation.FieldLastMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.LastMessageIDCleared() {
+		_spec.ClearField(conversation.FieldLastMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationUpdateOne is the builder for updating a single Conversation entity.
+type ConversationUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ConversationMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationUpdateOne) SetUpdatedAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUpdatedAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ConversationUpdateOne) ClearUpdatedAt() *ConversationUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConversationUpdateOne) SetDeletedAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableDeletedAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConversationUpdateOne) ClearDeletedAt() *ConversationUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *ConversationUpdateOne) SetCreatedBy(v uint32) *ConversationUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableCreatedBy(v *uint32) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *ConversationUpdateOne) AddCreatedBy(v int32) *ConversationUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *ConversationUpdateOne) ClearCreatedBy() *ConversationUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *ConversationUpdateOne) SetUpdatedBy(v uint32) *ConversationUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableUpdatedBy(v *uint32) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *ConversationUpdateOne) AddUpdatedBy(v int32) *ConversationUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *ConversationUpdateOne) ClearUpdatedBy() *ConversationUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *ConversationUpdateOne) SetDeletedBy(v uint32) *ConversationUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableDeletedBy(v *uint32) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *ConversationUpdateOne) AddDeletedBy(v int32) *ConversationUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *ConversationUpdateOne) ClearDeletedBy() *ConversationUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ConversationUpdateOne) SetType(v conversation.Type) *ConversationUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableType(v *conversation.Type) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// ClearType clears the value of the "type" field.
+func (_u *ConversationUpdateOne) ClearType() *ConversationUpdateOne {
+	_u.mutation.ClearType()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ConversationUpdateOne) SetName(v string) *ConversationUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableName(v *string) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *ConversationUpdateOne) ClearName() *ConversationUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (_u *ConversationUpdateOne) SetAvatarURL(v string) *ConversationUpdateOne {
+	_u.mutation.SetAvatarURL(v)
+	return _u
+}
+
+// SetNillableAvatarURL sets the "avatar_url" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableAvatarURL(v *string) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetAvatarURL(*v)
+	}
+	return _u
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (_u *ConversationUpdateOne) ClearAvatarURL() *ConversationUpdateOne {
+	_u.mutation.ClearAvatarURL()
+	return _u
+}
+
+// SetDirectKey sets the "direct_key" field.
+func (_u *ConversationUpdateOne) SetDirectKey(v string) *ConversationUpdateOne {
+	_u.mutation.SetDirectKey(v)
+	return _u
+}
+
+// SetNillableDirectKey sets the "direct_key" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableDirectKey(v *string) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetDirectKey(*v)
+	}
+	return _u
+}
+
+// ClearDirectKey clears the value of the "direct_key" field.
+func (_u *ConversationUpdateOne) ClearDirectKey() *ConversationUpdateOne {
+	_u.mutation.ClearDirectKey()
+	return _u
+}
+
+// SetMemberCount sets the "member_count" field.
+func (_u *ConversationUpdateOne) SetMemberCount(v uint32) *ConversationUpdateOne {
+	_u.mutation.ResetMemberCount()
+	_u.mutation.SetMemberCount(v)
+	return _u
+}
+
+// SetNillableMemberCount sets the "member_count" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableMemberCount(v *uint32) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetMemberCount(*v)
+	}
+	return _u
+}
+
+// AddMemberCount adds value to the "member_count" field.
+func (_u *ConversationUpdateOne) AddMemberCount(v int32) *ConversationUpdateOne {
+	_u.mutation.AddMemberCount(v)
+	return _u
+}
+
+// ClearMemberCount clears the value of the "member_count" field.
+func (_u *ConversationUpdateOne) ClearMemberCount() *ConversationUpdateOne {
+	_u.mutation.ClearMemberCount()
+	return _u
+}
+
+// SetLastMessageID sets the "last_message_id" field.
+func (_u *ConversationUpdateOne) SetLastMessageID(v uint32) *ConversationUpdateOne {
+	_u.mutation.ResetLastMessageID()
+	_u.mutation.SetLastMessageID(v)
+	return _u
+}
+
+// SetNillableLastMessageID sets the "last_message_id" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableLastMessageID(v *uint32) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastMessageID adds value to the "last_message_id" field.
+func (_u *ConversationUpdateOne) AddLastMessageID(v int32) *ConversationUpdateOne {
+	_u.mutation.AddLastMessageID(v)
+	return _u
+}
+
+// ClearLastMessageID clears the value of the "last_message_id" field.
+func (_u *ConversationUpdateOne) ClearLastMessageID() *ConversationUpdateOne {
+	_u.mutation.ClearLastMessageID()
+	return _u
+}
+
+// SetLastMessageAt sets the "last_message_at" field.
+func (_u *ConversationUpdateOne) SetLastMessageAt(v time.Time) *ConversationUpdateOne {
+	_u.mutation.SetLastMessageAt(v)
+	return _u
+}
+
+// SetNillableLastMessageAt sets the "last_message_at" field if the given value is not nil.
+func (_u *ConversationUpdateOne) SetNillableLastMessageAt(v *time.Time) *ConversationUpdateOne {
+	if v != nil {
+		_u.SetLastMessageAt(*v)
+	}
+	return _u
+}
+
+// ClearLastMessageAt clears the value of the "last_message_at" field.
+func (_u *ConversationUpdateOne) ClearLastMessageAt() *ConversationUpdateOne {
+	_u.mutation.ClearLastMessageAt()
+	return _u
+}
+
+// Mutation returns the ConversationMutation object of the builder.
+func (_u *ConversationUpdateOne) Mutation() *ConversationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConversationUpdate builder.
+func (_u *ConversationUpdateOne) Where(ps ...predicate.Conversation) *ConversationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationUpdateOne) Select(field string, fields ...string) *ConversationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Conversation entity.
+func (_u *ConversationUpdateOne) Save(ctx context.Context) (*Conversation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationUpdateOne) SaveX(ctx context.Context) *Conversation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := conversation.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Conversation.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationUpdateOne) sqlSave(ctx context.Context) (_node *Conversation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversation.Table, conversation.Columns, sqlgraph.NewFieldSpec(conversation.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Conversation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversation.FieldID)
+		for _, f := range fields {
+			if !conversation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(conversation.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(conversation.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(conversation.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(conversation.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(conversation.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(conversation.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(conversation.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(conversation.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(conversation.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(conversation.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(conversation.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(conversation.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(conversation.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(conversation.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(conversation.FieldType, field.TypeEnum, value)
+	}
+	if _u.mutation.TypeCleared() {
+		_spec.ClearField(conversation.FieldType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(conversation.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(conversation.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.AvatarURL(); ok {
+		_spec.SetField(conversation.FieldAvatarURL, field.TypeString, value)
+	}
+	if _u.mutation.AvatarURLCleared() {
+		_spec.ClearField(conversation.FieldAvatarURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.DirectKey(); ok {
+		_spec.SetField(conversation.FieldDirectKey, field.TypeString, value)
+	}
+	if _u.mutation.DirectKeyCleared() {
+		_spec.ClearField(conversation.FieldDirectKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.MemberCount(); ok {
+		_spec.SetField(conversation.FieldMemberCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMemberCount(); ok {
+		_spec.AddField(conversation.FieldMemberCount, field.TypeUint32, value)
+	}
+	if _u.mutation.MemberCountCleared() {
+		_spec.ClearField(conversation.FieldMemberCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastMessageID(); ok {
+		_spec.SetField(conversation.FieldLastMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLastMessageID(); ok {
+		_spec.AddField(conversation.FieldLastMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.LastMessageIDCleared() {
+		_spec.ClearField(conversation.FieldLastMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastMessageAt(); ok {
+		_spec.SetField(conversation.FieldLastMessageAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastMessageAtCleared() {
+		_spec.ClearField(conversation.FieldLastMessageAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &Conversation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
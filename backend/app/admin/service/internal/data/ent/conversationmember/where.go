@@ -0,0 +1,650 @@
+// Code generated by ent, DO NOT EDIT.
+
+package conversationmember
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldTenantID, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldConversationID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// MutedUntil applies equality check predicate on the "muted_until" field. It's identical to MutedUntilEQ.
+func MutedUntil(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// LastReadMessageID applies equality check predicate on the "last_read_message_id" field. It's identical to LastReadMessageIDEQ.
+func LastReadMessageID(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// UnreadCount applies equality check predicate on the "unread_count" field. It's identical to UnreadCountEQ.
+func UnreadCount(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUnreadCount, v))
+}
+
+// JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
+func JoinedAt(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldTenantID))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDIsNil applies the IsNil predicate on the "conversation_id" field.
+func ConversationIDIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldConversationID))
+}
+
+// ConversationIDNotNil applies the NotNil predicate on the "conversation_id" field.
+func ConversationIDNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldConversationID))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldUserID))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v Role) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v Role) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...Role) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...Role) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleIsNil applies the IsNil predicate on the "role" field.
+func RoleIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldRole))
+}
+
+// RoleNotNil applies the NotNil predicate on the "role" field.
+func RoleNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldRole))
+}
+
+// MutedUntilEQ applies the EQ predicate on the "muted_until" field.
+func MutedUntilEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilNEQ applies the NEQ predicate on the "muted_until" field.
+func MutedUntilNEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldMutedUntil, v))
+}
+
+// MutedUntilIn applies the In predicate on the "muted_until" field.
+func MutedUntilIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilNotIn applies the NotIn predicate on the "muted_until" field.
+func MutedUntilNotIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldMutedUntil, vs...))
+}
+
+// MutedUntilGT applies the GT predicate on the "muted_until" field.
+func MutedUntilGT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldMutedUntil, v))
+}
+
+// MutedUntilGTE applies the GTE predicate on the "muted_until" field.
+func MutedUntilGTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldMutedUntil, v))
+}
+
+// MutedUntilLT applies the LT predicate on the "muted_until" field.
+func MutedUntilLT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldMutedUntil, v))
+}
+
+// MutedUntilLTE applies the LTE predicate on the "muted_until" field.
+func MutedUntilLTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldMutedUntil, v))
+}
+
+// MutedUntilIsNil applies the IsNil predicate on the "muted_until" field.
+func MutedUntilIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldMutedUntil))
+}
+
+// MutedUntilNotNil applies the NotNil predicate on the "muted_until" field.
+func MutedUntilNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldMutedUntil))
+}
+
+// LastReadMessageIDEQ applies the EQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDNEQ applies the NEQ predicate on the "last_read_message_id" field.
+func LastReadMessageIDNEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDIn applies the In predicate on the "last_read_message_id" field.
+func LastReadMessageIDIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDNotIn applies the NotIn predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldLastReadMessageID, vs...))
+}
+
+// LastReadMessageIDGT applies the GT predicate on the "last_read_message_id" field.
+func LastReadMessageIDGT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDGTE applies the GTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDGTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLT applies the LT predicate on the "last_read_message_id" field.
+func LastReadMessageIDLT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDLTE applies the LTE predicate on the "last_read_message_id" field.
+func LastReadMessageIDLTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldLastReadMessageID, v))
+}
+
+// LastReadMessageIDIsNil applies the IsNil predicate on the "last_read_message_id" field.
+func LastReadMessageIDIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldLastReadMessageID))
+}
+
+// LastReadMessageIDNotNil applies the NotNil predicate on the "last_read_message_id" field.
+func LastReadMessageIDNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldLastReadMessageID))
+}
+
+// UnreadCountEQ applies the EQ predicate on the "unread_count" field.
+func UnreadCountEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldUnreadCount, v))
+}
+
+// UnreadCountNEQ applies the NEQ predicate on the "unread_count" field.
+func UnreadCountNEQ(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldUnreadCount, v))
+}
+
+// UnreadCountIn applies the In predicate on the "unread_count" field.
+func UnreadCountIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldUnreadCount, vs...))
+}
+
+// UnreadCountNotIn applies the NotIn predicate on the "unread_count" field.
+func UnreadCountNotIn(vs ...uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldUnreadCount, vs...))
+}
+
+// UnreadCountGT applies the GT predicate on the "unread_count" field.
+func UnreadCountGT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldUnreadCount, v))
+}
+
+// UnreadCountGTE applies the GTE predicate on the "unread_count" field.
+func UnreadCountGTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldUnreadCount, v))
+}
+
+// UnreadCountLT applies the LT predicate on the "unread_count" field.
+func UnreadCountLT(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldUnreadCount, v))
+}
+
+// UnreadCountLTE applies the LTE predicate on the "unread_count" field.
+func UnreadCountLTE(v uint32) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldUnreadCount, v))
+}
+
+// UnreadCountIsNil applies the IsNil predicate on the "unread_count" field.
+func UnreadCountIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldUnreadCount))
+}
+
+// UnreadCountNotNil applies the NotNil predicate on the "unread_count" field.
+func UnreadCountNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldUnreadCount))
+}
+
+// JoinedAtEQ applies the EQ predicate on the "joined_at" field.
+func JoinedAtEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtNEQ applies the NEQ predicate on the "joined_at" field.
+func JoinedAtNEQ(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNEQ(FieldJoinedAt, v))
+}
+
+// JoinedAtIn applies the In predicate on the "joined_at" field.
+func JoinedAtIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtNotIn applies the NotIn predicate on the "joined_at" field.
+func JoinedAtNotIn(vs ...time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotIn(FieldJoinedAt, vs...))
+}
+
+// JoinedAtGT applies the GT predicate on the "joined_at" field.
+func JoinedAtGT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGT(FieldJoinedAt, v))
+}
+
+// JoinedAtGTE applies the GTE predicate on the "joined_at" field.
+func JoinedAtGTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldGTE(FieldJoinedAt, v))
+}
+
+// JoinedAtLT applies the LT predicate on the "joined_at" field.
+func JoinedAtLT(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLT(FieldJoinedAt, v))
+}
+
+// JoinedAtLTE applies the LTE predicate on the "joined_at" field.
+func JoinedAtLTE(v time.Time) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldLTE(FieldJoinedAt, v))
+}
+
+// JoinedAtIsNil applies the IsNil predicate on the "joined_at" field.
+func JoinedAtIsNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldIsNull(FieldJoinedAt))
+}
+
+// JoinedAtNotNil applies the NotNil predicate on the "joined_at" field.
+func JoinedAtNotNil() predicate.ConversationMember {
+	return predicate.ConversationMember(sql.FieldNotNull(FieldJoinedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ConversationMember) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ConversationMember) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ConversationMember) predicate.ConversationMember {
+	return predicate.ConversationMember(sql.NotPredicates(p))
+}
@@ -0,0 +1,1289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationMemberCreate is the builder for creating a ConversationMember entity.
+type ConversationMemberCreate struct {
+	config
+	mutation *ConversationMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationMemberCreate) SetCreatedAt(v time.Time) *ConversationMemberCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableCreatedAt(v *time.Time) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ConversationMemberCreate) SetUpdatedAt(v time.Time) *ConversationMemberCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableUpdatedAt(v *time.Time) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ConversationMemberCreate) SetDeletedAt(v time.Time) *ConversationMemberCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableDeletedAt(v *time.Time) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *ConversationMemberCreate) SetTenantID(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableTenantID(v *uint32) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *ConversationMemberCreate) SetConversationID(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableConversationID(v *uint32) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetConversationID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ConversationMemberCreate) SetUserID(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableUserID(v *uint32) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *ConversationMemberCreate) SetRole(v conversationmember.Role) *ConversationMemberCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableRole(v *conversationmember.Role) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_c *ConversationMemberCreate) SetMutedUntil(v time.Time) *ConversationMemberCreate {
+	_c.mutation.SetMutedUntil(v)
+	return _c
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableMutedUntil(v *time.Time) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetMutedUntil(*v)
+	}
+	return _c
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_c *ConversationMemberCreate) SetLastReadMessageID(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetLastReadMessageID(v)
+	return _c
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableLastReadMessageID(v *uint32) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetLastReadMessageID(*v)
+	}
+	return _c
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (_c *ConversationMemberCreate) SetUnreadCount(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetUnreadCount(v)
+	return _c
+}
+
+// SetNillableUnreadCount sets the "unread_count" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableUnreadCount(v *uint32) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetUnreadCount(*v)
+	}
+	return _c
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_c *ConversationMemberCreate) SetJoinedAt(v time.Time) *ConversationMemberCreate {
+	_c.mutation.SetJoinedAt(v)
+	return _c
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_c *ConversationMemberCreate) SetNillableJoinedAt(v *time.Time) *ConversationMemberCreate {
+	if v != nil {
+		_c.SetJoinedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationMemberCreate) SetID(v uint32) *ConversationMemberCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the ConversationMemberMutation object of the builder.
+func (_c *ConversationMemberCreate) Mutation() *ConversationMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the ConversationMember in the database.
+func (_c *ConversationMemberCreate) Save(ctx context.Context) (*ConversationMember, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationMemberCreate) SaveX(ctx context.Context) *ConversationMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationMemberCreate) defaults() error {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := conversationmember.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.UnreadCount(); !ok {
+		v := conversationmember.DefaultUnreadCount
+		_c.mutation.SetUnreadCount(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationMemberCreate) check() error {
+	if v, ok := _c.mutation.Role(); ok {
+		if err := conversationmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationMember.role": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := conversationmember.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "ConversationMember.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *ConversationMemberCreate) sqlSave(ctx context.Context) (*ConversationMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationMemberCreate) createSpec() (*ConversationMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversationmember.Table, sqlgraph.NewFieldSpec(conversationmember.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationmember.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationmember.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(conversationmember.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(conversationmember.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(conversationmember.FieldConversationID, field.TypeUint32, value)
+		_node.ConversationID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(conversationmember.FieldUserID, field.TypeUint32, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(conversationmember.FieldRole, field.TypeEnum, value)
+		_node.Role = &value
+	}
+	if value, ok := _c.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationmember.FieldMutedUntil, field.TypeTime, value)
+		_node.MutedUntil = &value
+	}
+	if value, ok := _c.mutation.LastReadMessageID(); ok {
+		_spec.SetField(conversationmember.FieldLastReadMessageID, field.TypeUint32, value)
+		_node.LastReadMessageID = &value
+	}
+	if value, ok := _c.mutation.UnreadCount(); ok {
+		_spec.SetField(conversationmember.FieldUnreadCount, field.TypeUint32, value)
+		_node.UnreadCount = &value
+	}
+	if value, ok := _c.mutation.JoinedAt(); ok {
+		_spec.SetField(conversationmember.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationMember.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationMemberCreate) OnConflict(opts ...sql.ConflictOption) *ConversationMemberUpsertOne {
+	_c.conflict = opts
+	return &ConversationMemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationMemberCreate) OnConflictColumns(columns ...string) *ConversationMemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationMemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ConversationMemberUpsertOne is the builder for "upsert"-ing
+	//  one ConversationMember node.
+	ConversationMemberUpsertOne struct {
+		create *ConversationMemberCreate
+	}
+
+	// ConversationMemberUpsert is the "OnConflict" setter.
+	ConversationMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMemberUpsert) SetUpdatedAt(v time.Time) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateUpdatedAt() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationMemberUpsert) ClearUpdatedAt() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationMemberUpsert) SetDeletedAt(v time.Time) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateDeletedAt() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationMemberUpsert) ClearDeletedAt() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldDeletedAt)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMemberUpsert) SetConversationID(v uint32) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateConversationID() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldConversationID)
+	return u
+}
+
+// AddConversationID adds v to the "conversation_id" field.
+func (u *ConversationMemberUpsert) AddConversationID(v uint32) *ConversationMemberUpsert {
+	u.Add(conversationmember.FieldConversationID, v)
+	return u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ConversationMemberUpsert) ClearConversationID() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldConversationID)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ConversationMemberUpsert) SetUserID(v uint32) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateUserID() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ConversationMemberUpsert) AddUserID(v uint32) *ConversationMemberUpsert {
+	u.Add(conversationmember.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ConversationMemberUpsert) ClearUserID() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldUserID)
+	return u
+}
+
+// SetRole sets the "role" field.
+func (u *ConversationMemberUpsert) SetRole(v conversationmember.Role) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldRole, v)
+	return u
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateRole() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldRole)
+	return u
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *ConversationMemberUpsert) ClearRole() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldRole)
+	return u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *ConversationMemberUpsert) SetMutedUntil(v time.Time) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldMutedUntil, v)
+	return u
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateMutedUntil() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldMutedUntil)
+	return u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *ConversationMemberUpsert) ClearMutedUntil() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldMutedUntil)
+	return u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *ConversationMemberUpsert) SetLastReadMessageID(v uint32) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldLastReadMessageID, v)
+	return u
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateLastReadMessageID() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldLastReadMessageID)
+	return u
+}
+
+// AddLastReadMessageID adds v to the "last_read_message_id" field.
+func (u *ConversationMemberUpsert) AddLastReadMessageID(v uint32) *ConversationMemberUpsert {
+	u.Add(conversationmember.FieldLastReadMessageID, v)
+	return u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *ConversationMemberUpsert) ClearLastReadMessageID() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldLastReadMessageID)
+	return u
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *ConversationMemberUpsert) SetUnreadCount(v uint32) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldUnreadCount, v)
+	return u
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateUnreadCount() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldUnreadCount)
+	return u
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *ConversationMemberUpsert) AddUnreadCount(v uint32) *ConversationMemberUpsert {
+	u.Add(conversationmember.FieldUnreadCount, v)
+	return u
+}
+
+// ClearUnreadCount clears the value of the "unread_count" field.
+func (u *ConversationMemberUpsert) ClearUnreadCount() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldUnreadCount)
+	return u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *ConversationMemberUpsert) SetJoinedAt(v time.Time) *ConversationMemberUpsert {
+	u.Set(conversationmember.FieldJoinedAt, v)
+	return u
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsert) UpdateJoinedAt() *ConversationMemberUpsert {
+	u.SetExcluded(conversationmember.FieldJoinedAt)
+	return u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *ConversationMemberUpsert) ClearJoinedAt() *ConversationMemberUpsert {
+	u.SetNull(conversationmember.FieldJoinedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationMemberUpsertOne) UpdateNewValues() *ConversationMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(conversationmember.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(conversationmember.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(conversationmember.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ConversationMemberUpsertOne) Ignore() *ConversationMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationMemberUpsertOne) DoNothing() *ConversationMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationMemberCreate.OnConflict
+// documentation for more info.
+func (u *ConversationMemberUpsertOne) Update(set func(*ConversationMemberUpsert)) *ConversationMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMemberUpsertOne) SetUpdatedAt(v time.Time) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateUpdatedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationMemberUpsertOne) ClearUpdatedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationMemberUpsertOne) SetDeletedAt(v time.Time) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateDeletedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationMemberUpsertOne) ClearDeletedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMemberUpsertOne) SetConversationID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// AddConversationID adds v to the "conversation_id" field.
+func (u *ConversationMemberUpsertOne) AddConversationID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateConversationID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ConversationMemberUpsertOne) ClearConversationID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ConversationMemberUpsertOne) SetUserID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ConversationMemberUpsertOne) AddUserID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateUserID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ConversationMemberUpsertOne) ClearUserID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ConversationMemberUpsertOne) SetRole(v conversationmember.Role) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateRole() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *ConversationMemberUpsertOne) ClearRole() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearRole()
+	})
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *ConversationMemberUpsertOne) SetMutedUntil(v time.Time) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetMutedUntil(v)
+	})
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateMutedUntil() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateMutedUntil()
+	})
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *ConversationMemberUpsertOne) ClearMutedUntil() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearMutedUntil()
+	})
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *ConversationMemberUpsertOne) SetLastReadMessageID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetLastReadMessageID(v)
+	})
+}
+
+// AddLastReadMessageID adds v to the "last_read_message_id" field.
+func (u *ConversationMemberUpsertOne) AddLastReadMessageID(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddLastReadMessageID(v)
+	})
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateLastReadMessageID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateLastReadMessageID()
+	})
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *ConversationMemberUpsertOne) ClearLastReadMessageID() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearLastReadMessageID()
+	})
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *ConversationMemberUpsertOne) SetUnreadCount(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUnreadCount(v)
+	})
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *ConversationMemberUpsertOne) AddUnreadCount(v uint32) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddUnreadCount(v)
+	})
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateUnreadCount() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUnreadCount()
+	})
+}
+
+// ClearUnreadCount clears the value of the "unread_count" field.
+func (u *ConversationMemberUpsertOne) ClearUnreadCount() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUnreadCount()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *ConversationMemberUpsertOne) SetJoinedAt(v time.Time) *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertOne) UpdateJoinedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *ConversationMemberUpsertOne) ClearJoinedAt() *ConversationMemberUpsertOne {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ConversationMemberUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ConversationMemberUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ConversationMemberCreateBulk is the builder for creating many ConversationMember entities in bulk.
+type ConversationMemberCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ConversationMember entities in the database.
+func (_c *ConversationMemberCreateBulk) Save(ctx context.Context) ([]*ConversationMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConversationMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationMemberCreateBulk) SaveX(ctx context.Context) []*ConversationMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ConversationMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ConversationMemberUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ConversationMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *ConversationMemberUpsertBulk {
+	_c.conflict = opts
+	return &ConversationMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ConversationMemberCreateBulk) OnConflictColumns(columns ...string) *ConversationMemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ConversationMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// ConversationMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of ConversationMember nodes.
+type ConversationMemberUpsertBulk struct {
+	create *ConversationMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(conversationmember.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ConversationMemberUpsertBulk) UpdateNewValues() *ConversationMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(conversationmember.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(conversationmember.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(conversationmember.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ConversationMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ConversationMemberUpsertBulk) Ignore() *ConversationMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ConversationMemberUpsertBulk) DoNothing() *ConversationMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ConversationMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *ConversationMemberUpsertBulk) Update(set func(*ConversationMemberUpsert)) *ConversationMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ConversationMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ConversationMemberUpsertBulk) SetUpdatedAt(v time.Time) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateUpdatedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *ConversationMemberUpsertBulk) ClearUpdatedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ConversationMemberUpsertBulk) SetDeletedAt(v time.Time) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateDeletedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ConversationMemberUpsertBulk) ClearDeletedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *ConversationMemberUpsertBulk) SetConversationID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// AddConversationID adds v to the "conversation_id" field.
+func (u *ConversationMemberUpsertBulk) AddConversationID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateConversationID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *ConversationMemberUpsertBulk) ClearConversationID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *ConversationMemberUpsertBulk) SetUserID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *ConversationMemberUpsertBulk) AddUserID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateUserID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *ConversationMemberUpsertBulk) ClearUserID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetRole sets the "role" field.
+func (u *ConversationMemberUpsertBulk) SetRole(v conversationmember.Role) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetRole(v)
+	})
+}
+
+// UpdateRole sets the "role" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateRole() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateRole()
+	})
+}
+
+// ClearRole clears the value of the "role" field.
+func (u *ConversationMemberUpsertBulk) ClearRole() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearRole()
+	})
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (u *ConversationMemberUpsertBulk) SetMutedUntil(v time.Time) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetMutedUntil(v)
+	})
+}
+
+// UpdateMutedUntil sets the "muted_until" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateMutedUntil() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateMutedUntil()
+	})
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (u *ConversationMemberUpsertBulk) ClearMutedUntil() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearMutedUntil()
+	})
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (u *ConversationMemberUpsertBulk) SetLastReadMessageID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetLastReadMessageID(v)
+	})
+}
+
+// AddLastReadMessageID adds v to the "last_read_message_id" field.
+func (u *ConversationMemberUpsertBulk) AddLastReadMessageID(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddLastReadMessageID(v)
+	})
+}
+
+// UpdateLastReadMessageID sets the "last_read_message_id" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateLastReadMessageID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateLastReadMessageID()
+	})
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (u *ConversationMemberUpsertBulk) ClearLastReadMessageID() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearLastReadMessageID()
+	})
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (u *ConversationMemberUpsertBulk) SetUnreadCount(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetUnreadCount(v)
+	})
+}
+
+// AddUnreadCount adds v to the "unread_count" field.
+func (u *ConversationMemberUpsertBulk) AddUnreadCount(v uint32) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.AddUnreadCount(v)
+	})
+}
+
+// UpdateUnreadCount sets the "unread_count" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateUnreadCount() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateUnreadCount()
+	})
+}
+
+// ClearUnreadCount clears the value of the "unread_count" field.
+func (u *ConversationMemberUpsertBulk) ClearUnreadCount() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearUnreadCount()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *ConversationMemberUpsertBulk) SetJoinedAt(v time.Time) *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *ConversationMemberUpsertBulk) UpdateJoinedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *ConversationMemberUpsertBulk) ClearJoinedAt() *ConversationMemberUpsertBulk {
+	return u.Update(func(s *ConversationMemberUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *ConversationMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ConversationMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ConversationMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ConversationMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
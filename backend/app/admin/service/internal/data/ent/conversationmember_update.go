@@ -0,0 +1,778 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationMemberUpdate is the builder for updating ConversationMember entities.
+type ConversationMemberUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *ConversationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the ConversationMemberUpdate builder.
+func (_u *ConversationMemberUpdate) Where(ps ...predicate.ConversationMember) *ConversationMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationMemberUpdate) SetUpdatedAt(v time.Time) *ConversationMemberUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableUpdatedAt(v *time.Time) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ConversationMemberUpdate) ClearUpdatedAt() *ConversationMemberUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConversationMemberUpdate) SetDeletedAt(v time.Time) *ConversationMemberUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableDeletedAt(v *time.Time) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConversationMemberUpdate) ClearDeletedAt() *ConversationMemberUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *ConversationMemberUpdate) SetConversationID(v uint32) *ConversationMemberUpdate {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableConversationID(v *uint32) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *ConversationMemberUpdate) AddConversationID(v int32) *ConversationMemberUpdate {
+	_u.mutation.AddConversationID(v)
+	return _u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *ConversationMemberUpdate) ClearConversationID() *ConversationMemberUpdate {
+	_u.mutation.ClearConversationID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ConversationMemberUpdate) SetUserID(v uint32) *ConversationMemberUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableUserID(v *uint32) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ConversationMemberUpdate) AddUserID(v int32) *ConversationMemberUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ConversationMemberUpdate) ClearUserID() *ConversationMemberUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ConversationMemberUpdate) SetRole(v conversationmember.Role) *ConversationMemberUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableRole(v *conversationmember.Role) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *ConversationMemberUpdate) ClearRole() *ConversationMemberUpdate {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_u *ConversationMemberUpdate) SetMutedUntil(v time.Time) *ConversationMemberUpdate {
+	_u.mutation.SetMutedUntil(v)
+	return _u
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableMutedUntil(v *time.Time) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetMutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (_u *ConversationMemberUpdate) ClearMutedUntil() *ConversationMemberUpdate {
+	_u.mutation.ClearMutedUntil()
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *ConversationMemberUpdate) SetLastReadMessageID(v uint32) *ConversationMemberUpdate {
+	_u.mutation.ResetLastReadMessageID()
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableLastReadMessageID(v *uint32) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastReadMessageID adds value to the "last_read_message_id" field.
+func (_u *ConversationMemberUpdate) AddLastReadMessageID(v int32) *ConversationMemberUpdate {
+	_u.mutation.AddLastReadMessageID(v)
+	return _u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (_u *ConversationMemberUpdate) ClearLastReadMessageID() *ConversationMemberUpdate {
+	_u.mutation.ClearLastReadMessageID()
+	return _u
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (_u *ConversationMemberUpdate) SetUnreadCount(v uint32) *ConversationMemberUpdate {
+	_u.mutation.ResetUnreadCount()
+	_u.mutation.SetUnreadCount(v)
+	return _u
+}
+
+// SetNillableUnreadCount sets the "unread_count" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableUnreadCount(v *uint32) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetUnreadCount(*v)
+	}
+	return _u
+}
+
+// AddUnreadCount adds value to the "unread_count" field.
+func (_u *ConversationMemberUpdate) AddUnreadCount(v int32) *ConversationMemberUpdate {
+	_u.mutation.AddUnreadCount(v)
+	return _u
+}
+
+// ClearUnreadCount clears the value of the "unread_count" field.
+func (_u *ConversationMemberUpdate) ClearUnreadCount() *ConversationMemberUpdate {
+	_u.mutation.ClearUnreadCount()
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *ConversationMemberUpdate) SetJoinedAt(v time.Time) *ConversationMemberUpdate {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdate) SetNillableJoinedAt(v *time.Time) *ConversationMemberUpdate {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *ConversationMemberUpdate) ClearJoinedAt() *ConversationMemberUpdate {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// Mutation returns the ConversationMemberMutation object of the builder.
+func (_u *ConversationMemberUpdate) Mutation() *ConversationMemberMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationMemberUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := conversationmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationMember.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationMemberUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationMemberUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationmember.Table, conversationmember.Columns, sqlgraph.NewFieldSpec(conversationmember.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(conversationmember.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(conversationmember.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(conversationmember.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(conversationmember.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(conversationmember.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(conversationmember.FieldConversationID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(conversationmember.FieldConversationID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(conversationmember.FieldConversationID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(conversationmember.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(conversationmember.FieldUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(conversationmember.FieldUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(conversationmember.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(conversationmember.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationmember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.MutedUntilCleared() {
+		_spec.ClearField(conversationmember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastReadMessageID(); ok {
+		_spec.SetField(conversationmember.FieldLastReadMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLastReadMessageID(); ok {
+		_spec.AddField(conversationmember.FieldLastReadMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.LastReadMessageIDCleared() {
+		_spec.ClearField(conversationmember.FieldLastReadMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UnreadCount(); ok {
+		_spec.SetField(conversationmember.FieldUnreadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUnreadCount(); ok {
+		_spec.AddField(conversationmember.FieldUnreadCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UnreadCountCleared() {
+		_spec.ClearField(conversationmember.FieldUnreadCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(conversationmember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(conversationmember.FieldJoinedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationMemberUpdateOne is the builder for updating a single ConversationMember entity.
+type ConversationMemberUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *ConversationMemberMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ConversationMemberUpdateOne) SetUpdatedAt(v time.Time) *ConversationMemberUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableUpdatedAt(v *time.Time) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *ConversationMemberUpdateOne) ClearUpdatedAt() *ConversationMemberUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ConversationMemberUpdateOne) SetDeletedAt(v time.Time) *ConversationMemberUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableDeletedAt(v *time.Time) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ConversationMemberUpdateOne) ClearDeletedAt() *ConversationMemberUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *ConversationMemberUpdateOne) SetConversationID(v uint32) *ConversationMemberUpdateOne {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableConversationID(v *uint32) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *ConversationMemberUpdateOne) AddConversationID(v int32) *ConversationMemberUpdateOne {
+	_u.mutation.AddConversationID(v)
+	return _u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *ConversationMemberUpdateOne) ClearConversationID() *ConversationMemberUpdateOne {
+	_u.mutation.ClearConversationID()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ConversationMemberUpdateOne) SetUserID(v uint32) *ConversationMemberUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableUserID(v *uint32) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *ConversationMemberUpdateOne) AddUserID(v int32) *ConversationMemberUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *ConversationMemberUpdateOne) ClearUserID() *ConversationMemberUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *ConversationMemberUpdateOne) SetRole(v conversationmember.Role) *ConversationMemberUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableRole(v *conversationmember.Role) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// ClearRole clears the value of the "role" field.
+func (_u *ConversationMemberUpdateOne) ClearRole() *ConversationMemberUpdateOne {
+	_u.mutation.ClearRole()
+	return _u
+}
+
+// SetMutedUntil sets the "muted_until" field.
+func (_u *ConversationMemberUpdateOne) SetMutedUntil(v time.Time) *ConversationMemberUpdateOne {
+	_u.mutation.SetMutedUntil(v)
+	return _u
+}
+
+// SetNillableMutedUntil sets the "muted_until" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableMutedUntil(v *time.Time) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetMutedUntil(*v)
+	}
+	return _u
+}
+
+// ClearMutedUntil clears the value of the "muted_until" field.
+func (_u *ConversationMemberUpdateOne) ClearMutedUntil() *ConversationMemberUpdateOne {
+	_u.mutation.ClearMutedUntil()
+	return _u
+}
+
+// SetLastReadMessageID sets the "last_read_message_id" field.
+func (_u *ConversationMemberUpdateOne) SetLastReadMessageID(v uint32) *ConversationMemberUpdateOne {
+	_u.mutation.ResetLastReadMessageID()
+	_u.mutation.SetLastReadMessageID(v)
+	return _u
+}
+
+// SetNillableLastReadMessageID sets the "last_read_message_id" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableLastReadMessageID(v *uint32) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetLastReadMessageID(*v)
+	}
+	return _u
+}
+
+// AddLastReadMessageID adds value to the "last_read_message_id" field.
+func (_u *ConversationMemberUpdateOne) AddLastReadMessageID(v int32) *ConversationMemberUpdateOne {
+	_u.mutation.AddLastReadMessageID(v)
+	return _u
+}
+
+// ClearLastReadMessageID clears the value of the "last_read_message_id" field.
+func (_u *ConversationMemberUpdateOne) ClearLastReadMessageID() *ConversationMemberUpdateOne {
+	_u.mutation.ClearLastReadMessageID()
+	return _u
+}
+
+// SetUnreadCount sets the "unread_count" field.
+func (_u *ConversationMemberUpdateOne) SetUnreadCount(v uint32) *ConversationMemberUpdateOne {
+	_u.mutation.ResetUnreadCount()
+	_u.mutation.SetUnreadCount(v)
+	return _u
+}
+
+// SetNillableUnreadCount sets the "unread_count" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableUnreadCount(v *uint32) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetUnreadCount(*v)
+	}
+	return _u
+}
+
+// AddUnreadCount adds value to the "unread_count" field.
+func (_u *ConversationMemberUpdateOne) AddUnreadCount(v int32) *ConversationMemberUpdateOne {
+	_u.mutation.AddUnreadCount(v)
+	return _u
+}
+
+// ClearUnreadCount clears the value of the "unread_count" field.
+func (_u *ConversationMemberUpdateOne) ClearUnreadCount() *ConversationMemberUpdateOne {
+	_u.mutation.ClearUnreadCount()
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *ConversationMemberUpdateOne) SetJoinedAt(v time.Time) *ConversationMemberUpdateOne {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *ConversationMemberUpdateOne) SetNillableJoinedAt(v *time.Time) *ConversationMemberUpdateOne {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *ConversationMemberUpdateOne) ClearJoinedAt() *ConversationMemberUpdateOne {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// Mutation returns the ConversationMemberMutation object of the builder.
+func (_u *ConversationMemberUpdateOne) Mutation() *ConversationMemberMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConversationMemberUpdate builder.
+func (_u *ConversationMemberUpdateOne) Where(ps ...predicate.ConversationMember) *ConversationMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationMemberUpdateOne) Select(field string, fields ...string) *ConversationMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConversationMember entity.
+func (_u *ConversationMemberUpdateOne) Save(ctx context.Context) (*ConversationMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationMemberUpdateOne) SaveX(ctx context.Context) *ConversationMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := conversationmember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "ConversationMember.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *ConversationMemberUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *ConversationMemberUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *ConversationMemberUpdateOne) sqlSave(ctx context.Context) (_node *ConversationMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationmember.Table, conversationmember.Columns, sqlgraph.NewFieldSpec(conversationmember.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationmember.FieldID)
+		for _, f := range fields {
+			if !conversationmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(conversationmember.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(conversationmember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(conversationmember.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(conversationmember.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(conversationmember.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(conversationmember.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(conversationmember.FieldConversationID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(conversationmember.FieldConversationID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(conversationmember.FieldConversationID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(conversationmember.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(conversationmember.FieldUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(conversationmember.FieldUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(conversationmember.FieldRole, field.TypeEnum, value)
+	}
+	if _u.mutation.RoleCleared() {
+		_spec.ClearField(conversationmember.FieldRole, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MutedUntil(); ok {
+		_spec.SetField(conversationmember.FieldMutedUntil, field.TypeTime, value)
+	}
+	if _u.mutation.MutedUntilCleared() {
+		_spec.ClearField(conversationmember.FieldMutedUntil, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastReadMessageID(); ok {
+		_spec.SetField(conversationmember.FieldLastReadMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedLastReadMessageID(); ok {
+		_spec.AddField(conversationmember.FieldLastReadMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.LastReadMessageIDCleared() {
+		_spec.ClearField(conversationmember.FieldLastReadMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UnreadCount(); ok {
+		_spec.SetField(conversationmember.FieldUnreadCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUnreadCount(); ok {
+		_spec.AddField(conversationmember.FieldUnreadCount, field.TypeUint32, value)
+	}
+	if _u.mutation.UnreadCountCleared() {
+		_spec.ClearField(conversationmember.FieldUnreadCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(conversationmember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(conversationmember.FieldJoinedAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &ConversationMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -243,7 +243,9 @@ func (_c *DictEntryCreate) Mutation() *DictEntryMutation {
 
 // Save creates the DictEntry in the database.
 func (_c *DictEntryCreate) Save(ctx context.Context) (*DictEntry, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -270,7 +272,7 @@ func (_c *DictEntryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DictEntryCreate) defaults() {
+func (_c *DictEntryCreate) defaults() error {
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		v := dictentry.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
@@ -279,6 +281,7 @@ func (_c *DictEntryCreate) defaults() {
 		v := dictentry.DefaultIsEnabled
 		_c.mutation.SetIsEnabled(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
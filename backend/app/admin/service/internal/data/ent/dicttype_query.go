@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
@@ -367,6 +368,12 @@ func (_q *DictTypeQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if dicttype.Policy == nil {
+		return errors.New("ent: uninitialized dicttype.Policy (forgotten import ent/runtime?)")
+	}
+	if err := dicttype.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
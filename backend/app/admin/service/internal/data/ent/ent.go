@@ -10,15 +10,19 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/adminloginrestriction"
 	"go-wind-admin/app/admin/service/internal/data/ent/adminoperationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiresource"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
 	"go-wind-admin/app/admin/service/internal/data/ent/department"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
 	"go-wind-admin/app/admin/service/internal/data/ent/organization"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
@@ -27,10 +31,15 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemenu"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleorg"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 	"reflect"
@@ -99,33 +108,42 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			adminloginlog.Table:            adminloginlog.ValidColumn,
-			adminloginrestriction.Table:    adminloginrestriction.ValidColumn,
-			adminoperationlog.Table:        adminoperationlog.ValidColumn,
-			apiresource.Table:              apiresource.ValidColumn,
-			department.Table:               department.ValidColumn,
-			dictentry.Table:                dictentry.ValidColumn,
-			dicttype.Table:                 dicttype.ValidColumn,
-			file.Table:                     file.ValidColumn,
-			internalmessage.Table:          internalmessage.ValidColumn,
-			internalmessagecategory.Table:  internalmessagecategory.ValidColumn,
-			internalmessagerecipient.Table: internalmessagerecipient.ValidColumn,
-			language.Table:                 language.ValidColumn,
-			menu.Table:                     menu.ValidColumn,
-			organization.Table:             organization.ValidColumn,
-			position.Table:                 position.ValidColumn,
-			role.Table:                     role.ValidColumn,
-			roleapi.Table:                  roleapi.ValidColumn,
-			roledept.Table:                 roledept.ValidColumn,
-			rolemenu.Table:                 rolemenu.ValidColumn,
-			roleorg.Table:                  roleorg.ValidColumn,
-			roleposition.Table:             roleposition.ValidColumn,
-			task.Table:                     task.ValidColumn,
-			tenant.Table:                   tenant.ValidColumn,
-			user.Table:                     user.ValidColumn,
-			usercredential.Table:           usercredential.ValidColumn,
-			userposition.Table:             userposition.ValidColumn,
-			userrole.Table:                 userrole.ValidColumn,
+			adminloginlog.Table:              adminloginlog.ValidColumn,
+			adminloginrestriction.Table:      adminloginrestriction.ValidColumn,
+			adminoperationlog.Table:          adminoperationlog.ValidColumn,
+			apiresource.Table:                apiresource.ValidColumn,
+			conversation.Table:               conversation.ValidColumn,
+			conversationmember.Table:         conversationmember.ValidColumn,
+			department.Table:                 department.ValidColumn,
+			dictentry.Table:                  dictentry.ValidColumn,
+			dicttype.Table:                   dicttype.ValidColumn,
+			file.Table:                       file.ValidColumn,
+			internalmessage.Table:            internalmessage.ValidColumn,
+			internalmessagecategory.Table:    internalmessagecategory.ValidColumn,
+			internalmessagedelivery.Table:    internalmessagedelivery.ValidColumn,
+			internalmessagerecipient.Table:   internalmessagerecipient.ValidColumn,
+			language.Table:                   language.ValidColumn,
+			menu.Table:                       menu.ValidColumn,
+			messagetemplate.Table:            messagetemplate.ValidColumn,
+			organization.Table:               organization.ValidColumn,
+			position.Table:                   position.ValidColumn,
+			role.Table:                       role.ValidColumn,
+			roleapi.Table:                    roleapi.ValidColumn,
+			roledept.Table:                   roledept.ValidColumn,
+			rolemenu.Table:                   rolemenu.ValidColumn,
+			roleorg.Table:                    roleorg.ValidColumn,
+			roleposition.Table:               roleposition.ValidColumn,
+			setting.Table:                    setting.ValidColumn,
+			task.Table:                       task.ValidColumn,
+			taskrun.Table:                    taskrun.ValidColumn,
+			tenant.Table:                     tenant.ValidColumn,
+			tenantplan.Table:                 tenantplan.ValidColumn,
+			tenantusage.Table:                tenantusage.ValidColumn,
+			user.Table:                       user.ValidColumn,
+			usercredential.Table:             usercredential.ValidColumn,
+			usernotificationpreference.Table: usernotificationpreference.ValidColumn,
+			userposition.Table:               userposition.ValidColumn,
+			userrole.Table:                   userrole.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -7,15 +7,19 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/adminloginrestriction"
 	"go-wind-admin/app/admin/service/internal/data/ent/adminoperationlog"
 	"go-wind-admin/app/admin/service/internal/data/ent/apiresource"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
 	"go-wind-admin/app/admin/service/internal/data/ent/department"
 	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
 	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/language"
 	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
 	"go-wind-admin/app/admin/service/internal/data/ent/organization"
 	"go-wind-admin/app/admin/service/internal/data/ent/position"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
@@ -25,10 +29,15 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/rolemenu"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleorg"
 	"go-wind-admin/app/admin/service/internal/data/ent/roleposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
 	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
 	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
 	"go-wind-admin/app/admin/service/internal/data/ent/user"
 	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
 	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
 	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
 
@@ -40,7 +49,7 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 27)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 36)}
 	graph.Nodes[0] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   adminloginlog.Table,
@@ -106,31 +115,35 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "AdminOperationLog",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			adminoperationlog.FieldCreatedAt:      {Type: field.TypeTime, Column: adminoperationlog.FieldCreatedAt},
-			adminoperationlog.FieldRequestID:      {Type: field.TypeString, Column: adminoperationlog.FieldRequestID},
-			adminoperationlog.FieldMethod:         {Type: field.TypeString, Column: adminoperationlog.FieldMethod},
-			adminoperationlog.FieldOperation:      {Type: field.TypeString, Column: adminoperationlog.FieldOperation},
-			adminoperationlog.FieldPath:           {Type: field.TypeString, Column: adminoperationlog.FieldPath},
-			adminoperationlog.FieldReferer:        {Type: field.TypeString, Column: adminoperationlog.FieldReferer},
-			adminoperationlog.FieldRequestURI:     {Type: field.TypeString, Column: adminoperationlog.FieldRequestURI},
-			adminoperationlog.FieldRequestBody:    {Type: field.TypeString, Column: adminoperationlog.FieldRequestBody},
-			adminoperationlog.FieldRequestHeader:  {Type: field.TypeString, Column: adminoperationlog.FieldRequestHeader},
-			adminoperationlog.FieldResponse:       {Type: field.TypeString, Column: adminoperationlog.FieldResponse},
-			adminoperationlog.FieldCostTime:       {Type: field.TypeFloat64, Column: adminoperationlog.FieldCostTime},
-			adminoperationlog.FieldUserID:         {Type: field.TypeUint32, Column: adminoperationlog.FieldUserID},
-			adminoperationlog.FieldUsername:       {Type: field.TypeString, Column: adminoperationlog.FieldUsername},
-			adminoperationlog.FieldClientIP:       {Type: field.TypeString, Column: adminoperationlog.FieldClientIP},
-			adminoperationlog.FieldStatusCode:     {Type: field.TypeInt32, Column: adminoperationlog.FieldStatusCode},
-			adminoperationlog.FieldReason:         {Type: field.TypeString, Column: adminoperationlog.FieldReason},
-			adminoperationlog.FieldSuccess:        {Type: field.TypeBool, Column: adminoperationlog.FieldSuccess},
-			adminoperationlog.FieldLocation:       {Type: field.TypeString, Column: adminoperationlog.FieldLocation},
-			adminoperationlog.FieldUserAgent:      {Type: field.TypeString, Column: adminoperationlog.FieldUserAgent},
-			adminoperationlog.FieldBrowserName:    {Type: field.TypeString, Column: adminoperationlog.FieldBrowserName},
-			adminoperationlog.FieldBrowserVersion: {Type: field.TypeString, Column: adminoperationlog.FieldBrowserVersion},
-			adminoperationlog.FieldClientID:       {Type: field.TypeString, Column: adminoperationlog.FieldClientID},
-			adminoperationlog.FieldClientName:     {Type: field.TypeString, Column: adminoperationlog.FieldClientName},
-			adminoperationlog.FieldOsName:         {Type: field.TypeString, Column: adminoperationlog.FieldOsName},
-			adminoperationlog.FieldOsVersion:      {Type: field.TypeString, Column: adminoperationlog.FieldOsVersion},
+			adminoperationlog.FieldCreatedAt:            {Type: field.TypeTime, Column: adminoperationlog.FieldCreatedAt},
+			adminoperationlog.FieldRequestID:            {Type: field.TypeString, Column: adminoperationlog.FieldRequestID},
+			adminoperationlog.FieldMethod:               {Type: field.TypeString, Column: adminoperationlog.FieldMethod},
+			adminoperationlog.FieldOperation:            {Type: field.TypeString, Column: adminoperationlog.FieldOperation},
+			adminoperationlog.FieldPath:                 {Type: field.TypeString, Column: adminoperationlog.FieldPath},
+			adminoperationlog.FieldReferer:              {Type: field.TypeString, Column: adminoperationlog.FieldReferer},
+			adminoperationlog.FieldRequestURI:           {Type: field.TypeString, Column: adminoperationlog.FieldRequestURI},
+			adminoperationlog.FieldRequestBody:          {Type: field.TypeString, Column: adminoperationlog.FieldRequestBody},
+			adminoperationlog.FieldRequestHeader:        {Type: field.TypeString, Column: adminoperationlog.FieldRequestHeader},
+			adminoperationlog.FieldResponse:             {Type: field.TypeString, Column: adminoperationlog.FieldResponse},
+			adminoperationlog.FieldCostTime:             {Type: field.TypeFloat64, Column: adminoperationlog.FieldCostTime},
+			adminoperationlog.FieldUserID:               {Type: field.TypeUint32, Column: adminoperationlog.FieldUserID},
+			adminoperationlog.FieldUsername:             {Type: field.TypeString, Column: adminoperationlog.FieldUsername},
+			adminoperationlog.FieldClientIP:             {Type: field.TypeString, Column: adminoperationlog.FieldClientIP},
+			adminoperationlog.FieldImpersonatorID:       {Type: field.TypeUint32, Column: adminoperationlog.FieldImpersonatorID},
+			adminoperationlog.FieldImpersonatorName:     {Type: field.TypeString, Column: adminoperationlog.FieldImpersonatorName},
+			adminoperationlog.FieldImpersonationReason:  {Type: field.TypeString, Column: adminoperationlog.FieldImpersonationReason},
+			adminoperationlog.FieldImpersonationAuditID: {Type: field.TypeUint32, Column: adminoperationlog.FieldImpersonationAuditID},
+			adminoperationlog.FieldStatusCode:           {Type: field.TypeInt32, Column: adminoperationlog.FieldStatusCode},
+			adminoperationlog.FieldReason:               {Type: field.TypeString, Column: adminoperationlog.FieldReason},
+			adminoperationlog.FieldSuccess:              {Type: field.TypeBool, Column: adminoperationlog.FieldSuccess},
+			adminoperationlog.FieldLocation:             {Type: field.TypeString, Column: adminoperationlog.FieldLocation},
+			adminoperationlog.FieldUserAgent:            {Type: field.TypeString, Column: adminoperationlog.FieldUserAgent},
+			adminoperationlog.FieldBrowserName:          {Type: field.TypeString, Column: adminoperationlog.FieldBrowserName},
+			adminoperationlog.FieldBrowserVersion:       {Type: field.TypeString, Column: adminoperationlog.FieldBrowserVersion},
+			adminoperationlog.FieldClientID:             {Type: field.TypeString, Column: adminoperationlog.FieldClientID},
+			adminoperationlog.FieldClientName:           {Type: field.TypeString, Column: adminoperationlog.FieldClientName},
+			adminoperationlog.FieldOsName:               {Type: field.TypeString, Column: adminoperationlog.FieldOsName},
+			adminoperationlog.FieldOsVersion:            {Type: field.TypeString, Column: adminoperationlog.FieldOsVersion},
 		},
 	}
 	graph.Nodes[3] = &sqlgraph.Node{
@@ -160,6 +173,57 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 	}
 	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   conversation.Table,
+			Columns: conversation.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: conversation.FieldID,
+			},
+		},
+		Type: "Conversation",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			conversation.FieldCreatedAt:     {Type: field.TypeTime, Column: conversation.FieldCreatedAt},
+			conversation.FieldUpdatedAt:     {Type: field.TypeTime, Column: conversation.FieldUpdatedAt},
+			conversation.FieldDeletedAt:     {Type: field.TypeTime, Column: conversation.FieldDeletedAt},
+			conversation.FieldCreatedBy:     {Type: field.TypeUint32, Column: conversation.FieldCreatedBy},
+			conversation.FieldUpdatedBy:     {Type: field.TypeUint32, Column: conversation.FieldUpdatedBy},
+			conversation.FieldDeletedBy:     {Type: field.TypeUint32, Column: conversation.FieldDeletedBy},
+			conversation.FieldTenantID:      {Type: field.TypeUint32, Column: conversation.FieldTenantID},
+			conversation.FieldType:          {Type: field.TypeEnum, Column: conversation.FieldType},
+			conversation.FieldName:          {Type: field.TypeString, Column: conversation.FieldName},
+			conversation.FieldAvatarURL:     {Type: field.TypeString, Column: conversation.FieldAvatarURL},
+			conversation.FieldDirectKey:     {Type: field.TypeString, Column: conversation.FieldDirectKey},
+			conversation.FieldMemberCount:   {Type: field.TypeUint32, Column: conversation.FieldMemberCount},
+			conversation.FieldLastMessageID: {Type: field.TypeUint32, Column: conversation.FieldLastMessageID},
+			conversation.FieldLastMessageAt: {Type: field.TypeTime, Column: conversation.FieldLastMessageAt},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   conversationmember.Table,
+			Columns: conversationmember.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: conversationmember.FieldID,
+			},
+		},
+		Type: "ConversationMember",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			conversationmember.FieldCreatedAt:         {Type: field.TypeTime, Column: conversationmember.FieldCreatedAt},
+			conversationmember.FieldUpdatedAt:         {Type: field.TypeTime, Column: conversationmember.FieldUpdatedAt},
+			conversationmember.FieldDeletedAt:         {Type: field.TypeTime, Column: conversationmember.FieldDeletedAt},
+			conversationmember.FieldTenantID:          {Type: field.TypeUint32, Column: conversationmember.FieldTenantID},
+			conversationmember.FieldConversationID:    {Type: field.TypeUint32, Column: conversationmember.FieldConversationID},
+			conversationmember.FieldUserID:            {Type: field.TypeUint32, Column: conversationmember.FieldUserID},
+			conversationmember.FieldRole:              {Type: field.TypeEnum, Column: conversationmember.FieldRole},
+			conversationmember.FieldMutedUntil:        {Type: field.TypeTime, Column: conversationmember.FieldMutedUntil},
+			conversationmember.FieldLastReadMessageID: {Type: field.TypeUint32, Column: conversationmember.FieldLastReadMessageID},
+			conversationmember.FieldUnreadCount:       {Type: field.TypeUint32, Column: conversationmember.FieldUnreadCount},
+			conversationmember.FieldJoinedAt:          {Type: field.TypeTime, Column: conversationmember.FieldJoinedAt},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   department.Table,
 			Columns: department.Columns,
@@ -187,7 +251,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			department.FieldDescription:    {Type: field.TypeString, Column: department.FieldDescription},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dictentry.Table,
 			Columns: dictentry.Columns,
@@ -214,7 +278,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dictentry.FieldLanguageCode: {Type: field.TypeString, Column: dictentry.FieldLanguageCode},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[8] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   dicttype.Table,
 			Columns: dicttype.Columns,
@@ -239,7 +303,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			dicttype.FieldTypeName:    {Type: field.TypeString, Column: dicttype.FieldTypeName},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   file.Table,
 			Columns: file.Columns,
@@ -271,7 +335,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			file.FieldMd5:           {Type: field.TypeString, Column: file.FieldMd5},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessage.Table,
 			Columns: internalmessage.Columns,
@@ -282,22 +346,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "InternalMessage",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			internalmessage.FieldCreatedAt:  {Type: field.TypeTime, Column: internalmessage.FieldCreatedAt},
-			internalmessage.FieldUpdatedAt:  {Type: field.TypeTime, Column: internalmessage.FieldUpdatedAt},
-			internalmessage.FieldDeletedAt:  {Type: field.TypeTime, Column: internalmessage.FieldDeletedAt},
-			internalmessage.FieldCreatedBy:  {Type: field.TypeUint32, Column: internalmessage.FieldCreatedBy},
-			internalmessage.FieldUpdatedBy:  {Type: field.TypeUint32, Column: internalmessage.FieldUpdatedBy},
-			internalmessage.FieldDeletedBy:  {Type: field.TypeUint32, Column: internalmessage.FieldDeletedBy},
-			internalmessage.FieldTenantID:   {Type: field.TypeUint32, Column: internalmessage.FieldTenantID},
-			internalmessage.FieldTitle:      {Type: field.TypeString, Column: internalmessage.FieldTitle},
-			internalmessage.FieldContent:    {Type: field.TypeString, Column: internalmessage.FieldContent},
-			internalmessage.FieldSenderID:   {Type: field.TypeUint32, Column: internalmessage.FieldSenderID},
-			internalmessage.FieldCategoryID: {Type: field.TypeUint32, Column: internalmessage.FieldCategoryID},
-			internalmessage.FieldStatus:     {Type: field.TypeEnum, Column: internalmessage.FieldStatus},
-			internalmessage.FieldType:       {Type: field.TypeEnum, Column: internalmessage.FieldType},
+			internalmessage.FieldCreatedAt:          {Type: field.TypeTime, Column: internalmessage.FieldCreatedAt},
+			internalmessage.FieldUpdatedAt:          {Type: field.TypeTime, Column: internalmessage.FieldUpdatedAt},
+			internalmessage.FieldDeletedAt:          {Type: field.TypeTime, Column: internalmessage.FieldDeletedAt},
+			internalmessage.FieldCreatedBy:          {Type: field.TypeUint32, Column: internalmessage.FieldCreatedBy},
+			internalmessage.FieldUpdatedBy:          {Type: field.TypeUint32, Column: internalmessage.FieldUpdatedBy},
+			internalmessage.FieldDeletedBy:          {Type: field.TypeUint32, Column: internalmessage.FieldDeletedBy},
+			internalmessage.FieldTenantID:           {Type: field.TypeUint32, Column: internalmessage.FieldTenantID},
+			internalmessage.FieldTitle:              {Type: field.TypeString, Column: internalmessage.FieldTitle},
+			internalmessage.FieldContent:            {Type: field.TypeString, Column: internalmessage.FieldContent},
+			internalmessage.FieldSenderID:           {Type: field.TypeUint32, Column: internalmessage.FieldSenderID},
+			internalmessage.FieldCategoryID:         {Type: field.TypeUint32, Column: internalmessage.FieldCategoryID},
+			internalmessage.FieldStatus:             {Type: field.TypeEnum, Column: internalmessage.FieldStatus},
+			internalmessage.FieldType:               {Type: field.TypeEnum, Column: internalmessage.FieldType},
+			internalmessage.FieldSendAt:             {Type: field.TypeTime, Column: internalmessage.FieldSendAt},
+			internalmessage.FieldCronSpec:           {Type: field.TypeString, Column: internalmessage.FieldCronSpec},
+			internalmessage.FieldLastSentAt:         {Type: field.TypeTime, Column: internalmessage.FieldLastSentAt},
+			internalmessage.FieldTargetAll:          {Type: field.TypeBool, Column: internalmessage.FieldTargetAll},
+			internalmessage.FieldTargetUserIds:      {Type: field.TypeJSON, Column: internalmessage.FieldTargetUserIds},
+			internalmessage.FieldAudience:           {Type: field.TypeJSON, Column: internalmessage.FieldAudience},
+			internalmessage.FieldDeliveryStatus:     {Type: field.TypeEnum, Column: internalmessage.FieldDeliveryStatus},
+			internalmessage.FieldRecipientTotal:     {Type: field.TypeUint32, Column: internalmessage.FieldRecipientTotal},
+			internalmessage.FieldDeliveredCount:     {Type: field.TypeUint32, Column: internalmessage.FieldDeliveredCount},
+			internalmessage.FieldFailedCount:        {Type: field.TypeUint32, Column: internalmessage.FieldFailedCount},
+			internalmessage.FieldDeliveryStartedAt:  {Type: field.TypeTime, Column: internalmessage.FieldDeliveryStartedAt},
+			internalmessage.FieldDeliveryFinishedAt: {Type: field.TypeTime, Column: internalmessage.FieldDeliveryFinishedAt},
+			internalmessage.FieldTemplateCode:       {Type: field.TypeString, Column: internalmessage.FieldTemplateCode},
+			internalmessage.FieldTemplateVariables:  {Type: field.TypeJSON, Column: internalmessage.FieldTemplateVariables},
+			internalmessage.FieldConversationID:     {Type: field.TypeUint32, Column: internalmessage.FieldConversationID},
+			internalmessage.FieldThreadID:           {Type: field.TypeUint32, Column: internalmessage.FieldThreadID},
+			internalmessage.FieldReplyCount:         {Type: field.TypeUint32, Column: internalmessage.FieldReplyCount},
+			internalmessage.FieldRemindCount:        {Type: field.TypeUint32, Column: internalmessage.FieldRemindCount},
+			internalmessage.FieldLastRemindedAt:     {Type: field.TypeTime, Column: internalmessage.FieldLastRemindedAt},
+			internalmessage.FieldPriority:           {Type: field.TypeEnum, Column: internalmessage.FieldPriority},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagecategory.Table,
 			Columns: internalmessagecategory.Columns,
@@ -322,9 +406,36 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagecategory.FieldName:      {Type: field.TypeString, Column: internalmessagecategory.FieldName},
 			internalmessagecategory.FieldCode:      {Type: field.TypeString, Column: internalmessagecategory.FieldCode},
 			internalmessagecategory.FieldIconURL:   {Type: field.TypeString, Column: internalmessagecategory.FieldIconURL},
+			internalmessagecategory.FieldChannels:  {Type: field.TypeJSON, Column: internalmessagecategory.FieldChannels},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[12] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   internalmessagedelivery.Table,
+			Columns: internalmessagedelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: internalmessagedelivery.FieldID,
+			},
+		},
+		Type: "InternalMessageDelivery",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			internalmessagedelivery.FieldCreatedAt:       {Type: field.TypeTime, Column: internalmessagedelivery.FieldCreatedAt},
+			internalmessagedelivery.FieldUpdatedAt:       {Type: field.TypeTime, Column: internalmessagedelivery.FieldUpdatedAt},
+			internalmessagedelivery.FieldDeletedAt:       {Type: field.TypeTime, Column: internalmessagedelivery.FieldDeletedAt},
+			internalmessagedelivery.FieldTenantID:        {Type: field.TypeUint32, Column: internalmessagedelivery.FieldTenantID},
+			internalmessagedelivery.FieldMessageID:       {Type: field.TypeUint32, Column: internalmessagedelivery.FieldMessageID},
+			internalmessagedelivery.FieldRecipientID:     {Type: field.TypeUint32, Column: internalmessagedelivery.FieldRecipientID},
+			internalmessagedelivery.FieldRecipientUserID: {Type: field.TypeUint32, Column: internalmessagedelivery.FieldRecipientUserID},
+			internalmessagedelivery.FieldChannel:         {Type: field.TypeString, Column: internalmessagedelivery.FieldChannel},
+			internalmessagedelivery.FieldAddress:         {Type: field.TypeString, Column: internalmessagedelivery.FieldAddress},
+			internalmessagedelivery.FieldStatus:          {Type: field.TypeEnum, Column: internalmessagedelivery.FieldStatus},
+			internalmessagedelivery.FieldAttempts:        {Type: field.TypeUint32, Column: internalmessagedelivery.FieldAttempts},
+			internalmessagedelivery.FieldLastError:       {Type: field.TypeString, Column: internalmessagedelivery.FieldLastError},
+			internalmessagedelivery.FieldSentAt:          {Type: field.TypeTime, Column: internalmessagedelivery.FieldSentAt},
+		},
+	}
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   internalmessagerecipient.Table,
 			Columns: internalmessagerecipient.Columns,
@@ -344,9 +455,11 @@ var schemaGraph = func() *sqlgraph.Schema {
 			internalmessagerecipient.FieldStatus:          {Type: field.TypeEnum, Column: internalmessagerecipient.FieldStatus},
 			internalmessagerecipient.FieldReceivedAt:      {Type: field.TypeTime, Column: internalmessagerecipient.FieldReceivedAt},
 			internalmessagerecipient.FieldReadAt:          {Type: field.TypeTime, Column: internalmessagerecipient.FieldReadAt},
+			internalmessagerecipient.FieldTitle:           {Type: field.TypeString, Column: internalmessagerecipient.FieldTitle},
+			internalmessagerecipient.FieldContent:         {Type: field.TypeString, Column: internalmessagerecipient.FieldContent},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   language.Table,
 			Columns: language.Columns,
@@ -371,7 +484,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			language.FieldIsDefault:    {Type: field.TypeBool, Column: language.FieldIsDefault},
 		},
 	}
-	graph.Nodes[12] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   menu.Table,
 			Columns: menu.Columns,
@@ -400,7 +513,34 @@ var schemaGraph = func() *sqlgraph.Schema {
 			menu.FieldMeta:      {Type: field.TypeJSON, Column: menu.FieldMeta},
 		},
 	}
-	graph.Nodes[13] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   messagetemplate.Table,
+			Columns: messagetemplate.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: messagetemplate.FieldID,
+			},
+		},
+		Type: "MessageTemplate",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			messagetemplate.FieldCreatedAt:       {Type: field.TypeTime, Column: messagetemplate.FieldCreatedAt},
+			messagetemplate.FieldUpdatedAt:       {Type: field.TypeTime, Column: messagetemplate.FieldUpdatedAt},
+			messagetemplate.FieldDeletedAt:       {Type: field.TypeTime, Column: messagetemplate.FieldDeletedAt},
+			messagetemplate.FieldCreatedBy:       {Type: field.TypeUint32, Column: messagetemplate.FieldCreatedBy},
+			messagetemplate.FieldUpdatedBy:       {Type: field.TypeUint32, Column: messagetemplate.FieldUpdatedBy},
+			messagetemplate.FieldDeletedBy:       {Type: field.TypeUint32, Column: messagetemplate.FieldDeletedBy},
+			messagetemplate.FieldIsEnabled:       {Type: field.TypeBool, Column: messagetemplate.FieldIsEnabled},
+			messagetemplate.FieldRemark:          {Type: field.TypeString, Column: messagetemplate.FieldRemark},
+			messagetemplate.FieldTenantID:        {Type: field.TypeUint32, Column: messagetemplate.FieldTenantID},
+			messagetemplate.FieldCode:            {Type: field.TypeString, Column: messagetemplate.FieldCode},
+			messagetemplate.FieldName:            {Type: field.TypeString, Column: messagetemplate.FieldName},
+			messagetemplate.FieldCategoryID:      {Type: field.TypeUint32, Column: messagetemplate.FieldCategoryID},
+			messagetemplate.FieldDefaultLanguage: {Type: field.TypeString, Column: messagetemplate.FieldDefaultLanguage},
+			messagetemplate.FieldLocales:         {Type: field.TypeJSON, Column: messagetemplate.FieldLocales},
+		},
+	}
+	graph.Nodes[17] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   organization.Table,
 			Columns: organization.Columns,
@@ -431,7 +571,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			organization.FieldManagerID:        {Type: field.TypeUint32, Column: organization.FieldManagerID},
 		},
 	}
-	graph.Nodes[14] = &sqlgraph.Node{
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   position.Table,
 			Columns: position.Columns,
@@ -461,7 +601,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			position.FieldQuota:          {Type: field.TypeUint32, Column: position.FieldQuota},
 		},
 	}
-	graph.Nodes[15] = &sqlgraph.Node{
+	graph.Nodes[19] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   role.Table,
 			Columns: role.Columns,
@@ -490,7 +630,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			role.FieldStatus:    {Type: field.TypeEnum, Column: role.FieldStatus},
 		},
 	}
-	graph.Nodes[16] = &sqlgraph.Node{
+	graph.Nodes[20] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   roleapi.Table,
 			Columns: roleapi.Columns,
@@ -511,7 +651,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			roleapi.FieldAPIID:     {Type: field.TypeUint32, Column: roleapi.FieldAPIID},
 		},
 	}
-	graph.Nodes[17] = &sqlgraph.Node{
+	graph.Nodes[21] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   roledept.Table,
 			Columns: roledept.Columns,
@@ -532,7 +672,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			roledept.FieldDeptID:    {Type: field.TypeUint32, Column: roledept.FieldDeptID},
 		},
 	}
-	graph.Nodes[18] = &sqlgraph.Node{
+	graph.Nodes[22] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rolemenu.Table,
 			Columns: rolemenu.Columns,
@@ -553,7 +693,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rolemenu.FieldMenuID:    {Type: field.TypeUint32, Column: rolemenu.FieldMenuID},
 		},
 	}
-	graph.Nodes[19] = &sqlgraph.Node{
+	graph.Nodes[23] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   roleorg.Table,
 			Columns: roleorg.Columns,
@@ -574,7 +714,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			roleorg.FieldOrgID:     {Type: field.TypeUint32, Column: roleorg.FieldOrgID},
 		},
 	}
-	graph.Nodes[20] = &sqlgraph.Node{
+	graph.Nodes[24] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   roleposition.Table,
 			Columns: roleposition.Columns,
@@ -595,7 +735,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 			roleposition.FieldPositionID: {Type: field.TypeUint32, Column: roleposition.FieldPositionID},
 		},
 	}
-	graph.Nodes[21] = &sqlgraph.Node{
+	graph.Nodes[25] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   setting.Table,
+			Columns: setting.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: setting.FieldID,
+			},
+		},
+		Type: "Setting",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			setting.FieldCreatedAt: {Type: field.TypeTime, Column: setting.FieldCreatedAt},
+			setting.FieldUpdatedAt: {Type: field.TypeTime, Column: setting.FieldUpdatedAt},
+			setting.FieldDeletedAt: {Type: field.TypeTime, Column: setting.FieldDeletedAt},
+			setting.FieldCreatedBy: {Type: field.TypeUint32, Column: setting.FieldCreatedBy},
+			setting.FieldUpdatedBy: {Type: field.TypeUint32, Column: setting.FieldUpdatedBy},
+			setting.FieldDeletedBy: {Type: field.TypeUint32, Column: setting.FieldDeletedBy},
+			setting.FieldTenantID:  {Type: field.TypeUint32, Column: setting.FieldTenantID},
+			setting.FieldUserID:    {Type: field.TypeUint32, Column: setting.FieldUserID},
+			setting.FieldKey:       {Type: field.TypeString, Column: setting.FieldKey},
+			setting.FieldValue:     {Type: field.TypeString, Column: setting.FieldValue},
+		},
+	}
+	graph.Nodes[26] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   task.Table,
 			Columns: task.Columns,
@@ -622,7 +785,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 			task.FieldEnable:      {Type: field.TypeBool, Column: task.FieldEnable},
 		},
 	}
-	graph.Nodes[22] = &sqlgraph.Node{
+	graph.Nodes[27] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   taskrun.Table,
+			Columns: taskrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: taskrun.FieldID,
+			},
+		},
+		Type: "TaskRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			taskrun.FieldCreatedAt:       {Type: field.TypeTime, Column: taskrun.FieldCreatedAt},
+			taskrun.FieldUpdatedAt:       {Type: field.TypeTime, Column: taskrun.FieldUpdatedAt},
+			taskrun.FieldDeletedAt:       {Type: field.TypeTime, Column: taskrun.FieldDeletedAt},
+			taskrun.FieldCreatedBy:       {Type: field.TypeUint32, Column: taskrun.FieldCreatedBy},
+			taskrun.FieldUpdatedBy:       {Type: field.TypeUint32, Column: taskrun.FieldUpdatedBy},
+			taskrun.FieldDeletedBy:       {Type: field.TypeUint32, Column: taskrun.FieldDeletedBy},
+			taskrun.FieldTenantID:        {Type: field.TypeUint32, Column: taskrun.FieldTenantID},
+			taskrun.FieldTaskID:          {Type: field.TypeUint32, Column: taskrun.FieldTaskID},
+			taskrun.FieldTypeName:        {Type: field.TypeString, Column: taskrun.FieldTypeName},
+			taskrun.FieldRunID:           {Type: field.TypeString, Column: taskrun.FieldRunID},
+			taskrun.FieldQueue:           {Type: field.TypeString, Column: taskrun.FieldQueue},
+			taskrun.FieldStatus:          {Type: field.TypeEnum, Column: taskrun.FieldStatus},
+			taskrun.FieldTrigger:         {Type: field.TypeEnum, Column: taskrun.FieldTrigger},
+			taskrun.FieldStartedAt:       {Type: field.TypeTime, Column: taskrun.FieldStartedAt},
+			taskrun.FieldFinishedAt:      {Type: field.TypeTime, Column: taskrun.FieldFinishedAt},
+			taskrun.FieldDurationMs:      {Type: field.TypeInt64, Column: taskrun.FieldDurationMs},
+			taskrun.FieldRetryCount:      {Type: field.TypeInt32, Column: taskrun.FieldRetryCount},
+			taskrun.FieldMaxRetry:        {Type: field.TypeInt32, Column: taskrun.FieldMaxRetry},
+			taskrun.FieldProgress:        {Type: field.TypeInt32, Column: taskrun.FieldProgress},
+			taskrun.FieldProgressMessage: {Type: field.TypeString, Column: taskrun.FieldProgressMessage},
+			taskrun.FieldErrorMessage:    {Type: field.TypeString, Column: taskrun.FieldErrorMessage},
+			taskrun.FieldResult:          {Type: field.TypeString, Column: taskrun.FieldResult},
+		},
+	}
+	graph.Nodes[28] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   tenant.Table,
 			Columns: tenant.Columns,
@@ -651,12 +849,67 @@ var schemaGraph = func() *sqlgraph.Schema {
 			tenant.FieldSubscriptionAt:   {Type: field.TypeTime, Column: tenant.FieldSubscriptionAt},
 			tenant.FieldUnsubscribeAt:    {Type: field.TypeTime, Column: tenant.FieldUnsubscribeAt},
 			tenant.FieldSubscriptionPlan: {Type: field.TypeString, Column: tenant.FieldSubscriptionPlan},
+			tenant.FieldPlanID:           {Type: field.TypeUint32, Column: tenant.FieldPlanID},
 			tenant.FieldExpiredAt:        {Type: field.TypeTime, Column: tenant.FieldExpiredAt},
 			tenant.FieldLastLoginTime:    {Type: field.TypeTime, Column: tenant.FieldLastLoginTime},
 			tenant.FieldLastLoginIP:      {Type: field.TypeString, Column: tenant.FieldLastLoginIP},
 		},
 	}
-	graph.Nodes[23] = &sqlgraph.Node{
+	graph.Nodes[29] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tenantplan.Table,
+			Columns: tenantplan.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: tenantplan.FieldID,
+			},
+		},
+		Type: "TenantPlan",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tenantplan.FieldCreatedAt:            {Type: field.TypeTime, Column: tenantplan.FieldCreatedAt},
+			tenantplan.FieldUpdatedAt:            {Type: field.TypeTime, Column: tenantplan.FieldUpdatedAt},
+			tenantplan.FieldDeletedAt:            {Type: field.TypeTime, Column: tenantplan.FieldDeletedAt},
+			tenantplan.FieldCreatedBy:            {Type: field.TypeUint32, Column: tenantplan.FieldCreatedBy},
+			tenantplan.FieldUpdatedBy:            {Type: field.TypeUint32, Column: tenantplan.FieldUpdatedBy},
+			tenantplan.FieldDeletedBy:            {Type: field.TypeUint32, Column: tenantplan.FieldDeletedBy},
+			tenantplan.FieldRemark:               {Type: field.TypeString, Column: tenantplan.FieldRemark},
+			tenantplan.FieldSortOrder:            {Type: field.TypeInt32, Column: tenantplan.FieldSortOrder},
+			tenantplan.FieldName:                 {Type: field.TypeString, Column: tenantplan.FieldName},
+			tenantplan.FieldCode:                 {Type: field.TypeString, Column: tenantplan.FieldCode},
+			tenantplan.FieldDescription:          {Type: field.TypeString, Column: tenantplan.FieldDescription},
+			tenantplan.FieldStatus:               {Type: field.TypeEnum, Column: tenantplan.FieldStatus},
+			tenantplan.FieldMaxUsers:             {Type: field.TypeUint32, Column: tenantplan.FieldMaxUsers},
+			tenantplan.FieldMaxStorageBytes:      {Type: field.TypeUint64, Column: tenantplan.FieldMaxStorageBytes},
+			tenantplan.FieldMaxAPIRequestsPerDay: {Type: field.TypeUint32, Column: tenantplan.FieldMaxAPIRequestsPerDay},
+			tenantplan.FieldModules:              {Type: field.TypeJSON, Column: tenantplan.FieldModules},
+			tenantplan.FieldMenus:                {Type: field.TypeJSON, Column: tenantplan.FieldMenus},
+			tenantplan.FieldFeatures:             {Type: field.TypeJSON, Column: tenantplan.FieldFeatures},
+		},
+	}
+	graph.Nodes[30] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   tenantusage.Table,
+			Columns: tenantusage.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: tenantusage.FieldID,
+			},
+		},
+		Type: "TenantUsage",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			tenantusage.FieldCreatedAt:        {Type: field.TypeTime, Column: tenantusage.FieldCreatedAt},
+			tenantusage.FieldUpdatedAt:        {Type: field.TypeTime, Column: tenantusage.FieldUpdatedAt},
+			tenantusage.FieldDeletedAt:        {Type: field.TypeTime, Column: tenantusage.FieldDeletedAt},
+			tenantusage.FieldTenantID:         {Type: field.TypeUint32, Column: tenantusage.FieldTenantID},
+			tenantusage.FieldPeriodStart:      {Type: field.TypeTime, Column: tenantusage.FieldPeriodStart},
+			tenantusage.FieldActiveUsers:      {Type: field.TypeUint32, Column: tenantusage.FieldActiveUsers},
+			tenantusage.FieldAPICalls:         {Type: field.TypeUint64, Column: tenantusage.FieldAPICalls},
+			tenantusage.FieldAPICallsByModule: {Type: field.TypeJSON, Column: tenantusage.FieldAPICallsByModule},
+			tenantusage.FieldStorageBytes:     {Type: field.TypeUint64, Column: tenantusage.FieldStorageBytes},
+			tenantusage.FieldMessagesSent:     {Type: field.TypeUint64, Column: tenantusage.FieldMessagesSent},
+		},
+	}
+	graph.Nodes[31] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -684,6 +937,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldAvatar:        {Type: field.TypeString, Column: user.FieldAvatar},
 			user.FieldAddress:       {Type: field.TypeString, Column: user.FieldAddress},
 			user.FieldRegion:        {Type: field.TypeString, Column: user.FieldRegion},
+			user.FieldLanguage:      {Type: field.TypeString, Column: user.FieldLanguage},
 			user.FieldDescription:   {Type: field.TypeString, Column: user.FieldDescription},
 			user.FieldGender:        {Type: field.TypeEnum, Column: user.FieldGender},
 			user.FieldAuthority:     {Type: field.TypeEnum, Column: user.FieldAuthority},
@@ -697,7 +951,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			user.FieldRoleIds:       {Type: field.TypeJSON, Column: user.FieldRoleIds},
 		},
 	}
-	graph.Nodes[24] = &sqlgraph.Node{
+	graph.Nodes[32] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   usercredential.Table,
 			Columns: usercredential.Columns,
@@ -730,7 +984,30 @@ var schemaGraph = func() *sqlgraph.Schema {
 			usercredential.FieldResetTokenUsedAt:       {Type: field.TypeTime, Column: usercredential.FieldResetTokenUsedAt},
 		},
 	}
-	graph.Nodes[25] = &sqlgraph.Node{
+	graph.Nodes[33] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   usernotificationpreference.Table,
+			Columns: usernotificationpreference.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUint32,
+				Column: usernotificationpreference.FieldID,
+			},
+		},
+		Type: "UserNotificationPreference",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			usernotificationpreference.FieldCreatedAt:        {Type: field.TypeTime, Column: usernotificationpreference.FieldCreatedAt},
+			usernotificationpreference.FieldUpdatedAt:        {Type: field.TypeTime, Column: usernotificationpreference.FieldUpdatedAt},
+			usernotificationpreference.FieldDeletedAt:        {Type: field.TypeTime, Column: usernotificationpreference.FieldDeletedAt},
+			usernotificationpreference.FieldTenantID:         {Type: field.TypeUint32, Column: usernotificationpreference.FieldTenantID},
+			usernotificationpreference.FieldUserID:           {Type: field.TypeUint32, Column: usernotificationpreference.FieldUserID},
+			usernotificationpreference.FieldDisabledChannels: {Type: field.TypeJSON, Column: usernotificationpreference.FieldDisabledChannels},
+			usernotificationpreference.FieldCategoryChannels: {Type: field.TypeJSON, Column: usernotificationpreference.FieldCategoryChannels},
+			usernotificationpreference.FieldMutedCategoryIds: {Type: field.TypeJSON, Column: usernotificationpreference.FieldMutedCategoryIds},
+			usernotificationpreference.FieldQuietHours:       {Type: field.TypeJSON, Column: usernotificationpreference.FieldQuietHours},
+			usernotificationpreference.FieldDigest:           {Type: field.TypeJSON, Column: usernotificationpreference.FieldDigest},
+		},
+	}
+	graph.Nodes[34] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userposition.Table,
 			Columns: userposition.Columns,
@@ -751,7 +1028,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userposition.FieldPositionID: {Type: field.TypeUint32, Column: userposition.FieldPositionID},
 		},
 	}
-	graph.Nodes[26] = &sqlgraph.Node{
+	graph.Nodes[35] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userrole.Table,
 			Columns: userrole.Columns,
@@ -1279,6 +1556,26 @@ func (f *AdminOperationLogFilter) WhereClientIP(p entql.StringP) {
 	f.Where(p.Field(adminoperationlog.FieldClientIP))
 }
 
+// WhereImpersonatorID applies the entql uint32 predicate on the impersonator_id field.
+func (f *AdminOperationLogFilter) WhereImpersonatorID(p entql.Uint32P) {
+	f.Where(p.Field(adminoperationlog.FieldImpersonatorID))
+}
+
+// WhereImpersonatorName applies the entql string predicate on the impersonator_name field.
+func (f *AdminOperationLogFilter) WhereImpersonatorName(p entql.StringP) {
+	f.Where(p.Field(adminoperationlog.FieldImpersonatorName))
+}
+
+// WhereImpersonationReason applies the entql string predicate on the impersonation_reason field.
+func (f *AdminOperationLogFilter) WhereImpersonationReason(p entql.StringP) {
+	f.Where(p.Field(adminoperationlog.FieldImpersonationReason))
+}
+
+// WhereImpersonationAuditID applies the entql uint32 predicate on the impersonation_audit_id field.
+func (f *AdminOperationLogFilter) WhereImpersonationAuditID(p entql.Uint32P) {
+	f.Where(p.Field(adminoperationlog.FieldImpersonationAuditID))
+}
+
 // WhereStatusCode applies the entql int32 predicate on the status_code field.
 func (f *AdminOperationLogFilter) WhereStatusCode(p entql.Int32P) {
 	f.Where(p.Field(adminoperationlog.FieldStatusCode))
@@ -1439,6 +1736,211 @@ func (f *ApiResourceFilter) WhereScope(p entql.StringP) {
 	f.Where(p.Field(apiresource.FieldScope))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *ConversationQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ConversationQuery builder.
+func (_q *ConversationQuery) Filter() *ConversationFilter {
+	return &ConversationFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ConversationMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ConversationMutation builder.
+func (m *ConversationMutation) Filter() *ConversationFilter {
+	return &ConversationFilter{config: m.config, predicateAdder: m}
+}
+
+// ConversationFilter provides a generic filtering capability at runtime for ConversationQuery.
+type ConversationFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ConversationFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *ConversationFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ConversationFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(conversation.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ConversationFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(conversation.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ConversationFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(conversation.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *ConversationFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *ConversationFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *ConversationFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *ConversationFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldTenantID))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *ConversationFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldType))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *ConversationFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldName))
+}
+
+// WhereAvatarURL applies the entql string predicate on the avatar_url field.
+func (f *ConversationFilter) WhereAvatarURL(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldAvatarURL))
+}
+
+// WhereDirectKey applies the entql string predicate on the direct_key field.
+func (f *ConversationFilter) WhereDirectKey(p entql.StringP) {
+	f.Where(p.Field(conversation.FieldDirectKey))
+}
+
+// WhereMemberCount applies the entql uint32 predicate on the member_count field.
+func (f *ConversationFilter) WhereMemberCount(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldMemberCount))
+}
+
+// WhereLastMessageID applies the entql uint32 predicate on the last_message_id field.
+func (f *ConversationFilter) WhereLastMessageID(p entql.Uint32P) {
+	f.Where(p.Field(conversation.FieldLastMessageID))
+}
+
+// WhereLastMessageAt applies the entql time.Time predicate on the last_message_at field.
+func (f *ConversationFilter) WhereLastMessageAt(p entql.TimeP) {
+	f.Where(p.Field(conversation.FieldLastMessageAt))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *ConversationMemberQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ConversationMemberQuery builder.
+func (_q *ConversationMemberQuery) Filter() *ConversationMemberFilter {
+	return &ConversationMemberFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ConversationMemberMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ConversationMemberMutation builder.
+func (m *ConversationMemberMutation) Filter() *ConversationMemberFilter {
+	return &ConversationMemberFilter{config: m.config, predicateAdder: m}
+}
+
+// ConversationMemberFilter provides a generic filtering capability at runtime for ConversationMemberQuery.
+type ConversationMemberFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ConversationMemberFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *ConversationMemberFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *ConversationMemberFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(conversationmember.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *ConversationMemberFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(conversationmember.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ConversationMemberFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(conversationmember.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *ConversationMemberFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldTenantID))
+}
+
+// WhereConversationID applies the entql uint32 predicate on the conversation_id field.
+func (f *ConversationMemberFilter) WhereConversationID(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldConversationID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *ConversationMemberFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldUserID))
+}
+
+// WhereRole applies the entql string predicate on the role field.
+func (f *ConversationMemberFilter) WhereRole(p entql.StringP) {
+	f.Where(p.Field(conversationmember.FieldRole))
+}
+
+// WhereMutedUntil applies the entql time.Time predicate on the muted_until field.
+func (f *ConversationMemberFilter) WhereMutedUntil(p entql.TimeP) {
+	f.Where(p.Field(conversationmember.FieldMutedUntil))
+}
+
+// WhereLastReadMessageID applies the entql uint32 predicate on the last_read_message_id field.
+func (f *ConversationMemberFilter) WhereLastReadMessageID(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldLastReadMessageID))
+}
+
+// WhereUnreadCount applies the entql uint32 predicate on the unread_count field.
+func (f *ConversationMemberFilter) WhereUnreadCount(p entql.Uint32P) {
+	f.Where(p.Field(conversationmember.FieldUnreadCount))
+}
+
+// WhereJoinedAt applies the entql time.Time predicate on the joined_at field.
+func (f *ConversationMemberFilter) WhereJoinedAt(p entql.TimeP) {
+	f.Where(p.Field(conversationmember.FieldJoinedAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *DepartmentQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -1468,7 +1970,7 @@ type DepartmentFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DepartmentFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1611,7 +2113,7 @@ type DictEntryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DictEntryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1735,7 +2237,7 @@ type DictTypeFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DictTypeFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1849,7 +2351,7 @@ type FileFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *FileFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1984,7 +2486,7 @@ type InternalMessageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2060,12 +2562,112 @@ func (f *InternalMessageFilter) WhereType(p entql.StringP) {
 	f.Where(p.Field(internalmessage.FieldType))
 }
 
-// addPredicate implements the predicateAdder interface.
-func (_q *InternalMessageCategoryQuery) addPredicate(pred func(s *sql.Selector)) {
-	_q.predicates = append(_q.predicates, pred)
+// WhereSendAt applies the entql time.Time predicate on the send_at field.
+func (f *InternalMessageFilter) WhereSendAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldSendAt))
 }
 
-// Filter returns a Filter implementation to apply filters on the InternalMessageCategoryQuery builder.
+// WhereCronSpec applies the entql string predicate on the cron_spec field.
+func (f *InternalMessageFilter) WhereCronSpec(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldCronSpec))
+}
+
+// WhereLastSentAt applies the entql time.Time predicate on the last_sent_at field.
+func (f *InternalMessageFilter) WhereLastSentAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldLastSentAt))
+}
+
+// WhereTargetAll applies the entql bool predicate on the target_all field.
+func (f *InternalMessageFilter) WhereTargetAll(p entql.BoolP) {
+	f.Where(p.Field(internalmessage.FieldTargetAll))
+}
+
+// WhereTargetUserIds applies the entql json.RawMessage predicate on the target_user_ids field.
+func (f *InternalMessageFilter) WhereTargetUserIds(p entql.BytesP) {
+	f.Where(p.Field(internalmessage.FieldTargetUserIds))
+}
+
+// WhereAudience applies the entql json.RawMessage predicate on the audience field.
+func (f *InternalMessageFilter) WhereAudience(p entql.BytesP) {
+	f.Where(p.Field(internalmessage.FieldAudience))
+}
+
+// WhereDeliveryStatus applies the entql string predicate on the delivery_status field.
+func (f *InternalMessageFilter) WhereDeliveryStatus(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldDeliveryStatus))
+}
+
+// WhereRecipientTotal applies the entql uint32 predicate on the recipient_total field.
+func (f *InternalMessageFilter) WhereRecipientTotal(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldRecipientTotal))
+}
+
+// WhereDeliveredCount applies the entql uint32 predicate on the delivered_count field.
+func (f *InternalMessageFilter) WhereDeliveredCount(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldDeliveredCount))
+}
+
+// WhereFailedCount applies the entql uint32 predicate on the failed_count field.
+func (f *InternalMessageFilter) WhereFailedCount(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldFailedCount))
+}
+
+// WhereDeliveryStartedAt applies the entql time.Time predicate on the delivery_started_at field.
+func (f *InternalMessageFilter) WhereDeliveryStartedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldDeliveryStartedAt))
+}
+
+// WhereDeliveryFinishedAt applies the entql time.Time predicate on the delivery_finished_at field.
+func (f *InternalMessageFilter) WhereDeliveryFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldDeliveryFinishedAt))
+}
+
+// WhereTemplateCode applies the entql string predicate on the template_code field.
+func (f *InternalMessageFilter) WhereTemplateCode(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldTemplateCode))
+}
+
+// WhereTemplateVariables applies the entql json.RawMessage predicate on the template_variables field.
+func (f *InternalMessageFilter) WhereTemplateVariables(p entql.BytesP) {
+	f.Where(p.Field(internalmessage.FieldTemplateVariables))
+}
+
+// WhereConversationID applies the entql uint32 predicate on the conversation_id field.
+func (f *InternalMessageFilter) WhereConversationID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldConversationID))
+}
+
+// WhereThreadID applies the entql uint32 predicate on the thread_id field.
+func (f *InternalMessageFilter) WhereThreadID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldThreadID))
+}
+
+// WhereReplyCount applies the entql uint32 predicate on the reply_count field.
+func (f *InternalMessageFilter) WhereReplyCount(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldReplyCount))
+}
+
+// WhereRemindCount applies the entql uint32 predicate on the remind_count field.
+func (f *InternalMessageFilter) WhereRemindCount(p entql.Uint32P) {
+	f.Where(p.Field(internalmessage.FieldRemindCount))
+}
+
+// WhereLastRemindedAt applies the entql time.Time predicate on the last_reminded_at field.
+func (f *InternalMessageFilter) WhereLastRemindedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessage.FieldLastRemindedAt))
+}
+
+// WherePriority applies the entql string predicate on the priority field.
+func (f *InternalMessageFilter) WherePriority(p entql.StringP) {
+	f.Where(p.Field(internalmessage.FieldPriority))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *InternalMessageCategoryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InternalMessageCategoryQuery builder.
 func (_q *InternalMessageCategoryQuery) Filter() *InternalMessageCategoryFilter {
 	return &InternalMessageCategoryFilter{config: _q.config, predicateAdder: _q}
 }
@@ -2089,7 +2691,7 @@ type InternalMessageCategoryFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageCategoryFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2170,6 +2772,11 @@ func (f *InternalMessageCategoryFilter) WhereIconURL(p entql.StringP) {
 	f.Where(p.Field(internalmessagecategory.FieldIconURL))
 }
 
+// WhereChannels applies the entql json.RawMessage predicate on the channels field.
+func (f *InternalMessageCategoryFilter) WhereChannels(p entql.BytesP) {
+	f.Where(p.Field(internalmessagecategory.FieldChannels))
+}
+
 // WhereHasParent applies a predicate to check if query has an edge parent.
 func (f *InternalMessageCategoryFilter) WhereHasParent() {
 	f.Where(entql.HasEdge("parent"))
@@ -2198,6 +2805,111 @@ func (f *InternalMessageCategoryFilter) WhereHasChildrenWith(preds ...predicate.
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *InternalMessageDeliveryQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the InternalMessageDeliveryQuery builder.
+func (_q *InternalMessageDeliveryQuery) Filter() *InternalMessageDeliveryFilter {
+	return &InternalMessageDeliveryFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *InternalMessageDeliveryMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the InternalMessageDeliveryMutation builder.
+func (m *InternalMessageDeliveryMutation) Filter() *InternalMessageDeliveryFilter {
+	return &InternalMessageDeliveryFilter{config: m.config, predicateAdder: m}
+}
+
+// InternalMessageDeliveryFilter provides a generic filtering capability at runtime for InternalMessageDeliveryQuery.
+type InternalMessageDeliveryFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *InternalMessageDeliveryFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *InternalMessageDeliveryFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *InternalMessageDeliveryFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagedelivery.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *InternalMessageDeliveryFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagedelivery.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *InternalMessageDeliveryFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagedelivery.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *InternalMessageDeliveryFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldTenantID))
+}
+
+// WhereMessageID applies the entql uint32 predicate on the message_id field.
+func (f *InternalMessageDeliveryFilter) WhereMessageID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldMessageID))
+}
+
+// WhereRecipientID applies the entql uint32 predicate on the recipient_id field.
+func (f *InternalMessageDeliveryFilter) WhereRecipientID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldRecipientID))
+}
+
+// WhereRecipientUserID applies the entql uint32 predicate on the recipient_user_id field.
+func (f *InternalMessageDeliveryFilter) WhereRecipientUserID(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldRecipientUserID))
+}
+
+// WhereChannel applies the entql string predicate on the channel field.
+func (f *InternalMessageDeliveryFilter) WhereChannel(p entql.StringP) {
+	f.Where(p.Field(internalmessagedelivery.FieldChannel))
+}
+
+// WhereAddress applies the entql string predicate on the address field.
+func (f *InternalMessageDeliveryFilter) WhereAddress(p entql.StringP) {
+	f.Where(p.Field(internalmessagedelivery.FieldAddress))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *InternalMessageDeliveryFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(internalmessagedelivery.FieldStatus))
+}
+
+// WhereAttempts applies the entql uint32 predicate on the attempts field.
+func (f *InternalMessageDeliveryFilter) WhereAttempts(p entql.Uint32P) {
+	f.Where(p.Field(internalmessagedelivery.FieldAttempts))
+}
+
+// WhereLastError applies the entql string predicate on the last_error field.
+func (f *InternalMessageDeliveryFilter) WhereLastError(p entql.StringP) {
+	f.Where(p.Field(internalmessagedelivery.FieldLastError))
+}
+
+// WhereSentAt applies the entql time.Time predicate on the sent_at field.
+func (f *InternalMessageDeliveryFilter) WhereSentAt(p entql.TimeP) {
+	f.Where(p.Field(internalmessagedelivery.FieldSentAt))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *InternalMessageRecipientQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2227,7 +2939,7 @@ type InternalMessageRecipientFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *InternalMessageRecipientFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2283,6 +2995,16 @@ func (f *InternalMessageRecipientFilter) WhereReadAt(p entql.TimeP) {
 	f.Where(p.Field(internalmessagerecipient.FieldReadAt))
 }
 
+// WhereTitle applies the entql string predicate on the title field.
+func (f *InternalMessageRecipientFilter) WhereTitle(p entql.StringP) {
+	f.Where(p.Field(internalmessagerecipient.FieldTitle))
+}
+
+// WhereContent applies the entql string predicate on the content field.
+func (f *InternalMessageRecipientFilter) WhereContent(p entql.StringP) {
+	f.Where(p.Field(internalmessagerecipient.FieldContent))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *LanguageQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2312,7 +3034,7 @@ type LanguageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *LanguageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2412,7 +3134,7 @@ type MenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *MenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2531,6 +3253,116 @@ func (f *MenuFilter) WhereHasChildrenWith(preds ...predicate.Menu) {
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *MessageTemplateQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the MessageTemplateQuery builder.
+func (_q *MessageTemplateQuery) Filter() *MessageTemplateFilter {
+	return &MessageTemplateFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *MessageTemplateMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the MessageTemplateMutation builder.
+func (m *MessageTemplateMutation) Filter() *MessageTemplateFilter {
+	return &MessageTemplateFilter{config: m.config, predicateAdder: m}
+}
+
+// MessageTemplateFilter provides a generic filtering capability at runtime for MessageTemplateQuery.
+type MessageTemplateFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *MessageTemplateFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *MessageTemplateFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *MessageTemplateFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(messagetemplate.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *MessageTemplateFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(messagetemplate.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *MessageTemplateFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(messagetemplate.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *MessageTemplateFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *MessageTemplateFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *MessageTemplateFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldDeletedBy))
+}
+
+// WhereIsEnabled applies the entql bool predicate on the is_enabled field.
+func (f *MessageTemplateFilter) WhereIsEnabled(p entql.BoolP) {
+	f.Where(p.Field(messagetemplate.FieldIsEnabled))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *MessageTemplateFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(messagetemplate.FieldRemark))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *MessageTemplateFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldTenantID))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *MessageTemplateFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(messagetemplate.FieldCode))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *MessageTemplateFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(messagetemplate.FieldName))
+}
+
+// WhereCategoryID applies the entql uint32 predicate on the category_id field.
+func (f *MessageTemplateFilter) WhereCategoryID(p entql.Uint32P) {
+	f.Where(p.Field(messagetemplate.FieldCategoryID))
+}
+
+// WhereDefaultLanguage applies the entql string predicate on the default_language field.
+func (f *MessageTemplateFilter) WhereDefaultLanguage(p entql.StringP) {
+	f.Where(p.Field(messagetemplate.FieldDefaultLanguage))
+}
+
+// WhereLocales applies the entql json.RawMessage predicate on the locales field.
+func (f *MessageTemplateFilter) WhereLocales(p entql.BytesP) {
+	f.Where(p.Field(messagetemplate.FieldLocales))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *OrganizationQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -2560,7 +3392,7 @@ type OrganizationFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *OrganizationFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2718,7 +3550,7 @@ type PositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -2871,7 +3703,7 @@ type RoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3019,7 +3851,7 @@ type RoleApiFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleApiFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3099,7 +3931,7 @@ type RoleDeptFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleDeptFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3179,7 +4011,7 @@ type RoleMenuFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleMenuFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3259,7 +4091,7 @@ type RoleOrgFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RoleOrgFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3339,7 +4171,7 @@ type RolePositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RolePositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[20].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3391,103 +4223,193 @@ func (f *RolePositionFilter) WherePositionID(p entql.Uint32P) {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
+func (_q *SettingQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the TaskQuery builder.
-func (_q *TaskQuery) Filter() *TaskFilter {
-	return &TaskFilter{config: _q.config, predicateAdder: _q}
+// Filter returns a Filter implementation to apply filters on the SettingQuery builder.
+func (_q *SettingQuery) Filter() *SettingFilter {
+	return &SettingFilter{config: _q.config, predicateAdder: _q}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *SettingMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
-func (m *TaskMutation) Filter() *TaskFilter {
-	return &TaskFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the SettingMutation builder.
+func (m *SettingMutation) Filter() *SettingFilter {
+	return &SettingFilter{config: m.config, predicateAdder: m}
 }
 
-// TaskFilter provides a generic filtering capability at runtime for TaskQuery.
-type TaskFilter struct {
+// SettingFilter provides a generic filtering capability at runtime for SettingQuery.
+type SettingFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *TaskFilter) Where(p entql.P) {
+func (f *SettingFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[21].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
 }
 
 // WhereID applies the entql uint32 predicate on the id field.
-func (f *TaskFilter) WhereID(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldID))
+func (f *SettingFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldID))
 }
 
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *TaskFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldCreatedAt))
+func (f *SettingFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(setting.FieldCreatedAt))
 }
 
 // WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *TaskFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldUpdatedAt))
+func (f *SettingFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(setting.FieldUpdatedAt))
 }
 
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *TaskFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(task.FieldDeletedAt))
+func (f *SettingFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(setting.FieldDeletedAt))
 }
 
 // WhereCreatedBy applies the entql uint32 predicate on the created_by field.
-func (f *TaskFilter) WhereCreatedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldCreatedBy))
+func (f *SettingFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldCreatedBy))
 }
 
 // WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
-func (f *TaskFilter) WhereUpdatedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldUpdatedBy))
+func (f *SettingFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldUpdatedBy))
 }
 
 // WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
-func (f *TaskFilter) WhereDeletedBy(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldDeletedBy))
-}
-
-// WhereRemark applies the entql string predicate on the remark field.
-func (f *TaskFilter) WhereRemark(p entql.StringP) {
-	f.Where(p.Field(task.FieldRemark))
+func (f *SettingFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldDeletedBy))
 }
 
 // WhereTenantID applies the entql uint32 predicate on the tenant_id field.
-func (f *TaskFilter) WhereTenantID(p entql.Uint32P) {
-	f.Where(p.Field(task.FieldTenantID))
+func (f *SettingFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldTenantID))
 }
 
-// WhereType applies the entql string predicate on the type field.
-func (f *TaskFilter) WhereType(p entql.StringP) {
-	f.Where(p.Field(task.FieldType))
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *SettingFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(setting.FieldUserID))
 }
 
-// WhereTypeName applies the entql string predicate on the type_name field.
-func (f *TaskFilter) WhereTypeName(p entql.StringP) {
-	f.Where(p.Field(task.FieldTypeName))
+// WhereKey applies the entql string predicate on the key field.
+func (f *SettingFilter) WhereKey(p entql.StringP) {
+	f.Where(p.Field(setting.FieldKey))
 }
 
-// WhereTaskPayload applies the entql string predicate on the task_payload field.
-func (f *TaskFilter) WhereTaskPayload(p entql.StringP) {
-	f.Where(p.Field(task.FieldTaskPayload))
+// WhereValue applies the entql string predicate on the value field.
+func (f *SettingFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(setting.FieldValue))
 }
 
-// WhereCronSpec applies the entql string predicate on the cron_spec field.
-func (f *TaskFilter) WhereCronSpec(p entql.StringP) {
-	f.Where(p.Field(task.FieldCronSpec))
+// addPredicate implements the predicateAdder interface.
+func (_q *TaskQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TaskQuery builder.
+func (_q *TaskQuery) Filter() *TaskFilter {
+	return &TaskFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TaskMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TaskMutation builder.
+func (m *TaskMutation) Filter() *TaskFilter {
+	return &TaskFilter{config: m.config, predicateAdder: m}
+}
+
+// TaskFilter provides a generic filtering capability at runtime for TaskQuery.
+type TaskFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TaskFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *TaskFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TaskFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TaskFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TaskFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(task.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *TaskFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *TaskFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *TaskFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldDeletedBy))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *TaskFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(task.FieldRemark))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TaskFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(task.FieldTenantID))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *TaskFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(task.FieldType))
+}
+
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *TaskFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(task.FieldTypeName))
+}
+
+// WhereTaskPayload applies the entql string predicate on the task_payload field.
+func (f *TaskFilter) WhereTaskPayload(p entql.StringP) {
+	f.Where(p.Field(task.FieldTaskPayload))
+}
+
+// WhereCronSpec applies the entql string predicate on the cron_spec field.
+func (f *TaskFilter) WhereCronSpec(p entql.StringP) {
+	f.Where(p.Field(task.FieldCronSpec))
 }
 
 // WhereTaskOptions applies the entql json.RawMessage predicate on the task_options field.
@@ -3500,6 +4422,156 @@ func (f *TaskFilter) WhereEnable(p entql.BoolP) {
 	f.Where(p.Field(task.FieldEnable))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *TaskRunQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TaskRunQuery builder.
+func (_q *TaskRunQuery) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TaskRunMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TaskRunMutation builder.
+func (m *TaskRunMutation) Filter() *TaskRunFilter {
+	return &TaskRunFilter{config: m.config, predicateAdder: m}
+}
+
+// TaskRunFilter provides a generic filtering capability at runtime for TaskRunQuery.
+type TaskRunFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TaskRunFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[27].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *TaskRunFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TaskRunFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TaskRunFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TaskRunFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *TaskRunFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *TaskRunFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *TaskRunFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldDeletedBy))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TaskRunFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldTenantID))
+}
+
+// WhereTaskID applies the entql uint32 predicate on the task_id field.
+func (f *TaskRunFilter) WhereTaskID(p entql.Uint32P) {
+	f.Where(p.Field(taskrun.FieldTaskID))
+}
+
+// WhereTypeName applies the entql string predicate on the type_name field.
+func (f *TaskRunFilter) WhereTypeName(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldTypeName))
+}
+
+// WhereRunID applies the entql string predicate on the run_id field.
+func (f *TaskRunFilter) WhereRunID(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldRunID))
+}
+
+// WhereQueue applies the entql string predicate on the queue field.
+func (f *TaskRunFilter) WhereQueue(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldQueue))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TaskRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldStatus))
+}
+
+// WhereTrigger applies the entql string predicate on the trigger field.
+func (f *TaskRunFilter) WhereTrigger(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldTrigger))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *TaskRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldStartedAt))
+}
+
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *TaskRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(taskrun.FieldFinishedAt))
+}
+
+// WhereDurationMs applies the entql int64 predicate on the duration_ms field.
+func (f *TaskRunFilter) WhereDurationMs(p entql.Int64P) {
+	f.Where(p.Field(taskrun.FieldDurationMs))
+}
+
+// WhereRetryCount applies the entql int32 predicate on the retry_count field.
+func (f *TaskRunFilter) WhereRetryCount(p entql.Int32P) {
+	f.Where(p.Field(taskrun.FieldRetryCount))
+}
+
+// WhereMaxRetry applies the entql int32 predicate on the max_retry field.
+func (f *TaskRunFilter) WhereMaxRetry(p entql.Int32P) {
+	f.Where(p.Field(taskrun.FieldMaxRetry))
+}
+
+// WhereProgress applies the entql int32 predicate on the progress field.
+func (f *TaskRunFilter) WhereProgress(p entql.Int32P) {
+	f.Where(p.Field(taskrun.FieldProgress))
+}
+
+// WhereProgressMessage applies the entql string predicate on the progress_message field.
+func (f *TaskRunFilter) WhereProgressMessage(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldProgressMessage))
+}
+
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *TaskRunFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldErrorMessage))
+}
+
+// WhereResult applies the entql string predicate on the result field.
+func (f *TaskRunFilter) WhereResult(p entql.StringP) {
+	f.Where(p.Field(taskrun.FieldResult))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *TenantQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3529,7 +4601,7 @@ type TenantFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *TenantFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[22].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[28].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3630,6 +4702,11 @@ func (f *TenantFilter) WhereSubscriptionPlan(p entql.StringP) {
 	f.Where(p.Field(tenant.FieldSubscriptionPlan))
 }
 
+// WherePlanID applies the entql uint32 predicate on the plan_id field.
+func (f *TenantFilter) WherePlanID(p entql.Uint32P) {
+	f.Where(p.Field(tenant.FieldPlanID))
+}
+
 // WhereExpiredAt applies the entql time.Time predicate on the expired_at field.
 func (f *TenantFilter) WhereExpiredAt(p entql.TimeP) {
 	f.Where(p.Field(tenant.FieldExpiredAt))
@@ -3645,6 +4722,226 @@ func (f *TenantFilter) WhereLastLoginIP(p entql.StringP) {
 	f.Where(p.Field(tenant.FieldLastLoginIP))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *TenantPlanQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TenantPlanQuery builder.
+func (_q *TenantPlanQuery) Filter() *TenantPlanFilter {
+	return &TenantPlanFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TenantPlanMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TenantPlanMutation builder.
+func (m *TenantPlanMutation) Filter() *TenantPlanFilter {
+	return &TenantPlanFilter{config: m.config, predicateAdder: m}
+}
+
+// TenantPlanFilter provides a generic filtering capability at runtime for TenantPlanQuery.
+type TenantPlanFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TenantPlanFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[29].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *TenantPlanFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TenantPlanFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantplan.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TenantPlanFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantplan.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TenantPlanFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantplan.FieldDeletedAt))
+}
+
+// WhereCreatedBy applies the entql uint32 predicate on the created_by field.
+func (f *TenantPlanFilter) WhereCreatedBy(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldCreatedBy))
+}
+
+// WhereUpdatedBy applies the entql uint32 predicate on the updated_by field.
+func (f *TenantPlanFilter) WhereUpdatedBy(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldUpdatedBy))
+}
+
+// WhereDeletedBy applies the entql uint32 predicate on the deleted_by field.
+func (f *TenantPlanFilter) WhereDeletedBy(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldDeletedBy))
+}
+
+// WhereRemark applies the entql string predicate on the remark field.
+func (f *TenantPlanFilter) WhereRemark(p entql.StringP) {
+	f.Where(p.Field(tenantplan.FieldRemark))
+}
+
+// WhereSortOrder applies the entql int32 predicate on the sort_order field.
+func (f *TenantPlanFilter) WhereSortOrder(p entql.Int32P) {
+	f.Where(p.Field(tenantplan.FieldSortOrder))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *TenantPlanFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(tenantplan.FieldName))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *TenantPlanFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(tenantplan.FieldCode))
+}
+
+// WhereDescription applies the entql string predicate on the description field.
+func (f *TenantPlanFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(tenantplan.FieldDescription))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *TenantPlanFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(tenantplan.FieldStatus))
+}
+
+// WhereMaxUsers applies the entql uint32 predicate on the max_users field.
+func (f *TenantPlanFilter) WhereMaxUsers(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldMaxUsers))
+}
+
+// WhereMaxStorageBytes applies the entql uint64 predicate on the max_storage_bytes field.
+func (f *TenantPlanFilter) WhereMaxStorageBytes(p entql.Uint64P) {
+	f.Where(p.Field(tenantplan.FieldMaxStorageBytes))
+}
+
+// WhereMaxAPIRequestsPerDay applies the entql uint32 predicate on the max_api_requests_per_day field.
+func (f *TenantPlanFilter) WhereMaxAPIRequestsPerDay(p entql.Uint32P) {
+	f.Where(p.Field(tenantplan.FieldMaxAPIRequestsPerDay))
+}
+
+// WhereModules applies the entql json.RawMessage predicate on the modules field.
+func (f *TenantPlanFilter) WhereModules(p entql.BytesP) {
+	f.Where(p.Field(tenantplan.FieldModules))
+}
+
+// WhereMenus applies the entql json.RawMessage predicate on the menus field.
+func (f *TenantPlanFilter) WhereMenus(p entql.BytesP) {
+	f.Where(p.Field(tenantplan.FieldMenus))
+}
+
+// WhereFeatures applies the entql json.RawMessage predicate on the features field.
+func (f *TenantPlanFilter) WhereFeatures(p entql.BytesP) {
+	f.Where(p.Field(tenantplan.FieldFeatures))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *TenantUsageQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the TenantUsageQuery builder.
+func (_q *TenantUsageQuery) Filter() *TenantUsageFilter {
+	return &TenantUsageFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *TenantUsageMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the TenantUsageMutation builder.
+func (m *TenantUsageMutation) Filter() *TenantUsageFilter {
+	return &TenantUsageFilter{config: m.config, predicateAdder: m}
+}
+
+// TenantUsageFilter provides a generic filtering capability at runtime for TenantUsageQuery.
+type TenantUsageFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *TenantUsageFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[30].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *TenantUsageFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(tenantusage.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *TenantUsageFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantusage.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *TenantUsageFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantusage.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *TenantUsageFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(tenantusage.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *TenantUsageFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(tenantusage.FieldTenantID))
+}
+
+// WherePeriodStart applies the entql time.Time predicate on the period_start field.
+func (f *TenantUsageFilter) WherePeriodStart(p entql.TimeP) {
+	f.Where(p.Field(tenantusage.FieldPeriodStart))
+}
+
+// WhereActiveUsers applies the entql uint32 predicate on the active_users field.
+func (f *TenantUsageFilter) WhereActiveUsers(p entql.Uint32P) {
+	f.Where(p.Field(tenantusage.FieldActiveUsers))
+}
+
+// WhereAPICalls applies the entql uint64 predicate on the api_calls field.
+func (f *TenantUsageFilter) WhereAPICalls(p entql.Uint64P) {
+	f.Where(p.Field(tenantusage.FieldAPICalls))
+}
+
+// WhereAPICallsByModule applies the entql json.RawMessage predicate on the api_calls_by_module field.
+func (f *TenantUsageFilter) WhereAPICallsByModule(p entql.BytesP) {
+	f.Where(p.Field(tenantusage.FieldAPICallsByModule))
+}
+
+// WhereStorageBytes applies the entql uint64 predicate on the storage_bytes field.
+func (f *TenantUsageFilter) WhereStorageBytes(p entql.Uint64P) {
+	f.Where(p.Field(tenantusage.FieldStorageBytes))
+}
+
+// WhereMessagesSent applies the entql uint64 predicate on the messages_sent field.
+func (f *TenantUsageFilter) WhereMessagesSent(p entql.Uint64P) {
+	f.Where(p.Field(tenantusage.FieldMessagesSent))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3674,7 +4971,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[23].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[31].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3770,6 +5067,11 @@ func (f *UserFilter) WhereRegion(p entql.StringP) {
 	f.Where(p.Field(user.FieldRegion))
 }
 
+// WhereLanguage applies the entql string predicate on the language field.
+func (f *UserFilter) WhereLanguage(p entql.StringP) {
+	f.Where(p.Field(user.FieldLanguage))
+}
+
 // WhereDescription applies the entql string predicate on the description field.
 func (f *UserFilter) WhereDescription(p entql.StringP) {
 	f.Where(p.Field(user.FieldDescription))
@@ -3854,7 +5156,7 @@ type UserCredentialFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserCredentialFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[24].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[32].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -3965,6 +5267,96 @@ func (f *UserCredentialFilter) WhereResetTokenUsedAt(p entql.TimeP) {
 	f.Where(p.Field(usercredential.FieldResetTokenUsedAt))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *UserNotificationPreferenceQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the UserNotificationPreferenceQuery builder.
+func (_q *UserNotificationPreferenceQuery) Filter() *UserNotificationPreferenceFilter {
+	return &UserNotificationPreferenceFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *UserNotificationPreferenceMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the UserNotificationPreferenceMutation builder.
+func (m *UserNotificationPreferenceMutation) Filter() *UserNotificationPreferenceFilter {
+	return &UserNotificationPreferenceFilter{config: m.config, predicateAdder: m}
+}
+
+// UserNotificationPreferenceFilter provides a generic filtering capability at runtime for UserNotificationPreferenceQuery.
+type UserNotificationPreferenceFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *UserNotificationPreferenceFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[33].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql uint32 predicate on the id field.
+func (f *UserNotificationPreferenceFilter) WhereID(p entql.Uint32P) {
+	f.Where(p.Field(usernotificationpreference.FieldID))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *UserNotificationPreferenceFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(usernotificationpreference.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *UserNotificationPreferenceFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(usernotificationpreference.FieldUpdatedAt))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *UserNotificationPreferenceFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(usernotificationpreference.FieldDeletedAt))
+}
+
+// WhereTenantID applies the entql uint32 predicate on the tenant_id field.
+func (f *UserNotificationPreferenceFilter) WhereTenantID(p entql.Uint32P) {
+	f.Where(p.Field(usernotificationpreference.FieldTenantID))
+}
+
+// WhereUserID applies the entql uint32 predicate on the user_id field.
+func (f *UserNotificationPreferenceFilter) WhereUserID(p entql.Uint32P) {
+	f.Where(p.Field(usernotificationpreference.FieldUserID))
+}
+
+// WhereDisabledChannels applies the entql json.RawMessage predicate on the disabled_channels field.
+func (f *UserNotificationPreferenceFilter) WhereDisabledChannels(p entql.BytesP) {
+	f.Where(p.Field(usernotificationpreference.FieldDisabledChannels))
+}
+
+// WhereCategoryChannels applies the entql json.RawMessage predicate on the category_channels field.
+func (f *UserNotificationPreferenceFilter) WhereCategoryChannels(p entql.BytesP) {
+	f.Where(p.Field(usernotificationpreference.FieldCategoryChannels))
+}
+
+// WhereMutedCategoryIds applies the entql json.RawMessage predicate on the muted_category_ids field.
+func (f *UserNotificationPreferenceFilter) WhereMutedCategoryIds(p entql.BytesP) {
+	f.Where(p.Field(usernotificationpreference.FieldMutedCategoryIds))
+}
+
+// WhereQuietHours applies the entql json.RawMessage predicate on the quiet_hours field.
+func (f *UserNotificationPreferenceFilter) WhereQuietHours(p entql.BytesP) {
+	f.Where(p.Field(usernotificationpreference.FieldQuietHours))
+}
+
+// WhereDigest applies the entql json.RawMessage predicate on the digest field.
+func (f *UserNotificationPreferenceFilter) WhereDigest(p entql.BytesP) {
+	f.Where(p.Field(usernotificationpreference.FieldDigest))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *UserPositionQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -3994,7 +5386,7 @@ type UserPositionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserPositionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[25].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[34].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4074,7 +5466,7 @@ type UserRoleFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserRoleFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[26].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[35].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/file"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
@@ -330,6 +331,12 @@ func (_q *FileQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if file.Policy == nil {
+		return errors.New("ent: uninitialized file.Policy (forgotten import ent/runtime?)")
+	}
+	if err := file.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
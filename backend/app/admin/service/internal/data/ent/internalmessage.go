@@ -3,7 +3,9 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"strings"
 	"time"
@@ -43,7 +45,47 @@ type InternalMessage struct {
 	// 消息状态
 	Status *internalmessage.Status `json:"status,omitempty"`
 	// 消息类型
-	Type         *internalmessage.Type `json:"type,omitempty"`
+	Type *internalmessage.Type `json:"type,omitempty"`
+	// 定时发送时间，周期消息为下一次发送时间
+	SendAt *time.Time `json:"send_at,omitempty"`
+	// 周期发送的cron表达式
+	CronSpec *string `json:"cron_spec,omitempty"`
+	// 最近一次发送时间
+	LastSentAt *time.Time `json:"last_sent_at,omitempty"`
+	// 全员发送标志
+	TargetAll *bool `json:"target_all,omitempty"`
+	// 接收者用户ID列表，按受众发送时为发送时解析出的受众快照
+	TargetUserIds []uint32 `json:"target_user_ids,omitempty"`
+	// 受众表达式
+	Audience *internalMessageV1.MessageAudience `json:"audience,omitempty"`
+	// 投递状态
+	DeliveryStatus *internalmessage.DeliveryStatus `json:"delivery_status,omitempty"`
+	// 接收者总数
+	RecipientTotal *uint32 `json:"recipient_total,omitempty"`
+	// 已投递数量
+	DeliveredCount *uint32 `json:"delivered_count,omitempty"`
+	// 投递失败数量
+	FailedCount *uint32 `json:"failed_count,omitempty"`
+	// 开始投递时间
+	DeliveryStartedAt *time.Time `json:"delivery_started_at,omitempty"`
+	// 完成投递时间
+	DeliveryFinishedAt *time.Time `json:"delivery_finished_at,omitempty"`
+	// 消息模板编码
+	TemplateCode *string `json:"template_code,omitempty"`
+	// 消息模板变量
+	TemplateVariables map[string]string `json:"template_variables,omitempty"`
+	// 会话ID，私信与群聊消息所属的会话
+	ConversationID *uint32 `json:"conversation_id,omitempty"`
+	// 话题ID，回复消息所属的根消息ID
+	ThreadID *uint32 `json:"thread_id,omitempty"`
+	// 回复数量
+	ReplyCount *uint32 `json:"reply_count,omitempty"`
+	// 提醒未读者的次数
+	RemindCount *uint32 `json:"remind_count,omitempty"`
+	// 最近一次提醒未读者的时间
+	LastRemindedAt *time.Time `json:"last_reminded_at,omitempty"`
+	// 消息优先级
+	Priority     *internalmessage.Priority `json:"priority,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -52,11 +94,15 @@ func (*InternalMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case internalmessage.FieldID, internalmessage.FieldCreatedBy, internalmessage.FieldUpdatedBy, internalmessage.FieldDeletedBy, internalmessage.FieldTenantID, internalmessage.FieldSenderID, internalmessage.FieldCategoryID:
+		case internalmessage.FieldTargetUserIds, internalmessage.FieldAudience, internalmessage.FieldTemplateVariables:
+			values[i] = new([]byte)
+		case internalmessage.FieldTargetAll:
+			values[i] = new(sql.NullBool)
+		case internalmessage.FieldID, internalmessage.FieldCreatedBy, internalmessage.FieldUpdatedBy, internalmessage.FieldDeletedBy, internalmessage.FieldTenantID, internalmessage.FieldSenderID, internalmessage.FieldCategoryID, internalmessage.FieldRecipientTotal, internalmessage.FieldDeliveredCount, internalmessage.FieldFailedCount, internalmessage.FieldConversationID, internalmessage.FieldThreadID, internalmessage.FieldReplyCount, internalmessage.FieldRemindCount:
 			values[i] = new(sql.NullInt64)
-		case internalmessage.FieldTitle, internalmessage.FieldContent, internalmessage.FieldStatus, internalmessage.FieldType:
+		case internalmessage.FieldTitle, internalmessage.FieldContent, internalmessage.FieldStatus, internalmessage.FieldType, internalmessage.FieldCronSpec, internalmessage.FieldDeliveryStatus, internalmessage.FieldTemplateCode, internalmessage.FieldPriority:
 			values[i] = new(sql.NullString)
-		case internalmessage.FieldCreatedAt, internalmessage.FieldUpdatedAt, internalmessage.FieldDeletedAt:
+		case internalmessage.FieldCreatedAt, internalmessage.FieldUpdatedAt, internalmessage.FieldDeletedAt, internalmessage.FieldSendAt, internalmessage.FieldLastSentAt, internalmessage.FieldDeliveryStartedAt, internalmessage.FieldDeliveryFinishedAt, internalmessage.FieldLastRemindedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -170,6 +216,149 @@ func (_m *InternalMessage) assignValues(columns []string, values []any) error {
 				_m.Type = new(internalmessage.Type)
 				*_m.Type = internalmessage.Type(value.String)
 			}
+		case internalmessage.FieldSendAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field send_at", values[i])
+			} else if value.Valid {
+				_m.SendAt = new(time.Time)
+				*_m.SendAt = value.Time
+			}
+		case internalmessage.FieldCronSpec:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field cron_spec", values[i])
+			} else if value.Valid {
+				_m.CronSpec = new(string)
+				*_m.CronSpec = value.String
+			}
+		case internalmessage.FieldLastSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_sent_at", values[i])
+			} else if value.Valid {
+				_m.LastSentAt = new(time.Time)
+				*_m.LastSentAt = value.Time
+			}
+		case internalmessage.FieldTargetAll:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field target_all", values[i])
+			} else if value.Valid {
+				_m.TargetAll = new(bool)
+				*_m.TargetAll = value.Bool
+			}
+		case internalmessage.FieldTargetUserIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field target_user_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TargetUserIds); err != nil {
+					return fmt.Errorf("unmarshal field target_user_ids: %w", err)
+				}
+			}
+		case internalmessage.FieldAudience:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field audience", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Audience); err != nil {
+					return fmt.Errorf("unmarshal field audience: %w", err)
+				}
+			}
+		case internalmessage.FieldDeliveryStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_status", values[i])
+			} else if value.Valid {
+				_m.DeliveryStatus = new(internalmessage.DeliveryStatus)
+				*_m.DeliveryStatus = internalmessage.DeliveryStatus(value.String)
+			}
+		case internalmessage.FieldRecipientTotal:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_total", values[i])
+			} else if value.Valid {
+				_m.RecipientTotal = new(uint32)
+				*_m.RecipientTotal = uint32(value.Int64)
+			}
+		case internalmessage.FieldDeliveredCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_count", values[i])
+			} else if value.Valid {
+				_m.DeliveredCount = new(uint32)
+				*_m.DeliveredCount = uint32(value.Int64)
+			}
+		case internalmessage.FieldFailedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_count", values[i])
+			} else if value.Valid {
+				_m.FailedCount = new(uint32)
+				*_m.FailedCount = uint32(value.Int64)
+			}
+		case internalmessage.FieldDeliveryStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_started_at", values[i])
+			} else if value.Valid {
+				_m.DeliveryStartedAt = new(time.Time)
+				*_m.DeliveryStartedAt = value.Time
+			}
+		case internalmessage.FieldDeliveryFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivery_finished_at", values[i])
+			} else if value.Valid {
+				_m.DeliveryFinishedAt = new(time.Time)
+				*_m.DeliveryFinishedAt = value.Time
+			}
+		case internalmessage.FieldTemplateCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template_code", values[i])
+			} else if value.Valid {
+				_m.TemplateCode = new(string)
+				*_m.TemplateCode = value.String
+			}
+		case internalmessage.FieldTemplateVariables:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field template_variables", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.TemplateVariables); err != nil {
+					return fmt.Errorf("unmarshal field template_variables: %w", err)
+				}
+			}
+		case internalmessage.FieldConversationID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field conversation_id", values[i])
+			} else if value.Valid {
+				_m.ConversationID = new(uint32)
+				*_m.ConversationID = uint32(value.Int64)
+			}
+		case internalmessage.FieldThreadID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field thread_id", values[i])
+			} else if value.Valid {
+				_m.ThreadID = new(uint32)
+				*_m.ThreadID = uint32(value.Int64)
+			}
+		case internalmessage.FieldReplyCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_count", values[i])
+			} else if value.Valid {
+				_m.ReplyCount = new(uint32)
+				*_m.ReplyCount = uint32(value.Int64)
+			}
+		case internalmessage.FieldRemindCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field remind_count", values[i])
+			} else if value.Valid {
+				_m.RemindCount = new(uint32)
+				*_m.RemindCount = uint32(value.Int64)
+			}
+		case internalmessage.FieldLastRemindedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reminded_at", values[i])
+			} else if value.Valid {
+				_m.LastRemindedAt = new(time.Time)
+				*_m.LastRemindedAt = value.Time
+			}
+		case internalmessage.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = new(internalmessage.Priority)
+				*_m.Priority = internalmessage.Priority(value.String)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -270,6 +459,100 @@ func (_m *InternalMessage) String() string {
 		builder.WriteString("type=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
+	builder.WriteString(", ")
+	if v := _m.SendAt; v != nil {
+		builder.WriteString("send_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CronSpec; v != nil {
+		builder.WriteString("cron_spec=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSentAt; v != nil {
+		builder.WriteString("last_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TargetAll; v != nil {
+		builder.WriteString("target_all=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("target_user_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetUserIds))
+	builder.WriteString(", ")
+	builder.WriteString("audience=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Audience))
+	builder.WriteString(", ")
+	if v := _m.DeliveryStatus; v != nil {
+		builder.WriteString("delivery_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecipientTotal; v != nil {
+		builder.WriteString("recipient_total=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveredCount; v != nil {
+		builder.WriteString("delivered_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FailedCount; v != nil {
+		builder.WriteString("failed_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveryStartedAt; v != nil {
+		builder.WriteString("delivery_started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeliveryFinishedAt; v != nil {
+		builder.WriteString("delivery_finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TemplateCode; v != nil {
+		builder.WriteString("template_code=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("template_variables=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TemplateVariables))
+	builder.WriteString(", ")
+	if v := _m.ConversationID; v != nil {
+		builder.WriteString("conversation_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ThreadID; v != nil {
+		builder.WriteString("thread_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReplyCount; v != nil {
+		builder.WriteString("reply_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RemindCount; v != nil {
+		builder.WriteString("remind_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastRemindedAt; v != nil {
+		builder.WriteString("last_reminded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Priority; v != nil {
+		builder.WriteString("priority=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,6 +5,7 @@ package internalmessage
 import (
 	"fmt"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -39,6 +40,46 @@ const (
 	FieldStatus = "status"
 	// FieldType holds the string denoting the type field in the database.
 	FieldType = "type"
+	// FieldSendAt holds the string denoting the send_at field in the database.
+	FieldSendAt = "send_at"
+	// FieldCronSpec holds the string denoting the cron_spec field in the database.
+	FieldCronSpec = "cron_spec"
+	// FieldLastSentAt holds the string denoting the last_sent_at field in the database.
+	FieldLastSentAt = "last_sent_at"
+	// FieldTargetAll holds the string denoting the target_all field in the database.
+	FieldTargetAll = "target_all"
+	// FieldTargetUserIds holds the string denoting the target_user_ids field in the database.
+	FieldTargetUserIds = "target_user_ids"
+	// FieldAudience holds the string denoting the audience field in the database.
+	FieldAudience = "audience"
+	// FieldDeliveryStatus holds the string denoting the delivery_status field in the database.
+	FieldDeliveryStatus = "delivery_status"
+	// FieldRecipientTotal holds the string denoting the recipient_total field in the database.
+	FieldRecipientTotal = "recipient_total"
+	// FieldDeliveredCount holds the string denoting the delivered_count field in the database.
+	FieldDeliveredCount = "delivered_count"
+	// FieldFailedCount holds the string denoting the failed_count field in the database.
+	FieldFailedCount = "failed_count"
+	// FieldDeliveryStartedAt holds the string denoting the delivery_started_at field in the database.
+	FieldDeliveryStartedAt = "delivery_started_at"
+	// FieldDeliveryFinishedAt holds the string denoting the delivery_finished_at field in the database.
+	FieldDeliveryFinishedAt = "delivery_finished_at"
+	// FieldTemplateCode holds the string denoting the template_code field in the database.
+	FieldTemplateCode = "template_code"
+	// FieldTemplateVariables holds the string denoting the template_variables field in the database.
+	FieldTemplateVariables = "template_variables"
+	// FieldConversationID holds the string denoting the conversation_id field in the database.
+	FieldConversationID = "conversation_id"
+	// FieldThreadID holds the string denoting the thread_id field in the database.
+	FieldThreadID = "thread_id"
+	// FieldReplyCount holds the string denoting the reply_count field in the database.
+	FieldReplyCount = "reply_count"
+	// FieldRemindCount holds the string denoting the remind_count field in the database.
+	FieldRemindCount = "remind_count"
+	// FieldLastRemindedAt holds the string denoting the last_reminded_at field in the database.
+	FieldLastRemindedAt = "last_reminded_at"
+	// FieldPriority holds the string denoting the priority field in the database.
+	FieldPriority = "priority"
 	// Table holds the table name of the internalmessage in the database.
 	Table = "internal_messages"
 )
@@ -59,6 +100,26 @@ var Columns = []string{
 	FieldCategoryID,
 	FieldStatus,
 	FieldType,
+	FieldSendAt,
+	FieldCronSpec,
+	FieldLastSentAt,
+	FieldTargetAll,
+	FieldTargetUserIds,
+	FieldAudience,
+	FieldDeliveryStatus,
+	FieldRecipientTotal,
+	FieldDeliveredCount,
+	FieldFailedCount,
+	FieldDeliveryStartedAt,
+	FieldDeliveryFinishedAt,
+	FieldTemplateCode,
+	FieldTemplateVariables,
+	FieldConversationID,
+	FieldThreadID,
+	FieldReplyCount,
+	FieldRemindCount,
+	FieldLastRemindedAt,
+	FieldPriority,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -71,7 +132,18 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
 var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultReplyCount holds the default value on creation for the "reply_count" field.
+	DefaultReplyCount uint32
+	// DefaultRemindCount holds the default value on creation for the "remind_count" field.
+	DefaultRemindCount uint32
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(uint32) error
 )
@@ -133,6 +205,63 @@ func TypeValidator(_type Type) error {
 	}
 }
 
+// DeliveryStatus defines the type for the "delivery_status" enum field.
+type DeliveryStatus string
+
+// DeliveryStatusPending is the default value of the DeliveryStatus enum.
+const DefaultDeliveryStatus = DeliveryStatusPending
+
+// DeliveryStatus values.
+const (
+	DeliveryStatusPending         DeliveryStatus = "PENDING"
+	DeliveryStatusDelivering      DeliveryStatus = "DELIVERING"
+	DeliveryStatusDelivered       DeliveryStatus = "DELIVERED"
+	DeliveryStatusPartiallyFailed DeliveryStatus = "PARTIALLY_FAILED"
+	DeliveryStatusFailed          DeliveryStatus = "FAILED"
+)
+
+func (ds DeliveryStatus) String() string {
+	return string(ds)
+}
+
+// DeliveryStatusValidator is a validator for the "delivery_status" field enum values. It is called by the builders before save.
+func DeliveryStatusValidator(ds DeliveryStatus) error {
+	switch ds {
+	case DeliveryStatusPending, DeliveryStatusDelivering, DeliveryStatusDelivered, DeliveryStatusPartiallyFailed, DeliveryStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("internalmessage: invalid enum value for delivery_status field: %q", ds)
+	}
+}
+
+// Priority defines the type for the "priority" enum field.
+type Priority string
+
+// PriorityNormal is the default value of the Priority enum.
+const DefaultPriority = PriorityNormal
+
+// Priority values.
+const (
+	PriorityNormal Priority = "NORMAL"
+	PriorityLow    Priority = "LOW"
+	PriorityHigh   Priority = "HIGH"
+	PriorityUrgent Priority = "URGENT"
+)
+
+func (pr Priority) String() string {
+	return string(pr)
+}
+
+// PriorityValidator is a validator for the "priority" field enum values. It is called by the builders before save.
+func PriorityValidator(pr Priority) error {
+	switch pr {
+	case PriorityNormal, PriorityLow, PriorityHigh, PriorityUrgent:
+		return nil
+	default:
+		return fmt.Errorf("internalmessage: invalid enum value for priority field: %q", pr)
+	}
+}
+
 // OrderOption defines the ordering options for the InternalMessage queries.
 type OrderOption func(*sql.Selector)
 
@@ -205,3 +334,88 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 func ByType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldType, opts...).ToFunc()
 }
+
+// BySendAt orders the results by the send_at field.
+func BySendAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSendAt, opts...).ToFunc()
+}
+
+// ByCronSpec orders the results by the cron_spec field.
+func ByCronSpec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCronSpec, opts...).ToFunc()
+}
+
+// ByLastSentAt orders the results by the last_sent_at field.
+func ByLastSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSentAt, opts...).ToFunc()
+}
+
+// ByTargetAll orders the results by the target_all field.
+func ByTargetAll(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetAll, opts...).ToFunc()
+}
+
+// ByDeliveryStatus orders the results by the delivery_status field.
+func ByDeliveryStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStatus, opts...).ToFunc()
+}
+
+// ByRecipientTotal orders the results by the recipient_total field.
+func ByRecipientTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecipientTotal, opts...).ToFunc()
+}
+
+// ByDeliveredCount orders the results by the delivered_count field.
+func ByDeliveredCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveredCount, opts...).ToFunc()
+}
+
+// ByFailedCount orders the results by the failed_count field.
+func ByFailedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedCount, opts...).ToFunc()
+}
+
+// ByDeliveryStartedAt orders the results by the delivery_started_at field.
+func ByDeliveryStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryStartedAt, opts...).ToFunc()
+}
+
+// ByDeliveryFinishedAt orders the results by the delivery_finished_at field.
+func ByDeliveryFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeliveryFinishedAt, opts...).ToFunc()
+}
+
+// ByTemplateCode orders the results by the template_code field.
+func ByTemplateCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTemplateCode, opts...).ToFunc()
+}
+
+// ByConversationID orders the results by the conversation_id field.
+func ByConversationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConversationID, opts...).ToFunc()
+}
+
+// ByThreadID orders the results by the thread_id field.
+func ByThreadID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThreadID, opts...).ToFunc()
+}
+
+// ByReplyCount orders the results by the reply_count field.
+func ByReplyCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyCount, opts...).ToFunc()
+}
+
+// ByRemindCount orders the results by the remind_count field.
+func ByRemindCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemindCount, opts...).ToFunc()
+}
+
+// ByLastRemindedAt orders the results by the last_reminded_at field.
+func ByLastRemindedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastRemindedAt, opts...).ToFunc()
+}
+
+// ByPriority orders the results by the priority field.
+func ByPriority(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPriority, opts...).ToFunc()
+}
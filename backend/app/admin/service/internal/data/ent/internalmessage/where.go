@@ -109,6 +109,81 @@ func CategoryID(v uint32) predicate.InternalMessage {
 	return predicate.InternalMessage(sql.FieldEQ(FieldCategoryID, v))
 }
 
+// SendAt applies equality check predicate on the "send_at" field. It's identical to SendAtEQ.
+func SendAt(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// CronSpec applies equality check predicate on the "cron_spec" field. It's identical to CronSpecEQ.
+func CronSpec(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldCronSpec, v))
+}
+
+// LastSentAt applies equality check predicate on the "last_sent_at" field. It's identical to LastSentAtEQ.
+func LastSentAt(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// TargetAll applies equality check predicate on the "target_all" field. It's identical to TargetAllEQ.
+func TargetAll(v bool) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldTargetAll, v))
+}
+
+// RecipientTotal applies equality check predicate on the "recipient_total" field. It's identical to RecipientTotalEQ.
+func RecipientTotal(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldRecipientTotal, v))
+}
+
+// DeliveredCount applies equality check predicate on the "delivered_count" field. It's identical to DeliveredCountEQ.
+func DeliveredCount(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveredCount, v))
+}
+
+// FailedCount applies equality check predicate on the "failed_count" field. It's identical to FailedCountEQ.
+func FailedCount(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// DeliveryStartedAt applies equality check predicate on the "delivery_started_at" field. It's identical to DeliveryStartedAtEQ.
+func DeliveryStartedAt(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryFinishedAt applies equality check predicate on the "delivery_finished_at" field. It's identical to DeliveryFinishedAtEQ.
+func DeliveryFinishedAt(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveryFinishedAt, v))
+}
+
+// TemplateCode applies equality check predicate on the "template_code" field. It's identical to TemplateCodeEQ.
+func TemplateCode(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldTemplateCode, v))
+}
+
+// ConversationID applies equality check predicate on the "conversation_id" field. It's identical to ConversationIDEQ.
+func ConversationID(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ThreadID applies equality check predicate on the "thread_id" field. It's identical to ThreadIDEQ.
+func ThreadID(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ReplyCount applies equality check predicate on the "reply_count" field. It's identical to ReplyCountEQ.
+func ReplyCount(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldReplyCount, v))
+}
+
+// RemindCount applies equality check predicate on the "remind_count" field. It's identical to RemindCountEQ.
+func RemindCount(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldRemindCount, v))
+}
+
+// LastRemindedAt applies equality check predicate on the "last_reminded_at" field. It's identical to LastRemindedAtEQ.
+func LastRemindedAt(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldLastRemindedAt, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.InternalMessage {
 	return predicate.InternalMessage(sql.FieldEQ(FieldCreatedAt, v))
@@ -769,6 +844,866 @@ func TypeNotNil() predicate.InternalMessage {
 	return predicate.InternalMessage(sql.FieldNotNull(FieldType))
 }
 
+// SendAtEQ applies the EQ predicate on the "send_at" field.
+func SendAtEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldSendAt, v))
+}
+
+// SendAtNEQ applies the NEQ predicate on the "send_at" field.
+func SendAtNEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldSendAt, v))
+}
+
+// SendAtIn applies the In predicate on the "send_at" field.
+func SendAtIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldSendAt, vs...))
+}
+
+// SendAtNotIn applies the NotIn predicate on the "send_at" field.
+func SendAtNotIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldSendAt, vs...))
+}
+
+// SendAtGT applies the GT predicate on the "send_at" field.
+func SendAtGT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldSendAt, v))
+}
+
+// SendAtGTE applies the GTE predicate on the "send_at" field.
+func SendAtGTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldSendAt, v))
+}
+
+// SendAtLT applies the LT predicate on the "send_at" field.
+func SendAtLT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldSendAt, v))
+}
+
+// SendAtLTE applies the LTE predicate on the "send_at" field.
+func SendAtLTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldSendAt, v))
+}
+
+// SendAtIsNil applies the IsNil predicate on the "send_at" field.
+func SendAtIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldSendAt))
+}
+
+// SendAtNotNil applies the NotNil predicate on the "send_at" field.
+func SendAtNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldSendAt))
+}
+
+// CronSpecEQ applies the EQ predicate on the "cron_spec" field.
+func CronSpecEQ(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldCronSpec, v))
+}
+
+// CronSpecNEQ applies the NEQ predicate on the "cron_spec" field.
+func CronSpecNEQ(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldCronSpec, v))
+}
+
+// CronSpecIn applies the In predicate on the "cron_spec" field.
+func CronSpecIn(vs ...string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldCronSpec, vs...))
+}
+
+// CronSpecNotIn applies the NotIn predicate on the "cron_spec" field.
+func CronSpecNotIn(vs ...string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldCronSpec, vs...))
+}
+
+// CronSpecGT applies the GT predicate on the "cron_spec" field.
+func CronSpecGT(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldCronSpec, v))
+}
+
+// CronSpecGTE applies the GTE predicate on the "cron_spec" field.
+func CronSpecGTE(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldCronSpec, v))
+}
+
+// CronSpecLT applies the LT predicate on the "cron_spec" field.
+func CronSpecLT(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldCronSpec, v))
+}
+
+// CronSpecLTE applies the LTE predicate on the "cron_spec" field.
+func CronSpecLTE(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldCronSpec, v))
+}
+
+// CronSpecContains applies the Contains predicate on the "cron_spec" field.
+func CronSpecContains(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldContains(FieldCronSpec, v))
+}
+
+// CronSpecHasPrefix applies the HasPrefix predicate on the "cron_spec" field.
+func CronSpecHasPrefix(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldHasPrefix(FieldCronSpec, v))
+}
+
+// CronSpecHasSuffix applies the HasSuffix predicate on the "cron_spec" field.
+func CronSpecHasSuffix(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldHasSuffix(FieldCronSpec, v))
+}
+
+// CronSpecIsNil applies the IsNil predicate on the "cron_spec" field.
+func CronSpecIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldCronSpec))
+}
+
+// CronSpecNotNil applies the NotNil predicate on the "cron_spec" field.
+func CronSpecNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldCronSpec))
+}
+
+// CronSpecEqualFold applies the EqualFold predicate on the "cron_spec" field.
+func CronSpecEqualFold(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEqualFold(FieldCronSpec, v))
+}
+
+// CronSpecContainsFold applies the ContainsFold predicate on the "cron_spec" field.
+func CronSpecContainsFold(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldContainsFold(FieldCronSpec, v))
+}
+
+// LastSentAtEQ applies the EQ predicate on the "last_sent_at" field.
+func LastSentAtEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtNEQ applies the NEQ predicate on the "last_sent_at" field.
+func LastSentAtNEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldLastSentAt, v))
+}
+
+// LastSentAtIn applies the In predicate on the "last_sent_at" field.
+func LastSentAtIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtNotIn applies the NotIn predicate on the "last_sent_at" field.
+func LastSentAtNotIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldLastSentAt, vs...))
+}
+
+// LastSentAtGT applies the GT predicate on the "last_sent_at" field.
+func LastSentAtGT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldLastSentAt, v))
+}
+
+// LastSentAtGTE applies the GTE predicate on the "last_sent_at" field.
+func LastSentAtGTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldLastSentAt, v))
+}
+
+// LastSentAtLT applies the LT predicate on the "last_sent_at" field.
+func LastSentAtLT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldLastSentAt, v))
+}
+
+// LastSentAtLTE applies the LTE predicate on the "last_sent_at" field.
+func LastSentAtLTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldLastSentAt, v))
+}
+
+// LastSentAtIsNil applies the IsNil predicate on the "last_sent_at" field.
+func LastSentAtIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldLastSentAt))
+}
+
+// LastSentAtNotNil applies the NotNil predicate on the "last_sent_at" field.
+func LastSentAtNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldLastSentAt))
+}
+
+// TargetAllEQ applies the EQ predicate on the "target_all" field.
+func TargetAllEQ(v bool) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldTargetAll, v))
+}
+
+// TargetAllNEQ applies the NEQ predicate on the "target_all" field.
+func TargetAllNEQ(v bool) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldTargetAll, v))
+}
+
+// TargetAllIsNil applies the IsNil predicate on the "target_all" field.
+func TargetAllIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldTargetAll))
+}
+
+// TargetAllNotNil applies the NotNil predicate on the "target_all" field.
+func TargetAllNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldTargetAll))
+}
+
+// TargetUserIdsIsNil applies the IsNil predicate on the "target_user_ids" field.
+func TargetUserIdsIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldTargetUserIds))
+}
+
+// TargetUserIdsNotNil applies the NotNil predicate on the "target_user_ids" field.
+func TargetUserIdsNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldTargetUserIds))
+}
+
+// AudienceIsNil applies the IsNil predicate on the "audience" field.
+func AudienceIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldAudience))
+}
+
+// AudienceNotNil applies the NotNil predicate on the "audience" field.
+func AudienceNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldAudience))
+}
+
+// DeliveryStatusEQ applies the EQ predicate on the "delivery_status" field.
+func DeliveryStatusEQ(v DeliveryStatus) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusNEQ applies the NEQ predicate on the "delivery_status" field.
+func DeliveryStatusNEQ(v DeliveryStatus) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldDeliveryStatus, v))
+}
+
+// DeliveryStatusIn applies the In predicate on the "delivery_status" field.
+func DeliveryStatusIn(vs ...DeliveryStatus) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryStatusNotIn applies the NotIn predicate on the "delivery_status" field.
+func DeliveryStatusNotIn(vs ...DeliveryStatus) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldDeliveryStatus, vs...))
+}
+
+// DeliveryStatusIsNil applies the IsNil predicate on the "delivery_status" field.
+func DeliveryStatusIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldDeliveryStatus))
+}
+
+// DeliveryStatusNotNil applies the NotNil predicate on the "delivery_status" field.
+func DeliveryStatusNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldDeliveryStatus))
+}
+
+// RecipientTotalEQ applies the EQ predicate on the "recipient_total" field.
+func RecipientTotalEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldRecipientTotal, v))
+}
+
+// RecipientTotalNEQ applies the NEQ predicate on the "recipient_total" field.
+func RecipientTotalNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldRecipientTotal, v))
+}
+
+// RecipientTotalIn applies the In predicate on the "recipient_total" field.
+func RecipientTotalIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldRecipientTotal, vs...))
+}
+
+// RecipientTotalNotIn applies the NotIn predicate on the "recipient_total" field.
+func RecipientTotalNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldRecipientTotal, vs...))
+}
+
+// RecipientTotalGT applies the GT predicate on the "recipient_total" field.
+func RecipientTotalGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldRecipientTotal, v))
+}
+
+// RecipientTotalGTE applies the GTE predicate on the "recipient_total" field.
+func RecipientTotalGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldRecipientTotal, v))
+}
+
+// RecipientTotalLT applies the LT predicate on the "recipient_total" field.
+func RecipientTotalLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldRecipientTotal, v))
+}
+
+// RecipientTotalLTE applies the LTE predicate on the "recipient_total" field.
+func RecipientTotalLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldRecipientTotal, v))
+}
+
+// RecipientTotalIsNil applies the IsNil predicate on the "recipient_total" field.
+func RecipientTotalIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldRecipientTotal))
+}
+
+// RecipientTotalNotNil applies the NotNil predicate on the "recipient_total" field.
+func RecipientTotalNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldRecipientTotal))
+}
+
+// DeliveredCountEQ applies the EQ predicate on the "delivered_count" field.
+func DeliveredCountEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveredCount, v))
+}
+
+// DeliveredCountNEQ applies the NEQ predicate on the "delivered_count" field.
+func DeliveredCountNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldDeliveredCount, v))
+}
+
+// DeliveredCountIn applies the In predicate on the "delivered_count" field.
+func DeliveredCountIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldDeliveredCount, vs...))
+}
+
+// DeliveredCountNotIn applies the NotIn predicate on the "delivered_count" field.
+func DeliveredCountNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldDeliveredCount, vs...))
+}
+
+// DeliveredCountGT applies the GT predicate on the "delivered_count" field.
+func DeliveredCountGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldDeliveredCount, v))
+}
+
+// DeliveredCountGTE applies the GTE predicate on the "delivered_count" field.
+func DeliveredCountGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldDeliveredCount, v))
+}
+
+// DeliveredCountLT applies the LT predicate on the "delivered_count" field.
+func DeliveredCountLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldDeliveredCount, v))
+}
+
+// DeliveredCountLTE applies the LTE predicate on the "delivered_count" field.
+func DeliveredCountLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldDeliveredCount, v))
+}
+
+// DeliveredCountIsNil applies the IsNil predicate on the "delivered_count" field.
+func DeliveredCountIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldDeliveredCount))
+}
+
+// DeliveredCountNotNil applies the NotNil predicate on the "delivered_count" field.
+func DeliveredCountNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldDeliveredCount))
+}
+
+// FailedCountEQ applies the EQ predicate on the "failed_count" field.
+func FailedCountEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldFailedCount, v))
+}
+
+// FailedCountNEQ applies the NEQ predicate on the "failed_count" field.
+func FailedCountNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldFailedCount, v))
+}
+
+// FailedCountIn applies the In predicate on the "failed_count" field.
+func FailedCountIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldFailedCount, vs...))
+}
+
+// FailedCountNotIn applies the NotIn predicate on the "failed_count" field.
+func FailedCountNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldFailedCount, vs...))
+}
+
+// FailedCountGT applies the GT predicate on the "failed_count" field.
+func FailedCountGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldFailedCount, v))
+}
+
+// FailedCountGTE applies the GTE predicate on the "failed_count" field.
+func FailedCountGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldFailedCount, v))
+}
+
+// FailedCountLT applies the LT predicate on the "failed_count" field.
+func FailedCountLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldFailedCount, v))
+}
+
+// FailedCountLTE applies the LTE predicate on the "failed_count" field.
+func FailedCountLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldFailedCount, v))
+}
+
+// FailedCountIsNil applies the IsNil predicate on the "failed_count" field.
+func FailedCountIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldFailedCount))
+}
+
+// FailedCountNotNil applies the NotNil predicate on the "failed_count" field.
+func FailedCountNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldFailedCount))
+}
+
+// DeliveryStartedAtEQ applies the EQ predicate on the "delivery_started_at" field.
+func DeliveryStartedAtEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtNEQ applies the NEQ predicate on the "delivery_started_at" field.
+func DeliveryStartedAtNEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtIn applies the In predicate on the "delivery_started_at" field.
+func DeliveryStartedAtIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldDeliveryStartedAt, vs...))
+}
+
+// DeliveryStartedAtNotIn applies the NotIn predicate on the "delivery_started_at" field.
+func DeliveryStartedAtNotIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldDeliveryStartedAt, vs...))
+}
+
+// DeliveryStartedAtGT applies the GT predicate on the "delivery_started_at" field.
+func DeliveryStartedAtGT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtGTE applies the GTE predicate on the "delivery_started_at" field.
+func DeliveryStartedAtGTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtLT applies the LT predicate on the "delivery_started_at" field.
+func DeliveryStartedAtLT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtLTE applies the LTE predicate on the "delivery_started_at" field.
+func DeliveryStartedAtLTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldDeliveryStartedAt, v))
+}
+
+// DeliveryStartedAtIsNil applies the IsNil predicate on the "delivery_started_at" field.
+func DeliveryStartedAtIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldDeliveryStartedAt))
+}
+
+// DeliveryStartedAtNotNil applies the NotNil predicate on the "delivery_started_at" field.
+func DeliveryStartedAtNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldDeliveryStartedAt))
+}
+
+// DeliveryFinishedAtEQ applies the EQ predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtNEQ applies the NEQ predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtNEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtIn applies the In predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldDeliveryFinishedAt, vs...))
+}
+
+// DeliveryFinishedAtNotIn applies the NotIn predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtNotIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldDeliveryFinishedAt, vs...))
+}
+
+// DeliveryFinishedAtGT applies the GT predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtGT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtGTE applies the GTE predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtGTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtLT applies the LT predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtLT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtLTE applies the LTE predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtLTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldDeliveryFinishedAt, v))
+}
+
+// DeliveryFinishedAtIsNil applies the IsNil predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldDeliveryFinishedAt))
+}
+
+// DeliveryFinishedAtNotNil applies the NotNil predicate on the "delivery_finished_at" field.
+func DeliveryFinishedAtNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldDeliveryFinishedAt))
+}
+
+// TemplateCodeEQ applies the EQ predicate on the "template_code" field.
+func TemplateCodeEQ(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldTemplateCode, v))
+}
+
+// TemplateCodeNEQ applies the NEQ predicate on the "template_code" field.
+func TemplateCodeNEQ(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldTemplateCode, v))
+}
+
+// TemplateCodeIn applies the In predicate on the "template_code" field.
+func TemplateCodeIn(vs ...string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldTemplateCode, vs...))
+}
+
+// TemplateCodeNotIn applies the NotIn predicate on the "template_code" field.
+func TemplateCodeNotIn(vs ...string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldTemplateCode, vs...))
+}
+
+// TemplateCodeGT applies the GT predicate on the "template_code" field.
+func TemplateCodeGT(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldTemplateCode, v))
+}
+
+// TemplateCodeGTE applies the GTE predicate on the "template_code" field.
+func TemplateCodeGTE(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldTemplateCode, v))
+}
+
+// TemplateCodeLT applies the LT predicate on the "template_code" field.
+func TemplateCodeLT(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldTemplateCode, v))
+}
+
+// TemplateCodeLTE applies the LTE predicate on the "template_code" field.
+func TemplateCodeLTE(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldTemplateCode, v))
+}
+
+// TemplateCodeContains applies the Contains predicate on the "template_code" field.
+func TemplateCodeContains(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldContains(FieldTemplateCode, v))
+}
+
+// TemplateCodeHasPrefix applies the HasPrefix predicate on the "template_code" field.
+func TemplateCodeHasPrefix(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldHasPrefix(FieldTemplateCode, v))
+}
+
+// TemplateCodeHasSuffix applies the HasSuffix predicate on the "template_code" field.
+func TemplateCodeHasSuffix(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldHasSuffix(FieldTemplateCode, v))
+}
+
+// TemplateCodeIsNil applies the IsNil predicate on the "template_code" field.
+func TemplateCodeIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldTemplateCode))
+}
+
+// TemplateCodeNotNil applies the NotNil predicate on the "template_code" field.
+func TemplateCodeNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldTemplateCode))
+}
+
+// TemplateCodeEqualFold applies the EqualFold predicate on the "template_code" field.
+func TemplateCodeEqualFold(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEqualFold(FieldTemplateCode, v))
+}
+
+// TemplateCodeContainsFold applies the ContainsFold predicate on the "template_code" field.
+func TemplateCodeContainsFold(v string) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldContainsFold(FieldTemplateCode, v))
+}
+
+// TemplateVariablesIsNil applies the IsNil predicate on the "template_variables" field.
+func TemplateVariablesIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldTemplateVariables))
+}
+
+// TemplateVariablesNotNil applies the NotNil predicate on the "template_variables" field.
+func TemplateVariablesNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldTemplateVariables))
+}
+
+// ConversationIDEQ applies the EQ predicate on the "conversation_id" field.
+func ConversationIDEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldConversationID, v))
+}
+
+// ConversationIDNEQ applies the NEQ predicate on the "conversation_id" field.
+func ConversationIDNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldConversationID, v))
+}
+
+// ConversationIDIn applies the In predicate on the "conversation_id" field.
+func ConversationIDIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldConversationID, vs...))
+}
+
+// ConversationIDNotIn applies the NotIn predicate on the "conversation_id" field.
+func ConversationIDNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldConversationID, vs...))
+}
+
+// ConversationIDGT applies the GT predicate on the "conversation_id" field.
+func ConversationIDGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldConversationID, v))
+}
+
+// ConversationIDGTE applies the GTE predicate on the "conversation_id" field.
+func ConversationIDGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldConversationID, v))
+}
+
+// ConversationIDLT applies the LT predicate on the "conversation_id" field.
+func ConversationIDLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldConversationID, v))
+}
+
+// ConversationIDLTE applies the LTE predicate on the "conversation_id" field.
+func ConversationIDLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldConversationID, v))
+}
+
+// ConversationIDIsNil applies the IsNil predicate on the "conversation_id" field.
+func ConversationIDIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldConversationID))
+}
+
+// ConversationIDNotNil applies the NotNil predicate on the "conversation_id" field.
+func ConversationIDNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldConversationID))
+}
+
+// ThreadIDEQ applies the EQ predicate on the "thread_id" field.
+func ThreadIDEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldThreadID, v))
+}
+
+// ThreadIDNEQ applies the NEQ predicate on the "thread_id" field.
+func ThreadIDNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldThreadID, v))
+}
+
+// ThreadIDIn applies the In predicate on the "thread_id" field.
+func ThreadIDIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldThreadID, vs...))
+}
+
+// ThreadIDNotIn applies the NotIn predicate on the "thread_id" field.
+func ThreadIDNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldThreadID, vs...))
+}
+
+// ThreadIDGT applies the GT predicate on the "thread_id" field.
+func ThreadIDGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldThreadID, v))
+}
+
+// ThreadIDGTE applies the GTE predicate on the "thread_id" field.
+func ThreadIDGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldThreadID, v))
+}
+
+// ThreadIDLT applies the LT predicate on the "thread_id" field.
+func ThreadIDLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldThreadID, v))
+}
+
+// ThreadIDLTE applies the LTE predicate on the "thread_id" field.
+func ThreadIDLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldThreadID, v))
+}
+
+// ThreadIDIsNil applies the IsNil predicate on the "thread_id" field.
+func ThreadIDIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldThreadID))
+}
+
+// ThreadIDNotNil applies the NotNil predicate on the "thread_id" field.
+func ThreadIDNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldThreadID))
+}
+
+// ReplyCountEQ applies the EQ predicate on the "reply_count" field.
+func ReplyCountEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldReplyCount, v))
+}
+
+// ReplyCountNEQ applies the NEQ predicate on the "reply_count" field.
+func ReplyCountNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldReplyCount, v))
+}
+
+// ReplyCountIn applies the In predicate on the "reply_count" field.
+func ReplyCountIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldReplyCount, vs...))
+}
+
+// ReplyCountNotIn applies the NotIn predicate on the "reply_count" field.
+func ReplyCountNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldReplyCount, vs...))
+}
+
+// ReplyCountGT applies the GT predicate on the "reply_count" field.
+func ReplyCountGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldReplyCount, v))
+}
+
+// ReplyCountGTE applies the GTE predicate on the "reply_count" field.
+func ReplyCountGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldReplyCount, v))
+}
+
+// ReplyCountLT applies the LT predicate on the "reply_count" field.
+func ReplyCountLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldReplyCount, v))
+}
+
+// ReplyCountLTE applies the LTE predicate on the "reply_count" field.
+func ReplyCountLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldReplyCount, v))
+}
+
+// ReplyCountIsNil applies the IsNil predicate on the "reply_count" field.
+func ReplyCountIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldReplyCount))
+}
+
+// ReplyCountNotNil applies the NotNil predicate on the "reply_count" field.
+func ReplyCountNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldReplyCount))
+}
+
+// RemindCountEQ applies the EQ predicate on the "remind_count" field.
+func RemindCountEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldRemindCount, v))
+}
+
+// RemindCountNEQ applies the NEQ predicate on the "remind_count" field.
+func RemindCountNEQ(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldRemindCount, v))
+}
+
+// RemindCountIn applies the In predicate on the "remind_count" field.
+func RemindCountIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldRemindCount, vs...))
+}
+
+// RemindCountNotIn applies the NotIn predicate on the "remind_count" field.
+func RemindCountNotIn(vs ...uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldRemindCount, vs...))
+}
+
+// RemindCountGT applies the GT predicate on the "remind_count" field.
+func RemindCountGT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldRemindCount, v))
+}
+
+// RemindCountGTE applies the GTE predicate on the "remind_count" field.
+func RemindCountGTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldRemindCount, v))
+}
+
+// RemindCountLT applies the LT predicate on the "remind_count" field.
+func RemindCountLT(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldRemindCount, v))
+}
+
+// RemindCountLTE applies the LTE predicate on the "remind_count" field.
+func RemindCountLTE(v uint32) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldRemindCount, v))
+}
+
+// RemindCountIsNil applies the IsNil predicate on the "remind_count" field.
+func RemindCountIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldRemindCount))
+}
+
+// RemindCountNotNil applies the NotNil predicate on the "remind_count" field.
+func RemindCountNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldRemindCount))
+}
+
+// LastRemindedAtEQ applies the EQ predicate on the "last_reminded_at" field.
+func LastRemindedAtEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtNEQ applies the NEQ predicate on the "last_reminded_at" field.
+func LastRemindedAtNEQ(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtIn applies the In predicate on the "last_reminded_at" field.
+func LastRemindedAtIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldLastRemindedAt, vs...))
+}
+
+// LastRemindedAtNotIn applies the NotIn predicate on the "last_reminded_at" field.
+func LastRemindedAtNotIn(vs ...time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldLastRemindedAt, vs...))
+}
+
+// LastRemindedAtGT applies the GT predicate on the "last_reminded_at" field.
+func LastRemindedAtGT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGT(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtGTE applies the GTE predicate on the "last_reminded_at" field.
+func LastRemindedAtGTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldGTE(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtLT applies the LT predicate on the "last_reminded_at" field.
+func LastRemindedAtLT(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLT(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtLTE applies the LTE predicate on the "last_reminded_at" field.
+func LastRemindedAtLTE(v time.Time) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldLTE(FieldLastRemindedAt, v))
+}
+
+// LastRemindedAtIsNil applies the IsNil predicate on the "last_reminded_at" field.
+func LastRemindedAtIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldLastRemindedAt))
+}
+
+// LastRemindedAtNotNil applies the NotNil predicate on the "last_reminded_at" field.
+func LastRemindedAtNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldLastRemindedAt))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// PriorityIsNil applies the IsNil predicate on the "priority" field.
+func PriorityIsNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldIsNull(FieldPriority))
+}
+
+// PriorityNotNil applies the NotNil predicate on the "priority" field.
+func PriorityNotNil() predicate.InternalMessage {
+	return predicate.InternalMessage(sql.FieldNotNull(FieldPriority))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.InternalMessage) predicate.InternalMessage {
 	return predicate.InternalMessage(sql.AndPredicates(predicates...))
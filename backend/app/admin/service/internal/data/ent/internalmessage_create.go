@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"time"
 
@@ -204,6 +205,262 @@ func (_c *InternalMessageCreate) SetNillableType(v *internalmessage.Type) *Inter
 	return _c
 }
 
+// SetSendAt sets the "send_at" field.
+func (_c *InternalMessageCreate) SetSendAt(v time.Time) *InternalMessageCreate {
+	_c.mutation.SetSendAt(v)
+	return _c
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableSendAt(v *time.Time) *InternalMessageCreate {
+	if v != nil {
+		_c.SetSendAt(*v)
+	}
+	return _c
+}
+
+// SetCronSpec sets the "cron_spec" field.
+func (_c *InternalMessageCreate) SetCronSpec(v string) *InternalMessageCreate {
+	_c.mutation.SetCronSpec(v)
+	return _c
+}
+
+// SetNillableCronSpec sets the "cron_spec" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableCronSpec(v *string) *InternalMessageCreate {
+	if v != nil {
+		_c.SetCronSpec(*v)
+	}
+	return _c
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_c *InternalMessageCreate) SetLastSentAt(v time.Time) *InternalMessageCreate {
+	_c.mutation.SetLastSentAt(v)
+	return _c
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableLastSentAt(v *time.Time) *InternalMessageCreate {
+	if v != nil {
+		_c.SetLastSentAt(*v)
+	}
+	return _c
+}
+
+// SetTargetAll sets the "target_all" field.
+func (_c *InternalMessageCreate) SetTargetAll(v bool) *InternalMessageCreate {
+	_c.mutation.SetTargetAll(v)
+	return _c
+}
+
+// SetNillableTargetAll sets the "target_all" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableTargetAll(v *bool) *InternalMessageCreate {
+	if v != nil {
+		_c.SetTargetAll(*v)
+	}
+	return _c
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (_c *InternalMessageCreate) SetTargetUserIds(v []uint32) *InternalMessageCreate {
+	_c.mutation.SetTargetUserIds(v)
+	return _c
+}
+
+// SetAudience sets the "audience" field.
+func (_c *InternalMessageCreate) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageCreate {
+	_c.mutation.SetAudience(v)
+	return _c
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_c *InternalMessageCreate) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageCreate {
+	_c.mutation.SetDeliveryStatus(v)
+	return _c
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableDeliveryStatus(v *internalmessage.DeliveryStatus) *InternalMessageCreate {
+	if v != nil {
+		_c.SetDeliveryStatus(*v)
+	}
+	return _c
+}
+
+// SetRecipientTotal sets the "recipient_total" field.
+func (_c *InternalMessageCreate) SetRecipientTotal(v uint32) *InternalMessageCreate {
+	_c.mutation.SetRecipientTotal(v)
+	return _c
+}
+
+// SetNillableRecipientTotal sets the "recipient_total" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableRecipientTotal(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetRecipientTotal(*v)
+	}
+	return _c
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (_c *InternalMessageCreate) SetDeliveredCount(v uint32) *InternalMessageCreate {
+	_c.mutation.SetDeliveredCount(v)
+	return _c
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableDeliveredCount(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetDeliveredCount(*v)
+	}
+	return _c
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_c *InternalMessageCreate) SetFailedCount(v uint32) *InternalMessageCreate {
+	_c.mutation.SetFailedCount(v)
+	return _c
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableFailedCount(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetFailedCount(*v)
+	}
+	return _c
+}
+
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (_c *InternalMessageCreate) SetDeliveryStartedAt(v time.Time) *InternalMessageCreate {
+	_c.mutation.SetDeliveryStartedAt(v)
+	return _c
+}
+
+// SetNillableDeliveryStartedAt sets the "delivery_started_at" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableDeliveryStartedAt(v *time.Time) *InternalMessageCreate {
+	if v != nil {
+		_c.SetDeliveryStartedAt(*v)
+	}
+	return _c
+}
+
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (_c *InternalMessageCreate) SetDeliveryFinishedAt(v time.Time) *InternalMessageCreate {
+	_c.mutation.SetDeliveryFinishedAt(v)
+	return _c
+}
+
+// SetNillableDeliveryFinishedAt sets the "delivery_finished_at" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableDeliveryFinishedAt(v *time.Time) *InternalMessageCreate {
+	if v != nil {
+		_c.SetDeliveryFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetTemplateCode sets the "template_code" field.
+func (_c *InternalMessageCreate) SetTemplateCode(v string) *InternalMessageCreate {
+	_c.mutation.SetTemplateCode(v)
+	return _c
+}
+
+// SetNillableTemplateCode sets the "template_code" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableTemplateCode(v *string) *InternalMessageCreate {
+	if v != nil {
+		_c.SetTemplateCode(*v)
+	}
+	return _c
+}
+
+// SetTemplateVariables sets the "template_variables" field.
+func (_c *InternalMessageCreate) SetTemplateVariables(v map[string]string) *InternalMessageCreate {
+	_c.mutation.SetTemplateVariables(v)
+	return _c
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_c *InternalMessageCreate) SetConversationID(v uint32) *InternalMessageCreate {
+	_c.mutation.SetConversationID(v)
+	return _c
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableConversationID(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetConversationID(*v)
+	}
+	return _c
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_c *InternalMessageCreate) SetThreadID(v uint32) *InternalMessageCreate {
+	_c.mutation.SetThreadID(v)
+	return _c
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableThreadID(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetThreadID(*v)
+	}
+	return _c
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (_c *InternalMessageCreate) SetReplyCount(v uint32) *InternalMessageCreate {
+	_c.mutation.SetReplyCount(v)
+	return _c
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableReplyCount(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetReplyCount(*v)
+	}
+	return _c
+}
+
+// SetRemindCount sets the "remind_count" field.
+func (_c *InternalMessageCreate) SetRemindCount(v uint32) *InternalMessageCreate {
+	_c.mutation.SetRemindCount(v)
+	return _c
+}
+
+// SetNillableRemindCount sets the "remind_count" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableRemindCount(v *uint32) *InternalMessageCreate {
+	if v != nil {
+		_c.SetRemindCount(*v)
+	}
+	return _c
+}
+
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (_c *InternalMessageCreate) SetLastRemindedAt(v time.Time) *InternalMessageCreate {
+	_c.mutation.SetLastRemindedAt(v)
+	return _c
+}
+
+// SetNillableLastRemindedAt sets the "last_reminded_at" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillableLastRemindedAt(v *time.Time) *InternalMessageCreate {
+	if v != nil {
+		_c.SetLastRemindedAt(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *InternalMessageCreate) SetPriority(v internalmessage.Priority) *InternalMessageCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *InternalMessageCreate) SetNillablePriority(v *internalmessage.Priority) *InternalMessageCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *InternalMessageCreate) SetID(v uint32) *InternalMessageCreate {
 	_c.mutation.SetID(v)
@@ -217,7 +474,9 @@ func (_c *InternalMessageCreate) Mutation() *InternalMessageMutation {
 
 // Save creates the InternalMessage in the database.
 func (_c *InternalMessageCreate) Save(ctx context.Context) (*InternalMessage, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -244,7 +503,7 @@ func (_c *InternalMessageCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *InternalMessageCreate) defaults() {
+func (_c *InternalMessageCreate) defaults() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		v := internalmessage.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -253,6 +512,23 @@ func (_c *InternalMessageCreate) defaults() {
 		v := internalmessage.DefaultType
 		_c.mutation.SetType(v)
 	}
+	if _, ok := _c.mutation.DeliveryStatus(); !ok {
+		v := internalmessage.DefaultDeliveryStatus
+		_c.mutation.SetDeliveryStatus(v)
+	}
+	if _, ok := _c.mutation.ReplyCount(); !ok {
+		v := internalmessage.DefaultReplyCount
+		_c.mutation.SetReplyCount(v)
+	}
+	if _, ok := _c.mutation.RemindCount(); !ok {
+		v := internalmessage.DefaultRemindCount
+		_c.mutation.SetRemindCount(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := internalmessage.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -267,6 +543,21 @@ func (_c *InternalMessageCreate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.type": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Audience(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "audience", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.audience": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.DeliveryStatus(); ok {
+		if err := internalmessage.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := internalmessage.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.priority": %w`, err)}
+		}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := internalmessage.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.id": %w`, err)}
@@ -357,6 +648,86 @@ func (_c *InternalMessageCreate) createSpec() (*InternalMessage, *sqlgraph.Creat
 		_spec.SetField(internalmessage.FieldType, field.TypeEnum, value)
 		_node.Type = &value
 	}
+	if value, ok := _c.mutation.SendAt(); ok {
+		_spec.SetField(internalmessage.FieldSendAt, field.TypeTime, value)
+		_node.SendAt = &value
+	}
+	if value, ok := _c.mutation.CronSpec(); ok {
+		_spec.SetField(internalmessage.FieldCronSpec, field.TypeString, value)
+		_node.CronSpec = &value
+	}
+	if value, ok := _c.mutation.LastSentAt(); ok {
+		_spec.SetField(internalmessage.FieldLastSentAt, field.TypeTime, value)
+		_node.LastSentAt = &value
+	}
+	if value, ok := _c.mutation.TargetAll(); ok {
+		_spec.SetField(internalmessage.FieldTargetAll, field.TypeBool, value)
+		_node.TargetAll = &value
+	}
+	if value, ok := _c.mutation.TargetUserIds(); ok {
+		_spec.SetField(internalmessage.FieldTargetUserIds, field.TypeJSON, value)
+		_node.TargetUserIds = value
+	}
+	if value, ok := _c.mutation.Audience(); ok {
+		_spec.SetField(internalmessage.FieldAudience, field.TypeJSON, value)
+		_node.Audience = value
+	}
+	if value, ok := _c.mutation.DeliveryStatus(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStatus, field.TypeEnum, value)
+		_node.DeliveryStatus = &value
+	}
+	if value, ok := _c.mutation.RecipientTotal(); ok {
+		_spec.SetField(internalmessage.FieldRecipientTotal, field.TypeUint32, value)
+		_node.RecipientTotal = &value
+	}
+	if value, ok := _c.mutation.DeliveredCount(); ok {
+		_spec.SetField(internalmessage.FieldDeliveredCount, field.TypeUint32, value)
+		_node.DeliveredCount = &value
+	}
+	if value, ok := _c.mutation.FailedCount(); ok {
+		_spec.SetField(internalmessage.FieldFailedCount, field.TypeUint32, value)
+		_node.FailedCount = &value
+	}
+	if value, ok := _c.mutation.DeliveryStartedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStartedAt, field.TypeTime, value)
+		_node.DeliveryStartedAt = &value
+	}
+	if value, ok := _c.mutation.DeliveryFinishedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryFinishedAt, field.TypeTime, value)
+		_node.DeliveryFinishedAt = &value
+	}
+	if value, ok := _c.mutation.TemplateCode(); ok {
+		_spec.SetField(internalmessage.FieldTemplateCode, field.TypeString, value)
+		_node.TemplateCode = &value
+	}
+	if value, ok := _c.mutation.TemplateVariables(); ok {
+		_spec.SetField(internalmessage.FieldTemplateVariables, field.TypeJSON, value)
+		_node.TemplateVariables = value
+	}
+	if value, ok := _c.mutation.ConversationID(); ok {
+		_spec.SetField(internalmessage.FieldConversationID, field.TypeUint32, value)
+		_node.ConversationID = &value
+	}
+	if value, ok := _c.mutation.ThreadID(); ok {
+		_spec.SetField(internalmessage.FieldThreadID, field.TypeUint32, value)
+		_node.ThreadID = &value
+	}
+	if value, ok := _c.mutation.ReplyCount(); ok {
+		_spec.SetField(internalmessage.FieldReplyCount, field.TypeUint32, value)
+		_node.ReplyCount = &value
+	}
+	if value, ok := _c.mutation.RemindCount(); ok {
+		_spec.SetField(internalmessage.FieldRemindCount, field.TypeUint32, value)
+		_node.RemindCount = &value
+	}
+	if value, ok := _c.mutation.LastRemindedAt(); ok {
+		_spec.SetField(internalmessage.FieldLastRemindedAt, field.TypeTime, value)
+		_node.LastRemindedAt = &value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(internalmessage.FieldPriority, field.TypeEnum, value)
+		_node.Priority = &value
+	}
 	return _node, _spec
 }
 
@@ -637,809 +1008,2149 @@ func (u *InternalMessageUpsert) ClearType() *InternalMessageUpsert {
 	return u
 }
 
-// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
-// Using this option is equivalent to using:
-//
-//	client.InternalMessage.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(internalmessage.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *InternalMessageUpsertOne) UpdateNewValues() *InternalMessageUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		if _, exists := u.create.mutation.ID(); exists {
-			s.SetIgnore(internalmessage.FieldID)
-		}
-		if _, exists := u.create.mutation.CreatedAt(); exists {
-			s.SetIgnore(internalmessage.FieldCreatedAt)
-		}
-		if _, exists := u.create.mutation.TenantID(); exists {
-			s.SetIgnore(internalmessage.FieldTenantID)
-		}
-	}))
+// SetSendAt sets the "send_at" field.
+func (u *InternalMessageUpsert) SetSendAt(v time.Time) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldSendAt, v)
 	return u
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.InternalMessage.Create().
-//	    OnConflict(sql.ResolveWithIgnore()).
-//	    Exec(ctx)
-func (u *InternalMessageUpsertOne) Ignore() *InternalMessageUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateSendAt() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldSendAt)
 	return u
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *InternalMessageUpsertOne) DoNothing() *InternalMessageUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+// ClearSendAt clears the value of the "send_at" field.
+func (u *InternalMessageUpsert) ClearSendAt() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldSendAt)
 	return u
 }
 
-// Update allows overriding fields `UPDATE` values. See the InternalMessageCreate.OnConflict
-// documentation for more info.
-func (u *InternalMessageUpsertOne) Update(set func(*InternalMessageUpsert)) *InternalMessageUpsertOne {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&InternalMessageUpsert{UpdateSet: update})
-	}))
+// SetCronSpec sets the "cron_spec" field.
+func (u *InternalMessageUpsert) SetCronSpec(v string) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldCronSpec, v)
 	return u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *InternalMessageUpsertOne) SetUpdatedAt(v time.Time) *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetUpdatedAt(v)
-	})
+// UpdateCronSpec sets the "cron_spec" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateCronSpec() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldCronSpec)
+	return u
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateUpdatedAt() *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateUpdatedAt()
-	})
+// ClearCronSpec clears the value of the "cron_spec" field.
+func (u *InternalMessageUpsert) ClearCronSpec() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldCronSpec)
+	return u
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (u *InternalMessageUpsertOne) ClearUpdatedAt() *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearUpdatedAt()
-	})
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *InternalMessageUpsert) SetLastSentAt(v time.Time) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldLastSentAt, v)
+	return u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *InternalMessageUpsertOne) SetDeletedAt(v time.Time) *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetDeletedAt(v)
-	})
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateLastSentAt() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldLastSentAt)
+	return u
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateDeletedAt() *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateDeletedAt()
-	})
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *InternalMessageUpsert) ClearLastSentAt() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldLastSentAt)
+	return u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *InternalMessageUpsertOne) ClearDeletedAt() *InternalMessageUpsertOne {
-	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearDeletedAt()
-	})
+// SetTargetAll sets the "target_all" field.
+func (u *InternalMessageUpsert) SetTargetAll(v bool) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldTargetAll, v)
+	return u
+}
+
+// UpdateTargetAll sets the "target_all" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateTargetAll() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldTargetAll)
+	return u
+}
+
+// ClearTargetAll clears the value of the "target_all" field.
+func (u *InternalMessageUpsert) ClearTargetAll() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldTargetAll)
+	return u
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (u *InternalMessageUpsert) SetTargetUserIds(v []uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldTargetUserIds, v)
+	return u
+}
+
+// UpdateTargetUserIds sets the "target_user_ids" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateTargetUserIds() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldTargetUserIds)
+	return u
+}
+
+// ClearTargetUserIds clears the value of the "target_user_ids" field.
+func (u *InternalMessageUpsert) ClearTargetUserIds() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldTargetUserIds)
+	return u
+}
+
+// SetAudience sets the "audience" field.
+func (u *InternalMessageUpsert) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldAudience, v)
+	return u
+}
+
+// UpdateAudience sets the "audience" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateAudience() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldAudience)
+	return u
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (u *InternalMessageUpsert) ClearAudience() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldAudience)
+	return u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *InternalMessageUpsert) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldDeliveryStatus, v)
+	return u
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateDeliveryStatus() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldDeliveryStatus)
+	return u
+}
+
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (u *InternalMessageUpsert) ClearDeliveryStatus() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldDeliveryStatus)
+	return u
+}
+
+// SetRecipientTotal sets the "recipient_total" field.
+func (u *InternalMessageUpsert) SetRecipientTotal(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldRecipientTotal, v)
+	return u
+}
+
+// UpdateRecipientTotal sets the "recipient_total" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateRecipientTotal() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldRecipientTotal)
+	return u
+}
+
+// AddRecipientTotal adds v to the "recipient_total" field.
+func (u *InternalMessageUpsert) AddRecipientTotal(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldRecipientTotal, v)
+	return u
+}
+
+// ClearRecipientTotal clears the value of the "recipient_total" field.
+func (u *InternalMessageUpsert) ClearRecipientTotal() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldRecipientTotal)
+	return u
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *InternalMessageUpsert) SetDeliveredCount(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldDeliveredCount, v)
+	return u
+}
+
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateDeliveredCount() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldDeliveredCount)
+	return u
+}
+
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *InternalMessageUpsert) AddDeliveredCount(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldDeliveredCount, v)
+	return u
+}
+
+// ClearDeliveredCount clears the value of the "delivered_count" field.
+func (u *InternalMessageUpsert) ClearDeliveredCount() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldDeliveredCount)
+	return u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *InternalMessageUpsert) SetFailedCount(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldFailedCount, v)
+	return u
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateFailedCount() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldFailedCount)
+	return u
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *InternalMessageUpsert) AddFailedCount(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldFailedCount, v)
+	return u
+}
+
+// ClearFailedCount clears the value of the "failed_count" field.
+func (u *InternalMessageUpsert) ClearFailedCount() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldFailedCount)
+	return u
+}
+
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (u *InternalMessageUpsert) SetDeliveryStartedAt(v time.Time) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldDeliveryStartedAt, v)
+	return u
+}
+
+// UpdateDeliveryStartedAt sets the "delivery_started_at" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateDeliveryStartedAt() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldDeliveryStartedAt)
+	return u
+}
+
+// ClearDeliveryStartedAt clears the value of the "delivery_started_at" field.
+func (u *InternalMessageUpsert) ClearDeliveryStartedAt() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldDeliveryStartedAt)
+	return u
+}
+
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (u *InternalMessageUpsert) SetDeliveryFinishedAt(v time.Time) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldDeliveryFinishedAt, v)
+	return u
+}
+
+// UpdateDeliveryFinishedAt sets the "delivery_finished_at" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateDeliveryFinishedAt() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldDeliveryFinishedAt)
+	return u
+}
+
+// ClearDeliveryFinishedAt clears the value of the "delivery_finished_at" field.
+func (u *InternalMessageUpsert) ClearDeliveryFinishedAt() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldDeliveryFinishedAt)
+	return u
+}
+
+// SetTemplateCode sets the "template_code" field.
+func (u *InternalMessageUpsert) SetTemplateCode(v string) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldTemplateCode, v)
+	return u
+}
+
+// UpdateTemplateCode sets the "template_code" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateTemplateCode() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldTemplateCode)
+	return u
+}
+
+// ClearTemplateCode clears the value of the "template_code" field.
+func (u *InternalMessageUpsert) ClearTemplateCode() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldTemplateCode)
+	return u
+}
+
+// SetTemplateVariables sets the "template_variables" field.
+func (u *InternalMessageUpsert) SetTemplateVariables(v map[string]string) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldTemplateVariables, v)
+	return u
+}
+
+// UpdateTemplateVariables sets the "template_variables" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateTemplateVariables() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldTemplateVariables)
+	return u
+}
+
+// ClearTemplateVariables clears the value of the "template_variables" field.
+func (u *InternalMessageUpsert) ClearTemplateVariables() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldTemplateVariables)
+	return u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *InternalMessageUpsert) SetConversationID(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldConversationID, v)
+	return u
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateConversationID() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldConversationID)
+	return u
+}
+
+// AddConversationID adds v to the "conversation_id" field.
+func (u *InternalMessageUpsert) AddConversationID(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldConversationID, v)
+	return u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *InternalMessageUpsert) ClearConversationID() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldConversationID)
+	return u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *InternalMessageUpsert) SetThreadID(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldThreadID, v)
+	return u
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateThreadID() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldThreadID)
+	return u
+}
+
+// AddThreadID adds v to the "thread_id" field.
+func (u *InternalMessageUpsert) AddThreadID(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldThreadID, v)
+	return u
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *InternalMessageUpsert) ClearThreadID() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldThreadID)
+	return u
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (u *InternalMessageUpsert) SetReplyCount(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldReplyCount, v)
+	return u
+}
+
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateReplyCount() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldReplyCount)
+	return u
+}
+
+// AddReplyCount adds v to the "reply_count" field.
+func (u *InternalMessageUpsert) AddReplyCount(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldReplyCount, v)
+	return u
+}
+
+// ClearReplyCount clears the value of the "reply_count" field.
+func (u *InternalMessageUpsert) ClearReplyCount() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldReplyCount)
+	return u
+}
+
+// SetRemindCount sets the "remind_count" field.
+func (u *InternalMessageUpsert) SetRemindCount(v uint32) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldRemindCount, v)
+	return u
+}
+
+// UpdateRemindCount sets the "remind_count" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateRemindCount() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldRemindCount)
+	return u
+}
+
+// AddRemindCount adds v to the "remind_count" field.
+func (u *InternalMessageUpsert) AddRemindCount(v uint32) *InternalMessageUpsert {
+	u.Add(internalmessage.FieldRemindCount, v)
+	return u
+}
+
+// ClearRemindCount clears the value of the "remind_count" field.
+func (u *InternalMessageUpsert) ClearRemindCount() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldRemindCount)
+	return u
+}
+
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (u *InternalMessageUpsert) SetLastRemindedAt(v time.Time) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldLastRemindedAt, v)
+	return u
+}
+
+// UpdateLastRemindedAt sets the "last_reminded_at" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdateLastRemindedAt() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldLastRemindedAt)
+	return u
+}
+
+// ClearLastRemindedAt clears the value of the "last_reminded_at" field.
+func (u *InternalMessageUpsert) ClearLastRemindedAt() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldLastRemindedAt)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *InternalMessageUpsert) SetPriority(v internalmessage.Priority) *InternalMessageUpsert {
+	u.Set(internalmessage.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *InternalMessageUpsert) UpdatePriority() *InternalMessageUpsert {
+	u.SetExcluded(internalmessage.FieldPriority)
+	return u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *InternalMessageUpsert) ClearPriority() *InternalMessageUpsert {
+	u.SetNull(internalmessage.FieldPriority)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(internalmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InternalMessageUpsertOne) UpdateNewValues() *InternalMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(internalmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(internalmessage.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(internalmessage.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InternalMessageUpsertOne) Ignore() *InternalMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InternalMessageUpsertOne) DoNothing() *InternalMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InternalMessageCreate.OnConflict
+// documentation for more info.
+func (u *InternalMessageUpsertOne) Update(set func(*InternalMessageUpsert)) *InternalMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InternalMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InternalMessageUpsertOne) SetUpdatedAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateUpdatedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *InternalMessageUpsertOne) ClearUpdatedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InternalMessageUpsertOne) SetDeletedAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeletedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InternalMessageUpsertOne) ClearDeletedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *InternalMessageUpsertOne) SetCreatedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *InternalMessageUpsertOne) AddCreatedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateCreatedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *InternalMessageUpsertOne) ClearCreatedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *InternalMessageUpsertOne) SetUpdatedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *InternalMessageUpsertOne) AddUpdatedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateUpdatedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *InternalMessageUpsertOne) ClearUpdatedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *InternalMessageUpsertOne) SetDeletedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *InternalMessageUpsertOne) AddDeletedBy(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeletedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *InternalMessageUpsertOne) ClearDeletedBy() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetTitle sets the "title" field.
+func (u *InternalMessageUpsertOne) SetTitle(v string) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTitle(v)
+	})
+}
+
+// UpdateTitle sets the "title" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateTitle() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTitle()
+	})
+}
+
+// ClearTitle clears the value of the "title" field.
+func (u *InternalMessageUpsertOne) ClearTitle() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTitle()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *InternalMessageUpsertOne) SetContent(v string) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateContent() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// ClearContent clears the value of the "content" field.
+func (u *InternalMessageUpsertOne) ClearContent() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearContent()
+	})
+}
+
+// SetSenderID sets the "sender_id" field.
+func (u *InternalMessageUpsertOne) SetSenderID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetSenderID(v)
+	})
+}
+
+// AddSenderID adds v to the "sender_id" field.
+func (u *InternalMessageUpsertOne) AddSenderID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddSenderID(v)
+	})
+}
+
+// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateSenderID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateSenderID()
+	})
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (u *InternalMessageUpsertOne) ClearSenderID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearSenderID()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *InternalMessageUpsertOne) SetCategoryID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// AddCategoryID adds v to the "category_id" field.
+func (u *InternalMessageUpsertOne) AddCategoryID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateCategoryID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *InternalMessageUpsertOne) ClearCategoryID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InternalMessageUpsertOne) SetStatus(v internalmessage.Status) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateStatus() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *InternalMessageUpsertOne) ClearStatus() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetType sets the "type" field.
+func (u *InternalMessageUpsertOne) SetType(v internalmessage.Type) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetType(v)
+	})
+}
+
+// UpdateType sets the "type" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateType() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateType()
+	})
+}
+
+// ClearType clears the value of the "type" field.
+func (u *InternalMessageUpsertOne) ClearType() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearType()
+	})
+}
+
+// SetSendAt sets the "send_at" field.
+func (u *InternalMessageUpsertOne) SetSendAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetSendAt(v)
+	})
+}
+
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateSendAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateSendAt()
+	})
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (u *InternalMessageUpsertOne) ClearSendAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearSendAt()
+	})
+}
+
+// SetCronSpec sets the "cron_spec" field.
+func (u *InternalMessageUpsertOne) SetCronSpec(v string) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetCronSpec(v)
+	})
+}
+
+// UpdateCronSpec sets the "cron_spec" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateCronSpec() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateCronSpec()
+	})
+}
+
+// ClearCronSpec clears the value of the "cron_spec" field.
+func (u *InternalMessageUpsertOne) ClearCronSpec() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearCronSpec()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *InternalMessageUpsertOne) SetLastSentAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateLastSentAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *InternalMessageUpsertOne) ClearLastSentAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// SetTargetAll sets the "target_all" field.
+func (u *InternalMessageUpsertOne) SetTargetAll(v bool) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTargetAll(v)
+	})
+}
+
+// UpdateTargetAll sets the "target_all" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateTargetAll() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTargetAll()
+	})
+}
+
+// ClearTargetAll clears the value of the "target_all" field.
+func (u *InternalMessageUpsertOne) ClearTargetAll() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTargetAll()
+	})
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (u *InternalMessageUpsertOne) SetTargetUserIds(v []uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTargetUserIds(v)
+	})
+}
+
+// UpdateTargetUserIds sets the "target_user_ids" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateTargetUserIds() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTargetUserIds()
+	})
+}
+
+// ClearTargetUserIds clears the value of the "target_user_ids" field.
+func (u *InternalMessageUpsertOne) ClearTargetUserIds() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTargetUserIds()
+	})
+}
+
+// SetAudience sets the "audience" field.
+func (u *InternalMessageUpsertOne) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetAudience(v)
+	})
+}
+
+// UpdateAudience sets the "audience" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateAudience() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateAudience()
+	})
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (u *InternalMessageUpsertOne) ClearAudience() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearAudience()
+	})
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *InternalMessageUpsertOne) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeliveryStatus(v)
+	})
+}
+
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeliveryStatus() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeliveryStatus()
+	})
+}
+
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (u *InternalMessageUpsertOne) ClearDeliveryStatus() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeliveryStatus()
+	})
+}
+
+// SetRecipientTotal sets the "recipient_total" field.
+func (u *InternalMessageUpsertOne) SetRecipientTotal(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetRecipientTotal(v)
+	})
+}
+
+// AddRecipientTotal adds v to the "recipient_total" field.
+func (u *InternalMessageUpsertOne) AddRecipientTotal(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddRecipientTotal(v)
+	})
+}
+
+// UpdateRecipientTotal sets the "recipient_total" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateRecipientTotal() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateRecipientTotal()
+	})
+}
+
+// ClearRecipientTotal clears the value of the "recipient_total" field.
+func (u *InternalMessageUpsertOne) ClearRecipientTotal() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearRecipientTotal()
+	})
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *InternalMessageUpsertOne) SetDeliveredCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeliveredCount(v)
+	})
+}
+
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *InternalMessageUpsertOne) AddDeliveredCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddDeliveredCount(v)
+	})
+}
+
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeliveredCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeliveredCount()
+	})
+}
+
+// ClearDeliveredCount clears the value of the "delivered_count" field.
+func (u *InternalMessageUpsertOne) ClearDeliveredCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeliveredCount()
+	})
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (u *InternalMessageUpsertOne) SetFailedCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetFailedCount(v)
+	})
+}
+
+// AddFailedCount adds v to the "failed_count" field.
+func (u *InternalMessageUpsertOne) AddFailedCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddFailedCount(v)
+	})
+}
+
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateFailedCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateFailedCount()
+	})
+}
+
+// ClearFailedCount clears the value of the "failed_count" field.
+func (u *InternalMessageUpsertOne) ClearFailedCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearFailedCount()
+	})
+}
+
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (u *InternalMessageUpsertOne) SetDeliveryStartedAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeliveryStartedAt(v)
+	})
+}
+
+// UpdateDeliveryStartedAt sets the "delivery_started_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeliveryStartedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeliveryStartedAt()
+	})
+}
+
+// ClearDeliveryStartedAt clears the value of the "delivery_started_at" field.
+func (u *InternalMessageUpsertOne) ClearDeliveryStartedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeliveryStartedAt()
+	})
+}
+
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (u *InternalMessageUpsertOne) SetDeliveryFinishedAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeliveryFinishedAt(v)
+	})
+}
+
+// UpdateDeliveryFinishedAt sets the "delivery_finished_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateDeliveryFinishedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeliveryFinishedAt()
+	})
+}
+
+// ClearDeliveryFinishedAt clears the value of the "delivery_finished_at" field.
+func (u *InternalMessageUpsertOne) ClearDeliveryFinishedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeliveryFinishedAt()
+	})
+}
+
+// SetTemplateCode sets the "template_code" field.
+func (u *InternalMessageUpsertOne) SetTemplateCode(v string) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTemplateCode(v)
+	})
+}
+
+// UpdateTemplateCode sets the "template_code" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateTemplateCode() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTemplateCode()
+	})
+}
+
+// ClearTemplateCode clears the value of the "template_code" field.
+func (u *InternalMessageUpsertOne) ClearTemplateCode() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTemplateCode()
+	})
+}
+
+// SetTemplateVariables sets the "template_variables" field.
+func (u *InternalMessageUpsertOne) SetTemplateVariables(v map[string]string) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTemplateVariables(v)
+	})
+}
+
+// UpdateTemplateVariables sets the "template_variables" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateTemplateVariables() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTemplateVariables()
+	})
+}
+
+// ClearTemplateVariables clears the value of the "template_variables" field.
+func (u *InternalMessageUpsertOne) ClearTemplateVariables() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTemplateVariables()
+	})
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (u *InternalMessageUpsertOne) SetConversationID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetConversationID(v)
+	})
+}
+
+// AddConversationID adds v to the "conversation_id" field.
+func (u *InternalMessageUpsertOne) AddConversationID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddConversationID(v)
+	})
+}
+
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateConversationID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateConversationID()
+	})
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *InternalMessageUpsertOne) ClearConversationID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearConversationID()
+	})
+}
+
+// SetThreadID sets the "thread_id" field.
+func (u *InternalMessageUpsertOne) SetThreadID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetThreadID(v)
+	})
+}
+
+// AddThreadID adds v to the "thread_id" field.
+func (u *InternalMessageUpsertOne) AddThreadID(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddThreadID(v)
+	})
+}
+
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateThreadID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateThreadID()
+	})
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *InternalMessageUpsertOne) ClearThreadID() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearThreadID()
+	})
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (u *InternalMessageUpsertOne) SetReplyCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetReplyCount(v)
+	})
+}
+
+// AddReplyCount adds v to the "reply_count" field.
+func (u *InternalMessageUpsertOne) AddReplyCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddReplyCount(v)
+	})
+}
+
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateReplyCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateReplyCount()
+	})
+}
+
+// ClearReplyCount clears the value of the "reply_count" field.
+func (u *InternalMessageUpsertOne) ClearReplyCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearReplyCount()
+	})
+}
+
+// SetRemindCount sets the "remind_count" field.
+func (u *InternalMessageUpsertOne) SetRemindCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetRemindCount(v)
+	})
+}
+
+// AddRemindCount adds v to the "remind_count" field.
+func (u *InternalMessageUpsertOne) AddRemindCount(v uint32) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddRemindCount(v)
+	})
+}
+
+// UpdateRemindCount sets the "remind_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateRemindCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateRemindCount()
+	})
+}
+
+// ClearRemindCount clears the value of the "remind_count" field.
+func (u *InternalMessageUpsertOne) ClearRemindCount() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearRemindCount()
+	})
+}
+
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (u *InternalMessageUpsertOne) SetLastRemindedAt(v time.Time) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetLastRemindedAt(v)
+	})
+}
+
+// UpdateLastRemindedAt sets the "last_reminded_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdateLastRemindedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateLastRemindedAt()
+	})
+}
+
+// ClearLastRemindedAt clears the value of the "last_reminded_at" field.
+func (u *InternalMessageUpsertOne) ClearLastRemindedAt() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearLastRemindedAt()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *InternalMessageUpsertOne) SetPriority(v internalmessage.Priority) *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *InternalMessageUpsertOne) UpdatePriority() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *InternalMessageUpsertOne) ClearPriority() *InternalMessageUpsertOne {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearPriority()
+	})
+}
+
+// Exec executes the query.
+func (u *InternalMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InternalMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InternalMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InternalMessageUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InternalMessageUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InternalMessageCreateBulk is the builder for creating many InternalMessage entities in bulk.
+type InternalMessageCreateBulk struct {
+	config
+	err      error
+	builders []*InternalMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InternalMessage entities in the database.
+func (_c *InternalMessageCreateBulk) Save(ctx context.Context) ([]*InternalMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InternalMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternalMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InternalMessageCreateBulk) SaveX(ctx context.Context) []*InternalMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InternalMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InternalMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InternalMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InternalMessageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InternalMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *InternalMessageUpsertBulk {
+	_c.conflict = opts
+	return &InternalMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InternalMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InternalMessageCreateBulk) OnConflictColumns(columns ...string) *InternalMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InternalMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// InternalMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of InternalMessage nodes.
+type InternalMessageUpsertBulk struct {
+	create *InternalMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InternalMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(internalmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InternalMessageUpsertBulk) UpdateNewValues() *InternalMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(internalmessage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(internalmessage.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(internalmessage.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InternalMessageUpsertBulk) Ignore() *InternalMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InternalMessageUpsertBulk) DoNothing() *InternalMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InternalMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *InternalMessageUpsertBulk) Update(set func(*InternalMessageUpsert)) *InternalMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InternalMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InternalMessageUpsertBulk) SetUpdatedAt(v time.Time) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateUpdatedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *InternalMessageUpsertBulk) ClearUpdatedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InternalMessageUpsertBulk) SetDeletedAt(v time.Time) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateDeletedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InternalMessageUpsertBulk) ClearDeletedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeletedAt()
+	})
 }
 
 // SetCreatedBy sets the "created_by" field.
-func (u *InternalMessageUpsertOne) SetCreatedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetCreatedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetCreatedBy(v)
 	})
 }
 
 // AddCreatedBy adds v to the "created_by" field.
-func (u *InternalMessageUpsertOne) AddCreatedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) AddCreatedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.AddCreatedBy(v)
 	})
 }
 
 // UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateCreatedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateCreatedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateCreatedBy()
 	})
 }
 
 // ClearCreatedBy clears the value of the "created_by" field.
-func (u *InternalMessageUpsertOne) ClearCreatedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearCreatedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearCreatedBy()
 	})
 }
 
 // SetUpdatedBy sets the "updated_by" field.
-func (u *InternalMessageUpsertOne) SetUpdatedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetUpdatedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetUpdatedBy(v)
 	})
 }
 
 // AddUpdatedBy adds v to the "updated_by" field.
-func (u *InternalMessageUpsertOne) AddUpdatedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) AddUpdatedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.AddUpdatedBy(v)
 	})
 }
 
 // UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateUpdatedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateUpdatedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateUpdatedBy()
 	})
 }
 
 // ClearUpdatedBy clears the value of the "updated_by" field.
-func (u *InternalMessageUpsertOne) ClearUpdatedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearUpdatedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearUpdatedBy()
 	})
 }
 
 // SetDeletedBy sets the "deleted_by" field.
-func (u *InternalMessageUpsertOne) SetDeletedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetDeletedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetDeletedBy(v)
 	})
 }
 
 // AddDeletedBy adds v to the "deleted_by" field.
-func (u *InternalMessageUpsertOne) AddDeletedBy(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) AddDeletedBy(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.AddDeletedBy(v)
 	})
 }
 
 // UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateDeletedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateDeletedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateDeletedBy()
 	})
 }
 
 // ClearDeletedBy clears the value of the "deleted_by" field.
-func (u *InternalMessageUpsertOne) ClearDeletedBy() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearDeletedBy() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearDeletedBy()
 	})
 }
 
 // SetTitle sets the "title" field.
-func (u *InternalMessageUpsertOne) SetTitle(v string) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetTitle(v string) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetTitle(v)
 	})
 }
 
 // UpdateTitle sets the "title" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateTitle() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateTitle() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateTitle()
 	})
 }
 
 // ClearTitle clears the value of the "title" field.
-func (u *InternalMessageUpsertOne) ClearTitle() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearTitle() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearTitle()
 	})
 }
 
 // SetContent sets the "content" field.
-func (u *InternalMessageUpsertOne) SetContent(v string) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetContent(v string) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetContent(v)
 	})
 }
 
 // UpdateContent sets the "content" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateContent() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateContent() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateContent()
 	})
 }
 
 // ClearContent clears the value of the "content" field.
-func (u *InternalMessageUpsertOne) ClearContent() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearContent() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearContent()
 	})
 }
 
 // SetSenderID sets the "sender_id" field.
-func (u *InternalMessageUpsertOne) SetSenderID(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetSenderID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetSenderID(v)
 	})
 }
 
 // AddSenderID adds v to the "sender_id" field.
-func (u *InternalMessageUpsertOne) AddSenderID(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) AddSenderID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.AddSenderID(v)
 	})
 }
 
 // UpdateSenderID sets the "sender_id" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateSenderID() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateSenderID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateSenderID()
 	})
 }
 
 // ClearSenderID clears the value of the "sender_id" field.
-func (u *InternalMessageUpsertOne) ClearSenderID() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearSenderID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearSenderID()
 	})
 }
 
 // SetCategoryID sets the "category_id" field.
-func (u *InternalMessageUpsertOne) SetCategoryID(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetCategoryID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetCategoryID(v)
 	})
 }
 
 // AddCategoryID adds v to the "category_id" field.
-func (u *InternalMessageUpsertOne) AddCategoryID(v uint32) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) AddCategoryID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.AddCategoryID(v)
 	})
 }
 
 // UpdateCategoryID sets the "category_id" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateCategoryID() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateCategoryID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateCategoryID()
 	})
 }
 
 // ClearCategoryID clears the value of the "category_id" field.
-func (u *InternalMessageUpsertOne) ClearCategoryID() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearCategoryID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearCategoryID()
 	})
 }
 
 // SetStatus sets the "status" field.
-func (u *InternalMessageUpsertOne) SetStatus(v internalmessage.Status) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetStatus(v internalmessage.Status) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetStatus(v)
 	})
 }
 
 // UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateStatus() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateStatus() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateStatus()
 	})
 }
 
 // ClearStatus clears the value of the "status" field.
-func (u *InternalMessageUpsertOne) ClearStatus() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearStatus() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearStatus()
 	})
 }
 
 // SetType sets the "type" field.
-func (u *InternalMessageUpsertOne) SetType(v internalmessage.Type) *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) SetType(v internalmessage.Type) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.SetType(v)
 	})
 }
 
 // UpdateType sets the "type" field to the value that was provided on create.
-func (u *InternalMessageUpsertOne) UpdateType() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) UpdateType() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.UpdateType()
 	})
 }
 
 // ClearType clears the value of the "type" field.
-func (u *InternalMessageUpsertOne) ClearType() *InternalMessageUpsertOne {
+func (u *InternalMessageUpsertBulk) ClearType() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
 		s.ClearType()
 	})
 }
 
-// Exec executes the query.
-func (u *InternalMessageUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for InternalMessageCreate.OnConflict")
-	}
-	return u.create.Exec(ctx)
+// SetSendAt sets the "send_at" field.
+func (u *InternalMessageUpsertBulk) SetSendAt(v time.Time) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetSendAt(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (u *InternalMessageUpsertOne) ExecX(ctx context.Context) {
-	if err := u.create.Exec(ctx); err != nil {
-		panic(err)
-	}
+// UpdateSendAt sets the "send_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateSendAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateSendAt()
+	})
 }
 
-// Exec executes the UPSERT query and returns the inserted/updated ID.
-func (u *InternalMessageUpsertOne) ID(ctx context.Context) (id uint32, err error) {
-	node, err := u.create.Save(ctx)
-	if err != nil {
-		return id, err
-	}
-	return node.ID, nil
+// ClearSendAt clears the value of the "send_at" field.
+func (u *InternalMessageUpsertBulk) ClearSendAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearSendAt()
+	})
 }
 
-// IDX is like ID, but panics if an error occurs.
-func (u *InternalMessageUpsertOne) IDX(ctx context.Context) uint32 {
-	id, err := u.ID(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return id
+// SetCronSpec sets the "cron_spec" field.
+func (u *InternalMessageUpsertBulk) SetCronSpec(v string) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetCronSpec(v)
+	})
 }
 
-// InternalMessageCreateBulk is the builder for creating many InternalMessage entities in bulk.
-type InternalMessageCreateBulk struct {
-	config
-	err      error
-	builders []*InternalMessageCreate
-	conflict []sql.ConflictOption
+// UpdateCronSpec sets the "cron_spec" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateCronSpec() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateCronSpec()
+	})
 }
 
-// Save creates the InternalMessage entities in the database.
-func (_c *InternalMessageCreateBulk) Save(ctx context.Context) ([]*InternalMessage, error) {
-	if _c.err != nil {
-		return nil, _c.err
-	}
-	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
-	nodes := make([]*InternalMessage, len(_c.builders))
-	mutators := make([]Mutator, len(_c.builders))
-	for i := range _c.builders {
-		func(i int, root context.Context) {
-			builder := _c.builders[i]
-			builder.defaults()
-			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
-				mutation, ok := m.(*InternalMessageMutation)
-				if !ok {
-					return nil, fmt.Errorf("unexpected mutation type %T", m)
-				}
-				if err := builder.check(); err != nil {
-					return nil, err
-				}
-				builder.mutation = mutation
-				var err error
-				nodes[i], specs[i] = builder.createSpec()
-				if i < len(mutators)-1 {
-					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
-				} else {
-					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
-					spec.OnConflict = _c.conflict
-					// Invoke the actual operation on the latest mutation in the chain.
-					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
-						if sqlgraph.IsConstraintError(err) {
-							err = &ConstraintError{msg: err.Error(), wrap: err}
-						}
-					}
-				}
-				if err != nil {
-					return nil, err
-				}
-				mutation.id = &nodes[i].ID
-				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
-					id := specs[i].ID.Value.(int64)
-					nodes[i].ID = uint32(id)
-				}
-				mutation.done = true
-				return nodes[i], nil
-			})
-			for i := len(builder.hooks) - 1; i >= 0; i-- {
-				mut = builder.hooks[i](mut)
-			}
-			mutators[i] = mut
-		}(i, ctx)
-	}
-	if len(mutators) > 0 {
-		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
-			return nil, err
-		}
-	}
-	return nodes, nil
+// ClearCronSpec clears the value of the "cron_spec" field.
+func (u *InternalMessageUpsertBulk) ClearCronSpec() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearCronSpec()
+	})
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (u *InternalMessageUpsertBulk) SetLastSentAt(v time.Time) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetLastSentAt(v)
+	})
+}
+
+// UpdateLastSentAt sets the "last_sent_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateLastSentAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateLastSentAt()
+	})
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (u *InternalMessageUpsertBulk) ClearLastSentAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearLastSentAt()
+	})
+}
+
+// SetTargetAll sets the "target_all" field.
+func (u *InternalMessageUpsertBulk) SetTargetAll(v bool) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTargetAll(v)
+	})
+}
+
+// UpdateTargetAll sets the "target_all" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateTargetAll() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTargetAll()
+	})
+}
+
+// ClearTargetAll clears the value of the "target_all" field.
+func (u *InternalMessageUpsertBulk) ClearTargetAll() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTargetAll()
+	})
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (u *InternalMessageUpsertBulk) SetTargetUserIds(v []uint32) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetTargetUserIds(v)
+	})
+}
+
+// UpdateTargetUserIds sets the "target_user_ids" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateTargetUserIds() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateTargetUserIds()
+	})
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_c *InternalMessageCreateBulk) SaveX(ctx context.Context) []*InternalMessage {
-	v, err := _c.Save(ctx)
-	if err != nil {
-		panic(err)
-	}
-	return v
+// ClearTargetUserIds clears the value of the "target_user_ids" field.
+func (u *InternalMessageUpsertBulk) ClearTargetUserIds() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearTargetUserIds()
+	})
 }
 
-// Exec executes the query.
-func (_c *InternalMessageCreateBulk) Exec(ctx context.Context) error {
-	_, err := _c.Save(ctx)
-	return err
+// SetAudience sets the "audience" field.
+func (u *InternalMessageUpsertBulk) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetAudience(v)
+	})
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_c *InternalMessageCreateBulk) ExecX(ctx context.Context) {
-	if err := _c.Exec(ctx); err != nil {
-		panic(err)
-	}
+// UpdateAudience sets the "audience" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateAudience() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateAudience()
+	})
 }
 
-// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
-// of the `INSERT` statement. For example:
-//
-//	client.InternalMessage.CreateBulk(builders...).
-//		OnConflict(
-//			// Update the row with the new values
-//			// the was proposed for insertion.
-//			sql.ResolveWithNewValues(),
-//		).
-//		// Override some of the fields with custom
-//		// update values.
-//		Update(func(u *ent.InternalMessageUpsert) {
-//			SetCreatedAt(v+v).
-//		}).
-//		Exec(ctx)
-func (_c *InternalMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *InternalMessageUpsertBulk {
-	_c.conflict = opts
-	return &InternalMessageUpsertBulk{
-		create: _c,
-	}
+// ClearAudience clears the value of the "audience" field.
+func (u *InternalMessageUpsertBulk) ClearAudience() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearAudience()
+	})
 }
 
-// OnConflictColumns calls `OnConflict` and configures the columns
-// as conflict target. Using this option is equivalent to using:
-//
-//	client.InternalMessage.Create().
-//		OnConflict(sql.ConflictColumns(columns...)).
-//		Exec(ctx)
-func (_c *InternalMessageCreateBulk) OnConflictColumns(columns ...string) *InternalMessageUpsertBulk {
-	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
-	return &InternalMessageUpsertBulk{
-		create: _c,
-	}
+// SetDeliveryStatus sets the "delivery_status" field.
+func (u *InternalMessageUpsertBulk) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetDeliveryStatus(v)
+	})
 }
 
-// InternalMessageUpsertBulk is the builder for "upsert"-ing
-// a bulk of InternalMessage nodes.
-type InternalMessageUpsertBulk struct {
-	create *InternalMessageCreateBulk
+// UpdateDeliveryStatus sets the "delivery_status" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateDeliveryStatus() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateDeliveryStatus()
+	})
 }
 
-// UpdateNewValues updates the mutable fields using the new values that
-// were set on create. Using this option is equivalent to using:
-//
-//	client.InternalMessage.Create().
-//		OnConflict(
-//			sql.ResolveWithNewValues(),
-//			sql.ResolveWith(func(u *sql.UpdateSet) {
-//				u.SetIgnore(internalmessage.FieldID)
-//			}),
-//		).
-//		Exec(ctx)
-func (u *InternalMessageUpsertBulk) UpdateNewValues() *InternalMessageUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
-		for _, b := range u.create.builders {
-			if _, exists := b.mutation.ID(); exists {
-				s.SetIgnore(internalmessage.FieldID)
-			}
-			if _, exists := b.mutation.CreatedAt(); exists {
-				s.SetIgnore(internalmessage.FieldCreatedAt)
-			}
-			if _, exists := b.mutation.TenantID(); exists {
-				s.SetIgnore(internalmessage.FieldTenantID)
-			}
-		}
-	}))
-	return u
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (u *InternalMessageUpsertBulk) ClearDeliveryStatus() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearDeliveryStatus()
+	})
 }
 
-// Ignore sets each column to itself in case of conflict.
-// Using this option is equivalent to using:
-//
-//	client.InternalMessage.Create().
-//		OnConflict(sql.ResolveWithIgnore()).
-//		Exec(ctx)
-func (u *InternalMessageUpsertBulk) Ignore() *InternalMessageUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
-	return u
+// SetRecipientTotal sets the "recipient_total" field.
+func (u *InternalMessageUpsertBulk) SetRecipientTotal(v uint32) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetRecipientTotal(v)
+	})
 }
 
-// DoNothing configures the conflict_action to `DO NOTHING`.
-// Supported only by SQLite and PostgreSQL.
-func (u *InternalMessageUpsertBulk) DoNothing() *InternalMessageUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.DoNothing())
-	return u
+// AddRecipientTotal adds v to the "recipient_total" field.
+func (u *InternalMessageUpsertBulk) AddRecipientTotal(v uint32) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.AddRecipientTotal(v)
+	})
 }
 
-// Update allows overriding fields `UPDATE` values. See the InternalMessageCreateBulk.OnConflict
-// documentation for more info.
-func (u *InternalMessageUpsertBulk) Update(set func(*InternalMessageUpsert)) *InternalMessageUpsertBulk {
-	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
-		set(&InternalMessageUpsert{UpdateSet: update})
-	}))
-	return u
+// UpdateRecipientTotal sets the "recipient_total" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateRecipientTotal() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateRecipientTotal()
+	})
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (u *InternalMessageUpsertBulk) SetUpdatedAt(v time.Time) *InternalMessageUpsertBulk {
+// ClearRecipientTotal clears the value of the "recipient_total" field.
+func (u *InternalMessageUpsertBulk) ClearRecipientTotal() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetUpdatedAt(v)
+		s.ClearRecipientTotal()
 	})
 }
 
-// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateUpdatedAt() *InternalMessageUpsertBulk {
+// SetDeliveredCount sets the "delivered_count" field.
+func (u *InternalMessageUpsertBulk) SetDeliveredCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateUpdatedAt()
+		s.SetDeliveredCount(v)
 	})
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (u *InternalMessageUpsertBulk) ClearUpdatedAt() *InternalMessageUpsertBulk {
+// AddDeliveredCount adds v to the "delivered_count" field.
+func (u *InternalMessageUpsertBulk) AddDeliveredCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearUpdatedAt()
+		s.AddDeliveredCount(v)
 	})
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (u *InternalMessageUpsertBulk) SetDeletedAt(v time.Time) *InternalMessageUpsertBulk {
+// UpdateDeliveredCount sets the "delivered_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateDeliveredCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetDeletedAt(v)
+		s.UpdateDeliveredCount()
 	})
 }
 
-// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateDeletedAt() *InternalMessageUpsertBulk {
+// ClearDeliveredCount clears the value of the "delivered_count" field.
+func (u *InternalMessageUpsertBulk) ClearDeliveredCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateDeletedAt()
+		s.ClearDeliveredCount()
 	})
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (u *InternalMessageUpsertBulk) ClearDeletedAt() *InternalMessageUpsertBulk {
+// SetFailedCount sets the "failed_count" field.
+func (u *InternalMessageUpsertBulk) SetFailedCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearDeletedAt()
+		s.SetFailedCount(v)
 	})
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (u *InternalMessageUpsertBulk) SetCreatedBy(v uint32) *InternalMessageUpsertBulk {
+// AddFailedCount adds v to the "failed_count" field.
+func (u *InternalMessageUpsertBulk) AddFailedCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetCreatedBy(v)
+		s.AddFailedCount(v)
 	})
 }
 
-// AddCreatedBy adds v to the "created_by" field.
-func (u *InternalMessageUpsertBulk) AddCreatedBy(v uint32) *InternalMessageUpsertBulk {
+// UpdateFailedCount sets the "failed_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateFailedCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.AddCreatedBy(v)
+		s.UpdateFailedCount()
 	})
 }
 
-// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateCreatedBy() *InternalMessageUpsertBulk {
+// ClearFailedCount clears the value of the "failed_count" field.
+func (u *InternalMessageUpsertBulk) ClearFailedCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateCreatedBy()
+		s.ClearFailedCount()
 	})
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (u *InternalMessageUpsertBulk) ClearCreatedBy() *InternalMessageUpsertBulk {
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (u *InternalMessageUpsertBulk) SetDeliveryStartedAt(v time.Time) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearCreatedBy()
+		s.SetDeliveryStartedAt(v)
 	})
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (u *InternalMessageUpsertBulk) SetUpdatedBy(v uint32) *InternalMessageUpsertBulk {
+// UpdateDeliveryStartedAt sets the "delivery_started_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateDeliveryStartedAt() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetUpdatedBy(v)
+		s.UpdateDeliveryStartedAt()
 	})
 }
 
-// AddUpdatedBy adds v to the "updated_by" field.
-func (u *InternalMessageUpsertBulk) AddUpdatedBy(v uint32) *InternalMessageUpsertBulk {
+// ClearDeliveryStartedAt clears the value of the "delivery_started_at" field.
+func (u *InternalMessageUpsertBulk) ClearDeliveryStartedAt() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.AddUpdatedBy(v)
+		s.ClearDeliveryStartedAt()
 	})
 }
 
-// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateUpdatedBy() *InternalMessageUpsertBulk {
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (u *InternalMessageUpsertBulk) SetDeliveryFinishedAt(v time.Time) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateUpdatedBy()
+		s.SetDeliveryFinishedAt(v)
 	})
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (u *InternalMessageUpsertBulk) ClearUpdatedBy() *InternalMessageUpsertBulk {
+// UpdateDeliveryFinishedAt sets the "delivery_finished_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateDeliveryFinishedAt() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearUpdatedBy()
+		s.UpdateDeliveryFinishedAt()
 	})
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (u *InternalMessageUpsertBulk) SetDeletedBy(v uint32) *InternalMessageUpsertBulk {
+// ClearDeliveryFinishedAt clears the value of the "delivery_finished_at" field.
+func (u *InternalMessageUpsertBulk) ClearDeliveryFinishedAt() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetDeletedBy(v)
+		s.ClearDeliveryFinishedAt()
 	})
 }
 
-// AddDeletedBy adds v to the "deleted_by" field.
-func (u *InternalMessageUpsertBulk) AddDeletedBy(v uint32) *InternalMessageUpsertBulk {
+// SetTemplateCode sets the "template_code" field.
+func (u *InternalMessageUpsertBulk) SetTemplateCode(v string) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.AddDeletedBy(v)
+		s.SetTemplateCode(v)
 	})
 }
 
-// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateDeletedBy() *InternalMessageUpsertBulk {
+// UpdateTemplateCode sets the "template_code" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateTemplateCode() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateDeletedBy()
+		s.UpdateTemplateCode()
 	})
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (u *InternalMessageUpsertBulk) ClearDeletedBy() *InternalMessageUpsertBulk {
+// ClearTemplateCode clears the value of the "template_code" field.
+func (u *InternalMessageUpsertBulk) ClearTemplateCode() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearDeletedBy()
+		s.ClearTemplateCode()
 	})
 }
 
-// SetTitle sets the "title" field.
-func (u *InternalMessageUpsertBulk) SetTitle(v string) *InternalMessageUpsertBulk {
+// SetTemplateVariables sets the "template_variables" field.
+func (u *InternalMessageUpsertBulk) SetTemplateVariables(v map[string]string) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetTitle(v)
+		s.SetTemplateVariables(v)
 	})
 }
 
-// UpdateTitle sets the "title" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateTitle() *InternalMessageUpsertBulk {
+// UpdateTemplateVariables sets the "template_variables" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateTemplateVariables() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateTitle()
+		s.UpdateTemplateVariables()
 	})
 }
 
-// ClearTitle clears the value of the "title" field.
-func (u *InternalMessageUpsertBulk) ClearTitle() *InternalMessageUpsertBulk {
+// ClearTemplateVariables clears the value of the "template_variables" field.
+func (u *InternalMessageUpsertBulk) ClearTemplateVariables() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearTitle()
+		s.ClearTemplateVariables()
 	})
 }
 
-// SetContent sets the "content" field.
-func (u *InternalMessageUpsertBulk) SetContent(v string) *InternalMessageUpsertBulk {
+// SetConversationID sets the "conversation_id" field.
+func (u *InternalMessageUpsertBulk) SetConversationID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetContent(v)
+		s.SetConversationID(v)
 	})
 }
 
-// UpdateContent sets the "content" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateContent() *InternalMessageUpsertBulk {
+// AddConversationID adds v to the "conversation_id" field.
+func (u *InternalMessageUpsertBulk) AddConversationID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateContent()
+		s.AddConversationID(v)
 	})
 }
 
-// ClearContent clears the value of the "content" field.
-func (u *InternalMessageUpsertBulk) ClearContent() *InternalMessageUpsertBulk {
+// UpdateConversationID sets the "conversation_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateConversationID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearContent()
+		s.UpdateConversationID()
 	})
 }
 
-// SetSenderID sets the "sender_id" field.
-func (u *InternalMessageUpsertBulk) SetSenderID(v uint32) *InternalMessageUpsertBulk {
+// ClearConversationID clears the value of the "conversation_id" field.
+func (u *InternalMessageUpsertBulk) ClearConversationID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetSenderID(v)
+		s.ClearConversationID()
 	})
 }
 
-// AddSenderID adds v to the "sender_id" field.
-func (u *InternalMessageUpsertBulk) AddSenderID(v uint32) *InternalMessageUpsertBulk {
+// SetThreadID sets the "thread_id" field.
+func (u *InternalMessageUpsertBulk) SetThreadID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.AddSenderID(v)
+		s.SetThreadID(v)
 	})
 }
 
-// UpdateSenderID sets the "sender_id" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateSenderID() *InternalMessageUpsertBulk {
+// AddThreadID adds v to the "thread_id" field.
+func (u *InternalMessageUpsertBulk) AddThreadID(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateSenderID()
+		s.AddThreadID(v)
 	})
 }
 
-// ClearSenderID clears the value of the "sender_id" field.
-func (u *InternalMessageUpsertBulk) ClearSenderID() *InternalMessageUpsertBulk {
+// UpdateThreadID sets the "thread_id" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateThreadID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearSenderID()
+		s.UpdateThreadID()
 	})
 }
 
-// SetCategoryID sets the "category_id" field.
-func (u *InternalMessageUpsertBulk) SetCategoryID(v uint32) *InternalMessageUpsertBulk {
+// ClearThreadID clears the value of the "thread_id" field.
+func (u *InternalMessageUpsertBulk) ClearThreadID() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetCategoryID(v)
+		s.ClearThreadID()
 	})
 }
 
-// AddCategoryID adds v to the "category_id" field.
-func (u *InternalMessageUpsertBulk) AddCategoryID(v uint32) *InternalMessageUpsertBulk {
+// SetReplyCount sets the "reply_count" field.
+func (u *InternalMessageUpsertBulk) SetReplyCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.AddCategoryID(v)
+		s.SetReplyCount(v)
 	})
 }
 
-// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateCategoryID() *InternalMessageUpsertBulk {
+// AddReplyCount adds v to the "reply_count" field.
+func (u *InternalMessageUpsertBulk) AddReplyCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateCategoryID()
+		s.AddReplyCount(v)
 	})
 }
 
-// ClearCategoryID clears the value of the "category_id" field.
-func (u *InternalMessageUpsertBulk) ClearCategoryID() *InternalMessageUpsertBulk {
+// UpdateReplyCount sets the "reply_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateReplyCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearCategoryID()
+		s.UpdateReplyCount()
 	})
 }
 
-// SetStatus sets the "status" field.
-func (u *InternalMessageUpsertBulk) SetStatus(v internalmessage.Status) *InternalMessageUpsertBulk {
+// ClearReplyCount clears the value of the "reply_count" field.
+func (u *InternalMessageUpsertBulk) ClearReplyCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetStatus(v)
+		s.ClearReplyCount()
 	})
 }
 
-// UpdateStatus sets the "status" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateStatus() *InternalMessageUpsertBulk {
+// SetRemindCount sets the "remind_count" field.
+func (u *InternalMessageUpsertBulk) SetRemindCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateStatus()
+		s.SetRemindCount(v)
 	})
 }
 
-// ClearStatus clears the value of the "status" field.
-func (u *InternalMessageUpsertBulk) ClearStatus() *InternalMessageUpsertBulk {
+// AddRemindCount adds v to the "remind_count" field.
+func (u *InternalMessageUpsertBulk) AddRemindCount(v uint32) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearStatus()
+		s.AddRemindCount(v)
 	})
 }
 
-// SetType sets the "type" field.
-func (u *InternalMessageUpsertBulk) SetType(v internalmessage.Type) *InternalMessageUpsertBulk {
+// UpdateRemindCount sets the "remind_count" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateRemindCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.SetType(v)
+		s.UpdateRemindCount()
 	})
 }
 
-// UpdateType sets the "type" field to the value that was provided on create.
-func (u *InternalMessageUpsertBulk) UpdateType() *InternalMessageUpsertBulk {
+// ClearRemindCount clears the value of the "remind_count" field.
+func (u *InternalMessageUpsertBulk) ClearRemindCount() *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.UpdateType()
+		s.ClearRemindCount()
 	})
 }
 
-// ClearType clears the value of the "type" field.
-func (u *InternalMessageUpsertBulk) ClearType() *InternalMessageUpsertBulk {
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (u *InternalMessageUpsertBulk) SetLastRemindedAt(v time.Time) *InternalMessageUpsertBulk {
 	return u.Update(func(s *InternalMessageUpsert) {
-		s.ClearType()
+		s.SetLastRemindedAt(v)
+	})
+}
+
+// UpdateLastRemindedAt sets the "last_reminded_at" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdateLastRemindedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdateLastRemindedAt()
+	})
+}
+
+// ClearLastRemindedAt clears the value of the "last_reminded_at" field.
+func (u *InternalMessageUpsertBulk) ClearLastRemindedAt() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearLastRemindedAt()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *InternalMessageUpsertBulk) SetPriority(v internalmessage.Priority) *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *InternalMessageUpsertBulk) UpdatePriority() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (u *InternalMessageUpsertBulk) ClearPriority() *InternalMessageUpsertBulk {
+	return u.Update(func(s *InternalMessageUpsert) {
+		s.ClearPriority()
 	})
 }
 
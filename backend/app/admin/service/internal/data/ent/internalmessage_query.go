@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
@@ -330,6 +331,12 @@ func (_q *InternalMessageQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if internalmessage.Policy == nil {
+		return errors.New("ent: uninitialized internalmessage.Policy (forgotten import ent/runtime?)")
+	}
+	if err := internalmessage.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -6,12 +6,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -284,6 +286,437 @@ func (_u *InternalMessageUpdate) ClearType() *InternalMessageUpdate {
 	return _u
 }
 
+// SetSendAt sets the "send_at" field.
+func (_u *InternalMessageUpdate) SetSendAt(v time.Time) *InternalMessageUpdate {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableSendAt(v *time.Time) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (_u *InternalMessageUpdate) ClearSendAt() *InternalMessageUpdate {
+	_u.mutation.ClearSendAt()
+	return _u
+}
+
+// SetCronSpec sets the "cron_spec" field.
+func (_u *InternalMessageUpdate) SetCronSpec(v string) *InternalMessageUpdate {
+	_u.mutation.SetCronSpec(v)
+	return _u
+}
+
+// SetNillableCronSpec sets the "cron_spec" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableCronSpec(v *string) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetCronSpec(*v)
+	}
+	return _u
+}
+
+// ClearCronSpec clears the value of the "cron_spec" field.
+func (_u *InternalMessageUpdate) ClearCronSpec() *InternalMessageUpdate {
+	_u.mutation.ClearCronSpec()
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *InternalMessageUpdate) SetLastSentAt(v time.Time) *InternalMessageUpdate {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableLastSentAt(v *time.Time) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *InternalMessageUpdate) ClearLastSentAt() *InternalMessageUpdate {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetTargetAll sets the "target_all" field.
+func (_u *InternalMessageUpdate) SetTargetAll(v bool) *InternalMessageUpdate {
+	_u.mutation.SetTargetAll(v)
+	return _u
+}
+
+// SetNillableTargetAll sets the "target_all" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableTargetAll(v *bool) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetTargetAll(*v)
+	}
+	return _u
+}
+
+// ClearTargetAll clears the value of the "target_all" field.
+func (_u *InternalMessageUpdate) ClearTargetAll() *InternalMessageUpdate {
+	_u.mutation.ClearTargetAll()
+	return _u
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (_u *InternalMessageUpdate) SetTargetUserIds(v []uint32) *InternalMessageUpdate {
+	_u.mutation.SetTargetUserIds(v)
+	return _u
+}
+
+// AppendTargetUserIds appends value to the "target_user_ids" field.
+func (_u *InternalMessageUpdate) AppendTargetUserIds(v []uint32) *InternalMessageUpdate {
+	_u.mutation.AppendTargetUserIds(v)
+	return _u
+}
+
+// ClearTargetUserIds clears the value of the "target_user_ids" field.
+func (_u *InternalMessageUpdate) ClearTargetUserIds() *InternalMessageUpdate {
+	_u.mutation.ClearTargetUserIds()
+	return _u
+}
+
+// SetAudience sets the "audience" field.
+func (_u *InternalMessageUpdate) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageUpdate {
+	_u.mutation.SetAudience(v)
+	return _u
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (_u *InternalMessageUpdate) ClearAudience() *InternalMessageUpdate {
+	_u.mutation.ClearAudience()
+	return _u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_u *InternalMessageUpdate) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageUpdate {
+	_u.mutation.SetDeliveryStatus(v)
+	return _u
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableDeliveryStatus(v *internalmessage.DeliveryStatus) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetDeliveryStatus(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (_u *InternalMessageUpdate) ClearDeliveryStatus() *InternalMessageUpdate {
+	_u.mutation.ClearDeliveryStatus()
+	return _u
+}
+
+// SetRecipientTotal sets the "recipient_total" field.
+func (_u *InternalMessageUpdate) SetRecipientTotal(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetRecipientTotal()
+	_u.mutation.SetRecipientTotal(v)
+	return _u
+}
+
+// SetNillableRecipientTotal sets the "recipient_total" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableRecipientTotal(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetRecipientTotal(*v)
+	}
+	return _u
+}
+
+// AddRecipientTotal adds value to the "recipient_total" field.
+func (_u *InternalMessageUpdate) AddRecipientTotal(v int32) *InternalMessageUpdate {
+	_u.mutation.AddRecipientTotal(v)
+	return _u
+}
+
+// ClearRecipientTotal clears the value of the "recipient_total" field.
+func (_u *InternalMessageUpdate) ClearRecipientTotal() *InternalMessageUpdate {
+	_u.mutation.ClearRecipientTotal()
+	return _u
+}
+
+// SetDeliveredCount sets the "delivered_count" field.
+func (_u *InternalMessageUpdate) SetDeliveredCount(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetDeliveredCount()
+	_u.mutation.SetDeliveredCount(v)
+	return _u
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableDeliveredCount(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetDeliveredCount(*v)
+	}
+	return _u
+}
+
+// AddDeliveredCount adds value to the "delivered_count" field.
+func (_u *InternalMessageUpdate) AddDeliveredCount(v int32) *InternalMessageUpdate {
+	_u.mutation.AddDeliveredCount(v)
+	return _u
+}
+
+// ClearDeliveredCount clears the value of the "delivered_count" field.
+func (_u *InternalMessageUpdate) ClearDeliveredCount() *InternalMessageUpdate {
+	_u.mutation.ClearDeliveredCount()
+	return _u
+}
+
+// SetFailedCount sets the "failed_count" field.
+func (_u *InternalMessageUpdate) SetFailedCount(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableFailedCount(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetFailedCount(*v)
+	}
+	return _u
+}
+
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *InternalMessageUpdate) AddFailedCount(v int32) *InternalMessageUpdate {
+	_u.mutation.AddFailedCount(v)
+	return _u
+}
+
+// ClearFailedCount clears the value of the "failed_count" field.
+func (_u *InternalMessageUpdate) ClearFailedCount() *InternalMessageUpdate {
+	_u.mutation.ClearFailedCount()
+	return _u
+}
+
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (_u *InternalMessageUpdate) SetDeliveryStartedAt(v time.Time) *InternalMessageUpdate {
+	_u.mutation.SetDeliveryStartedAt(v)
+	return _u
+}
+
+// SetNillableDeliveryStartedAt sets the "delivery_started_at" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableDeliveryStartedAt(v *time.Time) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetDeliveryStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryStartedAt clears the value of the "delivery_started_at" field.
+func (_u *InternalMessageUpdate) ClearDeliveryStartedAt() *InternalMessageUpdate {
+	_u.mutation.ClearDeliveryStartedAt()
+	return _u
+}
+
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (_u *InternalMessageUpdate) SetDeliveryFinishedAt(v time.Time) *InternalMessageUpdate {
+	_u.mutation.SetDeliveryFinishedAt(v)
+	return _u
+}
+
+// SetNillableDeliveryFinishedAt sets the "delivery_finished_at" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableDeliveryFinishedAt(v *time.Time) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetDeliveryFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeliveryFinishedAt clears the value of the "delivery_finished_at" field.
+func (_u *InternalMessageUpdate) ClearDeliveryFinishedAt() *InternalMessageUpdate {
+	_u.mutation.ClearDeliveryFinishedAt()
+	return _u
+}
+
+// SetTemplateCode sets the "template_code" field.
+func (_u *InternalMessageUpdate) SetTemplateCode(v string) *InternalMessageUpdate {
+	_u.mutation.SetTemplateCode(v)
+	return _u
+}
+
+// SetNillableTemplateCode sets the "template_code" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableTemplateCode(v *string) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetTemplateCode(*v)
+	}
+	return _u
+}
+
+// ClearTemplateCode clears the value of the "template_code" field.
+func (_u *InternalMessageUpdate) ClearTemplateCode() *InternalMessageUpdate {
+	_u.mutation.ClearTemplateCode()
+	return _u
+}
+
+// SetTemplateVariables sets the "template_variables" field.
+func (_u *InternalMessageUpdate) SetTemplateVariables(v map[string]string) *InternalMessageUpdate {
+	_u.mutation.SetTemplateVariables(v)
+	return _u
+}
+
+// ClearTemplateVariables clears the value of the "template_variables" field.
+func (_u *InternalMessageUpdate) ClearTemplateVariables() *InternalMessageUpdate {
+	_u.mutation.ClearTemplateVariables()
+	return _u
+}
+
+// SetConversationID sets the "conversation_id" field.
+func (_u *InternalMessageUpdate) SetConversationID(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableConversationID(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetConversationID(*v)
+	}
+	return _u
+}
+
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *InternalMessageUpdate) AddConversationID(v int32) *InternalMessageUpdate {
+	_u.mutation.AddConversationID(v)
+	return _u
+}
+
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *InternalMessageUpdate) ClearConversationID() *InternalMessageUpdate {
+	_u.mutation.ClearConversationID()
+	return _u
+}
+
+// SetThreadID sets the "thread_id" field.
+func (_u *InternalMessageUpdate) SetThreadID(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetThreadID()
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableThreadID(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetThreadID(*v)
+	}
+	return _u
+}
+
+// AddThreadID adds value to the "thread_id" field.
+func (_u *InternalMessageUpdate) AddThreadID(v int32) *InternalMessageUpdate {
+	_u.mutation.AddThreadID(v)
+	return _u
+}
+
+// ClearThreadID clears the value of the "thread_id" field.
+func (_u *InternalMessageUpdate) ClearThreadID() *InternalMessageUpdate {
+	_u.mutation.ClearThreadID()
+	return _u
+}
+
+// SetReplyCount sets the "reply_count" field.
+func (_u *InternalMessageUpdate) SetReplyCount(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetReplyCount()
+	_u.mutation.SetReplyCount(v)
+	return _u
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableReplyCount(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetReplyCount(*v)
+	}
+	return _u
+}
+
+// AddReplyCount adds value to the "reply_count" field.
+func (_u *InternalMessageUpdate) AddReplyCount(v int32) *InternalMessageUpdate {
+	_u.mutation.AddReplyCount(v)
+	return _u
+}
+
+// ClearReplyCount clears the value of the "reply_count" field.
+func (_u *InternalMessageUpdate) ClearReplyCount() *InternalMessageUpdate {
+	_u.mutation.ClearReplyCount()
+	return _u
+}
+
+// SetRemindCount sets the "remind_count" field.
+func (_u *InternalMessageUpdate) SetRemindCount(v uint32) *InternalMessageUpdate {
+	_u.mutation.ResetRemindCount()
+	_u.mutation.SetRemindCount(v)
+	return _u
+}
+
+// SetNillableRemindCount sets the "remind_count" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableRemindCount(v *uint32) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetRemindCount(*v)
+	}
+	return _u
+}
+
+// AddRemindCount adds value to the "remind_count" field.
+func (_u *InternalMessageUpdate) AddRemindCount(v int32) *InternalMessageUpdate {
+	_u.mutation.AddRemindCount(v)
+	return _u
+}
+
+// ClearRemindCount clears the value of the "remind_count" field.
+func (_u *InternalMessageUpdate) ClearRemindCount() *InternalMessageUpdate {
+	_u.mutation.ClearRemindCount()
+	return _u
+}
+
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (_u *InternalMessageUpdate) SetLastRemindedAt(v time.Time) *InternalMessageUpdate {
+	_u.mutation.SetLastRemindedAt(v)
+	return _u
+}
+
+// SetNillableLastRemindedAt sets the "last_reminded_at" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillableLastRemindedAt(v *time.Time) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetLastRemindedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastRemindedAt clears the value of the "last_reminded_at" field.
+func (_u *InternalMessageUpdate) ClearLastRemindedAt() *InternalMessageUpdate {
+	_u.mutation.ClearLastRemindedAt()
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *InternalMessageUpdate) SetPriority(v internalmessage.Priority) *InternalMessageUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *InternalMessageUpdate) SetNillablePriority(v *internalmessage.Priority) *InternalMessageUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// ClearPriority clears the value of the "priority" field.
+func (_u *InternalMessageUpdate) ClearPriority() *InternalMessageUpdate {
+	_u.mutation.ClearPriority()
+	return _u
+}
+
 // Mutation returns the InternalMessageMutation object of the builder.
 func (_u *InternalMessageUpdate) Mutation() *InternalMessageMutation {
 	return _u.mutation
@@ -328,6 +761,21 @@ func (_u *InternalMessageUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Audience(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "audience", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.audience": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryStatus(); ok {
+		if err := internalmessage.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := internalmessage.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -433,283 +881,860 @@ func (_u *InternalMessageUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.GetType(); ok {
 		_spec.SetField(internalmessage.FieldType, field.TypeEnum, value)
 	}
-	if _u.mutation.TypeCleared() {
-		_spec.ClearField(internalmessage.FieldType, field.TypeEnum)
+	if _u.mutation.TypeCleared() {
+		_spec.ClearField(internalmessage.FieldType, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(internalmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.SendAtCleared() {
+		_spec.ClearField(internalmessage.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CronSpec(); ok {
+		_spec.SetField(internalmessage.FieldCronSpec, field.TypeString, value)
+	}
+	if _u.mutation.CronSpecCleared() {
+		_spec.ClearField(internalmessage.FieldCronSpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(internalmessage.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(internalmessage.FieldLastSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TargetAll(); ok {
+		_spec.SetField(internalmessage.FieldTargetAll, field.TypeBool, value)
+	}
+	if _u.mutation.TargetAllCleared() {
+		_spec.ClearField(internalmessage.FieldTargetAll, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TargetUserIds(); ok {
+		_spec.SetField(internalmessage.FieldTargetUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internalmessage.FieldTargetUserIds, value)
+		})
+	}
+	if _u.mutation.TargetUserIdsCleared() {
+		_spec.ClearField(internalmessage.FieldTargetUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Audience(); ok {
+		_spec.SetField(internalmessage.FieldAudience, field.TypeJSON, value)
+	}
+	if _u.mutation.AudienceCleared() {
+		_spec.ClearField(internalmessage.FieldAudience, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeliveryStatus(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryStatusCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RecipientTotal(); ok {
+		_spec.SetField(internalmessage.FieldRecipientTotal, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientTotal(); ok {
+		_spec.AddField(internalmessage.FieldRecipientTotal, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientTotalCleared() {
+		_spec.ClearField(internalmessage.FieldRecipientTotal, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeliveredCount(); ok {
+		_spec.SetField(internalmessage.FieldDeliveredCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveredCount(); ok {
+		_spec.AddField(internalmessage.FieldDeliveredCount, field.TypeUint32, value)
+	}
+	if _u.mutation.DeliveredCountCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveredCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(internalmessage.FieldFailedCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(internalmessage.FieldFailedCount, field.TypeUint32, value)
+	}
+	if _u.mutation.FailedCountCleared() {
+		_spec.ClearField(internalmessage.FieldFailedCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeliveryStartedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveryStartedAtCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveryFinishedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveryFinishedAtCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TemplateCode(); ok {
+		_spec.SetField(internalmessage.FieldTemplateCode, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCodeCleared() {
+		_spec.ClearField(internalmessage.FieldTemplateCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TemplateVariables(); ok {
+		_spec.SetField(internalmessage.FieldTemplateVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.TemplateVariablesCleared() {
+		_spec.ClearField(internalmessage.FieldTemplateVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(internalmessage.FieldConversationID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(internalmessage.FieldConversationID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(internalmessage.FieldConversationID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(internalmessage.FieldThreadID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedThreadID(); ok {
+		_spec.AddField(internalmessage.FieldThreadID, field.TypeUint32, value)
+	}
+	if _u.mutation.ThreadIDCleared() {
+		_spec.ClearField(internalmessage.FieldThreadID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReplyCount(); ok {
+		_spec.SetField(internalmessage.FieldReplyCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReplyCount(); ok {
+		_spec.AddField(internalmessage.FieldReplyCount, field.TypeUint32, value)
+	}
+	if _u.mutation.ReplyCountCleared() {
+		_spec.ClearField(internalmessage.FieldReplyCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemindCount(); ok {
+		_spec.SetField(internalmessage.FieldRemindCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRemindCount(); ok {
+		_spec.AddField(internalmessage.FieldRemindCount, field.TypeUint32, value)
+	}
+	if _u.mutation.RemindCountCleared() {
+		_spec.ClearField(internalmessage.FieldRemindCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastRemindedAt(); ok {
+		_spec.SetField(internalmessage.FieldLastRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRemindedAtCleared() {
+		_spec.ClearField(internalmessage.FieldLastRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(internalmessage.FieldPriority, field.TypeEnum, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(internalmessage.FieldPriority, field.TypeEnum)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internalmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InternalMessageUpdateOne is the builder for updating a single InternalMessage entity.
+type InternalMessageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InternalMessageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InternalMessageUpdateOne) SetUpdatedAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableUpdatedAt(v *time.Time) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *InternalMessageUpdateOne) ClearUpdatedAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *InternalMessageUpdateOne) SetDeletedAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeletedAt(v *time.Time) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *InternalMessageUpdateOne) ClearDeletedAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *InternalMessageUpdateOne) SetCreatedBy(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableCreatedBy(v *uint32) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *InternalMessageUpdateOne) AddCreatedBy(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *InternalMessageUpdateOne) ClearCreatedBy() *InternalMessageUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *InternalMessageUpdateOne) SetUpdatedBy(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableUpdatedBy(v *uint32) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *InternalMessageUpdateOne) AddUpdatedBy(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *InternalMessageUpdateOne) ClearUpdatedBy() *InternalMessageUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *InternalMessageUpdateOne) SetDeletedBy(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeletedBy(v *uint32) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *InternalMessageUpdateOne) AddDeletedBy(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *InternalMessageUpdateOne) ClearDeletedBy() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *InternalMessageUpdateOne) SetTitle(v string) *InternalMessageUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableTitle(v *string) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// ClearTitle clears the value of the "title" field.
+func (_u *InternalMessageUpdateOne) ClearTitle() *InternalMessageUpdateOne {
+	_u.mutation.ClearTitle()
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *InternalMessageUpdateOne) SetContent(v string) *InternalMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableContent(v *string) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// ClearContent clears the value of the "content" field.
+func (_u *InternalMessageUpdateOne) ClearContent() *InternalMessageUpdateOne {
+	_u.mutation.ClearContent()
+	return _u
+}
+
+// SetSenderID sets the "sender_id" field.
+func (_u *InternalMessageUpdateOne) SetSenderID(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetSenderID()
+	_u.mutation.SetSenderID(v)
+	return _u
+}
+
+// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableSenderID(v *uint32) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetSenderID(*v)
+	}
+	return _u
+}
+
+// AddSenderID adds value to the "sender_id" field.
+func (_u *InternalMessageUpdateOne) AddSenderID(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddSenderID(v)
+	return _u
+}
+
+// ClearSenderID clears the value of the "sender_id" field.
+func (_u *InternalMessageUpdateOne) ClearSenderID() *InternalMessageUpdateOne {
+	_u.mutation.ClearSenderID()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *InternalMessageUpdateOne) SetCategoryID(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableCategoryID(v *uint32) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *InternalMessageUpdateOne) AddCategoryID(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *InternalMessageUpdateOne) ClearCategoryID() *InternalMessageUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InternalMessageUpdateOne) SetStatus(v internalmessage.Status) *InternalMessageUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableStatus(v *internalmessage.Status) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *InternalMessageUpdateOne) ClearStatus() *InternalMessageUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *InternalMessageUpdateOne) SetType(v internalmessage.Type) *InternalMessageUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableType(v *internalmessage.Type) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// ClearType clears the value of the "type" field.
+func (_u *InternalMessageUpdateOne) ClearType() *InternalMessageUpdateOne {
+	_u.mutation.ClearType()
+	return _u
+}
+
+// SetSendAt sets the "send_at" field.
+func (_u *InternalMessageUpdateOne) SetSendAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetSendAt(v)
+	return _u
+}
+
+// SetNillableSendAt sets the "send_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableSendAt(v *time.Time) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetSendAt(*v)
+	}
+	return _u
+}
+
+// ClearSendAt clears the value of the "send_at" field.
+func (_u *InternalMessageUpdateOne) ClearSendAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearSendAt()
+	return _u
+}
+
+// SetCronSpec sets the "cron_spec" field.
+func (_u *InternalMessageUpdateOne) SetCronSpec(v string) *InternalMessageUpdateOne {
+	_u.mutation.SetCronSpec(v)
+	return _u
+}
+
+// SetNillableCronSpec sets the "cron_spec" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableCronSpec(v *string) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetCronSpec(*v)
+	}
+	return _u
+}
+
+// ClearCronSpec clears the value of the "cron_spec" field.
+func (_u *InternalMessageUpdateOne) ClearCronSpec() *InternalMessageUpdateOne {
+	_u.mutation.ClearCronSpec()
+	return _u
+}
+
+// SetLastSentAt sets the "last_sent_at" field.
+func (_u *InternalMessageUpdateOne) SetLastSentAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetLastSentAt(v)
+	return _u
+}
+
+// SetNillableLastSentAt sets the "last_sent_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableLastSentAt(v *time.Time) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetLastSentAt(*v)
+	}
+	return _u
+}
+
+// ClearLastSentAt clears the value of the "last_sent_at" field.
+func (_u *InternalMessageUpdateOne) ClearLastSentAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearLastSentAt()
+	return _u
+}
+
+// SetTargetAll sets the "target_all" field.
+func (_u *InternalMessageUpdateOne) SetTargetAll(v bool) *InternalMessageUpdateOne {
+	_u.mutation.SetTargetAll(v)
+	return _u
+}
+
+// SetNillableTargetAll sets the "target_all" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableTargetAll(v *bool) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetTargetAll(*v)
 	}
-	_spec.AddModifiers(_u.modifiers...)
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{internalmessage.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+	return _u
+}
+
+// ClearTargetAll clears the value of the "target_all" field.
+func (_u *InternalMessageUpdateOne) ClearTargetAll() *InternalMessageUpdateOne {
+	_u.mutation.ClearTargetAll()
+	return _u
+}
+
+// SetTargetUserIds sets the "target_user_ids" field.
+func (_u *InternalMessageUpdateOne) SetTargetUserIds(v []uint32) *InternalMessageUpdateOne {
+	_u.mutation.SetTargetUserIds(v)
+	return _u
+}
+
+// AppendTargetUserIds appends value to the "target_user_ids" field.
+func (_u *InternalMessageUpdateOne) AppendTargetUserIds(v []uint32) *InternalMessageUpdateOne {
+	_u.mutation.AppendTargetUserIds(v)
+	return _u
+}
+
+// ClearTargetUserIds clears the value of the "target_user_ids" field.
+func (_u *InternalMessageUpdateOne) ClearTargetUserIds() *InternalMessageUpdateOne {
+	_u.mutation.ClearTargetUserIds()
+	return _u
+}
+
+// SetAudience sets the "audience" field.
+func (_u *InternalMessageUpdateOne) SetAudience(v *internalMessageV1.MessageAudience) *InternalMessageUpdateOne {
+	_u.mutation.SetAudience(v)
+	return _u
+}
+
+// ClearAudience clears the value of the "audience" field.
+func (_u *InternalMessageUpdateOne) ClearAudience() *InternalMessageUpdateOne {
+	_u.mutation.ClearAudience()
+	return _u
+}
+
+// SetDeliveryStatus sets the "delivery_status" field.
+func (_u *InternalMessageUpdateOne) SetDeliveryStatus(v internalmessage.DeliveryStatus) *InternalMessageUpdateOne {
+	_u.mutation.SetDeliveryStatus(v)
+	return _u
+}
+
+// SetNillableDeliveryStatus sets the "delivery_status" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeliveryStatus(v *internalmessage.DeliveryStatus) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetDeliveryStatus(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// InternalMessageUpdateOne is the builder for updating a single InternalMessage entity.
-type InternalMessageUpdateOne struct {
-	config
-	fields    []string
-	hooks     []Hook
-	mutation  *InternalMessageMutation
-	modifiers []func(*sql.UpdateBuilder)
+// ClearDeliveryStatus clears the value of the "delivery_status" field.
+func (_u *InternalMessageUpdateOne) ClearDeliveryStatus() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeliveryStatus()
+	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *InternalMessageUpdateOne) SetUpdatedAt(v time.Time) *InternalMessageUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
+// SetRecipientTotal sets the "recipient_total" field.
+func (_u *InternalMessageUpdateOne) SetRecipientTotal(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetRecipientTotal()
+	_u.mutation.SetRecipientTotal(v)
 	return _u
 }
 
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableUpdatedAt(v *time.Time) *InternalMessageUpdateOne {
+// SetNillableRecipientTotal sets the "recipient_total" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableRecipientTotal(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetUpdatedAt(*v)
+		_u.SetRecipientTotal(*v)
 	}
 	return _u
 }
 
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (_u *InternalMessageUpdateOne) ClearUpdatedAt() *InternalMessageUpdateOne {
-	_u.mutation.ClearUpdatedAt()
+// AddRecipientTotal adds value to the "recipient_total" field.
+func (_u *InternalMessageUpdateOne) AddRecipientTotal(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddRecipientTotal(v)
 	return _u
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (_u *InternalMessageUpdateOne) SetDeletedAt(v time.Time) *InternalMessageUpdateOne {
-	_u.mutation.SetDeletedAt(v)
+// ClearRecipientTotal clears the value of the "recipient_total" field.
+func (_u *InternalMessageUpdateOne) ClearRecipientTotal() *InternalMessageUpdateOne {
+	_u.mutation.ClearRecipientTotal()
 	return _u
 }
 
-// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableDeletedAt(v *time.Time) *InternalMessageUpdateOne {
+// SetDeliveredCount sets the "delivered_count" field.
+func (_u *InternalMessageUpdateOne) SetDeliveredCount(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetDeliveredCount()
+	_u.mutation.SetDeliveredCount(v)
+	return _u
+}
+
+// SetNillableDeliveredCount sets the "delivered_count" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeliveredCount(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetDeletedAt(*v)
+		_u.SetDeliveredCount(*v)
 	}
 	return _u
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (_u *InternalMessageUpdateOne) ClearDeletedAt() *InternalMessageUpdateOne {
-	_u.mutation.ClearDeletedAt()
+// AddDeliveredCount adds value to the "delivered_count" field.
+func (_u *InternalMessageUpdateOne) AddDeliveredCount(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddDeliveredCount(v)
 	return _u
 }
 
-// SetCreatedBy sets the "created_by" field.
-func (_u *InternalMessageUpdateOne) SetCreatedBy(v uint32) *InternalMessageUpdateOne {
-	_u.mutation.ResetCreatedBy()
-	_u.mutation.SetCreatedBy(v)
+// ClearDeliveredCount clears the value of the "delivered_count" field.
+func (_u *InternalMessageUpdateOne) ClearDeliveredCount() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeliveredCount()
 	return _u
 }
 
-// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableCreatedBy(v *uint32) *InternalMessageUpdateOne {
+// SetFailedCount sets the "failed_count" field.
+func (_u *InternalMessageUpdateOne) SetFailedCount(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetFailedCount()
+	_u.mutation.SetFailedCount(v)
+	return _u
+}
+
+// SetNillableFailedCount sets the "failed_count" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableFailedCount(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetCreatedBy(*v)
+		_u.SetFailedCount(*v)
 	}
 	return _u
 }
 
-// AddCreatedBy adds value to the "created_by" field.
-func (_u *InternalMessageUpdateOne) AddCreatedBy(v int32) *InternalMessageUpdateOne {
-	_u.mutation.AddCreatedBy(v)
+// AddFailedCount adds value to the "failed_count" field.
+func (_u *InternalMessageUpdateOne) AddFailedCount(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddFailedCount(v)
 	return _u
 }
 
-// ClearCreatedBy clears the value of the "created_by" field.
-func (_u *InternalMessageUpdateOne) ClearCreatedBy() *InternalMessageUpdateOne {
-	_u.mutation.ClearCreatedBy()
+// ClearFailedCount clears the value of the "failed_count" field.
+func (_u *InternalMessageUpdateOne) ClearFailedCount() *InternalMessageUpdateOne {
+	_u.mutation.ClearFailedCount()
 	return _u
 }
 
-// SetUpdatedBy sets the "updated_by" field.
-func (_u *InternalMessageUpdateOne) SetUpdatedBy(v uint32) *InternalMessageUpdateOne {
-	_u.mutation.ResetUpdatedBy()
-	_u.mutation.SetUpdatedBy(v)
+// SetDeliveryStartedAt sets the "delivery_started_at" field.
+func (_u *InternalMessageUpdateOne) SetDeliveryStartedAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetDeliveryStartedAt(v)
 	return _u
 }
 
-// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableUpdatedBy(v *uint32) *InternalMessageUpdateOne {
+// SetNillableDeliveryStartedAt sets the "delivery_started_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeliveryStartedAt(v *time.Time) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetUpdatedBy(*v)
+		_u.SetDeliveryStartedAt(*v)
 	}
 	return _u
 }
 
-// AddUpdatedBy adds value to the "updated_by" field.
-func (_u *InternalMessageUpdateOne) AddUpdatedBy(v int32) *InternalMessageUpdateOne {
-	_u.mutation.AddUpdatedBy(v)
+// ClearDeliveryStartedAt clears the value of the "delivery_started_at" field.
+func (_u *InternalMessageUpdateOne) ClearDeliveryStartedAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeliveryStartedAt()
 	return _u
 }
 
-// ClearUpdatedBy clears the value of the "updated_by" field.
-func (_u *InternalMessageUpdateOne) ClearUpdatedBy() *InternalMessageUpdateOne {
-	_u.mutation.ClearUpdatedBy()
+// SetDeliveryFinishedAt sets the "delivery_finished_at" field.
+func (_u *InternalMessageUpdateOne) SetDeliveryFinishedAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetDeliveryFinishedAt(v)
 	return _u
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (_u *InternalMessageUpdateOne) SetDeletedBy(v uint32) *InternalMessageUpdateOne {
-	_u.mutation.ResetDeletedBy()
-	_u.mutation.SetDeletedBy(v)
+// SetNillableDeliveryFinishedAt sets the "delivery_finished_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableDeliveryFinishedAt(v *time.Time) *InternalMessageUpdateOne {
+	if v != nil {
+		_u.SetDeliveryFinishedAt(*v)
+	}
 	return _u
 }
 
-// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableDeletedBy(v *uint32) *InternalMessageUpdateOne {
+// ClearDeliveryFinishedAt clears the value of the "delivery_finished_at" field.
+func (_u *InternalMessageUpdateOne) ClearDeliveryFinishedAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearDeliveryFinishedAt()
+	return _u
+}
+
+// SetTemplateCode sets the "template_code" field.
+func (_u *InternalMessageUpdateOne) SetTemplateCode(v string) *InternalMessageUpdateOne {
+	_u.mutation.SetTemplateCode(v)
+	return _u
+}
+
+// SetNillableTemplateCode sets the "template_code" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableTemplateCode(v *string) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetDeletedBy(*v)
+		_u.SetTemplateCode(*v)
 	}
 	return _u
 }
 
-// AddDeletedBy adds value to the "deleted_by" field.
-func (_u *InternalMessageUpdateOne) AddDeletedBy(v int32) *InternalMessageUpdateOne {
-	_u.mutation.AddDeletedBy(v)
+// ClearTemplateCode clears the value of the "template_code" field.
+func (_u *InternalMessageUpdateOne) ClearTemplateCode() *InternalMessageUpdateOne {
+	_u.mutation.ClearTemplateCode()
 	return _u
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (_u *InternalMessageUpdateOne) ClearDeletedBy() *InternalMessageUpdateOne {
-	_u.mutation.ClearDeletedBy()
+// SetTemplateVariables sets the "template_variables" field.
+func (_u *InternalMessageUpdateOne) SetTemplateVariables(v map[string]string) *InternalMessageUpdateOne {
+	_u.mutation.SetTemplateVariables(v)
 	return _u
 }
 
-// SetTitle sets the "title" field.
-func (_u *InternalMessageUpdateOne) SetTitle(v string) *InternalMessageUpdateOne {
-	_u.mutation.SetTitle(v)
+// ClearTemplateVariables clears the value of the "template_variables" field.
+func (_u *InternalMessageUpdateOne) ClearTemplateVariables() *InternalMessageUpdateOne {
+	_u.mutation.ClearTemplateVariables()
 	return _u
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableTitle(v *string) *InternalMessageUpdateOne {
+// SetConversationID sets the "conversation_id" field.
+func (_u *InternalMessageUpdateOne) SetConversationID(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetConversationID()
+	_u.mutation.SetConversationID(v)
+	return _u
+}
+
+// SetNillableConversationID sets the "conversation_id" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableConversationID(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetTitle(*v)
+		_u.SetConversationID(*v)
 	}
 	return _u
 }
 
-// ClearTitle clears the value of the "title" field.
-func (_u *InternalMessageUpdateOne) ClearTitle() *InternalMessageUpdateOne {
-	_u.mutation.ClearTitle()
+// AddConversationID adds value to the "conversation_id" field.
+func (_u *InternalMessageUpdateOne) AddConversationID(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddConversationID(v)
 	return _u
 }
 
-// SetContent sets the "content" field.
-func (_u *InternalMessageUpdateOne) SetContent(v string) *InternalMessageUpdateOne {
-	_u.mutation.SetContent(v)
+// ClearConversationID clears the value of the "conversation_id" field.
+func (_u *InternalMessageUpdateOne) ClearConversationID() *InternalMessageUpdateOne {
+	_u.mutation.ClearConversationID()
 	return _u
 }
 
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableContent(v *string) *InternalMessageUpdateOne {
+// SetThreadID sets the "thread_id" field.
+func (_u *InternalMessageUpdateOne) SetThreadID(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetThreadID()
+	_u.mutation.SetThreadID(v)
+	return _u
+}
+
+// SetNillableThreadID sets the "thread_id" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableThreadID(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetContent(*v)
+		_u.SetThreadID(*v)
 	}
 	return _u
 }
 
-// ClearContent clears the value of the "content" field.
-func (_u *InternalMessageUpdateOne) ClearContent() *InternalMessageUpdateOne {
-	_u.mutation.ClearContent()
+// AddThreadID adds value to the "thread_id" field.
+func (_u *InternalMessageUpdateOne) AddThreadID(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddThreadID(v)
 	return _u
 }
 
-// SetSenderID sets the "sender_id" field.
-func (_u *InternalMessageUpdateOne) SetSenderID(v uint32) *InternalMessageUpdateOne {
-	_u.mutation.ResetSenderID()
-	_u.mutation.SetSenderID(v)
+// ClearThreadID clears the value of the "thread_id" field.
+func (_u *InternalMessageUpdateOne) ClearThreadID() *InternalMessageUpdateOne {
+	_u.mutation.ClearThreadID()
 	return _u
 }
 
-// SetNillableSenderID sets the "sender_id" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableSenderID(v *uint32) *InternalMessageUpdateOne {
+// SetReplyCount sets the "reply_count" field.
+func (_u *InternalMessageUpdateOne) SetReplyCount(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetReplyCount()
+	_u.mutation.SetReplyCount(v)
+	return _u
+}
+
+// SetNillableReplyCount sets the "reply_count" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableReplyCount(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetSenderID(*v)
+		_u.SetReplyCount(*v)
 	}
 	return _u
 }
 
-// AddSenderID adds value to the "sender_id" field.
-func (_u *InternalMessageUpdateOne) AddSenderID(v int32) *InternalMessageUpdateOne {
-	_u.mutation.AddSenderID(v)
+// AddReplyCount adds value to the "reply_count" field.
+func (_u *InternalMessageUpdateOne) AddReplyCount(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddReplyCount(v)
 	return _u
 }
 
-// ClearSenderID clears the value of the "sender_id" field.
-func (_u *InternalMessageUpdateOne) ClearSenderID() *InternalMessageUpdateOne {
-	_u.mutation.ClearSenderID()
+// ClearReplyCount clears the value of the "reply_count" field.
+func (_u *InternalMessageUpdateOne) ClearReplyCount() *InternalMessageUpdateOne {
+	_u.mutation.ClearReplyCount()
 	return _u
 }
 
-// SetCategoryID sets the "category_id" field.
-func (_u *InternalMessageUpdateOne) SetCategoryID(v uint32) *InternalMessageUpdateOne {
-	_u.mutation.ResetCategoryID()
-	_u.mutation.SetCategoryID(v)
+// SetRemindCount sets the "remind_count" field.
+func (_u *InternalMessageUpdateOne) SetRemindCount(v uint32) *InternalMessageUpdateOne {
+	_u.mutation.ResetRemindCount()
+	_u.mutation.SetRemindCount(v)
 	return _u
 }
 
-// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableCategoryID(v *uint32) *InternalMessageUpdateOne {
+// SetNillableRemindCount sets the "remind_count" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableRemindCount(v *uint32) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetCategoryID(*v)
+		_u.SetRemindCount(*v)
 	}
 	return _u
 }
 
-// AddCategoryID adds value to the "category_id" field.
-func (_u *InternalMessageUpdateOne) AddCategoryID(v int32) *InternalMessageUpdateOne {
-	_u.mutation.AddCategoryID(v)
+// AddRemindCount adds value to the "remind_count" field.
+func (_u *InternalMessageUpdateOne) AddRemindCount(v int32) *InternalMessageUpdateOne {
+	_u.mutation.AddRemindCount(v)
 	return _u
 }
 
-// ClearCategoryID clears the value of the "category_id" field.
-func (_u *InternalMessageUpdateOne) ClearCategoryID() *InternalMessageUpdateOne {
-	_u.mutation.ClearCategoryID()
+// ClearRemindCount clears the value of the "remind_count" field.
+func (_u *InternalMessageUpdateOne) ClearRemindCount() *InternalMessageUpdateOne {
+	_u.mutation.ClearRemindCount()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *InternalMessageUpdateOne) SetStatus(v internalmessage.Status) *InternalMessageUpdateOne {
-	_u.mutation.SetStatus(v)
+// SetLastRemindedAt sets the "last_reminded_at" field.
+func (_u *InternalMessageUpdateOne) SetLastRemindedAt(v time.Time) *InternalMessageUpdateOne {
+	_u.mutation.SetLastRemindedAt(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableStatus(v *internalmessage.Status) *InternalMessageUpdateOne {
+// SetNillableLastRemindedAt sets the "last_reminded_at" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillableLastRemindedAt(v *time.Time) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetLastRemindedAt(*v)
 	}
 	return _u
 }
 
-// ClearStatus clears the value of the "status" field.
-func (_u *InternalMessageUpdateOne) ClearStatus() *InternalMessageUpdateOne {
-	_u.mutation.ClearStatus()
+// ClearLastRemindedAt clears the value of the "last_reminded_at" field.
+func (_u *InternalMessageUpdateOne) ClearLastRemindedAt() *InternalMessageUpdateOne {
+	_u.mutation.ClearLastRemindedAt()
 	return _u
 }
 
-// SetType sets the "type" field.
-func (_u *InternalMessageUpdateOne) SetType(v internalmessage.Type) *InternalMessageUpdateOne {
-	_u.mutation.SetType(v)
+// SetPriority sets the "priority" field.
+func (_u *InternalMessageUpdateOne) SetPriority(v internalmessage.Priority) *InternalMessageUpdateOne {
+	_u.mutation.SetPriority(v)
 	return _u
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (_u *InternalMessageUpdateOne) SetNillableType(v *internalmessage.Type) *InternalMessageUpdateOne {
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *InternalMessageUpdateOne) SetNillablePriority(v *internalmessage.Priority) *InternalMessageUpdateOne {
 	if v != nil {
-		_u.SetType(*v)
+		_u.SetPriority(*v)
 	}
 	return _u
 }
 
-// ClearType clears the value of the "type" field.
-func (_u *InternalMessageUpdateOne) ClearType() *InternalMessageUpdateOne {
-	_u.mutation.ClearType()
+// ClearPriority clears the value of the "priority" field.
+func (_u *InternalMessageUpdateOne) ClearPriority() *InternalMessageUpdateOne {
+	_u.mutation.ClearPriority()
 	return _u
 }
 
@@ -770,6 +1795,21 @@ func (_u *InternalMessageUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Audience(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "audience", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.audience": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DeliveryStatus(); ok {
+		if err := internalmessage.DeliveryStatusValidator(v); err != nil {
+			return &ValidationError{Name: "delivery_status", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.delivery_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := internalmessage.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "InternalMessage.priority": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -895,6 +1935,152 @@ func (_u *InternalMessageUpdateOne) sqlSave(ctx context.Context) (_node *Interna
 	if _u.mutation.TypeCleared() {
 		_spec.ClearField(internalmessage.FieldType, field.TypeEnum)
 	}
+	if value, ok := _u.mutation.SendAt(); ok {
+		_spec.SetField(internalmessage.FieldSendAt, field.TypeTime, value)
+	}
+	if _u.mutation.SendAtCleared() {
+		_spec.ClearField(internalmessage.FieldSendAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CronSpec(); ok {
+		_spec.SetField(internalmessage.FieldCronSpec, field.TypeString, value)
+	}
+	if _u.mutation.CronSpecCleared() {
+		_spec.ClearField(internalmessage.FieldCronSpec, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastSentAt(); ok {
+		_spec.SetField(internalmessage.FieldLastSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastSentAtCleared() {
+		_spec.ClearField(internalmessage.FieldLastSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TargetAll(); ok {
+		_spec.SetField(internalmessage.FieldTargetAll, field.TypeBool, value)
+	}
+	if _u.mutation.TargetAllCleared() {
+		_spec.ClearField(internalmessage.FieldTargetAll, field.TypeBool)
+	}
+	if value, ok := _u.mutation.TargetUserIds(); ok {
+		_spec.SetField(internalmessage.FieldTargetUserIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTargetUserIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internalmessage.FieldTargetUserIds, value)
+		})
+	}
+	if _u.mutation.TargetUserIdsCleared() {
+		_spec.ClearField(internalmessage.FieldTargetUserIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Audience(); ok {
+		_spec.SetField(internalmessage.FieldAudience, field.TypeJSON, value)
+	}
+	if _u.mutation.AudienceCleared() {
+		_spec.ClearField(internalmessage.FieldAudience, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DeliveryStatus(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.DeliveryStatusCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.RecipientTotal(); ok {
+		_spec.SetField(internalmessage.FieldRecipientTotal, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientTotal(); ok {
+		_spec.AddField(internalmessage.FieldRecipientTotal, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientTotalCleared() {
+		_spec.ClearField(internalmessage.FieldRecipientTotal, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeliveredCount(); ok {
+		_spec.SetField(internalmessage.FieldDeliveredCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeliveredCount(); ok {
+		_spec.AddField(internalmessage.FieldDeliveredCount, field.TypeUint32, value)
+	}
+	if _u.mutation.DeliveredCountCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveredCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.FailedCount(); ok {
+		_spec.SetField(internalmessage.FieldFailedCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCount(); ok {
+		_spec.AddField(internalmessage.FieldFailedCount, field.TypeUint32, value)
+	}
+	if _u.mutation.FailedCountCleared() {
+		_spec.ClearField(internalmessage.FieldFailedCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeliveryStartedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveryStartedAtCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeliveryFinishedAt(); ok {
+		_spec.SetField(internalmessage.FieldDeliveryFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeliveryFinishedAtCleared() {
+		_spec.ClearField(internalmessage.FieldDeliveryFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TemplateCode(); ok {
+		_spec.SetField(internalmessage.FieldTemplateCode, field.TypeString, value)
+	}
+	if _u.mutation.TemplateCodeCleared() {
+		_spec.ClearField(internalmessage.FieldTemplateCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.TemplateVariables(); ok {
+		_spec.SetField(internalmessage.FieldTemplateVariables, field.TypeJSON, value)
+	}
+	if _u.mutation.TemplateVariablesCleared() {
+		_spec.ClearField(internalmessage.FieldTemplateVariables, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ConversationID(); ok {
+		_spec.SetField(internalmessage.FieldConversationID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedConversationID(); ok {
+		_spec.AddField(internalmessage.FieldConversationID, field.TypeUint32, value)
+	}
+	if _u.mutation.ConversationIDCleared() {
+		_spec.ClearField(internalmessage.FieldConversationID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ThreadID(); ok {
+		_spec.SetField(internalmessage.FieldThreadID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedThreadID(); ok {
+		_spec.AddField(internalmessage.FieldThreadID, field.TypeUint32, value)
+	}
+	if _u.mutation.ThreadIDCleared() {
+		_spec.ClearField(internalmessage.FieldThreadID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.ReplyCount(); ok {
+		_spec.SetField(internalmessage.FieldReplyCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedReplyCount(); ok {
+		_spec.AddField(internalmessage.FieldReplyCount, field.TypeUint32, value)
+	}
+	if _u.mutation.ReplyCountCleared() {
+		_spec.ClearField(internalmessage.FieldReplyCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RemindCount(); ok {
+		_spec.SetField(internalmessage.FieldRemindCount, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRemindCount(); ok {
+		_spec.AddField(internalmessage.FieldRemindCount, field.TypeUint32, value)
+	}
+	if _u.mutation.RemindCountCleared() {
+		_spec.ClearField(internalmessage.FieldRemindCount, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastRemindedAt(); ok {
+		_spec.SetField(internalmessage.FieldLastRemindedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastRemindedAtCleared() {
+		_spec.ClearField(internalmessage.FieldLastRemindedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(internalmessage.FieldPriority, field.TypeEnum, value)
+	}
+	if _u.mutation.PriorityCleared() {
+		_spec.ClearField(internalmessage.FieldPriority, field.TypeEnum)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &InternalMessage{config: _u.config}
 	_spec.Assign = _node.assignValues
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"strings"
@@ -46,6 +47,8 @@ type InternalMessageCategory struct {
 	Code *string `json:"code,omitempty"`
 	// 图标URL
 	IconURL *string `json:"icon_url,omitempty"`
+	// 默认通知渠道，站内信之外同时通过这些渠道发送
+	Channels []string `json:"channels,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the InternalMessageCategoryQuery when eager-loading is set.
 	Edges        InternalMessageCategoryEdges `json:"edges"`
@@ -88,6 +91,8 @@ func (*InternalMessageCategory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case internalmessagecategory.FieldChannels:
+			values[i] = new([]byte)
 		case internalmessagecategory.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
 		case internalmessagecategory.FieldID, internalmessagecategory.FieldCreatedBy, internalmessagecategory.FieldUpdatedBy, internalmessagecategory.FieldDeletedBy, internalmessagecategory.FieldSortOrder, internalmessagecategory.FieldTenantID, internalmessagecategory.FieldParentID:
@@ -215,6 +220,14 @@ func (_m *InternalMessageCategory) assignValues(columns []string, values []any)
 				_m.IconURL = new(string)
 				*_m.IconURL = value.String
 			}
+		case internalmessagecategory.FieldChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Channels); err != nil {
+					return fmt.Errorf("unmarshal field channels: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -330,6 +343,9 @@ func (_m *InternalMessageCategory) String() string {
 		builder.WriteString("icon_url=")
 		builder.WriteString(*v)
 	}
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channels))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -875,6 +875,16 @@ func IconURLContainsFold(v string) predicate.InternalMessageCategory {
 	return predicate.InternalMessageCategory(sql.FieldContainsFold(FieldIconURL, v))
 }
 
+// ChannelsIsNil applies the IsNil predicate on the "channels" field.
+func ChannelsIsNil() predicate.InternalMessageCategory {
+	return predicate.InternalMessageCategory(sql.FieldIsNull(FieldChannels))
+}
+
+// ChannelsNotNil applies the NotNil predicate on the "channels" field.
+func ChannelsNotNil() predicate.InternalMessageCategory {
+	return predicate.InternalMessageCategory(sql.FieldNotNull(FieldChannels))
+}
+
 // HasParent applies the HasEdge predicate on the "parent" edge.
 func HasParent() predicate.InternalMessageCategory {
 	return predicate.InternalMessageCategory(func(s *sql.Selector) {
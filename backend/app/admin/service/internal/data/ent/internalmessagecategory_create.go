@@ -218,6 +218,12 @@ func (_c *InternalMessageCategoryCreate) SetNillableIconURL(v *string) *Internal
 	return _c
 }
 
+// SetChannels sets the "channels" field.
+func (_c *InternalMessageCategoryCreate) SetChannels(v []string) *InternalMessageCategoryCreate {
+	_c.mutation.SetChannels(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *InternalMessageCategoryCreate) SetID(v uint32) *InternalMessageCategoryCreate {
 	_c.mutation.SetID(v)
@@ -251,7 +257,9 @@ func (_c *InternalMessageCategoryCreate) Mutation() *InternalMessageCategoryMuta
 
 // Save creates the InternalMessageCategory in the database.
 func (_c *InternalMessageCategoryCreate) Save(ctx context.Context) (*InternalMessageCategory, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -278,7 +286,7 @@ func (_c *InternalMessageCategoryCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *InternalMessageCategoryCreate) defaults() {
+func (_c *InternalMessageCategoryCreate) defaults() error {
 	if _, ok := _c.mutation.IsEnabled(); !ok {
 		v := internalmessagecategory.DefaultIsEnabled
 		_c.mutation.SetIsEnabled(v)
@@ -287,6 +295,7 @@ func (_c *InternalMessageCategoryCreate) defaults() {
 		v := internalmessagecategory.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -391,6 +400,10 @@ func (_c *InternalMessageCategoryCreate) createSpec() (*InternalMessageCategory,
 		_spec.SetField(internalmessagecategory.FieldIconURL, field.TypeString, value)
 		_node.IconURL = &value
 	}
+	if value, ok := _c.mutation.Channels(); ok {
+		_spec.SetField(internalmessagecategory.FieldChannels, field.TypeJSON, value)
+		_node.Channels = value
+	}
 	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -716,6 +729,24 @@ func (u *InternalMessageCategoryUpsert) ClearIconURL() *InternalMessageCategoryU
 	return u
 }
 
+// SetChannels sets the "channels" field.
+func (u *InternalMessageCategoryUpsert) SetChannels(v []string) *InternalMessageCategoryUpsert {
+	u.Set(internalmessagecategory.FieldChannels, v)
+	return u
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *InternalMessageCategoryUpsert) UpdateChannels() *InternalMessageCategoryUpsert {
+	u.SetExcluded(internalmessagecategory.FieldChannels)
+	return u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *InternalMessageCategoryUpsert) ClearChannels() *InternalMessageCategoryUpsert {
+	u.SetNull(internalmessagecategory.FieldChannels)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -1050,6 +1081,27 @@ func (u *InternalMessageCategoryUpsertOne) ClearIconURL() *InternalMessageCatego
 	})
 }
 
+// SetChannels sets the "channels" field.
+func (u *InternalMessageCategoryUpsertOne) SetChannels(v []string) *InternalMessageCategoryUpsertOne {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *InternalMessageCategoryUpsertOne) UpdateChannels() *InternalMessageCategoryUpsertOne {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *InternalMessageCategoryUpsertOne) ClearChannels() *InternalMessageCategoryUpsertOne {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.ClearChannels()
+	})
+}
+
 // Exec executes the query.
 func (u *InternalMessageCategoryUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1550,6 +1602,27 @@ func (u *InternalMessageCategoryUpsertBulk) ClearIconURL() *InternalMessageCateg
 	})
 }
 
+// SetChannels sets the "channels" field.
+func (u *InternalMessageCategoryUpsertBulk) SetChannels(v []string) *InternalMessageCategoryUpsertBulk {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.SetChannels(v)
+	})
+}
+
+// UpdateChannels sets the "channels" field to the value that was provided on create.
+func (u *InternalMessageCategoryUpsertBulk) UpdateChannels() *InternalMessageCategoryUpsertBulk {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.UpdateChannels()
+	})
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (u *InternalMessageCategoryUpsertBulk) ClearChannels() *InternalMessageCategoryUpsertBulk {
+	return u.Update(func(s *InternalMessageCategoryUpsert) {
+		s.ClearChannels()
+	})
+}
+
 // Exec executes the query.
 func (u *InternalMessageCategoryUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -5,6 +5,7 @@ package ent
 import (
 	"context"
 	"database/sql/driver"
+	"errors"
 	"fmt"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
@@ -401,6 +402,12 @@ func (_q *InternalMessageCategoryQuery) prepareQuery(ctx context.Context) error
 		}
 		_q.sql = prev
 	}
+	if internalmessagecategory.Policy == nil {
+		return errors.New("ent: uninitialized internalmessagecategory.Policy (forgotten import ent/runtime?)")
+	}
+	if err := internalmessagecategory.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -12,6 +12,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -297,6 +298,24 @@ func (_u *InternalMessageCategoryUpdate) ClearIconURL() *InternalMessageCategory
 	return _u
 }
 
+// SetChannels sets the "channels" field.
+func (_u *InternalMessageCategoryUpdate) SetChannels(v []string) *InternalMessageCategoryUpdate {
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// AppendChannels appends value to the "channels" field.
+func (_u *InternalMessageCategoryUpdate) AppendChannels(v []string) *InternalMessageCategoryUpdate {
+	_u.mutation.AppendChannels(v)
+	return _u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (_u *InternalMessageCategoryUpdate) ClearChannels() *InternalMessageCategoryUpdate {
+	_u.mutation.ClearChannels()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the InternalMessageCategory entity.
 func (_u *InternalMessageCategoryUpdate) SetParent(v *InternalMessageCategory) *InternalMessageCategoryUpdate {
 	return _u.SetParentID(v.ID)
@@ -493,6 +512,17 @@ func (_u *InternalMessageCategoryUpdate) sqlSave(ctx context.Context) (_node int
 	if _u.mutation.IconURLCleared() {
 		_spec.ClearField(internalmessagecategory.FieldIconURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(internalmessagecategory.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internalmessagecategory.FieldChannels, value)
+		})
+	}
+	if _u.mutation.ChannelsCleared() {
+		_spec.ClearField(internalmessagecategory.FieldChannels, field.TypeJSON)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -857,6 +887,24 @@ func (_u *InternalMessageCategoryUpdateOne) ClearIconURL() *InternalMessageCateg
 	return _u
 }
 
+// SetChannels sets the "channels" field.
+func (_u *InternalMessageCategoryUpdateOne) SetChannels(v []string) *InternalMessageCategoryUpdateOne {
+	_u.mutation.SetChannels(v)
+	return _u
+}
+
+// AppendChannels appends value to the "channels" field.
+func (_u *InternalMessageCategoryUpdateOne) AppendChannels(v []string) *InternalMessageCategoryUpdateOne {
+	_u.mutation.AppendChannels(v)
+	return _u
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (_u *InternalMessageCategoryUpdateOne) ClearChannels() *InternalMessageCategoryUpdateOne {
+	_u.mutation.ClearChannels()
+	return _u
+}
+
 // SetParent sets the "parent" edge to the InternalMessageCategory entity.
 func (_u *InternalMessageCategoryUpdateOne) SetParent(v *InternalMessageCategory) *InternalMessageCategoryUpdateOne {
 	return _u.SetParentID(v.ID)
@@ -1083,6 +1131,17 @@ func (_u *InternalMessageCategoryUpdateOne) sqlSave(ctx context.Context) (_node
 	if _u.mutation.IconURLCleared() {
 		_spec.ClearField(internalmessagecategory.FieldIconURL, field.TypeString)
 	}
+	if value, ok := _u.mutation.Channels(); ok {
+		_spec.SetField(internalmessagecategory.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, internalmessagecategory.FieldChannels, value)
+		})
+	}
+	if _u.mutation.ChannelsCleared() {
+		_spec.ClearField(internalmessagecategory.FieldChannels, field.TypeJSON)
+	}
 	if _u.mutation.ParentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
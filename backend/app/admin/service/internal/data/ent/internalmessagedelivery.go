@@ -0,0 +1,278 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 站内信消息外部渠道投递记录表
+type InternalMessageDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 站内信内容ID
+	MessageID *uint32 `json:"message_id,omitempty"`
+	// 收件记录ID
+	RecipientID *uint32 `json:"recipient_id,omitempty"`
+	// 接收者用户ID
+	RecipientUserID *uint32 `json:"recipient_user_id,omitempty"`
+	// 通知渠道
+	Channel *string `json:"channel,omitempty"`
+	// 接收地址
+	Address *string `json:"address,omitempty"`
+	// 投递状态
+	Status *internalmessagedelivery.Status `json:"status,omitempty"`
+	// 尝试次数
+	Attempts *uint32 `json:"attempts,omitempty"`
+	// 最后一次失败的原因
+	LastError *string `json:"last_error,omitempty"`
+	// 发送成功时间
+	SentAt       *time.Time `json:"sent_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*InternalMessageDelivery) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case internalmessagedelivery.FieldID, internalmessagedelivery.FieldTenantID, internalmessagedelivery.FieldMessageID, internalmessagedelivery.FieldRecipientID, internalmessagedelivery.FieldRecipientUserID, internalmessagedelivery.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case internalmessagedelivery.FieldChannel, internalmessagedelivery.FieldAddress, internalmessagedelivery.FieldStatus, internalmessagedelivery.FieldLastError:
+			values[i] = new(sql.NullString)
+		case internalmessagedelivery.FieldCreatedAt, internalmessagedelivery.FieldUpdatedAt, internalmessagedelivery.FieldDeletedAt, internalmessagedelivery.FieldSentAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the InternalMessageDelivery fields.
+func (_m *InternalMessageDelivery) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case internalmessagedelivery.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case internalmessagedelivery.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case internalmessagedelivery.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case internalmessagedelivery.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case internalmessagedelivery.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case internalmessagedelivery.FieldMessageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value.Valid {
+				_m.MessageID = new(uint32)
+				*_m.MessageID = uint32(value.Int64)
+			}
+		case internalmessagedelivery.FieldRecipientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_id", values[i])
+			} else if value.Valid {
+				_m.RecipientID = new(uint32)
+				*_m.RecipientID = uint32(value.Int64)
+			}
+		case internalmessagedelivery.FieldRecipientUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field recipient_user_id", values[i])
+			} else if value.Valid {
+				_m.RecipientUserID = new(uint32)
+				*_m.RecipientUserID = uint32(value.Int64)
+			}
+		case internalmessagedelivery.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = new(string)
+				*_m.Channel = value.String
+			}
+		case internalmessagedelivery.FieldAddress:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address", values[i])
+			} else if value.Valid {
+				_m.Address = new(string)
+				*_m.Address = value.String
+			}
+		case internalmessagedelivery.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = new(internalmessagedelivery.Status)
+				*_m.Status = internalmessagedelivery.Status(value.String)
+			}
+		case internalmessagedelivery.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = new(uint32)
+				*_m.Attempts = uint32(value.Int64)
+			}
+		case internalmessagedelivery.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = new(string)
+				*_m.LastError = value.String
+			}
+		case internalmessagedelivery.FieldSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field sent_at", values[i])
+			} else if value.Valid {
+				_m.SentAt = new(time.Time)
+				*_m.SentAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the InternalMessageDelivery.
+// This includes values selected through modifiers, order, etc.
+func (_m *InternalMessageDelivery) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this InternalMessageDelivery.
+// Note that you need to call InternalMessageDelivery.Unwrap() before calling this method if this InternalMessageDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *InternalMessageDelivery) Update() *InternalMessageDeliveryUpdateOne {
+	return NewInternalMessageDeliveryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the InternalMessageDelivery entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *InternalMessageDelivery) Unwrap() *InternalMessageDelivery {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: InternalMessageDelivery is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *InternalMessageDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("InternalMessageDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.MessageID; v != nil {
+		builder.WriteString("message_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecipientID; v != nil {
+		builder.WriteString("recipient_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RecipientUserID; v != nil {
+		builder.WriteString("recipient_user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Channel; v != nil {
+		builder.WriteString("channel=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Address; v != nil {
+		builder.WriteString("address=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.Status; v != nil {
+		builder.WriteString("status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Attempts; v != nil {
+		builder.WriteString("attempts=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastError; v != nil {
+		builder.WriteString("last_error=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SentAt; v != nil {
+		builder.WriteString("sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// InternalMessageDeliveries is a parsable slice of InternalMessageDelivery.
+type InternalMessageDeliveries []*InternalMessageDelivery
@@ -0,0 +1,835 @@
+// Code generated by ent, DO NOT EDIT.
+
+package internalmessagedelivery
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldTenantID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldMessageID, v))
+}
+
+// RecipientID applies equality check predicate on the "recipient_id" field. It's identical to RecipientIDEQ.
+func RecipientID(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientUserID applies equality check predicate on the "recipient_user_id" field. It's identical to RecipientUserIDEQ.
+func RecipientUserID(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldRecipientUserID, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldChannel, v))
+}
+
+// Address applies equality check predicate on the "address" field. It's identical to AddressEQ.
+func Address(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldAddress, v))
+}
+
+// Attempts applies equality check predicate on the "attempts" field. It's identical to AttemptsEQ.
+func Attempts(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// SentAt applies equality check predicate on the "sent_at" field. It's identical to SentAtEQ.
+func SentAt(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldSentAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldTenantID))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldMessageID))
+}
+
+// RecipientIDEQ applies the EQ predicate on the "recipient_id" field.
+func RecipientIDEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldRecipientID, v))
+}
+
+// RecipientIDNEQ applies the NEQ predicate on the "recipient_id" field.
+func RecipientIDNEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldRecipientID, v))
+}
+
+// RecipientIDIn applies the In predicate on the "recipient_id" field.
+func RecipientIDIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDNotIn applies the NotIn predicate on the "recipient_id" field.
+func RecipientIDNotIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldRecipientID, vs...))
+}
+
+// RecipientIDGT applies the GT predicate on the "recipient_id" field.
+func RecipientIDGT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldRecipientID, v))
+}
+
+// RecipientIDGTE applies the GTE predicate on the "recipient_id" field.
+func RecipientIDGTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldRecipientID, v))
+}
+
+// RecipientIDLT applies the LT predicate on the "recipient_id" field.
+func RecipientIDLT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldRecipientID, v))
+}
+
+// RecipientIDLTE applies the LTE predicate on the "recipient_id" field.
+func RecipientIDLTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldRecipientID, v))
+}
+
+// RecipientIDIsNil applies the IsNil predicate on the "recipient_id" field.
+func RecipientIDIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldRecipientID))
+}
+
+// RecipientIDNotNil applies the NotNil predicate on the "recipient_id" field.
+func RecipientIDNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldRecipientID))
+}
+
+// RecipientUserIDEQ applies the EQ predicate on the "recipient_user_id" field.
+func RecipientUserIDEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDNEQ applies the NEQ predicate on the "recipient_user_id" field.
+func RecipientUserIDNEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDIn applies the In predicate on the "recipient_user_id" field.
+func RecipientUserIDIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldRecipientUserID, vs...))
+}
+
+// RecipientUserIDNotIn applies the NotIn predicate on the "recipient_user_id" field.
+func RecipientUserIDNotIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldRecipientUserID, vs...))
+}
+
+// RecipientUserIDGT applies the GT predicate on the "recipient_user_id" field.
+func RecipientUserIDGT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDGTE applies the GTE predicate on the "recipient_user_id" field.
+func RecipientUserIDGTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDLT applies the LT predicate on the "recipient_user_id" field.
+func RecipientUserIDLT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDLTE applies the LTE predicate on the "recipient_user_id" field.
+func RecipientUserIDLTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldRecipientUserID, v))
+}
+
+// RecipientUserIDIsNil applies the IsNil predicate on the "recipient_user_id" field.
+func RecipientUserIDIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldRecipientUserID))
+}
+
+// RecipientUserIDNotNil applies the NotNil predicate on the "recipient_user_id" field.
+func RecipientUserIDNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldRecipientUserID))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelIsNil applies the IsNil predicate on the "channel" field.
+func ChannelIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldChannel))
+}
+
+// ChannelNotNil applies the NotNil predicate on the "channel" field.
+func ChannelNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldChannel))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// AddressEQ applies the EQ predicate on the "address" field.
+func AddressEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldAddress, v))
+}
+
+// AddressNEQ applies the NEQ predicate on the "address" field.
+func AddressNEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldAddress, v))
+}
+
+// AddressIn applies the In predicate on the "address" field.
+func AddressIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldAddress, vs...))
+}
+
+// AddressNotIn applies the NotIn predicate on the "address" field.
+func AddressNotIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldAddress, vs...))
+}
+
+// AddressGT applies the GT predicate on the "address" field.
+func AddressGT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldAddress, v))
+}
+
+// AddressGTE applies the GTE predicate on the "address" field.
+func AddressGTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldAddress, v))
+}
+
+// AddressLT applies the LT predicate on the "address" field.
+func AddressLT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldAddress, v))
+}
+
+// AddressLTE applies the LTE predicate on the "address" field.
+func AddressLTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldAddress, v))
+}
+
+// AddressContains applies the Contains predicate on the "address" field.
+func AddressContains(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContains(FieldAddress, v))
+}
+
+// AddressHasPrefix applies the HasPrefix predicate on the "address" field.
+func AddressHasPrefix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasPrefix(FieldAddress, v))
+}
+
+// AddressHasSuffix applies the HasSuffix predicate on the "address" field.
+func AddressHasSuffix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasSuffix(FieldAddress, v))
+}
+
+// AddressIsNil applies the IsNil predicate on the "address" field.
+func AddressIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldAddress))
+}
+
+// AddressNotNil applies the NotNil predicate on the "address" field.
+func AddressNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldAddress))
+}
+
+// AddressEqualFold applies the EqualFold predicate on the "address" field.
+func AddressEqualFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEqualFold(FieldAddress, v))
+}
+
+// AddressContainsFold applies the ContainsFold predicate on the "address" field.
+func AddressContainsFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContainsFold(FieldAddress, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldStatus))
+}
+
+// AttemptsEQ applies the EQ predicate on the "attempts" field.
+func AttemptsEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldAttempts, v))
+}
+
+// AttemptsNEQ applies the NEQ predicate on the "attempts" field.
+func AttemptsNEQ(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldAttempts, v))
+}
+
+// AttemptsIn applies the In predicate on the "attempts" field.
+func AttemptsIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldAttempts, vs...))
+}
+
+// AttemptsNotIn applies the NotIn predicate on the "attempts" field.
+func AttemptsNotIn(vs ...uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldAttempts, vs...))
+}
+
+// AttemptsGT applies the GT predicate on the "attempts" field.
+func AttemptsGT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldAttempts, v))
+}
+
+// AttemptsGTE applies the GTE predicate on the "attempts" field.
+func AttemptsGTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldAttempts, v))
+}
+
+// AttemptsLT applies the LT predicate on the "attempts" field.
+func AttemptsLT(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldAttempts, v))
+}
+
+// AttemptsLTE applies the LTE predicate on the "attempts" field.
+func AttemptsLTE(v uint32) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldAttempts, v))
+}
+
+// AttemptsIsNil applies the IsNil predicate on the "attempts" field.
+func AttemptsIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldAttempts))
+}
+
+// AttemptsNotNil applies the NotNil predicate on the "attempts" field.
+func AttemptsNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldAttempts))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// SentAtEQ applies the EQ predicate on the "sent_at" field.
+func SentAtEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldEQ(FieldSentAt, v))
+}
+
+// SentAtNEQ applies the NEQ predicate on the "sent_at" field.
+func SentAtNEQ(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNEQ(FieldSentAt, v))
+}
+
+// SentAtIn applies the In predicate on the "sent_at" field.
+func SentAtIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIn(FieldSentAt, vs...))
+}
+
+// SentAtNotIn applies the NotIn predicate on the "sent_at" field.
+func SentAtNotIn(vs ...time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotIn(FieldSentAt, vs...))
+}
+
+// SentAtGT applies the GT predicate on the "sent_at" field.
+func SentAtGT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGT(FieldSentAt, v))
+}
+
+// SentAtGTE applies the GTE predicate on the "sent_at" field.
+func SentAtGTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldGTE(FieldSentAt, v))
+}
+
+// SentAtLT applies the LT predicate on the "sent_at" field.
+func SentAtLT(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLT(FieldSentAt, v))
+}
+
+// SentAtLTE applies the LTE predicate on the "sent_at" field.
+func SentAtLTE(v time.Time) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldLTE(FieldSentAt, v))
+}
+
+// SentAtIsNil applies the IsNil predicate on the "sent_at" field.
+func SentAtIsNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldIsNull(FieldSentAt))
+}
+
+// SentAtNotNil applies the NotNil predicate on the "sent_at" field.
+func SentAtNotNil() predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.FieldNotNull(FieldSentAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.InternalMessageDelivery) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.InternalMessageDelivery) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.InternalMessageDelivery) predicate.InternalMessageDelivery {
+	return predicate.InternalMessageDelivery(sql.NotPredicates(p))
+}
@@ -0,0 +1,1450 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InternalMessageDeliveryCreate is the builder for creating a InternalMessageDelivery entity.
+type InternalMessageDeliveryCreate struct {
+	config
+	mutation *InternalMessageDeliveryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *InternalMessageDeliveryCreate) SetCreatedAt(v time.Time) *InternalMessageDeliveryCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableCreatedAt(v *time.Time) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *InternalMessageDeliveryCreate) SetUpdatedAt(v time.Time) *InternalMessageDeliveryCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableUpdatedAt(v *time.Time) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *InternalMessageDeliveryCreate) SetDeletedAt(v time.Time) *InternalMessageDeliveryCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableDeletedAt(v *time.Time) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *InternalMessageDeliveryCreate) SetTenantID(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableTenantID(v *uint32) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetMessageID sets the "message_id" field.
+func (_c *InternalMessageDeliveryCreate) SetMessageID(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetMessageID(v)
+	return _c
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableMessageID(v *uint32) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetMessageID(*v)
+	}
+	return _c
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_c *InternalMessageDeliveryCreate) SetRecipientID(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetRecipientID(v)
+	return _c
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableRecipientID(v *uint32) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetRecipientID(*v)
+	}
+	return _c
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (_c *InternalMessageDeliveryCreate) SetRecipientUserID(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetRecipientUserID(v)
+	return _c
+}
+
+// SetNillableRecipientUserID sets the "recipient_user_id" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableRecipientUserID(v *uint32) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetRecipientUserID(*v)
+	}
+	return _c
+}
+
+// SetChannel sets the "channel" field.
+func (_c *InternalMessageDeliveryCreate) SetChannel(v string) *InternalMessageDeliveryCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableChannel(v *string) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// SetAddress sets the "address" field.
+func (_c *InternalMessageDeliveryCreate) SetAddress(v string) *InternalMessageDeliveryCreate {
+	_c.mutation.SetAddress(v)
+	return _c
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableAddress(v *string) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetAddress(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *InternalMessageDeliveryCreate) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableStatus(v *internalmessagedelivery.Status) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *InternalMessageDeliveryCreate) SetAttempts(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableAttempts(v *uint32) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *InternalMessageDeliveryCreate) SetLastError(v string) *InternalMessageDeliveryCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableLastError(v *string) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_c *InternalMessageDeliveryCreate) SetSentAt(v time.Time) *InternalMessageDeliveryCreate {
+	_c.mutation.SetSentAt(v)
+	return _c
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_c *InternalMessageDeliveryCreate) SetNillableSentAt(v *time.Time) *InternalMessageDeliveryCreate {
+	if v != nil {
+		_c.SetSentAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *InternalMessageDeliveryCreate) SetID(v uint32) *InternalMessageDeliveryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the InternalMessageDeliveryMutation object of the builder.
+func (_c *InternalMessageDeliveryCreate) Mutation() *InternalMessageDeliveryMutation {
+	return _c.mutation
+}
+
+// Save creates the InternalMessageDelivery in the database.
+func (_c *InternalMessageDeliveryCreate) Save(ctx context.Context) (*InternalMessageDelivery, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *InternalMessageDeliveryCreate) SaveX(ctx context.Context) *InternalMessageDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InternalMessageDeliveryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InternalMessageDeliveryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *InternalMessageDeliveryCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := internalmessagedelivery.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := internalmessagedelivery.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *InternalMessageDeliveryCreate) check() error {
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := internalmessagedelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := internalmessagedelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := internalmessagedelivery.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *InternalMessageDeliveryCreate) sqlSave(ctx context.Context) (*InternalMessageDelivery, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *InternalMessageDeliveryCreate) createSpec() (*InternalMessageDelivery, *sqlgraph.CreateSpec) {
+	var (
+		_node = &InternalMessageDelivery{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(internalmessagedelivery.Table, sqlgraph.NewFieldSpec(internalmessagedelivery.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.MessageID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldMessageID, field.TypeUint32, value)
+		_node.MessageID = &value
+	}
+	if value, ok := _c.mutation.RecipientID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientID, field.TypeUint32, value)
+		_node.RecipientID = &value
+	}
+	if value, ok := _c.mutation.RecipientUserID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32, value)
+		_node.RecipientUserID = &value
+	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(internalmessagedelivery.FieldChannel, field.TypeString, value)
+		_node.Channel = &value
+	}
+	if value, ok := _c.mutation.Address(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAddress, field.TypeString, value)
+		_node.Address = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(internalmessagedelivery.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAttempts, field.TypeUint32, value)
+		_node.Attempts = &value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(internalmessagedelivery.FieldLastError, field.TypeString, value)
+		_node.LastError = &value
+	}
+	if value, ok := _c.mutation.SentAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldSentAt, field.TypeTime, value)
+		_node.SentAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InternalMessageDelivery.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InternalMessageDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InternalMessageDeliveryCreate) OnConflict(opts ...sql.ConflictOption) *InternalMessageDeliveryUpsertOne {
+	_c.conflict = opts
+	return &InternalMessageDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InternalMessageDeliveryCreate) OnConflictColumns(columns ...string) *InternalMessageDeliveryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InternalMessageDeliveryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// InternalMessageDeliveryUpsertOne is the builder for "upsert"-ing
+	//  one InternalMessageDelivery node.
+	InternalMessageDeliveryUpsertOne struct {
+		create *InternalMessageDeliveryCreate
+	}
+
+	// InternalMessageDeliveryUpsert is the "OnConflict" setter.
+	InternalMessageDeliveryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InternalMessageDeliveryUpsert) SetUpdatedAt(v time.Time) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateUpdatedAt() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *InternalMessageDeliveryUpsert) ClearUpdatedAt() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsert) SetDeletedAt(v time.Time) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateDeletedAt() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsert) ClearDeletedAt() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldDeletedAt)
+	return u
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *InternalMessageDeliveryUpsert) SetMessageID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldMessageID, v)
+	return u
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateMessageID() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldMessageID)
+	return u
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *InternalMessageDeliveryUpsert) AddMessageID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Add(internalmessagedelivery.FieldMessageID, v)
+	return u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *InternalMessageDeliveryUpsert) ClearMessageID() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldMessageID)
+	return u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsert) SetRecipientID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldRecipientID, v)
+	return u
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateRecipientID() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldRecipientID)
+	return u
+}
+
+// AddRecipientID adds v to the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsert) AddRecipientID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Add(internalmessagedelivery.FieldRecipientID, v)
+	return u
+}
+
+// ClearRecipientID clears the value of the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsert) ClearRecipientID() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldRecipientID)
+	return u
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsert) SetRecipientUserID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldRecipientUserID, v)
+	return u
+}
+
+// UpdateRecipientUserID sets the "recipient_user_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateRecipientUserID() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldRecipientUserID)
+	return u
+}
+
+// AddRecipientUserID adds v to the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsert) AddRecipientUserID(v uint32) *InternalMessageDeliveryUpsert {
+	u.Add(internalmessagedelivery.FieldRecipientUserID, v)
+	return u
+}
+
+// ClearRecipientUserID clears the value of the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsert) ClearRecipientUserID() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldRecipientUserID)
+	return u
+}
+
+// SetChannel sets the "channel" field.
+func (u *InternalMessageDeliveryUpsert) SetChannel(v string) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldChannel, v)
+	return u
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateChannel() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldChannel)
+	return u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *InternalMessageDeliveryUpsert) ClearChannel() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldChannel)
+	return u
+}
+
+// SetAddress sets the "address" field.
+func (u *InternalMessageDeliveryUpsert) SetAddress(v string) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldAddress, v)
+	return u
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateAddress() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldAddress)
+	return u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *InternalMessageDeliveryUpsert) ClearAddress() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldAddress)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *InternalMessageDeliveryUpsert) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateStatus() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *InternalMessageDeliveryUpsert) ClearStatus() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldStatus)
+	return u
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *InternalMessageDeliveryUpsert) SetAttempts(v uint32) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldAttempts, v)
+	return u
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateAttempts() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldAttempts)
+	return u
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *InternalMessageDeliveryUpsert) AddAttempts(v uint32) *InternalMessageDeliveryUpsert {
+	u.Add(internalmessagedelivery.FieldAttempts, v)
+	return u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *InternalMessageDeliveryUpsert) ClearAttempts() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldAttempts)
+	return u
+}
+
+// SetLastError sets the "last_error" field.
+func (u *InternalMessageDeliveryUpsert) SetLastError(v string) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldLastError, v)
+	return u
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateLastError() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldLastError)
+	return u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *InternalMessageDeliveryUpsert) ClearLastError() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldLastError)
+	return u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *InternalMessageDeliveryUpsert) SetSentAt(v time.Time) *InternalMessageDeliveryUpsert {
+	u.Set(internalmessagedelivery.FieldSentAt, v)
+	return u
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsert) UpdateSentAt() *InternalMessageDeliveryUpsert {
+	u.SetExcluded(internalmessagedelivery.FieldSentAt)
+	return u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *InternalMessageDeliveryUpsert) ClearSentAt() *InternalMessageDeliveryUpsert {
+	u.SetNull(internalmessagedelivery.FieldSentAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(internalmessagedelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InternalMessageDeliveryUpsertOne) UpdateNewValues() *InternalMessageDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(internalmessagedelivery.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(internalmessagedelivery.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(internalmessagedelivery.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *InternalMessageDeliveryUpsertOne) Ignore() *InternalMessageDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InternalMessageDeliveryUpsertOne) DoNothing() *InternalMessageDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InternalMessageDeliveryCreate.OnConflict
+// documentation for more info.
+func (u *InternalMessageDeliveryUpsertOne) Update(set func(*InternalMessageDeliveryUpsert)) *InternalMessageDeliveryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InternalMessageDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InternalMessageDeliveryUpsertOne) SetUpdatedAt(v time.Time) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateUpdatedAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearUpdatedAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsertOne) SetDeletedAt(v time.Time) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateDeletedAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearDeletedAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *InternalMessageDeliveryUpsertOne) SetMessageID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *InternalMessageDeliveryUpsertOne) AddMessageID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateMessageID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearMessageID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertOne) SetRecipientID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetRecipientID(v)
+	})
+}
+
+// AddRecipientID adds v to the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertOne) AddRecipientID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddRecipientID(v)
+	})
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateRecipientID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateRecipientID()
+	})
+}
+
+// ClearRecipientID clears the value of the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearRecipientID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearRecipientID()
+	})
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertOne) SetRecipientUserID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetRecipientUserID(v)
+	})
+}
+
+// AddRecipientUserID adds v to the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertOne) AddRecipientUserID(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddRecipientUserID(v)
+	})
+}
+
+// UpdateRecipientUserID sets the "recipient_user_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateRecipientUserID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateRecipientUserID()
+	})
+}
+
+// ClearRecipientUserID clears the value of the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearRecipientUserID() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearRecipientUserID()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *InternalMessageDeliveryUpsertOne) SetChannel(v string) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateChannel() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearChannel() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *InternalMessageDeliveryUpsertOne) SetAddress(v string) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateAddress() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearAddress() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InternalMessageDeliveryUpsertOne) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateStatus() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearStatus() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *InternalMessageDeliveryUpsertOne) SetAttempts(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *InternalMessageDeliveryUpsertOne) AddAttempts(v uint32) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateAttempts() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearAttempts() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *InternalMessageDeliveryUpsertOne) SetLastError(v string) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateLastError() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearLastError() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *InternalMessageDeliveryUpsertOne) SetSentAt(v time.Time) *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertOne) UpdateSentAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *InternalMessageDeliveryUpsertOne) ClearSentAt() *InternalMessageDeliveryUpsertOne {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InternalMessageDeliveryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InternalMessageDeliveryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InternalMessageDeliveryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *InternalMessageDeliveryUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *InternalMessageDeliveryUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// InternalMessageDeliveryCreateBulk is the builder for creating many InternalMessageDelivery entities in bulk.
+type InternalMessageDeliveryCreateBulk struct {
+	config
+	err      error
+	builders []*InternalMessageDeliveryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the InternalMessageDelivery entities in the database.
+func (_c *InternalMessageDeliveryCreateBulk) Save(ctx context.Context) ([]*InternalMessageDelivery, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*InternalMessageDelivery, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*InternalMessageDeliveryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *InternalMessageDeliveryCreateBulk) SaveX(ctx context.Context) []*InternalMessageDelivery {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *InternalMessageDeliveryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *InternalMessageDeliveryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.InternalMessageDelivery.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.InternalMessageDeliveryUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *InternalMessageDeliveryCreateBulk) OnConflict(opts ...sql.ConflictOption) *InternalMessageDeliveryUpsertBulk {
+	_c.conflict = opts
+	return &InternalMessageDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *InternalMessageDeliveryCreateBulk) OnConflictColumns(columns ...string) *InternalMessageDeliveryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &InternalMessageDeliveryUpsertBulk{
+		create: _c,
+	}
+}
+
+// InternalMessageDeliveryUpsertBulk is the builder for "upsert"-ing
+// a bulk of InternalMessageDelivery nodes.
+type InternalMessageDeliveryUpsertBulk struct {
+	create *InternalMessageDeliveryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(internalmessagedelivery.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *InternalMessageDeliveryUpsertBulk) UpdateNewValues() *InternalMessageDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(internalmessagedelivery.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(internalmessagedelivery.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(internalmessagedelivery.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.InternalMessageDelivery.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *InternalMessageDeliveryUpsertBulk) Ignore() *InternalMessageDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *InternalMessageDeliveryUpsertBulk) DoNothing() *InternalMessageDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the InternalMessageDeliveryCreateBulk.OnConflict
+// documentation for more info.
+func (u *InternalMessageDeliveryUpsertBulk) Update(set func(*InternalMessageDeliveryUpsert)) *InternalMessageDeliveryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&InternalMessageDeliveryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetUpdatedAt(v time.Time) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateUpdatedAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearUpdatedAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetDeletedAt(v time.Time) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateDeletedAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearDeletedAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetMessageID sets the "message_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetMessageID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetMessageID(v)
+	})
+}
+
+// AddMessageID adds v to the "message_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) AddMessageID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddMessageID(v)
+	})
+}
+
+// UpdateMessageID sets the "message_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateMessageID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateMessageID()
+	})
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearMessageID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearMessageID()
+	})
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetRecipientID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetRecipientID(v)
+	})
+}
+
+// AddRecipientID adds v to the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) AddRecipientID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddRecipientID(v)
+	})
+}
+
+// UpdateRecipientID sets the "recipient_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateRecipientID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateRecipientID()
+	})
+}
+
+// ClearRecipientID clears the value of the "recipient_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearRecipientID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearRecipientID()
+	})
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetRecipientUserID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetRecipientUserID(v)
+	})
+}
+
+// AddRecipientUserID adds v to the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) AddRecipientUserID(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddRecipientUserID(v)
+	})
+}
+
+// UpdateRecipientUserID sets the "recipient_user_id" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateRecipientUserID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateRecipientUserID()
+	})
+}
+
+// ClearRecipientUserID clears the value of the "recipient_user_id" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearRecipientUserID() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearRecipientUserID()
+	})
+}
+
+// SetChannel sets the "channel" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetChannel(v string) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetChannel(v)
+	})
+}
+
+// UpdateChannel sets the "channel" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateChannel() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateChannel()
+	})
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearChannel() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearChannel()
+	})
+}
+
+// SetAddress sets the "address" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetAddress(v string) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetAddress(v)
+	})
+}
+
+// UpdateAddress sets the "address" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateAddress() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateAddress()
+	})
+}
+
+// ClearAddress clears the value of the "address" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearAddress() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearAddress()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateStatus() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearStatus() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetAttempts sets the "attempts" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetAttempts(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetAttempts(v)
+	})
+}
+
+// AddAttempts adds v to the "attempts" field.
+func (u *InternalMessageDeliveryUpsertBulk) AddAttempts(v uint32) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.AddAttempts(v)
+	})
+}
+
+// UpdateAttempts sets the "attempts" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateAttempts() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateAttempts()
+	})
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearAttempts() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearAttempts()
+	})
+}
+
+// SetLastError sets the "last_error" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetLastError(v string) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetLastError(v)
+	})
+}
+
+// UpdateLastError sets the "last_error" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateLastError() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateLastError()
+	})
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearLastError() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearLastError()
+	})
+}
+
+// SetSentAt sets the "sent_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) SetSentAt(v time.Time) *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.SetSentAt(v)
+	})
+}
+
+// UpdateSentAt sets the "sent_at" field to the value that was provided on create.
+func (u *InternalMessageDeliveryUpsertBulk) UpdateSentAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.UpdateSentAt()
+	})
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (u *InternalMessageDeliveryUpsertBulk) ClearSentAt() *InternalMessageDeliveryUpsertBulk {
+	return u.Update(func(s *InternalMessageDeliveryUpsert) {
+		s.ClearSentAt()
+	})
+}
+
+// Exec executes the query.
+func (u *InternalMessageDeliveryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the InternalMessageDeliveryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for InternalMessageDeliveryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *InternalMessageDeliveryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
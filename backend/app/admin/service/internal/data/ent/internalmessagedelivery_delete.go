@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InternalMessageDeliveryDelete is the builder for deleting a InternalMessageDelivery entity.
+type InternalMessageDeliveryDelete struct {
+	config
+	hooks    []Hook
+	mutation *InternalMessageDeliveryMutation
+}
+
+// Where appends a list predicates to the InternalMessageDeliveryDelete builder.
+func (_d *InternalMessageDeliveryDelete) Where(ps ...predicate.InternalMessageDelivery) *InternalMessageDeliveryDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *InternalMessageDeliveryDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InternalMessageDeliveryDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *InternalMessageDeliveryDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(internalmessagedelivery.Table, sqlgraph.NewFieldSpec(internalmessagedelivery.FieldID, field.TypeUint32))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// InternalMessageDeliveryDeleteOne is the builder for deleting a single InternalMessageDelivery entity.
+type InternalMessageDeliveryDeleteOne struct {
+	_d *InternalMessageDeliveryDelete
+}
+
+// Where appends a list predicates to the InternalMessageDeliveryDelete builder.
+func (_d *InternalMessageDeliveryDeleteOne) Where(ps ...predicate.InternalMessageDelivery) *InternalMessageDeliveryDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *InternalMessageDeliveryDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{internalmessagedelivery.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *InternalMessageDeliveryDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,892 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// InternalMessageDeliveryUpdate is the builder for updating InternalMessageDelivery entities.
+type InternalMessageDeliveryUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *InternalMessageDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the InternalMessageDeliveryUpdate builder.
+func (_u *InternalMessageDeliveryUpdate) Where(ps ...predicate.InternalMessageDelivery) *InternalMessageDeliveryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InternalMessageDeliveryUpdate) SetUpdatedAt(v time.Time) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableUpdatedAt(v *time.Time) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *InternalMessageDeliveryUpdate) ClearUpdatedAt() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *InternalMessageDeliveryUpdate) SetDeletedAt(v time.Time) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableDeletedAt(v *time.Time) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *InternalMessageDeliveryUpdate) ClearDeletedAt() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InternalMessageDeliveryUpdate) SetMessageID(v uint32) *InternalMessageDeliveryUpdate {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableMessageID(v *uint32) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *InternalMessageDeliveryUpdate) AddMessageID(v int32) *InternalMessageDeliveryUpdate {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *InternalMessageDeliveryUpdate) ClearMessageID() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdate) SetRecipientID(v uint32) *InternalMessageDeliveryUpdate {
+	_u.mutation.ResetRecipientID()
+	_u.mutation.SetRecipientID(v)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableRecipientID(v *uint32) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetRecipientID(*v)
+	}
+	return _u
+}
+
+// AddRecipientID adds value to the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdate) AddRecipientID(v int32) *InternalMessageDeliveryUpdate {
+	_u.mutation.AddRecipientID(v)
+	return _u
+}
+
+// ClearRecipientID clears the value of the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdate) ClearRecipientID() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearRecipientID()
+	return _u
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdate) SetRecipientUserID(v uint32) *InternalMessageDeliveryUpdate {
+	_u.mutation.ResetRecipientUserID()
+	_u.mutation.SetRecipientUserID(v)
+	return _u
+}
+
+// SetNillableRecipientUserID sets the "recipient_user_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableRecipientUserID(v *uint32) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetRecipientUserID(*v)
+	}
+	return _u
+}
+
+// AddRecipientUserID adds value to the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdate) AddRecipientUserID(v int32) *InternalMessageDeliveryUpdate {
+	_u.mutation.AddRecipientUserID(v)
+	return _u
+}
+
+// ClearRecipientUserID clears the value of the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdate) ClearRecipientUserID() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearRecipientUserID()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *InternalMessageDeliveryUpdate) SetChannel(v string) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableChannel(v *string) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *InternalMessageDeliveryUpdate) ClearChannel() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *InternalMessageDeliveryUpdate) SetAddress(v string) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableAddress(v *string) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *InternalMessageDeliveryUpdate) ClearAddress() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InternalMessageDeliveryUpdate) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableStatus(v *internalmessagedelivery.Status) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *InternalMessageDeliveryUpdate) ClearStatus() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *InternalMessageDeliveryUpdate) SetAttempts(v uint32) *InternalMessageDeliveryUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableAttempts(v *uint32) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *InternalMessageDeliveryUpdate) AddAttempts(v int32) *InternalMessageDeliveryUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (_u *InternalMessageDeliveryUpdate) ClearAttempts() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *InternalMessageDeliveryUpdate) SetLastError(v string) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableLastError(v *string) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *InternalMessageDeliveryUpdate) ClearLastError() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *InternalMessageDeliveryUpdate) SetSentAt(v time.Time) *InternalMessageDeliveryUpdate {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdate) SetNillableSentAt(v *time.Time) *InternalMessageDeliveryUpdate {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *InternalMessageDeliveryUpdate) ClearSentAt() *InternalMessageDeliveryUpdate {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the InternalMessageDeliveryMutation object of the builder.
+func (_u *InternalMessageDeliveryUpdate) Mutation() *InternalMessageDeliveryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *InternalMessageDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InternalMessageDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *InternalMessageDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InternalMessageDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InternalMessageDeliveryUpdate) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := internalmessagedelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := internalmessagedelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InternalMessageDeliveryUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InternalMessageDeliveryUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InternalMessageDeliveryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internalmessagedelivery.Table, internalmessagedelivery.Columns, sqlgraph.NewFieldSpec(internalmessagedelivery.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RecipientID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldRecipientID, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldRecipientID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RecipientUserID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientUserID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientUserIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(internalmessagedelivery.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(internalmessagedelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(internalmessagedelivery.FieldAttempts, field.TypeUint32, value)
+	}
+	if _u.mutation.AttemptsCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldAttempts, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(internalmessagedelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldSentAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internalmessagedelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// InternalMessageDeliveryUpdateOne is the builder for updating a single InternalMessageDelivery entity.
+type InternalMessageDeliveryUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *InternalMessageDeliveryMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetUpdatedAt(v time.Time) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableUpdatedAt(v *time.Time) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearUpdatedAt() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetDeletedAt(v time.Time) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableDeletedAt(v *time.Time) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearDeletedAt() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetMessageID sets the "message_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetMessageID(v uint32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ResetMessageID()
+	_u.mutation.SetMessageID(v)
+	return _u
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableMessageID(v *uint32) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetMessageID(*v)
+	}
+	return _u
+}
+
+// AddMessageID adds value to the "message_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) AddMessageID(v int32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.AddMessageID(v)
+	return _u
+}
+
+// ClearMessageID clears the value of the "message_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearMessageID() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearMessageID()
+	return _u
+}
+
+// SetRecipientID sets the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetRecipientID(v uint32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ResetRecipientID()
+	_u.mutation.SetRecipientID(v)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableRecipientID(v *uint32) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRecipientID(*v)
+	}
+	return _u
+}
+
+// AddRecipientID adds value to the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) AddRecipientID(v int32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.AddRecipientID(v)
+	return _u
+}
+
+// ClearRecipientID clears the value of the "recipient_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearRecipientID() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearRecipientID()
+	return _u
+}
+
+// SetRecipientUserID sets the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetRecipientUserID(v uint32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ResetRecipientUserID()
+	_u.mutation.SetRecipientUserID(v)
+	return _u
+}
+
+// SetNillableRecipientUserID sets the "recipient_user_id" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableRecipientUserID(v *uint32) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetRecipientUserID(*v)
+	}
+	return _u
+}
+
+// AddRecipientUserID adds value to the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) AddRecipientUserID(v int32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.AddRecipientUserID(v)
+	return _u
+}
+
+// ClearRecipientUserID clears the value of the "recipient_user_id" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearRecipientUserID() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearRecipientUserID()
+	return _u
+}
+
+// SetChannel sets the "channel" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetChannel(v string) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableChannel(v *string) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearChannel() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearChannel()
+	return _u
+}
+
+// SetAddress sets the "address" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetAddress(v string) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetAddress(v)
+	return _u
+}
+
+// SetNillableAddress sets the "address" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableAddress(v *string) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAddress(*v)
+	}
+	return _u
+}
+
+// ClearAddress clears the value of the "address" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearAddress() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearAddress()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetStatus(v internalmessagedelivery.Status) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableStatus(v *internalmessagedelivery.Status) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearStatus() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetAttempts(v uint32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableAttempts(v *uint32) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *InternalMessageDeliveryUpdateOne) AddAttempts(v int32) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// ClearAttempts clears the value of the "attempts" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearAttempts() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearAttempts()
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetLastError(v string) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableLastError(v *string) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearLastError() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetSentAt sets the "sent_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) SetSentAt(v time.Time) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.SetSentAt(v)
+	return _u
+}
+
+// SetNillableSentAt sets the "sent_at" field if the given value is not nil.
+func (_u *InternalMessageDeliveryUpdateOne) SetNillableSentAt(v *time.Time) *InternalMessageDeliveryUpdateOne {
+	if v != nil {
+		_u.SetSentAt(*v)
+	}
+	return _u
+}
+
+// ClearSentAt clears the value of the "sent_at" field.
+func (_u *InternalMessageDeliveryUpdateOne) ClearSentAt() *InternalMessageDeliveryUpdateOne {
+	_u.mutation.ClearSentAt()
+	return _u
+}
+
+// Mutation returns the InternalMessageDeliveryMutation object of the builder.
+func (_u *InternalMessageDeliveryUpdateOne) Mutation() *InternalMessageDeliveryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the InternalMessageDeliveryUpdate builder.
+func (_u *InternalMessageDeliveryUpdateOne) Where(ps ...predicate.InternalMessageDelivery) *InternalMessageDeliveryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *InternalMessageDeliveryUpdateOne) Select(field string, fields ...string) *InternalMessageDeliveryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated InternalMessageDelivery entity.
+func (_u *InternalMessageDeliveryUpdateOne) Save(ctx context.Context) (*InternalMessageDelivery, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *InternalMessageDeliveryUpdateOne) SaveX(ctx context.Context) *InternalMessageDelivery {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *InternalMessageDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *InternalMessageDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *InternalMessageDeliveryUpdateOne) check() error {
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := internalmessagedelivery.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.channel": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := internalmessagedelivery.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "InternalMessageDelivery.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *InternalMessageDeliveryUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *InternalMessageDeliveryUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *InternalMessageDeliveryUpdateOne) sqlSave(ctx context.Context) (_node *InternalMessageDelivery, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(internalmessagedelivery.Table, internalmessagedelivery.Columns, sqlgraph.NewFieldSpec(internalmessagedelivery.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "InternalMessageDelivery.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, internalmessagedelivery.FieldID)
+		for _, f := range fields {
+			if !internalmessagedelivery.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != internalmessagedelivery.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MessageID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldMessageID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMessageID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldMessageID, field.TypeUint32, value)
+	}
+	if _u.mutation.MessageIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldMessageID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RecipientID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldRecipientID, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldRecipientID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.RecipientUserID(); ok {
+		_spec.SetField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedRecipientUserID(); ok {
+		_spec.AddField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32, value)
+	}
+	if _u.mutation.RecipientUserIDCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldRecipientUserID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(internalmessagedelivery.FieldChannel, field.TypeString, value)
+	}
+	if _u.mutation.ChannelCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldChannel, field.TypeString)
+	}
+	if value, ok := _u.mutation.Address(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAddress, field.TypeString, value)
+	}
+	if _u.mutation.AddressCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldAddress, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(internalmessagedelivery.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(internalmessagedelivery.FieldAttempts, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(internalmessagedelivery.FieldAttempts, field.TypeUint32, value)
+	}
+	if _u.mutation.AttemptsCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldAttempts, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(internalmessagedelivery.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.SentAt(); ok {
+		_spec.SetField(internalmessagedelivery.FieldSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.SentAtCleared() {
+		_spec.ClearField(internalmessagedelivery.FieldSentAt, field.TypeTime)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &InternalMessageDelivery{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{internalmessagedelivery.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,865 @@
+// Code generated by ent, DO NOT EDIT.
+
+package messagetemplate
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// IsEnabled applies equality check predicate on the "is_enabled" field. It's identical to IsEnabledEQ.
+func IsEnabled(v bool) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldIsEnabled, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldRemark, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// DefaultLanguage applies equality check predicate on the "default_language" field. It's identical to DefaultLanguageEQ.
+func DefaultLanguage(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDefaultLanguage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// IsEnabledEQ applies the EQ predicate on the "is_enabled" field.
+func IsEnabledEQ(v bool) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldIsEnabled, v))
+}
+
+// IsEnabledNEQ applies the NEQ predicate on the "is_enabled" field.
+func IsEnabledNEQ(v bool) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldIsEnabled, v))
+}
+
+// IsEnabledIsNil applies the IsNil predicate on the "is_enabled" field.
+func IsEnabledIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldIsEnabled))
+}
+
+// IsEnabledNotNil applies the NotNil predicate on the "is_enabled" field.
+func IsEnabledNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldIsEnabled))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldTenantID))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContainsFold(FieldName, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDGT applies the GT predicate on the "category_id" field.
+func CategoryIDGT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldCategoryID, v))
+}
+
+// CategoryIDGTE applies the GTE predicate on the "category_id" field.
+func CategoryIDGTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldCategoryID, v))
+}
+
+// CategoryIDLT applies the LT predicate on the "category_id" field.
+func CategoryIDLT(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldCategoryID, v))
+}
+
+// CategoryIDLTE applies the LTE predicate on the "category_id" field.
+func CategoryIDLTE(v uint32) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldCategoryID, v))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldCategoryID))
+}
+
+// DefaultLanguageEQ applies the EQ predicate on the "default_language" field.
+func DefaultLanguageEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEQ(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageNEQ applies the NEQ predicate on the "default_language" field.
+func DefaultLanguageNEQ(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNEQ(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageIn applies the In predicate on the "default_language" field.
+func DefaultLanguageIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIn(FieldDefaultLanguage, vs...))
+}
+
+// DefaultLanguageNotIn applies the NotIn predicate on the "default_language" field.
+func DefaultLanguageNotIn(vs ...string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotIn(FieldDefaultLanguage, vs...))
+}
+
+// DefaultLanguageGT applies the GT predicate on the "default_language" field.
+func DefaultLanguageGT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGT(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageGTE applies the GTE predicate on the "default_language" field.
+func DefaultLanguageGTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldGTE(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageLT applies the LT predicate on the "default_language" field.
+func DefaultLanguageLT(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLT(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageLTE applies the LTE predicate on the "default_language" field.
+func DefaultLanguageLTE(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldLTE(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageContains applies the Contains predicate on the "default_language" field.
+func DefaultLanguageContains(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContains(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageHasPrefix applies the HasPrefix predicate on the "default_language" field.
+func DefaultLanguageHasPrefix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasPrefix(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageHasSuffix applies the HasSuffix predicate on the "default_language" field.
+func DefaultLanguageHasSuffix(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldHasSuffix(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageIsNil applies the IsNil predicate on the "default_language" field.
+func DefaultLanguageIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldDefaultLanguage))
+}
+
+// DefaultLanguageNotNil applies the NotNil predicate on the "default_language" field.
+func DefaultLanguageNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldDefaultLanguage))
+}
+
+// DefaultLanguageEqualFold applies the EqualFold predicate on the "default_language" field.
+func DefaultLanguageEqualFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldEqualFold(FieldDefaultLanguage, v))
+}
+
+// DefaultLanguageContainsFold applies the ContainsFold predicate on the "default_language" field.
+func DefaultLanguageContainsFold(v string) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldContainsFold(FieldDefaultLanguage, v))
+}
+
+// LocalesIsNil applies the IsNil predicate on the "locales" field.
+func LocalesIsNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldIsNull(FieldLocales))
+}
+
+// LocalesNotNil applies the NotNil predicate on the "locales" field.
+func LocalesNotNil() predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.FieldNotNull(FieldLocales))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.MessageTemplate) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.MessageTemplate) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.MessageTemplate) predicate.MessageTemplate {
+	return predicate.MessageTemplate(sql.NotPredicates(p))
+}
@@ -0,0 +1,1512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageTemplateCreate is the builder for creating a MessageTemplate entity.
+type MessageTemplateCreate struct {
+	config
+	mutation *MessageTemplateMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MessageTemplateCreate) SetCreatedAt(v time.Time) *MessageTemplateCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableCreatedAt(v *time.Time) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *MessageTemplateCreate) SetUpdatedAt(v time.Time) *MessageTemplateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableUpdatedAt(v *time.Time) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *MessageTemplateCreate) SetDeletedAt(v time.Time) *MessageTemplateCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableDeletedAt(v *time.Time) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *MessageTemplateCreate) SetCreatedBy(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableCreatedBy(v *uint32) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *MessageTemplateCreate) SetUpdatedBy(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableUpdatedBy(v *uint32) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *MessageTemplateCreate) SetDeletedBy(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableDeletedBy(v *uint32) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (_c *MessageTemplateCreate) SetIsEnabled(v bool) *MessageTemplateCreate {
+	_c.mutation.SetIsEnabled(v)
+	return _c
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableIsEnabled(v *bool) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetIsEnabled(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *MessageTemplateCreate) SetRemark(v string) *MessageTemplateCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableRemark(v *string) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *MessageTemplateCreate) SetTenantID(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableTenantID(v *uint32) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *MessageTemplateCreate) SetCode(v string) *MessageTemplateCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableCode(v *string) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *MessageTemplateCreate) SetName(v string) *MessageTemplateCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableName(v *string) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *MessageTemplateCreate) SetCategoryID(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableCategoryID(v *uint32) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_c *MessageTemplateCreate) SetDefaultLanguage(v string) *MessageTemplateCreate {
+	_c.mutation.SetDefaultLanguage(v)
+	return _c
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_c *MessageTemplateCreate) SetNillableDefaultLanguage(v *string) *MessageTemplateCreate {
+	if v != nil {
+		_c.SetDefaultLanguage(*v)
+	}
+	return _c
+}
+
+// SetLocales sets the "locales" field.
+func (_c *MessageTemplateCreate) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateCreate {
+	_c.mutation.SetLocales(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *MessageTemplateCreate) SetID(v uint32) *MessageTemplateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the MessageTemplateMutation object of the builder.
+func (_c *MessageTemplateCreate) Mutation() *MessageTemplateMutation {
+	return _c.mutation
+}
+
+// Save creates the MessageTemplate in the database.
+func (_c *MessageTemplateCreate) Save(ctx context.Context) (*MessageTemplate, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MessageTemplateCreate) SaveX(ctx context.Context) *MessageTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageTemplateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageTemplateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MessageTemplateCreate) defaults() error {
+	if _, ok := _c.mutation.IsEnabled(); !ok {
+		v := messagetemplate.DefaultIsEnabled
+		_c.mutation.SetIsEnabled(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MessageTemplateCreate) check() error {
+	if v, ok := _c.mutation.Code(); ok {
+		if err := messagetemplate.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MessageTemplate.code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := messagetemplate.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "MessageTemplate.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *MessageTemplateCreate) sqlSave(ctx context.Context) (*MessageTemplate, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MessageTemplateCreate) createSpec() (*MessageTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &MessageTemplate{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(messagetemplate.Table, sqlgraph.NewFieldSpec(messagetemplate.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(messagetemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.IsEnabled(); ok {
+		_spec.SetField(messagetemplate.FieldIsEnabled, field.TypeBool, value)
+		_node.IsEnabled = &value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(messagetemplate.FieldRemark, field.TypeString, value)
+		_node.Remark = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(messagetemplate.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(messagetemplate.FieldCode, field.TypeString, value)
+		_node.Code = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(messagetemplate.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.CategoryID(); ok {
+		_spec.SetField(messagetemplate.FieldCategoryID, field.TypeUint32, value)
+		_node.CategoryID = &value
+	}
+	if value, ok := _c.mutation.DefaultLanguage(); ok {
+		_spec.SetField(messagetemplate.FieldDefaultLanguage, field.TypeString, value)
+		_node.DefaultLanguage = &value
+	}
+	if value, ok := _c.mutation.Locales(); ok {
+		_spec.SetField(messagetemplate.FieldLocales, field.TypeJSON, value)
+		_node.Locales = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageTemplate.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageTemplateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageTemplateCreate) OnConflict(opts ...sql.ConflictOption) *MessageTemplateUpsertOne {
+	_c.conflict = opts
+	return &MessageTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageTemplateCreate) OnConflictColumns(columns ...string) *MessageTemplateUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageTemplateUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// MessageTemplateUpsertOne is the builder for "upsert"-ing
+	//  one MessageTemplate node.
+	MessageTemplateUpsertOne struct {
+		create *MessageTemplateCreate
+	}
+
+	// MessageTemplateUpsert is the "OnConflict" setter.
+	MessageTemplateUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageTemplateUpsert) SetUpdatedAt(v time.Time) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateUpdatedAt() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MessageTemplateUpsert) ClearUpdatedAt() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageTemplateUpsert) SetDeletedAt(v time.Time) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateDeletedAt() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageTemplateUpsert) ClearDeletedAt() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MessageTemplateUpsert) SetCreatedBy(v uint32) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateCreatedBy() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MessageTemplateUpsert) AddCreatedBy(v uint32) *MessageTemplateUpsert {
+	u.Add(messagetemplate.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MessageTemplateUpsert) ClearCreatedBy() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MessageTemplateUpsert) SetUpdatedBy(v uint32) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateUpdatedBy() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MessageTemplateUpsert) AddUpdatedBy(v uint32) *MessageTemplateUpsert {
+	u.Add(messagetemplate.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MessageTemplateUpsert) ClearUpdatedBy() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MessageTemplateUpsert) SetDeletedBy(v uint32) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateDeletedBy() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MessageTemplateUpsert) AddDeletedBy(v uint32) *MessageTemplateUpsert {
+	u.Add(messagetemplate.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MessageTemplateUpsert) ClearDeletedBy() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldDeletedBy)
+	return u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *MessageTemplateUpsert) SetIsEnabled(v bool) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldIsEnabled, v)
+	return u
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateIsEnabled() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldIsEnabled)
+	return u
+}
+
+// ClearIsEnabled clears the value of the "is_enabled" field.
+func (u *MessageTemplateUpsert) ClearIsEnabled() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldIsEnabled)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *MessageTemplateUpsert) SetRemark(v string) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateRemark() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldRemark)
+	return u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *MessageTemplateUpsert) ClearRemark() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldRemark)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *MessageTemplateUpsert) SetCode(v string) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateCode() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldCode)
+	return u
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *MessageTemplateUpsert) ClearCode() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *MessageTemplateUpsert) SetName(v string) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateName() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *MessageTemplateUpsert) ClearName() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldName)
+	return u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *MessageTemplateUpsert) SetCategoryID(v uint32) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldCategoryID, v)
+	return u
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateCategoryID() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldCategoryID)
+	return u
+}
+
+// AddCategoryID adds v to the "category_id" field.
+func (u *MessageTemplateUpsert) AddCategoryID(v uint32) *MessageTemplateUpsert {
+	u.Add(messagetemplate.FieldCategoryID, v)
+	return u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *MessageTemplateUpsert) ClearCategoryID() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldCategoryID)
+	return u
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (u *MessageTemplateUpsert) SetDefaultLanguage(v string) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldDefaultLanguage, v)
+	return u
+}
+
+// UpdateDefaultLanguage sets the "default_language" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateDefaultLanguage() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldDefaultLanguage)
+	return u
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (u *MessageTemplateUpsert) ClearDefaultLanguage() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldDefaultLanguage)
+	return u
+}
+
+// SetLocales sets the "locales" field.
+func (u *MessageTemplateUpsert) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpsert {
+	u.Set(messagetemplate.FieldLocales, v)
+	return u
+}
+
+// UpdateLocales sets the "locales" field to the value that was provided on create.
+func (u *MessageTemplateUpsert) UpdateLocales() *MessageTemplateUpsert {
+	u.SetExcluded(messagetemplate.FieldLocales)
+	return u
+}
+
+// ClearLocales clears the value of the "locales" field.
+func (u *MessageTemplateUpsert) ClearLocales() *MessageTemplateUpsert {
+	u.SetNull(messagetemplate.FieldLocales)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagetemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageTemplateUpsertOne) UpdateNewValues() *MessageTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(messagetemplate.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(messagetemplate.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(messagetemplate.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *MessageTemplateUpsertOne) Ignore() *MessageTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageTemplateUpsertOne) DoNothing() *MessageTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageTemplateCreate.OnConflict
+// documentation for more info.
+func (u *MessageTemplateUpsertOne) Update(set func(*MessageTemplateUpsert)) *MessageTemplateUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageTemplateUpsertOne) SetUpdatedAt(v time.Time) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateUpdatedAt() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MessageTemplateUpsertOne) ClearUpdatedAt() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageTemplateUpsertOne) SetDeletedAt(v time.Time) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateDeletedAt() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageTemplateUpsertOne) ClearDeletedAt() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MessageTemplateUpsertOne) SetCreatedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MessageTemplateUpsertOne) AddCreatedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateCreatedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MessageTemplateUpsertOne) ClearCreatedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MessageTemplateUpsertOne) SetUpdatedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MessageTemplateUpsertOne) AddUpdatedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateUpdatedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MessageTemplateUpsertOne) ClearUpdatedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MessageTemplateUpsertOne) SetDeletedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MessageTemplateUpsertOne) AddDeletedBy(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateDeletedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MessageTemplateUpsertOne) ClearDeletedBy() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *MessageTemplateUpsertOne) SetIsEnabled(v bool) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateIsEnabled() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// ClearIsEnabled clears the value of the "is_enabled" field.
+func (u *MessageTemplateUpsertOne) ClearIsEnabled() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearIsEnabled()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *MessageTemplateUpsertOne) SetRemark(v string) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateRemark() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *MessageTemplateUpsertOne) ClearRemark() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *MessageTemplateUpsertOne) SetCode(v string) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateCode() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *MessageTemplateUpsertOne) ClearCode() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MessageTemplateUpsertOne) SetName(v string) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateName() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *MessageTemplateUpsertOne) ClearName() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *MessageTemplateUpsertOne) SetCategoryID(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// AddCategoryID adds v to the "category_id" field.
+func (u *MessageTemplateUpsertOne) AddCategoryID(v uint32) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateCategoryID() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *MessageTemplateUpsertOne) ClearCategoryID() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (u *MessageTemplateUpsertOne) SetDefaultLanguage(v string) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDefaultLanguage(v)
+	})
+}
+
+// UpdateDefaultLanguage sets the "default_language" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateDefaultLanguage() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDefaultLanguage()
+	})
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (u *MessageTemplateUpsertOne) ClearDefaultLanguage() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDefaultLanguage()
+	})
+}
+
+// SetLocales sets the "locales" field.
+func (u *MessageTemplateUpsertOne) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetLocales(v)
+	})
+}
+
+// UpdateLocales sets the "locales" field to the value that was provided on create.
+func (u *MessageTemplateUpsertOne) UpdateLocales() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateLocales()
+	})
+}
+
+// ClearLocales clears the value of the "locales" field.
+func (u *MessageTemplateUpsertOne) ClearLocales() *MessageTemplateUpsertOne {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearLocales()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageTemplateUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageTemplateCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageTemplateUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *MessageTemplateUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *MessageTemplateUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// MessageTemplateCreateBulk is the builder for creating many MessageTemplate entities in bulk.
+type MessageTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*MessageTemplateCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the MessageTemplate entities in the database.
+func (_c *MessageTemplateCreateBulk) Save(ctx context.Context) ([]*MessageTemplate, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*MessageTemplate, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MessageTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MessageTemplateCreateBulk) SaveX(ctx context.Context) []*MessageTemplate {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MessageTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MessageTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.MessageTemplate.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.MessageTemplateUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *MessageTemplateCreateBulk) OnConflict(opts ...sql.ConflictOption) *MessageTemplateUpsertBulk {
+	_c.conflict = opts
+	return &MessageTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *MessageTemplateCreateBulk) OnConflictColumns(columns ...string) *MessageTemplateUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &MessageTemplateUpsertBulk{
+		create: _c,
+	}
+}
+
+// MessageTemplateUpsertBulk is the builder for "upsert"-ing
+// a bulk of MessageTemplate nodes.
+type MessageTemplateUpsertBulk struct {
+	create *MessageTemplateCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(messagetemplate.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *MessageTemplateUpsertBulk) UpdateNewValues() *MessageTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(messagetemplate.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(messagetemplate.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(messagetemplate.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.MessageTemplate.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *MessageTemplateUpsertBulk) Ignore() *MessageTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *MessageTemplateUpsertBulk) DoNothing() *MessageTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the MessageTemplateCreateBulk.OnConflict
+// documentation for more info.
+func (u *MessageTemplateUpsertBulk) Update(set func(*MessageTemplateUpsert)) *MessageTemplateUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&MessageTemplateUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *MessageTemplateUpsertBulk) SetUpdatedAt(v time.Time) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateUpdatedAt() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *MessageTemplateUpsertBulk) ClearUpdatedAt() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *MessageTemplateUpsertBulk) SetDeletedAt(v time.Time) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateDeletedAt() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *MessageTemplateUpsertBulk) ClearDeletedAt() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *MessageTemplateUpsertBulk) SetCreatedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *MessageTemplateUpsertBulk) AddCreatedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateCreatedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *MessageTemplateUpsertBulk) ClearCreatedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *MessageTemplateUpsertBulk) SetUpdatedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *MessageTemplateUpsertBulk) AddUpdatedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateUpdatedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *MessageTemplateUpsertBulk) ClearUpdatedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *MessageTemplateUpsertBulk) SetDeletedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *MessageTemplateUpsertBulk) AddDeletedBy(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateDeletedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *MessageTemplateUpsertBulk) ClearDeletedBy() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (u *MessageTemplateUpsertBulk) SetIsEnabled(v bool) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetIsEnabled(v)
+	})
+}
+
+// UpdateIsEnabled sets the "is_enabled" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateIsEnabled() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateIsEnabled()
+	})
+}
+
+// ClearIsEnabled clears the value of the "is_enabled" field.
+func (u *MessageTemplateUpsertBulk) ClearIsEnabled() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearIsEnabled()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *MessageTemplateUpsertBulk) SetRemark(v string) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateRemark() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *MessageTemplateUpsertBulk) ClearRemark() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *MessageTemplateUpsertBulk) SetCode(v string) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateCode() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *MessageTemplateUpsertBulk) ClearCode() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *MessageTemplateUpsertBulk) SetName(v string) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateName() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *MessageTemplateUpsertBulk) ClearName() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetCategoryID sets the "category_id" field.
+func (u *MessageTemplateUpsertBulk) SetCategoryID(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetCategoryID(v)
+	})
+}
+
+// AddCategoryID adds v to the "category_id" field.
+func (u *MessageTemplateUpsertBulk) AddCategoryID(v uint32) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.AddCategoryID(v)
+	})
+}
+
+// UpdateCategoryID sets the "category_id" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateCategoryID() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateCategoryID()
+	})
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (u *MessageTemplateUpsertBulk) ClearCategoryID() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearCategoryID()
+	})
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (u *MessageTemplateUpsertBulk) SetDefaultLanguage(v string) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetDefaultLanguage(v)
+	})
+}
+
+// UpdateDefaultLanguage sets the "default_language" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateDefaultLanguage() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateDefaultLanguage()
+	})
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (u *MessageTemplateUpsertBulk) ClearDefaultLanguage() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearDefaultLanguage()
+	})
+}
+
+// SetLocales sets the "locales" field.
+func (u *MessageTemplateUpsertBulk) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.SetLocales(v)
+	})
+}
+
+// UpdateLocales sets the "locales" field to the value that was provided on create.
+func (u *MessageTemplateUpsertBulk) UpdateLocales() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.UpdateLocales()
+	})
+}
+
+// ClearLocales clears the value of the "locales" field.
+func (u *MessageTemplateUpsertBulk) ClearLocales() *MessageTemplateUpsertBulk {
+	return u.Update(func(s *MessageTemplateUpsert) {
+		s.ClearLocales()
+	})
+}
+
+// Exec executes the query.
+func (u *MessageTemplateUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the MessageTemplateCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for MessageTemplateCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *MessageTemplateUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
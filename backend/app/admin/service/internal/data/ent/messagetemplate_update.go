@@ -0,0 +1,942 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MessageTemplateUpdate is the builder for updating MessageTemplate entities.
+type MessageTemplateUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *MessageTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the MessageTemplateUpdate builder.
+func (_u *MessageTemplateUpdate) Where(ps ...predicate.MessageTemplate) *MessageTemplateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageTemplateUpdate) SetUpdatedAt(v time.Time) *MessageTemplateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableUpdatedAt(v *time.Time) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MessageTemplateUpdate) ClearUpdatedAt() *MessageTemplateUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MessageTemplateUpdate) SetDeletedAt(v time.Time) *MessageTemplateUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableDeletedAt(v *time.Time) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MessageTemplateUpdate) ClearDeletedAt() *MessageTemplateUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MessageTemplateUpdate) SetCreatedBy(v uint32) *MessageTemplateUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableCreatedBy(v *uint32) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *MessageTemplateUpdate) AddCreatedBy(v int32) *MessageTemplateUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MessageTemplateUpdate) ClearCreatedBy() *MessageTemplateUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *MessageTemplateUpdate) SetUpdatedBy(v uint32) *MessageTemplateUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableUpdatedBy(v *uint32) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *MessageTemplateUpdate) AddUpdatedBy(v int32) *MessageTemplateUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *MessageTemplateUpdate) ClearUpdatedBy() *MessageTemplateUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *MessageTemplateUpdate) SetDeletedBy(v uint32) *MessageTemplateUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableDeletedBy(v *uint32) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *MessageTemplateUpdate) AddDeletedBy(v int32) *MessageTemplateUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *MessageTemplateUpdate) ClearDeletedBy() *MessageTemplateUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (_u *MessageTemplateUpdate) SetIsEnabled(v bool) *MessageTemplateUpdate {
+	_u.mutation.SetIsEnabled(v)
+	return _u
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableIsEnabled(v *bool) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetIsEnabled(*v)
+	}
+	return _u
+}
+
+// ClearIsEnabled clears the value of the "is_enabled" field.
+func (_u *MessageTemplateUpdate) ClearIsEnabled() *MessageTemplateUpdate {
+	_u.mutation.ClearIsEnabled()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *MessageTemplateUpdate) SetRemark(v string) *MessageTemplateUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableRemark(v *string) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *MessageTemplateUpdate) ClearRemark() *MessageTemplateUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *MessageTemplateUpdate) SetCode(v string) *MessageTemplateUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableCode(v *string) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *MessageTemplateUpdate) ClearCode() *MessageTemplateUpdate {
+	_u.mutation.ClearCode()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MessageTemplateUpdate) SetName(v string) *MessageTemplateUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableName(v *string) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *MessageTemplateUpdate) ClearName() *MessageTemplateUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *MessageTemplateUpdate) SetCategoryID(v uint32) *MessageTemplateUpdate {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableCategoryID(v *uint32) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *MessageTemplateUpdate) AddCategoryID(v int32) *MessageTemplateUpdate {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *MessageTemplateUpdate) ClearCategoryID() *MessageTemplateUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_u *MessageTemplateUpdate) SetDefaultLanguage(v string) *MessageTemplateUpdate {
+	_u.mutation.SetDefaultLanguage(v)
+	return _u
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_u *MessageTemplateUpdate) SetNillableDefaultLanguage(v *string) *MessageTemplateUpdate {
+	if v != nil {
+		_u.SetDefaultLanguage(*v)
+	}
+	return _u
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (_u *MessageTemplateUpdate) ClearDefaultLanguage() *MessageTemplateUpdate {
+	_u.mutation.ClearDefaultLanguage()
+	return _u
+}
+
+// SetLocales sets the "locales" field.
+func (_u *MessageTemplateUpdate) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpdate {
+	_u.mutation.SetLocales(v)
+	return _u
+}
+
+// AppendLocales appends value to the "locales" field.
+func (_u *MessageTemplateUpdate) AppendLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpdate {
+	_u.mutation.AppendLocales(v)
+	return _u
+}
+
+// ClearLocales clears the value of the "locales" field.
+func (_u *MessageTemplateUpdate) ClearLocales() *MessageTemplateUpdate {
+	_u.mutation.ClearLocales()
+	return _u
+}
+
+// Mutation returns the MessageTemplateMutation object of the builder.
+func (_u *MessageTemplateUpdate) Mutation() *MessageTemplateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MessageTemplateUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MessageTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageTemplateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageTemplateUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := messagetemplate.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MessageTemplate.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MessageTemplateUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageTemplateUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MessageTemplateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagetemplate.Table, messagetemplate.Columns, sqlgraph.NewFieldSpec(messagetemplate.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(messagetemplate.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(messagetemplate.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(messagetemplate.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(messagetemplate.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(messagetemplate.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(messagetemplate.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.IsEnabled(); ok {
+		_spec.SetField(messagetemplate.FieldIsEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.IsEnabledCleared() {
+		_spec.ClearField(messagetemplate.FieldIsEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(messagetemplate.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(messagetemplate.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(messagetemplate.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(messagetemplate.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(messagetemplate.FieldCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(messagetemplate.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(messagetemplate.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(messagetemplate.FieldCategoryID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(messagetemplate.FieldCategoryID, field.TypeUint32, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(messagetemplate.FieldCategoryID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DefaultLanguage(); ok {
+		_spec.SetField(messagetemplate.FieldDefaultLanguage, field.TypeString, value)
+	}
+	if _u.mutation.DefaultLanguageCleared() {
+		_spec.ClearField(messagetemplate.FieldDefaultLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Locales(); ok {
+		_spec.SetField(messagetemplate.FieldLocales, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLocales(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, messagetemplate.FieldLocales, value)
+		})
+	}
+	if _u.mutation.LocalesCleared() {
+		_spec.ClearField(messagetemplate.FieldLocales, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagetemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MessageTemplateUpdateOne is the builder for updating a single MessageTemplate entity.
+type MessageTemplateUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *MessageTemplateMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *MessageTemplateUpdateOne) SetUpdatedAt(v time.Time) *MessageTemplateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableUpdatedAt(v *time.Time) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *MessageTemplateUpdateOne) ClearUpdatedAt() *MessageTemplateUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *MessageTemplateUpdateOne) SetDeletedAt(v time.Time) *MessageTemplateUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableDeletedAt(v *time.Time) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *MessageTemplateUpdateOne) ClearDeletedAt() *MessageTemplateUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *MessageTemplateUpdateOne) SetCreatedBy(v uint32) *MessageTemplateUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableCreatedBy(v *uint32) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *MessageTemplateUpdateOne) AddCreatedBy(v int32) *MessageTemplateUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *MessageTemplateUpdateOne) ClearCreatedBy() *MessageTemplateUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *MessageTemplateUpdateOne) SetUpdatedBy(v uint32) *MessageTemplateUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableUpdatedBy(v *uint32) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *MessageTemplateUpdateOne) AddUpdatedBy(v int32) *MessageTemplateUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *MessageTemplateUpdateOne) ClearUpdatedBy() *MessageTemplateUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *MessageTemplateUpdateOne) SetDeletedBy(v uint32) *MessageTemplateUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableDeletedBy(v *uint32) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *MessageTemplateUpdateOne) AddDeletedBy(v int32) *MessageTemplateUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *MessageTemplateUpdateOne) ClearDeletedBy() *MessageTemplateUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (_u *MessageTemplateUpdateOne) SetIsEnabled(v bool) *MessageTemplateUpdateOne {
+	_u.mutation.SetIsEnabled(v)
+	return _u
+}
+
+// SetNillableIsEnabled sets the "is_enabled" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableIsEnabled(v *bool) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetIsEnabled(*v)
+	}
+	return _u
+}
+
+// ClearIsEnabled clears the value of the "is_enabled" field.
+func (_u *MessageTemplateUpdateOne) ClearIsEnabled() *MessageTemplateUpdateOne {
+	_u.mutation.ClearIsEnabled()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *MessageTemplateUpdateOne) SetRemark(v string) *MessageTemplateUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableRemark(v *string) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *MessageTemplateUpdateOne) ClearRemark() *MessageTemplateUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *MessageTemplateUpdateOne) SetCode(v string) *MessageTemplateUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableCode(v *string) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *MessageTemplateUpdateOne) ClearCode() *MessageTemplateUpdateOne {
+	_u.mutation.ClearCode()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *MessageTemplateUpdateOne) SetName(v string) *MessageTemplateUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableName(v *string) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *MessageTemplateUpdateOne) ClearName() *MessageTemplateUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *MessageTemplateUpdateOne) SetCategoryID(v uint32) *MessageTemplateUpdateOne {
+	_u.mutation.ResetCategoryID()
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableCategoryID(v *uint32) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// AddCategoryID adds value to the "category_id" field.
+func (_u *MessageTemplateUpdateOne) AddCategoryID(v int32) *MessageTemplateUpdateOne {
+	_u.mutation.AddCategoryID(v)
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *MessageTemplateUpdateOne) ClearCategoryID() *MessageTemplateUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetDefaultLanguage sets the "default_language" field.
+func (_u *MessageTemplateUpdateOne) SetDefaultLanguage(v string) *MessageTemplateUpdateOne {
+	_u.mutation.SetDefaultLanguage(v)
+	return _u
+}
+
+// SetNillableDefaultLanguage sets the "default_language" field if the given value is not nil.
+func (_u *MessageTemplateUpdateOne) SetNillableDefaultLanguage(v *string) *MessageTemplateUpdateOne {
+	if v != nil {
+		_u.SetDefaultLanguage(*v)
+	}
+	return _u
+}
+
+// ClearDefaultLanguage clears the value of the "default_language" field.
+func (_u *MessageTemplateUpdateOne) ClearDefaultLanguage() *MessageTemplateUpdateOne {
+	_u.mutation.ClearDefaultLanguage()
+	return _u
+}
+
+// SetLocales sets the "locales" field.
+func (_u *MessageTemplateUpdateOne) SetLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpdateOne {
+	_u.mutation.SetLocales(v)
+	return _u
+}
+
+// AppendLocales appends value to the "locales" field.
+func (_u *MessageTemplateUpdateOne) AppendLocales(v []*internalMessageV1.MessageTemplateLocale) *MessageTemplateUpdateOne {
+	_u.mutation.AppendLocales(v)
+	return _u
+}
+
+// ClearLocales clears the value of the "locales" field.
+func (_u *MessageTemplateUpdateOne) ClearLocales() *MessageTemplateUpdateOne {
+	_u.mutation.ClearLocales()
+	return _u
+}
+
+// Mutation returns the MessageTemplateMutation object of the builder.
+func (_u *MessageTemplateUpdateOne) Mutation() *MessageTemplateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the MessageTemplateUpdate builder.
+func (_u *MessageTemplateUpdateOne) Where(ps ...predicate.MessageTemplate) *MessageTemplateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MessageTemplateUpdateOne) Select(field string, fields ...string) *MessageTemplateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated MessageTemplate entity.
+func (_u *MessageTemplateUpdateOne) Save(ctx context.Context) (*MessageTemplate, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MessageTemplateUpdateOne) SaveX(ctx context.Context) *MessageTemplate {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MessageTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MessageTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MessageTemplateUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := messagetemplate.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "MessageTemplate.code": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *MessageTemplateUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *MessageTemplateUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *MessageTemplateUpdateOne) sqlSave(ctx context.Context) (_node *MessageTemplate, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(messagetemplate.Table, messagetemplate.Columns, sqlgraph.NewFieldSpec(messagetemplate.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "MessageTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, messagetemplate.FieldID)
+		for _, f := range fields {
+			if !messagetemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != messagetemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(messagetemplate.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(messagetemplate.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(messagetemplate.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(messagetemplate.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(messagetemplate.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(messagetemplate.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(messagetemplate.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(messagetemplate.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(messagetemplate.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.IsEnabled(); ok {
+		_spec.SetField(messagetemplate.FieldIsEnabled, field.TypeBool, value)
+	}
+	if _u.mutation.IsEnabledCleared() {
+		_spec.ClearField(messagetemplate.FieldIsEnabled, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(messagetemplate.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(messagetemplate.FieldRemark, field.TypeString)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(messagetemplate.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(messagetemplate.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(messagetemplate.FieldCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(messagetemplate.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(messagetemplate.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.CategoryID(); ok {
+		_spec.SetField(messagetemplate.FieldCategoryID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCategoryID(); ok {
+		_spec.AddField(messagetemplate.FieldCategoryID, field.TypeUint32, value)
+	}
+	if _u.mutation.CategoryIDCleared() {
+		_spec.ClearField(messagetemplate.FieldCategoryID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DefaultLanguage(); ok {
+		_spec.SetField(messagetemplate.FieldDefaultLanguage, field.TypeString, value)
+	}
+	if _u.mutation.DefaultLanguageCleared() {
+		_spec.ClearField(messagetemplate.FieldDefaultLanguage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Locales(); ok {
+		_spec.SetField(messagetemplate.FieldLocales, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLocales(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, messagetemplate.FieldLocales, value)
+		})
+	}
+	if _u.mutation.LocalesCleared() {
+		_spec.ClearField(messagetemplate.FieldLocales, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &MessageTemplate{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{messagetemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
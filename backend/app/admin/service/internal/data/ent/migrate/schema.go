@@ -83,6 +83,10 @@ var (
 		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "操作者用户ID"},
 		{Name: "username", Type: field.TypeString, Nullable: true, Comment: "操作者账号名"},
 		{Name: "client_ip", Type: field.TypeString, Nullable: true, Comment: "操作者IP"},
+		{Name: "impersonator_id", Type: field.TypeUint32, Nullable: true, Comment: "代管操作人的用户ID"},
+		{Name: "impersonator_name", Type: field.TypeString, Nullable: true, Comment: "代管操作人的用户名"},
+		{Name: "impersonation_reason", Type: field.TypeString, Nullable: true, Comment: "代管原因"},
+		{Name: "impersonation_audit_id", Type: field.TypeUint32, Nullable: true, Comment: "代管审计日志ID"},
 		{Name: "status_code", Type: field.TypeInt32, Nullable: true, Comment: "状态码"},
 		{Name: "reason", Type: field.TypeString, Nullable: true, Comment: "操作失败原因"},
 		{Name: "success", Type: field.TypeBool, Nullable: true, Comment: "操作成功"},
@@ -126,6 +130,82 @@ var (
 		Columns:    SysAPIResourcesColumns,
 		PrimaryKey: []*schema.Column{SysAPIResourcesColumns[0]},
 	}
+	// InternalMessageConversationsColumns holds the columns for the "internal_message_conversations" table.
+	InternalMessageConversationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "type", Type: field.TypeEnum, Nullable: true, Comment: "会话类型", Enums: []string{"DIRECT", "GROUP"}, Default: "DIRECT"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "会话名称，私聊会话为空"},
+		{Name: "avatar_url", Type: field.TypeString, Nullable: true, Comment: "会话头像"},
+		{Name: "direct_key", Type: field.TypeString, Nullable: true, Comment: "私聊会话的成员键，由两个成员的用户ID组成，保证两人之间只有一个私聊会话"},
+		{Name: "member_count", Type: field.TypeUint32, Nullable: true, Comment: "成员数量", Default: 0},
+		{Name: "last_message_id", Type: field.TypeUint32, Nullable: true, Comment: "最后一条消息ID"},
+		{Name: "last_message_at", Type: field.TypeTime, Nullable: true, Comment: "最后一条消息的时间"},
+	}
+	// InternalMessageConversationsTable holds the schema information for the "internal_message_conversations" table.
+	InternalMessageConversationsTable = &schema.Table{
+		Name:       "internal_message_conversations",
+		Comment:    "站内信会话表",
+		Columns:    InternalMessageConversationsColumns,
+		PrimaryKey: []*schema.Column{InternalMessageConversationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversation_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageConversationsColumns[7]},
+			},
+			{
+				Name:    "idx_internal_message_conversation_direct_key",
+				Unique:  true,
+				Columns: []*schema.Column{InternalMessageConversationsColumns[11]},
+			},
+		},
+	}
+	// InternalMessageConversationMembersColumns holds the columns for the "internal_message_conversation_members" table.
+	InternalMessageConversationMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "conversation_id", Type: field.TypeUint32, Nullable: true, Comment: "会话ID"},
+		{Name: "user_id", Type: field.TypeUint32, Nullable: true, Comment: "成员用户ID"},
+		{Name: "role", Type: field.TypeEnum, Nullable: true, Comment: "成员角色", Enums: []string{"MEMBER", "ADMIN", "OWNER"}, Default: "MEMBER"},
+		{Name: "muted_until", Type: field.TypeTime, Nullable: true, Comment: "禁言截止时间"},
+		{Name: "last_read_message_id", Type: field.TypeUint32, Nullable: true, Comment: "已读到的消息ID"},
+		{Name: "unread_count", Type: field.TypeUint32, Nullable: true, Comment: "未读消息数量", Default: 0},
+		{Name: "joined_at", Type: field.TypeTime, Nullable: true, Comment: "加入时间"},
+	}
+	// InternalMessageConversationMembersTable holds the schema information for the "internal_message_conversation_members" table.
+	InternalMessageConversationMembersTable = &schema.Table{
+		Name:       "internal_message_conversation_members",
+		Comment:    "站内信会话成员表",
+		Columns:    InternalMessageConversationMembersColumns,
+		PrimaryKey: []*schema.Column{InternalMessageConversationMembersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationmember_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageConversationMembersColumns[4]},
+			},
+			{
+				Name:    "idx_internal_message_conversation_member",
+				Unique:  true,
+				Columns: []*schema.Column{InternalMessageConversationMembersColumns[5], InternalMessageConversationMembersColumns[6]},
+			},
+			{
+				Name:    "idx_internal_message_conversation_member_user",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageConversationMembersColumns[6]},
+			},
+		},
+	}
 	// SysDepartmentsColumns holds the columns for the "sys_departments" table.
 	SysDepartmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -257,9 +337,9 @@ var (
 				Columns: []*schema.Column{SysDictTypesColumns[10]},
 			},
 			{
-				Name:    "idx_sys_dict_types_type_code",
+				Name:    "idx_sys_dict_types_tenant_type_code",
 				Unique:  true,
-				Columns: []*schema.Column{SysDictTypesColumns[11]},
+				Columns: []*schema.Column{SysDictTypesColumns[10], SysDictTypesColumns[11]},
 			},
 		},
 	}
@@ -316,6 +396,26 @@ var (
 		{Name: "category_id", Type: field.TypeUint32, Nullable: true, Comment: "分类ID"},
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "消息状态", Enums: []string{"DRAFT", "PUBLISHED", "SCHEDULED", "REVOKED", "ARCHIVED", "DELETED"}, Default: "DRAFT"},
 		{Name: "type", Type: field.TypeEnum, Nullable: true, Comment: "消息类型", Enums: []string{"NOTIFICATION", "PRIVATE", "GROUP"}, Default: "NOTIFICATION"},
+		{Name: "send_at", Type: field.TypeTime, Nullable: true, Comment: "定时发送时间，周期消息为下一次发送时间"},
+		{Name: "cron_spec", Type: field.TypeString, Nullable: true, Comment: "周期发送的cron表达式"},
+		{Name: "last_sent_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次发送时间"},
+		{Name: "target_all", Type: field.TypeBool, Nullable: true, Comment: "全员发送标志"},
+		{Name: "target_user_ids", Type: field.TypeJSON, Nullable: true, Comment: "接收者用户ID列表，按受众发送时为发送时解析出的受众快照"},
+		{Name: "audience", Type: field.TypeJSON, Nullable: true, Comment: "受众表达式"},
+		{Name: "delivery_status", Type: field.TypeEnum, Nullable: true, Comment: "投递状态", Enums: []string{"PENDING", "DELIVERING", "DELIVERED", "PARTIALLY_FAILED", "FAILED"}, Default: "PENDING"},
+		{Name: "recipient_total", Type: field.TypeUint32, Nullable: true, Comment: "接收者总数"},
+		{Name: "delivered_count", Type: field.TypeUint32, Nullable: true, Comment: "已投递数量"},
+		{Name: "failed_count", Type: field.TypeUint32, Nullable: true, Comment: "投递失败数量"},
+		{Name: "delivery_started_at", Type: field.TypeTime, Nullable: true, Comment: "开始投递时间"},
+		{Name: "delivery_finished_at", Type: field.TypeTime, Nullable: true, Comment: "完成投递时间"},
+		{Name: "template_code", Type: field.TypeString, Nullable: true, Comment: "消息模板编码"},
+		{Name: "template_variables", Type: field.TypeJSON, Nullable: true, Comment: "消息模板变量"},
+		{Name: "conversation_id", Type: field.TypeUint32, Nullable: true, Comment: "会话ID，私信与群聊消息所属的会话"},
+		{Name: "thread_id", Type: field.TypeUint32, Nullable: true, Comment: "话题ID，回复消息所属的根消息ID"},
+		{Name: "reply_count", Type: field.TypeUint32, Nullable: true, Comment: "回复数量", Default: 0},
+		{Name: "remind_count", Type: field.TypeUint32, Nullable: true, Comment: "提醒未读者的次数", Default: 0},
+		{Name: "last_reminded_at", Type: field.TypeTime, Nullable: true, Comment: "最近一次提醒未读者的时间"},
+		{Name: "priority", Type: field.TypeEnum, Nullable: true, Comment: "消息优先级", Enums: []string{"NORMAL", "LOW", "HIGH", "URGENT"}, Default: "NORMAL"},
 	}
 	// InternalMessagesTable holds the schema information for the "internal_messages" table.
 	InternalMessagesTable = &schema.Table{
@@ -329,6 +429,21 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{InternalMessagesColumns[7]},
 			},
+			{
+				Name:    "idx_internal_message_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessagesColumns[12], InternalMessagesColumns[14]},
+			},
+			{
+				Name:    "idx_internal_message_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessagesColumns[12], InternalMessagesColumns[1]},
+			},
+			{
+				Name:    "idx_internal_message_conversation_thread",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessagesColumns[28], InternalMessagesColumns[29]},
+			},
 		},
 	}
 	// InternalMessageCategoriesColumns holds the columns for the "internal_message_categories" table.
@@ -347,6 +462,7 @@ var (
 		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "名称"},
 		{Name: "code", Type: field.TypeString, Nullable: true, Comment: "编码"},
 		{Name: "icon_url", Type: field.TypeString, Nullable: true, Comment: "图标URL"},
+		{Name: "channels", Type: field.TypeJSON, Nullable: true, Comment: "默认通知渠道，站内信之外同时通过这些渠道发送"},
 		{Name: "parent_id", Type: field.TypeUint32, Nullable: true, Comment: "父节点ID"},
 	}
 	// InternalMessageCategoriesTable holds the schema information for the "internal_message_categories" table.
@@ -358,7 +474,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "internal_message_categories_internal_message_categories_children",
-				Columns:    []*schema.Column{InternalMessageCategoriesColumns[14]},
+				Columns:    []*schema.Column{InternalMessageCategoriesColumns[15]},
 				RefColumns: []*schema.Column{InternalMessageCategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -375,9 +491,9 @@ var (
 				Columns: []*schema.Column{InternalMessageCategoriesColumns[10]},
 			},
 			{
-				Name:    "idx_internal_message_category_code",
+				Name:    "idx_internal_message_category_tenant_code",
 				Unique:  true,
-				Columns: []*schema.Column{InternalMessageCategoriesColumns[12]},
+				Columns: []*schema.Column{InternalMessageCategoriesColumns[10], InternalMessageCategoriesColumns[12]},
 			},
 			{
 				Name:    "idx_internal_message_category_name",
@@ -386,6 +502,47 @@ var (
 			},
 		},
 	}
+	// InternalMessageDeliveriesColumns holds the columns for the "internal_message_deliveries" table.
+	InternalMessageDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "message_id", Type: field.TypeUint32, Nullable: true, Comment: "站内信内容ID"},
+		{Name: "recipient_id", Type: field.TypeUint32, Nullable: true, Comment: "收件记录ID"},
+		{Name: "recipient_user_id", Type: field.TypeUint32, Nullable: true, Comment: "接收者用户ID"},
+		{Name: "channel", Type: field.TypeString, Nullable: true, Comment: "通知渠道"},
+		{Name: "address", Type: field.TypeString, Nullable: true, Comment: "接收地址"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "投递状态", Enums: []string{"PENDING", "SENT", "FAILED", "SKIPPED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeUint32, Nullable: true, Comment: "尝试次数", Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Comment: "最后一次失败的原因"},
+		{Name: "sent_at", Type: field.TypeTime, Nullable: true, Comment: "发送成功时间"},
+	}
+	// InternalMessageDeliveriesTable holds the schema information for the "internal_message_deliveries" table.
+	InternalMessageDeliveriesTable = &schema.Table{
+		Name:       "internal_message_deliveries",
+		Comment:    "站内信消息外部渠道投递记录表",
+		Columns:    InternalMessageDeliveriesColumns,
+		PrimaryKey: []*schema.Column{InternalMessageDeliveriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "internalmessagedelivery_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageDeliveriesColumns[4]},
+			},
+			{
+				Name:    "idx_internal_message_delivery_recipient_channel",
+				Unique:  true,
+				Columns: []*schema.Column{InternalMessageDeliveriesColumns[6], InternalMessageDeliveriesColumns[8]},
+			},
+			{
+				Name:    "idx_internal_message_delivery_message_status",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageDeliveriesColumns[5], InternalMessageDeliveriesColumns[10]},
+			},
+		},
+	}
 	// InternalMessageRecipientsColumns holds the columns for the "internal_message_recipients" table.
 	InternalMessageRecipientsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -398,6 +555,8 @@ var (
 		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "消息状态", Enums: []string{"SENT", "RECEIVED", "READ", "REVOKED", "DELETED"}},
 		{Name: "received_at", Type: field.TypeTime, Nullable: true, Comment: "消息到达用户收件箱的时间"},
 		{Name: "read_at", Type: field.TypeTime, Nullable: true, Comment: "用户阅读消息的时间"},
+		{Name: "title", Type: field.TypeString, Nullable: true, Comment: "按接收者渲染的消息标题，为空时使用消息的标题"},
+		{Name: "content", Type: field.TypeString, Nullable: true, Comment: "按接收者渲染的消息内容，为空时使用消息的内容"},
 	}
 	// InternalMessageRecipientsTable holds the schema information for the "internal_message_recipients" table.
 	InternalMessageRecipientsTable = &schema.Table{
@@ -411,6 +570,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{InternalMessageRecipientsColumns[4]},
 			},
+			{
+				Name:    "idx_internal_message_recipient_message_user",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageRecipientsColumns[5], InternalMessageRecipientsColumns[6]},
+			},
 		},
 	}
 	// SysLanguagesColumns holds the columns for the "sys_languages" table.
@@ -478,6 +642,48 @@ var (
 			},
 		},
 	}
+	// InternalMessageTemplatesColumns holds the columns for the "internal_message_templates" table.
+	InternalMessageTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "is_enabled", Type: field.TypeBool, Nullable: true, Comment: "是否启用", Default: true},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Comment: "备注"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "code", Type: field.TypeString, Nullable: true, Comment: "模板编码"},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "模板名称"},
+		{Name: "category_id", Type: field.TypeUint32, Nullable: true, Comment: "消息分类ID"},
+		{Name: "default_language", Type: field.TypeString, Nullable: true, Comment: "默认语言代码，没有匹配接收者语言的版本时使用"},
+		{Name: "locales", Type: field.TypeJSON, Nullable: true, Comment: "各语言版本的标题与正文"},
+	}
+	// InternalMessageTemplatesTable holds the schema information for the "internal_message_templates" table.
+	InternalMessageTemplatesTable = &schema.Table{
+		Name:       "internal_message_templates",
+		Comment:    "站内信消息模板表",
+		Columns:    InternalMessageTemplatesColumns,
+		PrimaryKey: []*schema.Column{InternalMessageTemplatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagetemplate_is_enabled",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageTemplatesColumns[7]},
+			},
+			{
+				Name:    "messagetemplate_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{InternalMessageTemplatesColumns[9]},
+			},
+			{
+				Name:    "idx_internal_message_template_code",
+				Unique:  true,
+				Columns: []*schema.Column{InternalMessageTemplatesColumns[10]},
+			},
+		},
+	}
 	// SysOrganizationsColumns holds the columns for the "sys_organizations" table.
 	SysOrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -569,9 +775,9 @@ var (
 				Columns: []*schema.Column{SysPositionsColumns[9]},
 			},
 			{
-				Name:    "idx_sys_position_code",
+				Name:    "idx_sys_position_tenant_code",
 				Unique:  true,
-				Columns: []*schema.Column{SysPositionsColumns[11]},
+				Columns: []*schema.Column{SysPositionsColumns[9], SysPositionsColumns[11]},
 			},
 			{
 				Name:    "idx_sys_position_name",
@@ -621,14 +827,14 @@ var (
 				Columns: []*schema.Column{SysRolesColumns[9]},
 			},
 			{
-				Name:    "idx_sys_role_name",
+				Name:    "idx_sys_role_tenant_name",
 				Unique:  true,
-				Columns: []*schema.Column{SysRolesColumns[10]},
+				Columns: []*schema.Column{SysRolesColumns[9], SysRolesColumns[10]},
 			},
 			{
-				Name:    "idx_sys_role_code",
+				Name:    "idx_sys_role_tenant_code",
 				Unique:  true,
-				Columns: []*schema.Column{SysRolesColumns[11]},
+				Columns: []*schema.Column{SysRolesColumns[9], SysRolesColumns[11]},
 			},
 		},
 	}
@@ -787,6 +993,39 @@ var (
 			},
 		},
 	}
+	// SysSettingsColumns holds the columns for the "sys_settings" table.
+	SysSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "用户ID，0表示租户级（租户ID为0时为系统级）的配置", Default: 0},
+		{Name: "key", Type: field.TypeString, Comment: "配置项键名"},
+		{Name: "value", Type: field.TypeString, Size: 2147483647, Comment: "配置值，以配置项类型的规范格式保存"},
+	}
+	// SysSettingsTable holds the schema information for the "sys_settings" table.
+	SysSettingsTable = &schema.Table{
+		Name:       "sys_settings",
+		Comment:    "配置项覆盖值表",
+		Columns:    SysSettingsColumns,
+		PrimaryKey: []*schema.Column{SysSettingsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "setting_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysSettingsColumns[7]},
+			},
+			{
+				Name:    "idx_sys_settings_tenant_user_key",
+				Unique:  true,
+				Columns: []*schema.Column{SysSettingsColumns[7], SysSettingsColumns[8], SysSettingsColumns[9]},
+			},
+		},
+	}
 	// SysTasksColumns holds the columns for the "sys_tasks" table.
 	SysTasksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -824,6 +1063,61 @@ var (
 			},
 		},
 	}
+	// SysTaskRunsColumns holds the columns for the "sys_task_runs" table.
+	SysTaskRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "task_id", Type: field.TypeUint32, Nullable: true, Comment: "调度任务ID"},
+		{Name: "type_name", Type: field.TypeString, Nullable: true, Comment: "任务执行类型名"},
+		{Name: "run_id", Type: field.TypeString, Nullable: true, Comment: "队列中的任务ID"},
+		{Name: "queue", Type: field.TypeString, Nullable: true, Comment: "队列名称"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "执行状态", Enums: []string{"PENDING", "RUNNING", "SUCCEEDED", "FAILED"}, Default: "PENDING"},
+		{Name: "trigger", Type: field.TypeEnum, Nullable: true, Comment: "触发方式", Enums: []string{"SCHEDULE", "MANUAL"}, Default: "SCHEDULE"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true, Comment: "开始时间"},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true, Comment: "结束时间"},
+		{Name: "duration_ms", Type: field.TypeInt64, Nullable: true, Comment: "执行耗时（毫秒）"},
+		{Name: "retry_count", Type: field.TypeInt32, Nullable: true, Comment: "已重试次数", Default: 0},
+		{Name: "max_retry", Type: field.TypeInt32, Nullable: true, Comment: "最大重试次数"},
+		{Name: "progress", Type: field.TypeInt32, Nullable: true, Comment: "执行进度（0-100）", Default: 0},
+		{Name: "progress_message", Type: field.TypeString, Nullable: true, Comment: "进度说明"},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Comment: "错误信息"},
+		{Name: "result", Type: field.TypeString, Nullable: true, Comment: "执行结果", SchemaType: map[string]string{"mysql": "json", "postgres": "jsonb"}},
+	}
+	// SysTaskRunsTable holds the schema information for the "sys_task_runs" table.
+	SysTaskRunsTable = &schema.Table{
+		Name:       "sys_task_runs",
+		Comment:    "任务执行记录表",
+		Columns:    SysTaskRunsColumns,
+		PrimaryKey: []*schema.Column{SysTaskRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taskrun_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[7]},
+			},
+			{
+				Name:    "idx_sys_task_run_task_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[8], SysTaskRunsColumns[14]},
+			},
+			{
+				Name:    "idx_sys_task_run_run_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[10]},
+			},
+			{
+				Name:    "idx_sys_task_run_status",
+				Unique:  false,
+				Columns: []*schema.Column{SysTaskRunsColumns[12]},
+			},
+		},
+	}
 	// SysTenantsColumns holds the columns for the "sys_tenants" table.
 	SysTenantsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -845,6 +1139,7 @@ var (
 		{Name: "subscription_at", Type: field.TypeTime, Nullable: true, Comment: "订阅时间"},
 		{Name: "unsubscribe_at", Type: field.TypeTime, Nullable: true, Comment: "取消订阅时间"},
 		{Name: "subscription_plan", Type: field.TypeString, Nullable: true, Comment: "订阅套餐"},
+		{Name: "plan_id", Type: field.TypeUint32, Nullable: true, Comment: "订阅套餐ID，为空表示不限制配额"},
 		{Name: "expired_at", Type: field.TypeTime, Nullable: true, Comment: "租户有效期"},
 		{Name: "last_login_time", Type: field.TypeTime, Nullable: true, Comment: "最后一次登录的时间"},
 		{Name: "last_login_ip", Type: field.TypeString, Nullable: true, Comment: "最后一次登录的IP"},
@@ -874,10 +1169,89 @@ var (
 			{
 				Name:    "idx_sys_tenant_expired_at",
 				Unique:  false,
+				Columns: []*schema.Column{SysTenantsColumns[20]},
+			},
+			{
+				Name:    "idx_sys_tenant_plan_id",
+				Unique:  false,
 				Columns: []*schema.Column{SysTenantsColumns[19]},
 			},
 		},
 	}
+	// SysTenantPlansColumns holds the columns for the "sys_tenant_plans" table.
+	SysTenantPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "created_by", Type: field.TypeUint32, Nullable: true, Comment: "创建者ID"},
+		{Name: "updated_by", Type: field.TypeUint32, Nullable: true, Comment: "更新者ID"},
+		{Name: "deleted_by", Type: field.TypeUint32, Nullable: true, Comment: "删除者ID"},
+		{Name: "remark", Type: field.TypeString, Nullable: true, Comment: "备注"},
+		{Name: "sort_order", Type: field.TypeInt32, Nullable: true, Comment: "排序顺序，值越小越靠前", Default: 0},
+		{Name: "name", Type: field.TypeString, Nullable: true, Comment: "套餐名称"},
+		{Name: "code", Type: field.TypeString, Nullable: true, Comment: "套餐编码"},
+		{Name: "description", Type: field.TypeString, Nullable: true, Comment: "套餐描述"},
+		{Name: "status", Type: field.TypeEnum, Nullable: true, Comment: "套餐状态", Enums: []string{"ON", "OFF"}, Default: "ON"},
+		{Name: "max_users", Type: field.TypeUint32, Nullable: true, Comment: "最大用户数，0表示不限制", Default: 0},
+		{Name: "max_storage_bytes", Type: field.TypeUint64, Nullable: true, Comment: "最大存储空间（字节），0表示不限制", Default: 0},
+		{Name: "max_api_requests_per_day", Type: field.TypeUint32, Nullable: true, Comment: "每日最大API请求数，0表示不限制", Default: 0},
+		{Name: "modules", Type: field.TypeJSON, Nullable: true, Comment: "启用的模块（API服务名），为空表示不限制"},
+		{Name: "menus", Type: field.TypeJSON, Nullable: true, Comment: "启用的菜单，为空表示不限制"},
+		{Name: "features", Type: field.TypeJSON, Nullable: true, Comment: "功能开关"},
+	}
+	// SysTenantPlansTable holds the schema information for the "sys_tenant_plans" table.
+	SysTenantPlansTable = &schema.Table{
+		Name:       "sys_tenant_plans",
+		Comment:    "租户订阅套餐表",
+		Columns:    SysTenantPlansColumns,
+		PrimaryKey: []*schema.Column{SysTenantPlansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sys_tenant_plan_code",
+				Unique:  true,
+				Columns: []*schema.Column{SysTenantPlansColumns[10]},
+			},
+		},
+	}
+	// SysTenantUsagesColumns holds the columns for the "sys_tenant_usages" table.
+	SysTenantUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "period_start", Type: field.TypeTime, Comment: "统计小时的开始时间"},
+		{Name: "active_users", Type: field.TypeUint32, Comment: "活跃用户数，该小时内发起过请求的不同用户数", Default: 0},
+		{Name: "api_calls", Type: field.TypeUint64, Comment: "API调用次数", Default: 0},
+		{Name: "api_calls_by_module", Type: field.TypeJSON, Nullable: true, Comment: "按业务模块拆分的API调用次数"},
+		{Name: "storage_bytes", Type: field.TypeUint64, Comment: "文件占用的存储空间（字节），首次汇总时的快照", Default: 0},
+		{Name: "messages_sent", Type: field.TypeUint64, Comment: "发送的站内信数量，每个接收者计一条", Default: 0},
+	}
+	// SysTenantUsagesTable holds the schema information for the "sys_tenant_usages" table.
+	SysTenantUsagesTable = &schema.Table{
+		Name:       "sys_tenant_usages",
+		Comment:    "租户每小时用量表",
+		Columns:    SysTenantUsagesColumns,
+		PrimaryKey: []*schema.Column{SysTenantUsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenantusage_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysTenantUsagesColumns[4]},
+			},
+			{
+				Name:    "idx_sys_tenant_usages_tenant_period",
+				Unique:  true,
+				Columns: []*schema.Column{SysTenantUsagesColumns[4], SysTenantUsagesColumns[5]},
+			},
+			{
+				Name:    "idx_sys_tenant_usages_period",
+				Unique:  false,
+				Columns: []*schema.Column{SysTenantUsagesColumns[5]},
+			},
+		},
+	}
 	// SysUsersColumns holds the columns for the "sys_users" table.
 	SysUsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -898,6 +1272,7 @@ var (
 		{Name: "avatar", Type: field.TypeString, Nullable: true, Comment: "头像"},
 		{Name: "address", Type: field.TypeString, Nullable: true, Comment: "地址", Default: ""},
 		{Name: "region", Type: field.TypeString, Nullable: true, Comment: "国家地区", Default: ""},
+		{Name: "language", Type: field.TypeString, Nullable: true, Size: 32, Comment: "首选语言代码"},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 1023, Comment: "个人说明"},
 		{Name: "gender", Type: field.TypeEnum, Nullable: true, Comment: "性别", Enums: []string{"SECRET", "MALE", "FEMALE"}, Default: "SECRET"},
 		{Name: "authority", Type: field.TypeEnum, Comment: "授权", Enums: []string{"SYS_ADMIN", "TENANT_ADMIN", "CUSTOMER_USER", "GUEST"}, Default: "CUSTOMER_USER"},
@@ -923,9 +1298,9 @@ var (
 				Columns: []*schema.Column{SysUsersColumns[8]},
 			},
 			{
-				Name:    "idx_sys_user_username",
+				Name:    "idx_sys_user_tenant_username",
 				Unique:  true,
-				Columns: []*schema.Column{SysUsersColumns[9]},
+				Columns: []*schema.Column{SysUsersColumns[8], SysUsersColumns[9]},
 			},
 		},
 	}
@@ -975,6 +1350,11 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{SysUserCredentialsColumns[7]},
 			},
+			{
+				Name:    "idx_sys_user_credential_tenant_identity_identifier",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserCredentialsColumns[4], SysUserCredentialsColumns[6], SysUserCredentialsColumns[7]},
+			},
 			{
 				Name:    "idx_sys_user_credential_user_id",
 				Unique:  false,
@@ -997,6 +1377,39 @@ var (
 			},
 		},
 	}
+	// SysUserNotificationPreferencesColumns holds the columns for the "sys_user_notification_preferences" table.
+	SysUserNotificationPreferencesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true, Comment: "创建时间"},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true, Comment: "更新时间"},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true, Comment: "删除时间"},
+		{Name: "tenant_id", Type: field.TypeUint32, Nullable: true, Comment: "租户ID"},
+		{Name: "user_id", Type: field.TypeUint32, Comment: "用户ID"},
+		{Name: "disabled_channels", Type: field.TypeJSON, Nullable: true, Comment: "不接收的通知渠道"},
+		{Name: "category_channels", Type: field.TypeJSON, Nullable: true, Comment: "按消息分类设置的通知渠道，优先于分类的默认渠道"},
+		{Name: "muted_category_ids", Type: field.TypeJSON, Nullable: true, Comment: "静音的消息分类"},
+		{Name: "quiet_hours", Type: field.TypeJSON, Nullable: true, Comment: "免打扰时段"},
+		{Name: "digest", Type: field.TypeJSON, Nullable: true, Comment: "摘要模式"},
+	}
+	// SysUserNotificationPreferencesTable holds the schema information for the "sys_user_notification_preferences" table.
+	SysUserNotificationPreferencesTable = &schema.Table{
+		Name:       "sys_user_notification_preferences",
+		Comment:    "用户通知偏好表",
+		Columns:    SysUserNotificationPreferencesColumns,
+		PrimaryKey: []*schema.Column{SysUserNotificationPreferencesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "usernotificationpreference_tenant_id",
+				Unique:  false,
+				Columns: []*schema.Column{SysUserNotificationPreferencesColumns[4]},
+			},
+			{
+				Name:    "idx_sys_user_notification_preference_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{SysUserNotificationPreferencesColumns[5]},
+			},
+		},
+	}
 	// SysUserPositionColumns holds the columns for the "sys_user_position" table.
 	SysUserPositionColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint32, Increment: true, Comment: "id"},
@@ -1075,15 +1488,19 @@ var (
 		SysAdminLoginRestrictionsTable,
 		SysAdminOperationLogsTable,
 		SysAPIResourcesTable,
+		InternalMessageConversationsTable,
+		InternalMessageConversationMembersTable,
 		SysDepartmentsTable,
 		SysDictEntriesTable,
 		SysDictTypesTable,
 		FilesTable,
 		InternalMessagesTable,
 		InternalMessageCategoriesTable,
+		InternalMessageDeliveriesTable,
 		InternalMessageRecipientsTable,
 		SysLanguagesTable,
 		SysMenusTable,
+		InternalMessageTemplatesTable,
 		SysOrganizationsTable,
 		SysPositionsTable,
 		SysRolesTable,
@@ -1092,10 +1509,15 @@ var (
 		SysRoleMenuTable,
 		SysRoleOrgTable,
 		SysRolePositionTable,
+		SysSettingsTable,
 		SysTasksTable,
+		SysTaskRunsTable,
 		SysTenantsTable,
+		SysTenantPlansTable,
+		SysTenantUsagesTable,
 		SysUsersTable,
 		SysUserCredentialsTable,
+		SysUserNotificationPreferencesTable,
 		SysUserPositionTable,
 		SysUserRoleTable,
 	}
@@ -1122,6 +1544,16 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	InternalMessageConversationsTable.Annotation = &entsql.Annotation{
+		Table:     "internal_message_conversations",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	InternalMessageConversationMembersTable.Annotation = &entsql.Annotation{
+		Table:     "internal_message_conversation_members",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysDepartmentsTable.ForeignKeys[0].RefTable = SysDepartmentsTable
 	SysDepartmentsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_departments",
@@ -1155,6 +1587,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	InternalMessageDeliveriesTable.Annotation = &entsql.Annotation{
+		Table:     "internal_message_deliveries",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	InternalMessageRecipientsTable.Annotation = &entsql.Annotation{
 		Table:     "internal_message_recipients",
 		Charset:   "utf8mb4",
@@ -1171,6 +1608,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	InternalMessageTemplatesTable.Annotation = &entsql.Annotation{
+		Table:     "internal_message_templates",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysOrganizationsTable.ForeignKeys[0].RefTable = SysOrganizationsTable
 	SysOrganizationsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_organizations",
@@ -1214,16 +1656,36 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysSettingsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_settings",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTasksTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tasks",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysTaskRunsTable.Annotation = &entsql.Annotation{
+		Table:     "sys_task_runs",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysTenantsTable.Annotation = &entsql.Annotation{
 		Table:     "sys_tenants",
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysTenantPlansTable.Annotation = &entsql.Annotation{
+		Table:     "sys_tenant_plans",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
+	SysTenantUsagesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_tenant_usages",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysUsersTable.Annotation = &entsql.Annotation{
 		Table:     "sys_users",
 		Charset:   "utf8mb4",
@@ -1234,6 +1696,11 @@ func init() {
 		Charset:   "utf8mb4",
 		Collation: "utf8mb4_bin",
 	}
+	SysUserNotificationPreferencesTable.Annotation = &entsql.Annotation{
+		Table:     "sys_user_notification_preferences",
+		Charset:   "utf8mb4",
+		Collation: "utf8mb4_bin",
+	}
 	SysUserPositionTable.Annotation = &entsql.Annotation{
 		Table:     "sys_user_position",
 		Charset:   "utf8mb4",
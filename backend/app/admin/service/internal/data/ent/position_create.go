@@ -277,7 +277,9 @@ func (_c *PositionCreate) Mutation() *PositionMutation {
 
 // Save creates the Position in the database.
 func (_c *PositionCreate) Save(ctx context.Context) (*Position, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -304,7 +306,7 @@ func (_c *PositionCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *PositionCreate) defaults() {
+func (_c *PositionCreate) defaults() error {
 	if _, ok := _c.mutation.SortOrder(); !ok {
 		v := position.DefaultSortOrder
 		_c.mutation.SetSortOrder(v)
@@ -313,6 +315,7 @@ func (_c *PositionCreate) defaults() {
 		v := position.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
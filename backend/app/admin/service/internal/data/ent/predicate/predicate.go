@@ -18,6 +18,12 @@ type AdminOperationLog func(*sql.Selector)
 // ApiResource is the predicate function for apiresource builders.
 type ApiResource func(*sql.Selector)
 
+// Conversation is the predicate function for conversation builders.
+type Conversation func(*sql.Selector)
+
+// ConversationMember is the predicate function for conversationmember builders.
+type ConversationMember func(*sql.Selector)
+
 // Department is the predicate function for department builders.
 type Department func(*sql.Selector)
 
@@ -36,6 +42,9 @@ type InternalMessage func(*sql.Selector)
 // InternalMessageCategory is the predicate function for internalmessagecategory builders.
 type InternalMessageCategory func(*sql.Selector)
 
+// InternalMessageDelivery is the predicate function for internalmessagedelivery builders.
+type InternalMessageDelivery func(*sql.Selector)
+
 // InternalMessageRecipient is the predicate function for internalmessagerecipient builders.
 type InternalMessageRecipient func(*sql.Selector)
 
@@ -45,6 +54,9 @@ type Language func(*sql.Selector)
 // Menu is the predicate function for menu builders.
 type Menu func(*sql.Selector)
 
+// MessageTemplate is the predicate function for messagetemplate builders.
+type MessageTemplate func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
@@ -69,18 +81,33 @@ type RoleOrg func(*sql.Selector)
 // RolePosition is the predicate function for roleposition builders.
 type RolePosition func(*sql.Selector)
 
+// Setting is the predicate function for setting builders.
+type Setting func(*sql.Selector)
+
 // Task is the predicate function for task builders.
 type Task func(*sql.Selector)
 
+// TaskRun is the predicate function for taskrun builders.
+type TaskRun func(*sql.Selector)
+
 // Tenant is the predicate function for tenant builders.
 type Tenant func(*sql.Selector)
 
+// TenantPlan is the predicate function for tenantplan builders.
+type TenantPlan func(*sql.Selector)
+
+// TenantUsage is the predicate function for tenantusage builders.
+type TenantUsage func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserCredential is the predicate function for usercredential builders.
 type UserCredential func(*sql.Selector)
 
+// UserNotificationPreference is the predicate function for usernotificationpreference builders.
+type UserNotificationPreference func(*sql.Selector)
+
 // UserPosition is the predicate function for userposition builders.
 type UserPosition func(*sql.Selector)
 
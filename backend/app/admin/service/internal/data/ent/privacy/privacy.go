@@ -207,6 +207,54 @@ func (f ApiResourceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mut
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ApiResourceMutation", m)
 }
 
+// The ConversationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ConversationQueryRuleFunc func(context.Context, *ent.ConversationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ConversationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConversationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ConversationQuery", q)
+}
+
+// The ConversationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ConversationMutationRuleFunc func(context.Context, *ent.ConversationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ConversationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ConversationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ConversationMutation", m)
+}
+
+// The ConversationMemberQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ConversationMemberQueryRuleFunc func(context.Context, *ent.ConversationMemberQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ConversationMemberQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ConversationMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ConversationMemberQuery", q)
+}
+
+// The ConversationMemberMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ConversationMemberMutationRuleFunc func(context.Context, *ent.ConversationMemberMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ConversationMemberMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ConversationMemberMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ConversationMemberMutation", m)
+}
+
 // The DepartmentQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DepartmentQueryRuleFunc func(context.Context, *ent.DepartmentQuery) error
@@ -351,6 +399,30 @@ func (f InternalMessageCategoryMutationRuleFunc) EvalMutation(ctx context.Contex
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InternalMessageCategoryMutation", m)
 }
 
+// The InternalMessageDeliveryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type InternalMessageDeliveryQueryRuleFunc func(context.Context, *ent.InternalMessageDeliveryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f InternalMessageDeliveryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.InternalMessageDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.InternalMessageDeliveryQuery", q)
+}
+
+// The InternalMessageDeliveryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type InternalMessageDeliveryMutationRuleFunc func(context.Context, *ent.InternalMessageDeliveryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f InternalMessageDeliveryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.InternalMessageDeliveryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.InternalMessageDeliveryMutation", m)
+}
+
 // The InternalMessageRecipientQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type InternalMessageRecipientQueryRuleFunc func(context.Context, *ent.InternalMessageRecipientQuery) error
@@ -423,6 +495,30 @@ func (f MenuMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MenuMutation", m)
 }
 
+// The MessageTemplateQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type MessageTemplateQueryRuleFunc func(context.Context, *ent.MessageTemplateQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f MessageTemplateQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.MessageTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.MessageTemplateQuery", q)
+}
+
+// The MessageTemplateMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type MessageTemplateMutationRuleFunc func(context.Context, *ent.MessageTemplateMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f MessageTemplateMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.MessageTemplateMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.MessageTemplateMutation", m)
+}
+
 // The OrganizationQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type OrganizationQueryRuleFunc func(context.Context, *ent.OrganizationQuery) error
@@ -615,6 +711,30 @@ func (f RolePositionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mu
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RolePositionMutation", m)
 }
 
+// The SettingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type SettingQueryRuleFunc func(context.Context, *ent.SettingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f SettingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SettingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.SettingQuery", q)
+}
+
+// The SettingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type SettingMutationRuleFunc func(context.Context, *ent.SettingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f SettingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.SettingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.SettingMutation", m)
+}
+
 // The TaskQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TaskQueryRuleFunc func(context.Context, *ent.TaskQuery) error
@@ -639,6 +759,30 @@ func (f TaskMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskMutation", m)
 }
 
+// The TaskRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TaskRunQueryRuleFunc func(context.Context, *ent.TaskRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TaskRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TaskRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TaskRunQuery", q)
+}
+
+// The TaskRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TaskRunMutationRuleFunc func(context.Context, *ent.TaskRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TaskRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TaskRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TaskRunMutation", m)
+}
+
 // The TenantQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TenantQueryRuleFunc func(context.Context, *ent.TenantQuery) error
@@ -663,6 +807,54 @@ func (f TenantMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TenantMutation", m)
 }
 
+// The TenantPlanQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TenantPlanQueryRuleFunc func(context.Context, *ent.TenantPlanQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TenantPlanQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantPlanQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TenantPlanQuery", q)
+}
+
+// The TenantPlanMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TenantPlanMutationRuleFunc func(context.Context, *ent.TenantPlanMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TenantPlanMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TenantPlanMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TenantPlanMutation", m)
+}
+
+// The TenantUsageQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TenantUsageQueryRuleFunc func(context.Context, *ent.TenantUsageQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TenantUsageQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantUsageQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TenantUsageQuery", q)
+}
+
+// The TenantUsageMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TenantUsageMutationRuleFunc func(context.Context, *ent.TenantUsageMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TenantUsageMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TenantUsageMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TenantUsageMutation", m)
+}
+
 // The UserQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserQueryRuleFunc func(context.Context, *ent.UserQuery) error
@@ -711,6 +903,30 @@ func (f UserCredentialMutationRuleFunc) EvalMutation(ctx context.Context, m ent.
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserCredentialMutation", m)
 }
 
+// The UserNotificationPreferenceQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type UserNotificationPreferenceQueryRuleFunc func(context.Context, *ent.UserNotificationPreferenceQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f UserNotificationPreferenceQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserNotificationPreferenceQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.UserNotificationPreferenceQuery", q)
+}
+
+// The UserNotificationPreferenceMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type UserNotificationPreferenceMutationRuleFunc func(context.Context, *ent.UserNotificationPreferenceMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f UserNotificationPreferenceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.UserNotificationPreferenceMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserNotificationPreferenceMutation", m)
+}
+
 // The UserPositionQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type UserPositionQueryRuleFunc func(context.Context, *ent.UserPositionQuery) error
@@ -802,6 +1018,10 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.ApiResourceQuery:
 		return q.Filter(), nil
+	case *ent.ConversationQuery:
+		return q.Filter(), nil
+	case *ent.ConversationMemberQuery:
+		return q.Filter(), nil
 	case *ent.DepartmentQuery:
 		return q.Filter(), nil
 	case *ent.DictEntryQuery:
@@ -814,12 +1034,16 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.InternalMessageCategoryQuery:
 		return q.Filter(), nil
+	case *ent.InternalMessageDeliveryQuery:
+		return q.Filter(), nil
 	case *ent.InternalMessageRecipientQuery:
 		return q.Filter(), nil
 	case *ent.LanguageQuery:
 		return q.Filter(), nil
 	case *ent.MenuQuery:
 		return q.Filter(), nil
+	case *ent.MessageTemplateQuery:
+		return q.Filter(), nil
 	case *ent.OrganizationQuery:
 		return q.Filter(), nil
 	case *ent.PositionQuery:
@@ -836,14 +1060,24 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.RolePositionQuery:
 		return q.Filter(), nil
+	case *ent.SettingQuery:
+		return q.Filter(), nil
 	case *ent.TaskQuery:
 		return q.Filter(), nil
+	case *ent.TaskRunQuery:
+		return q.Filter(), nil
 	case *ent.TenantQuery:
 		return q.Filter(), nil
+	case *ent.TenantPlanQuery:
+		return q.Filter(), nil
+	case *ent.TenantUsageQuery:
+		return q.Filter(), nil
 	case *ent.UserQuery:
 		return q.Filter(), nil
 	case *ent.UserCredentialQuery:
 		return q.Filter(), nil
+	case *ent.UserNotificationPreferenceQuery:
+		return q.Filter(), nil
 	case *ent.UserPositionQuery:
 		return q.Filter(), nil
 	case *ent.UserRoleQuery:
@@ -863,6 +1097,10 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.ApiResourceMutation:
 		return m.Filter(), nil
+	case *ent.ConversationMutation:
+		return m.Filter(), nil
+	case *ent.ConversationMemberMutation:
+		return m.Filter(), nil
 	case *ent.DepartmentMutation:
 		return m.Filter(), nil
 	case *ent.DictEntryMutation:
@@ -875,12 +1113,16 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.InternalMessageCategoryMutation:
 		return m.Filter(), nil
+	case *ent.InternalMessageDeliveryMutation:
+		return m.Filter(), nil
 	case *ent.InternalMessageRecipientMutation:
 		return m.Filter(), nil
 	case *ent.LanguageMutation:
 		return m.Filter(), nil
 	case *ent.MenuMutation:
 		return m.Filter(), nil
+	case *ent.MessageTemplateMutation:
+		return m.Filter(), nil
 	case *ent.OrganizationMutation:
 		return m.Filter(), nil
 	case *ent.PositionMutation:
@@ -897,14 +1139,24 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.RolePositionMutation:
 		return m.Filter(), nil
+	case *ent.SettingMutation:
+		return m.Filter(), nil
 	case *ent.TaskMutation:
 		return m.Filter(), nil
+	case *ent.TaskRunMutation:
+		return m.Filter(), nil
 	case *ent.TenantMutation:
 		return m.Filter(), nil
+	case *ent.TenantPlanMutation:
+		return m.Filter(), nil
+	case *ent.TenantUsageMutation:
+		return m.Filter(), nil
 	case *ent.UserMutation:
 		return m.Filter(), nil
 	case *ent.UserCredentialMutation:
 		return m.Filter(), nil
+	case *ent.UserNotificationPreferenceMutation:
+		return m.Filter(), nil
 	case *ent.UserPositionMutation:
 		return m.Filter(), nil
 	case *ent.UserRoleMutation:
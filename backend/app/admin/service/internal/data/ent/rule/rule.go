@@ -2,6 +2,8 @@ package rule
 
 import (
 	"context"
+
+	"entgo.io/ent"
 	"entgo.io/ent/entql"
 
 	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
@@ -9,13 +11,36 @@ import (
 	"go-wind-admin/pkg/entgo/viewer"
 )
 
-// FilterTenantRule is a query/mutation rule that filters out entities that are not in the tenant.
-func FilterTenantRule() privacy.QueryMutationRule {
-	type TenantsFilter interface {
-		WhereTenantID(p entql.Uint32P)
-		Where(p entql.P)
-	}
+const tenantIdField = "tenant_id"
+
+type tenantsFilter interface {
+	WhereTenantID(p entql.Uint32P)
+	Where(p entql.P)
+}
+
+// AllowIfSystemRule 系统上下文与系统管理员不受租户隔离限制
+func AllowIfSystemRule() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if view := viewer.FromContext(ctx); view != nil && view.SystemAdmin() {
+			return privacy.Allow
+		}
+		return privacy.Skip
+	})
+}
+
+// DenyIfNoViewerRule 没有查看者的上下文不能访问租户数据，后台任务需要使用 viewer.NewSystemViewerContext
+func DenyIfNoViewerRule() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if viewer.FromContext(ctx) == nil {
+			return privacy.Denyf("missing viewer in context")
+		}
+		return privacy.Skip
+	})
+}
 
+// FilterTenantRule is a query/mutation rule that filters out entities that are not in the tenant.
+// shared 为 true 时查看者还可以读取平台（租户ID为0）共享的数据，只用于字典、站内信分类等显式共享的目录数据
+func FilterTenantRule(shared bool) privacy.QueryMutationRule {
 	return privacy.FilterFunc(func(ctx context.Context, f privacy.Filter) error {
 		view := viewer.FromContext(ctx)
 		if view == nil {
@@ -32,9 +57,14 @@ func FilterTenantRule() privacy.QueryMutationRule {
 			return privacy.Denyf("missing tenant information in viewer")
 		}
 
-		tf, ok := f.(TenantsFilter)
+		tf, ok := f.(tenantsFilter)
 		if !ok {
-			return privacy.Denyf("unexpected filter type %TenantId", f)
+			return privacy.Denyf("unexpected filter type %T", f)
+		}
+
+		if !shared {
+			tf.Where(entql.Uint32EQ(tid).Field(tenantIdField))
+			return privacy.Skip
 		}
 
 		tf.Where(
@@ -42,9 +72,52 @@ func FilterTenantRule() privacy.QueryMutationRule {
 				entql.Uint32EQ(tid),
 				entql.Uint32EQ(0),
 				entql.Uint32Nil(),
-			).Field("tenant_id"),
+			).Field(tenantIdField),
 		)
 
 		return privacy.Skip
 	})
 }
+
+// TenantMutationRule 创建时写入查看者的租户ID，不允许写入或清空为其他租户；
+// 更新与删除只作用于查看者所在租户的实体，租户ID为0的共享数据只能由系统管理员修改
+func TenantMutationRule() privacy.MutationRule {
+	return privacy.MutationRuleFunc(func(ctx context.Context, m ent.Mutation) error {
+		view := viewer.FromContext(ctx)
+		if view == nil || view.SystemAdmin() {
+			return privacy.Skip
+		}
+
+		tid, ok := view.Tenant()
+		if !ok {
+			return privacy.Denyf("missing tenant information in viewer")
+		}
+
+		if v, exists := m.Field(tenantIdField); exists {
+			if id, _ := v.(uint32); id != tid {
+				return privacy.Denyf("cannot write entity of tenant %v", v)
+			}
+		}
+		if m.FieldCleared(tenantIdField) {
+			return privacy.Denyf("cannot clear tenant of entity")
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			if err := m.SetField(tenantIdField, tid); err != nil {
+				return privacy.Denyf("set tenant of entity failed: %v", err)
+			}
+			return privacy.Skip
+		}
+
+		return privacy.FilterFunc(func(_ context.Context, f privacy.Filter) error {
+			tf, ok := f.(tenantsFilter)
+			if !ok {
+				return privacy.Denyf("unexpected filter type %T", f)
+			}
+
+			tf.Where(entql.Uint32EQ(tid).Field(tenantIdField))
+
+			return privacy.Skip
+		}).EvalMutation(ctx, m)
+	})
+}
@@ -2,7 +2,931 @@
 
 package runtime
 
-// The schema-stitching logic is generated in go-wind-admin/app/admin/service/internal/data/ent/runtime.go
+import (
+	"context"
+	"go-wind-admin/app/admin/service/internal/data/ent/adminloginlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/adminloginrestriction"
+	"go-wind-admin/app/admin/service/internal/data/ent/adminoperationlog"
+	"go-wind-admin/app/admin/service/internal/data/ent/apiresource"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
+	"go-wind-admin/app/admin/service/internal/data/ent/department"
+	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
+	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
+	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/language"
+	"go-wind-admin/app/admin/service/internal/data/ent/menu"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
+	"go-wind-admin/app/admin/service/internal/data/ent/organization"
+	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleapi"
+	"go-wind-admin/app/admin/service/internal/data/ent/roledept"
+	"go-wind-admin/app/admin/service/internal/data/ent/rolemenu"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleorg"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/schema"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
+	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	adminloginlogMixin := schema.AdminLoginLog{}.Mixin()
+	adminloginlogMixinFields0 := adminloginlogMixin[0].Fields()
+	_ = adminloginlogMixinFields0
+	adminloginlogFields := schema.AdminLoginLog{}.Fields()
+	_ = adminloginlogFields
+	// adminloginlogDescID is the schema descriptor for id field.
+	adminloginlogDescID := adminloginlogMixinFields0[0].Descriptor()
+	// adminloginlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	adminloginlog.IDValidator = adminloginlogDescID.Validators[0].(func(uint32) error)
+	adminloginrestrictionMixin := schema.AdminLoginRestriction{}.Mixin()
+	adminloginrestrictionMixinFields0 := adminloginrestrictionMixin[0].Fields()
+	_ = adminloginrestrictionMixinFields0
+	adminloginrestrictionFields := schema.AdminLoginRestriction{}.Fields()
+	_ = adminloginrestrictionFields
+	// adminloginrestrictionDescID is the schema descriptor for id field.
+	adminloginrestrictionDescID := adminloginrestrictionMixinFields0[0].Descriptor()
+	// adminloginrestriction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	adminloginrestriction.IDValidator = adminloginrestrictionDescID.Validators[0].(func(uint32) error)
+	adminoperationlogMixin := schema.AdminOperationLog{}.Mixin()
+	adminoperationlogMixinFields0 := adminoperationlogMixin[0].Fields()
+	_ = adminoperationlogMixinFields0
+	adminoperationlogFields := schema.AdminOperationLog{}.Fields()
+	_ = adminoperationlogFields
+	// adminoperationlogDescID is the schema descriptor for id field.
+	adminoperationlogDescID := adminoperationlogMixinFields0[0].Descriptor()
+	// adminoperationlog.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	adminoperationlog.IDValidator = adminoperationlogDescID.Validators[0].(func(uint32) error)
+	apiresourceMixin := schema.ApiResource{}.Mixin()
+	apiresourceMixinFields0 := apiresourceMixin[0].Fields()
+	_ = apiresourceMixinFields0
+	apiresourceFields := schema.ApiResource{}.Fields()
+	_ = apiresourceFields
+	// apiresourceDescID is the schema descriptor for id field.
+	apiresourceDescID := apiresourceMixinFields0[0].Descriptor()
+	// apiresource.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apiresource.IDValidator = apiresourceDescID.Validators[0].(func(uint32) error)
+	conversationMixin := schema.Conversation{}.Mixin()
+	conversation.Policy = privacy.NewPolicies(conversationMixin[4], schema.Conversation{})
+	conversation.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := conversation.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	conversationMixinHooks4 := conversationMixin[4].Hooks()
+
+	conversation.Hooks[1] = conversationMixinHooks4[0]
+	conversationMixinFields0 := conversationMixin[0].Fields()
+	_ = conversationMixinFields0
+	conversationFields := schema.Conversation{}.Fields()
+	_ = conversationFields
+	// conversationDescMemberCount is the schema descriptor for member_count field.
+	conversationDescMemberCount := conversationFields[4].Descriptor()
+	// conversation.DefaultMemberCount holds the default value on creation for the member_count field.
+	conversation.DefaultMemberCount = conversationDescMemberCount.Default.(uint32)
+	// conversationDescID is the schema descriptor for id field.
+	conversationDescID := conversationMixinFields0[0].Descriptor()
+	// conversation.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversation.IDValidator = conversationDescID.Validators[0].(func(uint32) error)
+	conversationmemberMixin := schema.ConversationMember{}.Mixin()
+	conversationmember.Policy = privacy.NewPolicies(conversationmemberMixin[3], schema.ConversationMember{})
+	conversationmember.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := conversationmember.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	conversationmemberMixinHooks3 := conversationmemberMixin[3].Hooks()
+
+	conversationmember.Hooks[1] = conversationmemberMixinHooks3[0]
+	conversationmemberMixinFields0 := conversationmemberMixin[0].Fields()
+	_ = conversationmemberMixinFields0
+	conversationmemberFields := schema.ConversationMember{}.Fields()
+	_ = conversationmemberFields
+	// conversationmemberDescUnreadCount is the schema descriptor for unread_count field.
+	conversationmemberDescUnreadCount := conversationmemberFields[5].Descriptor()
+	// conversationmember.DefaultUnreadCount holds the default value on creation for the unread_count field.
+	conversationmember.DefaultUnreadCount = conversationmemberDescUnreadCount.Default.(uint32)
+	// conversationmemberDescID is the schema descriptor for id field.
+	conversationmemberDescID := conversationmemberMixinFields0[0].Descriptor()
+	// conversationmember.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	conversationmember.IDValidator = conversationmemberDescID.Validators[0].(func(uint32) error)
+	departmentMixin := schema.Department{}.Mixin()
+	department.Policy = privacy.NewPolicies(departmentMixin[7], schema.Department{})
+	department.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := department.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	departmentMixinHooks7 := departmentMixin[7].Hooks()
+
+	department.Hooks[1] = departmentMixinHooks7[0]
+	departmentMixinFields0 := departmentMixin[0].Fields()
+	_ = departmentMixinFields0
+	departmentMixinFields3 := departmentMixin[3].Fields()
+	_ = departmentMixinFields3
+	departmentFields := schema.Department{}.Fields()
+	_ = departmentFields
+	// departmentDescSortOrder is the schema descriptor for sort_order field.
+	departmentDescSortOrder := departmentMixinFields3[0].Descriptor()
+	// department.DefaultSortOrder holds the default value on creation for the sort_order field.
+	department.DefaultSortOrder = departmentDescSortOrder.Default.(int32)
+	// departmentDescName is the schema descriptor for name field.
+	departmentDescName := departmentFields[0].Descriptor()
+	// department.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	department.NameValidator = departmentDescName.Validators[0].(func(string) error)
+	// departmentDescID is the schema descriptor for id field.
+	departmentDescID := departmentMixinFields0[0].Descriptor()
+	// department.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	department.IDValidator = departmentDescID.Validators[0].(func(uint32) error)
+	dictentryMixin := schema.DictEntry{}.Mixin()
+	dictentry.Policy = privacy.NewPolicies(dictentryMixin[7], schema.DictEntry{})
+	dictentry.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := dictentry.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	dictentryMixinHooks7 := dictentryMixin[7].Hooks()
+
+	dictentry.Hooks[1] = dictentryMixinHooks7[0]
+	dictentryMixinFields0 := dictentryMixin[0].Fields()
+	_ = dictentryMixinFields0
+	dictentryMixinFields4 := dictentryMixin[4].Fields()
+	_ = dictentryMixinFields4
+	dictentryMixinFields5 := dictentryMixin[5].Fields()
+	_ = dictentryMixinFields5
+	dictentryFields := schema.DictEntry{}.Fields()
+	_ = dictentryFields
+	// dictentryDescSortOrder is the schema descriptor for sort_order field.
+	dictentryDescSortOrder := dictentryMixinFields4[0].Descriptor()
+	// dictentry.DefaultSortOrder holds the default value on creation for the sort_order field.
+	dictentry.DefaultSortOrder = dictentryDescSortOrder.Default.(int32)
+	// dictentryDescIsEnabled is the schema descriptor for is_enabled field.
+	dictentryDescIsEnabled := dictentryMixinFields5[0].Descriptor()
+	// dictentry.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	dictentry.DefaultIsEnabled = dictentryDescIsEnabled.Default.(bool)
+	// dictentryDescEntryLabel is the schema descriptor for entry_label field.
+	dictentryDescEntryLabel := dictentryFields[0].Descriptor()
+	// dictentry.EntryLabelValidator is a validator for the "entry_label" field. It is called by the builders before save.
+	dictentry.EntryLabelValidator = dictentryDescEntryLabel.Validators[0].(func(string) error)
+	// dictentryDescEntryValue is the schema descriptor for entry_value field.
+	dictentryDescEntryValue := dictentryFields[1].Descriptor()
+	// dictentry.EntryValueValidator is a validator for the "entry_value" field. It is called by the builders before save.
+	dictentry.EntryValueValidator = dictentryDescEntryValue.Validators[0].(func(string) error)
+	// dictentryDescID is the schema descriptor for id field.
+	dictentryDescID := dictentryMixinFields0[0].Descriptor()
+	// dictentry.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dictentry.IDValidator = dictentryDescID.Validators[0].(func(uint32) error)
+	dicttypeMixin := schema.DictType{}.Mixin()
+	dicttype.Policy = privacy.NewPolicies(dicttypeMixin[7], schema.DictType{})
+	dicttype.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := dicttype.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	dicttypeMixinHooks7 := dicttypeMixin[7].Hooks()
+
+	dicttype.Hooks[1] = dicttypeMixinHooks7[0]
+	dicttypeMixinFields0 := dicttypeMixin[0].Fields()
+	_ = dicttypeMixinFields0
+	dicttypeMixinFields3 := dicttypeMixin[3].Fields()
+	_ = dicttypeMixinFields3
+	dicttypeMixinFields4 := dicttypeMixin[4].Fields()
+	_ = dicttypeMixinFields4
+	dicttypeFields := schema.DictType{}.Fields()
+	_ = dicttypeFields
+	// dicttypeDescIsEnabled is the schema descriptor for is_enabled field.
+	dicttypeDescIsEnabled := dicttypeMixinFields3[0].Descriptor()
+	// dicttype.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	dicttype.DefaultIsEnabled = dicttypeDescIsEnabled.Default.(bool)
+	// dicttypeDescSortOrder is the schema descriptor for sort_order field.
+	dicttypeDescSortOrder := dicttypeMixinFields4[0].Descriptor()
+	// dicttype.DefaultSortOrder holds the default value on creation for the sort_order field.
+	dicttype.DefaultSortOrder = dicttypeDescSortOrder.Default.(int32)
+	// dicttypeDescTypeCode is the schema descriptor for type_code field.
+	dicttypeDescTypeCode := dicttypeFields[0].Descriptor()
+	// dicttype.TypeCodeValidator is a validator for the "type_code" field. It is called by the builders before save.
+	dicttype.TypeCodeValidator = dicttypeDescTypeCode.Validators[0].(func(string) error)
+	// dicttypeDescTypeName is the schema descriptor for type_name field.
+	dicttypeDescTypeName := dicttypeFields[1].Descriptor()
+	// dicttype.TypeNameValidator is a validator for the "type_name" field. It is called by the builders before save.
+	dicttype.TypeNameValidator = dicttypeDescTypeName.Validators[0].(func(string) error)
+	// dicttypeDescID is the schema descriptor for id field.
+	dicttypeDescID := dicttypeMixinFields0[0].Descriptor()
+	// dicttype.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	dicttype.IDValidator = dicttypeDescID.Validators[0].(func(uint32) error)
+	fileMixin := schema.File{}.Mixin()
+	file.Policy = privacy.NewPolicies(fileMixin[5], schema.File{})
+	file.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := file.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	fileMixinHooks5 := fileMixin[5].Hooks()
+
+	file.Hooks[1] = fileMixinHooks5[0]
+	fileMixinFields0 := fileMixin[0].Fields()
+	_ = fileMixinFields0
+	fileFields := schema.File{}.Fields()
+	_ = fileFields
+	// fileDescID is the schema descriptor for id field.
+	fileDescID := fileMixinFields0[0].Descriptor()
+	// file.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	file.IDValidator = fileDescID.Validators[0].(func(uint32) error)
+	internalmessageMixin := schema.InternalMessage{}.Mixin()
+	internalmessage.Policy = privacy.NewPolicies(internalmessageMixin[4], schema.InternalMessage{})
+	internalmessage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := internalmessage.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	internalmessageMixinHooks4 := internalmessageMixin[4].Hooks()
+
+	internalmessage.Hooks[1] = internalmessageMixinHooks4[0]
+	internalmessageMixinFields0 := internalmessageMixin[0].Fields()
+	_ = internalmessageMixinFields0
+	internalmessageFields := schema.InternalMessage{}.Fields()
+	_ = internalmessageFields
+	// internalmessageDescReplyCount is the schema descriptor for reply_count field.
+	internalmessageDescReplyCount := internalmessageFields[22].Descriptor()
+	// internalmessage.DefaultReplyCount holds the default value on creation for the reply_count field.
+	internalmessage.DefaultReplyCount = internalmessageDescReplyCount.Default.(uint32)
+	// internalmessageDescRemindCount is the schema descriptor for remind_count field.
+	internalmessageDescRemindCount := internalmessageFields[23].Descriptor()
+	// internalmessage.DefaultRemindCount holds the default value on creation for the remind_count field.
+	internalmessage.DefaultRemindCount = internalmessageDescRemindCount.Default.(uint32)
+	// internalmessageDescID is the schema descriptor for id field.
+	internalmessageDescID := internalmessageMixinFields0[0].Descriptor()
+	// internalmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	internalmessage.IDValidator = internalmessageDescID.Validators[0].(func(uint32) error)
+	internalmessagecategoryMixin := schema.InternalMessageCategory{}.Mixin()
+	internalmessagecategory.Policy = privacy.NewPolicies(internalmessagecategoryMixin[7], schema.InternalMessageCategory{})
+	internalmessagecategory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := internalmessagecategory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	internalmessagecategoryMixinHooks7 := internalmessagecategoryMixin[7].Hooks()
+
+	internalmessagecategory.Hooks[1] = internalmessagecategoryMixinHooks7[0]
+	internalmessagecategoryMixinFields0 := internalmessagecategoryMixin[0].Fields()
+	_ = internalmessagecategoryMixinFields0
+	internalmessagecategoryMixinFields3 := internalmessagecategoryMixin[3].Fields()
+	_ = internalmessagecategoryMixinFields3
+	internalmessagecategoryMixinFields4 := internalmessagecategoryMixin[4].Fields()
+	_ = internalmessagecategoryMixinFields4
+	internalmessagecategoryFields := schema.InternalMessageCategory{}.Fields()
+	_ = internalmessagecategoryFields
+	// internalmessagecategoryDescIsEnabled is the schema descriptor for is_enabled field.
+	internalmessagecategoryDescIsEnabled := internalmessagecategoryMixinFields3[0].Descriptor()
+	// internalmessagecategory.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	internalmessagecategory.DefaultIsEnabled = internalmessagecategoryDescIsEnabled.Default.(bool)
+	// internalmessagecategoryDescSortOrder is the schema descriptor for sort_order field.
+	internalmessagecategoryDescSortOrder := internalmessagecategoryMixinFields4[0].Descriptor()
+	// internalmessagecategory.DefaultSortOrder holds the default value on creation for the sort_order field.
+	internalmessagecategory.DefaultSortOrder = internalmessagecategoryDescSortOrder.Default.(int32)
+	// internalmessagecategoryDescName is the schema descriptor for name field.
+	internalmessagecategoryDescName := internalmessagecategoryFields[0].Descriptor()
+	// internalmessagecategory.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	internalmessagecategory.NameValidator = internalmessagecategoryDescName.Validators[0].(func(string) error)
+	// internalmessagecategoryDescCode is the schema descriptor for code field.
+	internalmessagecategoryDescCode := internalmessagecategoryFields[1].Descriptor()
+	// internalmessagecategory.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	internalmessagecategory.CodeValidator = internalmessagecategoryDescCode.Validators[0].(func(string) error)
+	// internalmessagecategoryDescID is the schema descriptor for id field.
+	internalmessagecategoryDescID := internalmessagecategoryMixinFields0[0].Descriptor()
+	// internalmessagecategory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	internalmessagecategory.IDValidator = internalmessagecategoryDescID.Validators[0].(func(uint32) error)
+	internalmessagedeliveryMixin := schema.InternalMessageDelivery{}.Mixin()
+	internalmessagedelivery.Policy = privacy.NewPolicies(internalmessagedeliveryMixin[3], schema.InternalMessageDelivery{})
+	internalmessagedelivery.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := internalmessagedelivery.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	internalmessagedeliveryMixinHooks3 := internalmessagedeliveryMixin[3].Hooks()
+
+	internalmessagedelivery.Hooks[1] = internalmessagedeliveryMixinHooks3[0]
+	internalmessagedeliveryMixinFields0 := internalmessagedeliveryMixin[0].Fields()
+	_ = internalmessagedeliveryMixinFields0
+	internalmessagedeliveryFields := schema.InternalMessageDelivery{}.Fields()
+	_ = internalmessagedeliveryFields
+	// internalmessagedeliveryDescChannel is the schema descriptor for channel field.
+	internalmessagedeliveryDescChannel := internalmessagedeliveryFields[3].Descriptor()
+	// internalmessagedelivery.ChannelValidator is a validator for the "channel" field. It is called by the builders before save.
+	internalmessagedelivery.ChannelValidator = internalmessagedeliveryDescChannel.Validators[0].(func(string) error)
+	// internalmessagedeliveryDescAttempts is the schema descriptor for attempts field.
+	internalmessagedeliveryDescAttempts := internalmessagedeliveryFields[6].Descriptor()
+	// internalmessagedelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	internalmessagedelivery.DefaultAttempts = internalmessagedeliveryDescAttempts.Default.(uint32)
+	// internalmessagedeliveryDescID is the schema descriptor for id field.
+	internalmessagedeliveryDescID := internalmessagedeliveryMixinFields0[0].Descriptor()
+	// internalmessagedelivery.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	internalmessagedelivery.IDValidator = internalmessagedeliveryDescID.Validators[0].(func(uint32) error)
+	internalmessagerecipientMixin := schema.InternalMessageRecipient{}.Mixin()
+	internalmessagerecipient.Policy = privacy.NewPolicies(internalmessagerecipientMixin[3], schema.InternalMessageRecipient{})
+	internalmessagerecipient.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := internalmessagerecipient.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	internalmessagerecipientMixinHooks3 := internalmessagerecipientMixin[3].Hooks()
+
+	internalmessagerecipient.Hooks[1] = internalmessagerecipientMixinHooks3[0]
+	internalmessagerecipientMixinFields0 := internalmessagerecipientMixin[0].Fields()
+	_ = internalmessagerecipientMixinFields0
+	internalmessagerecipientFields := schema.InternalMessageRecipient{}.Fields()
+	_ = internalmessagerecipientFields
+	// internalmessagerecipientDescID is the schema descriptor for id field.
+	internalmessagerecipientDescID := internalmessagerecipientMixinFields0[0].Descriptor()
+	// internalmessagerecipient.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	internalmessagerecipient.IDValidator = internalmessagerecipientDescID.Validators[0].(func(uint32) error)
+	languageMixin := schema.Language{}.Mixin()
+	languageMixinFields0 := languageMixin[0].Fields()
+	_ = languageMixinFields0
+	languageMixinFields3 := languageMixin[3].Fields()
+	_ = languageMixinFields3
+	languageMixinFields4 := languageMixin[4].Fields()
+	_ = languageMixinFields4
+	languageFields := schema.Language{}.Fields()
+	_ = languageFields
+	// languageDescSortOrder is the schema descriptor for sort_order field.
+	languageDescSortOrder := languageMixinFields3[0].Descriptor()
+	// language.DefaultSortOrder holds the default value on creation for the sort_order field.
+	language.DefaultSortOrder = languageDescSortOrder.Default.(int32)
+	// languageDescIsEnabled is the schema descriptor for is_enabled field.
+	languageDescIsEnabled := languageMixinFields4[0].Descriptor()
+	// language.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	language.DefaultIsEnabled = languageDescIsEnabled.Default.(bool)
+	// languageDescLanguageCode is the schema descriptor for language_code field.
+	languageDescLanguageCode := languageFields[0].Descriptor()
+	// language.LanguageCodeValidator is a validator for the "language_code" field. It is called by the builders before save.
+	language.LanguageCodeValidator = languageDescLanguageCode.Validators[0].(func(string) error)
+	// languageDescLanguageName is the schema descriptor for language_name field.
+	languageDescLanguageName := languageFields[1].Descriptor()
+	// language.LanguageNameValidator is a validator for the "language_name" field. It is called by the builders before save.
+	language.LanguageNameValidator = languageDescLanguageName.Validators[0].(func(string) error)
+	// languageDescNativeName is the schema descriptor for native_name field.
+	languageDescNativeName := languageFields[2].Descriptor()
+	// language.NativeNameValidator is a validator for the "native_name" field. It is called by the builders before save.
+	language.NativeNameValidator = languageDescNativeName.Validators[0].(func(string) error)
+	// languageDescIsDefault is the schema descriptor for is_default field.
+	languageDescIsDefault := languageFields[3].Descriptor()
+	// language.DefaultIsDefault holds the default value on creation for the is_default field.
+	language.DefaultIsDefault = languageDescIsDefault.Default.(bool)
+	// languageDescID is the schema descriptor for id field.
+	languageDescID := languageMixinFields0[0].Descriptor()
+	// language.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	language.IDValidator = languageDescID.Validators[0].(func(uint32) error)
+	menuMixin := schema.Menu{}.Mixin()
+	menuMixinFields0 := menuMixin[0].Fields()
+	_ = menuMixinFields0
+	menuFields := schema.Menu{}.Fields()
+	_ = menuFields
+	// menuDescPath is the schema descriptor for path field.
+	menuDescPath := menuFields[2].Descriptor()
+	// menu.DefaultPath holds the default value on creation for the path field.
+	menu.DefaultPath = menuDescPath.Default.(string)
+	// menuDescComponent is the schema descriptor for component field.
+	menuDescComponent := menuFields[6].Descriptor()
+	// menu.DefaultComponent holds the default value on creation for the component field.
+	menu.DefaultComponent = menuDescComponent.Default.(string)
+	// menuDescID is the schema descriptor for id field.
+	menuDescID := menuMixinFields0[0].Descriptor()
+	// menu.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	menu.IDValidator = menuDescID.Validators[0].(func(uint32) error)
+	messagetemplateMixin := schema.MessageTemplate{}.Mixin()
+	messagetemplate.Policy = privacy.NewPolicies(messagetemplateMixin[6], schema.MessageTemplate{})
+	messagetemplate.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := messagetemplate.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	messagetemplateMixinHooks6 := messagetemplateMixin[6].Hooks()
+
+	messagetemplate.Hooks[1] = messagetemplateMixinHooks6[0]
+	messagetemplateMixinFields0 := messagetemplateMixin[0].Fields()
+	_ = messagetemplateMixinFields0
+	messagetemplateMixinFields3 := messagetemplateMixin[3].Fields()
+	_ = messagetemplateMixinFields3
+	messagetemplateFields := schema.MessageTemplate{}.Fields()
+	_ = messagetemplateFields
+	// messagetemplateDescIsEnabled is the schema descriptor for is_enabled field.
+	messagetemplateDescIsEnabled := messagetemplateMixinFields3[0].Descriptor()
+	// messagetemplate.DefaultIsEnabled holds the default value on creation for the is_enabled field.
+	messagetemplate.DefaultIsEnabled = messagetemplateDescIsEnabled.Default.(bool)
+	// messagetemplateDescCode is the schema descriptor for code field.
+	messagetemplateDescCode := messagetemplateFields[0].Descriptor()
+	// messagetemplate.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	messagetemplate.CodeValidator = messagetemplateDescCode.Validators[0].(func(string) error)
+	// messagetemplateDescID is the schema descriptor for id field.
+	messagetemplateDescID := messagetemplateMixinFields0[0].Descriptor()
+	// messagetemplate.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	messagetemplate.IDValidator = messagetemplateDescID.Validators[0].(func(uint32) error)
+	organizationMixin := schema.Organization{}.Mixin()
+	organization.Policy = privacy.NewPolicies(organizationMixin[7], schema.Organization{})
+	organization.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := organization.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	organizationMixinHooks7 := organizationMixin[7].Hooks()
+
+	organization.Hooks[1] = organizationMixinHooks7[0]
+	organizationMixinFields0 := organizationMixin[0].Fields()
+	_ = organizationMixinFields0
+	organizationMixinFields4 := organizationMixin[4].Fields()
+	_ = organizationMixinFields4
+	organizationFields := schema.Organization{}.Fields()
+	_ = organizationFields
+	// organizationDescSortOrder is the schema descriptor for sort_order field.
+	organizationDescSortOrder := organizationMixinFields4[0].Descriptor()
+	// organization.DefaultSortOrder holds the default value on creation for the sort_order field.
+	organization.DefaultSortOrder = organizationDescSortOrder.Default.(int32)
+	// organizationDescName is the schema descriptor for name field.
+	organizationDescName := organizationFields[0].Descriptor()
+	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescID is the schema descriptor for id field.
+	organizationDescID := organizationMixinFields0[0].Descriptor()
+	// organization.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	organization.IDValidator = organizationDescID.Validators[0].(func(uint32) error)
+	positionMixin := schema.Position{}.Mixin()
+	position.Policy = privacy.NewPolicies(positionMixin[7], schema.Position{})
+	position.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := position.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	positionMixinHooks7 := positionMixin[7].Hooks()
+
+	position.Hooks[1] = positionMixinHooks7[0]
+	positionMixinFields0 := positionMixin[0].Fields()
+	_ = positionMixinFields0
+	positionMixinFields3 := positionMixin[3].Fields()
+	_ = positionMixinFields3
+	positionFields := schema.Position{}.Fields()
+	_ = positionFields
+	// positionDescSortOrder is the schema descriptor for sort_order field.
+	positionDescSortOrder := positionMixinFields3[0].Descriptor()
+	// position.DefaultSortOrder holds the default value on creation for the sort_order field.
+	position.DefaultSortOrder = positionDescSortOrder.Default.(int32)
+	// positionDescName is the schema descriptor for name field.
+	positionDescName := positionFields[0].Descriptor()
+	// position.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	position.NameValidator = positionDescName.Validators[0].(func(string) error)
+	// positionDescCode is the schema descriptor for code field.
+	positionDescCode := positionFields[1].Descriptor()
+	// position.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	position.CodeValidator = positionDescCode.Validators[0].(func(string) error)
+	// positionDescID is the schema descriptor for id field.
+	positionDescID := positionMixinFields0[0].Descriptor()
+	// position.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	position.IDValidator = positionDescID.Validators[0].(func(uint32) error)
+	roleMixin := schema.Role{}.Mixin()
+	role.Policy = privacy.NewPolicies(roleMixin[7], schema.Role{})
+	role.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := role.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	roleMixinHooks7 := roleMixin[7].Hooks()
+
+	role.Hooks[1] = roleMixinHooks7[0]
+	roleMixinFields0 := roleMixin[0].Fields()
+	_ = roleMixinFields0
+	roleMixinFields4 := roleMixin[4].Fields()
+	_ = roleMixinFields4
+	roleFields := schema.Role{}.Fields()
+	_ = roleFields
+	// roleDescSortOrder is the schema descriptor for sort_order field.
+	roleDescSortOrder := roleMixinFields4[0].Descriptor()
+	// role.DefaultSortOrder holds the default value on creation for the sort_order field.
+	role.DefaultSortOrder = roleDescSortOrder.Default.(int32)
+	// roleDescName is the schema descriptor for name field.
+	roleDescName := roleFields[0].Descriptor()
+	// role.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	role.NameValidator = roleDescName.Validators[0].(func(string) error)
+	// roleDescCode is the schema descriptor for code field.
+	roleDescCode := roleFields[1].Descriptor()
+	// role.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	role.CodeValidator = roleDescCode.Validators[0].(func(string) error)
+	// roleDescID is the schema descriptor for id field.
+	roleDescID := roleMixinFields0[0].Descriptor()
+	// role.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	role.IDValidator = roleDescID.Validators[0].(func(uint32) error)
+	roleapiMixin := schema.RoleApi{}.Mixin()
+	roleapiMixinFields0 := roleapiMixin[0].Fields()
+	_ = roleapiMixinFields0
+	roleapiFields := schema.RoleApi{}.Fields()
+	_ = roleapiFields
+	// roleapiDescID is the schema descriptor for id field.
+	roleapiDescID := roleapiMixinFields0[0].Descriptor()
+	// roleapi.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	roleapi.IDValidator = roleapiDescID.Validators[0].(func(uint32) error)
+	roledeptMixin := schema.RoleDept{}.Mixin()
+	roledeptMixinFields0 := roledeptMixin[0].Fields()
+	_ = roledeptMixinFields0
+	roledeptFields := schema.RoleDept{}.Fields()
+	_ = roledeptFields
+	// roledeptDescID is the schema descriptor for id field.
+	roledeptDescID := roledeptMixinFields0[0].Descriptor()
+	// roledept.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	roledept.IDValidator = roledeptDescID.Validators[0].(func(uint32) error)
+	rolemenuMixin := schema.RoleMenu{}.Mixin()
+	rolemenuMixinFields0 := rolemenuMixin[0].Fields()
+	_ = rolemenuMixinFields0
+	rolemenuFields := schema.RoleMenu{}.Fields()
+	_ = rolemenuFields
+	// rolemenuDescID is the schema descriptor for id field.
+	rolemenuDescID := rolemenuMixinFields0[0].Descriptor()
+	// rolemenu.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	rolemenu.IDValidator = rolemenuDescID.Validators[0].(func(uint32) error)
+	roleorgMixin := schema.RoleOrg{}.Mixin()
+	roleorgMixinFields0 := roleorgMixin[0].Fields()
+	_ = roleorgMixinFields0
+	roleorgFields := schema.RoleOrg{}.Fields()
+	_ = roleorgFields
+	// roleorgDescID is the schema descriptor for id field.
+	roleorgDescID := roleorgMixinFields0[0].Descriptor()
+	// roleorg.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	roleorg.IDValidator = roleorgDescID.Validators[0].(func(uint32) error)
+	rolepositionMixin := schema.RolePosition{}.Mixin()
+	rolepositionMixinFields0 := rolepositionMixin[0].Fields()
+	_ = rolepositionMixinFields0
+	rolepositionFields := schema.RolePosition{}.Fields()
+	_ = rolepositionFields
+	// rolepositionDescID is the schema descriptor for id field.
+	rolepositionDescID := rolepositionMixinFields0[0].Descriptor()
+	// roleposition.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	roleposition.IDValidator = rolepositionDescID.Validators[0].(func(uint32) error)
+	settingMixin := schema.Setting{}.Mixin()
+	setting.Policy = privacy.NewPolicies(settingMixin[4], schema.Setting{})
+	setting.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := setting.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	settingMixinHooks4 := settingMixin[4].Hooks()
+
+	setting.Hooks[1] = settingMixinHooks4[0]
+	settingMixinFields0 := settingMixin[0].Fields()
+	_ = settingMixinFields0
+	settingFields := schema.Setting{}.Fields()
+	_ = settingFields
+	// settingDescUserID is the schema descriptor for user_id field.
+	settingDescUserID := settingFields[0].Descriptor()
+	// setting.DefaultUserID holds the default value on creation for the user_id field.
+	setting.DefaultUserID = settingDescUserID.Default.(uint32)
+	// settingDescKey is the schema descriptor for key field.
+	settingDescKey := settingFields[1].Descriptor()
+	// setting.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	setting.KeyValidator = settingDescKey.Validators[0].(func(string) error)
+	// settingDescID is the schema descriptor for id field.
+	settingDescID := settingMixinFields0[0].Descriptor()
+	// setting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	setting.IDValidator = settingDescID.Validators[0].(func(uint32) error)
+	taskMixin := schema.Task{}.Mixin()
+	task.Policy = privacy.NewPolicies(taskMixin[5], schema.Task{})
+	task.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := task.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskMixinHooks5 := taskMixin[5].Hooks()
+
+	task.Hooks[1] = taskMixinHooks5[0]
+	taskMixinFields0 := taskMixin[0].Fields()
+	_ = taskMixinFields0
+	taskFields := schema.Task{}.Fields()
+	_ = taskFields
+	// taskDescID is the schema descriptor for id field.
+	taskDescID := taskMixinFields0[0].Descriptor()
+	// task.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	task.IDValidator = taskDescID.Validators[0].(func(uint32) error)
+	taskrunMixin := schema.TaskRun{}.Mixin()
+	taskrun.Policy = privacy.NewPolicies(taskrunMixin[4], schema.TaskRun{})
+	taskrun.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := taskrun.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	taskrunMixinHooks4 := taskrunMixin[4].Hooks()
+
+	taskrun.Hooks[1] = taskrunMixinHooks4[0]
+	taskrunMixinFields0 := taskrunMixin[0].Fields()
+	_ = taskrunMixinFields0
+	taskrunFields := schema.TaskRun{}.Fields()
+	_ = taskrunFields
+	// taskrunDescRetryCount is the schema descriptor for retry_count field.
+	taskrunDescRetryCount := taskrunFields[9].Descriptor()
+	// taskrun.DefaultRetryCount holds the default value on creation for the retry_count field.
+	taskrun.DefaultRetryCount = taskrunDescRetryCount.Default.(int32)
+	// taskrunDescProgress is the schema descriptor for progress field.
+	taskrunDescProgress := taskrunFields[11].Descriptor()
+	// taskrun.DefaultProgress holds the default value on creation for the progress field.
+	taskrun.DefaultProgress = taskrunDescProgress.Default.(int32)
+	// taskrunDescID is the schema descriptor for id field.
+	taskrunDescID := taskrunMixinFields0[0].Descriptor()
+	// taskrun.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	taskrun.IDValidator = taskrunDescID.Validators[0].(func(uint32) error)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[0].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
+	// tenantDescCode is the schema descriptor for code field.
+	tenantDescCode := tenantFields[1].Descriptor()
+	// tenant.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	tenant.CodeValidator = tenantDescCode.Validators[0].(func(string) error)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantMixinFields0[0].Descriptor()
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = tenantDescID.Validators[0].(func(uint32) error)
+	tenantplanMixin := schema.TenantPlan{}.Mixin()
+	tenantplanMixinFields0 := tenantplanMixin[0].Fields()
+	_ = tenantplanMixinFields0
+	tenantplanMixinFields4 := tenantplanMixin[4].Fields()
+	_ = tenantplanMixinFields4
+	tenantplanFields := schema.TenantPlan{}.Fields()
+	_ = tenantplanFields
+	// tenantplanDescSortOrder is the schema descriptor for sort_order field.
+	tenantplanDescSortOrder := tenantplanMixinFields4[0].Descriptor()
+	// tenantplan.DefaultSortOrder holds the default value on creation for the sort_order field.
+	tenantplan.DefaultSortOrder = tenantplanDescSortOrder.Default.(int32)
+	// tenantplanDescName is the schema descriptor for name field.
+	tenantplanDescName := tenantplanFields[0].Descriptor()
+	// tenantplan.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenantplan.NameValidator = tenantplanDescName.Validators[0].(func(string) error)
+	// tenantplanDescCode is the schema descriptor for code field.
+	tenantplanDescCode := tenantplanFields[1].Descriptor()
+	// tenantplan.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	tenantplan.CodeValidator = tenantplanDescCode.Validators[0].(func(string) error)
+	// tenantplanDescMaxUsers is the schema descriptor for max_users field.
+	tenantplanDescMaxUsers := tenantplanFields[4].Descriptor()
+	// tenantplan.DefaultMaxUsers holds the default value on creation for the max_users field.
+	tenantplan.DefaultMaxUsers = tenantplanDescMaxUsers.Default.(uint32)
+	// tenantplanDescMaxStorageBytes is the schema descriptor for max_storage_bytes field.
+	tenantplanDescMaxStorageBytes := tenantplanFields[5].Descriptor()
+	// tenantplan.DefaultMaxStorageBytes holds the default value on creation for the max_storage_bytes field.
+	tenantplan.DefaultMaxStorageBytes = tenantplanDescMaxStorageBytes.Default.(uint64)
+	// tenantplanDescMaxAPIRequestsPerDay is the schema descriptor for max_api_requests_per_day field.
+	tenantplanDescMaxAPIRequestsPerDay := tenantplanFields[6].Descriptor()
+	// tenantplan.DefaultMaxAPIRequestsPerDay holds the default value on creation for the max_api_requests_per_day field.
+	tenantplan.DefaultMaxAPIRequestsPerDay = tenantplanDescMaxAPIRequestsPerDay.Default.(uint32)
+	// tenantplanDescID is the schema descriptor for id field.
+	tenantplanDescID := tenantplanMixinFields0[0].Descriptor()
+	// tenantplan.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantplan.IDValidator = tenantplanDescID.Validators[0].(func(uint32) error)
+	tenantusageMixin := schema.TenantUsage{}.Mixin()
+	tenantusage.Policy = privacy.NewPolicies(tenantusageMixin[3], schema.TenantUsage{})
+	tenantusage.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenantusage.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantusageMixinHooks3 := tenantusageMixin[3].Hooks()
+
+	tenantusage.Hooks[1] = tenantusageMixinHooks3[0]
+	tenantusageMixinFields0 := tenantusageMixin[0].Fields()
+	_ = tenantusageMixinFields0
+	tenantusageFields := schema.TenantUsage{}.Fields()
+	_ = tenantusageFields
+	// tenantusageDescActiveUsers is the schema descriptor for active_users field.
+	tenantusageDescActiveUsers := tenantusageFields[1].Descriptor()
+	// tenantusage.DefaultActiveUsers holds the default value on creation for the active_users field.
+	tenantusage.DefaultActiveUsers = tenantusageDescActiveUsers.Default.(uint32)
+	// tenantusageDescAPICalls is the schema descriptor for api_calls field.
+	tenantusageDescAPICalls := tenantusageFields[2].Descriptor()
+	// tenantusage.DefaultAPICalls holds the default value on creation for the api_calls field.
+	tenantusage.DefaultAPICalls = tenantusageDescAPICalls.Default.(uint64)
+	// tenantusageDescStorageBytes is the schema descriptor for storage_bytes field.
+	tenantusageDescStorageBytes := tenantusageFields[4].Descriptor()
+	// tenantusage.DefaultStorageBytes holds the default value on creation for the storage_bytes field.
+	tenantusage.DefaultStorageBytes = tenantusageDescStorageBytes.Default.(uint64)
+	// tenantusageDescMessagesSent is the schema descriptor for messages_sent field.
+	tenantusageDescMessagesSent := tenantusageFields[5].Descriptor()
+	// tenantusage.DefaultMessagesSent holds the default value on creation for the messages_sent field.
+	tenantusage.DefaultMessagesSent = tenantusageDescMessagesSent.Default.(uint64)
+	// tenantusageDescID is the schema descriptor for id field.
+	tenantusageDescID := tenantusageMixinFields0[0].Descriptor()
+	// tenantusage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenantusage.IDValidator = tenantusageDescID.Validators[0].(func(uint32) error)
+	userMixin := schema.User{}.Mixin()
+	user.Policy = privacy.NewPolicies(userMixin[5], schema.User{})
+	user.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := user.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	userMixinHooks5 := userMixin[5].Hooks()
+
+	user.Hooks[1] = userMixinHooks5[0]
+	userMixinFields0 := userMixin[0].Fields()
+	_ = userMixinFields0
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[0].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[3].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = userDescEmail.Validators[0].(func(string) error)
+	// userDescMobile is the schema descriptor for mobile field.
+	userDescMobile := userFields[4].Descriptor()
+	// user.DefaultMobile holds the default value on creation for the mobile field.
+	user.DefaultMobile = userDescMobile.Default.(string)
+	// user.MobileValidator is a validator for the "mobile" field. It is called by the builders before save.
+	user.MobileValidator = userDescMobile.Validators[0].(func(string) error)
+	// userDescTelephone is the schema descriptor for telephone field.
+	userDescTelephone := userFields[5].Descriptor()
+	// user.DefaultTelephone holds the default value on creation for the telephone field.
+	user.DefaultTelephone = userDescTelephone.Default.(string)
+	// user.TelephoneValidator is a validator for the "telephone" field. It is called by the builders before save.
+	user.TelephoneValidator = userDescTelephone.Validators[0].(func(string) error)
+	// userDescAddress is the schema descriptor for address field.
+	userDescAddress := userFields[7].Descriptor()
+	// user.DefaultAddress holds the default value on creation for the address field.
+	user.DefaultAddress = userDescAddress.Default.(string)
+	// userDescRegion is the schema descriptor for region field.
+	userDescRegion := userFields[8].Descriptor()
+	// user.DefaultRegion holds the default value on creation for the region field.
+	user.DefaultRegion = userDescRegion.Default.(string)
+	// userDescLanguage is the schema descriptor for language field.
+	userDescLanguage := userFields[9].Descriptor()
+	// user.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	user.LanguageValidator = userDescLanguage.Validators[0].(func(string) error)
+	// userDescDescription is the schema descriptor for description field.
+	userDescDescription := userFields[10].Descriptor()
+	// user.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	user.DescriptionValidator = userDescDescription.Validators[0].(func(string) error)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userMixinFields0[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(uint32) error)
+	usercredentialMixin := schema.UserCredential{}.Mixin()
+	usercredential.Policy = privacy.NewPolicies(usercredentialMixin[3], schema.UserCredential{})
+	usercredential.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := usercredential.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	usercredentialMixinHooks3 := usercredentialMixin[3].Hooks()
+
+	usercredential.Hooks[1] = usercredentialMixinHooks3[0]
+	usercredentialMixinFields1 := usercredentialMixin[1].Fields()
+	_ = usercredentialMixinFields1
+	usercredentialFields := schema.UserCredential{}.Fields()
+	_ = usercredentialFields
+	// usercredentialDescIdentifier is the schema descriptor for identifier field.
+	usercredentialDescIdentifier := usercredentialFields[2].Descriptor()
+	// usercredential.IdentifierValidator is a validator for the "identifier" field. It is called by the builders before save.
+	usercredential.IdentifierValidator = usercredentialDescIdentifier.Validators[0].(func(string) error)
+	// usercredentialDescCredential is the schema descriptor for credential field.
+	usercredentialDescCredential := usercredentialFields[4].Descriptor()
+	// usercredential.CredentialValidator is a validator for the "credential" field. It is called by the builders before save.
+	usercredential.CredentialValidator = usercredentialDescCredential.Validators[0].(func(string) error)
+	// usercredentialDescIsPrimary is the schema descriptor for is_primary field.
+	usercredentialDescIsPrimary := usercredentialFields[5].Descriptor()
+	// usercredential.DefaultIsPrimary holds the default value on creation for the is_primary field.
+	usercredential.DefaultIsPrimary = usercredentialDescIsPrimary.Default.(bool)
+	// usercredentialDescActivateTokenHash is the schema descriptor for activate_token_hash field.
+	usercredentialDescActivateTokenHash := usercredentialFields[10].Descriptor()
+	// usercredential.ActivateTokenHashValidator is a validator for the "activate_token_hash" field. It is called by the builders before save.
+	usercredential.ActivateTokenHashValidator = usercredentialDescActivateTokenHash.Validators[0].(func(string) error)
+	// usercredentialDescResetTokenHash is the schema descriptor for reset_token_hash field.
+	usercredentialDescResetTokenHash := usercredentialFields[13].Descriptor()
+	// usercredential.ResetTokenHashValidator is a validator for the "reset_token_hash" field. It is called by the builders before save.
+	usercredential.ResetTokenHashValidator = usercredentialDescResetTokenHash.Validators[0].(func(string) error)
+	// usercredentialDescID is the schema descriptor for id field.
+	usercredentialDescID := usercredentialMixinFields1[0].Descriptor()
+	// usercredential.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usercredential.IDValidator = usercredentialDescID.Validators[0].(func(uint32) error)
+	usernotificationpreferenceMixin := schema.UserNotificationPreference{}.Mixin()
+	usernotificationpreference.Policy = privacy.NewPolicies(usernotificationpreferenceMixin[3], schema.UserNotificationPreference{})
+	usernotificationpreference.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := usernotificationpreference.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	usernotificationpreferenceMixinHooks3 := usernotificationpreferenceMixin[3].Hooks()
+
+	usernotificationpreference.Hooks[1] = usernotificationpreferenceMixinHooks3[0]
+	usernotificationpreferenceMixinFields0 := usernotificationpreferenceMixin[0].Fields()
+	_ = usernotificationpreferenceMixinFields0
+	usernotificationpreferenceFields := schema.UserNotificationPreference{}.Fields()
+	_ = usernotificationpreferenceFields
+	// usernotificationpreferenceDescID is the schema descriptor for id field.
+	usernotificationpreferenceDescID := usernotificationpreferenceMixinFields0[0].Descriptor()
+	// usernotificationpreference.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	usernotificationpreference.IDValidator = usernotificationpreferenceDescID.Validators[0].(func(uint32) error)
+	userpositionMixin := schema.UserPosition{}.Mixin()
+	userpositionMixinFields0 := userpositionMixin[0].Fields()
+	_ = userpositionMixinFields0
+	userpositionFields := schema.UserPosition{}.Fields()
+	_ = userpositionFields
+	// userpositionDescID is the schema descriptor for id field.
+	userpositionDescID := userpositionMixinFields0[0].Descriptor()
+	// userposition.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userposition.IDValidator = userpositionDescID.Validators[0].(func(uint32) error)
+	userroleMixin := schema.UserRole{}.Mixin()
+	userroleMixinFields0 := userroleMixin[0].Fields()
+	_ = userroleMixinFields0
+	userroleFields := schema.UserRole{}.Fields()
+	_ = userroleFields
+	// userroleDescID is the schema descriptor for id field.
+	userroleDescID := userroleMixinFields0[0].Descriptor()
+	// userrole.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	userrole.IDValidator = userroleDescID.Validators[0].(func(uint32) error)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
@@ -93,6 +93,26 @@ func (AdminOperationLog) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Uint32("impersonator_id").
+			Comment("代管操作人的用户ID").
+			Optional().
+			Nillable(),
+
+		field.String("impersonator_name").
+			Comment("代管操作人的用户名").
+			Optional().
+			Nillable(),
+
+		field.String("impersonation_reason").
+			Comment("代管原因").
+			Optional().
+			Nillable(),
+
+		field.Uint32("impersonation_audit_id").
+			Comment("代管审计日志ID").
+			Optional().
+			Nillable(),
+
 		field.Int32("status_code").
 			Comment("状态码").
 			Optional().
@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Conversation holds the schema definition for the Conversation entity.
+type Conversation struct {
+	ent.Schema
+}
+
+func (Conversation) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "internal_message_conversations",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("站内信会话表"),
+	}
+}
+
+// Fields of the Conversation.
+func (Conversation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("type").
+			Comment("会话类型").
+			NamedValues(
+				"Direct", "DIRECT",
+				"Group", "GROUP",
+			).
+			Default("DIRECT").
+			Optional().
+			Nillable(),
+
+		field.String("name").
+			Comment("会话名称，私聊会话为空").
+			Optional().
+			Nillable(),
+
+		field.String("avatar_url").
+			Comment("会话头像").
+			Optional().
+			Nillable(),
+
+		field.String("direct_key").
+			Comment("私聊会话的成员键，由两个成员的用户ID组成，保证两人之间只有一个私聊会话").
+			Optional().
+			Nillable(),
+
+		field.Uint32("member_count").
+			Comment("成员数量").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("last_message_id").
+			Comment("最后一条消息ID").
+			Optional().
+			Nillable(),
+
+		field.Time("last_message_at").
+			Comment("最后一条消息的时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the Conversation.
+func (Conversation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the Conversation.
+func (Conversation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("direct_key").Unique().StorageKey("idx_internal_message_conversation_direct_key"),
+	}
+}
@@ -0,0 +1,92 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// ConversationMember holds the schema definition for the ConversationMember entity.
+type ConversationMember struct {
+	ent.Schema
+}
+
+func (ConversationMember) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "internal_message_conversation_members",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("站内信会话成员表"),
+	}
+}
+
+// Fields of the ConversationMember.
+func (ConversationMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("conversation_id").
+			Comment("会话ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("user_id").
+			Comment("成员用户ID").
+			Optional().
+			Nillable(),
+
+		field.Enum("role").
+			Comment("成员角色").
+			NamedValues(
+				"Member", "MEMBER",
+				"Admin", "ADMIN",
+				"Owner", "OWNER",
+			).
+			Default("MEMBER").
+			Optional().
+			Nillable(),
+
+		field.Time("muted_until").
+			Comment("禁言截止时间").
+			Optional().
+			Nillable(),
+
+		field.Uint32("last_read_message_id").
+			Comment("已读到的消息ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("unread_count").
+			Comment("未读消息数量").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Time("joined_at").
+			Comment("加入时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the ConversationMember.
+func (ConversationMember) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the ConversationMember.
+func (ConversationMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("conversation_id", "user_id").Unique().StorageKey("idx_internal_message_conversation_member"),
+		index.Fields("user_id").StorageKey("idx_internal_message_conversation_member_user"),
+	}
+}
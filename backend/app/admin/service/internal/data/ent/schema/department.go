@@ -71,6 +71,7 @@ func (Department) Mixin() []ent.Mixin {
 		mixin.Remark{},
 		mixin.Tree[Department]{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
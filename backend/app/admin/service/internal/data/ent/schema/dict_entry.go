@@ -64,6 +64,7 @@ func (DictEntry) Mixin() []ent.Mixin {
 		mixin.SortOrder{},
 		mixin.IsEnabled{},
 		mixin.TenantID{},
+		TenantPrivacy{Shared: true},
 	}
 }
 
@@ -54,15 +54,17 @@ func (DictType) Mixin() []ent.Mixin {
 		mixin.SortOrder{},
 		mixin.Description{},
 		mixin.TenantID{},
+		TenantPrivacy{Shared: true},
 	}
 }
 
 // Indexes of the DictType.
 func (DictType) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("type_code").
+		// 字典类型编码在租户内唯一，平台字典的租户ID为0
+		index.Fields("tenant_id", "type_code").
 			Unique().
-			StorageKey("idx_sys_dict_types_type_code"),
+			StorageKey("idx_sys_dict_types_tenant_type_code"),
 	}
 }
 
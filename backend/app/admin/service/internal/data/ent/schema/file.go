@@ -107,5 +107,6 @@ func (File) Mixin() []ent.Mixin {
 		mixin.OperatorID{},
 		mixin.Remark{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
@@ -5,7 +5,10 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
 )
 
 // InternalMessage holds the schema definition for the InternalMessage entity.
@@ -72,6 +75,122 @@ func (InternalMessage) Fields() []ent.Field {
 			Default("NOTIFICATION").
 			Optional().
 			Nillable(),
+
+		field.Time("send_at").
+			Comment("定时发送时间，周期消息为下一次发送时间").
+			Optional().
+			Nillable(),
+
+		field.String("cron_spec").
+			Comment("周期发送的cron表达式").
+			Optional().
+			Nillable(),
+
+		field.Time("last_sent_at").
+			Comment("最近一次发送时间").
+			Optional().
+			Nillable(),
+
+		field.Bool("target_all").
+			Comment("全员发送标志").
+			Optional().
+			Nillable(),
+
+		field.JSON("target_user_ids", []uint32{}).
+			Comment("接收者用户ID列表，按受众发送时为发送时解析出的受众快照").
+			Optional(),
+
+		internalMessageJSON(
+			field.JSON("audience", &internalMessageV1.MessageAudience{}).
+				Comment("受众表达式").
+				Optional(),
+		),
+
+		field.Enum("delivery_status").
+			Comment("投递状态").
+			NamedValues(
+				"Pending", "PENDING",
+				"Delivering", "DELIVERING",
+				"Delivered", "DELIVERED",
+				"PartiallyFailed", "PARTIALLY_FAILED",
+				"Failed", "FAILED",
+			).
+			Default("PENDING").
+			Optional().
+			Nillable(),
+
+		field.Uint32("recipient_total").
+			Comment("接收者总数").
+			Optional().
+			Nillable(),
+
+		field.Uint32("delivered_count").
+			Comment("已投递数量").
+			Optional().
+			Nillable(),
+
+		field.Uint32("failed_count").
+			Comment("投递失败数量").
+			Optional().
+			Nillable(),
+
+		field.Time("delivery_started_at").
+			Comment("开始投递时间").
+			Optional().
+			Nillable(),
+
+		field.Time("delivery_finished_at").
+			Comment("完成投递时间").
+			Optional().
+			Nillable(),
+
+		field.String("template_code").
+			Comment("消息模板编码").
+			Optional().
+			Nillable(),
+
+		field.JSON("template_variables", map[string]string{}).
+			Comment("消息模板变量").
+			Optional(),
+
+		field.Uint32("conversation_id").
+			Comment("会话ID，私信与群聊消息所属的会话").
+			Optional().
+			Nillable(),
+
+		field.Uint32("thread_id").
+			Comment("话题ID，回复消息所属的根消息ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("reply_count").
+			Comment("回复数量").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("remind_count").
+			Comment("提醒未读者的次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Time("last_reminded_at").
+			Comment("最近一次提醒未读者的时间").
+			Optional().
+			Nillable(),
+
+		field.Enum("priority").
+			Comment("消息优先级").
+			NamedValues(
+				"Normal", "NORMAL",
+				"Low", "LOW",
+				"High", "HIGH",
+				"Urgent", "URGENT",
+			).
+			Default("NORMAL").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -82,5 +201,15 @@ func (InternalMessage) Mixin() []ent.Mixin {
 		mixin.TimeAt{},
 		mixin.OperatorID{},
 		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the InternalMessage.
+func (InternalMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "send_at").StorageKey("idx_internal_message_status_send_at"),
+		index.Fields("status", "created_at").StorageKey("idx_internal_message_status_created_at"),
+		index.Fields("conversation_id", "thread_id").StorageKey("idx_internal_message_conversation_thread"),
 	}
 }
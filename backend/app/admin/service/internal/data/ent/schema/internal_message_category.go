@@ -45,6 +45,10 @@ func (InternalMessageCategory) Fields() []ent.Field {
 			Comment("图标URL").
 			Optional().
 			Nillable(),
+
+		field.JSON("channels", []string{}).
+			Comment("默认通知渠道，站内信之外同时通过这些渠道发送").
+			Optional(),
 	}
 }
 
@@ -58,6 +62,7 @@ func (InternalMessageCategory) Mixin() []ent.Mixin {
 		mixin.SortOrder{},
 		mixin.Remark{},
 		mixin.TenantID{},
+		TenantPrivacy{Shared: true},
 		mixin.Tree[InternalMessageCategory]{},
 	}
 }
@@ -65,7 +70,8 @@ func (InternalMessageCategory) Mixin() []ent.Mixin {
 // Indexes of the InternalMessageCategory.
 func (InternalMessageCategory) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("code").Unique().StorageKey("idx_internal_message_category_code"),
+		// 新建租户时会从平台复制分类，编码在租户内唯一
+		index.Fields("tenant_id", "code").Unique().StorageKey("idx_internal_message_category_tenant_code"),
 		index.Fields("name").StorageKey("idx_internal_message_category_name"),
 	}
 }
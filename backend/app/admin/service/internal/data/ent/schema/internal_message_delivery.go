@@ -0,0 +1,105 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// InternalMessageDelivery holds the schema definition for the InternalMessageDelivery entity.
+type InternalMessageDelivery struct {
+	ent.Schema
+}
+
+func (InternalMessageDelivery) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "internal_message_deliveries",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("站内信消息外部渠道投递记录表"),
+	}
+}
+
+// Fields of the InternalMessageDelivery.
+func (InternalMessageDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("message_id").
+			Comment("站内信内容ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("recipient_id").
+			Comment("收件记录ID").
+			Optional().
+			Nillable(),
+
+		field.Uint32("recipient_user_id").
+			Comment("接收者用户ID").
+			Optional().
+			Nillable(),
+
+		field.String("channel").
+			Comment("通知渠道").
+			NotEmpty().
+			Optional().
+			Nillable(),
+
+		field.String("address").
+			Comment("接收地址").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("投递状态").
+			NamedValues(
+				"Pending", "PENDING",
+				"Sent", "SENT",
+				"Failed", "FAILED",
+				"Skipped", "SKIPPED",
+			).
+			Default("PENDING").
+			Optional().
+			Nillable(),
+
+		field.Uint32("attempts").
+			Comment("尝试次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.String("last_error").
+			Comment("最后一次失败的原因").
+			Optional().
+			Nillable(),
+
+		field.Time("sent_at").
+			Comment("发送成功时间").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the InternalMessageDelivery.
+func (InternalMessageDelivery) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the InternalMessageDelivery.
+func (InternalMessageDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		// 每条收件记录在每个渠道只投递一次
+		index.Fields("recipient_id", "channel").Unique().StorageKey("idx_internal_message_delivery_recipient_channel"),
+		index.Fields("message_id", "status").StorageKey("idx_internal_message_delivery_message_status"),
+	}
+}
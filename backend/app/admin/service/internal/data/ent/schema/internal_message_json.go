@@ -0,0 +1,19 @@
+package schema
+
+import (
+	"strings"
+
+	"entgo.io/ent"
+)
+
+// internalMessagePkgName 站内信服务与后台服务生成的Go包同名（servicev1），
+// ent 生成的 mutation.go 会同时导入两者，因此站内信服务的包以别名导入
+const internalMessagePkgName = "internalMessageV1"
+
+// internalMessageJSON 包装以站内信服务的类型声明的JSON字段，使生成代码以别名引用其类型
+func internalMessageJSON(f ent.Field) ent.Field {
+	info := f.Descriptor().Info
+	info.Ident = strings.Replace(info.Ident, info.PkgName+".", internalMessagePkgName+".", 1)
+	info.PkgName = internalMessagePkgName
+	return f
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/tx7do/go-crud/entgo/mixin"
 )
 
@@ -59,6 +60,16 @@ func (InternalMessageRecipient) Fields() []ent.Field {
 			Comment("用户阅读消息的时间").
 			Optional().
 			Nillable(),
+
+		field.String("title").
+			Comment("按接收者渲染的消息标题，为空时使用消息的标题").
+			Optional().
+			Nillable(),
+
+		field.String("content").
+			Comment("按接收者渲染的消息内容，为空时使用消息的内容").
+			Optional().
+			Nillable(),
 	}
 }
 
@@ -68,5 +79,13 @@ func (InternalMessageRecipient) Mixin() []ent.Mixin {
 		mixin.AutoIncrementId{},
 		mixin.TimeAt{},
 		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the InternalMessageRecipient.
+func (InternalMessageRecipient) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "recipient_user_id").StorageKey("idx_internal_message_recipient_message_user"),
 	}
 }
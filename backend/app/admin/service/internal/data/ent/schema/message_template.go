@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+// MessageTemplate holds the schema definition for the MessageTemplate entity.
+type MessageTemplate struct {
+	ent.Schema
+}
+
+func (MessageTemplate) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "internal_message_templates",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("站内信消息模板表"),
+	}
+}
+
+// Fields of the MessageTemplate.
+func (MessageTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code").
+			Comment("模板编码").
+			NotEmpty().
+			Optional().
+			Nillable(),
+
+		field.String("name").
+			Comment("模板名称").
+			Optional().
+			Nillable(),
+
+		field.Uint32("category_id").
+			Comment("消息分类ID").
+			Optional().
+			Nillable(),
+
+		field.String("default_language").
+			Comment("默认语言代码，没有匹配接收者语言的版本时使用").
+			Optional().
+			Nillable(),
+
+		internalMessageJSON(
+			field.JSON("locales", []*internalMessageV1.MessageTemplateLocale{}).
+				Comment("各语言版本的标题与正文").
+				Optional(),
+		),
+	}
+}
+
+// Mixin of the MessageTemplate.
+func (MessageTemplate) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.IsEnabled{},
+		mixin.Remark{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the MessageTemplate.
+func (MessageTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique().StorageKey("idx_internal_message_template_code"),
+	}
+}
@@ -94,6 +94,7 @@ func (Organization) Mixin() []ent.Mixin {
 		mixin.SortOrder{},
 		mixin.Tree[Organization]{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
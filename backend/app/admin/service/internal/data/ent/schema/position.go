@@ -83,13 +83,15 @@ func (Position) Mixin() []ent.Mixin {
 		mixin.Remark{},
 		mixin.Tree[Position]{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
 // Indexes of the Position.
 func (Position) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("code").Unique().StorageKey("idx_sys_position_code"),
+		// 职位编码在租户内唯一，导入租户数据时按编码识别已存在的职位
+		index.Fields("tenant_id", "code").Unique().StorageKey("idx_sys_position_tenant_code"),
 		index.Fields("name").StorageKey("idx_sys_position_name"),
 	}
 }
@@ -85,13 +85,15 @@ func (Role) Mixin() []ent.Mixin {
 		mixin.SortOrder{},
 		mixin.Tree[Role]{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
 // Indexes of the User.
 func (Role) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("name").Unique().StorageKey("idx_sys_role_name"),
-		index.Fields("code").Unique().StorageKey("idx_sys_role_code"),
+		// 角色名称与编码在租户内唯一，平台角色的租户ID为0
+		index.Fields("tenant_id", "name").Unique().StorageKey("idx_sys_role_tenant_name"),
+		index.Fields("tenant_id", "code").Unique().StorageKey("idx_sys_role_tenant_code"),
 	}
 }
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// Setting holds the schema definition for the Setting entity.
+type Setting struct {
+	ent.Schema
+}
+
+func (Setting) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_settings",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("配置项覆盖值表"),
+	}
+}
+
+// Fields of the Setting.
+func (Setting) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("用户ID，0表示租户级（租户ID为0时为系统级）的配置").
+			Default(0),
+
+		field.String("key").
+			Comment("配置项键名").
+			NotEmpty(),
+
+		field.Text("value").
+			Comment("配置值，以配置项类型的规范格式保存"),
+	}
+}
+
+// Mixin of the Setting.
+func (Setting) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the Setting.
+func (Setting) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "user_id", "key").
+			Unique().
+			StorageKey("idx_sys_settings_tenant_user_key"),
+	}
+}
@@ -82,6 +82,7 @@ func (Task) Mixin() []ent.Mixin {
 		mixin.OperatorID{},
 		mixin.Remark{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TaskRun holds the schema definition for the TaskRun entity.
+type TaskRun struct {
+	ent.Schema
+}
+
+func (TaskRun) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_task_runs",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("任务执行记录表"),
+	}
+}
+
+// Fields of the TaskRun.
+func (TaskRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("task_id").
+			Comment("调度任务ID").
+			Optional().
+			Nillable(),
+
+		field.String("type_name").
+			Comment("任务执行类型名").
+			Optional().
+			Nillable(),
+
+		field.String("run_id").
+			Comment("队列中的任务ID").
+			Optional().
+			Nillable(),
+
+		field.String("queue").
+			Comment("队列名称").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("执行状态").
+			NamedValues(
+				"Pending", "PENDING",
+				"Running", "RUNNING",
+				"Succeeded", "SUCCEEDED",
+				"Failed", "FAILED",
+			).
+			Default("PENDING").
+			Optional().
+			Nillable(),
+
+		field.Enum("trigger").
+			Comment("触发方式").
+			NamedValues(
+				"Schedule", "SCHEDULE",
+				"Manual", "MANUAL",
+			).
+			Default("SCHEDULE").
+			Optional().
+			Nillable(),
+
+		field.Time("started_at").
+			Comment("开始时间").
+			Optional().
+			Nillable(),
+
+		field.Time("finished_at").
+			Comment("结束时间").
+			Optional().
+			Nillable(),
+
+		field.Int64("duration_ms").
+			Comment("执行耗时（毫秒）").
+			Optional().
+			Nillable(),
+
+		field.Int32("retry_count").
+			Comment("已重试次数").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Int32("max_retry").
+			Comment("最大重试次数").
+			Optional().
+			Nillable(),
+
+		field.Int32("progress").
+			Comment("执行进度（0-100）").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.String("progress_message").
+			Comment("进度说明").
+			Optional().
+			Nillable(),
+
+		field.String("error_message").
+			Comment("错误信息").
+			Optional().
+			Nillable(),
+
+		field.String("result").
+			Comment("执行结果").
+			SchemaType(map[string]string{
+				dialect.MySQL:    "json",
+				dialect.Postgres: "jsonb",
+			}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Mixin of the TaskRun.
+func (TaskRun) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the TaskRun.
+func (TaskRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("task_id", "started_at").StorageKey("idx_sys_task_run_task_id_started_at"),
+		index.Fields("run_id").StorageKey("idx_sys_task_run_run_id"),
+		index.Fields("status").StorageKey("idx_sys_task_run_status"),
+	}
+}
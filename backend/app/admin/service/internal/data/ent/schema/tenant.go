@@ -109,6 +109,11 @@ func (Tenant) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.Uint32("plan_id").
+			Comment("订阅套餐ID，为空表示不限制配额").
+			Optional().
+			Nillable(),
+
 		field.Time("expired_at").
 			Comment("租户有效期").
 			Optional().
@@ -143,6 +148,7 @@ func (Tenant) Indexes() []ent.Index {
 		index.Fields("code").Unique().StorageKey("idx_sys_tenant_code"),
 		index.Fields("status", "audit_status").StorageKey("idx_sys_tenant_status_audit_status"),
 		index.Fields("expired_at").StorageKey("idx_sys_tenant_expired_at"),
+		index.Fields("plan_id").StorageKey("idx_sys_tenant_plan_id"),
 	}
 }
 
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantPlan holds the schema definition for the TenantPlan entity.
+type TenantPlan struct {
+	ent.Schema
+}
+
+func (TenantPlan) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_tenant_plans",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("租户订阅套餐表"),
+	}
+}
+
+// Fields of the TenantPlan.
+func (TenantPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("套餐名称").
+			NotEmpty().
+			Optional().
+			Nillable(),
+
+		field.String("code").
+			Comment("套餐编码").
+			NotEmpty().
+			Optional().
+			Nillable(),
+
+		field.String("description").
+			Comment("套餐描述").
+			Optional().
+			Nillable(),
+
+		field.Enum("status").
+			Comment("套餐状态").
+			NamedValues(
+				"On", "ON",
+				"Off", "OFF",
+			).
+			Default("ON").
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_users").
+			Comment("最大用户数，0表示不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint64("max_storage_bytes").
+			Comment("最大存储空间（字节），0表示不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.Uint32("max_api_requests_per_day").
+			Comment("每日最大API请求数，0表示不限制").
+			Default(0).
+			Optional().
+			Nillable(),
+
+		field.JSON("modules", []string{}).
+			Comment("启用的模块（API服务名），为空表示不限制").
+			Optional(),
+
+		field.JSON("menus", []uint32{}).
+			Comment("启用的菜单，为空表示不限制").
+			Optional(),
+
+		field.JSON("features", map[string]bool{}).
+			Comment("功能开关").
+			Optional(),
+	}
+}
+
+// Mixin of the TenantPlan.
+func (TenantPlan) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.OperatorID{},
+		mixin.Remark{},
+		mixin.SortOrder{},
+	}
+}
+
+// Indexes of the TenantPlan.
+func (TenantPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique().StorageKey("idx_sys_tenant_plan_code"),
+	}
+}
+
+// Edges of the TenantPlan.
+func (TenantPlan) Edges() []ent.Edge {
+	return nil
+}
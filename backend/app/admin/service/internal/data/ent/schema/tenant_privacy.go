@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	entMixin "entgo.io/ent/schema/mixin"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+	"go-wind-admin/app/admin/service/internal/data/ent/rule"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+// TenantPrivacy 租户隔离的隐私策略，所有使用 mixin.TenantID 的实体都需要加入：
+// 查询只返回查看者所在租户的数据，创建时写入查看者的租户ID，更新与删除只作用于查看者所在租户的数据。
+// 系统上下文与系统管理员不受限制，没有查看者的上下文会被拒绝
+type TenantPrivacy struct {
+	entMixin.Schema
+
+	// Shared 租户可以读取平台（租户ID为0）共享的数据，但不能修改。
+	// 只有字典、站内信分类等各租户共用的目录数据才开启，用户与凭证等数据必须严格按租户隔离
+	Shared bool
+}
+
+// Policy of the TenantPrivacy.
+func (p TenantPrivacy) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{
+			rule.AllowIfSystemRule(),
+			rule.DenyIfNoViewerRule(),
+			rule.FilterTenantRule(p.Shared),
+			privacy.AlwaysAllowRule(),
+		},
+		Mutation: privacy.MutationPolicy{
+			rule.AllowIfSystemRule(),
+			rule.DenyIfNoViewerRule(),
+			rule.TenantMutationRule(),
+			privacy.AlwaysAllowRule(),
+		},
+	}
+}
+
+// Hooks of the TenantPrivacy.
+func (TenantPrivacy) Hooks() []ent.Hook {
+	return []ent.Hook{
+		defaultTenantHook,
+	}
+}
+
+// defaultTenantHook 系统上下文与系统管理员创建数据时未指定租户ID，则归属平台（租户ID为0）。
+// 租户内唯一索引包含租户ID，数据库不会对租户ID为空的数据校验唯一性
+func defaultTenantHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if !m.Op().Is(ent.OpCreate) {
+			return next.Mutate(ctx, m)
+		}
+
+		if view := viewer.FromContext(ctx); view == nil || !view.SystemAdmin() {
+			return next.Mutate(ctx, m)
+		}
+
+		if _, exists := m.Field("tenant_id"); !exists {
+			if err := m.SetField("tenant_id", uint32(0)); err != nil {
+				return nil, err
+			}
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
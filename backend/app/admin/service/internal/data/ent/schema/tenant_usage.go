@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+)
+
+// TenantUsage holds the schema definition for the TenantUsage entity.
+type TenantUsage struct {
+	ent.Schema
+}
+
+func (TenantUsage) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_tenant_usages",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("租户每小时用量表"),
+	}
+}
+
+// Fields of the TenantUsage.
+func (TenantUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time("period_start").
+			Comment("统计小时的开始时间"),
+
+		field.Uint32("active_users").
+			Comment("活跃用户数，该小时内发起过请求的不同用户数").
+			Default(0),
+
+		field.Uint64("api_calls").
+			Comment("API调用次数").
+			Default(0),
+
+		field.JSON("api_calls_by_module", map[string]uint64{}).
+			Comment("按业务模块拆分的API调用次数").
+			Optional(),
+
+		field.Uint64("storage_bytes").
+			Comment("文件占用的存储空间（字节），首次汇总时的快照").
+			Default(0),
+
+		field.Uint64("messages_sent").
+			Comment("发送的站内信数量，每个接收者计一条").
+			Default(0),
+	}
+}
+
+// Mixin of the TenantUsage.
+func (TenantUsage) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the TenantUsage.
+func (TenantUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("tenant_id", "period_start").
+			Unique().
+			StorageKey("idx_sys_tenant_usages_tenant_period"),
+		index.Fields("period_start").
+			StorageKey("idx_sys_tenant_usages_period"),
+	}
+}
@@ -84,6 +84,12 @@ func (User) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 
+		field.String("language").
+			Comment("首选语言代码").
+			MaxLen(32).
+			Optional().
+			Nillable(),
+
 		field.String("description").
 			Comment("个人说明").
 			MaxLen(1023).
@@ -172,6 +178,7 @@ func (User) Mixin() []ent.Mixin {
 		mixin.TimeAt{},
 		mixin.Remark{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
@@ -183,6 +190,7 @@ func (User) Edges() []ent.Edge {
 // Indexes of the User.
 func (User) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("username").Unique().StorageKey("idx_sys_user_username"),
+		// 用户名在租户内唯一，平台用户的租户ID为0
+		index.Fields("tenant_id", "username").Unique().StorageKey("idx_sys_user_tenant_username"),
 	}
 }
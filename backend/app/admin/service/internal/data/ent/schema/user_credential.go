@@ -187,6 +187,7 @@ func (UserCredential) Mixin() []ent.Mixin {
 		mixin.TimeAt{},
 		mixin.AutoIncrementId{},
 		mixin.TenantID{},
+		TenantPrivacy{},
 	}
 }
 
@@ -201,6 +202,8 @@ func (UserCredential) Indexes() []ent.Index {
 		// 组合唯一索引：注意 identifier 可能为 NULL，视数据库行为（Postgres 多个 NULL 允许）考虑在迁移层创建 partial unique index。
 		index.Fields("user_id", "identity_type", "identifier").Unique().StorageKey("idx_sys_user_credential_uid_identity_identifier"),
 		index.Fields("identifier").StorageKey("idx_sys_user_credential_identifier"),
+		// 登录时按租户、身份类型与标识符查找凭证
+		index.Fields("tenant_id", "identity_type", "identifier").StorageKey("idx_sys_user_credential_tenant_identity_identifier"),
 		index.Fields("user_id").StorageKey("idx_sys_user_credential_user_id"),
 
 		// 联合唯一索引：确保同一第三方平台账号不重复
@@ -0,0 +1,81 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/tx7do/go-crud/entgo/mixin"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+// UserNotificationPreference holds the schema definition for the UserNotificationPreference entity.
+type UserNotificationPreference struct {
+	ent.Schema
+}
+
+func (UserNotificationPreference) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{
+			Table:     "sys_user_notification_preferences",
+			Charset:   "utf8mb4",
+			Collation: "utf8mb4_bin",
+		},
+		entsql.WithComments(true),
+		schema.Comment("用户通知偏好表"),
+	}
+}
+
+// Fields of the UserNotificationPreference.
+func (UserNotificationPreference) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint32("user_id").
+			Comment("用户ID").
+			Nillable(),
+
+		field.JSON("disabled_channels", []string{}).
+			Comment("不接收的通知渠道").
+			Optional(),
+
+		internalMessageJSON(
+			field.JSON("category_channels", []*internalMessageV1.CategoryChannels{}).
+				Comment("按消息分类设置的通知渠道，优先于分类的默认渠道").
+				Optional(),
+		),
+
+		field.JSON("muted_category_ids", []uint32{}).
+			Comment("静音的消息分类").
+			Optional(),
+
+		internalMessageJSON(
+			field.JSON("quiet_hours", &internalMessageV1.QuietHours{}).
+				Comment("免打扰时段").
+				Optional(),
+		),
+
+		internalMessageJSON(
+			field.JSON("digest", &internalMessageV1.DigestSettings{}).
+				Comment("摘要模式").
+				Optional(),
+		),
+	}
+}
+
+// Mixin of the UserNotificationPreference.
+func (UserNotificationPreference) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.AutoIncrementId{},
+		mixin.TimeAt{},
+		mixin.TenantID{},
+		TenantPrivacy{},
+	}
+}
+
+// Indexes of the UserNotificationPreference.
+func (UserNotificationPreference) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id").Unique().StorageKey("idx_sys_user_notification_preference_user_id"),
+	}
+}
@@ -0,0 +1,1380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taskrun
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TaskID applies equality check predicate on the "task_id" field. It's identical to TaskIDEQ.
+func TaskID(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TypeName applies equality check predicate on the "type_name" field. It's identical to TypeNameEQ.
+func TypeName(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRunID, v))
+}
+
+// Queue applies equality check predicate on the "queue" field. It's identical to QueueEQ.
+func Queue(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DurationMs applies equality check predicate on the "duration_ms" field. It's identical to DurationMsEQ.
+func DurationMs(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// RetryCount applies equality check predicate on the "retry_count" field. It's identical to RetryCountEQ.
+func RetryCount(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// MaxRetry applies equality check predicate on the "max_retry" field. It's identical to MaxRetryEQ.
+func MaxRetry(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// Progress applies equality check predicate on the "progress" field. It's identical to ProgressEQ.
+func Progress(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgress, v))
+}
+
+// ProgressMessage applies equality check predicate on the "progress_message" field. It's identical to ProgressMessageEQ.
+func ProgressMessage(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgressMessage, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// Result applies equality check predicate on the "result" field. It's identical to ResultEQ.
+func Result(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTenantID))
+}
+
+// TaskIDEQ applies the EQ predicate on the "task_id" field.
+func TaskIDEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTaskID, v))
+}
+
+// TaskIDNEQ applies the NEQ predicate on the "task_id" field.
+func TaskIDNEQ(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTaskID, v))
+}
+
+// TaskIDIn applies the In predicate on the "task_id" field.
+func TaskIDIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTaskID, vs...))
+}
+
+// TaskIDNotIn applies the NotIn predicate on the "task_id" field.
+func TaskIDNotIn(vs ...uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTaskID, vs...))
+}
+
+// TaskIDGT applies the GT predicate on the "task_id" field.
+func TaskIDGT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTaskID, v))
+}
+
+// TaskIDGTE applies the GTE predicate on the "task_id" field.
+func TaskIDGTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTaskID, v))
+}
+
+// TaskIDLT applies the LT predicate on the "task_id" field.
+func TaskIDLT(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTaskID, v))
+}
+
+// TaskIDLTE applies the LTE predicate on the "task_id" field.
+func TaskIDLTE(v uint32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTaskID, v))
+}
+
+// TaskIDIsNil applies the IsNil predicate on the "task_id" field.
+func TaskIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTaskID))
+}
+
+// TaskIDNotNil applies the NotNil predicate on the "task_id" field.
+func TaskIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTaskID))
+}
+
+// TypeNameEQ applies the EQ predicate on the "type_name" field.
+func TypeNameEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTypeName, v))
+}
+
+// TypeNameNEQ applies the NEQ predicate on the "type_name" field.
+func TypeNameNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTypeName, v))
+}
+
+// TypeNameIn applies the In predicate on the "type_name" field.
+func TypeNameIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTypeName, vs...))
+}
+
+// TypeNameNotIn applies the NotIn predicate on the "type_name" field.
+func TypeNameNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTypeName, vs...))
+}
+
+// TypeNameGT applies the GT predicate on the "type_name" field.
+func TypeNameGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldTypeName, v))
+}
+
+// TypeNameGTE applies the GTE predicate on the "type_name" field.
+func TypeNameGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldTypeName, v))
+}
+
+// TypeNameLT applies the LT predicate on the "type_name" field.
+func TypeNameLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldTypeName, v))
+}
+
+// TypeNameLTE applies the LTE predicate on the "type_name" field.
+func TypeNameLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldTypeName, v))
+}
+
+// TypeNameContains applies the Contains predicate on the "type_name" field.
+func TypeNameContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldTypeName, v))
+}
+
+// TypeNameHasPrefix applies the HasPrefix predicate on the "type_name" field.
+func TypeNameHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldTypeName, v))
+}
+
+// TypeNameHasSuffix applies the HasSuffix predicate on the "type_name" field.
+func TypeNameHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldTypeName, v))
+}
+
+// TypeNameIsNil applies the IsNil predicate on the "type_name" field.
+func TypeNameIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTypeName))
+}
+
+// TypeNameNotNil applies the NotNil predicate on the "type_name" field.
+func TypeNameNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTypeName))
+}
+
+// TypeNameEqualFold applies the EqualFold predicate on the "type_name" field.
+func TypeNameEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldTypeName, v))
+}
+
+// TypeNameContainsFold applies the ContainsFold predicate on the "type_name" field.
+func TypeNameContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldTypeName, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// RunIDGT applies the GT predicate on the "run_id" field.
+func RunIDGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldRunID, v))
+}
+
+// RunIDGTE applies the GTE predicate on the "run_id" field.
+func RunIDGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldRunID, v))
+}
+
+// RunIDLT applies the LT predicate on the "run_id" field.
+func RunIDLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldRunID, v))
+}
+
+// RunIDLTE applies the LTE predicate on the "run_id" field.
+func RunIDLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldRunID, v))
+}
+
+// RunIDContains applies the Contains predicate on the "run_id" field.
+func RunIDContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldRunID, v))
+}
+
+// RunIDHasPrefix applies the HasPrefix predicate on the "run_id" field.
+func RunIDHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldRunID, v))
+}
+
+// RunIDHasSuffix applies the HasSuffix predicate on the "run_id" field.
+func RunIDHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldRunID, v))
+}
+
+// RunIDIsNil applies the IsNil predicate on the "run_id" field.
+func RunIDIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldRunID))
+}
+
+// RunIDNotNil applies the NotNil predicate on the "run_id" field.
+func RunIDNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldRunID))
+}
+
+// RunIDEqualFold applies the EqualFold predicate on the "run_id" field.
+func RunIDEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldRunID, v))
+}
+
+// RunIDContainsFold applies the ContainsFold predicate on the "run_id" field.
+func RunIDContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldRunID, v))
+}
+
+// QueueEQ applies the EQ predicate on the "queue" field.
+func QueueEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldQueue, v))
+}
+
+// QueueNEQ applies the NEQ predicate on the "queue" field.
+func QueueNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldQueue, v))
+}
+
+// QueueIn applies the In predicate on the "queue" field.
+func QueueIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldQueue, vs...))
+}
+
+// QueueNotIn applies the NotIn predicate on the "queue" field.
+func QueueNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldQueue, vs...))
+}
+
+// QueueGT applies the GT predicate on the "queue" field.
+func QueueGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldQueue, v))
+}
+
+// QueueGTE applies the GTE predicate on the "queue" field.
+func QueueGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldQueue, v))
+}
+
+// QueueLT applies the LT predicate on the "queue" field.
+func QueueLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldQueue, v))
+}
+
+// QueueLTE applies the LTE predicate on the "queue" field.
+func QueueLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldQueue, v))
+}
+
+// QueueContains applies the Contains predicate on the "queue" field.
+func QueueContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldQueue, v))
+}
+
+// QueueHasPrefix applies the HasPrefix predicate on the "queue" field.
+func QueueHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldQueue, v))
+}
+
+// QueueHasSuffix applies the HasSuffix predicate on the "queue" field.
+func QueueHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldQueue, v))
+}
+
+// QueueIsNil applies the IsNil predicate on the "queue" field.
+func QueueIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldQueue))
+}
+
+// QueueNotNil applies the NotNil predicate on the "queue" field.
+func QueueNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldQueue))
+}
+
+// QueueEqualFold applies the EqualFold predicate on the "queue" field.
+func QueueEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldQueue, v))
+}
+
+// QueueContainsFold applies the ContainsFold predicate on the "queue" field.
+func QueueContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldQueue, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStatus))
+}
+
+// TriggerEQ applies the EQ predicate on the "trigger" field.
+func TriggerEQ(v Trigger) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldTrigger, v))
+}
+
+// TriggerNEQ applies the NEQ predicate on the "trigger" field.
+func TriggerNEQ(v Trigger) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldTrigger, v))
+}
+
+// TriggerIn applies the In predicate on the "trigger" field.
+func TriggerIn(vs ...Trigger) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldTrigger, vs...))
+}
+
+// TriggerNotIn applies the NotIn predicate on the "trigger" field.
+func TriggerNotIn(vs ...Trigger) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldTrigger, vs...))
+}
+
+// TriggerIsNil applies the IsNil predicate on the "trigger" field.
+func TriggerIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldTrigger))
+}
+
+// TriggerNotNil applies the NotNil predicate on the "trigger" field.
+func TriggerNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldTrigger))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// DurationMsEQ applies the EQ predicate on the "duration_ms" field.
+func DurationMsEQ(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldDurationMs, v))
+}
+
+// DurationMsNEQ applies the NEQ predicate on the "duration_ms" field.
+func DurationMsNEQ(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldDurationMs, v))
+}
+
+// DurationMsIn applies the In predicate on the "duration_ms" field.
+func DurationMsIn(vs ...int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldDurationMs, vs...))
+}
+
+// DurationMsNotIn applies the NotIn predicate on the "duration_ms" field.
+func DurationMsNotIn(vs ...int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldDurationMs, vs...))
+}
+
+// DurationMsGT applies the GT predicate on the "duration_ms" field.
+func DurationMsGT(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldDurationMs, v))
+}
+
+// DurationMsGTE applies the GTE predicate on the "duration_ms" field.
+func DurationMsGTE(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldDurationMs, v))
+}
+
+// DurationMsLT applies the LT predicate on the "duration_ms" field.
+func DurationMsLT(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldDurationMs, v))
+}
+
+// DurationMsLTE applies the LTE predicate on the "duration_ms" field.
+func DurationMsLTE(v int64) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldDurationMs, v))
+}
+
+// DurationMsIsNil applies the IsNil predicate on the "duration_ms" field.
+func DurationMsIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldDurationMs))
+}
+
+// DurationMsNotNil applies the NotNil predicate on the "duration_ms" field.
+func DurationMsNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldDurationMs))
+}
+
+// RetryCountEQ applies the EQ predicate on the "retry_count" field.
+func RetryCountEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldRetryCount, v))
+}
+
+// RetryCountNEQ applies the NEQ predicate on the "retry_count" field.
+func RetryCountNEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldRetryCount, v))
+}
+
+// RetryCountIn applies the In predicate on the "retry_count" field.
+func RetryCountIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldRetryCount, vs...))
+}
+
+// RetryCountNotIn applies the NotIn predicate on the "retry_count" field.
+func RetryCountNotIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldRetryCount, vs...))
+}
+
+// RetryCountGT applies the GT predicate on the "retry_count" field.
+func RetryCountGT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldRetryCount, v))
+}
+
+// RetryCountGTE applies the GTE predicate on the "retry_count" field.
+func RetryCountGTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldRetryCount, v))
+}
+
+// RetryCountLT applies the LT predicate on the "retry_count" field.
+func RetryCountLT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldRetryCount, v))
+}
+
+// RetryCountLTE applies the LTE predicate on the "retry_count" field.
+func RetryCountLTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldRetryCount, v))
+}
+
+// RetryCountIsNil applies the IsNil predicate on the "retry_count" field.
+func RetryCountIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldRetryCount))
+}
+
+// RetryCountNotNil applies the NotNil predicate on the "retry_count" field.
+func RetryCountNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldRetryCount))
+}
+
+// MaxRetryEQ applies the EQ predicate on the "max_retry" field.
+func MaxRetryEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryNEQ applies the NEQ predicate on the "max_retry" field.
+func MaxRetryNEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldMaxRetry, v))
+}
+
+// MaxRetryIn applies the In predicate on the "max_retry" field.
+func MaxRetryIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryNotIn applies the NotIn predicate on the "max_retry" field.
+func MaxRetryNotIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldMaxRetry, vs...))
+}
+
+// MaxRetryGT applies the GT predicate on the "max_retry" field.
+func MaxRetryGT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldMaxRetry, v))
+}
+
+// MaxRetryGTE applies the GTE predicate on the "max_retry" field.
+func MaxRetryGTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldMaxRetry, v))
+}
+
+// MaxRetryLT applies the LT predicate on the "max_retry" field.
+func MaxRetryLT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldMaxRetry, v))
+}
+
+// MaxRetryLTE applies the LTE predicate on the "max_retry" field.
+func MaxRetryLTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldMaxRetry, v))
+}
+
+// MaxRetryIsNil applies the IsNil predicate on the "max_retry" field.
+func MaxRetryIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldMaxRetry))
+}
+
+// MaxRetryNotNil applies the NotNil predicate on the "max_retry" field.
+func MaxRetryNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldMaxRetry))
+}
+
+// ProgressEQ applies the EQ predicate on the "progress" field.
+func ProgressEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgress, v))
+}
+
+// ProgressNEQ applies the NEQ predicate on the "progress" field.
+func ProgressNEQ(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldProgress, v))
+}
+
+// ProgressIn applies the In predicate on the "progress" field.
+func ProgressIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldProgress, vs...))
+}
+
+// ProgressNotIn applies the NotIn predicate on the "progress" field.
+func ProgressNotIn(vs ...int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldProgress, vs...))
+}
+
+// ProgressGT applies the GT predicate on the "progress" field.
+func ProgressGT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldProgress, v))
+}
+
+// ProgressGTE applies the GTE predicate on the "progress" field.
+func ProgressGTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldProgress, v))
+}
+
+// ProgressLT applies the LT predicate on the "progress" field.
+func ProgressLT(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldProgress, v))
+}
+
+// ProgressLTE applies the LTE predicate on the "progress" field.
+func ProgressLTE(v int32) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldProgress, v))
+}
+
+// ProgressIsNil applies the IsNil predicate on the "progress" field.
+func ProgressIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldProgress))
+}
+
+// ProgressNotNil applies the NotNil predicate on the "progress" field.
+func ProgressNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldProgress))
+}
+
+// ProgressMessageEQ applies the EQ predicate on the "progress_message" field.
+func ProgressMessageEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldProgressMessage, v))
+}
+
+// ProgressMessageNEQ applies the NEQ predicate on the "progress_message" field.
+func ProgressMessageNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldProgressMessage, v))
+}
+
+// ProgressMessageIn applies the In predicate on the "progress_message" field.
+func ProgressMessageIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldProgressMessage, vs...))
+}
+
+// ProgressMessageNotIn applies the NotIn predicate on the "progress_message" field.
+func ProgressMessageNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldProgressMessage, vs...))
+}
+
+// ProgressMessageGT applies the GT predicate on the "progress_message" field.
+func ProgressMessageGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldProgressMessage, v))
+}
+
+// ProgressMessageGTE applies the GTE predicate on the "progress_message" field.
+func ProgressMessageGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldProgressMessage, v))
+}
+
+// ProgressMessageLT applies the LT predicate on the "progress_message" field.
+func ProgressMessageLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldProgressMessage, v))
+}
+
+// ProgressMessageLTE applies the LTE predicate on the "progress_message" field.
+func ProgressMessageLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldProgressMessage, v))
+}
+
+// ProgressMessageContains applies the Contains predicate on the "progress_message" field.
+func ProgressMessageContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldProgressMessage, v))
+}
+
+// ProgressMessageHasPrefix applies the HasPrefix predicate on the "progress_message" field.
+func ProgressMessageHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldProgressMessage, v))
+}
+
+// ProgressMessageHasSuffix applies the HasSuffix predicate on the "progress_message" field.
+func ProgressMessageHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldProgressMessage, v))
+}
+
+// ProgressMessageIsNil applies the IsNil predicate on the "progress_message" field.
+func ProgressMessageIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldProgressMessage))
+}
+
+// ProgressMessageNotNil applies the NotNil predicate on the "progress_message" field.
+func ProgressMessageNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldProgressMessage))
+}
+
+// ProgressMessageEqualFold applies the EqualFold predicate on the "progress_message" field.
+func ProgressMessageEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldProgressMessage, v))
+}
+
+// ProgressMessageContainsFold applies the ContainsFold predicate on the "progress_message" field.
+func ProgressMessageContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldProgressMessage, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// ResultEQ applies the EQ predicate on the "result" field.
+func ResultEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEQ(FieldResult, v))
+}
+
+// ResultNEQ applies the NEQ predicate on the "result" field.
+func ResultNEQ(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNEQ(FieldResult, v))
+}
+
+// ResultIn applies the In predicate on the "result" field.
+func ResultIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIn(FieldResult, vs...))
+}
+
+// ResultNotIn applies the NotIn predicate on the "result" field.
+func ResultNotIn(vs ...string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotIn(FieldResult, vs...))
+}
+
+// ResultGT applies the GT predicate on the "result" field.
+func ResultGT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGT(FieldResult, v))
+}
+
+// ResultGTE applies the GTE predicate on the "result" field.
+func ResultGTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldGTE(FieldResult, v))
+}
+
+// ResultLT applies the LT predicate on the "result" field.
+func ResultLT(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLT(FieldResult, v))
+}
+
+// ResultLTE applies the LTE predicate on the "result" field.
+func ResultLTE(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldLTE(FieldResult, v))
+}
+
+// ResultContains applies the Contains predicate on the "result" field.
+func ResultContains(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContains(FieldResult, v))
+}
+
+// ResultHasPrefix applies the HasPrefix predicate on the "result" field.
+func ResultHasPrefix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasPrefix(FieldResult, v))
+}
+
+// ResultHasSuffix applies the HasSuffix predicate on the "result" field.
+func ResultHasSuffix(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldHasSuffix(FieldResult, v))
+}
+
+// ResultIsNil applies the IsNil predicate on the "result" field.
+func ResultIsNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldIsNull(FieldResult))
+}
+
+// ResultNotNil applies the NotNil predicate on the "result" field.
+func ResultNotNil() predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldNotNull(FieldResult))
+}
+
+// ResultEqualFold applies the EqualFold predicate on the "result" field.
+func ResultEqualFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldEqualFold(FieldResult, v))
+}
+
+// ResultContainsFold applies the ContainsFold predicate on the "result" field.
+func ResultContainsFold(v string) predicate.TaskRun {
+	return predicate.TaskRun(sql.FieldContainsFold(FieldResult, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaskRun) predicate.TaskRun {
+	return predicate.TaskRun(sql.NotPredicates(p))
+}
@@ -0,0 +1,2240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunCreate is the builder for creating a TaskRun entity.
+type TaskRunCreate struct {
+	config
+	mutation *TaskRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TaskRunCreate) SetCreatedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableCreatedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaskRunCreate) SetUpdatedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableUpdatedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TaskRunCreate) SetDeletedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDeletedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TaskRunCreate) SetCreatedBy(v uint32) *TaskRunCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableCreatedBy(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *TaskRunCreate) SetUpdatedBy(v uint32) *TaskRunCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableUpdatedBy(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *TaskRunCreate) SetDeletedBy(v uint32) *TaskRunCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDeletedBy(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TaskRunCreate) SetTenantID(v uint32) *TaskRunCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTenantID(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetTaskID sets the "task_id" field.
+func (_c *TaskRunCreate) SetTaskID(v uint32) *TaskRunCreate {
+	_c.mutation.SetTaskID(v)
+	return _c
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTaskID(v *uint32) *TaskRunCreate {
+	if v != nil {
+		_c.SetTaskID(*v)
+	}
+	return _c
+}
+
+// SetTypeName sets the "type_name" field.
+func (_c *TaskRunCreate) SetTypeName(v string) *TaskRunCreate {
+	_c.mutation.SetTypeName(v)
+	return _c
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTypeName(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetTypeName(*v)
+	}
+	return _c
+}
+
+// SetRunID sets the "run_id" field.
+func (_c *TaskRunCreate) SetRunID(v string) *TaskRunCreate {
+	_c.mutation.SetRunID(v)
+	return _c
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableRunID(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetRunID(*v)
+	}
+	return _c
+}
+
+// SetQueue sets the "queue" field.
+func (_c *TaskRunCreate) SetQueue(v string) *TaskRunCreate {
+	_c.mutation.SetQueue(v)
+	return _c
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableQueue(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetQueue(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TaskRunCreate) SetStatus(v taskrun.Status) *TaskRunCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStatus(v *taskrun.Status) *TaskRunCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetTrigger sets the "trigger" field.
+func (_c *TaskRunCreate) SetTrigger(v taskrun.Trigger) *TaskRunCreate {
+	_c.mutation.SetTrigger(v)
+	return _c
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableTrigger(v *taskrun.Trigger) *TaskRunCreate {
+	if v != nil {
+		_c.SetTrigger(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *TaskRunCreate) SetStartedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableStartedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *TaskRunCreate) SetFinishedAt(v time.Time) *TaskRunCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableFinishedAt(v *time.Time) *TaskRunCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *TaskRunCreate) SetDurationMs(v int64) *TaskRunCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableDurationMs(v *int64) *TaskRunCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_c *TaskRunCreate) SetRetryCount(v int32) *TaskRunCreate {
+	_c.mutation.SetRetryCount(v)
+	return _c
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableRetryCount(v *int32) *TaskRunCreate {
+	if v != nil {
+		_c.SetRetryCount(*v)
+	}
+	return _c
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_c *TaskRunCreate) SetMaxRetry(v int32) *TaskRunCreate {
+	_c.mutation.SetMaxRetry(v)
+	return _c
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableMaxRetry(v *int32) *TaskRunCreate {
+	if v != nil {
+		_c.SetMaxRetry(*v)
+	}
+	return _c
+}
+
+// SetProgress sets the "progress" field.
+func (_c *TaskRunCreate) SetProgress(v int32) *TaskRunCreate {
+	_c.mutation.SetProgress(v)
+	return _c
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableProgress(v *int32) *TaskRunCreate {
+	if v != nil {
+		_c.SetProgress(*v)
+	}
+	return _c
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (_c *TaskRunCreate) SetProgressMessage(v string) *TaskRunCreate {
+	_c.mutation.SetProgressMessage(v)
+	return _c
+}
+
+// SetNillableProgressMessage sets the "progress_message" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableProgressMessage(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetProgressMessage(*v)
+	}
+	return _c
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_c *TaskRunCreate) SetErrorMessage(v string) *TaskRunCreate {
+	_c.mutation.SetErrorMessage(v)
+	return _c
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableErrorMessage(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetErrorMessage(*v)
+	}
+	return _c
+}
+
+// SetResult sets the "result" field.
+func (_c *TaskRunCreate) SetResult(v string) *TaskRunCreate {
+	_c.mutation.SetResult(v)
+	return _c
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_c *TaskRunCreate) SetNillableResult(v *string) *TaskRunCreate {
+	if v != nil {
+		_c.SetResult(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaskRunCreate) SetID(v uint32) *TaskRunCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_c *TaskRunCreate) Mutation() *TaskRunMutation {
+	return _c.mutation
+}
+
+// Save creates the TaskRun in the database.
+func (_c *TaskRunCreate) Save(ctx context.Context) (*TaskRun, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaskRunCreate) SaveX(ctx context.Context) *TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaskRunCreate) defaults() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := taskrun.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Trigger(); !ok {
+		v := taskrun.DefaultTrigger
+		_c.mutation.SetTrigger(v)
+	}
+	if _, ok := _c.mutation.RetryCount(); !ok {
+		v := taskrun.DefaultRetryCount
+		_c.mutation.SetRetryCount(v)
+	}
+	if _, ok := _c.mutation.Progress(); !ok {
+		v := taskrun.DefaultProgress
+		_c.mutation.SetProgress(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaskRunCreate) check() error {
+	if v, ok := _c.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Trigger(); ok {
+		if err := taskrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TaskRun.trigger": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := taskrun.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TaskRun.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TaskRunCreate) sqlSave(ctx context.Context) (*TaskRun, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaskRunCreate) createSpec() (*TaskRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaskRun{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taskrun.Table, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(taskrun.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(taskrun.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskrun.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(taskrun.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(taskrun.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+		_node.TaskID = &value
+	}
+	if value, ok := _c.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+		_node.TypeName = &value
+	}
+	if value, ok := _c.mutation.RunID(); ok {
+		_spec.SetField(taskrun.FieldRunID, field.TypeString, value)
+		_node.RunID = &value
+	}
+	if value, ok := _c.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+		_node.Queue = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.Trigger(); ok {
+		_spec.SetField(taskrun.FieldTrigger, field.TypeEnum, value)
+		_node.Trigger = &value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeInt64, value)
+		_node.DurationMs = &value
+	}
+	if value, ok := _c.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+		_node.RetryCount = &value
+	}
+	if value, ok := _c.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+		_node.MaxRetry = &value
+	}
+	if value, ok := _c.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeInt32, value)
+		_node.Progress = &value
+	}
+	if value, ok := _c.mutation.ProgressMessage(); ok {
+		_spec.SetField(taskrun.FieldProgressMessage, field.TypeString, value)
+		_node.ProgressMessage = &value
+	}
+	if value, ok := _c.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := _c.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+		_node.Result = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertOne {
+	_c.conflict = opts
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreate) OnConflictColumns(columns ...string) *TaskRunUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TaskRunUpsertOne is the builder for "upsert"-ing
+	//  one TaskRun node.
+	TaskRunUpsertOne struct {
+		create *TaskRunCreate
+	}
+
+	// TaskRunUpsert is the "OnConflict" setter.
+	TaskRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsert) SetUpdatedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateUpdatedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsert) ClearUpdatedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsert) SetDeletedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDeletedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsert) ClearDeletedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskRunUpsert) SetCreatedBy(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateCreatedBy() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskRunUpsert) AddCreatedBy(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskRunUpsert) ClearCreatedBy() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskRunUpsert) SetUpdatedBy(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateUpdatedBy() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskRunUpsert) AddUpdatedBy(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskRunUpsert) ClearUpdatedBy() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskRunUpsert) SetDeletedBy(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDeletedBy() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskRunUpsert) AddDeletedBy(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskRunUpsert) ClearDeletedBy() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDeletedBy)
+	return u
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsert) SetTaskID(v uint32) *TaskRunUpsert {
+	u.Set(taskrun.FieldTaskID, v)
+	return u
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTaskID() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTaskID)
+	return u
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsert) AddTaskID(v uint32) *TaskRunUpsert {
+	u.Add(taskrun.FieldTaskID, v)
+	return u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsert) ClearTaskID() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTaskID)
+	return u
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsert) SetTypeName(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldTypeName, v)
+	return u
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTypeName() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTypeName)
+	return u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsert) ClearTypeName() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTypeName)
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *TaskRunUpsert) SetRunID(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateRunID() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldRunID)
+	return u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *TaskRunUpsert) ClearRunID() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldRunID)
+	return u
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsert) SetQueue(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldQueue, v)
+	return u
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateQueue() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldQueue)
+	return u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsert) ClearQueue() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldQueue)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsert) SetStatus(v taskrun.Status) *TaskRunUpsert {
+	u.Set(taskrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateStatus() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsert) ClearStatus() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldStatus)
+	return u
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *TaskRunUpsert) SetTrigger(v taskrun.Trigger) *TaskRunUpsert {
+	u.Set(taskrun.FieldTrigger, v)
+	return u
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateTrigger() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldTrigger)
+	return u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *TaskRunUpsert) ClearTrigger() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldTrigger)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsert) SetStartedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateStartedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldStartedAt)
+	return u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsert) ClearStartedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsert) SetFinishedAt(v time.Time) *TaskRunUpsert {
+	u.Set(taskrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateFinishedAt() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsert) ClearFinishedAt() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldFinishedAt)
+	return u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsert) SetDurationMs(v int64) *TaskRunUpsert {
+	u.Set(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateDurationMs() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldDurationMs)
+	return u
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsert) AddDurationMs(v int64) *TaskRunUpsert {
+	u.Add(taskrun.FieldDurationMs, v)
+	return u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsert) ClearDurationMs() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldDurationMs)
+	return u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsert) SetRetryCount(v int32) *TaskRunUpsert {
+	u.Set(taskrun.FieldRetryCount, v)
+	return u
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateRetryCount() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldRetryCount)
+	return u
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsert) AddRetryCount(v int32) *TaskRunUpsert {
+	u.Add(taskrun.FieldRetryCount, v)
+	return u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsert) ClearRetryCount() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldRetryCount)
+	return u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsert) SetMaxRetry(v int32) *TaskRunUpsert {
+	u.Set(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateMaxRetry() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldMaxRetry)
+	return u
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsert) AddMaxRetry(v int32) *TaskRunUpsert {
+	u.Add(taskrun.FieldMaxRetry, v)
+	return u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsert) ClearMaxRetry() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldMaxRetry)
+	return u
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsert) SetProgress(v int32) *TaskRunUpsert {
+	u.Set(taskrun.FieldProgress, v)
+	return u
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateProgress() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldProgress)
+	return u
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsert) AddProgress(v int32) *TaskRunUpsert {
+	u.Add(taskrun.FieldProgress, v)
+	return u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsert) ClearProgress() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldProgress)
+	return u
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (u *TaskRunUpsert) SetProgressMessage(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldProgressMessage, v)
+	return u
+}
+
+// UpdateProgressMessage sets the "progress_message" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateProgressMessage() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldProgressMessage)
+	return u
+}
+
+// ClearProgressMessage clears the value of the "progress_message" field.
+func (u *TaskRunUpsert) ClearProgressMessage() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldProgressMessage)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsert) SetErrorMessage(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateErrorMessage() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsert) ClearErrorMessage() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldErrorMessage)
+	return u
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsert) SetResult(v string) *TaskRunUpsert {
+	u.Set(taskrun.FieldResult, v)
+	return u
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsert) UpdateResult() *TaskRunUpsert {
+	u.SetExcluded(taskrun.FieldResult)
+	return u
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsert) ClearResult() *TaskRunUpsert {
+	u.SetNull(taskrun.FieldResult)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertOne) UpdateNewValues() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(taskrun.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(taskrun.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(taskrun.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TaskRunUpsertOne) Ignore() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertOne) DoNothing() *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreate.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertOne) Update(set func(*TaskRunUpsert)) *TaskRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsertOne) SetUpdatedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateUpdatedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsertOne) ClearUpdatedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsertOne) SetDeletedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDeletedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsertOne) ClearDeletedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskRunUpsertOne) SetCreatedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskRunUpsertOne) AddCreatedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateCreatedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskRunUpsertOne) ClearCreatedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskRunUpsertOne) SetUpdatedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskRunUpsertOne) AddUpdatedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateUpdatedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskRunUpsertOne) ClearUpdatedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskRunUpsertOne) SetDeletedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskRunUpsertOne) AddDeletedBy(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDeletedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskRunUpsertOne) ClearDeletedBy() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertOne) SetTaskID(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsertOne) AddTaskID(v uint32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertOne) ClearTaskID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertOne) SetTypeName(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTypeName() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsertOne) ClearTypeName() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTypeName()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *TaskRunUpsertOne) SetRunID(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateRunID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *TaskRunUpsertOne) ClearRunID() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertOne) SetQueue(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertOne) ClearQueue() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertOne) SetStatus(v taskrun.Status) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertOne) ClearStatus() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *TaskRunUpsertOne) SetTrigger(v taskrun.Trigger) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateTrigger() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *TaskRunUpsertOne) ClearTrigger() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTrigger()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsertOne) SetStartedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateStartedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsertOne) ClearStartedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertOne) SetFinishedAt(v time.Time) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertOne) ClearFinishedAt() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertOne) SetDurationMs(v int64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertOne) AddDurationMs(v int64) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertOne) ClearDurationMs() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsertOne) SetRetryCount(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsertOne) AddRetryCount(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateRetryCount() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsertOne) ClearRetryCount() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRetryCount()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertOne) SetMaxRetry(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertOne) AddMaxRetry(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertOne) ClearMaxRetry() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertOne) SetProgress(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsertOne) AddProgress(v int32) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertOne) ClearProgress() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (u *TaskRunUpsertOne) SetProgressMessage(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgressMessage(v)
+	})
+}
+
+// UpdateProgressMessage sets the "progress_message" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateProgressMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgressMessage()
+	})
+}
+
+// ClearProgressMessage clears the value of the "progress_message" field.
+func (u *TaskRunUpsertOne) ClearProgressMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgressMessage()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsertOne) SetErrorMessage(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateErrorMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsertOne) ClearErrorMessage() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertOne) SetResult(v string) *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertOne) UpdateResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertOne) ClearResult() *TaskRunUpsertOne {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TaskRunUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TaskRunUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TaskRunCreateBulk is the builder for creating many TaskRun entities in bulk.
+type TaskRunCreateBulk struct {
+	config
+	err      error
+	builders []*TaskRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TaskRun entities in the database.
+func (_c *TaskRunCreateBulk) Save(ctx context.Context) ([]*TaskRun, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaskRun, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaskRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) SaveX(ctx context.Context) []*TaskRun {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaskRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaskRunCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TaskRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TaskRunUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *TaskRunUpsertBulk {
+	_c.conflict = opts
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TaskRunCreateBulk) OnConflictColumns(columns ...string) *TaskRunUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TaskRunUpsertBulk{
+		create: _c,
+	}
+}
+
+// TaskRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of TaskRun nodes.
+type TaskRunUpsertBulk struct {
+	create *TaskRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(taskrun.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) UpdateNewValues() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(taskrun.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(taskrun.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(taskrun.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TaskRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TaskRunUpsertBulk) Ignore() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TaskRunUpsertBulk) DoNothing() *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TaskRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *TaskRunUpsertBulk) Update(set func(*TaskRunUpsert)) *TaskRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TaskRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TaskRunUpsertBulk) SetUpdatedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateUpdatedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TaskRunUpsertBulk) ClearUpdatedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TaskRunUpsertBulk) SetDeletedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDeletedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TaskRunUpsertBulk) ClearDeletedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TaskRunUpsertBulk) SetCreatedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TaskRunUpsertBulk) AddCreatedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateCreatedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TaskRunUpsertBulk) ClearCreatedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TaskRunUpsertBulk) SetUpdatedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TaskRunUpsertBulk) AddUpdatedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateUpdatedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TaskRunUpsertBulk) ClearUpdatedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TaskRunUpsertBulk) SetDeletedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TaskRunUpsertBulk) AddDeletedBy(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDeletedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TaskRunUpsertBulk) ClearDeletedBy() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetTaskID sets the "task_id" field.
+func (u *TaskRunUpsertBulk) SetTaskID(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTaskID(v)
+	})
+}
+
+// AddTaskID adds v to the "task_id" field.
+func (u *TaskRunUpsertBulk) AddTaskID(v uint32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddTaskID(v)
+	})
+}
+
+// UpdateTaskID sets the "task_id" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTaskID()
+	})
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (u *TaskRunUpsertBulk) ClearTaskID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTaskID()
+	})
+}
+
+// SetTypeName sets the "type_name" field.
+func (u *TaskRunUpsertBulk) SetTypeName(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTypeName(v)
+	})
+}
+
+// UpdateTypeName sets the "type_name" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTypeName() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTypeName()
+	})
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (u *TaskRunUpsertBulk) ClearTypeName() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTypeName()
+	})
+}
+
+// SetRunID sets the "run_id" field.
+func (u *TaskRunUpsertBulk) SetRunID(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateRunID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (u *TaskRunUpsertBulk) ClearRunID() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRunID()
+	})
+}
+
+// SetQueue sets the "queue" field.
+func (u *TaskRunUpsertBulk) SetQueue(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetQueue(v)
+	})
+}
+
+// UpdateQueue sets the "queue" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateQueue()
+	})
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (u *TaskRunUpsertBulk) ClearQueue() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearQueue()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TaskRunUpsertBulk) SetStatus(v taskrun.Status) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TaskRunUpsertBulk) ClearStatus() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetTrigger sets the "trigger" field.
+func (u *TaskRunUpsertBulk) SetTrigger(v taskrun.Trigger) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetTrigger(v)
+	})
+}
+
+// UpdateTrigger sets the "trigger" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateTrigger() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateTrigger()
+	})
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (u *TaskRunUpsertBulk) ClearTrigger() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearTrigger()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *TaskRunUpsertBulk) SetStartedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateStartedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (u *TaskRunUpsertBulk) ClearStartedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *TaskRunUpsertBulk) SetFinishedAt(v time.Time) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *TaskRunUpsertBulk) ClearFinishedAt() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (u *TaskRunUpsertBulk) SetDurationMs(v int64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetDurationMs(v)
+	})
+}
+
+// AddDurationMs adds v to the "duration_ms" field.
+func (u *TaskRunUpsertBulk) AddDurationMs(v int64) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddDurationMs(v)
+	})
+}
+
+// UpdateDurationMs sets the "duration_ms" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateDurationMs()
+	})
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (u *TaskRunUpsertBulk) ClearDurationMs() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearDurationMs()
+	})
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (u *TaskRunUpsertBulk) SetRetryCount(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetRetryCount(v)
+	})
+}
+
+// AddRetryCount adds v to the "retry_count" field.
+func (u *TaskRunUpsertBulk) AddRetryCount(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddRetryCount(v)
+	})
+}
+
+// UpdateRetryCount sets the "retry_count" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateRetryCount() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateRetryCount()
+	})
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (u *TaskRunUpsertBulk) ClearRetryCount() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearRetryCount()
+	})
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (u *TaskRunUpsertBulk) SetMaxRetry(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetMaxRetry(v)
+	})
+}
+
+// AddMaxRetry adds v to the "max_retry" field.
+func (u *TaskRunUpsertBulk) AddMaxRetry(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddMaxRetry(v)
+	})
+}
+
+// UpdateMaxRetry sets the "max_retry" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateMaxRetry()
+	})
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (u *TaskRunUpsertBulk) ClearMaxRetry() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearMaxRetry()
+	})
+}
+
+// SetProgress sets the "progress" field.
+func (u *TaskRunUpsertBulk) SetProgress(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgress(v)
+	})
+}
+
+// AddProgress adds v to the "progress" field.
+func (u *TaskRunUpsertBulk) AddProgress(v int32) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.AddProgress(v)
+	})
+}
+
+// UpdateProgress sets the "progress" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgress()
+	})
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (u *TaskRunUpsertBulk) ClearProgress() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgress()
+	})
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (u *TaskRunUpsertBulk) SetProgressMessage(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetProgressMessage(v)
+	})
+}
+
+// UpdateProgressMessage sets the "progress_message" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateProgressMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateProgressMessage()
+	})
+}
+
+// ClearProgressMessage clears the value of the "progress_message" field.
+func (u *TaskRunUpsertBulk) ClearProgressMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearProgressMessage()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *TaskRunUpsertBulk) SetErrorMessage(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateErrorMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *TaskRunUpsertBulk) ClearErrorMessage() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetResult sets the "result" field.
+func (u *TaskRunUpsertBulk) SetResult(v string) *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.SetResult(v)
+	})
+}
+
+// UpdateResult sets the "result" field to the value that was provided on create.
+func (u *TaskRunUpsertBulk) UpdateResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.UpdateResult()
+	})
+}
+
+// ClearResult clears the value of the "result" field.
+func (u *TaskRunUpsertBulk) ClearResult() *TaskRunUpsertBulk {
+	return u.Update(func(s *TaskRunUpsert) {
+		s.ClearResult()
+	})
+}
+
+// Exec executes the query.
+func (u *TaskRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TaskRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TaskRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TaskRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1440 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TaskRunUpdate is the builder for updating TaskRun entities.
+type TaskRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdate) Where(ps ...predicate.TaskRun) *TaskRunUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskRunUpdate) SetUpdatedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableUpdatedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskRunUpdate) ClearUpdatedAt() *TaskRunUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskRunUpdate) SetDeletedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDeletedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskRunUpdate) ClearDeletedAt() *TaskRunUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskRunUpdate) SetCreatedBy(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableCreatedBy(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskRunUpdate) AddCreatedBy(v int32) *TaskRunUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskRunUpdate) ClearCreatedBy() *TaskRunUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TaskRunUpdate) SetUpdatedBy(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableUpdatedBy(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TaskRunUpdate) AddUpdatedBy(v int32) *TaskRunUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TaskRunUpdate) ClearUpdatedBy() *TaskRunUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TaskRunUpdate) SetDeletedBy(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDeletedBy(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TaskRunUpdate) AddDeletedBy(v int32) *TaskRunUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TaskRunUpdate) ClearDeletedBy() *TaskRunUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdate) SetTaskID(v uint32) *TaskRunUpdate {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTaskID(v *uint32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *TaskRunUpdate) AddTaskID(v int32) *TaskRunUpdate {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdate) ClearTaskID() *TaskRunUpdate {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdate) SetTypeName(v string) *TaskRunUpdate {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTypeName(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (_u *TaskRunUpdate) ClearTypeName() *TaskRunUpdate {
+	_u.mutation.ClearTypeName()
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *TaskRunUpdate) SetRunID(v string) *TaskRunUpdate {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableRunID(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *TaskRunUpdate) ClearRunID() *TaskRunUpdate {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdate) SetQueue(v string) *TaskRunUpdate {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableQueue(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdate) ClearQueue() *TaskRunUpdate {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdate) SetStatus(v taskrun.Status) *TaskRunUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableStatus(v *taskrun.Status) *TaskRunUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdate) ClearStatus() *TaskRunUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *TaskRunUpdate) SetTrigger(v taskrun.Trigger) *TaskRunUpdate {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableTrigger(v *taskrun.Trigger) *TaskRunUpdate {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (_u *TaskRunUpdate) ClearTrigger() *TaskRunUpdate {
+	_u.mutation.ClearTrigger()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskRunUpdate) SetStartedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableStartedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskRunUpdate) ClearStartedAt() *TaskRunUpdate {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdate) SetFinishedAt(v time.Time) *TaskRunUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableFinishedAt(v *time.Time) *TaskRunUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdate) ClearFinishedAt() *TaskRunUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdate) SetDurationMs(v int64) *TaskRunUpdate {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableDurationMs(v *int64) *TaskRunUpdate {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdate) AddDurationMs(v int64) *TaskRunUpdate {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdate) ClearDurationMs() *TaskRunUpdate {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *TaskRunUpdate) SetRetryCount(v int32) *TaskRunUpdate {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableRetryCount(v *int32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *TaskRunUpdate) AddRetryCount(v int32) *TaskRunUpdate {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (_u *TaskRunUpdate) ClearRetryCount() *TaskRunUpdate {
+	_u.mutation.ClearRetryCount()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdate) SetMaxRetry(v int32) *TaskRunUpdate {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableMaxRetry(v *int32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdate) AddMaxRetry(v int32) *TaskRunUpdate {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdate) ClearMaxRetry() *TaskRunUpdate {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdate) SetProgress(v int32) *TaskRunUpdate {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableProgress(v *int32) *TaskRunUpdate {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *TaskRunUpdate) AddProgress(v int32) *TaskRunUpdate {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdate) ClearProgress() *TaskRunUpdate {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (_u *TaskRunUpdate) SetProgressMessage(v string) *TaskRunUpdate {
+	_u.mutation.SetProgressMessage(v)
+	return _u
+}
+
+// SetNillableProgressMessage sets the "progress_message" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableProgressMessage(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetProgressMessage(*v)
+	}
+	return _u
+}
+
+// ClearProgressMessage clears the value of the "progress_message" field.
+func (_u *TaskRunUpdate) ClearProgressMessage() *TaskRunUpdate {
+	_u.mutation.ClearProgressMessage()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskRunUpdate) SetErrorMessage(v string) *TaskRunUpdate {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableErrorMessage(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskRunUpdate) ClearErrorMessage() *TaskRunUpdate {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdate) SetResult(v string) *TaskRunUpdate {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdate) SetNillableResult(v *string) *TaskRunUpdate {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdate) ClearResult() *TaskRunUpdate {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdate) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaskRunUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaskRunUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := taskrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TaskRun.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskrun.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskrun.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(taskrun.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(taskrun.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(taskrun.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(taskrun.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if _u.mutation.TypeNameCleared() {
+		_spec.ClearField(taskrun.FieldTypeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(taskrun.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(taskrun.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(taskrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if _u.mutation.TriggerCleared() {
+		_spec.ClearField(taskrun.FieldTrigger, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if _u.mutation.RetryCountCleared() {
+		_spec.ClearField(taskrun.FieldRetryCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(taskrun.FieldProgress, field.TypeInt32, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ProgressMessage(); ok {
+		_spec.SetField(taskrun.FieldProgressMessage, field.TypeString, value)
+	}
+	if _u.mutation.ProgressMessageCleared() {
+		_spec.ClearField(taskrun.FieldProgressMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaskRunUpdateOne is the builder for updating a single TaskRun entity.
+type TaskRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TaskRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaskRunUpdateOne) SetUpdatedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableUpdatedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TaskRunUpdateOne) ClearUpdatedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TaskRunUpdateOne) SetDeletedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDeletedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TaskRunUpdateOne) ClearDeletedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TaskRunUpdateOne) SetCreatedBy(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableCreatedBy(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TaskRunUpdateOne) AddCreatedBy(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TaskRunUpdateOne) ClearCreatedBy() *TaskRunUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TaskRunUpdateOne) SetUpdatedBy(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableUpdatedBy(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TaskRunUpdateOne) AddUpdatedBy(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TaskRunUpdateOne) ClearUpdatedBy() *TaskRunUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TaskRunUpdateOne) SetDeletedBy(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDeletedBy(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TaskRunUpdateOne) AddDeletedBy(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TaskRunUpdateOne) ClearDeletedBy() *TaskRunUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetTaskID sets the "task_id" field.
+func (_u *TaskRunUpdateOne) SetTaskID(v uint32) *TaskRunUpdateOne {
+	_u.mutation.ResetTaskID()
+	_u.mutation.SetTaskID(v)
+	return _u
+}
+
+// SetNillableTaskID sets the "task_id" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTaskID(v *uint32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTaskID(*v)
+	}
+	return _u
+}
+
+// AddTaskID adds value to the "task_id" field.
+func (_u *TaskRunUpdateOne) AddTaskID(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddTaskID(v)
+	return _u
+}
+
+// ClearTaskID clears the value of the "task_id" field.
+func (_u *TaskRunUpdateOne) ClearTaskID() *TaskRunUpdateOne {
+	_u.mutation.ClearTaskID()
+	return _u
+}
+
+// SetTypeName sets the "type_name" field.
+func (_u *TaskRunUpdateOne) SetTypeName(v string) *TaskRunUpdateOne {
+	_u.mutation.SetTypeName(v)
+	return _u
+}
+
+// SetNillableTypeName sets the "type_name" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTypeName(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTypeName(*v)
+	}
+	return _u
+}
+
+// ClearTypeName clears the value of the "type_name" field.
+func (_u *TaskRunUpdateOne) ClearTypeName() *TaskRunUpdateOne {
+	_u.mutation.ClearTypeName()
+	return _u
+}
+
+// SetRunID sets the "run_id" field.
+func (_u *TaskRunUpdateOne) SetRunID(v string) *TaskRunUpdateOne {
+	_u.mutation.SetRunID(v)
+	return _u
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableRunID(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetRunID(*v)
+	}
+	return _u
+}
+
+// ClearRunID clears the value of the "run_id" field.
+func (_u *TaskRunUpdateOne) ClearRunID() *TaskRunUpdateOne {
+	_u.mutation.ClearRunID()
+	return _u
+}
+
+// SetQueue sets the "queue" field.
+func (_u *TaskRunUpdateOne) SetQueue(v string) *TaskRunUpdateOne {
+	_u.mutation.SetQueue(v)
+	return _u
+}
+
+// SetNillableQueue sets the "queue" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableQueue(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetQueue(*v)
+	}
+	return _u
+}
+
+// ClearQueue clears the value of the "queue" field.
+func (_u *TaskRunUpdateOne) ClearQueue() *TaskRunUpdateOne {
+	_u.mutation.ClearQueue()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TaskRunUpdateOne) SetStatus(v taskrun.Status) *TaskRunUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableStatus(v *taskrun.Status) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TaskRunUpdateOne) ClearStatus() *TaskRunUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetTrigger sets the "trigger" field.
+func (_u *TaskRunUpdateOne) SetTrigger(v taskrun.Trigger) *TaskRunUpdateOne {
+	_u.mutation.SetTrigger(v)
+	return _u
+}
+
+// SetNillableTrigger sets the "trigger" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableTrigger(v *taskrun.Trigger) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetTrigger(*v)
+	}
+	return _u
+}
+
+// ClearTrigger clears the value of the "trigger" field.
+func (_u *TaskRunUpdateOne) ClearTrigger() *TaskRunUpdateOne {
+	_u.mutation.ClearTrigger()
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *TaskRunUpdateOne) SetStartedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableStartedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *TaskRunUpdateOne) ClearStartedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *TaskRunUpdateOne) SetFinishedAt(v time.Time) *TaskRunUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableFinishedAt(v *time.Time) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *TaskRunUpdateOne) ClearFinishedAt() *TaskRunUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_u *TaskRunUpdateOne) SetDurationMs(v int64) *TaskRunUpdateOne {
+	_u.mutation.ResetDurationMs()
+	_u.mutation.SetDurationMs(v)
+	return _u
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableDurationMs(v *int64) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetDurationMs(*v)
+	}
+	return _u
+}
+
+// AddDurationMs adds value to the "duration_ms" field.
+func (_u *TaskRunUpdateOne) AddDurationMs(v int64) *TaskRunUpdateOne {
+	_u.mutation.AddDurationMs(v)
+	return _u
+}
+
+// ClearDurationMs clears the value of the "duration_ms" field.
+func (_u *TaskRunUpdateOne) ClearDurationMs() *TaskRunUpdateOne {
+	_u.mutation.ClearDurationMs()
+	return _u
+}
+
+// SetRetryCount sets the "retry_count" field.
+func (_u *TaskRunUpdateOne) SetRetryCount(v int32) *TaskRunUpdateOne {
+	_u.mutation.ResetRetryCount()
+	_u.mutation.SetRetryCount(v)
+	return _u
+}
+
+// SetNillableRetryCount sets the "retry_count" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableRetryCount(v *int32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetRetryCount(*v)
+	}
+	return _u
+}
+
+// AddRetryCount adds value to the "retry_count" field.
+func (_u *TaskRunUpdateOne) AddRetryCount(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddRetryCount(v)
+	return _u
+}
+
+// ClearRetryCount clears the value of the "retry_count" field.
+func (_u *TaskRunUpdateOne) ClearRetryCount() *TaskRunUpdateOne {
+	_u.mutation.ClearRetryCount()
+	return _u
+}
+
+// SetMaxRetry sets the "max_retry" field.
+func (_u *TaskRunUpdateOne) SetMaxRetry(v int32) *TaskRunUpdateOne {
+	_u.mutation.ResetMaxRetry()
+	_u.mutation.SetMaxRetry(v)
+	return _u
+}
+
+// SetNillableMaxRetry sets the "max_retry" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableMaxRetry(v *int32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetMaxRetry(*v)
+	}
+	return _u
+}
+
+// AddMaxRetry adds value to the "max_retry" field.
+func (_u *TaskRunUpdateOne) AddMaxRetry(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddMaxRetry(v)
+	return _u
+}
+
+// ClearMaxRetry clears the value of the "max_retry" field.
+func (_u *TaskRunUpdateOne) ClearMaxRetry() *TaskRunUpdateOne {
+	_u.mutation.ClearMaxRetry()
+	return _u
+}
+
+// SetProgress sets the "progress" field.
+func (_u *TaskRunUpdateOne) SetProgress(v int32) *TaskRunUpdateOne {
+	_u.mutation.ResetProgress()
+	_u.mutation.SetProgress(v)
+	return _u
+}
+
+// SetNillableProgress sets the "progress" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableProgress(v *int32) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetProgress(*v)
+	}
+	return _u
+}
+
+// AddProgress adds value to the "progress" field.
+func (_u *TaskRunUpdateOne) AddProgress(v int32) *TaskRunUpdateOne {
+	_u.mutation.AddProgress(v)
+	return _u
+}
+
+// ClearProgress clears the value of the "progress" field.
+func (_u *TaskRunUpdateOne) ClearProgress() *TaskRunUpdateOne {
+	_u.mutation.ClearProgress()
+	return _u
+}
+
+// SetProgressMessage sets the "progress_message" field.
+func (_u *TaskRunUpdateOne) SetProgressMessage(v string) *TaskRunUpdateOne {
+	_u.mutation.SetProgressMessage(v)
+	return _u
+}
+
+// SetNillableProgressMessage sets the "progress_message" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableProgressMessage(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetProgressMessage(*v)
+	}
+	return _u
+}
+
+// ClearProgressMessage clears the value of the "progress_message" field.
+func (_u *TaskRunUpdateOne) ClearProgressMessage() *TaskRunUpdateOne {
+	_u.mutation.ClearProgressMessage()
+	return _u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (_u *TaskRunUpdateOne) SetErrorMessage(v string) *TaskRunUpdateOne {
+	_u.mutation.SetErrorMessage(v)
+	return _u
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableErrorMessage(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetErrorMessage(*v)
+	}
+	return _u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (_u *TaskRunUpdateOne) ClearErrorMessage() *TaskRunUpdateOne {
+	_u.mutation.ClearErrorMessage()
+	return _u
+}
+
+// SetResult sets the "result" field.
+func (_u *TaskRunUpdateOne) SetResult(v string) *TaskRunUpdateOne {
+	_u.mutation.SetResult(v)
+	return _u
+}
+
+// SetNillableResult sets the "result" field if the given value is not nil.
+func (_u *TaskRunUpdateOne) SetNillableResult(v *string) *TaskRunUpdateOne {
+	if v != nil {
+		_u.SetResult(*v)
+	}
+	return _u
+}
+
+// ClearResult clears the value of the "result" field.
+func (_u *TaskRunUpdateOne) ClearResult() *TaskRunUpdateOne {
+	_u.mutation.ClearResult()
+	return _u
+}
+
+// Mutation returns the TaskRunMutation object of the builder.
+func (_u *TaskRunUpdateOne) Mutation() *TaskRunMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaskRunUpdate builder.
+func (_u *TaskRunUpdateOne) Where(ps ...predicate.TaskRun) *TaskRunUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaskRunUpdateOne) Select(field string, fields ...string) *TaskRunUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaskRun entity.
+func (_u *TaskRunUpdateOne) Save(ctx context.Context) (*TaskRun, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) SaveX(ctx context.Context) *TaskRun {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaskRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaskRunUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaskRunUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := taskrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TaskRun.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Trigger(); ok {
+		if err := taskrun.TriggerValidator(v); err != nil {
+			return &ValidationError{Name: "trigger", err: fmt.Errorf(`ent: validator failed for field "TaskRun.trigger": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TaskRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TaskRunUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TaskRunUpdateOne) sqlSave(ctx context.Context) (_node *TaskRun, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taskrun.Table, taskrun.Columns, sqlgraph.NewFieldSpec(taskrun.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaskRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taskrun.FieldID)
+		for _, f := range fields {
+			if !taskrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taskrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(taskrun.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taskrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(taskrun.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(taskrun.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(taskrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(taskrun.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(taskrun.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(taskrun.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(taskrun.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(taskrun.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(taskrun.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(taskrun.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(taskrun.FieldDeletedBy, field.TypeUint32)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(taskrun.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TaskID(); ok {
+		_spec.SetField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedTaskID(); ok {
+		_spec.AddField(taskrun.FieldTaskID, field.TypeUint32, value)
+	}
+	if _u.mutation.TaskIDCleared() {
+		_spec.ClearField(taskrun.FieldTaskID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.TypeName(); ok {
+		_spec.SetField(taskrun.FieldTypeName, field.TypeString, value)
+	}
+	if _u.mutation.TypeNameCleared() {
+		_spec.ClearField(taskrun.FieldTypeName, field.TypeString)
+	}
+	if value, ok := _u.mutation.RunID(); ok {
+		_spec.SetField(taskrun.FieldRunID, field.TypeString, value)
+	}
+	if _u.mutation.RunIDCleared() {
+		_spec.ClearField(taskrun.FieldRunID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Queue(); ok {
+		_spec.SetField(taskrun.FieldQueue, field.TypeString, value)
+	}
+	if _u.mutation.QueueCleared() {
+		_spec.ClearField(taskrun.FieldQueue, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(taskrun.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(taskrun.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Trigger(); ok {
+		_spec.SetField(taskrun.FieldTrigger, field.TypeEnum, value)
+	}
+	if _u.mutation.TriggerCleared() {
+		_spec.ClearField(taskrun.FieldTrigger, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(taskrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(taskrun.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(taskrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(taskrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DurationMs(); ok {
+		_spec.SetField(taskrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedDurationMs(); ok {
+		_spec.AddField(taskrun.FieldDurationMs, field.TypeInt64, value)
+	}
+	if _u.mutation.DurationMsCleared() {
+		_spec.ClearField(taskrun.FieldDurationMs, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.RetryCount(); ok {
+		_spec.SetField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedRetryCount(); ok {
+		_spec.AddField(taskrun.FieldRetryCount, field.TypeInt32, value)
+	}
+	if _u.mutation.RetryCountCleared() {
+		_spec.ClearField(taskrun.FieldRetryCount, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.MaxRetry(); ok {
+		_spec.SetField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxRetry(); ok {
+		_spec.AddField(taskrun.FieldMaxRetry, field.TypeInt32, value)
+	}
+	if _u.mutation.MaxRetryCleared() {
+		_spec.ClearField(taskrun.FieldMaxRetry, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Progress(); ok {
+		_spec.SetField(taskrun.FieldProgress, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedProgress(); ok {
+		_spec.AddField(taskrun.FieldProgress, field.TypeInt32, value)
+	}
+	if _u.mutation.ProgressCleared() {
+		_spec.ClearField(taskrun.FieldProgress, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.ProgressMessage(); ok {
+		_spec.SetField(taskrun.FieldProgressMessage, field.TypeString, value)
+	}
+	if _u.mutation.ProgressMessageCleared() {
+		_spec.ClearField(taskrun.FieldProgressMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ErrorMessage(); ok {
+		_spec.SetField(taskrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if _u.mutation.ErrorMessageCleared() {
+		_spec.ClearField(taskrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.Result(); ok {
+		_spec.SetField(taskrun.FieldResult, field.TypeString, value)
+	}
+	if _u.mutation.ResultCleared() {
+		_spec.ClearField(taskrun.FieldResult, field.TypeString)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TaskRun{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taskrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -54,6 +54,8 @@ type Tenant struct {
 	UnsubscribeAt *time.Time `json:"unsubscribe_at,omitempty"`
 	// 订阅套餐
 	SubscriptionPlan *string `json:"subscription_plan,omitempty"`
+	// 订阅套餐ID，为空表示不限制配额
+	PlanID *uint32 `json:"plan_id,omitempty"`
 	// 租户有效期
 	ExpiredAt *time.Time `json:"expired_at,omitempty"`
 	// 最后一次登录的时间
@@ -68,7 +70,7 @@ func (*Tenant) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case tenant.FieldID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy, tenant.FieldDeletedBy, tenant.FieldAdminUserID:
+		case tenant.FieldID, tenant.FieldCreatedBy, tenant.FieldUpdatedBy, tenant.FieldDeletedBy, tenant.FieldAdminUserID, tenant.FieldPlanID:
 			values[i] = new(sql.NullInt64)
 		case tenant.FieldRemark, tenant.FieldName, tenant.FieldCode, tenant.FieldLogoURL, tenant.FieldIndustry, tenant.FieldStatus, tenant.FieldType, tenant.FieldAuditStatus, tenant.FieldSubscriptionPlan, tenant.FieldLastLoginIP:
 			values[i] = new(sql.NullString)
@@ -221,6 +223,13 @@ func (_m *Tenant) assignValues(columns []string, values []any) error {
 				_m.SubscriptionPlan = new(string)
 				*_m.SubscriptionPlan = value.String
 			}
+		case tenant.FieldPlanID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field plan_id", values[i])
+			} else if value.Valid {
+				_m.PlanID = new(uint32)
+				*_m.PlanID = uint32(value.Int64)
+			}
 		case tenant.FieldExpiredAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field expired_at", values[i])
@@ -368,6 +377,11 @@ func (_m *Tenant) String() string {
 		builder.WriteString(*v)
 	}
 	builder.WriteString(", ")
+	if v := _m.PlanID; v != nil {
+		builder.WriteString("plan_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.ExpiredAt; v != nil {
 		builder.WriteString("expired_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -49,6 +49,8 @@ const (
 	FieldUnsubscribeAt = "unsubscribe_at"
 	// FieldSubscriptionPlan holds the string denoting the subscription_plan field in the database.
 	FieldSubscriptionPlan = "subscription_plan"
+	// FieldPlanID holds the string denoting the plan_id field in the database.
+	FieldPlanID = "plan_id"
 	// FieldExpiredAt holds the string denoting the expired_at field in the database.
 	FieldExpiredAt = "expired_at"
 	// FieldLastLoginTime holds the string denoting the last_login_time field in the database.
@@ -80,6 +82,7 @@ var Columns = []string{
 	FieldSubscriptionAt,
 	FieldUnsubscribeAt,
 	FieldSubscriptionPlan,
+	FieldPlanID,
 	FieldExpiredAt,
 	FieldLastLoginTime,
 	FieldLastLoginIP,
@@ -283,6 +286,11 @@ func BySubscriptionPlan(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSubscriptionPlan, opts...).ToFunc()
 }
 
+// ByPlanID orders the results by the plan_id field.
+func ByPlanID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlanID, opts...).ToFunc()
+}
+
 // ByExpiredAt orders the results by the expired_at field.
 func ByExpiredAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldExpiredAt, opts...).ToFunc()
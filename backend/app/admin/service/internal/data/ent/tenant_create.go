@@ -274,6 +274,20 @@ func (_c *TenantCreate) SetNillableSubscriptionPlan(v *string) *TenantCreate {
 	return _c
 }
 
+// SetPlanID sets the "plan_id" field.
+func (_c *TenantCreate) SetPlanID(v uint32) *TenantCreate {
+	_c.mutation.SetPlanID(v)
+	return _c
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_c *TenantCreate) SetNillablePlanID(v *uint32) *TenantCreate {
+	if v != nil {
+		_c.SetPlanID(*v)
+	}
+	return _c
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_c *TenantCreate) SetExpiredAt(v time.Time) *TenantCreate {
 	_c.mutation.SetExpiredAt(v)
@@ -504,6 +518,10 @@ func (_c *TenantCreate) createSpec() (*Tenant, *sqlgraph.CreateSpec) {
 		_spec.SetField(tenant.FieldSubscriptionPlan, field.TypeString, value)
 		_node.SubscriptionPlan = &value
 	}
+	if value, ok := _c.mutation.PlanID(); ok {
+		_spec.SetField(tenant.FieldPlanID, field.TypeUint32, value)
+		_node.PlanID = &value
+	}
 	if value, ok := _c.mutation.ExpiredAt(); ok {
 		_spec.SetField(tenant.FieldExpiredAt, field.TypeTime, value)
 		_node.ExpiredAt = &value
@@ -898,6 +916,30 @@ func (u *TenantUpsert) ClearSubscriptionPlan() *TenantUpsert {
 	return u
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsert) SetPlanID(v uint32) *TenantUpsert {
+	u.Set(tenant.FieldPlanID, v)
+	return u
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsert) UpdatePlanID() *TenantUpsert {
+	u.SetExcluded(tenant.FieldPlanID)
+	return u
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *TenantUpsert) AddPlanID(v uint32) *TenantUpsert {
+	u.Add(tenant.FieldPlanID, v)
+	return u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsert) ClearPlanID() *TenantUpsert {
+	u.SetNull(tenant.FieldPlanID)
+	return u
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (u *TenantUpsert) SetExpiredAt(v time.Time) *TenantUpsert {
 	u.Set(tenant.FieldExpiredAt, v)
@@ -1388,6 +1430,34 @@ func (u *TenantUpsertOne) ClearSubscriptionPlan() *TenantUpsertOne {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsertOne) SetPlanID(v uint32) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *TenantUpsertOne) AddPlanID(v uint32) *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsertOne) UpdatePlanID() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsertOne) ClearPlanID() *TenantUpsertOne {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (u *TenantUpsertOne) SetExpiredAt(v time.Time) *TenantUpsertOne {
 	return u.Update(func(s *TenantUpsert) {
@@ -2053,6 +2123,34 @@ func (u *TenantUpsertBulk) ClearSubscriptionPlan() *TenantUpsertBulk {
 	})
 }
 
+// SetPlanID sets the "plan_id" field.
+func (u *TenantUpsertBulk) SetPlanID(v uint32) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.SetPlanID(v)
+	})
+}
+
+// AddPlanID adds v to the "plan_id" field.
+func (u *TenantUpsertBulk) AddPlanID(v uint32) *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.AddPlanID(v)
+	})
+}
+
+// UpdatePlanID sets the "plan_id" field to the value that was provided on create.
+func (u *TenantUpsertBulk) UpdatePlanID() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.UpdatePlanID()
+	})
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (u *TenantUpsertBulk) ClearPlanID() *TenantUpsertBulk {
+	return u.Update(func(s *TenantUpsert) {
+		s.ClearPlanID()
+	})
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (u *TenantUpsertBulk) SetExpiredAt(v time.Time) *TenantUpsertBulk {
 	return u.Update(func(s *TenantUpsert) {
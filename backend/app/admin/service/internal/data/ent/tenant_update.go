@@ -397,6 +397,33 @@ func (_u *TenantUpdate) ClearSubscriptionPlan() *TenantUpdate {
 	return _u
 }
 
+// SetPlanID sets the "plan_id" field.
+func (_u *TenantUpdate) SetPlanID(v uint32) *TenantUpdate {
+	_u.mutation.ResetPlanID()
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePlanID(v *uint32) *TenantUpdate {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// AddPlanID adds value to the "plan_id" field.
+func (_u *TenantUpdate) AddPlanID(v int32) *TenantUpdate {
+	_u.mutation.AddPlanID(v)
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *TenantUpdate) ClearPlanID() *TenantUpdate {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_u *TenantUpdate) SetExpiredAt(v time.Time) *TenantUpdate {
 	_u.mutation.SetExpiredAt(v)
@@ -654,6 +681,15 @@ func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.SubscriptionPlanCleared() {
 		_spec.ClearField(tenant.FieldSubscriptionPlan, field.TypeString)
 	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(tenant.FieldPlanID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedPlanID(); ok {
+		_spec.AddField(tenant.FieldPlanID, field.TypeUint32, value)
+	}
+	if _u.mutation.PlanIDCleared() {
+		_spec.ClearField(tenant.FieldPlanID, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(tenant.FieldExpiredAt, field.TypeTime, value)
 	}
@@ -1062,6 +1098,33 @@ func (_u *TenantUpdateOne) ClearSubscriptionPlan() *TenantUpdateOne {
 	return _u
 }
 
+// SetPlanID sets the "plan_id" field.
+func (_u *TenantUpdateOne) SetPlanID(v uint32) *TenantUpdateOne {
+	_u.mutation.ResetPlanID()
+	_u.mutation.SetPlanID(v)
+	return _u
+}
+
+// SetNillablePlanID sets the "plan_id" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePlanID(v *uint32) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPlanID(*v)
+	}
+	return _u
+}
+
+// AddPlanID adds value to the "plan_id" field.
+func (_u *TenantUpdateOne) AddPlanID(v int32) *TenantUpdateOne {
+	_u.mutation.AddPlanID(v)
+	return _u
+}
+
+// ClearPlanID clears the value of the "plan_id" field.
+func (_u *TenantUpdateOne) ClearPlanID() *TenantUpdateOne {
+	_u.mutation.ClearPlanID()
+	return _u
+}
+
 // SetExpiredAt sets the "expired_at" field.
 func (_u *TenantUpdateOne) SetExpiredAt(v time.Time) *TenantUpdateOne {
 	_u.mutation.SetExpiredAt(v)
@@ -1349,6 +1412,15 @@ func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err erro
 	if _u.mutation.SubscriptionPlanCleared() {
 		_spec.ClearField(tenant.FieldSubscriptionPlan, field.TypeString)
 	}
+	if value, ok := _u.mutation.PlanID(); ok {
+		_spec.SetField(tenant.FieldPlanID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedPlanID(); ok {
+		_spec.AddField(tenant.FieldPlanID, field.TypeUint32, value)
+	}
+	if _u.mutation.PlanIDCleared() {
+		_spec.ClearField(tenant.FieldPlanID, field.TypeUint32)
+	}
 	if value, ok := _u.mutation.ExpiredAt(); ok {
 		_spec.SetField(tenant.FieldExpiredAt, field.TypeTime, value)
 	}
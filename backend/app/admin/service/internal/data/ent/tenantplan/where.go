@@ -0,0 +1,1000 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantplan
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// UpdatedBy applies equality check predicate on the "updated_by" field. It's identical to UpdatedByEQ.
+func UpdatedBy(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// DeletedBy applies equality check predicate on the "deleted_by" field. It's identical to DeletedByEQ.
+func DeletedBy(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// Remark applies equality check predicate on the "remark" field. It's identical to RemarkEQ.
+func Remark(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldRemark, v))
+}
+
+// SortOrder applies equality check predicate on the "sort_order" field. It's identical to SortOrderEQ.
+func SortOrder(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldName, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCode, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDescription, v))
+}
+
+// MaxUsers applies equality check predicate on the "max_users" field. It's identical to MaxUsersEQ.
+func MaxUsers(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// MaxStorageBytes applies equality check predicate on the "max_storage_bytes" field. It's identical to MaxStorageBytesEQ.
+func MaxStorageBytes(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxStorageBytes, v))
+}
+
+// MaxAPIRequestsPerDay applies equality check predicate on the "max_api_requests_per_day" field. It's identical to MaxAPIRequestsPerDayEQ.
+func MaxAPIRequestsPerDay(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxAPIRequestsPerDay, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// UpdatedByEQ applies the EQ predicate on the "updated_by" field.
+func UpdatedByEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByNEQ applies the NEQ predicate on the "updated_by" field.
+func UpdatedByNEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldUpdatedBy, v))
+}
+
+// UpdatedByIn applies the In predicate on the "updated_by" field.
+func UpdatedByIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByNotIn applies the NotIn predicate on the "updated_by" field.
+func UpdatedByNotIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldUpdatedBy, vs...))
+}
+
+// UpdatedByGT applies the GT predicate on the "updated_by" field.
+func UpdatedByGT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldUpdatedBy, v))
+}
+
+// UpdatedByGTE applies the GTE predicate on the "updated_by" field.
+func UpdatedByGTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByLT applies the LT predicate on the "updated_by" field.
+func UpdatedByLT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldUpdatedBy, v))
+}
+
+// UpdatedByLTE applies the LTE predicate on the "updated_by" field.
+func UpdatedByLTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldUpdatedBy, v))
+}
+
+// UpdatedByIsNil applies the IsNil predicate on the "updated_by" field.
+func UpdatedByIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldUpdatedBy))
+}
+
+// UpdatedByNotNil applies the NotNil predicate on the "updated_by" field.
+func UpdatedByNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldUpdatedBy))
+}
+
+// DeletedByEQ applies the EQ predicate on the "deleted_by" field.
+func DeletedByEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDeletedBy, v))
+}
+
+// DeletedByNEQ applies the NEQ predicate on the "deleted_by" field.
+func DeletedByNEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldDeletedBy, v))
+}
+
+// DeletedByIn applies the In predicate on the "deleted_by" field.
+func DeletedByIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByNotIn applies the NotIn predicate on the "deleted_by" field.
+func DeletedByNotIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldDeletedBy, vs...))
+}
+
+// DeletedByGT applies the GT predicate on the "deleted_by" field.
+func DeletedByGT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldDeletedBy, v))
+}
+
+// DeletedByGTE applies the GTE predicate on the "deleted_by" field.
+func DeletedByGTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldDeletedBy, v))
+}
+
+// DeletedByLT applies the LT predicate on the "deleted_by" field.
+func DeletedByLT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldDeletedBy, v))
+}
+
+// DeletedByLTE applies the LTE predicate on the "deleted_by" field.
+func DeletedByLTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldDeletedBy, v))
+}
+
+// DeletedByIsNil applies the IsNil predicate on the "deleted_by" field.
+func DeletedByIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldDeletedBy))
+}
+
+// DeletedByNotNil applies the NotNil predicate on the "deleted_by" field.
+func DeletedByNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldDeletedBy))
+}
+
+// RemarkEQ applies the EQ predicate on the "remark" field.
+func RemarkEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldRemark, v))
+}
+
+// RemarkNEQ applies the NEQ predicate on the "remark" field.
+func RemarkNEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldRemark, v))
+}
+
+// RemarkIn applies the In predicate on the "remark" field.
+func RemarkIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldRemark, vs...))
+}
+
+// RemarkNotIn applies the NotIn predicate on the "remark" field.
+func RemarkNotIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldRemark, vs...))
+}
+
+// RemarkGT applies the GT predicate on the "remark" field.
+func RemarkGT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldRemark, v))
+}
+
+// RemarkGTE applies the GTE predicate on the "remark" field.
+func RemarkGTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldRemark, v))
+}
+
+// RemarkLT applies the LT predicate on the "remark" field.
+func RemarkLT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldRemark, v))
+}
+
+// RemarkLTE applies the LTE predicate on the "remark" field.
+func RemarkLTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldRemark, v))
+}
+
+// RemarkContains applies the Contains predicate on the "remark" field.
+func RemarkContains(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContains(FieldRemark, v))
+}
+
+// RemarkHasPrefix applies the HasPrefix predicate on the "remark" field.
+func RemarkHasPrefix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasPrefix(FieldRemark, v))
+}
+
+// RemarkHasSuffix applies the HasSuffix predicate on the "remark" field.
+func RemarkHasSuffix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasSuffix(FieldRemark, v))
+}
+
+// RemarkIsNil applies the IsNil predicate on the "remark" field.
+func RemarkIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldRemark))
+}
+
+// RemarkNotNil applies the NotNil predicate on the "remark" field.
+func RemarkNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldRemark))
+}
+
+// RemarkEqualFold applies the EqualFold predicate on the "remark" field.
+func RemarkEqualFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEqualFold(FieldRemark, v))
+}
+
+// RemarkContainsFold applies the ContainsFold predicate on the "remark" field.
+func RemarkContainsFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContainsFold(FieldRemark, v))
+}
+
+// SortOrderEQ applies the EQ predicate on the "sort_order" field.
+func SortOrderEQ(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldSortOrder, v))
+}
+
+// SortOrderNEQ applies the NEQ predicate on the "sort_order" field.
+func SortOrderNEQ(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldSortOrder, v))
+}
+
+// SortOrderIn applies the In predicate on the "sort_order" field.
+func SortOrderIn(vs ...int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldSortOrder, vs...))
+}
+
+// SortOrderNotIn applies the NotIn predicate on the "sort_order" field.
+func SortOrderNotIn(vs ...int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldSortOrder, vs...))
+}
+
+// SortOrderGT applies the GT predicate on the "sort_order" field.
+func SortOrderGT(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldSortOrder, v))
+}
+
+// SortOrderGTE applies the GTE predicate on the "sort_order" field.
+func SortOrderGTE(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldSortOrder, v))
+}
+
+// SortOrderLT applies the LT predicate on the "sort_order" field.
+func SortOrderLT(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldSortOrder, v))
+}
+
+// SortOrderLTE applies the LTE predicate on the "sort_order" field.
+func SortOrderLTE(v int32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldSortOrder, v))
+}
+
+// SortOrderIsNil applies the IsNil predicate on the "sort_order" field.
+func SortOrderIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldSortOrder))
+}
+
+// SortOrderNotNil applies the NotNil predicate on the "sort_order" field.
+func SortOrderNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldSortOrder))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContainsFold(FieldName, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeIsNil applies the IsNil predicate on the "code" field.
+func CodeIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldCode))
+}
+
+// CodeNotNil applies the NotNil predicate on the "code" field.
+func CodeNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldCode))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContainsFold(FieldCode, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusIsNil applies the IsNil predicate on the "status" field.
+func StatusIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldStatus))
+}
+
+// StatusNotNil applies the NotNil predicate on the "status" field.
+func StatusNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldStatus))
+}
+
+// MaxUsersEQ applies the EQ predicate on the "max_users" field.
+func MaxUsersEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersNEQ applies the NEQ predicate on the "max_users" field.
+func MaxUsersNEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldMaxUsers, v))
+}
+
+// MaxUsersIn applies the In predicate on the "max_users" field.
+func MaxUsersIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersNotIn applies the NotIn predicate on the "max_users" field.
+func MaxUsersNotIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldMaxUsers, vs...))
+}
+
+// MaxUsersGT applies the GT predicate on the "max_users" field.
+func MaxUsersGT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldMaxUsers, v))
+}
+
+// MaxUsersGTE applies the GTE predicate on the "max_users" field.
+func MaxUsersGTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldMaxUsers, v))
+}
+
+// MaxUsersLT applies the LT predicate on the "max_users" field.
+func MaxUsersLT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldMaxUsers, v))
+}
+
+// MaxUsersLTE applies the LTE predicate on the "max_users" field.
+func MaxUsersLTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldMaxUsers, v))
+}
+
+// MaxUsersIsNil applies the IsNil predicate on the "max_users" field.
+func MaxUsersIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldMaxUsers))
+}
+
+// MaxUsersNotNil applies the NotNil predicate on the "max_users" field.
+func MaxUsersNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldMaxUsers))
+}
+
+// MaxStorageBytesEQ applies the EQ predicate on the "max_storage_bytes" field.
+func MaxStorageBytesEQ(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesNEQ applies the NEQ predicate on the "max_storage_bytes" field.
+func MaxStorageBytesNEQ(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesIn applies the In predicate on the "max_storage_bytes" field.
+func MaxStorageBytesIn(vs ...uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldMaxStorageBytes, vs...))
+}
+
+// MaxStorageBytesNotIn applies the NotIn predicate on the "max_storage_bytes" field.
+func MaxStorageBytesNotIn(vs ...uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldMaxStorageBytes, vs...))
+}
+
+// MaxStorageBytesGT applies the GT predicate on the "max_storage_bytes" field.
+func MaxStorageBytesGT(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesGTE applies the GTE predicate on the "max_storage_bytes" field.
+func MaxStorageBytesGTE(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesLT applies the LT predicate on the "max_storage_bytes" field.
+func MaxStorageBytesLT(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesLTE applies the LTE predicate on the "max_storage_bytes" field.
+func MaxStorageBytesLTE(v uint64) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldMaxStorageBytes, v))
+}
+
+// MaxStorageBytesIsNil applies the IsNil predicate on the "max_storage_bytes" field.
+func MaxStorageBytesIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldMaxStorageBytes))
+}
+
+// MaxStorageBytesNotNil applies the NotNil predicate on the "max_storage_bytes" field.
+func MaxStorageBytesNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldMaxStorageBytes))
+}
+
+// MaxAPIRequestsPerDayEQ applies the EQ predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldEQ(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayNEQ applies the NEQ predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayNEQ(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNEQ(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayIn applies the In predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIn(FieldMaxAPIRequestsPerDay, vs...))
+}
+
+// MaxAPIRequestsPerDayNotIn applies the NotIn predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayNotIn(vs ...uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotIn(FieldMaxAPIRequestsPerDay, vs...))
+}
+
+// MaxAPIRequestsPerDayGT applies the GT predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayGT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGT(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayGTE applies the GTE predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayGTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldGTE(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayLT applies the LT predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayLT(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLT(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayLTE applies the LTE predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayLTE(v uint32) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldLTE(FieldMaxAPIRequestsPerDay, v))
+}
+
+// MaxAPIRequestsPerDayIsNil applies the IsNil predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldMaxAPIRequestsPerDay))
+}
+
+// MaxAPIRequestsPerDayNotNil applies the NotNil predicate on the "max_api_requests_per_day" field.
+func MaxAPIRequestsPerDayNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldMaxAPIRequestsPerDay))
+}
+
+// ModulesIsNil applies the IsNil predicate on the "modules" field.
+func ModulesIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldModules))
+}
+
+// ModulesNotNil applies the NotNil predicate on the "modules" field.
+func ModulesNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldModules))
+}
+
+// MenusIsNil applies the IsNil predicate on the "menus" field.
+func MenusIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldMenus))
+}
+
+// MenusNotNil applies the NotNil predicate on the "menus" field.
+func MenusNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldMenus))
+}
+
+// FeaturesIsNil applies the IsNil predicate on the "features" field.
+func FeaturesIsNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldIsNull(FieldFeatures))
+}
+
+// FeaturesNotNil applies the NotNil predicate on the "features" field.
+func FeaturesNotNil() predicate.TenantPlan {
+	return predicate.TenantPlan(sql.FieldNotNull(FieldFeatures))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantPlan) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantPlan) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantPlan) predicate.TenantPlan {
+	return predicate.TenantPlan(sql.NotPredicates(p))
+}
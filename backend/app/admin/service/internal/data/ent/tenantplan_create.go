@@ -0,0 +1,1944 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantPlanCreate is the builder for creating a TenantPlan entity.
+type TenantPlanCreate struct {
+	config
+	mutation *TenantPlanMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantPlanCreate) SetCreatedAt(v time.Time) *TenantPlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableCreatedAt(v *time.Time) *TenantPlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TenantPlanCreate) SetUpdatedAt(v time.Time) *TenantPlanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableUpdatedAt(v *time.Time) *TenantPlanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TenantPlanCreate) SetDeletedAt(v time.Time) *TenantPlanCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableDeletedAt(v *time.Time) *TenantPlanCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *TenantPlanCreate) SetCreatedBy(v uint32) *TenantPlanCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableCreatedBy(v *uint32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_c *TenantPlanCreate) SetUpdatedBy(v uint32) *TenantPlanCreate {
+	_c.mutation.SetUpdatedBy(v)
+	return _c
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableUpdatedBy(v *uint32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetUpdatedBy(*v)
+	}
+	return _c
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_c *TenantPlanCreate) SetDeletedBy(v uint32) *TenantPlanCreate {
+	_c.mutation.SetDeletedBy(v)
+	return _c
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableDeletedBy(v *uint32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetDeletedBy(*v)
+	}
+	return _c
+}
+
+// SetRemark sets the "remark" field.
+func (_c *TenantPlanCreate) SetRemark(v string) *TenantPlanCreate {
+	_c.mutation.SetRemark(v)
+	return _c
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableRemark(v *string) *TenantPlanCreate {
+	if v != nil {
+		_c.SetRemark(*v)
+	}
+	return _c
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_c *TenantPlanCreate) SetSortOrder(v int32) *TenantPlanCreate {
+	_c.mutation.SetSortOrder(v)
+	return _c
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableSortOrder(v *int32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetSortOrder(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *TenantPlanCreate) SetName(v string) *TenantPlanCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableName(v *string) *TenantPlanCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *TenantPlanCreate) SetCode(v string) *TenantPlanCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableCode(v *string) *TenantPlanCreate {
+	if v != nil {
+		_c.SetCode(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *TenantPlanCreate) SetDescription(v string) *TenantPlanCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableDescription(v *string) *TenantPlanCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *TenantPlanCreate) SetStatus(v tenantplan.Status) *TenantPlanCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableStatus(v *tenantplan.Status) *TenantPlanCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_c *TenantPlanCreate) SetMaxUsers(v uint32) *TenantPlanCreate {
+	_c.mutation.SetMaxUsers(v)
+	return _c
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableMaxUsers(v *uint32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetMaxUsers(*v)
+	}
+	return _c
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (_c *TenantPlanCreate) SetMaxStorageBytes(v uint64) *TenantPlanCreate {
+	_c.mutation.SetMaxStorageBytes(v)
+	return _c
+}
+
+// SetNillableMaxStorageBytes sets the "max_storage_bytes" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableMaxStorageBytes(v *uint64) *TenantPlanCreate {
+	if v != nil {
+		_c.SetMaxStorageBytes(*v)
+	}
+	return _c
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (_c *TenantPlanCreate) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanCreate {
+	_c.mutation.SetMaxAPIRequestsPerDay(v)
+	return _c
+}
+
+// SetNillableMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field if the given value is not nil.
+func (_c *TenantPlanCreate) SetNillableMaxAPIRequestsPerDay(v *uint32) *TenantPlanCreate {
+	if v != nil {
+		_c.SetMaxAPIRequestsPerDay(*v)
+	}
+	return _c
+}
+
+// SetModules sets the "modules" field.
+func (_c *TenantPlanCreate) SetModules(v []string) *TenantPlanCreate {
+	_c.mutation.SetModules(v)
+	return _c
+}
+
+// SetMenus sets the "menus" field.
+func (_c *TenantPlanCreate) SetMenus(v []uint32) *TenantPlanCreate {
+	_c.mutation.SetMenus(v)
+	return _c
+}
+
+// SetFeatures sets the "features" field.
+func (_c *TenantPlanCreate) SetFeatures(v map[string]bool) *TenantPlanCreate {
+	_c.mutation.SetFeatures(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantPlanCreate) SetID(v uint32) *TenantPlanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantPlanMutation object of the builder.
+func (_c *TenantPlanCreate) Mutation() *TenantPlanMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantPlan in the database.
+func (_c *TenantPlanCreate) Save(ctx context.Context) (*TenantPlan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantPlanCreate) SaveX(ctx context.Context) *TenantPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantPlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantPlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantPlanCreate) defaults() {
+	if _, ok := _c.mutation.SortOrder(); !ok {
+		v := tenantplan.DefaultSortOrder
+		_c.mutation.SetSortOrder(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := tenantplan.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.MaxUsers(); !ok {
+		v := tenantplan.DefaultMaxUsers
+		_c.mutation.SetMaxUsers(v)
+	}
+	if _, ok := _c.mutation.MaxStorageBytes(); !ok {
+		v := tenantplan.DefaultMaxStorageBytes
+		_c.mutation.SetMaxStorageBytes(v)
+	}
+	if _, ok := _c.mutation.MaxAPIRequestsPerDay(); !ok {
+		v := tenantplan.DefaultMaxAPIRequestsPerDay
+		_c.mutation.SetMaxAPIRequestsPerDay(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantPlanCreate) check() error {
+	if v, ok := _c.mutation.Name(); ok {
+		if err := tenantplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := tenantplan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.code": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := tenantplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.status": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantplan.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantPlanCreate) sqlSave(ctx context.Context) (*TenantPlan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantPlanCreate) createSpec() (*TenantPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantPlan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantplan.Table, sqlgraph.NewFieldSpec(tenantplan.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantplan.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(tenantplan.FieldCreatedBy, field.TypeUint32, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := _c.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedBy, field.TypeUint32, value)
+		_node.UpdatedBy = &value
+	}
+	if value, ok := _c.mutation.DeletedBy(); ok {
+		_spec.SetField(tenantplan.FieldDeletedBy, field.TypeUint32, value)
+		_node.DeletedBy = &value
+	}
+	if value, ok := _c.mutation.Remark(); ok {
+		_spec.SetField(tenantplan.FieldRemark, field.TypeString, value)
+		_node.Remark = &value
+	}
+	if value, ok := _c.mutation.SortOrder(); ok {
+		_spec.SetField(tenantplan.FieldSortOrder, field.TypeInt32, value)
+		_node.SortOrder = &value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(tenantplan.FieldName, field.TypeString, value)
+		_node.Name = &value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(tenantplan.FieldCode, field.TypeString, value)
+		_node.Code = &value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(tenantplan.FieldDescription, field.TypeString, value)
+		_node.Description = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(tenantplan.FieldStatus, field.TypeEnum, value)
+		_node.Status = &value
+	}
+	if value, ok := _c.mutation.MaxUsers(); ok {
+		_spec.SetField(tenantplan.FieldMaxUsers, field.TypeUint32, value)
+		_node.MaxUsers = &value
+	}
+	if value, ok := _c.mutation.MaxStorageBytes(); ok {
+		_spec.SetField(tenantplan.FieldMaxStorageBytes, field.TypeUint64, value)
+		_node.MaxStorageBytes = &value
+	}
+	if value, ok := _c.mutation.MaxAPIRequestsPerDay(); ok {
+		_spec.SetField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32, value)
+		_node.MaxAPIRequestsPerDay = &value
+	}
+	if value, ok := _c.mutation.Modules(); ok {
+		_spec.SetField(tenantplan.FieldModules, field.TypeJSON, value)
+		_node.Modules = value
+	}
+	if value, ok := _c.mutation.Menus(); ok {
+		_spec.SetField(tenantplan.FieldMenus, field.TypeJSON, value)
+		_node.Menus = value
+	}
+	if value, ok := _c.mutation.Features(); ok {
+		_spec.SetField(tenantplan.FieldFeatures, field.TypeJSON, value)
+		_node.Features = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantPlan.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantPlanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantPlanCreate) OnConflict(opts ...sql.ConflictOption) *TenantPlanUpsertOne {
+	_c.conflict = opts
+	return &TenantPlanUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantPlanCreate) OnConflictColumns(columns ...string) *TenantPlanUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantPlanUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantPlanUpsertOne is the builder for "upsert"-ing
+	//  one TenantPlan node.
+	TenantPlanUpsertOne struct {
+		create *TenantPlanCreate
+	}
+
+	// TenantPlanUpsert is the "OnConflict" setter.
+	TenantPlanUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantPlanUpsert) SetUpdatedAt(v time.Time) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateUpdatedAt() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantPlanUpsert) ClearUpdatedAt() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantPlanUpsert) SetDeletedAt(v time.Time) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateDeletedAt() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantPlanUpsert) ClearDeletedAt() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldDeletedAt)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TenantPlanUpsert) SetCreatedBy(v uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateCreatedBy() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TenantPlanUpsert) AddCreatedBy(v uint32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TenantPlanUpsert) ClearCreatedBy() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldCreatedBy)
+	return u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantPlanUpsert) SetUpdatedBy(v uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldUpdatedBy, v)
+	return u
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateUpdatedBy() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldUpdatedBy)
+	return u
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantPlanUpsert) AddUpdatedBy(v uint32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldUpdatedBy, v)
+	return u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantPlanUpsert) ClearUpdatedBy() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldUpdatedBy)
+	return u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TenantPlanUpsert) SetDeletedBy(v uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldDeletedBy, v)
+	return u
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateDeletedBy() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldDeletedBy)
+	return u
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TenantPlanUpsert) AddDeletedBy(v uint32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldDeletedBy, v)
+	return u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TenantPlanUpsert) ClearDeletedBy() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldDeletedBy)
+	return u
+}
+
+// SetRemark sets the "remark" field.
+func (u *TenantPlanUpsert) SetRemark(v string) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldRemark, v)
+	return u
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateRemark() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldRemark)
+	return u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *TenantPlanUpsert) ClearRemark() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldRemark)
+	return u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *TenantPlanUpsert) SetSortOrder(v int32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldSortOrder, v)
+	return u
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateSortOrder() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldSortOrder)
+	return u
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *TenantPlanUpsert) AddSortOrder(v int32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldSortOrder, v)
+	return u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *TenantPlanUpsert) ClearSortOrder() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldSortOrder)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TenantPlanUpsert) SetName(v string) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateName() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TenantPlanUpsert) ClearName() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldName)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *TenantPlanUpsert) SetCode(v string) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateCode() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldCode)
+	return u
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *TenantPlanUpsert) ClearCode() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldCode)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *TenantPlanUpsert) SetDescription(v string) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateDescription() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TenantPlanUpsert) ClearDescription() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldDescription)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantPlanUpsert) SetStatus(v tenantplan.Status) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateStatus() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldStatus)
+	return u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TenantPlanUpsert) ClearStatus() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldStatus)
+	return u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *TenantPlanUpsert) SetMaxUsers(v uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldMaxUsers, v)
+	return u
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateMaxUsers() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldMaxUsers)
+	return u
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *TenantPlanUpsert) AddMaxUsers(v uint32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldMaxUsers, v)
+	return u
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *TenantPlanUpsert) ClearMaxUsers() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldMaxUsers)
+	return u
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (u *TenantPlanUpsert) SetMaxStorageBytes(v uint64) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldMaxStorageBytes, v)
+	return u
+}
+
+// UpdateMaxStorageBytes sets the "max_storage_bytes" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateMaxStorageBytes() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldMaxStorageBytes)
+	return u
+}
+
+// AddMaxStorageBytes adds v to the "max_storage_bytes" field.
+func (u *TenantPlanUpsert) AddMaxStorageBytes(v uint64) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldMaxStorageBytes, v)
+	return u
+}
+
+// ClearMaxStorageBytes clears the value of the "max_storage_bytes" field.
+func (u *TenantPlanUpsert) ClearMaxStorageBytes() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldMaxStorageBytes)
+	return u
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsert) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldMaxAPIRequestsPerDay, v)
+	return u
+}
+
+// UpdateMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateMaxAPIRequestsPerDay() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldMaxAPIRequestsPerDay)
+	return u
+}
+
+// AddMaxAPIRequestsPerDay adds v to the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsert) AddMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsert {
+	u.Add(tenantplan.FieldMaxAPIRequestsPerDay, v)
+	return u
+}
+
+// ClearMaxAPIRequestsPerDay clears the value of the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsert) ClearMaxAPIRequestsPerDay() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldMaxAPIRequestsPerDay)
+	return u
+}
+
+// SetModules sets the "modules" field.
+func (u *TenantPlanUpsert) SetModules(v []string) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldModules, v)
+	return u
+}
+
+// UpdateModules sets the "modules" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateModules() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldModules)
+	return u
+}
+
+// ClearModules clears the value of the "modules" field.
+func (u *TenantPlanUpsert) ClearModules() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldModules)
+	return u
+}
+
+// SetMenus sets the "menus" field.
+func (u *TenantPlanUpsert) SetMenus(v []uint32) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldMenus, v)
+	return u
+}
+
+// UpdateMenus sets the "menus" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateMenus() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldMenus)
+	return u
+}
+
+// ClearMenus clears the value of the "menus" field.
+func (u *TenantPlanUpsert) ClearMenus() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldMenus)
+	return u
+}
+
+// SetFeatures sets the "features" field.
+func (u *TenantPlanUpsert) SetFeatures(v map[string]bool) *TenantPlanUpsert {
+	u.Set(tenantplan.FieldFeatures, v)
+	return u
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *TenantPlanUpsert) UpdateFeatures() *TenantPlanUpsert {
+	u.SetExcluded(tenantplan.FieldFeatures)
+	return u
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (u *TenantPlanUpsert) ClearFeatures() *TenantPlanUpsert {
+	u.SetNull(tenantplan.FieldFeatures)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantplan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantPlanUpsertOne) UpdateNewValues() *TenantPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantplan.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantplan.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantPlanUpsertOne) Ignore() *TenantPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantPlanUpsertOne) DoNothing() *TenantPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantPlanCreate.OnConflict
+// documentation for more info.
+func (u *TenantPlanUpsertOne) Update(set func(*TenantPlanUpsert)) *TenantPlanUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantPlanUpsertOne) SetUpdatedAt(v time.Time) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateUpdatedAt() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantPlanUpsertOne) ClearUpdatedAt() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantPlanUpsertOne) SetDeletedAt(v time.Time) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateDeletedAt() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantPlanUpsertOne) ClearDeletedAt() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TenantPlanUpsertOne) SetCreatedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TenantPlanUpsertOne) AddCreatedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateCreatedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TenantPlanUpsertOne) ClearCreatedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantPlanUpsertOne) SetUpdatedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantPlanUpsertOne) AddUpdatedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateUpdatedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantPlanUpsertOne) ClearUpdatedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TenantPlanUpsertOne) SetDeletedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TenantPlanUpsertOne) AddDeletedBy(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateDeletedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TenantPlanUpsertOne) ClearDeletedBy() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *TenantPlanUpsertOne) SetRemark(v string) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateRemark() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *TenantPlanUpsertOne) ClearRemark() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *TenantPlanUpsertOne) SetSortOrder(v int32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *TenantPlanUpsertOne) AddSortOrder(v int32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateSortOrder() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *TenantPlanUpsertOne) ClearSortOrder() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearSortOrder()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TenantPlanUpsertOne) SetName(v string) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateName() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TenantPlanUpsertOne) ClearName() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *TenantPlanUpsertOne) SetCode(v string) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateCode() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *TenantPlanUpsertOne) ClearCode() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TenantPlanUpsertOne) SetDescription(v string) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateDescription() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TenantPlanUpsertOne) ClearDescription() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantPlanUpsertOne) SetStatus(v tenantplan.Status) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateStatus() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TenantPlanUpsertOne) ClearStatus() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *TenantPlanUpsertOne) SetMaxUsers(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *TenantPlanUpsertOne) AddMaxUsers(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateMaxUsers() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *TenantPlanUpsertOne) ClearMaxUsers() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (u *TenantPlanUpsertOne) SetMaxStorageBytes(v uint64) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxStorageBytes(v)
+	})
+}
+
+// AddMaxStorageBytes adds v to the "max_storage_bytes" field.
+func (u *TenantPlanUpsertOne) AddMaxStorageBytes(v uint64) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxStorageBytes(v)
+	})
+}
+
+// UpdateMaxStorageBytes sets the "max_storage_bytes" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateMaxStorageBytes() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxStorageBytes()
+	})
+}
+
+// ClearMaxStorageBytes clears the value of the "max_storage_bytes" field.
+func (u *TenantPlanUpsertOne) ClearMaxStorageBytes() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxStorageBytes()
+	})
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertOne) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxAPIRequestsPerDay(v)
+	})
+}
+
+// AddMaxAPIRequestsPerDay adds v to the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertOne) AddMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxAPIRequestsPerDay(v)
+	})
+}
+
+// UpdateMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateMaxAPIRequestsPerDay() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxAPIRequestsPerDay()
+	})
+}
+
+// ClearMaxAPIRequestsPerDay clears the value of the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertOne) ClearMaxAPIRequestsPerDay() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxAPIRequestsPerDay()
+	})
+}
+
+// SetModules sets the "modules" field.
+func (u *TenantPlanUpsertOne) SetModules(v []string) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetModules(v)
+	})
+}
+
+// UpdateModules sets the "modules" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateModules() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateModules()
+	})
+}
+
+// ClearModules clears the value of the "modules" field.
+func (u *TenantPlanUpsertOne) ClearModules() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearModules()
+	})
+}
+
+// SetMenus sets the "menus" field.
+func (u *TenantPlanUpsertOne) SetMenus(v []uint32) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMenus(v)
+	})
+}
+
+// UpdateMenus sets the "menus" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateMenus() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMenus()
+	})
+}
+
+// ClearMenus clears the value of the "menus" field.
+func (u *TenantPlanUpsertOne) ClearMenus() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMenus()
+	})
+}
+
+// SetFeatures sets the "features" field.
+func (u *TenantPlanUpsertOne) SetFeatures(v map[string]bool) *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetFeatures(v)
+	})
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *TenantPlanUpsertOne) UpdateFeatures() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateFeatures()
+	})
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (u *TenantPlanUpsertOne) ClearFeatures() *TenantPlanUpsertOne {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearFeatures()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantPlanUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantPlanCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantPlanUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantPlanUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantPlanUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantPlanCreateBulk is the builder for creating many TenantPlan entities in bulk.
+type TenantPlanCreateBulk struct {
+	config
+	err      error
+	builders []*TenantPlanCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantPlan entities in the database.
+func (_c *TenantPlanCreateBulk) Save(ctx context.Context) ([]*TenantPlan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantPlan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantPlanCreateBulk) SaveX(ctx context.Context) []*TenantPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantPlan.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantPlanUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantPlanCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantPlanUpsertBulk {
+	_c.conflict = opts
+	return &TenantPlanUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantPlanCreateBulk) OnConflictColumns(columns ...string) *TenantPlanUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantPlanUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantPlanUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantPlan nodes.
+type TenantPlanUpsertBulk struct {
+	create *TenantPlanCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantplan.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantPlanUpsertBulk) UpdateNewValues() *TenantPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantplan.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantplan.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantPlan.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantPlanUpsertBulk) Ignore() *TenantPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantPlanUpsertBulk) DoNothing() *TenantPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantPlanCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantPlanUpsertBulk) Update(set func(*TenantPlanUpsert)) *TenantPlanUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantPlanUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantPlanUpsertBulk) SetUpdatedAt(v time.Time) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateUpdatedAt() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantPlanUpsertBulk) ClearUpdatedAt() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantPlanUpsertBulk) SetDeletedAt(v time.Time) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateDeletedAt() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantPlanUpsertBulk) ClearDeletedAt() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *TenantPlanUpsertBulk) SetCreatedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *TenantPlanUpsertBulk) AddCreatedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateCreatedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *TenantPlanUpsertBulk) ClearCreatedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (u *TenantPlanUpsertBulk) SetUpdatedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetUpdatedBy(v)
+	})
+}
+
+// AddUpdatedBy adds v to the "updated_by" field.
+func (u *TenantPlanUpsertBulk) AddUpdatedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddUpdatedBy(v)
+	})
+}
+
+// UpdateUpdatedBy sets the "updated_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateUpdatedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateUpdatedBy()
+	})
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (u *TenantPlanUpsertBulk) ClearUpdatedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearUpdatedBy()
+	})
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (u *TenantPlanUpsertBulk) SetDeletedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDeletedBy(v)
+	})
+}
+
+// AddDeletedBy adds v to the "deleted_by" field.
+func (u *TenantPlanUpsertBulk) AddDeletedBy(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddDeletedBy(v)
+	})
+}
+
+// UpdateDeletedBy sets the "deleted_by" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateDeletedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDeletedBy()
+	})
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (u *TenantPlanUpsertBulk) ClearDeletedBy() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDeletedBy()
+	})
+}
+
+// SetRemark sets the "remark" field.
+func (u *TenantPlanUpsertBulk) SetRemark(v string) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetRemark(v)
+	})
+}
+
+// UpdateRemark sets the "remark" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateRemark() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateRemark()
+	})
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (u *TenantPlanUpsertBulk) ClearRemark() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearRemark()
+	})
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (u *TenantPlanUpsertBulk) SetSortOrder(v int32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetSortOrder(v)
+	})
+}
+
+// AddSortOrder adds v to the "sort_order" field.
+func (u *TenantPlanUpsertBulk) AddSortOrder(v int32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddSortOrder(v)
+	})
+}
+
+// UpdateSortOrder sets the "sort_order" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateSortOrder() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateSortOrder()
+	})
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (u *TenantPlanUpsertBulk) ClearSortOrder() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearSortOrder()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TenantPlanUpsertBulk) SetName(v string) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateName() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TenantPlanUpsertBulk) ClearName() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *TenantPlanUpsertBulk) SetCode(v string) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateCode() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// ClearCode clears the value of the "code" field.
+func (u *TenantPlanUpsertBulk) ClearCode() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearCode()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *TenantPlanUpsertBulk) SetDescription(v string) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateDescription() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *TenantPlanUpsertBulk) ClearDescription() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *TenantPlanUpsertBulk) SetStatus(v tenantplan.Status) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateStatus() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// ClearStatus clears the value of the "status" field.
+func (u *TenantPlanUpsertBulk) ClearStatus() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearStatus()
+	})
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (u *TenantPlanUpsertBulk) SetMaxUsers(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxUsers(v)
+	})
+}
+
+// AddMaxUsers adds v to the "max_users" field.
+func (u *TenantPlanUpsertBulk) AddMaxUsers(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxUsers(v)
+	})
+}
+
+// UpdateMaxUsers sets the "max_users" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateMaxUsers() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxUsers()
+	})
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (u *TenantPlanUpsertBulk) ClearMaxUsers() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxUsers()
+	})
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (u *TenantPlanUpsertBulk) SetMaxStorageBytes(v uint64) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxStorageBytes(v)
+	})
+}
+
+// AddMaxStorageBytes adds v to the "max_storage_bytes" field.
+func (u *TenantPlanUpsertBulk) AddMaxStorageBytes(v uint64) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxStorageBytes(v)
+	})
+}
+
+// UpdateMaxStorageBytes sets the "max_storage_bytes" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateMaxStorageBytes() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxStorageBytes()
+	})
+}
+
+// ClearMaxStorageBytes clears the value of the "max_storage_bytes" field.
+func (u *TenantPlanUpsertBulk) ClearMaxStorageBytes() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxStorageBytes()
+	})
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertBulk) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMaxAPIRequestsPerDay(v)
+	})
+}
+
+// AddMaxAPIRequestsPerDay adds v to the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertBulk) AddMaxAPIRequestsPerDay(v uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.AddMaxAPIRequestsPerDay(v)
+	})
+}
+
+// UpdateMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateMaxAPIRequestsPerDay() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMaxAPIRequestsPerDay()
+	})
+}
+
+// ClearMaxAPIRequestsPerDay clears the value of the "max_api_requests_per_day" field.
+func (u *TenantPlanUpsertBulk) ClearMaxAPIRequestsPerDay() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMaxAPIRequestsPerDay()
+	})
+}
+
+// SetModules sets the "modules" field.
+func (u *TenantPlanUpsertBulk) SetModules(v []string) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetModules(v)
+	})
+}
+
+// UpdateModules sets the "modules" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateModules() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateModules()
+	})
+}
+
+// ClearModules clears the value of the "modules" field.
+func (u *TenantPlanUpsertBulk) ClearModules() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearModules()
+	})
+}
+
+// SetMenus sets the "menus" field.
+func (u *TenantPlanUpsertBulk) SetMenus(v []uint32) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetMenus(v)
+	})
+}
+
+// UpdateMenus sets the "menus" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateMenus() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateMenus()
+	})
+}
+
+// ClearMenus clears the value of the "menus" field.
+func (u *TenantPlanUpsertBulk) ClearMenus() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearMenus()
+	})
+}
+
+// SetFeatures sets the "features" field.
+func (u *TenantPlanUpsertBulk) SetFeatures(v map[string]bool) *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.SetFeatures(v)
+	})
+}
+
+// UpdateFeatures sets the "features" field to the value that was provided on create.
+func (u *TenantPlanUpsertBulk) UpdateFeatures() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.UpdateFeatures()
+	})
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (u *TenantPlanUpsertBulk) ClearFeatures() *TenantPlanUpsertBulk {
+	return u.Update(func(s *TenantPlanUpsert) {
+		s.ClearFeatures()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantPlanUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantPlanCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantPlanCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantPlanUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
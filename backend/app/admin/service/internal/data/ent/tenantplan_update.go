@@ -0,0 +1,1265 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantPlanUpdate is the builder for updating TenantPlan entities.
+type TenantPlanUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantPlanMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantPlanUpdate builder.
+func (_u *TenantPlanUpdate) Where(ps ...predicate.TenantPlan) *TenantPlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantPlanUpdate) SetUpdatedAt(v time.Time) *TenantPlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableUpdatedAt(v *time.Time) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantPlanUpdate) ClearUpdatedAt() *TenantPlanUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TenantPlanUpdate) SetDeletedAt(v time.Time) *TenantPlanUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableDeletedAt(v *time.Time) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TenantPlanUpdate) ClearDeletedAt() *TenantPlanUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TenantPlanUpdate) SetCreatedBy(v uint32) *TenantPlanUpdate {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableCreatedBy(v *uint32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TenantPlanUpdate) AddCreatedBy(v int32) *TenantPlanUpdate {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TenantPlanUpdate) ClearCreatedBy() *TenantPlanUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TenantPlanUpdate) SetUpdatedBy(v uint32) *TenantPlanUpdate {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableUpdatedBy(v *uint32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TenantPlanUpdate) AddUpdatedBy(v int32) *TenantPlanUpdate {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TenantPlanUpdate) ClearUpdatedBy() *TenantPlanUpdate {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TenantPlanUpdate) SetDeletedBy(v uint32) *TenantPlanUpdate {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableDeletedBy(v *uint32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TenantPlanUpdate) AddDeletedBy(v int32) *TenantPlanUpdate {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TenantPlanUpdate) ClearDeletedBy() *TenantPlanUpdate {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *TenantPlanUpdate) SetRemark(v string) *TenantPlanUpdate {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableRemark(v *string) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *TenantPlanUpdate) ClearRemark() *TenantPlanUpdate {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *TenantPlanUpdate) SetSortOrder(v int32) *TenantPlanUpdate {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableSortOrder(v *int32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *TenantPlanUpdate) AddSortOrder(v int32) *TenantPlanUpdate {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (_u *TenantPlanUpdate) ClearSortOrder() *TenantPlanUpdate {
+	_u.mutation.ClearSortOrder()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantPlanUpdate) SetName(v string) *TenantPlanUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableName(v *string) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TenantPlanUpdate) ClearName() *TenantPlanUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *TenantPlanUpdate) SetCode(v string) *TenantPlanUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableCode(v *string) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *TenantPlanUpdate) ClearCode() *TenantPlanUpdate {
+	_u.mutation.ClearCode()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantPlanUpdate) SetDescription(v string) *TenantPlanUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableDescription(v *string) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantPlanUpdate) ClearDescription() *TenantPlanUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantPlanUpdate) SetStatus(v tenantplan.Status) *TenantPlanUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableStatus(v *tenantplan.Status) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantPlanUpdate) ClearStatus() *TenantPlanUpdate {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_u *TenantPlanUpdate) SetMaxUsers(v uint32) *TenantPlanUpdate {
+	_u.mutation.ResetMaxUsers()
+	_u.mutation.SetMaxUsers(v)
+	return _u
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableMaxUsers(v *uint32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetMaxUsers(*v)
+	}
+	return _u
+}
+
+// AddMaxUsers adds value to the "max_users" field.
+func (_u *TenantPlanUpdate) AddMaxUsers(v int32) *TenantPlanUpdate {
+	_u.mutation.AddMaxUsers(v)
+	return _u
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (_u *TenantPlanUpdate) ClearMaxUsers() *TenantPlanUpdate {
+	_u.mutation.ClearMaxUsers()
+	return _u
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (_u *TenantPlanUpdate) SetMaxStorageBytes(v uint64) *TenantPlanUpdate {
+	_u.mutation.ResetMaxStorageBytes()
+	_u.mutation.SetMaxStorageBytes(v)
+	return _u
+}
+
+// SetNillableMaxStorageBytes sets the "max_storage_bytes" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableMaxStorageBytes(v *uint64) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetMaxStorageBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxStorageBytes adds value to the "max_storage_bytes" field.
+func (_u *TenantPlanUpdate) AddMaxStorageBytes(v int64) *TenantPlanUpdate {
+	_u.mutation.AddMaxStorageBytes(v)
+	return _u
+}
+
+// ClearMaxStorageBytes clears the value of the "max_storage_bytes" field.
+func (_u *TenantPlanUpdate) ClearMaxStorageBytes() *TenantPlanUpdate {
+	_u.mutation.ClearMaxStorageBytes()
+	return _u
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdate) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanUpdate {
+	_u.mutation.ResetMaxAPIRequestsPerDay()
+	_u.mutation.SetMaxAPIRequestsPerDay(v)
+	return _u
+}
+
+// SetNillableMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field if the given value is not nil.
+func (_u *TenantPlanUpdate) SetNillableMaxAPIRequestsPerDay(v *uint32) *TenantPlanUpdate {
+	if v != nil {
+		_u.SetMaxAPIRequestsPerDay(*v)
+	}
+	return _u
+}
+
+// AddMaxAPIRequestsPerDay adds value to the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdate) AddMaxAPIRequestsPerDay(v int32) *TenantPlanUpdate {
+	_u.mutation.AddMaxAPIRequestsPerDay(v)
+	return _u
+}
+
+// ClearMaxAPIRequestsPerDay clears the value of the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdate) ClearMaxAPIRequestsPerDay() *TenantPlanUpdate {
+	_u.mutation.ClearMaxAPIRequestsPerDay()
+	return _u
+}
+
+// SetModules sets the "modules" field.
+func (_u *TenantPlanUpdate) SetModules(v []string) *TenantPlanUpdate {
+	_u.mutation.SetModules(v)
+	return _u
+}
+
+// AppendModules appends value to the "modules" field.
+func (_u *TenantPlanUpdate) AppendModules(v []string) *TenantPlanUpdate {
+	_u.mutation.AppendModules(v)
+	return _u
+}
+
+// ClearModules clears the value of the "modules" field.
+func (_u *TenantPlanUpdate) ClearModules() *TenantPlanUpdate {
+	_u.mutation.ClearModules()
+	return _u
+}
+
+// SetMenus sets the "menus" field.
+func (_u *TenantPlanUpdate) SetMenus(v []uint32) *TenantPlanUpdate {
+	_u.mutation.SetMenus(v)
+	return _u
+}
+
+// AppendMenus appends value to the "menus" field.
+func (_u *TenantPlanUpdate) AppendMenus(v []uint32) *TenantPlanUpdate {
+	_u.mutation.AppendMenus(v)
+	return _u
+}
+
+// ClearMenus clears the value of the "menus" field.
+func (_u *TenantPlanUpdate) ClearMenus() *TenantPlanUpdate {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// SetFeatures sets the "features" field.
+func (_u *TenantPlanUpdate) SetFeatures(v map[string]bool) *TenantPlanUpdate {
+	_u.mutation.SetFeatures(v)
+	return _u
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (_u *TenantPlanUpdate) ClearFeatures() *TenantPlanUpdate {
+	_u.mutation.ClearFeatures()
+	return _u
+}
+
+// Mutation returns the TenantPlanMutation object of the builder.
+func (_u *TenantPlanUpdate) Mutation() *TenantPlanMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantPlanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantPlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantPlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantPlanUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := tenantplan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantPlanUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantPlanUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantplan.Table, tenantplan.Columns, sqlgraph.NewFieldSpec(tenantplan.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantplan.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantplan.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantplan.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(tenantplan.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tenantplan.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(tenantplan.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(tenantplan.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(tenantplan.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantplan.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(tenantplan.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(tenantplan.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(tenantplan.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(tenantplan.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(tenantplan.FieldRemark, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(tenantplan.FieldSortOrder, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(tenantplan.FieldSortOrder, field.TypeInt32, value)
+	}
+	if _u.mutation.SortOrderCleared() {
+		_spec.ClearField(tenantplan.FieldSortOrder, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantplan.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(tenantplan.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(tenantplan.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(tenantplan.FieldCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantplan.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantplan.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantplan.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxUsers(); ok {
+		_spec.SetField(tenantplan.FieldMaxUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(tenantplan.FieldMaxUsers, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxUsersCleared() {
+		_spec.ClearField(tenantplan.FieldMaxUsers, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxStorageBytes(); ok {
+		_spec.SetField(tenantplan.FieldMaxStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStorageBytes(); ok {
+		_spec.AddField(tenantplan.FieldMaxStorageBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.MaxStorageBytesCleared() {
+		_spec.ClearField(tenantplan.FieldMaxStorageBytes, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MaxAPIRequestsPerDay(); ok {
+		_spec.SetField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAPIRequestsPerDay(); ok {
+		_spec.AddField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxAPIRequestsPerDayCleared() {
+		_spec.ClearField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Modules(); ok {
+		_spec.SetField(tenantplan.FieldModules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantplan.FieldModules, value)
+		})
+	}
+	if _u.mutation.ModulesCleared() {
+		_spec.ClearField(tenantplan.FieldModules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Menus(); ok {
+		_spec.SetField(tenantplan.FieldMenus, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMenus(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantplan.FieldMenus, value)
+		})
+	}
+	if _u.mutation.MenusCleared() {
+		_spec.ClearField(tenantplan.FieldMenus, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Features(); ok {
+		_spec.SetField(tenantplan.FieldFeatures, field.TypeJSON, value)
+	}
+	if _u.mutation.FeaturesCleared() {
+		_spec.ClearField(tenantplan.FieldFeatures, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantPlanUpdateOne is the builder for updating a single TenantPlan entity.
+type TenantPlanUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantPlanMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantPlanUpdateOne) SetUpdatedAt(v time.Time) *TenantPlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableUpdatedAt(v *time.Time) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantPlanUpdateOne) ClearUpdatedAt() *TenantPlanUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TenantPlanUpdateOne) SetDeletedAt(v time.Time) *TenantPlanUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableDeletedAt(v *time.Time) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TenantPlanUpdateOne) ClearDeletedAt() *TenantPlanUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *TenantPlanUpdateOne) SetCreatedBy(v uint32) *TenantPlanUpdateOne {
+	_u.mutation.ResetCreatedBy()
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableCreatedBy(v *uint32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// AddCreatedBy adds value to the "created_by" field.
+func (_u *TenantPlanUpdateOne) AddCreatedBy(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddCreatedBy(v)
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *TenantPlanUpdateOne) ClearCreatedBy() *TenantPlanUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedBy sets the "updated_by" field.
+func (_u *TenantPlanUpdateOne) SetUpdatedBy(v uint32) *TenantPlanUpdateOne {
+	_u.mutation.ResetUpdatedBy()
+	_u.mutation.SetUpdatedBy(v)
+	return _u
+}
+
+// SetNillableUpdatedBy sets the "updated_by" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableUpdatedBy(v *uint32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetUpdatedBy(*v)
+	}
+	return _u
+}
+
+// AddUpdatedBy adds value to the "updated_by" field.
+func (_u *TenantPlanUpdateOne) AddUpdatedBy(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddUpdatedBy(v)
+	return _u
+}
+
+// ClearUpdatedBy clears the value of the "updated_by" field.
+func (_u *TenantPlanUpdateOne) ClearUpdatedBy() *TenantPlanUpdateOne {
+	_u.mutation.ClearUpdatedBy()
+	return _u
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (_u *TenantPlanUpdateOne) SetDeletedBy(v uint32) *TenantPlanUpdateOne {
+	_u.mutation.ResetDeletedBy()
+	_u.mutation.SetDeletedBy(v)
+	return _u
+}
+
+// SetNillableDeletedBy sets the "deleted_by" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableDeletedBy(v *uint32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetDeletedBy(*v)
+	}
+	return _u
+}
+
+// AddDeletedBy adds value to the "deleted_by" field.
+func (_u *TenantPlanUpdateOne) AddDeletedBy(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddDeletedBy(v)
+	return _u
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (_u *TenantPlanUpdateOne) ClearDeletedBy() *TenantPlanUpdateOne {
+	_u.mutation.ClearDeletedBy()
+	return _u
+}
+
+// SetRemark sets the "remark" field.
+func (_u *TenantPlanUpdateOne) SetRemark(v string) *TenantPlanUpdateOne {
+	_u.mutation.SetRemark(v)
+	return _u
+}
+
+// SetNillableRemark sets the "remark" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableRemark(v *string) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetRemark(*v)
+	}
+	return _u
+}
+
+// ClearRemark clears the value of the "remark" field.
+func (_u *TenantPlanUpdateOne) ClearRemark() *TenantPlanUpdateOne {
+	_u.mutation.ClearRemark()
+	return _u
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (_u *TenantPlanUpdateOne) SetSortOrder(v int32) *TenantPlanUpdateOne {
+	_u.mutation.ResetSortOrder()
+	_u.mutation.SetSortOrder(v)
+	return _u
+}
+
+// SetNillableSortOrder sets the "sort_order" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableSortOrder(v *int32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetSortOrder(*v)
+	}
+	return _u
+}
+
+// AddSortOrder adds value to the "sort_order" field.
+func (_u *TenantPlanUpdateOne) AddSortOrder(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddSortOrder(v)
+	return _u
+}
+
+// ClearSortOrder clears the value of the "sort_order" field.
+func (_u *TenantPlanUpdateOne) ClearSortOrder() *TenantPlanUpdateOne {
+	_u.mutation.ClearSortOrder()
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantPlanUpdateOne) SetName(v string) *TenantPlanUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableName(v *string) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *TenantPlanUpdateOne) ClearName() *TenantPlanUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *TenantPlanUpdateOne) SetCode(v string) *TenantPlanUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableCode(v *string) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// ClearCode clears the value of the "code" field.
+func (_u *TenantPlanUpdateOne) ClearCode() *TenantPlanUpdateOne {
+	_u.mutation.ClearCode()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *TenantPlanUpdateOne) SetDescription(v string) *TenantPlanUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableDescription(v *string) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *TenantPlanUpdateOne) ClearDescription() *TenantPlanUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *TenantPlanUpdateOne) SetStatus(v tenantplan.Status) *TenantPlanUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableStatus(v *tenantplan.Status) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// ClearStatus clears the value of the "status" field.
+func (_u *TenantPlanUpdateOne) ClearStatus() *TenantPlanUpdateOne {
+	_u.mutation.ClearStatus()
+	return _u
+}
+
+// SetMaxUsers sets the "max_users" field.
+func (_u *TenantPlanUpdateOne) SetMaxUsers(v uint32) *TenantPlanUpdateOne {
+	_u.mutation.ResetMaxUsers()
+	_u.mutation.SetMaxUsers(v)
+	return _u
+}
+
+// SetNillableMaxUsers sets the "max_users" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableMaxUsers(v *uint32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetMaxUsers(*v)
+	}
+	return _u
+}
+
+// AddMaxUsers adds value to the "max_users" field.
+func (_u *TenantPlanUpdateOne) AddMaxUsers(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddMaxUsers(v)
+	return _u
+}
+
+// ClearMaxUsers clears the value of the "max_users" field.
+func (_u *TenantPlanUpdateOne) ClearMaxUsers() *TenantPlanUpdateOne {
+	_u.mutation.ClearMaxUsers()
+	return _u
+}
+
+// SetMaxStorageBytes sets the "max_storage_bytes" field.
+func (_u *TenantPlanUpdateOne) SetMaxStorageBytes(v uint64) *TenantPlanUpdateOne {
+	_u.mutation.ResetMaxStorageBytes()
+	_u.mutation.SetMaxStorageBytes(v)
+	return _u
+}
+
+// SetNillableMaxStorageBytes sets the "max_storage_bytes" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableMaxStorageBytes(v *uint64) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetMaxStorageBytes(*v)
+	}
+	return _u
+}
+
+// AddMaxStorageBytes adds value to the "max_storage_bytes" field.
+func (_u *TenantPlanUpdateOne) AddMaxStorageBytes(v int64) *TenantPlanUpdateOne {
+	_u.mutation.AddMaxStorageBytes(v)
+	return _u
+}
+
+// ClearMaxStorageBytes clears the value of the "max_storage_bytes" field.
+func (_u *TenantPlanUpdateOne) ClearMaxStorageBytes() *TenantPlanUpdateOne {
+	_u.mutation.ClearMaxStorageBytes()
+	return _u
+}
+
+// SetMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdateOne) SetMaxAPIRequestsPerDay(v uint32) *TenantPlanUpdateOne {
+	_u.mutation.ResetMaxAPIRequestsPerDay()
+	_u.mutation.SetMaxAPIRequestsPerDay(v)
+	return _u
+}
+
+// SetNillableMaxAPIRequestsPerDay sets the "max_api_requests_per_day" field if the given value is not nil.
+func (_u *TenantPlanUpdateOne) SetNillableMaxAPIRequestsPerDay(v *uint32) *TenantPlanUpdateOne {
+	if v != nil {
+		_u.SetMaxAPIRequestsPerDay(*v)
+	}
+	return _u
+}
+
+// AddMaxAPIRequestsPerDay adds value to the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdateOne) AddMaxAPIRequestsPerDay(v int32) *TenantPlanUpdateOne {
+	_u.mutation.AddMaxAPIRequestsPerDay(v)
+	return _u
+}
+
+// ClearMaxAPIRequestsPerDay clears the value of the "max_api_requests_per_day" field.
+func (_u *TenantPlanUpdateOne) ClearMaxAPIRequestsPerDay() *TenantPlanUpdateOne {
+	_u.mutation.ClearMaxAPIRequestsPerDay()
+	return _u
+}
+
+// SetModules sets the "modules" field.
+func (_u *TenantPlanUpdateOne) SetModules(v []string) *TenantPlanUpdateOne {
+	_u.mutation.SetModules(v)
+	return _u
+}
+
+// AppendModules appends value to the "modules" field.
+func (_u *TenantPlanUpdateOne) AppendModules(v []string) *TenantPlanUpdateOne {
+	_u.mutation.AppendModules(v)
+	return _u
+}
+
+// ClearModules clears the value of the "modules" field.
+func (_u *TenantPlanUpdateOne) ClearModules() *TenantPlanUpdateOne {
+	_u.mutation.ClearModules()
+	return _u
+}
+
+// SetMenus sets the "menus" field.
+func (_u *TenantPlanUpdateOne) SetMenus(v []uint32) *TenantPlanUpdateOne {
+	_u.mutation.SetMenus(v)
+	return _u
+}
+
+// AppendMenus appends value to the "menus" field.
+func (_u *TenantPlanUpdateOne) AppendMenus(v []uint32) *TenantPlanUpdateOne {
+	_u.mutation.AppendMenus(v)
+	return _u
+}
+
+// ClearMenus clears the value of the "menus" field.
+func (_u *TenantPlanUpdateOne) ClearMenus() *TenantPlanUpdateOne {
+	_u.mutation.ClearMenus()
+	return _u
+}
+
+// SetFeatures sets the "features" field.
+func (_u *TenantPlanUpdateOne) SetFeatures(v map[string]bool) *TenantPlanUpdateOne {
+	_u.mutation.SetFeatures(v)
+	return _u
+}
+
+// ClearFeatures clears the value of the "features" field.
+func (_u *TenantPlanUpdateOne) ClearFeatures() *TenantPlanUpdateOne {
+	_u.mutation.ClearFeatures()
+	return _u
+}
+
+// Mutation returns the TenantPlanMutation object of the builder.
+func (_u *TenantPlanUpdateOne) Mutation() *TenantPlanMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantPlanUpdate builder.
+func (_u *TenantPlanUpdateOne) Where(ps ...predicate.TenantPlan) *TenantPlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantPlanUpdateOne) Select(field string, fields ...string) *TenantPlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantPlan entity.
+func (_u *TenantPlanUpdateOne) Save(ctx context.Context) (*TenantPlan, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantPlanUpdateOne) SaveX(ctx context.Context) *TenantPlan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantPlanUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenantplan.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Code(); ok {
+		if err := tenantplan.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := tenantplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TenantPlan.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantPlanUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantPlanUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantPlanUpdateOne) sqlSave(ctx context.Context) (_node *TenantPlan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenantplan.Table, tenantplan.Columns, sqlgraph.NewFieldSpec(tenantplan.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantplan.FieldID)
+		for _, f := range fields {
+			if !tenantplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantplan.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantplan.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantplan.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(tenantplan.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(tenantplan.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(tenantplan.FieldCreatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(tenantplan.FieldCreatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UpdatedBy(); ok {
+		_spec.SetField(tenantplan.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUpdatedBy(); ok {
+		_spec.AddField(tenantplan.FieldUpdatedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.UpdatedByCleared() {
+		_spec.ClearField(tenantplan.FieldUpdatedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.DeletedBy(); ok {
+		_spec.SetField(tenantplan.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedDeletedBy(); ok {
+		_spec.AddField(tenantplan.FieldDeletedBy, field.TypeUint32, value)
+	}
+	if _u.mutation.DeletedByCleared() {
+		_spec.ClearField(tenantplan.FieldDeletedBy, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Remark(); ok {
+		_spec.SetField(tenantplan.FieldRemark, field.TypeString, value)
+	}
+	if _u.mutation.RemarkCleared() {
+		_spec.ClearField(tenantplan.FieldRemark, field.TypeString)
+	}
+	if value, ok := _u.mutation.SortOrder(); ok {
+		_spec.SetField(tenantplan.FieldSortOrder, field.TypeInt32, value)
+	}
+	if value, ok := _u.mutation.AddedSortOrder(); ok {
+		_spec.AddField(tenantplan.FieldSortOrder, field.TypeInt32, value)
+	}
+	if _u.mutation.SortOrderCleared() {
+		_spec.ClearField(tenantplan.FieldSortOrder, field.TypeInt32)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenantplan.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(tenantplan.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(tenantplan.FieldCode, field.TypeString, value)
+	}
+	if _u.mutation.CodeCleared() {
+		_spec.ClearField(tenantplan.FieldCode, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(tenantplan.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(tenantplan.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(tenantplan.FieldStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.StatusCleared() {
+		_spec.ClearField(tenantplan.FieldStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.MaxUsers(); ok {
+		_spec.SetField(tenantplan.FieldMaxUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUsers(); ok {
+		_spec.AddField(tenantplan.FieldMaxUsers, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxUsersCleared() {
+		_spec.ClearField(tenantplan.FieldMaxUsers, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.MaxStorageBytes(); ok {
+		_spec.SetField(tenantplan.FieldMaxStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxStorageBytes(); ok {
+		_spec.AddField(tenantplan.FieldMaxStorageBytes, field.TypeUint64, value)
+	}
+	if _u.mutation.MaxStorageBytesCleared() {
+		_spec.ClearField(tenantplan.FieldMaxStorageBytes, field.TypeUint64)
+	}
+	if value, ok := _u.mutation.MaxAPIRequestsPerDay(); ok {
+		_spec.SetField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedMaxAPIRequestsPerDay(); ok {
+		_spec.AddField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32, value)
+	}
+	if _u.mutation.MaxAPIRequestsPerDayCleared() {
+		_spec.ClearField(tenantplan.FieldMaxAPIRequestsPerDay, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.Modules(); ok {
+		_spec.SetField(tenantplan.FieldModules, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModules(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantplan.FieldModules, value)
+		})
+	}
+	if _u.mutation.ModulesCleared() {
+		_spec.ClearField(tenantplan.FieldModules, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Menus(); ok {
+		_spec.SetField(tenantplan.FieldMenus, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMenus(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, tenantplan.FieldMenus, value)
+		})
+	}
+	if _u.mutation.MenusCleared() {
+		_spec.ClearField(tenantplan.FieldMenus, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Features(); ok {
+		_spec.SetField(tenantplan.FieldFeatures, field.TypeJSON, value)
+	}
+	if _u.mutation.FeaturesCleared() {
+		_spec.ClearField(tenantplan.FieldFeatures, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantPlan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
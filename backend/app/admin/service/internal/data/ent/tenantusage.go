@@ -0,0 +1,221 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 租户每小时用量表
+type TenantUsage struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 统计小时的开始时间
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// 活跃用户数，该小时内发起过请求的不同用户数
+	ActiveUsers uint32 `json:"active_users,omitempty"`
+	// API调用次数
+	APICalls uint64 `json:"api_calls,omitempty"`
+	// 按业务模块拆分的API调用次数
+	APICallsByModule map[string]uint64 `json:"api_calls_by_module,omitempty"`
+	// 文件占用的存储空间（字节），首次汇总时的快照
+	StorageBytes uint64 `json:"storage_bytes,omitempty"`
+	// 发送的站内信数量，每个接收者计一条
+	MessagesSent uint64 `json:"messages_sent,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TenantUsage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tenantusage.FieldAPICallsByModule:
+			values[i] = new([]byte)
+		case tenantusage.FieldID, tenantusage.FieldTenantID, tenantusage.FieldActiveUsers, tenantusage.FieldAPICalls, tenantusage.FieldStorageBytes, tenantusage.FieldMessagesSent:
+			values[i] = new(sql.NullInt64)
+		case tenantusage.FieldCreatedAt, tenantusage.FieldUpdatedAt, tenantusage.FieldDeletedAt, tenantusage.FieldPeriodStart:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TenantUsage fields.
+func (_m *TenantUsage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tenantusage.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case tenantusage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case tenantusage.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case tenantusage.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case tenantusage.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case tenantusage.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case tenantusage.FieldActiveUsers:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field active_users", values[i])
+			} else if value.Valid {
+				_m.ActiveUsers = uint32(value.Int64)
+			}
+		case tenantusage.FieldAPICalls:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field api_calls", values[i])
+			} else if value.Valid {
+				_m.APICalls = uint64(value.Int64)
+			}
+		case tenantusage.FieldAPICallsByModule:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field api_calls_by_module", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.APICallsByModule); err != nil {
+					return fmt.Errorf("unmarshal field api_calls_by_module: %w", err)
+				}
+			}
+		case tenantusage.FieldStorageBytes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_bytes", values[i])
+			} else if value.Valid {
+				_m.StorageBytes = uint64(value.Int64)
+			}
+		case tenantusage.FieldMessagesSent:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field messages_sent", values[i])
+			} else if value.Valid {
+				_m.MessagesSent = uint64(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TenantUsage.
+// This includes values selected through modifiers, order, etc.
+func (_m *TenantUsage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TenantUsage.
+// Note that you need to call TenantUsage.Unwrap() before calling this method if this TenantUsage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TenantUsage) Update() *TenantUsageUpdateOne {
+	return NewTenantUsageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TenantUsage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TenantUsage) Unwrap() *TenantUsage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TenantUsage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TenantUsage) String() string {
+	var builder strings.Builder
+	builder.WriteString("TenantUsage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("active_users=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActiveUsers))
+	builder.WriteString(", ")
+	builder.WriteString("api_calls=")
+	builder.WriteString(fmt.Sprintf("%v", _m.APICalls))
+	builder.WriteString(", ")
+	builder.WriteString("api_calls_by_module=")
+	builder.WriteString(fmt.Sprintf("%v", _m.APICallsByModule))
+	builder.WriteString(", ")
+	builder.WriteString("storage_bytes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StorageBytes))
+	builder.WriteString(", ")
+	builder.WriteString("messages_sent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessagesSent))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TenantUsages is a parsable slice of TenantUsage.
+type TenantUsages []*TenantUsage
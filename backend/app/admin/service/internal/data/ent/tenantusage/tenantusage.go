@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantusage
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the tenantusage type in the database.
+	Label = "tenant_usage"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldTenantID holds the string denoting the tenant_id field in the database.
+	FieldTenantID = "tenant_id"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldActiveUsers holds the string denoting the active_users field in the database.
+	FieldActiveUsers = "active_users"
+	// FieldAPICalls holds the string denoting the api_calls field in the database.
+	FieldAPICalls = "api_calls"
+	// FieldAPICallsByModule holds the string denoting the api_calls_by_module field in the database.
+	FieldAPICallsByModule = "api_calls_by_module"
+	// FieldStorageBytes holds the string denoting the storage_bytes field in the database.
+	FieldStorageBytes = "storage_bytes"
+	// FieldMessagesSent holds the string denoting the messages_sent field in the database.
+	FieldMessagesSent = "messages_sent"
+	// Table holds the table name of the tenantusage in the database.
+	Table = "sys_tenant_usages"
+)
+
+// Columns holds all SQL columns for tenantusage fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldTenantID,
+	FieldPeriodStart,
+	FieldActiveUsers,
+	FieldAPICalls,
+	FieldAPICallsByModule,
+	FieldStorageBytes,
+	FieldMessagesSent,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
+var (
+	Hooks  [2]ent.Hook
+	Policy ent.Policy
+	// DefaultActiveUsers holds the default value on creation for the "active_users" field.
+	DefaultActiveUsers uint32
+	// DefaultAPICalls holds the default value on creation for the "api_calls" field.
+	DefaultAPICalls uint64
+	// DefaultStorageBytes holds the default value on creation for the "storage_bytes" field.
+	DefaultStorageBytes uint64
+	// DefaultMessagesSent holds the default value on creation for the "messages_sent" field.
+	DefaultMessagesSent uint64
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(uint32) error
+)
+
+// OrderOption defines the ordering options for the TenantUsage queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByTenantID orders the results by the tenant_id field.
+func ByTenantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTenantID, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByActiveUsers orders the results by the active_users field.
+func ByActiveUsers(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActiveUsers, opts...).ToFunc()
+}
+
+// ByAPICalls orders the results by the api_calls field.
+func ByAPICalls(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPICalls, opts...).ToFunc()
+}
+
+// ByStorageBytes orders the results by the storage_bytes field.
+func ByStorageBytes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStorageBytes, opts...).ToFunc()
+}
+
+// ByMessagesSent orders the results by the messages_sent field.
+func ByMessagesSent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessagesSent, opts...).ToFunc()
+}
@@ -0,0 +1,525 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tenantusage
+
+import (
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TenantID applies equality check predicate on the "tenant_id" field. It's identical to TenantIDEQ.
+func TenantID(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldTenantID, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// ActiveUsers applies equality check predicate on the "active_users" field. It's identical to ActiveUsersEQ.
+func ActiveUsers(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldActiveUsers, v))
+}
+
+// APICalls applies equality check predicate on the "api_calls" field. It's identical to APICallsEQ.
+func APICalls(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldAPICalls, v))
+}
+
+// StorageBytes applies equality check predicate on the "storage_bytes" field. It's identical to StorageBytesEQ.
+func StorageBytes(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldStorageBytes, v))
+}
+
+// MessagesSent applies equality check predicate on the "messages_sent" field. It's identical to MessagesSentEQ.
+func MessagesSent(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldMessagesSent, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// TenantIDEQ applies the EQ predicate on the "tenant_id" field.
+func TenantIDEQ(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldTenantID, v))
+}
+
+// TenantIDNEQ applies the NEQ predicate on the "tenant_id" field.
+func TenantIDNEQ(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldTenantID, v))
+}
+
+// TenantIDIn applies the In predicate on the "tenant_id" field.
+func TenantIDIn(vs ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldTenantID, vs...))
+}
+
+// TenantIDNotIn applies the NotIn predicate on the "tenant_id" field.
+func TenantIDNotIn(vs ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldTenantID, vs...))
+}
+
+// TenantIDGT applies the GT predicate on the "tenant_id" field.
+func TenantIDGT(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldTenantID, v))
+}
+
+// TenantIDGTE applies the GTE predicate on the "tenant_id" field.
+func TenantIDGTE(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldTenantID, v))
+}
+
+// TenantIDLT applies the LT predicate on the "tenant_id" field.
+func TenantIDLT(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldTenantID, v))
+}
+
+// TenantIDLTE applies the LTE predicate on the "tenant_id" field.
+func TenantIDLTE(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldTenantID, v))
+}
+
+// TenantIDIsNil applies the IsNil predicate on the "tenant_id" field.
+func TenantIDIsNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIsNull(FieldTenantID))
+}
+
+// TenantIDNotNil applies the NotNil predicate on the "tenant_id" field.
+func TenantIDNotNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotNull(FieldTenantID))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// ActiveUsersEQ applies the EQ predicate on the "active_users" field.
+func ActiveUsersEQ(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldActiveUsers, v))
+}
+
+// ActiveUsersNEQ applies the NEQ predicate on the "active_users" field.
+func ActiveUsersNEQ(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldActiveUsers, v))
+}
+
+// ActiveUsersIn applies the In predicate on the "active_users" field.
+func ActiveUsersIn(vs ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldActiveUsers, vs...))
+}
+
+// ActiveUsersNotIn applies the NotIn predicate on the "active_users" field.
+func ActiveUsersNotIn(vs ...uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldActiveUsers, vs...))
+}
+
+// ActiveUsersGT applies the GT predicate on the "active_users" field.
+func ActiveUsersGT(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldActiveUsers, v))
+}
+
+// ActiveUsersGTE applies the GTE predicate on the "active_users" field.
+func ActiveUsersGTE(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldActiveUsers, v))
+}
+
+// ActiveUsersLT applies the LT predicate on the "active_users" field.
+func ActiveUsersLT(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldActiveUsers, v))
+}
+
+// ActiveUsersLTE applies the LTE predicate on the "active_users" field.
+func ActiveUsersLTE(v uint32) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldActiveUsers, v))
+}
+
+// APICallsEQ applies the EQ predicate on the "api_calls" field.
+func APICallsEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldAPICalls, v))
+}
+
+// APICallsNEQ applies the NEQ predicate on the "api_calls" field.
+func APICallsNEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldAPICalls, v))
+}
+
+// APICallsIn applies the In predicate on the "api_calls" field.
+func APICallsIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldAPICalls, vs...))
+}
+
+// APICallsNotIn applies the NotIn predicate on the "api_calls" field.
+func APICallsNotIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldAPICalls, vs...))
+}
+
+// APICallsGT applies the GT predicate on the "api_calls" field.
+func APICallsGT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldAPICalls, v))
+}
+
+// APICallsGTE applies the GTE predicate on the "api_calls" field.
+func APICallsGTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldAPICalls, v))
+}
+
+// APICallsLT applies the LT predicate on the "api_calls" field.
+func APICallsLT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldAPICalls, v))
+}
+
+// APICallsLTE applies the LTE predicate on the "api_calls" field.
+func APICallsLTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldAPICalls, v))
+}
+
+// APICallsByModuleIsNil applies the IsNil predicate on the "api_calls_by_module" field.
+func APICallsByModuleIsNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIsNull(FieldAPICallsByModule))
+}
+
+// APICallsByModuleNotNil applies the NotNil predicate on the "api_calls_by_module" field.
+func APICallsByModuleNotNil() predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotNull(FieldAPICallsByModule))
+}
+
+// StorageBytesEQ applies the EQ predicate on the "storage_bytes" field.
+func StorageBytesEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldStorageBytes, v))
+}
+
+// StorageBytesNEQ applies the NEQ predicate on the "storage_bytes" field.
+func StorageBytesNEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldStorageBytes, v))
+}
+
+// StorageBytesIn applies the In predicate on the "storage_bytes" field.
+func StorageBytesIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldStorageBytes, vs...))
+}
+
+// StorageBytesNotIn applies the NotIn predicate on the "storage_bytes" field.
+func StorageBytesNotIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldStorageBytes, vs...))
+}
+
+// StorageBytesGT applies the GT predicate on the "storage_bytes" field.
+func StorageBytesGT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldStorageBytes, v))
+}
+
+// StorageBytesGTE applies the GTE predicate on the "storage_bytes" field.
+func StorageBytesGTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldStorageBytes, v))
+}
+
+// StorageBytesLT applies the LT predicate on the "storage_bytes" field.
+func StorageBytesLT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldStorageBytes, v))
+}
+
+// StorageBytesLTE applies the LTE predicate on the "storage_bytes" field.
+func StorageBytesLTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldStorageBytes, v))
+}
+
+// MessagesSentEQ applies the EQ predicate on the "messages_sent" field.
+func MessagesSentEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldEQ(FieldMessagesSent, v))
+}
+
+// MessagesSentNEQ applies the NEQ predicate on the "messages_sent" field.
+func MessagesSentNEQ(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNEQ(FieldMessagesSent, v))
+}
+
+// MessagesSentIn applies the In predicate on the "messages_sent" field.
+func MessagesSentIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldIn(FieldMessagesSent, vs...))
+}
+
+// MessagesSentNotIn applies the NotIn predicate on the "messages_sent" field.
+func MessagesSentNotIn(vs ...uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldNotIn(FieldMessagesSent, vs...))
+}
+
+// MessagesSentGT applies the GT predicate on the "messages_sent" field.
+func MessagesSentGT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGT(FieldMessagesSent, v))
+}
+
+// MessagesSentGTE applies the GTE predicate on the "messages_sent" field.
+func MessagesSentGTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldGTE(FieldMessagesSent, v))
+}
+
+// MessagesSentLT applies the LT predicate on the "messages_sent" field.
+func MessagesSentLT(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLT(FieldMessagesSent, v))
+}
+
+// MessagesSentLTE applies the LTE predicate on the "messages_sent" field.
+func MessagesSentLTE(v uint64) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.FieldLTE(FieldMessagesSent, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TenantUsage) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TenantUsage) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TenantUsage) predicate.TenantUsage {
+	return predicate.TenantUsage(sql.NotPredicates(p))
+}
@@ -0,0 +1,1113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantUsageCreate is the builder for creating a TenantUsage entity.
+type TenantUsageCreate struct {
+	config
+	mutation *TenantUsageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TenantUsageCreate) SetCreatedAt(v time.Time) *TenantUsageCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableCreatedAt(v *time.Time) *TenantUsageCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TenantUsageCreate) SetUpdatedAt(v time.Time) *TenantUsageCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableUpdatedAt(v *time.Time) *TenantUsageCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *TenantUsageCreate) SetDeletedAt(v time.Time) *TenantUsageCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableDeletedAt(v *time.Time) *TenantUsageCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *TenantUsageCreate) SetTenantID(v uint32) *TenantUsageCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableTenantID(v *uint32) *TenantUsageCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *TenantUsageCreate) SetPeriodStart(v time.Time) *TenantUsageCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (_c *TenantUsageCreate) SetActiveUsers(v uint32) *TenantUsageCreate {
+	_c.mutation.SetActiveUsers(v)
+	return _c
+}
+
+// SetNillableActiveUsers sets the "active_users" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableActiveUsers(v *uint32) *TenantUsageCreate {
+	if v != nil {
+		_c.SetActiveUsers(*v)
+	}
+	return _c
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (_c *TenantUsageCreate) SetAPICalls(v uint64) *TenantUsageCreate {
+	_c.mutation.SetAPICalls(v)
+	return _c
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableAPICalls(v *uint64) *TenantUsageCreate {
+	if v != nil {
+		_c.SetAPICalls(*v)
+	}
+	return _c
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (_c *TenantUsageCreate) SetAPICallsByModule(v map[string]uint64) *TenantUsageCreate {
+	_c.mutation.SetAPICallsByModule(v)
+	return _c
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (_c *TenantUsageCreate) SetStorageBytes(v uint64) *TenantUsageCreate {
+	_c.mutation.SetStorageBytes(v)
+	return _c
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableStorageBytes(v *uint64) *TenantUsageCreate {
+	if v != nil {
+		_c.SetStorageBytes(*v)
+	}
+	return _c
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (_c *TenantUsageCreate) SetMessagesSent(v uint64) *TenantUsageCreate {
+	_c.mutation.SetMessagesSent(v)
+	return _c
+}
+
+// SetNillableMessagesSent sets the "messages_sent" field if the given value is not nil.
+func (_c *TenantUsageCreate) SetNillableMessagesSent(v *uint64) *TenantUsageCreate {
+	if v != nil {
+		_c.SetMessagesSent(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TenantUsageCreate) SetID(v uint32) *TenantUsageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the TenantUsageMutation object of the builder.
+func (_c *TenantUsageCreate) Mutation() *TenantUsageMutation {
+	return _c.mutation
+}
+
+// Save creates the TenantUsage in the database.
+func (_c *TenantUsageCreate) Save(ctx context.Context) (*TenantUsage, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TenantUsageCreate) SaveX(ctx context.Context) *TenantUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantUsageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantUsageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TenantUsageCreate) defaults() error {
+	if _, ok := _c.mutation.ActiveUsers(); !ok {
+		v := tenantusage.DefaultActiveUsers
+		_c.mutation.SetActiveUsers(v)
+	}
+	if _, ok := _c.mutation.APICalls(); !ok {
+		v := tenantusage.DefaultAPICalls
+		_c.mutation.SetAPICalls(v)
+	}
+	if _, ok := _c.mutation.StorageBytes(); !ok {
+		v := tenantusage.DefaultStorageBytes
+		_c.mutation.SetStorageBytes(v)
+	}
+	if _, ok := _c.mutation.MessagesSent(); !ok {
+		v := tenantusage.DefaultMessagesSent
+		_c.mutation.SetMessagesSent(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TenantUsageCreate) check() error {
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "TenantUsage.period_start"`)}
+	}
+	if _, ok := _c.mutation.ActiveUsers(); !ok {
+		return &ValidationError{Name: "active_users", err: errors.New(`ent: missing required field "TenantUsage.active_users"`)}
+	}
+	if _, ok := _c.mutation.APICalls(); !ok {
+		return &ValidationError{Name: "api_calls", err: errors.New(`ent: missing required field "TenantUsage.api_calls"`)}
+	}
+	if _, ok := _c.mutation.StorageBytes(); !ok {
+		return &ValidationError{Name: "storage_bytes", err: errors.New(`ent: missing required field "TenantUsage.storage_bytes"`)}
+	}
+	if _, ok := _c.mutation.MessagesSent(); !ok {
+		return &ValidationError{Name: "messages_sent", err: errors.New(`ent: missing required field "TenantUsage.messages_sent"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := tenantusage.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "TenantUsage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *TenantUsageCreate) sqlSave(ctx context.Context) (*TenantUsage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TenantUsageCreate) createSpec() (*TenantUsage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TenantUsage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(tenantusage.Table, sqlgraph.NewFieldSpec(tenantusage.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(tenantusage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantusage.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantusage.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(tenantusage.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(tenantusage.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.ActiveUsers(); ok {
+		_spec.SetField(tenantusage.FieldActiveUsers, field.TypeUint32, value)
+		_node.ActiveUsers = value
+	}
+	if value, ok := _c.mutation.APICalls(); ok {
+		_spec.SetField(tenantusage.FieldAPICalls, field.TypeUint64, value)
+		_node.APICalls = value
+	}
+	if value, ok := _c.mutation.APICallsByModule(); ok {
+		_spec.SetField(tenantusage.FieldAPICallsByModule, field.TypeJSON, value)
+		_node.APICallsByModule = value
+	}
+	if value, ok := _c.mutation.StorageBytes(); ok {
+		_spec.SetField(tenantusage.FieldStorageBytes, field.TypeUint64, value)
+		_node.StorageBytes = value
+	}
+	if value, ok := _c.mutation.MessagesSent(); ok {
+		_spec.SetField(tenantusage.FieldMessagesSent, field.TypeUint64, value)
+		_node.MessagesSent = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantUsage.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantUsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantUsageCreate) OnConflict(opts ...sql.ConflictOption) *TenantUsageUpsertOne {
+	_c.conflict = opts
+	return &TenantUsageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantUsageCreate) OnConflictColumns(columns ...string) *TenantUsageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantUsageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// TenantUsageUpsertOne is the builder for "upsert"-ing
+	//  one TenantUsage node.
+	TenantUsageUpsertOne struct {
+		create *TenantUsageCreate
+	}
+
+	// TenantUsageUpsert is the "OnConflict" setter.
+	TenantUsageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantUsageUpsert) SetUpdatedAt(v time.Time) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateUpdatedAt() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantUsageUpsert) ClearUpdatedAt() *TenantUsageUpsert {
+	u.SetNull(tenantusage.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantUsageUpsert) SetDeletedAt(v time.Time) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateDeletedAt() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantUsageUpsert) ClearDeletedAt() *TenantUsageUpsert {
+	u.SetNull(tenantusage.FieldDeletedAt)
+	return u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TenantUsageUpsert) SetPeriodStart(v time.Time) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldPeriodStart, v)
+	return u
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdatePeriodStart() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldPeriodStart)
+	return u
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (u *TenantUsageUpsert) SetActiveUsers(v uint32) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldActiveUsers, v)
+	return u
+}
+
+// UpdateActiveUsers sets the "active_users" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateActiveUsers() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldActiveUsers)
+	return u
+}
+
+// AddActiveUsers adds v to the "active_users" field.
+func (u *TenantUsageUpsert) AddActiveUsers(v uint32) *TenantUsageUpsert {
+	u.Add(tenantusage.FieldActiveUsers, v)
+	return u
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (u *TenantUsageUpsert) SetAPICalls(v uint64) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldAPICalls, v)
+	return u
+}
+
+// UpdateAPICalls sets the "api_calls" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateAPICalls() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldAPICalls)
+	return u
+}
+
+// AddAPICalls adds v to the "api_calls" field.
+func (u *TenantUsageUpsert) AddAPICalls(v uint64) *TenantUsageUpsert {
+	u.Add(tenantusage.FieldAPICalls, v)
+	return u
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (u *TenantUsageUpsert) SetAPICallsByModule(v map[string]uint64) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldAPICallsByModule, v)
+	return u
+}
+
+// UpdateAPICallsByModule sets the "api_calls_by_module" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateAPICallsByModule() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldAPICallsByModule)
+	return u
+}
+
+// ClearAPICallsByModule clears the value of the "api_calls_by_module" field.
+func (u *TenantUsageUpsert) ClearAPICallsByModule() *TenantUsageUpsert {
+	u.SetNull(tenantusage.FieldAPICallsByModule)
+	return u
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *TenantUsageUpsert) SetStorageBytes(v uint64) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldStorageBytes, v)
+	return u
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateStorageBytes() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldStorageBytes)
+	return u
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *TenantUsageUpsert) AddStorageBytes(v uint64) *TenantUsageUpsert {
+	u.Add(tenantusage.FieldStorageBytes, v)
+	return u
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (u *TenantUsageUpsert) SetMessagesSent(v uint64) *TenantUsageUpsert {
+	u.Set(tenantusage.FieldMessagesSent, v)
+	return u
+}
+
+// UpdateMessagesSent sets the "messages_sent" field to the value that was provided on create.
+func (u *TenantUsageUpsert) UpdateMessagesSent() *TenantUsageUpsert {
+	u.SetExcluded(tenantusage.FieldMessagesSent)
+	return u
+}
+
+// AddMessagesSent adds v to the "messages_sent" field.
+func (u *TenantUsageUpsert) AddMessagesSent(v uint64) *TenantUsageUpsert {
+	u.Add(tenantusage.FieldMessagesSent, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantUsageUpsertOne) UpdateNewValues() *TenantUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tenantusage.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tenantusage.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(tenantusage.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TenantUsageUpsertOne) Ignore() *TenantUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantUsageUpsertOne) DoNothing() *TenantUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantUsageCreate.OnConflict
+// documentation for more info.
+func (u *TenantUsageUpsertOne) Update(set func(*TenantUsageUpsert)) *TenantUsageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantUsageUpsertOne) SetUpdatedAt(v time.Time) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateUpdatedAt() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantUsageUpsertOne) ClearUpdatedAt() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantUsageUpsertOne) SetDeletedAt(v time.Time) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateDeletedAt() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantUsageUpsertOne) ClearDeletedAt() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TenantUsageUpsertOne) SetPeriodStart(v time.Time) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdatePeriodStart() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (u *TenantUsageUpsertOne) SetActiveUsers(v uint32) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetActiveUsers(v)
+	})
+}
+
+// AddActiveUsers adds v to the "active_users" field.
+func (u *TenantUsageUpsertOne) AddActiveUsers(v uint32) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddActiveUsers(v)
+	})
+}
+
+// UpdateActiveUsers sets the "active_users" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateActiveUsers() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateActiveUsers()
+	})
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (u *TenantUsageUpsertOne) SetAPICalls(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetAPICalls(v)
+	})
+}
+
+// AddAPICalls adds v to the "api_calls" field.
+func (u *TenantUsageUpsertOne) AddAPICalls(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddAPICalls(v)
+	})
+}
+
+// UpdateAPICalls sets the "api_calls" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateAPICalls() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateAPICalls()
+	})
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (u *TenantUsageUpsertOne) SetAPICallsByModule(v map[string]uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetAPICallsByModule(v)
+	})
+}
+
+// UpdateAPICallsByModule sets the "api_calls_by_module" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateAPICallsByModule() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateAPICallsByModule()
+	})
+}
+
+// ClearAPICallsByModule clears the value of the "api_calls_by_module" field.
+func (u *TenantUsageUpsertOne) ClearAPICallsByModule() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearAPICallsByModule()
+	})
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *TenantUsageUpsertOne) SetStorageBytes(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetStorageBytes(v)
+	})
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *TenantUsageUpsertOne) AddStorageBytes(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddStorageBytes(v)
+	})
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateStorageBytes() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateStorageBytes()
+	})
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (u *TenantUsageUpsertOne) SetMessagesSent(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetMessagesSent(v)
+	})
+}
+
+// AddMessagesSent adds v to the "messages_sent" field.
+func (u *TenantUsageUpsertOne) AddMessagesSent(v uint64) *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddMessagesSent(v)
+	})
+}
+
+// UpdateMessagesSent sets the "messages_sent" field to the value that was provided on create.
+func (u *TenantUsageUpsertOne) UpdateMessagesSent() *TenantUsageUpsertOne {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateMessagesSent()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantUsageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantUsageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantUsageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TenantUsageUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TenantUsageUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TenantUsageCreateBulk is the builder for creating many TenantUsage entities in bulk.
+type TenantUsageCreateBulk struct {
+	config
+	err      error
+	builders []*TenantUsageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TenantUsage entities in the database.
+func (_c *TenantUsageCreateBulk) Save(ctx context.Context) ([]*TenantUsage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TenantUsage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TenantUsageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TenantUsageCreateBulk) SaveX(ctx context.Context) []*TenantUsage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TenantUsageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TenantUsageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TenantUsage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TenantUsageUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *TenantUsageCreateBulk) OnConflict(opts ...sql.ConflictOption) *TenantUsageUpsertBulk {
+	_c.conflict = opts
+	return &TenantUsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *TenantUsageCreateBulk) OnConflictColumns(columns ...string) *TenantUsageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &TenantUsageUpsertBulk{
+		create: _c,
+	}
+}
+
+// TenantUsageUpsertBulk is the builder for "upsert"-ing
+// a bulk of TenantUsage nodes.
+type TenantUsageUpsertBulk struct {
+	create *TenantUsageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tenantusage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TenantUsageUpsertBulk) UpdateNewValues() *TenantUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tenantusage.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tenantusage.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(tenantusage.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TenantUsage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TenantUsageUpsertBulk) Ignore() *TenantUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TenantUsageUpsertBulk) DoNothing() *TenantUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TenantUsageCreateBulk.OnConflict
+// documentation for more info.
+func (u *TenantUsageUpsertBulk) Update(set func(*TenantUsageUpsert)) *TenantUsageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TenantUsageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TenantUsageUpsertBulk) SetUpdatedAt(v time.Time) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateUpdatedAt() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *TenantUsageUpsertBulk) ClearUpdatedAt() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *TenantUsageUpsertBulk) SetDeletedAt(v time.Time) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateDeletedAt() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *TenantUsageUpsertBulk) ClearDeletedAt() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (u *TenantUsageUpsertBulk) SetPeriodStart(v time.Time) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetPeriodStart(v)
+	})
+}
+
+// UpdatePeriodStart sets the "period_start" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdatePeriodStart() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdatePeriodStart()
+	})
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (u *TenantUsageUpsertBulk) SetActiveUsers(v uint32) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetActiveUsers(v)
+	})
+}
+
+// AddActiveUsers adds v to the "active_users" field.
+func (u *TenantUsageUpsertBulk) AddActiveUsers(v uint32) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddActiveUsers(v)
+	})
+}
+
+// UpdateActiveUsers sets the "active_users" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateActiveUsers() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateActiveUsers()
+	})
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (u *TenantUsageUpsertBulk) SetAPICalls(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetAPICalls(v)
+	})
+}
+
+// AddAPICalls adds v to the "api_calls" field.
+func (u *TenantUsageUpsertBulk) AddAPICalls(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddAPICalls(v)
+	})
+}
+
+// UpdateAPICalls sets the "api_calls" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateAPICalls() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateAPICalls()
+	})
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (u *TenantUsageUpsertBulk) SetAPICallsByModule(v map[string]uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetAPICallsByModule(v)
+	})
+}
+
+// UpdateAPICallsByModule sets the "api_calls_by_module" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateAPICallsByModule() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateAPICallsByModule()
+	})
+}
+
+// ClearAPICallsByModule clears the value of the "api_calls_by_module" field.
+func (u *TenantUsageUpsertBulk) ClearAPICallsByModule() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.ClearAPICallsByModule()
+	})
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (u *TenantUsageUpsertBulk) SetStorageBytes(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetStorageBytes(v)
+	})
+}
+
+// AddStorageBytes adds v to the "storage_bytes" field.
+func (u *TenantUsageUpsertBulk) AddStorageBytes(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddStorageBytes(v)
+	})
+}
+
+// UpdateStorageBytes sets the "storage_bytes" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateStorageBytes() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateStorageBytes()
+	})
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (u *TenantUsageUpsertBulk) SetMessagesSent(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.SetMessagesSent(v)
+	})
+}
+
+// AddMessagesSent adds v to the "messages_sent" field.
+func (u *TenantUsageUpsertBulk) AddMessagesSent(v uint64) *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.AddMessagesSent(v)
+	})
+}
+
+// UpdateMessagesSent sets the "messages_sent" field to the value that was provided on create.
+func (u *TenantUsageUpsertBulk) UpdateMessagesSent() *TenantUsageUpsertBulk {
+	return u.Update(func(s *TenantUsageUpsert) {
+		s.UpdateMessagesSent()
+	})
+}
+
+// Exec executes the query.
+func (u *TenantUsageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TenantUsageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TenantUsageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TenantUsageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
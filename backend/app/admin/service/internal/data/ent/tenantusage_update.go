@@ -0,0 +1,594 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TenantUsageUpdate is the builder for updating TenantUsage entities.
+type TenantUsageUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *TenantUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the TenantUsageUpdate builder.
+func (_u *TenantUsageUpdate) Where(ps ...predicate.TenantUsage) *TenantUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantUsageUpdate) SetUpdatedAt(v time.Time) *TenantUsageUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableUpdatedAt(v *time.Time) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantUsageUpdate) ClearUpdatedAt() *TenantUsageUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TenantUsageUpdate) SetDeletedAt(v time.Time) *TenantUsageUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableDeletedAt(v *time.Time) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TenantUsageUpdate) ClearDeletedAt() *TenantUsageUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *TenantUsageUpdate) SetPeriodStart(v time.Time) *TenantUsageUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillablePeriodStart(v *time.Time) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (_u *TenantUsageUpdate) SetActiveUsers(v uint32) *TenantUsageUpdate {
+	_u.mutation.ResetActiveUsers()
+	_u.mutation.SetActiveUsers(v)
+	return _u
+}
+
+// SetNillableActiveUsers sets the "active_users" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableActiveUsers(v *uint32) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetActiveUsers(*v)
+	}
+	return _u
+}
+
+// AddActiveUsers adds value to the "active_users" field.
+func (_u *TenantUsageUpdate) AddActiveUsers(v int32) *TenantUsageUpdate {
+	_u.mutation.AddActiveUsers(v)
+	return _u
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (_u *TenantUsageUpdate) SetAPICalls(v uint64) *TenantUsageUpdate {
+	_u.mutation.ResetAPICalls()
+	_u.mutation.SetAPICalls(v)
+	return _u
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableAPICalls(v *uint64) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetAPICalls(*v)
+	}
+	return _u
+}
+
+// AddAPICalls adds value to the "api_calls" field.
+func (_u *TenantUsageUpdate) AddAPICalls(v int64) *TenantUsageUpdate {
+	_u.mutation.AddAPICalls(v)
+	return _u
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (_u *TenantUsageUpdate) SetAPICallsByModule(v map[string]uint64) *TenantUsageUpdate {
+	_u.mutation.SetAPICallsByModule(v)
+	return _u
+}
+
+// ClearAPICallsByModule clears the value of the "api_calls_by_module" field.
+func (_u *TenantUsageUpdate) ClearAPICallsByModule() *TenantUsageUpdate {
+	_u.mutation.ClearAPICallsByModule()
+	return _u
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (_u *TenantUsageUpdate) SetStorageBytes(v uint64) *TenantUsageUpdate {
+	_u.mutation.ResetStorageBytes()
+	_u.mutation.SetStorageBytes(v)
+	return _u
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableStorageBytes(v *uint64) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetStorageBytes(*v)
+	}
+	return _u
+}
+
+// AddStorageBytes adds value to the "storage_bytes" field.
+func (_u *TenantUsageUpdate) AddStorageBytes(v int64) *TenantUsageUpdate {
+	_u.mutation.AddStorageBytes(v)
+	return _u
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (_u *TenantUsageUpdate) SetMessagesSent(v uint64) *TenantUsageUpdate {
+	_u.mutation.ResetMessagesSent()
+	_u.mutation.SetMessagesSent(v)
+	return _u
+}
+
+// SetNillableMessagesSent sets the "messages_sent" field if the given value is not nil.
+func (_u *TenantUsageUpdate) SetNillableMessagesSent(v *uint64) *TenantUsageUpdate {
+	if v != nil {
+		_u.SetMessagesSent(*v)
+	}
+	return _u
+}
+
+// AddMessagesSent adds value to the "messages_sent" field.
+func (_u *TenantUsageUpdate) AddMessagesSent(v int64) *TenantUsageUpdate {
+	_u.mutation.AddMessagesSent(v)
+	return _u
+}
+
+// Mutation returns the TenantUsageMutation object of the builder.
+func (_u *TenantUsageUpdate) Mutation() *TenantUsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantUsageUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUsageUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenantusage.Table, tenantusage.Columns, sqlgraph.NewFieldSpec(tenantusage.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantusage.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantusage.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantusage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(tenantusage.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantusage.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(tenantusage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActiveUsers(); ok {
+		_spec.SetField(tenantusage.FieldActiveUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedActiveUsers(); ok {
+		_spec.AddField(tenantusage.FieldActiveUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.APICalls(); ok {
+		_spec.SetField(tenantusage.FieldAPICalls, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedAPICalls(); ok {
+		_spec.AddField(tenantusage.FieldAPICalls, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.APICallsByModule(); ok {
+		_spec.SetField(tenantusage.FieldAPICallsByModule, field.TypeJSON, value)
+	}
+	if _u.mutation.APICallsByModuleCleared() {
+		_spec.ClearField(tenantusage.FieldAPICallsByModule, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StorageBytes(); ok {
+		_spec.SetField(tenantusage.FieldStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedStorageBytes(); ok {
+		_spec.AddField(tenantusage.FieldStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.MessagesSent(); ok {
+		_spec.SetField(tenantusage.FieldMessagesSent, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesSent(); ok {
+		_spec.AddField(tenantusage.FieldMessagesSent, field.TypeUint64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantUsageUpdateOne is the builder for updating a single TenantUsage entity.
+type TenantUsageUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *TenantUsageMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TenantUsageUpdateOne) SetUpdatedAt(v time.Time) *TenantUsageUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableUpdatedAt(v *time.Time) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *TenantUsageUpdateOne) ClearUpdatedAt() *TenantUsageUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *TenantUsageUpdateOne) SetDeletedAt(v time.Time) *TenantUsageUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableDeletedAt(v *time.Time) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *TenantUsageUpdateOne) ClearDeletedAt() *TenantUsageUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *TenantUsageUpdateOne) SetPeriodStart(v time.Time) *TenantUsageUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillablePeriodStart(v *time.Time) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetActiveUsers sets the "active_users" field.
+func (_u *TenantUsageUpdateOne) SetActiveUsers(v uint32) *TenantUsageUpdateOne {
+	_u.mutation.ResetActiveUsers()
+	_u.mutation.SetActiveUsers(v)
+	return _u
+}
+
+// SetNillableActiveUsers sets the "active_users" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableActiveUsers(v *uint32) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetActiveUsers(*v)
+	}
+	return _u
+}
+
+// AddActiveUsers adds value to the "active_users" field.
+func (_u *TenantUsageUpdateOne) AddActiveUsers(v int32) *TenantUsageUpdateOne {
+	_u.mutation.AddActiveUsers(v)
+	return _u
+}
+
+// SetAPICalls sets the "api_calls" field.
+func (_u *TenantUsageUpdateOne) SetAPICalls(v uint64) *TenantUsageUpdateOne {
+	_u.mutation.ResetAPICalls()
+	_u.mutation.SetAPICalls(v)
+	return _u
+}
+
+// SetNillableAPICalls sets the "api_calls" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableAPICalls(v *uint64) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetAPICalls(*v)
+	}
+	return _u
+}
+
+// AddAPICalls adds value to the "api_calls" field.
+func (_u *TenantUsageUpdateOne) AddAPICalls(v int64) *TenantUsageUpdateOne {
+	_u.mutation.AddAPICalls(v)
+	return _u
+}
+
+// SetAPICallsByModule sets the "api_calls_by_module" field.
+func (_u *TenantUsageUpdateOne) SetAPICallsByModule(v map[string]uint64) *TenantUsageUpdateOne {
+	_u.mutation.SetAPICallsByModule(v)
+	return _u
+}
+
+// ClearAPICallsByModule clears the value of the "api_calls_by_module" field.
+func (_u *TenantUsageUpdateOne) ClearAPICallsByModule() *TenantUsageUpdateOne {
+	_u.mutation.ClearAPICallsByModule()
+	return _u
+}
+
+// SetStorageBytes sets the "storage_bytes" field.
+func (_u *TenantUsageUpdateOne) SetStorageBytes(v uint64) *TenantUsageUpdateOne {
+	_u.mutation.ResetStorageBytes()
+	_u.mutation.SetStorageBytes(v)
+	return _u
+}
+
+// SetNillableStorageBytes sets the "storage_bytes" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableStorageBytes(v *uint64) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetStorageBytes(*v)
+	}
+	return _u
+}
+
+// AddStorageBytes adds value to the "storage_bytes" field.
+func (_u *TenantUsageUpdateOne) AddStorageBytes(v int64) *TenantUsageUpdateOne {
+	_u.mutation.AddStorageBytes(v)
+	return _u
+}
+
+// SetMessagesSent sets the "messages_sent" field.
+func (_u *TenantUsageUpdateOne) SetMessagesSent(v uint64) *TenantUsageUpdateOne {
+	_u.mutation.ResetMessagesSent()
+	_u.mutation.SetMessagesSent(v)
+	return _u
+}
+
+// SetNillableMessagesSent sets the "messages_sent" field if the given value is not nil.
+func (_u *TenantUsageUpdateOne) SetNillableMessagesSent(v *uint64) *TenantUsageUpdateOne {
+	if v != nil {
+		_u.SetMessagesSent(*v)
+	}
+	return _u
+}
+
+// AddMessagesSent adds value to the "messages_sent" field.
+func (_u *TenantUsageUpdateOne) AddMessagesSent(v int64) *TenantUsageUpdateOne {
+	_u.mutation.AddMessagesSent(v)
+	return _u
+}
+
+// Mutation returns the TenantUsageMutation object of the builder.
+func (_u *TenantUsageUpdateOne) Mutation() *TenantUsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TenantUsageUpdate builder.
+func (_u *TenantUsageUpdateOne) Where(ps ...predicate.TenantUsage) *TenantUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantUsageUpdateOne) Select(field string, fields ...string) *TenantUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TenantUsage entity.
+func (_u *TenantUsageUpdateOne) Save(ctx context.Context) (*TenantUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUsageUpdateOne) SaveX(ctx context.Context) *TenantUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *TenantUsageUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *TenantUsageUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *TenantUsageUpdateOne) sqlSave(ctx context.Context) (_node *TenantUsage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tenantusage.Table, tenantusage.Columns, sqlgraph.NewFieldSpec(tenantusage.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TenantUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenantusage.FieldID)
+		for _, f := range fields {
+			if !tenantusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenantusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(tenantusage.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(tenantusage.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(tenantusage.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(tenantusage.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(tenantusage.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(tenantusage.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(tenantusage.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ActiveUsers(); ok {
+		_spec.SetField(tenantusage.FieldActiveUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedActiveUsers(); ok {
+		_spec.AddField(tenantusage.FieldActiveUsers, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.APICalls(); ok {
+		_spec.SetField(tenantusage.FieldAPICalls, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedAPICalls(); ok {
+		_spec.AddField(tenantusage.FieldAPICalls, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.APICallsByModule(); ok {
+		_spec.SetField(tenantusage.FieldAPICallsByModule, field.TypeJSON, value)
+	}
+	if _u.mutation.APICallsByModuleCleared() {
+		_spec.ClearField(tenantusage.FieldAPICallsByModule, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.StorageBytes(); ok {
+		_spec.SetField(tenantusage.FieldStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedStorageBytes(); ok {
+		_spec.AddField(tenantusage.FieldStorageBytes, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.MessagesSent(); ok {
+		_spec.SetField(tenantusage.FieldMessagesSent, field.TypeUint64, value)
+	}
+	if value, ok := _u.mutation.AddedMessagesSent(); ok {
+		_spec.AddField(tenantusage.FieldMessagesSent, field.TypeUint64, value)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &TenantUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenantusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
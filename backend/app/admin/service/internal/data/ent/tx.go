@@ -20,6 +20,10 @@ type Tx struct {
 	AdminOperationLog *AdminOperationLogClient
 	// ApiResource is the client for interacting with the ApiResource builders.
 	ApiResource *ApiResourceClient
+	// Conversation is the client for interacting with the Conversation builders.
+	Conversation *ConversationClient
+	// ConversationMember is the client for interacting with the ConversationMember builders.
+	ConversationMember *ConversationMemberClient
 	// Department is the client for interacting with the Department builders.
 	Department *DepartmentClient
 	// DictEntry is the client for interacting with the DictEntry builders.
@@ -32,12 +36,16 @@ type Tx struct {
 	InternalMessage *InternalMessageClient
 	// InternalMessageCategory is the client for interacting with the InternalMessageCategory builders.
 	InternalMessageCategory *InternalMessageCategoryClient
+	// InternalMessageDelivery is the client for interacting with the InternalMessageDelivery builders.
+	InternalMessageDelivery *InternalMessageDeliveryClient
 	// InternalMessageRecipient is the client for interacting with the InternalMessageRecipient builders.
 	InternalMessageRecipient *InternalMessageRecipientClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
 	// Menu is the client for interacting with the Menu builders.
 	Menu *MenuClient
+	// MessageTemplate is the client for interacting with the MessageTemplate builders.
+	MessageTemplate *MessageTemplateClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
 	// Position is the client for interacting with the Position builders.
@@ -54,14 +62,24 @@ type Tx struct {
 	RoleOrg *RoleOrgClient
 	// RolePosition is the client for interacting with the RolePosition builders.
 	RolePosition *RolePositionClient
+	// Setting is the client for interacting with the Setting builders.
+	Setting *SettingClient
 	// Task is the client for interacting with the Task builders.
 	Task *TaskClient
+	// TaskRun is the client for interacting with the TaskRun builders.
+	TaskRun *TaskRunClient
 	// Tenant is the client for interacting with the Tenant builders.
 	Tenant *TenantClient
+	// TenantPlan is the client for interacting with the TenantPlan builders.
+	TenantPlan *TenantPlanClient
+	// TenantUsage is the client for interacting with the TenantUsage builders.
+	TenantUsage *TenantUsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserCredential is the client for interacting with the UserCredential builders.
 	UserCredential *UserCredentialClient
+	// UserNotificationPreference is the client for interacting with the UserNotificationPreference builders.
+	UserNotificationPreference *UserNotificationPreferenceClient
 	// UserPosition is the client for interacting with the UserPosition builders.
 	UserPosition *UserPositionClient
 	// UserRole is the client for interacting with the UserRole builders.
@@ -201,15 +219,19 @@ func (tx *Tx) init() {
 	tx.AdminLoginRestriction = NewAdminLoginRestrictionClient(tx.config)
 	tx.AdminOperationLog = NewAdminOperationLogClient(tx.config)
 	tx.ApiResource = NewApiResourceClient(tx.config)
+	tx.Conversation = NewConversationClient(tx.config)
+	tx.ConversationMember = NewConversationMemberClient(tx.config)
 	tx.Department = NewDepartmentClient(tx.config)
 	tx.DictEntry = NewDictEntryClient(tx.config)
 	tx.DictType = NewDictTypeClient(tx.config)
 	tx.File = NewFileClient(tx.config)
 	tx.InternalMessage = NewInternalMessageClient(tx.config)
 	tx.InternalMessageCategory = NewInternalMessageCategoryClient(tx.config)
+	tx.InternalMessageDelivery = NewInternalMessageDeliveryClient(tx.config)
 	tx.InternalMessageRecipient = NewInternalMessageRecipientClient(tx.config)
 	tx.Language = NewLanguageClient(tx.config)
 	tx.Menu = NewMenuClient(tx.config)
+	tx.MessageTemplate = NewMessageTemplateClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
 	tx.Position = NewPositionClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
@@ -218,10 +240,15 @@ func (tx *Tx) init() {
 	tx.RoleMenu = NewRoleMenuClient(tx.config)
 	tx.RoleOrg = NewRoleOrgClient(tx.config)
 	tx.RolePosition = NewRolePositionClient(tx.config)
+	tx.Setting = NewSettingClient(tx.config)
 	tx.Task = NewTaskClient(tx.config)
+	tx.TaskRun = NewTaskRunClient(tx.config)
 	tx.Tenant = NewTenantClient(tx.config)
+	tx.TenantPlan = NewTenantPlanClient(tx.config)
+	tx.TenantUsage = NewTenantUsageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserCredential = NewUserCredentialClient(tx.config)
+	tx.UserNotificationPreference = NewUserNotificationPreferenceClient(tx.config)
 	tx.UserPosition = NewUserPositionClient(tx.config)
 	tx.UserRole = NewUserRoleClient(tx.config)
 }
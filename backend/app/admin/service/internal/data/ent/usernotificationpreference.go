@@ -0,0 +1,233 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// 用户通知偏好表
+type UserNotificationPreference struct {
+	config `json:"-"`
+	// ID of the ent.
+	// id
+	ID uint32 `json:"id,omitempty"`
+	// 创建时间
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// 更新时间
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// 删除时间
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 租户ID
+	TenantID *uint32 `json:"tenant_id,omitempty"`
+	// 用户ID
+	UserID *uint32 `json:"user_id,omitempty"`
+	// 不接收的通知渠道
+	DisabledChannels []string `json:"disabled_channels,omitempty"`
+	// 按消息分类设置的通知渠道，优先于分类的默认渠道
+	CategoryChannels []*internalMessageV1.CategoryChannels `json:"category_channels,omitempty"`
+	// 静音的消息分类
+	MutedCategoryIds []uint32 `json:"muted_category_ids,omitempty"`
+	// 免打扰时段
+	QuietHours *internalMessageV1.QuietHours `json:"quiet_hours,omitempty"`
+	// 摘要模式
+	Digest       *internalMessageV1.DigestSettings `json:"digest,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserNotificationPreference) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usernotificationpreference.FieldDisabledChannels, usernotificationpreference.FieldCategoryChannels, usernotificationpreference.FieldMutedCategoryIds, usernotificationpreference.FieldQuietHours, usernotificationpreference.FieldDigest:
+			values[i] = new([]byte)
+		case usernotificationpreference.FieldID, usernotificationpreference.FieldTenantID, usernotificationpreference.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case usernotificationpreference.FieldCreatedAt, usernotificationpreference.FieldUpdatedAt, usernotificationpreference.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserNotificationPreference fields.
+func (_m *UserNotificationPreference) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usernotificationpreference.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = uint32(value.Int64)
+		case usernotificationpreference.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case usernotificationpreference.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		case usernotificationpreference.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case usernotificationpreference.FieldTenantID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = new(uint32)
+				*_m.TenantID = uint32(value.Int64)
+			}
+		case usernotificationpreference.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uint32)
+				*_m.UserID = uint32(value.Int64)
+			}
+		case usernotificationpreference.FieldDisabledChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field disabled_channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DisabledChannels); err != nil {
+					return fmt.Errorf("unmarshal field disabled_channels: %w", err)
+				}
+			}
+		case usernotificationpreference.FieldCategoryChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field category_channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.CategoryChannels); err != nil {
+					return fmt.Errorf("unmarshal field category_channels: %w", err)
+				}
+			}
+		case usernotificationpreference.FieldMutedCategoryIds:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field muted_category_ids", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MutedCategoryIds); err != nil {
+					return fmt.Errorf("unmarshal field muted_category_ids: %w", err)
+				}
+			}
+		case usernotificationpreference.FieldQuietHours:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field quiet_hours", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.QuietHours); err != nil {
+					return fmt.Errorf("unmarshal field quiet_hours: %w", err)
+				}
+			}
+		case usernotificationpreference.FieldDigest:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field digest", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Digest); err != nil {
+					return fmt.Errorf("unmarshal field digest: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserNotificationPreference.
+// This includes values selected through modifiers, order, etc.
+func (_m *UserNotificationPreference) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserNotificationPreference.
+// Note that you need to call UserNotificationPreference.Unwrap() before calling this method if this UserNotificationPreference
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *UserNotificationPreference) Update() *UserNotificationPreferenceUpdateOne {
+	return NewUserNotificationPreferenceClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the UserNotificationPreference entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *UserNotificationPreference) Unwrap() *UserNotificationPreference {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserNotificationPreference is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *UserNotificationPreference) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserNotificationPreference(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TenantID; v != nil {
+		builder.WriteString("tenant_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("disabled_channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisabledChannels))
+	builder.WriteString(", ")
+	builder.WriteString("category_channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CategoryChannels))
+	builder.WriteString(", ")
+	builder.WriteString("muted_category_ids=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MutedCategoryIds))
+	builder.WriteString(", ")
+	builder.WriteString("quiet_hours=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuietHours))
+	builder.WriteString(", ")
+	builder.WriteString("digest=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Digest))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserNotificationPreferences is a parsable slice of UserNotificationPreference.
+type UserNotificationPreferences []*UserNotificationPreference
@@ -0,0 +1,1075 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserNotificationPreferenceCreate is the builder for creating a UserNotificationPreference entity.
+type UserNotificationPreferenceCreate struct {
+	config
+	mutation *UserNotificationPreferenceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *UserNotificationPreferenceCreate) SetCreatedAt(v time.Time) *UserNotificationPreferenceCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *UserNotificationPreferenceCreate) SetNillableCreatedAt(v *time.Time) *UserNotificationPreferenceCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *UserNotificationPreferenceCreate) SetUpdatedAt(v time.Time) *UserNotificationPreferenceCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *UserNotificationPreferenceCreate) SetNillableUpdatedAt(v *time.Time) *UserNotificationPreferenceCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *UserNotificationPreferenceCreate) SetDeletedAt(v time.Time) *UserNotificationPreferenceCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *UserNotificationPreferenceCreate) SetNillableDeletedAt(v *time.Time) *UserNotificationPreferenceCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *UserNotificationPreferenceCreate) SetTenantID(v uint32) *UserNotificationPreferenceCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetNillableTenantID sets the "tenant_id" field if the given value is not nil.
+func (_c *UserNotificationPreferenceCreate) SetNillableTenantID(v *uint32) *UserNotificationPreferenceCreate {
+	if v != nil {
+		_c.SetTenantID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *UserNotificationPreferenceCreate) SetUserID(v uint32) *UserNotificationPreferenceCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (_c *UserNotificationPreferenceCreate) SetDisabledChannels(v []string) *UserNotificationPreferenceCreate {
+	_c.mutation.SetDisabledChannels(v)
+	return _c
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (_c *UserNotificationPreferenceCreate) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceCreate {
+	_c.mutation.SetCategoryChannels(v)
+	return _c
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (_c *UserNotificationPreferenceCreate) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceCreate {
+	_c.mutation.SetMutedCategoryIds(v)
+	return _c
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (_c *UserNotificationPreferenceCreate) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceCreate {
+	_c.mutation.SetQuietHours(v)
+	return _c
+}
+
+// SetDigest sets the "digest" field.
+func (_c *UserNotificationPreferenceCreate) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceCreate {
+	_c.mutation.SetDigest(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *UserNotificationPreferenceCreate) SetID(v uint32) *UserNotificationPreferenceCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_c *UserNotificationPreferenceCreate) Mutation() *UserNotificationPreferenceMutation {
+	return _c.mutation
+}
+
+// Save creates the UserNotificationPreference in the database.
+func (_c *UserNotificationPreferenceCreate) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *UserNotificationPreferenceCreate) SaveX(ctx context.Context) *UserNotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserNotificationPreferenceCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *UserNotificationPreferenceCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserNotificationPreference.user_id"`)}
+	}
+	if v, ok := _c.mutation.QuietHours(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "quiet_hours", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.quiet_hours": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Digest(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "digest", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.digest": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := usernotificationpreference.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "UserNotificationPreference.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *UserNotificationPreferenceCreate) sqlSave(ctx context.Context) (*UserNotificationPreference, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = uint32(id)
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *UserNotificationPreferenceCreate) createSpec() (*UserNotificationPreference, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserNotificationPreference{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(usernotificationpreference.Table, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeUint32))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.TenantID(); ok {
+		_spec.SetField(usernotificationpreference.FieldTenantID, field.TypeUint32, value)
+		_node.TenantID = &value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(usernotificationpreference.FieldUserID, field.TypeUint32, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.DisabledChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldDisabledChannels, field.TypeJSON, value)
+		_node.DisabledChannels = value
+	}
+	if value, ok := _c.mutation.CategoryChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldCategoryChannels, field.TypeJSON, value)
+		_node.CategoryChannels = value
+	}
+	if value, ok := _c.mutation.MutedCategoryIds(); ok {
+		_spec.SetField(usernotificationpreference.FieldMutedCategoryIds, field.TypeJSON, value)
+		_node.MutedCategoryIds = value
+	}
+	if value, ok := _c.mutation.QuietHours(); ok {
+		_spec.SetField(usernotificationpreference.FieldQuietHours, field.TypeJSON, value)
+		_node.QuietHours = value
+	}
+	if value, ok := _c.mutation.Digest(); ok {
+		_spec.SetField(usernotificationpreference.FieldDigest, field.TypeJSON, value)
+		_node.Digest = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserNotificationPreference.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserNotificationPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserNotificationPreferenceCreate) OnConflict(opts ...sql.ConflictOption) *UserNotificationPreferenceUpsertOne {
+	_c.conflict = opts
+	return &UserNotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserNotificationPreferenceCreate) OnConflictColumns(columns ...string) *UserNotificationPreferenceUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserNotificationPreferenceUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// UserNotificationPreferenceUpsertOne is the builder for "upsert"-ing
+	//  one UserNotificationPreference node.
+	UserNotificationPreferenceUpsertOne struct {
+		create *UserNotificationPreferenceCreate
+	}
+
+	// UserNotificationPreferenceUpsert is the "OnConflict" setter.
+	UserNotificationPreferenceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserNotificationPreferenceUpsert) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateUpdatedAt() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldUpdatedAt)
+	return u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserNotificationPreferenceUpsert) ClearUpdatedAt() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldUpdatedAt)
+	return u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsert) SetDeletedAt(v time.Time) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateDeletedAt() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsert) ClearDeletedAt() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldDeletedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserNotificationPreferenceUpsert) SetUserID(v uint32) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateUserID() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserNotificationPreferenceUpsert) AddUserID(v uint32) *UserNotificationPreferenceUpsert {
+	u.Add(usernotificationpreference.FieldUserID, v)
+	return u
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsert) SetDisabledChannels(v []string) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldDisabledChannels, v)
+	return u
+}
+
+// UpdateDisabledChannels sets the "disabled_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateDisabledChannels() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldDisabledChannels)
+	return u
+}
+
+// ClearDisabledChannels clears the value of the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsert) ClearDisabledChannels() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldDisabledChannels)
+	return u
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (u *UserNotificationPreferenceUpsert) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldCategoryChannels, v)
+	return u
+}
+
+// UpdateCategoryChannels sets the "category_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateCategoryChannels() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldCategoryChannels)
+	return u
+}
+
+// ClearCategoryChannels clears the value of the "category_channels" field.
+func (u *UserNotificationPreferenceUpsert) ClearCategoryChannels() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldCategoryChannels)
+	return u
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsert) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldMutedCategoryIds, v)
+	return u
+}
+
+// UpdateMutedCategoryIds sets the "muted_category_ids" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateMutedCategoryIds() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldMutedCategoryIds)
+	return u
+}
+
+// ClearMutedCategoryIds clears the value of the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsert) ClearMutedCategoryIds() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldMutedCategoryIds)
+	return u
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsert) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldQuietHours, v)
+	return u
+}
+
+// UpdateQuietHours sets the "quiet_hours" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateQuietHours() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldQuietHours)
+	return u
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsert) ClearQuietHours() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldQuietHours)
+	return u
+}
+
+// SetDigest sets the "digest" field.
+func (u *UserNotificationPreferenceUpsert) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceUpsert {
+	u.Set(usernotificationpreference.FieldDigest, v)
+	return u
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsert) UpdateDigest() *UserNotificationPreferenceUpsert {
+	u.SetExcluded(usernotificationpreference.FieldDigest)
+	return u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (u *UserNotificationPreferenceUpsert) ClearDigest() *UserNotificationPreferenceUpsert {
+	u.SetNull(usernotificationpreference.FieldDigest)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usernotificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserNotificationPreferenceUpsertOne) UpdateNewValues() *UserNotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usernotificationpreference.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usernotificationpreference.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(usernotificationpreference.FieldTenantID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserNotificationPreferenceUpsertOne) Ignore() *UserNotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserNotificationPreferenceUpsertOne) DoNothing() *UserNotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserNotificationPreferenceCreate.OnConflict
+// documentation for more info.
+func (u *UserNotificationPreferenceUpsertOne) Update(set func(*UserNotificationPreferenceUpsert)) *UserNotificationPreferenceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserNotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserNotificationPreferenceUpsertOne) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateUpdatedAt() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearUpdatedAt() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsertOne) SetDeletedAt(v time.Time) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateDeletedAt() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearDeletedAt() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserNotificationPreferenceUpsertOne) SetUserID(v uint32) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserNotificationPreferenceUpsertOne) AddUserID(v uint32) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateUserID() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsertOne) SetDisabledChannels(v []string) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDisabledChannels(v)
+	})
+}
+
+// UpdateDisabledChannels sets the "disabled_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateDisabledChannels() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDisabledChannels()
+	})
+}
+
+// ClearDisabledChannels clears the value of the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearDisabledChannels() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDisabledChannels()
+	})
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (u *UserNotificationPreferenceUpsertOne) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetCategoryChannels(v)
+	})
+}
+
+// UpdateCategoryChannels sets the "category_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateCategoryChannels() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateCategoryChannels()
+	})
+}
+
+// ClearCategoryChannels clears the value of the "category_channels" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearCategoryChannels() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearCategoryChannels()
+	})
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsertOne) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetMutedCategoryIds(v)
+	})
+}
+
+// UpdateMutedCategoryIds sets the "muted_category_ids" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateMutedCategoryIds() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateMutedCategoryIds()
+	})
+}
+
+// ClearMutedCategoryIds clears the value of the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearMutedCategoryIds() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearMutedCategoryIds()
+	})
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsertOne) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetQuietHours(v)
+	})
+}
+
+// UpdateQuietHours sets the "quiet_hours" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateQuietHours() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateQuietHours()
+	})
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearQuietHours() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearQuietHours()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *UserNotificationPreferenceUpsertOne) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertOne) UpdateDigest() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (u *UserNotificationPreferenceUpsertOne) ClearDigest() *UserNotificationPreferenceUpsertOne {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDigest()
+	})
+}
+
+// Exec executes the query.
+func (u *UserNotificationPreferenceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserNotificationPreferenceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserNotificationPreferenceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserNotificationPreferenceUpsertOne) ID(ctx context.Context) (id uint32, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserNotificationPreferenceUpsertOne) IDX(ctx context.Context) uint32 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserNotificationPreferenceCreateBulk is the builder for creating many UserNotificationPreference entities in bulk.
+type UserNotificationPreferenceCreateBulk struct {
+	config
+	err      error
+	builders []*UserNotificationPreferenceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserNotificationPreference entities in the database.
+func (_c *UserNotificationPreferenceCreateBulk) Save(ctx context.Context) ([]*UserNotificationPreference, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*UserNotificationPreference, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserNotificationPreferenceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = uint32(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreateBulk) SaveX(ctx context.Context) []*UserNotificationPreference {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *UserNotificationPreferenceCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *UserNotificationPreferenceCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserNotificationPreference.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserNotificationPreferenceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *UserNotificationPreferenceCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserNotificationPreferenceUpsertBulk {
+	_c.conflict = opts
+	return &UserNotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *UserNotificationPreferenceCreateBulk) OnConflictColumns(columns ...string) *UserNotificationPreferenceUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &UserNotificationPreferenceUpsertBulk{
+		create: _c,
+	}
+}
+
+// UserNotificationPreferenceUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserNotificationPreference nodes.
+type UserNotificationPreferenceUpsertBulk struct {
+	create *UserNotificationPreferenceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usernotificationpreference.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserNotificationPreferenceUpsertBulk) UpdateNewValues() *UserNotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usernotificationpreference.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usernotificationpreference.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(usernotificationpreference.FieldTenantID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserNotificationPreference.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserNotificationPreferenceUpsertBulk) Ignore() *UserNotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserNotificationPreferenceUpsertBulk) DoNothing() *UserNotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserNotificationPreferenceCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserNotificationPreferenceUpsertBulk) Update(set func(*UserNotificationPreferenceUpsert)) *UserNotificationPreferenceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserNotificationPreferenceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateUpdatedAt() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearUpdatedAt() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearUpdatedAt()
+	})
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetDeletedAt(v time.Time) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateDeletedAt() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearDeletedAt() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetUserID(v uint32) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserNotificationPreferenceUpsertBulk) AddUserID(v uint32) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateUserID() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetDisabledChannels(v []string) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDisabledChannels(v)
+	})
+}
+
+// UpdateDisabledChannels sets the "disabled_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateDisabledChannels() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDisabledChannels()
+	})
+}
+
+// ClearDisabledChannels clears the value of the "disabled_channels" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearDisabledChannels() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDisabledChannels()
+	})
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetCategoryChannels(v)
+	})
+}
+
+// UpdateCategoryChannels sets the "category_channels" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateCategoryChannels() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateCategoryChannels()
+	})
+}
+
+// ClearCategoryChannels clears the value of the "category_channels" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearCategoryChannels() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearCategoryChannels()
+	})
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetMutedCategoryIds(v)
+	})
+}
+
+// UpdateMutedCategoryIds sets the "muted_category_ids" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateMutedCategoryIds() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateMutedCategoryIds()
+	})
+}
+
+// ClearMutedCategoryIds clears the value of the "muted_category_ids" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearMutedCategoryIds() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearMutedCategoryIds()
+	})
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetQuietHours(v)
+	})
+}
+
+// UpdateQuietHours sets the "quiet_hours" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateQuietHours() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateQuietHours()
+	})
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearQuietHours() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearQuietHours()
+	})
+}
+
+// SetDigest sets the "digest" field.
+func (u *UserNotificationPreferenceUpsertBulk) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.SetDigest(v)
+	})
+}
+
+// UpdateDigest sets the "digest" field to the value that was provided on create.
+func (u *UserNotificationPreferenceUpsertBulk) UpdateDigest() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.UpdateDigest()
+	})
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (u *UserNotificationPreferenceUpsertBulk) ClearDigest() *UserNotificationPreferenceUpsertBulk {
+	return u.Update(func(s *UserNotificationPreferenceUpsert) {
+		s.ClearDigest()
+	})
+}
+
+// Exec executes the query.
+func (u *UserNotificationPreferenceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserNotificationPreferenceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserNotificationPreferenceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserNotificationPreferenceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
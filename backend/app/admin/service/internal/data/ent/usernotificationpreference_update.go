@@ -0,0 +1,610 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// UserNotificationPreferenceUpdate is the builder for updating UserNotificationPreference entities.
+type UserNotificationPreferenceUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *UserNotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the UserNotificationPreferenceUpdate builder.
+func (_u *UserNotificationPreferenceUpdate) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdate) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableUpdatedAt(v *time.Time) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdate) ClearUpdatedAt() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserNotificationPreferenceUpdate) SetDeletedAt(v time.Time) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableDeletedAt(v *time.Time) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserNotificationPreferenceUpdate) ClearDeletedAt() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserNotificationPreferenceUpdate) SetUserID(v uint32) *UserNotificationPreferenceUpdate {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdate) SetNillableUserID(v *uint32) *UserNotificationPreferenceUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserNotificationPreferenceUpdate) AddUserID(v int32) *UserNotificationPreferenceUpdate {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdate) SetDisabledChannels(v []string) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetDisabledChannels(v)
+	return _u
+}
+
+// AppendDisabledChannels appends value to the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdate) AppendDisabledChannels(v []string) *UserNotificationPreferenceUpdate {
+	_u.mutation.AppendDisabledChannels(v)
+	return _u
+}
+
+// ClearDisabledChannels clears the value of the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdate) ClearDisabledChannels() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearDisabledChannels()
+	return _u
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdate) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetCategoryChannels(v)
+	return _u
+}
+
+// AppendCategoryChannels appends value to the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdate) AppendCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpdate {
+	_u.mutation.AppendCategoryChannels(v)
+	return _u
+}
+
+// ClearCategoryChannels clears the value of the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdate) ClearCategoryChannels() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearCategoryChannels()
+	return _u
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdate) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetMutedCategoryIds(v)
+	return _u
+}
+
+// AppendMutedCategoryIds appends value to the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdate) AppendMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpdate {
+	_u.mutation.AppendMutedCategoryIds(v)
+	return _u
+}
+
+// ClearMutedCategoryIds clears the value of the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdate) ClearMutedCategoryIds() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearMutedCategoryIds()
+	return _u
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (_u *UserNotificationPreferenceUpdate) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetQuietHours(v)
+	return _u
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (_u *UserNotificationPreferenceUpdate) ClearQuietHours() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearQuietHours()
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *UserNotificationPreferenceUpdate) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceUpdate {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *UserNotificationPreferenceUpdate) ClearDigest() *UserNotificationPreferenceUpdate {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_u *UserNotificationPreferenceUpdate) Mutation() *UserNotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserNotificationPreferenceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserNotificationPreferenceUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserNotificationPreferenceUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserNotificationPreferenceUpdate {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserNotificationPreferenceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usernotificationpreference.Table, usernotificationpreference.Columns, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeUint32))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(usernotificationpreference.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usernotificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usernotificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DisabledChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldDisabledChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldDisabledChannels, value)
+		})
+	}
+	if _u.mutation.DisabledChannelsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDisabledChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CategoryChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldCategoryChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategoryChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldCategoryChannels, value)
+		})
+	}
+	if _u.mutation.CategoryChannelsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldCategoryChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MutedCategoryIds(); ok {
+		_spec.SetField(usernotificationpreference.FieldMutedCategoryIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedCategoryIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldMutedCategoryIds, value)
+		})
+	}
+	if _u.mutation.MutedCategoryIdsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldMutedCategoryIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuietHours(); ok {
+		_spec.SetField(usernotificationpreference.FieldQuietHours, field.TypeJSON, value)
+	}
+	if _u.mutation.QuietHoursCleared() {
+		_spec.ClearField(usernotificationpreference.FieldQuietHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(usernotificationpreference.FieldDigest, field.TypeJSON, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDigest, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserNotificationPreferenceUpdateOne is the builder for updating a single UserNotificationPreference entity.
+type UserNotificationPreferenceUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *UserNotificationPreferenceMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetUpdatedAt(v time.Time) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableUpdatedAt(v *time.Time) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearUpdatedAt() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetDeletedAt(v time.Time) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableDeletedAt(v *time.Time) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearDeletedAt() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetUserID(v uint32) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ResetUserID()
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *UserNotificationPreferenceUpdateOne) SetNillableUserID(v *uint32) *UserNotificationPreferenceUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// AddUserID adds value to the "user_id" field.
+func (_u *UserNotificationPreferenceUpdateOne) AddUserID(v int32) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.AddUserID(v)
+	return _u
+}
+
+// SetDisabledChannels sets the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetDisabledChannels(v []string) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetDisabledChannels(v)
+	return _u
+}
+
+// AppendDisabledChannels appends value to the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) AppendDisabledChannels(v []string) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.AppendDisabledChannels(v)
+	return _u
+}
+
+// ClearDisabledChannels clears the value of the "disabled_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearDisabledChannels() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearDisabledChannels()
+	return _u
+}
+
+// SetCategoryChannels sets the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetCategoryChannels(v)
+	return _u
+}
+
+// AppendCategoryChannels appends value to the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) AppendCategoryChannels(v []*internalMessageV1.CategoryChannels) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.AppendCategoryChannels(v)
+	return _u
+}
+
+// ClearCategoryChannels clears the value of the "category_channels" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearCategoryChannels() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearCategoryChannels()
+	return _u
+}
+
+// SetMutedCategoryIds sets the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetMutedCategoryIds(v)
+	return _u
+}
+
+// AppendMutedCategoryIds appends value to the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdateOne) AppendMutedCategoryIds(v []uint32) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.AppendMutedCategoryIds(v)
+	return _u
+}
+
+// ClearMutedCategoryIds clears the value of the "muted_category_ids" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearMutedCategoryIds() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearMutedCategoryIds()
+	return _u
+}
+
+// SetQuietHours sets the "quiet_hours" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetQuietHours(v *internalMessageV1.QuietHours) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetQuietHours(v)
+	return _u
+}
+
+// ClearQuietHours clears the value of the "quiet_hours" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearQuietHours() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearQuietHours()
+	return _u
+}
+
+// SetDigest sets the "digest" field.
+func (_u *UserNotificationPreferenceUpdateOne) SetDigest(v *internalMessageV1.DigestSettings) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.SetDigest(v)
+	return _u
+}
+
+// ClearDigest clears the value of the "digest" field.
+func (_u *UserNotificationPreferenceUpdateOne) ClearDigest() *UserNotificationPreferenceUpdateOne {
+	_u.mutation.ClearDigest()
+	return _u
+}
+
+// Mutation returns the UserNotificationPreferenceMutation object of the builder.
+func (_u *UserNotificationPreferenceUpdateOne) Mutation() *UserNotificationPreferenceMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the UserNotificationPreferenceUpdate builder.
+func (_u *UserNotificationPreferenceUpdateOne) Where(ps ...predicate.UserNotificationPreference) *UserNotificationPreferenceUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserNotificationPreferenceUpdateOne) Select(field string, fields ...string) *UserNotificationPreferenceUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated UserNotificationPreference entity.
+func (_u *UserNotificationPreferenceUpdateOne) Save(ctx context.Context) (*UserNotificationPreference, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdateOne) SaveX(ctx context.Context) *UserNotificationPreference {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserNotificationPreferenceUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserNotificationPreferenceUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (_u *UserNotificationPreferenceUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *UserNotificationPreferenceUpdateOne {
+	_u.modifiers = append(_u.modifiers, modifiers...)
+	return _u
+}
+
+func (_u *UserNotificationPreferenceUpdateOne) sqlSave(ctx context.Context) (_node *UserNotificationPreference, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usernotificationpreference.Table, usernotificationpreference.Columns, sqlgraph.NewFieldSpec(usernotificationpreference.FieldID, field.TypeUint32))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserNotificationPreference.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usernotificationpreference.FieldID)
+		for _, f := range fields {
+			if !usernotificationpreference.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usernotificationpreference.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldUpdatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(usernotificationpreference.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDeletedAt, field.TypeTime)
+	}
+	if _u.mutation.TenantIDCleared() {
+		_spec.ClearField(usernotificationpreference.FieldTenantID, field.TypeUint32)
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(usernotificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.AddedUserID(); ok {
+		_spec.AddField(usernotificationpreference.FieldUserID, field.TypeUint32, value)
+	}
+	if value, ok := _u.mutation.DisabledChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldDisabledChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedDisabledChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldDisabledChannels, value)
+		})
+	}
+	if _u.mutation.DisabledChannelsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDisabledChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.CategoryChannels(); ok {
+		_spec.SetField(usernotificationpreference.FieldCategoryChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedCategoryChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldCategoryChannels, value)
+		})
+	}
+	if _u.mutation.CategoryChannelsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldCategoryChannels, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MutedCategoryIds(); ok {
+		_spec.SetField(usernotificationpreference.FieldMutedCategoryIds, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMutedCategoryIds(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usernotificationpreference.FieldMutedCategoryIds, value)
+		})
+	}
+	if _u.mutation.MutedCategoryIdsCleared() {
+		_spec.ClearField(usernotificationpreference.FieldMutedCategoryIds, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.QuietHours(); ok {
+		_spec.SetField(usernotificationpreference.FieldQuietHours, field.TypeJSON, value)
+	}
+	if _u.mutation.QuietHoursCleared() {
+		_spec.ClearField(usernotificationpreference.FieldQuietHours, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Digest(); ok {
+		_spec.SetField(usernotificationpreference.FieldDigest, field.TypeJSON, value)
+	}
+	if _u.mutation.DigestCleared() {
+		_spec.ClearField(usernotificationpreference.FieldDigest, field.TypeJSON)
+	}
+	_spec.AddModifiers(_u.modifiers...)
+	_node = &UserNotificationPreference{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usernotificationpreference.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -18,6 +18,9 @@ import (
 
 	"go-wind-admin/app/admin/service/internal/data/ent"
 	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+
+	// 注册 schema 中的隐私策略、钩子与默认值，schema 引用了 ent/privacy，不能由 ent 包直接导入
+	_ "go-wind-admin/app/admin/service/internal/data/ent/runtime"
 )
 
 // NewEntClient 创建Ent ORM数据库客户端
@@ -38,9 +41,17 @@ func NewEntClient(cfg *conf.Bootstrap, logger log.Logger) *entCrud.EntClient[*en
 
 		// 运行数据库迁移工具
 		if cfg.Data.Database.GetMigrate() {
-			if err := client.Schema.Create(context.Background(), migrate.WithForeignKeys(true)); err != nil {
+			if err := client.Schema.Create(context.Background(),
+				migrate.WithForeignKeys(true),
+				// 删除已从Schema中移除的索引，例如改为租户内唯一之前的全局唯一索引
+				migrate.WithDropIndex(true),
+			); err != nil {
 				l.Fatalf("failed creating schema resources: %v", err)
 			}
+
+			if err := migrateTenantScope(context.Background(), client); err != nil {
+				l.Fatalf("failed migrating tenant scoped data: %v", err)
+			}
 		}
 
 		return client
@@ -0,0 +1,15 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/eventbus"
+)
+
+// NewEventBus 创建进程内的事件总线，业务状态变化时发布事件，由关心的模块订阅处理
+func NewEventBus(logger log.Logger) (eventbus.EventBus, func()) {
+	bus := eventbus.NewEventBus(logger)
+	return bus, func() {
+		_ = bus.Close()
+	}
+}
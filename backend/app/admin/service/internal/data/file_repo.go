@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -82,6 +83,56 @@ func (r *FileRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector))
 	return count, nil
 }
 
+// SumSizeByTenant 统计租户全部文件的大小（字节）
+func (r *FileRepo) SumSizeByTenant(ctx context.Context, tenantId uint32) (uint64, error) {
+	var rows []struct {
+		Size uint64 `json:"size"`
+	}
+	err := r.data.db.Client().File.Query().
+		Where(file.TenantIDEQ(tenantId)).
+		Modify(func(s *sql.Selector) {
+			s.Select(sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(file.FieldSize)), "size"))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("query file size sum failed: %s", err.Error())
+		return 0, fileV1.ErrorInternalServerError("query file size sum failed")
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	return rows[0].Size, nil
+}
+
+// SumSizeGroupByTenant 统计各租户的文件总字节数，没有文件的租户不返回
+func (r *FileRepo) SumSizeGroupByTenant(ctx context.Context) (map[uint32]uint64, error) {
+	var rows []struct {
+		TenantId uint32 `json:"tenant_id"`
+		Size     uint64 `json:"size"`
+	}
+	err := r.data.db.Client().File.Query().
+		Where(file.TenantIDNotNil()).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				s.C(file.FieldTenantID),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", s.C(file.FieldSize)), "size"),
+			).GroupBy(s.C(file.FieldTenantID))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("query file size sum by tenant failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query file size sum failed")
+	}
+
+	sizes := make(map[uint32]uint64, len(rows))
+	for _, row := range rows {
+		sizes[row.TenantId] = row.Size
+	}
+
+	return sizes, nil
+}
+
 func (r *FileRepo) List(ctx context.Context, req *pagination.PagingRequest) (*fileV1.ListFileResponse, error) {
 	if req == nil {
 		return nil, fileV1.ErrorBadRequest("invalid parameter")
@@ -103,6 +154,28 @@ func (r *FileRepo) List(ctx context.Context, req *pagination.PagingRequest) (*fi
 	}, nil
 }
 
+// ListByDirectory 查询存储桶中某个目录下的全部文件，按创建时间倒序排列
+func (r *FileRepo) ListByDirectory(ctx context.Context, bucketName, fileDirectory string) ([]*fileV1.File, error) {
+	entities, err := r.data.db.Client().File.Query().
+		Where(
+			file.BucketNameEQ(bucketName),
+			file.FileDirectoryEQ(fileDirectory),
+		).
+		Order(ent.Desc(file.FieldCreatedAt), ent.Desc(file.FieldID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query list failed: %s", err.Error())
+		return nil, fileV1.ErrorInternalServerError("query list failed")
+	}
+
+	items := make([]*fileV1.File, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.mapper.ToDTO(entity))
+	}
+
+	return items, nil
+}
+
 func (r *FileRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
 	exist, err := r.data.db.Client().File.Query().
 		Where(file.IDEQ(id)).
@@ -17,15 +17,19 @@ func RegisterMigrateModels() {
 		&models.AdminLoginRestriction{},
 		&models.AdminOperationLog{},
 		&models.ApiResource{},
+		&models.Conversation{},
+		&models.ConversationMember{},
 		&models.Department{},
 		&models.DictEntry{},
 		&models.DictType{},
 		&models.File{},
 		&models.InternalMessage{},
 		&models.InternalMessageCategory{},
+		&models.InternalMessageDelivery{},
 		&models.InternalMessageRecipient{},
 		&models.Language{},
 		&models.Menu{},
+		&models.MessageTemplate{},
 		&models.Organization{},
 		&models.Position{},
 		&models.Role{},
@@ -35,9 +39,11 @@ func RegisterMigrateModels() {
 		&models.RoleOrg{},
 		&models.RolePosition{},
 		&models.Task{},
+		&models.TaskRun{},
 		&models.Tenant{},
 		&models.User{},
 		&models.UserCredential{},
+		&models.UserNotificationPreference{},
 		&models.UserPosition{},
 		&models.UserRole{},
 	)
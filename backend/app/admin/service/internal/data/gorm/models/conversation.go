@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// Conversation 对应表 internal_message_conversations
+type Conversation struct {
+	mixin.AutoIncrementID
+
+	Type          *string    `gorm:"column:type;type:enum('DIRECT','GROUP');default:DIRECT;comment:会话类型"`
+	Name          *string    `gorm:"column:name;type:varchar(255);comment:会话名称"`
+	AvatarURL     *string    `gorm:"column:avatar_url;type:varchar(1024);comment:会话头像"`
+	DirectKey     *string    `gorm:"column:direct_key;type:varchar(64);comment:私聊会话的成员键;uniqueIndex:idx_internal_message_conversation_direct_key"`
+	MemberCount   *uint32    `gorm:"column:member_count;type:int unsigned;default:0;comment:成员数量"`
+	LastMessageID *uint32    `gorm:"column:last_message_id;type:int unsigned;comment:最后一条消息ID"`
+	LastMessageAt *time.Time `gorm:"column:last_message_at;type:datetime;comment:最后一条消息的时间"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (Conversation) TableName() string {
+	return "internal_message_conversations"
+}
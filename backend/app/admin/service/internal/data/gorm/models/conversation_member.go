@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// ConversationMember 对应表 internal_message_conversation_members
+type ConversationMember struct {
+	mixin.AutoIncrementID
+
+	ConversationID    *uint32    `gorm:"column:conversation_id;type:int unsigned;comment:会话ID;uniqueIndex:idx_internal_message_conversation_member,priority:1"`
+	UserID            *uint32    `gorm:"column:user_id;type:int unsigned;comment:成员用户ID;uniqueIndex:idx_internal_message_conversation_member,priority:2;index:idx_internal_message_conversation_member_user"`
+	Role              *string    `gorm:"column:role;type:enum('MEMBER','ADMIN','OWNER');default:MEMBER;comment:成员角色"`
+	MutedUntil        *time.Time `gorm:"column:muted_until;type:datetime;comment:禁言截止时间"`
+	LastReadMessageID *uint32    `gorm:"column:last_read_message_id;type:int unsigned;comment:已读到的消息ID"`
+	UnreadCount       *uint32    `gorm:"column:unread_count;type:int unsigned;default:0;comment:未读消息数量"`
+	JoinedAt          *time.Time `gorm:"column:joined_at;type:datetime;comment:加入时间"`
+
+	mixin.TimeAt
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (ConversationMember) TableName() string {
+	return "internal_message_conversation_members"
+}
@@ -1,6 +1,12 @@
 package models
 
-import "github.com/tx7do/go-crud/gorm/mixin"
+import (
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
 
 // InternalMessage 对应表 internal_messages
 type InternalMessage struct {
@@ -10,9 +16,34 @@ type InternalMessage struct {
 	Content    *string `gorm:"column:content;type:text;comment:消息内容"`
 	SenderID   *uint32 `gorm:"column:sender_id;type:int unsigned;comment:发送者用户ID"`
 	CategoryID *uint32 `gorm:"column:category_id;type:int unsigned;comment:分类ID"`
-	Status     *string `gorm:"column:status;type:varchar(32);default:DRAFT;comment:消息状态"`
+	Status     *string `gorm:"column:status;type:varchar(32);default:DRAFT;comment:消息状态;index:idx_internal_message_status_send_at,priority:1;index:idx_internal_message_status_created_at,priority:1"`
 	Type       *string `gorm:"column:type;type:varchar(32);default:NOTIFICATION;comment:消息类型"`
 
+	SendAt        *time.Time      `gorm:"column:send_at;type:datetime;comment:计划发送时间;index:idx_internal_message_status_send_at,priority:2"`
+	CronSpec      *string         `gorm:"column:cron_spec;type:varchar(255);comment:周期发送的cron表达式"`
+	LastSentAt    *time.Time      `gorm:"column:last_sent_at;type:datetime;comment:最近一次发送时间"`
+	TargetAll     *bool           `gorm:"column:target_all;type:bool;comment:是否发送给所有用户"`
+	TargetUserIDs *datatypes.JSON `gorm:"column:target_user_ids;type:json;comment:接收者用户ID列表"`
+	Audience      *datatypes.JSON `gorm:"column:audience;type:json;comment:受众表达式"`
+
+	DeliveryStatus     *string    `gorm:"column:delivery_status;type:enum('PENDING','DELIVERING','DELIVERED','PARTIALLY_FAILED','FAILED');default:PENDING;comment:投递状态"`
+	RecipientTotal     *uint32    `gorm:"column:recipient_total;type:int unsigned;comment:接收者总数"`
+	DeliveredCount     *uint32    `gorm:"column:delivered_count;type:int unsigned;comment:已投递数量"`
+	FailedCount        *uint32    `gorm:"column:failed_count;type:int unsigned;comment:投递失败数量"`
+	DeliveryStartedAt  *time.Time `gorm:"column:delivery_started_at;type:datetime;comment:开始投递时间"`
+	DeliveryFinishedAt *time.Time `gorm:"column:delivery_finished_at;type:datetime;comment:完成投递时间"`
+
+	TemplateCode      *string         `gorm:"column:template_code;type:varchar(128);comment:消息模板编码"`
+	TemplateVariables *datatypes.JSON `gorm:"column:template_variables;type:json;comment:消息模板变量"`
+
+	ConversationID *uint32 `gorm:"column:conversation_id;type:int unsigned;comment:会话ID;index:idx_internal_message_conversation_thread,priority:1"`
+	ThreadID       *uint32 `gorm:"column:thread_id;type:int unsigned;comment:话题ID;index:idx_internal_message_conversation_thread,priority:2"`
+	ReplyCount     *uint32 `gorm:"column:reply_count;type:int unsigned;default:0;comment:回复数量"`
+
+	RemindCount    *uint32    `gorm:"column:remind_count;type:int unsigned;default:0;comment:提醒未读者的次数"`
+	LastRemindedAt *time.Time `gorm:"column:last_reminded_at;type:datetime;comment:最近一次提醒未读者的时间"`
+	Priority       *string    `gorm:"column:priority;type:enum('NORMAL','LOW','HIGH','URGENT');default:NORMAL;comment:消息优先级"`
+
 	mixin.TimeAt
 	mixin.OperatorID
 	mixin.TenantID
@@ -1,6 +1,10 @@
 package models
 
-import "github.com/tx7do/go-crud/gorm/mixin"
+import (
+	"gorm.io/datatypes"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
 
 // InternalMessageCategory 对应表 internal_message_categories
 type InternalMessageCategory struct {
@@ -10,6 +14,8 @@ type InternalMessageCategory struct {
 	Code    *string `gorm:"column:code;type:varchar(128);comment:编码"`
 	IconURL *string `gorm:"column:icon_url;type:varchar(1024);comment:图标URL"`
 
+	Channels *datatypes.JSON `gorm:"column:channels;type:json;comment:默认通知渠道"`
+
 	// 简单树结构字段（原 Ent 的 Tree 在 GORM mixin 中可能不同，这里保留常用字段）
 	ParentID *uint32 `gorm:"column:parent_id;type:int unsigned;comment:父级ID"`
 	Path     *string `gorm:"column:path;type:varchar(1024);comment:路径"`
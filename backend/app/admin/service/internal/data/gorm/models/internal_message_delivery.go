@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// InternalMessageDelivery 对应表 internal_message_deliveries，站内信消息外部渠道投递记录表
+type InternalMessageDelivery struct {
+	mixin.AutoIncrementID
+
+	MessageID       *uint32    `gorm:"column:message_id;type:int unsigned;comment:站内信内容ID;index:idx_internal_message_delivery_message_status,priority:1"`
+	RecipientID     *uint32    `gorm:"column:recipient_id;type:int unsigned;comment:收件记录ID;uniqueIndex:idx_internal_message_delivery_recipient_channel,priority:1"`
+	RecipientUserID *uint32    `gorm:"column:recipient_user_id;type:int unsigned;comment:接收者用户ID"`
+	Channel         *string    `gorm:"column:channel;type:varchar(32);comment:通知渠道;uniqueIndex:idx_internal_message_delivery_recipient_channel,priority:2"`
+	Address         *string    `gorm:"column:address;type:varchar(255);comment:接收地址"`
+	Status          *string    `gorm:"column:status;type:enum('PENDING','SENT','FAILED','SKIPPED');default:PENDING;comment:投递状态;index:idx_internal_message_delivery_message_status,priority:2"`
+	Attempts        *uint32    `gorm:"column:attempts;type:int unsigned;default:0;comment:尝试次数"`
+	LastError       *string    `gorm:"column:last_error;type:text;comment:最后一次失败的原因"`
+	SentAt          *time.Time `gorm:"column:sent_at;type:datetime;comment:发送成功时间"`
+
+	mixin.TimeAt
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (InternalMessageDelivery) TableName() string {
+	return "internal_message_deliveries"
+}
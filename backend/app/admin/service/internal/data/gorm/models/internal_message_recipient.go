@@ -10,11 +10,13 @@ import (
 type InternalMessageRecipient struct {
 	mixin.AutoIncrementID
 
-	MessageID       *uint32    `gorm:"column:message_id;type:int unsigned;comment:站内信内容ID"`
-	RecipientUserID *uint32    `gorm:"column:recipient_user_id;type:int unsigned;comment:接收者用户ID"`
+	MessageID       *uint32    `gorm:"column:message_id;type:int unsigned;comment:站内信内容ID;index:idx_internal_message_recipient_message_user,priority:1"`
+	RecipientUserID *uint32    `gorm:"column:recipient_user_id;type:int unsigned;comment:接收者用户ID;index:idx_internal_message_recipient_message_user,priority:2"`
 	Status          *string    `gorm:"column:status;type:varchar(32);comment:消息状态"`
 	ReceivedAt      *time.Time `gorm:"column:received_at;type:datetime;comment:消息到达用户收件箱的时间"`
 	ReadAt          *time.Time `gorm:"column:read_at;type:datetime;comment:用户阅读消息的时间"`
+	Title           *string    `gorm:"column:title;type:varchar(255);comment:按接收者渲染的消息标题"`
+	Content         *string    `gorm:"column:content;type:text;comment:按接收者渲染的消息内容"`
 
 	mixin.TimeAt
 	mixin.TenantID
@@ -0,0 +1,29 @@
+package models
+
+import (
+	"gorm.io/datatypes"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// MessageTemplate 对应表 internal_message_templates，站内信消息模板表
+type MessageTemplate struct {
+	mixin.AutoIncrementID
+
+	Code            *string         `gorm:"column:code;type:varchar(128);comment:模板编码;uniqueIndex:idx_internal_message_template_code"`
+	Name            *string         `gorm:"column:name;type:varchar(255);comment:模板名称"`
+	CategoryID      *uint32         `gorm:"column:category_id;type:int unsigned;comment:消息分类ID"`
+	DefaultLanguage *string         `gorm:"column:default_language;type:varchar(32);comment:默认语言代码"`
+	Locales         *datatypes.JSON `gorm:"column:locales;type:json;comment:各语言版本的标题与正文"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.IsEnabled
+	mixin.Remark
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (MessageTemplate) TableName() string {
+	return "internal_message_templates"
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// TaskRun 对应表 sys_task_runs，任务执行记录表
+type TaskRun struct {
+	mixin.AutoIncrementID
+
+	TaskID          *uint32         `gorm:"column:task_id;type:int unsigned;comment:调度任务ID;index:idx_sys_task_run_task_id_started_at,priority:1"`
+	TypeName        *string         `gorm:"column:type_name;type:varchar(255);comment:任务执行类型名"`
+	RunID           *string         `gorm:"column:run_id;type:varchar(255);comment:队列中的任务ID;index:idx_sys_task_run_run_id"`
+	Queue           *string         `gorm:"column:queue;type:varchar(64);comment:队列名称"`
+	Status          *string         `gorm:"column:status;type:enum('PENDING','RUNNING','SUCCEEDED','FAILED');default:PENDING;comment:执行状态;index:idx_sys_task_run_status"`
+	Trigger         *string         `gorm:"column:trigger;type:enum('SCHEDULE','MANUAL');default:SCHEDULE;comment:触发方式"`
+	StartedAt       *time.Time      `gorm:"column:started_at;type:datetime;comment:开始时间;index:idx_sys_task_run_task_id_started_at,priority:2"`
+	FinishedAt      *time.Time      `gorm:"column:finished_at;type:datetime;comment:结束时间"`
+	DurationMs      *int64          `gorm:"column:duration_ms;type:bigint;comment:执行耗时（毫秒）"`
+	RetryCount      *int32          `gorm:"column:retry_count;type:int;default:0;comment:已重试次数"`
+	MaxRetry        *int32          `gorm:"column:max_retry;type:int;comment:最大重试次数"`
+	Progress        *int32          `gorm:"column:progress;type:int;default:0;comment:执行进度（0-100）"`
+	ProgressMessage *string         `gorm:"column:progress_message;type:varchar(1024);comment:进度说明"`
+	ErrorMessage    *string         `gorm:"column:error_message;type:text;comment:错误信息"`
+	Result          *datatypes.JSON `gorm:"column:result;type:json;comment:执行结果"`
+
+	mixin.TimeAt
+	mixin.OperatorID
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (TaskRun) TableName() string {
+	return "sys_task_runs"
+}
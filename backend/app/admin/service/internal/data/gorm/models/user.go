@@ -12,7 +12,7 @@ import (
 type User struct {
 	mixin.AutoIncrementID
 
-	Username      *string         `gorm:"column:username;type:varchar(255);comment:用户名;uniqueIndex:idx_sys_user_username"`
+	Username      *string         `gorm:"column:username;type:varchar(255);comment:用户名;index:idx_sys_user_username"`
 	Nickname      *string         `gorm:"column:nickname;type:varchar(255);comment:昵称"`
 	Realname      *string         `gorm:"column:realname;type:varchar(255);comment:真实名字"`
 	Email         *string         `gorm:"column:email;type:varchar(320);comment:电子邮箱"`
@@ -21,6 +21,7 @@ type User struct {
 	Avatar        *string         `gorm:"column:avatar;type:varchar(255);comment:头像"`
 	Address       *string         `gorm:"column:address;type:varchar(255);comment:地址"`
 	Region        *string         `gorm:"column:region;type:varchar(255);comment:国家地区"`
+	Language      *string         `gorm:"column:language;type:varchar(32);comment:首选语言代码"`
 	Description   *string         `gorm:"column:description;type:varchar(1023);comment:个人说明"`
 	Gender        *string         `gorm:"column:gender;type:enum('SECRET','MALE','FEMALE');comment:性别;index:idx_sys_user_gender"`
 	Authority     *string         `gorm:"column:authority;type:enum('SYS_ADMIN','TENANT_ADMIN','CUSTOMER_USER','GUEST');comment:授权;index:idx_sys_user_authority"`
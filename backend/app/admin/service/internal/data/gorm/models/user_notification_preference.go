@@ -0,0 +1,27 @@
+package models
+
+import (
+	"gorm.io/datatypes"
+
+	"github.com/tx7do/go-crud/gorm/mixin"
+)
+
+// UserNotificationPreference 对应表 sys_user_notification_preferences，用户通知偏好表
+type UserNotificationPreference struct {
+	mixin.AutoIncrementID
+
+	UserID           *uint32         `gorm:"column:user_id;type:int unsigned;comment:用户ID;uniqueIndex:idx_sys_user_notification_preference_user_id"`
+	DisabledChannels *datatypes.JSON `gorm:"column:disabled_channels;type:json;comment:不接收的通知渠道"`
+	CategoryChannels *datatypes.JSON `gorm:"column:category_channels;type:json;comment:按消息分类设置的通知渠道"`
+	MutedCategoryIDs *datatypes.JSON `gorm:"column:muted_category_ids;type:json;comment:静音的消息分类"`
+	QuietHours       *datatypes.JSON `gorm:"column:quiet_hours;type:json;comment:免打扰时段"`
+	Digest           *datatypes.JSON `gorm:"column:digest;type:json;comment:摘要模式"`
+
+	mixin.TimeAt
+	mixin.TenantID
+}
+
+// TableName 指定表名
+func (UserNotificationPreference) TableName() string {
+	return "sys_user_notification_preferences"
+}
@@ -0,0 +1,244 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+const (
+	inboxCounterKeyPrefix = "inbox:unread:"
+	inboxCounterExpires   = 7 * 24 * time.Hour
+
+	inboxCounterTotalField    = "total"
+	inboxCounterCategoryField = "category:"
+	inboxCounterTypeField     = "type:"
+)
+
+// inboxCounterIncrScript 计数存在时才累加，避免在没有对账的计数上累加出错误的数量；
+// 累加后出现负数说明计数与数据库不一致，删除计数等待下次对账。
+// 返回累加后的全部计数，计数不存在或被删除时返回空
+const inboxCounterIncrScript = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local negative = false
+for i = 1, #ARGV - 1, 2 do
+	if redis.call('HINCRBY', KEYS[1], ARGV[i], ARGV[i + 1]) < 0 then
+		negative = true
+	end
+end
+if negative then
+	redis.call('DEL', KEYS[1])
+	return false
+end
+redis.call('EXPIRE', KEYS[1], ARGV[#ARGV])
+return redis.call('HGETALL', KEYS[1])
+`
+
+// InboxUnreadCounts 收件箱的未读数量，既可以表示全部计数，也可以表示计数的变化
+type InboxUnreadCounts map[string]int64
+
+// Add 累加一条消息的未读数量
+func (c InboxUnreadCounts) Add(categoryId uint32, messageType internalMessageV1.InternalMessage_Type, n int64) {
+	if n == 0 {
+		return
+	}
+	c[inboxCounterTotalField] += n
+	c[inboxCounterCategoryField+strconv.FormatUint(uint64(categoryId), 10)] += n
+	c[inboxCounterTypeField+messageType.String()] += n
+}
+
+// Key 按计数内容生成的键，内容相同的计数键相同
+func (c InboxUnreadCounts) Key() string {
+	keys := make([]string, 0, len(c))
+	for k, v := range c {
+		if v != 0 {
+			keys = append(keys, k+"="+strconv.FormatInt(v, 10))
+		}
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
+// ToSummary 转换为用户的未读数量汇总
+func (c InboxUnreadCounts) ToSummary(userId uint32) *internalMessageV1.InboxSummary {
+	values := make(map[string]string, len(c))
+	for k, v := range c {
+		values[k] = strconv.FormatInt(v, 10)
+	}
+	return toInboxSummary(userId, values)
+}
+
+// InboxCounterRepo 收件箱未读数量计数，按用户保存在Redis的哈希中。
+// 计数在投递、已读、删除与撤销时累加，不存在时由数据库对账重建
+type InboxCounterRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+
+	keyPrefix string
+	expires   time.Duration
+}
+
+func NewInboxCounterRepo(logger log.Logger, rdb *redis.Client) *InboxCounterRepo {
+	return &InboxCounterRepo{
+		log:       log.NewHelper(log.With(logger, "module", "inbox-counter/cache")),
+		rdb:       rdb,
+		keyPrefix: inboxCounterKeyPrefix,
+		expires:   inboxCounterExpires,
+	}
+}
+
+func (r *InboxCounterRepo) makeKey(userId uint32) string {
+	return fmt.Sprintf("%s%d", r.keyPrefix, userId)
+}
+
+// Get 获取用户的未读数量汇总，计数不存在时返回false
+func (r *InboxCounterRepo) Get(ctx context.Context, userId uint32) (*internalMessageV1.InboxSummary, bool, error) {
+	values, err := r.rdb.HGetAll(ctx, r.makeKey(userId)).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(values) == 0 {
+		return nil, false, nil
+	}
+
+	return toInboxSummary(userId, values), true, nil
+}
+
+// Set 用数据库对账得到的数量覆盖用户的计数，返回对应的汇总
+func (r *InboxCounterRepo) Set(ctx context.Context, userId uint32, counts InboxUnreadCounts) (*internalMessageV1.InboxSummary, error) {
+	values := make(map[string]any, len(counts)+1)
+	// 没有未读时也写入总数，表示计数已经对账
+	values[inboxCounterTotalField] = int64(0)
+	for k, v := range counts {
+		if v > 0 {
+			values[k] = v
+		}
+	}
+
+	key := r.makeKey(userId)
+	if _, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.HSet(ctx, key, values)
+		pipe.Expire(ctx, key, r.expires)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return counts.ToSummary(userId), nil
+}
+
+// Incr 累加多个用户的计数，返回累加后的汇总，计数不存在的用户不会出现在结果中
+func (r *InboxCounterRepo) Incr(ctx context.Context, userIds []uint32, delta InboxUnreadCounts) (map[uint32]*internalMessageV1.InboxSummary, error) {
+	if len(userIds) == 0 || len(delta) == 0 {
+		return map[uint32]*internalMessageV1.InboxSummary{}, nil
+	}
+
+	args := make([]any, 0, len(delta)*2+1)
+	for k, v := range delta {
+		if v != 0 {
+			args = append(args, k, v)
+		}
+	}
+	args = append(args, int64(r.expires/time.Second))
+
+	cmds := make([]*redis.Cmd, len(userIds))
+	if _, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, userId := range userIds {
+			cmds[i] = pipe.Eval(ctx, inboxCounterIncrScript, []string{r.makeKey(userId)}, args...)
+		}
+		return nil
+	}); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	summaries := make(map[uint32]*internalMessageV1.InboxSummary, len(userIds))
+	for i, cmd := range cmds {
+		items, err := cmd.Slice()
+		if err != nil {
+			continue
+		}
+
+		values := make(map[string]string, len(items)/2)
+		for j := 0; j+1 < len(items); j += 2 {
+			values[fmt.Sprint(items[j])] = fmt.Sprint(items[j+1])
+		}
+		summaries[userIds[i]] = toInboxSummary(userIds[i], values)
+	}
+
+	return summaries, nil
+}
+
+// Invalidate 删除用户的计数，下次查询时对账重建
+func (r *InboxCounterRepo) Invalidate(ctx context.Context, userIds ...uint32) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		keys = append(keys, r.makeKey(userId))
+	}
+	return r.rdb.Del(ctx, keys...).Err()
+}
+
+// toInboxSummary 将哈希中的计数转换为汇总，数量为0的分类与类型不返回
+func toInboxSummary(userId uint32, values map[string]string) *internalMessageV1.InboxSummary {
+	summary := &internalMessageV1.InboxSummary{
+		UserId:     userId,
+		Categories: []*internalMessageV1.InboxSummary_CategoryUnread{},
+		Types:      []*internalMessageV1.InboxSummary_TypeUnread{},
+	}
+
+	for field, value := range values {
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n <= 0 {
+			continue
+		}
+
+		switch {
+		case field == inboxCounterTotalField:
+			summary.TotalUnread = uint32(n)
+
+		case strings.HasPrefix(field, inboxCounterCategoryField):
+			categoryId, err := strconv.ParseUint(strings.TrimPrefix(field, inboxCounterCategoryField), 10, 32)
+			if err != nil {
+				continue
+			}
+			summary.Categories = append(summary.Categories, &internalMessageV1.InboxSummary_CategoryUnread{
+				CategoryId: uint32(categoryId),
+				Unread:     uint32(n),
+			})
+
+		case strings.HasPrefix(field, inboxCounterTypeField):
+			messageType, ok := internalMessageV1.InternalMessage_Type_value[strings.TrimPrefix(field, inboxCounterTypeField)]
+			if !ok {
+				continue
+			}
+			summary.Types = append(summary.Types, &internalMessageV1.InboxSummary_TypeUnread{
+				Type:   internalMessageV1.InternalMessage_Type(messageType),
+				Unread: uint32(n),
+			})
+		}
+	}
+
+	sort.Slice(summary.Categories, func(i, j int) bool {
+		return summary.Categories[i].CategoryId < summary.Categories[j].CategoryId
+	})
+	sort.Slice(summary.Types, func(i, j int) bool {
+		return summary.Types[i].Type < summary.Types[j].Type
+	})
+
+	return summary
+}
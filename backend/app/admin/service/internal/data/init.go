@@ -19,10 +19,24 @@ var ProviderSet = wire.NewSet(
 
 	NewMinIoClient,
 
+	NewNotifyRegistry,
+	NewTenantTemplates,
+	NewSettingRegistry,
+
+	NewLeaderElector,
+	NewClusterBroadcaster,
+
+	NewRateLimitPolicy,
+	NewRateLimiter,
+
+	NewEventBus,
+
 	NewMenuRepo,
 	NewDictTypeRepo,
 	NewDictEntryRepo,
 	NewTaskRepo,
+	NewTaskRunRepo,
+	NewDatabaseBackupRepo,
 	NewAdminLoginRestrictionRepo,
 	NewApiResourceRepo,
 
@@ -32,6 +46,8 @@ var ProviderSet = wire.NewSet(
 	NewRoleRepo,
 	NewUserRepo,
 	NewTenantRepo,
+	NewTenantPlanRepo,
+	NewSettingRepo,
 	NewUserCredentialRepo,
 
 	NewRoleApiRepo,
@@ -50,6 +66,21 @@ var ProviderSet = wire.NewSet(
 	NewInternalMessageRepo,
 	NewInternalMessageCategoryRepo,
 	NewInternalMessageRecipientRepo,
+	NewInternalMessageDeliveryRepo,
+	NewUserNotificationPreferenceRepo,
+	NewAudienceRepo,
+	NewMessageTemplateRepo,
+	NewConversationRepo,
 
 	NewUserTokenRepo,
+	NewInboxCounterRepo,
+	NewNotificationDigestRepo,
+	NewTenantCacheRepo,
+	NewTenantUsageRepo,
+	NewTenantUsageCacheRepo,
+	NewTenantUsageConfig,
+	NewSseConfig,
+	NewSettingCacheRepo,
+	NewTenantProvisionRepo,
+	NewTenantTransferRepo,
 )
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"slices"
 	"sort"
 	"time"
 
@@ -205,6 +206,7 @@ func (r *InternalMessageCategoryRepo) Create(ctx context.Context, req *internalM
 		SetNillableName(req.Data.Name).
 		SetNillableCode(req.Data.Code).
 		SetNillableIconURL(req.Data.IconUrl).
+		SetChannels(req.Data.Channels).
 		SetNillableParentID(req.Data.ParentId).
 		SetNillableSortOrder(req.Data.SortOrder).
 		SetNillableIsEnabled(req.Data.IsEnabled).
@@ -259,6 +261,11 @@ func (r *InternalMessageCategoryRepo) Update(ctx context.Context, req *internalM
 				SetNillableUpdatedBy(req.Data.UpdatedBy).
 				SetNillableUpdatedAt(timeutil.TimestamppbToTime(req.Data.UpdatedAt))
 
+			// 通知渠道为空时只有在更新掩码中显式指定才会清空
+			if req.Data.Channels != nil || slices.Contains(req.GetUpdateMask().GetPaths(), "channels") {
+				builder.SetChannels(req.Data.Channels)
+			}
+
 			if req.Data.UpdatedAt == nil {
 				builder.SetUpdatedAt(time.Now())
 			}
@@ -0,0 +1,181 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+type InternalMessageDeliveryRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper          *mapper.CopierMapper[internalMessageV1.InternalMessageDelivery, ent.InternalMessageDelivery]
+	statusConverter *mapper.EnumTypeConverter[internalMessageV1.InternalMessageDelivery_Status, internalmessagedelivery.Status]
+
+	repository *entCrud.Repository[
+		ent.InternalMessageDeliveryQuery, ent.InternalMessageDeliverySelect,
+		ent.InternalMessageDeliveryCreate, ent.InternalMessageDeliveryCreateBulk,
+		ent.InternalMessageDeliveryUpdate, ent.InternalMessageDeliveryUpdateOne,
+		ent.InternalMessageDeliveryDelete,
+		predicate.InternalMessageDelivery,
+		internalMessageV1.InternalMessageDelivery, ent.InternalMessageDelivery,
+	]
+}
+
+func NewInternalMessageDeliveryRepo(data *Data, logger log.Logger) *InternalMessageDeliveryRepo {
+	repo := &InternalMessageDeliveryRepo{
+		log:             log.NewHelper(log.With(logger, "module", "internal-message-delivery/repo/admin-service")),
+		data:            data,
+		mapper:          mapper.NewCopierMapper[internalMessageV1.InternalMessageDelivery, ent.InternalMessageDelivery](),
+		statusConverter: mapper.NewEnumTypeConverter[internalMessageV1.InternalMessageDelivery_Status, internalmessagedelivery.Status](internalMessageV1.InternalMessageDelivery_Status_name, internalMessageV1.InternalMessageDelivery_Status_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *InternalMessageDeliveryRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.InternalMessageDeliveryQuery, ent.InternalMessageDeliverySelect,
+		ent.InternalMessageDeliveryCreate, ent.InternalMessageDeliveryCreateBulk,
+		ent.InternalMessageDeliveryUpdate, ent.InternalMessageDeliveryUpdateOne,
+		ent.InternalMessageDeliveryDelete,
+		predicate.InternalMessageDelivery,
+		internalMessageV1.InternalMessageDelivery, ent.InternalMessageDelivery,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+func (r *InternalMessageDeliveryRepo) List(ctx context.Context, req *pagination.PagingRequest) (*internalMessageV1.ListInternalMessageDeliveryResponse, error) {
+	if req == nil {
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().InternalMessageDelivery.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &internalMessageV1.ListInternalMessageDeliveryResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &internalMessageV1.ListInternalMessageDeliveryResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+// CreatePending 为收件记录批量创建待发送的投递记录，返回记录ID列表
+func (r *InternalMessageDeliveryRepo) CreatePending(ctx context.Context, items []*internalMessageV1.InternalMessageDelivery) ([]uint32, error) {
+	if len(items) == 0 {
+		return []uint32{}, nil
+	}
+
+	now := time.Now()
+
+	builders := make([]*ent.InternalMessageDeliveryCreate, 0, len(items))
+	for _, item := range items {
+		builders = append(builders, r.data.db.Client().InternalMessageDelivery.Create().
+			SetNillableMessageID(item.MessageId).
+			SetNillableRecipientID(item.RecipientId).
+			SetNillableRecipientUserID(item.RecipientUserId).
+			SetNillableChannel(item.Channel).
+			SetStatus(internalmessagedelivery.StatusPending).
+			SetAttempts(0).
+			SetCreatedAt(now),
+		)
+	}
+
+	entities, err := r.data.db.Client().InternalMessageDelivery.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert deliveries failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("insert deliveries failed")
+	}
+
+	ids := make([]uint32, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.ID)
+	}
+
+	return ids, nil
+}
+
+// ListUnsent 查询尚未发送成功的投递记录
+func (r *InternalMessageDeliveryRepo) ListUnsent(ctx context.Context, ids []uint32) ([]*internalMessageV1.InternalMessageDelivery, error) {
+	if len(ids) == 0 {
+		return []*internalMessageV1.InternalMessageDelivery{}, nil
+	}
+
+	entities, err := r.data.db.Client().InternalMessageDelivery.Query().
+		Where(
+			internalmessagedelivery.IDIn(ids...),
+			internalmessagedelivery.StatusIn(
+				internalmessagedelivery.StatusPending,
+				internalmessagedelivery.StatusFailed,
+			),
+		).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query deliveries failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query deliveries failed")
+	}
+
+	dtos := make([]*internalMessageV1.InternalMessageDelivery, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// UpdateResult 记录一次发送的结果
+func (r *InternalMessageDeliveryRepo) UpdateResult(ctx context.Context, id uint32, address string, status internalMessageV1.InternalMessageDelivery_Status, lastError string) error {
+	now := time.Now()
+
+	builder := r.data.db.Client().InternalMessageDelivery.UpdateOneID(id).
+		SetAddress(address).
+		SetNillableStatus(r.statusConverter.ToEntity(&status)).
+		SetUpdatedAt(now)
+
+	switch status {
+	case internalMessageV1.InternalMessageDelivery_SENT:
+		builder.
+			AddAttempts(1).
+			SetSentAt(now).
+			ClearLastError()
+	case internalMessageV1.InternalMessageDelivery_SKIPPED:
+		builder.SetLastError(lastError)
+	default:
+		builder.
+			AddAttempts(1).
+			SetLastError(lastError)
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("update delivery result failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("update delivery result failed")
+	}
+
+	return nil
+}
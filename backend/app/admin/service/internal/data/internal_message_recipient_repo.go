@@ -16,10 +16,13 @@ import (
 	"github.com/tx7do/go-utils/trans"
 
 	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
 	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
 	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
 
 	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+
+	"go-wind-admin/pkg/utils/slice"
 )
 
 type InternalMessageRecipientRepo struct {
@@ -99,7 +102,12 @@ func (r *InternalMessageRecipientRepo) List(ctx context.Context, req *pagination
 		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
 	}
 
-	builder := r.data.db.Client().InternalMessageRecipient.Query()
+	// 已撤销与已删除的记录保留用于阅读统计，不在收件箱中显示
+	builder := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(internalmessagerecipient.Or(
+			internalmessagerecipient.StatusIsNil(),
+			internalmessagerecipient.StatusNotIn(internalmessagerecipient.StatusRevoked, internalmessagerecipient.StatusDeleted),
+		))
 
 	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
 	if err != nil {
@@ -224,79 +232,598 @@ func (r *InternalMessageRecipientRepo) Delete(ctx context.Context, id uint32) er
 	return nil
 }
 
-// MarkNotificationAsRead 将通知标记为已读
-func (r *InternalMessageRecipientRepo) MarkNotificationAsRead(ctx context.Context, req *internalMessageV1.MarkNotificationAsReadRequest) error {
+// inboxQueryBatchSize 按消息ID批量查询时每批的数量
+const inboxQueryBatchSize = 1000
+
+// inboxRow 计算未读数量变化所需的收件记录字段
+type inboxRow struct {
+	ID              uint32                           `json:"id"`
+	MessageID       *uint32                          `json:"message_id"`
+	RecipientUserID *uint32                          `json:"recipient_user_id"`
+	Status          *internalmessagerecipient.Status `json:"status"`
+}
+
+// InboxChange 收件记录未读状态的变化
+type InboxChange struct {
+	UserID    uint32
+	MessageID uint32
+	Delta     int64 // 变为未读为1，不再未读为-1
+}
+
+// isUnreadStatus 已投递与已接收的收件记录计入未读数量
+func isUnreadStatus(status *internalmessagerecipient.Status) bool {
+	if status == nil {
+		return true
+	}
+	return *status == internalmessagerecipient.StatusSent || *status == internalmessagerecipient.StatusReceived
+}
+
+// changeInbox 在事务中锁定符合条件的收件记录后修改为新的状态，返回未读状态发生变化的收件记录
+func (r *InternalMessageRecipientRepo) changeInbox(
+	ctx context.Context,
+	where []predicate.InternalMessageRecipient,
+	newStatus internalmessagerecipient.Status,
+	apply func(tx *ent.Tx, ids []uint32) error,
+) ([]InboxChange, error) {
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	var rows []inboxRow
+	if err = tx.InternalMessageRecipient.Query().
+		Where(where...).
+		ForUpdate().
+		Select(
+			internalmessagerecipient.FieldID,
+			internalmessagerecipient.FieldMessageID,
+			internalmessagerecipient.FieldRecipientUserID,
+			internalmessagerecipient.FieldStatus,
+		).
+		Scan(ctx, &rows); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("query inbox failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query inbox failed")
+	}
+
+	if len(rows) == 0 {
+		_ = tx.Rollback()
+		return []InboxChange{}, nil
+	}
+
+	ids := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	if err = apply(tx, ids); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("update inbox failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("update inbox failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	unreadAfter := isUnreadStatus(&newStatus)
+
+	var changes []InboxChange
+	for _, row := range rows {
+		if row.MessageID == nil || row.RecipientUserID == nil {
+			continue
+		}
+
+		change := InboxChange{UserID: *row.RecipientUserID, MessageID: *row.MessageID}
+		switch unreadBefore := isUnreadStatus(row.Status); {
+		case unreadBefore && !unreadAfter:
+			change.Delta = -1
+		case !unreadBefore && unreadAfter:
+			change.Delta = 1
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// MarkNotificationAsRead 将通知标记为已读，返回未读数量的变化
+func (r *InternalMessageRecipientRepo) MarkNotificationAsRead(ctx context.Context, req *internalMessageV1.MarkNotificationAsReadRequest) ([]InboxChange, error) {
 	if len(req.GetRecipientIds()) == 0 {
-		return internalMessageV1.ErrorBadRequest("invalid parameter")
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
 	}
 	if req.GetUserId() == 0 {
-		return internalMessageV1.ErrorBadRequest("invalid parameter")
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
 	}
 
-	now := time.Now()
-	_, err := r.data.db.Client().InternalMessageRecipient.Update().
-		Where(
-			internalmessagerecipient.IDIn(req.GetRecipientIds()...),
-			internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
-			internalmessagerecipient.StatusNEQ(internalmessagerecipient.StatusRead),
-		).
-		SetStatus(internalmessagerecipient.StatusRead).
-		SetNillableReadAt(trans.Ptr(now)).
-		SetNillableUpdatedAt(trans.Ptr(now)).
-		Save(ctx)
-	return err
+	return r.markAsRead(ctx,
+		internalmessagerecipient.IDIn(req.GetRecipientIds()...),
+		internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
+		internalmessagerecipient.StatusNEQ(internalmessagerecipient.StatusRead),
+	)
+}
+
+func (r *InternalMessageRecipientRepo) markAsRead(ctx context.Context, where ...predicate.InternalMessageRecipient) ([]InboxChange, error) {
+	status := internalmessagerecipient.StatusRead
+	return r.changeInbox(ctx, where, status, func(tx *ent.Tx, ids []uint32) error {
+		now := time.Now()
+		_, err := tx.InternalMessageRecipient.Update().
+			Where(internalmessagerecipient.IDIn(ids...)).
+			SetStatus(status).
+			SetNillableReadAt(trans.Ptr(now)).
+			SetNillableUpdatedAt(trans.Ptr(now)).
+			Save(ctx)
+		return err
+	})
 }
 
-// MarkNotificationsStatus 标记特定用户的某些或所有通知的状态
-func (r *InternalMessageRecipientRepo) MarkNotificationsStatus(ctx context.Context, req *internalMessageV1.MarkNotificationsStatusRequest) error {
+// MarkAllAsRead 将用户收件箱中的未读通知全部标记为已读，可以只标记某个分类或类型的通知，返回未读数量的变化
+func (r *InternalMessageRecipientRepo) MarkAllAsRead(ctx context.Context, userId uint32, categoryId *uint32, messageType *internalMessageV1.InternalMessage_Type) ([]InboxChange, error) {
+	if userId == 0 {
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	where := []predicate.InternalMessageRecipient{
+		internalmessagerecipient.RecipientUserIDEQ(userId),
+		unreadPredicate(),
+	}
+
+	if categoryId != nil || messageType != nil {
+		messageIds, err := r.ListUnreadMessageIds(ctx, userId)
+		if err != nil {
+			return nil, err
+		}
+
+		if messageIds, err = r.filterMessageIds(ctx, messageIds, categoryId, messageType); err != nil {
+			return nil, err
+		}
+		if len(messageIds) == 0 {
+			return []InboxChange{}, nil
+		}
+
+		where = append(where, internalmessagerecipient.MessageIDIn(messageIds...))
+	}
+
+	return r.markAsRead(ctx, where...)
+}
+
+// filterMessageIds 筛选属于分类与类型的消息，分类ID为0表示未分类
+func (r *InternalMessageRecipientRepo) filterMessageIds(ctx context.Context, messageIds []uint32, categoryId *uint32, messageType *internalMessageV1.InternalMessage_Type) ([]uint32, error) {
+	var where []predicate.InternalMessage
+	if categoryId != nil {
+		if *categoryId == 0 {
+			where = append(where, internalmessage.Or(internalmessage.CategoryIDIsNil(), internalmessage.CategoryIDEQ(0)))
+		} else {
+			where = append(where, internalmessage.CategoryIDEQ(*categoryId))
+		}
+	}
+	if messageType != nil {
+		where = append(where, internalmessage.TypeEQ(internalmessage.Type(messageType.String())))
+	}
+
+	var filtered []uint32
+	for _, batch := range slice.Chunk(messageIds, inboxQueryBatchSize) {
+		ids, err := r.data.db.Client().InternalMessage.Query().
+			Where(append(where, internalmessage.IDIn(batch...))...).
+			IDs(ctx)
+		if err != nil {
+			r.log.Errorf("filter messages failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("filter messages failed")
+		}
+		filtered = append(filtered, ids...)
+	}
+
+	return filtered, nil
+}
+
+// MarkNotificationsStatus 标记特定用户的某些或所有通知的状态，返回未读数量的变化
+func (r *InternalMessageRecipientRepo) MarkNotificationsStatus(ctx context.Context, req *internalMessageV1.MarkNotificationsStatusRequest) ([]InboxChange, error) {
 	if len(req.GetRecipientIds()) == 0 {
-		return internalMessageV1.ErrorBadRequest("invalid parameter")
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
 	}
 	if req.GetUserId() == 0 {
-		return internalMessageV1.ErrorBadRequest("invalid parameter")
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
 	}
 
-	now := time.Now()
-	var readAt *time.Time
-	var receiveAt *time.Time
-	switch req.GetNewStatus() {
-	case internalMessageV1.InternalMessageRecipient_READ:
-		readAt = trans.Ptr(now)
-	case internalMessageV1.InternalMessageRecipient_RECEIVED:
-		receiveAt = trans.Ptr(now)
+	status := r.statusConverter.ToEntity(trans.Ptr(req.GetNewStatus()))
+
+	return r.changeInbox(ctx,
+		[]predicate.InternalMessageRecipient{
+			internalmessagerecipient.IDIn(req.GetRecipientIds()...),
+			internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
+			internalmessagerecipient.StatusNEQ(*status),
+		},
+		*status,
+		func(tx *ent.Tx, ids []uint32) error {
+			now := time.Now()
+			var readAt *time.Time
+			var receiveAt *time.Time
+			switch req.GetNewStatus() {
+			case internalMessageV1.InternalMessageRecipient_READ:
+				readAt = trans.Ptr(now)
+			case internalMessageV1.InternalMessageRecipient_RECEIVED:
+				receiveAt = trans.Ptr(now)
+			}
+
+			_, err := tx.InternalMessageRecipient.Update().
+				Where(internalmessagerecipient.IDIn(ids...)).
+				SetNillableStatus(status).
+				SetNillableReadAt(readAt).
+				SetNillableReceivedAt(receiveAt).
+				SetNillableUpdatedAt(trans.Ptr(now)).
+				Save(ctx)
+			return err
+		},
+	)
+}
+
+// RevokeMessage 撤销某条消息，没有指定用户时撤销所有接收者的消息，返回未读数量的变化。
+// 收件记录保留为已撤销状态用于阅读统计
+func (r *InternalMessageRecipientRepo) RevokeMessage(ctx context.Context, req *internalMessageV1.RevokeMessageRequest) ([]InboxChange, error) {
+	where := []predicate.InternalMessageRecipient{
+		internalmessagerecipient.MessageIDEQ(req.GetMessageId()),
+		internalmessagerecipient.Or(
+			internalmessagerecipient.StatusIsNil(),
+			internalmessagerecipient.StatusNEQ(internalmessagerecipient.StatusRevoked),
+		),
+	}
+	if req.GetUserId() != 0 {
+		where = append(where, internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()))
 	}
 
-	_, err := r.data.db.Client().InternalMessageRecipient.Update().
-		Where(
+	return r.setInboxStatus(ctx, where, internalmessagerecipient.StatusRevoked)
+}
+
+// DeleteNotificationFromInbox 删除收件箱中的通知，返回未读数量的变化。
+// 收件记录保留为已删除状态用于阅读统计，已读时间不会清除
+func (r *InternalMessageRecipientRepo) DeleteNotificationFromInbox(ctx context.Context, req *internalMessageV1.DeleteNotificationFromInboxRequest) ([]InboxChange, error) {
+	return r.setInboxStatus(ctx,
+		[]predicate.InternalMessageRecipient{
 			internalmessagerecipient.IDIn(req.GetRecipientIds()...),
 			internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
-			internalmessagerecipient.StatusNEQ(*r.statusConverter.ToEntity(trans.Ptr(req.GetNewStatus()))),
+			internalmessagerecipient.Or(
+				internalmessagerecipient.StatusIsNil(),
+				internalmessagerecipient.StatusNotIn(internalmessagerecipient.StatusRevoked, internalmessagerecipient.StatusDeleted),
+			),
+		},
+		internalmessagerecipient.StatusDeleted,
+	)
+}
+
+func (r *InternalMessageRecipientRepo) setInboxStatus(ctx context.Context, where []predicate.InternalMessageRecipient, status internalmessagerecipient.Status) ([]InboxChange, error) {
+	return r.changeInbox(ctx, where, status, func(tx *ent.Tx, ids []uint32) error {
+		_, err := tx.InternalMessageRecipient.Update().
+			Where(internalmessagerecipient.IDIn(ids...)).
+			SetStatus(status).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+		return err
+	})
+}
+
+// ListUnreadMessageIds 查询用户收件箱中未读通知的消息ID
+func (r *InternalMessageRecipientRepo) ListUnreadMessageIds(ctx context.Context, userId uint32) ([]uint32, error) {
+	var rows []inboxRow
+	if err := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(
+			internalmessagerecipient.RecipientUserIDEQ(userId),
+			internalmessagerecipient.MessageIDNotNil(),
+			unreadPredicate(),
 		).
-		SetNillableStatus(r.statusConverter.ToEntity(trans.Ptr(req.GetNewStatus()))).
-		SetNillableReadAt(readAt).
-		SetNillableReceivedAt(receiveAt).
-		SetNillableUpdatedAt(trans.Ptr(now)).
-		Save(ctx)
-	return err
+		Select(internalmessagerecipient.FieldID, internalmessagerecipient.FieldMessageID).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query unread messages failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query unread messages failed")
+	}
+
+	ids := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, *row.MessageID)
+	}
+
+	return ids, nil
 }
 
-// RevokeMessage 撤销某条消息
-func (r *InternalMessageRecipientRepo) RevokeMessage(ctx context.Context, req *internalMessageV1.RevokeMessageRequest) error {
-	_, err := r.data.db.Client().InternalMessageRecipient.Delete().
+// CountUnread 从数据库统计用户收件箱的未读数量，用于对账Redis中的计数。
+// 消息已经被删除的收件记录不计入
+func (r *InternalMessageRecipientRepo) CountUnread(ctx context.Context, userId uint32) (InboxUnreadCounts, error) {
+	messageIds, err := r.ListUnreadMessageIds(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 同一条消息只会投递一次，按消息分组即可得到数量
+	counts := make(InboxUnreadCounts)
+	for _, batch := range slice.Chunk(messageIds, inboxQueryBatchSize) {
+		var groups []struct {
+			CategoryID *uint32               `json:"category_id"`
+			Type       *internalmessage.Type `json:"type"`
+			Count      int64                 `json:"count"`
+		}
+		if err = r.data.db.Client().InternalMessage.Query().
+			Where(internalmessage.IDIn(batch...)).
+			GroupBy(internalmessage.FieldCategoryID, internalmessage.FieldType).
+			Aggregate(ent.Count()).
+			Scan(ctx, &groups); err != nil {
+			r.log.Errorf("count unread messages failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("count unread messages failed")
+		}
+
+		for _, g := range groups {
+			var categoryId uint32
+			if g.CategoryID != nil {
+				categoryId = *g.CategoryID
+			}
+			counts.Add(categoryId, toMessageType(g.Type), g.Count)
+		}
+	}
+
+	return counts, nil
+}
+
+// unreadPredicate 未读的收件记录
+func unreadPredicate() predicate.InternalMessageRecipient {
+	return internalmessagerecipient.Or(
+		internalmessagerecipient.StatusIsNil(),
+		internalmessagerecipient.StatusIn(internalmessagerecipient.StatusSent, internalmessagerecipient.StatusReceived),
+	)
+}
+
+// MessageReadCounts 一条消息的收件记录按阅读状态的数量
+type MessageReadCounts struct {
+	Delivered uint32 // 全部收件记录
+	Received  uint32 // 客户端已接收，包含已读
+	Read      uint32 // 已读，包含阅读后删除与撤销的
+	Unread    uint32
+	Revoked   uint32
+	Deleted   uint32
+}
+
+// CountByMessage 统计一条消息的收件记录的阅读状态
+func (r *InternalMessageRecipientRepo) CountByMessage(ctx context.Context, messageId uint32) (*MessageReadCounts, error) {
+	var groups []struct {
+		Status *internalmessagerecipient.Status `json:"status"`
+		Count  int                              `json:"count"`
+	}
+	if err := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(internalmessagerecipient.MessageIDEQ(messageId)).
+		GroupBy(internalmessagerecipient.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &groups); err != nil {
+		r.log.Errorf("count recipients by status failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("count recipients failed")
+	}
+
+	counts := &MessageReadCounts{}
+	for _, g := range groups {
+		n := uint32(g.Count)
+		counts.Delivered += n
+
+		switch {
+		case isUnreadStatus(g.Status):
+			counts.Unread += n
+		case *g.Status == internalmessagerecipient.StatusRevoked:
+			counts.Revoked += n
+		case *g.Status == internalmessagerecipient.StatusDeleted:
+			counts.Deleted += n
+		}
+	}
+
+	// 删除与撤销不会清除阅读与接收时间，按时间统计才能包含阅读后删除的记录
+	read, err := r.data.db.Client().InternalMessageRecipient.Query().
 		Where(
-			internalmessagerecipient.MessageIDEQ(req.GetMessageId()),
-			internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
+			internalmessagerecipient.MessageIDEQ(messageId),
+			internalmessagerecipient.ReadAtNotNil(),
 		).
-		Exec(ctx)
-	return err
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count read recipients failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("count recipients failed")
+	}
+	counts.Read = uint32(read)
+
+	received, err := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(
+			internalmessagerecipient.MessageIDEQ(messageId),
+			internalmessagerecipient.Or(
+				internalmessagerecipient.ReceivedAtNotNil(),
+				internalmessagerecipient.ReadAtNotNil(),
+			),
+		).
+		Count(ctx)
+	if err != nil {
+		r.log.Errorf("count received recipients failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("count recipients failed")
+	}
+	counts.Received = uint32(received)
+
+	return counts, nil
 }
 
-func (r *InternalMessageRecipientRepo) DeleteNotificationFromInbox(ctx context.Context, req *internalMessageV1.DeleteNotificationFromInboxRequest) error {
-	_, err := r.data.db.Client().InternalMessageRecipient.Delete().
+// ListReadTimes 按时间顺序查询一条消息的所有阅读时间
+func (r *InternalMessageRecipientRepo) ListReadTimes(ctx context.Context, messageId uint32) ([]time.Time, error) {
+	var rows []struct {
+		ReadAt *time.Time `json:"read_at"`
+	}
+	if err := r.data.db.Client().InternalMessageRecipient.Query().
 		Where(
-			internalmessagerecipient.IDIn(req.GetRecipientIds()...),
-			internalmessagerecipient.RecipientUserIDEQ(req.GetUserId()),
+			internalmessagerecipient.MessageIDEQ(messageId),
+			internalmessagerecipient.ReadAtNotNil(),
 		).
-		Exec(ctx)
-	return err
+		Order(ent.Asc(internalmessagerecipient.FieldReadAt)).
+		Select(internalmessagerecipient.FieldReadAt).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query read times failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query read times failed")
+	}
+
+	times := make([]time.Time, 0, len(rows))
+	for _, row := range rows {
+		if row.ReadAt != nil {
+			times = append(times, *row.ReadAt)
+		}
+	}
+
+	return times, nil
+}
+
+// ListNonReaders 按记录ID顺序分页查询一条消息未读的收件记录，limit 为0时查询全部，返回记录与总数
+func (r *InternalMessageRecipientRepo) ListNonReaders(ctx context.Context, messageId uint32, offset, limit int) ([]*internalMessageV1.InternalMessageRecipient, int, error) {
+	builder := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(
+			internalmessagerecipient.MessageIDEQ(messageId),
+			unreadPredicate(),
+		)
+
+	total, err := builder.Clone().Count(ctx)
+	if err != nil {
+		r.log.Errorf("count non-readers failed: %s", err.Error())
+		return nil, 0, internalMessageV1.ErrorInternalServerError("count non-readers failed")
+	}
+
+	builder.Order(ent.Asc(internalmessagerecipient.FieldID))
+	if limit > 0 {
+		builder.Offset(offset).Limit(limit)
+	}
+
+	entities, err := builder.All(ctx)
+	if err != nil {
+		r.log.Errorf("query non-readers failed: %s", err.Error())
+		return nil, 0, internalMessageV1.ErrorInternalServerError("query non-readers failed")
+	}
+
+	dtos := make([]*internalMessageV1.InternalMessageRecipient, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, total, nil
+}
+
+// toMessageType 转换消息类型，为空时为通知
+func toMessageType(t *internalmessage.Type) internalMessageV1.InternalMessage_Type {
+	if t == nil {
+		return internalMessageV1.InternalMessage_NOTIFICATION
+	}
+	return internalMessageV1.InternalMessage_Type(internalMessageV1.InternalMessage_Type_value[string(*t)])
+}
+
+// DeliverBatch 批量写入一批接收者的收件箱，并在同一个事务中累加消息的已投递数量。
+// 已经有收件记录的接收者会被跳过，批次重试时不会重复投递，返回本次新写入的收件记录。
+// items 中只需要设置接收者用户ID，按接收者渲染的标题与内容可选。
+func (r *InternalMessageRecipientRepo) DeliverBatch(ctx context.Context, messageId uint32, items []*internalMessageV1.InternalMessageRecipient) ([]*internalMessageV1.InternalMessageRecipient, error) {
+	if len(items) == 0 {
+		return []*internalMessageV1.InternalMessageRecipient{}, nil
+	}
+
+	userIds := make([]uint32, 0, len(items))
+	for _, item := range items {
+		userIds = append(userIds, item.GetRecipientUserId())
+	}
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	var existing []struct {
+		RecipientUserID uint32 `json:"recipient_user_id"`
+	}
+	if err = tx.InternalMessageRecipient.Query().
+		Where(
+			internalmessagerecipient.MessageIDEQ(messageId),
+			internalmessagerecipient.RecipientUserIDIn(userIds...),
+		).
+		Select(internalmessagerecipient.FieldRecipientUserID).
+		Scan(ctx, &existing); err != nil {
+		err = entCrud.Rollback(tx, err)
+		r.log.Errorf("query delivered recipients failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query delivered recipients failed")
+	}
+
+	delivered := make(map[uint32]struct{}, len(existing))
+	for _, e := range existing {
+		delivered[e.RecipientUserID] = struct{}{}
+	}
+
+	now := time.Now()
+
+	builders := make([]*ent.InternalMessageRecipientCreate, 0, len(items))
+	for _, item := range items {
+		uid := item.GetRecipientUserId()
+		if _, ok := delivered[uid]; ok {
+			continue
+		}
+		delivered[uid] = struct{}{}
+
+		builders = append(builders, tx.InternalMessageRecipient.Create().
+			SetMessageID(messageId).
+			SetRecipientUserID(uid).
+			SetNillableTitle(item.Title).
+			SetNillableContent(item.Content).
+			SetStatus(internalmessagerecipient.StatusSent).
+			SetCreatedAt(now),
+		)
+	}
+
+	var entities []*ent.InternalMessageRecipient
+	if len(builders) > 0 {
+		if entities, err = tx.InternalMessageRecipient.CreateBulk(builders...).Save(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("insert recipients failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("insert recipients failed")
+		}
+
+		if err = tx.InternalMessage.UpdateOneID(messageId).
+			AddDeliveredCount(int32(len(entities))).
+			Exec(ctx); err != nil {
+			err = entCrud.Rollback(tx, err)
+			r.log.Errorf("add message delivered count failed: %s", err.Error())
+			return nil, internalMessageV1.ErrorInternalServerError("add message delivered count failed")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	dtos := make([]*internalMessageV1.InternalMessageRecipient, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// CountCreatedGroupByTenant 统计各租户在 [start, end) 之间投递的收件记录数，即发送的站内信数量
+func (r *InternalMessageRecipientRepo) CountCreatedGroupByTenant(ctx context.Context, start, end time.Time) (map[uint32]uint64, error) {
+	var groups []struct {
+		TenantId uint32 `json:"tenant_id"`
+		Count    int    `json:"count"`
+	}
+	if err := r.data.db.Client().InternalMessageRecipient.Query().
+		Where(
+			internalmessagerecipient.TenantIDNotNil(),
+			internalmessagerecipient.CreatedAtGTE(start),
+			internalmessagerecipient.CreatedAtLT(end),
+		).
+		GroupBy(internalmessagerecipient.FieldTenantID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &groups); err != nil {
+		r.log.Errorf("count recipients by tenant failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("count recipients failed")
+	}
+
+	counts := make(map[uint32]uint64, len(groups))
+	for _, g := range groups {
+		counts[g.TenantId] = uint64(g.Count)
+	}
+
+	return counts, nil
 }
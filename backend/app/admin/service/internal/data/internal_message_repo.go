@@ -29,6 +29,9 @@ type InternalMessageRepo struct {
 	statusConverter *mapper.EnumTypeConverter[internalMessageV1.InternalMessage_Status, internalmessage.Status]
 	typeConverter   *mapper.EnumTypeConverter[internalMessageV1.InternalMessage_Type, internalmessage.Type]
 
+	deliveryStatusConverter *mapper.EnumTypeConverter[internalMessageV1.InternalMessage_DeliveryStatus, internalmessage.DeliveryStatus]
+	priorityConverter       *mapper.EnumTypeConverter[internalMessageV1.InternalMessage_Priority, internalmessage.Priority]
+
 	repository *entCrud.Repository[
 		ent.InternalMessageQuery, ent.InternalMessageSelect,
 		ent.InternalMessageCreate, ent.InternalMessageCreateBulk,
@@ -46,6 +49,9 @@ func NewInternalMessageRepo(data *Data, logger log.Logger) *InternalMessageRepo
 		mapper:          mapper.NewCopierMapper[internalMessageV1.InternalMessage, ent.InternalMessage](),
 		statusConverter: mapper.NewEnumTypeConverter[internalMessageV1.InternalMessage_Status, internalmessage.Status](internalMessageV1.InternalMessage_Status_name, internalMessageV1.InternalMessage_Status_value),
 		typeConverter:   mapper.NewEnumTypeConverter[internalMessageV1.InternalMessage_Type, internalmessage.Type](internalMessageV1.InternalMessage_Type_name, internalMessageV1.InternalMessage_Type_value),
+
+		deliveryStatusConverter: mapper.NewEnumTypeConverter[internalMessageV1.InternalMessage_DeliveryStatus, internalmessage.DeliveryStatus](internalMessageV1.InternalMessage_DeliveryStatus_name, internalMessageV1.InternalMessage_DeliveryStatus_value),
+		priorityConverter:       mapper.NewEnumTypeConverter[internalMessageV1.InternalMessage_Priority, internalmessage.Priority](internalMessageV1.InternalMessage_Priority_name, internalMessageV1.InternalMessage_Priority_value),
 	}
 
 	repo.init()
@@ -68,6 +74,8 @@ func (r *InternalMessageRepo) init() {
 
 	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
 	r.mapper.AppendConverters(r.typeConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.deliveryStatusConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.priorityConverter.NewConverterPair())
 }
 
 func (r *InternalMessageRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
@@ -151,9 +159,27 @@ func (r *InternalMessageRepo) Create(ctx context.Context, req *internalMessageV1
 		SetNillableCategoryID(req.Data.CategoryId).
 		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
 		SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
+		SetNillableSendAt(timeutil.TimestamppbToTime(req.Data.SendAt)).
+		SetNillableCronSpec(req.Data.CronSpec).
+		SetNillableLastSentAt(timeutil.TimestamppbToTime(req.Data.LastSentAt)).
+		SetNillableTargetAll(req.Data.TargetAll).
+		SetNillableDeliveryStatus(r.deliveryStatusConverter.ToEntity(req.Data.DeliveryStatus)).
+		SetNillablePriority(r.priorityConverter.ToEntity(req.Data.Priority)).
+		SetNillableTemplateCode(req.Data.TemplateCode).
+		SetNillableTenantID(req.Data.TenantId).
 		SetNillableCreatedBy(req.Data.CreatedBy).
 		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
 
+	if len(req.Data.TargetUserIds) > 0 {
+		builder.SetTargetUserIds(req.Data.TargetUserIds)
+	}
+	if req.Data.Audience != nil {
+		builder.SetAudience(req.Data.Audience)
+	}
+	if len(req.Data.TemplateVariables) > 0 {
+		builder.SetTemplateVariables(req.Data.TemplateVariables)
+	}
+
 	if req.Data.CreatedAt == nil {
 		builder.SetCreatedAt(time.Now())
 	}
@@ -202,6 +228,8 @@ func (r *InternalMessageRepo) Update(ctx context.Context, req *internalMessageV1
 				SetNillableCategoryID(req.Data.CategoryId).
 				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
 				SetNillableType(r.typeConverter.ToEntity(req.Data.Type)).
+				SetNillableTargetAll(req.Data.TargetAll).
+				SetNillablePriority(r.priorityConverter.ToEntity(req.Data.Priority)).
 				SetNillableUpdatedBy(req.Data.UpdatedBy).
 				SetNillableUpdatedAt(timeutil.TimestamppbToTime(req.Data.UpdatedAt))
 
@@ -234,3 +262,312 @@ func (r *InternalMessageRepo) Delete(ctx context.Context, id uint32) error {
 
 	return nil
 }
+
+// ListScheduled 查询所有待发送的定时消息
+func (r *InternalMessageRepo) ListScheduled(ctx context.Context) ([]*internalMessageV1.InternalMessage, error) {
+	entities, err := r.data.db.Client().InternalMessage.Query().
+		Where(
+			internalmessage.StatusEQ(internalmessage.StatusScheduled),
+			internalmessage.SendAtNotNil(),
+		).
+		Order(ent.Asc(internalmessage.FieldSendAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query scheduled messages failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query scheduled messages failed")
+	}
+
+	items := make([]*internalMessageV1.InternalMessage, 0, len(entities))
+	for _, entity := range entities {
+		items = append(items, r.mapper.ToDTO(entity))
+	}
+
+	return items, nil
+}
+
+// UpdateSchedule 修改定时消息的内容与发送计划，消息已发送或已取消时返回 false
+func (r *InternalMessageRepo) UpdateSchedule(ctx context.Context, id uint32, title, content *string, sendAt time.Time, cronSpec *string, operatorId uint32) (bool, error) {
+	builder := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.StatusEQ(internalmessage.StatusScheduled),
+		).
+		SetNillableTitle(title).
+		SetNillableContent(content).
+		SetSendAt(sendAt).
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(time.Now())
+
+	if cronSpec != nil {
+		if *cronSpec == "" {
+			builder.ClearCronSpec()
+		} else {
+			builder.SetCronSpec(*cronSpec)
+		}
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("update message schedule failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("update message schedule failed")
+	}
+
+	return affected > 0, nil
+}
+
+// CancelSchedule 取消定时消息，消息退回草稿状态，消息已发送或已取消时返回 false
+func (r *InternalMessageRepo) CancelSchedule(ctx context.Context, id uint32, operatorId uint32) (bool, error) {
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.StatusEQ(internalmessage.StatusScheduled),
+		).
+		SetStatus(internalmessage.StatusDraft).
+		ClearSendAt().
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("cancel message schedule failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("cancel message schedule failed")
+	}
+
+	return affected > 0, nil
+}
+
+// ClaimScheduled 认领一次定时发送：只有消息仍处于定时状态且计划发送时间与 sendAt 一致时才认领成功。
+// next 为空表示只发送一次，消息转为已发布并记录受众快照 targetUserIds；否则把计划发送时间推进到 next。
+// 同一次发送只会被认领一次，任务重试或重复入队都不会重复投递。
+func (r *InternalMessageRepo) ClaimScheduled(ctx context.Context, id uint32, sendAt time.Time, next *time.Time, targetUserIds []uint32) (bool, error) {
+	now := time.Now()
+
+	builder := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.StatusEQ(internalmessage.StatusScheduled),
+			internalmessage.SendAtEQ(sendAt),
+		).
+		SetLastSentAt(now).
+		SetUpdatedAt(now)
+
+	if next == nil {
+		builder.
+			SetStatus(internalmessage.StatusPublished).
+			SetTargetUserIds(targetUserIds)
+	} else {
+		builder.SetSendAt(*next)
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim scheduled message failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("claim scheduled message failed")
+	}
+
+	return affected > 0, nil
+}
+
+// ArchiveBefore 把发布时间早于 before 的已发布消息标记为已归档，返回归档的数量
+func (r *InternalMessageRepo) ArchiveBefore(ctx context.Context, before time.Time) (int, error) {
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.StatusEQ(internalmessage.StatusPublished),
+			// 定时消息以实际发送时间为准
+			internalmessage.Or(
+				internalmessage.LastSentAtLT(before),
+				internalmessage.And(
+					internalmessage.LastSentAtIsNil(),
+					internalmessage.CreatedAtLT(before),
+				),
+			),
+		).
+		SetStatus(internalmessage.StatusArchived).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("archive messages failed: %s", err.Error())
+		return 0, internalMessageV1.ErrorInternalServerError("archive messages failed")
+	}
+
+	return affected, nil
+}
+
+// StartDelivery 开始投递：记录接收者总数并清零投递计数，消息已经开始投递时返回 false，避免分发任务重试时清空进度
+func (r *InternalMessageRepo) StartDelivery(ctx context.Context, id uint32, total uint32) (bool, error) {
+	now := time.Now()
+
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.Or(
+				internalmessage.DeliveryStatusEQ(internalmessage.DeliveryStatusPending),
+				internalmessage.DeliveryStatusIsNil(),
+			),
+		).
+		SetDeliveryStatus(internalmessage.DeliveryStatusDelivering).
+		SetRecipientTotal(total).
+		SetDeliveredCount(0).
+		SetFailedCount(0).
+		SetDeliveryStartedAt(now).
+		ClearDeliveryFinishedAt().
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("start message delivery failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("start message delivery failed")
+	}
+
+	return affected > 0, nil
+}
+
+// AddFailedCount 累加投递失败数量
+func (r *InternalMessageRepo) AddFailedCount(ctx context.Context, id uint32, count int) error {
+	if err := r.data.db.Client().InternalMessage.UpdateOneID(id).
+		AddFailedCount(int32(count)).
+		Exec(ctx); err != nil {
+		r.log.Errorf("add message failed count failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("add message failed count failed")
+	}
+
+	return nil
+}
+
+// FinishDelivery 结束投递，只有第一个结束投递的调用返回 true
+func (r *InternalMessageRepo) FinishDelivery(ctx context.Context, id uint32, status internalMessageV1.InternalMessage_DeliveryStatus) (bool, error) {
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.DeliveryStatusEQ(internalmessage.DeliveryStatusDelivering),
+		).
+		SetNillableDeliveryStatus(r.deliveryStatusConverter.ToEntity(&status)).
+		SetDeliveryFinishedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("finish message delivery failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("finish message delivery failed")
+	}
+
+	return affected > 0, nil
+}
+
+// ListConversationMessages 按ID倒序查询会话消息。threadId 为空时查询会话的主消息，否则查询该话题的回复；
+// beforeId 不为0时只查询更早的消息。返回消息列表与是否还有更早的消息
+func (r *InternalMessageRepo) ListConversationMessages(ctx context.Context, conversationId uint32, threadId *uint32, beforeId uint32, limit int) ([]*internalMessageV1.InternalMessage, bool, error) {
+	builder := r.data.db.Client().InternalMessage.Query().
+		Where(internalmessage.ConversationIDEQ(conversationId))
+
+	if threadId != nil {
+		builder.Where(internalmessage.ThreadIDEQ(*threadId))
+	} else {
+		builder.Where(internalmessage.ThreadIDIsNil())
+	}
+	if beforeId > 0 {
+		builder.Where(internalmessage.IDLT(beforeId))
+	}
+
+	entities, err := builder.
+		Order(ent.Desc(internalmessage.FieldID)).
+		Limit(limit + 1).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query conversation messages failed: %s", err.Error())
+		return nil, false, internalMessageV1.ErrorInternalServerError("query conversation messages failed")
+	}
+
+	hasMore := len(entities) > limit
+	if hasMore {
+		entities = entities[:limit]
+	}
+
+	dtos := make([]*internalMessageV1.InternalMessage, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, hasMore, nil
+}
+
+// RemoveConversationMessage 删除会话消息，消息保留为已撤销状态以维持话题与已读位置
+func (r *InternalMessageRepo) RemoveConversationMessage(ctx context.Context, conversationId, messageId, operatorId uint32) error {
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(messageId),
+			internalmessage.ConversationIDEQ(conversationId),
+		).
+		SetStatus(internalmessage.StatusRevoked).
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("remove conversation message failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("remove conversation message failed")
+	}
+	if affected == 0 {
+		return internalMessageV1.ErrorNotFound("message not found")
+	}
+
+	return nil
+}
+
+// Revoke 将消息标记为已撤销，消息保留用于阅读统计
+func (r *InternalMessageRepo) Revoke(ctx context.Context, id uint32, operatorId uint32) error {
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(internalmessage.IDEQ(id)).
+		SetStatus(internalmessage.StatusRevoked).
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("revoke message failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("revoke message failed")
+	}
+	if affected == 0 {
+		return internalMessageV1.ErrorNotFound("message not found")
+	}
+
+	return nil
+}
+
+// ClaimRemind 记录一次提醒未读者，距离上次提醒不足 minInterval 时不记录并返回false，
+// 多个实例同时提醒时只有一个能成功
+func (r *InternalMessageRepo) ClaimRemind(ctx context.Context, id uint32, minInterval time.Duration) (bool, error) {
+	now := time.Now()
+	affected, err := r.data.db.Client().InternalMessage.Update().
+		Where(
+			internalmessage.IDEQ(id),
+			internalmessage.Or(
+				internalmessage.LastRemindedAtIsNil(),
+				internalmessage.LastRemindedAtLT(now.Add(-minInterval)),
+			),
+		).
+		SetLastRemindedAt(now).
+		AddRemindCount(1).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("claim message remind failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("claim message remind failed")
+	}
+
+	return affected > 0, nil
+}
+
+// ListByIds 根据ID列表查询消息
+func (r *InternalMessageRepo) ListByIds(ctx context.Context, ids []uint32) ([]*internalMessageV1.InternalMessage, error) {
+	if len(ids) == 0 {
+		return []*internalMessageV1.InternalMessage{}, nil
+	}
+
+	entities, err := r.data.db.Client().InternalMessage.Query().
+		Where(internalmessage.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query messages by ids failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query messages by ids failed")
+	}
+
+	dtos := make([]*internalMessageV1.InternalMessage, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
@@ -0,0 +1,243 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/timeutil"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+type MessageTemplateRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper *mapper.CopierMapper[internalMessageV1.MessageTemplate, ent.MessageTemplate]
+
+	repository *entCrud.Repository[
+		ent.MessageTemplateQuery, ent.MessageTemplateSelect,
+		ent.MessageTemplateCreate, ent.MessageTemplateCreateBulk,
+		ent.MessageTemplateUpdate, ent.MessageTemplateUpdateOne,
+		ent.MessageTemplateDelete,
+		predicate.MessageTemplate,
+		internalMessageV1.MessageTemplate, ent.MessageTemplate,
+	]
+}
+
+func NewMessageTemplateRepo(data *Data, logger log.Logger) *MessageTemplateRepo {
+	repo := &MessageTemplateRepo{
+		log:    log.NewHelper(log.With(logger, "module", "message-template/repo/admin-service")),
+		data:   data,
+		mapper: mapper.NewCopierMapper[internalMessageV1.MessageTemplate, ent.MessageTemplate](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *MessageTemplateRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.MessageTemplateQuery, ent.MessageTemplateSelect,
+		ent.MessageTemplateCreate, ent.MessageTemplateCreateBulk,
+		ent.MessageTemplateUpdate, ent.MessageTemplateUpdateOne,
+		ent.MessageTemplateDelete,
+		predicate.MessageTemplate,
+		internalMessageV1.MessageTemplate, ent.MessageTemplate,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+func (r *MessageTemplateRepo) List(ctx context.Context, req *pagination.PagingRequest) (*internalMessageV1.ListMessageTemplateResponse, error) {
+	if req == nil {
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().MessageTemplate.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &internalMessageV1.ListMessageTemplateResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &internalMessageV1.ListMessageTemplateResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *MessageTemplateRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.data.db.Client().MessageTemplate.Query().
+		Where(messagetemplate.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, internalMessageV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *MessageTemplateRepo) Get(ctx context.Context, req *internalMessageV1.GetMessageTemplateRequest) (*internalMessageV1.MessageTemplate, error) {
+	if req == nil {
+		return nil, internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().MessageTemplate.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *internalMessageV1.GetMessageTemplateRequest_Id:
+		whereCond = append(whereCond, messagetemplate.IDEQ(req.GetId()))
+	case *internalMessageV1.GetMessageTemplateRequest_Code:
+		whereCond = append(whereCond, messagetemplate.CodeEQ(req.GetCode()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// GetEnabledByCode 根据编码查询启用的模板，模板不存在或已停用时返回 NotFound
+func (r *MessageTemplateRepo) GetEnabledByCode(ctx context.Context, code string) (*internalMessageV1.MessageTemplate, error) {
+	entity, err := r.data.db.Client().MessageTemplate.Query().
+		Where(
+			messagetemplate.CodeEQ(code),
+			messagetemplate.IsEnabledEQ(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, internalMessageV1.ErrorNotFound("message template not found")
+		}
+
+		r.log.Errorf("query message template failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query message template failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+func (r *MessageTemplateRepo) Create(ctx context.Context, req *internalMessageV1.CreateMessageTemplateRequest) error {
+	if req == nil || req.Data == nil {
+		return internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().MessageTemplate.Create().
+		SetNillableCode(req.Data.Code).
+		SetNillableName(req.Data.Name).
+		SetNillableCategoryID(req.Data.CategoryId).
+		SetNillableDefaultLanguage(req.Data.DefaultLanguage).
+		SetLocales(req.Data.Locales).
+		SetNillableIsEnabled(req.Data.IsEnabled).
+		SetNillableRemark(req.Data.Remark).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
+
+	if req.Data.CreatedAt == nil {
+		builder.SetCreatedAt(time.Now())
+	}
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsConstraintError(err) {
+			return internalMessageV1.ErrorBadRequest("message template code already exists")
+		}
+
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return nil
+}
+
+func (r *MessageTemplateRepo) Update(ctx context.Context, req *internalMessageV1.UpdateMessageTemplateRequest) error {
+	if req == nil || req.Data == nil {
+		return internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &internalMessageV1.CreateMessageTemplateRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.data.db.Client().MessageTemplate.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *internalMessageV1.MessageTemplate) {
+			builder.
+				SetNillableCode(req.Data.Code).
+				SetNillableName(req.Data.Name).
+				SetNillableCategoryID(req.Data.CategoryId).
+				SetNillableDefaultLanguage(req.Data.DefaultLanguage).
+				SetNillableIsEnabled(req.Data.IsEnabled).
+				SetNillableRemark(req.Data.Remark).
+				SetNillableUpdatedBy(req.Data.UpdatedBy).
+				SetNillableUpdatedAt(timeutil.TimestamppbToTime(req.Data.UpdatedAt))
+
+			// 语言版本为空时只有在更新掩码中显式指定才会清空
+			if req.Data.Locales != nil || slices.Contains(req.GetUpdateMask().GetPaths(), "locales") {
+				builder.SetLocales(req.Data.Locales)
+			}
+
+			if req.Data.UpdatedAt == nil {
+				builder.SetUpdatedAt(time.Now())
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(messagetemplate.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *MessageTemplateRepo) Delete(ctx context.Context, req *internalMessageV1.DeleteMessageTemplateRequest) error {
+	if req == nil {
+		return internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.data.db.Client().MessageTemplate.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return internalMessageV1.ErrorNotFound("message template not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
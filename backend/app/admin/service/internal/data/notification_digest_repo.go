@@ -0,0 +1,98 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	notificationDigestKeyPrefix = "notification:digest:"
+	notificationDigestExpires   = 7 * 24 * time.Hour
+)
+
+// NotificationDigestRepo 摘要模式下等待汇总的消息，按用户保存在Redis的列表中
+type NotificationDigestRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+
+	keyPrefix string
+	expires   time.Duration
+}
+
+func NewNotificationDigestRepo(logger log.Logger, rdb *redis.Client) *NotificationDigestRepo {
+	return &NotificationDigestRepo{
+		log:       log.NewHelper(log.With(logger, "module", "notification-digest/cache")),
+		rdb:       rdb,
+		keyPrefix: notificationDigestKeyPrefix,
+		expires:   notificationDigestExpires,
+	}
+}
+
+func (r *NotificationDigestRepo) makeKey(userId uint32) string {
+	return fmt.Sprintf("%s%d", r.keyPrefix, userId)
+}
+
+// Add 把消息加入多个用户的待汇总列表，返回列表原本为空的用户，需要为这些用户预约摘要的发送
+func (r *NotificationDigestRepo) Add(ctx context.Context, userIds []uint32, messageId uint32) ([]uint32, error) {
+	if len(userIds) == 0 {
+		return nil, nil
+	}
+
+	cmds := make([]*redis.IntCmd, len(userIds))
+	if _, err := r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, userId := range userIds {
+			key := r.makeKey(userId)
+			cmds[i] = pipe.RPush(ctx, key, messageId)
+			pipe.Expire(ctx, key, r.expires)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	var first []uint32
+	for i, cmd := range cmds {
+		if cmd.Val() == 1 {
+			first = append(first, userIds[i])
+		}
+	}
+
+	return first, nil
+}
+
+// Pop 取出并清空用户的待汇总列表，返回去重后的消息ID
+func (r *NotificationDigestRepo) Pop(ctx context.Context, userId uint32) ([]uint32, error) {
+	key := r.makeKey(userId)
+
+	var rangeCmd *redis.StringSliceCmd
+	if _, err := r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		rangeCmd = pipe.LRange(ctx, key, 0, -1)
+		pipe.Del(ctx, key)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint32]struct{}, len(rangeCmd.Val()))
+	ids := make([]uint32, 0, len(rangeCmd.Val()))
+	for _, v := range rangeCmd.Val() {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			r.log.Warnf("invalid digest message id [%s] of user [%d]", v, userId)
+			continue
+		}
+		if _, ok := seen[uint32(id)]; ok {
+			continue
+		}
+		seen[uint32(id)] = struct{}{}
+		ids = append(ids, uint32(id))
+	}
+
+	return ids, nil
+}
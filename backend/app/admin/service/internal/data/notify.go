@@ -0,0 +1,28 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/notify"
+)
+
+// NewNotifyRegistry 根据配置目录中的 notify 配置创建站内信之外的通知渠道，配置有误时不启用任何渠道
+func NewNotifyRegistry(logger log.Logger) *notify.Registry {
+	l := log.NewHelper(log.With(logger, "module", "notify/data/admin-service"))
+
+	var cfg notify.Config
+	if err := scanConfig("notify", &cfg); err != nil {
+		l.Errorf("load notify config failed: %s", err.Error())
+		return notify.NewRegistry()
+	}
+
+	registry, err := notify.NewRegistryFromConfig(&cfg)
+	if err != nil {
+		l.Errorf("create notify channels failed: %s", err.Error())
+		return notify.NewRegistry()
+	}
+
+	l.Infof("enabled notify channels: %v", registry.Names())
+
+	return registry
+}
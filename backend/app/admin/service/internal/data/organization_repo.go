@@ -224,7 +224,7 @@ func (r *OrganizationRepo) Create(ctx context.Context, req *userV1.CreateOrganiz
 		SetNillableCreatedBy(req.Data.CreatedBy).
 		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
 
-	if req.Data.TenantId == nil {
+	if req.Data.TenantId != nil {
 		builder.SetTenantID(req.Data.GetTenantId())
 	}
 	if req.Data.CreatedAt == nil {
@@ -318,3 +318,27 @@ func (r *OrganizationRepo) Delete(ctx context.Context, req *userV1.DeleteOrganiz
 
 	return nil
 }
+
+// ListParentIds 获取所有组织的上级关系：组织ID -> 上级组织ID，顶级组织的上级为0
+func (r *OrganizationRepo) ListParentIds(ctx context.Context) (map[uint32]uint32, error) {
+	var rows []struct {
+		ID       uint32  `json:"id"`
+		ParentID *uint32 `json:"parent_id"`
+	}
+	if err := r.data.db.Client().Organization.Query().
+		Select(organization.FieldID, organization.FieldParentID).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query organization parent ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query organization parent ids failed")
+	}
+
+	parents := make(map[uint32]uint32, len(rows))
+	for _, row := range rows {
+		if row.ParentID != nil {
+			parents[row.ID] = *row.ParentID
+		} else {
+			parents[row.ID] = 0
+		}
+	}
+	return parents, nil
+}
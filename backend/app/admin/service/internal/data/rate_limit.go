@@ -0,0 +1,38 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	"go-wind-admin/pkg/ratelimit"
+	"go-wind-admin/pkg/service"
+)
+
+// rateLimitPrefix 令牌桶与计数的默认键前缀，同一个服务的所有副本共用令牌桶
+const rateLimitPrefix = "ratelimit:" + service.AdminService + ":"
+
+// NewRateLimitPolicy 根据配置目录中的 rate_limit 配置创建限流策略，配置有误或没有配置时不限流
+func NewRateLimitPolicy(logger log.Logger) *ratelimit.Policy {
+	l := log.NewHelper(log.With(logger, "module", "rate-limit/data/admin-service"))
+
+	var cfg ratelimit.Config
+	if err := scanConfig("rate_limit", &cfg); err != nil {
+		l.Errorf("load rate limit config failed: %s", err.Error())
+		return ratelimit.NewPolicy(nil)
+	}
+
+	if cfg.Prefix == "" {
+		cfg.Prefix = rateLimitPrefix
+	}
+
+	policy := ratelimit.NewPolicy(&cfg)
+
+	l.Infof("rate limit enabled: %v, operations: %d, plans: %d", policy.Enabled(), len(cfg.Operations), len(cfg.Plans))
+
+	return policy
+}
+
+// NewRateLimiter 创建基于 Redis 的令牌桶限流器
+func NewRateLimiter(rdb *redis.Client, policy *ratelimit.Policy) *ratelimit.Limiter {
+	return ratelimit.NewLimiter(rdb, policy)
+}
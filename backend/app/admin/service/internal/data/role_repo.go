@@ -19,6 +19,8 @@ import (
 	"go-wind-admin/app/admin/service/internal/data/ent/role"
 
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
 )
 
 type RoleRepo struct {
@@ -206,6 +208,32 @@ func (r *RoleRepo) ListRoleCodesByRoleIds(ctx context.Context, ids []uint32) ([]
 	return codes, nil
 }
 
+// RoleCodeExists 检查角色编码是否已被其他角色占用。租户用户同时拥有本租户与平台共享角色的权限，
+// 因此租户角色不能与本租户及平台的角色同名，平台角色不能与任何租户的角色同名
+func (r *RoleRepo) RoleCodeExists(ctx context.Context, tenantId uint32, code string, excludeId uint32) (bool, error) {
+	builder := r.data.db.Client().Role.Query().
+		Where(role.CodeEQ(code))
+	if tenantId != 0 {
+		builder.Where(role.Or(
+			role.TenantIDEQ(tenantId),
+			role.TenantIDEQ(0),
+			role.TenantIDIsNil(),
+		))
+	}
+	if excludeId != 0 {
+		builder.Where(role.IDNEQ(excludeId))
+	}
+
+	// 需要检查其他租户的角色，不受租户隔离限制
+	exist, err := builder.Exist(viewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query role code exist failed: %s", err.Error())
+		return false, userV1.ErrorInternalServerError("query role code exist failed")
+	}
+
+	return exist, nil
+}
+
 func (r *RoleRepo) Create(ctx context.Context, req *userV1.CreateRoleRequest) error {
 	if req == nil || req.Data == nil {
 		return userV1.ErrorBadRequest("invalid parameter")
@@ -221,7 +249,7 @@ func (r *RoleRepo) Create(ctx context.Context, req *userV1.CreateRoleRequest) er
 		SetNillableCreatedBy(req.Data.CreatedBy).
 		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
 
-	if req.Data.TenantId == nil {
+	if req.Data.TenantId != nil {
 		builder.SetTenantID(req.Data.GetTenantId())
 	}
 	if req.Data.CreatedAt == nil {
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	settingOverridesKeyPrefix = "setting:overrides:"
+	settingOverridesExpires   = 10 * time.Minute
+)
+
+// SettingCacheRepo 配置覆盖值缓存，登录与每次读取配置都要合并多个层级，按层级缓存在Redis中，修改时删除
+type SettingCacheRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+
+	overridesKeyPrefix string
+	overridesExpires   time.Duration
+}
+
+func NewSettingCacheRepo(logger log.Logger, rdb *redis.Client) *SettingCacheRepo {
+	return &SettingCacheRepo{
+		log:                log.NewHelper(log.With(logger, "module", "setting/cache")),
+		rdb:                rdb,
+		overridesKeyPrefix: settingOverridesKeyPrefix,
+		overridesExpires:   settingOverridesExpires,
+	}
+}
+
+func (r *SettingCacheRepo) makeOverridesKey(tenantId, userId uint32) string {
+	return fmt.Sprintf("%s%d:%d", r.overridesKeyPrefix, tenantId, userId)
+}
+
+// GetOverrides 获取缓存的一个层级的配置覆盖值，缓存不存在时返回false
+func (r *SettingCacheRepo) GetOverrides(ctx context.Context, tenantId, userId uint32) (map[string]string, bool, error) {
+	value, err := r.rdb.Get(ctx, r.makeOverridesKey(tenantId, userId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var overrides map[string]string
+	if err = json.Unmarshal(value, &overrides); err != nil {
+		r.log.Warnf("invalid setting cache [%d:%d]: %s", tenantId, userId, err.Error())
+		return nil, false, nil
+	}
+
+	return overrides, true, nil
+}
+
+// SetOverrides 缓存一个层级的配置覆盖值，没有覆盖值时也缓存，避免反复查询数据库
+func (r *SettingCacheRepo) SetOverrides(ctx context.Context, tenantId, userId uint32, overrides map[string]string) error {
+	if overrides == nil {
+		overrides = map[string]string{}
+	}
+
+	value, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, r.makeOverridesKey(tenantId, userId), value, r.overridesExpires).Err()
+}
+
+// InvalidateOverrides 删除一个层级的配置覆盖值缓存，下次读取时从数据库重新加载
+func (r *SettingCacheRepo) InvalidateOverrides(ctx context.Context, tenantId, userId uint32) error {
+	return r.rdb.Del(ctx, r.makeOverridesKey(tenantId, userId)).Err()
+}
@@ -0,0 +1,117 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/settings"
+)
+
+// NewSettingRegistry 创建注册了内置配置项的配置注册表
+func NewSettingRegistry() *settings.Registry {
+	return settings.NewDefaultRegistry()
+}
+
+// SettingRepo 配置覆盖值，租户ID与用户ID都为0的是系统配置，用户ID为0的是租户配置，其余为用户配置
+type SettingRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewSettingRepo(data *Data, logger log.Logger) *SettingRepo {
+	return &SettingRepo{
+		log:  log.NewHelper(log.With(logger, "module", "setting/repo/admin-service")),
+		data: data,
+	}
+}
+
+// ListOverrides 查询一个层级的配置覆盖值。
+// 登录时还没有查看者，各层级的配置都按租户ID与用户ID精确查询，使用系统上下文读取
+func (r *SettingRepo) ListOverrides(ctx context.Context, tenantId, userId uint32) (map[string]string, error) {
+	entities, err := r.data.db.Client().Setting.Query().
+		Where(
+			setting.TenantIDEQ(tenantId),
+			setting.UserIDEQ(userId),
+		).
+		All(viewer.NewSystemViewerContext(ctx))
+	if err != nil {
+		r.log.Errorf("query settings failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query settings failed")
+	}
+
+	overrides := make(map[string]string, len(entities))
+	for _, entity := range entities {
+		overrides[entity.Key] = entity.Value
+	}
+
+	return overrides, nil
+}
+
+// SaveOverrides 保存一个层级的配置覆盖值，并删除 resetKeys 中的覆盖值，配置值需要先经过注册表校验
+func (r *SettingRepo) SaveOverrides(
+	ctx context.Context,
+	tenantId, userId uint32,
+	values map[string]string,
+	resetKeys []string,
+	operatorId uint32,
+) error {
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return userV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	now := time.Now()
+
+	for key, value := range values {
+		err = tx.Setting.Create().
+			SetTenantID(tenantId).
+			SetUserID(userId).
+			SetKey(key).
+			SetValue(value).
+			SetCreatedBy(operatorId).
+			SetCreatedAt(now).
+			OnConflictColumns(setting.FieldTenantID, setting.FieldUserID, setting.FieldKey).
+			Update(func(u *ent.SettingUpsert) {
+				u.SetValue(value)
+				u.SetUpdatedBy(operatorId)
+				u.SetUpdatedAt(now)
+			}).
+			Exec(ctx)
+		if err != nil {
+			err = entgo.Rollback(tx, err)
+			r.log.Errorf("save setting [%s] failed: %s", key, err.Error())
+			return userV1.ErrorInternalServerError("save settings failed")
+		}
+	}
+
+	if len(resetKeys) > 0 {
+		if _, err = tx.Setting.Delete().
+			Where(
+				setting.TenantIDEQ(tenantId),
+				setting.UserIDEQ(userId),
+				setting.KeyIn(resetKeys...),
+			).
+			Exec(ctx); err != nil {
+			err = entgo.Rollback(tx, err)
+			r.log.Errorf("reset settings failed: %s", err.Error())
+			return userV1.ErrorInternalServerError("reset settings failed")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return userV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/sse"
+)
+
+// NewSseConfig 读取 server.sse 配置中的心跳、空闲超时与重放数量，配置有误时使用默认值
+func NewSseConfig(logger log.Logger) *sse.Config {
+	l := log.NewHelper(log.With(logger, "module", "sse/data/admin-service"))
+
+	var cfg sse.Config
+	if err := scanConfig("server.sse", &cfg); err != nil {
+		l.Errorf("load sse config failed: %s", err.Error())
+		return &sse.Config{}
+	}
+
+	return &cfg
+}
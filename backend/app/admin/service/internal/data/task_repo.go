@@ -114,6 +114,18 @@ func (r *TaskRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
 	return exist, nil
 }
 
+// IsTypeNameExist 是否已经存在该类型的任务
+func (r *TaskRepo) IsTypeNameExist(ctx context.Context, typeName string) (bool, error) {
+	exist, err := r.data.db.Client().Task.Query().
+		Where(task.TypeNameEQ(typeName)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, adminV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
 func (r *TaskRepo) Get(ctx context.Context, req *adminV1.GetTaskRequest) (*adminV1.Task, error) {
 	if req == nil {
 		return nil, adminV1.ErrorBadRequest("invalid parameter")
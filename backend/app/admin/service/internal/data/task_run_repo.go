@@ -0,0 +1,241 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/timeutil"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+type TaskRunRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper           *mapper.CopierMapper[adminV1.TaskRun, ent.TaskRun]
+	statusConverter  *mapper.EnumTypeConverter[adminV1.TaskRun_Status, taskrun.Status]
+	triggerConverter *mapper.EnumTypeConverter[adminV1.TaskRun_Trigger, taskrun.Trigger]
+
+	repository *entCrud.Repository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		adminV1.TaskRun, ent.TaskRun,
+	]
+}
+
+func NewTaskRunRepo(data *Data, logger log.Logger) *TaskRunRepo {
+	repo := &TaskRunRepo{
+		log:              log.NewHelper(log.With(logger, "module", "task-run/repo/admin-service")),
+		data:             data,
+		mapper:           mapper.NewCopierMapper[adminV1.TaskRun, ent.TaskRun](),
+		statusConverter:  mapper.NewEnumTypeConverter[adminV1.TaskRun_Status, taskrun.Status](adminV1.TaskRun_Status_name, adminV1.TaskRun_Status_value),
+		triggerConverter: mapper.NewEnumTypeConverter[adminV1.TaskRun_Trigger, taskrun.Trigger](adminV1.TaskRun_Trigger_name, adminV1.TaskRun_Trigger_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TaskRunRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TaskRunQuery, ent.TaskRunSelect,
+		ent.TaskRunCreate, ent.TaskRunCreateBulk,
+		ent.TaskRunUpdate, ent.TaskRunUpdateOne,
+		ent.TaskRunDelete,
+		predicate.TaskRun,
+		adminV1.TaskRun, ent.TaskRun,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+	r.mapper.AppendConverters(r.triggerConverter.NewConverterPair())
+}
+
+func (r *TaskRunRepo) List(ctx context.Context, req *pagination.PagingRequest) (*adminV1.ListTaskRunResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TaskRun.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &adminV1.ListTaskRunResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &adminV1.ListTaskRunResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TaskRunRepo) Get(ctx context.Context, id uint32) (*adminV1.TaskRun, error) {
+	if id == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	entity, err := r.data.db.Client().TaskRun.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, adminV1.ErrorNotFound("task run not found")
+		}
+
+		r.log.Errorf("query one data failed: %s", err.Error())
+
+		return nil, adminV1.ErrorInternalServerError("query data failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// GetPendingByRunId 查询等待执行的记录，用于把手动触发时预先创建的记录与实际执行关联起来
+func (r *TaskRunRepo) GetPendingByRunId(ctx context.Context, runId string) (*adminV1.TaskRun, error) {
+	entity, err := r.data.db.Client().TaskRun.Query().
+		Where(
+			taskrun.RunIDEQ(runId),
+			taskrun.StatusEQ(taskrun.StatusPending),
+		).
+		Order(ent.Desc(taskrun.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+
+		r.log.Errorf("query pending task run failed: %s", err.Error())
+
+		return nil, adminV1.ErrorInternalServerError("query pending task run failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// ListLatestByTaskIds 查询每个调度任务最近一次的执行记录
+func (r *TaskRunRepo) ListLatestByTaskIds(ctx context.Context, taskIds []uint32) (map[uint32]*adminV1.TaskRun, error) {
+	result := make(map[uint32]*adminV1.TaskRun, len(taskIds))
+
+	for _, taskId := range taskIds {
+		entity, err := r.data.db.Client().TaskRun.Query().
+			Where(taskrun.TaskIDEQ(taskId)).
+			Order(ent.Desc(taskrun.FieldID)).
+			First(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				continue
+			}
+
+			r.log.Errorf("query latest task run failed: %s", err.Error())
+
+			return nil, adminV1.ErrorInternalServerError("query latest task run failed")
+		}
+
+		result[taskId] = r.mapper.ToDTO(entity)
+	}
+
+	return result, nil
+}
+
+func (r *TaskRunRepo) Create(ctx context.Context, req *adminV1.TaskRun) (*adminV1.TaskRun, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TaskRun.Create().
+		SetNillableTaskID(req.TaskId).
+		SetNillableTypeName(req.TypeName).
+		SetNillableRunID(req.RunId).
+		SetNillableQueue(req.Queue).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Status)).
+		SetNillableTrigger(r.triggerConverter.ToEntity(req.Trigger)).
+		SetNillableStartedAt(timeutil.TimestamppbToTime(req.StartedAt)).
+		SetNillableFinishedAt(timeutil.TimestamppbToTime(req.FinishedAt)).
+		SetNillableDurationMs(req.DurationMs).
+		SetNillableRetryCount(req.RetryCount).
+		SetNillableMaxRetry(req.MaxRetry).
+		SetNillableProgress(req.Progress).
+		SetNillableProgressMessage(req.ProgressMessage).
+		SetNillableErrorMessage(req.ErrorMessage).
+		SetNillableResult(req.Result).
+		SetNillableTenantID(req.TenantId).
+		SetNillableCreatedBy(req.CreatedBy).
+		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.CreatedAt))
+
+	if req.CreatedAt == nil {
+		builder.SetCreatedAt(time.Now())
+	}
+
+	entity, err := builder.Save(ctx)
+	if err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// Update 更新执行记录，只更新非空字段
+func (r *TaskRunRepo) Update(ctx context.Context, id uint32, req *adminV1.TaskRun) error {
+	if id == 0 || req == nil {
+		return adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TaskRun.UpdateOneID(id).
+		SetNillableRunID(req.RunId).
+		SetNillableQueue(req.Queue).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Status)).
+		SetNillableStartedAt(timeutil.TimestamppbToTime(req.StartedAt)).
+		SetNillableFinishedAt(timeutil.TimestamppbToTime(req.FinishedAt)).
+		SetNillableDurationMs(req.DurationMs).
+		SetNillableRetryCount(req.RetryCount).
+		SetNillableMaxRetry(req.MaxRetry).
+		SetNillableProgress(req.Progress).
+		SetNillableProgressMessage(req.ProgressMessage).
+		SetNillableErrorMessage(req.ErrorMessage).
+		SetNillableResult(req.Result).
+		SetUpdatedAt(time.Now())
+
+	if err := builder.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return adminV1.ErrorNotFound("task run not found")
+		}
+
+		r.log.Errorf("update one data failed: %s", err.Error())
+
+		return adminV1.ErrorInternalServerError("update data failed")
+	}
+
+	return nil
+}
+
+// DeleteByTaskId 删除调度任务的所有执行记录
+func (r *TaskRunRepo) DeleteByTaskId(ctx context.Context, taskId uint32) error {
+	if _, err := r.data.db.Client().TaskRun.Delete().
+		Where(taskrun.TaskIDEQ(taskId)).
+		Exec(ctx); err != nil {
+		r.log.Errorf("delete task runs failed: %s", err.Error())
+		return adminV1.ErrorInternalServerError("delete task runs failed")
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+)
+
+const (
+	tenantStateKeyPrefix        = "tenant:state:"
+	tenantStateExpires          = 5 * time.Minute
+	tenantExpireRemindKeyPrefix = "tenant:expire:remind:"
+	tenantApiRequestsKeyPrefix  = "tenant:api:requests:"
+
+	// tenantApiRequestsExpires 每日请求计数跨过零点后仍需要查询一段时间，保留两天
+	tenantApiRequestsExpires = 48 * time.Hour
+)
+
+// TenantState 登录与请求鉴权时需要检查的租户状态
+type TenantState struct {
+	// Exists 租户是否存在，已删除的租户也会缓存，避免反复查询数据库
+	Exists bool `json:"exists"`
+
+	Status      userV1.Tenant_Status       `json:"status"`
+	AuditStatus *userV1.Tenant_AuditStatus `json:"audit_status,omitempty"`
+	ExpiredAt   *time.Time                 `json:"expired_at,omitempty"`
+
+	// Limits 订阅套餐的配额，为空表示没有订阅套餐，不限制配额
+	Limits *TenantLimits `json:"limits,omitempty"`
+}
+
+// TenantCacheRepo 租户状态缓存，每个请求都要检查租户状态，缓存在Redis中，租户变更时删除
+type TenantCacheRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+
+	stateKeyPrefix string
+	stateExpires   time.Duration
+
+	remindKeyPrefix string
+
+	apiRequestsKeyPrefix string
+	apiRequestsExpires   time.Duration
+}
+
+func NewTenantCacheRepo(logger log.Logger, rdb *redis.Client) *TenantCacheRepo {
+	return &TenantCacheRepo{
+		log:             log.NewHelper(log.With(logger, "module", "tenant/cache")),
+		rdb:             rdb,
+		stateKeyPrefix:  tenantStateKeyPrefix,
+		stateExpires:    tenantStateExpires,
+		remindKeyPrefix: tenantExpireRemindKeyPrefix,
+
+		apiRequestsKeyPrefix: tenantApiRequestsKeyPrefix,
+		apiRequestsExpires:   tenantApiRequestsExpires,
+	}
+}
+
+func (r *TenantCacheRepo) makeStateKey(tenantId uint32) string {
+	return fmt.Sprintf("%s%d", r.stateKeyPrefix, tenantId)
+}
+
+// GetState 获取缓存的租户状态，缓存不存在时返回false
+func (r *TenantCacheRepo) GetState(ctx context.Context, tenantId uint32) (*TenantState, bool, error) {
+	value, err := r.rdb.Get(ctx, r.makeStateKey(tenantId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var state TenantState
+	if err = json.Unmarshal(value, &state); err != nil {
+		r.log.Warnf("invalid tenant state cache [%d]: %s", tenantId, err.Error())
+		return nil, false, nil
+	}
+
+	return &state, true, nil
+}
+
+// SetState 缓存租户状态
+func (r *TenantCacheRepo) SetState(ctx context.Context, tenantId uint32, state *TenantState) error {
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return r.rdb.Set(ctx, r.makeStateKey(tenantId), value, r.stateExpires).Err()
+}
+
+// InvalidateState 删除租户状态缓存，下次检查时从数据库重新加载
+func (r *TenantCacheRepo) InvalidateState(ctx context.Context, tenantIds ...uint32) error {
+	if len(tenantIds) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(tenantIds))
+	for _, tenantId := range tenantIds {
+		keys = append(keys, r.makeStateKey(tenantId))
+	}
+	return r.rdb.Del(ctx, keys...).Err()
+}
+
+// ClaimExpireRemind 登记租户到期提醒，同一个到期时间只提醒一次，返回false表示已经提醒过
+func (r *TenantCacheRepo) ClaimExpireRemind(ctx context.Context, tenantId uint32, expiredAt time.Time) (bool, error) {
+	key := fmt.Sprintf("%s%d:%d", r.remindKeyPrefix, tenantId, expiredAt.Unix())
+
+	// 到期后提醒记录不再需要，多保留一天
+	ttl := time.Until(expiredAt) + 24*time.Hour
+	if ttl <= 0 {
+		ttl = 24 * time.Hour
+	}
+
+	return r.rdb.SetNX(ctx, key, time.Now().Unix(), ttl).Result()
+}
+
+func (r *TenantCacheRepo) makeApiRequestsKey(tenantId uint32, day time.Time) string {
+	return fmt.Sprintf("%s%d:%s", r.apiRequestsKeyPrefix, tenantId, day.Format("20060102"))
+}
+
+// IncrApiRequests 累加租户当天的API请求数，返回累加后的请求数
+func (r *TenantCacheRepo) IncrApiRequests(ctx context.Context, tenantId uint32, day time.Time) (int64, error) {
+	key := r.makeApiRequestsKey(tenantId, day)
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, r.apiRequestsExpires)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// GetApiRequests 获取租户当天的API请求数
+func (r *TenantCacheRepo) GetApiRequests(ctx context.Context, tenantId uint32, day time.Time) (int64, error) {
+	n, err := r.rdb.Get(ctx, r.makeApiRequestsKey(tenantId, day)).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return n, nil
+}
@@ -0,0 +1,402 @@
+package data
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	entCrud "github.com/tx7do/go-crud/entgo"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/timeutil"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantplan"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+)
+
+// TenantLimits 租户订阅套餐的配额与功能，随租户状态一起缓存。配额为0或列表为空表示不限制
+type TenantLimits struct {
+	PlanId   uint32 `json:"plan_id"`
+	PlanCode string `json:"plan_code,omitempty"`
+
+	MaxUsers             uint32 `json:"max_users,omitempty"`
+	MaxStorageBytes      uint64 `json:"max_storage_bytes,omitempty"`
+	MaxApiRequestsPerDay uint32 `json:"max_api_requests_per_day,omitempty"`
+
+	Modules  []string        `json:"modules,omitempty"`
+	Menus    []uint32        `json:"menus,omitempty"`
+	Features map[string]bool `json:"features,omitempty"`
+}
+
+func newTenantLimits(plan *ent.TenantPlan) *TenantLimits {
+	limits := &TenantLimits{
+		PlanId:   plan.ID,
+		Modules:  plan.Modules,
+		Menus:    plan.Menus,
+		Features: plan.Features,
+	}
+	if plan.Code != nil {
+		limits.PlanCode = *plan.Code
+	}
+	if plan.MaxUsers != nil {
+		limits.MaxUsers = *plan.MaxUsers
+	}
+	if plan.MaxStorageBytes != nil {
+		limits.MaxStorageBytes = *plan.MaxStorageBytes
+	}
+	if plan.MaxAPIRequestsPerDay != nil {
+		limits.MaxApiRequestsPerDay = *plan.MaxAPIRequestsPerDay
+	}
+	return limits
+}
+
+// AllowUsers 已有 used 个用户时能否再增加 n 个用户
+func (l *TenantLimits) AllowUsers(used, n int) bool {
+	if l == nil || l.MaxUsers == 0 {
+		return true
+	}
+	return used+n <= int(l.MaxUsers)
+}
+
+// AllowStorage 已用 used 字节时能否再写入 size 字节
+func (l *TenantLimits) AllowStorage(used, size uint64) bool {
+	if l == nil || l.MaxStorageBytes == 0 {
+		return true
+	}
+	return used+size <= l.MaxStorageBytes
+}
+
+// AllowApiRequests 当天第 n 个请求是否在配额内
+func (l *TenantLimits) AllowApiRequests(n int64) bool {
+	if l == nil || l.MaxApiRequestsPerDay == 0 {
+		return true
+	}
+	return n <= int64(l.MaxApiRequestsPerDay)
+}
+
+// AllowModule 模块（API服务名）是否已开通
+func (l *TenantLimits) AllowModule(module string) bool {
+	if l == nil || len(l.Modules) == 0 {
+		return true
+	}
+	return slices.Contains(l.Modules, module)
+}
+
+// FilterMenus 去掉未开通的菜单
+func (l *TenantLimits) FilterMenus(menus []uint32) []uint32 {
+	if l == nil || len(l.Menus) == 0 {
+		return menus
+	}
+
+	filtered := make([]uint32, 0, len(menus))
+	for _, menu := range menus {
+		if slices.Contains(l.Menus, menu) {
+			filtered = append(filtered, menu)
+		}
+	}
+	return filtered
+}
+
+// FeatureEnabled 功能是否已开通，没有订阅套餐时全部开通
+func (l *TenantLimits) FeatureEnabled(feature string) bool {
+	if l == nil {
+		return true
+	}
+	return l.Features[feature]
+}
+
+// OperationModule 从接口的 operation（如 /admin.service.v1.UserService/List）中取出服务名作为模块名
+func OperationModule(operation string) string {
+	operation = strings.TrimPrefix(operation, "/")
+	if i := strings.LastIndex(operation, "/"); i >= 0 {
+		operation = operation[:i]
+	}
+	if i := strings.LastIndex(operation, "."); i >= 0 {
+		operation = operation[i+1:]
+	}
+	return operation
+}
+
+type TenantPlanRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper          *mapper.CopierMapper[userV1.TenantPlan, ent.TenantPlan]
+	statusConverter *mapper.EnumTypeConverter[userV1.TenantPlan_Status, tenantplan.Status]
+
+	repository *entCrud.Repository[
+		ent.TenantPlanQuery, ent.TenantPlanSelect,
+		ent.TenantPlanCreate, ent.TenantPlanCreateBulk,
+		ent.TenantPlanUpdate, ent.TenantPlanUpdateOne,
+		ent.TenantPlanDelete,
+		predicate.TenantPlan,
+		userV1.TenantPlan, ent.TenantPlan,
+	]
+}
+
+func NewTenantPlanRepo(data *Data, logger log.Logger) *TenantPlanRepo {
+	repo := &TenantPlanRepo{
+		log:             log.NewHelper(log.With(logger, "module", "tenant-plan/repo/admin-service")),
+		data:            data,
+		mapper:          mapper.NewCopierMapper[userV1.TenantPlan, ent.TenantPlan](),
+		statusConverter: mapper.NewEnumTypeConverter[userV1.TenantPlan_Status, tenantplan.Status](userV1.TenantPlan_Status_name, userV1.TenantPlan_Status_value),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *TenantPlanRepo) init() {
+	r.repository = entCrud.NewRepository[
+		ent.TenantPlanQuery, ent.TenantPlanSelect,
+		ent.TenantPlanCreate, ent.TenantPlanCreateBulk,
+		ent.TenantPlanUpdate, ent.TenantPlanUpdateOne,
+		ent.TenantPlanDelete,
+		predicate.TenantPlan,
+		userV1.TenantPlan, ent.TenantPlan,
+	](r.mapper)
+
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+
+	r.mapper.AppendConverters(r.statusConverter.NewConverterPair())
+}
+
+func (r *TenantPlanRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector)) (int, error) {
+	builder := r.data.db.Client().TenantPlan.Query()
+	if len(whereCond) != 0 {
+		builder.Modify(whereCond...)
+	}
+
+	count, err := builder.Count(ctx)
+	if err != nil {
+		r.log.Errorf("query count failed: %s", err.Error())
+		return 0, userV1.ErrorInternalServerError("query count failed")
+	}
+
+	return count, nil
+}
+
+func (r *TenantPlanRepo) List(ctx context.Context, req *pagination.PagingRequest) (*userV1.ListTenantPlanResponse, error) {
+	if req == nil {
+		return nil, userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TenantPlan.Query()
+
+	ret, err := r.repository.ListWithPaging(ctx, builder, builder.Clone(), req)
+	if err != nil {
+		return nil, err
+	}
+	if ret == nil {
+		return &userV1.ListTenantPlanResponse{Total: 0, Items: nil}, nil
+	}
+
+	return &userV1.ListTenantPlanResponse{
+		Total: ret.Total,
+		Items: ret.Items,
+	}, nil
+}
+
+func (r *TenantPlanRepo) IsExist(ctx context.Context, id uint32) (bool, error) {
+	exist, err := r.data.db.Client().TenantPlan.Query().
+		Where(tenantplan.IDEQ(id)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, userV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *TenantPlanRepo) Get(ctx context.Context, req *userV1.GetTenantPlanRequest) (*userV1.TenantPlan, error) {
+	if req == nil {
+		return nil, userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TenantPlan.Query()
+
+	var whereCond []func(s *sql.Selector)
+	switch req.QueryBy.(type) {
+	default:
+	case *userV1.GetTenantPlanRequest_Id:
+		whereCond = append(whereCond, tenantplan.IDEQ(req.GetId()))
+
+	case *userV1.GetTenantPlanRequest_Code:
+		whereCond = append(whereCond, tenantplan.CodeEQ(req.GetCode()))
+	}
+
+	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto, err
+}
+
+// ListPlansByIds 通过多个ID获取套餐
+func (r *TenantPlanRepo) ListPlansByIds(ctx context.Context, ids []uint32) ([]*userV1.TenantPlan, error) {
+	if len(ids) == 0 {
+		return []*userV1.TenantPlan{}, nil
+	}
+
+	entities, err := r.data.db.Client().TenantPlan.Query().
+		Where(tenantplan.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant plan by ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant plan by ids failed")
+	}
+
+	dtos := make([]*userV1.TenantPlan, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// CodeExists 套餐编码是否已被其他套餐使用
+func (r *TenantPlanRepo) CodeExists(ctx context.Context, code string, excludeId uint32) (bool, error) {
+	exist, err := r.data.db.Client().TenantPlan.Query().
+		Where(
+			tenantplan.CodeEQ(code),
+			tenantplan.IDNEQ(excludeId),
+		).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query exist failed: %s", err.Error())
+		return false, userV1.ErrorInternalServerError("query exist failed")
+	}
+	return exist, nil
+}
+
+func (r *TenantPlanRepo) Create(ctx context.Context, req *userV1.CreateTenantPlanRequest) error {
+	if req == nil || req.Data == nil {
+		return userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	builder := r.data.db.Client().TenantPlan.Create().
+		SetNillableName(req.Data.Name).
+		SetNillableCode(req.Data.Code).
+		SetNillableDescription(req.Data.Description).
+		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+		SetNillableSortOrder(req.Data.SortOrder).
+		SetNillableRemark(req.Data.Remark).
+		SetNillableMaxUsers(req.Data.MaxUsers).
+		SetNillableMaxStorageBytes(req.Data.MaxStorageBytes).
+		SetNillableMaxAPIRequestsPerDay(req.Data.MaxApiRequestsPerDay).
+		SetNillableCreatedBy(req.Data.CreatedBy).
+		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
+
+	if req.Data.Modules != nil {
+		builder.SetModules(req.Data.Modules)
+	}
+	if req.Data.Menus != nil {
+		builder.SetMenus(req.Data.Menus)
+	}
+	if req.Data.Features != nil {
+		builder.SetFeatures(req.Data.Features)
+	}
+
+	if req.Data.CreatedAt == nil {
+		builder.SetCreatedAt(time.Now())
+	}
+
+	if req.Data.Id != nil {
+		builder.SetID(req.GetData().GetId())
+	}
+
+	if err := builder.Exec(ctx); err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return userV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return nil
+}
+
+func (r *TenantPlanRepo) Update(ctx context.Context, req *userV1.UpdateTenantPlanRequest) error {
+	if req == nil || req.Data == nil {
+		return userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 如果不存在则创建
+	if req.GetAllowMissing() {
+		exist, err := r.IsExist(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			createReq := &userV1.CreateTenantPlanRequest{Data: req.Data}
+			createReq.Data.CreatedBy = createReq.Data.UpdatedBy
+			createReq.Data.UpdatedBy = nil
+			return r.Create(ctx, createReq)
+		}
+	}
+
+	builder := r.data.db.Client().Debug().TenantPlan.Update()
+	err := r.repository.UpdateX(ctx, builder, req.Data, req.GetUpdateMask(),
+		func(dto *userV1.TenantPlan) {
+			builder.
+				SetNillableName(req.Data.Name).
+				SetNillableCode(req.Data.Code).
+				SetNillableDescription(req.Data.Description).
+				SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
+				SetNillableSortOrder(req.Data.SortOrder).
+				SetNillableRemark(req.Data.Remark).
+				SetNillableMaxUsers(req.Data.MaxUsers).
+				SetNillableMaxStorageBytes(req.Data.MaxStorageBytes).
+				SetNillableMaxAPIRequestsPerDay(req.Data.MaxApiRequestsPerDay).
+				SetNillableUpdatedBy(req.Data.UpdatedBy)
+
+			if req.Data.Modules != nil {
+				builder.SetModules(req.Data.Modules)
+			}
+			if req.Data.Menus != nil {
+				builder.SetMenus(req.Data.Menus)
+			}
+			if req.Data.Features != nil {
+				builder.SetFeatures(req.Data.Features)
+			}
+
+			if req.Data.UpdatedAt == nil {
+				builder.SetUpdatedAt(time.Now())
+			} else {
+				builder.SetNillableUpdatedAt(timeutil.TimestamppbToTime(req.Data.UpdatedAt))
+			}
+		},
+		func(s *sql.Selector) {
+			s.Where(sql.EQ(tenantplan.FieldID, req.GetId()))
+		},
+	)
+
+	return err
+}
+
+func (r *TenantPlanRepo) Delete(ctx context.Context, req *userV1.DeleteTenantPlanRequest) error {
+	if req == nil {
+		return userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	if err := r.data.db.Client().TenantPlan.DeleteOneID(req.GetId()).Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return userV1.ErrorNotFound("tenant plan not found")
+		}
+
+		r.log.Errorf("delete one data failed: %s", err.Error())
+
+		return userV1.ErrorInternalServerError("delete failed")
+	}
+
+	return nil
+}
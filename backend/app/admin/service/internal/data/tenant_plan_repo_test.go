@@ -0,0 +1,85 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+)
+
+func TestTenantLimits(t *testing.T) {
+	// 没有订阅套餐时不限制
+	var none *TenantLimits
+	assert.True(t, none.AllowUsers(100, 1))
+	assert.True(t, none.AllowStorage(1<<40, 1))
+	assert.True(t, none.AllowApiRequests(1<<20))
+	assert.True(t, none.AllowModule("TaskService"))
+	assert.True(t, none.FeatureEnabled("export"))
+	assert.Equal(t, []uint32{1, 2}, none.FilterMenus([]uint32{1, 2}))
+
+	limits := &TenantLimits{
+		PlanId:               1,
+		MaxUsers:             10,
+		MaxStorageBytes:      1024,
+		MaxApiRequestsPerDay: 100,
+		Modules:              []string{"UserService"},
+		Menus:                []uint32{1, 3},
+		Features:             map[string]bool{"export": true},
+	}
+
+	assert.True(t, limits.AllowUsers(9, 1))
+	assert.False(t, limits.AllowUsers(10, 1))
+	assert.False(t, limits.AllowUsers(5, 6))
+
+	assert.True(t, limits.AllowStorage(1000, 24))
+	assert.False(t, limits.AllowStorage(1000, 25))
+
+	assert.True(t, limits.AllowApiRequests(100))
+	assert.False(t, limits.AllowApiRequests(101))
+
+	assert.True(t, limits.AllowModule("UserService"))
+	assert.False(t, limits.AllowModule("TaskService"))
+
+	assert.True(t, limits.FeatureEnabled("export"))
+	assert.False(t, limits.FeatureEnabled("import"))
+
+	assert.Equal(t, []uint32{1, 3}, limits.FilterMenus([]uint32{1, 2, 3}))
+
+	// 配额为0表示不限制
+	unlimited := &TenantLimits{PlanId: 2}
+	assert.True(t, unlimited.AllowUsers(1000, 1))
+	assert.True(t, unlimited.AllowStorage(1<<40, 1))
+	assert.True(t, unlimited.AllowApiRequests(1<<20))
+}
+
+func TestNewTenantLimits(t *testing.T) {
+	limits := newTenantLimits(&ent.TenantPlan{
+		ID:                   1,
+		Code:                 trans.Ptr("pro"),
+		MaxUsers:             trans.Ptr(uint32(10)),
+		MaxStorageBytes:      trans.Ptr(uint64(1024)),
+		MaxAPIRequestsPerDay: trans.Ptr(uint32(100)),
+		Modules:              []string{"UserService"},
+	})
+
+	assert.Equal(t, &TenantLimits{
+		PlanId:               1,
+		PlanCode:             "pro",
+		MaxUsers:             10,
+		MaxStorageBytes:      1024,
+		MaxApiRequestsPerDay: 100,
+		Modules:              []string{"UserService"},
+	}, limits)
+
+	// 没有设置配额的套餐不限制
+	assert.True(t, newTenantLimits(&ent.TenantPlan{ID: 2}).AllowApiRequests(1<<20))
+}
+
+func TestOperationModule(t *testing.T) {
+	assert.Equal(t, "UserService", OperationModule("/admin.service.v1.UserService/List"))
+	assert.Equal(t, "TaskService", OperationModule("/admin.service.v1.TaskService/Create"))
+	assert.Equal(t, "RouterService", OperationModule("RouterService"))
+}
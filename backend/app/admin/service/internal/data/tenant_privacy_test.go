@@ -0,0 +1,540 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/enttest"
+	"go-wind-admin/app/admin/service/internal/data/ent/privacy"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+// tenantEntityCase 租户实体的测试用例，create 的 n 用于区分唯一字段
+type tenantEntityCase struct {
+	name string
+	// shared 租户可以读取平台共享的数据
+	shared bool
+	create func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error)
+	get    func(ctx context.Context, c *ent.Client, id uint32) error
+	update func(ctx context.Context, c *ent.Client, id uint32) error
+	delete func(ctx context.Context, c *ent.Client, id uint32) error
+}
+
+func tenantEntityCases() []tenantEntityCase {
+	return []tenantEntityCase{
+		{
+			name: "Conversation",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Conversation.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.Conversation) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Conversation.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Conversation.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Conversation.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "ConversationMember",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.ConversationMember.Create().
+					SetNillableTenantID(tenantId).
+					SetConversationID(uint32(n)).
+					SetUserID(uint32(n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.ConversationMember) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.ConversationMember.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.ConversationMember.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.ConversationMember.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Department",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Department.Create().
+					SetNillableTenantID(tenantId).
+					SetOrganizationID(1).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.Department) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Department.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Department.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Department.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name:   "DictEntry",
+			shared: true,
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				dictType, err := c.DictType.Create().
+					SetNillableTenantID(tenantId).
+					SetTypeCode(fmt.Sprintf("entry_type_%d", n)).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				e, err := c.DictEntry.Create().
+					SetNillableTenantID(tenantId).
+					SetSysDictTypesID(dictType.ID).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.DictEntry) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.DictEntry.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.DictEntry.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.DictEntry.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name:   "DictType",
+			shared: true,
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.DictType.Create().
+					SetNillableTenantID(tenantId).
+					SetTypeCode(fmt.Sprintf("type_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.DictType) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.DictType.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.DictType.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.DictType.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "File",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.File.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.File) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.File.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.File.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.File.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "InternalMessage",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.InternalMessage.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.InternalMessage) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.InternalMessage.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessage.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessage.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name:   "InternalMessageCategory",
+			shared: true,
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.InternalMessageCategory.Create().
+					SetNillableTenantID(tenantId).
+					SetCode(fmt.Sprintf("category_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.InternalMessageCategory) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.InternalMessageCategory.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageCategory.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageCategory.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "InternalMessageDelivery",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.InternalMessageDelivery.Create().
+					SetNillableTenantID(tenantId).
+					SetRecipientID(uint32(n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.InternalMessageDelivery) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.InternalMessageDelivery.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageDelivery.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageDelivery.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "InternalMessageRecipient",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.InternalMessageRecipient.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.InternalMessageRecipient) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.InternalMessageRecipient.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageRecipient.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.InternalMessageRecipient.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "MessageTemplate",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.MessageTemplate.Create().
+					SetNillableTenantID(tenantId).
+					SetCode(fmt.Sprintf("template_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.MessageTemplate) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.MessageTemplate.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.MessageTemplate.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.MessageTemplate.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Organization",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Organization.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.Organization) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Organization.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Organization.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Organization.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Position",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Position.Create().
+					SetNillableTenantID(tenantId).
+					SetOrganizationID(1).
+					SetDepartmentID(1).
+					SetCode(fmt.Sprintf("position_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.Position) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Position.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Position.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Position.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Role",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Role.Create().
+					SetNillableTenantID(tenantId).
+					SetName(fmt.Sprintf("role_%d", n)).
+					SetCode(fmt.Sprintf("role_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.Role) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Role.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Role.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Role.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Setting",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Setting.Create().
+					SetNillableTenantID(tenantId).
+					SetKey(fmt.Sprintf("setting.%d", n)).
+					SetValue("true").
+					Save(ctx)
+				return idOf(e, err, func(e *ent.Setting) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Setting.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Setting.UpdateOneID(id).SetValue("false").Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Setting.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "Task",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.Task.Create().
+					SetNillableTenantID(tenantId).
+					SetTypeName(fmt.Sprintf("task_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.Task) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.Task.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Task.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.Task.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "TaskRun",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.TaskRun.Create().SetNillableTenantID(tenantId).Save(ctx)
+				return idOf(e, err, func(e *ent.TaskRun) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.TaskRun.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.TaskRun.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.TaskRun.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "TenantUsage",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.TenantUsage.Create().
+					SetNillableTenantID(tenantId).
+					SetPeriodStart(time.Date(2026, 1, 1, n, 0, 0, 0, time.Local)).
+					SetAPICalls(uint64(n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.TenantUsage) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.TenantUsage.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.TenantUsage.UpdateOneID(id).SetMessagesSent(1).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.TenantUsage.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "User",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.User.Create().
+					SetNillableTenantID(tenantId).
+					SetUsername(fmt.Sprintf("user_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.User) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.User.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.User.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.User.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "UserCredential",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.UserCredential.Create().
+					SetNillableTenantID(tenantId).
+					SetUserID(uint32(n)).
+					SetIdentifier(fmt.Sprintf("user_%d", n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.UserCredential) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.UserCredential.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.UserCredential.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.UserCredential.DeleteOneID(id).Exec(ctx)
+			},
+		},
+		{
+			name: "UserNotificationPreference",
+			create: func(ctx context.Context, c *ent.Client, n int, tenantId *uint32) (uint32, error) {
+				e, err := c.UserNotificationPreference.Create().
+					SetNillableTenantID(tenantId).
+					SetUserID(uint32(n)).
+					Save(ctx)
+				return idOf(e, err, func(e *ent.UserNotificationPreference) uint32 { return e.ID })
+			},
+			get: func(ctx context.Context, c *ent.Client, id uint32) error {
+				_, err := c.UserNotificationPreference.Get(ctx, id)
+				return err
+			},
+			update: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.UserNotificationPreference.UpdateOneID(id).SetUpdatedAt(time.Now()).Exec(ctx)
+			},
+			delete: func(ctx context.Context, c *ent.Client, id uint32) error {
+				return c.UserNotificationPreference.DeleteOneID(id).Exec(ctx)
+			},
+		},
+	}
+}
+
+func idOf[T any](e T, err error, id func(T) uint32) (uint32, error) {
+	if err != nil {
+		return 0, err
+	}
+	return id(e), nil
+}
+
+func tenantContext(tenantId uint32) context.Context {
+	return viewer.NewContext(context.Background(), viewer.UserViewer{
+		Authority: userV1.User_TENANT_ADMIN,
+		TenantId:  trans.Ptr(tenantId),
+	})
+}
+
+func TestTenantPrivacy(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_privacy?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	systemCtx := viewer.NewSystemViewerContext(context.Background())
+	tenant1Ctx := tenantContext(1)
+	tenant2Ctx := tenantContext(2)
+
+	n := 0
+	next := func() int {
+		n++
+		return n
+	}
+
+	for _, tc := range tenantEntityCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			// 系统上下文可以为任意租户创建数据
+			id, err := tc.create(systemCtx, client, next(), trans.Ptr(uint32(1)))
+			require.NoError(t, err)
+
+			// 其他租户读不到
+			err = tc.get(tenant2Ctx, client, id)
+			assert.True(t, ent.IsNotFound(err), "cross-tenant read: %v", err)
+
+			// 其他租户改不了，也删不掉
+			err = tc.update(tenant2Ctx, client, id)
+			assert.True(t, ent.IsNotFound(err), "cross-tenant update: %v", err)
+			err = tc.delete(tenant2Ctx, client, id)
+			assert.True(t, ent.IsNotFound(err), "cross-tenant delete: %v", err)
+
+			// 本租户可以读写
+			assert.NoError(t, tc.get(tenant1Ctx, client, id))
+			assert.NoError(t, tc.update(tenant1Ctx, client, id))
+
+			// 不能为其他租户创建数据
+			_, err = tc.create(tenant2Ctx, client, next(), trans.Ptr(uint32(1)))
+			assert.True(t, errors.Is(err, privacy.Deny), "cross-tenant create: %v", err)
+
+			// 不指定租户时写入查看者的租户
+			ownId, err := tc.create(tenant2Ctx, client, next(), nil)
+			require.NoError(t, err)
+			assert.NoError(t, tc.get(tenant2Ctx, client, ownId))
+			err = tc.get(tenant1Ctx, client, ownId)
+			assert.True(t, ent.IsNotFound(err), "cross-tenant read: %v", err)
+
+			// 没有查看者的上下文被拒绝
+			err = tc.get(context.Background(), client, id)
+			assert.True(t, errors.Is(err, privacy.Deny), "read without viewer: %v", err)
+
+			// 平台数据只有开启共享的实体各租户可读，且只有系统上下文可以修改
+			sharedId, err := tc.create(systemCtx, client, next(), trans.Ptr(uint32(0)))
+			require.NoError(t, err)
+			if tc.shared {
+				assert.NoError(t, tc.get(tenant2Ctx, client, sharedId))
+			} else {
+				err = tc.get(tenant2Ctx, client, sharedId)
+				assert.True(t, ent.IsNotFound(err), "platform read: %v", err)
+			}
+			err = tc.update(tenant2Ctx, client, sharedId)
+			assert.True(t, ent.IsNotFound(err), "shared update: %v", err)
+
+			assert.NoError(t, tc.delete(tenant1Ctx, client, id))
+			assert.NoError(t, tc.delete(systemCtx, client, sharedId))
+		})
+	}
+}
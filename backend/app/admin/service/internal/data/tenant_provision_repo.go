@@ -0,0 +1,722 @@
+package data
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/entgo"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversation"
+	"go-wind-admin/app/admin/service/internal/data/ent/conversationmember"
+	"go-wind-admin/app/admin/service/internal/data/ent/department"
+	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
+	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
+	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessage"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagedelivery"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagerecipient"
+	"go-wind-admin/app/admin/service/internal/data/ent/messagetemplate"
+	"go-wind-admin/app/admin/service/internal/data/ent/organization"
+	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleapi"
+	"go-wind-admin/app/admin/service/internal/data/ent/roledept"
+	"go-wind-admin/app/admin/service/internal/data/ent/rolemenu"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleorg"
+	"go-wind-admin/app/admin/service/internal/data/ent/roleposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/setting"
+	"go-wind-admin/app/admin/service/internal/data/ent/task"
+	"go-wind-admin/app/admin/service/internal/data/ent/taskrun"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+	"go-wind-admin/app/admin/service/internal/data/ent/userposition"
+	"go-wind-admin/app/admin/service/internal/data/ent/userrole"
+
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+// TenantProvisionRequest 开通租户的参数
+type TenantProvisionRequest struct {
+	Tenant   *userV1.Tenant
+	Admin    *userV1.User
+	Password string
+	Template *TenantTemplate
+
+	OperatorId uint32
+}
+
+// TenantOffboardResult 租户下线的结果
+type TenantOffboardResult struct {
+	// Affected 各数据表受影响的行数：归档时为保留的行数，清除时为删除的行数
+	Affected map[string]uint32
+	// UserIds 租户内的用户，用于让这些用户下线
+	UserIds []uint32
+}
+
+// TenantProvisionRepo 租户的开通与下线，涉及多个实体的写入，全部在一个事务中完成
+type TenantProvisionRepo struct {
+	data *Data
+	log  *log.Helper
+
+	tenantRepo         *TenantRepo
+	userRepo           *UserRepo
+	userCredentialRepo *UserCredentialRepo
+}
+
+func NewTenantProvisionRepo(
+	data *Data,
+	logger log.Logger,
+	tenantRepo *TenantRepo,
+	userRepo *UserRepo,
+	userCredentialRepo *UserCredentialRepo,
+) *TenantProvisionRepo {
+	return &TenantProvisionRepo{
+		log:                log.NewHelper(log.With(logger, "module", "tenant-provision/repo/admin-service")),
+		data:               data,
+		tenantRepo:         tenantRepo,
+		userRepo:           userRepo,
+		userCredentialRepo: userCredentialRepo,
+	}
+}
+
+// Provision 在一个事务中创建租户、租户管理员及其凭证，并按模板从平台复制角色、字典与站内信分类，任何一步失败都不会留下数据
+func (r *TenantProvisionRepo) Provision(ctx context.Context, req *TenantProvisionRequest) (*userV1.Tenant, error) {
+	if req == nil || req.Tenant == nil || req.Admin == nil {
+		return nil, userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	tpl := req.Template
+	if tpl == nil {
+		tpl = &TenantTemplate{}
+	}
+
+	// 模板数据属于平台，需要跨租户读取
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	tenantEntity, err := r.tenantRepo.newCreateBuilder(tx.Client(), req.Tenant).Save(ctx)
+	if err != nil {
+		err = entgo.Rollback(tx, err)
+		if ent.IsConstraintError(err) {
+			return nil, userV1.ErrorConflict("tenant already exists")
+		}
+		r.log.Errorf("insert tenant failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("insert tenant failed")
+	}
+	tenantId := tenantEntity.ID
+
+	roleIds, err := r.cloneRoles(ctx, tx, tenantId, tpl.Roles, req.OperatorId)
+	if err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("clone template roles failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("clone template roles failed")
+	}
+
+	admin := req.Admin
+	admin.TenantId = &tenantId
+	admin.RoleIds = nil
+	for _, code := range tpl.AdminRoles {
+		if id, ok := roleIds[code]; ok {
+			admin.RoleIds = append(admin.RoleIds, id)
+		}
+	}
+
+	adminEntity, err := r.userRepo.newCreateBuilder(tx.Client(), admin).Save(ctx)
+	if err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("insert tenant admin user failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("insert tenant admin user failed")
+	}
+
+	credential, err := r.userCredentialRepo.newCreateBuilder(tx.Client(), &authenticationV1.UserCredential{
+		UserId:         &adminEntity.ID,
+		TenantId:       &tenantId,
+		IdentityType:   authenticationV1.UserCredential_USERNAME.Enum(),
+		Identifier:     adminEntity.Username,
+		CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
+		Credential:     &req.Password,
+		IsPrimary:      trans.Ptr(true),
+		Status:         authenticationV1.UserCredential_ENABLED.Enum(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err = credential.Exec(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("insert tenant admin credential failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("insert tenant admin credential failed")
+	}
+
+	if tenantEntity, err = tx.Tenant.UpdateOneID(tenantId).
+		SetAdminUserID(adminEntity.ID).
+		Save(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("update tenant admin user failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("update tenant admin user failed")
+	}
+
+	if err = r.cloneDictTypes(ctx, tx, tenantId, tpl.DictTypes, req.OperatorId); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("clone template dict types failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("clone template dict types failed")
+	}
+
+	if err = r.cloneMessageCategories(ctx, tx, tenantId, tpl.MessageCategories, req.OperatorId); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("clone template message categories failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("clone template message categories failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return r.tenantRepo.mapper.ToDTO(tenantEntity), nil
+}
+
+// cloneRoles 复制平台角色及其菜单与API授权，返回平台角色编码到新角色ID的映射。上级角色同样被复制时保留层级关系。
+// 角色编码不能与平台的角色重名，复制的角色编码加上租户前缀
+func (r *TenantProvisionRepo) cloneRoles(ctx context.Context, tx *ent.Tx, tenantId uint32, codes []string, operatorId uint32) (map[string]uint32, error) {
+	ids := make(map[string]uint32, len(codes))
+	if len(codes) == 0 {
+		return ids, nil
+	}
+
+	sources, err := tx.Role.Query().
+		Where(role.CodeIn(codes...), tenantScope(0)).
+		Order(ent.Asc(role.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	newIds := make(map[uint32]uint32, len(sources))
+	for _, src := range sources {
+		entity, err := tx.Role.Create().
+			SetTenantID(tenantId).
+			SetNillableName(src.Name).
+			SetCode(tenantRoleCode(tenantId, *src.Code)).
+			SetMenus(src.Menus).
+			SetApis(src.Apis).
+			SetNillableDataScope(src.DataScope).
+			SetNillableStatus(src.Status).
+			SetNillableRemark(src.Remark).
+			SetNillableSortOrder(src.SortOrder).
+			SetCreatedBy(operatorId).
+			SetCreatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		newIds[src.ID] = entity.ID
+		ids[*src.Code] = entity.ID
+	}
+
+	for _, src := range sources {
+		if src.ParentID == nil {
+			continue
+		}
+		parentId, ok := newIds[*src.ParentID]
+		if !ok {
+			continue
+		}
+		if err = tx.Role.UpdateOneID(newIds[src.ID]).
+			SetParentID(parentId).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// tenantRoleCode 复制到租户的角色编码，如租户3的 user 角色为 t3_user
+func tenantRoleCode(tenantId uint32, code string) string {
+	return "t" + strconv.FormatUint(uint64(tenantId), 10) + "_" + code
+}
+
+// cloneDictTypes 复制平台字典类型及其字典条目
+func (r *TenantProvisionRepo) cloneDictTypes(ctx context.Context, tx *ent.Tx, tenantId uint32, codes []string, operatorId uint32) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	sources, err := tx.DictType.Query().
+		Where(dicttype.TypeCodeIn(codes...), tenantScope(0)).
+		WithEntries().
+		Order(ent.Asc(dicttype.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, src := range sources {
+		entity, err := tx.DictType.Create().
+			SetTenantID(tenantId).
+			SetNillableTypeCode(src.TypeCode).
+			SetNillableTypeName(src.TypeName).
+			SetNillableIsEnabled(src.IsEnabled).
+			SetNillableSortOrder(src.SortOrder).
+			SetNillableDescription(src.Description).
+			SetCreatedBy(operatorId).
+			SetCreatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(src.Edges.Entries) == 0 {
+			continue
+		}
+
+		builders := make([]*ent.DictEntryCreate, 0, len(src.Edges.Entries))
+		for _, entry := range src.Edges.Entries {
+			builders = append(builders, tx.DictEntry.Create().
+				SetTenantID(tenantId).
+				SetSysDictTypesID(entity.ID).
+				SetNillableEntryLabel(entry.EntryLabel).
+				SetNillableEntryValue(entry.EntryValue).
+				SetNillableNumericValue(entry.NumericValue).
+				SetNillableLanguageCode(entry.LanguageCode).
+				SetNillableDescription(entry.Description).
+				SetNillableSortOrder(entry.SortOrder).
+				SetNillableIsEnabled(entry.IsEnabled).
+				SetCreatedBy(operatorId).
+				SetCreatedAt(now),
+			)
+		}
+		if _, err = tx.DictEntry.CreateBulk(builders...).Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneMessageCategories 复制平台站内信分类，上级分类同样被复制时保留层级关系
+func (r *TenantProvisionRepo) cloneMessageCategories(ctx context.Context, tx *ent.Tx, tenantId uint32, codes []string, operatorId uint32) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	sources, err := tx.InternalMessageCategory.Query().
+		Where(internalmessagecategory.CodeIn(codes...), tenantScope(0)).
+		Order(ent.Asc(internalmessagecategory.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	newIds := make(map[uint32]uint32, len(sources))
+	for _, src := range sources {
+		entity, err := tx.InternalMessageCategory.Create().
+			SetTenantID(tenantId).
+			SetNillableName(src.Name).
+			SetNillableCode(src.Code).
+			SetNillableIconURL(src.IconURL).
+			SetChannels(src.Channels).
+			SetNillableIsEnabled(src.IsEnabled).
+			SetNillableSortOrder(src.SortOrder).
+			SetNillableRemark(src.Remark).
+			SetCreatedBy(operatorId).
+			SetCreatedAt(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		newIds[src.ID] = entity.ID
+	}
+
+	for _, src := range sources {
+		if src.ParentID == nil {
+			continue
+		}
+		parentId, ok := newIds[*src.ParentID]
+		if !ok {
+			continue
+		}
+		if err = tx.InternalMessageCategory.UpdateOneID(newIds[src.ID]).
+			SetParentID(parentId).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Archive 归档租户：停用租户并记录退订时间，保留租户的全部数据，重新启用租户即可恢复。返回保留的各表行数
+func (r *TenantProvisionRepo) Archive(ctx context.Context, tenantId uint32, operatorId uint32) (*TenantOffboardResult, error) {
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	now := time.Now()
+	if err = tx.Tenant.UpdateOneID(tenantId).
+		SetStatus(tenant.StatusOff).
+		SetUnsubscribeAt(now).
+		SetUpdatedBy(operatorId).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		if ent.IsNotFound(err) {
+			return nil, userV1.ErrorTenantNotFound("tenant not found")
+		}
+		r.log.Errorf("archive tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("archive tenant failed")
+	}
+
+	result := &TenantOffboardResult{Affected: make(map[string]uint32)}
+	for _, t := range tenantOwnedTables {
+		n, err := t.count(ctx, tx.Client(), tenantId)
+		if err != nil {
+			err = entgo.Rollback(tx, err)
+			r.log.Errorf("count tenant [%d] rows of [%s] failed: %s", tenantId, t.name, err.Error())
+			return nil, userV1.ErrorInternalServerError("count tenant data failed")
+		}
+		result.Affected[t.name] = uint32(n)
+	}
+
+	if result.UserIds, err = tx.User.Query().
+		Where(user.TenantIDEQ(tenantId)).
+		IDs(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("query tenant [%d] users failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant users failed")
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return result, nil
+}
+
+// Purge 在一个事务中删除租户及其全部数据，包括租户角色与用户的关联数据，不可恢复。返回删除的各表行数
+func (r *TenantProvisionRepo) Purge(ctx context.Context, tenantId uint32) (*TenantOffboardResult, error) {
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	result := &TenantOffboardResult{Affected: make(map[string]uint32)}
+
+	if result.UserIds, err = tx.User.Query().
+		Where(user.TenantIDEQ(tenantId)).
+		IDs(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("query tenant [%d] users failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant users failed")
+	}
+
+	roleIds, err := tx.Role.Query().
+		Where(role.TenantIDEQ(tenantId)).
+		IDs(ctx)
+	if err != nil {
+		err = entgo.Rollback(tx, err)
+		r.log.Errorf("query tenant [%d] roles failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant roles failed")
+	}
+
+	// 关联表没有租户ID，按租户的角色与用户删除
+	relations := []struct {
+		name  string
+		purge func() (int, error)
+	}{
+		{rolemenu.Table, func() (int, error) { return tx.RoleMenu.Delete().Where(rolemenu.RoleIDIn(roleIds...)).Exec(ctx) }},
+		{roleapi.Table, func() (int, error) { return tx.RoleApi.Delete().Where(roleapi.RoleIDIn(roleIds...)).Exec(ctx) }},
+		{roledept.Table, func() (int, error) { return tx.RoleDept.Delete().Where(roledept.RoleIDIn(roleIds...)).Exec(ctx) }},
+		{roleorg.Table, func() (int, error) { return tx.RoleOrg.Delete().Where(roleorg.RoleIDIn(roleIds...)).Exec(ctx) }},
+		{roleposition.Table, func() (int, error) {
+			return tx.RolePosition.Delete().Where(roleposition.RoleIDIn(roleIds...)).Exec(ctx)
+		}},
+		{userrole.Table, func() (int, error) { return tx.UserRole.Delete().Where(userrole.UserIDIn(result.UserIds...)).Exec(ctx) }},
+		{userposition.Table, func() (int, error) {
+			return tx.UserPosition.Delete().Where(userposition.UserIDIn(result.UserIds...)).Exec(ctx)
+		}},
+	}
+	for _, rel := range relations {
+		n, err := rel.purge()
+		if err != nil {
+			err = entgo.Rollback(tx, err)
+			r.log.Errorf("purge tenant [%d] rows of [%s] failed: %s", tenantId, rel.name, err.Error())
+			return nil, userV1.ErrorInternalServerError("purge tenant data failed")
+		}
+		result.Affected[rel.name] = uint32(n)
+	}
+
+	for _, t := range tenantOwnedTables {
+		n, err := t.purge(ctx, tx.Client(), tenantId)
+		if err != nil {
+			err = entgo.Rollback(tx, err)
+			r.log.Errorf("purge tenant [%d] rows of [%s] failed: %s", tenantId, t.name, err.Error())
+			return nil, userV1.ErrorInternalServerError("purge tenant data failed")
+		}
+		result.Affected[t.name] = uint32(n)
+	}
+
+	if err = tx.Tenant.DeleteOneID(tenantId).Exec(ctx); err != nil {
+		err = entgo.Rollback(tx, err)
+		if ent.IsNotFound(err) {
+			return nil, userV1.ErrorTenantNotFound("tenant not found")
+		}
+		r.log.Errorf("delete tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("delete tenant failed")
+	}
+	result.Affected[tenant.Table] = 1
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return result, nil
+}
+
+// tenantOwnedTable 带有租户ID的数据表
+type tenantOwnedTable struct {
+	name  string
+	count func(ctx context.Context, c *ent.Client, tenantId uint32) (int, error)
+	purge func(ctx context.Context, c *ent.Client, tenantId uint32) (int, error)
+}
+
+// tenantOwnedTables 所有带有租户ID的数据表，按删除顺序排列：先删除引用方，再删除被引用方。
+// 树形结构的数据表先清空上级ID，避免逐行检查外键时删除失败。新增租户实体时需要加入这里
+var tenantOwnedTables = []tenantOwnedTable{
+	{
+		name: internalmessagerecipient.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessageRecipient.Query().Where(internalmessagerecipient.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessageRecipient.Delete().Where(internalmessagerecipient.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: internalmessagedelivery.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessageDelivery.Query().Where(internalmessagedelivery.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessageDelivery.Delete().Where(internalmessagedelivery.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: internalmessage.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessage.Query().Where(internalmessage.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessage.Delete().Where(internalmessage.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: conversationmember.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.ConversationMember.Query().Where(conversationmember.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.ConversationMember.Delete().Where(conversationmember.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: conversation.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Conversation.Query().Where(conversation.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Conversation.Delete().Where(conversation.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: usernotificationpreference.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.UserNotificationPreference.Query().Where(usernotificationpreference.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.UserNotificationPreference.Delete().Where(usernotificationpreference.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: setting.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Setting.Query().Where(setting.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Setting.Delete().Where(setting.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: messagetemplate.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.MessageTemplate.Query().Where(messagetemplate.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.MessageTemplate.Delete().Where(messagetemplate.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: internalmessagecategory.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.InternalMessageCategory.Query().Where(internalmessagecategory.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			if err := c.InternalMessageCategory.Update().
+				Where(internalmessagecategory.TenantIDEQ(tid)).
+				ClearParentID().
+				Exec(ctx); err != nil {
+				return 0, err
+			}
+			return c.InternalMessageCategory.Delete().Where(internalmessagecategory.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: file.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.File.Query().Where(file.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.File.Delete().Where(file.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: tenantusage.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.TenantUsage.Query().Where(tenantusage.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.TenantUsage.Delete().Where(tenantusage.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: taskrun.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.TaskRun.Query().Where(taskrun.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.TaskRun.Delete().Where(taskrun.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: task.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Task.Query().Where(task.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Task.Delete().Where(task.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: dictentry.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.DictEntry.Query().Where(dictentry.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.DictEntry.Delete().Where(dictentry.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: dicttype.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.DictType.Query().Where(dicttype.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.DictType.Delete().Where(dicttype.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: usercredential.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.UserCredential.Query().Where(usercredential.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.UserCredential.Delete().Where(usercredential.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: user.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.User.Query().Where(user.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.User.Delete().Where(user.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: role.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Role.Query().Where(role.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			if err := c.Role.Update().Where(role.TenantIDEQ(tid)).ClearParentID().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return c.Role.Delete().Where(role.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: position.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Position.Query().Where(position.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			if err := c.Position.Update().Where(position.TenantIDEQ(tid)).ClearParentID().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return c.Position.Delete().Where(position.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: department.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Department.Query().Where(department.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			if err := c.Department.Update().Where(department.TenantIDEQ(tid)).ClearParentID().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return c.Department.Delete().Where(department.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+	{
+		name: organization.Table,
+		count: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			return c.Organization.Query().Where(organization.TenantIDEQ(tid)).Count(ctx)
+		},
+		purge: func(ctx context.Context, c *ent.Client, tid uint32) (int, error) {
+			if err := c.Organization.Update().Where(organization.TenantIDEQ(tid)).ClearParentID().Exec(ctx); err != nil {
+				return 0, err
+			}
+			return c.Organization.Delete().Where(organization.TenantIDEQ(tid)).Exec(ctx)
+		},
+	},
+}
@@ -0,0 +1,168 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
+	"go-wind-admin/app/admin/service/internal/data/ent/enttest"
+	"go-wind-admin/app/admin/service/internal/data/ent/migrate"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+// TestTenantOwnedTablesComplete 新增带有租户ID的实体时必须加入 tenantOwnedTables，否则清除租户时会遗留数据
+func TestTenantOwnedTablesComplete(t *testing.T) {
+	names := make(map[string]bool, len(tenantOwnedTables))
+	for _, table := range tenantOwnedTables {
+		names[table.name] = true
+	}
+
+	for _, table := range migrate.Tables {
+		for _, column := range table.Columns {
+			if column.Name == "tenant_id" {
+				assert.True(t, names[table.Name], "table %s has tenant_id but is not purged with its tenant", table.Name)
+			}
+		}
+	}
+}
+
+func TestTenantProvisionCloneTemplate(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_provision_clone?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	r := &TenantProvisionRepo{log: log.NewHelper(log.DefaultLogger)}
+
+	// 平台的模板数据
+	parent, err := client.Role.Create().SetName("租户管理员").SetCode("tenant_admin").SetMenus([]uint32{1, 2}).SetApis([]uint32{3}).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Role.Create().SetName("普通用户").SetCode("user").SetParentID(parent.ID).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Role.Create().SetName("审计员").SetCode("auditor").Save(ctx)
+	require.NoError(t, err)
+
+	gender, err := client.DictType.Create().SetTypeCode("gender").SetTypeName("性别").Save(ctx)
+	require.NoError(t, err)
+	for _, v := range []string{"MALE", "FEMALE"} {
+		_, err = client.DictEntry.Create().SetSysDictTypesID(gender.ID).SetEntryLabel(v).SetEntryValue(v).Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// 其他租户同编码的数据不是模板
+	_, err = client.Role.Create().SetTenantID(9).SetName("其他").SetCode("auditor").Save(ctx)
+	require.NoError(t, err)
+
+	const tenantId = uint32(5)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+
+	roleIds, err := r.cloneRoles(ctx, tx, tenantId, []string{"tenant_admin", "user", "missing"}, 1)
+	require.NoError(t, err)
+	require.NoError(t, r.cloneDictTypes(ctx, tx, tenantId, []string{"gender"}, 1))
+	require.NoError(t, tx.Commit())
+
+	assert.Len(t, roleIds, 2)
+
+	admin, err := client.Role.Get(ctx, roleIds["tenant_admin"])
+	require.NoError(t, err)
+	assert.Equal(t, tenantId, *admin.TenantID)
+	// 复制的角色编码不与平台的角色重名
+	assert.Equal(t, "t5_tenant_admin", *admin.Code)
+	assert.Equal(t, []uint32{1, 2}, admin.Menus)
+	assert.Equal(t, []uint32{3}, admin.Apis)
+
+	// 层级关系指向复制后的上级角色
+	user, err := client.Role.Get(ctx, roleIds["user"])
+	require.NoError(t, err)
+	require.NotNil(t, user.ParentID)
+	assert.Equal(t, admin.ID, *user.ParentID)
+
+	n, err := client.Role.Query().Where(role.TenantIDEQ(tenantId)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	n, err = client.DictEntry.Query().Where(dictentry.TenantIDEQ(tenantId)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+}
+
+func TestTenantProvisionPurgeTables(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_provision_purge?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := viewer.NewSystemViewerContext(context.Background())
+
+	n := 0
+	for _, tc := range tenantEntityCases() {
+		for _, tid := range []uint32{1, 2} {
+			n++
+			_, err := tc.create(ctx, client, n, &tid)
+			require.NoError(t, err, tc.name)
+		}
+	}
+
+	// 树形数据的下级引用上级
+	parent, err := client.Role.Create().SetTenantID(1).SetName("上级").SetCode("parent").Save(ctx)
+	require.NoError(t, err)
+	_, err = client.Role.Create().SetTenantID(1).SetName("下级").SetCode("child").SetParentID(parent.ID).Save(ctx)
+	require.NoError(t, err)
+
+	tx, err := client.Tx(ctx)
+	require.NoError(t, err)
+	for _, table := range tenantOwnedTables {
+		_, err = table.purge(ctx, tx.Client(), 1)
+		require.NoError(t, err, table.name)
+	}
+	require.NoError(t, tx.Commit())
+
+	for _, table := range tenantOwnedTables {
+		purged, err := table.count(ctx, client, 1)
+		require.NoError(t, err)
+		assert.Zero(t, purged, table.name)
+	}
+
+	// 其他租户的数据不受影响
+	kept, err := client.Role.Query().Where(role.TenantIDEQ(2)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, kept)
+	assert.True(t, ent.IsNotFound(client.Role.DeleteOneID(parent.ID).Exec(ctx)))
+}
+
+func TestTenantTemplates(t *testing.T) {
+	// 没有配置时使用内置模板
+	templates := NewTenantTemplatesFromConfig(nil)
+	tpl, ok := templates.Get("")
+	require.True(t, ok)
+	assert.Equal(t, []string{"tenant_admin"}, tpl.AdminRoles)
+
+	// 默认模板不存在时使用名称排序后的第一个模板
+	templates = NewTenantTemplatesFromConfig(&TenantTemplateConfig{
+		DefaultTemplate: "missing",
+		Templates: map[string]*TenantTemplate{
+			"standard": {Roles: []string{"tenant_admin", "user"}},
+			"minimal":  {Roles: []string{"tenant_admin"}},
+		},
+	})
+	assert.Equal(t, []string{"minimal", "standard"}, templates.Names())
+
+	tpl, ok = templates.Get("")
+	require.True(t, ok)
+	assert.Equal(t, []string{"tenant_admin"}, tpl.Roles)
+
+	_, ok = templates.Get("missing")
+	assert.False(t, ok)
+
+	items := templates.ToProto()
+	require.Len(t, items, 2)
+	assert.True(t, items[0].GetIsDefault())
+	assert.False(t, items[1].GetIsDefault())
+}
@@ -153,7 +153,19 @@ func (r *TenantRepo) Create(ctx context.Context, data *userV1.Tenant) (*userV1.T
 		return nil, userV1.ErrorBadRequest("invalid parameter")
 	}
 
-	builder := r.data.db.Client().Tenant.Create().
+	builder := r.newCreateBuilder(r.data.db.Client(), data)
+
+	if ret, err := builder.Save(ctx); err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("insert data failed")
+	} else {
+		return r.mapper.ToDTO(ret), nil
+	}
+}
+
+// newCreateBuilder 创建租户的构建器，client 可以是事务的客户端
+func (r *TenantRepo) newCreateBuilder(client *ent.Client, data *userV1.Tenant) *ent.TenantCreate {
+	builder := client.Tenant.Create().
 		SetNillableName(data.Name).
 		SetNillableCode(data.Code).
 		SetNillableLogoURL(data.LogoUrl).
@@ -166,6 +178,7 @@ func (r *TenantRepo) Create(ctx context.Context, data *userV1.Tenant) (*userV1.T
 		SetNillableLastLoginTime(timeutil.TimestamppbToTime(data.LastLoginTime)).
 		SetNillableLastLoginIP(data.LastLoginIp).
 		SetNillableSubscriptionPlan(data.SubscriptionPlan).
+		SetNillablePlanID(data.PlanId).
 		SetNillableExpiredAt(timeutil.TimestamppbToTime(data.ExpiredAt)).
 		SetNillableSubscriptionAt(timeutil.TimestamppbToTime(data.SubscriptionAt)).
 		SetNillableUnsubscribeAt(timeutil.TimestamppbToTime(data.UnsubscribeAt))
@@ -182,12 +195,7 @@ func (r *TenantRepo) Create(ctx context.Context, data *userV1.Tenant) (*userV1.T
 		builder.SetID(data.GetId())
 	}
 
-	if ret, err := builder.Save(ctx); err != nil {
-		r.log.Errorf("insert one data failed: %s", err.Error())
-		return nil, userV1.ErrorInternalServerError("insert data failed")
-	} else {
-		return r.mapper.ToDTO(ret), nil
-	}
+	return builder
 }
 
 func (r *TenantRepo) Update(ctx context.Context, req *userV1.UpdateTenantRequest) error {
@@ -226,6 +234,7 @@ func (r *TenantRepo) Update(ctx context.Context, req *userV1.UpdateTenantRequest
 				SetNillableLastLoginTime(timeutil.TimestamppbToTime(req.Data.LastLoginTime)).
 				SetNillableLastLoginIP(req.Data.LastLoginIp).
 				SetNillableSubscriptionPlan(req.Data.SubscriptionPlan).
+				SetNillablePlanID(req.Data.PlanId).
 				SetNillableExpiredAt(timeutil.TimestamppbToTime(req.Data.ExpiredAt)).
 				SetNillableSubscriptionAt(timeutil.TimestamppbToTime(req.Data.SubscriptionAt)).
 				SetNillableUnsubscribeAt(timeutil.TimestamppbToTime(req.Data.UnsubscribeAt)).
@@ -300,3 +309,154 @@ func (r *TenantRepo) ListTenantsByIds(ctx context.Context, ids []uint32) ([]*use
 
 	return dtos, nil
 }
+
+// GetState 查询租户的状态，租户不存在时 Exists 为false
+func (r *TenantRepo) GetState(ctx context.Context, id uint32) (*TenantState, error) {
+	entity, err := r.data.db.Client().Tenant.Query().
+		Where(tenant.IDEQ(id)).
+		Select(tenant.FieldStatus, tenant.FieldAuditStatus, tenant.FieldExpiredAt, tenant.FieldPlanID).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &TenantState{Exists: false}, nil
+		}
+
+		r.log.Errorf("query tenant state failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant state failed")
+	}
+
+	var limits *TenantLimits
+	if entity.PlanID != nil {
+		plan, err := r.data.db.Client().TenantPlan.Get(ctx, *entity.PlanID)
+		switch {
+		case err == nil:
+			limits = newTenantLimits(plan)
+		case ent.IsNotFound(err):
+			// 套餐已被删除，按没有订阅套餐处理
+			r.log.Warnf("plan [%d] of tenant [%d] not found", *entity.PlanID, id)
+		default:
+			r.log.Errorf("query tenant plan failed: %s", err.Error())
+			return nil, userV1.ErrorInternalServerError("query tenant plan failed")
+		}
+	}
+
+	state := &TenantState{
+		Exists:      true,
+		Status:      userV1.Tenant_ON,
+		AuditStatus: r.auditStatusConverter.ToDTO(entity.AuditStatus),
+		ExpiredAt:   entity.ExpiredAt,
+		Limits:      limits,
+	}
+	if status := r.statusConverter.ToDTO(entity.Status); status != nil {
+		state.Status = *status
+	}
+
+	return state, nil
+}
+
+// GetIdByCode 通过租户编码查询租户ID，租户不存在时返回false
+func (r *TenantRepo) GetIdByCode(ctx context.Context, code string) (uint32, bool, error) {
+	id, err := r.data.db.Client().Tenant.Query().
+		Where(tenant.CodeEQ(code)).
+		OnlyID(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, false, nil
+		}
+
+		r.log.Errorf("query tenant id by code failed: %s", err.Error())
+		return 0, false, userV1.ErrorInternalServerError("query tenant id failed")
+	}
+
+	return id, true, nil
+}
+
+// ListExpiring 查询启用中且在 before 之前到期的租户
+func (r *TenantRepo) ListExpiring(ctx context.Context, before time.Time) ([]*userV1.Tenant, error) {
+	entities, err := r.data.db.Client().Tenant.Query().
+		Where(
+			tenant.StatusEQ(tenant.StatusOn),
+			tenant.ExpiredAtNotNil(),
+			tenant.ExpiredAtLTE(before),
+		).
+		Order(ent.Asc(tenant.FieldExpiredAt)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query expiring tenants failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query expiring tenants failed")
+	}
+
+	dtos := make([]*userV1.Tenant, 0, len(entities))
+	for _, entity := range entities {
+		dtos = append(dtos, r.mapper.ToDTO(entity))
+	}
+
+	return dtos, nil
+}
+
+// UpdateStatus 租户状态为 from 时改为 to，返回false表示状态已被其他操作修改
+func (r *TenantRepo) UpdateStatus(ctx context.Context, id uint32, from, to userV1.Tenant_Status) (bool, error) {
+	count, err := r.data.db.Client().Tenant.Update().
+		Where(
+			tenant.IDEQ(id),
+			tenant.StatusEQ(*r.statusConverter.ToEntity(&from)),
+		).
+		SetStatus(*r.statusConverter.ToEntity(&to)).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		r.log.Errorf("update tenant status failed: %s", err.Error())
+		return false, userV1.ErrorInternalServerError("update tenant status failed")
+	}
+
+	return count > 0, nil
+}
+
+// ListIdsByPlan 查询订阅了套餐的租户ID
+func (r *TenantRepo) ListIdsByPlan(ctx context.Context, planId uint32) ([]uint32, error) {
+	ids, err := r.data.db.Client().Tenant.Query().
+		Where(tenant.PlanIDEQ(planId)).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant ids by plan failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant ids by plan failed")
+	}
+
+	return ids, nil
+}
+
+// ListIds 查询所有租户的ID
+func (r *TenantRepo) ListIds(ctx context.Context) ([]uint32, error) {
+	ids, err := r.data.db.Client().Tenant.Query().IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant ids failed")
+	}
+
+	return ids, nil
+}
+
+// ListNamesByIds 查询租户的名称，键为租户ID
+func (r *TenantRepo) ListNamesByIds(ctx context.Context, ids []uint32) (map[uint32]string, error) {
+	names := make(map[uint32]string, len(ids))
+	if len(ids) == 0 {
+		return names, nil
+	}
+
+	entities, err := r.data.db.Client().Tenant.Query().
+		Where(tenant.IDIn(ids...)).
+		Select(tenant.FieldID, tenant.FieldName).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant names failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant names failed")
+	}
+
+	for _, entity := range entities {
+		if entity.Name != nil {
+			names[entity.ID] = *entity.Name
+		}
+	}
+
+	return names, nil
+}
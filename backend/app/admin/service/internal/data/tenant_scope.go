@@ -0,0 +1,145 @@
+package data
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
+	"go-wind-admin/app/admin/service/internal/data/ent/internalmessagecategory"
+	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+// tenantScope 限定查询所属的租户，可用于任意带有租户ID的实体。
+// 租户ID为0表示平台，同时匹配尚未迁移、租户ID为空的历史数据
+func tenantScope(tenantId uint32) func(s *sql.Selector) {
+	return func(s *sql.Selector) {
+		if tenantId == 0 {
+			s.Where(sql.Or(
+				sql.EQ(s.C("tenant_id"), 0),
+				sql.IsNull(s.C("tenant_id")),
+			))
+			return
+		}
+		s.Where(sql.EQ(s.C("tenant_id"), tenantId))
+	}
+}
+
+// setTenantId 租户ID是创建后不可修改的字段，更新构造器没有 SetTenantID，迁移时直接写入列
+func setTenantId(tenantId uint32) func(u *sql.UpdateBuilder) {
+	return func(u *sql.UpdateBuilder) {
+		u.Set("tenant_id", tenantId)
+	}
+}
+
+// migrateTenantScope 用户名、角色名称与编码、字典类型编码、站内信分类编码、职位编码由全局唯一改为租户内唯一后，补齐历史数据的租户ID：
+// 租户管理员与其凭证归入所在租户，其余租户ID为空的数据归入平台（租户ID为0），使新的组合唯一索引对平台数据同样生效。
+// 原有的全局唯一索引保证了历史数据不会在补齐后产生冲突，迁移可以重复执行
+func migrateTenantScope(ctx context.Context, client *ent.Client) error {
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	// 系统管理员创建的租户管理员没有写入租户ID
+	tenants, err := client.Tenant.Query().
+		Where(tenant.AdminUserIDNotNil()).
+		Select(tenant.FieldID, tenant.FieldAdminUserID).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range tenants {
+		if err = client.User.Update().
+			Where(
+				user.IDEQ(*t.AdminUserID),
+				user.TenantIDIsNil(),
+			).
+			Modify(setTenantId(t.ID)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if err = client.User.Update().
+		Where(user.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// 凭证的租户ID与所属用户保持一致
+	if err = client.UserCredential.Update().
+		Where(
+			usercredential.Or(
+				usercredential.TenantIDIsNil(),
+				usercredential.TenantIDEQ(0),
+			),
+			func(s *sql.Selector) {
+				t := sql.Table(user.Table)
+				s.Where(sql.In(
+					s.C(usercredential.FieldUserID),
+					sql.Select(t.C(user.FieldID)).
+						From(t).
+						Where(sql.NEQ(t.C(user.FieldTenantID), 0)),
+				))
+			},
+		).
+		Modify(func(u *sql.UpdateBuilder) {
+			t := sql.Table(user.Table)
+			u.Set(usercredential.FieldTenantID, sql.ExprFunc(func(b *sql.Builder) {
+				b.Wrap(func(b *sql.Builder) {
+					b.Join(sql.Select(t.C(user.FieldTenantID)).
+						From(t).
+						Where(sql.ColumnsEQ(
+							t.C(user.FieldID),
+							sql.Table(usercredential.Table).C(usercredential.FieldUserID),
+						)))
+				})
+			}))
+		}).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = client.UserCredential.Update().
+		Where(usercredential.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = client.Role.Update().
+		Where(role.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = client.DictType.Update().
+		Where(dicttype.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = client.InternalMessageCategory.Update().
+		Where(internalmessagecategory.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if err = client.Position.Update().
+		Where(position.TenantIDIsNil()).
+		Modify(setTenantId(0)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/enttest"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+	"go-wind-admin/app/admin/service/internal/data/ent/usercredential"
+
+	"go-wind-admin/pkg/entgo/viewer"
+)
+
+func TestTenantScopedUniqueness(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_scope_unique?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	systemCtx := viewer.NewSystemViewerContext(context.Background())
+	tenant1Ctx := tenantContext(1)
+	tenant2Ctx := tenantContext(2)
+
+	// 不同租户可以使用相同的用户名、角色编码与字典类型编码
+	for _, ctx := range []context.Context{tenant1Ctx, tenant2Ctx} {
+		_, err := client.User.Create().SetUsername("alice").Save(ctx)
+		require.NoError(t, err)
+		_, err = client.Role.Create().SetName("管理员").SetCode("admin").Save(ctx)
+		require.NoError(t, err)
+		_, err = client.DictType.Create().SetTypeCode("gender").Save(ctx)
+		require.NoError(t, err)
+	}
+
+	// 同一租户内仍然唯一
+	_, err := client.User.Create().SetUsername("alice").Save(tenant1Ctx)
+	assert.True(t, ent.IsConstraintError(err), "duplicate username: %v", err)
+	_, err = client.Role.Create().SetName("管理员").SetCode("other").Save(tenant1Ctx)
+	assert.True(t, ent.IsConstraintError(err), "duplicate role name: %v", err)
+	_, err = client.Role.Create().SetName("other").SetCode("admin").Save(tenant1Ctx)
+	assert.True(t, ent.IsConstraintError(err), "duplicate role code: %v", err)
+	_, err = client.DictType.Create().SetTypeCode("gender").Save(tenant1Ctx)
+	assert.True(t, ent.IsConstraintError(err), "duplicate dict type code: %v", err)
+
+	// 平台数据不指定租户时写入0，组合唯一索引同样生效
+	platform, err := client.User.Create().SetUsername("alice").Save(systemCtx)
+	require.NoError(t, err)
+	require.NotNil(t, platform.TenantID)
+	assert.Equal(t, uint32(0), *platform.TenantID)
+	_, err = client.User.Create().SetUsername("alice").Save(systemCtx)
+	assert.True(t, ent.IsConstraintError(err), "duplicate platform username: %v", err)
+
+	// 按租户限定查询
+	for _, tid := range []uint32{0, 1, 2} {
+		u, err := client.User.Query().
+			Where(user.UsernameEQ("alice"), tenantScope(tid)).
+			Only(systemCtx)
+		require.NoError(t, err)
+		assert.Equal(t, tid, *u.TenantID)
+	}
+}
+
+// clearTenantId 把租户ID置为空，模拟租户隔离上线之前创建的历史数据
+func clearTenantId(u *sql.UpdateBuilder) {
+	u.SetNull("tenant_id")
+}
+
+func TestMigrateTenantScope(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_scope_migrate?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := viewer.NewSystemViewerContext(context.Background())
+
+	// 系统管理员创建的租户管理员没有写入租户ID
+	tenantAdmin, err := client.User.Create().SetUsername("tenant_admin").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.User.UpdateOneID(tenantAdmin.ID).Modify(clearTenantId).Exec(ctx))
+	tnt, err := client.Tenant.Create().SetName("tenant").SetAdminUserID(tenantAdmin.ID).Save(ctx)
+	require.NoError(t, err)
+
+	// 历史版本创建租户管理员凭证时没有写入租户ID
+	cred, err := client.UserCredential.Create().
+		SetUserID(tenantAdmin.ID).
+		SetIdentifier("tenant_admin").
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.UserCredential.UpdateOneID(cred.ID).Modify(clearTenantId).Exec(ctx))
+
+	platformUser, err := client.User.Create().SetUsername("admin").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.User.UpdateOneID(platformUser.ID).Modify(clearTenantId).Exec(ctx))
+	platformCred, err := client.UserCredential.Create().
+		SetUserID(platformUser.ID).
+		SetIdentifier("admin").
+		Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.UserCredential.UpdateOneID(platformCred.ID).Modify(clearTenantId).Exec(ctx))
+
+	r, err := client.Role.Create().SetName("管理员").SetCode("admin").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Role.UpdateOneID(r.ID).Modify(clearTenantId).Exec(ctx))
+	dt, err := client.DictType.Create().SetTypeCode("gender").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.DictType.UpdateOneID(dt.ID).Modify(clearTenantId).Exec(ctx))
+	category, err := client.InternalMessageCategory.Create().SetCode("notice").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.InternalMessageCategory.UpdateOneID(category.ID).Modify(clearTenantId).Exec(ctx))
+	pos, err := client.Position.Create().SetOrganizationID(1).SetDepartmentID(1).SetCode("manager").Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Position.UpdateOneID(pos.ID).Modify(clearTenantId).Exec(ctx))
+
+	// 其他租户的数据保持不变
+	other, err := client.User.Create().SetUsername("other").SetTenantID(5).Save(ctx)
+	require.NoError(t, err)
+
+	// 可以重复执行
+	for i := 0; i < 2; i++ {
+		require.NoError(t, migrateTenantScope(context.Background(), client))
+	}
+
+	tenantOf := func(tid *uint32) uint32 {
+		require.NotNil(t, tid)
+		return *tid
+	}
+
+	u, err := client.User.Get(ctx, tenantAdmin.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tnt.ID, tenantOf(u.TenantID))
+	u, err = client.User.Get(ctx, platformUser.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(u.TenantID))
+	u, err = client.User.Get(ctx, other.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(5), tenantOf(u.TenantID))
+
+	got, err := client.UserCredential.Get(ctx, cred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tnt.ID, tenantOf(got.TenantID))
+	got, err = client.UserCredential.Get(ctx, platformCred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(got.TenantID))
+
+	gotRole, err := client.Role.Get(ctx, r.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(gotRole.TenantID))
+	gotDictType, err := client.DictType.Get(ctx, dt.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(gotDictType.TenantID))
+	gotCategory, err := client.InternalMessageCategory.Get(ctx, category.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(gotCategory.TenantID))
+	gotPosition, err := client.Position.Get(ctx, pos.ID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(0), tenantOf(gotPosition.TenantID))
+
+	n, err := client.UserCredential.Query().
+		Where(usercredential.IdentifierEQ("tenant_admin"), tenantScope(tnt.ID)).
+		Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
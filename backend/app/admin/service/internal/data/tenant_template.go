@@ -0,0 +1,131 @@
+package data
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+// TenantTemplate 新建租户时从平台（租户ID为0）复制的数据，均按编码匹配，平台中不存在的编码会被忽略
+type TenantTemplate struct {
+	Description string `json:"description"`
+
+	// Roles 复制的角色编码，连同角色的菜单与API授权
+	Roles []string `json:"roles"`
+	// AdminRoles 分配给租户管理员的角色编码，必须包含在 Roles 中
+	AdminRoles []string `json:"admin_roles"`
+	// DictTypes 复制的字典类型编码，连同字典条目
+	DictTypes []string `json:"dict_types"`
+	// MessageCategories 复制的站内信分类编码
+	MessageCategories []string `json:"message_categories"`
+}
+
+// TenantTemplateConfig 配置目录中的 tenant 配置
+type TenantTemplateConfig struct {
+	DefaultTemplate string                     `json:"default_template"`
+	Templates       map[string]*TenantTemplate `json:"templates"`
+}
+
+// TenantTemplates 可用的租户模板
+type TenantTemplates struct {
+	defaultName string
+	templates   map[string]*TenantTemplate
+}
+
+// defaultTenantTemplate 没有配置租户模板时使用，与默认数据中的租户管理员角色一致
+var defaultTenantTemplate = &TenantTemplate{
+	Description: "租户管理员角色",
+	Roles:       []string{"tenant_admin"},
+	AdminRoles:  []string{"tenant_admin"},
+}
+
+// NewTenantTemplates 根据配置目录中的 tenant 配置加载租户模板，配置有误或没有配置时只提供内置的默认模板
+func NewTenantTemplates(logger log.Logger) *TenantTemplates {
+	l := log.NewHelper(log.With(logger, "module", "tenant-template/data/admin-service"))
+
+	var cfg TenantTemplateConfig
+	if err := scanConfig("tenant", &cfg); err != nil {
+		l.Errorf("load tenant template config failed: %s", err.Error())
+		return NewTenantTemplatesFromConfig(nil)
+	}
+
+	templates := NewTenantTemplatesFromConfig(&cfg)
+
+	l.Infof("tenant templates: %v, default: %s", templates.Names(), templates.defaultName)
+
+	return templates
+}
+
+// NewTenantTemplatesFromConfig 根据配置创建租户模板。
+// 默认模板不存在时使用名称排序后的第一个模板，没有任何模板时使用内置的默认模板
+func NewTenantTemplatesFromConfig(cfg *TenantTemplateConfig) *TenantTemplates {
+	t := &TenantTemplates{
+		templates: make(map[string]*TenantTemplate),
+	}
+
+	if cfg != nil {
+		for name, tpl := range cfg.Templates {
+			if name == "" || tpl == nil {
+				continue
+			}
+			t.templates[name] = tpl
+		}
+		t.defaultName = cfg.DefaultTemplate
+	}
+
+	if len(t.templates) == 0 {
+		t.templates["default"] = defaultTenantTemplate
+		t.defaultName = "default"
+	}
+
+	if _, ok := t.templates[t.defaultName]; !ok {
+		t.defaultName = t.Names()[0]
+	}
+
+	return t
+}
+
+// Names 返回按名称排序的模板名称
+func (t *TenantTemplates) Names() []string {
+	names := make([]string, 0, len(t.templates))
+	for name := range t.templates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get 获取模板，名称为空时返回默认模板
+func (t *TenantTemplates) Get(name string) (*TenantTemplate, bool) {
+	if name == "" {
+		name = t.defaultName
+	}
+	tpl, ok := t.templates[name]
+	return tpl, ok
+}
+
+// ToProto 转换为接口返回的模板列表
+func (t *TenantTemplates) ToProto() []*adminV1.TenantTemplate {
+	items := make([]*adminV1.TenantTemplate, 0, len(t.templates))
+	for _, name := range t.Names() {
+		tpl := t.templates[name]
+
+		item := &adminV1.TenantTemplate{
+			Name:              name,
+			IsDefault:         name == t.defaultName,
+			Roles:             slices.Clone(tpl.Roles),
+			AdminRoles:        slices.Clone(tpl.AdminRoles),
+			DictTypes:         slices.Clone(tpl.DictTypes),
+			MessageCategories: slices.Clone(tpl.MessageCategories),
+		}
+		if tpl.Description != "" {
+			item.Description = &tpl.Description
+		}
+
+		items = append(items, item)
+	}
+	return items
+}
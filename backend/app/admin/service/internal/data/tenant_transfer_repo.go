@@ -0,0 +1,1066 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-crud/entgo"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/department"
+	"go-wind-admin/app/admin/service/internal/data/ent/dictentry"
+	"go-wind-admin/app/admin/service/internal/data/ent/dicttype"
+	"go-wind-admin/app/admin/service/internal/data/ent/file"
+	"go-wind-admin/app/admin/service/internal/data/ent/organization"
+	"go-wind-admin/app/admin/service/internal/data/ent/position"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenant"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/tenantarchive"
+)
+
+// TenantImportOptions 导入租户数据的参数
+type TenantImportOptions struct {
+	// Conflict 目标租户中已存在同一编码的实体时的处理方式
+	Conflict tenantarchive.Conflict
+	// DryRun 只生成导入报告，不提交任何修改
+	DryRun bool
+
+	OperatorId uint32
+}
+
+// TenantTransferRepo 租户数据的导出与导入，用于在环境之间迁移租户或拆分租户
+type TenantTransferRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTenantTransferRepo(data *Data, logger log.Logger) *TenantTransferRepo {
+	return &TenantTransferRepo{
+		log:  log.NewHelper(log.With(logger, "module", "tenant-transfer/repo/admin-service")),
+		data: data,
+	}
+}
+
+// Export 导出租户的组织、部门、职位、角色、用户、字典与文件元数据。
+// 源数据中指向已删除实体或其他租户的引用会被清除，返回清除的引用说明
+func (r *TenantTransferRepo) Export(ctx context.Context, tenantId uint32) (*tenantarchive.Archive, []string, error) {
+	if tenantId == 0 {
+		return nil, nil, userV1.ErrorBadRequest("platform data can not be exported as a tenant")
+	}
+
+	ctx = viewer.NewSystemViewerContext(ctx)
+	client := r.data.db.Client()
+
+	t, err := client.Tenant.Get(ctx, tenantId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, userV1.ErrorTenantNotFound("tenant not found")
+		}
+		r.log.Errorf("query tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, nil, userV1.ErrorInternalServerError("query tenant failed")
+	}
+
+	archive := tenantarchive.New(tenantarchive.TenantInfo{
+		Id:   t.ID,
+		Code: derefString(t.Code),
+		Name: derefString(t.Name),
+	})
+
+	if err = r.load(ctx, client, tenantId, archive); err != nil {
+		r.log.Errorf("export tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, nil, userV1.ErrorInternalServerError("export tenant data failed")
+	}
+
+	return archive, archive.Prune(), nil
+}
+
+// Import 在一个事务中把归档导入到租户：按编码识别已存在的实体，重新分配ID并转换实体之间的引用。
+// 预演时执行完全相同的写入，最后回滚事务，因此预演报告与实际导入的结果一致
+func (r *TenantTransferRepo) Import(ctx context.Context, tenantId uint32, archive *tenantarchive.Archive, opts *TenantImportOptions) (*tenantarchive.Report, error) {
+	if tenantId == 0 || archive == nil || opts == nil {
+		return nil, userV1.ErrorBadRequest("invalid parameter")
+	}
+
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	exist, err := r.data.db.Client().Tenant.Query().
+		Where(tenant.IDEQ(tenantId)).
+		Exist(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("query tenant failed")
+	}
+	if !exist {
+		return nil, userV1.ErrorTenantNotFound("tenant not found")
+	}
+
+	tx, err := r.data.db.Client().Tx(ctx)
+	if err != nil {
+		r.log.Errorf("start transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("start transaction failed")
+	}
+
+	report, err := r.importArchive(ctx, tx, tenantId, archive, opts)
+	if err != nil {
+		err = entgo.Rollback(tx, err)
+		if ent.IsConstraintError(err) {
+			return nil, userV1.ErrorConflict("tenant data conflicts with existing data: %s", err.Error())
+		}
+		r.log.Errorf("import tenant [%d] failed: %s", tenantId, err.Error())
+		return nil, userV1.ErrorInternalServerError("import tenant data failed")
+	}
+
+	if opts.DryRun {
+		if err = tx.Rollback(); err != nil {
+			r.log.Errorf("rollback transaction failed: %s", err.Error())
+			return nil, userV1.ErrorInternalServerError("rollback transaction failed")
+		}
+		return report, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		r.log.Errorf("commit transaction failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("commit transaction failed")
+	}
+
+	return report, nil
+}
+
+// load 读取租户的全部可导出数据，菜单与API授权转换为各个环境中通用的标识
+func (r *TenantTransferRepo) load(ctx context.Context, c *ent.Client, tenantId uint32, a *tenantarchive.Archive) error {
+	menuKeys, apiKeys, err := r.loadGrantKeys(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	orgs, err := c.Organization.Query().Where(organization.TenantIDEQ(tenantId)).Order(ent.Asc(organization.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, o := range orgs {
+		a.Organizations = append(a.Organizations, &tenantarchive.Organization{
+			Id:               o.ID,
+			ParentId:         o.ParentID,
+			Name:             o.Name,
+			Status:           enumString(o.Status),
+			OrganizationType: enumString(o.OrganizationType),
+			CreditCode:       o.CreditCode,
+			Address:          o.Address,
+			BusinessScope:    o.BusinessScope,
+			IsLegalEntity:    o.IsLegalEntity,
+			ManagerId:        o.ManagerID,
+			SortOrder:        o.SortOrder,
+			Remark:           o.Remark,
+		})
+	}
+
+	depts, err := c.Department.Query().Where(department.TenantIDEQ(tenantId)).Order(ent.Asc(department.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range depts {
+		a.Departments = append(a.Departments, &tenantarchive.Department{
+			Id:             d.ID,
+			ParentId:       d.ParentID,
+			OrganizationId: d.OrganizationID,
+			Name:           d.Name,
+			ManagerId:      d.ManagerID,
+			Status:         enumString(d.Status),
+			Description:    d.Description,
+			SortOrder:      d.SortOrder,
+			Remark:         d.Remark,
+		})
+	}
+
+	positions, err := c.Position.Query().Where(position.TenantIDEQ(tenantId)).Order(ent.Asc(position.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range positions {
+		a.Positions = append(a.Positions, &tenantarchive.Position{
+			Id:             p.ID,
+			ParentId:       p.ParentID,
+			OrganizationId: p.OrganizationID,
+			DepartmentId:   p.DepartmentID,
+			Name:           p.Name,
+			Code:           p.Code,
+			Status:         enumString(p.Status),
+			Description:    p.Description,
+			Quota:          p.Quota,
+			SortOrder:      p.SortOrder,
+			Remark:         p.Remark,
+		})
+	}
+
+	roles, err := c.Role.Query().Where(role.TenantIDEQ(tenantId)).Order(ent.Asc(role.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, ro := range roles {
+		a.Roles = append(a.Roles, &tenantarchive.Role{
+			Id:        ro.ID,
+			ParentId:  ro.ParentID,
+			Name:      ro.Name,
+			Code:      ro.Code,
+			Menus:     grantKeys(ro.Menus, menuKeys),
+			Apis:      grantKeys(ro.Apis, apiKeys),
+			DataScope: enumString(ro.DataScope),
+			Status:    enumString(ro.Status),
+			SortOrder: ro.SortOrder,
+			Remark:    ro.Remark,
+		})
+	}
+
+	users, err := c.User.Query().Where(user.TenantIDEQ(tenantId)).Order(ent.Asc(user.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		roleIds := make([]uint32, 0, len(u.RoleIds))
+		for _, id := range u.RoleIds {
+			roleIds = append(roleIds, uint32(id))
+		}
+
+		a.Users = append(a.Users, &tenantarchive.User{
+			Id:           u.ID,
+			Username:     u.Username,
+			Nickname:     u.Nickname,
+			Realname:     u.Realname,
+			Email:        u.Email,
+			Mobile:       u.Mobile,
+			Telephone:    u.Telephone,
+			Avatar:       u.Avatar,
+			Address:      u.Address,
+			Region:       u.Region,
+			Language:     u.Language,
+			Description:  u.Description,
+			Gender:       enumString(u.Gender),
+			Authority:    enumString(u.Authority),
+			Status:       enumString(u.Status),
+			OrgId:        u.OrgID,
+			DepartmentId: u.DepartmentID,
+			PositionId:   u.PositionID,
+			WorkId:       u.WorkID,
+			RoleIds:      roleIds,
+			Remark:       u.Remark,
+		})
+	}
+
+	dictTypes, err := c.DictType.Query().
+		Where(dicttype.TenantIDEQ(tenantId)).
+		WithEntries(func(q *ent.DictEntryQuery) {
+			q.Order(ent.Asc(dictentry.FieldID))
+		}).
+		Order(ent.Asc(dicttype.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range dictTypes {
+		item := &tenantarchive.DictType{
+			Id:          t.ID,
+			TypeCode:    t.TypeCode,
+			TypeName:    t.TypeName,
+			IsEnabled:   t.IsEnabled,
+			SortOrder:   t.SortOrder,
+			Description: t.Description,
+		}
+		for _, e := range t.Edges.Entries {
+			item.Entries = append(item.Entries, &tenantarchive.DictEntry{
+				EntryLabel:   e.EntryLabel,
+				EntryValue:   e.EntryValue,
+				NumericValue: e.NumericValue,
+				LanguageCode: e.LanguageCode,
+				Description:  e.Description,
+				SortOrder:    e.SortOrder,
+				IsEnabled:    e.IsEnabled,
+			})
+		}
+		a.DictTypes = append(a.DictTypes, item)
+	}
+
+	files, err := c.File.Query().Where(file.TenantIDEQ(tenantId)).Order(ent.Asc(file.FieldID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		a.Files = append(a.Files, &tenantarchive.File{
+			Id:            f.ID,
+			Provider:      enumString(f.Provider),
+			BucketName:    f.BucketName,
+			FileDirectory: f.FileDirectory,
+			FileGuid:      f.FileGUID,
+			SaveFileName:  f.SaveFileName,
+			FileName:      f.FileName,
+			Extension:     f.Extension,
+			Size:          f.Size,
+			SizeFormat:    f.SizeFormat,
+			LinkUrl:       f.LinkURL,
+			Md5:           f.Md5,
+			Remark:        f.Remark,
+		})
+	}
+
+	return nil
+}
+
+// loadGrantKeys 读取菜单与API的ID到通用标识的映射，菜单与API不属于租户
+func (r *TenantTransferRepo) loadGrantKeys(ctx context.Context, c *ent.Client) (menuKeys, apiKeys map[uint32]string, err error) {
+	menus, err := c.Menu.Query().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	menuKeys = make(map[uint32]string, len(menus))
+	for _, m := range menus {
+		if key := tenantarchive.MenuKey(m.Name, m.Path); key != "" {
+			menuKeys[m.ID] = key
+		}
+	}
+
+	apis, err := c.ApiResource.Query().All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	apiKeys = make(map[uint32]string, len(apis))
+	for _, api := range apis {
+		if key := tenantarchive.ApiKey(api.Method, api.Path); key != "" {
+			apiKeys[api.ID] = key
+		}
+	}
+
+	return menuKeys, apiKeys, nil
+}
+
+// importArchive 在事务中导入归档，出错时由调用方回滚
+func (r *TenantTransferRepo) importArchive(ctx context.Context, tx *ent.Tx, tenantId uint32, a *tenantarchive.Archive, opts *TenantImportOptions) (*tenantarchive.Report, error) {
+	conflict, err := tenantarchive.ParseConflict(string(opts.Conflict))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = a.Validate(); err != nil {
+		return nil, err
+	}
+
+	menuKeys, apiKeys, err := r.loadGrantKeys(ctx, tx.Client())
+	if err != nil {
+		return nil, err
+	}
+
+	existing := &tenantarchive.Archive{}
+	if err = r.load(ctx, tx.Client(), tenantId, existing); err != nil {
+		return nil, err
+	}
+
+	imp := &tenantImporter{
+		tx:       tx,
+		tenantId: tenantId,
+		archive:  a,
+		conflict: conflict,
+		operator: opts.OperatorId,
+		now:      time.Now(),
+		ids:      make(tenantarchive.IdMap),
+		written:  make(map[string]map[uint32]bool),
+		menuIds:  invertKeys(menuKeys),
+		apiIds:   invertKeys(apiKeys),
+		report:   tenantarchive.NewReport(a, tenantId, conflict, opts.DryRun),
+	}
+
+	steps := []struct {
+		entity string
+		run    func(ctx context.Context, existing *tenantarchive.Archive) error
+	}{
+		{tenantarchive.EntityOrganization, imp.importOrganizations},
+		{tenantarchive.EntityDepartment, imp.importDepartments},
+		{tenantarchive.EntityPosition, imp.importPositions},
+		{tenantarchive.EntityRole, imp.importRoles},
+		{tenantarchive.EntityUser, imp.importUsers},
+		{tenantarchive.EntityOrganization, imp.linkManagers},
+		{tenantarchive.EntityDictType, imp.importDictTypes},
+		{tenantarchive.EntityFile, imp.importFiles},
+	}
+	for _, step := range steps {
+		if err = step.run(ctx, existing); err != nil {
+			return nil, fmt.Errorf("import %s: %w", step.entity, err)
+		}
+	}
+
+	return imp.report, nil
+}
+
+// tenantImporter 一次导入的状态
+type tenantImporter struct {
+	tx       *ent.Tx
+	tenantId uint32
+	archive  *tenantarchive.Archive
+	conflict tenantarchive.Conflict
+	operator uint32
+	now      time.Time
+
+	// ids 归档中的ID到目标租户中ID的映射
+	ids tenantarchive.IdMap
+	// written 新建或覆盖的实体，只有这些实体需要补充引用
+	written map[string]map[uint32]bool
+
+	menuIds map[string]uint32
+	apiIds  map[string]uint32
+
+	report *tenantarchive.Report
+}
+
+// apply 按标识匹配目标租户中已存在的实体：不存在时新建，存在时按冲突处理方式覆盖或跳过，并记录新旧ID的映射
+func (imp *tenantImporter) apply(
+	ctx context.Context,
+	entity, key string,
+	sourceId uint32,
+	existing map[string]uint32,
+	create func(ctx context.Context) (uint32, error),
+	update func(ctx context.Context, targetId uint32) error,
+) error {
+	targetId, found := existing[key]
+	if key == "" {
+		found = false
+	}
+
+	action := tenantarchive.ActionSkip
+	switch {
+	case !found:
+		id, err := create(ctx)
+		if err != nil {
+			return fmt.Errorf("%s [%s]: %w", entity, key, err)
+		}
+		targetId = id
+		action = tenantarchive.ActionCreate
+
+	case imp.conflict == tenantarchive.ConflictOverwrite:
+		if err := update(ctx, targetId); err != nil {
+			return fmt.Errorf("%s [%s]: %w", entity, key, err)
+		}
+		action = tenantarchive.ActionUpdate
+	}
+
+	imp.ids.Set(entity, sourceId, targetId)
+	if action != tenantarchive.ActionSkip {
+		if imp.written[entity] == nil {
+			imp.written[entity] = make(map[uint32]bool)
+		}
+		imp.written[entity][sourceId] = true
+	}
+	imp.report.Add(entity, key, sourceId, targetId, action)
+
+	return nil
+}
+
+func (imp *tenantImporter) importOrganizations(ctx context.Context, existing *tenantarchive.Archive) error {
+	keys := tenantarchive.UniqueKeys(imp.archive.OrganizationKeys())
+	targets := invertKeys(tenantarchive.UniqueKeys(existing.OrganizationKeys()))
+
+	byId := make(map[uint32]*tenantarchive.Organization, len(imp.archive.Organizations))
+	ids := make([]uint32, 0, len(imp.archive.Organizations))
+	for _, o := range imp.archive.Organizations {
+		byId[o.Id] = o
+		ids = append(ids, o.Id)
+	}
+
+	for _, id := range tenantarchive.TreeOrder(ids, func(id uint32) *uint32 { return byId[id].ParentId }) {
+		o := byId[id]
+		if err := imp.apply(ctx, tenantarchive.EntityOrganization, keys[o.Id], o.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.Organization.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityOrganization, o.ParentId)).
+					SetNillableName(o.Name).
+					SetNillableStatus(enumValue[organization.Status](o.Status)).
+					SetNillableOrganizationType(enumValue[organization.OrganizationType](o.OrganizationType)).
+					SetNillableCreditCode(o.CreditCode).
+					SetNillableAddress(o.Address).
+					SetNillableBusinessScope(o.BusinessScope).
+					SetNillableIsLegalEntity(o.IsLegalEntity).
+					SetNillableSortOrder(o.SortOrder).
+					SetNillableRemark(o.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				return imp.tx.Organization.UpdateOneID(targetId).
+					SetNillableStatus(enumValue[organization.Status](o.Status)).
+					SetNillableOrganizationType(enumValue[organization.OrganizationType](o.OrganizationType)).
+					SetNillableCreditCode(o.CreditCode).
+					SetNillableAddress(o.Address).
+					SetNillableBusinessScope(o.BusinessScope).
+					SetNillableIsLegalEntity(o.IsLegalEntity).
+					SetNillableSortOrder(o.SortOrder).
+					SetNillableRemark(o.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importDepartments(ctx context.Context, existing *tenantarchive.Archive) error {
+	keys := tenantarchive.UniqueKeys(imp.archive.DepartmentKeys())
+	targets := invertKeys(tenantarchive.UniqueKeys(existing.DepartmentKeys()))
+
+	byId := make(map[uint32]*tenantarchive.Department, len(imp.archive.Departments))
+	ids := make([]uint32, 0, len(imp.archive.Departments))
+	for _, d := range imp.archive.Departments {
+		byId[d.Id] = d
+		ids = append(ids, d.Id)
+	}
+
+	for _, id := range tenantarchive.TreeOrder(ids, func(id uint32) *uint32 { return byId[id].ParentId }) {
+		d := byId[id]
+
+		// 部门必须属于一个组织
+		orgId := imp.ids.Ref(tenantarchive.EntityOrganization, d.OrganizationId)
+		if orgId == nil {
+			imp.report.Warn("部门[%s]没有所属的组织，已跳过", derefString(d.Name))
+			continue
+		}
+
+		if err := imp.apply(ctx, tenantarchive.EntityDepartment, keys[d.Id], d.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.Department.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityDepartment, d.ParentId)).
+					SetOrganizationID(*orgId).
+					SetNillableName(d.Name).
+					SetNillableStatus(enumValue[department.Status](d.Status)).
+					SetNillableDescription(d.Description).
+					SetNillableSortOrder(d.SortOrder).
+					SetNillableRemark(d.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				return imp.tx.Department.UpdateOneID(targetId).
+					SetNillableStatus(enumValue[department.Status](d.Status)).
+					SetNillableDescription(d.Description).
+					SetNillableSortOrder(d.SortOrder).
+					SetNillableRemark(d.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importPositions(ctx context.Context, existing *tenantarchive.Archive) error {
+	targets := make(map[string]uint32, len(existing.Positions))
+	for _, p := range existing.Positions {
+		targets[derefString(p.Code)] = p.Id
+	}
+
+	byId := make(map[uint32]*tenantarchive.Position, len(imp.archive.Positions))
+	ids := make([]uint32, 0, len(imp.archive.Positions))
+	for _, p := range imp.archive.Positions {
+		byId[p.Id] = p
+		ids = append(ids, p.Id)
+	}
+
+	for _, id := range tenantarchive.TreeOrder(ids, func(id uint32) *uint32 { return byId[id].ParentId }) {
+		p := byId[id]
+
+		// 职位必须属于一个组织与部门
+		orgId := imp.ids.Ref(tenantarchive.EntityOrganization, p.OrganizationId)
+		deptId := imp.ids.Ref(tenantarchive.EntityDepartment, p.DepartmentId)
+		if orgId == nil || deptId == nil {
+			imp.report.Warn("职位[%s]没有所属的组织或部门，已跳过", derefString(p.Code))
+			continue
+		}
+
+		if err := imp.apply(ctx, tenantarchive.EntityPosition, derefString(p.Code), p.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.Position.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityPosition, p.ParentId)).
+					SetOrganizationID(*orgId).
+					SetDepartmentID(*deptId).
+					SetNillableName(p.Name).
+					SetNillableCode(p.Code).
+					SetNillableStatus(enumValue[position.Status](p.Status)).
+					SetNillableDescription(p.Description).
+					SetNillableQuota(p.Quota).
+					SetNillableSortOrder(p.SortOrder).
+					SetNillableRemark(p.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				return imp.tx.Position.UpdateOneID(targetId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityPosition, p.ParentId)).
+					SetOrganizationID(*orgId).
+					SetDepartmentID(*deptId).
+					SetNillableName(p.Name).
+					SetNillableStatus(enumValue[position.Status](p.Status)).
+					SetNillableDescription(p.Description).
+					SetNillableQuota(p.Quota).
+					SetNillableSortOrder(p.SortOrder).
+					SetNillableRemark(p.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importRoles(ctx context.Context, existing *tenantarchive.Archive) error {
+	targets := make(map[string]uint32, len(existing.Roles))
+	for _, ro := range existing.Roles {
+		targets[derefString(ro.Code)] = ro.Id
+	}
+
+	byId := make(map[uint32]*tenantarchive.Role, len(imp.archive.Roles))
+	ids := make([]uint32, 0, len(imp.archive.Roles))
+	var menus, apis []string
+	for _, ro := range imp.archive.Roles {
+		byId[ro.Id] = ro
+		ids = append(ids, ro.Id)
+		menus = append(menus, ro.Menus...)
+		apis = append(apis, ro.Apis...)
+	}
+
+	// 目标环境中不存在的菜单与API授权被忽略
+	if missing := tenantarchive.MissingKeys(menus, imp.menuIds); len(missing) > 0 {
+		imp.report.Warn("目标环境中不存在以下菜单，相应的授权已忽略: %s", strings.Join(missing, ", "))
+	}
+	if missing := tenantarchive.MissingKeys(apis, imp.apiIds); len(missing) > 0 {
+		imp.report.Warn("目标环境中不存在以下API，相应的授权已忽略: %s", strings.Join(missing, ", "))
+	}
+
+	for _, id := range tenantarchive.TreeOrder(ids, func(id uint32) *uint32 { return byId[id].ParentId }) {
+		ro := byId[id]
+		menuIds := grantIds(ro.Menus, imp.menuIds)
+		apiIds := grantIds(ro.Apis, imp.apiIds)
+
+		if err := imp.apply(ctx, tenantarchive.EntityRole, derefString(ro.Code), ro.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.Role.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityRole, ro.ParentId)).
+					SetNillableName(ro.Name).
+					SetNillableCode(ro.Code).
+					SetMenus(menuIds).
+					SetApis(apiIds).
+					SetNillableDataScope(enumValue[role.DataScope](ro.DataScope)).
+					SetNillableStatus(enumValue[role.Status](ro.Status)).
+					SetNillableSortOrder(ro.SortOrder).
+					SetNillableRemark(ro.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				return imp.tx.Role.UpdateOneID(targetId).
+					SetNillableParentID(imp.ids.Ref(tenantarchive.EntityRole, ro.ParentId)).
+					SetNillableName(ro.Name).
+					SetMenus(menuIds).
+					SetApis(apiIds).
+					SetNillableDataScope(enumValue[role.DataScope](ro.DataScope)).
+					SetNillableStatus(enumValue[role.Status](ro.Status)).
+					SetNillableSortOrder(ro.SortOrder).
+					SetNillableRemark(ro.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importUsers(ctx context.Context, existing *tenantarchive.Archive) error {
+	targets := make(map[string]uint32, len(existing.Users))
+	for _, u := range existing.Users {
+		targets[derefString(u.Username)] = u.Id
+	}
+
+	for _, u := range imp.archive.Users {
+		roleIds := make([]int, 0, len(u.RoleIds))
+		for _, id := range imp.ids.Refs(tenantarchive.EntityRole, u.RoleIds) {
+			roleIds = append(roleIds, int(id))
+		}
+
+		// 租户中的用户不能是系统管理员
+		authority := enumValue[user.Authority](u.Authority)
+		if authority != nil && *authority == user.AuthoritySysAdmin {
+			imp.report.Warn("用户[%s]是系统管理员，已改为普通用户", derefString(u.Username))
+			authority = nil
+		}
+
+		if err := imp.apply(ctx, tenantarchive.EntityUser, derefString(u.Username), u.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.User.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableUsername(u.Username).
+					SetNillableNickname(u.Nickname).
+					SetNillableRealname(u.Realname).
+					SetNillableEmail(u.Email).
+					SetNillableMobile(u.Mobile).
+					SetNillableTelephone(u.Telephone).
+					SetNillableAvatar(u.Avatar).
+					SetNillableAddress(u.Address).
+					SetNillableRegion(u.Region).
+					SetNillableLanguage(u.Language).
+					SetNillableDescription(u.Description).
+					SetNillableGender(enumValue[user.Gender](u.Gender)).
+					SetNillableAuthority(authority).
+					SetNillableStatus(enumValue[user.Status](u.Status)).
+					SetNillableOrgID(imp.ids.Ref(tenantarchive.EntityOrganization, u.OrgId)).
+					SetNillableDepartmentID(imp.ids.Ref(tenantarchive.EntityDepartment, u.DepartmentId)).
+					SetNillablePositionID(imp.ids.Ref(tenantarchive.EntityPosition, u.PositionId)).
+					SetNillableWorkID(u.WorkId).
+					SetRoleIds(roleIds).
+					SetNillableRemark(u.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				builder := imp.tx.User.UpdateOneID(targetId).
+					SetNillableNickname(u.Nickname).
+					SetNillableRealname(u.Realname).
+					SetNillableEmail(u.Email).
+					SetNillableMobile(u.Mobile).
+					SetNillableTelephone(u.Telephone).
+					SetNillableAvatar(u.Avatar).
+					SetNillableAddress(u.Address).
+					SetNillableRegion(u.Region).
+					SetNillableLanguage(u.Language).
+					SetNillableDescription(u.Description).
+					SetNillableGender(enumValue[user.Gender](u.Gender)).
+					SetNillableStatus(enumValue[user.Status](u.Status)).
+					SetNillableOrgID(imp.ids.Ref(tenantarchive.EntityOrganization, u.OrgId)).
+					SetNillableDepartmentID(imp.ids.Ref(tenantarchive.EntityDepartment, u.DepartmentId)).
+					SetNillablePositionID(imp.ids.Ref(tenantarchive.EntityPosition, u.PositionId)).
+					SetNillableWorkID(u.WorkId).
+					SetNillableRemark(u.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now)
+				if len(roleIds) > 0 {
+					builder.SetRoleIds(roleIds)
+				}
+				return builder.Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if n := imp.report.Count(tenantarchive.EntityUser, tenantarchive.ActionCreate); n > 0 {
+		imp.report.Warn("新建的%d个用户没有登录凭证，需要重置密码后才能登录", n)
+	}
+
+	return nil
+}
+
+// linkManagers 用户导入后补充新建或覆盖的组织与部门的负责人
+func (imp *tenantImporter) linkManagers(ctx context.Context, _ *tenantarchive.Archive) error {
+	for _, o := range imp.archive.Organizations {
+		managerId := imp.ids.Ref(tenantarchive.EntityUser, o.ManagerId)
+		if managerId == nil || !imp.written[tenantarchive.EntityOrganization][o.Id] {
+			continue
+		}
+		targetId, _ := imp.ids.Get(tenantarchive.EntityOrganization, o.Id)
+		if err := imp.tx.Organization.UpdateOneID(targetId).
+			SetManagerID(*managerId).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range imp.archive.Departments {
+		managerId := imp.ids.Ref(tenantarchive.EntityUser, d.ManagerId)
+		if managerId == nil || !imp.written[tenantarchive.EntityDepartment][d.Id] {
+			continue
+		}
+		targetId, _ := imp.ids.Get(tenantarchive.EntityDepartment, d.Id)
+		if err := imp.tx.Department.UpdateOneID(targetId).
+			SetManagerID(*managerId).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importDictTypes(ctx context.Context, existing *tenantarchive.Archive) error {
+	targets := make(map[string]uint32, len(existing.DictTypes))
+	entries := make(map[uint32]map[string]bool, len(existing.DictTypes))
+	for _, t := range existing.DictTypes {
+		targets[derefString(t.TypeCode)] = t.Id
+		values := make(map[string]bool, len(t.Entries))
+		for _, e := range t.Entries {
+			values[derefString(e.EntryValue)] = true
+		}
+		entries[t.Id] = values
+	}
+
+	for _, t := range imp.archive.DictTypes {
+		if err := imp.apply(ctx, tenantarchive.EntityDictType, derefString(t.TypeCode), t.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.DictType.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableTypeCode(t.TypeCode).
+					SetNillableTypeName(t.TypeName).
+					SetNillableIsEnabled(t.IsEnabled).
+					SetNillableSortOrder(t.SortOrder).
+					SetNillableDescription(t.Description).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, imp.importDictEntries(ctx, entity.ID, t, nil)
+			},
+			func(ctx context.Context, targetId uint32) error {
+				if err := imp.tx.DictType.UpdateOneID(targetId).
+					SetNillableTypeName(t.TypeName).
+					SetNillableIsEnabled(t.IsEnabled).
+					SetNillableSortOrder(t.SortOrder).
+					SetNillableDescription(t.Description).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx); err != nil {
+					return err
+				}
+				return imp.importDictEntries(ctx, targetId, t, entries[targetId])
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importDictEntries 导入字典条目，字典类型中已存在的条目值按条目值覆盖
+func (imp *tenantImporter) importDictEntries(ctx context.Context, typeId uint32, t *tenantarchive.DictType, existing map[string]bool) error {
+	for _, e := range t.Entries {
+		key := derefString(t.TypeCode) + ":" + derefString(e.EntryValue)
+
+		if existing[derefString(e.EntryValue)] {
+			if _, err := imp.tx.DictEntry.Update().
+				Where(
+					dictentry.HasSysDictTypesWith(dicttype.IDEQ(typeId)),
+					dictentry.EntryValueEQ(derefString(e.EntryValue)),
+				).
+				SetNillableEntryLabel(e.EntryLabel).
+				SetNillableNumericValue(e.NumericValue).
+				SetNillableLanguageCode(e.LanguageCode).
+				SetNillableDescription(e.Description).
+				SetNillableSortOrder(e.SortOrder).
+				SetNillableIsEnabled(e.IsEnabled).
+				SetUpdatedBy(imp.operator).
+				SetUpdatedAt(imp.now).
+				Save(ctx); err != nil {
+				return err
+			}
+			imp.report.Add(tenantarchive.EntityDictEntry, key, 0, 0, tenantarchive.ActionUpdate)
+			continue
+		}
+
+		entity, err := imp.tx.DictEntry.Create().
+			SetTenantID(imp.tenantId).
+			SetSysDictTypesID(typeId).
+			SetNillableEntryLabel(e.EntryLabel).
+			SetNillableEntryValue(e.EntryValue).
+			SetNillableNumericValue(e.NumericValue).
+			SetNillableLanguageCode(e.LanguageCode).
+			SetNillableDescription(e.Description).
+			SetNillableSortOrder(e.SortOrder).
+			SetNillableIsEnabled(e.IsEnabled).
+			SetCreatedBy(imp.operator).
+			SetCreatedAt(imp.now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		imp.report.Add(tenantarchive.EntityDictEntry, key, 0, entity.ID, tenantarchive.ActionCreate)
+	}
+
+	return nil
+}
+
+func (imp *tenantImporter) importFiles(ctx context.Context, existing *tenantarchive.Archive) error {
+	targets := make(map[string]uint32, len(existing.Files))
+	for _, f := range existing.Files {
+		targets[tenantarchive.FileKey(f)] = f.Id
+	}
+
+	keys := make(map[uint32]string, len(imp.archive.Files))
+	for _, f := range imp.archive.Files {
+		keys[f.Id] = tenantarchive.FileKey(f)
+	}
+	keys = tenantarchive.UniqueKeys(keys)
+
+	for _, f := range imp.archive.Files {
+		if err := imp.apply(ctx, tenantarchive.EntityFile, keys[f.Id], f.Id, targets,
+			func(ctx context.Context) (uint32, error) {
+				entity, err := imp.tx.File.Create().
+					SetTenantID(imp.tenantId).
+					SetNillableProvider(enumValue[file.Provider](f.Provider)).
+					SetNillableBucketName(f.BucketName).
+					SetNillableFileDirectory(f.FileDirectory).
+					SetNillableFileGUID(f.FileGuid).
+					SetNillableSaveFileName(f.SaveFileName).
+					SetNillableFileName(f.FileName).
+					SetNillableExtension(f.Extension).
+					SetNillableSize(f.Size).
+					SetNillableSizeFormat(f.SizeFormat).
+					SetNillableLinkURL(f.LinkUrl).
+					SetNillableMd5(f.Md5).
+					SetNillableRemark(f.Remark).
+					SetCreatedBy(imp.operator).
+					SetCreatedAt(imp.now).
+					Save(ctx)
+				if err != nil {
+					return 0, err
+				}
+				return entity.ID, nil
+			},
+			func(ctx context.Context, targetId uint32) error {
+				return imp.tx.File.UpdateOneID(targetId).
+					SetNillableProvider(enumValue[file.Provider](f.Provider)).
+					SetNillableBucketName(f.BucketName).
+					SetNillableFileDirectory(f.FileDirectory).
+					SetNillableSaveFileName(f.SaveFileName).
+					SetNillableFileName(f.FileName).
+					SetNillableExtension(f.Extension).
+					SetNillableSize(f.Size).
+					SetNillableSizeFormat(f.SizeFormat).
+					SetNillableLinkURL(f.LinkUrl).
+					SetNillableMd5(f.Md5).
+					SetNillableRemark(f.Remark).
+					SetUpdatedBy(imp.operator).
+					SetUpdatedAt(imp.now).
+					Exec(ctx)
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	if n := imp.report.Count(tenantarchive.EntityFile, tenantarchive.ActionCreate); n > 0 {
+		imp.report.Warn("导入了%d个文件的元数据，对象存储中的文件需要另外复制到目标环境", n)
+	}
+
+	return nil
+}
+
+// grantKeys 把菜单或API的ID转换为通用标识，找不到的ID被忽略
+func grantKeys(ids []uint32, keys map[uint32]string) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if key, ok := keys[id]; ok {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// grantIds 把菜单或API的通用标识转换为目标环境中的ID，找不到的标识被忽略
+func grantIds(keys []string, ids map[string]uint32) []uint32 {
+	result := make([]uint32, 0, len(keys))
+	for _, key := range keys {
+		if id, ok := ids[key]; ok {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// invertKeys 把ID到标识的映射转换为标识到ID的映射，同一标识对应多个ID时使用最小的ID
+func invertKeys(keys map[uint32]string) map[string]uint32 {
+	ids := make([]uint32, 0, len(keys))
+	for id := range keys {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	result := make(map[string]uint32, len(keys))
+	for _, id := range ids {
+		if _, ok := result[keys[id]]; !ok {
+			result[keys[id]] = id
+		}
+	}
+	return result
+}
+
+// enumString 把实体的枚举值转换为字符串
+func enumString[T ~string](v *T) *string {
+	if v == nil {
+		return nil
+	}
+	s := string(*v)
+	return &s
+}
+
+// enumValue 把字符串转换为实体的枚举值，空字符串视为没有值
+func enumValue[T ~string](v *string) *T {
+	if v == nil || *v == "" {
+		return nil
+	}
+	e := T(*v)
+	return &e
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go-wind-admin/app/admin/service/internal/data/ent/enttest"
+	"go-wind-admin/app/admin/service/internal/data/ent/role"
+	"go-wind-admin/app/admin/service/internal/data/ent/user"
+
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/tenantarchive"
+)
+
+func TestTenantTransferExportImport(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant_transfer?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+
+	ctx := viewer.NewSystemViewerContext(context.Background())
+	r := &TenantTransferRepo{log: log.NewHelper(log.DefaultLogger)}
+
+	const (
+		sourceTenant = uint32(3)
+		targetTenant = uint32(7)
+	)
+
+	// 全局的菜单与API
+	menu, err := client.Menu.Create().SetName("System").SetPath("/system").Save(ctx)
+	require.NoError(t, err)
+	api, err := client.ApiResource.Create().SetMethod("GET").SetPath("/admin/v1/users").Save(ctx)
+	require.NoError(t, err)
+
+	// 源租户的数据
+	org, err := client.Organization.Create().SetTenantID(sourceTenant).SetName("总部").Save(ctx)
+	require.NoError(t, err)
+	dept, err := client.Department.Create().SetTenantID(sourceTenant).SetOrganizationID(org.ID).SetName("研发部").Save(ctx)
+	require.NoError(t, err)
+	pos, err := client.Position.Create().SetTenantID(sourceTenant).SetOrganizationID(org.ID).SetDepartmentID(dept.ID).SetName("工程师").SetCode("engineer").Save(ctx)
+	require.NoError(t, err)
+	admin, err := client.Role.Create().SetTenantID(sourceTenant).SetName("管理员").SetCode("admin").SetMenus([]uint32{menu.ID}).SetApis([]uint32{api.ID}).Save(ctx)
+	require.NoError(t, err)
+	auditor, err := client.Role.Create().SetTenantID(sourceTenant).SetName("审计员").SetCode("auditor").SetParentID(admin.ID).Save(ctx)
+	require.NoError(t, err)
+	alice, err := client.User.Create().SetTenantID(sourceTenant).SetUsername("alice").SetNickname("Alice").
+		SetOrgID(org.ID).SetDepartmentID(dept.ID).SetPositionID(pos.ID).SetRoleIds([]int{int(admin.ID)}).
+		SetAuthority(user.AuthoritySysAdmin).Save(ctx)
+	require.NoError(t, err)
+	_, err = client.User.Create().SetTenantID(sourceTenant).SetUsername("bob").SetRoleIds([]int{int(auditor.ID)}).Save(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Organization.UpdateOneID(org.ID).SetManagerID(alice.ID).Exec(ctx))
+	gender, err := client.DictType.Create().SetTenantID(sourceTenant).SetTypeCode("gender").SetTypeName("性别").Save(ctx)
+	require.NoError(t, err)
+	for _, v := range []string{"MALE", "FEMALE"} {
+		_, err = client.DictEntry.Create().SetTenantID(sourceTenant).SetSysDictTypesID(gender.ID).SetEntryLabel(v).SetEntryValue(v).Save(ctx)
+		require.NoError(t, err)
+	}
+	_, err = client.File.Create().SetTenantID(sourceTenant).SetFileGUID("guid-1").SetFileName("a.png").Save(ctx)
+	require.NoError(t, err)
+
+	// 目标租户中已存在同编码的角色
+	existing, err := client.Role.Create().SetTenantID(targetTenant).SetName("已有管理员").SetCode("admin").Save(ctx)
+	require.NoError(t, err)
+
+	archive := tenantarchive.New(tenantarchive.TenantInfo{Id: sourceTenant, Code: "acme"})
+	require.NoError(t, r.load(ctx, client, sourceTenant, archive))
+	assert.Empty(t, archive.Prune())
+	require.Len(t, archive.Roles, 2)
+	assert.Equal(t, []string{"System"}, archive.Roles[0].Menus)
+	assert.Equal(t, []string{"GET /admin/v1/users"}, archive.Roles[0].Apis)
+
+	importArchive := func(opts *TenantImportOptions, commit bool) *tenantarchive.Report {
+		tx, err := client.Tx(ctx)
+		require.NoError(t, err)
+		report, err := r.importArchive(ctx, tx, targetTenant, archive, opts)
+		require.NoError(t, err)
+		if commit {
+			require.NoError(t, tx.Commit())
+		} else {
+			require.NoError(t, tx.Rollback())
+		}
+		return report
+	}
+
+	// 预演不修改数据
+	report := importArchive(&TenantImportOptions{Conflict: tenantarchive.ConflictSkip, DryRun: true}, false)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, 1, report.Count(tenantarchive.EntityRole, tenantarchive.ActionSkip))
+	assert.Equal(t, 1, report.Count(tenantarchive.EntityRole, tenantarchive.ActionCreate))
+	assert.Equal(t, 2, report.Count(tenantarchive.EntityUser, tenantarchive.ActionCreate))
+	assert.Equal(t, 2, report.Count(tenantarchive.EntityDictEntry, tenantarchive.ActionCreate))
+	assert.NotEmpty(t, report.Warnings)
+
+	count, err := client.User.Query().Where(user.TenantIDEQ(targetTenant)).Count(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// 跳过已存在的角色，引用重新映射到目标租户中的ID
+	report = importArchive(&TenantImportOptions{Conflict: tenantarchive.ConflictSkip}, true)
+	assert.False(t, report.DryRun)
+
+	importedAlice, err := client.User.Query().Where(user.TenantIDEQ(targetTenant), user.UsernameEQ("alice")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []int{int(existing.ID)}, importedAlice.RoleIds)
+	assert.NotEqual(t, user.AuthoritySysAdmin, *importedAlice.Authority)
+	assert.NotEqual(t, org.ID, *importedAlice.OrgID)
+
+	importedOrg, err := client.Organization.Get(ctx, *importedAlice.OrgID)
+	require.NoError(t, err)
+	assert.Equal(t, targetTenant, *importedOrg.TenantID)
+	assert.Equal(t, importedAlice.ID, *importedOrg.ManagerID)
+
+	importedAuditor, err := client.Role.Query().Where(role.TenantIDEQ(targetTenant), role.CodeEQ("auditor")).Only(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, existing.ID, *importedAuditor.ParentID)
+
+	kept, err := client.Role.Get(ctx, existing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "已有管理员", *kept.Name)
+	assert.Empty(t, kept.Menus)
+
+	// 再次导入时全部按编码识别，覆盖已存在的实体
+	report = importArchive(&TenantImportOptions{Conflict: tenantarchive.ConflictOverwrite}, true)
+	assert.Zero(t, report.Count(tenantarchive.EntityUser, tenantarchive.ActionCreate))
+	assert.Equal(t, 2, report.Count(tenantarchive.EntityUser, tenantarchive.ActionUpdate))
+	assert.Equal(t, 2, report.Count(tenantarchive.EntityDictEntry, tenantarchive.ActionUpdate))
+	assert.Equal(t, 1, report.Count(tenantarchive.EntityFile, tenantarchive.ActionUpdate))
+
+	overwritten, err := client.Role.Get(ctx, existing.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "管理员", *overwritten.Name)
+	assert.Equal(t, []uint32{menu.ID}, overwritten.Menus)
+	assert.Equal(t, []uint32{api.ID}, overwritten.Apis)
+
+	count, err = client.User.Query().Where(user.TenantIDEQ(targetTenant)).Count(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// 源租户的数据不受影响
+	sourceRoles, err := client.Role.Query().Where(role.TenantIDEQ(sourceTenant)).All(ctx)
+	require.NoError(t, err)
+	assert.Len(t, sourceRoles, 2)
+}
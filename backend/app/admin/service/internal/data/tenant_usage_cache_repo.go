@@ -0,0 +1,131 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+
+	"go-wind-admin/pkg/metering"
+)
+
+const (
+	tenantUsageApiKeyPrefix       = "tenant:usage:api:"
+	tenantUsageUsersKeyPrefix     = "tenant:usage:users:"
+	tenantUsageThresholdKeyPrefix = "tenant:usage:threshold:"
+
+	// tenantUsageCounterExpires 每小时的请求计数汇总后仍可能需要重新汇总，保留两天
+	tenantUsageCounterExpires = 48 * time.Hour
+
+	// tenantUsageHourLayout 请求计数按小时分桶的键格式
+	tenantUsageHourLayout = "2006010215"
+)
+
+// TenantHourCounters 租户一个小时内的请求计数
+type TenantHourCounters struct {
+	// Operations 各接口的调用次数，键为接口的 operation
+	Operations map[string]uint64
+
+	// ActiveUsers 发起过请求的不同用户数
+	ActiveUsers uint32
+}
+
+// TenantUsageCacheRepo 租户用量的请求计数，每个请求按小时累加到Redis中，由用量汇总任务写入用量记录
+type TenantUsageCacheRepo struct {
+	log *log.Helper
+
+	rdb *redis.Client
+
+	apiKeyPrefix       string
+	usersKeyPrefix     string
+	thresholdKeyPrefix string
+	counterExpires     time.Duration
+}
+
+func NewTenantUsageCacheRepo(logger log.Logger, rdb *redis.Client) *TenantUsageCacheRepo {
+	return &TenantUsageCacheRepo{
+		log:                log.NewHelper(log.With(logger, "module", "tenant-usage/cache")),
+		rdb:                rdb,
+		apiKeyPrefix:       tenantUsageApiKeyPrefix,
+		usersKeyPrefix:     tenantUsageUsersKeyPrefix,
+		thresholdKeyPrefix: tenantUsageThresholdKeyPrefix,
+		counterExpires:     tenantUsageCounterExpires,
+	}
+}
+
+func (r *TenantUsageCacheRepo) makeApiKey(tenantId uint32, hour time.Time) string {
+	return fmt.Sprintf("%s%d:%s", r.apiKeyPrefix, tenantId, hour.Local().Format(tenantUsageHourLayout))
+}
+
+func (r *TenantUsageCacheRepo) makeUsersKey(tenantId uint32, hour time.Time) string {
+	return fmt.Sprintf("%s%d:%s", r.usersKeyPrefix, tenantId, hour.Local().Format(tenantUsageHourLayout))
+}
+
+// RecordRequest 累加租户当前小时的接口调用次数，并登记发起请求的用户
+func (r *TenantUsageCacheRepo) RecordRequest(ctx context.Context, tenantId, userId uint32, operation string, at time.Time) error {
+	apiKey := r.makeApiKey(tenantId, at)
+	usersKey := r.makeUsersKey(tenantId, at)
+
+	pipe := r.rdb.Pipeline()
+	pipe.HIncrBy(ctx, apiKey, operation, 1)
+	pipe.Expire(ctx, apiKey, r.counterExpires)
+	pipe.SAdd(ctx, usersKey, userId)
+	pipe.Expire(ctx, usersKey, r.counterExpires)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// GetHourCounters 获取租户在 hour 所在小时的请求计数，没有请求的租户不返回
+func (r *TenantUsageCacheRepo) GetHourCounters(ctx context.Context, hour time.Time, tenantIds []uint32) (map[uint32]*TenantHourCounters, error) {
+	if len(tenantIds) == 0 {
+		return nil, nil
+	}
+
+	hour = metering.TruncateHour(hour)
+
+	pipe := r.rdb.Pipeline()
+	apiCmds := make([]*redis.MapStringStringCmd, len(tenantIds))
+	usersCmds := make([]*redis.IntCmd, len(tenantIds))
+	for i, tenantId := range tenantIds {
+		apiCmds[i] = pipe.HGetAll(ctx, r.makeApiKey(tenantId, hour))
+		usersCmds[i] = pipe.SCard(ctx, r.makeUsersKey(tenantId, hour))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	counters := make(map[uint32]*TenantHourCounters)
+	for i, tenantId := range tenantIds {
+		operations := make(map[string]uint64)
+		for operation, value := range apiCmds[i].Val() {
+			n, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				r.log.Warnf("invalid api counter [%d] [%s]: %s", tenantId, operation, value)
+				continue
+			}
+			operations[operation] = n
+		}
+
+		activeUsers := usersCmds[i].Val()
+		if len(operations) == 0 && activeUsers == 0 {
+			continue
+		}
+
+		counters[tenantId] = &TenantHourCounters{
+			Operations:  operations,
+			ActiveUsers: uint32(activeUsers),
+		}
+	}
+
+	return counters, nil
+}
+
+// ClaimThreshold 登记租户用量越过阈值的事件，同一个小时的同一个阈值只登记一次，返回false表示已经登记过
+func (r *TenantUsageCacheRepo) ClaimThreshold(ctx context.Context, tenantId uint32, metric metering.Metric, percent uint32, periodStart time.Time) (bool, error) {
+	key := fmt.Sprintf("%s%d:%s:%d:%d", r.thresholdKeyPrefix, tenantId, metric, percent, periodStart.Unix())
+	return r.rdb.SetNX(ctx, key, time.Now().Unix(), r.counterExpires).Result()
+}
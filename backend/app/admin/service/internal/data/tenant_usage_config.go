@@ -0,0 +1,22 @@
+package data
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/pkg/metering"
+)
+
+// NewTenantUsageConfig 读取配置目录中的 tenant_usage 配置，配置有误或没有配置时不计量
+func NewTenantUsageConfig(logger log.Logger) *metering.Config {
+	l := log.NewHelper(log.With(logger, "module", "tenant-usage/data/admin-service"))
+
+	var cfg metering.Config
+	if err := scanConfig("tenant_usage", &cfg); err != nil {
+		l.Errorf("load tenant usage config failed: %s", err.Error())
+		return &metering.Config{}
+	}
+
+	l.Infof("tenant usage metering enabled: %v, thresholds: %v", cfg.Enabled, cfg.GetThresholds())
+
+	return &cfg
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/predicate"
+	"go-wind-admin/app/admin/service/internal/data/ent/tenantusage"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/metering"
+)
+
+// tenantUsageBatchSize 批量写入用量记录时每批的条数
+const tenantUsageBatchSize = 500
+
+// TenantUsageRepo 租户每小时的用量记录
+type TenantUsageRepo struct {
+	data *Data
+	log  *log.Helper
+}
+
+func NewTenantUsageRepo(data *Data, logger log.Logger) *TenantUsageRepo {
+	return &TenantUsageRepo{
+		log:  log.NewHelper(log.With(logger, "module", "tenant-usage/repo/admin-service")),
+		data: data,
+	}
+}
+
+// Save 保存用量记录，同一租户同一小时的记录已存在时覆盖。
+// 存储空间是汇总时的快照，重新汇总过去的小时时保留首次汇总的值
+func (r *TenantUsageRepo) Save(ctx context.Context, records []*metering.Record) error {
+	now := time.Now()
+
+	for start := 0; start < len(records); start += tenantUsageBatchSize {
+		end := min(start+tenantUsageBatchSize, len(records))
+
+		builders := make([]*ent.TenantUsageCreate, 0, end-start)
+		for _, record := range records[start:end] {
+			builders = append(builders, r.data.db.Client().TenantUsage.Create().
+				SetTenantID(record.TenantId).
+				SetPeriodStart(record.PeriodStart).
+				SetActiveUsers(record.ActiveUsers).
+				SetAPICalls(record.ApiCalls).
+				SetAPICallsByModule(record.ApiCallsByModule).
+				SetStorageBytes(record.StorageBytes).
+				SetMessagesSent(record.MessagesSent).
+				SetCreatedAt(now),
+			)
+		}
+
+		err := r.data.db.Client().TenantUsage.CreateBulk(builders...).
+			OnConflictColumns(tenantusage.FieldTenantID, tenantusage.FieldPeriodStart).
+			Update(func(u *ent.TenantUsageUpsert) {
+				u.UpdateActiveUsers()
+				u.UpdateAPICalls()
+				u.UpdateAPICallsByModule()
+				u.UpdateMessagesSent()
+				u.SetUpdatedAt(now)
+			}).
+			Exec(ctx)
+		if err != nil {
+			r.log.Errorf("save tenant usage records failed: %s", err.Error())
+			return adminV1.ErrorInternalServerError("save tenant usage records failed")
+		}
+	}
+
+	return nil
+}
+
+// List 查询 [start, end) 之间的用量记录，按小时与租户排序。tenantId 为0时查询所有租户
+func (r *TenantUsageRepo) List(ctx context.Context, tenantId uint32, start, end time.Time) ([]*metering.Record, error) {
+	where := []predicate.TenantUsage{
+		tenantusage.PeriodStartGTE(start),
+		tenantusage.PeriodStartLT(end),
+	}
+	if tenantId != 0 {
+		where = append(where, tenantusage.TenantIDEQ(tenantId))
+	}
+
+	entities, err := r.data.db.Client().TenantUsage.Query().
+		Where(where...).
+		Order(ent.Asc(tenantusage.FieldPeriodStart), ent.Asc(tenantusage.FieldTenantID)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query tenant usage records failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query tenant usage records failed")
+	}
+
+	records := make([]*metering.Record, 0, len(entities))
+	for _, entity := range entities {
+		records = append(records, r.toRecord(entity))
+	}
+
+	return records, nil
+}
+
+// SumApiCallsGroupByTenant 统计各租户在 [start, end) 之间的API调用次数
+func (r *TenantUsageRepo) SumApiCallsGroupByTenant(ctx context.Context, start, end time.Time) (map[uint32]uint64, error) {
+	var rows []struct {
+		TenantId uint32 `json:"tenant_id"`
+		ApiCalls uint64 `json:"api_calls"`
+	}
+	err := r.data.db.Client().TenantUsage.Query().
+		Where(
+			tenantusage.PeriodStartGTE(start),
+			tenantusage.PeriodStartLT(end),
+		).
+		Modify(func(s *sql.Selector) {
+			s.Select(
+				s.C(tenantusage.FieldTenantID),
+				sql.As(sql.Sum(s.C(tenantusage.FieldAPICalls)), "api_calls"),
+			).GroupBy(s.C(tenantusage.FieldTenantID))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		r.log.Errorf("query tenant api calls sum failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query tenant api calls sum failed")
+	}
+
+	sums := make(map[uint32]uint64, len(rows))
+	for _, row := range rows {
+		sums[row.TenantId] = row.ApiCalls
+	}
+
+	return sums, nil
+}
+
+func (r *TenantUsageRepo) toRecord(entity *ent.TenantUsage) *metering.Record {
+	record := &metering.Record{
+		PeriodStart:      entity.PeriodStart,
+		ActiveUsers:      entity.ActiveUsers,
+		ApiCalls:         entity.APICalls,
+		ApiCallsByModule: entity.APICallsByModule,
+		StorageBytes:     entity.StorageBytes,
+		MessagesSent:     entity.MessagesSent,
+	}
+	if entity.TenantID != nil {
+		record.TenantId = *entity.TenantID
+	}
+	return record
+}
@@ -0,0 +1,49 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+)
+
+func TestTenantUsageToRecord(t *testing.T) {
+	repo := NewTenantUsageRepo(nil, log.DefaultLogger)
+
+	hour := time.Date(2026, 10, 19, 8, 0, 0, 0, time.Local)
+	tenantId := uint32(3)
+
+	record := repo.toRecord(&ent.TenantUsage{
+		TenantID:         &tenantId,
+		PeriodStart:      hour,
+		ActiveUsers:      2,
+		APICalls:         7,
+		APICallsByModule: map[string]uint64{"UserService": 5, "TaskService": 2},
+		StorageBytes:     1024,
+		MessagesSent:     4,
+	})
+	assert.Equal(t, uint32(3), record.TenantId)
+	assert.Equal(t, hour, record.PeriodStart)
+	assert.Equal(t, uint32(2), record.ActiveUsers)
+	assert.Equal(t, uint64(7), record.ApiCalls)
+	assert.Equal(t, uint64(5), record.ApiCallsByModule["UserService"])
+	assert.Equal(t, uint64(1024), record.StorageBytes)
+	assert.Equal(t, uint64(4), record.MessagesSent)
+
+	// 没有租户ID的记录归到租户0
+	record = repo.toRecord(&ent.TenantUsage{PeriodStart: hour})
+	assert.Equal(t, uint32(0), record.TenantId)
+}
+
+func TestTenantUsageCacheKeys(t *testing.T) {
+	repo := NewTenantUsageCacheRepo(log.DefaultLogger, nil)
+
+	// 同一小时内的请求计入同一个键
+	hour := time.Date(2026, 10, 19, 8, 0, 0, 0, time.Local)
+	assert.Equal(t, "tenant:usage:api:3:2026101908", repo.makeApiKey(3, hour))
+	assert.Equal(t, "tenant:usage:api:3:2026101908", repo.makeApiKey(3, hour.Add(59*time.Minute)))
+	assert.Equal(t, "tenant:usage:users:3:2026101909", repo.makeUsersKey(3, hour.Add(time.Hour)))
+}
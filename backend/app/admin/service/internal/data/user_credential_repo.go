@@ -132,45 +132,52 @@ func (r *UserCredentialRepo) Create(ctx context.Context, req *authenticationV1.C
 		return authenticationV1.ErrorBadRequest("invalid request")
 	}
 
-	var err error
+	builder, err := r.newCreateBuilder(r.data.db.Client(), req.Data)
+	if err != nil {
+		return err
+	}
 
-	if req.Data.Credential != nil {
-		var newCredential string
-		newCredential, err = r.prepareCredential(r.credentialTypeConverter.ToEntity(req.Data.CredentialType), req.Data.GetCredential())
+	if err = builder.Exec(ctx); err != nil {
+		r.log.Errorf("insert one data failed: %s [%v]", err.Error(), req.Data)
+		return authenticationV1.ErrorInternalServerError("insert data failed")
+	}
+
+	return nil
+}
+
+// newCreateBuilder 创建认证信息的构建器，明文密码会被加密，client 可以是事务的客户端
+func (r *UserCredentialRepo) newCreateBuilder(client *ent.Client, data *authenticationV1.UserCredential) (*ent.UserCredentialCreate, error) {
+	if data.Credential != nil {
+		newCredential, err := r.prepareCredential(r.credentialTypeConverter.ToEntity(data.CredentialType), data.GetCredential())
 		if err != nil {
 			r.log.Errorf("prepare new credential failed: %s", err.Error())
-			return authenticationV1.ErrorBadRequest("prepare new credential failed")
+			return nil, authenticationV1.ErrorBadRequest("prepare new credential failed")
 		}
-		req.Data.Credential = trans.Ptr(newCredential)
+		data.Credential = trans.Ptr(newCredential)
 	}
 
-	builder := r.data.db.Client().UserCredential.Create()
+	builder := client.UserCredential.Create()
 	builder.
-		SetUserID(req.Data.GetUserId()).
-		SetNillableIdentityType(r.identityTypeConverter.ToEntity(req.Data.IdentityType)).
-		SetNillableIdentifier(req.Data.Identifier).
-		SetNillableCredentialType(r.credentialTypeConverter.ToEntity(req.Data.CredentialType)).
-		SetNillableCredential(req.Data.Credential).
-		SetNillableIsPrimary(req.Data.IsPrimary).
-		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
-		SetNillableExtraInfo(req.Data.ExtraInfo).
-		SetNillableProvider(req.Data.Provider).
-		SetNillableProviderAccountID(req.Data.ProviderAccountId).
-		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
-
-	if req.Data.TenantId == nil {
-		builder.SetTenantID(req.Data.GetTenantId())
-	}
-	if req.Data.CreatedAt == nil {
+		SetUserID(data.GetUserId()).
+		SetNillableIdentityType(r.identityTypeConverter.ToEntity(data.IdentityType)).
+		SetNillableIdentifier(data.Identifier).
+		SetNillableCredentialType(r.credentialTypeConverter.ToEntity(data.CredentialType)).
+		SetNillableCredential(data.Credential).
+		SetNillableIsPrimary(data.IsPrimary).
+		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
+		SetNillableExtraInfo(data.ExtraInfo).
+		SetNillableProvider(data.Provider).
+		SetNillableProviderAccountID(data.ProviderAccountId).
+		SetNillableCreatedAt(timeutil.TimestamppbToTime(data.CreatedAt))
+
+	if data.TenantId != nil {
+		builder.SetTenantID(data.GetTenantId())
+	}
+	if data.CreatedAt == nil {
 		builder.SetCreatedAt(time.Now())
 	}
 
-	if err = builder.Exec(ctx); err != nil {
-		r.log.Errorf("insert one data failed: %s [%v]", err.Error(), req.Data)
-		return authenticationV1.ErrorInternalServerError("insert data failed")
-	}
-
-	return nil
+	return builder, nil
 }
 
 func (r *UserCredentialRepo) Update(ctx context.Context, req *authenticationV1.UpdateUserCredentialRequest) error {
@@ -321,6 +328,9 @@ func (r *UserCredentialRepo) GetByIdentifier(ctx context.Context, req *authentic
 		usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 		usercredential.IdentifierEQ(req.GetIdentifier()),
 	)
+	if req.TenantId != nil {
+		builder.Where(tenantScope(req.GetTenantId()))
+	}
 
 	entity, err := builder.Only(ctx)
 	if err != nil {
@@ -353,13 +363,17 @@ func (r *UserCredentialRepo) VerifyCredential(ctx context.Context, req *authenti
 		req.Credential = string(plainPassword)
 	}
 
-	entity, err := r.data.db.Client().UserCredential.Query().
+	builder := r.data.db.Client().UserCredential.Query().
 		Select(usercredential.FieldCredentialType, usercredential.FieldCredential, usercredential.FieldStatus).
 		Where(
 			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 			usercredential.IdentifierEQ(req.GetIdentifier()),
-		).
-		Only(ctx)
+		)
+	if req.TenantId != nil {
+		builder.Where(tenantScope(req.GetTenantId()))
+	}
+
+	entity, err := builder.Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return nil, authenticationV1.ErrorUserNotFound("user not found")
@@ -433,7 +447,7 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		req.NewCredential = string(plainPassword)
 	}
 
-	entity, err := r.data.db.Client().UserCredential.
+	query := r.data.db.Client().UserCredential.
 		Query().
 		Select(
 			usercredential.FieldCredentialType,
@@ -442,8 +456,12 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		Where(
 			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 			usercredential.IdentifierEQ(req.GetIdentifier()),
-		).
-		Only(ctx)
+		)
+	if req.TenantId != nil {
+		query.Where(tenantScope(req.GetTenantId()))
+	}
+
+	entity, err := query.Only(ctx)
 	if err != nil {
 		r.log.Errorf("query one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("query one data failed")
@@ -474,6 +492,9 @@ func (r *UserCredentialRepo) ChangeCredential(ctx context.Context, req *authenti
 		usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 		usercredential.IdentifierEQ(req.GetIdentifier()),
 	)
+	if req.TenantId != nil {
+		builder.Where(tenantScope(req.GetTenantId()))
+	}
 	builder.
 		SetCredential(newCredential).
 		SetUpdatedAt(time.Now())
@@ -494,7 +515,7 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		req.NewCredential = string(plainPassword)
 	}
 
-	entity, err := r.data.db.Client().UserCredential.
+	query := r.data.db.Client().UserCredential.
 		Query().
 		Select(
 			usercredential.FieldCredentialType,
@@ -502,8 +523,12 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		Where(
 			usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 			usercredential.IdentifierEQ(req.GetIdentifier()),
-		).
-		Only(ctx)
+		)
+	if req.TenantId != nil {
+		query.Where(tenantScope(req.GetTenantId()))
+	}
+
+	entity, err := query.Only(ctx)
 	if err != nil {
 		r.log.Errorf("query one data failed: %s", err.Error())
 		return authenticationV1.ErrorInternalServerError("query one data failed")
@@ -529,6 +554,9 @@ func (r *UserCredentialRepo) ResetCredential(ctx context.Context, req *authentic
 		usercredential.IdentityTypeEQ(*r.identityTypeConverter.ToEntity(trans.Ptr(req.GetIdentityType()))),
 		usercredential.IdentifierEQ(req.GetIdentifier()),
 	)
+	if req.TenantId != nil {
+		builder.Where(tenantScope(req.GetTenantId()))
+	}
 	builder.
 		SetCredential(newCredential).
 		SetUpdatedAt(time.Now())
@@ -0,0 +1,114 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/tx7do/go-utils/copierutil"
+	"github.com/tx7do/go-utils/mapper"
+	"github.com/tx7do/go-utils/trans"
+
+	"go-wind-admin/app/admin/service/internal/data/ent"
+	"go-wind-admin/app/admin/service/internal/data/ent/usernotificationpreference"
+
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+)
+
+type UserNotificationPreferenceRepo struct {
+	data *Data
+	log  *log.Helper
+
+	mapper *mapper.CopierMapper[internalMessageV1.UserNotificationPreference, ent.UserNotificationPreference]
+}
+
+func NewUserNotificationPreferenceRepo(data *Data, logger log.Logger) *UserNotificationPreferenceRepo {
+	repo := &UserNotificationPreferenceRepo{
+		log:    log.NewHelper(log.With(logger, "module", "user-notification-preference/repo/admin-service")),
+		data:   data,
+		mapper: mapper.NewCopierMapper[internalMessageV1.UserNotificationPreference, ent.UserNotificationPreference](),
+	}
+
+	repo.init()
+
+	return repo
+}
+
+func (r *UserNotificationPreferenceRepo) init() {
+	r.mapper.AppendConverters(copierutil.NewTimeStringConverterPair())
+	r.mapper.AppendConverters(copierutil.NewTimeTimestamppbConverterPair())
+}
+
+// Get 查询用户的通知偏好，没有设置过时返回默认偏好
+func (r *UserNotificationPreferenceRepo) Get(ctx context.Context, userId uint32) (*internalMessageV1.UserNotificationPreference, error) {
+	entity, err := r.data.db.Client().UserNotificationPreference.Query().
+		Where(usernotificationpreference.UserIDEQ(userId)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return &internalMessageV1.UserNotificationPreference{UserId: trans.Ptr(userId)}, nil
+		}
+
+		r.log.Errorf("query notification preference failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query notification preference failed")
+	}
+
+	return r.mapper.ToDTO(entity), nil
+}
+
+// ListByUserIds 批量查询用户的通知偏好，没有设置过的用户不在结果中
+func (r *UserNotificationPreferenceRepo) ListByUserIds(ctx context.Context, userIds []uint32) (map[uint32]*internalMessageV1.UserNotificationPreference, error) {
+	if len(userIds) == 0 {
+		return map[uint32]*internalMessageV1.UserNotificationPreference{}, nil
+	}
+
+	entities, err := r.data.db.Client().UserNotificationPreference.Query().
+		Where(usernotificationpreference.UserIDIn(userIds...)).
+		All(ctx)
+	if err != nil {
+		r.log.Errorf("query notification preferences failed: %s", err.Error())
+		return nil, internalMessageV1.ErrorInternalServerError("query notification preferences failed")
+	}
+
+	prefs := make(map[uint32]*internalMessageV1.UserNotificationPreference, len(entities))
+	for _, entity := range entities {
+		prefs[*entity.UserID] = r.mapper.ToDTO(entity)
+	}
+
+	return prefs, nil
+}
+
+// Save 保存用户的通知偏好
+func (r *UserNotificationPreferenceRepo) Save(ctx context.Context, data *internalMessageV1.UserNotificationPreference) error {
+	if data == nil || data.GetUserId() == 0 {
+		return internalMessageV1.ErrorBadRequest("invalid parameter")
+	}
+
+	now := time.Now()
+
+	err := r.data.db.Client().UserNotificationPreference.Create().
+		SetUserID(data.GetUserId()).
+		SetDisabledChannels(data.DisabledChannels).
+		SetCategoryChannels(data.CategoryChannels).
+		SetMutedCategoryIds(data.MutedCategoryIds).
+		SetQuietHours(data.QuietHours).
+		SetDigest(data.Digest).
+		SetCreatedAt(now).
+		OnConflictColumns(usernotificationpreference.FieldUserID).
+		Update(func(u *ent.UserNotificationPreferenceUpsert) {
+			u.SetDisabledChannels(data.DisabledChannels)
+			u.SetCategoryChannels(data.CategoryChannels)
+			u.SetMutedCategoryIds(data.MutedCategoryIds)
+			u.SetQuietHours(data.QuietHours)
+			u.SetDigest(data.Digest)
+			u.SetUpdatedAt(now)
+		}).
+		Exec(ctx)
+	if err != nil {
+		r.log.Errorf("save notification preference failed: %s", err.Error())
+		return internalMessageV1.ErrorInternalServerError("save notification preference failed")
+	}
+
+	return nil
+}
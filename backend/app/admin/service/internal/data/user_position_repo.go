@@ -107,3 +107,28 @@ func (r *UserPositionRepo) RemovePositions(ctx context.Context, userId uint32, i
 	}
 	return nil
 }
+
+// ListUserIdsByPositionIds 获取担任任一岗位的用户ID列表
+func (r *UserPositionRepo) ListUserIdsByPositionIds(ctx context.Context, positionIds []uint32) ([]uint32, error) {
+	if len(positionIds) == 0 {
+		return []uint32{}, nil
+	}
+
+	var rows []struct {
+		UserID uint32 `json:"user_id"`
+	}
+	if err := r.data.db.Client().UserPosition.Query().
+		Where(userposition.PositionIDIn(positionIds...)).
+		Unique(true).
+		Select(userposition.FieldUserID).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query user ids by position ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query user ids by position ids failed")
+	}
+
+	ids := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.UserID)
+	}
+	return ids, nil
+}
@@ -89,6 +89,11 @@ func (r *UserRepo) Count(ctx context.Context, whereCond []func(s *sql.Selector))
 	return count, nil
 }
 
+// CountByTenant 统计租户的用户数
+func (r *UserRepo) CountByTenant(ctx context.Context, tenantId uint32) (int, error) {
+	return r.Count(ctx, []func(s *sql.Selector){tenantScope(tenantId)})
+}
+
 func (r *UserRepo) List(ctx context.Context, req *pagination.PagingRequest) (*userV1.ListUserResponse, error) {
 	if req == nil {
 		return nil, userV1.ErrorBadRequest("invalid parameter")
@@ -137,6 +142,9 @@ func (r *UserRepo) Get(ctx context.Context, req *userV1.GetUserRequest) (*userV1
 	default:
 		whereCond = append(whereCond, user.IDEQ(req.GetId()))
 	}
+	if req.TenantId != nil {
+		whereCond = append(whereCond, tenantScope(req.GetTenantId()))
+	}
 
 	dto, err := r.repository.Get(ctx, builder, req.GetViewMask(), whereCond...)
 	if err != nil {
@@ -151,59 +159,67 @@ func (r *UserRepo) Create(ctx context.Context, req *userV1.CreateUserRequest) (*
 		return nil, userV1.ErrorBadRequest("invalid parameter")
 	}
 
-	builder := r.data.db.Client().User.Create().
-		SetNillableUsername(req.Data.Username).
-		SetNillableNickname(req.Data.Nickname).
-		SetNillableRealname(req.Data.Realname).
-		SetNillableAvatar(req.Data.Avatar).
-		SetNillableEmail(req.Data.Email).
-		SetNillableMobile(req.Data.Mobile).
-		SetNillableTelephone(req.Data.Telephone).
-		SetNillableRegion(req.Data.Region).
-		SetNillableAddress(req.Data.Address).
-		SetNillableDescription(req.Data.Description).
-		SetNillableRemark(req.Data.Remark).
-		SetNillableLastLoginTime(timeutil.TimestamppbToTime(req.Data.LastLoginTime)).
-		SetNillableLastLoginIP(req.Data.LastLoginIp).
-		SetNillableStatus(r.statusConverter.ToEntity(req.Data.Status)).
-		SetNillableGender(r.genderConverter.ToEntity(req.Data.Gender)).
-		SetNillableAuthority(r.authorityConverter.ToEntity(req.Data.Authority)).
-		SetNillableOrgID(req.Data.OrgId).
-		SetNillableDepartmentID(req.Data.DepartmentId).
-		SetNillablePositionID(req.Data.PositionId).
-		SetNillableWorkID(req.Data.WorkId).
-		SetNillableCreatedBy(req.Data.CreatedBy).
-		SetNillableCreatedAt(timeutil.TimestamppbToTime(req.Data.CreatedAt))
-
-	if req.Data.TenantId == nil {
-		builder.SetTenantID(req.Data.GetTenantId())
+	builder := r.newCreateBuilder(r.data.db.Client(), req.Data)
+
+	if ret, err := builder.Save(ctx); err != nil {
+		r.log.Errorf("insert one data failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("insert data failed")
+	} else {
+		return r.mapper.ToDTO(ret), nil
+	}
+}
+
+// newCreateBuilder 创建用户的构建器，client 可以是事务的客户端
+func (r *UserRepo) newCreateBuilder(client *ent.Client, data *userV1.User) *ent.UserCreate {
+	builder := client.User.Create().
+		SetNillableUsername(data.Username).
+		SetNillableNickname(data.Nickname).
+		SetNillableRealname(data.Realname).
+		SetNillableAvatar(data.Avatar).
+		SetNillableEmail(data.Email).
+		SetNillableMobile(data.Mobile).
+		SetNillableTelephone(data.Telephone).
+		SetNillableRegion(data.Region).
+		SetNillableLanguage(data.Language).
+		SetNillableAddress(data.Address).
+		SetNillableDescription(data.Description).
+		SetNillableRemark(data.Remark).
+		SetNillableLastLoginTime(timeutil.TimestamppbToTime(data.LastLoginTime)).
+		SetNillableLastLoginIP(data.LastLoginIp).
+		SetNillableStatus(r.statusConverter.ToEntity(data.Status)).
+		SetNillableGender(r.genderConverter.ToEntity(data.Gender)).
+		SetNillableAuthority(r.authorityConverter.ToEntity(data.Authority)).
+		SetNillableOrgID(data.OrgId).
+		SetNillableDepartmentID(data.DepartmentId).
+		SetNillablePositionID(data.PositionId).
+		SetNillableWorkID(data.WorkId).
+		SetNillableCreatedBy(data.CreatedBy).
+		SetNillableCreatedAt(timeutil.TimestamppbToTime(data.CreatedAt))
+
+	if data.TenantId != nil {
+		builder.SetTenantID(data.GetTenantId())
 	}
-	if req.Data.CreatedAt == nil {
+	if data.CreatedAt == nil {
 		builder.SetCreatedAt(time.Now())
 	}
 
-	if req.Data.Id != nil {
-		builder.SetID(req.GetData().GetId())
+	if data.Id != nil {
+		builder.SetID(data.GetId())
 	}
 
-	//if req.Data.Roles != nil {
-	//	builder.SetRoles(req.Data.GetRoles())
+	//if data.Roles != nil {
+	//	builder.SetRoles(data.GetRoles())
 	//}
 
-	if req.Data.RoleIds != nil {
+	if data.RoleIds != nil {
 		var roleIds []int
-		for _, roleId := range req.Data.GetRoleIds() {
+		for _, roleId := range data.GetRoleIds() {
 			roleIds = append(roleIds, int(roleId))
 		}
 		builder.SetRoleIds(roleIds)
 	}
 
-	if ret, err := builder.Save(ctx); err != nil {
-		r.log.Errorf("insert one data failed: %s", err.Error())
-		return nil, userV1.ErrorInternalServerError("insert data failed")
-	} else {
-		return r.mapper.ToDTO(ret), nil
-	}
+	return builder
 }
 
 func (r *UserRepo) Update(ctx context.Context, req *userV1.UpdateUserRequest) error {
@@ -237,6 +253,7 @@ func (r *UserRepo) Update(ctx context.Context, req *userV1.UpdateUserRequest) er
 				SetNillableMobile(req.Data.Mobile).
 				SetNillableTelephone(req.Data.Telephone).
 				SetNillableRegion(req.Data.Region).
+				SetNillableLanguage(req.Data.Language).
 				SetNillableAddress(req.Data.Address).
 				SetNillableDescription(req.Data.Description).
 				SetNillableRemark(req.Data.Remark).
@@ -309,11 +326,15 @@ func (r *UserRepo) ListUsersByIds(ctx context.Context, ids []uint32) ([]*userV1.
 	return dtos, nil
 }
 
-// UserExists 检查用户是否存在
+// UserExists 检查用户是否存在，指定租户时只检查该租户内的用户
 func (r *UserRepo) UserExists(ctx context.Context, req *userV1.UserExistsRequest) (*userV1.UserExistsResponse, error) {
-	exist, err := r.data.db.Client().User.Query().
-		Where(user.UsernameEQ(req.GetUsername())).
-		Exist(ctx)
+	builder := r.data.db.Client().User.Query().
+		Where(user.UsernameEQ(req.GetUsername()))
+	if req.TenantId != nil {
+		builder.Where(tenantScope(req.GetTenantId()))
+	}
+
+	exist, err := builder.Exist(ctx)
 	if err != nil {
 		r.log.Errorf("query exist failed: %s", err.Error())
 		return &userV1.UserExistsResponse{
@@ -325,3 +346,15 @@ func (r *UserRepo) UserExists(ctx context.Context, req *userV1.UserExistsRequest
 		Exist: exist,
 	}, nil
 }
+
+// ListIdsWhere 查询满足条件的用户ID列表
+func (r *UserRepo) ListIdsWhere(ctx context.Context, whereCond ...predicate.User) ([]uint32, error) {
+	ids, err := r.data.db.Client().User.Query().
+		Where(whereCond...).
+		IDs(ctx)
+	if err != nil {
+		r.log.Errorf("query user ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query user ids failed")
+	}
+	return ids, nil
+}
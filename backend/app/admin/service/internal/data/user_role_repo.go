@@ -107,3 +107,28 @@ func (r *UserRoleRepo) RemoveRoles(ctx context.Context, userId uint32, ids []uin
 	}
 	return nil
 }
+
+// ListUserIdsByRoleIds 获取拥有任一角色的用户ID列表
+func (r *UserRoleRepo) ListUserIdsByRoleIds(ctx context.Context, roleIds []uint32) ([]uint32, error) {
+	if len(roleIds) == 0 {
+		return []uint32{}, nil
+	}
+
+	var rows []struct {
+		UserID uint32 `json:"user_id"`
+	}
+	if err := r.data.db.Client().UserRole.Query().
+		Where(userrole.RoleIDIn(roleIds...)).
+		Unique(true).
+		Select(userrole.FieldUserID).
+		Scan(ctx, &rows); err != nil {
+		r.log.Errorf("query user ids by role ids failed: %s", err.Error())
+		return nil, userV1.ErrorInternalServerError("query user ids by role ids failed")
+	}
+
+	ids := make([]uint32, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.UserID)
+	}
+	return ids, nil
+}
@@ -12,9 +12,11 @@ import (
 
 	authnEngine "github.com/tx7do/kratos-authn/engine"
 
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
 	"go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/sse"
 )
 
 type UserTokenCacheRepo struct {
@@ -52,16 +54,22 @@ func NewUserTokenCacheRepo(
 	}
 }
 
-// GenerateToken 创建令牌
-func (r *UserTokenCacheRepo) GenerateToken(ctx context.Context, user *userV1.User, clientId string) (accessToken string, refreshToken string, err error) {
+// GenerateToken 创建令牌，sessionTimeout 大于0时访问令牌与刷新令牌的有效期都不超过该时长，超过该时长未刷新需要重新登录
+func (r *UserTokenCacheRepo) GenerateToken(ctx context.Context, user *userV1.User, clientId string, sessionTimeout time.Duration) (accessToken string, refreshToken string, err error) {
+	accessExpires, refreshExpires := r.accessTokenExpires, r.refreshTokenExpires
+	if sessionTimeout > 0 {
+		accessExpires = capExpires(accessExpires, sessionTimeout)
+		refreshExpires = capExpires(refreshExpires, sessionTimeout)
+	}
+
 	// 创建访问令牌
-	if accessToken, err = r.GenerateAccessToken(ctx, user, clientId); accessToken == "" {
+	if accessToken, err = r.generateAccessToken(ctx, user, clientId, accessExpires, sessionTimeout > 0); accessToken == "" {
 		err = errors.New("create access token failed")
 		return
 	}
 
 	// 创建刷新令牌
-	if refreshToken, err = r.GenerateRefreshToken(ctx, user); refreshToken == "" {
+	if refreshToken, err = r.generateRefreshToken(ctx, user, refreshExpires); refreshToken == "" {
 		err = errors.New("create refresh token failed")
 		return
 	}
@@ -71,12 +79,52 @@ func (r *UserTokenCacheRepo) GenerateToken(ctx context.Context, user *userV1.Use
 
 // GenerateAccessToken 创建访问令牌
 func (r *UserTokenCacheRepo) GenerateAccessToken(ctx context.Context, user *userV1.User, clientId string) (accessToken string, err error) {
-	if accessToken = r.createAccessJwtToken(user, clientId); accessToken == "" {
+	return r.generateAccessToken(ctx, user, clientId, r.accessTokenExpires, false)
+}
+
+// generateAccessToken 创建访问令牌，withExpiresAt 为true时在令牌中写入过期时间
+func (r *UserTokenCacheRepo) generateAccessToken(
+	ctx context.Context,
+	user *userV1.User,
+	clientId string,
+	expires time.Duration,
+	withExpiresAt bool,
+) (accessToken string, err error) {
+	var expiresAt time.Time
+	if withExpiresAt {
+		expiresAt = time.Now().Add(expires)
+	}
+
+	if accessToken = r.createAccessJwtToken(user, clientId, expiresAt); accessToken == "" {
 		err = errors.New("create access token failed")
 		return
 	}
 
-	if err = r.setAccessTokenToRedis(ctx, user.GetId(), accessToken, r.accessTokenExpires); err != nil {
+	if err = r.setAccessTokenToRedis(ctx, user.GetId(), accessToken, expires); err != nil {
+		return
+	}
+
+	return
+}
+
+// GenerateImpersonationToken 创建代管访问令牌，令牌以 user 的身份访问并记录代管操作人 actor 与代管的审计日志ID，在 expires 后失效，不能刷新
+func (r *UserTokenCacheRepo) GenerateImpersonationToken(
+	ctx context.Context,
+	user *userV1.User,
+	actor *authenticationV1.UserTokenPayload,
+	clientId string,
+	auditId uint32,
+	expires time.Duration,
+) (accessToken string, err error) {
+	authClaims := jwt.NewImpersonationAuthClaims(user, actor, clientId, auditId, time.Now().Add(expires))
+
+	if accessToken, err = r.authenticator.CreateIdentity(*authClaims); err != nil || accessToken == "" {
+		r.log.Errorf("create impersonation token failed: [%v]", err)
+		err = errors.New("create impersonation token failed")
+		return
+	}
+
+	if err = r.setAccessTokenToRedis(ctx, user.GetId(), accessToken, expires); err != nil {
 		return
 	}
 
@@ -85,12 +133,16 @@ func (r *UserTokenCacheRepo) GenerateAccessToken(ctx context.Context, user *user
 
 // GenerateRefreshToken 创建刷新令牌
 func (r *UserTokenCacheRepo) GenerateRefreshToken(ctx context.Context, user *userV1.User) (refreshToken string, err error) {
+	return r.generateRefreshToken(ctx, user, r.refreshTokenExpires)
+}
+
+func (r *UserTokenCacheRepo) generateRefreshToken(ctx context.Context, user *userV1.User, expires time.Duration) (refreshToken string, err error) {
 	if refreshToken = r.createRefreshToken(); refreshToken == "" {
 		err = errors.New("create refresh token failed")
 		return
 	}
 
-	if err = r.setRefreshTokenToRedis(ctx, user.GetId(), refreshToken, r.refreshTokenExpires); err != nil {
+	if err = r.setRefreshTokenToRedis(ctx, user.GetId(), refreshToken, expires); err != nil {
 		return
 	}
 
@@ -147,6 +199,16 @@ func (r *UserTokenCacheRepo) IsExistRefreshToken(ctx context.Context, userId uin
 	return r.exists(ctx, key, refreshToken)
 }
 
+// IsExistSession 登录会话是否存在，会话ID由访问令牌计算得到
+func (r *UserTokenCacheRepo) IsExistSession(ctx context.Context, userId uint32, sessionId string) bool {
+	for _, token := range r.GetAccessToken(ctx, userId) {
+		if sse.SessionID(token) == sessionId {
+			return true
+		}
+	}
+	return false
+}
+
 // setAccessTokenToRedis 设置访问令牌
 func (r *UserTokenCacheRepo) setAccessTokenToRedis(ctx context.Context, userId uint32, token string, expires time.Duration) error {
 	key := r.makeAccessTokenKey(userId)
@@ -216,9 +278,12 @@ func (r *UserTokenCacheRepo) deleteRefreshTokenFromRedis(ctx context.Context, us
 	return r.del(ctx, key)
 }
 
-// createAccessJwtToken 生成JWT访问令牌
-func (r *UserTokenCacheRepo) createAccessJwtToken(user *userV1.User, clientId string) string {
+// createAccessJwtToken 生成JWT访问令牌，expiresAt 不为零值时写入过期时间
+func (r *UserTokenCacheRepo) createAccessJwtToken(user *userV1.User, clientId string, expiresAt time.Time) string {
 	authClaims := jwt.NewUserTokenAuthClaims(user, clientId)
+	if !expiresAt.IsZero() {
+		(*authClaims)[jwt.ClaimFieldExpiresAt] = expiresAt.Unix()
+	}
 
 	signedToken, err := r.authenticator.CreateIdentity(*authClaims)
 	if err != nil {
@@ -234,6 +299,14 @@ func (r *UserTokenCacheRepo) createRefreshToken() string {
 	return strUUID.String()
 }
 
+// capExpires 有效期不超过 limit，有效期为0表示不过期
+func capExpires(expires, limit time.Duration) time.Duration {
+	if expires <= 0 || expires > limit {
+		return limit
+	}
+	return expires
+}
+
 // makeAccessTokenKey 生成访问令牌键
 func (r *UserTokenCacheRepo) makeAccessTokenKey(userId uint32) string {
 	return fmt.Sprintf("%s%d", r.accessTokenKeyPrefix, userId)
@@ -2,19 +2,61 @@ package server
 
 import (
 	"context"
+	"time"
+
+	hibikenAsynq "github.com/hibiken/asynq"
 
 	"github.com/go-kratos/kratos/v2/log"
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
 	"github.com/tx7do/kratos-transport/transport/asynq"
-	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/service"
 
+	"go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/task"
 )
 
+// NewTaskScheduler creates a new periodic task scheduler.
+func NewTaskScheduler(cfg *conf.Bootstrap, logger log.Logger, svc *service.TaskService) *task.Scheduler {
+	if cfg == nil || cfg.Server == nil || cfg.Server.Asynq == nil {
+		return nil
+	}
+
+	l := log.NewHelper(log.With(logger, "module", "task-scheduler/admin-service"))
+
+	redisOpt, err := hibikenAsynq.ParseRedisURI(cfg.Server.Asynq.GetUri())
+	if err != nil {
+		l.Errorf("parse asynq redis uri failed: %s", err.Error())
+		return nil
+	}
+
+	opts := &hibikenAsynq.SchedulerOpts{}
+	if cfg.Server.Asynq.GetLocation() != "" {
+		if opts.Location, err = time.LoadLocation(cfg.Server.Asynq.GetLocation()); err != nil {
+			l.Errorf("load scheduler location failed: %s", err.Error())
+		}
+	}
+
+	s := task.NewScheduler(redisOpt, opts, cfg.Server.Asynq.GetCodec())
+
+	svc.Scheduler = s
+
+	return s
+}
+
+// systemContextTaskMiddleware 队列任务没有登录用户，在系统上下文中访问数据
+func systemContextTaskMiddleware(next hibikenAsynq.Handler) hibikenAsynq.Handler {
+	return hibikenAsynq.HandlerFunc(func(ctx context.Context, t *hibikenAsynq.Task) error {
+		return next.ProcessTask(viewer.NewSystemViewerContext(ctx), t)
+	})
+}
+
 // NewAsynqServer creates a new asynq server.
-func NewAsynqServer(cfg *conf.Bootstrap, _ log.Logger, svc *service.TaskService) *asynq.Server {
+func NewAsynqServer(
+	cfg *conf.Bootstrap, _ log.Logger,
+	svc *service.TaskService, _ *task.Scheduler,
+	internalMessageSvc *service.InternalMessageService,
+) *asynq.Server {
 	if cfg == nil || cfg.Server == nil || cfg.Server.Asynq == nil {
 		return nil
 	}
@@ -25,19 +67,18 @@ func NewAsynqServer(cfg *conf.Bootstrap, _ log.Logger, svc *service.TaskService)
 		asynq.WithLocation(cfg.Server.Asynq.GetLocation()),
 		asynq.WithGracefullyShutdown(cfg.Server.Asynq.GetEnableGracefullyShutdown()),
 		asynq.WithShutdownTimeout(cfg.Server.Asynq.GetShutdownTimeout().AsDuration()),
+		asynq.WithMiddleware(systemContextTaskMiddleware, svc.TaskRunMiddleware),
 	)
 
 	svc.Server = srv
 
-	var err error
-
-	// 注册任务
-	if err = asynq.RegisterSubscriber(srv, task.BackupTaskType, svc.AsyncBackup); err != nil {
+	// 注册任务，周期任务由领导者在当选后统一登记
+	if err := svc.SubscribeHandlers(srv); err != nil {
+		log.Error(err)
+	}
+	if err := internalMessageSvc.SubscribeHandlers(srv); err != nil {
 		log.Error(err)
 	}
-
-	// 启动所有的任务
-	_, _ = svc.StartAllTask(context.Background(), &emptypb.Empty{})
 
 	return srv
 }
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"mime"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"go-wind-admin/app/admin/service/internal/service"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+)
+
+func registerTenantUsageExportHandler(srv *http.Server, svc *service.TenantUsageService) {
+	r := srv.Route("/")
+	r.GET("admin/v1/tenant_usage:export", _TenantUsageService_ExportTenantUsage_HTTP_Handler(svc))
+}
+
+const OperationTenantUsageServiceExportTenantUsage = "/admin.service.v1.TenantUsageService/ExportTenantUsage"
+
+func _TenantUsageService_ExportTenantUsage_HTTP_Handler(svc *service.TenantUsageService) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in adminV1.ExportTenantUsageRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+
+		http.SetOperation(ctx, OperationTenantUsageServiceExportTenantUsage)
+
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return svc.ExportTenantUsage(ctx, req.(*adminV1.ExportTenantUsageRequest))
+		})
+
+		// 逻辑处理，取数据
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+
+		reply := out.(*adminV1.ExportTenantUsageResponse)
+
+		ctx.Response().Header().Set("Content-Disposition",
+			mime.FormatMediaType("attachment", map[string]string{"filename": reply.GetFileName()}))
+
+		return ctx.Blob(200, "text/csv; charset=utf-8", reply.GetContent())
+	}
+}
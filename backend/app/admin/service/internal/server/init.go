@@ -10,6 +10,7 @@ import (
 // ProviderSet is server providers.
 var ProviderSet = wire.NewSet(
 	NewRESTServer,
+	NewTaskScheduler,
 	NewAsynqServer,
 	NewSseServer,
 )
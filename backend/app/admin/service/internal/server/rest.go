@@ -26,22 +26,68 @@ import (
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 
+	"go-wind-admin/pkg/cluster"
+	"go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
 	applogging "go-wind-admin/pkg/middleware/logging"
+	appratelimit "go-wind-admin/pkg/middleware/ratelimit"
+	"go-wind-admin/pkg/ratelimit"
 )
 
+// restWhiteList 不需要登录的接口
+var restWhiteList = map[string]bool{
+	adminV1.OperationAuthenticationServiceLogin: true,
+}
+
+// restAuthzWhiteList 登录即可访问、不需要鉴权的接口
+var restAuthzWhiteList = map[string]bool{
+	adminV1.OperationAuthenticationServiceWhoAmI: true,
+
+	// 个人配置只作用于登录用户自己，主题与语言等配置对所有用户生效
+	adminV1.OperationUserProfileServiceGetSettings:    true,
+	adminV1.OperationUserProfileServiceUpdateSettings: true,
+}
+
 // NewWhiteListMatcher 创建jwt白名单
 func newRestWhiteListMatcher() selector.MatchFunc {
-	whiteList := make(map[string]bool)
-	whiteList[adminV1.OperationAuthenticationServiceLogin] = true
 	return func(ctx context.Context, operation string) bool {
-		if _, ok := whiteList[operation]; ok {
+		if _, ok := restWhiteList[operation]; ok {
 			return false
 		}
 		return true
 	}
 }
 
+// newRestAuthzMatcher 需要鉴权的接口
+func newRestAuthzMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		if _, ok := restWhiteList[operation]; ok {
+			return false
+		}
+		if _, ok := restAuthzWhiteList[operation]; ok {
+			return false
+		}
+		return true
+	}
+}
+
+// newSystemContextMatcher 白名单中的接口没有登录用户，在系统上下文中访问数据
+func newSystemContextMatcher() selector.MatchFunc {
+	return func(ctx context.Context, operation string) bool {
+		_, ok := restWhiteList[operation]
+		return ok
+	}
+}
+
+// systemContextServer 为请求附加系统上下文
+func systemContextServer() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			return handler(viewer.NewSystemViewerContext(ctx), req)
+		}
+	}
+}
+
 // NewMiddleware 创建中间件
 func newRestMiddleware(
 	logger log.Logger,
@@ -49,6 +95,9 @@ func newRestMiddleware(
 	authorizer *data.Authorizer,
 	operationLogRepo *data.AdminOperationLogRepo,
 	loginLogRepo *data.AdminLoginLogRepo,
+	tenantService *service.TenantService,
+	tenantUsageService *service.TenantUsageService,
+	limiter *ratelimit.Limiter,
 ) []middleware.Middleware {
 	var ms []middleware.Middleware
 	ms = append(ms, logging.Server(logger))
@@ -66,10 +115,37 @@ func newRestMiddleware(
 
 	ms = append(ms, selector.Server(
 		authn.Server(authenticator),
-		auth.Server(),
-		authz.Server(authorizer.Engine()),
+		auth.Server(
+			auth.WithCheckTenantFunc(tenantService.CheckTenant),
+			auth.WithCheckTenantRequestFunc(tenantService.CheckTenantRequest),
+			auth.WithRecordTenantRequestFunc(tenantUsageService.RecordRequest),
+			auth.WithIsPlatformRoleFunc(authorizer.IsPlatformRole),
+		),
 	).Match(newRestWhiteListMatcher()).Build())
 
+	// 限流放在认证之后、鉴权之前：登录用户按租户、用户与客户端限流，白名单中的接口按IP限流
+	if limiter.Policy().Enabled() {
+		ms = append(ms, appratelimit.Server(limiter,
+			appratelimit.WithLogger(logger),
+			appratelimit.WithTrustForwardedFor(limiter.Policy().Config().TrustForwardedFor),
+			appratelimit.WithPlanFunc(func(ctx context.Context, tenantId uint32) string {
+				limits, err := tenantService.TenantLimits(ctx, tenantId)
+				if err != nil || limits == nil {
+					return ""
+				}
+				return limits.PlanCode
+			}),
+		))
+	}
+
+	ms = append(ms, selector.Server(
+		authz.Server(authorizer.Engine()),
+	).Match(newRestAuthzMatcher()).Build())
+
+	ms = append(ms, selector.Server(
+		systemContextServer(),
+	).Match(newSystemContextMatcher()).Build())
+
 	return ms
 }
 
@@ -94,20 +170,29 @@ func NewRESTServer(
 	ueditorSvc *service.UEditorService,
 	fileService *service.FileService,
 	tenantService *service.TenantService,
+	tenantPlanService *service.TenantPlanService,
+	settingService *service.SettingService,
 	taskService *service.TaskService,
 	internalMessageService *service.InternalMessageService,
 	internalMessageCategoryService *service.InternalMessageCategoryService,
 	internalMessageRecipientService *service.InternalMessageRecipientService,
+	messageTemplateService *service.MessageTemplateService,
+	conversationService *service.ConversationService,
 	adminLoginRestrictionService *service.AdminLoginRestrictionService,
 	userProfileService *service.UserProfileService,
 	apiResourceService *service.ApiResourceService,
+	clusterService *service.ClusterService,
+	rateLimitService *service.RateLimitService,
+	tenantUsageService *service.TenantUsageService,
+	limiter *ratelimit.Limiter,
+	elector *cluster.Elector,
 ) *http.Server {
 	if cfg == nil || cfg.Server == nil || cfg.Server.Rest == nil {
 		return nil
 	}
 
 	srv := rpc.CreateRestServer(cfg,
-		newRestMiddleware(logger, authenticator, authorizer, operationLogRepo, loginLogRepo)...,
+		newRestMiddleware(logger, authenticator, authorizer, operationLogRepo, loginLogRepo, tenantService, tenantUsageService, limiter)...,
 	)
 
 	adminV1.RegisterAuthenticationServiceHTTPServer(srv, authnSvc)
@@ -120,6 +205,8 @@ func NewRESTServer(
 	adminV1.RegisterTaskServiceHTTPServer(srv, taskService)
 	adminV1.RegisterAdminLoginRestrictionServiceHTTPServer(srv, adminLoginRestrictionService)
 	adminV1.RegisterApiResourceServiceHTTPServer(srv, apiResourceService)
+	adminV1.RegisterClusterServiceHTTPServer(srv, clusterService)
+	adminV1.RegisterRateLimitServiceHTTPServer(srv, rateLimitService)
 
 	apiResourceService.RestServer = srv
 
@@ -129,6 +216,9 @@ func NewRESTServer(
 	adminV1.RegisterPositionServiceHTTPServer(srv, positionSvc)
 	adminV1.RegisterDepartmentServiceHTTPServer(srv, deptSvc)
 	adminV1.RegisterTenantServiceHTTPServer(srv, tenantService)
+	adminV1.RegisterTenantPlanServiceHTTPServer(srv, tenantPlanService)
+	adminV1.RegisterTenantUsageServiceHTTPServer(srv, tenantUsageService)
+	adminV1.RegisterSettingServiceHTTPServer(srv, settingService)
 
 	adminV1.RegisterAdminLoginLogServiceHTTPServer(srv, adminLoginLogSvc)
 	adminV1.RegisterAdminOperationLogServiceHTTPServer(srv, adminOperationLogSvc)
@@ -141,9 +231,12 @@ func NewRESTServer(
 	adminV1.RegisterInternalMessageServiceHTTPServer(srv, internalMessageService)
 	adminV1.RegisterInternalMessageCategoryServiceHTTPServer(srv, internalMessageCategoryService)
 	adminV1.RegisterInternalMessageRecipientServiceHTTPServer(srv, internalMessageRecipientService)
+	adminV1.RegisterMessageTemplateServiceHTTPServer(srv, messageTemplateService)
+	adminV1.RegisterConversationServiceHTTPServer(srv, conversationService)
 
 	registerFileUploadHandler(srv, ossSvc)
 	registerUEditorUploadHandler(srv, ueditorSvc)
+	registerTenantUsageExportHandler(srv, tenantUsageService)
 
 	if cfg.GetServer().GetRest().GetEnableSwagger() {
 		swaggerUI.RegisterSwaggerUIServerWithOption(
@@ -154,12 +247,32 @@ func NewRESTServer(
 	}
 
 	// Trigger policy reload after all services are initialized to ensure that the policy rules are up to date.
-	ctx := context.Background()
+	ctx := viewer.NewSystemViewerContext(context.Background())
 	if err := authorizer.ResetPolicies(ctx); err != nil {
 		log.Errorf("Failed to reload policies after service initialization: %v", err)
 	} else {
 		log.Info("Successfully reloaded policies after service initialization")
 	}
 
+	// 默认数据初始化与周期任务调度只在领导者上执行，领导者宕机后由新的领导者接管
+	elector.OnStartedLeading(func(ctx context.Context) {
+		ctx = viewer.NewSystemViewerContext(ctx)
+
+		roleSvc.EnsureDefaultRoles(ctx)
+		userSvc.EnsureDefaultUser(ctx)
+
+		// 默认角色可能刚刚创建，通知所有实例重新加载权限策略
+		if err := authorizer.ReloadPolicies(ctx); err != nil {
+			log.Errorf("Failed to reload policies after leader election: %v", err)
+		}
+
+		taskService.EnsureDefaultTasks(ctx)
+		taskService.StartScheduling(ctx)
+
+		// 补投队列中丢失的定时消息
+		internalMessageService.EnsureScheduledMessages(ctx)
+	})
+	elector.OnStoppedLeading(taskService.StopScheduling)
+
 	return srv
 }
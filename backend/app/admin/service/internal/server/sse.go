@@ -1,33 +1,86 @@
 package server
 
 import (
+	"context"
+	"errors"
+	"net/http"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
 
+	authnEngine "github.com/tx7do/kratos-authn/engine"
 	conf "github.com/tx7do/kratos-bootstrap/api/gen/go/conf/v1"
-	"github.com/tx7do/kratos-transport/transport/sse"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	"go-wind-admin/pkg/jwt"
+	"go-wind-admin/pkg/sse"
+)
+
+const (
+	sseTicketKeyPrefix = "sse:ticket:"
+	sseBrokerKeyPrefix = "sse:broker:"
 )
 
 // NewSseServer creates a new SSE server.
-func NewSseServer(cfg *conf.Bootstrap, logger log.Logger) *sse.Server {
+// 连接通过请求头 Authorization 中的访问令牌，或者登录后换取的一次性票据认证，
+// 访问令牌不再作为流ID出现在URL中。
+// 事件通过Redis在多个实例之间分发，并保存在Redis Stream中供断线重连后按 Last-Event-ID 重放，
+// 心跳间隔、空闲超时与重放数量来自 server.sse 配置
+func NewSseServer(
+	cfg *conf.Bootstrap,
+	sseCfg *sse.Config,
+	logger log.Logger,
+	rdb *redis.Client,
+	authenticator authnEngine.Authenticator,
+	userToken *data.UserTokenCacheRepo,
+) *sse.Server {
 	if cfg == nil || cfg.Server == nil || cfg.Server.Sse == nil {
 		return nil
 	}
 
-	l := log.NewHelper(log.With(logger, "module", "sse-server/admin-service"))
-
-	s := sse.NewServer(
+	opts := []sse.Option{
+		sse.WithLogger(logger),
 		sse.WithAddress(cfg.Server.Sse.GetAddr()),
-		sse.WithCodec(cfg.Server.Sse.GetCodec()),
 		sse.WithPath(cfg.Server.Sse.GetPath()),
-		sse.WithAutoStream(true),
-		sse.WithAutoReply(false),
-		sse.WithSubscriberFunction(func(streamID sse.StreamID, sub *sse.Subscriber) {
-			//l.Infof("SSE: [%s]", sub.URL)
-			l.Infof("subscriber [%s] connected", streamID)
-		}),
-	)
+		sse.WithAuthenticator(newSseAuthenticator(authenticator, userToken)),
+		sse.WithTicketStore(sse.NewRedisTicketStore(rdb, sseTicketKeyPrefix)),
+		sse.WithBroker(sse.NewRedisBroker(rdb, sseBrokerKeyPrefix, 0, 0, logger)),
+		sse.WithSessionValidator(func(ctx context.Context, identity *sse.Identity) bool {
+			return userToken.IsExistSession(ctx, identity.UserID, identity.SessionID)
+		}, 0),
+	}
 
-	//s.CreateStream("test")
+	return sse.NewServer(append(opts, sseCfg.Options()...)...)
+}
+
+// newSseAuthenticator 使用与REST接口相同的认证器校验访问令牌，并要求令牌没有被登出或吊销
+func newSseAuthenticator(authenticator authnEngine.Authenticator, userToken *data.UserTokenCacheRepo) sse.Authenticator {
+	return func(r *http.Request) (*sse.Identity, error) {
+		token := sse.BearerToken(r)
+		if token == "" {
+			return nil, errors.New("missing access token")
+		}
+
+		claims, err := authenticator.AuthenticateToken(token)
+		if err != nil {
+			return nil, err
+		}
 
-	return s
+		payload, err := jwt.NewUserTokenPayloadWithClaims(claims)
+		if err != nil {
+			return nil, err
+		}
+
+		if !userToken.IsExistAccessToken(r.Context(), payload.GetUserId(), token) {
+			return nil, errors.New("access token has been revoked")
+		}
+
+		return &sse.Identity{
+			UserID:    payload.GetUserId(),
+			TenantID:  payload.GetTenantId(),
+			SessionID: sse.SessionID(token),
+			Roles:     payload.GetRoles(),
+		}, nil
+	}
 }
@@ -81,7 +81,7 @@ func (s *ApiResourceService) Create(ctx context.Context, req *adminV1.CreateApiR
 	}
 
 	// 重置权限策略
-	if err = s.authorizer.ResetPolicies(ctx); err != nil {
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,7 @@ func (s *ApiResourceService) Update(ctx context.Context, req *adminV1.UpdateApiR
 	}
 
 	// 重置权限策略
-	if err = s.authorizer.ResetPolicies(ctx); err != nil {
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +119,7 @@ func (s *ApiResourceService) Delete(ctx context.Context, req *adminV1.DeleteApiR
 	}
 
 	// 重置权限策略
-	if err := s.authorizer.ResetPolicies(ctx); err != nil {
+	if err := s.authorizer.ReloadPolicies(ctx); err != nil {
 		return nil, err
 	}
 
@@ -138,7 +138,7 @@ func (s *ApiResourceService) SyncApiResources(ctx context.Context, _ *emptypb.Em
 	}
 
 	// 重置权限策略
-	if err := s.authorizer.ResetPolicies(ctx); err != nil {
+	if err := s.authorizer.ReloadPolicies(ctx); err != nil {
 		return nil, err
 	}
 
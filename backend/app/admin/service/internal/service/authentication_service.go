@@ -2,8 +2,15 @@ package service
 
 import (
 	"context"
+	"net"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/tx7do/go-utils/trans"
 	authnEngine "github.com/tx7do/kratos-authn/engine"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -16,8 +23,19 @@ import (
 
 	"go-wind-admin/pkg/jwt"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/settings"
+	"go-wind-admin/pkg/sse"
 )
 
+// tenantCodeHeader 登录时指定租户编码的请求头
+const tenantCodeHeader = "X-Tenant-Code"
+
+// impersonationTokenExpires 代管访问令牌的有效期
+const impersonationTokenExpires = 30 * time.Minute
+
+// impersonationReasonMaxLength 代管原因的最大字符数
+const impersonationReasonMaxLength = 200
+
 type AuthenticationService struct {
 	adminV1.AuthenticationServiceHTTPServer
 
@@ -26,10 +44,17 @@ type AuthenticationService struct {
 	roleRepo           *data.RoleRepo
 	tenantRepo         *data.TenantRepo
 
+	operationLogRepo *data.AdminOperationLogRepo
+
+	tenantService  *TenantService
+	settingService *SettingService
+
 	userToken *data.UserTokenCacheRepo
 
 	authenticator authnEngine.Authenticator
 
+	sseServer *sse.Server
+
 	log *log.Helper
 }
 
@@ -39,8 +64,12 @@ func NewAuthenticationService(
 	userCredentialRepo *data.UserCredentialRepo,
 	tenantRepo *data.TenantRepo,
 	roleRepo *data.RoleRepo,
+	operationLogRepo *data.AdminOperationLogRepo,
+	tenantService *TenantService,
+	settingService *SettingService,
 	userToken *data.UserTokenCacheRepo,
 	authenticator authnEngine.Authenticator,
+	sseServer *sse.Server,
 ) *AuthenticationService {
 	l := log.NewHelper(log.With(logger, "module", "authn/service/admin-service"))
 	return &AuthenticationService{
@@ -49,8 +78,12 @@ func NewAuthenticationService(
 		userCredentialRepo: userCredentialRepo,
 		tenantRepo:         tenantRepo,
 		roleRepo:           roleRepo,
+		operationLogRepo:   operationLogRepo,
+		tenantService:      tenantService,
+		settingService:     settingService,
 		userToken:          userToken,
 		authenticator:      authenticator,
+		sseServer:          sseServer,
 	}
 }
 
@@ -86,21 +119,99 @@ func (s *AuthenticationService) checkAuthority(user *userV1.User) error {
 	return nil
 }
 
+// resolveLoginTenant 识别登录的租户，依次使用请求中的租户编码、请求头 X-Tenant-Code 与子域名，都没有时为平台（租户ID为0）。
+// 明确指定的租户不存在时返回错误，子域名不是租户编码时按平台处理
+func (s *AuthenticationService) resolveLoginTenant(ctx context.Context, tenantCode string) (uint32, error) {
+	tenantCode = strings.TrimSpace(tenantCode)
+
+	tr, hasTransport := transport.FromServerContext(ctx)
+	if tenantCode == "" && hasTransport {
+		tenantCode = strings.TrimSpace(tr.RequestHeader().Get(tenantCodeHeader))
+	}
+
+	explicit := tenantCode != ""
+	if !explicit && hasTransport {
+		host := tr.RequestHeader().Get("X-Forwarded-Host")
+		if host == "" {
+			if r, ok := http.RequestFromServerContext(ctx); ok {
+				host = r.Host
+			}
+		}
+		tenantCode = tenantCodeFromHost(host)
+	}
+
+	if tenantCode == "" {
+		return 0, nil
+	}
+
+	tenantId, exist, err := s.tenantRepo.GetIdByCode(ctx, tenantCode)
+	if err != nil {
+		return 0, err
+	}
+	if !exist {
+		if explicit {
+			return 0, userV1.ErrorTenantNotFound("租户不存在")
+		}
+		return 0, nil
+	}
+
+	return tenantId, nil
+}
+
+// tenantCodeFromHost 从形如 {租户编码}.admin.example.com 的域名中取得租户编码，IP地址与二级及以下的域名没有子域名
+func tenantCodeFromHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "" || net.ParseIP(host) != nil {
+		return ""
+	}
+
+	labels := strings.Split(host, ".")
+	if len(labels) < 3 {
+		return ""
+	}
+
+	return strings.ToLower(labels[0])
+}
+
+// sessionTimeout 租户配置的会话超时，0表示使用系统的令牌有效期
+func sessionTimeout(values settings.Values) time.Duration {
+	return time.Duration(values.Int(settings.KeySessionTimeoutMinutes)) * time.Minute
+}
+
 // doGrantTypePassword 处理授权类型 - 密码
 func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
-	var err error
+	// 用户名在租户内唯一，需要先识别租户
+	tenantId, err := s.resolveLoginTenant(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := s.settingService.Resolve(ctx, tenantId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(values.Strings(settings.KeyLoginMethods), settings.LoginMethodPassword) {
+		return nil, authenticationV1.ErrorForbidden("未启用密码登录")
+	}
+
 	if _, err = s.userCredentialRepo.VerifyCredential(ctx, &authenticationV1.VerifyCredentialRequest{
 		IdentityType: authenticationV1.UserCredential_USERNAME,
 		Identifier:   req.GetUsername(),
 		Credential:   req.GetPassword(),
 		NeedDecrypt:  true,
+		TenantId:     trans.Ptr(tenantId),
 	}); err != nil {
 		return nil, err
 	}
 
 	// 获取用户信息
 	var user *userV1.User
-	user, err = s.userRepo.Get(ctx, &userV1.GetUserRequest{QueryBy: &userV1.GetUserRequest_UserName{UserName: req.GetUsername()}})
+	user, err = s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy:  &userV1.GetUserRequest_UserName{UserName: req.GetUsername()},
+		TenantId: trans.Ptr(tenantId),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -110,6 +221,11 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 		return nil, err
 	}
 
+	// 验证租户状态
+	if err = s.tenantService.CheckTenant(ctx, user.GetTenantId()); err != nil {
+		return nil, err
+	}
+
 	roleCodes, err := s.roleRepo.ListRoleCodesByRoleIds(ctx, user.GetRoleIds())
 	if err != nil {
 		s.log.Errorf("get user role codes failed [%s]", err.Error())
@@ -119,7 +235,7 @@ func (s *AuthenticationService) doGrantTypePassword(ctx context.Context, req *au
 	}
 
 	// 生成令牌
-	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, user, req.GetClientId())
+	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, user, req.GetClientId(), sessionTimeout(values))
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +255,11 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		return nil, err
 	}
 
+	// 代管令牌到期后需要重新代管
+	if jwt.IsImpersonated(operator) {
+		return nil, authenticationV1.ErrorForbidden("代管令牌不能刷新")
+	}
+
 	// 获取用户信息
 	user, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
 		QueryBy: &userV1.GetUserRequest_Id{
@@ -154,6 +275,11 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		return nil, err
 	}
 
+	// 验证租户状态
+	if err = s.tenantService.CheckTenant(ctx, user.GetTenantId()); err != nil {
+		return nil, err
+	}
+
 	// 校验刷新令牌
 	if !s.userToken.IsExistRefreshToken(ctx, operator.UserId, req.GetRefreshToken()) {
 		return nil, authenticationV1.ErrorIncorrectRefreshToken("invalid refresh token")
@@ -171,8 +297,13 @@ func (s *AuthenticationService) doGrantTypeRefreshToken(ctx context.Context, req
 		user.Roles = roleCodes
 	}
 
+	values, err := s.settingService.Resolve(ctx, user.GetTenantId(), 0)
+	if err != nil {
+		return nil, err
+	}
+
 	// 生成令牌
-	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, user, req.GetClientId())
+	accessToken, refreshToken, err := s.userToken.GenerateToken(ctx, user, req.GetClientId(), sessionTimeout(values))
 	if err != nil {
 		return nil, authenticationV1.ErrorServiceUnavailable("generate token failed")
 	}
@@ -197,13 +328,62 @@ func (s *AuthenticationService) Logout(ctx context.Context, _ *emptypb.Empty) (*
 		return nil, err
 	}
 
+	// 退出代管只作废代管令牌，被代管用户自己的登录不受影响
+	if jwt.IsImpersonated(operator) {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			token := sse.ParseBearer(tr.RequestHeader().Get("Authorization"))
+			if err = s.userToken.RemoveAccessToken(ctx, operator.UserId, token); err != nil {
+				s.log.Errorf("remove impersonation token failed [%s]", err.Error())
+			}
+		}
+		return &emptypb.Empty{}, nil
+	}
+
 	if err = s.userToken.RemoveToken(ctx, operator.UserId); err != nil {
 		return nil, err
 	}
 
+	// 令牌已经失效，断开用户的SSE连接
+	s.sseServer.CloseUser(operator.UserId)
+
 	return &emptypb.Empty{}, nil
 }
 
+// CreateSseTicket 创建SSE连接票据，票据绑定当前的登录会话，只能使用一次
+func (s *AuthenticationService) CreateSseTicket(ctx context.Context, _ *emptypb.Empty) (*authenticationV1.CreateSseTicketResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return nil, authenticationV1.ErrorUnauthorized("missing access token")
+	}
+
+	token := sse.ParseBearer(tr.RequestHeader().Get("Authorization"))
+	if token == "" {
+		return nil, authenticationV1.ErrorUnauthorized("missing access token")
+	}
+
+	ticket, ttl, err := s.sseServer.IssueTicket(ctx, &sse.Identity{
+		UserID:    operator.GetUserId(),
+		TenantID:  operator.GetTenantId(),
+		SessionID: sse.SessionID(token),
+		Roles:     operator.GetRoles(),
+	})
+	if err != nil {
+		s.log.Errorf("issue sse ticket failed: %s", err)
+		return nil, adminV1.ErrorServiceUnavailable("sse is not available")
+	}
+
+	return &authenticationV1.CreateSseTicketResponse{
+		Ticket:    ticket,
+		ExpiresIn: uint32(ttl.Seconds()),
+	}, nil
+}
+
 // RefreshToken 刷新令牌
 func (s *AuthenticationService) RefreshToken(ctx context.Context, req *authenticationV1.LoginRequest) (*authenticationV1.LoginResponse, error) {
 	// 校验授权类型
@@ -238,17 +418,41 @@ func (s *AuthenticationService) ValidateToken(_ context.Context, req *authentica
 
 // RegisterUser 注册前台用户
 func (s *AuthenticationService) RegisterUser(ctx context.Context, req *authenticationV1.RegisterUserRequest) (*authenticationV1.RegisterUserResponse, error) {
-	var err error
+	tenantId, err := s.resolveLoginTenant(ctx, req.GetTenantCode())
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := s.settingService.Resolve(ctx, tenantId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if !values.Bool(settings.KeySelfRegistration) {
+		return nil, authenticationV1.ErrorForbidden("未开放注册")
+	}
+	if err = settings.PasswordPolicyFrom(values).Check(req.GetPassword()); err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	// 用户名在租户内唯一
+	exist, err := s.userRepo.UserExists(ctx, &userV1.UserExistsRequest{
+		Username: req.GetUsername(),
+		TenantId: trans.Ptr(tenantId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if exist.GetExist() {
+		return nil, userV1.ErrorConflict("用户名已存在")
+	}
 
-	var tenantId *uint32
-	tenant, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{QueryBy: &userV1.GetTenantRequest_Code{Code: req.GetTenantCode()}})
-	if tenant != nil {
-		tenantId = tenant.Id
+	if err = s.tenantService.CheckUserQuota(ctx, tenantId, 1); err != nil {
+		return nil, err
 	}
 
 	user, err := s.userRepo.Create(ctx, &userV1.CreateUserRequest{
 		Data: &userV1.User{
-			TenantId:  tenantId,
+			TenantId:  trans.Ptr(tenantId),
 			Username:  trans.Ptr(req.Username),
 			Email:     req.Email,
 			Authority: trans.Ptr(userV1.User_CUSTOMER_USER),
@@ -291,9 +495,136 @@ func (s *AuthenticationService) WhoAmI(ctx context.Context, _ *emptypb.Empty) (*
 		return nil, err
 	}
 
-	return &authenticationV1.WhoAmIResponse{
-		UserId:    operator.GetUserId(),
-		Username:  operator.GetUsername(),
-		Authority: operator.GetAuthority(),
+	resp := &authenticationV1.WhoAmIResponse{
+		UserId:       operator.GetUserId(),
+		Username:     operator.GetUsername(),
+		Authority:    operator.GetAuthority(),
+		TenantId:     operator.TenantId,
+		Impersonated: jwt.IsImpersonated(operator),
+		ActUserId:    operator.ActUserId,
+		ActUsername:  operator.ActUsername,
+	}
+
+	// 代管原因只记录在审计日志中
+	if operator.GetActAuditId() != 0 {
+		audit, err := s.operationLogRepo.Get(ctx, &adminV1.GetAdminOperationLogRequest{
+			QueryBy: &adminV1.GetAdminOperationLogRequest_Id{Id: operator.GetActAuditId()},
+		})
+		if err != nil {
+			s.log.Errorf("get impersonation audit log [%d] failed [%s]", operator.GetActAuditId(), err.Error())
+		} else {
+			resp.ActReason = audit.ImpersonationReason
+		}
+	}
+
+	if operator.GetTenantId() != 0 {
+		tenant, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{
+			QueryBy: &userV1.GetTenantRequest_Id{Id: operator.GetTenantId()},
+		})
+		if err != nil {
+			s.log.Errorf("get tenant [%d] failed [%s]", operator.GetTenantId(), err.Error())
+		} else {
+			resp.TenantName = tenant.Name
+		}
+	}
+
+	return resp, nil
+}
+
+// Impersonate 平台管理员代管租户：以租户用户（默认为租户管理员）的身份签发限时的访问令牌，不签发刷新令牌。
+// 令牌中记录代管操作人与代管原因，代管期间的每一条操作日志都会记录下来
+func (s *AuthenticationService) Impersonate(ctx context.Context, req *authenticationV1.ImpersonateRequest) (*authenticationV1.LoginResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 只有平台管理员可以代管，代管令牌不能再次代管
+	if operator.GetAuthority() != userV1.User_SYS_ADMIN || operator.GetTenantId() != 0 || jwt.IsImpersonated(operator) {
+		return nil, authenticationV1.ErrorForbidden("只有平台管理员可以代管租户")
+	}
+
+	reason := strings.TrimSpace(req.GetReason())
+	if reason == "" {
+		return nil, adminV1.ErrorBadRequest("请填写代管原因")
+	}
+	if utf8.RuneCountInString(reason) > impersonationReasonMaxLength {
+		return nil, adminV1.ErrorBadRequest("代管原因不能超过%d个字符", impersonationReasonMaxLength)
+	}
+	if req.GetTenantId() == 0 {
+		return nil, adminV1.ErrorBadRequest("请选择代管的租户")
+	}
+
+	// 不可用的租户不能代管
+	if err = s.tenantService.CheckTenant(ctx, req.GetTenantId()); err != nil {
+		return nil, err
+	}
+
+	userId := req.GetUserId()
+	if userId == 0 {
+		tenant, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{
+			QueryBy: &userV1.GetTenantRequest_Id{Id: req.GetTenantId()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if userId = tenant.GetAdminUserId(); userId == 0 {
+			return nil, adminV1.ErrorBadRequest("租户没有管理员，请指定代管的用户")
+		}
+	}
+
+	user, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: userId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if user.GetTenantId() != req.GetTenantId() {
+		return nil, adminV1.ErrorBadRequest("用户不属于该租户")
+	}
+
+	// 被代管的用户需要能够登录后台
+	if err = s.checkAuthority(user); err != nil {
+		return nil, err
+	}
+
+	roleCodes, err := s.roleRepo.ListRoleCodesByRoleIds(ctx, user.GetRoleIds())
+	if err != nil {
+		s.log.Errorf("get user role codes failed [%s]", err.Error())
+	}
+	if roleCodes != nil {
+		user.Roles = roleCodes
+	}
+
+	// 代管原因记录在审计日志中，令牌只携带审计日志的ID，代管期间的操作日志据此关联到代管原因
+	auditId, err := s.operationLogRepo.CreateAudit(ctx, &adminV1.CreateAdminOperationLogRequest{
+		Data: &adminV1.AdminOperationLog{
+			Operation:           trans.Ptr(adminV1.OperationAuthenticationServiceImpersonate),
+			UserId:              trans.Ptr(user.GetId()),
+			Username:            user.Username,
+			ImpersonatorId:      trans.Ptr(operator.GetUserId()),
+			ImpersonatorName:    operator.Username,
+			ImpersonationReason: trans.Ptr(reason),
+			ClientId:            req.ClientId,
+			Success:             trans.Ptr(true),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.userToken.GenerateImpersonationToken(ctx, user, operator, req.GetClientId(), auditId, impersonationTokenExpires)
+	if err != nil {
+		return nil, authenticationV1.ErrorServiceUnavailable("generate token failed")
+	}
+
+	s.log.Infof("user [%d] [%s] impersonates user [%d] [%s] of tenant [%d], audit log [%d]",
+		operator.GetUserId(), operator.GetUsername(), user.GetId(), user.GetUsername(), user.GetTenantId(), auditId)
+
+	return &authenticationV1.LoginResponse{
+		TokenType:   authenticationV1.TokenType_bearer,
+		AccessToken: accessToken,
+		ExpiresIn:   trans.Ptr(int64(impersonationTokenExpires.Seconds())),
 	}, nil
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/cluster"
+)
+
+type ClusterService struct {
+	adminV1.ClusterServiceHTTPServer
+
+	log *log.Helper
+
+	elector *cluster.Elector
+}
+
+func NewClusterService(logger log.Logger, elector *cluster.Elector) *ClusterService {
+	l := log.NewHelper(log.With(logger, "module", "cluster/service/admin-service"))
+	return &ClusterService{
+		log:     l,
+		elector: elector,
+	}
+}
+
+// GetLeaderStatus 查询领导者选举状态
+func (s *ClusterService) GetLeaderStatus(ctx context.Context, _ *emptypb.Empty) (*adminV1.LeaderStatus, error) {
+	status, err := s.elector.Status(ctx)
+	if err != nil {
+		s.log.Errorf("query leader status failed [%s]", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query leader status failed")
+	}
+
+	resp := &adminV1.LeaderStatus{
+		InstanceId: status.InstanceID,
+		LeaderId:   status.Leader,
+		IsLeader:   status.IsLeader,
+		LeaseTtl:   durationpb.New(status.LeaseTTL),
+	}
+	if !status.LeaderSince.IsZero() {
+		resp.LeaderSince = timestamppb.New(status.LeaderSince)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,907 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/sse"
+)
+
+const (
+	defaultConversationPageSize   = 20
+	defaultConversationMessageNum = 50
+	maxConversationMessageNum     = 200
+)
+
+// 会话事件，通过SSE推送给会话成员
+const (
+	conversationEventMessage        = "conversation_message"
+	conversationEventMessageRemoved = "conversation_message_removed"
+	conversationEventRead           = "conversation_read"
+	conversationEventTyping         = "conversation_typing"
+	conversationEventMembers        = "conversation_members"
+)
+
+type ConversationService struct {
+	adminV1.ConversationServiceHTTPServer
+
+	log *log.Helper
+
+	conversationRepo    *data.ConversationRepo
+	internalMessageRepo *data.InternalMessageRepo
+	userRepo            *data.UserRepo
+
+	sseServer *sse.Server
+}
+
+func NewConversationService(
+	logger log.Logger,
+	conversationRepo *data.ConversationRepo,
+	internalMessageRepo *data.InternalMessageRepo,
+	userRepo *data.UserRepo,
+	sseServer *sse.Server,
+) *ConversationService {
+	l := log.NewHelper(log.With(logger, "module", "conversation/service/admin-service"))
+	return &ConversationService{
+		log:                 l,
+		conversationRepo:    conversationRepo,
+		internalMessageRepo: internalMessageRepo,
+		userRepo:            userRepo,
+		sseServer:           sseServer,
+	}
+}
+
+// List 查询当前用户的会话列表
+func (s *ConversationService) List(ctx context.Context, req *pagination.PagingRequest) (*internalMessageV1.ListConversationResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := int(req.GetPage()), int(req.GetPageSize())
+	if req.GetNoPaging() {
+		pageSize = 0
+	} else if pageSize == 0 {
+		pageSize = defaultConversationPageSize
+	}
+
+	items, total, err := s.conversationRepo.ListByMember(ctx, operator.GetUserId(), page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillConversations(ctx, operator.GetUserId(), items)
+
+	return &internalMessageV1.ListConversationResponse{
+		Items: items,
+		Total: total,
+	}, nil
+}
+
+// Get 查询会话详情
+func (s *ConversationService) Get(ctx context.Context, req *internalMessageV1.GetConversationRequest) (*internalMessageV1.Conversation, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if member != nil {
+		conversation.UnreadCount = member.UnreadCount
+		conversation.Role = member.Role
+		conversation.MutedUntil = member.MutedUntil
+	}
+
+	s.fillConversations(ctx, operator.GetUserId(), []*internalMessageV1.Conversation{conversation})
+
+	return conversation, nil
+}
+
+// Create 创建会话
+func (s *ConversationService) Create(ctx context.Context, req *internalMessageV1.CreateConversationRequest) (*internalMessageV1.Conversation, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var memberIds []uint32
+	for _, uid := range req.GetMemberUserIds() {
+		if uid != operator.GetUserId() && !slices.Contains(memberIds, uid) {
+			memberIds = append(memberIds, uid)
+		}
+	}
+
+	if err = s.checkUsersExist(ctx, memberIds); err != nil {
+		return nil, err
+	}
+
+	var conversation *internalMessageV1.Conversation
+	switch req.GetType() {
+	case internalMessageV1.Conversation_DIRECT:
+		if len(memberIds) != 1 {
+			return nil, adminV1.ErrorBadRequest("direct conversation requires exactly one other member")
+		}
+		if conversation, err = s.getOrCreateDirect(ctx, operator.GetUserId(), memberIds[0]); err != nil {
+			return nil, err
+		}
+
+	default:
+		if len(memberIds) == 0 {
+			return nil, adminV1.ErrorBadRequest("group conversation requires members")
+		}
+		if conversation, err = s.conversationRepo.Create(ctx, &internalMessageV1.Conversation{
+			Type:      trans.Ptr(internalMessageV1.Conversation_GROUP),
+			Name:      req.Name,
+			AvatarUrl: req.AvatarUrl,
+		}, operator.GetUserId(), memberIds); err != nil {
+			return nil, err
+		}
+
+		s.publishEvent(ctx, memberIds, conversationEventMembers, &internalMessageV1.ConversationEvent{
+			ConversationId: conversation.GetId(),
+			UserId:         operator.GetUserId(),
+		})
+	}
+
+	return s.Get(ctx, &internalMessageV1.GetConversationRequest{Id: conversation.GetId()})
+}
+
+// Update 更新群聊名称与头像，群主与群管理员可以修改
+func (s *ConversationService) Update(ctx context.Context, req *internalMessageV1.UpdateConversationRequest) (*emptypb.Empty, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+	if !canManage(operator, member) {
+		return nil, adminV1.ErrorForbidden("only owner or admins can update the conversation")
+	}
+
+	if err = s.conversationRepo.Update(ctx, req.GetId(), req.Name, req.AvatarUrl, operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMember 查询会话成员
+func (s *ConversationService) ListMember(ctx context.Context, req *internalMessageV1.ListConversationMemberRequest) (*internalMessageV1.ListConversationMemberResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err = s.checkAccess(ctx, operator, req.GetConversationId()); err != nil {
+		return nil, err
+	}
+
+	members, err := s.conversationRepo.ListMembers(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+
+	userIds := make([]uint32, 0, len(members))
+	for _, member := range members {
+		userIds = append(userIds, member.GetUserId())
+	}
+
+	users := s.queryUsers(ctx, userIds)
+	for _, member := range members {
+		if user, ok := users[member.GetUserId()]; ok {
+			member.UserName = user.Username
+			member.Nickname = trans.Ptr(userDisplayName(user))
+		}
+	}
+
+	return &internalMessageV1.ListConversationMemberResponse{
+		Items: members,
+		Total: uint64(len(members)),
+	}, nil
+}
+
+// AddMembers 添加群聊成员，群主与群管理员可以添加
+func (s *ConversationService) AddMembers(ctx context.Context, req *internalMessageV1.AddConversationMembersRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+	if !canManage(operator, member) {
+		return nil, adminV1.ErrorForbidden("only owner or admins can add members")
+	}
+
+	if err = s.checkUsersExist(ctx, req.GetUserIds()); err != nil {
+		return nil, err
+	}
+
+	added, err := s.conversationRepo.AddMembers(ctx, req.GetConversationId(), req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(added) > 0 {
+		s.publishMembersChanged(ctx, req.GetConversationId(), operator.GetUserId())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// RemoveMember 移除群聊成员，群主可以移除管理员与成员，群管理员只能移除普通成员
+func (s *ConversationService) RemoveMember(ctx context.Context, req *internalMessageV1.RemoveConversationMemberRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+
+	target, err := s.conversationRepo.GetMember(ctx, req.GetConversationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if target.GetRole() == internalMessageV1.ConversationMember_OWNER {
+		return nil, adminV1.ErrorBadRequest("the owner cannot be removed, transfer ownership first")
+	}
+	if !canModerate(operator, member, target) {
+		return nil, adminV1.ErrorForbidden("no permission to remove the member")
+	}
+
+	// 先通知，移除后该成员就收不到事件了
+	s.publishMembersChanged(ctx, req.GetConversationId(), operator.GetUserId())
+
+	if err = s.conversationRepo.RemoveMember(ctx, req.GetConversationId(), req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// UpdateMemberRole 修改群聊成员角色，只有群主可以修改，设置新的群主即转让群主
+func (s *ConversationService) UpdateMemberRole(ctx context.Context, req *internalMessageV1.UpdateConversationMemberRoleRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+	if !isModerator(operator) && member.GetRole() != internalMessageV1.ConversationMember_OWNER {
+		return nil, adminV1.ErrorForbidden("only the owner can change member roles")
+	}
+
+	target, err := s.conversationRepo.GetMember(ctx, req.GetConversationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if target.GetRole() == internalMessageV1.ConversationMember_OWNER && req.GetRole() != internalMessageV1.ConversationMember_OWNER {
+		return nil, adminV1.ErrorBadRequest("transfer ownership to another member instead")
+	}
+
+	if err = s.conversationRepo.UpdateMemberRole(ctx, req.GetConversationId(), req.GetUserId(), req.GetRole()); err != nil {
+		return nil, err
+	}
+
+	s.publishMembersChanged(ctx, req.GetConversationId(), operator.GetUserId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// MuteMember 禁言或解除禁言群聊成员，被禁言的成员不能发送消息
+func (s *ConversationService) MuteMember(ctx context.Context, req *internalMessageV1.MuteConversationMemberRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, member, err := s.checkAccess(ctx, operator, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+
+	target, err := s.conversationRepo.GetMember(ctx, req.GetConversationId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if !canModerate(operator, member, target) {
+		return nil, adminV1.ErrorForbidden("no permission to mute the member")
+	}
+
+	var until *time.Time
+	if req.GetDurationSeconds() > 0 {
+		until = trans.Ptr(time.Now().Add(time.Duration(req.GetDurationSeconds()) * time.Second))
+	}
+
+	if err = s.conversationRepo.MuteMember(ctx, req.GetConversationId(), req.GetUserId(), until); err != nil {
+		return nil, err
+	}
+
+	s.publishMembersChanged(ctx, req.GetConversationId(), operator.GetUserId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// Leave 退出群聊，群主需要先转让群主，最后一个成员可以直接退出
+func (s *ConversationService) Leave(ctx context.Context, req *internalMessageV1.LeaveConversationRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversation, err := s.conversationRepo.Get(ctx, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+	if err = checkGroup(conversation); err != nil {
+		return nil, err
+	}
+
+	member, err := s.conversationRepo.GetMember(ctx, req.GetConversationId(), operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if member.GetRole() == internalMessageV1.ConversationMember_OWNER && conversation.GetMemberCount() > 1 {
+		return nil, adminV1.ErrorBadRequest("the owner must transfer ownership before leaving")
+	}
+
+	if err = s.conversationRepo.RemoveMember(ctx, req.GetConversationId(), operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	s.publishMembersChanged(ctx, req.GetConversationId(), operator.GetUserId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMessage 查询会话消息，已删除的消息不返回标题与内容
+func (s *ConversationService) ListMessage(ctx context.Context, req *internalMessageV1.ListConversationMessageRequest) (*internalMessageV1.ListConversationMessageResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, _, err = s.checkAccess(ctx, operator, req.GetConversationId()); err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultConversationMessageNum
+	}
+	if limit > maxConversationMessageNum {
+		limit = maxConversationMessageNum
+	}
+
+	items, hasMore, err := s.internalMessageRepo.ListConversationMessages(ctx, req.GetConversationId(), req.ThreadId, req.GetBeforeId(), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillMessages(ctx, items)
+
+	return &internalMessageV1.ListConversationMessageResponse{
+		Items:   items,
+		HasMore: hasMore,
+	}, nil
+}
+
+// SendMessage 发送会话消息
+func (s *ConversationService) SendMessage(ctx context.Context, req *internalMessageV1.SendConversationMessageRequest) (*internalMessageV1.InternalMessage, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.send(ctx, operator.GetUserId(), req.GetConversationId(), req.Title, req.GetContent(), req.ThreadId)
+}
+
+// RemoveMessage 删除会话消息，发送者本人、群主、群管理员与系统管理员可以删除
+func (s *ConversationService) RemoveMessage(ctx context.Context, req *internalMessageV1.RemoveConversationMessageRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, member, err := s.checkAccess(ctx, operator, req.GetConversationId())
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: req.GetMessageId()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if msg.GetConversationId() != req.GetConversationId() {
+		return nil, internalMessageV1.ErrorNotFound("message not found")
+	}
+	if msg.GetSenderId() != operator.GetUserId() && !canManage(operator, member) {
+		return nil, adminV1.ErrorForbidden("no permission to remove the message")
+	}
+
+	if err = s.internalMessageRepo.RemoveConversationMessage(ctx, req.GetConversationId(), req.GetMessageId(), operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	s.publishToConversation(ctx, req.GetConversationId(), 0, conversationEventMessageRemoved, &internalMessageV1.ConversationEvent{
+		ConversationId: req.GetConversationId(),
+		UserId:         operator.GetUserId(),
+		ThreadId:       msg.ThreadId,
+		MessageId:      trans.Ptr(req.GetMessageId()),
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// MarkRead 标记会话已读，并通知其他成员当前用户的已读位置
+func (s *ConversationService) MarkRead(ctx context.Context, req *internalMessageV1.MarkConversationReadRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messageId := req.GetMessageId()
+	if req.MessageId == nil {
+		conversation, err := s.conversationRepo.Get(ctx, req.GetConversationId())
+		if err != nil {
+			return nil, err
+		}
+		messageId = conversation.GetLastMessageId()
+	}
+
+	lastRead, unread, err := s.conversationRepo.MarkRead(ctx, req.GetConversationId(), operator.GetUserId(), messageId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publishToConversation(ctx, req.GetConversationId(), 0, conversationEventRead, &internalMessageV1.ConversationEvent{
+		ConversationId: req.GetConversationId(),
+		UserId:         operator.GetUserId(),
+		MessageId:      trans.Ptr(lastRead),
+		UnreadCount:    trans.Ptr(unread),
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// Typing 通知其他成员当前用户正在输入
+func (s *ConversationService) Typing(ctx context.Context, req *internalMessageV1.ConversationTypingRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.conversationRepo.GetMember(ctx, req.GetConversationId(), operator.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if isMuted(member) {
+		return &emptypb.Empty{}, nil
+	}
+
+	s.publishToConversation(ctx, req.GetConversationId(), operator.GetUserId(), conversationEventTyping, &internalMessageV1.ConversationEvent{
+		ConversationId: req.GetConversationId(),
+		UserId:         operator.GetUserId(),
+		ThreadId:       req.ThreadId,
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// sendFromRequest 处理通过站内信发送接口发送的私信与群聊消息：
+// 指定了会话时发送到该会话，否则私信发送到与接收者之间的私聊会话
+func (s *ConversationService) sendFromRequest(ctx context.Context, senderId uint32, req *internalMessageV1.SendMessageRequest) (*internalMessageV1.SendMessageResponse, error) {
+	conversationId := req.GetConversationId()
+	if req.ConversationId == nil {
+		if req.GetType() != internalMessageV1.InternalMessage_PRIVATE || req.RecipientUserId == nil {
+			return nil, adminV1.ErrorBadRequest("conversation id or recipient is required")
+		}
+		if req.GetRecipientUserId() == senderId {
+			return nil, adminV1.ErrorBadRequest("cannot send private message to yourself")
+		}
+		if err := s.checkUsersExist(ctx, []uint32{req.GetRecipientUserId()}); err != nil {
+			return nil, err
+		}
+
+		conversation, err := s.getOrCreateDirect(ctx, senderId, req.GetRecipientUserId())
+		if err != nil {
+			return nil, err
+		}
+		conversationId = conversation.GetId()
+	}
+
+	msg, err := s.send(ctx, senderId, conversationId, req.Title, req.GetContent(), req.ThreadId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalMessageV1.SendMessageResponse{
+		MessageId: msg.GetId(),
+	}, nil
+}
+
+// send 保存会话消息并推送给所有成员
+func (s *ConversationService) send(ctx context.Context, senderId, conversationId uint32, title *string, content string, threadId *uint32) (*internalMessageV1.InternalMessage, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, adminV1.ErrorBadRequest("message content is required")
+	}
+
+	conversation, err := s.conversationRepo.Get(ctx, conversationId)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.conversationRepo.GetMember(ctx, conversationId, senderId)
+	if err != nil {
+		if internalMessageV1.IsNotFound(err) {
+			return nil, adminV1.ErrorForbidden("not a member of the conversation")
+		}
+		return nil, err
+	}
+	if isMuted(member) {
+		return nil, adminV1.ErrorForbidden("you are muted in this conversation")
+	}
+
+	if threadId != nil {
+		var root *internalMessageV1.InternalMessage
+		if root, err = s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+			QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: *threadId},
+		}); err != nil {
+			return nil, err
+		}
+		// 话题只有一层，回复只能指向会话中的主消息
+		if root.GetConversationId() != conversationId || root.ThreadId != nil {
+			return nil, adminV1.ErrorBadRequest("invalid thread")
+		}
+		if root.GetStatus() == internalMessageV1.InternalMessage_REVOKED {
+			return nil, adminV1.ErrorBadRequest("the thread has been removed")
+		}
+	}
+
+	msgType := internalMessageV1.InternalMessage_PRIVATE
+	if conversation.GetType() == internalMessageV1.Conversation_GROUP {
+		msgType = internalMessageV1.InternalMessage_GROUP
+	}
+
+	messageId, err := s.conversationRepo.SendMessage(ctx, &internalMessageV1.InternalMessage{
+		Title:          title,
+		Content:        trans.Ptr(content),
+		Type:           trans.Ptr(msgType),
+		SenderId:       trans.Ptr(senderId),
+		ConversationId: trans.Ptr(conversationId),
+		ThreadId:       threadId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: messageId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillMessages(ctx, []*internalMessageV1.InternalMessage{msg})
+
+	// 每个成员收到的事件带有自己的未读数量
+	counts, err := s.conversationRepo.GetUnreadCounts(ctx, conversationId)
+	if err != nil {
+		s.log.Errorf("query unread counts of conversation [%d] failed: %s", conversationId, err)
+		return msg, nil
+	}
+	for userId, unread := range counts {
+		s.publishEvent(ctx, []uint32{userId}, conversationEventMessage, &internalMessageV1.ConversationEvent{
+			ConversationId: conversationId,
+			UserId:         senderId,
+			ThreadId:       threadId,
+			MessageId:      trans.Ptr(messageId),
+			Message:        msg,
+			UnreadCount:    trans.Ptr(unread),
+		})
+	}
+
+	return msg, nil
+}
+
+// getOrCreateDirect 获取两个用户之间的私聊会话，不存在时创建
+func (s *ConversationService) getOrCreateDirect(ctx context.Context, userId, peerUserId uint32) (*internalMessageV1.Conversation, error) {
+	conversation, err := s.conversationRepo.GetDirect(ctx, userId, peerUserId)
+	if err != nil {
+		return nil, err
+	}
+	if conversation != nil {
+		return conversation, nil
+	}
+
+	return s.conversationRepo.Create(ctx, &internalMessageV1.Conversation{
+		Type: trans.Ptr(internalMessageV1.Conversation_DIRECT),
+	}, userId, []uint32{peerUserId})
+}
+
+// checkAccess 检查操作人是否可以访问会话，会话成员与系统管理员可以访问。
+// 返回会话与操作人的成员信息，系统管理员不是成员时成员信息为空
+func (s *ConversationService) checkAccess(ctx context.Context, operator *authenticationV1.UserTokenPayload, conversationId uint32) (*internalMessageV1.Conversation, *internalMessageV1.ConversationMember, error) {
+	conversation, err := s.conversationRepo.Get(ctx, conversationId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member, err := s.conversationRepo.GetMember(ctx, conversationId, operator.GetUserId())
+	if err != nil {
+		if !internalMessageV1.IsNotFound(err) {
+			return nil, nil, err
+		}
+		if !isModerator(operator) {
+			return nil, nil, adminV1.ErrorForbidden("not a member of the conversation")
+		}
+		member = nil
+	}
+
+	return conversation, member, nil
+}
+
+// checkUsersExist 检查用户都存在
+func (s *ConversationService) checkUsersExist(ctx context.Context, userIds []uint32) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	users, err := s.userRepo.ListUsersByIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	for _, uid := range userIds {
+		if !slices.ContainsFunc(users, func(u *userV1.User) bool { return u.GetId() == uid }) {
+			return adminV1.ErrorBadRequest("user [%d] not found", uid)
+		}
+	}
+
+	return nil
+}
+
+// queryUsers 查询用户信息，查询失败时返回空结果
+func (s *ConversationService) queryUsers(ctx context.Context, userIds []uint32) map[uint32]*userV1.User {
+	result := make(map[uint32]*userV1.User, len(userIds))
+	if len(userIds) == 0 {
+		return result
+	}
+
+	users, err := s.userRepo.ListUsersByIds(ctx, userIds)
+	if err != nil {
+		s.log.Errorf("query users failed: %s", err)
+		return result
+	}
+	for _, user := range users {
+		result[user.GetId()] = user
+	}
+
+	return result
+}
+
+// fillConversations 填充私聊会话的对方信息与会话的最后一条消息
+func (s *ConversationService) fillConversations(ctx context.Context, userId uint32, conversations []*internalMessageV1.Conversation) {
+	var peerIds, messageIds []uint32
+	for _, c := range conversations {
+		if c.GetType() == internalMessageV1.Conversation_DIRECT && c.PeerUserId == nil {
+			c.PeerUserId = trans.Ptr(s.queryPeerUserId(ctx, c.GetId(), userId))
+		}
+		if c.GetPeerUserId() != 0 {
+			peerIds = append(peerIds, c.GetPeerUserId())
+		}
+		if c.LastMessageId != nil {
+			messageIds = append(messageIds, c.GetLastMessageId())
+		}
+	}
+
+	users := s.queryUsers(ctx, peerIds)
+
+	messages, err := s.internalMessageRepo.ListByIds(ctx, messageIds)
+	if err != nil {
+		s.log.Errorf("query last messages failed: %s", err)
+	}
+	s.fillMessages(ctx, messages)
+
+	messageMap := make(map[uint32]*internalMessageV1.InternalMessage, len(messages))
+	for _, msg := range messages {
+		messageMap[msg.GetId()] = msg
+	}
+
+	for _, c := range conversations {
+		if user, ok := users[c.GetPeerUserId()]; ok && c.GetName() == "" {
+			c.Name = trans.Ptr(userDisplayName(user))
+			if c.AvatarUrl == nil {
+				c.AvatarUrl = user.Avatar
+			}
+		}
+		if msg, ok := messageMap[c.GetLastMessageId()]; ok {
+			c.LastMessage = msg
+		}
+	}
+}
+
+// queryPeerUserId 查询私聊会话中对方的用户ID
+func (s *ConversationService) queryPeerUserId(ctx context.Context, conversationId, userId uint32) uint32 {
+	userIds, err := s.conversationRepo.ListMemberUserIds(ctx, conversationId)
+	if err != nil {
+		s.log.Errorf("query members of conversation [%d] failed: %s", conversationId, err)
+		return 0
+	}
+
+	for _, uid := range userIds {
+		if uid != userId {
+			return uid
+		}
+	}
+
+	return 0
+}
+
+// fillMessages 填充发送者名称，已删除的消息不返回标题与内容
+func (s *ConversationService) fillMessages(ctx context.Context, messages []*internalMessageV1.InternalMessage) {
+	var senderIds []uint32
+	for _, msg := range messages {
+		if msg.GetStatus() == internalMessageV1.InternalMessage_REVOKED {
+			msg.Title = nil
+			msg.Content = nil
+		}
+		if msg.SenderId != nil && !slices.Contains(senderIds, msg.GetSenderId()) {
+			senderIds = append(senderIds, msg.GetSenderId())
+		}
+	}
+
+	users := s.queryUsers(ctx, senderIds)
+	for _, msg := range messages {
+		if user, ok := users[msg.GetSenderId()]; ok {
+			msg.SenderName = trans.Ptr(userDisplayName(user))
+		}
+	}
+}
+
+// publishMembersChanged 通知会话成员成员列表发生了变化
+func (s *ConversationService) publishMembersChanged(ctx context.Context, conversationId, operatorId uint32) {
+	s.publishToConversation(ctx, conversationId, 0, conversationEventMembers, &internalMessageV1.ConversationEvent{
+		ConversationId: conversationId,
+		UserId:         operatorId,
+	})
+}
+
+// publishToConversation 向会话的所有成员推送事件，excludeUserId 不为0时不推送给该用户
+func (s *ConversationService) publishToConversation(ctx context.Context, conversationId, excludeUserId uint32, event string, payload *internalMessageV1.ConversationEvent) {
+	userIds, err := s.conversationRepo.ListMemberUserIds(ctx, conversationId)
+	if err != nil {
+		s.log.Errorf("query members of conversation [%d] failed: %s", conversationId, err)
+		return
+	}
+
+	if excludeUserId != 0 {
+		userIds = slices.DeleteFunc(userIds, func(uid uint32) bool { return uid == excludeUserId })
+	}
+
+	s.publishEvent(ctx, userIds, event, payload)
+}
+
+// publishEvent 向用户在线的客户端推送会话事件
+func (s *ConversationService) publishEvent(ctx context.Context, userIds []uint32, event string, payload *internalMessageV1.ConversationEvent) {
+	payloadJson, _ := json.Marshal(payload)
+
+	for _, userId := range userIds {
+		s.sseServer.PublishToUser(ctx, userId, &sse.Event{
+			ID:    uuid.New().String(),
+			Data:  payloadJson,
+			Event: event,
+		})
+	}
+}
+
+// isModerator 系统管理员与租户管理员可以管理所有会话
+func isModerator(operator *authenticationV1.UserTokenPayload) bool {
+	return operator.GetAuthority() == userV1.User_SYS_ADMIN || operator.GetAuthority() == userV1.User_TENANT_ADMIN
+}
+
+// canManage 群主、群管理员与系统管理员可以管理会话
+func canManage(operator *authenticationV1.UserTokenPayload, member *internalMessageV1.ConversationMember) bool {
+	if isModerator(operator) {
+		return true
+	}
+	return member.GetRole() == internalMessageV1.ConversationMember_OWNER ||
+		member.GetRole() == internalMessageV1.ConversationMember_ADMIN
+}
+
+// canModerate 操作人可以管理角色比自己低的成员，系统管理员可以管理所有成员
+func canModerate(operator *authenticationV1.UserTokenPayload, member, target *internalMessageV1.ConversationMember) bool {
+	if isModerator(operator) {
+		return true
+	}
+	return canManage(operator, member) && member.GetRole() > target.GetRole()
+}
+
+// isMuted 成员是否处于禁言中
+func isMuted(member *internalMessageV1.ConversationMember) bool {
+	return member.MutedUntil != nil && member.GetMutedUntil().AsTime().After(time.Now())
+}
+
+// checkGroup 成员管理只适用于群聊
+func checkGroup(conversation *internalMessageV1.Conversation) error {
+	if conversation.GetType() != internalMessageV1.Conversation_GROUP {
+		return adminV1.ErrorBadRequest("only group conversations support this operation")
+	}
+	return nil
+}
@@ -21,14 +21,16 @@ type FileService struct {
 
 	log *log.Helper
 
-	fileRepo *data.FileRepo
+	fileRepo      *data.FileRepo
+	tenantService *TenantService
 }
 
-func NewFileService(logger log.Logger, repo *data.FileRepo) *FileService {
+func NewFileService(logger log.Logger, repo *data.FileRepo, tenantService *TenantService) *FileService {
 	l := log.NewHelper(log.With(logger, "module", "file/service/admin-service"))
 	return &FileService{
-		log:      l,
-		fileRepo: repo,
+		log:           l,
+		fileRepo:      repo,
+		tenantService: tenantService,
 	}
 }
 
@@ -53,6 +55,10 @@ func (s *FileService) Create(ctx context.Context, req *fileV1.CreateFileRequest)
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
+	if err = s.tenantService.CheckStorageQuota(ctx, operator.GetTenantId(), req.Data.GetSize()); err != nil {
+		return nil, err
+	}
+
 	if err = s.fileRepo.Create(ctx, req); err != nil {
 		return nil, err
 	}
@@ -25,11 +25,18 @@ var ProviderSet = wire.NewSet(
 	NewUEditorService,
 	NewFileService,
 	NewTenantService,
+	NewTenantPlanService,
+	NewTenantUsageService,
+	NewSettingService,
 	NewInternalMessageService,
 	NewInternalMessageCategoryService,
 	NewInternalMessageRecipientService,
+	NewMessageTemplateService,
+	NewConversationService,
 	NewAdminLoginRestrictionService,
 	NewUserProfileService,
 	NewUserCredentialService,
 	NewApiResourceService,
+	NewClusterService,
+	NewRateLimitService,
 )
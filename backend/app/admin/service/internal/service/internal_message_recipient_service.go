@@ -4,13 +4,18 @@ import (
 	"context"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/sse"
 )
 
 type InternalMessageRecipientService struct {
@@ -20,18 +25,28 @@ type InternalMessageRecipientService struct {
 
 	internalMessageRepo          *data.InternalMessageRepo
 	internalMessageRecipientRepo *data.InternalMessageRecipientRepo
+	internalMessageCategoryRepo  *data.InternalMessageCategoryRepo
+	inboxCounterRepo             *data.InboxCounterRepo
+
+	sseServer *sse.Server
 }
 
 func NewInternalMessageRecipientService(
 	logger log.Logger,
 	internalMessageRepo *data.InternalMessageRepo,
 	internalMessageRecipientRepo *data.InternalMessageRecipientRepo,
+	internalMessageCategoryRepo *data.InternalMessageCategoryRepo,
+	inboxCounterRepo *data.InboxCounterRepo,
+	sseServer *sse.Server,
 ) *InternalMessageRecipientService {
 	l := log.NewHelper(log.With(logger, "module", "internal-message-recipient/service/admin-service"))
 	return &InternalMessageRecipientService{
 		log:                          l,
 		internalMessageRepo:          internalMessageRepo,
 		internalMessageRecipientRepo: internalMessageRecipientRepo,
+		internalMessageCategoryRepo:  internalMessageCategoryRepo,
+		inboxCounterRepo:             inboxCounterRepo,
+		sseServer:                    sseServer,
 	}
 }
 
@@ -43,7 +58,8 @@ func (s *InternalMessageRecipientService) ListUserInbox(ctx context.Context, req
 	}
 
 	for _, d := range resp.Items {
-		if d.MessageId == nil {
+		// 模板消息按接收者渲染过标题与内容
+		if d.MessageId == nil || (d.Title != nil && d.Content != nil) {
 			continue
 		}
 
@@ -65,21 +81,262 @@ func (s *InternalMessageRecipientService) ListUserInbox(ctx context.Context, req
 }
 
 func (s *InternalMessageRecipientService) DeleteNotificationFromInbox(ctx context.Context, req *internalMessageV1.DeleteNotificationFromInboxRequest) (*emptypb.Empty, error) {
-	var err error
-	err = s.internalMessageRecipientRepo.DeleteNotificationFromInbox(ctx, req)
-	return &emptypb.Empty{}, err
+	changes, err := s.internalMessageRecipientRepo.DeleteNotificationFromInbox(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyUnreadChanges(ctx, changes)
+
+	return &emptypb.Empty{}, nil
 }
 
 // MarkNotificationAsRead 将通知标记为已读
 func (s *InternalMessageRecipientService) MarkNotificationAsRead(ctx context.Context, req *internalMessageV1.MarkNotificationAsReadRequest) (*emptypb.Empty, error) {
-	var err error
-	err = s.internalMessageRecipientRepo.MarkNotificationAsRead(ctx, req)
-	return &emptypb.Empty{}, err
+	changes, err := s.internalMessageRecipientRepo.MarkNotificationAsRead(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyUnreadChanges(ctx, changes)
+
+	return &emptypb.Empty{}, nil
 }
 
 // MarkNotificationsStatus 标记特定用户的某些或所有通知的状态
 func (s *InternalMessageRecipientService) MarkNotificationsStatus(ctx context.Context, req *internalMessageV1.MarkNotificationsStatusRequest) (*emptypb.Empty, error) {
-	var err error
-	err = s.internalMessageRecipientRepo.MarkNotificationsStatus(ctx, req)
-	return &emptypb.Empty{}, err
+	changes, err := s.internalMessageRecipientRepo.MarkNotificationsStatus(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.applyUnreadChanges(ctx, changes)
+
+	return &emptypb.Empty{}, nil
+}
+
+// MarkAllNotificationsAsRead 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+func (s *InternalMessageRecipientService) MarkAllNotificationsAsRead(ctx context.Context, req *internalMessageV1.MarkAllNotificationsAsReadRequest) (*internalMessageV1.InboxSummary, error) {
+	userId, err := inboxUserId(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	var messageType *internalMessageV1.InternalMessage_Type
+	if req.Type != nil {
+		messageType = req.Type.Enum()
+	}
+
+	changes, err := s.internalMessageRecipientRepo.MarkAllAsRead(ctx, userId, req.CategoryId, messageType)
+	if err != nil {
+		return nil, err
+	}
+
+	if summary := s.applyUnreadChanges(ctx, changes)[userId]; summary != nil {
+		return summary, nil
+	}
+
+	// 计数不存在或已被删除，从数据库重建
+	return s.GetInboxSummary(ctx, &internalMessageV1.GetInboxSummaryRequest{UserId: userId})
+}
+
+// GetInboxSummary 获取用户收件箱的未读数量汇总，计数不存在时从数据库对账重建
+func (s *InternalMessageRecipientService) GetInboxSummary(ctx context.Context, req *internalMessageV1.GetInboxSummaryRequest) (*internalMessageV1.InboxSummary, error) {
+	userId, err := inboxUserId(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	summary, ok, err := s.inboxCounterRepo.Get(ctx, userId)
+	if err != nil {
+		s.log.Errorf("get inbox counter of user [%d] failed: %s", userId, err)
+	}
+	if !ok {
+		if summary, err = s.reconcileInbox(ctx, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	s.fillCategoryNames(ctx, summary)
+
+	return summary, nil
+}
+
+// inboxUserId 请求中没有指定用户时使用当前用户
+func inboxUserId(ctx context.Context, userId uint32) (uint32, error) {
+	if userId != 0 {
+		return userId, nil
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return operator.GetUserId(), nil
+}
+
+// reconcileInbox 从数据库统计未读数量并覆盖Redis中的计数，Redis不可用时直接返回统计结果
+func (s *InternalMessageRecipientService) reconcileInbox(ctx context.Context, userId uint32) (*internalMessageV1.InboxSummary, error) {
+	counts, err := s.internalMessageRecipientRepo.CountUnread(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := s.inboxCounterRepo.Set(ctx, userId, counts)
+	if err != nil {
+		s.log.Errorf("save inbox counter of user [%d] failed: %s", userId, err)
+		return counts.ToSummary(userId), nil
+	}
+
+	return summary, nil
+}
+
+// applyUnreadChanges 按消息的分类与类型累加接收者的未读计数，并把新的汇总推送给接收者。
+// 返回计数存在的接收者的新汇总，计数不存在的等待下次查询时对账
+func (s *InternalMessageRecipientService) applyUnreadChanges(ctx context.Context, changes []data.InboxChange) map[uint32]*internalMessageV1.InboxSummary {
+	summaries := make(map[uint32]*internalMessageV1.InboxSummary)
+	if len(changes) == 0 {
+		return summaries
+	}
+
+	var messageIds []uint32
+	seen := make(map[uint32]struct{})
+	for _, c := range changes {
+		if _, ok := seen[c.MessageID]; !ok {
+			seen[c.MessageID] = struct{}{}
+			messageIds = append(messageIds, c.MessageID)
+		}
+	}
+
+	messages, err := s.internalMessageRepo.ListByIds(ctx, messageIds)
+	if err != nil {
+		s.log.Errorf("update inbox counter failed: %s", err)
+		messages = nil
+	}
+
+	messageMap := make(map[uint32]*internalMessageV1.InternalMessage, len(messages))
+	for _, msg := range messages {
+		messageMap[msg.GetId()] = msg
+	}
+
+	// 按接收者汇总变化，变化相同的接收者一起累加，撤销所有接收者的消息时只需要一批
+	userDeltas := make(map[uint32]data.InboxUnreadCounts)
+	var invalid []uint32
+	for _, c := range changes {
+		msg, ok := messageMap[c.MessageID]
+		if !ok {
+			// 无法确定分类与类型，删除计数等待对账
+			invalid = append(invalid, c.UserID)
+			continue
+		}
+
+		delta, ok := userDeltas[c.UserID]
+		if !ok {
+			delta = make(data.InboxUnreadCounts)
+			userDeltas[c.UserID] = delta
+		}
+		delta.Add(msg.GetCategoryId(), msg.GetType(), c.Delta)
+	}
+
+	for _, uid := range invalid {
+		delete(userDeltas, uid)
+	}
+	s.invalidateInbox(ctx, invalid...)
+
+	groups := make(map[string][]uint32)
+	deltas := make(map[string]data.InboxUnreadCounts)
+	for uid, delta := range userDeltas {
+		key := delta.Key()
+		groups[key] = append(groups[key], uid)
+		deltas[key] = delta
+	}
+
+	for key, userIds := range groups {
+		for uid, summary := range s.incrInbox(ctx, userIds, deltas[key]) {
+			summaries[uid] = summary
+		}
+	}
+
+	return summaries
+}
+
+// incrInbox 累加多个用户的未读计数，并推送新的汇总
+func (s *InternalMessageRecipientService) incrInbox(ctx context.Context, userIds []uint32, delta data.InboxUnreadCounts) map[uint32]*internalMessageV1.InboxSummary {
+	summaries, err := s.inboxCounterRepo.Incr(ctx, userIds, delta)
+	if err != nil {
+		s.log.Errorf("incr inbox counter failed: %s", err)
+		s.invalidateInbox(ctx, userIds...)
+		return nil
+	}
+
+	if len(summaries) == 0 {
+		return summaries
+	}
+
+	categoryNames := s.queryCategoryNames(ctx, summaries)
+	for _, summary := range summaries {
+		for _, c := range summary.Categories {
+			c.CategoryName = categoryNames[c.GetCategoryId()]
+		}
+		s.publishInboxSummary(ctx, summary)
+	}
+
+	return summaries
+}
+
+// invalidateInbox 计数更新失败时删除计数，避免之后在错误的计数上继续累加
+func (s *InternalMessageRecipientService) invalidateInbox(ctx context.Context, userIds ...uint32) {
+	if err := s.inboxCounterRepo.Invalidate(context.WithoutCancel(ctx), userIds...); err != nil {
+		s.log.Errorf("invalidate inbox counter failed: %s", err)
+	}
+}
+
+// fillCategoryNames 填充汇总中的分类名称
+func (s *InternalMessageRecipientService) fillCategoryNames(ctx context.Context, summary *internalMessageV1.InboxSummary) {
+	categoryNames := s.queryCategoryNames(ctx, map[uint32]*internalMessageV1.InboxSummary{summary.GetUserId(): summary})
+	for _, c := range summary.Categories {
+		c.CategoryName = categoryNames[c.GetCategoryId()]
+	}
+}
+
+func (s *InternalMessageRecipientService) queryCategoryNames(ctx context.Context, summaries map[uint32]*internalMessageV1.InboxSummary) map[uint32]*string {
+	var ids []uint32
+	seen := make(map[uint32]struct{})
+	for _, summary := range summaries {
+		for _, c := range summary.Categories {
+			if _, ok := seen[c.GetCategoryId()]; ok || c.GetCategoryId() == 0 {
+				continue
+			}
+			seen[c.GetCategoryId()] = struct{}{}
+			ids = append(ids, c.GetCategoryId())
+		}
+	}
+
+	names := make(map[uint32]*string, len(ids))
+	if len(ids) == 0 {
+		return names
+	}
+
+	categories, err := s.internalMessageCategoryRepo.ListCategoriesByIds(ctx, ids)
+	if err != nil {
+		s.log.Warnf("list internal message categories failed: %s", err)
+		return names
+	}
+
+	for _, c := range categories {
+		names[c.GetId()] = c.Name
+	}
+
+	return names
+}
+
+// publishInboxSummary 向用户在线的客户端推送新的未读数量汇总，字段名与查询接口返回的一致
+func (s *InternalMessageRecipientService) publishInboxSummary(ctx context.Context, summary *internalMessageV1.InboxSummary) {
+	summaryJson, _ := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(summary)
+
+	s.sseServer.PublishToUser(ctx, summary.GetUserId(), &sse.Event{
+		ID:    uuid.New().String(),
+		Data:  summaryJson,
+		Event: "inbox_summary",
+	})
 }
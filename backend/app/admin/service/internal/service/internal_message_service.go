@@ -1,25 +1,51 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/google/uuid"
+	"github.com/hibiken/asynq"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	"github.com/tx7do/go-utils/timeutil"
 	"github.com/tx7do/go-utils/trans"
-	"github.com/tx7do/kratos-transport/transport/sse"
+	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
+	"go-wind-admin/pkg/audience"
+	"go-wind-admin/pkg/entgo/viewer"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/msgtemplate"
+	"go-wind-admin/pkg/notify"
+	"go-wind-admin/pkg/sse"
+	"go-wind-admin/pkg/task"
 	"go-wind-admin/pkg/utils/name_set"
+	"go-wind-admin/pkg/utils/slice"
+)
+
+const (
+	// deliverBatchSize 每个投递批次的接收者数量
+	deliverBatchSize = 500
+
+	fanoutMaxRetry  = 3
+	deliverMaxRetry = 5
+	channelMaxRetry = 5
 )
 
 type InternalMessageService struct {
@@ -27,13 +53,25 @@ type InternalMessageService struct {
 
 	log *log.Helper
 
+	// Server 用于投递定时消息与分批投递任务，由队列服务创建后设置
+	Server *asynqServer.Server
+
 	internalMessageRepo          *data.InternalMessageRepo
 	internalMessageCategoryRepo  *data.InternalMessageCategoryRepo
 	internalMessageRecipientRepo *data.InternalMessageRecipientRepo
 	userRepo                     *data.UserRepo
+	audienceRepo                 *data.AudienceRepo
+	internalMessageDeliveryRepo  *data.InternalMessageDeliveryRepo
+	notificationPreferenceRepo   *data.UserNotificationPreferenceRepo
+	notificationDigestRepo       *data.NotificationDigestRepo
+	messageTemplateRepo          *data.MessageTemplateRepo
+
+	conversationService             *ConversationService
+	internalMessageRecipientService *InternalMessageRecipientService
+
+	notifyRegistry *notify.Registry
 
 	sseServer *sse.Server
-	userToken *data.UserTokenCacheRepo
 }
 
 func NewInternalMessageService(
@@ -42,18 +80,32 @@ func NewInternalMessageService(
 	internalMessageCategoryRepo *data.InternalMessageCategoryRepo,
 	internalMessageRecipientRepo *data.InternalMessageRecipientRepo,
 	userRepo *data.UserRepo,
+	audienceRepo *data.AudienceRepo,
+	internalMessageDeliveryRepo *data.InternalMessageDeliveryRepo,
+	notificationPreferenceRepo *data.UserNotificationPreferenceRepo,
+	notificationDigestRepo *data.NotificationDigestRepo,
+	messageTemplateRepo *data.MessageTemplateRepo,
+	conversationService *ConversationService,
+	internalMessageRecipientService *InternalMessageRecipientService,
+	notifyRegistry *notify.Registry,
 	sseServer *sse.Server,
-	userToken *data.UserTokenCacheRepo,
 ) *InternalMessageService {
 	l := log.NewHelper(log.With(logger, "module", "internal-message/service/admin-service"))
 	return &InternalMessageService{
-		log:                          l,
-		internalMessageRepo:          internalMessageRepo,
-		internalMessageCategoryRepo:  internalMessageCategoryRepo,
-		internalMessageRecipientRepo: internalMessageRecipientRepo,
-		userRepo:                     userRepo,
-		sseServer:                    sseServer,
-		userToken:                    userToken,
+		log:                             l,
+		internalMessageRepo:             internalMessageRepo,
+		internalMessageCategoryRepo:     internalMessageCategoryRepo,
+		internalMessageRecipientRepo:    internalMessageRecipientRepo,
+		userRepo:                        userRepo,
+		audienceRepo:                    audienceRepo,
+		internalMessageDeliveryRepo:     internalMessageDeliveryRepo,
+		notificationPreferenceRepo:      notificationPreferenceRepo,
+		notificationDigestRepo:          notificationDigestRepo,
+		messageTemplateRepo:             messageTemplateRepo,
+		conversationService:             conversationService,
+		internalMessageRecipientService: internalMessageRecipientService,
+		notifyRegistry:                  notifyRegistry,
+		sseServer:                       sseServer,
 	}
 }
 
@@ -167,21 +219,36 @@ func (s *InternalMessageService) DeleteMessage(ctx context.Context, req *interna
 	return &emptypb.Empty{}, nil
 }
 
-// RevokeMessage 撤销某条消息
+// RevokeMessage 撤销某条消息，没有指定用户时撤销所有接收者的消息并将消息标记为已撤销。
+// 消息与收件记录都会保留，用于发送者查看阅读统计
 func (s *InternalMessageService) RevokeMessage(ctx context.Context, req *internalMessageV1.RevokeMessageRequest) (*emptypb.Empty, error) {
-	var err error
-	if err = s.internalMessageRepo.Delete(ctx, req.GetMessageId()); err != nil {
-		s.log.Errorf("delete internal message failed: [%d]", req.GetMessageId())
+	if req.GetMessageId() == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetUserId() == 0 {
+		if err = s.internalMessageRepo.Revoke(ctx, req.GetMessageId(), operator.GetUserId()); err != nil {
+			return nil, err
+		}
 	}
 
-	if err = s.internalMessageRecipientRepo.RevokeMessage(ctx, req); err != nil {
-		s.log.Errorf("delete internal message inbox failed: [%d][%d]", req.GetMessageId(), req.GetUserId())
+	changes, err := s.internalMessageRecipientRepo.RevokeMessage(ctx, req)
+	if err != nil {
+		s.log.Errorf("revoke internal message inbox failed: [%d][%d]", req.GetMessageId(), req.GetUserId())
+		return nil, err
 	}
 
-	return &emptypb.Empty{}, err
+	s.internalMessageRecipientService.applyUnreadChanges(ctx, changes)
+
+	return &emptypb.Empty{}, nil
 }
 
-// SendMessage 发送消息
+// SendMessage 发送消息，设置了发送时间或cron表达式时作为定时消息，到时由队列投递
 func (s *InternalMessageService) SendMessage(ctx context.Context, req *internalMessageV1.SendMessageRequest) (*internalMessageV1.SendMessageResponse, error) {
 	// 获取操作人信息
 	operator, err := auth.FromContext(ctx)
@@ -191,41 +258,89 @@ func (s *InternalMessageService) SendMessage(ctx context.Context, req *internalM
 
 	now := time.Now()
 
+	scheduled := req.SendAt != nil || req.GetCronSpec() != ""
+
+	// 会话消息与单人私信发送到会话中，不经过分批投递
+	if isConversationRequest(req) {
+		if scheduled {
+			return nil, adminV1.ErrorBadRequest("conversation messages cannot be scheduled")
+		}
+		return s.conversationService.sendFromRequest(ctx, operator.GetUserId(), req)
+	}
+
+	var sendAt time.Time
+	if scheduled {
+		if sendAt, err = resolveSendAt(req.SendAt, req.GetCronSpec(), now); err != nil {
+			return nil, err
+		}
+	}
+
+	target := requestAudience(req)
+	if !target.GetAll() && toAudienceSpec(target).Include.IsEmpty() {
+		return nil, adminV1.ErrorBadRequest("no recipients specified")
+	}
+
+	data := &internalMessageV1.InternalMessage{
+		Title:      req.Title,
+		Content:    trans.Ptr(req.GetContent()),
+		Status:     trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
+		Type:       trans.Ptr(req.GetType()),
+		Priority:   trans.Ptr(req.GetPriority()),
+		CategoryId: req.CategoryId,
+		TargetAll:  target.All,
+		Audience:   target,
+		CreatedBy:  trans.Ptr(operator.GetUserId()),
+		CreatedAt:  timeutil.TimeToTimestamppb(&now),
+
+		DeliveryStatus: trans.Ptr(internalMessageV1.InternalMessage_PENDING),
+	}
+
+	if req.GetTemplateCode() != "" {
+		if err = s.applyTemplate(ctx, data, req.GetTemplateCode(), req.GetVariables()); err != nil {
+			return nil, err
+		}
+	}
+
+	// 定时消息在发送时才解析受众，立即发送的消息现在解析并保存受众快照
+	if !scheduled {
+		if data.TargetUserIds, err = s.resolveAudience(ctx, target); err != nil {
+			return nil, err
+		}
+	}
+
+	if scheduled {
+		data.Status = trans.Ptr(internalMessageV1.InternalMessage_SCHEDULED)
+		data.SendAt = timestamppb.New(sendAt)
+		if req.GetCronSpec() != "" {
+			data.CronSpec = req.CronSpec
+		}
+	} else {
+		data.LastSentAt = data.CreatedAt
+	}
+
 	var msg *internalMessageV1.InternalMessage
 	if msg, err = s.internalMessageRepo.Create(ctx, &internalMessageV1.CreateInternalMessageRequest{
-		Data: &internalMessageV1.InternalMessage{
-			Title:      req.Title,
-			Content:    trans.Ptr(req.GetContent()),
-			Status:     trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
-			Type:       trans.Ptr(req.GetType()),
-			CategoryId: req.CategoryId,
-			CreatedBy:  trans.Ptr(operator.GetUserId()),
-			CreatedAt:  timeutil.TimeToTimestamppb(&now),
-		},
+		Data: data,
 	}); err != nil {
 		s.log.Errorf("create internal message failed: %s", err)
 		return nil, err
 	}
 
-	if req.GetTargetAll() {
-		users, err := s.userRepo.List(ctx, &pagination.PagingRequest{NoPaging: trans.Ptr(true)})
-		if err != nil {
-			s.log.Errorf("send message failed, list users failed, %s", err)
-		} else {
-			for _, user := range users.Items {
-				_ = s.sendNotification(ctx, msg.GetId(), user.GetId(), operator.GetUserId(), &now, msg.GetTitle(), msg.GetContent())
-			}
-		}
-	} else {
-		if req.RecipientUserId != nil {
-			_ = s.sendNotification(ctx, msg.GetId(), req.GetRecipientUserId(), operator.GetUserId(), &now, msg.GetTitle(), msg.GetContent())
-		} else {
-			if len(req.TargetUserIds) != 0 {
-				for _, uid := range req.TargetUserIds {
-					_ = s.sendNotification(ctx, msg.GetId(), uid, operator.GetUserId(), &now, msg.GetTitle(), msg.GetContent())
-				}
-			}
+	if scheduled {
+		if err = s.enqueueScheduledMessage(msg.GetId(), msg.GetTenantId(), sendAt); err != nil {
+			// 消息已保存为定时状态，领导者重新选举时会补投
+			s.log.Errorf("enqueue scheduled message [%d] failed: %s", msg.GetId(), err)
 		}
+
+		return &internalMessageV1.SendMessageResponse{
+			MessageId: msg.GetId(),
+			SendAt:    msg.SendAt,
+		}, nil
+	}
+
+	if err = s.deliverMessage(ctx, msg, operator.GetUserId()); err != nil {
+		s.log.Errorf("deliver message [%d] failed: %s", msg.GetId(), err)
+		return nil, err
 	}
 
 	return &internalMessageV1.SendMessageResponse{
@@ -233,36 +348,1536 @@ func (s *InternalMessageService) SendMessage(ctx context.Context, req *internalM
 	}, nil
 }
 
-// sendNotification 向客户端发送通知消息
-func (s *InternalMessageService) sendNotification(ctx context.Context, messageId uint32, recipientUserId uint32, senderUserId uint32, now *time.Time, title, content string) error {
-	recipient := &internalMessageV1.InternalMessageRecipient{
-		MessageId:       trans.Ptr(messageId),
-		RecipientUserId: trans.Ptr(recipientUserId),
-		Status:          trans.Ptr(internalMessageV1.InternalMessageRecipient_SENT),
-		CreatedBy:       trans.Ptr(senderUserId),
-		CreatedAt:       timeutil.TimeToTimestamppb(now),
-		Title:           trans.Ptr(title),
-		Content:         trans.Ptr(content),
+// deliverMessage 把消息投递到受众快照中所有接收者的收件箱。
+// 投递由队列异步完成，接口不随接收者数量变慢；未配置队列服务时在当前请求中直接投递。
+func (s *InternalMessageService) deliverMessage(ctx context.Context, msg *internalMessageV1.InternalMessage, senderUserId uint32) error {
+	data := &task.InternalMessageFanoutTaskData{
+		MessageId: msg.GetId(),
+		SenderId:  senderUserId,
+		TenantId:  msg.GetTenantId(),
 	}
 
-	var err error
-	var entity *internalMessageV1.InternalMessageRecipient
-	if entity, err = s.internalMessageRecipientRepo.Create(ctx, recipient); err != nil {
-		s.log.Errorf("send message failed, send to user failed, %s", err)
+	if s.Server == nil {
+		return s.handleFanout(ctx, task.InternalMessageFanoutTaskType, data)
+	}
+
+	err := s.Server.NewTask(task.InternalMessageFanoutTaskType, data,
+		asynq.TaskID(task.CreateInternalMessageFanoutTaskID(msg.GetId())),
+		asynq.MaxRetry(fanoutMaxRetry),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// handleFanout 把受众快照拆分为多个批次，每个批次作为单独的任务投递，失败时只重试失败的批次
+func (s *InternalMessageService) handleFanout(ctx context.Context, _ string, data *task.InternalMessageFanoutTaskData) error {
+	ctx = tenantTaskContext(ctx, data.TenantId)
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: data.MessageId},
+	})
+	if err != nil {
+		if internalMessageV1.IsNotFound(err) {
+			return nil
+		}
 		return err
 	}
-	recipient.Id = entity.Id
 
-	recipientJson, _ := json.Marshal(recipient)
+	userIds := msg.GetTargetUserIds()
+
+	var started bool
+	if started, err = s.internalMessageRepo.StartDelivery(ctx, data.MessageId, uint32(len(userIds))); err != nil {
+		return err
+	}
+	if !started && msg.GetDeliveryStatus() != internalMessageV1.InternalMessage_DELIVERING {
+		s.log.Infof("message [%d] has been delivered, skip", data.MessageId)
+		return nil
+	}
+
+	if len(userIds) == 0 {
+		return s.finishDeliveryIfDone(ctx, data.MessageId, data.SenderId)
+	}
+
+	// 分发任务重试时批次任务ID不变，已经入队的批次不会重复入队
+	for i, batch := range slice.Chunk(userIds, deliverBatchSize) {
+		batchData := &task.InternalMessageDeliverTaskData{
+			MessageId: data.MessageId,
+			SenderId:  data.SenderId,
+			TenantId:  data.TenantId,
+			Batch:     i,
+			UserIds:   batch,
+		}
+
+		if s.Server == nil {
+			if err = s.handleDeliverBatch(ctx, task.InternalMessageDeliverTaskType, batchData); err != nil {
+				s.log.Errorf("deliver message [%d] batch [%d] failed: %s", data.MessageId, i, err)
+			}
+			continue
+		}
+
+		if err = s.Server.NewTask(task.InternalMessageDeliverTaskType, batchData,
+			asynq.TaskID(task.CreateInternalMessageDeliverTaskID(data.MessageId, i)),
+			asynq.MaxRetry(deliverMaxRetry),
+		); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleDeliverBatch 投递一个批次，写入收件箱后推送给在线的接收者
+func (s *InternalMessageService) handleDeliverBatch(ctx context.Context, _ string, data *task.InternalMessageDeliverTaskData) error {
+	ctx = tenantTaskContext(ctx, data.TenantId)
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: data.MessageId},
+	})
+	if err != nil {
+		if internalMessageV1.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	items := s.prepareRecipients(ctx, msg, data.UserIds)
+
+	var recipients []*internalMessageV1.InternalMessageRecipient
+	if recipients, err = s.internalMessageRecipientRepo.DeliverBatch(ctx, data.MessageId, items); err != nil {
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, ok := asynq.GetMaxRetry(ctx)
+		if ok && retried < maxRetry {
+			return err
+		}
+
+		// 已是最后一次尝试，整个批次记为失败
+		s.log.Errorf("deliver message [%d] batch [%d] failed: %s", data.MessageId, data.Batch, err)
+		if err = s.internalMessageRepo.AddFailedCount(ctx, data.MessageId, len(data.UserIds)); err != nil {
+			return err
+		}
+
+		return s.finishDeliveryIfDone(ctx, data.MessageId, data.SenderId)
+	}
+
+	s.incrDeliveredUnread(ctx, msg, recipients)
+
+	// 收件箱已经写入，推送与外部渠道投递失败不影响批次的投递结果
+	if err = s.notifyRecipients(ctx, msg, recipients, data.Batch); err != nil {
+		s.log.Errorf("notify message [%d] batch [%d] recipients failed: %s", data.MessageId, data.Batch, err)
+	}
+
+	return s.finishDeliveryIfDone(ctx, data.MessageId, data.SenderId)
+}
+
+// notifyRecipients 按接收者的通知偏好推送一批已写入收件箱的收件记录：
+// 静音分类的消息不推送；开启摘要模式的接收者，低优先级的消息加入摘要；免打扰时段内不推送到客户端，外部渠道推迟到时段结束后发送
+func (s *InternalMessageService) notifyRecipients(ctx context.Context, msg *internalMessageV1.InternalMessage, recipients []*internalMessageV1.InternalMessageRecipient, batch int) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	userIds := make([]uint32, 0, len(recipients))
+	for _, recipient := range recipients {
+		userIds = append(userIds, recipient.GetRecipientUserId())
+	}
+
+	prefs, err := s.notificationPreferenceRepo.ListByUserIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	var channelRecipients []*internalMessageV1.InternalMessageRecipient
+	deferUntil := make(map[uint32]time.Time)
+	digestUserIds := make(map[time.Duration][]uint32)
+	for _, recipient := range recipients {
+		if recipient.Title == nil {
+			recipient.Title = msg.Title
+		}
+		if recipient.Content == nil {
+			recipient.Content = msg.Content
+		}
+
+		pref := prefs[recipient.GetRecipientUserId()]
+
+		mode, until := decideNotify(pref, msg, now)
+		// 没有队列服务时无法预约摘要，直接推送
+		if mode == notifyDigest && s.Server == nil {
+			mode = notifyNow
+		}
+
+		switch mode {
+		case notifyNow:
+			s.publishNotification(ctx, recipient)
+			channelRecipients = append(channelRecipients, recipient)
+
+		case notifyDeferred:
+			// 没有队列服务时无法推迟发送，免打扰时段内不通过外部渠道发送
+			if s.Server != nil {
+				deferUntil[recipient.GetRecipientUserId()] = until
+				channelRecipients = append(channelRecipients, recipient)
+			}
+
+		case notifyDigest:
+			interval := digestInterval(pref.GetDigest())
+			digestUserIds[interval] = append(digestUserIds[interval], recipient.GetRecipientUserId())
+		}
+	}
+
+	for interval, ids := range digestUserIds {
+		if err = s.addToDigest(ctx, msg.GetId(), ids, now.Add(interval)); err != nil {
+			s.log.Errorf("add message [%d] to digest failed: %s", msg.GetId(), err)
+		}
+	}
+
+	return s.enqueueChannelDeliveries(ctx, msg, channelRecipients, batch, prefs, deferUntil)
+}
+
+// enqueueChannelDeliveries 按分类默认渠道与接收者的通知偏好，为一批收件记录创建外部渠道投递记录并投递发送任务，
+// deferUntil 中的接收者处于免打扰时段，发送任务推迟到时段结束
+func (s *InternalMessageService) enqueueChannelDeliveries(
+	ctx context.Context,
+	msg *internalMessageV1.InternalMessage,
+	recipients []*internalMessageV1.InternalMessageRecipient,
+	batch int,
+	prefs map[uint32]*internalMessageV1.UserNotificationPreference,
+	deferUntil map[uint32]time.Time,
+) error {
+	if len(recipients) == 0 || len(s.notifyRegistry.Names()) == 0 {
+		return nil
+	}
 
-	recipientStreamIds := s.userToken.GetAccessToken(ctx, recipientUserId)
-	for _, streamId := range recipientStreamIds {
-		s.sseServer.Publish(ctx, sse.StreamID(streamId), &sse.Event{
-			ID:    []byte(uuid.New().String()),
-			Data:  recipientJson,
-			Event: []byte("notification"),
+	var defaults []string
+	if msg.CategoryId != nil {
+		category, err := s.internalMessageCategoryRepo.Get(ctx, &internalMessageV1.GetInternalMessageCategoryRequest{
+			QueryBy: &internalMessageV1.GetInternalMessageCategoryRequest_Id{Id: msg.GetCategoryId()},
 		})
+		if err != nil && !internalMessageV1.IsNotFound(err) {
+			return err
+		}
+		defaults = category.GetChannels()
+	}
+
+	// 同一渠道的投递按发送时间分组，免打扰时段结束时间相同的接收者在同一个任务中发送
+	type channelGroup struct {
+		channel   string
+		processAt int64
+	}
+
+	deliveries := make(map[channelGroup][]*internalMessageV1.InternalMessageDelivery)
+	for _, recipient := range recipients {
+		var processAt int64
+		if until, ok := deferUntil[recipient.GetRecipientUserId()]; ok {
+			processAt = until.Unix()
+		}
+
+		for _, channel := range recipientChannels(defaults, prefs[recipient.GetRecipientUserId()], msg.GetCategoryId()) {
+			if _, ok := s.notifyRegistry.Get(channel); !ok {
+				continue
+			}
+
+			group := channelGroup{channel: channel, processAt: processAt}
+			deliveries[group] = append(deliveries[group], &internalMessageV1.InternalMessageDelivery{
+				MessageId:       msg.Id,
+				RecipientId:     recipient.Id,
+				RecipientUserId: recipient.RecipientUserId,
+				Channel:         trans.Ptr(channel),
+			})
+		}
+	}
+
+	for group, items := range deliveries {
+		ids, err := s.internalMessageDeliveryRepo.CreatePending(ctx, items)
+		if err != nil {
+			return err
+		}
+
+		taskData := &task.InternalMessageChannelTaskData{
+			MessageId:   msg.GetId(),
+			Channel:     group.channel,
+			Batch:       batch,
+			DeliveryIds: ids,
+		}
+
+		if s.Server == nil {
+			if err = s.handleChannelDelivery(ctx, task.InternalMessageChannelTaskType, taskData); err != nil {
+				s.log.Errorf("deliver message [%d] via [%s] failed: %s", msg.GetId(), group.channel, err)
+			}
+			continue
+		}
+
+		opts := []asynq.Option{asynq.MaxRetry(channelMaxRetry)}
+		if group.processAt != 0 {
+			opts = append(opts,
+				asynq.TaskID(task.CreateInternalMessageDeferredChannelTaskID(msg.GetId(), group.channel, batch, group.processAt)),
+				asynq.ProcessAt(time.Unix(group.processAt, 0)),
+			)
+		} else {
+			opts = append(opts, asynq.TaskID(task.CreateInternalMessageChannelTaskID(msg.GetId(), group.channel, batch)))
+		}
+
+		if err = s.Server.NewTask(task.InternalMessageChannelTaskType, taskData, opts...); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleChannelDelivery 通过外部渠道发送一批投递记录，失败的记录随任务重试，最后一次尝试仍失败时记为失败
+func (s *InternalMessageService) handleChannelDelivery(ctx context.Context, _ string, data *task.InternalMessageChannelTaskData) error {
+	deliveries, err := s.internalMessageDeliveryRepo.ListUnsent(ctx, data.DeliveryIds)
+	if err != nil {
+		return err
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: data.MessageId},
+	})
+	if err != nil {
+		if internalMessageV1.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	content := notify.Content{
+		Title: msg.GetTitle(),
+		Body:  msg.GetContent(),
+	}
+	if msg.CategoryId != nil {
+		if category, err := s.internalMessageCategoryRepo.Get(ctx, &internalMessageV1.GetInternalMessageCategoryRequest{
+			QueryBy: &internalMessageV1.GetInternalMessageCategoryRequest_Id{Id: msg.GetCategoryId()},
+		}); err == nil {
+			content.Category = category.GetName()
+		}
+	}
+
+	userIds := make([]uint32, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		userIds = append(userIds, delivery.GetRecipientUserId())
+	}
+
+	users, err := s.userRepo.ListUsersByIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+	userMap := make(map[uint32]*userV1.User, len(users))
+	for _, user := range users {
+		userMap[user.GetId()] = user
+	}
+
+	tpl := s.loadMessageTemplate(ctx, msg)
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, ok := asynq.GetMaxRetry(ctx)
+	final := !ok || retried >= maxRetry
+
+	var failed int
+	for _, delivery := range deliveries {
+		user := userMap[delivery.GetRecipientUserId()]
+
+		address := channelAddress(delivery.GetChannel(), user)
+		if address == "" {
+			if err = s.internalMessageDeliveryRepo.UpdateResult(ctx, delivery.GetId(), "",
+				internalMessageV1.InternalMessageDelivery_SKIPPED, notify.ErrEmptyAddress.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// 撤销的消息不再发送
+		if msg.GetStatus() == internalMessageV1.InternalMessage_REVOKED {
+			if err = s.internalMessageDeliveryRepo.UpdateResult(ctx, delivery.GetId(), address,
+				internalMessageV1.InternalMessageDelivery_SKIPPED, "message revoked"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		c := content
+		c.RecipientName = userDisplayName(user)
+		if tpl != nil {
+			if result, renderErr := renderMessageForUser(tpl, msg, user); renderErr == nil {
+				c.Title = result.Subject
+				c.Body = result.Body
+			}
+		}
+
+		if sendErr := s.notifyRegistry.Send(ctx, delivery.GetChannel(), address, &c); sendErr != nil {
+			failed++
+
+			status := internalMessageV1.InternalMessageDelivery_PENDING
+			if final {
+				status = internalMessageV1.InternalMessageDelivery_FAILED
+			}
+			if err = s.internalMessageDeliveryRepo.UpdateResult(ctx, delivery.GetId(), address, status, sendErr.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err = s.internalMessageDeliveryRepo.UpdateResult(ctx, delivery.GetId(), address,
+			internalMessageV1.InternalMessageDelivery_SENT, ""); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 && !final {
+		return fmt.Errorf("[%d] deliveries via [%s] failed", failed, data.Channel)
+	}
+
+	return nil
+}
+
+// applyTemplate 使用模板填充消息，消息本身保存默认语言的渲染结果，投递时再按接收者的首选语言渲染
+func (s *InternalMessageService) applyTemplate(ctx context.Context, data *internalMessageV1.InternalMessage, code string, variables map[string]string) error {
+	tpl, err := s.messageTemplateRepo.GetEnabledByCode(ctx, code)
+	if err != nil {
+		return err
+	}
+
+	result, err := msgtemplate.Render(toMsgTemplate(tpl), &msgtemplate.Data{Vars: variables})
+	if err != nil {
+		return adminV1.ErrorBadRequest("render template failed: %s", err.Error())
+	}
+
+	data.Title = trans.Ptr(result.Subject)
+	data.Content = trans.Ptr(result.Body)
+	data.TemplateCode = trans.Ptr(code)
+	data.TemplateVariables = variables
+	if data.CategoryId == nil {
+		data.CategoryId = tpl.CategoryId
 	}
 
 	return nil
 }
+
+// loadMessageTemplate 查询消息使用的模板，消息没有使用模板或模板已被删除时返回 nil。
+// 消息发送后模板被停用不影响投递。
+func (s *InternalMessageService) loadMessageTemplate(ctx context.Context, msg *internalMessageV1.InternalMessage) *msgtemplate.Template {
+	if msg.GetTemplateCode() == "" {
+		return nil
+	}
+
+	tpl, err := s.messageTemplateRepo.Get(ctx, &internalMessageV1.GetMessageTemplateRequest{
+		QueryBy: &internalMessageV1.GetMessageTemplateRequest_Code{Code: msg.GetTemplateCode()},
+	})
+	if err != nil {
+		s.log.Warnf("load template [%s] of message [%d] failed: %s", msg.GetTemplateCode(), msg.GetId(), err)
+		return nil
+	}
+
+	return toMsgTemplate(tpl)
+}
+
+// prepareRecipients 为一批接收者准备收件记录，模板消息按接收者的首选语言渲染标题与内容，
+// 渲染失败的接收者使用消息本身的标题与内容
+func (s *InternalMessageService) prepareRecipients(ctx context.Context, msg *internalMessageV1.InternalMessage, userIds []uint32) []*internalMessageV1.InternalMessageRecipient {
+	items := make([]*internalMessageV1.InternalMessageRecipient, 0, len(userIds))
+	for _, uid := range userIds {
+		items = append(items, &internalMessageV1.InternalMessageRecipient{
+			RecipientUserId: trans.Ptr(uid),
+		})
+	}
+
+	tpl := s.loadMessageTemplate(ctx, msg)
+	if tpl == nil {
+		return items
+	}
+
+	users, err := s.userRepo.ListUsersByIds(ctx, userIds)
+	if err != nil {
+		s.log.Errorf("query recipients of message [%d] failed: %s", msg.GetId(), err)
+		return items
+	}
+	userMap := make(map[uint32]*userV1.User, len(users))
+	for _, user := range users {
+		userMap[user.GetId()] = user
+	}
+
+	for _, item := range items {
+		result, err := renderMessageForUser(tpl, msg, userMap[item.GetRecipientUserId()])
+		if err != nil {
+			s.log.Errorf("render message [%d] for user [%d] failed: %s", msg.GetId(), item.GetRecipientUserId(), err)
+			continue
+		}
+
+		item.Title = trans.Ptr(result.Subject)
+		item.Content = trans.Ptr(result.Body)
+	}
+
+	return items
+}
+
+// renderMessageForUser 按接收者的首选语言渲染模板消息
+func renderMessageForUser(tpl *msgtemplate.Template, msg *internalMessageV1.InternalMessage, user *userV1.User) (*msgtemplate.Result, error) {
+	return msgtemplate.Render(tpl, &msgtemplate.Data{
+		Vars: msg.GetTemplateVariables(),
+		User: toMsgTemplateUser(user),
+	}, user.GetLanguage())
+}
+
+// SendTemplate 以系统身份使用模板向指定用户发送通知，供业务代码与脚本调用，返回消息ID
+func (s *InternalMessageService) SendTemplate(ctx context.Context, code string, userIds []uint32, variables map[string]string) (uint32, error) {
+	if len(userIds) == 0 {
+		return 0, adminV1.ErrorBadRequest("no recipients specified")
+	}
+
+	data := newSystemNotification(userIds)
+	if err := s.applyTemplate(ctx, data, code, variables); err != nil {
+		return 0, err
+	}
+
+	return s.sendSystemNotification(ctx, data)
+}
+
+// SendNotification 以系统身份向指定用户发送纯文本通知，返回消息ID
+func (s *InternalMessageService) SendNotification(ctx context.Context, title, content string, userIds []uint32) (uint32, error) {
+	if len(userIds) == 0 {
+		return 0, adminV1.ErrorBadRequest("no recipients specified")
+	}
+
+	data := newSystemNotification(userIds)
+	data.Title = trans.Ptr(title)
+	data.Content = trans.Ptr(content)
+
+	return s.sendSystemNotification(ctx, data)
+}
+
+// newSystemNotification 创建发送给指定用户的系统通知
+func newSystemNotification(userIds []uint32) *internalMessageV1.InternalMessage {
+	now := time.Now()
+
+	return &internalMessageV1.InternalMessage{
+		Status: trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
+		Type:   trans.Ptr(internalMessageV1.InternalMessage_NOTIFICATION),
+		Audience: &internalMessageV1.MessageAudience{
+			Include: &internalMessageV1.AudienceFilter{UserIds: userIds},
+		},
+		CreatedAt: timeutil.TimeToTimestamppb(&now),
+
+		LastSentAt:     timeutil.TimeToTimestamppb(&now),
+		DeliveryStatus: trans.Ptr(internalMessageV1.InternalMessage_PENDING),
+	}
+}
+
+// sendSystemNotification 保存系统通知并投递给接收者，发送者为系统
+func (s *InternalMessageService) sendSystemNotification(ctx context.Context, data *internalMessageV1.InternalMessage) (uint32, error) {
+	var err error
+	if data.TargetUserIds, err = s.resolveAudience(ctx, data.GetAudience()); err != nil {
+		return 0, err
+	}
+
+	var msg *internalMessageV1.InternalMessage
+	if msg, err = s.internalMessageRepo.Create(ctx, &internalMessageV1.CreateInternalMessageRequest{
+		Data: data,
+	}); err != nil {
+		return 0, err
+	}
+
+	if err = s.deliverMessage(ctx, msg, 0); err != nil {
+		s.log.Errorf("deliver message [%d] failed: %s", msg.GetId(), err)
+		return 0, err
+	}
+
+	return msg.GetId(), nil
+}
+
+// RenderTemplate 按指定语言渲染模板，不涉及接收者信息
+func (s *InternalMessageService) RenderTemplate(ctx context.Context, code, language string, variables map[string]string) (*msgtemplate.Result, error) {
+	tpl, err := s.messageTemplateRepo.GetEnabledByCode(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := msgtemplate.Render(toMsgTemplate(tpl), &msgtemplate.Data{Vars: variables}, language)
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("render template failed: %s", err.Error())
+	}
+
+	return result, nil
+}
+
+// ListDelivery 查询外部渠道投递记录
+func (s *InternalMessageService) ListDelivery(ctx context.Context, req *pagination.PagingRequest) (*internalMessageV1.ListInternalMessageDeliveryResponse, error) {
+	return s.internalMessageDeliveryRepo.List(ctx, req)
+}
+
+// ListChannel 查询已启用的通知渠道
+func (s *InternalMessageService) ListChannel(_ context.Context, _ *emptypb.Empty) (*internalMessageV1.ListNotificationChannelResponse, error) {
+	return &internalMessageV1.ListNotificationChannelResponse{
+		Channels: s.notifyRegistry.Names(),
+	}, nil
+}
+
+// getStatsMessage 查询统计的消息，只有消息的发送者与管理员可以查看阅读统计与提醒未读者
+func (s *InternalMessageService) getStatsMessage(ctx context.Context, messageId uint32) (*internalMessageV1.InternalMessage, error) {
+	if messageId == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: messageId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.GetSenderId() != operator.GetUserId() &&
+		operator.GetAuthority() != userV1.User_SYS_ADMIN &&
+		operator.GetAuthority() != userV1.User_TENANT_ADMIN {
+		return nil, adminV1.ErrorForbidden("only the sender can view the message statistics")
+	}
+
+	return msg, nil
+}
+
+// GetMessageReadStats 查询消息的阅读统计
+func (s *InternalMessageService) GetMessageReadStats(ctx context.Context, req *internalMessageV1.GetMessageReadStatsRequest) (*internalMessageV1.MessageReadStats, error) {
+	var interval time.Duration
+	switch req.GetInterval() {
+	case "", "hour":
+		interval = time.Hour
+	case "day":
+		interval = 24 * time.Hour
+	default:
+		return nil, adminV1.ErrorBadRequest("invalid interval")
+	}
+
+	msg, err := s.getStatsMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.internalMessageRecipientRepo.CountByMessage(ctx, msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	readTimes, err := s.internalMessageRecipientRepo.ListReadTimes(ctx, msg.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	targeted := msg.GetRecipientTotal()
+	if targeted < counts.Delivered {
+		targeted = counts.Delivered
+	}
+
+	start := msg.GetCreatedAt().AsTime()
+	if msg.DeliveryStartedAt != nil {
+		start = msg.GetDeliveryStartedAt().AsTime()
+	}
+
+	return &internalMessageV1.MessageReadStats{
+		MessageId:      msg.GetId(),
+		Targeted:       targeted,
+		Delivered:      counts.Delivered,
+		Failed:         msg.GetFailedCount(),
+		Received:       counts.Received,
+		Read:           counts.Read,
+		Unread:         counts.Unread,
+		Revoked:        counts.Revoked,
+		Deleted:        counts.Deleted,
+		ReadRate:       readRate(counts.Read, counts.Delivered),
+		ReadTrend:      buildReadTrend(readTimes, start, interval, counts.Delivered),
+		RemindCount:    msg.RemindCount,
+		LastRemindedAt: msg.LastRemindedAt,
+	}, nil
+}
+
+// maxReadTrendPoints 阅读趋势最多的时间段数量，超过时按天统计
+const maxReadTrendPoints = 24 * 31
+
+func readRate(read, delivered uint32) float64 {
+	if delivered == 0 {
+		return 0
+	}
+	return float64(read) / float64(delivered)
+}
+
+// truncateTime 按统计粒度取时间段的开始时间，按天统计时使用服务器的时区
+func truncateTime(t time.Time, interval time.Duration) time.Time {
+	t = t.Local()
+	if interval >= 24*time.Hour {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	}
+	return t.Truncate(interval)
+}
+
+// buildReadTrend 从投递开始到最后一次阅读，按统计粒度划分连续的时间段并统计阅读人数，readTimes 需要按时间排序
+func buildReadTrend(readTimes []time.Time, start time.Time, interval time.Duration, delivered uint32) []*internalMessageV1.MessageReadStats_ReadTrendPoint {
+	if len(readTimes) == 0 {
+		return []*internalMessageV1.MessageReadStats_ReadTrendPoint{}
+	}
+
+	if readTimes[0].Before(start) {
+		start = readTimes[0]
+	}
+	end := readTimes[len(readTimes)-1]
+
+	if interval < 24*time.Hour && end.Sub(start)/interval >= maxReadTrendPoints {
+		interval = 24 * time.Hour
+	}
+
+	var points []*internalMessageV1.MessageReadStats_ReadTrendPoint
+	var cumulative uint32
+	i := 0
+	for t := truncateTime(start, interval); !t.After(end); {
+		next := t.Add(interval)
+		if interval >= 24*time.Hour {
+			next = t.AddDate(0, 0, 1)
+		}
+
+		var read uint32
+		for i < len(readTimes) && readTimes[i].Before(next) {
+			read++
+			i++
+		}
+		cumulative += read
+
+		points = append(points, &internalMessageV1.MessageReadStats_ReadTrendPoint{
+			Time:           timestamppb.New(t),
+			Read:           read,
+			CumulativeRead: cumulative,
+			ReadRate:       readRate(cumulative, delivered),
+		})
+
+		t = next
+	}
+
+	return points
+}
+
+// ListMessageNonReaders 查询消息的未读者
+func (s *InternalMessageService) ListMessageNonReaders(ctx context.Context, req *internalMessageV1.ListMessageNonReadersRequest) (*internalMessageV1.ListMessageNonReadersResponse, error) {
+	msg, err := s.getStatsMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	page := max(int(req.GetPage()), 1)
+	pageSize := int(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+
+	recipients, total, err := s.internalMessageRecipientRepo.ListNonReaders(ctx, msg.GetId(), (page-1)*pageSize, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalMessageV1.ListMessageNonReadersResponse{
+		Items: s.toNonReaders(ctx, recipients),
+		Total: uint64(total),
+	}, nil
+}
+
+// ExportMessageNonReaders 导出消息的全部未读者为CSV文件
+func (s *InternalMessageService) ExportMessageNonReaders(ctx context.Context, req *internalMessageV1.ExportMessageNonReadersRequest) (*internalMessageV1.ExportMessageNonReadersResponse, error) {
+	msg, err := s.getStatsMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, _, err := s.internalMessageRecipientRepo.ListNonReaders(ctx, msg.GetId(), 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	formatTime := func(ts *timestamppb.Timestamp) string {
+		if ts == nil {
+			return ""
+		}
+		return ts.AsTime().Local().Format(time.DateTime)
+	}
+
+	var buf bytes.Buffer
+	// 带上BOM，Excel才能正确识别UTF-8编码的中文
+	buf.WriteString("\ufeff")
+
+	w := csv.NewWriter(&buf)
+	_ = w.Write([]string{"用户ID", "用户名", "姓名", "状态", "投递时间", "接收时间"})
+	for _, r := range s.toNonReaders(ctx, recipients) {
+		_ = w.Write([]string{
+			strconv.FormatUint(uint64(r.GetUserId()), 10),
+			r.GetUsername(),
+			r.GetRealname(),
+			r.GetStatus().String(),
+			formatTime(r.DeliveredAt),
+			formatTime(r.ReceivedAt),
+		})
+	}
+	w.Flush()
+	if err = w.Error(); err != nil {
+		s.log.Errorf("export message [%d] non-readers failed: %s", msg.GetId(), err)
+		return nil, adminV1.ErrorInternalServerError("export non-readers failed")
+	}
+
+	return &internalMessageV1.ExportMessageNonReadersResponse{
+		FileName: fmt.Sprintf("message_%d_non_readers.csv", msg.GetId()),
+		MimeType: "text/csv",
+		Content:  buf.Bytes(),
+	}, nil
+}
+
+// remindMinInterval 同一条消息两次提醒未读者的最小间隔
+const remindMinInterval = 10 * time.Minute
+
+// RemindMessageNonReaders 再次向消息的未读者推送通知，静音了消息分类或处于免打扰时段的未读者不提醒。
+// 外部渠道的投递记录每个接收者只有一条，提醒只推送给在线的客户端，离线的接收者登录后会在收件箱中看到未读消息
+func (s *InternalMessageService) RemindMessageNonReaders(ctx context.Context, req *internalMessageV1.RemindMessageNonReadersRequest) (*internalMessageV1.RemindMessageNonReadersResponse, error) {
+	msg, err := s.getStatsMessage(ctx, req.GetMessageId())
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.GetStatus() == internalMessageV1.InternalMessage_REVOKED {
+		return nil, adminV1.ErrorBadRequest("message has been revoked")
+	}
+
+	ok, err := s.internalMessageRepo.ClaimRemind(ctx, msg.GetId(), remindMinInterval)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, adminV1.ErrorBadRequest("the non-readers of this message have been reminded recently")
+	}
+
+	recipients, _, err := s.internalMessageRecipientRepo.ListNonReaders(ctx, msg.GetId(), 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var reminded uint32
+	for _, batch := range slice.Chunk(recipients, deliverBatchSize) {
+		userIds := make([]uint32, 0, len(batch))
+		for _, recipient := range batch {
+			userIds = append(userIds, recipient.GetRecipientUserId())
+		}
+
+		prefs, err := s.notificationPreferenceRepo.ListByUserIds(ctx, userIds)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, recipient := range batch {
+			// 静音了该分类或处于免打扰时段的接收者不提醒
+			if mode, _ := decideNotify(prefs[recipient.GetRecipientUserId()], msg, now); mode == notifyMuted || mode == notifyDeferred {
+				continue
+			}
+
+			if recipient.Title == nil {
+				recipient.Title = msg.Title
+			}
+			if recipient.Content == nil {
+				recipient.Content = msg.Content
+			}
+			s.publishRecipientEvent(ctx, "notification_reminder", recipient)
+			reminded++
+		}
+	}
+
+	s.log.Infof("remind [%d] of [%d] non-readers of message [%d]", reminded, len(recipients), msg.GetId())
+
+	return &internalMessageV1.RemindMessageNonReadersResponse{
+		Reminded: reminded,
+	}, nil
+}
+
+// toNonReaders 把未读的收件记录转换为未读者，并填充用户名与姓名
+func (s *InternalMessageService) toNonReaders(ctx context.Context, recipients []*internalMessageV1.InternalMessageRecipient) []*internalMessageV1.MessageNonReader {
+	userIds := make([]uint32, 0, len(recipients))
+	for _, recipient := range recipients {
+		userIds = append(userIds, recipient.GetRecipientUserId())
+	}
+
+	userMap := make(map[uint32]*userV1.User, len(userIds))
+	for _, batch := range slice.Chunk(userIds, deliverBatchSize) {
+		users, err := s.userRepo.ListUsersByIds(ctx, batch)
+		if err != nil {
+			s.log.Warnf("list non-reader users failed: %s", err)
+			continue
+		}
+		for _, u := range users {
+			userMap[u.GetId()] = u
+		}
+	}
+
+	items := make([]*internalMessageV1.MessageNonReader, 0, len(recipients))
+	for _, recipient := range recipients {
+		item := &internalMessageV1.MessageNonReader{
+			RecipientId: recipient.GetId(),
+			UserId:      recipient.GetRecipientUserId(),
+			Status:      recipient.GetStatus(),
+			DeliveredAt: recipient.CreatedAt,
+			ReceivedAt:  recipient.ReceivedAt,
+		}
+		if u, ok := userMap[recipient.GetRecipientUserId()]; ok {
+			item.Username = u.Username
+			item.Realname = u.Realname
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// recipientChannels 接收者使用的外部通知渠道：用户按分类设置的渠道优先于分类的默认渠道，再去掉用户不接收的渠道
+func recipientChannels(defaults []string, pref *internalMessageV1.UserNotificationPreference, categoryId uint32) []string {
+	channels := defaults
+	if categoryId != 0 {
+		for _, cc := range pref.GetCategoryChannels() {
+			if cc.GetCategoryId() == categoryId {
+				channels = cc.GetChannels()
+				break
+			}
+		}
+	}
+
+	disabled := pref.GetDisabledChannels()
+	if len(disabled) == 0 {
+		return channels
+	}
+
+	result := make([]string, 0, len(channels))
+	for _, channel := range channels {
+		if !slices.Contains(disabled, channel) {
+			result = append(result, channel)
+		}
+	}
+	return result
+}
+
+// notifyMode 按接收者的通知偏好决定的推送方式
+type notifyMode int
+
+const (
+	// notifyNow 立即推送到客户端与外部渠道
+	notifyNow notifyMode = iota
+	// notifyMuted 消息分类已静音，只进入收件箱
+	notifyMuted
+	// notifyDigest 汇总到定期发送的摘要消息中
+	notifyDigest
+	// notifyDeferred 处于免打扰时段，不推送到客户端，外部渠道推迟到时段结束后发送
+	notifyDeferred
+)
+
+const (
+	// defaultDigestInterval 未设置发送间隔时摘要的发送间隔
+	defaultDigestInterval = time.Hour
+
+	// digestMaxItems 摘要消息中最多列出的消息标题数量
+	digestMaxItems = 20
+)
+
+// decideNotify 按接收者的通知偏好决定消息的推送方式，推迟推送时同时返回免打扰时段的结束时间。
+// 紧急消息不受免打扰时段限制，也不会汇总到摘要中
+func decideNotify(pref *internalMessageV1.UserNotificationPreference, msg *internalMessageV1.InternalMessage, now time.Time) (notifyMode, time.Time) {
+	if msg.CategoryId != nil && slices.Contains(pref.GetMutedCategoryIds(), msg.GetCategoryId()) {
+		return notifyMuted, time.Time{}
+	}
+
+	if msg.GetPriority() == internalMessageV1.InternalMessage_URGENT {
+		return notifyNow, time.Time{}
+	}
+
+	if pref.GetDigest().GetEnabled() && msg.GetPriority() == internalMessageV1.InternalMessage_LOW {
+		return notifyDigest, time.Time{}
+	}
+
+	if qh := pref.GetQuietHours(); qh.GetEnabled() {
+		if quiet, err := notify.ParseQuietHours(qh.GetStart(), qh.GetEnd(), qh.GetTimezone()); err == nil {
+			if until, ok := quiet.Until(now); ok {
+				return notifyDeferred, until
+			}
+		}
+	}
+
+	return notifyNow, time.Time{}
+}
+
+// digestInterval 摘要的发送间隔
+func digestInterval(digest *internalMessageV1.DigestSettings) time.Duration {
+	if digest.GetIntervalMinutes() == 0 {
+		return defaultDigestInterval
+	}
+	return time.Duration(digest.GetIntervalMinutes()) * time.Minute
+}
+
+// addToDigest 把消息加入接收者的摘要，待汇总列表原本为空的接收者预约在 sendAt 发送摘要
+func (s *InternalMessageService) addToDigest(ctx context.Context, messageId uint32, userIds []uint32, sendAt time.Time) error {
+	first, err := s.notificationDigestRepo.Add(ctx, userIds, messageId)
+	if err != nil {
+		return err
+	}
+
+	sendAt = sendAt.Truncate(time.Second)
+	for _, userId := range first {
+		if err = s.Server.NewTask(task.InternalMessageDigestTaskType,
+			&task.InternalMessageDigestTaskData{
+				UserId: userId,
+				SendAt: sendAt.Unix(),
+			},
+			asynq.ProcessAt(sendAt),
+			asynq.TaskID(task.CreateInternalMessageDigestTaskID(userId, sendAt.Unix())),
+		); err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleDigest 把用户积累的低优先级通知汇总为一条摘要消息发送，已撤销的消息不列出
+func (s *InternalMessageService) handleDigest(ctx context.Context, _ string, data *task.InternalMessageDigestTaskData) error {
+	messageIds, err := s.notificationDigestRepo.Pop(ctx, data.UserId)
+	if err != nil {
+		return err
+	}
+	if len(messageIds) == 0 {
+		return nil
+	}
+
+	messages, err := s.internalMessageRepo.ListByIds(ctx, messageIds)
+	if err != nil {
+		return err
+	}
+
+	var titles []string
+	for _, msg := range messages {
+		if msg.GetStatus() == internalMessageV1.InternalMessage_REVOKED {
+			continue
+		}
+		titles = append(titles, msg.GetTitle())
+	}
+	if len(titles) == 0 {
+		return nil
+	}
+
+	var content bytes.Buffer
+	for i, title := range titles {
+		if i == digestMaxItems {
+			fmt.Fprintf(&content, "……等共%d条通知\n", len(titles))
+			break
+		}
+		fmt.Fprintf(&content, "%d. %s\n", i+1, title)
+	}
+
+	now := time.Now()
+
+	digest, err := s.internalMessageRepo.Create(ctx, &internalMessageV1.CreateInternalMessageRequest{
+		Data: &internalMessageV1.InternalMessage{
+			Title:         trans.Ptr(fmt.Sprintf("您有%d条新通知", len(titles))),
+			Content:       trans.Ptr(content.String()),
+			Status:        trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
+			Type:          trans.Ptr(internalMessageV1.InternalMessage_NOTIFICATION),
+			Priority:      trans.Ptr(internalMessageV1.InternalMessage_NORMAL),
+			TargetUserIds: []uint32{data.UserId},
+			LastSentAt:    timeutil.TimeToTimestamppb(&now),
+			CreatedAt:     timeutil.TimeToTimestamppb(&now),
+
+			DeliveryStatus: trans.Ptr(internalMessageV1.InternalMessage_PENDING),
+		},
+	})
+	if err != nil {
+		s.log.Errorf("create digest message for user [%d] failed: %s", data.UserId, err)
+		return err
+	}
+
+	return s.deliverMessage(ctx, digest, 0)
+}
+
+// channelAddress 用户在通知渠道上的接收地址
+func channelAddress(channel string, user *userV1.User) string {
+	switch channel {
+	case notify.ChannelEmail:
+		return user.GetEmail()
+	case notify.ChannelSMS:
+		return user.GetMobile()
+	default:
+		return ""
+	}
+}
+
+// isConversationRequest 是否为会话消息：指定了会话，或者只有一个接收者的私信
+func isConversationRequest(req *internalMessageV1.SendMessageRequest) bool {
+	if req.ConversationId != nil {
+		return true
+	}
+
+	return req.GetType() == internalMessageV1.InternalMessage_PRIVATE &&
+		req.RecipientUserId != nil &&
+		len(req.GetTargetUserIds()) == 0 &&
+		!req.GetTargetAll() &&
+		req.Audience == nil &&
+		req.GetTemplateCode() == ""
+}
+
+// userDisplayName 用户的显示名称
+func userDisplayName(user *userV1.User) string {
+	switch {
+	case user.GetNickname() != "":
+		return user.GetNickname()
+	case user.GetRealname() != "":
+		return user.GetRealname()
+	default:
+		return user.GetUsername()
+	}
+}
+
+// finishDeliveryIfDone 所有批次都处理完成后更新投递状态，并通知发送者投递结果
+func (s *InternalMessageService) finishDeliveryIfDone(ctx context.Context, messageId, senderUserId uint32) error {
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: messageId},
+	})
+	if err != nil {
+		return err
+	}
+
+	if msg.GetDeliveredCount()+msg.GetFailedCount() < msg.GetRecipientTotal() {
+		return nil
+	}
+
+	status := internalMessageV1.InternalMessage_DELIVERED
+	switch {
+	case msg.GetFailedCount() == 0:
+	case msg.GetDeliveredCount() == 0:
+		status = internalMessageV1.InternalMessage_FAILED
+	default:
+		status = internalMessageV1.InternalMessage_PARTIALLY_FAILED
+	}
+
+	var finished bool
+	if finished, err = s.internalMessageRepo.FinishDelivery(ctx, messageId, status); err != nil {
+		return err
+	}
+	if !finished {
+		return nil
+	}
+
+	msg.DeliveryStatus = trans.Ptr(status)
+	s.log.Infof("message [%d] delivery finished: %s, delivered [%d], failed [%d]",
+		messageId, status.String(), msg.GetDeliveredCount(), msg.GetFailedCount())
+
+	if senderUserId == 0 {
+		return nil
+	}
+
+	resultJson, _ := json.Marshal(&internalMessageV1.InternalMessage{
+		Id:             msg.Id,
+		Title:          msg.Title,
+		DeliveryStatus: msg.DeliveryStatus,
+		RecipientTotal: msg.RecipientTotal,
+		DeliveredCount: msg.DeliveredCount,
+		FailedCount:    msg.FailedCount,
+	})
+
+	s.sseServer.PublishToUser(ctx, senderUserId, &sse.Event{
+		ID:    uuid.New().String(),
+		Data:  resultJson,
+		Event: "message_delivery",
+	})
+
+	return nil
+}
+
+// UpdateScheduledMessage 修改尚未发送的定时消息
+func (s *InternalMessageService) UpdateScheduledMessage(ctx context.Context, req *internalMessageV1.UpdateScheduledMessageRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var msg *internalMessageV1.InternalMessage
+	if msg, err = s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: req.GetMessageId()},
+	}); err != nil {
+		return nil, err
+	}
+
+	if msg.GetStatus() != internalMessageV1.InternalMessage_SCHEDULED {
+		return nil, adminV1.ErrorBadRequest("message [%d] is not scheduled", req.GetMessageId())
+	}
+
+	cronSpec := msg.GetCronSpec()
+	if req.CronSpec != nil {
+		cronSpec = req.GetCronSpec()
+	}
+
+	sendAt := msg.GetSendAt().AsTime()
+	if req.SendAt != nil || req.CronSpec != nil {
+		base := req.SendAt
+		if base == nil {
+			base = msg.SendAt
+		}
+		if sendAt, err = resolveSendAt(base, cronSpec, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	var ok bool
+	if ok, err = s.internalMessageRepo.UpdateSchedule(ctx, req.GetMessageId(), req.Title, req.Content, sendAt, req.CronSpec, operator.GetUserId()); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, adminV1.ErrorBadRequest("message [%d] has already been sent or canceled", req.GetMessageId())
+	}
+
+	// 旧的投递任务到期后发现计划发送时间不一致会自动作废
+	if err = s.enqueueScheduledMessage(req.GetMessageId(), msg.GetTenantId(), sendAt); err != nil {
+		s.log.Errorf("enqueue scheduled message [%d] failed: %s", req.GetMessageId(), err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// CancelScheduledMessage 取消尚未发送的定时消息，消息退回草稿状态
+func (s *InternalMessageService) CancelScheduledMessage(ctx context.Context, req *internalMessageV1.CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ok bool
+	if ok, err = s.internalMessageRepo.CancelSchedule(ctx, req.GetMessageId(), operator.GetUserId()); err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, adminV1.ErrorBadRequest("message [%d] is not scheduled", req.GetMessageId())
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// SubscribeHandlers 把定时消息与分批投递的处理器注册到队列服务
+func (s *InternalMessageService) SubscribeHandlers(srv *asynqServer.Server) error {
+	s.Server = srv
+
+	if err := asynqServer.RegisterSubscriberWithCtx(srv, task.InternalMessageSendTaskType, s.handleScheduledMessage); err != nil {
+		return err
+	}
+	if err := asynqServer.RegisterSubscriberWithCtx(srv, task.InternalMessageFanoutTaskType, s.handleFanout); err != nil {
+		return err
+	}
+	if err := asynqServer.RegisterSubscriberWithCtx(srv, task.InternalMessageChannelTaskType, s.handleChannelDelivery); err != nil {
+		return err
+	}
+	if err := asynqServer.RegisterSubscriberWithCtx(srv, task.InternalMessageDigestTaskType, s.handleDigest); err != nil {
+		return err
+	}
+	return asynqServer.RegisterSubscriberWithCtx(srv, task.InternalMessageDeliverTaskType, s.handleDeliverBatch)
+}
+
+// EnsureScheduledMessages 重新投递所有待发送的定时消息，已在队列中的投递任务不会重复入队
+func (s *InternalMessageService) EnsureScheduledMessages(ctx context.Context) {
+	messages, err := s.internalMessageRepo.ListScheduled(ctx)
+	if err != nil {
+		return
+	}
+
+	var count int
+	for _, msg := range messages {
+		if err = s.enqueueScheduledMessage(msg.GetId(), msg.GetTenantId(), msg.GetSendAt().AsTime()); err != nil {
+			s.log.Errorf("enqueue scheduled message [%d] failed: %s", msg.GetId(), err)
+			continue
+		}
+		count++
+	}
+
+	s.log.Infof("enqueued [%d] scheduled messages", count)
+}
+
+// enqueueScheduledMessage 投递定时消息的发送任务，任务携带消息所属的租户
+func (s *InternalMessageService) enqueueScheduledMessage(messageId, tenantId uint32, sendAt time.Time) error {
+	if s.Server == nil {
+		return errors.New("task server is not configured")
+	}
+
+	err := s.Server.NewTask(task.InternalMessageSendTaskType,
+		&task.InternalMessageSendTaskData{
+			MessageId: messageId,
+			TenantId:  tenantId,
+			SendAt:    sendAt.Unix(),
+		},
+		asynq.ProcessAt(sendAt),
+		asynq.TaskID(task.CreateInternalMessageSendTaskID(messageId, sendAt.Unix())),
+	)
+	if errors.Is(err, asynq.ErrTaskIDConflict) {
+		return nil
+	}
+
+	return err
+}
+
+// handleScheduledMessage 到达发送时间后投递定时消息，周期消息投递后预约下一次发送
+func (s *InternalMessageService) handleScheduledMessage(ctx context.Context, _ string, data *task.InternalMessageSendTaskData) error {
+	// 受众在消息所属租户的上下文中解析，全员消息只发给该租户的用户
+	ctx = tenantTaskContext(ctx, data.TenantId)
+
+	msg, err := s.internalMessageRepo.Get(ctx, &internalMessageV1.GetInternalMessageRequest{
+		QueryBy: &internalMessageV1.GetInternalMessageRequest_Id{Id: data.MessageId},
+	})
+	if err != nil {
+		if internalMessageV1.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if msg.GetStatus() != internalMessageV1.InternalMessage_SCHEDULED ||
+		msg.SendAt == nil || msg.GetSendAt().AsTime().Unix() != data.SendAt {
+		s.log.Infof("scheduled message [%d] has been changed or canceled, skip", data.MessageId)
+		return nil
+	}
+
+	now := time.Now()
+	sendAt := time.Unix(data.SendAt, 0)
+
+	var next *time.Time
+	if msg.GetCronSpec() != "" {
+		after := now
+		if sendAt.After(after) {
+			after = sendAt
+		}
+
+		n, err := task.NextCronTime(msg.GetCronSpec(), after)
+		if err != nil {
+			s.log.Errorf("scheduled message [%d] has invalid cron spec: %s", data.MessageId, err)
+		} else {
+			next = trans.Ptr(n.Truncate(time.Second))
+		}
+	}
+
+	// 受众在发送时解析，期间入职、调岗的用户都能收到
+	targetUserIds, err := s.resolveAudience(ctx, messageAudience(msg))
+	if err != nil && !adminV1.IsBadRequest(err) {
+		return err
+	}
+
+	var claimed bool
+	if claimed, err = s.internalMessageRepo.ClaimScheduled(ctx, data.MessageId, sendAt, next, targetUserIds); err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	msg.TargetUserIds = targetUserIds
+
+	occurrence := msg
+	if next != nil {
+		// 周期消息每次发送生成一条已发布的消息，各次发送可以单独撤销与归档
+		if occurrence, err = s.internalMessageRepo.Create(ctx, &internalMessageV1.CreateInternalMessageRequest{
+			Data: &internalMessageV1.InternalMessage{
+				Title:         msg.Title,
+				Content:       msg.Content,
+				TemplateCode:  msg.TemplateCode,
+				Status:        trans.Ptr(internalMessageV1.InternalMessage_PUBLISHED),
+				Type:          msg.Type,
+				Priority:      msg.Priority,
+				CategoryId:    msg.CategoryId,
+				TargetAll:     msg.TargetAll,
+				TargetUserIds: targetUserIds,
+				Audience:      msg.Audience,
+				LastSentAt:    timeutil.TimeToTimestamppb(&now),
+				CreatedBy:     msg.CreatedBy,
+				CreatedAt:     timeutil.TimeToTimestamppb(&now),
+
+				TemplateVariables: msg.TemplateVariables,
+				DeliveryStatus:    trans.Ptr(internalMessageV1.InternalMessage_PENDING),
+				TenantId:          msg.TenantId,
+			},
+		}); err != nil {
+			s.log.Errorf("create recurring message occurrence [%d] failed: %s", data.MessageId, err)
+			occurrence = msg
+		}
+
+		if err = s.enqueueScheduledMessage(data.MessageId, msg.GetTenantId(), *next); err != nil {
+			s.log.Errorf("enqueue next scheduled message [%d] failed: %s", data.MessageId, err)
+		}
+	}
+
+	if err = s.deliverMessage(ctx, occurrence, msg.GetCreatedBy()); err != nil {
+		s.log.Errorf("deliver scheduled message [%d] failed: %s", occurrence.GetId(), err)
+	}
+
+	return nil
+}
+
+// tenantTaskContext 队列任务在系统上下文中执行，租户的消息切换到该租户的上下文，读写的数据按租户隔离；
+// 平台的消息与在请求中直接执行时保持原有的上下文
+func tenantTaskContext(ctx context.Context, tenantId uint32) context.Context {
+	if tenantId == 0 || !viewer.IsSystemContext(ctx) {
+		return ctx
+	}
+	return viewer.NewTenantViewerContext(ctx, tenantId)
+}
+
+// PreviewAudience 预览受众解析结果
+func (s *InternalMessageService) PreviewAudience(ctx context.Context, req *internalMessageV1.MessageAudience) (*internalMessageV1.PreviewAudienceResponse, error) {
+	ids, err := s.resolveAudience(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &internalMessageV1.PreviewAudienceResponse{
+		UserIds: ids,
+		Total:   uint32(len(ids)),
+	}, nil
+}
+
+// resolveAudience 解析受众表达式，返回接收者用户ID列表
+func (s *InternalMessageService) resolveAudience(ctx context.Context, target *internalMessageV1.MessageAudience) ([]uint32, error) {
+	ids, err := s.audienceRepo.Resolve(ctx, toAudienceSpec(target))
+	if err != nil {
+		if errors.Is(err, audience.ErrEmptyAudience) {
+			return nil, adminV1.ErrorBadRequest("no recipients specified")
+		}
+		return nil, err
+	}
+	return ids, nil
+}
+
+// requestAudience 合并请求中的受众表达式与 target_all、recipient_user_id、target_user_ids
+func requestAudience(req *internalMessageV1.SendMessageRequest) *internalMessageV1.MessageAudience {
+	target := &internalMessageV1.MessageAudience{}
+	if req.Audience != nil {
+		target = proto.Clone(req.Audience).(*internalMessageV1.MessageAudience)
+	}
+
+	if req.GetTargetAll() {
+		target.All = trans.Ptr(true)
+	}
+
+	var userIds []uint32
+	if req.RecipientUserId != nil {
+		userIds = append(userIds, req.GetRecipientUserId())
+	}
+	userIds = append(userIds, req.TargetUserIds...)
+
+	if len(userIds) > 0 {
+		if target.Include == nil {
+			target.Include = &internalMessageV1.AudienceFilter{}
+		}
+		target.Include.UserIds = append(target.Include.UserIds, userIds...)
+	}
+
+	return target
+}
+
+// messageAudience 返回消息的受众表达式，兼容只保存了 target_all 与 target_user_ids 的消息
+func messageAudience(msg *internalMessageV1.InternalMessage) *internalMessageV1.MessageAudience {
+	if msg.Audience != nil {
+		return msg.Audience
+	}
+
+	return &internalMessageV1.MessageAudience{
+		All:     msg.TargetAll,
+		Include: &internalMessageV1.AudienceFilter{UserIds: msg.TargetUserIds},
+	}
+}
+
+func toAudienceSpec(target *internalMessageV1.MessageAudience) *audience.Spec {
+	if target == nil {
+		return nil
+	}
+
+	return &audience.Spec{
+		All:     target.GetAll(),
+		Include: toAudienceFilter(target.GetInclude()),
+		Exclude: toAudienceFilter(target.GetExclude()),
+	}
+}
+
+func toAudienceFilter(f *internalMessageV1.AudienceFilter) audience.Filter {
+	return audience.Filter{
+		UserIds:                 f.GetUserIds(),
+		RoleIds:                 f.GetRoleIds(),
+		DepartmentIds:           f.GetDepartmentIds(),
+		OrganizationIds:         f.GetOrganizationIds(),
+		PositionIds:             f.GetPositionIds(),
+		TenantIds:               f.GetTenantIds(),
+		IncludeSubDepartments:   f.GetIncludeSubDepartments(),
+		IncludeSubOrganizations: f.GetIncludeSubOrganizations(),
+	}
+}
+
+// resolveSendAt 计算定时消息的发送时间：只发送一次时发送时间必须晚于当前时间；
+// 周期消息以 sendAt 为开始时间（为空时从当前时间开始），取之后cron表达式的第一次触发时间
+func resolveSendAt(sendAt *timestamppb.Timestamp, cronSpec string, now time.Time) (time.Time, error) {
+	if cronSpec == "" {
+		if sendAt == nil {
+			return time.Time{}, adminV1.ErrorBadRequest("send_at is required")
+		}
+
+		t := sendAt.AsTime().Truncate(time.Second)
+		if !t.After(now) {
+			return time.Time{}, adminV1.ErrorBadRequest("send_at must be in the future")
+		}
+		return t, nil
+	}
+
+	after := now
+	if sendAt != nil && sendAt.AsTime().After(after) {
+		// 从开始时间起算，开始时间本身也可以是一次触发
+		after = sendAt.AsTime().Add(-time.Second)
+	}
+
+	t, err := task.NextCronTime(cronSpec, after)
+	if err != nil {
+		return time.Time{}, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	return t.Truncate(time.Second), nil
+}
+
+// incrDeliveredUnread 累加一批新写入收件箱的接收者的未读计数
+func (s *InternalMessageService) incrDeliveredUnread(ctx context.Context, msg *internalMessageV1.InternalMessage, recipients []*internalMessageV1.InternalMessageRecipient) {
+	if len(recipients) == 0 {
+		return
+	}
+
+	userIds := make([]uint32, 0, len(recipients))
+	for _, recipient := range recipients {
+		userIds = append(userIds, recipient.GetRecipientUserId())
+	}
+
+	delta := make(data.InboxUnreadCounts)
+	delta.Add(msg.GetCategoryId(), msg.GetType(), 1)
+
+	s.internalMessageRecipientService.incrInbox(ctx, userIds, delta)
+}
+
+// publishNotification 向接收者在线的客户端推送通知消息
+func (s *InternalMessageService) publishNotification(ctx context.Context, recipient *internalMessageV1.InternalMessageRecipient) {
+	s.publishRecipientEvent(ctx, "notification", recipient)
+}
+
+// publishRecipientEvent 向接收者在线的客户端推送收件记录
+func (s *InternalMessageService) publishRecipientEvent(ctx context.Context, event string, recipient *internalMessageV1.InternalMessageRecipient) {
+	recipientJson, _ := json.Marshal(recipient)
+
+	s.sseServer.PublishToUser(ctx, recipient.GetRecipientUserId(), &sse.Event{
+		ID:    uuid.New().String(),
+		Data:  recipientJson,
+		Event: event,
+	})
+}
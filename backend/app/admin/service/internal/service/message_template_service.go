@@ -0,0 +1,202 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/msgtemplate"
+)
+
+type MessageTemplateService struct {
+	adminV1.MessageTemplateServiceHTTPServer
+
+	log *log.Helper
+
+	repo     *data.MessageTemplateRepo
+	userRepo *data.UserRepo
+}
+
+func NewMessageTemplateService(logger log.Logger, repo *data.MessageTemplateRepo, userRepo *data.UserRepo) *MessageTemplateService {
+	l := log.NewHelper(log.With(logger, "module", "message-template/service/admin-service"))
+	return &MessageTemplateService{
+		log:      l,
+		repo:     repo,
+		userRepo: userRepo,
+	}
+}
+
+func (s *MessageTemplateService) List(ctx context.Context, req *pagination.PagingRequest) (*internalMessageV1.ListMessageTemplateResponse, error) {
+	return s.repo.List(ctx, req)
+}
+
+func (s *MessageTemplateService) Get(ctx context.Context, req *internalMessageV1.GetMessageTemplateRequest) (*internalMessageV1.MessageTemplate, error) {
+	return s.repo.Get(ctx, req)
+}
+
+func (s *MessageTemplateService) Create(ctx context.Context, req *internalMessageV1.CreateMessageTemplateRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+	if req.Data.GetCode() == "" {
+		return nil, adminV1.ErrorBadRequest("template code is required")
+	}
+
+	if err := validateMessageTemplate(req.Data); err != nil {
+		return nil, err
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageTemplateService) Update(ctx context.Context, req *internalMessageV1.UpdateMessageTemplateRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 只更新其他字段时不校验语言版本
+	if req.Data.Locales != nil || req.GetAllowMissing() {
+		if err := validateMessageTemplate(req.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if err = s.repo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *MessageTemplateService) Delete(ctx context.Context, req *internalMessageV1.DeleteMessageTemplateRequest) (*emptypb.Empty, error) {
+	if err := s.repo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// Preview 渲染已保存或编辑中的模板，默认以当前用户作为接收者
+func (s *MessageTemplateService) Preview(ctx context.Context, req *internalMessageV1.PreviewMessageTemplateRequest) (*internalMessageV1.PreviewMessageTemplateResponse, error) {
+	if req == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := req.GetTemplate()
+	if tpl == nil {
+		if req.GetCode() == "" {
+			return nil, adminV1.ErrorBadRequest("template code is required")
+		}
+
+		if tpl, err = s.repo.Get(ctx, &internalMessageV1.GetMessageTemplateRequest{
+			QueryBy: &internalMessageV1.GetMessageTemplateRequest_Code{Code: req.GetCode()},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	userId := operator.UserId
+	if req.UserId != nil {
+		userId = req.GetUserId()
+	}
+
+	user, err := s.userRepo.Get(ctx, &userV1.GetUserRequest{
+		QueryBy: &userV1.GetUserRequest_Id{Id: userId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	language := req.GetLanguageCode()
+	if language == "" {
+		language = user.GetLanguage()
+	}
+
+	result, err := msgtemplate.Render(toMsgTemplate(tpl), &msgtemplate.Data{
+		Vars: req.GetVariables(),
+		User: toMsgTemplateUser(user),
+	}, language)
+	if err != nil {
+		return nil, adminV1.ErrorBadRequest("render template failed: %s", err.Error())
+	}
+
+	return &internalMessageV1.PreviewMessageTemplateResponse{
+		LanguageCode: result.Language,
+		Subject:      result.Subject,
+		Body:         result.Body,
+	}, nil
+}
+
+// validateMessageTemplate 校验模板各语言版本的语法
+func validateMessageTemplate(tpl *internalMessageV1.MessageTemplate) error {
+	if err := msgtemplate.Validate(toMsgTemplate(tpl)); err != nil {
+		return adminV1.ErrorBadRequest("invalid message template: %s", err.Error())
+	}
+	return nil
+}
+
+func toMsgTemplate(tpl *internalMessageV1.MessageTemplate) *msgtemplate.Template {
+	t := &msgtemplate.Template{
+		Code:            tpl.GetCode(),
+		DefaultLanguage: tpl.GetDefaultLanguage(),
+		Locales:         make([]msgtemplate.Locale, 0, len(tpl.GetLocales())),
+	}
+	for _, l := range tpl.GetLocales() {
+		t.Locales = append(t.Locales, msgtemplate.Locale{
+			Language: l.GetLanguageCode(),
+			Subject:  l.GetSubject(),
+			Body:     l.GetBody(),
+		})
+	}
+	return t
+}
+
+func toMsgTemplateUser(user *userV1.User) *msgtemplate.User {
+	if user == nil {
+		return nil
+	}
+	return &msgtemplate.User{
+		ID:       user.GetId(),
+		Username: user.GetUsername(),
+		Nickname: user.GetNickname(),
+		Realname: user.GetRealname(),
+		Email:    user.GetEmail(),
+		Mobile:   user.GetMobile(),
+		Language: user.GetLanguage(),
+	}
+}
@@ -2,13 +2,20 @@ package service
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"path"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/tx7do/go-utils/trans"
 
+	"go-wind-admin/app/admin/service/internal/data"
+
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
 
+	"go-wind-admin/pkg/middleware/auth"
 	"go-wind-admin/pkg/oss"
 )
 
@@ -18,13 +25,18 @@ type OssService struct {
 	log *log.Helper
 
 	mc *oss.MinIOClient
+
+	fileRepo      *data.FileRepo
+	tenantService *TenantService
 }
 
-func NewOssService(logger log.Logger, mc *oss.MinIOClient) *OssService {
+func NewOssService(logger log.Logger, mc *oss.MinIOClient, fileRepo *data.FileRepo, tenantService *TenantService) *OssService {
 	l := log.NewHelper(log.With(logger, "module", "oss/service/admin-service"))
 	return &OssService{
-		log: l,
-		mc:  mc,
+		log:           l,
+		mc:            mc,
+		fileRepo:      fileRepo,
+		tenantService: tenantService,
 	}
 }
 
@@ -44,7 +56,7 @@ func (s *OssService) PostUploadFile(ctx context.Context, req *fileV1.UploadOssFi
 		req.ObjectName = trans.Ptr(req.GetSourceFileName())
 	}
 
-	downloadUrl, err := s.mc.UploadFile(ctx, req.GetBucketName(), req.GetObjectName(), req.GetFile())
+	downloadUrl, err := s.UploadTenantFile(ctx, req.GetBucketName(), req.GetObjectName(), req.GetSourceFileName(), req.GetFile())
 	return &fileV1.UploadOssFileResponse{
 		Url: downloadUrl,
 	}, err
@@ -62,8 +74,56 @@ func (s *OssService) PutUploadFile(ctx context.Context, req *fileV1.UploadOssFil
 		req.ObjectName = trans.Ptr(req.GetSourceFileName())
 	}
 
-	downloadUrl, err := s.mc.UploadFile(ctx, req.GetBucketName(), req.GetObjectName(), req.GetFile())
+	downloadUrl, err := s.UploadTenantFile(ctx, req.GetBucketName(), req.GetObjectName(), req.GetSourceFileName(), req.GetFile())
 	return &fileV1.UploadOssFileResponse{
 		Url: downloadUrl,
 	}, err
 }
+
+// UploadTenantFile 检查操作人所属租户的存储配额后上传文件，并写入文件记录，租户的存储用量按文件记录统计
+func (s *OssService) UploadTenantFile(ctx context.Context, bucketName, objectName, fileName string, content []byte) (string, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	size := uint64(len(content))
+
+	if err = s.tenantService.CheckStorageQuota(ctx, operator.GetTenantId(), size); err != nil {
+		return "", err
+	}
+
+	downloadUrl, err := s.mc.UploadFile(ctx, bucketName, objectName, content)
+	if err != nil {
+		return "", err
+	}
+
+	fileDirectory := path.Dir(objectName)
+	if fileDirectory == "." {
+		fileDirectory = ""
+	}
+
+	sum := md5.Sum(content)
+	if err = s.fileRepo.Create(ctx, &fileV1.CreateFileRequest{
+		Data: &fileV1.File{
+			Provider:      trans.Ptr(fileV1.OSSProvider_MINIO),
+			BucketName:    trans.Ptr(bucketName),
+			FileDirectory: trans.Ptr(fileDirectory),
+			FileGuid:      trans.Ptr(uuid.New().String()),
+			SaveFileName:  trans.Ptr(path.Base(objectName)),
+			FileName:      trans.Ptr(fileName),
+			Extension:     trans.Ptr(path.Ext(fileName)),
+			Size:          trans.Ptr(size),
+			SizeFormat:    trans.Ptr(formatFileSize(size)),
+			LinkUrl:       trans.Ptr(downloadUrl),
+			Md5:           trans.Ptr(hex.EncodeToString(sum[:])),
+			CreatedBy:     trans.Ptr(operator.UserId),
+		},
+	}); err != nil {
+		// 文件已上传成功，记录写入失败只影响存储用量的统计
+		s.log.Errorf("record uploaded file [%s/%s] failed: %s", bucketName, objectName, err.Error())
+	}
+
+	return downloadUrl, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/ratelimit"
+)
+
+// defaultRateLimitCounterLimit 默认返回的计数条数
+const defaultRateLimitCounterLimit = 100
+
+type RateLimitService struct {
+	adminV1.RateLimitServiceHTTPServer
+
+	log *log.Helper
+
+	limiter *ratelimit.Limiter
+}
+
+func NewRateLimitService(logger log.Logger, limiter *ratelimit.Limiter) *RateLimitService {
+	l := log.NewHelper(log.With(logger, "module", "rate-limit/service/admin-service"))
+	return &RateLimitService{
+		log:     l,
+		limiter: limiter,
+	}
+}
+
+// ListCounters 查询某一天的请求计数。计数包含所有租户的用户与IP，只有平台的操作人可以查询
+func (s *RateLimitService) ListCounters(ctx context.Context, req *adminV1.ListRateLimitCountersRequest) (*adminV1.ListRateLimitCountersResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if operator.GetTenantId() != 0 {
+		return nil, adminV1.ErrorForbidden("只有平台用户可以查询限流计数")
+	}
+
+	day := time.Now()
+	if req.Date != nil {
+		if day, err = time.ParseInLocation(time.DateOnly, req.GetDate(), time.Local); err != nil {
+			return nil, adminV1.ErrorBadRequest("日期格式应为 YYYY-MM-DD")
+		}
+	}
+
+	dimension := ratelimit.Dimension(req.GetDimension())
+	if dimension != "" && !slices.Contains(ratelimit.Dimensions, dimension) {
+		return nil, adminV1.ErrorBadRequest("不支持的限流维度")
+	}
+
+	counters, err := s.limiter.Counters(ctx, day, dimension)
+	if err != nil {
+		s.log.Errorf("query rate limit counters failed [%s]", err.Error())
+		return nil, adminV1.ErrorInternalServerError("query rate limit counters failed")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 {
+		limit = defaultRateLimitCounterLimit
+	}
+
+	resp := &adminV1.ListRateLimitCountersResponse{
+		Enabled: s.limiter.Policy().Enabled(),
+		Date:    day.Format(time.DateOnly),
+		Total:   uint32(len(counters)),
+	}
+	for i, c := range counters {
+		if i >= limit {
+			break
+		}
+		resp.Items = append(resp.Items, &adminV1.RateLimitCounter{
+			Dimension: string(c.Dimension),
+			Value:     c.Value,
+			Allowed:   c.Allowed,
+			Rejected:  c.Rejected,
+		})
+	}
+
+	return resp, nil
+}
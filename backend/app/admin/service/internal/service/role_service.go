@@ -55,13 +55,11 @@ func NewRoleService(
 		rolePositionRepo: rolePositionRepo,
 	}
 
-	svc.init()
-
 	return svc
 }
 
-func (s *RoleService) init() {
-	ctx := context.Background()
+// EnsureDefaultRoles 没有任何角色时创建默认角色，只在领导者副本上执行
+func (s *RoleService) EnsureDefaultRoles(ctx context.Context) {
 	if count, _ := s.roleRepo.Count(ctx, []func(s *sql.Selector){}); count == 0 {
 		_ = s.createDefaultRoles(ctx)
 	}
@@ -88,11 +86,19 @@ func (s *RoleService) Create(ctx context.Context, req *userV1.CreateRoleRequest)
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
+	tenantId := operator.GetTenantId()
+	if req.Data.TenantId != nil {
+		tenantId = req.Data.GetTenantId()
+	}
+	if err = s.checkRoleCode(ctx, tenantId, req.Data.GetCode(), 0); err != nil {
+		return nil, err
+	}
+
 	if err = s.roleRepo.Create(ctx, req); err != nil {
 		return nil, err
 	}
 
-	if err = s.authorizer.ResetPolicies(ctx); err != nil {
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
 		s.log.Errorf("reset policies error: %v", err)
 	}
 
@@ -113,18 +119,50 @@ func (s *RoleService) Update(ctx context.Context, req *userV1.UpdateRoleRequest)
 
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 
+	if req.Data.Code != nil {
+		// 角色不存在且允许新增时，按当前用户所在的租户检查
+		tenantId := operator.GetTenantId()
+		role, err := s.roleRepo.Get(ctx, &userV1.GetRoleRequest{QueryBy: &userV1.GetRoleRequest_Id{Id: req.GetId()}})
+		if err == nil {
+			tenantId = role.GetTenantId()
+		} else if !req.GetAllowMissing() {
+			return nil, err
+		}
+
+		if err = s.checkRoleCode(ctx, tenantId, req.Data.GetCode(), req.GetId()); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = s.roleRepo.Update(ctx, req); err != nil {
 		s.log.Errorf("update role error: %v", err)
 		return nil, err
 	}
 
-	if err = s.authorizer.ResetPolicies(ctx); err != nil {
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
 		s.log.Errorf("reset policies error: %v", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// checkRoleCode 角色编码在租户内唯一，且不能与平台共享的角色同名
+func (s *RoleService) checkRoleCode(ctx context.Context, tenantId uint32, code string, excludeId uint32) error {
+	if code == "" {
+		return nil
+	}
+
+	exist, err := s.roleRepo.RoleCodeExists(ctx, tenantId, code, excludeId)
+	if err != nil {
+		return err
+	}
+	if exist {
+		return adminV1.ErrorConflict("角色编码已存在")
+	}
+
+	return nil
+}
+
 func (s *RoleService) Delete(ctx context.Context, req *userV1.DeleteRoleRequest) (*emptypb.Empty, error) {
 	var err error
 
@@ -132,7 +170,7 @@ func (s *RoleService) Delete(ctx context.Context, req *userV1.DeleteRoleRequest)
 		return nil, err
 	}
 
-	if err = s.authorizer.ResetPolicies(ctx); err != nil {
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
 		s.log.Errorf("reset policies error: %v", err)
 	}
 
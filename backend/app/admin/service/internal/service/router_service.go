@@ -30,6 +30,8 @@ type RouterService struct {
 	menuRepo *data.MenuRepo
 	roleRepo *data.RoleRepo
 	userRepo *data.UserRepo
+
+	tenantService *TenantService
 }
 
 func NewRouterService(
@@ -37,13 +39,15 @@ func NewRouterService(
 	menuRepo *data.MenuRepo,
 	roleRepo *data.RoleRepo,
 	userRepo *data.UserRepo,
+	tenantService *TenantService,
 ) *RouterService {
 	l := log.NewHelper(log.With(logger, "module", "router/service/admin-service"))
 	return &RouterService{
-		log:      l,
-		menuRepo: menuRepo,
-		roleRepo: roleRepo,
-		userRepo: userRepo,
+		log:           l,
+		menuRepo:      menuRepo,
+		roleRepo:      roleRepo,
+		userRepo:      userRepo,
+		tenantService: tenantService,
 	}
 }
 
@@ -117,6 +121,15 @@ func (s *RouterService) queryMultipleRolesMenusByRoleIds(ctx context.Context, ro
 	return menus, nil
 }
 
+// filterPlanMenus 去掉租户订阅套餐未开通的菜单
+func (s *RouterService) filterPlanMenus(ctx context.Context, tenantId uint32, menus []uint32) ([]uint32, error) {
+	limits, err := s.tenantService.TenantLimits(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	return limits.FilterMenus(menus), nil
+}
+
 func (s *RouterService) ListPermissionCode(ctx context.Context, _ *emptypb.Empty) (*adminV1.ListPermissionCodeResponse, error) {
 	// 获取操作人信息
 	operator, err := auth.FromContext(ctx)
@@ -146,6 +159,10 @@ func (s *RouterService) ListPermissionCode(ctx context.Context, _ *emptypb.Empty
 		return nil, err
 	}
 
+	if roleMenus, err = s.filterPlanMenus(ctx, operator.GetTenantId(), roleMenus); err != nil {
+		return nil, err
+	}
+
 	menus, err := s.menuRepo.List(ctx, &pagination.PagingRequest{
 		NoPaging: trans.Ptr(true),
 		Query:    trans.Ptr(s.menuListToQueryString(roleMenus, true)),
@@ -238,6 +255,10 @@ func (s *RouterService) ListRoute(ctx context.Context, _ *emptypb.Empty) (*admin
 		return nil, err
 	}
 
+	if roleMenus, err = s.filterPlanMenus(ctx, operator.GetTenantId(), roleMenus); err != nil {
+		return nil, err
+	}
+
 	menuList, err := s.menuRepo.List(ctx, &pagination.PagingRequest{
 		NoPaging: trans.Ptr(true),
 		Query:    trans.Ptr(s.menuListToQueryString(roleMenus, false)),
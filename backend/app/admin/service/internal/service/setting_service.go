@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/settings"
+)
+
+type SettingService struct {
+	adminV1.SettingServiceHTTPServer
+
+	log *log.Helper
+
+	registry *settings.Registry
+
+	settingRepo  *data.SettingRepo
+	settingCache *data.SettingCacheRepo
+	tenantRepo   *data.TenantRepo
+}
+
+func NewSettingService(
+	logger log.Logger,
+	registry *settings.Registry,
+	settingRepo *data.SettingRepo,
+	settingCache *data.SettingCacheRepo,
+	tenantRepo *data.TenantRepo,
+) *SettingService {
+	l := log.NewHelper(log.With(logger, "module", "setting/service/admin-service"))
+	return &SettingService{
+		log:          l,
+		registry:     registry,
+		settingRepo:  settingRepo,
+		settingCache: settingCache,
+		tenantRepo:   tenantRepo,
+	}
+}
+
+// ListDefinitions 查询配置项定义
+func (s *SettingService) ListDefinitions(_ context.Context, _ *emptypb.Empty) (*userV1.ListSettingDefinitionResponse, error) {
+	defs := s.registry.List()
+
+	resp := &userV1.ListSettingDefinitionResponse{
+		Items: make([]*userV1.SettingDefinition, 0, len(defs)),
+	}
+	for _, def := range defs {
+		item := &userV1.SettingDefinition{
+			Key:          def.Key,
+			Kind:         string(def.Kind),
+			DefaultValue: def.Default,
+			Scope:        userV1.SettingDefinition_Scope(def.Scope),
+			Options:      def.Options,
+			Min:          def.Min,
+			Max:          def.Max,
+			Description:  def.Description,
+		}
+		if def.MaxLength > 0 {
+			item.MaxLength = trans.Ptr(uint32(def.MaxLength))
+		}
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+// GetSettings 查询系统或租户生效的配置
+func (s *SettingService) GetSettings(ctx context.Context, req *userV1.GetSettingsRequest) (*userV1.ListSettingResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantId, err := s.targetTenant(ctx, operator, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := settings.ScopeTenant
+	if tenantId == 0 {
+		scope = settings.ScopeSystem
+	}
+
+	return s.listSettings(ctx, tenantId, 0, scope)
+}
+
+// UpdateSettings 更新系统或租户配置
+func (s *SettingService) UpdateSettings(ctx context.Context, req *userV1.UpdateSettingsRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantId, err := s.targetTenant(ctx, operator, req.TenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := settings.ScopeTenant
+	if tenantId == 0 {
+		scope = settings.ScopeSystem
+	}
+
+	if err = s.saveSettings(ctx, tenantId, 0, scope, req, operator.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListUserSettings 查询对用户生效的配置
+func (s *SettingService) ListUserSettings(ctx context.Context, tenantId, userId uint32) (*userV1.ListSettingResponse, error) {
+	return s.listSettings(ctx, tenantId, userId, settings.ScopeUser)
+}
+
+// SaveUserSettings 保存用户配置，只能覆盖允许用户修改的配置项
+func (s *SettingService) SaveUserSettings(ctx context.Context, tenantId, userId uint32, req *userV1.UpdateSettingsRequest) error {
+	return s.saveSettings(ctx, tenantId, userId, settings.ScopeUser, req, userId)
+}
+
+// Resolve 获取对租户或用户生效的配置，userId 为0时只合并到租户配置
+func (s *SettingService) Resolve(ctx context.Context, tenantId, userId uint32) (settings.Values, error) {
+	system, tenant, user, err := s.loadLayers(ctx, tenantId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.registry.Resolve(system, tenant, user), nil
+}
+
+// targetTenant 确定要查询或修改的租户：不指定时为操作人所在租户，只有平台的操作人可以指定其他租户，0为系统配置
+func (s *SettingService) targetTenant(ctx context.Context, operator *authenticationV1.UserTokenPayload, tenantId *uint32) (uint32, error) {
+	if tenantId == nil || *tenantId == operator.GetTenantId() {
+		return operator.GetTenantId(), nil
+	}
+
+	if operator.GetTenantId() != 0 {
+		return 0, adminV1.ErrorForbidden("不能访问其他租户的配置")
+	}
+
+	if *tenantId == 0 {
+		return 0, nil
+	}
+
+	state, err := s.tenantRepo.GetState(viewer.NewSystemViewerContext(ctx), *tenantId)
+	if err != nil {
+		return 0, err
+	}
+	if !state.Exists {
+		return 0, userV1.ErrorTenantNotFound("tenant not found")
+	}
+
+	return *tenantId, nil
+}
+
+// listSettings 查询 scope 层级生效的配置，并标明当前层级可以修改的配置项
+func (s *SettingService) listSettings(ctx context.Context, tenantId, userId uint32, scope settings.Scope) (*userV1.ListSettingResponse, error) {
+	system, tenant, user, err := s.loadLayers(ctx, tenantId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	items := s.registry.Effective(system, tenant, user)
+
+	resp := &userV1.ListSettingResponse{
+		Items: make([]*userV1.Setting, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &userV1.Setting{
+			Key:      item.Definition.Key,
+			Value:    item.Value,
+			Source:   userV1.Setting_Source(item.Source),
+			Editable: item.Definition.Overridable(scope),
+		})
+	}
+
+	return resp, nil
+}
+
+// saveSettings 校验并保存一个层级的配置覆盖值，保存后删除该层级的缓存
+func (s *SettingService) saveSettings(
+	ctx context.Context,
+	tenantId, userId uint32,
+	scope settings.Scope,
+	req *userV1.UpdateSettingsRequest,
+	operatorId uint32,
+) error {
+	if len(req.GetValues()) == 0 && len(req.GetResetKeys()) == 0 {
+		return adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	values := make(map[string]string, len(req.GetValues()))
+	for key, value := range req.GetValues() {
+		normalized, err := s.registry.Validate(scope, key, value)
+		if err != nil {
+			return settingError(err)
+		}
+		values[key] = normalized
+	}
+
+	for _, key := range req.GetResetKeys() {
+		if _, ok := values[key]; ok {
+			return adminV1.ErrorBadRequest("配置项[%s]不能同时修改与重置", key)
+		}
+		if _, ok := s.registry.Lookup(key); !ok {
+			return adminV1.ErrorBadRequest("未知的配置项: %s", key)
+		}
+	}
+
+	if err := s.settingRepo.SaveOverrides(ctx, tenantId, userId, values, req.GetResetKeys(), operatorId); err != nil {
+		return err
+	}
+
+	if err := s.settingCache.InvalidateOverrides(ctx, tenantId, userId); err != nil {
+		s.log.Warnf("invalidate settings cache [%d:%d] failed: %s", tenantId, userId, err)
+	}
+
+	return nil
+}
+
+// loadLayers 读取系统、租户与用户三层覆盖值，租户ID为0时没有租户层，用户ID为0时没有用户层
+func (s *SettingService) loadLayers(ctx context.Context, tenantId, userId uint32) (system, tenant, user map[string]string, err error) {
+	if system, err = s.loadOverrides(ctx, 0, 0); err != nil {
+		return
+	}
+
+	if tenantId != 0 {
+		if tenant, err = s.loadOverrides(ctx, tenantId, 0); err != nil {
+			return
+		}
+	}
+
+	if userId != 0 {
+		if user, err = s.loadOverrides(ctx, tenantId, userId); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// loadOverrides 读取一个层级的覆盖值，缓存不存在时从数据库加载
+func (s *SettingService) loadOverrides(ctx context.Context, tenantId, userId uint32) (map[string]string, error) {
+	if overrides, ok, err := s.settingCache.GetOverrides(ctx, tenantId, userId); err != nil {
+		s.log.Warnf("get settings [%d:%d] from cache failed: %s", tenantId, userId, err)
+	} else if ok {
+		return overrides, nil
+	}
+
+	overrides, err := s.settingRepo.ListOverrides(ctx, tenantId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.settingCache.SetOverrides(ctx, tenantId, userId, overrides); err != nil {
+		s.log.Warnf("cache settings [%d:%d] failed: %s", tenantId, userId, err)
+	}
+
+	return overrides, nil
+}
+
+// settingError 将配置注册表的校验错误转换为请求错误
+func settingError(err error) error {
+	switch {
+	case errors.Is(err, settings.ErrUnknownKey):
+		return adminV1.ErrorBadRequest("未知的配置项: %s", err.Error())
+	case errors.Is(err, settings.ErrNotOverridable):
+		return adminV1.ErrorForbidden("不能在当前层级修改该配置项: %s", err.Error())
+	default:
+		return adminV1.ErrorBadRequest("配置值不合法: %s", err.Error())
+	}
+}
@@ -1,14 +1,23 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	"github.com/hibiken/asynq"
+	"github.com/minio/minio-go/v7"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/timeutil"
 	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/tx7do/kratos-transport/broker"
@@ -17,9 +26,15 @@ import (
 	"go-wind-admin/app/admin/service/internal/data"
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	fileV1 "go-wind-admin/api/gen/go/file/service/v1"
 
+	"go-wind-admin/pkg/backup"
+	"go-wind-admin/pkg/cluster"
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/oss"
+	"go-wind-admin/pkg/sse"
 	"go-wind-admin/pkg/task"
+	"go-wind-admin/pkg/tenantarchive"
 )
 
 type TaskService struct {
@@ -27,38 +42,245 @@ type TaskService struct {
 
 	log *log.Helper
 
-	Server *asynqServer.Server
+	Server    *asynqServer.Server
+	Scheduler *task.Scheduler
 
-	userRepo *data.UserRepo
-	taskRepo *data.TaskRepo
+	registry *task.Registry
+
+	userRepo    *data.UserRepo
+	taskRepo    *data.TaskRepo
+	taskRunRepo *data.TaskRunRepo
+
+	fileRepo   *data.FileRepo
+	backupRepo *data.DatabaseBackupRepo
+	mc         *oss.MinIOClient
+
+	internalMessageRepo *data.InternalMessageRepo
+
+	tenantService      *TenantService
+	tenantTransferRepo *data.TenantTransferRepo
+	tenantUsageService *TenantUsageService
+
+	authorizer *data.Authorizer
+
+	sseServer *sse.Server
+
+	elector     *cluster.Elector
+	broadcaster *cluster.Broadcaster
+}
+
+// 调度任务只由领导者登记，其他副本上的任务变更通过该频道转发给领导者执行
+const taskControlChannel = "task:control"
+
+const (
+	taskControlSync       = "sync"
+	taskControlStart      = "start"
+	taskControlStop       = "stop"
+	taskControlRestart    = "restart"
+	taskControlStartAll   = "start_all"
+	taskControlStopAll    = "stop_all"
+	taskControlRestartAll = "restart_all"
+)
+
+type taskControlMessage struct {
+	Action string `json:"action"`
+	TaskId uint32 `json:"task_id,omitempty"`
 }
 
 func NewTaskService(
 	logger log.Logger,
 	taskRepo *data.TaskRepo,
+	taskRunRepo *data.TaskRunRepo,
 	userRepo *data.UserRepo,
+	fileRepo *data.FileRepo,
+	backupRepo *data.DatabaseBackupRepo,
+	mc *oss.MinIOClient,
+	internalMessageRepo *data.InternalMessageRepo,
+	tenantService *TenantService,
+	tenantTransferRepo *data.TenantTransferRepo,
+	tenantUsageService *TenantUsageService,
+	authorizer *data.Authorizer,
+	sseServer *sse.Server,
+	elector *cluster.Elector,
+	broadcaster *cluster.Broadcaster,
 ) *TaskService {
 	l := log.NewHelper(log.With(logger, "module", "task/service/admin-service"))
-	return &TaskService{
-		log:      l,
-		taskRepo: taskRepo,
-		userRepo: userRepo,
+	svc := &TaskService{
+		log:         l,
+		taskRepo:    taskRepo,
+		taskRunRepo: taskRunRepo,
+		userRepo:    userRepo,
+		fileRepo:    fileRepo,
+		backupRepo:  backupRepo,
+		mc:          mc,
+
+		internalMessageRepo: internalMessageRepo,
+		tenantService:       tenantService,
+		tenantTransferRepo:  tenantTransferRepo,
+		tenantUsageService:  tenantUsageService,
+		authorizer:          authorizer,
+		sseServer:           sseServer,
+		registry:            task.NewRegistry(),
+		elector:             elector,
+		broadcaster:         broadcaster,
+	}
+
+	svc.registerHandlers()
+
+	if broadcaster != nil {
+		broadcaster.Subscribe(taskControlChannel, svc.handleTaskControl)
+	}
+
+	return svc
+}
+
+// registerHandlers 注册所有的任务处理器
+func (s *TaskService) registerHandlers() {
+	if err := task.Register(s.registry, task.BackupTaskType, "数据库备份", s.AsyncBackup); err != nil {
+		s.log.Error(err)
+	}
+	if err := task.Register(s.registry, task.ArchiveInternalMessageTaskType, "站内信归档", s.AsyncArchiveInternalMessage); err != nil {
+		s.log.Error(err)
+	}
+	if err := task.Register(s.registry, task.TenantExpireTaskType, "租户到期", s.AsyncExpireTenants); err != nil {
+		s.log.Error(err)
+	}
+	if err := task.Register(s.registry, task.TenantExportTaskType, "租户导出", s.AsyncExportTenant); err != nil {
+		s.log.Error(err)
+	}
+	if err := task.Register(s.registry, task.TenantImportTaskType, "租户导入", s.AsyncImportTenant); err != nil {
+		s.log.Error(err)
+	}
+	if err := task.Register(s.registry, task.TenantUsageTaskType, "租户用量汇总", s.AsyncAggregateTenantUsage); err != nil {
+		s.log.Error(err)
+	}
+}
+
+// EnsureDefaultTasks 登记系统必需的周期任务，已经存在时不做修改，只在领导者副本上执行
+func (s *TaskService) EnsureDefaultTasks(ctx context.Context) {
+	defaults := []*adminV1.Task{
+		{
+			Type:        trans.Ptr(adminV1.Task_PERIODIC),
+			TypeName:    trans.Ptr(task.TenantExpireTaskType),
+			TaskPayload: trans.Ptr("{}"),
+			CronSpec:    trans.Ptr(task.DefaultTenantExpireCronSpec),
+			Enable:      trans.Ptr(true),
+			Remark:      trans.Ptr("将到期的租户改为已过期，并提前提醒租户管理员"),
+		},
+		{
+			Type:        trans.Ptr(adminV1.Task_PERIODIC),
+			TypeName:    trans.Ptr(task.TenantUsageTaskType),
+			TaskPayload: trans.Ptr("{}"),
+			CronSpec:    trans.Ptr(task.DefaultTenantUsageCronSpec),
+			Enable:      trans.Ptr(true),
+			Remark:      trans.Ptr("每小时汇总租户的活跃用户、API调用、存储空间与站内信用量"),
+		},
+	}
+
+	for _, t := range defaults {
+		exist, err := s.taskRepo.IsTypeNameExist(ctx, t.GetTypeName())
+		if err != nil || exist {
+			continue
+		}
+
+		if _, err = s.taskRepo.Create(ctx, &adminV1.CreateTaskRequest{Data: t}); err != nil {
+			s.log.Errorf("创建默认任务[%s]失败[%s]", t.GetTypeName(), err.Error())
+		}
 	}
 }
 
+// SubscribeHandlers 把任务处理器注册到队列服务
+func (s *TaskService) SubscribeHandlers(srv *asynqServer.Server) error {
+	return s.registry.Subscribe(srv)
+}
+
 func (s *TaskService) List(ctx context.Context, req *pagination.PagingRequest) (*adminV1.ListTaskResponse, error) {
-	return s.taskRepo.List(ctx, req)
+	resp, err := s.taskRepo.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillLastRun(ctx, resp.Items...)
+
+	return resp, nil
 }
 
 func (s *TaskService) Get(ctx context.Context, req *adminV1.GetTaskRequest) (*adminV1.Task, error) {
-	return s.taskRepo.Get(ctx, req)
+	resp, err := s.taskRepo.Get(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.fillLastRun(ctx, resp)
+
+	return resp, nil
+}
+
+// fillLastRun 填充任务最近一次的执行记录
+func (s *TaskService) fillLastRun(ctx context.Context, tasks ...*adminV1.Task) {
+	ids := make([]uint32, 0, len(tasks))
+	for _, t := range tasks {
+		if t.Id != nil {
+			ids = append(ids, t.GetId())
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	runs, err := s.taskRunRepo.ListLatestByTaskIds(ctx, ids)
+	if err != nil {
+		s.log.Errorf("query latest task runs failed [%s]", err.Error())
+		return
+	}
+
+	for _, t := range tasks {
+		if run, ok := runs[t.GetId()]; ok {
+			t.LastRun = run
+		}
+	}
 }
 
 func (s *TaskService) ListTaskTypeName(_ context.Context, _ *emptypb.Empty) (*adminV1.ListTaskTypeNameResponse, error) {
-	typeNames := s.Server.GetRegisteredTaskTypes()
-	return &adminV1.ListTaskTypeNameResponse{
-		TypeNames: typeNames,
-	}, nil
+	resp := &adminV1.ListTaskTypeNameResponse{}
+	for _, h := range s.registry.Handlers() {
+		info := &adminV1.TaskTypeInfo{
+			TypeName:    h.TypeName,
+			Description: h.Description,
+		}
+		for _, f := range h.Schema.Fields {
+			info.PayloadFields = append(info.PayloadFields, &adminV1.TaskTypeInfo_PayloadField{
+				Name:        f.Name,
+				Type:        f.Type,
+				Required:    f.Required,
+				Description: f.Description,
+			})
+		}
+
+		resp.TypeNames = append(resp.TypeNames, h.TypeName)
+		resp.Types = append(resp.Types, info)
+	}
+
+	return resp, nil
+}
+
+// validateTask 校验任务类型、cron表达式以及任务数据
+func (s *TaskService) validateTask(t *adminV1.Task) error {
+	if _, ok := s.registry.Get(t.GetTypeName()); !ok {
+		return adminV1.ErrorBadRequest("task type [%s] is not registered", t.GetTypeName())
+	}
+
+	if t.GetType() == adminV1.Task_PERIODIC {
+		if err := task.ValidateCronSpec(t.GetCronSpec()); err != nil {
+			return adminV1.ErrorBadRequest("%s", err.Error())
+		}
+	}
+
+	if err := s.registry.ValidatePayload(t.GetTypeName(), t.GetTaskPayload()); err != nil {
+		return adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
+	return nil
 }
 
 func (s *TaskService) Create(ctx context.Context, req *adminV1.CreateTaskRequest) (*emptypb.Empty, error) {
@@ -74,12 +296,16 @@ func (s *TaskService) Create(ctx context.Context, req *adminV1.CreateTaskRequest
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
+	if err = s.validateTask(req.Data); err != nil {
+		return nil, err
+	}
+
 	var t *adminV1.Task
 	if t, err = s.taskRepo.Create(ctx, req); err != nil {
 		return nil, err
 	}
 
-	if err = s.startTask(t); err != nil {
+	if err = s.dispatchTaskControl(ctx, taskControlSync, t.GetId()); err != nil {
 		s.log.Error(err)
 	}
 
@@ -99,13 +325,25 @@ func (s *TaskService) Update(ctx context.Context, req *adminV1.UpdateTaskRequest
 
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 
+	var old *adminV1.Task
+	if old, err = s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_Id{Id: req.GetId()}}); err != nil {
+		if !req.GetAllowMissing() || !adminV1.IsNotFound(err) {
+			return nil, err
+		}
+		old = nil
+	}
+
+	if err = s.validateTask(mergeTask(old, req.Data)); err != nil {
+		return nil, err
+	}
+
 	var t *adminV1.Task
 	if t, err = s.taskRepo.Update(ctx, req); err != nil {
-
 		return nil, err
 	}
 
-	if err = s.startTask(t); err != nil {
+	// 类型名可能被修改，按任务ID停止旧的调度后再按新的配置启动
+	if err = s.dispatchTaskControl(ctx, taskControlSync, t.GetId()); err != nil {
 		s.log.Error(err)
 	}
 
@@ -114,17 +352,16 @@ func (s *TaskService) Update(ctx context.Context, req *adminV1.UpdateTaskRequest
 
 func (s *TaskService) Delete(ctx context.Context, req *adminV1.DeleteTaskRequest) (*emptypb.Empty, error) {
 	var err error
-	var t *adminV1.Task
-	if t, err = s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_Id{Id: req.GetId()}}); err != nil {
-		s.log.Error(err)
-	}
-
 	if err = s.taskRepo.Delete(ctx, req); err != nil {
 		return nil, err
 	}
 
-	if t != nil {
-		_ = s.stopTask(t)
+	if err = s.taskRunRepo.DeleteByTaskId(ctx, req.GetId()); err != nil {
+		s.log.Error(err)
+	}
+
+	if err = s.dispatchTaskControl(ctx, taskControlStop, req.GetId()); err != nil {
+		s.log.Error(err)
 	}
 
 	return &emptypb.Empty{}, nil
@@ -132,52 +369,136 @@ func (s *TaskService) Delete(ctx context.Context, req *adminV1.DeleteTaskRequest
 
 // ControlTask 控制调度任务
 func (s *TaskService) ControlTask(ctx context.Context, req *adminV1.ControlTaskRequest) (*emptypb.Empty, error) {
-	t, err := s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_TypeName{TypeName: req.GetTypeName()}})
+	getReq := &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_TypeName{TypeName: req.GetTypeName()}}
+	if req.Id != nil {
+		getReq.QueryBy = &adminV1.GetTaskRequest_Id{Id: req.GetId()}
+	}
+
+	t, err := s.taskRepo.Get(ctx, getReq)
 	if err != nil {
 		s.log.Errorf("获取任务失败[%s]", err.Error())
 		return nil, err
 	}
 
+	var action string
 	switch req.GetControlType() {
 	case adminV1.ControlTaskRequest_Restart:
-		if err = s.stopTask(t); err != nil {
-			return nil, err
-		}
+		action = taskControlRestart
+	case adminV1.ControlTaskRequest_Stop:
+		action = taskControlStop
+	case adminV1.ControlTaskRequest_Start:
+		action = taskControlStart
+	default:
+		return nil, adminV1.ErrorBadRequest("invalid control type")
+	}
 
-		if err = s.startTask(t); err != nil {
-			return nil, err
-		}
+	if err = s.dispatchTaskControl(ctx, action, t.GetId()); err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
 
-	case adminV1.ControlTaskRequest_Stop:
-		err = s.stopTask(t)
+	return &emptypb.Empty{}, nil
+}
+
+// RunTask 立即执行调度任务
+func (s *TaskService) RunTask(ctx context.Context, req *adminV1.RunTaskRequest) (*adminV1.RunTaskResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
 		return nil, err
+	}
 
-	case adminV1.ControlTaskRequest_Start:
-		err = s.startTask(t)
+	var t *adminV1.Task
+	if t, err = s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_Id{Id: req.GetId()}}); err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	if req.TaskPayload != nil {
+		t.TaskPayload = req.TaskPayload
+	}
+
+	opts, payload := s.convertTaskOption(t)
+
+	// 手动触发时不能复用调度任务的唯一ID，否则会与正在排队的周期任务冲突
+	runId := task.CreateTaskRunID(t.GetTypeName(), t.GetId())
+	opts = append(opts, asynq.TaskID(runId))
+
+	var run *adminV1.TaskRun
+	if run, err = s.taskRunRepo.Create(ctx, &adminV1.TaskRun{
+		TaskId:    t.Id,
+		TypeName:  t.TypeName,
+		RunId:     trans.Ptr(runId),
+		Status:    trans.Ptr(adminV1.TaskRun_PENDING),
+		Trigger:   trans.Ptr(adminV1.TaskRun_MANUAL),
+		TenantId:  t.TenantId,
+		CreatedBy: trans.Ptr(operator.UserId),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = s.Server.NewTask(t.GetTypeName(), payload, opts...); err != nil {
+		s.log.Errorf("[%s] 立即执行任务失败[%s]", t.GetTypeName(), err.Error())
+
+		_ = s.taskRunRepo.Update(ctx, run.GetId(), &adminV1.TaskRun{
+			Status:       trans.Ptr(adminV1.TaskRun_FAILED),
+			ErrorMessage: trans.Ptr(err.Error()),
+		})
+
+		return nil, adminV1.ErrorInternalServerError("enqueue task failed")
+	}
+
+	return &adminV1.RunTaskResponse{
+		RunId: run.GetId(),
+	}, nil
+}
+
+// ListTaskRun 查询任务执行记录列表
+func (s *TaskService) ListTaskRun(ctx context.Context, req *pagination.PagingRequest) (*adminV1.ListTaskRunResponse, error) {
+	return s.taskRunRepo.List(ctx, req)
+}
+
+// ListTaskRunByTask 查询某个调度任务的执行记录列表
+func (s *TaskService) ListTaskRunByTask(ctx context.Context, req *adminV1.ListTaskRunByTaskRequest) (*adminV1.ListTaskRunResponse, error) {
+	if req.GetTaskId() == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	return s.taskRunRepo.List(ctx, &pagination.PagingRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Query:    trans.Ptr(fmt.Sprintf(`{"task_id":"%d"}`, req.GetTaskId())),
+		OrderBy:  []string{"-id"},
+	})
+}
+
+// GetTaskRun 查询任务执行记录详情
+func (s *TaskService) GetTaskRun(ctx context.Context, req *adminV1.GetTaskRunRequest) (*adminV1.TaskRun, error) {
+	return s.taskRunRepo.Get(ctx, req.GetId())
 }
 
 // StopAllTask 停止所有的调度任务
-func (s *TaskService) StopAllTask(_ context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	s.stopAllTask()
+func (s *TaskService) StopAllTask(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	if err := s.dispatchTaskControl(ctx, taskControlStopAll, 0); err != nil {
+		return nil, err
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
 // StartAllTask 启动所有的调度任务
 func (s *TaskService) StartAllTask(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
-	_, err := s.startAllTask(ctx)
-	if err != nil {
+	if err := s.dispatchTaskControl(ctx, taskControlStartAll, 0); err != nil {
 		return nil, err
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-// RestartAllTask 重启所有的调度任务
+// RestartAllTask 重启所有的调度任务，本实例不是领导者时转发给领导者执行，不返回启动数量
 func (s *TaskService) RestartAllTask(ctx context.Context, _ *emptypb.Empty) (*adminV1.RestartAllTaskResponse, error) {
+	if !s.isLeader() {
+		return &adminV1.RestartAllTaskResponse{}, s.dispatchTaskControl(ctx, taskControlRestartAll, 0)
+	}
+
 	// 停止所有的任务
 	s.stopAllTask()
 
@@ -191,7 +512,6 @@ func (s *TaskService) RestartAllTask(ctx context.Context, _ *emptypb.Empty) (*ad
 
 // StartAllTask 启动所有的任务
 func (s *TaskService) startAllTask(ctx context.Context) (int32, error) {
-	//_, _ = s.Server.NewPeriodicTask("*/1 * * * ?", task.BackupTaskType, task.BackupTaskData{Name: "test"})
 
 	resp, err := s.List(ctx, &pagination.PagingRequest{
 		NoPaging: trans.Ptr(true),
@@ -224,24 +544,168 @@ func (s *TaskService) stopAllTask() {
 	s.log.Infof("开始清除所有的定时任务...")
 
 	// 清除所有的定时任务
-	s.Server.RemoveAllPeriodicTask()
+	if s.Scheduler != nil {
+		s.Scheduler.UnregisterAll()
+	}
 
 	s.log.Infof("完成清除所有的定时任务")
 }
 
+// StartScheduling 成为领导者后登记所有的调度任务
+func (s *TaskService) StartScheduling(ctx context.Context) {
+	if _, err := s.startAllTask(ctx); err != nil {
+		s.log.Errorf("启动调度任务失败[%s]", err.Error())
+	}
+}
+
+// StopScheduling 失去领导权后清除所有的调度任务，由新的领导者接管
+func (s *TaskService) StopScheduling() {
+	s.stopAllTask()
+}
+
+// isLeader 本实例是否负责调度任务，未配置选举时视为单实例部署
+func (s *TaskService) isLeader() bool {
+	return s.elector == nil || s.elector.IsLeader()
+}
+
+// dispatchTaskControl 本实例是领导者时直接执行，否则转发给领导者执行
+func (s *TaskService) dispatchTaskControl(ctx context.Context, action string, taskId uint32) error {
+	if s.isLeader() {
+		return s.applyTaskControl(ctx, action, taskId)
+	}
+
+	if s.broadcaster == nil {
+		return errors.New("task control broadcaster is not configured")
+	}
+
+	return s.broadcaster.Publish(ctx, taskControlChannel, &taskControlMessage{
+		Action: action,
+		TaskId: taskId,
+	})
+}
+
+// handleTaskControl 领导者处理其他副本转发的任务变更
+func (s *TaskService) handleTaskControl(ctx context.Context, payload []byte) {
+	if !s.isLeader() {
+		return
+	}
+
+	var msg taskControlMessage
+	if err := json.Unmarshal(payload, &msg); err != nil {
+		s.log.Errorf("解析任务控制消息失败[%s]", err.Error())
+		return
+	}
+
+	if err := s.applyTaskControl(ctx, msg.Action, msg.TaskId); err != nil && !errors.Is(err, task.ErrTaskNotScheduled) {
+		s.log.Errorf("[%s] 执行任务控制[%d]失败[%s]", msg.Action, msg.TaskId, err.Error())
+	}
+}
+
+// applyTaskControl 在本实例上执行任务变更
+func (s *TaskService) applyTaskControl(ctx context.Context, action string, taskId uint32) error {
+	switch action {
+	case taskControlSync:
+		if err := s.unscheduleTask(taskId); err != nil && !errors.Is(err, task.ErrTaskNotScheduled) {
+			return err
+		}
+
+		t, err := s.getTask(ctx, taskId)
+		if err != nil {
+			return err
+		}
+		if !t.GetEnable() {
+			return nil
+		}
+		return s.startTask(t)
+
+	case taskControlStart:
+		t, err := s.getTask(ctx, taskId)
+		if err != nil {
+			return err
+		}
+		return s.startTask(t)
+
+	case taskControlStop:
+		t, err := s.getTask(ctx, taskId)
+		if err != nil {
+			// 任务已被删除，按任务ID清除残留的调度
+			if err = s.unscheduleTask(taskId); err != nil && !errors.Is(err, task.ErrTaskNotScheduled) {
+				return err
+			}
+			return nil
+		}
+		return s.stopTask(t)
+
+	case taskControlRestart:
+		// 任务未在运行时也允许重启
+		if err := s.unscheduleTask(taskId); err != nil && !errors.Is(err, task.ErrTaskNotScheduled) {
+			return err
+		}
+
+		t, err := s.getTask(ctx, taskId)
+		if err != nil {
+			return err
+		}
+		return s.startTask(t)
+
+	case taskControlStartAll:
+		_, err := s.startAllTask(ctx)
+		return err
+
+	case taskControlStopAll:
+		s.stopAllTask()
+		return nil
+
+	case taskControlRestartAll:
+		s.stopAllTask()
+		_, err := s.startAllTask(ctx)
+		return err
+	}
+
+	return fmt.Errorf("unknown task control action [%s]", action)
+}
+
+func (s *TaskService) getTask(ctx context.Context, taskId uint32) (*adminV1.Task, error) {
+	return s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_Id{Id: taskId}})
+}
+
+// unscheduleTask 按任务ID清除调度，任务类型名修改前后登记的调度都会被清除
+func (s *TaskService) unscheduleTask(taskId uint32) error {
+	if s.Scheduler == nil {
+		return errors.New("task scheduler is not configured")
+	}
+
+	var found bool
+	for _, key := range s.Scheduler.Keys() {
+		if _, id, err := task.ParseTaskKey(key); err != nil || id != taskId {
+			continue
+		}
+		if err := s.Scheduler.Unregister(key); err != nil && !errors.Is(err, task.ErrTaskNotScheduled) {
+			return err
+		}
+		found = true
+	}
+
+	if !found {
+		return fmt.Errorf("%w: task [%d]", task.ErrTaskNotScheduled, taskId)
+	}
+
+	return nil
+}
+
 // stopTask 停止一个任务
 func (s *TaskService) stopTask(t *adminV1.Task) error {
 	if t == nil {
 		return errors.New("task is nil")
 	}
 
-	if t.GetEnable() == false {
-		return errors.New("task is not enable")
-	}
-
+	// 停用的任务也可能仍在调度中（例如刚被修改为停用），所以不检查启用状态
 	switch t.GetType() {
 	case adminV1.Task_PERIODIC:
-		return s.Server.RemovePeriodicTask(t.GetTypeName())
+		if s.Scheduler == nil {
+			return errors.New("task scheduler is not configured")
+		}
+		return s.unscheduleTask(t.GetId())
 
 	case adminV1.Task_DELAY:
 
@@ -294,6 +758,29 @@ func (s *TaskService) convertTaskOption(t *adminV1.Task) (opts []asynq.Option, p
 	return
 }
 
+// mergeTask 合并更新前后的任务定义，用于在写入前校验更新后的结果
+func mergeTask(old, data *adminV1.Task) *adminV1.Task {
+	if old == nil {
+		return data
+	}
+
+	merged := proto.Clone(old).(*adminV1.Task)
+	if data.Type != nil {
+		merged.Type = data.Type
+	}
+	if data.TypeName != nil {
+		merged.TypeName = data.TypeName
+	}
+	if data.TaskPayload != nil {
+		merged.TaskPayload = data.TaskPayload
+	}
+	if data.CronSpec != nil {
+		merged.CronSpec = data.CronSpec
+	}
+
+	return merged
+}
+
 // startTask 启动一个任务
 func (s *TaskService) startTask(t *adminV1.Task) error {
 	if t == nil {
@@ -304,14 +791,22 @@ func (s *TaskService) startTask(t *adminV1.Task) error {
 		return errors.New("task is not enable")
 	}
 
+	if _, ok := s.registry.Get(t.GetTypeName()); !ok {
+		return fmt.Errorf("task type [%s] is not registered", t.GetTypeName())
+	}
+
 	var opts []asynq.Option
 	var payload broker.Any
 	var err error
 
 	switch t.GetType() {
 	case adminV1.Task_PERIODIC:
+		if s.Scheduler == nil {
+			return errors.New("task scheduler is not configured")
+		}
+
 		opts, payload = s.convertTaskOption(t)
-		if _, err = s.Server.NewPeriodicTask(t.GetCronSpec(), task.CreateBackupTaskID(t.GetId()), t.GetTypeName(), payload, opts...); err != nil {
+		if err = s.Scheduler.Register(task.CreateTaskKey(t.GetTypeName(), t.GetId()), t.GetCronSpec(), t.GetTypeName(), payload, opts...); err != nil {
 			s.log.Errorf("[%s] 创建定时任务失败[%s]", t.GetTypeName(), err.Error())
 			return err
 		}
@@ -334,8 +829,507 @@ func (s *TaskService) startTask(t *adminV1.Task) error {
 	return nil
 }
 
+// TaskRunMiddleware 记录每一次任务处理函数的执行情况，并通过SSE推送执行进度
+func (s *TaskService) TaskRunMiddleware(next asynq.Handler) asynq.Handler {
+	return asynq.HandlerFunc(func(ctx context.Context, t *asynq.Task) error {
+		// 只记录任务管理中登记的任务，站内信投递等系统内部任务不记录
+		if _, ok := s.registry.Get(t.Type()); !ok {
+			return next.ProcessTask(ctx, t)
+		}
+
+		run := s.beginTaskRun(ctx, t)
+
+		runCtx, state := task.NewRunContext(ctx, func(percent int32, message string) {
+			s.updateTaskRunProgress(ctx, run, percent, message)
+		})
+
+		err := next.ProcessTask(runCtx, t)
+
+		if result := state.Result(); len(result) > 0 && t.ResultWriter() != nil {
+			if _, werr := t.ResultWriter().Write(result); werr != nil {
+				s.log.Errorf("[%s] 写入任务结果失败[%s]", t.Type(), werr.Error())
+			}
+		}
+
+		s.finishTaskRun(ctx, run, state.Result(), err)
+
+		return err
+	})
+}
+
+// beginTaskRun 创建执行记录，手动触发的任务会复用预先创建的记录
+func (s *TaskService) beginTaskRun(ctx context.Context, t *asynq.Task) *adminV1.TaskRun {
+	runId, _ := asynq.GetTaskID(ctx)
+	queue, _ := asynq.GetQueueName(ctx)
+	retryCount, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+
+	now := time.Now()
+
+	run := &adminV1.TaskRun{
+		TypeName:   trans.Ptr(t.Type()),
+		RunId:      trans.Ptr(runId),
+		Queue:      trans.Ptr(queue),
+		Status:     trans.Ptr(adminV1.TaskRun_RUNNING),
+		Trigger:    trans.Ptr(adminV1.TaskRun_SCHEDULE),
+		StartedAt:  timeutil.TimeToTimestamppb(&now),
+		RetryCount: trans.Ptr(int32(retryCount)),
+		MaxRetry:   trans.Ptr(int32(maxRetry)),
+		Progress:   trans.Ptr(int32(0)),
+	}
+
+	if taskId, err := task.ParseTaskID(t.Type(), runId); err == nil {
+		run.TaskId = trans.Ptr(taskId)
+
+		if def, err := s.taskRepo.Get(ctx, &adminV1.GetTaskRequest{QueryBy: &adminV1.GetTaskRequest_Id{Id: taskId}}); err == nil {
+			run.TenantId = def.TenantId
+			run.CreatedBy = def.CreatedBy
+		}
+	}
+
+	if retryCount == 0 && runId != "" {
+		if pending, err := s.taskRunRepo.GetPendingByRunId(ctx, runId); err == nil && pending != nil {
+			run.Id = pending.Id
+			run.Trigger = pending.Trigger
+			run.CreatedBy = pending.CreatedBy
+
+			if err = s.taskRunRepo.Update(ctx, pending.GetId(), run); err != nil {
+				s.log.Errorf("[%s] 更新任务执行记录失败[%s]", t.Type(), err.Error())
+			}
+		}
+	}
+
+	if run.Id == nil {
+		created, err := s.taskRunRepo.Create(ctx, run)
+		if err != nil {
+			s.log.Errorf("[%s] 创建任务执行记录失败[%s]", t.Type(), err.Error())
+		} else {
+			run.Id = created.Id
+		}
+	}
+
+	s.publishTaskRunEvent(ctx, run)
+
+	return run
+}
+
+// updateTaskRunProgress 更新执行进度
+func (s *TaskService) updateTaskRunProgress(ctx context.Context, run *adminV1.TaskRun, percent int32, message string) {
+	run.Progress = trans.Ptr(percent)
+	run.ProgressMessage = trans.Ptr(message)
+
+	if run.Id != nil {
+		if err := s.taskRunRepo.Update(ctx, run.GetId(), &adminV1.TaskRun{
+			Progress:        run.Progress,
+			ProgressMessage: run.ProgressMessage,
+		}); err != nil {
+			s.log.Errorf("[%s] 更新任务执行进度失败[%s]", run.GetTypeName(), err.Error())
+		}
+	}
+
+	s.publishTaskRunEvent(ctx, run)
+}
+
+// finishTaskRun 记录执行结果
+func (s *TaskService) finishTaskRun(ctx context.Context, run *adminV1.TaskRun, result []byte, taskErr error) {
+	now := time.Now()
+
+	run.FinishedAt = timeutil.TimeToTimestamppb(&now)
+	run.DurationMs = trans.Ptr(now.Sub(run.GetStartedAt().AsTime()).Milliseconds())
+
+	if len(result) > 0 {
+		run.Result = trans.Ptr(string(result))
+	}
+
+	if taskErr != nil {
+		run.Status = trans.Ptr(adminV1.TaskRun_FAILED)
+		run.ErrorMessage = trans.Ptr(taskErr.Error())
+	} else {
+		run.Status = trans.Ptr(adminV1.TaskRun_SUCCEEDED)
+		run.Progress = trans.Ptr(int32(100))
+	}
+
+	if run.Id != nil {
+		if err := s.taskRunRepo.Update(ctx, run.GetId(), &adminV1.TaskRun{
+			Status:       run.Status,
+			FinishedAt:   run.FinishedAt,
+			DurationMs:   run.DurationMs,
+			Progress:     run.Progress,
+			ErrorMessage: run.ErrorMessage,
+			Result:       run.Result,
+		}); err != nil {
+			s.log.Errorf("[%s] 更新任务执行记录失败[%s]", run.GetTypeName(), err.Error())
+		}
+	}
+
+	s.publishTaskRunEvent(ctx, run)
+}
+
+// publishTaskRunEvent 向任务的触发者推送执行状态
+func (s *TaskService) publishTaskRunEvent(ctx context.Context, run *adminV1.TaskRun) {
+	if s.sseServer == nil || run.CreatedBy == nil {
+		return
+	}
+
+	runJson, err := json.Marshal(run)
+	if err != nil {
+		return
+	}
+
+	s.sseServer.PublishToUser(ctx, run.GetCreatedBy(), &sse.Event{
+		ID:    uuid.New().String(),
+		Data:  runJson,
+		Event: task.RunEventName,
+	})
+}
+
 // AsyncBackup 异步备份
-func (s *TaskService) AsyncBackup(taskType string, taskData *task.BackupTaskData) error {
-	s.log.Infof("AsyncBackup [%s] [%+v] [%s]", taskType, taskData, taskData.Name)
-	return nil
+func (s *TaskService) AsyncBackup(ctx context.Context, taskType string, taskData *task.BackupTaskData) error {
+	s.log.Infof("AsyncBackup [%s] [%+v]", taskType, taskData)
+
+	if s.mc == nil {
+		return errors.New("oss client is not configured")
+	}
+
+	bucketName := taskData.GetBucket()
+	fileDirectory := taskData.GetDirectory()
+
+	// 导出占总进度的80%，上传与清理占剩余部分
+	var buf bytes.Buffer
+	manifest, err := s.backupRepo.Export(ctx, &buf, func(table string, done, total int) {
+		task.ReportProgress(ctx, int32(done*80/total), fmt.Sprintf("exported table %s", table))
+	})
+	if err != nil {
+		return err
+	}
+
+	content := buf.Bytes()
+	saveFileName := backup.FileName(taskData.Name, manifest.CreatedAt)
+	objectName := fileDirectory + "/" + saveFileName
+
+	linkUrl, err := s.uploadArchiveFile(ctx, bucketName, fileDirectory, saveFileName, backup.FileExtension, content)
+	if err != nil {
+		return err
+	}
+	task.ReportProgress(ctx, 90, "uploaded "+saveFileName)
+
+	pruned := s.pruneBackups(ctx, bucketName, fileDirectory, backup.RetentionPolicy{
+		KeepLast: taskData.KeepLast,
+		KeepDays: taskData.KeepDays,
+	})
+
+	return task.SetResult(ctx, map[string]any{
+		"bucket":  bucketName,
+		"object":  objectName,
+		"size":    len(content),
+		"tables":  len(manifest.Tables),
+		"rows":    manifest.TotalRows(),
+		"pruned":  pruned,
+		"linkUrl": linkUrl,
+	})
+}
+
+// AsyncArchiveInternalMessage 归档过期的站内信
+func (s *TaskService) AsyncArchiveInternalMessage(ctx context.Context, taskType string, taskData *task.ArchiveInternalMessageTaskData) error {
+	before := time.Now().AddDate(0, 0, -taskData.OlderThanDays)
+
+	count, err := s.internalMessageRepo.ArchiveBefore(ctx, before)
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("[%s] 归档站内信[%d]条", taskType, count)
+
+	return task.SetResult(ctx, map[string]any{
+		"archived": count,
+		"before":   before.Format(time.DateTime),
+	})
+}
+
+// AsyncExpireTenants 将到期的租户改为已过期，并提前提醒即将到期的租户
+func (s *TaskService) AsyncExpireTenants(ctx context.Context, taskType string, taskData *task.TenantExpireTaskData) error {
+	expired, reminded, err := s.tenantService.ExpireTenants(ctx, taskData.GetRemindBefore())
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("[%s] 租户过期[%d]个，到期提醒[%d]个", taskType, expired, reminded)
+
+	return task.SetResult(ctx, map[string]any{
+		"expired":  expired,
+		"reminded": reminded,
+	})
+}
+
+// AsyncAggregateTenantUsage 汇总最近几个小时的租户用量，用量越过订阅套餐配额的阈值时发布事件
+func (s *TaskService) AsyncAggregateTenantUsage(ctx context.Context, taskType string, taskData *task.TenantUsageTaskData) error {
+	records, events, err := s.tenantUsageService.AggregateUsage(ctx, taskData.GetHours())
+	if err != nil {
+		return err
+	}
+
+	s.log.Infof("[%s] 用量记录[%d]条，阈值事件[%d]个", taskType, records, events)
+
+	return task.SetResult(ctx, map[string]any{
+		"hours":   taskData.GetHours(),
+		"records": records,
+		"events":  events,
+	})
+}
+
+// AsyncExportTenant 导出租户数据，归档文件上传到对象存储并登记为文件，供导入任务使用
+func (s *TaskService) AsyncExportTenant(ctx context.Context, taskType string, taskData *task.TenantExportTaskData) error {
+	s.log.Infof("AsyncExportTenant [%s] [%+v]", taskType, taskData)
+
+	if s.mc == nil {
+		return errors.New("oss client is not configured")
+	}
+
+	archive, pruned, err := s.tenantTransferRepo.Export(ctx, taskData.TenantId)
+	if err != nil {
+		return err
+	}
+	task.ReportProgress(ctx, 50, "exported tenant data")
+
+	var buf bytes.Buffer
+	manifest, err := archive.Write(&buf)
+	if err != nil {
+		s.log.Errorf("write tenant archive failed: %s", err.Error())
+		return err
+	}
+
+	bucketName := taskData.GetBucket()
+	fileDirectory := fmt.Sprintf("tenant-%d", taskData.TenantId)
+	saveFileName := tenantarchive.FileName(manifest.Tenant.Code, manifest.CreatedAt)
+
+	linkUrl, err := s.uploadArchiveFile(ctx, bucketName, fileDirectory, saveFileName, tenantarchive.FileExtension, buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[string]int, len(manifest.Entities))
+	for _, e := range manifest.Entities {
+		counts[e.Name] = e.Count
+	}
+
+	return task.SetResult(ctx, map[string]any{
+		"tenantId": taskData.TenantId,
+		"bucket":   bucketName,
+		"object":   fileDirectory + "/" + saveFileName,
+		"size":     buf.Len(),
+		"entities": counts,
+		"pruned":   pruned,
+		"linkUrl":  linkUrl,
+	})
+}
+
+// AsyncImportTenant 把归档文件导入到租户。总是先预演，检查用户配额后才实际导入，任务结果为导入报告
+func (s *TaskService) AsyncImportTenant(ctx context.Context, taskType string, taskData *task.TenantImportTaskData) error {
+	s.log.Infof("AsyncImportTenant [%s] [%+v]", taskType, taskData)
+
+	if s.mc == nil {
+		return errors.New("oss client is not configured")
+	}
+
+	f, err := s.fileRepo.Get(ctx, &fileV1.GetFileRequest{QueryBy: &fileV1.GetFileRequest_Id{Id: taskData.FileId}})
+	if err != nil {
+		return err
+	}
+
+	object, err := s.mc.GetClient().GetObject(ctx,
+		f.GetBucketName(), f.GetFileDirectory()+"/"+f.GetSaveFileName(),
+		minio.GetObjectOptions{},
+	)
+	if err != nil {
+		s.log.Errorf("download tenant archive failed: %s", err.Error())
+		return err
+	}
+	defer object.Close()
+
+	archive, err := tenantarchive.Read(object)
+	if err != nil {
+		return err
+	}
+	task.ReportProgress(ctx, 20, "validated tenant archive")
+
+	opts := &data.TenantImportOptions{
+		Conflict: taskData.GetConflict(),
+		DryRun:   true,
+	}
+
+	report, err := s.tenantTransferRepo.Import(ctx, taskData.TenantId, archive, opts)
+	if err != nil {
+		return err
+	}
+	task.ReportProgress(ctx, 50, "dry run finished")
+
+	if !taskData.Apply {
+		return task.SetResult(ctx, report)
+	}
+
+	if err = s.tenantService.CheckUserQuota(ctx, taskData.TenantId,
+		report.Count(tenantarchive.EntityUser, tenantarchive.ActionCreate),
+	); err != nil {
+		return err
+	}
+
+	s.log.Warnf("import tenant archive [%d] %s into tenant [%d]", f.GetId(), f.GetSaveFileName(), taskData.TenantId)
+
+	opts.DryRun = false
+	if report, err = s.tenantTransferRepo.Import(ctx, taskData.TenantId, archive, opts); err != nil {
+		return err
+	}
+
+	// 导入的角色及其授权需要加入鉴权策略
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
+		s.log.Errorf("reload policies error: %v", err)
+	}
+
+	return task.SetResult(ctx, report)
+}
+
+// uploadArchiveFile 上传归档文件并登记文件记录，返回文件的访问地址
+func (s *TaskService) uploadArchiveFile(ctx context.Context, bucketName, fileDirectory, saveFileName, extension string, content []byte) (string, error) {
+	objectName := fileDirectory + "/" + saveFileName
+
+	if err := s.mc.EnsureBucketExists(ctx, bucketName); err != nil {
+		s.log.Errorf("ensure bucket [%s] failed: %s", bucketName, err.Error())
+		return "", err
+	}
+
+	linkUrl, err := s.mc.UploadFile(ctx, bucketName, objectName, content)
+	if err != nil {
+		s.log.Errorf("upload file [%s] failed: %s", objectName, err.Error())
+		return "", err
+	}
+
+	sum := md5.Sum(content)
+	if err = s.fileRepo.Create(ctx, &fileV1.CreateFileRequest{
+		Data: &fileV1.File{
+			Provider:      trans.Ptr(fileV1.OSSProvider_MINIO),
+			BucketName:    trans.Ptr(bucketName),
+			FileDirectory: trans.Ptr(fileDirectory),
+			FileGuid:      trans.Ptr(uuid.New().String()),
+			SaveFileName:  trans.Ptr(saveFileName),
+			FileName:      trans.Ptr(saveFileName),
+			Extension:     trans.Ptr(extension),
+			Size:          trans.Ptr(uint64(len(content))),
+			SizeFormat:    trans.Ptr(formatFileSize(uint64(len(content)))),
+			LinkUrl:       trans.Ptr(linkUrl),
+			Md5:           trans.Ptr(hex.EncodeToString(sum[:])),
+		},
+	}); err != nil {
+		// 文件记录写入失败时删除已上传的对象，避免留下无法管理的文件
+		_, _ = s.mc.DeleteFile(ctx, &fileV1.DeleteOssFileRequest{
+			BucketName: trans.Ptr(bucketName),
+			ObjectName: trans.Ptr(objectName),
+		})
+		return "", err
+	}
+
+	return linkUrl, nil
+}
+
+// pruneBackups 按保留策略清理过期的备份文件，返回清理的数量
+func (s *TaskService) pruneBackups(ctx context.Context, bucketName, fileDirectory string, policy backup.RetentionPolicy) int {
+	files, err := s.fileRepo.ListByDirectory(ctx, bucketName, fileDirectory)
+	if err != nil {
+		return 0
+	}
+
+	createdAt := make([]time.Time, len(files))
+	for i, f := range files {
+		createdAt[i] = f.GetCreatedAt().AsTime()
+	}
+
+	var pruned int
+	for _, i := range policy.Expired(createdAt, time.Now()) {
+		f := files[i]
+
+		if _, err = s.mc.DeleteFile(ctx, &fileV1.DeleteOssFileRequest{
+			BucketName: f.BucketName,
+			ObjectName: trans.Ptr(f.GetFileDirectory() + "/" + f.GetSaveFileName()),
+		}); err != nil {
+			s.log.Errorf("delete expired backup [%s] failed: %s", f.GetSaveFileName(), err.Error())
+			continue
+		}
+
+		if err = s.fileRepo.Delete(ctx, &fileV1.DeleteFileRequest{Id: f.GetId()}); err != nil {
+			continue
+		}
+
+		pruned++
+	}
+
+	return pruned
+}
+
+// RestoreBackup 从备份文件恢复数据库，写入前先完整校验备份归档
+func (s *TaskService) RestoreBackup(ctx context.Context, req *adminV1.RestoreBackupRequest) (*adminV1.RestoreBackupResponse, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.mc == nil {
+		return nil, adminV1.ErrorInternalServerError("oss client is not configured")
+	}
+
+	f, err := s.fileRepo.Get(ctx, &fileV1.GetFileRequest{QueryBy: &fileV1.GetFileRequest_Id{Id: req.GetFileId()}})
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := s.mc.GetClient().GetObject(ctx,
+		f.GetBucketName(), f.GetFileDirectory()+"/"+f.GetSaveFileName(),
+		minio.GetObjectOptions{},
+	)
+	if err != nil {
+		s.log.Errorf("download backup file failed: %s", err.Error())
+		return nil, adminV1.ErrorInternalServerError("download backup file failed")
+	}
+	defer object.Close()
+
+	archive, err := s.backupRepo.Validate(object)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminV1.RestoreBackupResponse{
+		Version:    int32(archive.Manifest.Version),
+		Driver:     archive.Manifest.Driver,
+		BackupAt:   timeutil.TimeToTimestamppb(&archive.Manifest.CreatedAt),
+		TableCount: uint32(len(archive.Manifest.Tables)),
+		RowCount:   archive.Manifest.TotalRows(),
+	}
+
+	if req.GetDryRun() {
+		return resp, nil
+	}
+
+	s.log.Warnf("user [%d] restores database from backup file [%d] %s", operator.UserId, f.GetId(), f.GetSaveFileName())
+
+	if err = s.backupRepo.Restore(ctx, archive, nil); err != nil {
+		return nil, err
+	}
+
+	resp.Restored = true
+
+	return resp, nil
+}
+
+// formatFileSize 格式化文件大小
+func formatFileSize(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.2f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
 }
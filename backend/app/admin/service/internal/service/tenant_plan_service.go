@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
+	"github.com/tx7do/go-utils/trans"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+)
+
+type TenantPlanService struct {
+	adminV1.TenantPlanServiceHTTPServer
+
+	log *log.Helper
+
+	tenantPlanRepo *data.TenantPlanRepo
+	tenantRepo     *data.TenantRepo
+
+	tenantService *TenantService
+}
+
+func NewTenantPlanService(
+	logger log.Logger,
+	tenantPlanRepo *data.TenantPlanRepo,
+	tenantRepo *data.TenantRepo,
+	tenantService *TenantService,
+) *TenantPlanService {
+	l := log.NewHelper(log.With(logger, "module", "tenant-plan/service/admin-service"))
+	return &TenantPlanService{
+		log:            l,
+		tenantPlanRepo: tenantPlanRepo,
+		tenantRepo:     tenantRepo,
+		tenantService:  tenantService,
+	}
+}
+
+func (s *TenantPlanService) List(ctx context.Context, req *pagination.PagingRequest) (*userV1.ListTenantPlanResponse, error) {
+	return s.tenantPlanRepo.List(ctx, req)
+}
+
+func (s *TenantPlanService) Get(ctx context.Context, req *userV1.GetTenantPlanRequest) (*userV1.TenantPlan, error) {
+	return s.tenantPlanRepo.Get(ctx, req)
+}
+
+func (s *TenantPlanService) Create(ctx context.Context, req *userV1.CreateTenantPlanRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.CreatedBy = trans.Ptr(operator.UserId)
+
+	exist, err := s.tenantPlanRepo.CodeExists(ctx, req.Data.GetCode(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if exist {
+		return nil, adminV1.ErrorConflict("套餐编码已存在")
+	}
+
+	if err = s.tenantPlanRepo.Create(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TenantPlanService) Update(ctx context.Context, req *userV1.UpdateTenantPlanRequest) (*emptypb.Empty, error) {
+	if req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
+
+	if req.Data.Code != nil {
+		exist, err := s.tenantPlanRepo.CodeExists(ctx, req.Data.GetCode(), req.GetId())
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			return nil, adminV1.ErrorConflict("套餐编码已存在")
+		}
+	}
+
+	if err = s.tenantPlanRepo.Update(ctx, req); err != nil {
+		return nil, err
+	}
+
+	// 配额随租户状态缓存，套餐变更后立即生效
+	s.tenantService.InvalidatePlanTenants(ctx, req.GetId())
+
+	return &emptypb.Empty{}, nil
+}
+
+// Delete 删除套餐，仍有租户订阅的套餐不能删除
+func (s *TenantPlanService) Delete(ctx context.Context, req *userV1.DeleteTenantPlanRequest) (*emptypb.Empty, error) {
+	ids, err := s.tenantRepo.ListIdsByPlan(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > 0 {
+		return nil, adminV1.ErrorConflict("仍有租户订阅该套餐")
+	}
+
+	if err = s.tenantPlanRepo.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
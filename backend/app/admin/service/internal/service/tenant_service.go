@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 	pagination "github.com/tx7do/go-crud/api/gen/go/pagination/v1"
 	"github.com/tx7do/go-utils/trans"
-	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"go-wind-admin/app/admin/service/internal/data"
@@ -15,32 +18,71 @@ import (
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/eventbus"
 	"go-wind-admin/pkg/middleware/auth"
+	appService "go-wind-admin/pkg/service"
+	"go-wind-admin/pkg/sse"
 	"go-wind-admin/pkg/utils/name_set"
 )
 
+// tenantStatusChangedEvent 租户状态变化时向租户内的在线用户推送的SSE事件
+const tenantStatusChangedEvent = "tenant_status_changed"
+
 type TenantService struct {
 	adminV1.TenantServiceHTTPServer
 
 	log *log.Helper
 
 	tenantRepo          *data.TenantRepo
+	tenantCache         *data.TenantCacheRepo
+	tenantProvisionRepo *data.TenantProvisionRepo
+	tenantTemplates     *data.TenantTemplates
+	tenantPlanRepo      *data.TenantPlanRepo
 	userRepo            *data.UserRepo
+	fileRepo            *data.FileRepo
 	userCredentialsRepo *data.UserCredentialRepo
+	userToken           *data.UserTokenCacheRepo
+	authorizer          *data.Authorizer
+
+	internalMessageService *InternalMessageService
+
+	eventBus  eventbus.EventBus
+	sseServer *sse.Server
 }
 
 func NewTenantService(
 	logger log.Logger,
 	tenantRepo *data.TenantRepo,
+	tenantCache *data.TenantCacheRepo,
+	tenantProvisionRepo *data.TenantProvisionRepo,
+	tenantTemplates *data.TenantTemplates,
+	tenantPlanRepo *data.TenantPlanRepo,
 	userRepo *data.UserRepo,
+	fileRepo *data.FileRepo,
 	userCredentialsRepo *data.UserCredentialRepo,
+	userToken *data.UserTokenCacheRepo,
+	authorizer *data.Authorizer,
+	internalMessageService *InternalMessageService,
+	eventBus eventbus.EventBus,
+	sseServer *sse.Server,
 ) *TenantService {
 	l := log.NewHelper(log.With(logger, "module", "tenant/service/admin-service"))
 	return &TenantService{
-		log:                 l,
-		tenantRepo:          tenantRepo,
-		userRepo:            userRepo,
-		userCredentialsRepo: userCredentialsRepo,
+		log:                    l,
+		tenantRepo:             tenantRepo,
+		tenantCache:            tenantCache,
+		tenantProvisionRepo:    tenantProvisionRepo,
+		tenantTemplates:        tenantTemplates,
+		tenantPlanRepo:         tenantPlanRepo,
+		userRepo:               userRepo,
+		fileRepo:               fileRepo,
+		userCredentialsRepo:    userCredentialsRepo,
+		userToken:              userToken,
+		authorizer:             authorizer,
+		internalMessageService: internalMessageService,
+		eventBus:               eventBus,
+		sseServer:              sseServer,
 	}
 }
 
@@ -68,6 +110,8 @@ func (s *TenantService) List(ctx context.Context, req *pagination.PagingRequest)
 		}
 	}
 
+	s.fillPlanName(ctx, resp.Items...)
+
 	return resp, nil
 }
 
@@ -90,9 +134,57 @@ func (s *TenantService) Get(ctx context.Context, req *userV1.GetTenantRequest) (
 		}
 	}
 
+	s.fillPlanName(ctx, resp)
+
 	return resp, nil
 }
 
+// validatePlan 检查分配给租户的订阅套餐是否存在
+func (s *TenantService) validatePlan(ctx context.Context, planId *uint32) error {
+	if planId == nil {
+		return nil
+	}
+
+	exist, err := s.tenantPlanRepo.IsExist(ctx, *planId)
+	if err != nil {
+		return err
+	}
+	if !exist {
+		return adminV1.ErrorBadRequest("订阅套餐不存在")
+	}
+
+	return nil
+}
+
+// fillPlanName 填充租户订阅套餐的名称
+func (s *TenantService) fillPlanName(ctx context.Context, tenants ...*userV1.Tenant) {
+	var planIds []uint32
+	for _, t := range tenants {
+		if t.PlanId != nil {
+			planIds = append(planIds, t.GetPlanId())
+		}
+	}
+	if len(planIds) == 0 {
+		return
+	}
+
+	plans, err := s.tenantPlanRepo.ListPlansByIds(ctx, planIds)
+	if err != nil {
+		s.log.Errorf("failed to get tenant plan info: %v", err)
+		return
+	}
+
+	names := make(map[uint32]*string, len(plans))
+	for _, plan := range plans {
+		names[plan.GetId()] = plan.Name
+	}
+	for _, t := range tenants {
+		if t.PlanId != nil {
+			t.PlanName = names[t.GetPlanId()]
+		}
+	}
+}
+
 func (s *TenantService) Create(ctx context.Context, req *userV1.CreateTenantRequest) (*emptypb.Empty, error) {
 	if req.Data == nil {
 		return nil, adminV1.ErrorBadRequest("invalid parameter")
@@ -106,6 +198,10 @@ func (s *TenantService) Create(ctx context.Context, req *userV1.CreateTenantRequ
 
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 
+	if err = s.validatePlan(ctx, req.Data.PlanId); err != nil {
+		return nil, err
+	}
+
 	if _, err = s.tenantRepo.Create(ctx, req.Data); err != nil {
 		return nil, err
 	}
@@ -126,18 +222,41 @@ func (s *TenantService) Update(ctx context.Context, req *userV1.UpdateTenantRequ
 
 	req.Data.UpdatedBy = trans.Ptr(operator.UserId)
 
+	if err = s.validatePlan(ctx, req.Data.PlanId); err != nil {
+		return nil, err
+	}
+
+	previous, err := s.tenantRepo.GetState(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
 	if err = s.tenantRepo.Update(ctx, req); err != nil {
 		return nil, err
 	}
 
+	s.invalidateTenantState(ctx, req.GetId())
+
+	current, err := s.tenantRepo.GetState(ctx, req.GetId())
+	if err != nil {
+		s.log.Errorf("get tenant [%d] state after update failed: %s", req.GetId(), err)
+		return &emptypb.Empty{}, nil
+	}
+
+	s.emitStatusChanged(ctx, req.GetId(), previous, current, operator.GetUserId(), "")
+
 	return &emptypb.Empty{}, nil
 }
 
+// Delete 删除租户及其全部数据，租户需要先归档（停用）
+// Delete 只删除租户记录，不清理租户的数据；下线租户并清除全部数据使用 OffboardTenant
 func (s *TenantService) Delete(ctx context.Context, req *userV1.DeleteTenantRequest) (*emptypb.Empty, error) {
 	if err := s.tenantRepo.Delete(ctx, req); err != nil {
 		return nil, err
 	}
 
+	s.invalidateTenantState(ctx, req.GetId())
+
 	return &emptypb.Empty{}, nil
 }
 
@@ -160,70 +279,557 @@ func (s *TenantService) CreateTenantWithAdminUser(ctx context.Context, req *admi
 	req.Tenant.CreatedBy = trans.Ptr(operator.UserId)
 	req.User.CreatedBy = trans.Ptr(operator.UserId)
 
-	// Check if tenant code or admin username already exists
-	if _, err = s.tenantRepo.TenantExists(ctx, &userV1.TenantExistsRequest{
+	// 租户编码用于登录时识别租户，全局唯一；管理员用户名只需在新租户内唯一，无需检查
+	var exist *userV1.TenantExistsResponse
+	if exist, err = s.tenantRepo.TenantExists(ctx, &userV1.TenantExistsRequest{
 		Code: req.GetTenant().GetCode(),
 	}); err != nil {
 		s.log.Errorf("check tenant code exists err: %v", err)
 		return nil, err
 	}
+	if exist.GetExist() {
+		return nil, adminV1.ErrorConflict("租户编码已存在")
+	}
 
-	// Check if admin user exists
-	if _, err = s.userRepo.UserExists(ctx, &userV1.UserExistsRequest{
-		Username: req.GetUser().GetUsername(),
-	}); err != nil {
-		s.log.Errorf("check admin user exists err: %v", err)
+	if err = s.validatePlan(ctx, req.Tenant.PlanId); err != nil {
 		return nil, err
 	}
 
-	// Create tenant
-	var tenant *userV1.Tenant
-	if tenant, err = s.tenantRepo.Create(ctx, req.Tenant); err != nil {
-		s.log.Errorf("create tenant err: %v", err)
-		return nil, err
+	tpl, ok := s.tenantTemplates.Get(req.GetTemplate())
+	if !ok {
+		return nil, adminV1.ErrorBadRequest("租户模板不存在")
 	}
 
 	req.User.Authority = userV1.User_TENANT_ADMIN.Enum()
-	req.User.TenantId = tenant.Id
 
-	// Create tenant admin user
-	var adminUser *userV1.User
-	if adminUser, err = s.userRepo.Create(ctx, &userV1.CreateUserRequest{
-		Data: req.User,
-	}); err != nil {
-		s.log.Errorf("create tenant admin user err: %v", err)
+	// 租户、管理员、凭证与模板数据在一个事务中创建
+	tenant, err := s.tenantProvisionRepo.Provision(ctx, &data.TenantProvisionRequest{
+		Tenant:     req.Tenant,
+		Admin:      req.User,
+		Password:   req.GetPassword(),
+		Template:   tpl,
+		OperatorId: operator.GetUserId(),
+	})
+	if err != nil {
+		s.log.Errorf("provision tenant err: %v", err)
 		return nil, err
 	}
 
-	// Create user credential
-	if err = s.userCredentialsRepo.Create(ctx, &authenticationV1.CreateUserCredentialRequest{
-		Data: &authenticationV1.UserCredential{
-			UserId:         adminUser.Id,
-			IdentityType:   authenticationV1.UserCredential_USERNAME.Enum(),
-			Identifier:     adminUser.Username,
-			CredentialType: authenticationV1.UserCredential_PASSWORD_HASH.Enum(),
-			Credential:     trans.Ptr(req.GetPassword()),
-			IsPrimary:      trans.Ptr(true),
-			Status:         authenticationV1.UserCredential_ENABLED.Enum(),
-		},
-	}); err != nil {
-		s.log.Errorf("create tenant admin user credential err: %v", err)
+	// 复制的角色及其授权需要加入鉴权策略
+	if err = s.authorizer.ReloadPolicies(ctx); err != nil {
+		s.log.Errorf("reload policies error: %v", err)
+	}
+
+	s.publishEvent(ctx, eventbus.EventTenantProvisioned, &eventbus.TenantProvisionedEvent{
+		TenantID:    tenant.GetId(),
+		AdminUserID: tenant.GetAdminUserId(),
+		Template:    req.GetTemplate(),
+		OperatorID:  operator.GetUserId(),
+	})
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListTenantTemplates 获取可用的租户模板
+func (s *TenantService) ListTenantTemplates(_ context.Context, _ *emptypb.Empty) (*adminV1.ListTenantTemplatesResponse, error) {
+	return &adminV1.ListTenantTemplatesResponse{
+		Items: s.tenantTemplates.ToProto(),
+	}, nil
+}
+
+// OffboardTenant 租户下线：归档时停用租户并使租户用户下线，保留全部数据；清除时删除租户及其全部数据，租户需要先归档。
+// 两种方式都需要输入租户编码确认
+func (s *TenantService) OffboardTenant(ctx context.Context, req *adminV1.OffboardTenantRequest) (*adminV1.OffboardTenantResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	// Update tenant with admin user id
-	if err = s.tenantRepo.Update(ctx, &userV1.UpdateTenantRequest{
-		Data: &userV1.Tenant{
-			Id:          tenant.Id,
-			AdminUserId: adminUser.Id,
-		},
-		UpdateMask: &field_mask.FieldMask{
-			Paths: []string{"id", "admin_user_id"},
-		},
-	}); err != nil {
-		s.log.Errorf("update tenant with admin user id err: %v", err)
+	t, err := s.tenantRepo.Get(ctx, &userV1.GetTenantRequest{
+		QueryBy: &userV1.GetTenantRequest_Id{Id: req.GetId()},
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	if req.GetConfirmCode() == "" || req.GetConfirmCode() != t.GetCode() {
+		return nil, adminV1.ErrorBadRequest("确认的租户编码不一致")
+	}
+
+	var result *data.TenantOffboardResult
+	switch req.GetMode() {
+	case adminV1.OffboardTenantRequest_ARCHIVE:
+		result, err = s.archiveTenant(ctx, t, operator.GetUserId(), req.GetReason())
+	case adminV1.OffboardTenantRequest_PURGE:
+		result, err = s.purgeTenant(ctx, t, operator.GetUserId(), req.GetReason())
+	default:
+		return nil, adminV1.ErrorBadRequest("invalid offboard mode")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminV1.OffboardTenantResponse{
+		Affected: result.Affected,
+	}, nil
+}
+
+// archiveTenant 停用租户并使租户用户下线，租户数据全部保留
+func (s *TenantService) archiveTenant(ctx context.Context, t *userV1.Tenant, operatorId uint32, reason string) (*data.TenantOffboardResult, error) {
+	previous, err := s.tenantRepo.GetState(ctx, t.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.tenantProvisionRepo.Archive(ctx, t.GetId(), operatorId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateTenantState(ctx, t.GetId())
+	s.revokeUserTokens(ctx, result.UserIds)
+
+	current := *previous
+	current.Status = userV1.Tenant_OFF
+	s.emitStatusChanged(ctx, t.GetId(), previous, &current, operatorId, reason)
+
+	s.emitOffboarded(ctx, t, adminV1.OffboardTenantRequest_ARCHIVE, result, operatorId, reason)
+
+	return result, nil
+}
+
+// purgeTenant 删除租户及其全部数据，启用中的租户需要先归档，避免误删正在使用的租户
+func (s *TenantService) purgeTenant(ctx context.Context, t *userV1.Tenant, operatorId uint32, reason string) (*data.TenantOffboardResult, error) {
+	state, err := s.tenantRepo.GetState(ctx, t.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if state.Status == userV1.Tenant_ON {
+		return nil, adminV1.ErrorConflict("租户仍在启用中，请先归档")
+	}
+
+	result, err := s.tenantProvisionRepo.Purge(ctx, t.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidateTenantState(ctx, t.GetId())
+	s.revokeUserTokens(ctx, result.UserIds)
+
+	s.emitOffboarded(ctx, t, adminV1.OffboardTenantRequest_PURGE, result, operatorId, reason)
+
+	return result, nil
+}
+
+// revokeUserTokens 删除用户的登录令牌，使其立即下线
+func (s *TenantService) revokeUserTokens(ctx context.Context, userIds []uint32) {
+	if s.userToken == nil {
+		return
+	}
+
+	for _, userId := range userIds {
+		if err := s.userToken.RemoveToken(ctx, userId); err != nil {
+			s.log.Errorf("remove user [%d] token failed: %s", userId, err)
+		}
+	}
+}
+
+// emitOffboarded 发布租户下线事件
+func (s *TenantService) emitOffboarded(ctx context.Context, t *userV1.Tenant, mode adminV1.OffboardTenantRequest_Mode, result *data.TenantOffboardResult, operatorId uint32, reason string) {
+	s.log.Infof("tenant [%d:%s] offboarded by [%d], mode: %s, affected: %v",
+		t.GetId(), t.GetCode(), operatorId, mode.String(), result.Affected)
+
+	s.publishEvent(ctx, eventbus.EventTenantOffboarded, &eventbus.TenantOffboardedEvent{
+		TenantID:   t.GetId(),
+		Mode:       mode.String(),
+		Affected:   result.Affected,
+		OperatorID: operatorId,
+		Reason:     reason,
+	})
+}
+
+// CheckTenant 检查租户是否可用：租户存在、状态为启用、已通过审核且没有到期。
+// 登录与每个需要登录的请求都会检查，租户状态缓存在Redis中。平台租户（租户ID为0）不做检查
+func (s *TenantService) CheckTenant(ctx context.Context, tenantId uint32) error {
+	if tenantId == 0 {
+		return nil
+	}
+
+	state, err := s.getTenantState(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+
+	return checkTenantState(state, time.Now())
+}
+
+// getTenantState 获取租户状态，缓存不存在时从数据库加载
+func (s *TenantService) getTenantState(ctx context.Context, tenantId uint32) (*data.TenantState, error) {
+	if state, ok, err := s.tenantCache.GetState(ctx, tenantId); err != nil {
+		s.log.Warnf("get tenant [%d] state from cache failed: %s", tenantId, err)
+	} else if ok {
+		return state, nil
+	}
+
+	state, err := s.tenantRepo.GetState(viewer.NewSystemViewerContext(ctx), tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.tenantCache.SetState(ctx, tenantId, state); err != nil {
+		s.log.Warnf("cache tenant [%d] state failed: %s", tenantId, err)
+	}
+
+	return state, nil
+}
+
+// invalidateTenantState 租户变更后删除状态缓存
+func (s *TenantService) invalidateTenantState(ctx context.Context, tenantIds ...uint32) {
+	if err := s.tenantCache.InvalidateState(ctx, tenantIds...); err != nil {
+		s.log.Errorf("invalidate tenant %v state failed: %s", tenantIds, err)
+	}
+}
+
+// checkTenantState 租户不可用时返回对应的错误
+func checkTenantState(state *data.TenantState, now time.Time) error {
+	if state == nil || !state.Exists {
+		return authenticationV1.ErrorTenantDisabled("租户不存在")
+	}
+
+	if state.Status == userV1.Tenant_EXPIRED || (state.ExpiredAt != nil && !now.Before(*state.ExpiredAt)) {
+		return authenticationV1.ErrorTenantExpired("租户已过期")
+	}
+
+	switch state.Status {
+	case userV1.Tenant_ON:
+	case userV1.Tenant_FREEZE:
+		return authenticationV1.ErrorTenantDisabled("租户已冻结")
+	default:
+		return authenticationV1.ErrorTenantDisabled("租户已停用")
+	}
+
+	// 没有审核状态的租户是引入审核之前创建的，视为已通过
+	if state.AuditStatus != nil && *state.AuditStatus != userV1.Tenant_APPROVED {
+		return authenticationV1.ErrorTenantDisabled("租户未通过审核")
+	}
+
+	return nil
+}
+
+// tenantBaseModules 订阅套餐限制模块时仍然可以访问的模块：登录、个人中心、路由菜单与租户用量
+var tenantBaseModules = map[string]bool{
+	"AuthenticationService": true,
+	"UserProfileService":    true,
+	"RouterService":         true,
+	"TenantService":         true,
+}
+
+// TenantLimits 获取租户订阅套餐的配额，平台租户与没有订阅套餐的租户返回nil，表示不限制
+func (s *TenantService) TenantLimits(ctx context.Context, tenantId uint32) (*data.TenantLimits, error) {
+	if tenantId == 0 {
+		return nil, nil
+	}
+
+	state, err := s.getTenantState(ctx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return state.Limits, nil
+}
+
+// CheckTenantRequest 检查租户的订阅套餐是否允许本次请求：模块已开通且没有超过每日API请求数。
+// 每个需要登录的请求都会检查并累加当天的请求数，计数失败时不拦截请求
+func (s *TenantService) CheckTenantRequest(ctx context.Context, tenantId uint32, operation string) error {
+	limits, err := s.TenantLimits(ctx, tenantId)
+	if err != nil || limits == nil {
+		return err
+	}
+
+	if module := data.OperationModule(operation); !tenantBaseModules[module] && !limits.AllowModule(module) {
+		return userV1.ErrorTenantFeatureDisabled("订阅套餐未开通该模块")
+	}
+
+	if limits.MaxApiRequestsPerDay == 0 {
+		return nil
+	}
+
+	n, err := s.tenantCache.IncrApiRequests(ctx, tenantId, time.Now())
+	if err != nil {
+		s.log.Warnf("count tenant [%d] api requests failed: %s", tenantId, err)
+		return nil
+	}
+	if !limits.AllowApiRequests(n) {
+		return userV1.ErrorTooManyRequests("今日API请求数已达到订阅套餐的上限")
+	}
+
+	return nil
+}
+
+// CheckUserQuota 检查租户能否再增加 n 个用户
+func (s *TenantService) CheckUserQuota(ctx context.Context, tenantId uint32, n int) error {
+	limits, err := s.TenantLimits(ctx, tenantId)
+	if err != nil || limits == nil || limits.MaxUsers == 0 {
+		return err
+	}
+
+	used, err := s.userRepo.CountByTenant(viewer.NewSystemViewerContext(ctx), tenantId)
+	if err != nil {
+		return err
+	}
+	if !limits.AllowUsers(used, n) {
+		return userV1.ErrorTenantQuotaExceeded("用户数已达到订阅套餐的上限")
+	}
+
+	return nil
+}
+
+// CheckStorageQuota 检查租户能否再写入 size 字节的文件
+func (s *TenantService) CheckStorageQuota(ctx context.Context, tenantId uint32, size uint64) error {
+	limits, err := s.TenantLimits(ctx, tenantId)
+	if err != nil || limits == nil || limits.MaxStorageBytes == 0 {
+		return err
+	}
+
+	used, err := s.fileRepo.SumSizeByTenant(viewer.NewSystemViewerContext(ctx), tenantId)
+	if err != nil {
+		return err
+	}
+	if !limits.AllowStorage(used, size) {
+		return userV1.ErrorTenantQuotaExceeded("存储空间已达到订阅套餐的上限")
+	}
+
+	return nil
+}
+
+// CheckFeature 检查租户的订阅套餐是否开通了功能
+func (s *TenantService) CheckFeature(ctx context.Context, tenantId uint32, feature string) error {
+	limits, err := s.TenantLimits(ctx, tenantId)
+	if err != nil {
+		return err
+	}
+	if !limits.FeatureEnabled(feature) {
+		return userV1.ErrorTenantFeatureDisabled("订阅套餐未开通该功能")
+	}
+
+	return nil
+}
+
+// InvalidatePlanTenants 套餐变更后删除订阅了套餐的租户的状态缓存，使新的配额立即生效
+func (s *TenantService) InvalidatePlanTenants(ctx context.Context, planId uint32) {
+	ids, err := s.tenantRepo.ListIdsByPlan(viewer.NewSystemViewerContext(ctx), planId)
+	if err != nil {
+		s.log.Errorf("list tenants of plan [%d] failed: %s", planId, err)
+		return
+	}
+
+	s.invalidateTenantState(ctx, ids...)
+}
+
+// GetTenantUsage 获取租户的用量与订阅套餐的配额，租户用户只能查询所属租户
+func (s *TenantService) GetTenantUsage(ctx context.Context, req *adminV1.GetTenantUsageRequest) (*adminV1.TenantUsage, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantId := operator.GetTenantId()
+	if tenantId == 0 {
+		tenantId = req.GetId()
+	}
+	if tenantId == 0 {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	systemCtx := viewer.NewSystemViewerContext(ctx)
+
+	t, err := s.tenantRepo.Get(systemCtx, &userV1.GetTenantRequest{
+		QueryBy: &userV1.GetTenantRequest_Id{Id: tenantId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminV1.TenantUsage{
+		TenantId:         tenantId,
+		Users:            &adminV1.QuotaUsage{},
+		StorageBytes:     &adminV1.QuotaUsage{},
+		ApiRequestsToday: &adminV1.QuotaUsage{},
+	}
+
+	if t.PlanId != nil {
+		if resp.Plan, err = s.tenantPlanRepo.Get(systemCtx, &userV1.GetTenantPlanRequest{
+			QueryBy: &userV1.GetTenantPlanRequest_Id{Id: t.GetPlanId()},
+		}); err != nil {
+			s.log.Warnf("get plan [%d] of tenant [%d] failed: %s", t.GetPlanId(), tenantId, err)
+		}
+	}
+
+	users, err := s.userRepo.CountByTenant(systemCtx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := s.fileRepo.SumSizeByTenant(systemCtx, tenantId)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := s.tenantCache.GetApiRequests(ctx, tenantId, time.Now())
+	if err != nil {
+		s.log.Warnf("get tenant [%d] api requests failed: %s", tenantId, err)
+	}
+
+	resp.Users.Used = uint64(users)
+	resp.Users.Limit = uint64(resp.GetPlan().GetMaxUsers())
+	resp.StorageBytes.Used = storage
+	resp.StorageBytes.Limit = resp.GetPlan().GetMaxStorageBytes()
+	resp.ApiRequestsToday.Used = uint64(requests)
+	resp.ApiRequestsToday.Limit = uint64(resp.GetPlan().GetMaxApiRequestsPerDay())
+
+	for _, usage := range []*adminV1.QuotaUsage{resp.Users, resp.StorageBytes, resp.ApiRequestsToday} {
+		usage.Exceeded = usage.Limit != 0 && usage.Used >= usage.Limit
+	}
+
+	return resp, nil
+}
+
+// ExpireTenants 将已经到期的租户改为已过期，并提醒 remindBefore 之内即将到期的租户管理员，返回过期与提醒的租户数量
+func (s *TenantService) ExpireTenants(ctx context.Context, remindBefore time.Duration) (expired, reminded int, err error) {
+	now := time.Now()
+
+	tenants, err := s.tenantRepo.ListExpiring(ctx, now.Add(remindBefore))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, t := range tenants {
+		expiredAt := t.GetExpiredAt().AsTime()
+
+		if !expiredAt.After(now) {
+			previous, err := s.tenantRepo.GetState(ctx, t.GetId())
+			if err != nil {
+				s.log.Errorf("get tenant [%d] state failed: %s", t.GetId(), err)
+				continue
+			}
+
+			// 状态已被修改时不再处理，例如管理员刚刚续期或停用了租户
+			ok, err := s.tenantRepo.UpdateStatus(ctx, t.GetId(), userV1.Tenant_ON, userV1.Tenant_EXPIRED)
+			if err != nil {
+				s.log.Errorf("expire tenant [%d] failed: %s", t.GetId(), err)
+				continue
+			}
+			if !ok {
+				continue
+			}
+
+			expired++
+			s.invalidateTenantState(ctx, t.GetId())
+
+			current := *previous
+			current.Status = userV1.Tenant_EXPIRED
+			s.emitStatusChanged(ctx, t.GetId(), previous, &current, 0, "expired")
+
+			s.notifyTenantAdmin(ctx, t, "租户已过期",
+				fmt.Sprintf("租户[%s]已于%s到期，租户内的用户将无法登录，请及时续期。", t.GetName(), expiredAt.Local().Format(time.DateTime)))
+			continue
+		}
+
+		claimed, err := s.tenantCache.ClaimExpireRemind(ctx, t.GetId(), expiredAt)
+		if err != nil {
+			s.log.Errorf("claim tenant [%d] expire remind failed: %s", t.GetId(), err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		reminded++
+
+		s.publishEvent(ctx, eventbus.EventTenantExpiring, &eventbus.TenantExpiringEvent{
+			TenantID:  t.GetId(),
+			ExpiredAt: expiredAt,
+		})
+
+		s.notifyTenantAdmin(ctx, t, "租户即将到期",
+			fmt.Sprintf("租户[%s]将于%s到期，到期后租户内的用户将无法登录，请及时续期。", t.GetName(), expiredAt.Local().Format(time.DateTime)))
+	}
+
+	return expired, reminded, nil
+}
+
+// notifyTenantAdmin 以系统身份通知租户管理员，消息归属该租户
+func (s *TenantService) notifyTenantAdmin(ctx context.Context, t *userV1.Tenant, title, content string) {
+	if s.internalMessageService == nil || t.AdminUserId == nil {
+		return
+	}
+
+	if _, err := s.internalMessageService.SendNotification(
+		viewer.NewTenantViewerContext(ctx, t.GetId()),
+		title, content, []uint32{t.GetAdminUserId()},
+	); err != nil {
+		s.log.Errorf("notify tenant [%d] admin failed: %s", t.GetId(), err)
+	}
+}
+
+// emitStatusChanged 租户状态或审核状态发生变化时发布事件，并通知租户内的在线用户
+func (s *TenantService) emitStatusChanged(ctx context.Context, tenantId uint32, previous, current *data.TenantState, operatorId uint32, reason string) {
+	// 新建或删除租户不属于状态变化
+	if previous == nil || current == nil || !previous.Exists || !current.Exists {
+		return
+	}
+
+	previousAudit := auditStatusName(previous.AuditStatus)
+	currentAudit := auditStatusName(current.AuditStatus)
+	if previous.Status == current.Status && previousAudit == currentAudit {
+		return
+	}
+
+	event := &eventbus.TenantStatusChangedEvent{
+		TenantID:            tenantId,
+		Status:              current.Status.String(),
+		PreviousStatus:      previous.Status.String(),
+		AuditStatus:         currentAudit,
+		PreviousAuditStatus: previousAudit,
+		OperatorID:          operatorId,
+		Reason:              reason,
+	}
+
+	s.log.Infof("tenant [%d] status changed: %s/%s -> %s/%s",
+		tenantId, event.PreviousStatus, event.PreviousAuditStatus, event.Status, event.AuditStatus)
+
+	s.publishEvent(ctx, eventbus.EventTenantStatusChanged, event)
+
+	if s.sseServer == nil {
+		return
+	}
+
+	eventJson, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	s.sseServer.Publish(ctx, sse.TenantTopic(tenantId), &sse.Event{
+		ID:    uuid.New().String(),
+		Data:  eventJson,
+		Event: tenantStatusChangedEvent,
+	})
+}
+
+// publishEvent 向进程内的事件总线发布租户事件
+func (s *TenantService) publishEvent(ctx context.Context, eventType string, data any) {
+	if s.eventBus == nil {
+		return
+	}
+
+	if err := s.eventBus.Publish(ctx, eventbus.NewEvent(eventType, data).WithSource(appService.AdminService)); err != nil {
+		s.log.Errorf("publish event [%s] failed: %s", eventType, err)
+	}
+}
+
+func auditStatusName(status *userV1.Tenant_AuditStatus) string {
+	if status == nil {
+		return ""
+	}
+	return status.String()
 }
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-wind-admin/app/admin/service/internal/data"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/entgo/viewer"
+	"go-wind-admin/pkg/eventbus"
+	"go-wind-admin/pkg/metering"
+	"go-wind-admin/pkg/middleware/auth"
+	appService "go-wind-admin/pkg/service"
+)
+
+type TenantUsageService struct {
+	adminV1.TenantUsageServiceHTTPServer
+
+	log *log.Helper
+
+	cfg *metering.Config
+
+	usageRepo       *data.TenantUsageRepo
+	usageCache      *data.TenantUsageCacheRepo
+	tenantRepo      *data.TenantRepo
+	fileRepo        *data.FileRepo
+	apiResourceRepo *data.ApiResourceRepo
+	recipientRepo   *data.InternalMessageRecipientRepo
+
+	tenantService *TenantService
+
+	eventBus eventbus.EventBus
+}
+
+func NewTenantUsageService(
+	logger log.Logger,
+	cfg *metering.Config,
+	usageRepo *data.TenantUsageRepo,
+	usageCache *data.TenantUsageCacheRepo,
+	tenantRepo *data.TenantRepo,
+	fileRepo *data.FileRepo,
+	apiResourceRepo *data.ApiResourceRepo,
+	recipientRepo *data.InternalMessageRecipientRepo,
+	tenantService *TenantService,
+	eventBus eventbus.EventBus,
+) *TenantUsageService {
+	l := log.NewHelper(log.With(logger, "module", "tenant-usage/service/admin-service"))
+	return &TenantUsageService{
+		log:             l,
+		cfg:             cfg,
+		usageRepo:       usageRepo,
+		usageCache:      usageCache,
+		tenantRepo:      tenantRepo,
+		fileRepo:        fileRepo,
+		apiResourceRepo: apiResourceRepo,
+		recipientRepo:   recipientRepo,
+		tenantService:   tenantService,
+		eventBus:        eventBus,
+	}
+}
+
+// RecordRequest 记录租户用户的一次请求，计数失败时不拦截请求。平台用户的请求不计量
+func (s *TenantUsageService) RecordRequest(ctx context.Context, tenantId, userId uint32, operation string) {
+	if !s.cfg.Enabled || tenantId == 0 {
+		return
+	}
+
+	if err := s.usageCache.RecordRequest(ctx, tenantId, userId, operation, time.Now()); err != nil {
+		s.log.Warnf("record tenant [%d] request failed: %s", tenantId, err)
+	}
+}
+
+// AggregateUsage 汇总最近 hours 个已结束的小时的用量，返回写入的用量记录数与发布的阈值事件数
+func (s *TenantUsageService) AggregateUsage(ctx context.Context, hours int) (records, events int, err error) {
+	if !s.cfg.Enabled {
+		return 0, 0, nil
+	}
+
+	ctx = viewer.NewSystemViewerContext(ctx)
+
+	tenantIds, err := s.tenantRepo.ListIds(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	modules, err := s.apiResourceRepo.ListOperationModules(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// 从最早的小时开始汇总，越过阈值时需要与上一个小时的记录比较
+	current := metering.TruncateHour(time.Now())
+	for i := hours; i >= 1; i-- {
+		hour := current.Add(-time.Duration(i) * time.Hour)
+
+		saved, err := s.aggregateHour(ctx, hour, tenantIds, modules)
+		if err != nil {
+			return records, events, err
+		}
+		records += len(saved)
+
+		events += s.checkThresholds(ctx, hour, saved)
+	}
+
+	return records, events, nil
+}
+
+// aggregateHour 把一个小时的请求计数、存储空间与站内信数量汇总为用量记录，没有任何用量的租户不保存记录
+func (s *TenantUsageService) aggregateHour(ctx context.Context, hour time.Time, tenantIds []uint32, modules map[string]string) ([]*metering.Record, error) {
+	counters, err := s.usageCache.GetHourCounters(ctx, hour, tenantIds)
+	if err != nil {
+		s.log.Errorf("get tenant usage counters of [%s] failed: %s", hour.Format(time.DateTime), err)
+		return nil, adminV1.ErrorInternalServerError("get tenant usage counters failed")
+	}
+
+	storage, err := s.fileRepo.SumSizeGroupByTenant(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := s.recipientRepo.CountCreatedGroupByTenant(ctx, hour, hour.Add(time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]*metering.Record, 0, len(tenantIds))
+	for _, tenantId := range tenantIds {
+		record := &metering.Record{
+			TenantId:     tenantId,
+			PeriodStart:  hour,
+			StorageBytes: storage[tenantId],
+			MessagesSent: messages[tenantId],
+		}
+
+		if c := counters[tenantId]; c != nil {
+			record.ActiveUsers = c.ActiveUsers
+			for operation, n := range c.Operations {
+				// API资源表中没有的接口按服务名归类
+				module := modules[metering.OperationId(operation)]
+				if module == "" {
+					module = data.OperationModule(operation)
+				}
+
+				if record.ApiCallsByModule == nil {
+					record.ApiCallsByModule = make(map[string]uint64)
+				}
+				record.ApiCallsByModule[module] += n
+				record.ApiCalls += n
+			}
+		}
+
+		if !record.Empty() {
+			records = append(records, record)
+		}
+	}
+
+	if err = s.usageRepo.Save(ctx, records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// checkThresholds 比较用量与订阅套餐的配额，越过阈值时发布事件，返回发布的事件数。
+// 活跃用户数与存储空间和上一个小时比较，API调用次数按当天的累计值与每日配额比较
+func (s *TenantUsageService) checkThresholds(ctx context.Context, hour time.Time, records []*metering.Record) int {
+	if len(records) == 0 {
+		return 0
+	}
+
+	thresholds := s.cfg.GetThresholds()
+
+	previous := make(map[uint32]*metering.Record)
+	if prevRecords, err := s.usageRepo.List(ctx, 0, hour.Add(-time.Hour), hour); err != nil {
+		s.log.Warnf("list previous tenant usage records failed: %s", err)
+	} else {
+		for _, r := range prevRecords {
+			previous[r.TenantId] = r
+		}
+	}
+
+	dailyApiCalls, err := s.usageRepo.SumApiCallsGroupByTenant(ctx, metering.TruncateDay(hour), hour)
+	if err != nil {
+		s.log.Warnf("sum daily tenant api calls failed: %s", err)
+		dailyApiCalls = map[uint32]uint64{}
+	}
+
+	events := 0
+	for _, record := range records {
+		limits, err := s.tenantService.TenantLimits(ctx, record.TenantId)
+		if err != nil || limits == nil {
+			continue
+		}
+
+		prev := previous[record.TenantId]
+		if prev == nil {
+			prev = &metering.Record{TenantId: record.TenantId}
+		}
+
+		checks := []struct {
+			metric            metering.Metric
+			previous, current uint64
+			limit             uint64
+		}{
+			{metering.MetricActiveUsers, prev.Value(metering.MetricActiveUsers), record.Value(metering.MetricActiveUsers), uint64(limits.MaxUsers)},
+			{metering.MetricApiCalls, dailyApiCalls[record.TenantId], dailyApiCalls[record.TenantId] + record.ApiCalls, uint64(limits.MaxApiRequestsPerDay)},
+			{metering.MetricStorageBytes, prev.Value(metering.MetricStorageBytes), record.Value(metering.MetricStorageBytes), limits.MaxStorageBytes},
+		}
+
+		for _, c := range checks {
+			for _, percent := range metering.Crossed(c.previous, c.current, c.limit, thresholds) {
+				claimed, err := s.usageCache.ClaimThreshold(ctx, record.TenantId, c.metric, percent, hour)
+				if err != nil {
+					s.log.Errorf("claim tenant [%d] usage threshold failed: %s", record.TenantId, err)
+					continue
+				}
+				if !claimed {
+					continue
+				}
+
+				events++
+				s.publishEvent(ctx, &eventbus.TenantUsageThresholdEvent{
+					TenantID:    record.TenantId,
+					PlanID:      limits.PlanId,
+					Metric:      string(c.metric),
+					Threshold:   percent,
+					Used:        c.current,
+					Limit:       c.limit,
+					PeriodStart: hour,
+				})
+			}
+		}
+	}
+
+	return events
+}
+
+// publishEvent 向进程内的事件总线发布用量越过阈值的事件
+func (s *TenantUsageService) publishEvent(ctx context.Context, event *eventbus.TenantUsageThresholdEvent) {
+	if s.eventBus == nil {
+		return
+	}
+
+	if err := s.eventBus.Publish(ctx, eventbus.NewEvent(eventbus.EventTenantUsageThreshold, event).WithSource(appService.AdminService)); err != nil {
+		s.log.Errorf("publish event [%s] failed: %s", eventbus.EventTenantUsageThreshold, err)
+	}
+}
+
+// resolveTenant 确定查询的租户：租户用户只能查询所属租户，平台用户查询请求中的租户，required 时平台用户必须指定租户
+func (s *TenantUsageService) resolveTenant(ctx context.Context, tenantId uint32, required bool) (uint32, error) {
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if operator.GetTenantId() != 0 {
+		return operator.GetTenantId(), nil
+	}
+	if required && tenantId == 0 {
+		return 0, adminV1.ErrorBadRequest("请指定租户")
+	}
+
+	return tenantId, nil
+}
+
+// listRecords 查询计费周期内的用量记录
+func (s *TenantUsageService) listRecords(ctx context.Context, tenantId uint32, periodValue string) (metering.Period, []*metering.Record, error) {
+	period, err := metering.ParsePeriod(periodValue, time.Now())
+	if err != nil {
+		return period, nil, adminV1.ErrorBadRequest("计费周期格式应为 YYYY-MM 或 YYYY-MM-DD")
+	}
+
+	records, err := s.usageRepo.List(viewer.NewSystemViewerContext(ctx), tenantId, period.Start, period.End)
+	if err != nil {
+		return period, nil, err
+	}
+
+	return period, records, nil
+}
+
+// tenantNames 查询用量记录中出现的租户的名称
+func (s *TenantUsageService) tenantNames(ctx context.Context, records []*metering.Record) map[uint32]string {
+	var ids []uint32
+	seen := make(map[uint32]bool)
+	for _, r := range records {
+		if !seen[r.TenantId] {
+			seen[r.TenantId] = true
+			ids = append(ids, r.TenantId)
+		}
+	}
+
+	names, err := s.tenantRepo.ListNamesByIds(viewer.NewSystemViewerContext(ctx), ids)
+	if err != nil {
+		s.log.Warnf("list tenant names failed: %s", err)
+	}
+	return names
+}
+
+// ListTenantUsageRecords 查询租户在计费周期内每小时的用量记录
+func (s *TenantUsageService) ListTenantUsageRecords(ctx context.Context, req *adminV1.ListTenantUsageRecordsRequest) (*adminV1.ListTenantUsageRecordsResponse, error) {
+	tenantId, err := s.resolveTenant(ctx, req.GetTenantId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	period, records, err := s.listRecords(ctx, tenantId, req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &adminV1.ListTenantUsageRecordsResponse{
+		PeriodStart: timestamppb.New(period.Start),
+		PeriodEnd:   timestamppb.New(period.End),
+		Items:       make([]*adminV1.TenantUsageRecord, 0, len(records)),
+	}
+	for _, r := range records {
+		resp.Items = append(resp.Items, &adminV1.TenantUsageRecord{
+			TenantId:         r.TenantId,
+			PeriodStart:      timestamppb.New(r.PeriodStart),
+			ActiveUsers:      r.ActiveUsers,
+			ApiCalls:         r.ApiCalls,
+			ApiCallsByModule: r.ApiCallsByModule,
+			StorageBytes:     r.StorageBytes,
+			MessagesSent:     r.MessagesSent,
+		})
+	}
+
+	return resp, nil
+}
+
+// ListTenantUsageSummaries 查询计费周期内各租户的用量汇总
+func (s *TenantUsageService) ListTenantUsageSummaries(ctx context.Context, req *adminV1.ListTenantUsageSummariesRequest) (*adminV1.ListTenantUsageSummariesResponse, error) {
+	tenantId, err := s.resolveTenant(ctx, req.GetTenantId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	period, records, err := s.listRecords(ctx, tenantId, req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	names := s.tenantNames(ctx, records)
+	summaries := metering.Summarize(records)
+
+	resp := &adminV1.ListTenantUsageSummariesResponse{
+		PeriodStart: timestamppb.New(period.Start),
+		PeriodEnd:   timestamppb.New(period.End),
+		Items:       make([]*adminV1.TenantUsageSummary, 0, len(summaries)),
+	}
+	for _, sum := range summaries {
+		resp.Items = append(resp.Items, &adminV1.TenantUsageSummary{
+			TenantId:         sum.TenantId,
+			TenantName:       names[sum.TenantId],
+			Hours:            sum.Hours,
+			PeakActiveUsers:  sum.PeakActiveUsers,
+			ApiCalls:         sum.ApiCalls,
+			ApiCallsByModule: sum.ApiCallsByModule,
+			PeakStorageBytes: sum.PeakStorageBytes,
+			StorageByteHours: sum.StorageByteHours,
+			MessagesSent:     sum.MessagesSent,
+		})
+	}
+
+	return resp, nil
+}
+
+// ExportTenantUsage 导出计费周期内的用量为CSV文件，默认每个租户一行的汇总，也可以导出每小时的用量记录
+func (s *TenantUsageService) ExportTenantUsage(ctx context.Context, req *adminV1.ExportTenantUsageRequest) (*adminV1.ExportTenantUsageResponse, error) {
+	tenantId, err := s.resolveTenant(ctx, req.GetTenantId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	period, records, err := s.listRecords(ctx, tenantId, req.GetPeriod())
+	if err != nil {
+		return nil, err
+	}
+
+	names := s.tenantNames(ctx, records)
+
+	fileName := "tenant_usage_" + period.Name()
+	if tenantId != 0 {
+		fileName += fmt.Sprintf("_%d", tenantId)
+	}
+
+	var buf bytes.Buffer
+	switch req.GetGranularity() {
+	case adminV1.ExportTenantUsageRequest_HOURLY:
+		fileName += "_hourly"
+		err = metering.WriteRecordsCSV(&buf, records, names)
+	default:
+		err = metering.WriteSummariesCSV(&buf, period, metering.Summarize(records), names)
+	}
+	if err != nil {
+		s.log.Errorf("write tenant usage csv failed: %s", err)
+		return nil, adminV1.ErrorInternalServerError("export tenant usage failed")
+	}
+
+	return &adminV1.ExportTenantUsageResponse{
+		FileName: fileName + ".csv",
+		Content:  buf.Bytes(),
+	}, nil
+}
@@ -23,13 +23,16 @@ type UEditorService struct {
 	log *log.Helper
 
 	mc *oss.MinIOClient
+
+	ossService *OssService
 }
 
-func NewUEditorService(logger log.Logger, mc *oss.MinIOClient) *UEditorService {
+func NewUEditorService(logger log.Logger, mc *oss.MinIOClient, ossService *OssService) *UEditorService {
 	l := log.NewHelper(log.With(logger, "module", "ueditor/service/admin-service"))
 	return &UEditorService{
-		log: l,
-		mc:  mc,
+		log:        l,
+		mc:         mc,
+		ossService: ossService,
 	}
 }
 
@@ -136,7 +139,7 @@ func (s *UEditorService) UploadFile(ctx context.Context, req *fileV1.UEditorUplo
 		bucketName = "videos"
 	}
 
-	downloadUrl, err := s.mc.UploadFile(ctx, bucketName, req.GetSourceFileName(), req.GetFile())
+	downloadUrl, err := s.ossService.UploadTenantFile(ctx, bucketName, req.GetSourceFileName(), req.GetSourceFileName(), req.GetFile())
 	if err != nil {
 		return &fileV1.UEditorUploadResponse{
 			State: trans.Ptr(err.Error()),
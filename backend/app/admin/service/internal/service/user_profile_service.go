@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"slices"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/tx7do/go-utils/trans"
@@ -12,9 +13,12 @@ import (
 
 	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
 	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
+	internalMessageV1 "go-wind-admin/api/gen/go/internal_message/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 
 	"go-wind-admin/pkg/middleware/auth"
+	"go-wind-admin/pkg/notify"
+	"go-wind-admin/pkg/settings"
 )
 
 type UserProfileService struct {
@@ -25,6 +29,12 @@ type UserProfileService struct {
 	roleRepo           *data.RoleRepo
 	userCredentialRepo *data.UserCredentialRepo
 
+	notificationPreferenceRepo  *data.UserNotificationPreferenceRepo
+	internalMessageCategoryRepo *data.InternalMessageCategoryRepo
+	notifyRegistry              *notify.Registry
+
+	settingService *SettingService
+
 	log *log.Helper
 }
 
@@ -34,6 +44,10 @@ func NewUserProfileService(
 	userToken *data.UserTokenCacheRepo,
 	roleRepo *data.RoleRepo,
 	userCredentialRepo *data.UserCredentialRepo,
+	notificationPreferenceRepo *data.UserNotificationPreferenceRepo,
+	internalMessageCategoryRepo *data.InternalMessageCategoryRepo,
+	notifyRegistry *notify.Registry,
+	settingService *SettingService,
 ) *UserProfileService {
 	l := log.NewHelper(log.With(logger, "module", "user-profile/service/admin-service"))
 	return &UserProfileService{
@@ -42,6 +56,12 @@ func NewUserProfileService(
 		userToken:          userToken,
 		roleRepo:           roleRepo,
 		userCredentialRepo: userCredentialRepo,
+
+		notificationPreferenceRepo:  notificationPreferenceRepo,
+		internalMessageCategoryRepo: internalMessageCategoryRepo,
+		notifyRegistry:              notifyRegistry,
+
+		settingService: settingService,
 	}
 }
 
@@ -96,11 +116,21 @@ func (s *UserProfileService) ChangePassword(ctx context.Context, req *userV1.Cha
 		return nil, err
 	}
 
+	// 新密码需要符合租户的密码策略
+	values, err := s.settingService.Resolve(ctx, operator.GetTenantId(), 0)
+	if err != nil {
+		return nil, err
+	}
+	if err = settings.PasswordPolicyFrom(values).Check(req.GetNewPassword()); err != nil {
+		return nil, adminV1.ErrorBadRequest("%s", err.Error())
+	}
+
 	err = s.userCredentialRepo.ChangeCredential(ctx, &authenticationV1.ChangeCredentialRequest{
 		IdentityType:  authenticationV1.UserCredential_USERNAME,
 		Identifier:    operator.GetUsername(),
 		OldCredential: req.GetOldPassword(),
 		NewCredential: req.GetNewPassword(),
+		TenantId:      trans.Ptr(operator.GetTenantId()),
 	})
 	return &emptypb.Empty{}, err
 }
@@ -174,3 +204,132 @@ func (s *UserProfileService) BindContact(context.Context, *userV1.BindContactReq
 func (s *UserProfileService) VerifyContact(context.Context, *userV1.VerifyContactRequest) (*emptypb.Empty, error) {
 	return nil, nil
 }
+
+// GetNotificationPreference 获取通知偏好
+func (s *UserProfileService) GetNotificationPreference(ctx context.Context, _ *emptypb.Empty) (*internalMessageV1.UserNotificationPreference, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.notificationPreferenceRepo.Get(ctx, operator.UserId)
+}
+
+// UpdateNotificationPreference 更新通知偏好
+func (s *UserProfileService) UpdateNotificationPreference(ctx context.Context, req *internalMessageV1.UpdateUserNotificationPreferenceRequest) (*emptypb.Empty, error) {
+	if req == nil || req.Data == nil {
+		return nil, adminV1.ErrorBadRequest("invalid parameter")
+	}
+
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validateNotificationPreference(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	req.Data.UserId = trans.Ptr(operator.UserId)
+
+	if err = s.notificationPreferenceRepo.Save(ctx, req.Data); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// GetSettings 获取个人配置，返回对当前用户生效的配置
+func (s *UserProfileService) GetSettings(ctx context.Context, _ *emptypb.Empty) (*userV1.ListSettingResponse, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.settingService.ListUserSettings(ctx, operator.GetTenantId(), operator.GetUserId())
+}
+
+// UpdateSettings 更新个人配置，只能覆盖允许用户修改的配置项
+func (s *UserProfileService) UpdateSettings(ctx context.Context, req *userV1.UpdateSettingsRequest) (*emptypb.Empty, error) {
+	// 获取操作人信息
+	operator, err := auth.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.settingService.SaveUserSettings(ctx, operator.GetTenantId(), operator.GetUserId(), req); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+const (
+	// minDigestIntervalMinutes、maxDigestIntervalMinutes 摘要发送间隔的取值范围（分钟）
+	minDigestIntervalMinutes = 5
+	maxDigestIntervalMinutes = 24 * 60
+)
+
+// validateNotificationPreference 校验通知渠道、消息分类、免打扰时段与摘要模式
+func (s *UserProfileService) validateNotificationPreference(ctx context.Context, pref *internalMessageV1.UserNotificationPreference) error {
+	known := append([]string{notify.ChannelEmail, notify.ChannelSMS}, s.notifyRegistry.Names()...)
+
+	checkChannels := func(channels []string) error {
+		for _, channel := range channels {
+			if !slices.Contains(known, channel) {
+				return adminV1.ErrorBadRequest("unknown notification channel: %s", channel)
+			}
+		}
+		return nil
+	}
+
+	if err := checkChannels(pref.GetDisabledChannels()); err != nil {
+		return err
+	}
+
+	categoryIds := make([]uint32, 0, len(pref.GetCategoryChannels()))
+	for _, cc := range pref.GetCategoryChannels() {
+		if slices.Contains(categoryIds, cc.GetCategoryId()) {
+			return adminV1.ErrorBadRequest("duplicate category: %d", cc.GetCategoryId())
+		}
+		categoryIds = append(categoryIds, cc.GetCategoryId())
+
+		if err := checkChannels(cc.GetChannels()); err != nil {
+			return err
+		}
+	}
+
+	for _, categoryId := range pref.GetMutedCategoryIds() {
+		if !slices.Contains(categoryIds, categoryId) {
+			categoryIds = append(categoryIds, categoryId)
+		}
+	}
+
+	if qh := pref.GetQuietHours(); qh.GetEnabled() {
+		if _, err := notify.ParseQuietHours(qh.GetStart(), qh.GetEnd(), qh.GetTimezone()); err != nil {
+			return adminV1.ErrorBadRequest("invalid quiet hours: %s", err.Error())
+		}
+	}
+
+	if minutes := pref.GetDigest().GetIntervalMinutes(); minutes != 0 &&
+		(minutes < minDigestIntervalMinutes || minutes > maxDigestIntervalMinutes) {
+		return adminV1.ErrorBadRequest("digest interval must be between %d and %d minutes", minDigestIntervalMinutes, maxDigestIntervalMinutes)
+	}
+
+	if len(categoryIds) == 0 {
+		return nil
+	}
+
+	categories, err := s.internalMessageCategoryRepo.ListCategoriesByIds(ctx, categoryIds)
+	if err != nil {
+		return err
+	}
+	if len(categories) != len(categoryIds) {
+		return adminV1.ErrorBadRequest("category not found")
+	}
+
+	return nil
+}
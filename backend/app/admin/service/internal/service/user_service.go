@@ -31,6 +31,7 @@ type UserService struct {
 	departmentRepo     *data.DepartmentRepo
 	organizationRepo   *data.OrganizationRepo
 	tenantRepo         *data.TenantRepo
+	tenantService      *TenantService
 
 	userRoleRepo     *data.UserRoleRepo
 	userPositionRepo *data.UserPositionRepo
@@ -45,6 +46,7 @@ func NewUserService(
 	departmentRepo *data.DepartmentRepo,
 	organizationRepo *data.OrganizationRepo,
 	tenantRepo *data.TenantRepo,
+	tenantService *TenantService,
 	userRoleRepo *data.UserRoleRepo,
 	userPositionRepo *data.UserPositionRepo,
 ) *UserService {
@@ -58,17 +60,16 @@ func NewUserService(
 		departmentRepo:     departmentRepo,
 		organizationRepo:   organizationRepo,
 		tenantRepo:         tenantRepo,
+		tenantService:      tenantService,
 		userRoleRepo:       userRoleRepo,
 		userPositionRepo:   userPositionRepo,
 	}
 
-	svc.init()
-
 	return svc
 }
 
-func (s *UserService) init() {
-	ctx := context.Background()
+// EnsureDefaultUser 没有任何用户时创建默认用户，只在领导者副本上执行
+func (s *UserService) EnsureDefaultUser(ctx context.Context) {
 	if count, _ := s.userRepo.Count(ctx, []func(s *sql.Selector){}); count == 0 {
 		_ = s.CreateDefaultUser(ctx)
 	}
@@ -295,6 +296,22 @@ func (s *UserService) Create(ctx context.Context, req *userV1.CreateUserRequest)
 	req.Data.CreatedBy = trans.Ptr(operator.UserId)
 	req.Data.TenantId = operator.TenantId
 
+	// 用户名在租户内唯一
+	exist, err := s.userRepo.UserExists(ctx, &userV1.UserExistsRequest{
+		Username: req.Data.GetUsername(),
+		TenantId: trans.Ptr(operator.GetTenantId()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if exist.GetExist() {
+		return nil, adminV1.ErrorConflict("用户名已存在")
+	}
+
+	if err = s.tenantService.CheckUserQuota(ctx, operator.GetTenantId(), 1); err != nil {
+		return nil, err
+	}
+
 	// 创建用户
 	var user *userV1.User
 	if user, err = s.userRepo.Create(ctx, req); err != nil {
@@ -370,10 +387,21 @@ func (s *UserService) Update(ctx context.Context, req *userV1.UpdateUserRequest)
 	}
 
 	if len(req.GetPassword()) > 0 {
+		// 用户名在租户内唯一，按用户所在的租户重置凭证
+		var user *userV1.User
+		if user, err = s.userRepo.Get(ctx, &userV1.GetUserRequest{
+			QueryBy: &userV1.GetUserRequest_Id{
+				Id: req.GetId(),
+			},
+		}); err != nil {
+			return nil, err
+		}
+
 		if err = s.userCredentialRepo.ResetCredential(ctx, &authenticationV1.ResetCredentialRequest{
 			IdentityType:  authenticationV1.UserCredential_USERNAME,
 			Identifier:    req.Data.GetUsername(),
 			NewCredential: req.GetPassword(),
+			TenantId:      trans.Ptr(user.GetTenantId()),
 		}); err != nil {
 			return nil, err
 		}
@@ -430,6 +458,15 @@ func (s *UserService) Delete(ctx context.Context, req *userV1.DeleteUserRequest)
 }
 
 func (s *UserService) UserExists(ctx context.Context, req *userV1.UserExistsRequest) (*userV1.UserExistsResponse, error) {
+	// 用户名在租户内唯一，未指定租户时检查当前用户所在的租户
+	if req.TenantId == nil {
+		operator, err := auth.FromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		req.TenantId = trans.Ptr(operator.GetTenantId())
+	}
+
 	return s.userRepo.UserExists(ctx, req)
 }
 
@@ -450,6 +487,7 @@ func (s *UserService) EditUserPassword(ctx context.Context, req *userV1.EditUser
 		Identifier:    u.GetUsername(),
 		NewCredential: req.GetNewPassword(),
 		NeedDecrypt:   false,
+		TenantId:      trans.Ptr(u.GetTenantId()),
 	}); err != nil {
 		s.log.Errorf("reset user password err: %v", err)
 		return nil, err
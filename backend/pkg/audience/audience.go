@@ -0,0 +1,210 @@
+package audience
+
+import (
+	"context"
+	"errors"
+	"sort"
+)
+
+// ErrEmptyAudience 没有指定任何接收对象
+var ErrEmptyAudience = errors.New("audience: no recipients specified")
+
+// Filter 受众筛选条件，各条件之间为并集
+type Filter struct {
+	UserIds         []uint32
+	RoleIds         []uint32
+	DepartmentIds   []uint32
+	OrganizationIds []uint32
+	PositionIds     []uint32
+	TenantIds       []uint32
+
+	// IncludeSubDepartments 部门条件是否包含所有下级部门
+	IncludeSubDepartments bool
+	// IncludeSubOrganizations 组织条件是否包含所有下级组织
+	IncludeSubOrganizations bool
+}
+
+// IsEmpty 是否没有设置任何条件
+func (f *Filter) IsEmpty() bool {
+	return f == nil ||
+		len(f.UserIds) == 0 &&
+			len(f.RoleIds) == 0 &&
+			len(f.DepartmentIds) == 0 &&
+			len(f.OrganizationIds) == 0 &&
+			len(f.PositionIds) == 0 &&
+			len(f.TenantIds) == 0
+}
+
+// Spec 受众表达式：All 或 Include 选出的用户，去掉 Exclude 选出的用户
+type Spec struct {
+	All     bool
+	Include Filter
+	Exclude Filter
+}
+
+// Source 受众解析所需的数据来源
+type Source interface {
+	// AllUserIds 所有用户
+	AllUserIds(ctx context.Context) ([]uint32, error)
+	// UserIdsByRoles 拥有任一角色的用户
+	UserIdsByRoles(ctx context.Context, roleIds []uint32) ([]uint32, error)
+	// UserIdsByDepartments 属于任一部门的用户
+	UserIdsByDepartments(ctx context.Context, departmentIds []uint32) ([]uint32, error)
+	// UserIdsByOrganizations 属于任一组织的用户
+	UserIdsByOrganizations(ctx context.Context, organizationIds []uint32) ([]uint32, error)
+	// UserIdsByPositions 担任任一职位的用户
+	UserIdsByPositions(ctx context.Context, positionIds []uint32) ([]uint32, error)
+	// UserIdsByTenants 属于任一租户的用户
+	UserIdsByTenants(ctx context.Context, tenantIds []uint32) ([]uint32, error)
+
+	// DepartmentParents 部门ID -> 上级部门ID
+	DepartmentParents(ctx context.Context) (map[uint32]uint32, error)
+	// OrganizationParents 组织ID -> 上级组织ID
+	OrganizationParents(ctx context.Context) (map[uint32]uint32, error)
+}
+
+// Resolve 解析受众表达式，返回按ID升序排列、去重后的用户ID列表
+func Resolve(ctx context.Context, src Source, spec *Spec) ([]uint32, error) {
+	if spec == nil || (!spec.All && spec.Include.IsEmpty()) {
+		return nil, ErrEmptyAudience
+	}
+
+	var included map[uint32]struct{}
+	if spec.All {
+		ids, err := src.AllUserIds(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		included = make(map[uint32]struct{}, len(ids))
+		addAll(included, ids)
+	} else {
+		var err error
+		if included, err = collect(ctx, src, &spec.Include); err != nil {
+			return nil, err
+		}
+	}
+
+	if !spec.Exclude.IsEmpty() {
+		excluded, err := collect(ctx, src, &spec.Exclude)
+		if err != nil {
+			return nil, err
+		}
+
+		for id := range excluded {
+			delete(included, id)
+		}
+	}
+
+	return sortedKeys(included), nil
+}
+
+// Descendants 返回 roots 及其所有下级节点，parents 为 子节点ID -> 父节点ID
+func Descendants(roots []uint32, parents map[uint32]uint32) []uint32 {
+	children := make(map[uint32][]uint32, len(parents))
+	for child, parent := range parents {
+		if parent != 0 && parent != child {
+			children[parent] = append(children[parent], child)
+		}
+	}
+
+	visited := make(map[uint32]struct{}, len(roots))
+	queue := append([]uint32{}, roots...)
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		// 数据异常形成环时避免死循环
+		if _, ok := visited[id]; ok {
+			continue
+		}
+		visited[id] = struct{}{}
+
+		queue = append(queue, children[id]...)
+	}
+
+	return sortedKeys(visited)
+}
+
+func collect(ctx context.Context, src Source, f *Filter) (map[uint32]struct{}, error) {
+	set := make(map[uint32]struct{})
+
+	addAll(set, f.UserIds)
+
+	if len(f.RoleIds) > 0 {
+		ids, err := src.UserIdsByRoles(ctx, f.RoleIds)
+		if err != nil {
+			return nil, err
+		}
+		addAll(set, ids)
+	}
+
+	if len(f.DepartmentIds) > 0 {
+		deptIds := f.DepartmentIds
+		if f.IncludeSubDepartments {
+			parents, err := src.DepartmentParents(ctx)
+			if err != nil {
+				return nil, err
+			}
+			deptIds = Descendants(deptIds, parents)
+		}
+
+		ids, err := src.UserIdsByDepartments(ctx, deptIds)
+		if err != nil {
+			return nil, err
+		}
+		addAll(set, ids)
+	}
+
+	if len(f.OrganizationIds) > 0 {
+		orgIds := f.OrganizationIds
+		if f.IncludeSubOrganizations {
+			parents, err := src.OrganizationParents(ctx)
+			if err != nil {
+				return nil, err
+			}
+			orgIds = Descendants(orgIds, parents)
+		}
+
+		ids, err := src.UserIdsByOrganizations(ctx, orgIds)
+		if err != nil {
+			return nil, err
+		}
+		addAll(set, ids)
+	}
+
+	if len(f.PositionIds) > 0 {
+		ids, err := src.UserIdsByPositions(ctx, f.PositionIds)
+		if err != nil {
+			return nil, err
+		}
+		addAll(set, ids)
+	}
+
+	if len(f.TenantIds) > 0 {
+		ids, err := src.UserIdsByTenants(ctx, f.TenantIds)
+		if err != nil {
+			return nil, err
+		}
+		addAll(set, ids)
+	}
+
+	return set, nil
+}
+
+func addAll(set map[uint32]struct{}, ids []uint32) {
+	for _, id := range ids {
+		if id != 0 {
+			set[id] = struct{}{}
+		}
+	}
+}
+
+func sortedKeys(set map[uint32]struct{}) []uint32 {
+	ids := make([]uint32, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
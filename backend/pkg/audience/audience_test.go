@@ -0,0 +1,157 @@
+package audience
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSource struct {
+	users []uint32
+
+	roles         map[uint32][]uint32
+	departments   map[uint32][]uint32
+	organizations map[uint32][]uint32
+	positions     map[uint32][]uint32
+	tenants       map[uint32][]uint32
+
+	departmentParents   map[uint32]uint32
+	organizationParents map[uint32]uint32
+}
+
+func lookup(m map[uint32][]uint32, keys []uint32) []uint32 {
+	var ids []uint32
+	for _, k := range keys {
+		ids = append(ids, m[k]...)
+	}
+	return ids
+}
+
+func (s *fakeSource) AllUserIds(context.Context) ([]uint32, error) { return s.users, nil }
+func (s *fakeSource) UserIdsByRoles(_ context.Context, ids []uint32) ([]uint32, error) {
+	return lookup(s.roles, ids), nil
+}
+func (s *fakeSource) UserIdsByDepartments(_ context.Context, ids []uint32) ([]uint32, error) {
+	return lookup(s.departments, ids), nil
+}
+func (s *fakeSource) UserIdsByOrganizations(_ context.Context, ids []uint32) ([]uint32, error) {
+	return lookup(s.organizations, ids), nil
+}
+func (s *fakeSource) UserIdsByPositions(_ context.Context, ids []uint32) ([]uint32, error) {
+	return lookup(s.positions, ids), nil
+}
+func (s *fakeSource) UserIdsByTenants(_ context.Context, ids []uint32) ([]uint32, error) {
+	return lookup(s.tenants, ids), nil
+}
+func (s *fakeSource) DepartmentParents(context.Context) (map[uint32]uint32, error) {
+	return s.departmentParents, nil
+}
+func (s *fakeSource) OrganizationParents(context.Context) (map[uint32]uint32, error) {
+	return s.organizationParents, nil
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{
+		users: []uint32{1, 2, 3, 4, 5, 6, 7, 8},
+		roles: map[uint32][]uint32{
+			1: {1, 2},
+			2: {3},
+		},
+		// 部门树: 10 -> 11 -> 12, 20
+		departments: map[uint32][]uint32{
+			10: {4},
+			11: {5},
+			12: {6},
+			20: {7},
+		},
+		departmentParents: map[uint32]uint32{11: 10, 12: 11, 20: 0},
+		// 组织树: 100 -> 101
+		organizations: map[uint32][]uint32{
+			100: {1},
+			101: {8},
+		},
+		organizationParents: map[uint32]uint32{101: 100},
+		positions: map[uint32][]uint32{
+			30: {2, 7},
+		},
+		tenants: map[uint32][]uint32{
+			1: {1, 2, 3, 4},
+			2: {5, 6, 7, 8},
+		},
+	}
+}
+
+func TestResolveEmpty(t *testing.T) {
+	ctx := context.Background()
+	src := newFakeSource()
+
+	_, err := Resolve(ctx, src, nil)
+	assert.ErrorIs(t, err, ErrEmptyAudience)
+
+	_, err = Resolve(ctx, src, &Spec{Exclude: Filter{UserIds: []uint32{1}}})
+	assert.ErrorIs(t, err, ErrEmptyAudience)
+}
+
+func TestResolveAllWithExclusions(t *testing.T) {
+	ids, err := Resolve(context.Background(), newFakeSource(), &Spec{
+		All:     true,
+		Exclude: Filter{RoleIds: []uint32{1}, UserIds: []uint32{8}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{3, 4, 5, 6, 7}, ids)
+}
+
+func TestResolveUnion(t *testing.T) {
+	ids, err := Resolve(context.Background(), newFakeSource(), &Spec{
+		Include: Filter{
+			UserIds:     []uint32{3},
+			RoleIds:     []uint32{1},
+			PositionIds: []uint32{30},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3, 7}, ids)
+}
+
+func TestResolveSubDepartments(t *testing.T) {
+	ctx := context.Background()
+	src := newFakeSource()
+
+	ids, err := Resolve(ctx, src, &Spec{Include: Filter{DepartmentIds: []uint32{10}}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{4}, ids)
+
+	ids, err = Resolve(ctx, src, &Spec{Include: Filter{DepartmentIds: []uint32{10}, IncludeSubDepartments: true}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{4, 5, 6}, ids)
+
+	ids, err = Resolve(ctx, src, &Spec{
+		Include: Filter{DepartmentIds: []uint32{10}, IncludeSubDepartments: true},
+		Exclude: Filter{DepartmentIds: []uint32{12}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{4, 5}, ids)
+}
+
+func TestResolveSubOrganizationsAndTenants(t *testing.T) {
+	ctx := context.Background()
+	src := newFakeSource()
+
+	ids, err := Resolve(ctx, src, &Spec{Include: Filter{OrganizationIds: []uint32{100}, IncludeSubOrganizations: true}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 8}, ids)
+
+	ids, err = Resolve(ctx, src, &Spec{
+		Include: Filter{TenantIds: []uint32{2}},
+		Exclude: Filter{PositionIds: []uint32{30}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{5, 6, 8}, ids)
+}
+
+func TestDescendantsCycle(t *testing.T) {
+	ids := Descendants([]uint32{1}, map[uint32]uint32{2: 1, 3: 2, 1: 3})
+	assert.Equal(t, []uint32{1, 2, 3}, ids)
+}
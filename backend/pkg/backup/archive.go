@@ -0,0 +1,384 @@
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatVersion 备份归档格式版本，格式不兼容时递增
+	FormatVersion = 1
+
+	// FileExtension 备份归档文件扩展名
+	FileExtension = "tar.gz"
+
+	// ContentType 备份归档的MIME类型
+	ContentType = "application/gzip"
+
+	// PostgreSQL 与 MySQL 都能解析的时间格式
+	timeLayout = "2006-01-02 15:04:05.999999-07:00"
+
+	manifestName = "manifest.json"
+	tablesDir    = "tables"
+)
+
+var (
+	ErrManifestMissing    = errors.New("backup: manifest not found in archive")
+	ErrUnsupportedVersion = errors.New("backup: unsupported archive format version")
+	ErrDriverMismatch     = errors.New("backup: archive driver does not match target database")
+	ErrChecksumMismatch   = errors.New("backup: table checksum mismatch")
+	ErrRowCountMismatch   = errors.New("backup: table row count mismatch")
+	ErrTableMissing       = errors.New("backup: table data missing in archive")
+	ErrUnknownTable       = errors.New("backup: archive contains unknown table")
+)
+
+// TableManifest 单张表的元数据
+type TableManifest struct {
+	Name     string   `json:"name"`
+	Columns  []string `json:"columns"`
+	Rows     int64    `json:"rows"`
+	Checksum string   `json:"checksum"`
+}
+
+// Manifest 备份归档清单，恢复前用于校验归档的完整性
+type Manifest struct {
+	Version   int             `json:"version"`
+	Driver    string          `json:"driver"`
+	CreatedAt time.Time       `json:"created_at"`
+	Tables    []TableManifest `json:"tables"`
+}
+
+// TotalRows 返回所有表的总行数
+func (m *Manifest) TotalRows() int64 {
+	var total int64
+	for _, t := range m.Tables {
+		total += t.Rows
+	}
+	return total
+}
+
+// Archive 读入内存并通过校验的备份归档
+type Archive struct {
+	Manifest Manifest
+
+	tables map[string][]byte
+}
+
+// Rows 遍历表中的每一行数据，行数据按照清单中的列顺序排列
+func (a *Archive) Rows(table string, fn func(values []any) error) error {
+	data, ok := a.tables[table]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrTableMissing, table)
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		values, err := decodeRow(line)
+		if err != nil {
+			return fmt.Errorf("backup: decode row of table %s failed: %w", table, err)
+		}
+
+		if err = fn(values); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// Writer 以 tar.gz 格式写入备份归档，表数据为每行一个JSON数组，清单最后写入。
+type Writer struct {
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+
+	manifest Manifest
+}
+
+// NewWriter 创建备份归档写入器
+func NewWriter(w io.Writer, driver string) *Writer {
+	gz := gzip.NewWriter(w)
+	now := time.Now().UTC()
+	return &Writer{
+		gz:  gz,
+		tw:  tar.NewWriter(gz),
+		now: now,
+		manifest: Manifest{
+			Version:   FormatVersion,
+			Driver:    driver,
+			CreatedAt: now,
+		},
+	}
+}
+
+// TableWriter 单张表的数据写入器
+type TableWriter struct {
+	name    string
+	columns []string
+	rows    int64
+	buf     bytes.Buffer
+	owner   *Writer
+}
+
+// Table 开始写入一张表
+func (w *Writer) Table(name string, columns []string) *TableWriter {
+	return &TableWriter{
+		name:    name,
+		columns: columns,
+		owner:   w,
+	}
+}
+
+// WriteRow 写入一行数据
+func (t *TableWriter) WriteRow(values []any) error {
+	if len(values) != len(t.columns) {
+		return fmt.Errorf("backup: table %s expects %d values, got %d", t.name, len(t.columns), len(values))
+	}
+
+	line, err := encodeRow(values)
+	if err != nil {
+		return fmt.Errorf("backup: encode row of table %s failed: %w", t.name, err)
+	}
+
+	t.buf.Write(line)
+	t.buf.WriteByte('\n')
+	t.rows++
+
+	return nil
+}
+
+// Close 结束写入表数据，并把表数据写入归档
+func (t *TableWriter) Close() error {
+	data := t.buf.Bytes()
+	sum := sha256.Sum256(data)
+
+	if err := t.owner.writeFile(tableFileName(t.name), data); err != nil {
+		return err
+	}
+
+	t.owner.manifest.Tables = append(t.owner.manifest.Tables, TableManifest{
+		Name:     t.name,
+		Columns:  t.columns,
+		Rows:     t.rows,
+		Checksum: hex.EncodeToString(sum[:]),
+	})
+
+	return nil
+}
+
+// Close 写入清单并关闭归档
+func (w *Writer) Close() (*Manifest, error) {
+	data, err := json.MarshalIndent(&w.manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err = w.writeFile(manifestName, data); err != nil {
+		return nil, err
+	}
+
+	if err = w.tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = w.gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return &w.manifest, nil
+}
+
+func (w *Writer) writeFile(name string, data []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: w.now,
+	}); err != nil {
+		return err
+	}
+
+	_, err := w.tw.Write(data)
+	return err
+}
+
+// ReadArchive 读取并校验备份归档。
+// driver 为目标数据库驱动，knownTables 为目标数据库中允许恢复的表，为空时不检查表名。
+func ReadArchive(r io.Reader, driver string, knownTables []string) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("backup: invalid archive: %w", err)
+	}
+	defer gz.Close()
+
+	var manifestData []byte
+	tables := make(map[string][]byte)
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("backup: invalid archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("backup: invalid archive: %w", err)
+		}
+
+		switch {
+		case hdr.Name == manifestName:
+			manifestData = data
+		case path.Dir(hdr.Name) == tablesDir && strings.HasSuffix(hdr.Name, ".jsonl"):
+			tables[strings.TrimSuffix(path.Base(hdr.Name), ".jsonl")] = data
+		}
+	}
+
+	if manifestData == nil {
+		return nil, ErrManifestMissing
+	}
+
+	archive := &Archive{tables: tables}
+	if err = json.Unmarshal(manifestData, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("backup: invalid manifest: %w", err)
+	}
+
+	if err = archive.validate(driver, knownTables); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
+
+func (a *Archive) validate(driver string, knownTables []string) error {
+	if a.Manifest.Version != FormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, a.Manifest.Version)
+	}
+
+	if driver != "" && a.Manifest.Driver != driver {
+		return fmt.Errorf("%w: archive=%s target=%s", ErrDriverMismatch, a.Manifest.Driver, driver)
+	}
+
+	known := make(map[string]struct{}, len(knownTables))
+	for _, name := range knownTables {
+		known[name] = struct{}{}
+	}
+
+	for _, t := range a.Manifest.Tables {
+		if len(known) > 0 {
+			if _, ok := known[t.Name]; !ok {
+				return fmt.Errorf("%w: %s", ErrUnknownTable, t.Name)
+			}
+		}
+
+		data, ok := a.tables[t.Name]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrTableMissing, t.Name)
+		}
+
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != t.Checksum {
+			return fmt.Errorf("%w: %s", ErrChecksumMismatch, t.Name)
+		}
+
+		if rows := int64(bytes.Count(data, []byte{'\n'})); rows != t.Rows {
+			return fmt.Errorf("%w: %s expects %d, got %d", ErrRowCountMismatch, t.Name, t.Rows, rows)
+		}
+	}
+
+	return nil
+}
+
+// FileName 生成备份归档文件名
+func FileName(name string, t time.Time) string {
+	if name == "" {
+		name = "database"
+	}
+	return fmt.Sprintf("backup-%s-%s.%s", name, t.UTC().Format("20060102150405"), FileExtension)
+}
+
+func tableFileName(table string) string {
+	return path.Join(tablesDir, table+".jsonl")
+}
+
+// 二进制数据需要与字符串区分，否则恢复后类型会发生变化
+type binaryValue struct {
+	Bytes []byte `json:"$bytes"`
+}
+
+func encodeRow(values []any) ([]byte, error) {
+	encoded := make([]any, len(values))
+	for i, v := range values {
+		switch val := v.(type) {
+		case time.Time:
+			encoded[i] = val.UTC().Format(timeLayout)
+		case []byte:
+			if val == nil {
+				encoded[i] = nil
+			} else {
+				encoded[i] = binaryValue{Bytes: val}
+			}
+		default:
+			encoded[i] = val
+		}
+	}
+	return json.Marshal(encoded)
+}
+
+func decodeRow(line []byte) ([]any, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+
+	var raw []any
+	if err := dec.Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	for i, v := range raw {
+		switch val := v.(type) {
+		case json.Number:
+			if n, err := val.Int64(); err == nil {
+				raw[i] = n
+			} else if f, err := val.Float64(); err == nil {
+				raw[i] = f
+			} else {
+				raw[i] = val.String()
+			}
+
+		case map[string]any:
+			if b, ok := val["$bytes"].(string); ok && len(val) == 1 {
+				data, err := base64.StdEncoding.DecodeString(b)
+				if err != nil {
+					return nil, err
+				}
+				raw[i] = data
+			}
+		}
+	}
+
+	return raw, nil
+}
@@ -0,0 +1,148 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestArchive(t *testing.T, driver string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf, driver)
+
+	users := w.Table("sys_users", []string{"id", "username", "avatar", "created_at", "deleted_at"})
+	created := time.Date(2025, 1, 2, 3, 4, 5, 600000000, time.UTC)
+	require.NoError(t, users.WriteRow([]any{int64(1), "admin", []byte{0x00, 0xff}, created, nil}))
+	require.NoError(t, users.WriteRow([]any{int64(2), "user", nil, created, nil}))
+	require.NoError(t, users.Close())
+
+	roles := w.Table("sys_roles", []string{"id", "name"})
+	require.NoError(t, roles.Close())
+
+	manifest, err := w.Close()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), manifest.TotalRows())
+
+	return buf.Bytes()
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	data := writeTestArchive(t, DriverPostgres)
+
+	archive, err := ReadArchive(bytes.NewReader(data), DriverPostgres, []string{"sys_users", "sys_roles"})
+	require.NoError(t, err)
+
+	assert.Equal(t, FormatVersion, archive.Manifest.Version)
+	assert.Equal(t, DriverPostgres, archive.Manifest.Driver)
+	require.Len(t, archive.Manifest.Tables, 2)
+	assert.Equal(t, "sys_users", archive.Manifest.Tables[0].Name)
+	assert.Equal(t, int64(2), archive.Manifest.Tables[0].Rows)
+	assert.Equal(t, int64(0), archive.Manifest.Tables[1].Rows)
+
+	var rows [][]any
+	require.NoError(t, archive.Rows("sys_users", func(values []any) error {
+		rows = append(rows, values)
+		return nil
+	}))
+
+	require.Len(t, rows, 2)
+	assert.Equal(t, []any{int64(1), "admin", []byte{0x00, 0xff}, "2025-01-02 03:04:05.6+00:00", nil}, rows[0])
+	assert.Equal(t, []any{int64(2), "user", nil, "2025-01-02 03:04:05.6+00:00", nil}, rows[1])
+}
+
+func TestReadArchiveValidation(t *testing.T) {
+	data := writeTestArchive(t, DriverMySQL)
+
+	_, err := ReadArchive(bytes.NewReader(data), DriverPostgres, nil)
+	assert.ErrorIs(t, err, ErrDriverMismatch)
+
+	_, err = ReadArchive(bytes.NewReader(data), DriverMySQL, []string{"sys_users"})
+	assert.ErrorIs(t, err, ErrUnknownTable)
+
+	_, err = ReadArchive(bytes.NewReader([]byte("not an archive")), DriverMySQL, nil)
+	assert.Error(t, err)
+
+	tampered := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == "tables/sys_users.jsonl" {
+			return bytes.Replace(content, []byte("admin"), []byte("root!"), 1)
+		}
+		return content
+	})
+	_, err = ReadArchive(bytes.NewReader(tampered), DriverMySQL, nil)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	withoutManifest := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == manifestName {
+			return nil
+		}
+		return content
+	})
+	_, err = ReadArchive(bytes.NewReader(withoutManifest), DriverMySQL, nil)
+	assert.ErrorIs(t, err, ErrManifestMissing)
+}
+
+// rewriteArchive 重写归档中的文件，fn 返回 nil 时删除该文件
+func rewriteArchive(t *testing.T, data []byte, fn func(name string, content []byte) []byte) []byte {
+	t.Helper()
+
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		content = fn(hdr.Name, content)
+		if content == nil {
+			continue
+		}
+
+		hdr.Size = int64(len(content))
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return buf.Bytes()
+}
+
+func TestDialect(t *testing.T) {
+	pg, err := NewDialect(DriverPostgres)
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO "sys_users" ("id", "user""name") VALUES ($1, $2)`, pg.InsertSQL("sys_users", []string{"id", `user"name`}))
+
+	my, err := NewDialect(DriverMySQL)
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `sys_users` (`id`, `username`) VALUES (?, ?)", my.InsertSQL("sys_users", []string{"id", "username"}))
+
+	_, err = NewDialect("sqlite3")
+	assert.Error(t, err)
+}
+
+func TestFileName(t *testing.T) {
+	ts := time.Date(2025, 6, 7, 8, 9, 10, 0, time.UTC)
+	assert.Equal(t, "backup-daily-20250607080910.tar.gz", FileName("daily", ts))
+	assert.Equal(t, "backup-database-20250607080910.tar.gz", FileName("", ts))
+}
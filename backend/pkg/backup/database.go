@@ -0,0 +1,267 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	DriverPostgres = "postgres"
+	DriverMySQL    = "mysql"
+)
+
+// ProgressFunc 备份/恢复进度回调，done 为已处理的表数量
+type ProgressFunc func(table string, done, total int)
+
+// Dialect 数据库方言
+type Dialect struct {
+	driver string
+}
+
+// NewDialect 创建数据库方言，只支持 postgres 与 mysql
+func NewDialect(driver string) (Dialect, error) {
+	switch driver {
+	case DriverPostgres, DriverMySQL:
+		return Dialect{driver: driver}, nil
+	default:
+		return Dialect{}, fmt.Errorf("backup: unsupported database driver: %s", driver)
+	}
+}
+
+// Driver 返回驱动名称
+func (d Dialect) Driver() string {
+	return d.driver
+}
+
+// Quote 引用标识符
+func (d Dialect) Quote(ident string) string {
+	if d.driver == DriverMySQL {
+		return "`" + strings.ReplaceAll(ident, "`", "``") + "`"
+	}
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+// Placeholder 返回第 n 个（从1开始）参数占位符
+func (d Dialect) Placeholder(n int) string {
+	if d.driver == DriverMySQL {
+		return "?"
+	}
+	return fmt.Sprintf("$%d", n)
+}
+
+// InsertSQL 生成插入语句
+func (d Dialect) InsertSQL(table string, columns []string) string {
+	quoted := make([]string, len(columns))
+	placeholders := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = d.Quote(c)
+		placeholders[i] = d.Placeholder(i + 1)
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		d.Quote(table), strings.Join(quoted, ", "), strings.Join(placeholders, ", "),
+	)
+}
+
+// Dump 导出数据库中指定表的全部数据到备份归档。
+// tables 需按照外键依赖排序（被依赖的表在前），恢复时按同样的顺序写入。
+func Dump(ctx context.Context, db *sql.DB, driver string, tables []string, w io.Writer, progress ProgressFunc) (*Manifest, error) {
+	dialect, err := NewDialect(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	// 在只读事务中导出，保证各表数据的一致性
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true, Isolation: sql.LevelRepeatableRead})
+	if err != nil {
+		return nil, fmt.Errorf("backup: begin transaction failed: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	aw := NewWriter(w, driver)
+
+	for i, table := range tables {
+		if err = dumpTable(ctx, tx, dialect, aw, table); err != nil {
+			return nil, err
+		}
+
+		if progress != nil {
+			progress(table, i+1, len(tables))
+		}
+	}
+
+	return aw.Close()
+}
+
+func dumpTable(ctx context.Context, tx *sql.Tx, dialect Dialect, aw *Writer, table string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT * FROM "+dialect.Quote(table))
+	if err != nil {
+		return fmt.Errorf("backup: query table %s failed: %w", table, err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+
+	binary := make([]bool, len(columnTypes))
+	for i, ct := range columnTypes {
+		binary[i] = isBinaryType(ct.DatabaseTypeName())
+	}
+
+	tw := aw.Table(table, columns)
+
+	values := make([]any, len(columns))
+	pointers := make([]any, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err = rows.Scan(pointers...); err != nil {
+			return fmt.Errorf("backup: scan table %s failed: %w", table, err)
+		}
+
+		row := make([]any, len(values))
+		for i, v := range values {
+			// 驱动对文本、JSON等类型也可能返回[]byte，只有真正的二进制列才保留为字节
+			if b, ok := v.([]byte); ok && !binary[i] {
+				row[i] = string(b)
+			} else {
+				row[i] = v
+			}
+		}
+
+		if err = tw.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("backup: read table %s failed: %w", table, err)
+	}
+
+	return tw.Close()
+}
+
+func isBinaryType(typeName string) bool {
+	switch strings.ToUpper(typeName) {
+	case "BYTEA", "BLOB", "TINYBLOB", "MEDIUMBLOB", "LONGBLOB", "BINARY", "VARBINARY":
+		return true
+	default:
+		return false
+	}
+}
+
+// Restore 在一个事务中用归档数据替换目标表的数据，任一步失败则整体回滚。
+func Restore(ctx context.Context, db *sql.DB, archive *Archive, progress ProgressFunc) error {
+	dialect, err := NewDialect(archive.Manifest.Driver)
+	if err != nil {
+		return err
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if dialect.driver == DriverMySQL {
+		// 外键检查是会话级别的设置，需要在同一个连接上关闭与恢复
+		if _, err = conn.ExecContext(ctx, "SET FOREIGN_KEY_CHECKS = 0"); err != nil {
+			return err
+		}
+		defer func() { _, _ = conn.ExecContext(context.Background(), "SET FOREIGN_KEY_CHECKS = 1") }()
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("backup: begin transaction failed: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if dialect.driver == DriverPostgres {
+		if _, err = tx.ExecContext(ctx, "SET CONSTRAINTS ALL DEFERRED"); err != nil {
+			return err
+		}
+	}
+
+	tables := archive.Manifest.Tables
+
+	// 先按依赖的逆序清空数据，再按顺序写入
+	for i := len(tables) - 1; i >= 0; i-- {
+		if _, err = tx.ExecContext(ctx, "DELETE FROM "+dialect.Quote(tables[i].Name)); err != nil {
+			return fmt.Errorf("backup: clear table %s failed: %w", tables[i].Name, err)
+		}
+	}
+
+	for i, t := range tables {
+		if err = restoreTable(ctx, tx, dialect, archive, t); err != nil {
+			return err
+		}
+
+		if progress != nil {
+			progress(t.Name, i+1, len(tables))
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("backup: commit transaction failed: %w", err)
+	}
+
+	return nil
+}
+
+func restoreTable(ctx context.Context, tx *sql.Tx, dialect Dialect, archive *Archive, t TableManifest) error {
+	if t.Rows == 0 {
+		return nil
+	}
+
+	stmt, err := tx.PrepareContext(ctx, dialect.InsertSQL(t.Name, t.Columns))
+	if err != nil {
+		return fmt.Errorf("backup: prepare insert of table %s failed: %w", t.Name, err)
+	}
+	defer stmt.Close()
+
+	if err = archive.Rows(t.Name, func(values []any) error {
+		if len(values) != len(t.Columns) {
+			return fmt.Errorf("backup: table %s expects %d values, got %d", t.Name, len(t.Columns), len(values))
+		}
+		if _, err := stmt.ExecContext(ctx, values...); err != nil {
+			return fmt.Errorf("backup: insert into table %s failed: %w", t.Name, err)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// PostgreSQL 的自增序列不会随显式写入的主键变化，需要重置到当前最大值
+	if dialect.driver == DriverPostgres && hasColumn(t.Columns, "id") {
+		query := fmt.Sprintf(
+			"SELECT setval(pg_get_serial_sequence('%s', 'id'), MAX(id)) FROM %s HAVING MAX(id) IS NOT NULL AND pg_get_serial_sequence('%s', 'id') IS NOT NULL",
+			dialect.Quote(t.Name), dialect.Quote(t.Name), dialect.Quote(t.Name),
+		)
+		if _, err = tx.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("backup: reset sequence of table %s failed: %w", t.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func hasColumn(columns []string, name string) bool {
+	for _, c := range columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
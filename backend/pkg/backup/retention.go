@@ -0,0 +1,33 @@
+package backup
+
+import "time"
+
+// RetentionPolicy 备份保留策略，两个条件同时设置时满足任一条件即清理
+type RetentionPolicy struct {
+	// KeepLast 保留最近的备份数量，0 表示不按数量清理
+	KeepLast int
+	// KeepDays 保留最近多少天的备份，0 表示不按时间清理
+	KeepDays int
+}
+
+// Expired 返回需要清理的备份下标，createdAt 需按创建时间倒序排列。
+// 最新的一份备份总是保留。
+func (p RetentionPolicy) Expired(createdAt []time.Time, now time.Time) []int {
+	if p.KeepLast <= 0 && p.KeepDays <= 0 {
+		return nil
+	}
+
+	deadline := now.AddDate(0, 0, -p.KeepDays)
+
+	var expired []int
+	for i := 1; i < len(createdAt); i++ {
+		switch {
+		case p.KeepLast > 0 && i >= p.KeepLast:
+			expired = append(expired, i)
+		case p.KeepDays > 0 && createdAt[i].Before(deadline):
+			expired = append(expired, i)
+		}
+	}
+
+	return expired
+}
@@ -0,0 +1,28 @@
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionPolicyExpired(t *testing.T) {
+	now := time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC)
+	createdAt := []time.Time{
+		now.Add(-1 * time.Hour),
+		now.AddDate(0, 0, -1),
+		now.AddDate(0, 0, -3),
+		now.AddDate(0, 0, -8),
+	}
+
+	assert.Nil(t, RetentionPolicy{}.Expired(createdAt, now))
+	assert.Equal(t, []int{2, 3}, RetentionPolicy{KeepLast: 2}.Expired(createdAt, now))
+	assert.Equal(t, []int{3}, RetentionPolicy{KeepDays: 7}.Expired(createdAt, now))
+	assert.Equal(t, []int{3}, RetentionPolicy{KeepLast: 3, KeepDays: 7}.Expired(createdAt, now))
+	assert.Equal(t, []int{2, 3}, RetentionPolicy{KeepLast: 10, KeepDays: 2}.Expired(createdAt, now))
+
+	// 最新的备份即使超过保留时间也不清理
+	old := []time.Time{now.AddDate(0, 0, -30), now.AddDate(0, 0, -31)}
+	assert.Equal(t, []int{1}, RetentionPolicy{KeepDays: 7}.Expired(old, now))
+}
@@ -0,0 +1,86 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+type broadcastMessage struct {
+	Source  string          `json:"source"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Broadcaster 基于 Redis 发布订阅的实例间通知，用于让其他实例刷新进程内的状态
+type Broadcaster struct {
+	rdb    redis.UniversalClient
+	prefix string
+	id     string
+
+	log *log.Helper
+}
+
+// NewBroadcaster 创建实例间通知，instanceID 用于忽略本实例发出的消息
+func NewBroadcaster(rdb redis.UniversalClient, prefix, instanceID string, logger log.Logger) *Broadcaster {
+	return &Broadcaster{
+		rdb:    rdb,
+		prefix: prefix,
+		id:     instanceID,
+		log:    log.NewHelper(log.With(logger, "module", "cluster/broadcaster")),
+	}
+}
+
+// Publish 向其他实例发布消息
+func (b *Broadcaster) Publish(ctx context.Context, channel string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	msg, err := json.Marshal(&broadcastMessage{Source: b.id, Payload: data})
+	if err != nil {
+		return err
+	}
+
+	return b.rdb.Publish(ctx, b.prefix+channel, msg).Err()
+}
+
+// Subscribe 订阅其他实例发布的消息，返回的函数用于取消订阅
+func (b *Broadcaster) Subscribe(channel string, handler func(ctx context.Context, payload []byte)) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sub := b.rdb.Subscribe(ctx, b.prefix+channel)
+
+	go func() {
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				var msg broadcastMessage
+				if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+					b.log.Errorf("invalid broadcast message on [%s]: %s", channel, err.Error())
+					continue
+				}
+
+				if msg.Source == b.id {
+					continue
+				}
+
+				handler(ctx, msg.Payload)
+			}
+		}
+	}()
+
+	return cancel
+}
@@ -0,0 +1,141 @@
+package cluster
+
+import (
+	"context"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRedis 连接测试用的Redis，地址可通过 REDIS_ADDR 指定，连接失败时跳过测试
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "127.0.0.1:6379"
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: addr, DB: 15})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		_ = rdb.Close()
+		t.Skipf("redis is not available at %s: %v", addr, err)
+	}
+
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return rdb
+}
+
+func TestLocker(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	locker := NewLocker(rdb, "test:lock:")
+	key := t.Name()
+	defer rdb.Del(ctx, "test:lock:"+key)
+
+	l1, err := locker.TryObtain(ctx, key, time.Second)
+	require.NoError(t, err)
+
+	_, err = locker.TryObtain(ctx, key, time.Second)
+	assert.ErrorIs(t, err, ErrNotObtained)
+
+	require.NoError(t, l1.Refresh(ctx, 2*time.Second))
+	require.NoError(t, l1.Release(ctx))
+	assert.ErrorIs(t, l1.Release(ctx), ErrLockLost)
+
+	l2, err := locker.TryObtain(ctx, key, 100*time.Millisecond)
+	require.NoError(t, err)
+
+	// 过期后其他实例可以获取锁，原持有者无法再续期
+	l3, err := locker.Obtain(ctx, key, time.Second, 20*time.Millisecond)
+	require.NoError(t, err)
+	assert.ErrorIs(t, l2.Refresh(ctx, time.Second), ErrLockLost)
+	require.NoError(t, l3.Release(ctx))
+}
+
+func TestLockerWithLock(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	locker := NewLocker(rdb, "test:lock:")
+	key := t.Name()
+	defer rdb.Del(ctx, "test:lock:"+key)
+
+	var running, maxRunning int32
+	done := make(chan struct{})
+
+	for i := 0; i < 3; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+
+			_ = locker.WithLock(ctx, key, 300*time.Millisecond, func(context.Context) error {
+				n := atomic.AddInt32(&running, 1)
+				if n > atomic.LoadInt32(&maxRunning) {
+					atomic.StoreInt32(&maxRunning, n)
+				}
+				time.Sleep(50 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				return nil
+			})
+		}()
+	}
+
+	for i := 0; i < 3; i++ {
+		<-done
+	}
+
+	assert.Equal(t, int32(1), maxRunning)
+}
+
+func TestElectorFailover(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	key := "test:leader:" + t.Name()
+	defer rdb.Del(ctx, key)
+
+	var leaderCount int32
+	newElector := func(id string) *Elector {
+		return NewElector(rdb, key,
+			WithInstanceID(id),
+			WithLeaseTTL(300*time.Millisecond),
+			WithOnStartedLeading(func(context.Context) { atomic.AddInt32(&leaderCount, 1) }),
+			WithOnStoppedLeading(func() { atomic.AddInt32(&leaderCount, -1) }),
+		)
+	}
+
+	a := newElector("a")
+	b := newElector("b")
+
+	require.NoError(t, a.Start(ctx))
+	assert.Eventually(t, a.IsLeader, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, b.Start(ctx))
+	time.Sleep(400 * time.Millisecond)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+
+	status, err := b.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "a", status.Leader)
+	assert.Equal(t, "b", status.InstanceID)
+
+	// 领导者退出后由其他实例接管
+	require.NoError(t, a.Stop(ctx))
+	assert.Eventually(t, b.IsLeader, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&leaderCount))
+
+	require.NoError(t, b.Stop(ctx))
+	assert.False(t, b.IsLeader())
+	assert.Equal(t, int32(0), atomic.LoadInt32(&leaderCount))
+}
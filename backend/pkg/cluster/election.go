@@ -0,0 +1,330 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultLeaseTTL = 15 * time.Second
+
+// ElectionStatus 选举状态
+type ElectionStatus struct {
+	InstanceID  string
+	Leader      string
+	IsLeader    bool
+	LeaderSince time.Time
+	LeaseTTL    time.Duration
+}
+
+// ElectorOption 选举器选项
+type ElectorOption func(*Elector)
+
+// WithInstanceID 设置实例标识，默认为 "<hostname>-<pid>-<random>"
+func WithInstanceID(id string) ElectorOption {
+	return func(e *Elector) {
+		if id != "" {
+			e.id = id
+		}
+	}
+}
+
+// WithLeaseTTL 设置租约时长，领导者每 1/3 租约续期一次，租约过期后其他实例接管
+func WithLeaseTTL(ttl time.Duration) ElectorOption {
+	return func(e *Elector) {
+		if ttl > 0 {
+			e.ttl = ttl
+		}
+	}
+}
+
+// WithOnStartedLeading 成为领导者时的回调，ctx 在失去领导权时取消
+func WithOnStartedLeading(fn func(ctx context.Context)) ElectorOption {
+	return func(e *Elector) {
+		e.OnStartedLeading(fn)
+	}
+}
+
+// WithOnStoppedLeading 失去领导权时的回调
+func WithOnStoppedLeading(fn func()) ElectorOption {
+	return func(e *Elector) {
+		e.OnStoppedLeading(fn)
+	}
+}
+
+// WithLogger 设置日志
+func WithLogger(logger log.Logger) ElectorOption {
+	return func(e *Elector) {
+		e.log = log.NewHelper(log.With(logger, "module", "cluster/elector"))
+	}
+}
+
+// Elector 基于 Redis 租约的领导者选举，同一个 key 下同一时间只有一个实例是领导者。
+// 领导者宕机后租约过期，其他实例在下一次尝试时接管；正常退出时主动释放租约，立即触发切换。
+type Elector struct {
+	rdb redis.UniversalClient
+	key string
+	id  string
+	ttl time.Duration
+
+	callbackMu sync.Mutex
+	onStarted  []func(ctx context.Context)
+	onStopped  []func()
+
+	log *log.Helper
+
+	mu          sync.RWMutex
+	leading     bool
+	leaderSince time.Time
+	lock        *Lock
+	stopLeading context.CancelFunc
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewElector 创建领导者选举器
+func NewElector(rdb redis.UniversalClient, key string, opts ...ElectorOption) *Elector {
+	e := &Elector{
+		rdb: rdb,
+		key: key,
+		id:  defaultInstanceID(),
+		ttl: defaultLeaseTTL,
+		log: log.NewHelper(log.With(log.GetLogger(), "module", "cluster/elector")),
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e
+}
+
+// OnStartedLeading 追加成为领导者时的回调，多个回调按追加顺序在同一个协程中依次执行
+func (e *Elector) OnStartedLeading(fn func(ctx context.Context)) {
+	if fn == nil {
+		return
+	}
+
+	e.callbackMu.Lock()
+	defer e.callbackMu.Unlock()
+
+	e.onStarted = append(e.onStarted, fn)
+}
+
+// OnStoppedLeading 追加失去领导权时的回调
+func (e *Elector) OnStoppedLeading(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	e.callbackMu.Lock()
+	defer e.callbackMu.Unlock()
+
+	e.onStopped = append(e.onStopped, fn)
+}
+
+// InstanceID 返回本实例标识
+func (e *Elector) InstanceID() string {
+	return e.id
+}
+
+// IsLeader 本实例是否为领导者
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.leading
+}
+
+// Status 查询选举状态
+func (e *Elector) Status(ctx context.Context) (*ElectionStatus, error) {
+	leader, err := e.rdb.Get(ctx, e.key).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, err
+	}
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return &ElectionStatus{
+		InstanceID:  e.id,
+		Leader:      leader,
+		IsLeader:    e.leading,
+		LeaderSince: e.leaderSince,
+		LeaseTTL:    e.ttl,
+	}, nil
+}
+
+// Start 开始参与选举，实现 transport.Server 接口
+func (e *Elector) Start(_ context.Context) error {
+	e.mu.Lock()
+	if e.cancel != nil {
+		e.mu.Unlock()
+		return errors.New("cluster: elector already started")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e.cancel = cancel
+	e.done = make(chan struct{})
+	e.mu.Unlock()
+
+	go e.run(ctx)
+
+	return nil
+}
+
+// Stop 退出选举，是领导者时主动释放租约，实现 transport.Server 接口
+func (e *Elector) Stop(ctx context.Context) error {
+	e.mu.Lock()
+	cancel, done := e.cancel, e.done
+	e.cancel = nil
+	e.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (e *Elector) run(ctx context.Context) {
+	defer close(e.done)
+
+	interval := e.ttl / 3
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastRenew := time.Now()
+
+	for {
+		if e.IsLeader() {
+			if err := e.renew(ctx); err != nil {
+				// Redis 暂时不可用时在租约到期前继续保持领导权，租约到期后必须让出
+				if errors.Is(err, ErrLockLost) || time.Since(lastRenew) >= e.ttl {
+					e.log.Warnf("instance [%s] lost leadership of [%s]: %s", e.id, e.key, err.Error())
+					e.stepDown(false)
+				}
+			} else {
+				lastRenew = time.Now()
+			}
+		} else if e.tryAcquire(ctx) {
+			lastRenew = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			e.stepDown(true)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (e *Elector) tryAcquire(ctx context.Context) bool {
+	ok, err := e.rdb.SetNX(ctx, e.key, e.id, e.ttl).Result()
+	if err != nil {
+		if ctx.Err() == nil {
+			e.log.Errorf("instance [%s] acquire leadership of [%s] failed: %s", e.id, e.key, err.Error())
+		}
+		return false
+	}
+	if !ok {
+		return false
+	}
+
+	leaderCtx, stopLeading := context.WithCancel(context.Background())
+
+	e.mu.Lock()
+	e.leading = true
+	e.leaderSince = time.Now()
+	e.lock = &Lock{rdb: e.rdb, key: e.key, token: e.id}
+	e.stopLeading = stopLeading
+	e.mu.Unlock()
+
+	e.log.Infof("instance [%s] became leader of [%s]", e.id, e.key)
+
+	e.callbackMu.Lock()
+	callbacks := append([]func(ctx context.Context){}, e.onStarted...)
+	e.callbackMu.Unlock()
+
+	go func() {
+		for _, fn := range callbacks {
+			if leaderCtx.Err() != nil {
+				return
+			}
+			fn(leaderCtx)
+		}
+	}()
+
+	return true
+}
+
+func (e *Elector) renew(ctx context.Context) error {
+	e.mu.RLock()
+	l := e.lock
+	e.mu.RUnlock()
+
+	if l == nil {
+		return ErrLockLost
+	}
+
+	return l.Refresh(ctx, e.ttl)
+}
+
+func (e *Elector) stepDown(release bool) {
+	e.mu.Lock()
+	if !e.leading {
+		e.mu.Unlock()
+		return
+	}
+
+	l, stopLeading := e.lock, e.stopLeading
+	e.leading = false
+	e.leaderSince = time.Time{}
+	e.lock = nil
+	e.stopLeading = nil
+	e.mu.Unlock()
+
+	if stopLeading != nil {
+		stopLeading()
+	}
+
+	if release && l != nil {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+		_ = l.Release(releaseCtx)
+		cancel()
+	}
+
+	e.log.Infof("instance [%s] stepped down from leader of [%s]", e.id, e.key)
+
+	e.callbackMu.Lock()
+	callbacks := append([]func(){}, e.onStopped...)
+	e.callbackMu.Unlock()
+
+	for _, fn := range callbacks {
+		fn()
+	}
+}
+
+func defaultInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "unknown"
+	}
+	return fmt.Sprintf("%s-%d-%s", host, os.Getpid(), newToken()[:8])
+}
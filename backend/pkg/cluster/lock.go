@@ -0,0 +1,166 @@
+package cluster
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	// ErrNotObtained 锁已被其他实例持有
+	ErrNotObtained = errors.New("cluster: lock not obtained")
+	// ErrLockLost 锁已过期或被其他实例持有
+	ErrLockLost = errors.New("cluster: lock lost")
+)
+
+// 只有持有者才能续期和释放，防止误删其他实例的锁
+var (
+	refreshScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+	releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// Locker 基于 Redis 的分布式锁
+type Locker struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+// NewLocker 创建分布式锁，prefix 为锁在 Redis 中的键前缀
+func NewLocker(rdb redis.UniversalClient, prefix string) *Locker {
+	return &Locker{
+		rdb:    rdb,
+		prefix: prefix,
+	}
+}
+
+// Lock 已获取的锁
+type Lock struct {
+	rdb   redis.UniversalClient
+	key   string
+	token string
+}
+
+// Key 返回锁在 Redis 中的键
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// Token 返回锁的持有者标识
+func (l *Lock) Token() string {
+	return l.token
+}
+
+// TryObtain 尝试获取锁，锁已被持有时立即返回 ErrNotObtained
+func (lk *Locker) TryObtain(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	return lk.obtain(ctx, key, newToken(), ttl)
+}
+
+// Obtain 获取锁，锁已被持有时每隔 retry 重试一次，直到获取成功或 ctx 结束
+func (lk *Locker) Obtain(ctx context.Context, key string, ttl, retry time.Duration) (*Lock, error) {
+	token := newToken()
+
+	ticker := time.NewTicker(retry)
+	defer ticker.Stop()
+
+	for {
+		l, err := lk.obtain(ctx, key, token, ttl)
+		if !errors.Is(err, ErrNotObtained) {
+			return l, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (lk *Locker) obtain(ctx context.Context, key, token string, ttl time.Duration) (*Lock, error) {
+	fullKey := lk.prefix + key
+
+	ok, err := lk.rdb.SetNX(ctx, fullKey, token, ttl).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNotObtained
+	}
+
+	return &Lock{rdb: lk.rdb, key: fullKey, token: token}, nil
+}
+
+// WithLock 在持有锁的情况下执行 fn，执行完成后释放锁。
+// 锁在 fn 执行期间会自动续期，fn 执行时间可以超过 ttl。
+func (lk *Locker) WithLock(ctx context.Context, key string, ttl time.Duration, fn func(ctx context.Context) error) error {
+	l, err := lk.Obtain(ctx, key, ttl, ttl/10+time.Millisecond)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = l.Release(context.Background()) }()
+
+	fnCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		ticker := time.NewTicker(ttl / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-fnCtx.Done():
+				return
+			case <-ticker.C:
+				if err := l.Refresh(fnCtx, ttl); err != nil {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+
+	return fn(fnCtx)
+}
+
+// Refresh 续期，锁已丢失时返回 ErrLockLost
+func (l *Lock) Refresh(ctx context.Context, ttl time.Duration) error {
+	res, err := refreshScript.Run(ctx, l.rdb, []string{l.key}, l.token, ttl.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+// Release 释放锁，锁已丢失时返回 ErrLockLost
+func (l *Lock) Release(ctx context.Context) error {
+	res, err := releaseScript.Run(ctx, l.rdb, []string{l.key}, l.token).Int()
+	if err != nil {
+		return err
+	}
+	if res == 0 {
+		return ErrLockLost
+	}
+	return nil
+}
+
+func newToken() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -46,6 +46,25 @@ func (v UserViewer) Tenant() (uint32, bool) {
 	return 0, false
 }
 
+// systemViewer 系统上下文的查看者，用于后台任务、启动初始化与登录等没有登录用户的场景，不受租户隔离限制
+type systemViewer struct{}
+
+func (systemViewer) Admin() bool {
+	return true
+}
+
+func (systemViewer) SystemAdmin() bool {
+	return true
+}
+
+func (systemViewer) TenantAdmin() bool {
+	return false
+}
+
+func (systemViewer) Tenant() (uint32, bool) {
+	return 0, false
+}
+
 type ctxKey struct{}
 
 // FromContext returns the Viewer stored in a context.
@@ -58,3 +77,21 @@ func FromContext(ctx context.Context) Viewer {
 func NewContext(parent context.Context, v Viewer) context.Context {
 	return context.WithValue(parent, ctxKey{}, v)
 }
+
+// NewSystemViewerContext 返回附加了系统查看者的上下文。
+// 没有查看者的上下文访问租户数据时会被拒绝，后台任务与启动初始化需要显式使用系统上下文
+func NewSystemViewerContext(parent context.Context) context.Context {
+	return NewContext(parent, systemViewer{})
+}
+
+// NewTenantViewerContext 返回附加了租户管理员查看者的上下文，后台任务代表某个租户执行时使用，
+// 读写的数据按该租户隔离，创建的数据归属该租户
+func NewTenantViewerContext(parent context.Context, tenantId uint32) context.Context {
+	return NewContext(parent, UserViewer{TenantId: &tenantId, Authority: userV1.User_TENANT_ADMIN})
+}
+
+// IsSystemContext 上下文是否为系统上下文
+func IsSystemContext(ctx context.Context) bool {
+	_, ok := FromContext(ctx).(systemViewer)
+	return ok
+}
@@ -0,0 +1,43 @@
+package viewer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	userV1 "go-wind-admin/api/gen/go/user/service/v1"
+)
+
+func TestSystemViewerContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Nil(t, FromContext(ctx))
+	assert.False(t, IsSystemContext(ctx))
+
+	sys := NewSystemViewerContext(ctx)
+	assert.True(t, IsSystemContext(sys))
+	assert.True(t, FromContext(sys).SystemAdmin())
+	_, ok := FromContext(sys).Tenant()
+	assert.False(t, ok)
+
+	// 系统管理员用户不是系统上下文
+	tid := uint32(1)
+	user := NewContext(ctx, UserViewer{TenantId: &tid, Authority: userV1.User_SYS_ADMIN})
+	assert.False(t, IsSystemContext(user))
+	assert.True(t, FromContext(user).SystemAdmin())
+
+	tenant, ok := FromContext(NewContext(ctx, UserViewer{TenantId: &tid, Authority: userV1.User_CUSTOMER_USER})).Tenant()
+	assert.True(t, ok)
+	assert.Equal(t, tid, tenant)
+}
+
+func TestTenantViewerContext(t *testing.T) {
+	ctx := NewTenantViewerContext(NewSystemViewerContext(context.Background()), 2)
+	assert.False(t, IsSystemContext(ctx))
+	assert.False(t, FromContext(ctx).SystemAdmin())
+	assert.True(t, FromContext(ctx).TenantAdmin())
+
+	tenant, ok := FromContext(ctx).Tenant()
+	assert.True(t, ok)
+	assert.Equal(t, uint32(2), tenant)
+}
@@ -1,5 +1,7 @@
 package eventbus
 
+import "time"
+
 // Common event types
 const (
 	// Email events
@@ -25,6 +27,13 @@ const (
 	EventTaskFailed      = "task.failed"
 	EventTaskCancelled   = "task.cancelled"
 
+	// Tenant events
+	EventTenantStatusChanged  = "tenant.status_changed"
+	EventTenantExpiring       = "tenant.expiring"
+	EventTenantProvisioned    = "tenant.provisioned"
+	EventTenantOffboarded     = "tenant.offboarded"
+	EventTenantUsageThreshold = "tenant.usage_threshold"
+
 	// System events
 	EventSystemStarted   = "system.started"
 	EventSystemStopped   = "system.stopped"
@@ -74,3 +83,48 @@ type SystemErrorEvent struct {
 	Error     string `json:"error"`
 	Severity  string `json:"severity"`
 }
+
+// TenantStatusChangedEvent represents a tenant status or audit status transition
+type TenantStatusChangedEvent struct {
+	TenantID            uint32 `json:"tenant_id"`
+	Status              string `json:"status"`
+	PreviousStatus      string `json:"previous_status"`
+	AuditStatus         string `json:"audit_status,omitempty"`
+	PreviousAuditStatus string `json:"previous_audit_status,omitempty"`
+	OperatorID          uint32 `json:"operator_id,omitempty"`
+	Reason              string `json:"reason,omitempty"`
+}
+
+// TenantExpiringEvent represents a tenant that will expire soon
+type TenantExpiringEvent struct {
+	TenantID  uint32    `json:"tenant_id"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+// TenantProvisionedEvent represents a tenant created together with its admin user and template data
+type TenantProvisionedEvent struct {
+	TenantID    uint32 `json:"tenant_id"`
+	AdminUserID uint32 `json:"admin_user_id"`
+	Template    string `json:"template,omitempty"`
+	OperatorID  uint32 `json:"operator_id,omitempty"`
+}
+
+// TenantOffboardedEvent represents a tenant that was archived or purged
+type TenantOffboardedEvent struct {
+	TenantID   uint32            `json:"tenant_id"`
+	Mode       string            `json:"mode"`
+	Affected   map[string]uint32 `json:"affected,omitempty"`
+	OperatorID uint32            `json:"operator_id,omitempty"`
+	Reason     string            `json:"reason,omitempty"`
+}
+
+// TenantUsageThresholdEvent represents a tenant whose metered usage crossed a percentage of its plan quota
+type TenantUsageThresholdEvent struct {
+	TenantID    uint32    `json:"tenant_id"`
+	PlanID      uint32    `json:"plan_id"`
+	Metric      string    `json:"metric"`
+	Threshold   uint32    `json:"threshold"`
+	Used        uint64    `json:"used"`
+	Limit       uint64    `json:"limit"`
+	PeriodStart time.Time `json:"period_start"`
+}
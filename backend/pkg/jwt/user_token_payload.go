@@ -2,6 +2,7 @@ package jwt
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/golang-jwt/jwt/v5"
@@ -22,6 +23,11 @@ const (
 	ClaimFieldAuthority = "aut"                   // 用户权限
 	//ClaimFieldRoleIds   = "rid"                   // 角色ID列表
 	ClaimFieldRoleCodes = "roc" // 角色码列表
+
+	ClaimFieldActUserID   = "act" // 代管操作人的用户ID
+	ClaimFieldActUsername = "acn" // 代管操作人的用户名
+	ClaimFieldActAuditID  = "aai" // 代管审计日志ID，代管原因只记录在审计日志中
+	ClaimFieldExpiresAt   = "exp" // 过期时间
 )
 
 // NewUserTokenPayload 创建用户令牌
@@ -47,6 +53,27 @@ func NewUserTokenAuthClaims(user *userV1.User, clientId string) *authn.AuthClaim
 	}
 }
 
+// NewImpersonationAuthClaims 创建代管令牌的声明：以 user 的身份访问，同时记录代管操作人 actor 与代管的审计日志ID，到 expiresAt 过期
+func NewImpersonationAuthClaims(
+	user *userV1.User,
+	actor *authenticationV1.UserTokenPayload,
+	clientId string,
+	auditId uint32,
+	expiresAt time.Time,
+) *authn.AuthClaims {
+	claims := NewUserTokenAuthClaims(user, clientId)
+	(*claims)[ClaimFieldActUserID] = actor.GetUserId()
+	(*claims)[ClaimFieldActUsername] = actor.GetUsername()
+	(*claims)[ClaimFieldActAuditID] = auditId
+	(*claims)[ClaimFieldExpiresAt] = expiresAt.Unix()
+	return claims
+}
+
+// IsImpersonated 是否为代管令牌
+func IsImpersonated(payload *authenticationV1.UserTokenPayload) bool {
+	return payload.GetActUserId() != 0
+}
+
 func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.UserTokenPayload, error) {
 	payload := &authenticationV1.UserTokenPayload{}
 
@@ -101,6 +128,27 @@ func NewUserTokenPayloadWithClaims(claims *authn.AuthClaims) (*authenticationV1.
 		payload.Roles = roleCodes
 	}
 
+	// 只有代管令牌才有代管声明
+	if _, ok := (*claims)[ClaimFieldActUserID]; ok {
+		actUserId, err := claims.GetUint32(ClaimFieldActUserID)
+		if err != nil {
+			log.Errorf("GetUint32 ClaimFieldActUserID failed: %v", err)
+		}
+		if actUserId != 0 {
+			payload.ActUserId = trans.Ptr(actUserId)
+		}
+
+		actUsername, _ := claims.GetString(ClaimFieldActUsername)
+		if actUsername != "" {
+			payload.ActUsername = trans.Ptr(actUsername)
+		}
+
+		actAuditId, _ := claims.GetUint32(ClaimFieldActAuditID)
+		if actAuditId != 0 {
+			payload.ActAuditId = trans.Ptr(actAuditId)
+		}
+	}
+
 	return payload, nil
 }
 
@@ -154,5 +202,20 @@ func NewUserTokenPayloadWithJwtMapClaims(claims jwt.MapClaims) (*authenticationV
 		}
 	}
 
+	actUserId, _ := claims[ClaimFieldActUserID]
+	if actUserId != nil {
+		payload.ActUserId = trans.Ptr(uint32(actUserId.(float64)))
+	}
+
+	actUsername, _ := claims[ClaimFieldActUsername]
+	if actUsername != nil {
+		payload.ActUsername = trans.Ptr(actUsername.(string))
+	}
+
+	actAuditId, _ := claims[ClaimFieldActAuditID]
+	if actAuditId != nil {
+		payload.ActAuditId = trans.Ptr(uint32(actAuditId.(float64)))
+	}
+
 	return payload, nil
 }
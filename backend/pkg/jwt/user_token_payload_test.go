@@ -2,11 +2,13 @@ package jwt
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/tx7do/go-utils/trans"
 	authn "github.com/tx7do/kratos-authn/engine"
 
+	authenticationV1 "go-wind-admin/api/gen/go/authentication/service/v1"
 	userV1 "go-wind-admin/api/gen/go/user/service/v1"
 )
 
@@ -71,3 +73,35 @@ func TestExtractAuthClaims(t *testing.T) {
 	assert.Equal(t, clientId, payload.GetClientId())
 	assert.Equal(t, user.GetAuthority(), payload.GetAuthority())
 }
+
+func TestImpersonationAuthClaims(t *testing.T) {
+	user := userV1.User{
+		Id:        trans.Ptr(uint32(10)),
+		TenantId:  trans.Ptr(uint32(2)),
+		Username:  trans.Ptr("tenant_admin"),
+		Authority: trans.Ptr(userV1.User_TENANT_ADMIN),
+	}
+	actor := &authenticationV1.UserTokenPayload{
+		UserId:    1,
+		Username:  trans.Ptr("admin"),
+		Authority: userV1.User_SYS_ADMIN,
+	}
+	expiresAt := time.Now().Add(30 * time.Minute)
+
+	claims := NewImpersonationAuthClaims(&user, actor, "client_123", 42, expiresAt)
+	assert.Equal(t, expiresAt.Unix(), (*claims)[ClaimFieldExpiresAt])
+
+	payload, err := NewUserTokenPayloadWithClaims(claims)
+	assert.NoError(t, err)
+	assert.True(t, IsImpersonated(payload))
+	assert.Equal(t, user.GetId(), payload.GetUserId())
+	assert.Equal(t, user.GetTenantId(), payload.GetTenantId())
+	assert.Equal(t, actor.GetUserId(), payload.GetActUserId())
+	assert.Equal(t, actor.GetUsername(), payload.GetActUsername())
+	assert.Equal(t, uint32(42), payload.GetActAuditId())
+
+	// 普通令牌没有代管声明
+	payload, err = NewUserTokenPayloadWithClaims(NewUserTokenAuthClaims(&user, "client_123"))
+	assert.NoError(t, err)
+	assert.False(t, IsImpersonated(payload))
+}
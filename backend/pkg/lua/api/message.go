@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/msgtemplate"
+)
+
+// MessageSender sends internal messages rendered from message templates
+type MessageSender interface {
+	// SendTemplate sends a template message to the users and returns the message id
+	SendTemplate(ctx context.Context, code string, userIds []uint32, variables map[string]string) (uint32, error)
+	// RenderTemplate renders a template in the given language without recipient information
+	RenderTemplate(ctx context.Context, code, language string, variables map[string]string) (*msgtemplate.Result, error)
+}
+
+// RegisterMessage registers the internal message API for Lua as a requireable module
+func RegisterMessage(L *lua.LState, sender MessageSender, logger *log.Helper) {
+	// Create loader function that returns the module
+	loader := func(L *lua.LState) int {
+		// Create message module
+		messageModule := L.NewTable()
+
+		// message.send_template(code, user_ids, variables)
+		// Returns: message_id or nil, error
+		messageModule.RawSetString("send_template", L.NewFunction(func(L *lua.LState) int {
+			code := L.CheckString(1)
+			userIds := luaUserIds(L.CheckTable(2))
+			variables := luaVariables(L.OptTable(3, nil))
+
+			messageId, err := sender.SendTemplate(luaContext(L), code, userIds, variables)
+			if err != nil {
+				logger.Errorf("message.send_template error: %v", err)
+				L.Push(lua.LNil)
+				L.Push(lua.LString(err.Error()))
+				return 2
+			}
+
+			L.Push(lua.LNumber(messageId))
+			return 1
+		}))
+
+		// message.render_template(code, language, variables)
+		// Returns: {language, subject, body} or nil, error
+		messageModule.RawSetString("render_template", L.NewFunction(func(L *lua.LState) int {
+			code := L.CheckString(1)
+			language := L.OptString(2, "")
+			variables := luaVariables(L.OptTable(3, nil))
+
+			result, err := sender.RenderTemplate(luaContext(L), code, language, variables)
+			if err != nil {
+				logger.Errorf("message.render_template error: %v", err)
+				L.Push(lua.LNil)
+				L.Push(lua.LString(err.Error()))
+				return 2
+			}
+
+			resultTable := L.NewTable()
+			resultTable.RawSetString("language", lua.LString(result.Language))
+			resultTable.RawSetString("subject", lua.LString(result.Subject))
+			resultTable.RawSetString("body", lua.LString(result.Body))
+
+			L.Push(resultTable)
+			return 1
+		}))
+
+		L.Push(messageModule)
+		return 1
+	}
+
+	// Register in package.preload
+	L.PreloadModule("kratos_message", loader)
+}
+
+// luaContext returns the execution context of the VM
+func luaContext(L *lua.LState) context.Context {
+	if ctx := L.Context(); ctx != nil {
+		return ctx
+	}
+	return context.Background()
+}
+
+// luaUserIds converts an array of user ids, non-numeric items are ignored
+func luaUserIds(tbl *lua.LTable) []uint32 {
+	userIds := make([]uint32, 0, tbl.Len())
+	tbl.ForEach(func(_, v lua.LValue) {
+		if n, ok := v.(lua.LNumber); ok && n > 0 {
+			userIds = append(userIds, uint32(n))
+		}
+	})
+	return userIds
+}
+
+// luaVariables converts a table of template variables, values are converted to strings
+func luaVariables(tbl *lua.LTable) map[string]string {
+	if tbl == nil {
+		return nil
+	}
+
+	variables := make(map[string]string)
+	tbl.ForEach(func(k, v lua.LValue) {
+		if key, ok := k.(lua.LString); ok {
+			variables[string(key)] = v.String()
+		}
+	})
+	return variables
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	lua "github.com/yuin/gopher-lua"
+
+	"go-wind-admin/pkg/msgtemplate"
+)
+
+type fakeMessageSender struct {
+	code      string
+	userIds   []uint32
+	variables map[string]string
+}
+
+func (s *fakeMessageSender) SendTemplate(_ context.Context, code string, userIds []uint32, variables map[string]string) (uint32, error) {
+	if code == "missing" {
+		return 0, errors.New("message template not found")
+	}
+
+	s.code = code
+	s.userIds = userIds
+	s.variables = variables
+	return 42, nil
+}
+
+func (s *fakeMessageSender) RenderTemplate(_ context.Context, code, language string, variables map[string]string) (*msgtemplate.Result, error) {
+	return &msgtemplate.Result{
+		Language: language,
+		Subject:  code + ":" + variables["order_no"],
+		Body:     "body",
+	}, nil
+}
+
+func TestMessageAPI_SendTemplate(t *testing.T) {
+	L := lua.NewState()
+	defer L.Close()
+
+	sender := &fakeMessageSender{}
+	RegisterMessage(L, sender, log.NewHelper(log.DefaultLogger))
+
+	script := `
+		local message = require "kratos_message"
+
+		local id, err = message.send_template("order_shipped", {1, 2}, {order_no = "A001", count = 3})
+		assert(err == nil, "send_template should succeed")
+		assert(id == 42, "message id should be returned")
+
+		local missing, err2 = message.send_template("missing", {1})
+		assert(missing == nil, "missing template should fail")
+		assert(err2 ~= nil, "error should be returned")
+
+		local result = message.render_template("order_shipped", "zh-CN", {order_no = "A002"})
+		assert(result.language == "zh-CN")
+		assert(result.subject == "order_shipped:A002")
+		assert(result.body == "body")
+
+		return true
+	`
+
+	err := L.DoString(script)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "order_shipped", sender.code)
+	assert.Equal(t, []uint32{1, 2}, sender.userIds)
+	assert.Equal(t, map[string]string{"order_no": "A001", "count": "3"}, sender.variables)
+}
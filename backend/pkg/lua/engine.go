@@ -34,6 +34,7 @@ type Engine struct {
 	rdb             *redis.Client              // Redis client for cache operations
 	eventbusManager *eventbus.Manager          // EventBus manager
 	ossClient       *oss.MinIOClient           // OSS/MinIO client
+	messageSender   api.MessageSender          // Internal message sender
 	callbacks       map[string][]*CallbackInfo // Hook callbacks (hook name -> multiple callbacks)
 	dedicatedVMs    map[*lua.LState]bool       // VMs that should not be pooled
 	mu              sync.RWMutex
@@ -208,6 +209,11 @@ func (e *Engine) registerAPIs(L *lua.LState) {
 		api.RegisterOSS(L, e.ossClient, e.logger)
 	}
 
+	// Register message API if sender is available
+	if e.messageSender != nil {
+		api.RegisterMessage(L, e.messageSender, e.logger)
+	}
+
 	// Register Crypto API (always available - uses global encryptor)
 	api.RegisterCrypto(L, e.logger)
 
@@ -604,6 +610,14 @@ func (e *Engine) SetOSS(client *oss.MinIOClient) {
 	e.logger.Info("OSS client configured for Lua OSS API")
 }
 
+// SetMessageSender sets the sender for internal message operations
+func (e *Engine) SetMessageSender(sender api.MessageSender) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.messageSender = sender
+	e.logger.Info("Message sender configured for Lua message API")
+}
+
 // setContext sets the execution context in the VM
 func (e *Engine) setContext(L *lua.LState, ctx *Context) error {
 	// Store context as upvalue for API functions
@@ -0,0 +1,115 @@
+package metering
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// apiCallsModuleColumnPrefix 按模块拆分的API调用次数的列名前缀
+const apiCallsModuleColumnPrefix = "api_calls:"
+
+// WriteSummariesCSV 把计费周期内各租户的用量汇总写为CSV，每个租户一行，names 为租户ID到租户名称的映射
+func WriteSummariesCSV(w io.Writer, period Period, summaries []*Summary, names map[uint32]string) error {
+	byModules := make([]map[string]uint64, 0, len(summaries))
+	for _, s := range summaries {
+		byModules = append(byModules, s.ApiCallsByModule)
+	}
+	modules := collectModules(byModules)
+
+	header := []string{
+		"period_start", "period_end", "tenant_id", "tenant_name", "hours",
+		"peak_active_users", "api_calls", "peak_storage_bytes", "storage_byte_hours", "messages_sent",
+	}
+	for _, module := range modules {
+		header = append(header, apiCallsModuleColumnPrefix+module)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, s := range summaries {
+		row := []string{
+			period.Start.Format(time.RFC3339),
+			period.End.Format(time.RFC3339),
+			strconv.FormatUint(uint64(s.TenantId), 10),
+			names[s.TenantId],
+			strconv.FormatUint(uint64(s.Hours), 10),
+			strconv.FormatUint(uint64(s.PeakActiveUsers), 10),
+			strconv.FormatUint(s.ApiCalls, 10),
+			strconv.FormatUint(s.PeakStorageBytes, 10),
+			strconv.FormatUint(s.StorageByteHours, 10),
+			strconv.FormatUint(s.MessagesSent, 10),
+		}
+		for _, module := range modules {
+			row = append(row, strconv.FormatUint(s.ApiCallsByModule[module], 10))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteRecordsCSV 把每小时的用量记录写为CSV，每条记录一行，names 为租户ID到租户名称的映射
+func WriteRecordsCSV(w io.Writer, records []*Record, names map[uint32]string) error {
+	byModules := make([]map[string]uint64, 0, len(records))
+	for _, r := range records {
+		byModules = append(byModules, r.ApiCallsByModule)
+	}
+	modules := collectModules(byModules)
+
+	header := []string{
+		"period_start", "tenant_id", "tenant_name",
+		"active_users", "api_calls", "storage_bytes", "messages_sent",
+	}
+	for _, module := range modules {
+		header = append(header, apiCallsModuleColumnPrefix+module)
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		row := []string{
+			r.PeriodStart.Format(time.RFC3339),
+			strconv.FormatUint(uint64(r.TenantId), 10),
+			names[r.TenantId],
+			strconv.FormatUint(uint64(r.ActiveUsers), 10),
+			strconv.FormatUint(r.ApiCalls, 10),
+			strconv.FormatUint(r.StorageBytes, 10),
+			strconv.FormatUint(r.MessagesSent, 10),
+		}
+		for _, module := range modules {
+			row = append(row, strconv.FormatUint(r.ApiCallsByModule[module], 10))
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// collectModules 收集所有出现过的模块名并排序，作为CSV中按模块拆分的列
+func collectModules(byModules []map[string]uint64) []string {
+	var modules []string
+	for _, byModule := range byModules {
+		for module := range byModule {
+			if !slices.Contains(modules, module) {
+				modules = append(modules, module)
+			}
+		}
+	}
+	slices.Sort(modules)
+	return modules
+}
@@ -0,0 +1,145 @@
+package metering
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// Metric 计量指标
+type Metric string
+
+const (
+	// MetricActiveUsers 活跃用户数：一小时内发起过请求的不同用户数
+	MetricActiveUsers Metric = "active_users"
+	// MetricApiCalls API调用次数
+	MetricApiCalls Metric = "api_calls"
+	// MetricStorageBytes 文件占用的存储空间（字节），整点时的快照
+	MetricStorageBytes Metric = "storage_bytes"
+	// MetricMessagesSent 发送的站内信数量，每个接收者计一条
+	MetricMessagesSent Metric = "messages_sent"
+)
+
+// Metrics 所有计量指标
+var Metrics = []Metric{MetricActiveUsers, MetricApiCalls, MetricStorageBytes, MetricMessagesSent}
+
+// Record 租户一小时的用量
+type Record struct {
+	TenantId    uint32    `json:"tenant_id"`
+	PeriodStart time.Time `json:"period_start"`
+
+	ActiveUsers      uint32            `json:"active_users"`
+	ApiCalls         uint64            `json:"api_calls"`
+	ApiCallsByModule map[string]uint64 `json:"api_calls_by_module,omitempty"`
+	StorageBytes     uint64            `json:"storage_bytes"`
+	MessagesSent     uint64            `json:"messages_sent"`
+}
+
+// Empty 是否没有任何用量，没有用量的小时不保存记录
+func (r *Record) Empty() bool {
+	return r.ActiveUsers == 0 && r.ApiCalls == 0 && r.StorageBytes == 0 && r.MessagesSent == 0
+}
+
+// Value 指标的值
+func (r *Record) Value(metric Metric) uint64 {
+	switch metric {
+	case MetricActiveUsers:
+		return uint64(r.ActiveUsers)
+	case MetricApiCalls:
+		return r.ApiCalls
+	case MetricStorageBytes:
+		return r.StorageBytes
+	case MetricMessagesSent:
+		return r.MessagesSent
+	default:
+		return 0
+	}
+}
+
+// Summary 租户在计费周期内的用量汇总
+type Summary struct {
+	TenantId uint32 `json:"tenant_id"`
+
+	// Hours 有用量记录的小时数
+	Hours uint32 `json:"hours"`
+
+	// PeakActiveUsers 单个小时内的最大活跃用户数
+	PeakActiveUsers uint32 `json:"peak_active_users"`
+
+	ApiCalls         uint64            `json:"api_calls"`
+	ApiCallsByModule map[string]uint64 `json:"api_calls_by_module,omitempty"`
+
+	// PeakStorageBytes 最大的存储空间快照
+	PeakStorageBytes uint64 `json:"peak_storage_bytes"`
+	// StorageByteHours 每小时存储空间快照之和，按字节·小时计费时使用
+	StorageByteHours uint64 `json:"storage_byte_hours"`
+
+	MessagesSent uint64 `json:"messages_sent"`
+}
+
+// Summarize 按租户汇总用量记录，结果按租户ID排序
+func Summarize(records []*Record) []*Summary {
+	byTenant := make(map[uint32]*Summary)
+	for _, r := range records {
+		s, ok := byTenant[r.TenantId]
+		if !ok {
+			s = &Summary{TenantId: r.TenantId}
+			byTenant[r.TenantId] = s
+		}
+
+		s.Hours++
+		s.PeakActiveUsers = max(s.PeakActiveUsers, r.ActiveUsers)
+		s.ApiCalls += r.ApiCalls
+		s.PeakStorageBytes = max(s.PeakStorageBytes, r.StorageBytes)
+		s.StorageByteHours += r.StorageBytes
+		s.MessagesSent += r.MessagesSent
+
+		for module, n := range r.ApiCallsByModule {
+			if s.ApiCallsByModule == nil {
+				s.ApiCallsByModule = make(map[string]uint64)
+			}
+			s.ApiCallsByModule[module] += n
+		}
+	}
+
+	summaries := make([]*Summary, 0, len(byTenant))
+	for _, s := range byTenant {
+		summaries = append(summaries, s)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].TenantId < summaries[j].TenantId
+	})
+
+	return summaries
+}
+
+// TruncateHour 取整到所在小时的开始，按本地时区计算
+func TruncateHour(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, time.Local)
+}
+
+// TruncateDay 取整到所在日期的零点，按本地时区计算
+func TruncateDay(t time.Time) time.Time {
+	t = t.Local()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// OperationId 把接口的 operation（如 /admin.service.v1.UserService/List）转换为 OpenAPI 的 operationId（如 UserService_List），
+// API资源表中保存的是 operationId
+func OperationId(operation string) string {
+	operation = strings.TrimPrefix(operation, "/")
+
+	method := ""
+	if i := strings.LastIndex(operation, "/"); i >= 0 {
+		operation, method = operation[:i], operation[i+1:]
+	}
+	if i := strings.LastIndex(operation, "."); i >= 0 {
+		operation = operation[i+1:]
+	}
+
+	if method == "" {
+		return operation
+	}
+	return operation + "_" + method
+}
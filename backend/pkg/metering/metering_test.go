@@ -0,0 +1,162 @@
+package metering
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarize(t *testing.T) {
+	hour := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+
+	records := []*Record{
+		{
+			TenantId: 2, PeriodStart: hour,
+			ActiveUsers: 3, ApiCalls: 10, StorageBytes: 100, MessagesSent: 1,
+			ApiCallsByModule: map[string]uint64{"UserService": 6, "TaskService": 4},
+		},
+		{
+			TenantId: 1, PeriodStart: hour,
+			ActiveUsers: 1, ApiCalls: 2, StorageBytes: 50,
+			ApiCallsByModule: map[string]uint64{"UserService": 2},
+		},
+		{
+			TenantId: 2, PeriodStart: hour.Add(time.Hour),
+			ActiveUsers: 5, ApiCalls: 20, StorageBytes: 80, MessagesSent: 2,
+			ApiCallsByModule: map[string]uint64{"UserService": 20},
+		},
+	}
+
+	summaries := Summarize(records)
+	require.Len(t, summaries, 2)
+
+	assert.Equal(t, uint32(1), summaries[0].TenantId)
+	assert.Equal(t, uint32(1), summaries[0].Hours)
+
+	s := summaries[1]
+	assert.Equal(t, uint32(2), s.TenantId)
+	assert.Equal(t, uint32(2), s.Hours)
+	assert.Equal(t, uint32(5), s.PeakActiveUsers)
+	assert.Equal(t, uint64(30), s.ApiCalls)
+	assert.Equal(t, map[string]uint64{"UserService": 26, "TaskService": 4}, s.ApiCallsByModule)
+	assert.Equal(t, uint64(100), s.PeakStorageBytes)
+	assert.Equal(t, uint64(180), s.StorageByteHours)
+	assert.Equal(t, uint64(3), s.MessagesSent)
+}
+
+func TestRecord(t *testing.T) {
+	assert.True(t, (&Record{TenantId: 1}).Empty())
+
+	r := &Record{ActiveUsers: 1, ApiCalls: 2, StorageBytes: 3, MessagesSent: 4}
+	assert.False(t, r.Empty())
+	assert.Equal(t, uint64(1), r.Value(MetricActiveUsers))
+	assert.Equal(t, uint64(2), r.Value(MetricApiCalls))
+	assert.Equal(t, uint64(3), r.Value(MetricStorageBytes))
+	assert.Equal(t, uint64(4), r.Value(MetricMessagesSent))
+	assert.Equal(t, uint64(0), r.Value("unknown"))
+}
+
+func TestOperationId(t *testing.T) {
+	assert.Equal(t, "UserService_List", OperationId("/admin.service.v1.UserService/List"))
+	assert.Equal(t, "TaskService_RunTask", OperationId("/admin.service.v1.TaskService/RunTask"))
+	assert.Equal(t, "RouterService", OperationId("RouterService"))
+}
+
+func TestParsePeriod(t *testing.T) {
+	now := time.Date(2026, 10, 19, 15, 30, 0, 0, time.Local)
+
+	p, err := ParsePeriod("", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local), p.Start)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, time.Local), p.End)
+	assert.Equal(t, "2026-10", p.Name())
+
+	p, err = ParsePeriod("2026-12", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.Local), p.End)
+
+	p, err = ParsePeriod("2026-10-05", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 5, 0, 0, 0, 0, time.Local), p.Start)
+	assert.Equal(t, time.Date(2026, 10, 6, 0, 0, 0, 0, time.Local), p.End)
+	assert.Equal(t, "2026-10-05", p.Name())
+
+	_, err = ParsePeriod("2026/10", now)
+	assert.Error(t, err)
+}
+
+func TestCrossed(t *testing.T) {
+	thresholds := []uint32{80, 100}
+
+	assert.Nil(t, Crossed(0, 1000, 0, thresholds))
+	assert.Nil(t, Crossed(90, 80, 100, thresholds))
+	assert.Nil(t, Crossed(10, 20, 100, thresholds))
+
+	assert.Equal(t, []uint32{80}, Crossed(70, 80, 100, thresholds))
+	assert.Equal(t, []uint32{100}, Crossed(85, 120, 100, thresholds))
+	assert.Equal(t, []uint32{80, 100}, Crossed(0, 100, 100, thresholds))
+
+	// 已经越过的阈值不会再次触发
+	assert.Nil(t, Crossed(100, 150, 100, thresholds))
+
+	// 配额很小时阈值向上取整
+	assert.Equal(t, []uint32{80}, Crossed(0, 1, 1, []uint32{80}))
+}
+
+func TestConfigThresholds(t *testing.T) {
+	var cfg *Config
+	assert.Equal(t, DefaultThresholds, cfg.GetThresholds())
+
+	cfg = &Config{Thresholds: []uint32{100, 0, 50, 100}}
+	assert.Equal(t, []uint32{50, 100}, cfg.GetThresholds())
+}
+
+func TestWriteCSV(t *testing.T) {
+	hour := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+
+	records := []*Record{
+		{
+			TenantId: 1, PeriodStart: hour,
+			ActiveUsers: 2, ApiCalls: 5, StorageBytes: 10, MessagesSent: 1,
+			ApiCallsByModule: map[string]uint64{"UserService": 3, "FileService": 2},
+		},
+		{
+			TenantId: 1, PeriodStart: hour.Add(time.Hour),
+			ApiCalls: 1, StorageBytes: 10,
+			ApiCallsByModule: map[string]uint64{"UserService": 1},
+		},
+	}
+	names := map[uint32]string{1: "Acme, Inc."}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteRecordsCSV(&buf, records, names))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	assert.Equal(t, []string{
+		"period_start", "tenant_id", "tenant_name",
+		"active_users", "api_calls", "storage_bytes", "messages_sent",
+		"api_calls:FileService", "api_calls:UserService",
+	}, rows[0])
+	assert.Equal(t, []string{"2026-10-01T08:00:00Z", "1", "Acme, Inc.", "2", "5", "10", "1", "2", "3"}, rows[1])
+	assert.Equal(t, []string{"2026-10-01T09:00:00Z", "1", "Acme, Inc.", "0", "1", "10", "0", "0", "1"}, rows[2])
+
+	period := Period{Start: hour, End: hour.AddDate(0, 1, 0)}
+
+	buf.Reset()
+	require.NoError(t, WriteSummariesCSV(&buf, period, Summarize(records), names))
+
+	rows, err = csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, "api_calls:UserService", rows[0][len(rows[0])-1])
+	assert.Equal(t, []string{
+		"2026-10-01T08:00:00Z", "2026-11-01T08:00:00Z", "1", "Acme, Inc.", "2",
+		"2", "6", "10", "20", "1", "2", "4",
+	}, rows[1])
+}
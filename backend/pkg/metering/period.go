@@ -0,0 +1,49 @@
+package metering
+
+import (
+	"errors"
+	"time"
+)
+
+// periodMonthLayout 按月的计费周期格式
+const periodMonthLayout = "2006-01"
+
+// Period 计费周期 [Start, End)
+type Period struct {
+	Start time.Time
+	End   time.Time
+}
+
+// CurrentPeriod 当前所在自然月的计费周期
+func CurrentPeriod(now time.Time) Period {
+	now = now.Local()
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	return Period{Start: start, End: start.AddDate(0, 1, 0)}
+}
+
+// ParsePeriod 解析计费周期：YYYY-MM 表示一个自然月，YYYY-MM-DD 表示一天，为空时为当前自然月
+func ParsePeriod(value string, now time.Time) (Period, error) {
+	if value == "" {
+		return CurrentPeriod(now), nil
+	}
+
+	if start, err := time.ParseInLocation(periodMonthLayout, value, time.Local); err == nil {
+		return Period{Start: start, End: start.AddDate(0, 1, 0)}, nil
+	}
+	if start, err := time.ParseInLocation(time.DateOnly, value, time.Local); err == nil {
+		return Period{Start: start, End: start.AddDate(0, 0, 1)}, nil
+	}
+
+	return Period{}, errors.New("period must be YYYY-MM or YYYY-MM-DD")
+}
+
+// Name 计费周期的名称，用作导出的文件名
+func (p Period) Name() string {
+	if p.End.Equal(p.Start.AddDate(0, 1, 0)) && p.Start.Day() == 1 {
+		return p.Start.Format(periodMonthLayout)
+	}
+	if p.End.Equal(p.Start.AddDate(0, 0, 1)) {
+		return p.Start.Format(time.DateOnly)
+	}
+	return p.Start.Format(time.DateOnly) + "_" + p.End.Format(time.DateOnly)
+}
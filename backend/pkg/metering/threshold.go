@@ -0,0 +1,51 @@
+package metering
+
+import (
+	"slices"
+)
+
+// DefaultThresholds 默认在用量达到配额的 80% 与 100% 时发布事件
+var DefaultThresholds = []uint32{80, 100}
+
+// Config 用量计量的配置
+type Config struct {
+	// Enabled 关闭时不记录请求，也不汇总用量
+	Enabled bool `json:"enabled"`
+
+	// Thresholds 用量达到订阅套餐配额的百分比时发布事件，为空时使用 DefaultThresholds
+	Thresholds []uint32 `json:"thresholds"`
+}
+
+// GetThresholds 返回从小到大排列的阈值百分比
+func (c *Config) GetThresholds() []uint32 {
+	if c == nil || len(c.Thresholds) == 0 {
+		return DefaultThresholds
+	}
+
+	thresholds := make([]uint32, 0, len(c.Thresholds))
+	for _, t := range c.Thresholds {
+		if t > 0 && !slices.Contains(thresholds, t) {
+			thresholds = append(thresholds, t)
+		}
+	}
+	slices.Sort(thresholds)
+
+	return thresholds
+}
+
+// Crossed 用量从 previous 增长到 current 时越过的阈值百分比，limit 为0表示不限制，不会越过任何阈值
+func Crossed(previous, current, limit uint64, thresholds []uint32) []uint32 {
+	if limit == 0 || current <= previous {
+		return nil
+	}
+
+	var crossed []uint32
+	for _, percent := range thresholds {
+		// 向上取整，避免配额很小时阈值为0
+		level := (limit*uint64(percent) + 99) / 100
+		if previous < level && current >= level {
+			crossed = append(crossed, percent)
+		}
+	}
+	return crossed
+}
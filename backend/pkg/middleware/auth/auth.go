@@ -72,6 +72,27 @@ func Server(opts ...Option) middleware.Middleware {
 				}
 			}
 
+			// 校验租户状态，平台用户没有租户
+			if op.checkTenant != nil && tokenPayload.GetTenantId() != 0 {
+				if err = op.checkTenant(ctx, tokenPayload.GetTenantId()); err != nil {
+					op.log.Errorf("auth middleware: tenant [%d] of user [%d] is not available [%s]", tokenPayload.GetTenantId(), tokenPayload.UserId, err.Error())
+					return nil, err
+				}
+			}
+
+			// 校验租户是否允许本次请求
+			if op.checkTenantRequest != nil && tokenPayload.GetTenantId() != 0 {
+				if err = op.checkTenantRequest(ctx, tokenPayload.GetTenantId(), tr.Operation()); err != nil {
+					op.log.Errorf("auth middleware: tenant [%d] of user [%d] is not allowed to request [%s] [%s]", tokenPayload.GetTenantId(), tokenPayload.UserId, tr.Operation(), err.Error())
+					return nil, err
+				}
+			}
+
+			// 记录租户的请求，用于用量计量
+			if op.recordTenantRequest != nil && tokenPayload.GetTenantId() != 0 {
+				op.recordTenantRequest(ctx, tokenPayload.GetTenantId(), tokenPayload.UserId, tr.Operation())
+			}
+
 			if op.injectOperatorId {
 				if err = setRequestOperationId(req, tokenPayload); err != nil {
 					op.log.Errorf("auth middleware: invalid token payload in context [%s]", err.Error())
@@ -91,9 +112,10 @@ func Server(opts ...Option) middleware.Middleware {
 			}
 
 			if op.injectEnt {
+				// 没有租户的用户属于平台租户（租户ID为0），只能访问平台与共享的数据
 				ctx = viewer.NewContext(ctx, viewer.UserViewer{
 					Authority: tokenPayload.GetAuthority(),
-					TenantId:  tokenPayload.TenantId,
+					TenantId:  trans.Ptr(tokenPayload.GetTenantId()),
 				})
 			}
 
@@ -106,7 +128,7 @@ func Server(opts ...Option) middleware.Middleware {
 			}
 
 			if op.enableAuthz {
-				ctx, err = processAuthz(ctx, tr, tokenPayload)
+				ctx, err = processAuthz(ctx, tr, tokenPayload, op.isPlatformRole)
 				if err != nil {
 					op.log.Errorf("auth middleware: invalid token payload in context [%s]", err.Error())
 					return nil, err
@@ -122,6 +144,7 @@ func processAuthz(
 	ctx context.Context,
 	tr transport.Transporter,
 	tokenPayload *authenticationV1.UserTokenPayload,
+	isPlatformRole IsPlatformRole,
 ) (context.Context, error) {
 	//var sub string
 	//if sub, err = tokenPayload.GetSubject(); err != nil {
@@ -144,7 +167,7 @@ func processAuthz(
 
 	authzClaims := authzEngine.AuthClaims{
 		//Subject:  (*authzEngine.Subject)(&sub),
-		Subjects: trans.Ptr(tokenPayload.GetRoles()),
+		Subjects: trans.Ptr(RoleSubjects(tokenPayload.GetTenantId(), tokenPayload.GetRoles(), isPlatformRole)),
 		Action:   trans.Ptr(action),
 		Resource: trans.Ptr(path),
 		//Project:  trans.Ptr(authzEngine.Project("api")),
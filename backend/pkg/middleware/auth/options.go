@@ -8,15 +8,31 @@ import (
 
 type IsExistAccessToken func(ctx context.Context, userId uint32) bool
 
+// CheckTenant 检查用户所属的租户是否可用，返回的错误会直接返回给调用方
+type CheckTenant func(ctx context.Context, tenantId uint32) error
+
+// CheckTenantRequest 检查租户是否允许本次请求（如订阅套餐的模块与请求数配额），返回的错误会直接返回给调用方
+type CheckTenantRequest func(ctx context.Context, tenantId uint32, operation string) error
+
+// RecordTenantRequest 记录租户用户通过检查的请求，用于用量计量，不影响请求的处理
+type RecordTenantRequest func(ctx context.Context, tenantId, userId uint32, operation string)
+
+// IsPlatformRole 角色编码是否属于平台共享的角色，租户用户只有持有平台角色时才使用平台的鉴权主体
+type IsPlatformRole func(roleCode string) bool
+
 type options struct {
 	log *log.Helper
 
-	isExistAccessToken IsExistAccessToken
-	injectOperatorId   bool
-	injectTenantId     bool
-	enableAuthz        bool
-	injectEnt          bool
-	injectMetadata     bool
+	isExistAccessToken  IsExistAccessToken
+	checkTenant         CheckTenant
+	checkTenantRequest  CheckTenantRequest
+	recordTenantRequest RecordTenantRequest
+	isPlatformRole      IsPlatformRole
+	injectOperatorId    bool
+	injectTenantId      bool
+	enableAuthz         bool
+	injectEnt           bool
+	injectMetadata      bool
 }
 
 type Option func(*options)
@@ -27,6 +43,30 @@ func WithIsExistAccessTokenFunc(fc IsExistAccessToken) Option {
 	}
 }
 
+func WithCheckTenantFunc(fc CheckTenant) Option {
+	return func(opts *options) {
+		opts.checkTenant = fc
+	}
+}
+
+func WithCheckTenantRequestFunc(fc CheckTenantRequest) Option {
+	return func(opts *options) {
+		opts.checkTenantRequest = fc
+	}
+}
+
+func WithRecordTenantRequestFunc(fc RecordTenantRequest) Option {
+	return func(opts *options) {
+		opts.recordTenantRequest = fc
+	}
+}
+
+func WithIsPlatformRoleFunc(fc IsPlatformRole) Option {
+	return func(opts *options) {
+		opts.isPlatformRole = fc
+	}
+}
+
 func WithInjectOperatorId(enable bool) Option {
 	return func(opts *options) {
 		opts.injectOperatorId = enable
@@ -0,0 +1,26 @@
+package auth
+
+import "strconv"
+
+// RoleSubject 鉴权策略中的角色主体。角色编码只在租户内唯一，
+// 平台角色（租户ID为0）直接使用角色编码，租户角色加上租户ID前缀，不同租户的同名角色不会共用策略
+func RoleSubject(tenantId uint32, roleCode string) string {
+	if tenantId == 0 {
+		return roleCode
+	}
+	return strconv.FormatUint(uint64(tenantId), 10) + ":" + roleCode
+}
+
+// RoleSubjects 用户的鉴权主体。租户用户的角色既可能属于本租户，也可能是平台共享的角色，
+// 角色编码在平台与租户之间不会重名：平台的角色使用平台的主体，其余的角色只使用本租户的主体
+func RoleSubjects(tenantId uint32, roleCodes []string, isPlatformRole IsPlatformRole) []string {
+	subjects := make([]string, 0, len(roleCodes))
+	for _, code := range roleCodes {
+		if tenantId == 0 || (isPlatformRole != nil && isPlatformRole(code)) {
+			subjects = append(subjects, code)
+			continue
+		}
+		subjects = append(subjects, RoleSubject(tenantId, code))
+	}
+	return subjects
+}
@@ -0,0 +1,21 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoleSubject(t *testing.T) {
+	assert.Equal(t, "admin", RoleSubject(0, "admin"))
+	assert.Equal(t, "12:admin", RoleSubject(12, "admin"))
+}
+
+func TestRoleSubjects(t *testing.T) {
+	isPlatformRole := func(code string) bool { return code == "auditor" }
+
+	assert.Equal(t, []string{"admin", "user"}, RoleSubjects(0, []string{"admin", "user"}, isPlatformRole))
+	assert.Equal(t, []string{"3:admin", "auditor"}, RoleSubjects(3, []string{"admin", "auditor"}, isPlatformRole))
+	assert.Equal(t, []string{"3:admin", "3:auditor"}, RoleSubjects(3, []string{"admin", "auditor"}, nil))
+	assert.Empty(t, RoleSubjects(3, nil, isPlatformRole))
+}
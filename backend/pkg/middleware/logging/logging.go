@@ -144,6 +144,11 @@ func fillOperationLog(htr *http.Transport) *adminV1.AdminOperationLog {
 	if ut != nil {
 		operationLogData.UserId = trans.Ptr(ut.UserId)
 		operationLogData.Username = ut.Username
+
+		// 代管租户时的操作，同时记录代管操作人与代管的审计日志，代管原因记录在审计日志中
+		operationLogData.ImpersonatorId = ut.ActUserId
+		operationLogData.ImpersonatorName = ut.ActUsername
+		operationLogData.ImpersonationAuditId = ut.ActAuditId
 	}
 
 	// 获取客户端ID
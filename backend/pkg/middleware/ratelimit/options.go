@@ -0,0 +1,38 @@
+package ratelimit
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// PlanFunc 返回租户订阅套餐的编码，用于按套餐选择限制
+type PlanFunc func(ctx context.Context, tenantId uint32) string
+
+type options struct {
+	log *log.Helper
+
+	planFunc          PlanFunc
+	trustForwardedFor bool
+}
+
+type Option func(*options)
+
+func WithPlanFunc(fc PlanFunc) Option {
+	return func(opts *options) {
+		opts.planFunc = fc
+	}
+}
+
+// WithTrustForwardedFor 服务部署在反向代理之后时，按 X-Forwarded-For 与 X-Real-IP 识别客户端IP
+func WithTrustForwardedFor(trust bool) Option {
+	return func(opts *options) {
+		opts.trustForwardedFor = trust
+	}
+}
+
+func WithLogger(logger log.Logger) Option {
+	return func(opts *options) {
+		opts.log = log.NewHelper(log.With(logger, "module", "ratelimit.middleware"))
+	}
+}
@@ -0,0 +1,137 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"net"
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	adminV1 "go-wind-admin/api/gen/go/admin/service/v1"
+
+	"go-wind-admin/pkg/middleware/auth"
+	rate "go-wind-admin/pkg/ratelimit"
+)
+
+const (
+	HeaderKeyRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderKeyRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderKeyRateLimitReset     = "X-RateLimit-Reset"
+	HeaderKeyRetryAfter         = "Retry-After"
+
+	headerKeyXForwardedFor = "X-Forwarded-For"
+	headerKeyXRealIP       = "X-Real-IP"
+)
+
+// Allower 检查请求能否通过限流
+type Allower interface {
+	Allow(ctx context.Context, operation, plan string, subject rate.Subject) (*rate.Decision, error)
+}
+
+// Server 按租户、用户、客户端与IP限流，需要放在认证中间件之后才能识别登录用户。
+// 限流器出错时放行请求，避免 Redis 故障导致服务不可用
+func Server(limiter Allower, opts ...Option) middleware.Middleware {
+	op := options{
+		log: log.NewHelper(log.With(log.DefaultLogger, "module", "ratelimit.middleware")),
+	}
+	for _, o := range opts {
+		o(&op)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok || limiter == nil {
+				return handler(ctx, req)
+			}
+
+			var subject rate.Subject
+			if payload, err := auth.FromContext(ctx); err == nil {
+				subject.TenantId = payload.GetTenantId()
+				subject.UserId = payload.GetUserId()
+				subject.ClientId = payload.GetClientId()
+			}
+			if htr, ok := tr.(*http.Transport); ok {
+				subject.IP = clientIP(htr.Request(), op.trustForwardedFor)
+			}
+
+			var plan string
+			if op.planFunc != nil && subject.TenantId != 0 {
+				plan = op.planFunc(ctx, subject.TenantId)
+			}
+
+			decision, err := limiter.Allow(ctx, tr.Operation(), plan, subject)
+			if err != nil {
+				op.log.Errorf("ratelimit middleware: check [%s] failed [%s]", tr.Operation(), err.Error())
+				return handler(ctx, req)
+			}
+
+			binding := decision.Binding()
+			if binding == nil {
+				return handler(ctx, req)
+			}
+
+			setHeaders(tr.ReplyHeader(), decision.Allowed, binding)
+
+			if !decision.Allowed {
+				op.log.Warnf("ratelimit middleware: [%s] [%s] exceeds the limit of [%s]",
+					binding.Rule.Dimension, binding.Value, tr.Operation(),
+				)
+				return nil, adminV1.ErrorTooManyRequests("请求过于频繁，请在%d秒后重试", seconds(binding.RetryAfter))
+			}
+
+			return handler(ctx, req)
+		}
+	}
+}
+
+// setHeaders 写入限流响应头，拒绝时附加 Retry-After
+func setHeaders(header transport.Header, allowed bool, binding *rate.Result) {
+	header.Set(HeaderKeyRateLimitLimit, strconv.Itoa(binding.Rule.Limit.Capacity()))
+	header.Set(HeaderKeyRateLimitRemaining, strconv.Itoa(binding.Remaining))
+	header.Set(HeaderKeyRateLimitReset, strconv.Itoa(seconds(binding.ResetAfter)))
+	if !allowed {
+		header.Set(HeaderKeyRetryAfter, strconv.Itoa(max(1, seconds(binding.RetryAfter))))
+	}
+}
+
+// seconds 向上取整为秒
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// clientIP 获取客户端IP，只有部署在可信的反向代理之后才使用代理设置的请求头，否则客户端可以伪造IP绕过限流
+func clientIP(r *stdhttp.Request, trustForwardedFor bool) string {
+	if r == nil {
+		return ""
+	}
+
+	if trustForwardedFor {
+		if xff := r.Header.Get(headerKeyXForwardedFor); xff != "" {
+			for _, ip := range strings.Split(xff, ",") {
+				if ip = strings.TrimSpace(ip); net.ParseIP(ip) != nil {
+					return ip
+				}
+			}
+		}
+		if xri := strings.TrimSpace(r.Header.Get(headerKeyXRealIP)); net.ParseIP(xri) != nil {
+			return xri
+		}
+	}
+
+	host, _, err := net.SplitHostPort(strings.TrimSpace(r.RemoteAddr))
+	if err != nil {
+		host = strings.TrimSpace(r.RemoteAddr)
+	}
+	if net.ParseIP(host) != nil {
+		return host
+	}
+	return ""
+}
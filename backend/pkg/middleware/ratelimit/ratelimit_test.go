@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	stdhttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rate "go-wind-admin/pkg/ratelimit"
+)
+
+type headerCarrier stdhttp.Header
+
+func (hc headerCarrier) Get(key string) string      { return stdhttp.Header(hc).Get(key) }
+func (hc headerCarrier) Set(key, value string)      { stdhttp.Header(hc).Set(key, value) }
+func (hc headerCarrier) Add(key, value string)      { stdhttp.Header(hc).Add(key, value) }
+func (hc headerCarrier) Values(key string) []string { return stdhttp.Header(hc).Values(key) }
+func (hc headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(hc))
+	for k := range stdhttp.Header(hc) {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type testTransport struct {
+	operation string
+	reply     headerCarrier
+}
+
+func (t *testTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *testTransport) Endpoint() string                { return "" }
+func (t *testTransport) Operation() string               { return t.operation }
+func (t *testTransport) RequestHeader() transport.Header { return headerCarrier{} }
+func (t *testTransport) ReplyHeader() transport.Header   { return t.reply }
+
+type testAllower struct {
+	decision *rate.Decision
+	err      error
+}
+
+func (a *testAllower) Allow(context.Context, string, string, rate.Subject) (*rate.Decision, error) {
+	return a.decision, a.err
+}
+
+func TestServer(t *testing.T) {
+	rule := rate.Rule{Dimension: rate.DimensionIP, Limit: rate.Limit{Rate: 1, Burst: 10}}
+
+	run := func(a *testAllower) (*testTransport, bool, error) {
+		tr := &testTransport{operation: "/test.Service/Get", reply: headerCarrier{}}
+		ctx := transport.NewServerContext(context.Background(), tr)
+
+		var called bool
+		_, err := Server(a)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return nil, nil
+		})(ctx, nil)
+		return tr, called, err
+	}
+
+	tr, called, err := run(&testAllower{decision: &rate.Decision{Allowed: true, Results: []rate.Result{
+		{Rule: rule, Value: "10.0.0.1", Remaining: 9, ResetAfter: 1500 * time.Millisecond},
+	}}})
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "10", tr.reply.Get(HeaderKeyRateLimitLimit))
+	assert.Equal(t, "9", tr.reply.Get(HeaderKeyRateLimitRemaining))
+	assert.Equal(t, "2", tr.reply.Get(HeaderKeyRateLimitReset))
+	assert.Empty(t, tr.reply.Get(HeaderKeyRetryAfter))
+
+	tr, called, err = run(&testAllower{decision: &rate.Decision{Allowed: false, Results: []rate.Result{
+		{Rule: rule, Value: "10.0.0.1", RetryAfter: 300 * time.Millisecond, ResetAfter: 10 * time.Second},
+	}}})
+	assert.False(t, called)
+	assert.Equal(t, int32(429), errors.FromError(err).Code)
+	assert.Equal(t, "0", tr.reply.Get(HeaderKeyRateLimitRemaining))
+	assert.Equal(t, "1", tr.reply.Get(HeaderKeyRetryAfter))
+
+	// 限流器出错时放行
+	_, called, err = run(&testAllower{err: assert.AnError})
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	// 没有需要检查的令牌桶时不写响应头
+	tr, called, err = run(&testAllower{decision: &rate.Decision{Allowed: true}})
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Empty(t, tr.reply.Keys())
+}
+
+func TestClientIP(t *testing.T) {
+	r, err := stdhttp.NewRequest(stdhttp.MethodGet, "/", nil)
+	require.NoError(t, err)
+	r.RemoteAddr = "192.168.1.10:52000"
+	r.Header.Set("X-Forwarded-For", "unknown, 203.0.113.5, 10.0.0.1")
+	r.Header.Set("X-Real-IP", "198.51.100.7")
+
+	assert.Equal(t, "192.168.1.10", clientIP(r, false))
+	assert.Equal(t, "203.0.113.5", clientIP(r, true))
+
+	r.Header.Del("X-Forwarded-For")
+	assert.Equal(t, "198.51.100.7", clientIP(r, true))
+
+	r.Header.Del("X-Real-IP")
+	r.RemoteAddr = "[::1]:8080"
+	assert.Equal(t, "::1", clientIP(r, true))
+
+	r.RemoteAddr = "invalid"
+	assert.Empty(t, clientIP(r, false))
+	assert.Empty(t, clientIP(nil, false))
+}
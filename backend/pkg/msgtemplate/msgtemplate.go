@@ -0,0 +1,177 @@
+package msgtemplate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// ErrNoLocale 模板没有任何语言版本
+var ErrNoLocale = errors.New("msgtemplate: template has no locale")
+
+// Locale 模板的一个语言版本
+type Locale struct {
+	Language string
+	Subject  string
+	Body     string
+}
+
+// Template 消息模板
+type Template struct {
+	Code string
+	// DefaultLanguage 没有匹配的语言时使用的语言
+	DefaultLanguage string
+	Locales         []Locale
+}
+
+// User 模板中可以使用的接收者信息，例如 {{.User.Nickname}}
+type User struct {
+	ID       uint32
+	Username string
+	Nickname string
+	Realname string
+	Email    string
+	Mobile   string
+	Language string
+}
+
+// Data 渲染模板的数据，例如 {{.Vars.order_no}}
+type Data struct {
+	Vars map[string]string
+	User *User
+	Now  time.Time
+}
+
+// Result 渲染结果
+type Result struct {
+	Language string
+	Subject  string
+	Body     string
+}
+
+// Validate 校验所有语言版本的模板语法
+func Validate(tpl *Template) error {
+	if len(tpl.Locales) == 0 {
+		return ErrNoLocale
+	}
+
+	seen := make(map[string]struct{}, len(tpl.Locales))
+	for _, l := range tpl.Locales {
+		key := normalize(l.Language)
+		if key == "" {
+			return errors.New("msgtemplate: locale language is required")
+		}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("msgtemplate: duplicate locale %s", l.Language)
+		}
+		seen[key] = struct{}{}
+
+		if _, err := parse(l.Language+".subject", l.Subject); err != nil {
+			return err
+		}
+		if _, err := parse(l.Language+".body", l.Body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelectLocale 依次按 preferred 中的语言、模板的默认语言选择语言版本，都没有匹配时使用第一个语言版本。
+// 语言先按完整代码匹配，再按主语言匹配，例如 zh-TW 可以匹配 zh-CN。
+func SelectLocale(tpl *Template, preferred ...string) (*Locale, error) {
+	if len(tpl.Locales) == 0 {
+		return nil, ErrNoLocale
+	}
+
+	candidates := append(append([]string{}, preferred...), tpl.DefaultLanguage)
+
+	for _, lang := range candidates {
+		if lang == "" {
+			continue
+		}
+
+		key := normalize(lang)
+		for i := range tpl.Locales {
+			if normalize(tpl.Locales[i].Language) == key {
+				return &tpl.Locales[i], nil
+			}
+		}
+
+		base := baseLanguage(key)
+		for i := range tpl.Locales {
+			if baseLanguage(normalize(tpl.Locales[i].Language)) == base {
+				return &tpl.Locales[i], nil
+			}
+		}
+	}
+
+	return &tpl.Locales[0], nil
+}
+
+// Render 选择语言版本并渲染模板
+func Render(tpl *Template, data *Data, preferred ...string) (*Result, error) {
+	locale, err := SelectLocale(tpl, preferred...)
+	if err != nil {
+		return nil, err
+	}
+
+	if data == nil {
+		data = &Data{}
+	}
+	if data.User == nil {
+		data.User = &User{}
+	}
+	if data.Now.IsZero() {
+		data.Now = time.Now()
+	}
+
+	subject, err := execute(locale.Language+".subject", locale.Subject, data)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := execute(locale.Language+".body", locale.Body, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Language: locale.Language,
+		Subject:  subject,
+		Body:     body,
+	}, nil
+}
+
+func parse(name, text string) (*template.Template, error) {
+	tpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("msgtemplate: %w", err)
+	}
+	return tpl, nil
+}
+
+func execute(name, text string, data *Data) (string, error) {
+	tpl, err := parse(name, text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err = tpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("msgtemplate: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func normalize(lang string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(lang), "_", "-"))
+}
+
+func baseLanguage(lang string) string {
+	base, _, _ := strings.Cut(lang, "-")
+	return base
+}
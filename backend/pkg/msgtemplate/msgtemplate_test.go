@@ -0,0 +1,82 @@
+package msgtemplate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTemplate() *Template {
+	return &Template{
+		Code:            "order_shipped",
+		DefaultLanguage: "en-US",
+		Locales: []Locale{
+			{Language: "zh-CN", Subject: "订单 {{.Vars.order_no}} 已发货", Body: "{{.User.Nickname}}，您的订单已发货。"},
+			{Language: "en-US", Subject: "Order {{.Vars.order_no}} shipped", Body: "Hi {{.User.Nickname}}, your order has shipped."},
+		},
+	}
+}
+
+func TestSelectLocale(t *testing.T) {
+	tpl := newTemplate()
+
+	cases := []struct {
+		preferred []string
+		want      string
+	}{
+		{[]string{"zh-CN"}, "zh-CN"},
+		{[]string{"zh_cn"}, "zh-CN"},
+		{[]string{"zh-TW"}, "zh-CN"},
+		{[]string{"fr-FR", "zh"}, "zh-CN"},
+		{[]string{"fr-FR"}, "en-US"},
+		{nil, "en-US"},
+	}
+	for _, c := range cases {
+		locale, err := SelectLocale(tpl, c.preferred...)
+		require.NoError(t, err)
+		assert.Equal(t, c.want, locale.Language, "preferred %v", c.preferred)
+	}
+
+	tpl.DefaultLanguage = ""
+	locale, err := SelectLocale(tpl, "fr-FR")
+	require.NoError(t, err)
+	assert.Equal(t, "zh-CN", locale.Language)
+
+	_, err = SelectLocale(&Template{})
+	assert.ErrorIs(t, err, ErrNoLocale)
+}
+
+func TestRender(t *testing.T) {
+	result, err := Render(newTemplate(), &Data{
+		Vars: map[string]string{"order_no": "A001"},
+		User: &User{Nickname: "小明"},
+		Now:  time.Unix(0, 0),
+	}, "zh-CN")
+	require.NoError(t, err)
+	assert.Equal(t, "zh-CN", result.Language)
+	assert.Equal(t, "订单 A001 已发货", result.Subject)
+	assert.Equal(t, "小明，您的订单已发货。", result.Body)
+
+	// 缺少的变量渲染为空
+	result, err = Render(newTemplate(), nil, "en")
+	require.NoError(t, err)
+	assert.Equal(t, "Order  shipped", result.Subject)
+	assert.Equal(t, "Hi , your order has shipped.", result.Body)
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(newTemplate()))
+
+	assert.ErrorIs(t, Validate(&Template{}), ErrNoLocale)
+
+	assert.Error(t, Validate(&Template{Locales: []Locale{{Language: "", Subject: "a"}}}))
+
+	assert.Error(t, Validate(&Template{Locales: []Locale{
+		{Language: "zh-CN", Subject: "a"},
+		{Language: "zh_cn", Subject: "b"},
+	}}))
+
+	assert.Error(t, Validate(&Template{Locales: []Locale{{Language: "zh-CN", Subject: "{{.Vars.a"}}}))
+}
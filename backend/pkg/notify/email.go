@@ -0,0 +1,215 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultEmailSubjectTemplate = "{{.Title}}"
+	defaultEmailBodyTemplate    = "{{.Body}}"
+
+	defaultSMTPTimeout = 10 * time.Second
+)
+
+// EmailConfig SMTP 邮件渠道配置
+type EmailConfig struct {
+	Enabled bool `json:"enabled"`
+
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+
+	// From 发件人，例如 "Wind Admin <noreply@example.com>"
+	From string `json:"from"`
+
+	// ImplicitTLS 连接时直接使用 TLS（通常为 465 端口），否则在服务器支持时使用 STARTTLS
+	ImplicitTLS bool `json:"implicit_tls"`
+	// InsecureSkipVerify 不校验服务器证书，仅用于测试环境
+	InsecureSkipVerify bool `json:"insecure_skip_verify"`
+
+	// HTML 正文是否为 HTML
+	HTML bool `json:"html"`
+
+	SubjectTemplate string `json:"subject_template"`
+	BodyTemplate    string `json:"body_template"`
+
+	// Timeout 连接与发送超时时间，单位秒
+	Timeout int `json:"timeout"`
+}
+
+// EmailChannel 通过 SMTP 发送邮件
+type EmailChannel struct {
+	cfg  *EmailConfig
+	from *mail.Address
+
+	subject *Template
+	body    *Template
+}
+
+func NewEmailChannel(cfg *EmailConfig) (*EmailChannel, error) {
+	if cfg.Host == "" {
+		return nil, errors.New("notify: smtp host is required")
+	}
+
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("notify: invalid sender address: %w", err)
+	}
+
+	ch := &EmailChannel{cfg: cfg, from: from}
+
+	if ch.subject, err = ParseTemplate("email_subject", cfg.SubjectTemplate, defaultEmailSubjectTemplate); err != nil {
+		return nil, err
+	}
+	if ch.body, err = ParseTemplate("email_body", cfg.BodyTemplate, defaultEmailBodyTemplate); err != nil {
+		return nil, err
+	}
+
+	return ch, nil
+}
+
+func (c *EmailChannel) Name() string {
+	return ChannelEmail
+}
+
+func (c *EmailChannel) Send(ctx context.Context, to string, content *Content) error {
+	rcpt, err := mail.ParseAddress(to)
+	if err != nil {
+		return fmt.Errorf("notify: invalid recipient address: %w", err)
+	}
+
+	subject, err := c.subject.Render(content)
+	if err != nil {
+		return err
+	}
+
+	var body string
+	if body, err = c.body.Render(content); err != nil {
+		return err
+	}
+
+	msg := buildEmail(c.from, rcpt, subject, body, c.cfg.HTML, time.Now())
+
+	return c.send(ctx, rcpt.Address, msg)
+}
+
+func (c *EmailChannel) send(ctx context.Context, to string, msg []byte) error {
+	timeout := defaultSMTPTimeout
+	if c.cfg.Timeout > 0 {
+		timeout = time.Duration(c.cfg.Timeout) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	port := c.cfg.Port
+	if port == 0 {
+		port = 25
+		if c.cfg.ImplicitTLS {
+			port = 465
+		}
+	}
+	addr := net.JoinHostPort(c.cfg.Host, strconv.Itoa(port))
+
+	tlsConfig := &tls.Config{
+		ServerName:         c.cfg.Host,
+		InsecureSkipVerify: c.cfg.InsecureSkipVerify,
+	}
+
+	var conn net.Conn
+	var err error
+	dialer := &net.Dialer{}
+	if c.cfg.ImplicitTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, c.cfg.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer func() { _ = client.Close() }()
+
+	if !c.cfg.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		}
+	}
+
+	if c.cfg.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, c.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err = client.Mail(c.from.Address); err != nil {
+		return err
+	}
+	if err = client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(msg); err != nil {
+		_ = w.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// buildEmail 生成 MIME 邮件，正文使用 base64 编码
+func buildEmail(from, to *mail.Address, subject, body string, html bool, date time.Time) []byte {
+	contentType := "text/plain"
+	if html {
+		contentType = "text/html"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + from.String() + "\r\n")
+	buf.WriteString("To: " + to.String() + "\r\n")
+	buf.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	buf.WriteString("Date: " + date.Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: " + contentType + "; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: base64\r\n")
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+
+	return buf.Bytes()
+}
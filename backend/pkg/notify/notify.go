@@ -0,0 +1,144 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// 通知渠道名称
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+var (
+	// ErrChannelNotFound 通知渠道未配置
+	ErrChannelNotFound = errors.New("notify: channel not found")
+	// ErrEmptyAddress 接收者没有该渠道的接收地址
+	ErrEmptyAddress = errors.New("notify: empty recipient address")
+)
+
+// Content 待发送的通知内容，各渠道使用自己的模板渲染后发送
+type Content struct {
+	// Title 标题
+	Title string
+	// Body 正文
+	Body string
+	// Category 消息分类名称
+	Category string
+	// RecipientName 接收者名称
+	RecipientName string
+	// Data 模板中可以使用的其他变量
+	Data map[string]any
+}
+
+// Channel 通知渠道
+type Channel interface {
+	// Name 渠道名称
+	Name() string
+	// Send 向接收地址发送通知，接收地址为邮箱、手机号码等
+	Send(ctx context.Context, to string, content *Content) error
+}
+
+// Registry 已启用的通知渠道
+type Registry struct {
+	mu       sync.RWMutex
+	channels map[string]Channel
+}
+
+func NewRegistry(channels ...Channel) *Registry {
+	r := &Registry{
+		channels: make(map[string]Channel, len(channels)),
+	}
+	for _, ch := range channels {
+		r.Register(ch)
+	}
+	return r
+}
+
+// Register 注册通知渠道，同名渠道会被替换
+func (r *Registry) Register(ch Channel) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.channels[ch.Name()] = ch
+}
+
+// Get 获取通知渠道
+func (r *Registry) Get(name string) (Channel, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ch, ok := r.channels[name]
+	return ch, ok
+}
+
+// Names 已启用的渠道名称
+func (r *Registry) Names() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.channels))
+	for name := range r.channels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Send 通过指定渠道发送通知
+func (r *Registry) Send(ctx context.Context, channel, to string, content *Content) error {
+	ch, ok := r.Get(channel)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrChannelNotFound, channel)
+	}
+
+	if to == "" {
+		return ErrEmptyAddress
+	}
+
+	return ch.Send(ctx, to, content)
+}
+
+// Config 通知渠道配置
+type Config struct {
+	Email *EmailConfig `json:"email"`
+	SMS   *SMSConfig   `json:"sms"`
+}
+
+// NewRegistryFromConfig 根据配置创建通知渠道，未配置或未启用的渠道不会注册
+func NewRegistryFromConfig(cfg *Config) (*Registry, error) {
+	r := NewRegistry()
+	if cfg == nil {
+		return r, nil
+	}
+
+	if cfg.Email != nil && cfg.Email.Enabled {
+		ch, err := NewEmailChannel(cfg.Email)
+		if err != nil {
+			return nil, err
+		}
+		r.Register(ch)
+	}
+
+	if cfg.SMS != nil && cfg.SMS.Enabled {
+		ch, err := NewSMSChannel(cfg.SMS)
+		if err != nil {
+			return nil, err
+		}
+		r.Register(ch)
+	}
+
+	return r, nil
+}
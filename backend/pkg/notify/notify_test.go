@@ -0,0 +1,171 @@
+package notify
+
+import (
+	"context"
+	"encoding/base64"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordProvider struct {
+	phone   string
+	content string
+}
+
+func (p *recordProvider) Send(_ context.Context, phone, content string) error {
+	p.phone = phone
+	p.content = content
+	return nil
+}
+
+func TestTemplateRender(t *testing.T) {
+	tpl, err := ParseTemplate("test", "", "{{.Title}}-{{.Data.code}}-{{.Data.missing}}")
+	require.NoError(t, err)
+
+	text, err := tpl.Render(&Content{Title: "验证码", Data: map[string]any{"code": "123456"}})
+	require.NoError(t, err)
+	assert.Equal(t, "验证码-123456-<no value>", text)
+
+	_, err = ParseTemplate("bad", "{{.Title", "")
+	assert.Error(t, err)
+}
+
+func TestRegistrySend(t *testing.T) {
+	provider := &recordProvider{}
+	ch, err := NewSMSChannelWithProvider(&SMSConfig{SignName: "风行"}, provider)
+	require.NoError(t, err)
+
+	r := NewRegistry(ch)
+	assert.Equal(t, []string{ChannelSMS}, r.Names())
+
+	err = r.Send(context.Background(), ChannelEmail, "a@example.com", &Content{})
+	assert.ErrorIs(t, err, ErrChannelNotFound)
+
+	err = r.Send(context.Background(), ChannelSMS, "", &Content{})
+	assert.ErrorIs(t, err, ErrEmptyAddress)
+
+	require.NoError(t, r.Send(context.Background(), ChannelSMS, "13800000000", &Content{Title: "通知", Body: "系统维护"}))
+	assert.Equal(t, "13800000000", provider.phone)
+	assert.Equal(t, "【风行】通知：系统维护", provider.content)
+}
+
+func TestSMSTruncate(t *testing.T) {
+	provider := &recordProvider{}
+	ch, err := NewSMSChannelWithProvider(&SMSConfig{Template: "{{.Body}}", MaxLength: 5}, provider)
+	require.NoError(t, err)
+
+	require.NoError(t, ch.Send(context.Background(), "13800000000", &Content{Body: "一二三四五六七"}))
+	assert.Equal(t, "一二三四…", provider.content)
+}
+
+func TestLogSMSProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sms.log")
+
+	r, err := NewRegistryFromConfig(&Config{
+		Email: &EmailConfig{Enabled: false},
+		SMS:   &SMSConfig{Enabled: true, Provider: "log", LogFile: path},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ChannelSMS}, r.Names())
+
+	require.NoError(t, r.Send(context.Background(), ChannelSMS, "13800000000", &Content{Title: "a", Body: "b"}))
+	require.NoError(t, r.Send(context.Background(), ChannelSMS, "13900000000", &Content{Title: "c", Body: "d"}))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.True(t, strings.HasSuffix(lines[0], "\t13800000000\ta：b"))
+	assert.True(t, strings.HasSuffix(lines[1], "\t13900000000\tc：d"))
+
+	_, err = NewRegistryFromConfig(&Config{SMS: &SMSConfig{Enabled: true, Provider: "unknown"}})
+	assert.Error(t, err)
+}
+
+func TestBuildEmail(t *testing.T) {
+	from := &mail.Address{Name: "Wind Admin", Address: "noreply@example.com"}
+	to := &mail.Address{Address: "user@example.com"}
+
+	body := strings.Repeat("正文", 40)
+	msg := string(buildEmail(from, to, "系统通知", body, true, time.Unix(0, 0)))
+
+	header, encoded, ok := strings.Cut(msg, "\r\n\r\n")
+	require.True(t, ok)
+	assert.Contains(t, header, "From: \"Wind Admin\" <noreply@example.com>")
+	assert.Contains(t, header, "To: <user@example.com>")
+	assert.Contains(t, header, "Subject: =?UTF-8?b?")
+	assert.Contains(t, header, "Content-Type: text/html; charset=UTF-8")
+
+	for _, line := range strings.Split(strings.TrimSpace(encoded), "\r\n") {
+		assert.LessOrEqual(t, len(line), 76)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(strings.TrimSpace(encoded), "\r\n", ""))
+	require.NoError(t, err)
+	assert.Equal(t, body, string(decoded))
+}
+
+func TestNewEmailChannel(t *testing.T) {
+	_, err := NewEmailChannel(&EmailConfig{From: "noreply@example.com"})
+	assert.Error(t, err)
+
+	_, err = NewEmailChannel(&EmailConfig{Host: "smtp.example.com", From: "invalid"})
+	assert.Error(t, err)
+
+	ch, err := NewEmailChannel(&EmailConfig{Host: "smtp.example.com", From: "Wind Admin <noreply@example.com>"})
+	require.NoError(t, err)
+	assert.Equal(t, ChannelEmail, ch.Name())
+
+	err = ch.Send(context.Background(), "not an address", &Content{})
+	assert.Error(t, err)
+}
+
+func TestQuietHours(t *testing.T) {
+	_, err := ParseQuietHours("22:00", "22:00", "")
+	assert.ErrorIs(t, err, ErrInvalidQuietHours)
+	_, err = ParseQuietHours("25:00", "07:00", "")
+	assert.Error(t, err)
+	_, err = ParseQuietHours("22:00", "07:00", "Mars/Olympus")
+	assert.Error(t, err)
+
+	shanghai, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+
+	// 跨越午夜的时段
+	q, err := ParseQuietHours("22:00", "07:00", "Asia/Shanghai")
+	require.NoError(t, err)
+
+	until, ok := q.Until(time.Date(2024, 5, 1, 23, 30, 0, 0, shanghai))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(time.Date(2024, 5, 2, 7, 0, 0, 0, shanghai)))
+
+	until, ok = q.Until(time.Date(2024, 5, 2, 6, 59, 0, 0, shanghai))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(time.Date(2024, 5, 2, 7, 0, 0, 0, shanghai)))
+
+	_, ok = q.Until(time.Date(2024, 5, 2, 7, 0, 0, 0, shanghai))
+	assert.False(t, ok)
+
+	// 按接收者的时区计算：UTC 15:00 是上海的 23:00
+	_, ok = q.Until(time.Date(2024, 5, 1, 15, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+
+	// 同一天内的时段
+	q, err = ParseQuietHours("12:00", "13:30", "Asia/Shanghai")
+	require.NoError(t, err)
+
+	until, ok = q.Until(time.Date(2024, 5, 1, 12, 15, 0, 0, shanghai))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(time.Date(2024, 5, 1, 13, 30, 0, 0, shanghai)))
+
+	_, ok = q.Until(time.Date(2024, 5, 1, 11, 59, 0, 0, shanghai))
+	assert.False(t, ok)
+}
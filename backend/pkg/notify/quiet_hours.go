@@ -0,0 +1,79 @@
+package notify
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrInvalidQuietHours 免打扰时段的开始时间与结束时间相同
+	ErrInvalidQuietHours = errors.New("notify: quiet hours start and end must differ")
+)
+
+// QuietHours 免打扰时段，按接收者所在时区的时钟时间计算
+type QuietHours struct {
+	// start、end 为一天中的分钟数，end 小于 start 表示时段跨越午夜
+	start int
+	end   int
+
+	location *time.Location
+}
+
+// ParseQuietHours 解析免打扰时段，start 与 end 的格式为 HH:MM，timezone 为IANA时区名称，为空时使用服务器时区
+func ParseQuietHours(start, end, timezone string) (*QuietHours, error) {
+	s, err := parseClock(start)
+	if err != nil {
+		return nil, err
+	}
+	e, err := parseClock(end)
+	if err != nil {
+		return nil, err
+	}
+	if s == e {
+		return nil, ErrInvalidQuietHours
+	}
+
+	location := time.Local
+	if timezone != "" {
+		if location, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("notify: invalid timezone %q: %w", timezone, err)
+		}
+	}
+
+	return &QuietHours{start: s, end: e, location: location}, nil
+}
+
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("notify: invalid clock time %q, expected HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Until 判断 t 是否处于免打扰时段内，处于时段内时返回时段的结束时间
+func (q *QuietHours) Until(t time.Time) (time.Time, bool) {
+	lt := t.In(q.location)
+	minute := lt.Hour()*60 + lt.Minute()
+
+	endOn := func(days int) time.Time {
+		return time.Date(lt.Year(), lt.Month(), lt.Day()+days, q.end/60, q.end%60, 0, 0, q.location)
+	}
+
+	if q.start < q.end {
+		if minute >= q.start && minute < q.end {
+			return endOn(0), true
+		}
+		return time.Time{}, false
+	}
+
+	switch {
+	case minute >= q.start:
+		return endOn(1), true
+	case minute < q.end:
+		return endOn(0), true
+	default:
+		return time.Time{}, false
+	}
+}
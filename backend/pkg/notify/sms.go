@@ -0,0 +1,170 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	defaultSMSTemplate = "{{.Title}}：{{.Body}}"
+
+	// defaultSMSMaxLength 短信内容的最大字符数，超出部分会被截断
+	defaultSMSMaxLength = 300
+)
+
+// SMSProvider 短信服务商
+type SMSProvider interface {
+	// Send 向手机号码发送短信
+	Send(ctx context.Context, phone, content string) error
+}
+
+// SMSProviderFactory 根据配置创建短信服务商
+type SMSProviderFactory func(cfg *SMSConfig) (SMSProvider, error)
+
+var (
+	smsProvidersMu sync.RWMutex
+	smsProviders   = map[string]SMSProviderFactory{
+		"log": newLogSMSProvider,
+	}
+)
+
+// RegisterSMSProvider 注册短信服务商，配置中的 provider 使用注册时的名称
+func RegisterSMSProvider(name string, factory SMSProviderFactory) {
+	smsProvidersMu.Lock()
+	defer smsProvidersMu.Unlock()
+
+	smsProviders[name] = factory
+}
+
+// SMSConfig 短信渠道配置
+type SMSConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Provider 短信服务商，内置 log：把短信写入文件，用于开发与测试
+	Provider string `json:"provider"`
+
+	// SignName 短信签名
+	SignName string `json:"sign_name"`
+
+	Template string `json:"template"`
+	// MaxLength 短信内容的最大字符数
+	MaxLength int `json:"max_length"`
+
+	// LogFile log 服务商写入的文件，为空时写到标准输出
+	LogFile string `json:"log_file"`
+
+	// Options 服务商的其他配置，例如密钥、地域
+	Options map[string]string `json:"options"`
+}
+
+// SMSChannel 短信渠道
+type SMSChannel struct {
+	cfg      *SMSConfig
+	provider SMSProvider
+	template *Template
+}
+
+func NewSMSChannel(cfg *SMSConfig) (*SMSChannel, error) {
+	name := cfg.Provider
+	if name == "" {
+		name = "log"
+	}
+
+	smsProvidersMu.RLock()
+	factory, ok := smsProviders[name]
+	smsProvidersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("notify: unknown sms provider: %s", name)
+	}
+
+	provider, err := factory(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSMSChannelWithProvider(cfg, provider)
+}
+
+// NewSMSChannelWithProvider 使用指定的短信服务商创建短信渠道
+func NewSMSChannelWithProvider(cfg *SMSConfig, provider SMSProvider) (*SMSChannel, error) {
+	tpl, err := ParseTemplate("sms", cfg.Template, defaultSMSTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SMSChannel{
+		cfg:      cfg,
+		provider: provider,
+		template: tpl,
+	}, nil
+}
+
+func (c *SMSChannel) Name() string {
+	return ChannelSMS
+}
+
+func (c *SMSChannel) Send(ctx context.Context, to string, content *Content) error {
+	text, err := c.template.Render(content)
+	if err != nil {
+		return err
+	}
+
+	if c.cfg.SignName != "" {
+		text = "【" + c.cfg.SignName + "】" + text
+	}
+
+	maxLength := c.cfg.MaxLength
+	if maxLength <= 0 {
+		maxLength = defaultSMSMaxLength
+	}
+
+	return c.provider.Send(ctx, to, truncate(text, maxLength))
+}
+
+// truncate 按字符截断，超出时以省略号结尾
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	runes := []rune(s)
+	return string(runes[:n-1]) + "…"
+}
+
+// logSMSProvider 把短信写入文件而不真正发送
+type logSMSProvider struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newLogSMSProvider(cfg *SMSConfig) (SMSProvider, error) {
+	return &logSMSProvider{path: cfg.LogFile}, nil
+}
+
+func (p *logSMSProvider) Send(_ context.Context, phone, content string) error {
+	line := fmt.Sprintf("%s\t%s\t%s\n", time.Now().Format(time.RFC3339), phone, content)
+
+	if p.path == "" {
+		_, err := fmt.Fprint(os.Stdout, line)
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	f, err := os.OpenFile(p.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+
+	if _, err = f.WriteString(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
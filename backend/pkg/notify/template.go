@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"bytes"
+	"text/template"
+)
+
+// Template 通知内容模板，模板中可以使用 Content 的字段，例如 {{.Title}}、{{.Data.code}}
+type Template struct {
+	tpl *template.Template
+}
+
+// ParseTemplate 解析模板，text 为空时使用 defaultText
+func ParseTemplate(name, text, defaultText string) (*Template, error) {
+	if text == "" {
+		text = defaultText
+	}
+
+	tpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{tpl: tpl}, nil
+}
+
+// Render 渲染模板
+func (t *Template) Render(content *Content) (string, error) {
+	if content == nil {
+		content = &Content{}
+	}
+
+	var buf bytes.Buffer
+	if err := t.tpl.Execute(&buf, content); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
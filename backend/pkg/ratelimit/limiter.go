@@ -0,0 +1,335 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// bucketScript 单个令牌桶。每次调用只访问一个键，在 Redis Cluster 中各个令牌桶可以位于不同的槽。
+// KEYS[1] 为令牌桶，ARGV 依次为速率、容量与操作：take 消耗一个令牌，refund 归还一个令牌，peek 只补充令牌。
+// 时间取自 Redis，各个副本的时钟不一致也不影响结果。
+// 返回是否取得令牌，以及剩余令牌数、需要等待的毫秒数与补满所需的毫秒数
+var bucketScript = redis.NewScript(`
+local t = redis.call("TIME")
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local op = ARGV[3]
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tk = tonumber(state[1]) or burst
+local ts = tonumber(state[2]) or now
+if now > ts then
+	tk = math.min(burst, tk + (now - ts) * rate / 1000)
+end
+
+local taken = 0
+if op == "take" and tk >= 1 then
+	tk = tk - 1
+	taken = 1
+elseif op == "refund" then
+	tk = math.min(burst, tk + 1)
+end
+
+local reset = math.ceil((burst - tk) / rate * 1000)
+redis.call("HSET", KEYS[1], "tokens", tk, "ts", now)
+redis.call("PEXPIRE", KEYS[1], reset + 1000)
+
+local retry = 0
+if tk < 1 then
+	retry = math.ceil((1 - tk) / rate * 1000)
+end
+
+return {taken, math.floor(tk), retry, reset}`)
+
+const (
+	bucketTake   = "take"
+	bucketRefund = "refund"
+	bucketPeek   = "peek"
+)
+
+const (
+	counterAllowed  = "allowed"
+	counterRejected = "rejected"
+)
+
+// Subject 发起请求的主体，值为空的维度不限流
+type Subject struct {
+	TenantId uint32
+	UserId   uint32
+	ClientId string
+	IP       string
+}
+
+// Value 返回维度的值，平台用户（租户ID为0）不按租户限流
+func (s Subject) Value(d Dimension) string {
+	switch d {
+	case DimensionTenant:
+		if s.TenantId != 0 {
+			return strconv.FormatUint(uint64(s.TenantId), 10)
+		}
+	case DimensionUser:
+		if s.UserId != 0 {
+			return strconv.FormatUint(uint64(s.UserId), 10)
+		}
+	case DimensionClient:
+		return s.ClientId
+	case DimensionIP:
+		return s.IP
+	}
+	return ""
+}
+
+// Result 单个令牌桶的检查结果
+type Result struct {
+	Rule  Rule
+	Value string
+
+	// Remaining 本次请求之后剩余的令牌数
+	Remaining int
+	// RetryAfter 下一个令牌补充前需要等待的时长，有剩余令牌时为0
+	RetryAfter time.Duration
+	// ResetAfter 令牌桶补满需要的时长
+	ResetAfter time.Duration
+}
+
+// Decision 一个请求的限流结果
+type Decision struct {
+	Allowed bool
+	Results []Result
+}
+
+// Binding 返回决定本次结果的令牌桶：拒绝时为需要等待最久的令牌桶，放行时为剩余令牌最少的令牌桶
+func (d *Decision) Binding() *Result {
+	var binding *Result
+	for i := range d.Results {
+		r := &d.Results[i]
+		switch {
+		case binding == nil:
+			binding = r
+		case !d.Allowed && r.RetryAfter > binding.RetryAfter:
+			binding = r
+		case d.Allowed && r.Remaining < binding.Remaining:
+			binding = r
+		}
+	}
+	return binding
+}
+
+// Counter 一个主体当天的请求计数
+type Counter struct {
+	Dimension Dimension
+	Value     string
+	Allowed   int64
+	Rejected  int64
+}
+
+// Limiter 基于 Redis 的令牌桶限流器，多个副本共用令牌桶
+type Limiter struct {
+	rdb    redis.UniversalClient
+	policy *Policy
+}
+
+// NewLimiter 创建限流器
+func NewLimiter(rdb redis.UniversalClient, policy *Policy) *Limiter {
+	if policy == nil {
+		policy = NewPolicy(nil)
+	}
+	return &Limiter{
+		rdb:    rdb,
+		policy: policy,
+	}
+}
+
+// Policy 返回限流策略
+func (l *Limiter) Policy() *Policy {
+	return l.policy
+}
+
+// Allow 检查主体能否访问接口，没有需要检查的令牌桶时直接放行
+func (l *Limiter) Allow(ctx context.Context, operation, plan string, subject Subject) (*Decision, error) {
+	var results []Result
+	for _, rule := range l.policy.Resolve(operation, plan) {
+		if value := subject.Value(rule.Dimension); value != "" {
+			results = append(results, Result{Rule: rule, Value: value})
+		}
+	}
+	if len(results) == 0 {
+		return &Decision{Allowed: true}, nil
+	}
+
+	cfg := l.policy.Config()
+
+	// 依次从各个令牌桶取令牌，某个令牌桶没有令牌时归还已取得的令牌，其余的令牌桶只补充令牌，
+	// 所有令牌桶都有令牌时才算消耗，拒绝的请求不消耗令牌
+	decision := &Decision{Allowed: true}
+	for i := range results {
+		op := bucketTake
+		if !decision.Allowed {
+			op = bucketPeek
+		}
+
+		taken, err := l.runBucket(ctx, cfg.Prefix, &results[i], op)
+		if err != nil {
+			if decision.Allowed {
+				_ = l.refund(ctx, cfg.Prefix, results[:i])
+			}
+			return nil, err
+		}
+
+		if op == bucketTake && !taken {
+			decision.Allowed = false
+			if err = l.refund(ctx, cfg.Prefix, results[:i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	decision.Results = results
+
+	l.count(ctx, cfg, results, decision.Allowed)
+
+	return decision, nil
+}
+
+// runBucket 对一个令牌桶执行操作，并把令牌桶的状态写入检查结果
+func (l *Limiter) runBucket(ctx context.Context, prefix string, r *Result, op string) (bool, error) {
+	reply, err := bucketScript.Run(ctx, l.rdb,
+		[]string{bucketKey(prefix, r.Rule, r.Value)},
+		r.Rule.Limit.Rate, r.Rule.Limit.Capacity(), op,
+	).Int64Slice()
+	if err != nil {
+		return false, err
+	}
+	if len(reply) != 4 {
+		return false, fmt.Errorf("ratelimit: unexpected script reply length %d", len(reply))
+	}
+
+	r.Remaining = int(reply[1])
+	r.RetryAfter = time.Duration(reply[2]) * time.Millisecond
+	r.ResetAfter = time.Duration(reply[3]) * time.Millisecond
+
+	return reply[0] == 1, nil
+}
+
+// refund 归还已取得的令牌
+func (l *Limiter) refund(ctx context.Context, prefix string, results []Result) error {
+	for i := range results {
+		if _, err := l.runBucket(ctx, prefix, &results[i], bucketRefund); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// count 按主体累加当天的请求计数，同一主体的多个令牌桶只计一次。计数失败不影响限流结果
+func (l *Limiter) count(ctx context.Context, cfg Config, results []Result, allowed bool) {
+	counter := counterRejected
+	if allowed {
+		counter = counterAllowed
+	}
+
+	key := statsKey(cfg.Prefix, time.Now())
+	seen := make(map[string]bool, len(results))
+
+	_, _ = l.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, r := range results {
+			field := string(r.Rule.Dimension) + ":" + r.Value
+			if !seen[field] {
+				seen[field] = true
+				pipe.HIncrBy(ctx, key, field+"|"+counter, 1)
+			}
+		}
+		pipe.Expire(ctx, key, time.Duration(cfg.StatsDays)*24*time.Hour)
+		return nil
+	})
+}
+
+// Counters 返回某一天的请求计数，按拒绝数与放行数倒序排列。dimension 为空时返回所有维度
+func (l *Limiter) Counters(ctx context.Context, day time.Time, dimension Dimension) ([]Counter, error) {
+	values, err := l.rdb.HGetAll(ctx, statsKey(l.policy.Config().Prefix, day)).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCounters(values, dimension), nil
+}
+
+// ParseCounters 解析计数哈希，字段格式为 维度:值|allowed 或 维度:值|rejected
+func ParseCounters(values map[string]string, dimension Dimension) []Counter {
+	counters := make(map[string]*Counter)
+	for field, value := range values {
+		i := strings.LastIndex(field, "|")
+		j := strings.Index(field, ":")
+		if i < 0 || j < 0 || j > i {
+			continue
+		}
+
+		d := Dimension(field[:j])
+		if dimension != "" && d != dimension {
+			continue
+		}
+
+		counter := field[i+1:]
+		if counter != counterAllowed && counter != counterRejected {
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		key := field[:i]
+		c := counters[key]
+		if c == nil {
+			c = &Counter{Dimension: d, Value: field[j+1 : i]}
+			counters[key] = c
+		}
+
+		switch counter {
+		case counterAllowed:
+			c.Allowed += n
+		case counterRejected:
+			c.Rejected += n
+		}
+	}
+
+	result := make([]Counter, 0, len(counters))
+	for _, c := range counters {
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Rejected != b.Rejected {
+			return a.Rejected > b.Rejected
+		}
+		if a.Allowed != b.Allowed {
+			return a.Allowed > b.Allowed
+		}
+		if a.Dimension != b.Dimension {
+			return a.Dimension < b.Dimension
+		}
+		return a.Value < b.Value
+	})
+
+	return result
+}
+
+func bucketKey(prefix string, rule Rule, value string) string {
+	key := prefix + "bucket:" + string(rule.Dimension) + ":" + value
+	if rule.Operation != "" {
+		key += ":" + rule.Operation
+	}
+	return key
+}
+
+func statsKey(prefix string, day time.Time) string {
+	return prefix + "stats:" + day.Format("20060102")
+}
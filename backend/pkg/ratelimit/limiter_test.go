@@ -0,0 +1,162 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRedis 连接测试用的Redis，地址可通过 REDIS_ADDR 指定，连接失败时跳过测试
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "127.0.0.1:6379"
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: addr, DB: 15})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		_ = rdb.Close()
+		t.Skipf("redis is not available at %s: %v", addr, err)
+	}
+
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return rdb
+}
+
+func cleanupPrefix(t *testing.T, rdb *redis.Client, prefix string) {
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys, _ := rdb.Keys(ctx, prefix+"*").Result()
+		if len(keys) > 0 {
+			rdb.Del(ctx, keys...)
+		}
+	})
+}
+
+func TestLimiterAllow(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	prefix := "test:ratelimit:" + t.Name() + ":"
+	cleanupPrefix(t, rdb, prefix)
+
+	const login = "/admin.service.v1.AuthenticationService/Login"
+
+	l := NewLimiter(rdb, NewPolicy(&Config{
+		Enabled: true,
+		Prefix:  prefix,
+		Default: Rules{
+			User: &Limit{Rate: 1, Burst: 3},
+		},
+		Operations: map[string]Rules{
+			login: {IP: &Limit{Rate: 1, Burst: 2}},
+		},
+	}))
+
+	subject := Subject{TenantId: 1, UserId: 7, IP: "10.0.0.1"}
+
+	for i := 0; i < 3; i++ {
+		d, err := l.Allow(ctx, "/admin.service.v1.UserService/ListUser", "", subject)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+		assert.Equal(t, 2-i, d.Binding().Remaining)
+	}
+
+	d, err := l.Allow(ctx, "/admin.service.v1.UserService/ListUser", "", subject)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Greater(t, d.Binding().RetryAfter, time.Duration(0))
+	assert.LessOrEqual(t, d.Binding().RetryAfter, time.Second)
+
+	// 未登录的请求只检查接口的IP限制，拒绝时不消耗令牌
+	anonymous := Subject{IP: "10.0.0.2"}
+	for i := 0; i < 2; i++ {
+		d, err = l.Allow(ctx, login, "", anonymous)
+		require.NoError(t, err)
+		assert.True(t, d.Allowed)
+	}
+	d, err = l.Allow(ctx, login, "", anonymous)
+	require.NoError(t, err)
+	assert.False(t, d.Allowed)
+	assert.Equal(t, login, d.Binding().Rule.Operation)
+
+	// 其他IP不受影响
+	d, err = l.Allow(ctx, login, "", Subject{IP: "10.0.0.3"})
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	// 令牌按速率补充
+	time.Sleep(1100 * time.Millisecond)
+	d, err = l.Allow(ctx, login, "", anonymous)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	counters, err := l.Counters(ctx, time.Now(), "")
+	require.NoError(t, err)
+	assert.Contains(t, counters, Counter{Dimension: DimensionUser, Value: "7", Allowed: 3, Rejected: 1})
+
+	counters, err = l.Counters(ctx, time.Now(), DimensionIP)
+	require.NoError(t, err)
+	assert.Contains(t, counters, Counter{Dimension: DimensionIP, Value: "10.0.0.2", Allowed: 3, Rejected: 1})
+}
+
+// TestLimiterRefund 一个令牌桶拒绝时，其他令牌桶已取得的令牌被归还
+func TestLimiterRefund(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx := context.Background()
+
+	prefix := "test:ratelimit:" + t.Name() + ":"
+	cleanupPrefix(t, rdb, prefix)
+
+	l := NewLimiter(rdb, NewPolicy(&Config{
+		Enabled: true,
+		Prefix:  prefix,
+		Default: Rules{
+			User: &Limit{Rate: 0.1, Burst: 3},
+			IP:   &Limit{Rate: 0.1, Burst: 1},
+		},
+	}))
+
+	subject := Subject{TenantId: 1, UserId: 7, IP: "10.0.0.1"}
+
+	d, err := l.Allow(ctx, "/x", "", subject)
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+
+	for i := 0; i < 2; i++ {
+		d, err = l.Allow(ctx, "/x", "", subject)
+		require.NoError(t, err)
+		assert.False(t, d.Allowed)
+		assert.Equal(t, DimensionIP, d.Binding().Rule.Dimension)
+	}
+
+	// 被IP拒绝的请求没有消耗用户的令牌
+	d, err = l.Allow(ctx, "/x", "", Subject{TenantId: 1, UserId: 7, IP: "10.0.0.2"})
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+	for _, r := range d.Results {
+		if r.Rule.Dimension == DimensionUser {
+			assert.Equal(t, 1, r.Remaining)
+		}
+	}
+}
+
+// TestLimiterDisabled 没有需要检查的令牌桶时不访问 Redis
+func TestLimiterDisabled(t *testing.T) {
+	l := NewLimiter(nil, NewPolicy(&Config{Default: Rules{User: &Limit{Rate: 1}}}))
+	d, err := l.Allow(context.Background(), "/x", "", Subject{UserId: 1})
+	require.NoError(t, err)
+	assert.True(t, d.Allowed)
+	assert.Empty(t, d.Results)
+}
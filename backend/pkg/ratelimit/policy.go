@@ -0,0 +1,221 @@
+package ratelimit
+
+import (
+	"math"
+	"strings"
+)
+
+// Dimension 限流维度，同一维度的请求共用一个令牌桶
+type Dimension string
+
+const (
+	DimensionTenant Dimension = "tenant"
+	DimensionUser   Dimension = "user"
+	DimensionClient Dimension = "client"
+	DimensionIP     Dimension = "ip"
+)
+
+// Dimensions 全部限流维度
+var Dimensions = []Dimension{
+	DimensionTenant,
+	DimensionUser,
+	DimensionClient,
+	DimensionIP,
+}
+
+// Limit 令牌桶：每秒补充 Rate 个令牌，最多积累 Burst 个，每个请求消耗一个令牌
+type Limit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst,omitempty"`
+}
+
+// Enabled 速率为0表示不限制
+func (l Limit) Enabled() bool {
+	return l.Rate > 0
+}
+
+// Capacity 返回令牌桶容量，没有配置 Burst 时为一秒补充的令牌数，至少为1
+func (l Limit) Capacity() int {
+	if l.Burst > 0 {
+		return l.Burst
+	}
+	return int(math.Max(1, math.Ceil(l.Rate)))
+}
+
+// Rules 各个维度的限制，为空表示沿用上一级配置，速率为0表示不限制
+type Rules struct {
+	Tenant *Limit `json:"tenant,omitempty"`
+	User   *Limit `json:"user,omitempty"`
+	Client *Limit `json:"client,omitempty"`
+	IP     *Limit `json:"ip,omitempty"`
+}
+
+// Get 返回维度的限制
+func (r Rules) Get(d Dimension) *Limit {
+	switch d {
+	case DimensionTenant:
+		return r.Tenant
+	case DimensionUser:
+		return r.User
+	case DimensionClient:
+		return r.Client
+	case DimensionIP:
+		return r.IP
+	}
+	return nil
+}
+
+// Merge 用 o 中配置了的维度覆盖 r
+func (r Rules) Merge(o Rules) Rules {
+	if o.Tenant != nil {
+		r.Tenant = o.Tenant
+	}
+	if o.User != nil {
+		r.User = o.User
+	}
+	if o.Client != nil {
+		r.Client = o.Client
+	}
+	if o.IP != nil {
+		r.IP = o.IP
+	}
+	return r
+}
+
+// PlanRules 订阅套餐的限制，覆盖默认限制与接口限制
+type PlanRules struct {
+	Rules
+
+	// Operations 套餐中单个接口的限制
+	Operations map[string]Rules `json:"operations,omitempty"`
+}
+
+// Config 配置目录中的 rate_limit 配置
+type Config struct {
+	Enabled bool `json:"enabled"`
+
+	// Prefix 令牌桶与计数在 Redis 中的键前缀
+	Prefix string `json:"prefix,omitempty"`
+
+	// TrustForwardedFor 服务部署在反向代理之后时，按 X-Forwarded-For 与 X-Real-IP 识别客户端IP
+	TrustForwardedFor bool `json:"trust_forwarded_for,omitempty"`
+
+	// StatsDays 计数保留的天数
+	StatsDays int `json:"stats_days,omitempty"`
+
+	// Default 所有接口共用的限制
+	Default Rules `json:"default"`
+
+	// Operations 单个接口的限制，按接口单独计算。以 * 结尾的键按前缀匹配，例如 /admin.service.v1.UserService/*
+	Operations map[string]Rules `json:"operations,omitempty"`
+
+	// Plans 按订阅套餐编码配置的限制
+	Plans map[string]*PlanRules `json:"plans,omitempty"`
+}
+
+const (
+	DefaultPrefix    = "ratelimit:"
+	DefaultStatsDays = 7
+)
+
+// Rule 一个请求需要检查的令牌桶
+type Rule struct {
+	Dimension Dimension
+	Limit     Limit
+
+	// Operation 只作用于单个接口的限制对应的接口，为空表示所有接口共用
+	Operation string
+}
+
+// Policy 根据配置确定请求需要检查的令牌桶
+type Policy struct {
+	cfg Config
+}
+
+// NewPolicy 创建限流策略，cfg 为空时不限流
+func NewPolicy(cfg *Config) *Policy {
+	p := &Policy{}
+	if cfg != nil {
+		p.cfg = *cfg
+	}
+	if p.cfg.Prefix == "" {
+		p.cfg.Prefix = DefaultPrefix
+	}
+	if p.cfg.StatsDays <= 0 {
+		p.cfg.StatsDays = DefaultStatsDays
+	}
+	return p
+}
+
+// Enabled 是否启用限流
+func (p *Policy) Enabled() bool {
+	return p != nil && p.cfg.Enabled
+}
+
+// Config 返回限流配置
+func (p *Policy) Config() Config {
+	return p.cfg
+}
+
+// Resolve 返回接口在订阅套餐下需要检查的令牌桶。所有接口共用的限制按 默认→套餐 覆盖，
+// 接口的限制按 接口→套餐中的接口 覆盖并单独计算，两类限制同时生效
+func (p *Policy) Resolve(operation, plan string) []Rule {
+	if !p.Enabled() {
+		return nil
+	}
+
+	global := p.cfg.Default
+	var scoped Rules
+	var scopedKey string
+
+	if key, rules, ok := matchOperation(p.cfg.Operations, operation); ok {
+		scoped, scopedKey = rules, key
+	}
+
+	if pr := p.cfg.Plans[plan]; plan != "" && pr != nil {
+		global = global.Merge(pr.Rules)
+		if key, rules, ok := matchOperation(pr.Operations, operation); ok {
+			scoped = scoped.Merge(rules)
+			if scopedKey == "" || len(key) > len(scopedKey) {
+				scopedKey = key
+			}
+		}
+	}
+
+	var result []Rule
+	for _, d := range Dimensions {
+		if l := global.Get(d); l != nil && l.Enabled() {
+			result = append(result, Rule{Dimension: d, Limit: *l})
+		}
+	}
+	for _, d := range Dimensions {
+		if l := scoped.Get(d); l != nil && l.Enabled() {
+			result = append(result, Rule{Dimension: d, Limit: *l, Operation: scopedKey})
+		}
+	}
+
+	return result
+}
+
+// matchOperation 查找接口的配置：优先精确匹配，其次匹配最长的前缀
+func matchOperation(ops map[string]Rules, operation string) (string, Rules, bool) {
+	if rules, ok := ops[operation]; ok {
+		return operation, rules, true
+	}
+
+	var key string
+	for k := range ops {
+		prefix, ok := strings.CutSuffix(k, "*")
+		if !ok || !strings.HasPrefix(operation, prefix) {
+			continue
+		}
+		if len(k) > len(key) {
+			key = k
+		}
+	}
+	if key == "" {
+		return "", Rules{}, false
+	}
+
+	return key, ops[key], true
+}
@@ -0,0 +1,133 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitCapacity(t *testing.T) {
+	assert.Equal(t, 20, Limit{Rate: 10, Burst: 20}.Capacity())
+	assert.Equal(t, 3, Limit{Rate: 2.5}.Capacity())
+	assert.Equal(t, 1, Limit{Rate: 0.2}.Capacity())
+	assert.False(t, Limit{}.Enabled())
+}
+
+func TestPolicyResolve(t *testing.T) {
+	p := NewPolicy(&Config{
+		Enabled: true,
+		Default: Rules{
+			Tenant: &Limit{Rate: 100, Burst: 200},
+			User:   &Limit{Rate: 10, Burst: 20},
+			IP:     &Limit{Rate: 20},
+		},
+		Operations: map[string]Rules{
+			"/admin.service.v1.AuthenticationService/Login": {IP: &Limit{Rate: 0.2, Burst: 5}},
+			"/admin.service.v1.UserService/*":               {User: &Limit{Rate: 5}},
+			"/admin.service.v1.*":                           {User: &Limit{Rate: 50}},
+		},
+		Plans: map[string]*PlanRules{
+			"pro": {
+				Rules: Rules{
+					Tenant: &Limit{Rate: 500, Burst: 1000},
+					// 速率为0表示套餐不按用户限流
+					User: &Limit{},
+				},
+				Operations: map[string]Rules{
+					"/admin.service.v1.UserService/ListUser": {User: &Limit{Rate: 20}},
+				},
+			},
+		},
+	})
+
+	rules := p.Resolve("/admin.service.v1.AuthenticationService/Login", "")
+	assert.Equal(t, []Rule{
+		{Dimension: DimensionTenant, Limit: Limit{Rate: 100, Burst: 200}},
+		{Dimension: DimensionUser, Limit: Limit{Rate: 10, Burst: 20}},
+		{Dimension: DimensionIP, Limit: Limit{Rate: 20}},
+		{Dimension: DimensionIP, Limit: Limit{Rate: 0.2, Burst: 5}, Operation: "/admin.service.v1.AuthenticationService/Login"},
+	}, rules)
+
+	// 最长前缀优先
+	rules = p.Resolve("/admin.service.v1.UserService/ListUser", "")
+	assert.Equal(t, Rule{Dimension: DimensionUser, Limit: Limit{Rate: 5}, Operation: "/admin.service.v1.UserService/*"}, rules[len(rules)-1])
+
+	rules = p.Resolve("/admin.service.v1.RoleService/ListRole", "")
+	assert.Equal(t, "/admin.service.v1.*", rules[len(rules)-1].Operation)
+
+	// 套餐覆盖默认限制与接口限制
+	rules = p.Resolve("/admin.service.v1.UserService/ListUser", "pro")
+	assert.Equal(t, []Rule{
+		{Dimension: DimensionTenant, Limit: Limit{Rate: 500, Burst: 1000}},
+		{Dimension: DimensionIP, Limit: Limit{Rate: 20}},
+		{Dimension: DimensionUser, Limit: Limit{Rate: 20}, Operation: "/admin.service.v1.UserService/ListUser"},
+	}, rules)
+
+	// 未配置的套餐使用默认限制
+	assert.Len(t, p.Resolve("/other.Service/Get", "basic"), 3)
+
+	assert.Empty(t, NewPolicy(&Config{Default: Rules{User: &Limit{Rate: 1}}}).Resolve("/x", ""))
+	assert.Empty(t, NewPolicy(nil).Resolve("/x", ""))
+}
+
+func TestPolicyDefaults(t *testing.T) {
+	cfg := NewPolicy(&Config{Enabled: true}).Config()
+	assert.Equal(t, DefaultPrefix, cfg.Prefix)
+	assert.Equal(t, DefaultStatsDays, cfg.StatsDays)
+}
+
+func TestSubjectValue(t *testing.T) {
+	s := Subject{TenantId: 3, UserId: 7, ClientId: "web", IP: "10.0.0.1"}
+	assert.Equal(t, "3", s.Value(DimensionTenant))
+	assert.Equal(t, "7", s.Value(DimensionUser))
+	assert.Equal(t, "web", s.Value(DimensionClient))
+	assert.Equal(t, "10.0.0.1", s.Value(DimensionIP))
+
+	// 平台用户与未登录的请求只按IP限流
+	assert.Empty(t, Subject{IP: "10.0.0.1"}.Value(DimensionTenant))
+	assert.Empty(t, Subject{IP: "10.0.0.1"}.Value(DimensionUser))
+}
+
+func TestDecisionBinding(t *testing.T) {
+	d := &Decision{Allowed: true, Results: []Result{
+		{Value: "a", Remaining: 5},
+		{Value: "b", Remaining: 2},
+	}}
+	assert.Equal(t, "b", d.Binding().Value)
+
+	d = &Decision{Allowed: false, Results: []Result{
+		{Value: "a", RetryAfter: time.Second},
+		{Value: "b", RetryAfter: 3 * time.Second},
+	}}
+	assert.Equal(t, "b", d.Binding().Value)
+
+	assert.Nil(t, (&Decision{Allowed: true}).Binding())
+}
+
+func TestParseCounters(t *testing.T) {
+	counters := ParseCounters(map[string]string{
+		"tenant:3|allowed":       "10",
+		"tenant:3|rejected":      "2",
+		"user:7|allowed":         "4",
+		"ip:::1|allowed":         "8",
+		"ip:::1|rejected":        "5",
+		"client:a|b|allowed":     "1",
+		"invalid":                "1",
+		"user:8|allowed":         "x",
+		"user:9|unknown-counter": "3",
+	}, "")
+
+	assert.Equal(t, []Counter{
+		{Dimension: DimensionIP, Value: "::1", Allowed: 8, Rejected: 5},
+		{Dimension: DimensionTenant, Value: "3", Allowed: 10, Rejected: 2},
+		{Dimension: DimensionUser, Value: "7", Allowed: 4},
+		{Dimension: DimensionClient, Value: "a|b", Allowed: 1},
+	}, counters)
+
+	counters = ParseCounters(map[string]string{
+		"tenant:3|allowed": "10",
+		"user:7|allowed":   "4",
+	}, DimensionUser)
+	assert.Equal(t, []Counter{{Dimension: DimensionUser, Value: "7", Allowed: 4}}, counters)
+}
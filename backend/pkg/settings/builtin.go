@@ -0,0 +1,193 @@
+package settings
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// 内置配置项键名
+const (
+	KeyPasswordMinLength     = "security.password.min_length"     // 密码最小长度
+	KeyPasswordRequireDigit  = "security.password.require_digit"  // 密码必须包含数字
+	KeyPasswordRequireUpper  = "security.password.require_upper"  // 密码必须包含大写字母
+	KeyPasswordRequireSymbol = "security.password.require_symbol" // 密码必须包含特殊字符
+	KeySessionTimeoutMinutes = "security.session.timeout_minutes" // 会话超时（分钟），0表示使用系统的令牌有效期
+
+	KeyLoginMethods     = "auth.login_methods"     // 允许的登录方式
+	KeySelfRegistration = "auth.self_registration" // 是否允许自助注册
+
+	KeyDefaultLanguage = "locale.default_language" // 默认语言
+
+	KeyAppName    = "branding.app_name"    // 应用名称，为空表示使用系统名称
+	KeyThemeColor = "branding.theme_color" // 主题色
+	KeyThemeMode  = "branding.theme_mode"  // 主题模式
+)
+
+// 登录方式，与登录请求的授权类型一致
+const (
+	LoginMethodPassword          = "password"
+	LoginMethodClientCredentials = "client_credentials"
+	LoginMethodAuthorizationCode = "authorization_code"
+	LoginMethodImplicit          = "implicit"
+)
+
+func int64Ptr(n int64) *int64 { return &n }
+
+// Builtin 系统内置的配置项定义
+func Builtin() []*Definition {
+	return []*Definition{
+		{
+			Key:         KeyPasswordMinLength,
+			Kind:        KindInt,
+			Default:     "6",
+			Scope:       ScopeTenant,
+			Min:         int64Ptr(1),
+			Max:         int64Ptr(64),
+			Description: "密码最小长度",
+		},
+		{
+			Key:         KeyPasswordRequireDigit,
+			Kind:        KindBool,
+			Default:     "false",
+			Scope:       ScopeTenant,
+			Description: "密码必须包含数字",
+		},
+		{
+			Key:         KeyPasswordRequireUpper,
+			Kind:        KindBool,
+			Default:     "false",
+			Scope:       ScopeTenant,
+			Description: "密码必须包含大写字母",
+		},
+		{
+			Key:         KeyPasswordRequireSymbol,
+			Kind:        KindBool,
+			Default:     "false",
+			Scope:       ScopeTenant,
+			Description: "密码必须包含特殊字符",
+		},
+		{
+			Key:         KeySessionTimeoutMinutes,
+			Kind:        KindInt,
+			Default:     "0",
+			Scope:       ScopeTenant,
+			Min:         int64Ptr(0),
+			Max:         int64Ptr(30 * 24 * 60),
+			Description: "会话超时（分钟），超过该时间未刷新令牌需要重新登录，0表示使用系统的令牌有效期",
+		},
+		{
+			Key:     KeyLoginMethods,
+			Kind:    KindStrings,
+			Default: `["password"]`,
+			Scope:   ScopeTenant,
+			Options: []string{
+				LoginMethodPassword,
+				LoginMethodClientCredentials,
+				LoginMethodAuthorizationCode,
+				LoginMethodImplicit,
+			},
+			Description: "允许的登录方式",
+		},
+		{
+			Key:         KeySelfRegistration,
+			Kind:        KindBool,
+			Default:     "true",
+			Scope:       ScopeTenant,
+			Description: "是否允许自助注册",
+		},
+		{
+			Key:         KeyDefaultLanguage,
+			Kind:        KindEnum,
+			Default:     "zh-CN",
+			Scope:       ScopeUser,
+			Options:     []string{"zh-CN", "en-US"},
+			Description: "默认语言",
+		},
+		{
+			Key:         KeyAppName,
+			Kind:        KindString,
+			Default:     "",
+			Scope:       ScopeTenant,
+			MaxLength:   64,
+			Description: "应用名称，为空表示使用系统名称",
+		},
+		{
+			Key:         KeyThemeColor,
+			Kind:        KindColor,
+			Default:     "#1677FF",
+			Scope:       ScopeTenant,
+			Description: "主题色",
+		},
+		{
+			Key:         KeyThemeMode,
+			Kind:        KindEnum,
+			Default:     "auto",
+			Scope:       ScopeUser,
+			Options:     []string{"auto", "light", "dark"},
+			Description: "主题模式",
+		},
+	}
+}
+
+// NewDefaultRegistry 创建注册了内置配置项的注册表
+func NewDefaultRegistry() *Registry {
+	r, err := NewRegistry(Builtin()...)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// PasswordPolicy 密码策略
+type PasswordPolicy struct {
+	MinLength     int
+	RequireDigit  bool
+	RequireUpper  bool
+	RequireSymbol bool
+}
+
+// PasswordPolicyFrom 从生效的配置中读取密码策略
+func PasswordPolicyFrom(values Values) PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:     int(values.Int(KeyPasswordMinLength)),
+		RequireDigit:  values.Bool(KeyPasswordRequireDigit),
+		RequireUpper:  values.Bool(KeyPasswordRequireUpper),
+		RequireSymbol: values.Bool(KeyPasswordRequireSymbol),
+	}
+}
+
+// Check 检查密码是否符合策略，返回不符合的原因
+func (p PasswordPolicy) Check(password string) error {
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("密码长度不能少于%d位", p.MinLength)
+	}
+
+	var hasDigit, hasUpper, hasSymbol bool
+	for _, c := range password {
+		switch {
+		case unicode.IsDigit(c):
+			hasDigit = true
+		case unicode.IsUpper(c):
+			hasUpper = true
+		case unicode.IsPunct(c) || unicode.IsSymbol(c):
+			hasSymbol = true
+		}
+	}
+
+	var missing []string
+	if p.RequireDigit && !hasDigit {
+		missing = append(missing, "数字")
+	}
+	if p.RequireUpper && !hasUpper {
+		missing = append(missing, "大写字母")
+	}
+	if p.RequireSymbol && !hasSymbol {
+		missing = append(missing, "特殊字符")
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("密码必须包含%s", strings.Join(missing, "、"))
+	}
+
+	return nil
+}
@@ -0,0 +1,294 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Kind 配置项的值类型，所有类型的值都以字符串保存
+type Kind string
+
+const (
+	KindString  Kind = "string"  // 字符串
+	KindInt     Kind = "int"     // 整数
+	KindBool    Kind = "bool"    // 布尔值，保存为 true / false
+	KindEnum    Kind = "enum"    // 枚举，值必须是 Options 之一
+	KindStrings Kind = "strings" // 字符串列表，保存为JSON数组，设置了 Options 时每一项必须是 Options 之一
+	KindColor   Kind = "color"   // 颜色，保存为 #RRGGBB
+)
+
+// Scope 配置项允许覆盖的层级，系统配置总是可以覆盖默认值
+type Scope int
+
+const (
+	ScopeSystem Scope = iota // 只能由平台配置
+	ScopeTenant              // 租户可以覆盖
+	ScopeUser                // 租户与用户都可以覆盖
+)
+
+var (
+	// ErrUnknownKey 配置项没有注册
+	ErrUnknownKey = errors.New("settings: unknown key")
+	// ErrInvalidValue 配置值不符合配置项的类型或校验规则
+	ErrInvalidValue = errors.New("settings: invalid value")
+	// ErrNotOverridable 配置项不允许在该层级覆盖
+	ErrNotOverridable = errors.New("settings: not overridable at this scope")
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// Definition 配置项的定义
+type Definition struct {
+	// Key 配置项键名，使用点号分隔的小写名称，如 security.password.min_length
+	Key string
+	// Kind 值类型
+	Kind Kind
+	// Default 默认值
+	Default string
+	// Scope 允许覆盖的层级
+	Scope Scope
+	// Options 枚举与字符串列表的可选值
+	Options []string
+	// Min、Max 整数的取值范围，为空表示不限制
+	Min, Max *int64
+	// MaxLength 字符串的最大长度，0表示不限制
+	MaxLength int
+	// Description 配置项说明
+	Description string
+}
+
+// Normalize 校验配置值并转换为规范的保存格式
+func (d *Definition) Normalize(value string) (string, error) {
+	switch d.Kind {
+	case KindString:
+		if d.MaxLength > 0 && len([]rune(value)) > d.MaxLength {
+			return "", fmt.Errorf("%w: %s length exceeds %d", ErrInvalidValue, d.Key, d.MaxLength)
+		}
+		return value, nil
+
+	case KindInt:
+		n, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be an integer", ErrInvalidValue, d.Key)
+		}
+		if d.Min != nil && n < *d.Min {
+			return "", fmt.Errorf("%w: %s must be at least %d", ErrInvalidValue, d.Key, *d.Min)
+		}
+		if d.Max != nil && n > *d.Max {
+			return "", fmt.Errorf("%w: %s must be at most %d", ErrInvalidValue, d.Key, *d.Max)
+		}
+		return strconv.FormatInt(n, 10), nil
+
+	case KindBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("%w: %s must be a boolean", ErrInvalidValue, d.Key)
+		}
+		return strconv.FormatBool(b), nil
+
+	case KindEnum:
+		if !slices.Contains(d.Options, value) {
+			return "", fmt.Errorf("%w: %s must be one of %s", ErrInvalidValue, d.Key, strings.Join(d.Options, ","))
+		}
+		return value, nil
+
+	case KindStrings:
+		var items []string
+		if err := json.Unmarshal([]byte(value), &items); err != nil {
+			return "", fmt.Errorf("%w: %s must be a json array of strings", ErrInvalidValue, d.Key)
+		}
+		normalized := make([]string, 0, len(items))
+		for _, item := range items {
+			if len(d.Options) > 0 && !slices.Contains(d.Options, item) {
+				return "", fmt.Errorf("%w: %s item must be one of %s", ErrInvalidValue, d.Key, strings.Join(d.Options, ","))
+			}
+			if !slices.Contains(normalized, item) {
+				normalized = append(normalized, item)
+			}
+		}
+		b, _ := json.Marshal(normalized)
+		return string(b), nil
+
+	case KindColor:
+		if !colorPattern.MatchString(value) {
+			return "", fmt.Errorf("%w: %s must be a color like #1677FF", ErrInvalidValue, d.Key)
+		}
+		return strings.ToUpper(value), nil
+	}
+
+	return "", fmt.Errorf("%w: %s has unsupported kind %s", ErrInvalidValue, d.Key, d.Kind)
+}
+
+// Overridable 配置项是否允许在该层级覆盖
+func (d *Definition) Overridable(scope Scope) bool {
+	return scope <= d.Scope
+}
+
+// Registry 配置项定义的注册表
+type Registry struct {
+	mu   sync.RWMutex
+	defs map[string]*Definition
+}
+
+func NewRegistry(defs ...*Definition) (*Registry, error) {
+	r := &Registry{
+		defs: make(map[string]*Definition, len(defs)),
+	}
+	for _, def := range defs {
+		if err := r.Register(def); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Register 注册配置项，默认值必须能通过校验，同名配置项会被替换
+func (r *Registry) Register(def *Definition) error {
+	if def == nil || def.Key == "" {
+		return fmt.Errorf("%w: empty key", ErrUnknownKey)
+	}
+
+	normalized, err := def.Normalize(def.Default)
+	if err != nil {
+		return fmt.Errorf("invalid default: %w", err)
+	}
+	def.Default = normalized
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.defs[def.Key] = def
+
+	return nil
+}
+
+// Lookup 查找配置项定义
+func (r *Registry) Lookup(key string) (*Definition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	def, ok := r.defs[key]
+	return def, ok
+}
+
+// List 按键名排序返回所有配置项定义
+func (r *Registry) List() []*Definition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	defs := make([]*Definition, 0, len(r.defs))
+	for _, def := range r.defs {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool { return defs[i].Key < defs[j].Key })
+
+	return defs
+}
+
+// Validate 校验在 scope 层级设置的配置值，返回规范的保存格式
+func (r *Registry) Validate(scope Scope, key, value string) (string, error) {
+	def, ok := r.Lookup(key)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, key)
+	}
+	if !def.Overridable(scope) {
+		return "", fmt.Errorf("%w: %s", ErrNotOverridable, key)
+	}
+	return def.Normalize(value)
+}
+
+// Source 生效的配置值的来源
+type Source int
+
+const (
+	SourceDefault Source = iota // 默认值
+	SourceSystem                // 系统配置
+	SourceTenant                // 租户配置
+	SourceUser                  // 用户配置
+)
+
+// Effective 一个配置项生效的值
+type Effective struct {
+	Definition *Definition
+	Value      string
+	Source     Source
+}
+
+// Effective 按键名排序，合并默认值与系统、租户、用户三层覆盖值，得到每个配置项生效的值与来源。
+// 未注册、不允许在该层级覆盖或校验失败的覆盖值会被忽略，保证配置定义变更后旧数据不会生效
+func (r *Registry) Effective(system, tenant, user map[string]string) []Effective {
+	layers := []struct {
+		scope     Scope
+		source    Source
+		overrides map[string]string
+	}{
+		{ScopeSystem, SourceSystem, system},
+		{ScopeTenant, SourceTenant, tenant},
+		{ScopeUser, SourceUser, user},
+	}
+
+	defs := r.List()
+	items := make([]Effective, 0, len(defs))
+	for _, def := range defs {
+		item := Effective{Definition: def, Value: def.Default, Source: SourceDefault}
+		for _, layer := range layers {
+			value, ok := layer.overrides[def.Key]
+			if !ok || !def.Overridable(layer.scope) {
+				continue
+			}
+			if normalized, err := def.Normalize(value); err == nil {
+				item.Value = normalized
+				item.Source = layer.source
+			}
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// Resolve 合并三层覆盖值，得到生效的配置
+func (r *Registry) Resolve(system, tenant, user map[string]string) Values {
+	items := r.Effective(system, tenant, user)
+
+	values := make(Values, len(items))
+	for _, item := range items {
+		values[item.Definition.Key] = item.Value
+	}
+
+	return values
+}
+
+// Values 生效的配置，按键名读取时转换为对应的类型，值不合法时返回零值
+type Values map[string]string
+
+// String 读取字符串、枚举或颜色配置
+func (v Values) String(key string) string {
+	return v[key]
+}
+
+// Int 读取整数配置
+func (v Values) Int(key string) int64 {
+	n, _ := strconv.ParseInt(v[key], 10, 64)
+	return n
+}
+
+// Bool 读取布尔配置
+func (v Values) Bool(key string) bool {
+	b, _ := strconv.ParseBool(v[key])
+	return b
+}
+
+// Strings 读取字符串列表配置
+func (v Values) Strings(key string) []string {
+	var items []string
+	_ = json.Unmarshal([]byte(v[key]), &items)
+	return items
+}
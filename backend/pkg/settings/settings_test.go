@@ -0,0 +1,105 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefinitionNormalize(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	cases := []struct {
+		key     string
+		value   string
+		want    string
+		wantErr error
+	}{
+		{KeyPasswordMinLength, " 8 ", "8", nil},
+		{KeyPasswordMinLength, "0", "", ErrInvalidValue},
+		{KeyPasswordMinLength, "abc", "", ErrInvalidValue},
+		{KeyPasswordRequireDigit, "1", "true", nil},
+		{KeyPasswordRequireDigit, "yes", "", ErrInvalidValue},
+		{KeyDefaultLanguage, "en-US", "en-US", nil},
+		{KeyDefaultLanguage, "fr-FR", "", ErrInvalidValue},
+		{KeyLoginMethods, `["password","password"]`, `["password"]`, nil},
+		{KeyLoginMethods, `["sms"]`, "", ErrInvalidValue},
+		{KeyLoginMethods, `password`, "", ErrInvalidValue},
+		{KeyThemeColor, "#1677ff", "#1677FF", nil},
+		{KeyThemeColor, "blue", "", ErrInvalidValue},
+		{"unknown.key", "1", "", ErrUnknownKey},
+	}
+	for _, c := range cases {
+		got, err := r.Validate(ScopeSystem, c.key, c.value)
+		if c.wantErr != nil {
+			assert.ErrorIs(t, err, c.wantErr, c.key+"="+c.value)
+			continue
+		}
+		require.NoError(t, err, c.key+"="+c.value)
+		assert.Equal(t, c.want, got)
+	}
+}
+
+func TestRegistryScope(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	_, err := r.Validate(ScopeTenant, KeyPasswordMinLength, "8")
+	assert.NoError(t, err)
+
+	_, err = r.Validate(ScopeUser, KeyPasswordMinLength, "8")
+	assert.ErrorIs(t, err, ErrNotOverridable)
+
+	_, err = r.Validate(ScopeUser, KeyDefaultLanguage, "en-US")
+	assert.NoError(t, err)
+
+	require.NoError(t, r.Register(&Definition{Key: "platform.only", Kind: KindBool, Default: "false"}))
+	_, err = r.Validate(ScopeTenant, "platform.only", "true")
+	assert.ErrorIs(t, err, ErrNotOverridable)
+
+	assert.Error(t, r.Register(&Definition{Key: "bad.default", Kind: KindInt, Default: "x"}))
+}
+
+func TestRegistryResolve(t *testing.T) {
+	r := NewDefaultRegistry()
+
+	values := r.Resolve(
+		map[string]string{KeyPasswordMinLength: "8", KeyDefaultLanguage: "en-US"},
+		map[string]string{KeyPasswordMinLength: "10", KeyThemeColor: "bad"},
+		map[string]string{KeyPasswordMinLength: "2", KeyDefaultLanguage: "zh-CN", "unknown": "1"},
+	)
+
+	// 用户不能覆盖密码策略，非法的租户覆盖值被忽略
+	assert.EqualValues(t, 10, values.Int(KeyPasswordMinLength))
+	assert.Equal(t, "zh-CN", values.String(KeyDefaultLanguage))
+	assert.Equal(t, "#1677FF", values.String(KeyThemeColor))
+	assert.Equal(t, []string{LoginMethodPassword}, values.Strings(KeyLoginMethods))
+	assert.True(t, values.Bool(KeySelfRegistration))
+	assert.NotContains(t, values, "unknown")
+	assert.Len(t, values, len(r.List()))
+
+	sources := make(map[string]Source)
+	for _, item := range r.Effective(
+		map[string]string{KeyDefaultLanguage: "en-US"},
+		map[string]string{KeyPasswordMinLength: "10"},
+		map[string]string{KeyPasswordMinLength: "2", KeyThemeMode: "dark"},
+	) {
+		sources[item.Definition.Key] = item.Source
+	}
+	assert.Equal(t, SourceSystem, sources[KeyDefaultLanguage])
+	assert.Equal(t, SourceTenant, sources[KeyPasswordMinLength])
+	assert.Equal(t, SourceUser, sources[KeyThemeMode])
+	assert.Equal(t, SourceDefault, sources[KeyThemeColor])
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	p := PasswordPolicy{MinLength: 8, RequireDigit: true, RequireUpper: true, RequireSymbol: true}
+
+	assert.Error(t, p.Check("Ab1!"))
+	assert.EqualError(t, p.Check("abcdefgh"), "密码必须包含数字、大写字母、特殊字符")
+	assert.NoError(t, p.Check("Abcdefg1!"))
+
+	values := NewDefaultRegistry().Resolve(nil, nil, nil)
+	assert.NoError(t, PasswordPolicyFrom(values).Check("123456"))
+	assert.Error(t, PasswordPolicyFrom(values).Check("12345"))
+}
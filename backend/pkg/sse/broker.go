@@ -0,0 +1,173 @@
+package sse
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message 实例之间传递的消息，可以是主题事件，也可以是断开连接的控制消息
+type Message struct {
+	Topic Topic  `json:"topic,omitempty"`
+	Event *Event `json:"event,omitempty"`
+
+	CloseUserID    uint32 `json:"close_user_id,omitempty"`
+	CloseSessionID string `json:"close_session_id,omitempty"`
+}
+
+// Broker 事件总线。
+// 事件先保存到主题的缓冲中用于断线重连后的重放，再通知所有实例投递给本实例上的连接
+type Broker interface {
+	// Publish 保存事件并通知所有实例，返回分配了ID的事件
+	Publish(ctx context.Context, topic Topic, event *Event) (*Event, error)
+	// Send 通知所有实例，消息不会保存
+	Send(ctx context.Context, msg *Message) error
+	// Subscribe 接收所有实例发出的消息，ctx 结束时停止接收
+	Subscribe(ctx context.Context, handler func(msg *Message)) error
+	// Replay 按ID顺序返回主题中在 lastEventId 之后的事件，超过 limit 个时只返回最新的 limit 个
+	Replay(ctx context.Context, topics []Topic, lastEventId string, limit int) ([]*Event, error)
+}
+
+// compareEventId 比较形如 "毫秒时间戳-序号" 的事件ID，格式与Redis Stream的ID相同
+func compareEventId(a, b string) int {
+	am, as := parseEventId(a)
+	bm, bs := parseEventId(b)
+	if am != bm {
+		if am < bm {
+			return -1
+		}
+		return 1
+	}
+	switch {
+	case as < bs:
+		return -1
+	case as > bs:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func parseEventId(id string) (uint64, uint64) {
+	msPart, seqPart, _ := strings.Cut(id, "-")
+	ms, _ := strconv.ParseUint(msPart, 10, 64)
+	seq, _ := strconv.ParseUint(seqPart, 10, 64)
+	return ms, seq
+}
+
+// isValidEventId 事件ID是否为可以比较的格式
+func isValidEventId(id string) bool {
+	msPart, seqPart, ok := strings.Cut(id, "-")
+	if !ok {
+		return false
+	}
+	if _, err := strconv.ParseUint(msPart, 10, 64); err != nil {
+		return false
+	}
+	_, err := strconv.ParseUint(seqPart, 10, 64)
+	return err == nil
+}
+
+// mergeEvents 合并多个主题的事件，按ID排序并去掉重复的事件，超过 limit 个时保留最新的
+func mergeEvents(events []*Event, limit int) []*Event {
+	slices.SortFunc(events, func(a, b *Event) int { return compareEventId(a.ID, b.ID) })
+	events = slices.CompactFunc(events, func(a, b *Event) bool { return a.ID == b.ID })
+	if limit > 0 && len(events) > limit {
+		events = events[len(events)-limit:]
+	}
+	return events
+}
+
+// MemoryBroker 进程内的事件总线，只适用于单实例部署与测试
+type MemoryBroker struct {
+	mu       sync.Mutex
+	size     int
+	buffers  map[Topic][]*Event
+	handlers []func(msg *Message)
+
+	lastMs  uint64
+	lastSeq uint64
+}
+
+// NewMemoryBroker 创建进程内的事件总线，每个主题保留最近 size 个事件
+func NewMemoryBroker(size int) *MemoryBroker {
+	return &MemoryBroker{
+		size:    size,
+		buffers: make(map[Topic][]*Event),
+	}
+}
+
+func (b *MemoryBroker) nextId() string {
+	ms := uint64(time.Now().UnixMilli())
+	if ms <= b.lastMs {
+		b.lastSeq++
+	} else {
+		b.lastMs, b.lastSeq = ms, 0
+	}
+	return fmt.Sprintf("%d-%d", b.lastMs, b.lastSeq)
+}
+
+func (b *MemoryBroker) Publish(ctx context.Context, topic Topic, event *Event) (*Event, error) {
+	ev := *event
+
+	b.mu.Lock()
+	ev.ID = b.nextId()
+	if b.size > 0 {
+		buf := append(b.buffers[topic], &ev)
+		if len(buf) > b.size {
+			buf = buf[len(buf)-b.size:]
+		}
+		b.buffers[topic] = buf
+	}
+	b.mu.Unlock()
+
+	return &ev, b.Send(ctx, &Message{Topic: topic, Event: &ev})
+}
+
+func (b *MemoryBroker) Send(_ context.Context, msg *Message) error {
+	b.mu.Lock()
+	handlers := slices.Clone(b.handlers)
+	b.mu.Unlock()
+
+	for _, h := range handlers {
+		h(msg)
+	}
+	return nil
+}
+
+func (b *MemoryBroker) Subscribe(ctx context.Context, handler func(msg *Message)) error {
+	b.mu.Lock()
+	b.handlers = append(b.handlers, handler)
+	idx := len(b.handlers) - 1
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.handlers[idx] = func(*Message) {}
+	}()
+
+	return nil
+}
+
+func (b *MemoryBroker) Replay(_ context.Context, topics []Topic, lastEventId string, limit int) ([]*Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var events []*Event
+	for _, topic := range topics {
+		for _, ev := range b.buffers[topic] {
+			if compareEventId(ev.ID, lastEventId) > 0 {
+				events = append(events, ev)
+			}
+		}
+	}
+
+	return mergeEvents(events, limit), nil
+}
@@ -0,0 +1,28 @@
+package sse
+
+import "time"
+
+// Config 连接保活与重放的配置，与服务器的地址、路径一起写在 server.sse 配置中
+type Config struct {
+	// Heartbeat 心跳间隔，单位秒，为0时使用默认值
+	Heartbeat int `json:"heartbeat"`
+
+	// IdleTimeout 连接超过该时间没有收到事件时断开，单位秒，为0时不断开
+	IdleTimeout int `json:"idle_timeout"`
+
+	// ReplayLimit 重连时最多重放的事件数量，为0时使用默认值
+	ReplayLimit int `json:"replay_limit"`
+}
+
+// Options 转换为服务器选项
+func (c *Config) Options() []Option {
+	if c == nil {
+		return nil
+	}
+
+	return []Option{
+		WithHeartbeat(time.Duration(c.Heartbeat) * time.Second),
+		WithIdleTimeout(time.Duration(c.IdleTimeout) * time.Second),
+		WithReplayLimit(c.ReplayLimit),
+	}
+}
@@ -0,0 +1,46 @@
+package sse
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Event SSE事件
+type Event struct {
+	ID    string `json:"id,omitempty"`
+	Event string `json:"event,omitempty"`
+	Data  []byte `json:"data,omitempty"`
+}
+
+// writeTo 按 text/event-stream 格式写出事件，多行数据拆分为多个 data 字段
+func (e *Event) writeTo(w io.Writer) error {
+	var buf bytes.Buffer
+
+	if e.ID != "" {
+		_, _ = fmt.Fprintf(&buf, "id: %s\n", e.ID)
+	}
+	if e.Event != "" {
+		_, _ = fmt.Fprintf(&buf, "event: %s\n", e.Event)
+	}
+	for _, line := range bytes.Split(e.Data, []byte("\n")) {
+		_, _ = fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteByte('\n')
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeRetry 告诉浏览器断线后的重连间隔
+func writeRetry(w io.Writer, retry time.Duration) error {
+	_, err := fmt.Fprintf(w, "retry: %d\n\n", retry.Milliseconds())
+	return err
+}
+
+// writeComment 写出注释行，浏览器会忽略注释，用作心跳
+func writeComment(w io.Writer, comment string) error {
+	_, err := fmt.Fprintf(w, ": %s\n\n", comment)
+	return err
+}
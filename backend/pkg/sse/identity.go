@@ -0,0 +1,81 @@
+package sse
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Topic 订阅主题，事件按主题投递给订阅了该主题的连接
+type Topic string
+
+// BroadcastTopic 所有连接都订阅的广播主题
+const BroadcastTopic Topic = "broadcast"
+
+// UserTopic 用户主题，用户的所有连接都会订阅
+func UserTopic(userId uint32) Topic {
+	return Topic("user:" + strconv.FormatUint(uint64(userId), 10))
+}
+
+// TenantTopic 租户主题，租户下所有用户的连接都会订阅
+func TenantTopic(tenantId uint32) Topic {
+	return Topic("tenant:" + strconv.FormatUint(uint64(tenantId), 10))
+}
+
+// RoleTopic 角色主题，角色编码只在租户内唯一，所以主题带有租户ID
+func RoleTopic(tenantId uint32, role string) Topic {
+	return TenantTopic(tenantId) + Topic(":role:"+role)
+}
+
+// Identity 连接的身份，由认证器根据请求生成
+type Identity struct {
+	UserID   uint32 `json:"user_id"`
+	TenantID uint32 `json:"tenant_id,omitempty"`
+
+	// SessionID 登录会话ID，由访问令牌计算得到，登出或令牌失效时按会话断开连接
+	SessionID string `json:"session_id"`
+
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Topics 身份可以订阅的所有主题
+func (i *Identity) Topics() []Topic {
+	topics := []Topic{BroadcastTopic, UserTopic(i.UserID)}
+	if i.TenantID > 0 {
+		topics = append(topics, TenantTopic(i.TenantID))
+	}
+	for _, role := range i.Roles {
+		topics = append(topics, RoleTopic(i.TenantID, role))
+	}
+	return topics
+}
+
+// Authenticator 认证SSE请求，返回连接的身份
+type Authenticator func(r *http.Request) (*Identity, error)
+
+// SessionValidator 校验连接的登录会话是否仍然有效，无效的连接会被断开
+type SessionValidator func(ctx context.Context, identity *Identity) bool
+
+// SessionID 由访问令牌计算会话ID，避免令牌出现在日志与内存状态中
+func SessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// BearerToken 从请求头 Authorization 中取出 Bearer 令牌
+func BearerToken(r *http.Request) string {
+	return ParseBearer(r.Header.Get("Authorization"))
+}
+
+// ParseBearer 解析 Authorization 请求头的值，不是 Bearer 令牌时返回空
+func ParseBearer(header string) string {
+	const prefix = "bearer "
+
+	if len(header) > len(prefix) && strings.EqualFold(header[:len(prefix)], prefix) {
+		return strings.TrimSpace(header[len(prefix):])
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package sse
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisBrokerChannel = "events"
+
+	defaultRedisStreamMaxLen = 200
+	defaultRedisStreamTTL    = 24 * time.Hour
+)
+
+// RedisBroker 基于Redis的事件总线。
+// 每个主题的事件保存在一个 Stream 中，Stream 的ID作为事件ID用于重放；
+// 实例之间通过发布订阅通知新事件，任意实例发布的事件都能投递给连接在其他实例上的用户
+type RedisBroker struct {
+	rdb    redis.UniversalClient
+	prefix string
+
+	maxLen int64
+	ttl    time.Duration
+
+	log *log.Helper
+}
+
+// NewRedisBroker 创建Redis事件总线，每个主题最多保留 maxLen 个事件，主题没有新事件 ttl 后删除
+func NewRedisBroker(rdb redis.UniversalClient, prefix string, maxLen int64, ttl time.Duration, logger log.Logger) *RedisBroker {
+	if maxLen <= 0 {
+		maxLen = defaultRedisStreamMaxLen
+	}
+	if ttl <= 0 {
+		ttl = defaultRedisStreamTTL
+	}
+
+	return &RedisBroker{
+		rdb:    rdb,
+		prefix: prefix,
+		maxLen: maxLen,
+		ttl:    ttl,
+		log:    log.NewHelper(log.With(logger, "module", "sse/redis-broker")),
+	}
+}
+
+func (b *RedisBroker) streamKey(topic Topic) string {
+	return b.prefix + "stream:" + string(topic)
+}
+
+func (b *RedisBroker) Publish(ctx context.Context, topic Topic, event *Event) (*Event, error) {
+	key := b.streamKey(topic)
+
+	id, err := b.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: key,
+		MaxLen: b.maxLen,
+		Approx: true,
+		Values: map[string]any{
+			"event": event.Event,
+			"data":  event.Data,
+		},
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = b.rdb.Expire(ctx, key, b.ttl).Err(); err != nil {
+		b.log.Warnf("set expiration of [%s] failed: %s", key, err)
+	}
+
+	ev := *event
+	ev.ID = id
+
+	return &ev, b.Send(ctx, &Message{Topic: topic, Event: &ev})
+}
+
+func (b *RedisBroker) Send(ctx context.Context, msg *Message) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return b.rdb.Publish(ctx, b.prefix+redisBrokerChannel, data).Err()
+}
+
+func (b *RedisBroker) Subscribe(ctx context.Context, handler func(msg *Message)) error {
+	sub := b.rdb.Subscribe(ctx, b.prefix+redisBrokerChannel)
+
+	// 等待订阅生效，避免订阅前发布的消息丢失
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return err
+	}
+
+	go func() {
+		defer func() { _ = sub.Close() }()
+
+		ch := sub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case m, ok := <-ch:
+				if !ok {
+					return
+				}
+
+				var msg Message
+				if err := json.Unmarshal([]byte(m.Payload), &msg); err != nil {
+					b.log.Errorf("invalid message: %s", err)
+					continue
+				}
+
+				handler(&msg)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *RedisBroker) Replay(ctx context.Context, topics []Topic, lastEventId string, limit int) ([]*Event, error) {
+	var events []*Event
+	for _, topic := range topics {
+		// 只取最新的 limit 个事件，"(" 表示不包含 lastEventId 本身
+		cmd := b.rdb.XRevRangeN(ctx, b.streamKey(topic), "+", "("+lastEventId, int64(limit))
+		if limit <= 0 {
+			cmd = b.rdb.XRevRange(ctx, b.streamKey(topic), "+", "("+lastEventId)
+		}
+
+		entries, err := cmd.Result()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			ev := &Event{ID: entry.ID}
+			if v, ok := entry.Values["event"].(string); ok {
+				ev.Event = v
+			}
+			if v, ok := entry.Values["data"].(string); ok {
+				ev.Data = []byte(v)
+			}
+			events = append(events, ev)
+		}
+	}
+
+	return mergeEvents(events, limit), nil
+}
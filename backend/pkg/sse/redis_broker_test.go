@@ -0,0 +1,83 @@
+package sse
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestRedis 连接测试用的Redis，地址可通过 REDIS_ADDR 指定，连接失败时跳过测试
+func newTestRedis(t *testing.T) *redis.Client {
+	t.Helper()
+
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "127.0.0.1:6379"
+	}
+
+	rdb := redis.NewClient(&redis.Options{Addr: addr, DB: 15})
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		_ = rdb.Close()
+		t.Skipf("redis is not available at %s: %v", addr, err)
+	}
+
+	t.Cleanup(func() { _ = rdb.Close() })
+
+	return rdb
+}
+
+func TestRedisBroker(t *testing.T) {
+	rdb := newTestRedis(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	prefix := "test:sse:" + t.Name() + ":"
+	defer rdb.Del(context.Background(), prefix+"stream:"+string(UserTopic(1)))
+
+	a := NewRedisBroker(rdb, prefix, 10, time.Minute, log.DefaultLogger)
+	b := NewRedisBroker(rdb, prefix, 10, time.Minute, log.DefaultLogger)
+
+	received := make(chan *Message, 4)
+	require.NoError(t, b.Subscribe(ctx, func(msg *Message) { received <- msg }))
+
+	first, err := a.Publish(ctx, UserTopic(1), &Event{Event: "a", Data: []byte("1")})
+	require.NoError(t, err)
+	second, err := a.Publish(ctx, UserTopic(1), &Event{Event: "b", Data: []byte("2")})
+	require.NoError(t, err)
+
+	select {
+	case msg := <-received:
+		assert.Equal(t, UserTopic(1), msg.Topic)
+		assert.Equal(t, first.ID, msg.Event.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for message")
+	}
+
+	events, err := b.Replay(ctx, []Topic{UserTopic(1)}, first.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, second.ID, events[0].ID)
+	assert.Equal(t, "b", events[0].Event)
+	assert.Equal(t, []byte("2"), events[0].Data)
+
+	require.NoError(t, a.Send(ctx, &Message{CloseUserID: 1}))
+	for {
+		select {
+		case msg := <-received:
+			if msg.CloseUserID == 1 {
+				return
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for close message")
+		}
+	}
+}
@@ -0,0 +1,580 @@
+package sse
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+var _ transport.Server = (*Server)(nil)
+
+const (
+	defaultPath             = "/events"
+	defaultBufferSize       = 64
+	defaultValidateInterval = time.Minute
+	defaultTicketTTL        = 30 * time.Second
+	defaultHeartbeat        = 15 * time.Second
+	defaultWriteTimeout     = 10 * time.Second
+	defaultReplayLimit      = 100
+	defaultRetry            = 3 * time.Second
+)
+
+// Option 服务器选项
+type Option func(*Server)
+
+// WithAddress 监听地址
+func WithAddress(addr string) Option {
+	return func(s *Server) {
+		if addr != "" {
+			s.address = addr
+		}
+	}
+}
+
+// WithPath 连接路径
+func WithPath(path string) Option {
+	return func(s *Server) {
+		if path != "" {
+			s.path = path
+		}
+	}
+}
+
+// WithAuthenticator 通过请求头认证连接
+func WithAuthenticator(fn Authenticator) Option {
+	return func(s *Server) {
+		s.authenticate = fn
+	}
+}
+
+// WithTicketStore 通过一次性票据认证连接，票据放在查询参数 ticket 中
+func WithTicketStore(store TicketStore) Option {
+	return func(s *Server) {
+		s.tickets = store
+	}
+}
+
+// WithTicketTTL 票据的有效期
+func WithTicketTTL(ttl time.Duration) Option {
+	return func(s *Server) {
+		if ttl > 0 {
+			s.ticketTTL = ttl
+		}
+	}
+}
+
+// WithSessionValidator 定期校验连接的登录会话，令牌被吊销后断开连接
+func WithSessionValidator(fn SessionValidator, interval time.Duration) Option {
+	return func(s *Server) {
+		s.validate = fn
+		if interval > 0 {
+			s.validateInterval = interval
+		}
+	}
+}
+
+// WithBroker 事件总线，多实例部署时使用Redis事件总线，默认为进程内的事件总线
+func WithBroker(broker Broker) Option {
+	return func(s *Server) {
+		s.broker = broker
+	}
+}
+
+// WithHeartbeat 心跳间隔，空闲的连接定期发送注释行，避免被代理断开，也用于及时发现已断开的连接
+func WithHeartbeat(interval time.Duration) Option {
+	return func(s *Server) {
+		if interval > 0 {
+			s.heartbeat = interval
+		}
+	}
+}
+
+// WithIdleTimeout 连接超过该时间没有收到事件时断开，客户端会带上 Last-Event-ID 重连，为0时不断开
+func WithIdleTimeout(timeout time.Duration) Option {
+	return func(s *Server) {
+		s.idleTimeout = timeout
+	}
+}
+
+// WithReplayLimit 重连时最多重放的事件数量
+func WithReplayLimit(limit int) Option {
+	return func(s *Server) {
+		if limit > 0 {
+			s.replayLimit = limit
+		}
+	}
+}
+
+// WithBufferSize 每个连接的事件缓冲数量，缓冲满时丢弃新事件
+func WithBufferSize(size int) Option {
+	return func(s *Server) {
+		if size > 0 {
+			s.bufferSize = size
+		}
+	}
+}
+
+// WithLogger 日志
+func WithLogger(logger log.Logger) Option {
+	return func(s *Server) {
+		s.log = log.NewHelper(log.With(logger, "module", "sse/server"))
+	}
+}
+
+// client 一个SSE连接
+type client struct {
+	id       uint64
+	identity *Identity
+	topics   []Topic
+
+	events    chan *Event
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *client) close() {
+	c.closeOnce.Do(func() { close(c.done) })
+}
+
+// Server 需要认证的SSE服务器。
+// 连接建立时通过认证器或一次性票据确定身份，并按身份订阅用户、租户、角色与广播主题；
+// 登出或令牌被吊销时断开对应会话的连接。
+// 事件经过事件总线发布，使用Redis事件总线时任意实例发布的事件都能投递到连接在其他实例上的用户，
+// 客户端重连时通过 Last-Event-ID 重放断线期间错过的事件。
+// 空闲的连接定期发送心跳，配置了空闲超时时超过该时间没有收到事件的连接会被断开
+type Server struct {
+	*http.Server
+
+	lis     net.Listener
+	address string
+	path    string
+
+	authenticate Authenticator
+	tickets      TicketStore
+	ticketTTL    time.Duration
+
+	validate         SessionValidator
+	validateInterval time.Duration
+
+	bufferSize int
+
+	broker      Broker
+	replayLimit int
+	heartbeat   time.Duration
+	idleTimeout time.Duration
+
+	mu      sync.RWMutex
+	clients map[uint64]*client
+	topics  map[Topic]map[uint64]*client
+	nextId  atomic.Uint64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	log *log.Helper
+}
+
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		address:          ":0",
+		path:             defaultPath,
+		bufferSize:       defaultBufferSize,
+		validateInterval: defaultValidateInterval,
+		ticketTTL:        defaultTicketTTL,
+		replayLimit:      defaultReplayLimit,
+		heartbeat:        defaultHeartbeat,
+		clients:          make(map[uint64]*client),
+		topics:           make(map[Topic]map[uint64]*client),
+		log:              log.NewHelper(log.With(log.DefaultLogger, "module", "sse/server")),
+	}
+
+	for _, o := range opts {
+		o(s)
+	}
+
+	if s.broker == nil {
+		s.broker = NewMemoryBroker(s.replayLimit)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(s.path, s)
+	s.Server = &http.Server{Handler: mux}
+
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.BaseContext = func(net.Listener) context.Context { return s.ctx }
+
+	if err := s.broker.Subscribe(s.ctx, s.handleMessage); err != nil {
+		s.log.Errorf("subscribe broker failed: %s", err)
+	}
+
+	return s
+}
+
+func (s *Server) Start(_ context.Context) error {
+	lis, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return err
+	}
+	s.lis = lis
+
+	if s.validate != nil {
+		go s.runValidator(s.ctx)
+	}
+
+	s.log.Infof("server listening on: %s", lis.Addr().String())
+
+	if err = s.Serve(lis); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	s.cancel()
+
+	s.mu.Lock()
+	for _, c := range s.clients {
+		c.close()
+	}
+	s.mu.Unlock()
+
+	return s.Shutdown(ctx)
+}
+
+// ServeHTTP 处理SSE连接，查询参数 topics 可以指定只订阅部分主题，多个主题用逗号分隔
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if _, ok := w.(http.Flusher); !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	identity, err := s.authenticateRequest(r)
+	if err != nil {
+		s.log.Warnf("reject sse connection from [%s]: %s", r.RemoteAddr, err)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	topics, err := selectTopics(identity, r.URL.Query().Get("topics"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	// 先注册再重放，重放期间发布的事件会在缓冲中等待，按ID去掉重复
+	c := s.register(identity, topics)
+	defer s.unregister(c)
+
+	rc := http.NewResponseController(w)
+	write := func(fn func() error) bool {
+		_ = rc.SetWriteDeadline(time.Now().Add(defaultWriteTimeout))
+		if err := fn(); err != nil {
+			return false
+		}
+		return rc.Flush() == nil
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if !write(func() error { return writeRetry(w, defaultRetry) }) {
+		return
+	}
+
+	lastId := lastEventId(r)
+	if lastId != "" {
+		replayed, err := s.broker.Replay(r.Context(), topics, lastId, s.replayLimit)
+		if err != nil {
+			s.log.Errorf("replay events after [%s] for user [%d] failed: %s", lastId, identity.UserID, err)
+		}
+		for _, ev := range replayed {
+			if !write(func() error { return ev.writeTo(w) }) {
+				return
+			}
+			lastId = ev.ID
+		}
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+
+	var idle <-chan time.Time
+	var idleTimer *time.Timer
+	if s.idleTimeout > 0 {
+		idleTimer = time.NewTimer(s.idleTimeout)
+		defer idleTimer.Stop()
+		idle = idleTimer.C
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-c.done:
+			return
+		case <-idle:
+			return
+		case <-heartbeat.C:
+			if !write(func() error { return writeComment(w, "ping") }) {
+				return
+			}
+		case ev := <-c.events:
+			if lastId != "" && ev.ID != "" && compareEventId(ev.ID, lastId) <= 0 {
+				continue
+			}
+			if !write(func() error { return ev.writeTo(w) }) {
+				return
+			}
+			if idleTimer != nil {
+				idleTimer.Reset(s.idleTimeout)
+			}
+		}
+	}
+}
+
+// lastEventId 客户端最后收到的事件ID，浏览器自动重连时通过请求头传递，
+// 使用一次性票据重连时浏览器不会带上请求头，由客户端通过查询参数传递
+func lastEventId(r *http.Request) string {
+	id := r.Header.Get("Last-Event-ID")
+	if id == "" {
+		id = r.URL.Query().Get("last_event_id")
+	}
+	if !isValidEventId(id) {
+		return ""
+	}
+	return id
+}
+
+// IssueTicket 为已认证的身份签发一次性连接票据，返回票据与有效期
+func (s *Server) IssueTicket(ctx context.Context, identity *Identity) (string, time.Duration, error) {
+	if s == nil || s.tickets == nil {
+		return "", 0, errors.New("sse: ticket store not configured")
+	}
+
+	ticket, err := s.tickets.Issue(ctx, identity, s.ticketTTL)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return ticket, s.ticketTTL, nil
+}
+
+// authenticateRequest 优先使用一次性票据，其次使用认证器
+func (s *Server) authenticateRequest(r *http.Request) (*Identity, error) {
+	if ticket := r.URL.Query().Get("ticket"); ticket != "" {
+		if s.tickets == nil {
+			return nil, ErrInvalidTicket
+		}
+		return s.tickets.Redeem(r.Context(), ticket)
+	}
+
+	if s.authenticate == nil {
+		return nil, errors.New("sse: no authenticator")
+	}
+
+	return s.authenticate(r)
+}
+
+// selectTopics 计算连接订阅的主题，未指定时订阅身份允许的所有主题
+func selectTopics(identity *Identity, requested string) ([]Topic, error) {
+	allowed := identity.Topics()
+	if requested == "" {
+		return allowed, nil
+	}
+
+	var topics []Topic
+	for _, t := range strings.Split(requested, ",") {
+		topic := Topic(strings.TrimSpace(t))
+		if topic == "" || slices.Contains(topics, topic) {
+			continue
+		}
+		if !slices.Contains(allowed, topic) {
+			return nil, errors.New("sse: topic not allowed: " + string(topic))
+		}
+		topics = append(topics, topic)
+	}
+
+	return topics, nil
+}
+
+func (s *Server) register(identity *Identity, topics []Topic) *client {
+	c := &client{
+		id:       s.nextId.Add(1),
+		identity: identity,
+		topics:   topics,
+		events:   make(chan *Event, s.bufferSize),
+		done:     make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.clients[c.id] = c
+	for _, topic := range topics {
+		subs, ok := s.topics[topic]
+		if !ok {
+			subs = make(map[uint64]*client)
+			s.topics[topic] = subs
+		}
+		subs[c.id] = c
+	}
+
+	s.log.Infof("user [%d] connected, session [%s], topics %v", identity.UserID, identity.SessionID, topics)
+
+	return c
+}
+
+func (s *Server) unregister(c *client) {
+	c.close()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.clients, c.id)
+	for _, topic := range c.topics {
+		if subs, ok := s.topics[topic]; ok {
+			delete(subs, c.id)
+			if len(subs) == 0 {
+				delete(s.topics, topic)
+			}
+		}
+	}
+
+	s.log.Infof("user [%d] disconnected, session [%s]", c.identity.UserID, c.identity.SessionID)
+}
+
+// Publish 向订阅了主题的所有连接推送事件，连接可以在任意实例上
+func (s *Server) Publish(ctx context.Context, topic Topic, event *Event) {
+	if s == nil || event == nil {
+		return
+	}
+
+	if _, err := s.broker.Publish(ctx, topic, event); err != nil {
+		s.log.Errorf("publish event [%s] to [%s] failed: %s", event.Event, topic, err)
+	}
+}
+
+// handleMessage 处理事件总线上的消息
+func (s *Server) handleMessage(msg *Message) {
+	switch {
+	case msg.CloseUserID != 0:
+		s.closeLocal(func(identity *Identity) bool { return identity.UserID == msg.CloseUserID })
+	case msg.CloseSessionID != "":
+		s.closeLocal(func(identity *Identity) bool { return identity.SessionID == msg.CloseSessionID })
+	case msg.Event != nil:
+		s.deliver(msg.Topic, msg.Event)
+	}
+}
+
+// deliver 向本实例上订阅了主题的连接投递事件
+func (s *Server) deliver(topic Topic, event *Event) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, c := range s.topics[topic] {
+		select {
+		case c.events <- event:
+		default:
+			s.log.Warnf("drop event [%s] for user [%d]: buffer full", event.Event, c.identity.UserID)
+		}
+	}
+}
+
+// PublishToUser 向用户的所有连接推送事件
+func (s *Server) PublishToUser(ctx context.Context, userId uint32, event *Event) {
+	s.Publish(ctx, UserTopic(userId), event)
+}
+
+// CloseUser 断开用户在所有实例上的连接
+func (s *Server) CloseUser(userId uint32) {
+	s.send(&Message{CloseUserID: userId})
+}
+
+// CloseSession 断开登录会话在所有实例上的连接
+func (s *Server) CloseSession(sessionId string) {
+	s.send(&Message{CloseSessionID: sessionId})
+}
+
+// send 通过事件总线通知所有实例，失败时至少处理本实例
+func (s *Server) send(msg *Message) {
+	if s == nil {
+		return
+	}
+
+	if err := s.broker.Send(s.ctx, msg); err != nil {
+		s.log.Errorf("send message failed: %s", err)
+		s.handleMessage(msg)
+	}
+}
+
+func (s *Server) closeLocal(match func(identity *Identity) bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, c := range s.clients {
+		if match(c.identity) {
+			c.close()
+		}
+	}
+}
+
+// ConnectionCount 当前的连接数量
+func (s *Server) ConnectionCount() int {
+	if s == nil {
+		return 0
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.clients)
+}
+
+// runValidator 定期校验所有连接的会话，同一会话只校验一次
+func (s *Server) runValidator(ctx context.Context) {
+	ticker := time.NewTicker(s.validateInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.validateSessions(ctx)
+		}
+	}
+}
+
+func (s *Server) validateSessions(ctx context.Context) {
+	sessions := make(map[string]*Identity)
+
+	s.mu.RLock()
+	for _, c := range s.clients {
+		sessions[c.identity.SessionID] = c.identity
+	}
+	s.mu.RUnlock()
+
+	for sessionId, identity := range sessions {
+		if !s.validate(ctx, identity) {
+			s.log.Infof("session [%s] of user [%d] is no longer valid, closing", sessionId, identity.UserID)
+			s.CloseSession(sessionId)
+		}
+	}
+}
@@ -0,0 +1,366 @@
+package sse
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testIdentities = map[string]*Identity{
+	"token-alice": {UserID: 1, TenantID: 10, SessionID: SessionID("token-alice"), Roles: []string{"admin"}},
+	"token-bob":   {UserID: 2, TenantID: 10, SessionID: SessionID("token-bob")},
+}
+
+func testAuthenticator(r *http.Request) (*Identity, error) {
+	if identity, ok := testIdentities[BearerToken(r)]; ok {
+		return identity, nil
+	}
+	return nil, errors.New("invalid token")
+}
+
+func newTestServer(t *testing.T, opts ...Option) (*Server, *httptest.Server) {
+	t.Helper()
+
+	s := NewServer(append([]Option{WithAuthenticator(testAuthenticator)}, opts...)...)
+	ts := httptest.NewServer(s)
+	t.Cleanup(ts.Close)
+
+	return s, ts
+}
+
+// connect 建立SSE连接，返回读取事件名称的通道，注释行以 ":" 开头
+func connect(t *testing.T, ts *httptest.Server, token, query string) (*http.Response, <-chan string) {
+	t.Helper()
+
+	resp, events := connectWithHeader(t, ts, token, query, nil)
+
+	names := make(chan string, 16)
+	go func() {
+		defer close(names)
+		for ev := range events {
+			names <- ev.Event
+		}
+	}()
+
+	return resp, names
+}
+
+// connectWithHeader 建立SSE连接，返回读取事件的通道
+func connectWithHeader(t *testing.T, ts *httptest.Server, token, query string, header http.Header) (*http.Response, <-chan *Event) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, ts.URL+"?"+query, nil)
+	require.NoError(t, err)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	events := make(chan *Event, 16)
+	go func() {
+		defer close(events)
+
+		ev := &Event{}
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if ev.Event != "" {
+					events <- ev
+				}
+				ev = &Event{}
+			case strings.HasPrefix(line, ": "):
+				ev.Event = ":" + strings.TrimPrefix(line, ": ")
+			case strings.HasPrefix(line, "id: "):
+				ev.ID = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				ev.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				ev.Data = append(ev.Data, strings.TrimPrefix(line, "data: ")...)
+			}
+		}
+	}()
+
+	return resp, events
+}
+
+func waitConnections(t *testing.T, s *Server, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return s.ConnectionCount() == n }, time.Second, 10*time.Millisecond)
+}
+
+func receive(t *testing.T, events <-chan string) string {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for event")
+		return ""
+	}
+}
+
+func TestServer_RejectsUnauthenticated(t *testing.T) {
+	_, ts := newTestServer(t)
+
+	resp, _ := connect(t, ts, "", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = connect(t, ts, "wrong", "stream=token-alice")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestServer_PublishByTopic(t *testing.T) {
+	s, ts := newTestServer(t)
+	ctx := context.Background()
+
+	resp, alice := connect(t, ts, "token-alice", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, bob := connect(t, ts, "token-bob", "")
+	waitConnections(t, s, 2)
+
+	s.PublishToUser(ctx, 2, &Event{Event: "to_bob", Data: []byte("{}")})
+	assert.Equal(t, "to_bob", receive(t, bob))
+
+	s.Publish(ctx, RoleTopic(10, "admin"), &Event{Event: "to_admins", Data: []byte("{}")})
+	assert.Equal(t, "to_admins", receive(t, alice))
+
+	s.Publish(ctx, TenantTopic(10), &Event{Event: "to_tenant", Data: []byte("{}")})
+	assert.Equal(t, "to_tenant", receive(t, alice))
+	assert.Equal(t, "to_tenant", receive(t, bob))
+
+	s.Publish(ctx, BroadcastTopic, &Event{Event: "to_all", Data: []byte("{}")})
+	assert.Equal(t, "to_all", receive(t, alice))
+	assert.Equal(t, "to_all", receive(t, bob))
+}
+
+func TestServer_TopicSelection(t *testing.T) {
+	s, ts := newTestServer(t)
+
+	resp, _ := connect(t, ts, "token-bob", "topics=user:1")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	resp, bob := connect(t, ts, "token-bob", "topics=user:2")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	waitConnections(t, s, 1)
+
+	s.Publish(context.Background(), TenantTopic(10), &Event{Event: "ignored", Data: []byte("{}")})
+	s.PublishToUser(context.Background(), 2, &Event{Event: "direct", Data: []byte("{}")})
+	assert.Equal(t, "direct", receive(t, bob))
+}
+
+func TestServer_Ticket(t *testing.T) {
+	store := NewMemoryTicketStore()
+	s, ts := newTestServer(t, WithTicketStore(store))
+
+	ticket, ttl, err := s.IssueTicket(context.Background(), testIdentities["token-bob"])
+	require.NoError(t, err)
+	assert.Equal(t, defaultTicketTTL, ttl)
+
+	resp, bob := connect(t, ts, "", "ticket="+ticket)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	waitConnections(t, s, 1)
+
+	s.PublishToUser(context.Background(), 2, &Event{Event: "hello", Data: []byte("{}")})
+	assert.Equal(t, "hello", receive(t, bob))
+
+	// 票据只能使用一次
+	resp, _ = connect(t, ts, "", "ticket="+ticket)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// 票据携带角色，连接订阅角色主题
+	ticket, _, err = s.IssueTicket(context.Background(), testIdentities["token-alice"])
+	require.NoError(t, err)
+
+	resp, alice := connect(t, ts, "", "ticket="+ticket)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	waitConnections(t, s, 2)
+
+	s.Publish(context.Background(), RoleTopic(10, "admin"), &Event{Event: "to_admins", Data: []byte("{}")})
+	assert.Equal(t, "to_admins", receive(t, alice))
+}
+
+func TestServer_CloseSession(t *testing.T) {
+	s, ts := newTestServer(t)
+
+	_, alice := connect(t, ts, "token-alice", "")
+	_, _ = connect(t, ts, "token-bob", "")
+	waitConnections(t, s, 2)
+
+	s.CloseSession(SessionID("token-alice"))
+	waitConnections(t, s, 1)
+
+	_, ok := <-alice
+	assert.False(t, ok)
+
+	s.CloseUser(2)
+	waitConnections(t, s, 0)
+}
+
+func TestServer_SessionValidator(t *testing.T) {
+	s, ts := newTestServer(t, WithSessionValidator(func(_ context.Context, identity *Identity) bool {
+		return identity.UserID != 1
+	}, time.Hour))
+
+	_, _ = connect(t, ts, "token-alice", "")
+	_, _ = connect(t, ts, "token-bob", "")
+	waitConnections(t, s, 2)
+
+	s.validateSessions(context.Background())
+	waitConnections(t, s, 1)
+}
+
+func TestServer_NilSafe(t *testing.T) {
+	var s *Server
+	s.PublishToUser(context.Background(), 1, &Event{Event: "noop"})
+	s.CloseUser(1)
+	assert.Equal(t, 0, s.ConnectionCount())
+}
+
+func receiveEvent(t *testing.T, events <-chan *Event) *Event {
+	t.Helper()
+	select {
+	case ev := <-events:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for event")
+		return nil
+	}
+}
+
+func TestServer_ReplayAfterLastEventId(t *testing.T) {
+	s, ts := newTestServer(t)
+	ctx := context.Background()
+
+	resp, events := connectWithHeader(t, ts, "token-bob", "", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	waitConnections(t, s, 1)
+
+	s.PublishToUser(ctx, 2, &Event{Event: "first", Data: []byte("1")})
+	first := receiveEvent(t, events)
+	assert.Equal(t, "first", first.Event)
+	require.NotEmpty(t, first.ID)
+
+	_ = resp.Body.Close()
+	waitConnections(t, s, 0)
+
+	// 断线期间发布的事件
+	s.PublishToUser(ctx, 2, &Event{Event: "second", Data: []byte("2")})
+	s.Publish(ctx, TenantTopic(10), &Event{Event: "third", Data: []byte("3")})
+	s.PublishToUser(ctx, 1, &Event{Event: "not_for_bob", Data: []byte("x")})
+
+	resp, events = connectWithHeader(t, ts, "token-bob", "", http.Header{"Last-Event-ID": {first.ID}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	assert.Equal(t, "second", receiveEvent(t, events).Event)
+	assert.Equal(t, "third", receiveEvent(t, events).Event)
+
+	// 查询参数同样可以指定重放位置
+	_, events = connectWithHeader(t, ts, "token-bob", "last_event_id="+first.ID, nil)
+	assert.Equal(t, "second", receiveEvent(t, events).Event)
+}
+
+func TestServer_SharedBroker(t *testing.T) {
+	// 两个服务器共用一个事件总线，模拟多实例部署
+	broker := NewMemoryBroker(10)
+	a, tsA := newTestServer(t, WithBroker(broker))
+	b, tsB := newTestServer(t, WithBroker(broker))
+
+	_, alice := connect(t, tsA, "token-alice", "")
+	_, bob := connect(t, tsB, "token-bob", "")
+	waitConnections(t, a, 1)
+	waitConnections(t, b, 1)
+
+	a.PublishToUser(context.Background(), 2, &Event{Event: "cross_replica", Data: []byte("{}")})
+	assert.Equal(t, "cross_replica", receive(t, bob))
+
+	b.CloseUser(1)
+	waitConnections(t, a, 0)
+
+	_, ok := <-alice
+	assert.False(t, ok)
+}
+
+func TestServer_HeartbeatAndIdleTimeout(t *testing.T) {
+	s, ts := newTestServer(t, WithHeartbeat(20*time.Millisecond), WithIdleTimeout(200*time.Millisecond))
+
+	_, events := connect(t, ts, "token-bob", "")
+	waitConnections(t, s, 1)
+
+	assert.Equal(t, ":ping", receive(t, events))
+
+	// 超过空闲时间后断开连接
+	waitConnections(t, s, 0)
+}
+
+func TestServer_IdleTimeoutFromConfig(t *testing.T) {
+	cfg := &Config{Heartbeat: 10, IdleTimeout: 1}
+	s, ts := newTestServer(t, cfg.Options()...)
+
+	_, events := connect(t, ts, "token-bob", "")
+	waitConnections(t, s, 1)
+
+	// 收到事件后重新计算空闲时间
+	time.Sleep(500 * time.Millisecond)
+	s.PublishToUser(context.Background(), 2, &Event{Event: "keepalive", Data: []byte("{}")})
+	assert.Equal(t, "keepalive", receive(t, events))
+
+	time.Sleep(700 * time.Millisecond)
+	assert.Equal(t, 1, s.ConnectionCount())
+
+	require.Eventually(t, func() bool { return s.ConnectionCount() == 0 }, 2*time.Second, 10*time.Millisecond)
+	_, ok := <-events
+	assert.False(t, ok)
+
+	// 没有配置时不断开空闲连接
+	assert.Nil(t, (*Config)(nil).Options())
+}
+
+func TestMemoryBroker_Replay(t *testing.T) {
+	broker := NewMemoryBroker(2)
+	ctx := context.Background()
+
+	first, err := broker.Publish(ctx, UserTopic(1), &Event{Event: "a"})
+	require.NoError(t, err)
+	_, _ = broker.Publish(ctx, UserTopic(1), &Event{Event: "b"})
+	_, _ = broker.Publish(ctx, UserTopic(1), &Event{Event: "c"})
+	_, _ = broker.Publish(ctx, UserTopic(2), &Event{Event: "d"})
+
+	// 每个主题只保留最近的2个事件
+	events, err := broker.Replay(ctx, []Topic{UserTopic(1)}, "0-0", 0)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "b", events[0].Event)
+	assert.Equal(t, "c", events[1].Event)
+
+	events, err = broker.Replay(ctx, []Topic{UserTopic(1), UserTopic(2)}, first.ID, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "c", events[0].Event)
+	assert.Equal(t, "d", events[1].Event)
+}
+
+func TestCompareEventId(t *testing.T) {
+	assert.Equal(t, -1, compareEventId("1-0", "1-1"))
+	assert.Equal(t, 1, compareEventId("2-0", "1-9"))
+	assert.Equal(t, 0, compareEventId("3-4", "3-4"))
+
+	assert.True(t, isValidEventId("1700000000000-0"))
+	assert.False(t, isValidEventId("not-an-id"))
+	assert.False(t, isValidEventId("12"))
+}
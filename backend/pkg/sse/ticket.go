@@ -0,0 +1,133 @@
+package sse
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrInvalidTicket 票据不存在、已使用或已过期
+var ErrInvalidTicket = errors.New("sse: invalid or expired ticket")
+
+// TicketStore 一次性连接票据。
+// 浏览器的 EventSource 不能设置请求头，客户端先通过认证过的接口换取短期票据，再用票据建立连接，
+// 这样访问令牌就不会出现在URL与访问日志中
+type TicketStore interface {
+	// Issue 为身份签发票据，票据在 ttl 后过期
+	Issue(ctx context.Context, identity *Identity, ttl time.Duration) (string, error)
+	// Redeem 使用票据，票据只能使用一次
+	Redeem(ctx context.Context, ticket string) (*Identity, error)
+}
+
+// newTicket 生成随机票据
+func newTicket() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+type memoryTicket struct {
+	identity *Identity
+	expireAt time.Time
+}
+
+// MemoryTicketStore 进程内的票据存储，只适用于单实例部署与测试
+type MemoryTicketStore struct {
+	mu      sync.Mutex
+	tickets map[string]memoryTicket
+}
+
+func NewMemoryTicketStore() *MemoryTicketStore {
+	return &MemoryTicketStore{tickets: make(map[string]memoryTicket)}
+}
+
+func (s *MemoryTicketStore) Issue(_ context.Context, identity *Identity, ttl time.Duration) (string, error) {
+	ticket, err := newTicket()
+	if err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for k, v := range s.tickets {
+		if now.After(v.expireAt) {
+			delete(s.tickets, k)
+		}
+	}
+
+	s.tickets[ticket] = memoryTicket{identity: identity, expireAt: now.Add(ttl)}
+
+	return ticket, nil
+}
+
+func (s *MemoryTicketStore) Redeem(_ context.Context, ticket string) (*Identity, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tickets[ticket]
+	if !ok {
+		return nil, ErrInvalidTicket
+	}
+	delete(s.tickets, ticket)
+
+	if time.Now().After(t.expireAt) {
+		return nil, ErrInvalidTicket
+	}
+
+	return t.identity, nil
+}
+
+// RedisTicketStore 基于Redis的票据存储，票据可以在任意实例上使用
+type RedisTicketStore struct {
+	rdb    redis.UniversalClient
+	prefix string
+}
+
+func NewRedisTicketStore(rdb redis.UniversalClient, prefix string) *RedisTicketStore {
+	return &RedisTicketStore{rdb: rdb, prefix: prefix}
+}
+
+func (s *RedisTicketStore) Issue(ctx context.Context, identity *Identity, ttl time.Duration) (string, error) {
+	ticket, err := newTicket()
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(identity)
+	if err != nil {
+		return "", err
+	}
+
+	if err = s.rdb.Set(ctx, s.prefix+ticket, data, ttl).Err(); err != nil {
+		return "", err
+	}
+
+	return ticket, nil
+}
+
+func (s *RedisTicketStore) Redeem(ctx context.Context, ticket string) (*Identity, error) {
+	data, err := s.rdb.GetDel(ctx, s.prefix+ticket).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrInvalidTicket
+		}
+		return nil, err
+	}
+
+	var identity Identity
+	if err = json.Unmarshal(data, &identity); err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
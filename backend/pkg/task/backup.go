@@ -1,18 +1,55 @@
 package task
 
-import "fmt"
+import (
+	"errors"
+	"regexp"
+)
 
 const (
 	BackupTaskType = "backup"
+
+	// DefaultBackupBucket 备份文件默认存放的存储桶
+	DefaultBackupBucket = "backups"
 )
 
+// 备份名称会作为存储目录与文件名的一部分
+var backupNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
 type BackupTaskData struct {
-	Name string `json:"name"`
+	Name string `json:"name" description:"备份名称，用作存储目录与文件名，只能包含字母、数字、下划线和中划线"`
+
+	// Bucket 备份文件存放的存储桶，为空时使用 DefaultBackupBucket
+	Bucket string `json:"bucket,omitempty" description:"备份文件存放的存储桶"`
+
+	// KeepLast 保留最近的备份数量，0 表示不按数量清理
+	KeepLast int `json:"keep_last,omitempty" description:"保留最近的备份数量，0表示不按数量清理"`
+	// KeepDays 保留最近多少天的备份，0 表示不按时间清理
+	KeepDays int `json:"keep_days,omitempty" description:"保留最近多少天的备份，0表示不按时间清理"`
+}
+
+// Validate 校验备份任务数据
+func (d *BackupTaskData) Validate() error {
+	if !backupNamePattern.MatchString(d.Name) {
+		return errors.New("name must be 1-64 letters, digits, '_' or '-'")
+	}
+	if d.KeepLast < 0 || d.KeepDays < 0 {
+		return errors.New("keep_last and keep_days must not be negative")
+	}
+	return nil
+}
+
+// GetBucket 返回备份文件存放的存储桶
+func (d *BackupTaskData) GetBucket() string {
+	if d == nil || d.Bucket == "" {
+		return DefaultBackupBucket
+	}
+	return d.Bucket
 }
 
-// CreateBackupTaskID creates a unique task ID for a backup task based on the lottery details.
-func CreateBackupTaskID(taskId uint32) string {
-	return fmt.Sprintf("%s:%d",
-		BackupTaskType, taskId,
-	)
+// GetDirectory 返回备份文件存放的目录，同名的备份任务共用一个目录，按目录清理过期备份
+func (d *BackupTaskData) GetDirectory() string {
+	if d == nil || d.Name == "" {
+		return "database"
+	}
+	return d.Name
 }
@@ -0,0 +1,113 @@
+package task
+
+import (
+	"errors"
+	"strconv"
+)
+
+const (
+	// InternalMessageSendTaskType 定时站内信的投递任务，由站内信服务投递，不在任务管理中登记
+	InternalMessageSendTaskType = "internal_message_send"
+
+	// InternalMessageFanoutTaskType 站内信分发任务，把接收者拆分为批次后投递批次任务
+	InternalMessageFanoutTaskType = "internal_message_fanout"
+
+	// InternalMessageDeliverTaskType 站内信批次投递任务，批量写入一批接收者的收件箱
+	InternalMessageDeliverTaskType = "internal_message_deliver"
+
+	// InternalMessageChannelTaskType 站内信外部渠道投递任务，通过邮件、短信等渠道发送一批收件记录
+	InternalMessageChannelTaskType = "internal_message_channel"
+
+	// InternalMessageDigestTaskType 站内信摘要任务，把用户摘要模式下积累的低优先级通知汇总为一条摘要消息
+	InternalMessageDigestTaskType = "internal_message_digest"
+
+	// ArchiveInternalMessageTaskType 归档过期的站内信
+	ArchiveInternalMessageTaskType = "archive_internal_message"
+)
+
+// InternalMessageSendTaskData 定时站内信投递任务的数据
+type InternalMessageSendTaskData struct {
+	MessageId uint32 `json:"message_id"`
+
+	// TenantId 消息所属的租户，任务在该租户的上下文中解析受众，0 表示平台的消息
+	TenantId uint32 `json:"tenant_id,omitempty"`
+
+	// SendAt 投递时消息的计划发送时间（Unix秒），与消息当前的计划发送时间不一致时说明消息已被修改或取消，本次投递作废
+	SendAt int64 `json:"send_at"`
+}
+
+// CreateInternalMessageSendTaskID 生成定时站内信投递任务的任务ID，同一条消息的同一次发送只会入队一次
+func CreateInternalMessageSendTaskID(messageId uint32, sendAt int64) string {
+	return InternalMessageSendTaskType + ":" + strconv.FormatUint(uint64(messageId), 10) + ":" + strconv.FormatInt(sendAt, 10)
+}
+
+// InternalMessageFanoutTaskData 站内信分发任务的数据
+type InternalMessageFanoutTaskData struct {
+	MessageId uint32 `json:"message_id"`
+	SenderId  uint32 `json:"sender_id"`
+	TenantId  uint32 `json:"tenant_id,omitempty"`
+}
+
+// CreateInternalMessageFanoutTaskID 生成站内信分发任务的任务ID，同一条消息只会分发一次
+func CreateInternalMessageFanoutTaskID(messageId uint32) string {
+	return InternalMessageFanoutTaskType + ":" + strconv.FormatUint(uint64(messageId), 10)
+}
+
+// InternalMessageDeliverTaskData 站内信批次投递任务的数据
+type InternalMessageDeliverTaskData struct {
+	MessageId uint32   `json:"message_id"`
+	SenderId  uint32   `json:"sender_id"`
+	TenantId  uint32   `json:"tenant_id,omitempty"`
+	Batch     int      `json:"batch"`
+	UserIds   []uint32 `json:"user_ids"`
+}
+
+// CreateInternalMessageDeliverTaskID 生成站内信批次投递任务的任务ID，分发任务重试时已投递的批次不会重复入队
+func CreateInternalMessageDeliverTaskID(messageId uint32, batch int) string {
+	return InternalMessageDeliverTaskType + ":" + strconv.FormatUint(uint64(messageId), 10) + ":" + strconv.Itoa(batch)
+}
+
+// InternalMessageChannelTaskData 站内信外部渠道投递任务的数据
+type InternalMessageChannelTaskData struct {
+	MessageId   uint32   `json:"message_id"`
+	Channel     string   `json:"channel"`
+	Batch       int      `json:"batch"`
+	DeliveryIds []uint32 `json:"delivery_ids"`
+}
+
+// CreateInternalMessageChannelTaskID 生成站内信外部渠道投递任务的任务ID
+func CreateInternalMessageChannelTaskID(messageId uint32, channel string, batch int) string {
+	return InternalMessageChannelTaskType + ":" + strconv.FormatUint(uint64(messageId), 10) + ":" + channel + ":" + strconv.Itoa(batch)
+}
+
+// CreateInternalMessageDeferredChannelTaskID 生成推迟发送的外部渠道投递任务的任务ID，免打扰时段结束后才发送
+func CreateInternalMessageDeferredChannelTaskID(messageId uint32, channel string, batch int, processAt int64) string {
+	return CreateInternalMessageChannelTaskID(messageId, channel, batch) + ":" + strconv.FormatInt(processAt, 10)
+}
+
+// InternalMessageDigestTaskData 站内信摘要任务的数据
+type InternalMessageDigestTaskData struct {
+	UserId uint32 `json:"user_id"`
+
+	// SendAt 摘要的计划发送时间（Unix秒）
+	SendAt int64 `json:"send_at"`
+}
+
+// CreateInternalMessageDigestTaskID 生成站内信摘要任务的任务ID，同一个用户的同一次摘要只会入队一次
+func CreateInternalMessageDigestTaskID(userId uint32, sendAt int64) string {
+	return InternalMessageDigestTaskType + ":" + strconv.FormatUint(uint64(userId), 10) + ":" + strconv.FormatInt(sendAt, 10)
+}
+
+// ArchiveInternalMessageTaskData 站内信归档任务的数据
+type ArchiveInternalMessageTaskData struct {
+	// OlderThanDays 归档发布时间早于多少天之前的消息
+	OlderThanDays int `json:"older_than_days" description:"归档发布时间早于多少天之前的消息"`
+}
+
+// Validate 校验归档任务数据
+func (d *ArchiveInternalMessageTaskData) Validate() error {
+	if d.OlderThanDays <= 0 {
+		return errors.New("older_than_days must be positive")
+	}
+	return nil
+}
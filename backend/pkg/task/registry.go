@@ -0,0 +1,278 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	asynqServer "github.com/tx7do/kratos-transport/transport/asynq"
+)
+
+var (
+	ErrHandlerNotFound   = errors.New("task handler not registered")
+	ErrHandlerRegistered = errors.New("task handler already registered")
+	ErrInvalidTypeName   = errors.New("invalid task type name")
+	ErrInvalidCronSpec   = errors.New("invalid cron spec")
+	ErrInvalidPayload    = errors.New("invalid task payload")
+)
+
+// PayloadValidator 任务数据的自定义校验，任务数据结构体实现该接口后会在保存任务时调用
+type PayloadValidator interface {
+	Validate() error
+}
+
+// PayloadField 任务数据字段描述
+type PayloadField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Required    bool   `json:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+// PayloadSchema 任务数据结构描述，由任务数据结构体的 json 与 description 标签生成，
+// 没有 omitempty 的字段为必填字段。
+type PayloadSchema struct {
+	Fields []PayloadField `json:"fields"`
+}
+
+// HandlerInfo 已注册的任务处理器
+type HandlerInfo struct {
+	TypeName    string
+	Description string
+	Schema      PayloadSchema
+
+	validate  func(payload []byte) error
+	subscribe func(srv *asynqServer.Server) error
+}
+
+// Registry 任务处理器注册表
+type Registry struct {
+	mu       sync.RWMutex
+	handlers map[string]*HandlerInfo
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		handlers: make(map[string]*HandlerInfo),
+	}
+}
+
+// Register 注册强类型的任务处理器，任务数据的结构由 T 决定
+func Register[T any](r *Registry, typeName, description string, handler func(context.Context, string, *T) error) error {
+	if typeName == "" || strings.Contains(typeName, ":") {
+		// 任务ID使用 "<type>:<id>" 格式，类型名中不能包含分隔符
+		return fmt.Errorf("%w: %q", ErrInvalidTypeName, typeName)
+	}
+	if handler == nil {
+		return fmt.Errorf("task handler [%s] is nil", typeName)
+	}
+
+	info := &HandlerInfo{
+		TypeName:    typeName,
+		Description: description,
+		Schema:      schemaOf(reflect.TypeFor[T]()),
+		validate: func(payload []byte) error {
+			return validatePayload[T](payload)
+		},
+		subscribe: func(srv *asynqServer.Server) error {
+			return asynqServer.RegisterSubscriberWithCtx(srv, typeName, handler)
+		},
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.handlers[typeName]; ok {
+		return fmt.Errorf("%w: %s", ErrHandlerRegistered, typeName)
+	}
+	r.handlers[typeName] = info
+
+	return nil
+}
+
+// Get 查询任务处理器
+func (r *Registry) Get(typeName string) (*HandlerInfo, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	info, ok := r.handlers[typeName]
+	return info, ok
+}
+
+// Handlers 返回所有任务处理器，按类型名排序
+func (r *Registry) Handlers() []*HandlerInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	handlers := make([]*HandlerInfo, 0, len(r.handlers))
+	for _, info := range r.handlers {
+		handlers = append(handlers, info)
+	}
+	sort.Slice(handlers, func(i, j int) bool {
+		return handlers[i].TypeName < handlers[j].TypeName
+	})
+
+	return handlers
+}
+
+// TypeNames 返回所有任务类型名，按类型名排序
+func (r *Registry) TypeNames() []string {
+	handlers := r.Handlers()
+	names := make([]string, 0, len(handlers))
+	for _, info := range handlers {
+		names = append(names, info.TypeName)
+	}
+	return names
+}
+
+// ValidatePayload 按任务处理器的数据结构校验任务数据
+func (r *Registry) ValidatePayload(typeName, payload string) error {
+	info, ok := r.Get(typeName)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrHandlerNotFound, typeName)
+	}
+
+	return info.validate([]byte(payload))
+}
+
+// Subscribe 把所有任务处理器注册到队列服务
+func (r *Registry) Subscribe(srv *asynqServer.Server) error {
+	for _, info := range r.Handlers() {
+		if err := info.subscribe(srv); err != nil {
+			return fmt.Errorf("subscribe task handler [%s] failed: %w", info.TypeName, err)
+		}
+	}
+	return nil
+}
+
+// cronParser 与 asynq 调度器使用的解析规则保持一致：标准5段格式，并支持 @every 等描述符
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// ValidateCronSpec 校验周期任务的 cron 表达式
+func ValidateCronSpec(spec string) error {
+	if strings.TrimSpace(spec) == "" {
+		return fmt.Errorf("%w: empty", ErrInvalidCronSpec)
+	}
+
+	if _, err := cronParser.Parse(spec); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCronSpec, err.Error())
+	}
+
+	return nil
+}
+
+// NextCronTime 返回 after 之后 cron 表达式的下一次触发时间
+func NextCronTime(spec string, after time.Time) (time.Time, error) {
+	if strings.TrimSpace(spec) == "" {
+		return time.Time{}, fmt.Errorf("%w: empty", ErrInvalidCronSpec)
+	}
+
+	schedule, err := cronParser.Parse(spec)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s", ErrInvalidCronSpec, err.Error())
+	}
+
+	return schedule.Next(after), nil
+}
+
+func validatePayload[T any](payload []byte) error {
+	if len(bytes.TrimSpace(payload)) == 0 {
+		payload = []byte("{}")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+
+	for _, f := range schemaOf(reflect.TypeFor[T]()).Fields {
+		if !f.Required {
+			continue
+		}
+		if v, ok := fields[f.Name]; !ok || string(v) == "null" {
+			return fmt.Errorf("%w: field [%s] is required", ErrInvalidPayload, f.Name)
+		}
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.DisallowUnknownFields()
+
+	var data T
+	if err := dec.Decode(&data); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+	}
+
+	if v, ok := any(&data).(PayloadValidator); ok {
+		if err := v.Validate(); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidPayload, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func schemaOf(t reflect.Type) PayloadSchema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var schema PayloadSchema
+	if t.Kind() != reflect.Struct {
+		return schema
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+
+		schema.Fields = append(schema.Fields, PayloadField{
+			Name:        name,
+			Type:        jsonTypeOf(f.Type),
+			Required:    !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Pointer,
+			Description: f.Tag.Get("description"),
+		})
+	}
+
+	return schema
+}
+
+func jsonTypeOf(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	case reflect.Slice, reflect.Array:
+		return "array"
+	default:
+		return "object"
+	}
+}
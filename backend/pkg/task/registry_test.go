@@ -0,0 +1,98 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testReportData struct {
+	Title  string   `json:"title" description:"报表标题"`
+	Emails []string `json:"emails,omitempty"`
+	Limit  *int     `json:"limit"`
+}
+
+func (d *testReportData) Validate() error {
+	if d.Title == "forbidden" {
+		return assert.AnError
+	}
+	return nil
+}
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+
+	r := NewRegistry()
+	require.NoError(t, Register(r, BackupTaskType, "数据库备份", func(context.Context, string, *BackupTaskData) error { return nil }))
+	require.NoError(t, Register(r, "report", "生成报表", func(context.Context, string, *testReportData) error { return nil }))
+
+	return r
+}
+
+func TestRegistryRegister(t *testing.T) {
+	r := newTestRegistry(t)
+
+	assert.Equal(t, []string{"backup", "report"}, r.TypeNames())
+
+	err := Register(r, "report", "", func(context.Context, string, *testReportData) error { return nil })
+	assert.ErrorIs(t, err, ErrHandlerRegistered)
+
+	err = Register(r, "bad:name", "", func(context.Context, string, *testReportData) error { return nil })
+	assert.ErrorIs(t, err, ErrInvalidTypeName)
+
+	info, ok := r.Get("report")
+	require.True(t, ok)
+	assert.Equal(t, "生成报表", info.Description)
+	assert.Equal(t, []PayloadField{
+		{Name: "title", Type: "string", Required: true, Description: "报表标题"},
+		{Name: "emails", Type: "array"},
+		{Name: "limit", Type: "integer"},
+	}, info.Schema.Fields)
+}
+
+func TestRegistryValidatePayload(t *testing.T) {
+	r := newTestRegistry(t)
+
+	assert.NoError(t, r.ValidatePayload("report", `{"title":"daily","emails":["a@b.c"]}`))
+	assert.NoError(t, r.ValidatePayload("backup", `{"name":"daily","keep_last":3}`))
+
+	assert.ErrorIs(t, r.ValidatePayload("unknown", `{}`), ErrHandlerNotFound)
+	assert.ErrorIs(t, r.ValidatePayload("report", ``), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("report", `{"title":null}`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("report", `{"title":"x","unknown":1}`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("report", `{"title":1}`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("report", `{"title":"forbidden"}`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("report", `[1,2]`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("backup", `{"name":"../etc"}`), ErrInvalidPayload)
+	assert.ErrorIs(t, r.ValidatePayload("backup", `{"name":"daily","keep_days":-1}`), ErrInvalidPayload)
+}
+
+func TestValidateCronSpec(t *testing.T) {
+	assert.NoError(t, ValidateCronSpec("0 * * * *"))
+	assert.NoError(t, ValidateCronSpec("*/5 1-3 * * MON-FRI"))
+	assert.NoError(t, ValidateCronSpec("@every 30s"))
+	assert.NoError(t, ValidateCronSpec("@daily"))
+	assert.NoError(t, ValidateCronSpec(DefaultTenantExpireCronSpec))
+
+	assert.ErrorIs(t, ValidateCronSpec(""), ErrInvalidCronSpec)
+	assert.ErrorIs(t, ValidateCronSpec("* * *"), ErrInvalidCronSpec)
+	assert.ErrorIs(t, ValidateCronSpec("61 * * * *"), ErrInvalidCronSpec)
+}
+
+func TestNextCronTime(t *testing.T) {
+	after := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	next, err := NextCronTime("0 * * * *", after)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC), next)
+
+	next, err = NextCronTime("@every 1m", after)
+	assert.NoError(t, err)
+	assert.Equal(t, after.Add(time.Minute), next)
+
+	_, err = NextCronTime("", after)
+	assert.ErrorIs(t, err, ErrInvalidCronSpec)
+}
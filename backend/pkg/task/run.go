@@ -0,0 +1,125 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RunEventName 任务执行状态推送到SSE时使用的事件名
+	RunEventName = "task_run"
+)
+
+// ProgressFunc 任务执行进度回调
+type ProgressFunc func(percent int32, message string)
+
+// RunState 单次任务执行的上下文状态，由执行记录中间件创建，处理函数通过 ReportProgress/SetResult 写入。
+type RunState struct {
+	mu sync.Mutex
+
+	onProgress ProgressFunc
+	result     []byte
+}
+
+// Result 返回处理函数设置的执行结果
+func (s *RunState) Result() []byte {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.result
+}
+
+type runStateKey struct{}
+
+// NewRunContext 创建携带执行状态的上下文
+func NewRunContext(ctx context.Context, onProgress ProgressFunc) (context.Context, *RunState) {
+	state := &RunState{onProgress: onProgress}
+	return context.WithValue(ctx, runStateKey{}, state), state
+}
+
+// RunStateFromContext 从上下文中获取执行状态
+func RunStateFromContext(ctx context.Context) (*RunState, bool) {
+	state, ok := ctx.Value(runStateKey{}).(*RunState)
+	return state, ok
+}
+
+// ReportProgress 上报任务执行进度，percent 取值 0-100，超出范围会被截断。
+// 上下文中没有执行状态时不做任何处理。
+func ReportProgress(ctx context.Context, percent int32, message string) {
+	state, ok := RunStateFromContext(ctx)
+	if !ok || state.onProgress == nil {
+		return
+	}
+
+	if percent < 0 {
+		percent = 0
+	}
+	if percent > 100 {
+		percent = 100
+	}
+
+	state.onProgress(percent, message)
+}
+
+// SetResult 设置任务执行结果，结果以 JSON 格式保存到执行记录中
+func SetResult(ctx context.Context, result any) error {
+	state, ok := RunStateFromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.result = data
+
+	return nil
+}
+
+// CreateTaskRunID 为一次手动触发的任务执行创建唯一的队列任务ID，格式为 "<type>:<id>:<nanos>"
+func CreateTaskRunID(typeName string, taskId uint32) string {
+	return fmt.Sprintf("%s:%d:%d", typeName, taskId, time.Now().UnixNano())
+}
+
+var ErrInvalidTaskID = errors.New("invalid queue task id")
+
+// ParseTaskID 从队列任务ID中解析调度任务ID，支持 "<type>:<id>" 和 "<type>:<id>:<nanos>" 两种格式。
+// 任务ID必须以该任务类型开头，其他类型的队列任务（如站内信任务）的ID不会被误认为调度任务ID。
+func ParseTaskID(typeName, queueTaskId string) (uint32, error) {
+	rest, ok := strings.CutPrefix(queueTaskId, typeName+":")
+	if !ok || typeName == "" {
+		return 0, fmt.Errorf("%w: [%s] is not a task of type [%s]", ErrInvalidTaskID, queueTaskId, typeName)
+	}
+
+	parts := strings.Split(rest, ":")
+	if len(parts) > 2 {
+		return 0, fmt.Errorf("%w: [%s]", ErrInvalidTaskID, queueTaskId)
+	}
+	if len(parts) == 2 {
+		if _, err := strconv.ParseInt(parts[1], 10, 64); err != nil {
+			return 0, fmt.Errorf("%w: [%s]", ErrInvalidTaskID, queueTaskId)
+		}
+	}
+
+	id, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("%w: [%s]", ErrInvalidTaskID, queueTaskId)
+	}
+
+	return uint32(id), nil
+}
@@ -0,0 +1,86 @@
+package task
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportProgress(t *testing.T) {
+	var percents []int32
+	var messages []string
+
+	ctx, _ := NewRunContext(context.Background(), func(percent int32, message string) {
+		percents = append(percents, percent)
+		messages = append(messages, message)
+	})
+
+	ReportProgress(ctx, -5, "start")
+	ReportProgress(ctx, 50, "half")
+	ReportProgress(ctx, 120, "done")
+
+	assert.Equal(t, []int32{0, 50, 100}, percents)
+	assert.Equal(t, []string{"start", "half", "done"}, messages)
+
+	// 没有执行状态的上下文不应该报错
+	ReportProgress(context.Background(), 10, "ignored")
+}
+
+func TestSetResult(t *testing.T) {
+	ctx, state := NewRunContext(context.Background(), nil)
+
+	assert.Nil(t, state.Result())
+
+	err := SetResult(ctx, map[string]any{"count": 3})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"count":3}`, string(state.Result()))
+
+	assert.NoError(t, SetResult(context.Background(), "ignored"))
+
+	var nilState *RunState
+	assert.Nil(t, nilState.Result())
+}
+
+func TestParseTaskID(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantId  uint32
+		wantErr bool
+	}{
+		{in: "backup:12", wantId: 12},
+		{in: "backup:12:1700000000", wantId: 12},
+		{in: "backup", wantErr: true},
+		{in: "backup:abc", wantErr: true},
+		{in: "backup:0", wantErr: true},
+		{in: "backup:12:abc", wantErr: true},
+		{in: "backup:12:1700000000:1", wantErr: true},
+		{in: "report:12", wantErr: true},
+		{in: "backup_old:12", wantErr: true},
+		{in: "internal_message_send:12:1700000000", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		id, err := ParseTaskID("backup", tt.in)
+		if tt.wantErr {
+			assert.ErrorIs(t, err, ErrInvalidTaskID, tt.in)
+		} else {
+			assert.NoError(t, err, tt.in)
+		}
+		assert.Equal(t, tt.wantId, id, tt.in)
+	}
+
+	_, err := ParseTaskID("", ":12")
+	assert.ErrorIs(t, err, ErrInvalidTaskID)
+}
+
+func TestCreateTaskRunID(t *testing.T) {
+	runId := CreateTaskRunID("backup", 7)
+	assert.True(t, strings.HasPrefix(runId, "backup:7:"))
+
+	id, err := ParseTaskID("backup", runId)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(7), id)
+}
@@ -0,0 +1,164 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/encoding"
+	_ "github.com/go-kratos/kratos/v2/encoding/json"
+	"github.com/hibiken/asynq"
+)
+
+var ErrTaskNotScheduled = errors.New("periodic task not scheduled")
+
+// CreateTaskKey 由任务类型和任务ID生成调度任务的唯一标识，格式为 "<type>:<id>"。
+// 周期任务在队列中的任务ID也使用该标识，执行记录据此关联到调度任务。
+func CreateTaskKey(typeName string, taskId uint32) string {
+	return typeName + ":" + strconv.FormatUint(uint64(taskId), 10)
+}
+
+// ParseTaskKey 解析 CreateTaskKey 生成的调度任务标识，返回任务类型和任务ID
+func ParseTaskKey(key string) (string, uint32, error) {
+	typeName, _, ok := strings.Cut(key, ":")
+	if !ok || typeName == "" || strings.Count(key, ":") != 1 {
+		return "", 0, fmt.Errorf("%w: [%s]", ErrInvalidTaskID, key)
+	}
+
+	taskId, err := ParseTaskID(typeName, key)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return typeName, taskId, nil
+}
+
+// Scheduler 周期任务调度器。
+// 每个调度任务按 CreateTaskKey 生成的标识单独登记，同类型的多个任务互不影响，可以单独停止或重启。
+type Scheduler struct {
+	mu sync.Mutex
+
+	scheduler *asynq.Scheduler
+	codec     encoding.Codec
+
+	entries map[string]string // 任务标识 -> 调度条目ID
+}
+
+// NewScheduler 创建周期任务调度器，codec 为任务数据的编码格式，需与队列服务保持一致
+func NewScheduler(redisOpt asynq.RedisConnOpt, opts *asynq.SchedulerOpts, codec string) *Scheduler {
+	c := encoding.GetCodec(codec)
+	if c == nil {
+		c = encoding.GetCodec("json")
+	}
+
+	return &Scheduler{
+		scheduler: asynq.NewScheduler(redisOpt, opts),
+		codec:     c,
+		entries:   make(map[string]string),
+	}
+}
+
+// Register 登记周期任务，同一标识已登记时先移除旧的调度条目
+func (s *Scheduler) Register(key, cronSpec, typeName string, payload any, opts ...asynq.Option) error {
+	if err := ValidateCronSpec(cronSpec); err != nil {
+		return err
+	}
+
+	var data []byte
+	if payload != nil {
+		var err error
+		if data, err = s.codec.Marshal(payload); err != nil {
+			return fmt.Errorf("marshal task payload failed: %w", err)
+		}
+	}
+
+	options := append([]asynq.Option{}, opts...)
+	options = append(options, asynq.TaskID(key))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.unregister(key); err != nil && !errors.Is(err, ErrTaskNotScheduled) {
+		return err
+	}
+
+	entryID, err := s.scheduler.Register(cronSpec, asynq.NewTask(typeName, data, options...))
+	if err != nil {
+		return err
+	}
+
+	s.entries[key] = entryID
+
+	return nil
+}
+
+// Unregister 移除周期任务
+func (s *Scheduler) Unregister(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.unregister(key)
+}
+
+func (s *Scheduler) unregister(key string) error {
+	entryID, ok := s.entries[key]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrTaskNotScheduled, key)
+	}
+
+	if err := s.scheduler.Unregister(entryID); err != nil {
+		return err
+	}
+
+	delete(s.entries, key)
+
+	return nil
+}
+
+// UnregisterAll 移除所有周期任务
+func (s *Scheduler) UnregisterAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for key := range s.entries {
+		_ = s.unregister(key)
+	}
+}
+
+// IsScheduled 周期任务是否已登记
+func (s *Scheduler) IsScheduled(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	_, ok := s.entries[key]
+	return ok
+}
+
+// Keys 返回所有已登记的周期任务标识
+func (s *Scheduler) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.entries))
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Start 启动调度器，实现 transport.Server 接口
+func (s *Scheduler) Start(_ context.Context) error {
+	return s.scheduler.Start()
+}
+
+// Stop 停止调度器，实现 transport.Server 接口
+func (s *Scheduler) Stop(_ context.Context) error {
+	s.scheduler.Shutdown()
+	return nil
+}
@@ -0,0 +1,50 @@
+package task
+
+import (
+	"testing"
+
+	"github.com/hibiken/asynq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulerRegister(t *testing.T) {
+	// 调度器未启动时不会连接Redis
+	s := NewScheduler(asynq.RedisClientOpt{Addr: "127.0.0.1:0"}, nil, "json")
+
+	backupKey := CreateTaskKey(BackupTaskType, 1)
+	reportKey := CreateTaskKey("report", 2)
+	assert.Equal(t, "backup:1", backupKey)
+
+	require.NoError(t, s.Register(backupKey, "0 * * * *", BackupTaskType, BackupTaskData{Name: "hourly"}))
+	require.NoError(t, s.Register(reportKey, "@daily", "report", map[string]any{"title": "daily"}))
+	assert.Equal(t, []string{"backup:1", "report:2"}, s.Keys())
+
+	// 重复登记会替换旧的调度条目
+	require.NoError(t, s.Register(backupKey, "*/5 * * * *", BackupTaskType, BackupTaskData{Name: "hourly"}))
+	assert.Len(t, s.Keys(), 2)
+
+	assert.ErrorIs(t, s.Register("report:3", "bad cron", "report", nil), ErrInvalidCronSpec)
+	assert.False(t, s.IsScheduled("report:3"))
+
+	// 停止一个任务不影响其他任务
+	require.NoError(t, s.Unregister(backupKey))
+	assert.False(t, s.IsScheduled(backupKey))
+	assert.True(t, s.IsScheduled(reportKey))
+	assert.ErrorIs(t, s.Unregister(backupKey), ErrTaskNotScheduled)
+
+	s.UnregisterAll()
+	assert.Empty(t, s.Keys())
+}
+
+func TestParseTaskKey(t *testing.T) {
+	typeName, id, err := ParseTaskKey(CreateTaskKey("report", 42))
+	assert.NoError(t, err)
+	assert.Equal(t, "report", typeName)
+	assert.Equal(t, uint32(42), id)
+
+	for _, key := range []string{"report", "report:", ":42", "report:42:1700000000", "report:abc"} {
+		_, _, err = ParseTaskKey(key)
+		assert.ErrorIs(t, err, ErrInvalidTaskID, key)
+	}
+}
@@ -0,0 +1,40 @@
+package task
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// TenantExpireTaskType 租户到期任务，把到期的租户改为已过期，并提前提醒租户管理员
+	TenantExpireTaskType = "tenant_expire"
+
+	// DefaultTenantExpireCronSpec 租户到期任务默认的执行周期
+	DefaultTenantExpireCronSpec = "*/10 * * * *"
+
+	// DefaultTenantRemindDays 默认提前多少天提醒租户管理员
+	DefaultTenantRemindDays = 7
+)
+
+// TenantExpireTaskData 租户到期任务的数据
+type TenantExpireTaskData struct {
+	// RemindDays 提前多少天提醒租户管理员，0 表示使用 DefaultTenantRemindDays
+	RemindDays int `json:"remind_days,omitempty" description:"提前多少天提醒租户管理员，0表示使用默认的7天"`
+}
+
+// Validate 校验租户到期任务数据
+func (d *TenantExpireTaskData) Validate() error {
+	if d.RemindDays < 0 {
+		return errors.New("remind_days must not be negative")
+	}
+	return nil
+}
+
+// GetRemindBefore 返回提前提醒的时长
+func (d *TenantExpireTaskData) GetRemindBefore() time.Duration {
+	days := DefaultTenantRemindDays
+	if d != nil && d.RemindDays > 0 {
+		days = d.RemindDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
@@ -0,0 +1,74 @@
+package task
+
+import (
+	"errors"
+
+	"go-wind-admin/pkg/tenantarchive"
+)
+
+const (
+	// TenantExportTaskType 租户导出任务，把租户数据打包为归档文件上传到对象存储
+	TenantExportTaskType = "tenant_export"
+
+	// TenantImportTaskType 租户导入任务，把归档文件导入到租户，默认只预演并生成导入报告
+	TenantImportTaskType = "tenant_import"
+
+	// DefaultTenantExportBucket 租户归档文件默认存放的存储桶
+	DefaultTenantExportBucket = "tenant-exports"
+)
+
+// TenantExportTaskData 租户导出任务的数据
+type TenantExportTaskData struct {
+	TenantId uint32 `json:"tenant_id" description:"导出的租户ID"`
+
+	// Bucket 归档文件存放的存储桶，为空时使用 DefaultTenantExportBucket
+	Bucket string `json:"bucket,omitempty" description:"归档文件存放的存储桶"`
+}
+
+// Validate 校验租户导出任务数据
+func (d *TenantExportTaskData) Validate() error {
+	if d.TenantId == 0 {
+		return errors.New("tenant_id is required")
+	}
+	return nil
+}
+
+// GetBucket 返回归档文件存放的存储桶
+func (d *TenantExportTaskData) GetBucket() string {
+	if d == nil || d.Bucket == "" {
+		return DefaultTenantExportBucket
+	}
+	return d.Bucket
+}
+
+// TenantImportTaskData 租户导入任务的数据
+type TenantImportTaskData struct {
+	FileId   uint32 `json:"file_id" description:"租户归档文件的文件ID"`
+	TenantId uint32 `json:"tenant_id" description:"导入的目标租户ID"`
+
+	// Conflict 目标租户中已存在同一编码的实体时的处理方式，为空时跳过
+	Conflict string `json:"conflict,omitempty" description:"编码冲突时的处理方式：skip跳过，overwrite覆盖"`
+
+	// Apply 为 false 时只预演，不修改任何数据
+	Apply bool `json:"apply,omitempty" description:"是否实际导入，为false时只生成导入报告"`
+}
+
+// Validate 校验租户导入任务数据
+func (d *TenantImportTaskData) Validate() error {
+	if d.FileId == 0 || d.TenantId == 0 {
+		return errors.New("file_id and tenant_id are required")
+	}
+	if _, err := tenantarchive.ParseConflict(d.Conflict); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetConflict 返回冲突处理方式
+func (d *TenantImportTaskData) GetConflict() tenantarchive.Conflict {
+	conflict, err := tenantarchive.ParseConflict(d.Conflict)
+	if err != nil {
+		return tenantarchive.ConflictSkip
+	}
+	return conflict
+}
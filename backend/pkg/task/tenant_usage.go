@@ -0,0 +1,44 @@
+package task
+
+import (
+	"errors"
+)
+
+const (
+	// TenantUsageTaskType 租户用量汇总任务，把每小时的请求计数、存储空间与站内信数量汇总为用量记录
+	TenantUsageTaskType = "tenant_usage"
+
+	// DefaultTenantUsageCronSpec 租户用量汇总任务默认的执行周期，每小时过后5分钟汇总上一个小时
+	DefaultTenantUsageCronSpec = "5 * * * *"
+
+	// DefaultTenantUsageHours 默认汇总最近几个已结束的小时，重复汇总同一个小时会覆盖之前的记录
+	DefaultTenantUsageHours = 2
+
+	// MaxTenantUsageHours 请求计数只保留两天，最多回溯汇总的小时数
+	MaxTenantUsageHours = 47
+)
+
+// TenantUsageTaskData 租户用量汇总任务的数据
+type TenantUsageTaskData struct {
+	// Hours 汇总最近几个已结束的小时，0 表示使用 DefaultTenantUsageHours
+	Hours int `json:"hours,omitempty" description:"汇总最近几个已结束的小时，0表示使用默认的2个小时，最多47个小时"`
+}
+
+// Validate 校验租户用量汇总任务数据
+func (d *TenantUsageTaskData) Validate() error {
+	if d.Hours < 0 {
+		return errors.New("hours must not be negative")
+	}
+	if d.Hours > MaxTenantUsageHours {
+		return errors.New("hours must not exceed 47")
+	}
+	return nil
+}
+
+// GetHours 返回需要汇总的小时数
+func (d *TenantUsageTaskData) GetHours() int {
+	if d == nil || d.Hours <= 0 {
+		return DefaultTenantUsageHours
+	}
+	return d.Hours
+}
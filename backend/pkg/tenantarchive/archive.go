@@ -0,0 +1,320 @@
+package tenantarchive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatVersion 租户归档格式版本，格式不兼容时递增
+	FormatVersion = 1
+
+	// FileExtension 租户归档文件扩展名
+	FileExtension = "tar.gz"
+
+	// ContentType 租户归档的MIME类型
+	ContentType = "application/gzip"
+
+	manifestName = "manifest.json"
+	entitiesDir  = "entities"
+)
+
+// 归档中的实体，按导入顺序排列：被引用的实体排在前面
+const (
+	EntityOrganization = "organization"
+	EntityDepartment   = "department"
+	EntityPosition     = "position"
+	EntityRole         = "role"
+	EntityUser         = "user"
+	EntityDictType     = "dict_type"
+	EntityDictEntry    = "dict_entry"
+	EntityFile         = "file"
+)
+
+// Entities 归档中的全部实体，按导入顺序排列
+var Entities = []string{
+	EntityOrganization,
+	EntityDepartment,
+	EntityPosition,
+	EntityRole,
+	EntityUser,
+	EntityDictType,
+	EntityFile,
+}
+
+var (
+	ErrManifestMissing    = errors.New("tenantarchive: manifest not found in archive")
+	ErrUnsupportedVersion = errors.New("tenantarchive: unsupported archive format version")
+	ErrChecksumMismatch   = errors.New("tenantarchive: entity checksum mismatch")
+	ErrCountMismatch      = errors.New("tenantarchive: entity count mismatch")
+	ErrEntityMissing      = errors.New("tenantarchive: entity data missing in archive")
+	ErrUnknownEntity      = errors.New("tenantarchive: archive contains unknown entity")
+	ErrDuplicateId        = errors.New("tenantarchive: duplicate id")
+	ErrDuplicateKey       = errors.New("tenantarchive: duplicate key")
+	ErrDanglingReference  = errors.New("tenantarchive: reference to missing entity")
+	ErrCyclicTree         = errors.New("tenantarchive: cyclic parent reference")
+)
+
+// TenantInfo 导出的租户
+type TenantInfo struct {
+	Id   uint32 `json:"id"`
+	Code string `json:"code,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// EntityManifest 单个实体的元数据
+type EntityManifest struct {
+	Name     string `json:"name"`
+	Count    int    `json:"count"`
+	Checksum string `json:"checksum"`
+}
+
+// Manifest 租户归档清单，导入前用于校验归档的完整性
+type Manifest struct {
+	Version   int              `json:"version"`
+	CreatedAt time.Time        `json:"created_at"`
+	Tenant    TenantInfo       `json:"tenant"`
+	Entities  []EntityManifest `json:"entities"`
+}
+
+// Count 返回实体的数量
+func (m *Manifest) Count(entity string) int {
+	for _, e := range m.Entities {
+		if e.Name == entity {
+			return e.Count
+		}
+	}
+	return 0
+}
+
+// Archive 租户归档。实体使用导出环境中的ID互相引用，导入时重新分配ID。
+// 用户不包含登录凭证与登录记录，文件只包含元数据，对象存储中的文件需要另外迁移。
+// 用量计量属于导出环境的计费记录，不随租户迁移，导入后从零开始计量。
+type Archive struct {
+	Manifest Manifest
+
+	Organizations []*Organization
+	Departments   []*Department
+	Positions     []*Position
+	Roles         []*Role
+	Users         []*User
+	DictTypes     []*DictType
+	Files         []*File
+}
+
+// New 创建空的租户归档
+func New(tenant TenantInfo) *Archive {
+	return &Archive{
+		Manifest: Manifest{
+			Version:   FormatVersion,
+			CreatedAt: time.Now().UTC(),
+			Tenant:    tenant,
+		},
+	}
+}
+
+// entityData 返回实体对应的数据
+func (a *Archive) entityData(entity string) any {
+	switch entity {
+	case EntityOrganization:
+		return &a.Organizations
+	case EntityDepartment:
+		return &a.Departments
+	case EntityPosition:
+		return &a.Positions
+	case EntityRole:
+		return &a.Roles
+	case EntityUser:
+		return &a.Users
+	case EntityDictType:
+		return &a.DictTypes
+	case EntityFile:
+		return &a.Files
+	}
+	return nil
+}
+
+// entityCount 返回实体的数量，字典条目随字典类型保存
+func (a *Archive) entityCount(entity string) int {
+	switch entity {
+	case EntityOrganization:
+		return len(a.Organizations)
+	case EntityDepartment:
+		return len(a.Departments)
+	case EntityPosition:
+		return len(a.Positions)
+	case EntityRole:
+		return len(a.Roles)
+	case EntityUser:
+		return len(a.Users)
+	case EntityDictType:
+		return len(a.DictTypes)
+	case EntityFile:
+		return len(a.Files)
+	}
+	return 0
+}
+
+// Write 校验归档并以 tar.gz 格式写入，每个实体一个JSON数组文件，清单最后写入
+func (a *Archive) Write(w io.Writer) (*Manifest, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	writeFile := func(name string, data []byte) error {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(data)),
+			ModTime: a.Manifest.CreatedAt,
+		}); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	a.Manifest.Version = FormatVersion
+	a.Manifest.Entities = a.Manifest.Entities[:0]
+	for _, entity := range Entities {
+		data, err := json.Marshal(a.entityData(entity))
+		if err != nil {
+			return nil, fmt.Errorf("tenantarchive: encode %s failed: %w", entity, err)
+		}
+
+		if err = writeFile(entityFileName(entity), data); err != nil {
+			return nil, err
+		}
+
+		sum := sha256.Sum256(data)
+		a.Manifest.Entities = append(a.Manifest.Entities, EntityManifest{
+			Name:     entity,
+			Count:    a.entityCount(entity),
+			Checksum: hex.EncodeToString(sum[:]),
+		})
+	}
+
+	manifest, err := json.MarshalIndent(&a.Manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = writeFile(manifestName, manifest); err != nil {
+		return nil, err
+	}
+
+	if err = tw.Close(); err != nil {
+		return nil, err
+	}
+	if err = gz.Close(); err != nil {
+		return nil, err
+	}
+
+	return &a.Manifest, nil
+}
+
+// Read 读取租户归档，校验清单、校验和与实体之间的引用，任何一项不通过都返回错误
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("tenantarchive: invalid archive: %w", err)
+	}
+	defer gz.Close()
+
+	var manifestData []byte
+	files := make(map[string][]byte)
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tenantarchive: invalid archive: %w", err)
+		}
+
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("tenantarchive: invalid archive: %w", err)
+		}
+
+		switch {
+		case hdr.Name == manifestName:
+			manifestData = data
+		case path.Dir(hdr.Name) == entitiesDir && strings.HasSuffix(hdr.Name, ".json"):
+			files[strings.TrimSuffix(path.Base(hdr.Name), ".json")] = data
+		}
+	}
+
+	if manifestData == nil {
+		return nil, ErrManifestMissing
+	}
+
+	a := &Archive{}
+	if err = json.Unmarshal(manifestData, &a.Manifest); err != nil {
+		return nil, fmt.Errorf("tenantarchive: invalid manifest: %w", err)
+	}
+
+	if a.Manifest.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, a.Manifest.Version)
+	}
+
+	for _, e := range a.Manifest.Entities {
+		target := a.entityData(e.Name)
+		if target == nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownEntity, e.Name)
+		}
+
+		data, ok := files[e.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrEntityMissing, e.Name)
+		}
+
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != e.Checksum {
+			return nil, fmt.Errorf("%w: %s", ErrChecksumMismatch, e.Name)
+		}
+
+		if err = json.Unmarshal(data, target); err != nil {
+			return nil, fmt.Errorf("tenantarchive: decode %s failed: %w", e.Name, err)
+		}
+
+		if n := a.entityCount(e.Name); n != e.Count {
+			return nil, fmt.Errorf("%w: %s expects %d, got %d", ErrCountMismatch, e.Name, e.Count, n)
+		}
+	}
+
+	if err = a.Validate(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// FileName 生成租户归档文件名
+func FileName(tenantCode string, t time.Time) string {
+	if tenantCode == "" {
+		tenantCode = "tenant"
+	}
+	return fmt.Sprintf("tenant-%s-%s.%s", tenantCode, t.UTC().Format("20060102150405"), FileExtension)
+}
+
+func entityFileName(entity string) string {
+	return path.Join(entitiesDir, entity+".json")
+}
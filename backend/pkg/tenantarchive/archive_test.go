@@ -0,0 +1,228 @@
+package tenantarchive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func testArchive() *Archive {
+	a := New(TenantInfo{Id: 7, Code: "acme", Name: "Acme"})
+
+	a.Organizations = []*Organization{
+		{Id: 10, Name: ptr("总部"), ManagerId: ptr(uint32(100))},
+		{Id: 11, ParentId: ptr(uint32(10)), Name: ptr("华东分公司")},
+	}
+	a.Departments = []*Department{
+		{Id: 21, ParentId: ptr(uint32(20)), OrganizationId: ptr(uint32(10)), Name: ptr("后端组")},
+		{Id: 20, OrganizationId: ptr(uint32(10)), Name: ptr("研发部")},
+	}
+	a.Positions = []*Position{
+		{Id: 30, OrganizationId: ptr(uint32(10)), DepartmentId: ptr(uint32(20)), Name: ptr("工程师"), Code: ptr("engineer")},
+	}
+	a.Roles = []*Role{
+		{Id: 40, Name: ptr("管理员"), Code: ptr("admin"), Menus: []string{"Dashboard"}, Apis: []string{"GET /admin/v1/users"}},
+		{Id: 41, ParentId: ptr(uint32(40)), Name: ptr("用户"), Code: ptr("user")},
+	}
+	a.Users = []*User{
+		{Id: 100, Username: ptr("alice"), OrgId: ptr(uint32(11)), DepartmentId: ptr(uint32(21)), PositionId: ptr(uint32(30)), RoleIds: []uint32{40, 41}},
+	}
+	a.DictTypes = []*DictType{
+		{Id: 50, TypeCode: ptr("gender"), Entries: []*DictEntry{{EntryValue: ptr("m")}, {EntryValue: ptr("f")}}},
+	}
+	a.Files = []*File{
+		{Id: 60, FileGuid: ptr("guid-1"), FileName: ptr("a.png")},
+	}
+
+	return a
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	manifest, err := testArchive().Write(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, FormatVersion, manifest.Version)
+	assert.Equal(t, 2, manifest.Count(EntityOrganization))
+	assert.Equal(t, 1, manifest.Count(EntityUser))
+
+	a, err := Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme", a.Manifest.Tenant.Code)
+	require.Len(t, a.Users, 1)
+	assert.Equal(t, "alice", *a.Users[0].Username)
+	assert.Equal(t, []uint32{40, 41}, a.Users[0].RoleIds)
+	assert.Equal(t, []string{"Dashboard"}, a.Roles[0].Menus)
+	require.Len(t, a.DictTypes[0].Entries, 2)
+	assert.Nil(t, a.Users[0].Email)
+}
+
+// rewriteArchive 修改归档中的文件，用于构造被篡改的归档
+func rewriteArchive(t *testing.T, data []byte, modify func(name string, content []byte) []byte) []byte {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	var out bytes.Buffer
+	gw := gzip.NewWriter(&out)
+	tw := tar.NewWriter(gw)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+
+		content = modify(hdr.Name, content)
+		if content == nil {
+			continue
+		}
+
+		hdr.Size = int64(len(content))
+		require.NoError(t, tw.WriteHeader(hdr))
+		_, err = tw.Write(content)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+
+	return out.Bytes()
+}
+
+func TestReadRejectsInvalidArchive(t *testing.T) {
+	var buf bytes.Buffer
+	_, err := testArchive().Write(&buf)
+	require.NoError(t, err)
+	data := buf.Bytes()
+
+	tampered := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == entityFileName(EntityUser) {
+			return bytes.Replace(content, []byte("alice"), []byte("mallory"), 1)
+		}
+		return content
+	})
+	_, err = Read(bytes.NewReader(tampered))
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+
+	missing := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == entityFileName(EntityRole) {
+			return nil
+		}
+		return content
+	})
+	_, err = Read(bytes.NewReader(missing))
+	assert.ErrorIs(t, err, ErrEntityMissing)
+
+	noManifest := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == manifestName {
+			return nil
+		}
+		return content
+	})
+	_, err = Read(bytes.NewReader(noManifest))
+	assert.ErrorIs(t, err, ErrManifestMissing)
+
+	newer := rewriteArchive(t, data, func(name string, content []byte) []byte {
+		if name == manifestName {
+			return bytes.Replace(content, []byte(`"version": 1`), []byte(`"version": 99`), 1)
+		}
+		return content
+	})
+	_, err = Read(bytes.NewReader(newer))
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
+
+func TestValidate(t *testing.T) {
+	a := testArchive()
+	a.Users[0].RoleIds = append(a.Users[0].RoleIds, 99)
+	assert.ErrorIs(t, a.Validate(), ErrDanglingReference)
+
+	a = testArchive()
+	a.Roles[0].ParentId = ptr(uint32(41))
+	assert.ErrorIs(t, a.Validate(), ErrCyclicTree)
+
+	a = testArchive()
+	a.Roles[1].Code = ptr("admin")
+	assert.ErrorIs(t, a.Validate(), ErrDuplicateKey)
+
+	a = testArchive()
+	a.Positions = append(a.Positions, &Position{Id: 30, Code: ptr("other")})
+	assert.ErrorIs(t, a.Validate(), ErrDuplicateId)
+
+	a = testArchive()
+	a.DictTypes[0].Entries = append(a.DictTypes[0].Entries, &DictEntry{EntryValue: ptr("m")})
+	assert.ErrorIs(t, a.Validate(), ErrDuplicateKey)
+
+	// 组织名称可以重复，导入时重名的组织总是新建
+	a = testArchive()
+	a.Organizations = append(a.Organizations, &Organization{Id: 12, ParentId: ptr(uint32(10)), Name: ptr("华东分公司")})
+	assert.NoError(t, a.Validate())
+}
+
+func TestPrune(t *testing.T) {
+	a := testArchive()
+	a.Users[0].RoleIds = []uint32{1, 40}
+	a.Users[0].PositionId = ptr(uint32(999))
+	a.Roles[1].ParentId = ptr(uint32(2))
+
+	pruned := a.Prune()
+	assert.Len(t, pruned, 3)
+	assert.Equal(t, []uint32{40}, a.Users[0].RoleIds)
+	assert.Nil(t, a.Users[0].PositionId)
+	assert.Nil(t, a.Roles[1].ParentId)
+	assert.NoError(t, a.Validate())
+
+	assert.Empty(t, testArchive().Prune())
+}
+
+func TestKeys(t *testing.T) {
+	a := testArchive()
+
+	assert.Equal(t, map[uint32]string{10: "总部", 11: "总部/华东分公司"}, a.OrganizationKeys())
+	assert.Equal(t, map[uint32]string{20: "总部:研发部", 21: "总部:研发部/后端组"}, a.DepartmentKeys())
+
+	assert.Equal(t, "Dashboard", MenuKey(ptr("Dashboard"), ptr("/dashboard")))
+	assert.Equal(t, "/dashboard", MenuKey(ptr(""), ptr("/dashboard")))
+	assert.Equal(t, "GET /admin/v1/users", ApiKey(ptr("get"), ptr("/admin/v1/users")))
+	assert.Equal(t, "", ApiKey(nil, ptr("/admin/v1/users")))
+	assert.Equal(t, "guid-1", FileKey(a.Files[0]))
+	assert.Equal(t, "images/2025/a.png", FileKey(&File{BucketName: ptr("images"), FileDirectory: ptr("2025"), SaveFileName: ptr("a.png")}))
+
+	assert.Equal(t,
+		map[uint32]string{1: "a"},
+		UniqueKeys(map[uint32]string{1: "a", 2: "b", 3: "b", 4: ""}),
+	)
+}
+
+func TestTreeOrder(t *testing.T) {
+	parents := map[uint32]*uint32{
+		1: ptr(uint32(3)),
+		2: nil,
+		3: ptr(uint32(2)),
+		4: ptr(uint32(100)),
+	}
+
+	order := TreeOrder([]uint32{1, 2, 3, 4}, func(id uint32) *uint32 { return parents[id] })
+	assert.Equal(t, []uint32{2, 3, 1, 4}, order)
+}
+
+func TestFileName(t *testing.T) {
+	a := testArchive()
+	assert.Equal(t, "tenant-acme-20250102030405.tar.gz", FileName("acme", mustTime(t, "2025-01-02T03:04:05Z")))
+	assert.Equal(t, "tenant-tenant-20250102030405.tar.gz", FileName("", mustTime(t, "2025-01-02T03:04:05Z")))
+	assert.NotZero(t, a.Manifest.CreatedAt)
+}
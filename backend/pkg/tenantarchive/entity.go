@@ -0,0 +1,523 @@
+package tenantarchive
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 枚举值使用接口中的名称保存，如 ON、ORG_AND_CHILD，与数据库的保存方式无关
+
+// Organization 组织
+type Organization struct {
+	Id               uint32  `json:"id"`
+	ParentId         *uint32 `json:"parent_id,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Status           *string `json:"status,omitempty"`
+	OrganizationType *string `json:"organization_type,omitempty"`
+	CreditCode       *string `json:"credit_code,omitempty"`
+	Address          *string `json:"address,omitempty"`
+	BusinessScope    *string `json:"business_scope,omitempty"`
+	IsLegalEntity    *bool   `json:"is_legal_entity,omitempty"`
+	ManagerId        *uint32 `json:"manager_id,omitempty"`
+	SortOrder        *int32  `json:"sort_order,omitempty"`
+	Remark           *string `json:"remark,omitempty"`
+}
+
+// Department 部门
+type Department struct {
+	Id             uint32  `json:"id"`
+	ParentId       *uint32 `json:"parent_id,omitempty"`
+	OrganizationId *uint32 `json:"organization_id,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	ManagerId      *uint32 `json:"manager_id,omitempty"`
+	Status         *string `json:"status,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	SortOrder      *int32  `json:"sort_order,omitempty"`
+	Remark         *string `json:"remark,omitempty"`
+}
+
+// Position 职位
+type Position struct {
+	Id             uint32  `json:"id"`
+	ParentId       *uint32 `json:"parent_id,omitempty"`
+	OrganizationId *uint32 `json:"organization_id,omitempty"`
+	DepartmentId   *uint32 `json:"department_id,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	Code           *string `json:"code,omitempty"`
+	Status         *string `json:"status,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Quota          *uint32 `json:"quota,omitempty"`
+	SortOrder      *int32  `json:"sort_order,omitempty"`
+	Remark         *string `json:"remark,omitempty"`
+}
+
+// Role 角色。菜单与API在各个环境中的ID不同，菜单授权使用 MenuKey，API授权使用 ApiKey
+type Role struct {
+	Id        uint32   `json:"id"`
+	ParentId  *uint32  `json:"parent_id,omitempty"`
+	Name      *string  `json:"name,omitempty"`
+	Code      *string  `json:"code,omitempty"`
+	Menus     []string `json:"menus,omitempty"`
+	Apis      []string `json:"apis,omitempty"`
+	DataScope *string  `json:"data_scope,omitempty"`
+	Status    *string  `json:"status,omitempty"`
+	SortOrder *int32   `json:"sort_order,omitempty"`
+	Remark    *string  `json:"remark,omitempty"`
+}
+
+// User 用户，不包含登录凭证与登录记录
+type User struct {
+	Id           uint32   `json:"id"`
+	Username     *string  `json:"username,omitempty"`
+	Nickname     *string  `json:"nickname,omitempty"`
+	Realname     *string  `json:"realname,omitempty"`
+	Email        *string  `json:"email,omitempty"`
+	Mobile       *string  `json:"mobile,omitempty"`
+	Telephone    *string  `json:"telephone,omitempty"`
+	Avatar       *string  `json:"avatar,omitempty"`
+	Address      *string  `json:"address,omitempty"`
+	Region       *string  `json:"region,omitempty"`
+	Language     *string  `json:"language,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	Gender       *string  `json:"gender,omitempty"`
+	Authority    *string  `json:"authority,omitempty"`
+	Status       *string  `json:"status,omitempty"`
+	OrgId        *uint32  `json:"org_id,omitempty"`
+	DepartmentId *uint32  `json:"department_id,omitempty"`
+	PositionId   *uint32  `json:"position_id,omitempty"`
+	WorkId       *uint32  `json:"work_id,omitempty"`
+	RoleIds      []uint32 `json:"role_ids,omitempty"`
+	Remark       *string  `json:"remark,omitempty"`
+}
+
+// DictType 字典类型及其条目
+type DictType struct {
+	Id          uint32       `json:"id"`
+	TypeCode    *string      `json:"type_code,omitempty"`
+	TypeName    *string      `json:"type_name,omitempty"`
+	IsEnabled   *bool        `json:"is_enabled,omitempty"`
+	SortOrder   *int32       `json:"sort_order,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	Entries     []*DictEntry `json:"entries,omitempty"`
+}
+
+// DictEntry 字典条目，在字典类型内以条目值区分
+type DictEntry struct {
+	EntryLabel   *string `json:"entry_label,omitempty"`
+	EntryValue   *string `json:"entry_value,omitempty"`
+	NumericValue *int32  `json:"numeric_value,omitempty"`
+	LanguageCode *string `json:"language_code,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	SortOrder    *int32  `json:"sort_order,omitempty"`
+	IsEnabled    *bool   `json:"is_enabled,omitempty"`
+}
+
+// File 文件元数据
+type File struct {
+	Id            uint32  `json:"id"`
+	Provider      *string `json:"provider,omitempty"`
+	BucketName    *string `json:"bucket_name,omitempty"`
+	FileDirectory *string `json:"file_directory,omitempty"`
+	FileGuid      *string `json:"file_guid,omitempty"`
+	SaveFileName  *string `json:"save_file_name,omitempty"`
+	FileName      *string `json:"file_name,omitempty"`
+	Extension     *string `json:"extension,omitempty"`
+	Size          *uint64 `json:"size,omitempty"`
+	SizeFormat    *string `json:"size_format,omitempty"`
+	LinkUrl       *string `json:"link_url,omitempty"`
+	Md5           *string `json:"md5,omitempty"`
+	Remark        *string `json:"remark,omitempty"`
+}
+
+// MenuKey 菜单在各个环境中通用的标识：优先使用路由名称，没有名称时使用路径
+func MenuKey(name, path *string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	if path != nil {
+		return *path
+	}
+	return ""
+}
+
+// ApiKey API在各个环境中通用的标识，如 GET /admin/v1/users
+func ApiKey(method, path *string) string {
+	if method == nil || path == nil || *path == "" {
+		return ""
+	}
+	return strings.ToUpper(*method) + " " + *path
+}
+
+// FileKey 文件在租户内的标识：优先使用文件GUID，没有GUID时使用存储位置
+func FileKey(f *File) string {
+	if f.FileGuid != nil && *f.FileGuid != "" {
+		return *f.FileGuid
+	}
+	return joinPath(f.BucketName, f.FileDirectory, f.SaveFileName)
+}
+
+// OrganizationKeys 组织在租户内的标识：从根组织开始的名称路径，如 总部/华东分公司
+func (a *Archive) OrganizationKeys() map[uint32]string {
+	names := make(map[uint32]string, len(a.Organizations))
+	parents := make(map[uint32]*uint32, len(a.Organizations))
+	for _, o := range a.Organizations {
+		names[o.Id] = str(o.Name)
+		parents[o.Id] = o.ParentId
+	}
+	return treeKeys(names, parents)
+}
+
+// DepartmentKeys 部门在租户内的标识：所属组织的标识加上从根部门开始的名称路径，如 总部:研发部/后端组
+func (a *Archive) DepartmentKeys() map[uint32]string {
+	orgKeys := a.OrganizationKeys()
+
+	names := make(map[uint32]string, len(a.Departments))
+	parents := make(map[uint32]*uint32, len(a.Departments))
+	for _, d := range a.Departments {
+		names[d.Id] = str(d.Name)
+		parents[d.Id] = d.ParentId
+	}
+
+	keys := treeKeys(names, parents)
+	for _, d := range a.Departments {
+		if d.OrganizationId != nil {
+			keys[d.Id] = orgKeys[*d.OrganizationId] + ":" + keys[d.Id]
+		}
+	}
+	return keys
+}
+
+// TreeOrder 返回树形实体的创建顺序，上级排在下级前面，同一层级保持原有顺序
+func TreeOrder(ids []uint32, parentOf func(id uint32) *uint32) []uint32 {
+	exists := make(map[uint32]bool, len(ids))
+	for _, id := range ids {
+		exists[id] = true
+	}
+
+	visited := make(map[uint32]bool, len(ids))
+	order := make([]uint32, 0, len(ids))
+
+	var visit func(id uint32)
+	visit = func(id uint32) {
+		if visited[id] {
+			return
+		}
+		visited[id] = true
+		if p := parentOf(id); p != nil && exists[*p] {
+			visit(*p)
+		}
+		order = append(order, id)
+	}
+
+	for _, id := range ids {
+		visit(id)
+	}
+
+	return order
+}
+
+// Validate 校验归档：同一实体的ID与编码不重复，实体之间的引用都能在归档中找到，树形结构没有环
+func (a *Archive) Validate() error {
+	orgs := make(map[uint32]*uint32, len(a.Organizations))
+	for _, o := range a.Organizations {
+		if _, ok := orgs[o.Id]; ok {
+			return fmt.Errorf("%w: %s %d", ErrDuplicateId, EntityOrganization, o.Id)
+		}
+		orgs[o.Id] = o.ParentId
+	}
+
+	depts := make(map[uint32]*uint32, len(a.Departments))
+	for _, d := range a.Departments {
+		if _, ok := depts[d.Id]; ok {
+			return fmt.Errorf("%w: %s %d", ErrDuplicateId, EntityDepartment, d.Id)
+		}
+		depts[d.Id] = d.ParentId
+	}
+
+	positions := make(map[uint32]*uint32, len(a.Positions))
+	for _, p := range a.Positions {
+		if _, ok := positions[p.Id]; ok {
+			return fmt.Errorf("%w: %s %d", ErrDuplicateId, EntityPosition, p.Id)
+		}
+		positions[p.Id] = p.ParentId
+	}
+
+	roles := make(map[uint32]*uint32, len(a.Roles))
+	for _, r := range a.Roles {
+		if _, ok := roles[r.Id]; ok {
+			return fmt.Errorf("%w: %s %d", ErrDuplicateId, EntityRole, r.Id)
+		}
+		roles[r.Id] = r.ParentId
+	}
+
+	users := make(map[uint32]*uint32, len(a.Users))
+	for _, u := range a.Users {
+		if _, ok := users[u.Id]; ok {
+			return fmt.Errorf("%w: %s %d", ErrDuplicateId, EntityUser, u.Id)
+		}
+		users[u.Id] = nil
+	}
+
+	for entity, tree := range map[string]map[uint32]*uint32{
+		EntityOrganization: orgs,
+		EntityDepartment:   depts,
+		EntityPosition:     positions,
+		EntityRole:         roles,
+	} {
+		if err := checkTree(entity, tree); err != nil {
+			return err
+		}
+	}
+
+	for _, o := range a.Organizations {
+		if err := checkRef(EntityOrganization, o.Id, EntityUser, o.ManagerId, users); err != nil {
+			return err
+		}
+	}
+	for _, d := range a.Departments {
+		if err := checkRef(EntityDepartment, d.Id, EntityOrganization, d.OrganizationId, orgs); err != nil {
+			return err
+		}
+		if err := checkRef(EntityDepartment, d.Id, EntityUser, d.ManagerId, users); err != nil {
+			return err
+		}
+	}
+	for _, p := range a.Positions {
+		if err := checkRef(EntityPosition, p.Id, EntityOrganization, p.OrganizationId, orgs); err != nil {
+			return err
+		}
+		if err := checkRef(EntityPosition, p.Id, EntityDepartment, p.DepartmentId, depts); err != nil {
+			return err
+		}
+	}
+	for _, u := range a.Users {
+		if err := checkRef(EntityUser, u.Id, EntityOrganization, u.OrgId, orgs); err != nil {
+			return err
+		}
+		if err := checkRef(EntityUser, u.Id, EntityDepartment, u.DepartmentId, depts); err != nil {
+			return err
+		}
+		if err := checkRef(EntityUser, u.Id, EntityPosition, u.PositionId, positions); err != nil {
+			return err
+		}
+		for _, roleId := range u.RoleIds {
+			if err := checkRef(EntityUser, u.Id, EntityRole, &roleId, roles); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 导入时按编码识别已存在的实体，这些编码在数据库中租户内唯一，归档中也不能重复
+	codes := map[string]map[uint32]string{
+		EntityPosition: make(map[uint32]string, len(a.Positions)),
+		EntityRole:     make(map[uint32]string, len(a.Roles)),
+		EntityUser:     make(map[uint32]string, len(a.Users)),
+		EntityDictType: make(map[uint32]string, len(a.DictTypes)),
+	}
+	for _, p := range a.Positions {
+		codes[EntityPosition][p.Id] = str(p.Code)
+	}
+	for _, r := range a.Roles {
+		codes[EntityRole][r.Id] = str(r.Code)
+	}
+	for _, u := range a.Users {
+		codes[EntityUser][u.Id] = str(u.Username)
+	}
+	for _, t := range a.DictTypes {
+		codes[EntityDictType][t.Id] = str(t.TypeCode)
+	}
+	for entity, keys := range codes {
+		if len(UniqueKeys(keys)) != countKeys(keys) {
+			return fmt.Errorf("%w: %s", ErrDuplicateKey, entity)
+		}
+	}
+
+	for _, t := range a.DictTypes {
+		values := make(map[string]bool, len(t.Entries))
+		for _, e := range t.Entries {
+			value := str(e.EntryValue)
+			if value == "" {
+				continue
+			}
+			if values[value] {
+				return fmt.Errorf("%w: %s %q of %s", ErrDuplicateKey, EntityDictEntry, value, str(t.TypeCode))
+			}
+			values[value] = true
+		}
+	}
+
+	return nil
+}
+
+// checkTree 检查上级都在归档中且没有环
+func checkTree(entity string, parents map[uint32]*uint32) error {
+	for id, parent := range parents {
+		if err := checkRef(entity, id, entity, parent, parents); err != nil {
+			return err
+		}
+
+		// 沿着上级向上查找，步数超过节点数说明存在环
+		cur := parent
+		for steps := 0; cur != nil; steps++ {
+			if *cur == id || steps > len(parents) {
+				return fmt.Errorf("%w: %s %d", ErrCyclicTree, entity, id)
+			}
+			cur = parents[*cur]
+		}
+	}
+	return nil
+}
+
+func checkRef(entity string, id uint32, refEntity string, ref *uint32, exists map[uint32]*uint32) error {
+	if ref == nil || *ref == 0 {
+		return nil
+	}
+	if _, ok := exists[*ref]; !ok {
+		return fmt.Errorf("%w: %s %d references %s %d", ErrDanglingReference, entity, id, refEntity, *ref)
+	}
+	return nil
+}
+
+// treeKeys 计算树形实体从根节点开始的名称路径，调用前需要保证没有环
+func treeKeys(names map[uint32]string, parents map[uint32]*uint32) map[uint32]string {
+	keys := make(map[uint32]string, len(names))
+
+	var keyOf func(id uint32) string
+	keyOf = func(id uint32) string {
+		if key, ok := keys[id]; ok {
+			return key
+		}
+		key := names[id]
+		if p := parents[id]; p != nil {
+			if _, ok := names[*p]; ok && *p != id {
+				key = keyOf(*p) + "/" + key
+			}
+		}
+		keys[id] = key
+		return key
+	}
+
+	for id := range names {
+		keyOf(id)
+	}
+
+	return keys
+}
+
+// UniqueKeys 返回标识在归档中唯一的实体，没有标识或标识重复的实体导入时总是新建
+func UniqueKeys(keys map[uint32]string) map[uint32]string {
+	counts := make(map[string]int, len(keys))
+	for _, key := range keys {
+		counts[key]++
+	}
+
+	unique := make(map[uint32]string, len(keys))
+	for id, key := range keys {
+		if key != "" && counts[key] == 1 {
+			unique[id] = key
+		}
+	}
+	return unique
+}
+
+// countKeys 返回有标识的实体数量
+func countKeys(keys map[uint32]string) int {
+	var n int
+	for _, key := range keys {
+		if key != "" {
+			n++
+		}
+	}
+	return n
+}
+
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func joinPath(parts ...*string) string {
+	values := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != nil && *p != "" {
+			values = append(values, *p)
+		}
+	}
+	return strings.Join(values, "/")
+}
+
+// Prune 清除指向归档之外的引用，如已删除的上级、组织或不属于该租户的角色，返回清除的引用说明。
+// 导出时调用，避免源数据中残留的无效引用导致整个归档无法导入
+func (a *Archive) Prune() []string {
+	exists := func(ids []uint32) map[uint32]bool {
+		m := make(map[uint32]bool, len(ids))
+		for _, id := range ids {
+			m[id] = true
+		}
+		return m
+	}
+
+	var orgIds, deptIds, positionIds, roleIds, userIds []uint32
+	for _, o := range a.Organizations {
+		orgIds = append(orgIds, o.Id)
+	}
+	for _, d := range a.Departments {
+		deptIds = append(deptIds, d.Id)
+	}
+	for _, p := range a.Positions {
+		positionIds = append(positionIds, p.Id)
+	}
+	for _, r := range a.Roles {
+		roleIds = append(roleIds, r.Id)
+	}
+	for _, u := range a.Users {
+		userIds = append(userIds, u.Id)
+	}
+	orgs, depts, positions, roles, users := exists(orgIds), exists(deptIds), exists(positionIds), exists(roleIds), exists(userIds)
+
+	var pruned []string
+	prune := func(entity string, id uint32, field string, ref **uint32, valid map[uint32]bool) {
+		if *ref == nil || **ref == 0 || valid[**ref] {
+			return
+		}
+		pruned = append(pruned, fmt.Sprintf("%s %d: %s %d not exported", entity, id, field, **ref))
+		*ref = nil
+	}
+
+	for _, o := range a.Organizations {
+		prune(EntityOrganization, o.Id, "parent_id", &o.ParentId, orgs)
+		prune(EntityOrganization, o.Id, "manager_id", &o.ManagerId, users)
+	}
+	for _, d := range a.Departments {
+		prune(EntityDepartment, d.Id, "parent_id", &d.ParentId, depts)
+		prune(EntityDepartment, d.Id, "organization_id", &d.OrganizationId, orgs)
+		prune(EntityDepartment, d.Id, "manager_id", &d.ManagerId, users)
+	}
+	for _, p := range a.Positions {
+		prune(EntityPosition, p.Id, "parent_id", &p.ParentId, positions)
+		prune(EntityPosition, p.Id, "organization_id", &p.OrganizationId, orgs)
+		prune(EntityPosition, p.Id, "department_id", &p.DepartmentId, depts)
+	}
+	for _, r := range a.Roles {
+		prune(EntityRole, r.Id, "parent_id", &r.ParentId, roles)
+	}
+	for _, u := range a.Users {
+		prune(EntityUser, u.Id, "org_id", &u.OrgId, orgs)
+		prune(EntityUser, u.Id, "department_id", &u.DepartmentId, depts)
+		prune(EntityUser, u.Id, "position_id", &u.PositionId, positions)
+
+		kept := u.RoleIds[:0]
+		for _, roleId := range u.RoleIds {
+			if roles[roleId] {
+				kept = append(kept, roleId)
+			} else {
+				pruned = append(pruned, fmt.Sprintf("%s %d: role_id %d not exported", EntityUser, u.Id, roleId))
+			}
+		}
+		u.RoleIds = kept
+	}
+
+	return pruned
+}
@@ -0,0 +1,155 @@
+package tenantarchive
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Conflict 导入时目标租户中已存在同一编码的实体的处理方式
+type Conflict string
+
+const (
+	// ConflictSkip 保留已存在的实体，归档中的引用指向已存在的实体
+	ConflictSkip Conflict = "skip"
+	// ConflictOverwrite 用归档中的数据覆盖已存在的实体
+	ConflictOverwrite Conflict = "overwrite"
+)
+
+// ParseConflict 解析冲突处理方式，为空时使用 ConflictSkip
+func ParseConflict(s string) (Conflict, error) {
+	switch Conflict(s) {
+	case "", ConflictSkip:
+		return ConflictSkip, nil
+	case ConflictOverwrite:
+		return ConflictOverwrite, nil
+	}
+	return "", fmt.Errorf("tenantarchive: unknown conflict strategy %q", s)
+}
+
+// Action 导入时对单个实体的操作
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionSkip   Action = "skip"
+)
+
+// IdMap 归档中的ID到目标租户中ID的映射，按实体区分
+type IdMap map[string]map[uint32]uint32
+
+// Set 记录实体的新ID
+func (m IdMap) Set(entity string, sourceId, targetId uint32) {
+	ids, ok := m[entity]
+	if !ok {
+		ids = make(map[uint32]uint32)
+		m[entity] = ids
+	}
+	ids[sourceId] = targetId
+}
+
+// Get 查询实体的新ID
+func (m IdMap) Get(entity string, sourceId uint32) (uint32, bool) {
+	id, ok := m[entity][sourceId]
+	return id, ok
+}
+
+// Ref 转换可选的引用，没有引用或找不到新ID时返回 nil
+func (m IdMap) Ref(entity string, sourceId *uint32) *uint32 {
+	if sourceId == nil || *sourceId == 0 {
+		return nil
+	}
+	if id, ok := m.Get(entity, *sourceId); ok {
+		return &id
+	}
+	return nil
+}
+
+// Refs 转换引用列表，忽略找不到新ID的引用
+func (m IdMap) Refs(entity string, sourceIds []uint32) []uint32 {
+	ids := make([]uint32, 0, len(sourceIds))
+	for _, sourceId := range sourceIds {
+		if id, ok := m.Get(entity, sourceId); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ReportItem 单个实体的导入结果
+type ReportItem struct {
+	Entity   string `json:"entity"`
+	Key      string `json:"key"`
+	SourceId uint32 `json:"source_id,omitempty"`
+	TargetId uint32 `json:"target_id,omitempty"`
+	Action   Action `json:"action"`
+}
+
+// Report 导入报告。预演时与实际导入执行相同的操作，只是最后不提交，所以报告与实际导入的结果一致
+type Report struct {
+	DryRun   bool       `json:"dry_run"`
+	Conflict Conflict   `json:"conflict"`
+	Source   TenantInfo `json:"source"`
+	TenantId uint32     `json:"tenant_id"`
+
+	// Summary 各实体每种操作的数量
+	Summary map[string]map[Action]int `json:"summary"`
+	// Items 每个实体的导入结果
+	Items []ReportItem `json:"items,omitempty"`
+	// Warnings 不影响导入但需要关注的问题，如找不到的菜单授权
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// NewReport 创建导入报告
+func NewReport(a *Archive, tenantId uint32, conflict Conflict, dryRun bool) *Report {
+	return &Report{
+		DryRun:   dryRun,
+		Conflict: conflict,
+		Source:   a.Manifest.Tenant,
+		TenantId: tenantId,
+		Summary:  make(map[string]map[Action]int),
+	}
+}
+
+// Add 记录单个实体的导入结果
+func (r *Report) Add(entity, key string, sourceId, targetId uint32, action Action) {
+	r.Items = append(r.Items, ReportItem{
+		Entity:   entity,
+		Key:      key,
+		SourceId: sourceId,
+		TargetId: targetId,
+		Action:   action,
+	})
+
+	counts, ok := r.Summary[entity]
+	if !ok {
+		counts = make(map[Action]int)
+		r.Summary[entity] = counts
+	}
+	counts[action]++
+}
+
+// Warn 记录警告
+func (r *Report) Warn(format string, args ...any) {
+	r.Warnings = append(r.Warnings, fmt.Sprintf(format, args...))
+}
+
+// Count 返回实体某种操作的数量
+func (r *Report) Count(entity string, action Action) int {
+	return r.Summary[entity][action]
+}
+
+// MissingKeys 去重并排序找不到的标识，用于生成警告
+func MissingKeys(keys []string, known map[string]uint32) []string {
+	seen := make(map[string]bool)
+	var missing []string
+	for _, key := range keys {
+		if _, ok := known[key]; ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		missing = append(missing, key)
+	}
+	sort.Strings(missing)
+	return missing
+}
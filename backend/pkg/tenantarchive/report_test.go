@@ -0,0 +1,71 @@
+package tenantarchive
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	v, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return v
+}
+
+func TestParseConflict(t *testing.T) {
+	c, err := ParseConflict("")
+	require.NoError(t, err)
+	assert.Equal(t, ConflictSkip, c)
+
+	c, err = ParseConflict("overwrite")
+	require.NoError(t, err)
+	assert.Equal(t, ConflictOverwrite, c)
+
+	_, err = ParseConflict("merge")
+	assert.Error(t, err)
+}
+
+func TestIdMap(t *testing.T) {
+	m := make(IdMap)
+	m.Set(EntityRole, 40, 400)
+	m.Set(EntityRole, 41, 401)
+
+	id, ok := m.Get(EntityRole, 40)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(400), id)
+
+	_, ok = m.Get(EntityUser, 40)
+	assert.False(t, ok)
+
+	assert.Equal(t, uint32(401), *m.Ref(EntityRole, ptr(uint32(41))))
+	assert.Nil(t, m.Ref(EntityRole, ptr(uint32(42))))
+	assert.Nil(t, m.Ref(EntityRole, ptr(uint32(0))))
+	assert.Nil(t, m.Ref(EntityRole, nil))
+
+	assert.Equal(t, []uint32{400, 401}, m.Refs(EntityRole, []uint32{40, 99, 41}))
+}
+
+func TestReport(t *testing.T) {
+	r := NewReport(testArchive(), 9, ConflictSkip, true)
+	r.Add(EntityRole, "admin", 40, 400, ActionCreate)
+	r.Add(EntityRole, "user", 41, 500, ActionSkip)
+	r.Add(EntityUser, "alice", 100, 900, ActionCreate)
+	r.Warn("menu %s not found", "Dashboard")
+
+	assert.True(t, r.DryRun)
+	assert.Equal(t, "acme", r.Source.Code)
+	assert.Equal(t, 1, r.Count(EntityRole, ActionCreate))
+	assert.Equal(t, 1, r.Count(EntityRole, ActionSkip))
+	assert.Equal(t, 0, r.Count(EntityRole, ActionUpdate))
+	assert.Equal(t, 1, r.Count(EntityUser, ActionCreate))
+	assert.Len(t, r.Items, 3)
+	assert.Equal(t, []string{"menu Dashboard not found"}, r.Warnings)
+
+	assert.Equal(t,
+		[]string{"Reports", "Users"},
+		MissingKeys([]string{"Users", "Dashboard", "Reports", "Users"}, map[string]uint32{"Dashboard": 1}),
+	)
+}
@@ -0,0 +1,14 @@
+package slice
+
+// Chunk 按 size 把切片拆分为多个批次，最后一个批次可能不足 size 个元素，返回的批次与原切片共享底层数组
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 || len(s) == 0 {
+		return nil
+	}
+
+	chunks := make([][]T, 0, (len(s)+size-1)/size)
+	for size < len(s) {
+		s, chunks = s[size:], append(chunks, s[0:size:size])
+	}
+	return append(chunks, s)
+}
@@ -0,0 +1,65 @@
+package slice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		slice    []uint32
+		size     int
+		expected [][]uint32
+	}{
+		{
+			name:     "Empty slice",
+			slice:    nil,
+			size:     2,
+			expected: nil,
+		},
+		{
+			name:     "Invalid size",
+			slice:    []uint32{1, 2, 3},
+			size:     0,
+			expected: nil,
+		},
+		{
+			name:     "Exact batches",
+			slice:    []uint32{1, 2, 3, 4},
+			size:     2,
+			expected: [][]uint32{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "Last batch smaller",
+			slice:    []uint32{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]uint32{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "Size larger than slice",
+			slice:    []uint32{1, 2, 3},
+			size:     10,
+			expected: [][]uint32{{1, 2, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Chunk(tt.slice, tt.size)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Chunk() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestChunkDoesNotOverwrite(t *testing.T) {
+	s := []uint32{1, 2, 3, 4}
+	chunks := Chunk(s, 2)
+
+	chunks[0] = append(chunks[0], 99)
+	if s[2] != 3 {
+		t.Errorf("appending to a chunk overwrote the next batch: %v", s)
+	}
+}
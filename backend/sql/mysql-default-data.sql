@@ -3,28 +3,28 @@ USE `gwa`;
 -- 插入4个权限的用户
 TRUNCATE TABLE `sys_users`;
 INSERT INTO `sys_users` (username, nickname, realname, email, authority, role_ids, gender, tenant_id, created_at)
-VALUES ('admin', '鹳狸猿', '喵个咪', 'admin@gmail.com', 'SYS_ADMIN', '[1]', 'MALE', NULL, NOW()),
+VALUES ('admin', '鹳狸猿', '喵个咪', 'admin@gmail.com', 'SYS_ADMIN', '[1]', 'MALE', 0, NOW()),
        ('tenant_admin', '租户管理', '张管理员', 'tenant@company.com', 'TENANT_ADMIN', '[2]', 'MALE', 1, NOW()),
-       ('normal_user', '普通用户', '李用户', 'user@company.com', 'CUSTOMER_USER', '[3]', 'FEMALE', NULL, NOW()),
-       ('guest_user', '临时访客', '王访客', 'guest@company.com', 'GUEST', '[4]', 'SECRET', NULL, NOW());
+       ('normal_user', '普通用户', '李用户', 'user@company.com', 'CUSTOMER_USER', '[3]', 'FEMALE', 0, NOW()),
+       ('guest_user', '临时访客', '王访客', 'guest@company.com', 'GUEST', '[4]', 'SECRET', 0, NOW());
 
 -- 插入4个用户的凭证（密码统一为admin）
 TRUNCATE TABLE `sys_user_credentials`;
-INSERT INTO `sys_user_credentials` (user_id, identity_type, identifier, credential_type, credential, status, is_primary, created_at)
-VALUES (1, 'USERNAME', 'admin', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
-       (1, 'EMAIL', 'admin@gmail.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
-       (2, 'USERNAME', 'tenant_admin', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
-       (2, 'EMAIL', 'tenant@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
-       (3, 'USERNAME', 'normal_user', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
-       (3, 'EMAIL', 'user@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
-       (4, 'USERNAME', 'guest_user', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
-       (4, 'EMAIL', 'guest@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW());
+INSERT INTO `sys_user_credentials` (user_id, tenant_id, identity_type, identifier, credential_type, credential, status, is_primary, created_at)
+VALUES (1, 0, 'USERNAME', 'admin', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
+       (1, 0, 'EMAIL', 'admin@gmail.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
+       (2, 1, 'USERNAME', 'tenant_admin', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
+       (2, 1, 'EMAIL', 'tenant@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
+       (3, 0, 'USERNAME', 'normal_user', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
+       (3, 0, 'EMAIL', 'user@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW()),
+       (4, 0, 'USERNAME', 'guest_user', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 1, NOW()),
+       (4, 0, 'EMAIL', 'guest@company.com', 'PASSWORD_HASH', '$2a$10$yajZDX20Y40FkG0Bu4N19eXNqRizez/S9fK63.JxGkfLq.RoNKR/a', 'ENABLED', 0, NOW());
 
 -- 默认的角色
 TRUNCATE TABLE `sys_roles`;
 INSERT INTO `sys_roles` (id, parent_id, created_by, sort_order, name, code, status, remark, menus, apis, created_at)
 VALUES (1, NULL, 0, 1, '超级管理员', 'super', 'ON', '拥有系统所有功能的操作权限，可管理租户、用户、角色及所有资源',
-        '[1, 2, 10, 11, 20, 21, 22, 23, 24, 25, 30, 31, 32, 40, 41, 42, 50, 51, 52, 60, 61, 62, 63, 64, 65]', '[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107]', NOW()),
+        '[1, 2, 10, 11, 12, 13, 20, 21, 22, 23, 24, 25, 30, 31, 32, 40, 41, 42, 50, 51, 52, 60, 61, 62, 63, 64, 65, 66]', '[1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51, 52, 53, 54, 55, 56, 57, 58, 59, 60, 61, 62, 63, 64, 65, 66, 67, 68, 69, 70, 71, 72, 73, 74, 75, 76, 77, 78, 79, 80, 81, 82, 83, 84, 85, 86, 87, 88, 89, 90, 91, 92, 93, 94, 95, 96, 97, 98, 99, 100, 101, 102, 103, 104, 105, 106, 107]', NOW()),
        (2, NULL, 0, 2, '租户管理员', 'tenant_admin', 'ON', '管理当前租户下的用户、角色及资源，无跨租户操作权限', '[1, 2, 20, 21, 22, 23, 24, 25, 50, 51, 52]', '[105, 104, 35, 34, 16, 106, 93, 14, 1, 92, 91, 85, 79, 46, 24, 23, 78, 56, 55, 8, 7, 52, 51, 6, 5, 4, 31, 30, 20, 19, 53, 15]', NOW()),
        (3, NULL, 0, 3, '普通用户', 'user', 'ON', '可访问和使用租户内授权的资源，无管理权限', '[]', '[]', NOW()),
        (4, NULL, 0, 4, '访客用户', 'guest', 'ON', '仅可访问公开资源，无修改和管理权限，会话过期后自动失效', '[]', '[]', NOW()),
@@ -37,6 +37,8 @@ VALUES (1, NULL, 'FOLDER', 'Dashboard', '/', NULL, 'BasicLayout', 'ON', NOW(), '
        (2, 1, 'MENU', 'Analytics', '/analytics', NULL, 'dashboard/analytics/index.vue', 'ON', NOW(), '{"order":-1, "title":"page.dashboard.analytics", "icon":"lucide:area-chart", "affixTab": true, "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (10, NULL, 'FOLDER', 'TenantManagement', '/tenant', NULL, 'BasicLayout', 'ON', NOW(), '{"order":2000, "title":"menu.tenant.moduleName", "icon":"lucide:building-2", "keepAlive":true, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (11, 10, 'MENU', 'TenantMemberManagement', 'members', NULL, 'app/tenant/tenant/index.vue', 'ON', NOW(), '{"order":1, "title":"menu.tenant.member", "icon":"lucide:building-2", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
+       (12, 10, 'MENU', 'TenantPlanManagement', 'plans', NULL, 'app/tenant/plan/index.vue', 'ON', NOW(), '{"order":2, "title":"menu.tenant.plan", "icon":"lucide:package", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
+       (13, 10, 'MENU', 'TenantUsageManagement', 'usage', NULL, 'app/tenant/usage/index.vue', 'ON', NOW(), '{"order":3, "title":"menu.tenant.usage", "icon":"lucide:gauge", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (20, NULL, 'FOLDER', 'OrganizationalPersonnelManagement', '/opm', NULL, 'BasicLayout', 'ON', NOW(), '{"order":2001, "title":"menu.opm.moduleName", "icon":"lucide:users", "keepAlive":true, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (21, 20, 'MENU', 'OrganizationManagement', 'organizations', NULL, 'app/opm/org/index.vue', 'ON', NOW(), '{"order":1, "title":"menu.opm.org", "icon":"lucide:building-2", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (22, 20, 'MENU', 'DepartmentManagement', 'departments', NULL, 'app/opm/dept/index.vue', 'ON', NOW(), '{"order":2, "title":"menu.opm.dept", "icon":"lucide:folder-tree", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
@@ -57,7 +59,8 @@ VALUES (1, NULL, 'FOLDER', 'Dashboard', '/', NULL, 'BasicLayout', 'ON', NOW(), '
        (62, 60, 'MENU', 'FileManagement', 'files', NULL, 'app/system/files/index.vue', 'ON', NOW(), '{"order":2, "title":"menu.system.file", "icon":"lucide:file-search", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (63, 60, 'MENU', 'TaskManagement', 'tasks', NULL, 'app/system/task/index.vue', 'ON', NOW(), '{"order":3, "title":"menu.system.task", "icon":"lucide:list-todo", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
        (64, 60, 'MENU', 'APIResourceManagement', 'apis', NULL, 'app/system/api_resource/index.vue', 'ON', NOW(), '{"order":4, "title":"menu.system.apiResource", "icon":"lucide:route", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
-       (65, 60, 'MENU', 'AdminLoginRestrictionManagement', 'admin_login_restriction', NULL, 'app/system/admin_login_restriction/index.vue', 'ON', NOW(), '{"order":5, "title":"menu.system.adminLoginRestriction", "icon":"lucide:shield-x", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}');
+       (65, 60, 'MENU', 'AdminLoginRestrictionManagement', 'admin_login_restriction', NULL, 'app/system/admin_login_restriction/index.vue', 'ON', NOW(), '{"order":5, "title":"menu.system.adminLoginRestriction", "icon":"lucide:shield-x", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}'),
+       (66, 60, 'MENU', 'SettingManagement', 'settings', NULL, 'app/system/settings/index.vue', 'ON', NOW(), '{"order":6, "title":"menu.system.setting", "icon":"lucide:settings-2", "keepAlive":false, "hideInBreadcrumb":false, "hideInMenu":false, "hideInTab":false}');
 
 -- API资源表数据
 TRUNCATE TABLE `sys_api_resources`;
@@ -59,7 +59,8 @@ VALUES
 TRUNCATE TABLE `sys_tasks`;
 INSERT INTO `sys_tasks`(type, type_name, task_payload, cron_spec, enable, created_at)
 VALUES
-    ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', 1, NOW());
+    ('PERIODIC', 'backup', '{ "name": "test", "keep_last": 24, "keep_days": 7}', '0 * * * *', 1, NOW()),
+    ('PERIODIC', 'archive_internal_message', '{ "older_than_days": 180}', '0 3 * * *', 1, NOW());
 
 -- 后台登录限制
 TRUNCATE TABLE `sys_admin_login_restrictions`;
@@ -0,0 +1,10 @@
+-- 职位编码由全局唯一改为租户内唯一，不同租户可以使用相同的职位编码，导入租户数据时按编码识别已存在的职位。
+-- 开启数据库自动迁移（data.database.migrate）时服务启动会自动完成，未开启时手动执行本脚本。
+USE `gwa`;
+
+ALTER TABLE `sys_positions` DROP INDEX `idx_sys_position_code`;
+
+-- 租户ID为空的职位归入平台（租户ID为0）
+UPDATE `sys_positions` SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+ALTER TABLE `sys_positions` ADD UNIQUE INDEX `idx_sys_position_tenant_code` (tenant_id, code);
@@ -0,0 +1,34 @@
+-- 用户名、角色名称与编码、字典类型编码由全局唯一改为租户内唯一。
+-- 开启数据库自动迁移（data.database.migrate）时服务启动会自动完成，未开启时手动执行本脚本。
+USE `gwa`;
+
+-- 删除原有的全局唯一索引
+ALTER TABLE `sys_users` DROP INDEX `idx_sys_user_username`;
+ALTER TABLE `sys_roles` DROP INDEX `idx_sys_role_name`, DROP INDEX `idx_sys_role_code`;
+ALTER TABLE `sys_dict_types` DROP INDEX `idx_sys_dict_types_type_code`;
+
+-- 系统管理员创建的租户管理员没有写入租户ID
+UPDATE `sys_users` u
+    JOIN `sys_tenants` t ON t.admin_user_id = u.id
+SET u.tenant_id = t.id
+WHERE u.tenant_id IS NULL;
+
+-- 其余租户ID为空的数据归入平台（租户ID为0）
+UPDATE `sys_users` SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+-- 凭证的租户ID与所属用户保持一致
+UPDATE `sys_user_credentials` c
+    JOIN `sys_users` u ON u.id = c.user_id
+SET c.tenant_id = u.tenant_id
+WHERE u.tenant_id <> 0
+  AND (c.tenant_id IS NULL OR c.tenant_id = 0);
+
+UPDATE `sys_user_credentials` SET tenant_id = 0 WHERE tenant_id IS NULL;
+UPDATE `sys_roles` SET tenant_id = 0 WHERE tenant_id IS NULL;
+UPDATE `sys_dict_types` SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+-- 租户内唯一索引
+ALTER TABLE `sys_users` ADD UNIQUE INDEX `idx_sys_user_tenant_username` (tenant_id, username);
+ALTER TABLE `sys_roles` ADD UNIQUE INDEX `idx_sys_role_tenant_name` (tenant_id, name), ADD UNIQUE INDEX `idx_sys_role_tenant_code` (tenant_id, code);
+ALTER TABLE `sys_dict_types` ADD UNIQUE INDEX `idx_sys_dict_types_tenant_type_code` (tenant_id, type_code);
+ALTER TABLE `sys_user_credentials` ADD INDEX `idx_sys_user_credential_tenant_identity_identifier` (tenant_id, identity_type, identifier);
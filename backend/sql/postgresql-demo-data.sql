@@ -79,7 +79,8 @@ SELECT setval('sys_positions_id_seq', (SELECT MAX(id) FROM sys_positions));
 -- 调度任务
 INSERT INTO public.sys_tasks(type, type_name, task_payload, cron_spec, enable, created_at)
 VALUES
-    ('PERIODIC', 'backup', '{ "name": "test"}', '0 * * * *', true, now())
+    ('PERIODIC', 'backup', '{ "name": "test", "keep_last": 24, "keep_days": 7}', '0 * * * *', true, now()),
+    ('PERIODIC', 'archive_internal_message', '{ "older_than_days": 180}', '0 3 * * *', true, now())
 ;
 SELECT setval('sys_tasks_id_seq', (SELECT MAX(id) FROM sys_tasks));
 
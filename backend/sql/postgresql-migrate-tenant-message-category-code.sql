@@ -0,0 +1,14 @@
+-- 站内信分类编码由全局唯一改为租户内唯一，新建租户时会从平台复制分类。
+-- 开启数据库自动迁移（data.database.migrate）时服务启动会自动完成，未开启时手动执行本脚本。
+BEGIN;
+
+SET LOCAL search_path = public, pg_catalog;
+
+DROP INDEX IF EXISTS idx_internal_message_category_code;
+
+-- 租户ID为空的分类归入平台（租户ID为0）
+UPDATE internal_message_categories SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_internal_message_category_tenant_code ON internal_message_categories (tenant_id, code);
+
+COMMIT;
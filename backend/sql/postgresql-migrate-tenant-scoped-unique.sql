@@ -0,0 +1,42 @@
+-- 用户名、角色名称与编码、字典类型编码由全局唯一改为租户内唯一。
+-- 开启数据库自动迁移（data.database.migrate）时服务启动会自动完成，未开启时手动执行本脚本。
+BEGIN;
+
+SET LOCAL search_path = public, pg_catalog;
+
+-- 删除原有的全局唯一索引
+DROP INDEX IF EXISTS idx_sys_user_username;
+DROP INDEX IF EXISTS idx_sys_role_name;
+DROP INDEX IF EXISTS idx_sys_role_code;
+DROP INDEX IF EXISTS idx_sys_dict_types_type_code;
+
+-- 系统管理员创建的租户管理员没有写入租户ID
+UPDATE sys_users u
+SET tenant_id = t.id
+FROM sys_tenants t
+WHERE t.admin_user_id = u.id
+  AND u.tenant_id IS NULL;
+
+-- 其余租户ID为空的数据归入平台（租户ID为0）
+UPDATE sys_users SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+-- 凭证的租户ID与所属用户保持一致
+UPDATE sys_user_credentials c
+SET tenant_id = u.tenant_id
+FROM sys_users u
+WHERE u.id = c.user_id
+  AND u.tenant_id <> 0
+  AND (c.tenant_id IS NULL OR c.tenant_id = 0);
+
+UPDATE sys_user_credentials SET tenant_id = 0 WHERE tenant_id IS NULL;
+UPDATE sys_roles SET tenant_id = 0 WHERE tenant_id IS NULL;
+UPDATE sys_dict_types SET tenant_id = 0 WHERE tenant_id IS NULL;
+
+-- 租户内唯一索引
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sys_user_tenant_username ON sys_users (tenant_id, username);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sys_role_tenant_name ON sys_roles (tenant_id, name);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sys_role_tenant_code ON sys_roles (tenant_id, code);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_sys_dict_types_tenant_type_code ON sys_dict_types (tenant_id, type_code);
+CREATE INDEX IF NOT EXISTS idx_sys_user_credential_tenant_identity_identifier ON sys_user_credentials (tenant_id, identity_type, identifier);
+
+COMMIT;
@@ -670,6 +670,10 @@ export type AdminOperationLog = {
   userId?: number;
   username?: string;
   clientIp?: string;
+  impersonatorId?: number;
+  impersonatorName?: string;
+  impersonationReason?: string;
+  impersonationAuditId?: number;
   userAgent?: string;
   browserName?: string;
   browserVersion?: string;
@@ -1096,6 +1100,12 @@ export interface AuthenticationService {
   Logout(request: wellKnownEmpty): Promise<wellKnownEmpty>;
   // 刷新认证令牌
   RefreshToken(request: authenticationservicev1_LoginRequest): Promise<authenticationservicev1_LoginResponse>;
+  // 获取当前用户身份信息，代管时同时返回操作人的身份
+  WhoAmI(request: wellKnownEmpty): Promise<authenticationservicev1_WhoAmIResponse>;
+  // 平台管理员代管租户，获取限时的代管访问令牌
+  Impersonate(request: authenticationservicev1_ImpersonateRequest): Promise<authenticationservicev1_LoginResponse>;
+  // 创建SSE连接票据，浏览器的 EventSource 不能设置请求头，使用票据建立连接
+  CreateSseTicket(request: wellKnownEmpty): Promise<authenticationservicev1_CreateSseTicketResponse>;
 }
 
 export function createAuthenticationServiceClient(
@@ -1153,6 +1163,57 @@ export function createAuthenticationServiceClient(
         method: "RefreshToken",
       }) as Promise<authenticationservicev1_LoginResponse>;
     },
+    WhoAmI(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/whoami`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "AuthenticationService",
+        method: "WhoAmI",
+      }) as Promise<authenticationservicev1_WhoAmIResponse>;
+    },
+    Impersonate(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/impersonate`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "AuthenticationService",
+        method: "Impersonate",
+      }) as Promise<authenticationservicev1_LoginResponse>;
+    },
+    CreateSseTicket(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/sse/ticket`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "AuthenticationService",
+        method: "CreateSseTicket",
+      }) as Promise<authenticationservicev1_CreateSseTicketResponse>;
+    },
   };
 }
 // 用户后台登录 - 请求
@@ -1167,6 +1228,7 @@ export type authenticationservicev1_LoginRequest = {
   username?: string;
   password?: string;
   user_id?: number;
+  tenant_code?: string;
   refresh_token?: string;
   code?: string;
   client_type?: authenticationservicev1_ClientType;
@@ -1192,6 +1254,39 @@ export type authenticationservicev1_LoginResponse = {
   scope?: string;
 };
 
+// 获取当前用户身份信息 - 响应
+export type authenticationservicev1_WhoAmIResponse = {
+  uid: number | undefined;
+  username: string | undefined;
+  authority: userservicev1_User_Authority | undefined;
+  tenantId?: number;
+  tenantName?: string;
+  impersonated: boolean | undefined;
+  actUserId?: number;
+  actUsername?: string;
+  actReason?: string;
+};
+
+// 代管租户 - 请求
+export type authenticationservicev1_ImpersonateRequest = {
+  //
+  // Behaviors: REQUIRED
+  tenantId: number | undefined;
+  userId?: number;
+  //
+  // Behaviors: REQUIRED
+  reason: string | undefined;
+  client_id?: string;
+};
+
+// 创建SSE连接票据 - 回应
+export type authenticationservicev1_CreateSseTicketResponse = {
+  // 一次性连接票据，通过查询参数 ticket 建立SSE连接
+  ticket: string | undefined;
+  // 票据有效期，单位秒
+  expiresIn: number | undefined;
+};
+
 // 令牌类型
 export type authenticationservicev1_TokenType =
   | "bearer"
@@ -2079,6 +2174,14 @@ export interface InternalMessageService {
   SendMessage(request: internal_messageservicev1_SendMessageRequest): Promise<internal_messageservicev1_SendMessageResponse>;
   // 撤销某条消息
   RevokeMessage(request: internal_messageservicev1_RevokeMessageRequest): Promise<wellKnownEmpty>;
+  // 查询消息的阅读统计
+  GetMessageReadStats(request: internal_messageservicev1_GetMessageReadStatsRequest): Promise<internal_messageservicev1_MessageReadStats>;
+  // 查询消息的未读者列表
+  ListMessageNonReaders(request: internal_messageservicev1_ListMessageNonReadersRequest): Promise<internal_messageservicev1_ListMessageNonReadersResponse>;
+  // 导出消息的未读者
+  ExportMessageNonReaders(request: internal_messageservicev1_ExportMessageNonReadersRequest): Promise<internal_messageservicev1_ExportMessageNonReadersResponse>;
+  // 提醒消息的未读者
+  RemindMessageNonReaders(request: internal_messageservicev1_RemindMessageNonReadersRequest): Promise<internal_messageservicev1_RemindMessageNonReadersResponse>;
 }
 
 export function createInternalMessageServiceClient(
@@ -2254,6 +2357,95 @@ export function createInternalMessageServiceClient(
         method: "RevokeMessage",
       }) as Promise<wellKnownEmpty>;
     },
+    GetMessageReadStats(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.messageId) {
+        throw new Error("missing required field request.message_id");
+      }
+      const path = `admin/v1/internal-message/messages/${request.messageId}/read-stats`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.interval) {
+        queryParams.push(`interval=${encodeURIComponent(request.interval.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "InternalMessageService",
+        method: "GetMessageReadStats",
+      }) as Promise<internal_messageservicev1_MessageReadStats>;
+    },
+    ListMessageNonReaders(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.messageId) {
+        throw new Error("missing required field request.message_id");
+      }
+      const path = `admin/v1/internal-message/messages/${request.messageId}/non-readers`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.page) {
+        queryParams.push(`page=${encodeURIComponent(request.page.toString())}`)
+      }
+      if (request.pageSize) {
+        queryParams.push(`pageSize=${encodeURIComponent(request.pageSize.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "InternalMessageService",
+        method: "ListMessageNonReaders",
+      }) as Promise<internal_messageservicev1_ListMessageNonReadersResponse>;
+    },
+    ExportMessageNonReaders(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.messageId) {
+        throw new Error("missing required field request.message_id");
+      }
+      const path = `admin/v1/internal-message/messages/${request.messageId}/non-readers:export`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "InternalMessageService",
+        method: "ExportMessageNonReaders",
+      }) as Promise<internal_messageservicev1_ExportMessageNonReadersResponse>;
+    },
+    RemindMessageNonReaders(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.messageId) {
+        throw new Error("missing required field request.message_id");
+      }
+      const path = `admin/v1/internal-message/messages/${request.messageId}/non-readers:remind`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "InternalMessageService",
+        method: "RemindMessageNonReaders",
+      }) as Promise<internal_messageservicev1_RemindMessageNonReadersResponse>;
+    },
   };
 }
 // 查询站内信消息列表 - 回应
@@ -2275,6 +2467,10 @@ export type internal_messageservicev1_InternalMessage = {
   senderName?: string;
   categoryId?: number;
   categoryName?: string;
+  remindCount?: number;
+  lastRemindedAt?: wellKnownTimestamp;
+  priority?: internal_messageservicev1_InternalMessage_Priority;
+  tenantId?: number;
   createdBy?: number;
   updatedBy?: number;
   deletedBy?: number;
@@ -2296,6 +2492,12 @@ export type internal_messageservicev1_InternalMessage_Type =
   | "NOTIFICATION"
   | "PRIVATE"
   | "GROUP";
+// 消息优先级
+export type internal_messageservicev1_InternalMessage_Priority =
+  | "NORMAL"
+  | "LOW"
+  | "HIGH"
+  | "URGENT";
 // 查询站内信消息详情 - 请求
 export type internal_messageservicev1_GetInternalMessageRequest = {
   id?: number;
@@ -2324,6 +2526,7 @@ export type internal_messageservicev1_SendMessageRequest = {
   targetAll?: boolean;
   title?: string;
   content: string | undefined;
+  priority?: internal_messageservicev1_InternalMessage_Priority;
 };
 
 export type internal_messageservicev1_SendMessageResponse = {
@@ -2563,6 +2766,10 @@ export interface InternalMessageRecipientService {
   DeleteNotificationFromInbox(request: internal_messageservicev1_DeleteNotificationFromInboxRequest): Promise<wellKnownEmpty>;
   // 将通知标记为已读
   MarkNotificationAsRead(request: internal_messageservicev1_MarkNotificationAsReadRequest): Promise<wellKnownEmpty>;
+  // 获取用户收件箱的未读数量汇总
+  GetInboxSummary(request: internal_messageservicev1_GetInboxSummaryRequest): Promise<internal_messageservicev1_InboxSummary>;
+  // 将用户收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+  MarkAllNotificationsAsRead(request: internal_messageservicev1_MarkAllNotificationsAsReadRequest): Promise<internal_messageservicev1_InboxSummary>;
 }
 
 export function createInternalMessageRecipientServiceClient(
@@ -2675,6 +2882,43 @@ export function createInternalMessageRecipientServiceClient(
         method: "MarkNotificationAsRead",
       }) as Promise<wellKnownEmpty>;
     },
+    GetInboxSummary(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/internal-message/inbox/summary`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.userId) {
+        queryParams.push(`userId=${encodeURIComponent(request.userId.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "InternalMessageRecipientService",
+        method: "GetInboxSummary",
+      }) as Promise<internal_messageservicev1_InboxSummary>;
+    },
+    MarkAllNotificationsAsRead(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/internal-message/read-all`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "InternalMessageRecipientService",
+        method: "MarkAllNotificationsAsRead",
+      }) as Promise<internal_messageservicev1_InboxSummary>;
+    },
   };
 }
 export type internal_messageservicev1_ListUserInboxResponse = {
@@ -2717,6 +2961,116 @@ export type internal_messageservicev1_MarkNotificationAsReadRequest = {
   recipientIds: number[] | undefined;
 };
 
+// 查询收件箱未读数量汇总 - 请求
+export type internal_messageservicev1_GetInboxSummaryRequest = {
+  userId: number | undefined;
+};
+
+// 收件箱未读数量汇总
+export type internal_messageservicev1_InboxSummary = {
+  userId: number | undefined;
+  totalUnread: number | undefined;
+  categories: internal_messageservicev1_InboxSummary_CategoryUnread[] | undefined;
+  types: internal_messageservicev1_InboxSummary_TypeUnread[] | undefined;
+};
+
+// 分类的未读数量
+export type internal_messageservicev1_InboxSummary_CategoryUnread = {
+  categoryId: number | undefined;
+  categoryName?: string;
+  unread: number | undefined;
+};
+
+// 消息类型的未读数量
+export type internal_messageservicev1_InboxSummary_TypeUnread = {
+  type: internal_messageservicev1_InternalMessage_Type | undefined;
+  unread: number | undefined;
+};
+
+// 全部标记为已读 - 请求
+export type internal_messageservicev1_MarkAllNotificationsAsReadRequest = {
+  userId: number | undefined;
+  categoryId?: number;
+  type?: internal_messageservicev1_InternalMessage_Type;
+};
+
+// 查询消息阅读统计 - 请求
+export type internal_messageservicev1_GetMessageReadStatsRequest = {
+  messageId: number | undefined;
+  interval?: string;
+};
+
+// 消息阅读统计
+export type internal_messageservicev1_MessageReadStats = {
+  messageId: number | undefined;
+  targeted: number | undefined;
+  delivered: number | undefined;
+  failed: number | undefined;
+  received: number | undefined;
+  read: number | undefined;
+  unread: number | undefined;
+  revoked: number | undefined;
+  deleted: number | undefined;
+  readRate: number | undefined;
+  readTrend: internal_messageservicev1_MessageReadStats_ReadTrendPoint[] | undefined;
+  remindCount?: number;
+  lastRemindedAt?: wellKnownTimestamp;
+};
+
+// 阅读趋势的时间段
+export type internal_messageservicev1_MessageReadStats_ReadTrendPoint = {
+  time: wellKnownTimestamp | undefined;
+  read: number | undefined;
+  cumulativeRead: number | undefined;
+  readRate: number | undefined;
+};
+
+// 查询消息未读者列表 - 请求
+export type internal_messageservicev1_ListMessageNonReadersRequest = {
+  messageId: number | undefined;
+  page?: number;
+  pageSize?: number;
+};
+
+// 消息未读者
+export type internal_messageservicev1_MessageNonReader = {
+  recipientId: number | undefined;
+  userId: number | undefined;
+  username?: string;
+  realname?: string;
+  status: internal_messageservicev1_InternalMessageRecipient_Status | undefined;
+  deliveredAt?: wellKnownTimestamp;
+  receivedAt?: wellKnownTimestamp;
+};
+
+// 查询消息未读者列表 - 回应
+export type internal_messageservicev1_ListMessageNonReadersResponse = {
+  items: internal_messageservicev1_MessageNonReader[] | undefined;
+  total: number | undefined;
+};
+
+// 导出消息未读者 - 请求
+export type internal_messageservicev1_ExportMessageNonReadersRequest = {
+  messageId: number | undefined;
+};
+
+// 导出消息未读者 - 回应
+export type internal_messageservicev1_ExportMessageNonReadersResponse = {
+  fileName: string | undefined;
+  mimeType: string | undefined;
+  content: string | undefined;
+};
+
+// 提醒消息未读者 - 请求
+export type internal_messageservicev1_RemindMessageNonReadersRequest = {
+  messageId: number | undefined;
+};
+
+// 提醒消息未读者 - 回应
+export type internal_messageservicev1_RemindMessageNonReadersResponse = {
+  reminded: number | undefined;
+};
+
 // 路由项
 export type RouteItem = {
   children: RouteItem[] | undefined;
@@ -4056,6 +4410,130 @@ export type ListTaskTypeNameResponse = {
   typeNames: string[] | undefined;
 };
 
+// 系统与租户配置管理服务
+export interface SettingService {
+  // 查询配置项定义
+  ListDefinitions(request: wellKnownEmpty): Promise<userservicev1_ListSettingDefinitionResponse>;
+  // 查询系统或租户生效的配置
+  GetSettings(request: userservicev1_GetSettingsRequest): Promise<userservicev1_ListSettingResponse>;
+  // 更新系统或租户配置
+  UpdateSettings(request: userservicev1_UpdateSettingsRequest): Promise<wellKnownEmpty>;
+}
+
+export function createSettingServiceClient(
+  handler: RequestHandler
+): SettingService {
+  return {
+    ListDefinitions(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/setting_definitions`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "SettingService",
+        method: "ListDefinitions",
+      }) as Promise<userservicev1_ListSettingDefinitionResponse>;
+    },
+    GetSettings(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/settings`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.tenantId) {
+        queryParams.push(`tenantId=${encodeURIComponent(request.tenantId.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "SettingService",
+        method: "GetSettings",
+      }) as Promise<userservicev1_ListSettingResponse>;
+    },
+    UpdateSettings(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/settings`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "PUT",
+        body,
+      }, {
+        service: "SettingService",
+        method: "UpdateSettings",
+      }) as Promise<wellKnownEmpty>;
+    },
+  };
+}
+// 配置项定义列表 - 答复
+export type userservicev1_ListSettingDefinitionResponse = {
+  items: userservicev1_SettingDefinition[] | undefined;
+};
+
+// 配置项定义
+export type userservicev1_SettingDefinition = {
+  key: string | undefined;
+  kind: string | undefined;
+  defaultValue: string | undefined;
+  scope: userservicev1_SettingDefinition_Scope | undefined;
+  options: string[] | undefined;
+  min?: number;
+  max?: number;
+  maxLength?: number;
+  description: string | undefined;
+};
+
+// 允许覆盖的层级
+export type userservicev1_SettingDefinition_Scope =
+  | "SYSTEM"
+  | "TENANT"
+  | "USER";
+// 查询配置 - 请求
+export type userservicev1_GetSettingsRequest = {
+  tenantId?: number;
+};
+
+// 生效的配置列表 - 答复
+export type userservicev1_ListSettingResponse = {
+  items: userservicev1_Setting[] | undefined;
+};
+
+// 生效的配置
+export type userservicev1_Setting = {
+  key: string | undefined;
+  value: string | undefined;
+  source: userservicev1_Setting_Source | undefined;
+  editable: boolean | undefined;
+};
+
+// 配置值的来源
+export type userservicev1_Setting_Source =
+  | "DEFAULT"
+  | "SYSTEM"
+  | "TENANT"
+  | "USER";
+// 更新配置 - 请求
+export type userservicev1_UpdateSettingsRequest = {
+  tenantId?: number;
+  values: { [key: string]: string } | undefined;
+  resetKeys: string[] | undefined;
+};
+
 // 调度任务管理服务
 export interface TaskService {
   // 查询调度任务列表
@@ -4331,18 +4809,96 @@ export type CreateTenantWithAdminUserRequest = {
   tenant: userservicev1_Tenant | undefined;
   user: userservicev1_User | undefined;
   password: string | undefined;
+  // 租户模板名称
+  template?: string;
 };
 
-// 租户
-export type userservicev1_Tenant = {
-  id?: number;
-  name?: string;
-  code?: string;
-  logoUrl?: string;
-  industry?: string;
-  status?: userservicev1_Tenant_Status;
-  type?: userservicev1_Tenant_Type;
-  auditStatus?: userservicev1_Tenant_AuditStatus;
+// 租户模板
+export type TenantTemplate = {
+  // 模板名称
+  name: string | undefined;
+  // 模板描述
+  description?: string;
+  // 是否为默认模板
+  isDefault: boolean | undefined;
+  // 复制的平台角色编码
+  roles: string[] | undefined;
+  // 分配给租户管理员的角色编码
+  adminRoles: string[] | undefined;
+  // 复制的平台字典类型编码
+  dictTypes: string[] | undefined;
+  // 复制的平台站内信分类编码
+  messageCategories: string[] | undefined;
+};
+
+// 获取租户模板列表 - 回应
+export type ListTenantTemplatesResponse = {
+  items: TenantTemplate[] | undefined;
+};
+
+// 租户下线 - 请求
+export type OffboardTenantRequest = {
+  // 租户ID
+  id: number | undefined;
+  // 下线方式
+  mode: OffboardTenantRequest_Mode | undefined;
+  // 确认的租户编码
+  confirmCode: string | undefined;
+  // 下线原因
+  reason?: string;
+};
+
+// 下线方式
+export type OffboardTenantRequest_Mode =
+  // 归档：停用租户并使租户用户下线，保留全部数据，可重新启用
+  | "ARCHIVE"
+  // 清除：删除租户及其全部数据，不可恢复
+  | "PURGE";
+// 租户下线 - 回应
+export type OffboardTenantResponse = {
+  // 各数据表受影响的行数
+  affected: { [key: string]: number } | undefined;
+};
+
+// 查询租户用量 - 请求
+export type GetTenantUsageRequest = {
+  // 租户ID，租户用户只能查询自己所在的租户
+  id?: number;
+};
+
+// 单项配额的用量
+export type QuotaUsage = {
+  // 已用量
+  used: number | undefined;
+  // 上限，0表示不限制
+  limit: number | undefined;
+  // 是否已达到上限
+  exceeded: boolean | undefined;
+};
+
+// 租户用量
+export type TenantUsage = {
+  tenantId: number | undefined;
+  // 订阅套餐，为空表示不限制
+  plan?: userservicev1_TenantPlan;
+  // 用户数
+  users: QuotaUsage | undefined;
+  // 存储空间（字节）
+  storageBytes: QuotaUsage | undefined;
+  // 今日API请求数
+  apiRequestsToday: QuotaUsage | undefined;
+};
+
+// 租户
+export type userservicev1_Tenant = {
+  id?: number;
+  name?: string;
+  code?: string;
+  logoUrl?: string;
+  industry?: string;
+  status?: userservicev1_Tenant_Status;
+  type?: userservicev1_Tenant_Type;
+  auditStatus?: userservicev1_Tenant_AuditStatus;
   remark?: string;
   adminUserId?: number;
   adminUserName?: string;
@@ -4355,6 +4911,8 @@ export type userservicev1_Tenant = {
   lastLoginIp?: string;
   parentId?: number;
   children: userservicev1_Tenant[] | undefined;
+  planId?: number;
+  planName?: string;
   createdBy?: number;
   updatedBy?: number;
   deletedBy?: number;
@@ -4454,6 +5012,12 @@ export interface TenantService {
   CreateTenantWithAdminUser(request: CreateTenantWithAdminUserRequest): Promise<wellKnownEmpty>;
   // 租户是否存在
   TenantExists(request: userservicev1_TenantExistsRequest): Promise<userservicev1_TenantExistsResponse>;
+  // 获取租户模板列表
+  ListTenantTemplates(request: wellKnownEmpty): Promise<ListTenantTemplatesResponse>;
+  // 租户下线：归档或清除租户的全部数据
+  OffboardTenant(request: OffboardTenantRequest): Promise<OffboardTenantResponse>;
+  // 查询租户用量及套餐配额
+  GetTenantUsage(request: GetTenantUsageRequest): Promise<TenantUsage>;
 }
 
 export function createTenantServiceClient(
@@ -4655,6 +5219,63 @@ export function createTenantServiceClient(
         method: "TenantExists",
       }) as Promise<userservicev1_TenantExistsResponse>;
     },
+    ListTenantTemplates(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_templates`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantService",
+        method: "ListTenantTemplates",
+      }) as Promise<ListTenantTemplatesResponse>;
+    },
+    OffboardTenant(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.id) {
+        throw new Error("missing required field request.id");
+      }
+      const path = `admin/v1/tenants/${request.id}/offboard`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "TenantService",
+        method: "OffboardTenant",
+      }) as Promise<OffboardTenantResponse>;
+    },
+    GetTenantUsage(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_usage`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.id) {
+        queryParams.push(`id=${encodeURIComponent(request.id.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantService",
+        method: "GetTenantUsage",
+      }) as Promise<TenantUsage>;
+    },
   };
 }
 // 租户列表 - 答复
@@ -4699,6 +5320,397 @@ export type userservicev1_TenantExistsResponse = {
   exist: boolean | undefined;
 };
 
+// 租户订阅套餐管理服务
+export interface TenantPlanService {
+  // 查询套餐列表
+  List(request: pagination_PagingRequest): Promise<userservicev1_ListTenantPlanResponse>;
+  // 查询套餐详情
+  Get(request: userservicev1_GetTenantPlanRequest): Promise<userservicev1_TenantPlan>;
+  // 创建套餐
+  Create(request: userservicev1_CreateTenantPlanRequest): Promise<wellKnownEmpty>;
+  // 更新套餐
+  Update(request: userservicev1_UpdateTenantPlanRequest): Promise<wellKnownEmpty>;
+  // 删除套餐
+  Delete(request: userservicev1_DeleteTenantPlanRequest): Promise<wellKnownEmpty>;
+}
+
+export function createTenantPlanServiceClient(
+  handler: RequestHandler
+): TenantPlanService {
+  return {
+    List(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_plans`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.page) {
+        queryParams.push(`page=${encodeURIComponent(request.page.toString())}`)
+      }
+      if (request.pageSize) {
+        queryParams.push(`pageSize=${encodeURIComponent(request.pageSize.toString())}`)
+      }
+      if (request.offset) {
+        queryParams.push(`offset=${encodeURIComponent(request.offset.toString())}`)
+      }
+      if (request.limit) {
+        queryParams.push(`limit=${encodeURIComponent(request.limit.toString())}`)
+      }
+      if (request.token) {
+        queryParams.push(`token=${encodeURIComponent(request.token.toString())}`)
+      }
+      if (request.noPaging) {
+        queryParams.push(`noPaging=${encodeURIComponent(request.noPaging.toString())}`)
+      }
+      if (request.orderBy) {
+        request.orderBy.forEach((x) => {
+          queryParams.push(`orderBy=${encodeURIComponent(x.toString())}`)
+        })
+      }
+      if (request.sorting?.field) {
+        queryParams.push(`sorting.field=${encodeURIComponent(request.sorting.field.toString())}`)
+      }
+      if (request.sorting?.order) {
+        queryParams.push(`sorting.order=${encodeURIComponent(request.sorting.order.toString())}`)
+      }
+      if (request.query) {
+        queryParams.push(`query=${encodeURIComponent(request.query.toString())}`)
+      }
+      if (request.or) {
+        queryParams.push(`or=${encodeURIComponent(request.or.toString())}`)
+      }
+      if (request.filterExpr?.type) {
+        queryParams.push(`filterExpr.type=${encodeURIComponent(request.filterExpr.type.toString())}`)
+      }
+      if (request.filterExpr?.conditions?.field) {
+        queryParams.push(`filterExpr.conditions.field=${encodeURIComponent(request.filterExpr.conditions.field.toString())}`)
+      }
+      if (request.filterExpr?.conditions?.op) {
+        queryParams.push(`filterExpr.conditions.op=${encodeURIComponent(request.filterExpr.conditions.op.toString())}`)
+      }
+      if (request.filterExpr?.conditions?.value) {
+        queryParams.push(`filterExpr.conditions.value=${encodeURIComponent(request.filterExpr.conditions.value.toString())}`)
+      }
+      if (request.filterExpr?.conditions?.values) {
+        request.filterExpr.conditions.values.forEach((x) => {
+          queryParams.push(`filterExpr.conditions.values=${encodeURIComponent(x.toString())}`)
+        })
+      }
+      if (request.fieldMask) {
+        queryParams.push(`fieldMask=${encodeURIComponent(request.fieldMask.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantPlanService",
+        method: "List",
+      }) as Promise<userservicev1_ListTenantPlanResponse>;
+    },
+    Get(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.id) {
+        throw new Error("missing required field request.id");
+      }
+      const path = `admin/v1/tenant_plans/${request.id}`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.code) {
+        queryParams.push(`code=${encodeURIComponent(request.code.toString())}`)
+      }
+      if (request.viewMask) {
+        queryParams.push(`viewMask=${encodeURIComponent(request.viewMask.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantPlanService",
+        method: "Get",
+      }) as Promise<userservicev1_TenantPlan>;
+    },
+    Create(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_plans`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "POST",
+        body,
+      }, {
+        service: "TenantPlanService",
+        method: "Create",
+      }) as Promise<wellKnownEmpty>;
+    },
+    Update(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.id) {
+        throw new Error("missing required field request.id");
+      }
+      const path = `admin/v1/tenant_plans/${request.id}`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "PUT",
+        body,
+      }, {
+        service: "TenantPlanService",
+        method: "Update",
+      }) as Promise<wellKnownEmpty>;
+    },
+    Delete(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      if (!request.id) {
+        throw new Error("missing required field request.id");
+      }
+      const path = `admin/v1/tenant_plans/${request.id}`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "DELETE",
+        body,
+      }, {
+        service: "TenantPlanService",
+        method: "Delete",
+      }) as Promise<wellKnownEmpty>;
+    },
+  };
+}
+// 租户订阅套餐，配额为0或列表为空表示不限制
+export type userservicev1_TenantPlan = {
+  id?: number;
+  name?: string;
+  code?: string;
+  description?: string;
+  status?: userservicev1_TenantPlan_Status;
+  sortOrder?: number;
+  remark?: string;
+  maxUsers?: number;
+  maxStorageBytes?: number;
+  maxApiRequestsPerDay?: number;
+  modules: string[] | undefined;
+  menus: number[] | undefined;
+  features: { [key: string]: boolean } | undefined;
+  createdBy?: number;
+  updatedBy?: number;
+  deletedBy?: number;
+  createdAt?: wellKnownTimestamp;
+  updatedAt?: wellKnownTimestamp;
+  deletedAt?: wellKnownTimestamp;
+};
+
+// 套餐状态
+export type userservicev1_TenantPlan_Status =
+  | "OFF"
+  | "ON";
+// 套餐列表 - 答复
+export type userservicev1_ListTenantPlanResponse = {
+  items: userservicev1_TenantPlan[] | undefined;
+  total: number | undefined;
+};
+
+// 套餐数据 - 请求
+export type userservicev1_GetTenantPlanRequest = {
+  id?: number;
+  code?: string;
+  viewMask?: wellKnownFieldMask;
+};
+
+// 创建套餐 - 请求
+export type userservicev1_CreateTenantPlanRequest = {
+  data: userservicev1_TenantPlan | undefined;
+};
+
+// 更新套餐 - 请求
+export type userservicev1_UpdateTenantPlanRequest = {
+  id: number | undefined;
+  data: userservicev1_TenantPlan | undefined;
+  updateMask: wellKnownFieldMask | undefined;
+  allowMissing?: boolean;
+};
+
+// 删除套餐 - 请求
+export type userservicev1_DeleteTenantPlanRequest = {
+  id: number | undefined;
+};
+
+// 租户用量计量服务
+export interface TenantUsageService {
+  // 查询租户在计费周期内每小时的用量记录
+  ListTenantUsageRecords(request: ListTenantUsageRecordsRequest): Promise<ListTenantUsageRecordsResponse>;
+  // 查询租户在计费周期内的用量汇总
+  ListTenantUsageSummaries(request: ListTenantUsageSummariesRequest): Promise<ListTenantUsageSummariesResponse>;
+}
+
+export function createTenantUsageServiceClient(
+  handler: RequestHandler
+): TenantUsageService {
+  return {
+    ListTenantUsageRecords(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_usage/records`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.tenantId) {
+        queryParams.push(`tenantId=${encodeURIComponent(request.tenantId.toString())}`)
+      }
+      if (request.period) {
+        queryParams.push(`period=${encodeURIComponent(request.period.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantUsageService",
+        method: "ListTenantUsageRecords",
+      }) as Promise<ListTenantUsageRecordsResponse>;
+    },
+    ListTenantUsageSummaries(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/tenant_usage/summaries`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      if (request.tenantId) {
+        queryParams.push(`tenantId=${encodeURIComponent(request.tenantId.toString())}`)
+      }
+      if (request.period) {
+        queryParams.push(`period=${encodeURIComponent(request.period.toString())}`)
+      }
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "TenantUsageService",
+        method: "ListTenantUsageSummaries",
+      }) as Promise<ListTenantUsageSummariesResponse>;
+    },
+  };
+}
+// 查询用量记录 - 请求
+export type ListTenantUsageRecordsRequest = {
+  // 租户ID
+  tenantId?: number;
+  // 计费周期
+  period?: string;
+};
+
+// 查询用量记录 - 回应
+export type ListTenantUsageRecordsResponse = {
+  // 计费周期的开始时间
+  periodStart: wellKnownTimestamp | undefined;
+  // 计费周期的结束时间
+  periodEnd: wellKnownTimestamp | undefined;
+  // 每小时的用量记录
+  items: TenantUsageRecord[] | undefined;
+};
+
+// 租户一小时的用量
+export type TenantUsageRecord = {
+  // 租户ID
+  tenantId: number | undefined;
+  // 统计小时的开始时间
+  periodStart: wellKnownTimestamp | undefined;
+  // 活跃用户数
+  activeUsers: number | undefined;
+  // API调用次数
+  apiCalls: number | undefined;
+  // 按业务模块拆分的API调用次数
+  apiCallsByModule: { [key: string]: number } | undefined;
+  // 存储空间（字节）
+  storageBytes: number | undefined;
+  // 发送的站内信数量
+  messagesSent: number | undefined;
+};
+
+// 查询用量汇总 - 请求
+export type ListTenantUsageSummariesRequest = {
+  // 租户ID
+  tenantId?: number;
+  // 计费周期
+  period?: string;
+};
+
+// 查询用量汇总 - 回应
+export type ListTenantUsageSummariesResponse = {
+  // 计费周期的开始时间
+  periodStart: wellKnownTimestamp | undefined;
+  // 计费周期的结束时间
+  periodEnd: wellKnownTimestamp | undefined;
+  // 各租户的用量汇总
+  items: TenantUsageSummary[] | undefined;
+};
+
+// 租户在计费周期内的用量汇总
+export type TenantUsageSummary = {
+  // 租户ID
+  tenantId: number | undefined;
+  // 租户名称
+  tenantName: string | undefined;
+  // 有用量记录的小时数
+  hours: number | undefined;
+  // 最大活跃用户数
+  peakActiveUsers: number | undefined;
+  // API调用次数
+  apiCalls: number | undefined;
+  // 按业务模块拆分的API调用次数
+  apiCallsByModule: { [key: string]: number } | undefined;
+  // 最大的存储空间（字节）
+  peakStorageBytes: number | undefined;
+  // 存储空间（字节·小时）
+  storageByteHours: number | undefined;
+  // 发送的站内信数量
+  messagesSent: number | undefined;
+};
+
+// 导出用量 - 请求
+export type ExportTenantUsageRequest = {
+  // 租户ID
+  tenantId?: number;
+  // 计费周期
+  period?: string;
+  // 导出的粒度
+  granularity?: ExportTenantUsageRequest_Granularity;
+};
+
+// 导出的粒度
+export type ExportTenantUsageRequest_Granularity =
+  | "SUMMARY"
+  | "HOURLY";
+// 导出用量 - 回应
+export type ExportTenantUsageResponse = {
+  // 文件名
+  fileName: string | undefined;
+  // CSV文件内容
+  content: string | undefined;
+};
+
 // UEditor后端服务
 export interface UEditorService {
   // UEditor API
@@ -4960,6 +5972,9 @@ export function createUserServiceClient(
       if (request.userName) {
         queryParams.push(`userName=${encodeURIComponent(request.userName.toString())}`)
       }
+      if (request.tenantId) {
+        queryParams.push(`tenantId=${encodeURIComponent(request.tenantId.toString())}`)
+      }
       if (request.viewMask) {
         queryParams.push(`viewMask=${encodeURIComponent(request.viewMask.toString())}`)
       }
@@ -5040,6 +6055,9 @@ export function createUserServiceClient(
       if (request.username) {
         queryParams.push(`username=${encodeURIComponent(request.username.toString())}`)
       }
+      if (request.tenantId) {
+        queryParams.push(`tenantId=${encodeURIComponent(request.tenantId.toString())}`)
+      }
       let uri = path;
       if (queryParams.length > 0) {
         uri += `?${queryParams.join("&")}`
@@ -5085,6 +6103,7 @@ export type userservicev1_ListUserResponse = {
 export type userservicev1_GetUserRequest = {
   id?: number;
   userName?: string;
+  tenantId?: number;
   viewMask?: wellKnownFieldMask;
 };
 
@@ -5113,6 +6132,7 @@ export type userservicev1_DeleteUserRequest = {
 // 用户是否存在 - 请求
 export type userservicev1_UserExistsRequest = {
   username: string | undefined;
+  tenantId?: number;
 };
 
 // 用户是否存在 - 答复
@@ -5142,6 +6162,14 @@ export interface UserProfileService {
   BindContact(request: userservicev1_BindContactRequest): Promise<wellKnownEmpty>;
   // 验证手机号码/邮箱
   VerifyContact(request: userservicev1_VerifyContactRequest): Promise<wellKnownEmpty>;
+  // 获取通知偏好
+  GetNotificationPreference(request: wellKnownEmpty): Promise<internal_messageservicev1_UserNotificationPreference>;
+  // 更新通知偏好
+  UpdateNotificationPreference(request: internal_messageservicev1_UpdateUserNotificationPreferenceRequest): Promise<wellKnownEmpty>;
+  // 获取个人配置，返回对当前用户生效的配置
+  GetSettings(request: wellKnownEmpty): Promise<userservicev1_ListSettingResponse>;
+  // 更新个人配置，只能覆盖允许用户修改的配置项
+  UpdateSettings(request: userservicev1_UpdateSettingsRequest): Promise<wellKnownEmpty>;
 }
 
 export function createUserProfileServiceClient(
@@ -5267,6 +6295,74 @@ export function createUserProfileServiceClient(
         method: "VerifyContact",
       }) as Promise<wellKnownEmpty>;
     },
+    GetNotificationPreference(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/me/notification-preference`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "UserProfileService",
+        method: "GetNotificationPreference",
+      }) as Promise<internal_messageservicev1_UserNotificationPreference>;
+    },
+    UpdateNotificationPreference(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/me/notification-preference`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "PUT",
+        body,
+      }, {
+        service: "UserProfileService",
+        method: "UpdateNotificationPreference",
+      }) as Promise<wellKnownEmpty>;
+    },
+    GetSettings(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/me/settings`; // eslint-disable-line quotes
+      const body = null;
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "GET",
+        body,
+      }, {
+        service: "UserProfileService",
+        method: "GetSettings",
+      }) as Promise<userservicev1_ListSettingResponse>;
+    },
+    UpdateSettings(request) { // eslint-disable-line @typescript-eslint/no-unused-vars
+      const path = `admin/v1/me/settings`; // eslint-disable-line quotes
+      const body = JSON.stringify(request);
+      const queryParams: string[] = [];
+      let uri = path;
+      if (queryParams.length > 0) {
+        uri += `?${queryParams.join("&")}`
+      }
+      return handler({
+        path: uri,
+        method: "PUT",
+        body,
+      }, {
+        service: "UserProfileService",
+        method: "UpdateSettings",
+      }) as Promise<wellKnownEmpty>;
+    },
   };
 }
 // 修改用户密码（需要验证旧密码） - 请求
@@ -5306,6 +6402,43 @@ export type userservicev1_VerifyContactRequest = {
   verificationId?: string;
 };
 
+// 用户通知偏好
+export type internal_messageservicev1_UserNotificationPreference = {
+  userId?: number;
+  disabledChannels: string[] | undefined;
+  categoryChannels: internal_messageservicev1_CategoryChannels[] | undefined;
+  mutedCategoryIds: number[] | undefined;
+  quietHours?: internal_messageservicev1_QuietHours;
+  digest?: internal_messageservicev1_DigestSettings;
+  createdAt?: wellKnownTimestamp;
+  updatedAt?: wellKnownTimestamp;
+};
+
+// 消息分类的通知渠道
+export type internal_messageservicev1_CategoryChannels = {
+  categoryId: number | undefined;
+  channels: string[] | undefined;
+};
+
+// 免打扰时段，时段内的消息只进入收件箱，不推送到客户端，外部渠道推迟到时段结束后发送
+export type internal_messageservicev1_QuietHours = {
+  enabled: boolean | undefined;
+  start: string | undefined;
+  end: string | undefined;
+  timezone: string | undefined;
+};
+
+// 摘要模式，低优先级的通知汇总为定期的摘要消息
+export type internal_messageservicev1_DigestSettings = {
+  enabled: boolean | undefined;
+  intervalMinutes: number | undefined;
+};
+
+// 更新用户通知偏好 - 请求
+export type internal_messageservicev1_UpdateUserNotificationPreferenceRequest = {
+  data: internal_messageservicev1_UserNotificationPreference | undefined;
+};
+
 // 手机验证
 export type userservicev1_PhoneVerification = {
   phone: string | undefined;
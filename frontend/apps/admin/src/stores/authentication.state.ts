@@ -19,6 +19,9 @@ import {
 import { $t } from '#/locales';
 import { requestClientRequestHandler } from '#/utils/request';
 
+// 代管期间保存平台管理员自己的访问令牌，退出代管时恢复
+const IMPERSONATOR_TOKEN_KEY = 'impersonator_access_token';
+
 export const useAuthStore = defineStore('auth', () => {
   const accessStore = useAccessStore();
   const userStore = useUserStore();
@@ -72,6 +75,7 @@ export const useAuthStore = defineStore('auth', () => {
         username: params.username,
         password: encryptPassword(params.password),
         grant_type: 'password',
+        tenant_code: params.tenantCode || undefined,
       });
 
       // 如果成功获取到 accessToken
@@ -152,6 +156,8 @@ export const useAuthStore = defineStore('auth', () => {
   async function doLogout(redirect: boolean = true) {
     console.log('doLogout');
 
+    localStorage.removeItem(IMPERSONATOR_TOKEN_KEY);
+
     resetAllStores();
 
     accessStore.setLoginExpired(false);
@@ -186,6 +192,75 @@ export const useAuthStore = defineStore('auth', () => {
     return newToken;
   }
 
+  /**
+   * 获取SSE连接票据，票据只能使用一次，每次建立连接前都需要重新获取
+   */
+  async function createSseTicket() {
+    const resp = await authnService.CreateSseTicket({});
+    return resp.ticket ?? '';
+  }
+
+  /**
+   * 获取当前用户身份，代管时同时返回代管操作人
+   */
+  async function whoAmI() {
+    return await authnService.WhoAmI({});
+  }
+
+  /**
+   * 是否正在代管租户
+   */
+  function isImpersonating() {
+    return !!localStorage.getItem(IMPERSONATOR_TOKEN_KEY);
+  }
+
+  /**
+   * 代管租户，切换为代管令牌后重新加载页面
+   * @param tenantId 租户ID
+   * @param reason 代管原因
+   * @param userId 代管的用户ID，为空时代管租户管理员
+   */
+  async function impersonate(tenantId: number, reason: string, userId?: number) {
+    const { access_token } = await authnService.Impersonate({
+      tenantId,
+      userId,
+      reason,
+    });
+    if (!access_token) {
+      return;
+    }
+
+    if (!isImpersonating() && accessStore.accessToken) {
+      localStorage.setItem(IMPERSONATOR_TOKEN_KEY, accessStore.accessToken);
+    }
+    accessStore.setAccessToken(access_token);
+
+    await router.replace(DEFAULT_HOME_PATH);
+    window.location.reload();
+  }
+
+  /**
+   * 退出代管，作废代管令牌并恢复平台管理员自己的登录
+   */
+  async function stopImpersonation() {
+    const originToken = localStorage.getItem(IMPERSONATOR_TOKEN_KEY);
+    if (!originToken) {
+      return;
+    }
+
+    try {
+      await authnService.Logout({});
+    } catch {
+      // 代管令牌可能已经过期，不做任何处理
+    }
+
+    localStorage.removeItem(IMPERSONATOR_TOKEN_KEY);
+    accessStore.setAccessToken(originToken);
+
+    await router.replace(DEFAULT_HOME_PATH);
+    window.location.reload();
+  }
+
   /**
    * 重新认证
    */
@@ -193,6 +268,12 @@ export const useAuthStore = defineStore('auth', () => {
     console.warn('Access token or refresh token is invalid or expired. ');
     const accessStore = useAccessStore();
 
+    // 代管令牌过期后回到平台管理员自己的登录
+    if (isImpersonating()) {
+      await stopImpersonation();
+      return;
+    }
+
     accessStore.setAccessToken(null);
 
     if (
@@ -234,9 +315,14 @@ export const useAuthStore = defineStore('auth', () => {
   return {
     $reset,
     authLogin,
+    createSseTicket,
     fetchUserInfo,
+    impersonate,
+    isImpersonating,
     loginLoading,
     logout,
+    stopImpersonation,
+    whoAmI,
     refreshToken,
     reauthenticate,
   };
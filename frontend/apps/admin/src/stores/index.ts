@@ -16,8 +16,11 @@ export * from './menu.state';
 export * from './organization.state';
 export * from './position.state';
 export * from './role.state';
+export * from './setting.state';
 export * from './task.state';
 export * from './tenant.state';
+export * from './tenant_plan.state';
+export * from './tenant_usage.state';
 export * from './user.state';
 
 export const enableList = computed(() => [
@@ -7,9 +7,11 @@ import { defineStore } from 'pinia';
 import {
   createInternalMessageRecipientServiceClient,
   createInternalMessageServiceClient,
+  createUserProfileServiceClient,
   type internal_messageservicev1_InternalMessage_Status as InternalMessage_Status,
   type internal_messageservicev1_InternalMessage_Type as InternalMessage_Type,
   type internal_messageservicev1_InternalMessageRecipient_Status as InternalMessageRecipient_Status,
+  type internal_messageservicev1_UserNotificationPreference as UserNotificationPreference,
 } from '#/generated/api/admin/service/v1';
 import { makeQueryString, makeUpdateMask } from '#/utils/query';
 import { requestClientRequestHandler } from '#/utils/request';
@@ -22,6 +24,10 @@ export const useInternalMessageStore = defineStore('internal_message', () => {
   const internalMessageRecipientService =
     createInternalMessageRecipientServiceClient(requestClientRequestHandler);
 
+  const userProfileService = createUserProfileServiceClient(
+    requestClientRequestHandler,
+  );
+
   /**
    * 查询消息列表
    */
@@ -109,6 +115,30 @@ export const useInternalMessageStore = defineStore('internal_message', () => {
     });
   }
 
+  /**
+   * 获取收件箱的未读数量汇总
+   */
+  async function getInboxSummary(userId?: number) {
+    return await internalMessageRecipientService.GetInboxSummary({
+      userId,
+    });
+  }
+
+  /**
+   * 将收件箱中的通知全部标记为已读，可以只标记某个分类或类型
+   */
+  async function markAllNotificationsAsRead(
+    userId: number,
+    categoryId?: number,
+    type?: InternalMessage_Type,
+  ) {
+    return await internalMessageRecipientService.MarkAllNotificationsAsRead({
+      userId,
+      categoryId,
+      type,
+    });
+  }
+
   /**
    * 删除收件箱中的通知
    */
@@ -132,6 +162,68 @@ export const useInternalMessageStore = defineStore('internal_message', () => {
     });
   }
 
+  /**
+   * 查询消息的阅读统计
+   */
+  async function getMessageReadStats(
+    messageId: number,
+    interval?: 'day' | 'hour',
+  ) {
+    return await internalMessageService.GetMessageReadStats({
+      messageId,
+      interval,
+    });
+  }
+
+  /**
+   * 查询消息的未读者列表
+   */
+  async function listMessageNonReaders(
+    messageId: number,
+    page?: number,
+    pageSize?: number,
+  ) {
+    return await internalMessageService.ListMessageNonReaders({
+      messageId,
+      page,
+      pageSize,
+    });
+  }
+
+  /**
+   * 导出消息的未读者
+   */
+  async function exportMessageNonReaders(messageId: number) {
+    return await internalMessageService.ExportMessageNonReaders({
+      messageId,
+    });
+  }
+
+  /**
+   * 提醒消息的未读者
+   */
+  async function remindMessageNonReaders(messageId: number) {
+    return await internalMessageService.RemindMessageNonReaders({
+      messageId,
+    });
+  }
+
+  /**
+   * 获取当前用户的通知偏好
+   */
+  async function getNotificationPreference() {
+    return await userProfileService.GetNotificationPreference({});
+  }
+
+  /**
+   * 更新当前用户的通知偏好，包括静音分类、免打扰时段与摘要模式
+   */
+  async function updateNotificationPreference(
+    data: UserNotificationPreference,
+  ) {
+    return await userProfileService.UpdateNotificationPreference({ data });
+  }
+
   /**
    * 发送消息
    */
@@ -150,7 +242,15 @@ export const useInternalMessageStore = defineStore('internal_message', () => {
     listUserInbox,
     sendMessage,
     revokeMessage,
+    getMessageReadStats,
+    listMessageNonReaders,
+    exportMessageNonReaders,
+    remindMessageNonReaders,
+    getNotificationPreference,
+    updateNotificationPreference,
     markNotificationAsRead,
+    getInboxSummary,
+    markAllNotificationsAsRead,
     deleteNotificationFromInbox,
   };
 });
@@ -328,3 +428,22 @@ export function internalMessageRecipientStatusColor(
     }
   }
 }
+
+export const internalMessagePriorityList = computed(() => [
+  {
+    value: 'LOW',
+    label: $t('enum.internalMessagePriority.LOW'),
+  },
+  {
+    value: 'NORMAL',
+    label: $t('enum.internalMessagePriority.NORMAL'),
+  },
+  {
+    value: 'HIGH',
+    label: $t('enum.internalMessagePriority.HIGH'),
+  },
+  {
+    value: 'URGENT',
+    label: $t('enum.internalMessagePriority.URGENT'),
+  },
+]);
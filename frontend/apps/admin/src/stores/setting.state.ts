@@ -0,0 +1,125 @@
+import type { SupportedLanguagesType } from '@vben/locales';
+import type { ThemeModeType } from '@vben/types';
+
+import type { userservicev1_Setting as Setting } from '#/generated/api/admin/service/v1';
+
+import { loadLocaleMessages } from '@vben/locales';
+import { preferences, updatePreferences } from '@vben/preferences';
+
+import { defineStore } from 'pinia';
+
+import {
+  createSettingServiceClient,
+  createUserProfileServiceClient,
+} from '#/generated/api/admin/service/v1';
+import { requestClientRequestHandler } from '#/utils/request';
+
+/**
+ * 内置配置项键名
+ */
+export const SettingKeys = {
+  PasswordMinLength: 'security.password.min_length',
+  SessionTimeoutMinutes: 'security.session.timeout_minutes',
+  LoginMethods: 'auth.login_methods',
+  DefaultLanguage: 'locale.default_language',
+  AppName: 'branding.app_name',
+  ThemeColor: 'branding.theme_color',
+  ThemeMode: 'branding.theme_mode',
+};
+
+export const useSettingStore = defineStore('setting', () => {
+  const service = createSettingServiceClient(requestClientRequestHandler);
+  const userProfileService = createUserProfileServiceClient(
+    requestClientRequestHandler,
+  );
+
+  /**
+   * 查询配置项定义
+   */
+  async function listSettingDefinitions() {
+    return await service.ListDefinitions({});
+  }
+
+  /**
+   * 查询系统或租户生效的配置，不指定租户时为当前租户
+   */
+  async function getSettings(tenantId?: number) {
+    return await service.GetSettings({ tenantId });
+  }
+
+  /**
+   * 更新系统或租户配置
+   */
+  async function updateSettings(
+    values: Record<string, string>,
+    resetKeys: string[] = [],
+    tenantId?: number,
+  ) {
+    return await service.UpdateSettings({ tenantId, values, resetKeys });
+  }
+
+  /**
+   * 查询对当前用户生效的配置
+   */
+  async function getMySettings() {
+    return await userProfileService.GetSettings({});
+  }
+
+  /**
+   * 更新个人配置
+   */
+  async function updateMySettings(
+    values: Record<string, string>,
+    resetKeys: string[] = [],
+  ) {
+    return await userProfileService.UpdateSettings({ values, resetKeys });
+  }
+
+  /**
+   * 将对当前用户生效的品牌与语言配置应用到界面，只应用设置过的配置项，保留本地的偏好设置
+   */
+  async function applyMySettings() {
+    const resp = await getMySettings();
+
+    const configured = new Map<string, string>();
+    (resp.items ?? []).forEach((item: Setting) => {
+      if (item.key && item.source !== 'DEFAULT') {
+        configured.set(item.key, item.value ?? '');
+      }
+    });
+
+    const appName = configured.get(SettingKeys.AppName);
+    const themeColor = configured.get(SettingKeys.ThemeColor);
+    const themeMode = configured.get(SettingKeys.ThemeMode);
+    const locale = configured.get(SettingKeys.DefaultLanguage);
+
+    updatePreferences({
+      ...(appName ? { app: { name: appName } } : {}),
+      theme: {
+        ...(themeColor
+          ? { builtinType: 'custom', colorPrimary: themeColor }
+          : {}),
+        ...(themeMode ? { mode: themeMode as ThemeModeType } : {}),
+      },
+    });
+
+    if (locale && locale !== preferences.app.locale) {
+      updatePreferences({
+        app: { locale: locale as SupportedLanguagesType },
+      });
+      await loadLocaleMessages(locale as SupportedLanguagesType);
+    }
+  }
+
+  function $reset() {}
+
+  return {
+    $reset,
+    listSettingDefinitions,
+    getSettings,
+    updateSettings,
+    getMySettings,
+    updateMySettings,
+    applyMySettings,
+  };
+});
@@ -7,6 +7,7 @@ import { defineStore } from 'pinia';
 import {
   createTenantServiceClient,
   type CreateTenantWithAdminUserRequest,
+  type OffboardTenantRequest_Mode as OffboardMode,
   type userservicev1_Tenant_AuditStatus as Tenant_AuditStatus,
   type userservicev1_Tenant_Status as Tenant_Status,
   type userservicev1_Tenant_Type as Tenant_Type,
@@ -98,6 +99,37 @@ export const useTenantStore = defineStore('tenant', () => {
     return await service.TenantExists({ code });
   }
 
+  /**
+   * 获取租户模板列表
+   */
+  async function listTenantTemplates() {
+    return await service.ListTenantTemplates({});
+  }
+
+  /**
+   * 租户下线
+   * @param id 租户ID
+   * @param mode 下线方式：归档或清除
+   * @param confirmCode 确认的租户编码
+   * @param reason 下线原因
+   */
+  async function offboardTenant(
+    id: number,
+    mode: OffboardMode,
+    confirmCode: string,
+    reason?: string,
+  ) {
+    return await service.OffboardTenant({ id, mode, confirmCode, reason });
+  }
+
+  /**
+   * 查询租户用量及套餐配额
+   * @param id 租户ID，租户用户可不传
+   */
+  async function getTenantUsage(id?: number) {
+    return await service.GetTenantUsage({ id });
+  }
+
   function $reset() {}
 
   return {
@@ -109,6 +141,9 @@ export const useTenantStore = defineStore('tenant', () => {
     updateTenant,
     deleteTenant,
     tenantExists,
+    listTenantTemplates,
+    offboardTenant,
+    getTenantUsage,
   };
 });
 
@@ -0,0 +1,83 @@
+import { defineStore } from 'pinia';
+
+import { createTenantPlanServiceClient } from '#/generated/api/admin/service/v1';
+import { makeQueryString, makeUpdateMask } from '#/utils/query';
+import { requestClientRequestHandler } from '#/utils/request';
+
+export const useTenantPlanStore = defineStore('tenant-plan', () => {
+  const service = createTenantPlanServiceClient(requestClientRequestHandler);
+
+  /**
+   * 查询套餐列表
+   */
+  async function listTenantPlan(
+    noPaging: boolean = false,
+    page?: null | number,
+    pageSize?: null | number,
+    formValues?: null | object,
+    fieldMask?: null | string,
+    orderBy?: null | string[],
+  ) {
+    return await service.List({
+      // @ts-ignore proto generated code is error.
+      fieldMask,
+      orderBy: orderBy ?? [],
+      query: makeQueryString(formValues ?? null),
+      page,
+      pageSize,
+      noPaging,
+    });
+  }
+
+  /**
+   * 获取套餐
+   */
+  async function getTenantPlan(id: number) {
+    return await service.Get({ id });
+  }
+
+  /**
+   * 创建套餐
+   */
+  async function createTenantPlan(values: object) {
+    return await service.Create({
+      // @ts-ignore proto generated code is error.
+      data: {
+        ...values,
+      },
+    });
+  }
+
+  /**
+   * 更新套餐
+   */
+  async function updateTenantPlan(id: number, values: object) {
+    return await service.Update({
+      id,
+      // @ts-ignore proto generated code is error.
+      data: {
+        ...values,
+      },
+      // @ts-ignore proto generated code is error.
+      updateMask: makeUpdateMask(Object.keys(values ?? [])),
+    });
+  }
+
+  /**
+   * 删除套餐
+   */
+  async function deleteTenantPlan(id: number) {
+    return await service.Delete({ id });
+  }
+
+  function $reset() {}
+
+  return {
+    $reset,
+    listTenantPlan,
+    getTenantPlan,
+    createTenantPlan,
+    updateTenantPlan,
+    deleteTenantPlan,
+  };
+});
@@ -0,0 +1,63 @@
+import { defineStore } from 'pinia';
+
+import {
+  createTenantUsageServiceClient,
+  type ExportTenantUsageRequest_Granularity,
+} from '#/generated/api/admin/service/v1';
+import { requestClient, requestClientRequestHandler } from '#/utils/request';
+
+export const useTenantUsageStore = defineStore('tenant-usage', () => {
+  const service = createTenantUsageServiceClient(requestClientRequestHandler);
+
+  /**
+   * 查询计费周期内的用量汇总
+   */
+  async function listTenantUsageSummaries(
+    tenantId?: null | number,
+    period?: null | string,
+  ) {
+    return await service.ListTenantUsageSummaries({
+      tenantId: tenantId ?? undefined,
+      period: period ?? undefined,
+    });
+  }
+
+  /**
+   * 查询计费周期内每小时的用量记录
+   */
+  async function listTenantUsageRecords(
+    tenantId?: null | number,
+    period?: null | string,
+  ) {
+    return await service.ListTenantUsageRecords({
+      tenantId: tenantId ?? undefined,
+      period: period ?? undefined,
+    });
+  }
+
+  /**
+   * 导出计费周期内的用量为CSV文件
+   */
+  async function exportTenantUsage(
+    tenantId?: null | number,
+    period?: null | string,
+    granularity: ExportTenantUsageRequest_Granularity = 'SUMMARY',
+  ) {
+    return await requestClient.download('admin/v1/tenant_usage:export', {
+      params: {
+        tenantId: tenantId || undefined,
+        period: period || undefined,
+        granularity,
+      },
+    });
+  }
+
+  function $reset() {}
+
+  return {
+    $reset,
+    listTenantUsageSummaries,
+    listTenantUsageRecords,
+    exportTenantUsage,
+  };
+});
@@ -13,6 +13,10 @@ export class SSEClient {
   private eventSource: EventSource | null = null;
   // 存储事件监听器（键：事件名，值：回调数组）
   private handlers = new Map<SSEEventName, SSEEventHandler[]>();
+  // 最后收到的事件ID，重连时用于让服务器重放断线期间的事件
+  private lastEventId = '';
+  // 当前 EventSource 上已注册的自定义事件
+  private listened = new Set<SSEEventName>();
   private status: SSEConnectionStatus = 'disconnected';
 
   constructor(config: SSEClientConfig) {
@@ -54,6 +58,11 @@ export class SSEClient {
     data: T,
     event: Event,
   ): void {
+    const id = (event as MessageEvent).lastEventId;
+    if (id) {
+      this.lastEventId = id;
+    }
+
     const handlers = this.handlers.get(eventName);
     if (handlers) {
       handlers.forEach((handler) => {
@@ -66,6 +75,36 @@ export class SSEClient {
     }
   }
 
+  /**
+   * 向 EventSource 注册自定义事件
+   * @param eventName
+   * @private
+   */
+  private listen(eventName: SSEEventName): void {
+    if (!this.eventSource || this.listened.has(eventName)) {
+      return;
+    }
+    this.listened.add(eventName);
+    this.eventSource.addEventListener(eventName, (event) => {
+      const data = this.parseData((event as MessageEvent).data);
+      this.triggerHandler(eventName, data, event as MessageEvent);
+    });
+  }
+
+  /**
+   * 在地址上附加最后收到的事件ID
+   * 浏览器自动重连时会携带 Last-Event-ID 请求头，重新获取地址后的连接需要通过查询参数传递
+   * @param url
+   * @private
+   */
+  private withLastEventId(url: string): string {
+    if (!this.lastEventId) {
+      return url;
+    }
+    const separator = url.includes('?') ? '&' : '?';
+    return `${url}${separator}last_event_id=${encodeURIComponent(this.lastEventId)}`;
+  }
+
   /**
    * 关闭 SSE 连接
    */
@@ -80,14 +119,29 @@ export class SSEClient {
   /**
    * 建立 SSE 连接
    */
-  connect(): void {
+  async connect(): Promise<void> {
     if (this.status === 'connected' || this.status === 'connecting') {
       console.warn('SSE 连接已存在或正在建立中');
       return;
     }
 
     this.status = 'connecting';
-    this.eventSource = new EventSource(this.config.url, {
+
+    let url: string;
+    try {
+      url =
+        typeof this.config.url === 'function'
+          ? this.withLastEventId(await this.config.url())
+          : this.config.url;
+    } catch (error) {
+      console.error('获取 SSE 连接地址失败:', error);
+      this.status = 'disconnected';
+      setTimeout(() => this.connect(), this.config.reconnectDelay);
+      return;
+    }
+
+    this.listened.clear();
+    this.eventSource = new EventSource(url, {
       withCredentials: this.config.withCredentials,
     });
 
@@ -97,6 +151,13 @@ export class SSEClient {
       this.triggerHandler('open', undefined, event);
     });
 
+    // 重新注册已有的自定义事件，重连后创建的 EventSource 也能收到
+    this.handlers.forEach((_, eventName) => {
+      if (!['error', 'message', 'open'].includes(eventName)) {
+        this.listen(eventName);
+      }
+    });
+
     // 监听默认消息事件（服务器未指定 event 字段时触发）
     this.eventSource.onmessage = (event) => {
       const data = this.parseData(event.data);
@@ -108,6 +169,11 @@ export class SSEClient {
       this.status = 'error';
       this.triggerHandler('error', undefined, event as MessageEvent);
 
+      // 一次性票据不能重复使用，由客户端重新获取地址后重连，不使用浏览器的自动重连
+      if (typeof this.config.url === 'function' && this.eventSource) {
+        this.eventSource.close();
+      }
+
       // 连接关闭时尝试重连（排除手动关闭的情况）
       if (this.eventSource?.readyState === EventSource.CLOSED) {
         this.status = 'disconnected';
@@ -157,11 +223,9 @@ export class SSEClient {
     this.handlers.get(eventName)?.push(handler as SSEEventHandler);
 
     // 对自定义事件（非 open/error/message），需要额外注册到 EventSource
-    if (!['error', 'message', 'open'].includes(eventName) && this.eventSource) {
-      this.eventSource.addEventListener(eventName, (event) => {
-        const data = this.parseData((event as MessageEvent).data);
-        this.triggerHandler(eventName, data, event as MessageEvent);
-      });
+    // 同一事件只注册一次，避免回调被重复触发
+    if (!['error', 'message', 'open'].includes(eventName)) {
+      this.listen(eventName);
     }
   }
 }
@@ -15,7 +15,7 @@ export type SSEEventHandler<T = unknown> = (
  * SSE 客户端配置
  */
 export interface SSEClientConfig {
-  url: string; // SSE 服务器端点 URL
+  url: (() => Promise<string>) | string; // SSE 服务器端点 URL，需要一次性票据时传入获取 URL 的函数
   withCredentials?: boolean; // 是否携带跨域凭证（cookie 等）
   reconnectDelay?: number; // 断开后重连延迟（毫秒，默认 3000）
   autoParseJson?: boolean; // 是否自动解析 JSON 格式的数据（默认 true）
@@ -24,6 +24,8 @@ export const LucideChevronLeft = createIconifyIcon('lucide:chevron-left');
 
 export const LucideTrash = createIconifyIcon('lucide:trash');
 export const LucideTrash2 = createIconifyIcon('lucide:trash-2');
+export const LucideArchive = createIconifyIcon('lucide:archive');
+export const LucideUserCog = createIconifyIcon('lucide:user-cog');
 
 export const LucidePencil = createIconifyIcon('lucide:pencil');
 export const LucidePencilOff = createIconifyIcon('lucide:pencil-off');